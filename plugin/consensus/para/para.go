@@ -65,6 +65,7 @@ type client struct {
 	subCfg          *subConfig
 	dldCfg          *downloadClient
 	blsSignCli      *blsClient
+	mainVerifyCli   *mainVerifyClient
 	isClosed        int32
 	quit            chan struct{}
 }
@@ -92,6 +93,14 @@ type subConfig struct {
 	JumpDownloadClose       bool     `json:"jumpDownloadClose,omitempty"`
 	BlsSign                 bool     `json:"blsSign,omitempty"`
 	BlsLeaderSwitchIntval   int32    `json:"blsLeaderSwitchIntval,omitempty"`
+	BlsThresholdSign        bool     `json:"blsThresholdSign,omitempty"`
+	MainVerifyOpen          bool     `json:"mainVerifyOpen,omitempty"`
+	MainVerifyMinAgree      int32    `json:"mainVerifyMinAgree,omitempty"`
+	MainConsensus           string   `json:"mainConsensus,omitempty"`
+	MainSignName            string   `json:"mainSignName,omitempty"`
+	MainValidators          []string `json:"mainValidators,omitempty"`
+	MainRetargetBlocks      int64    `json:"mainRetargetBlocks,omitempty"`
+	MainRetargetFactor      int64    `json:"mainRetargetFactor,omitempty"`
 }
 
 // New function to init paracross env
@@ -164,6 +173,8 @@ func New(cfg *types.Consensus, sub []byte) queue.Module {
 
 	para.blsSignCli = newBlsClient(para, &subcfg)
 
+	para.mainVerifyCli = newMainVerifyCli(para, &subcfg)
+
 	c.SetChild(para)
 	return para
 }
@@ -206,9 +217,10 @@ func (client *client) SetQueueClient(c queue.Client) {
 	client.wg.Add(1)
 	go client.blockSyncClient.syncBlocks()
 
-	client.wg.Add(2)
+	client.wg.Add(3)
 	go client.blsSignCli.procAggregateTxs()
 	go client.blsSignCli.procLeaderSync()
+	go client.blsSignCli.procDkg()
 
 }
 
@@ -343,6 +355,8 @@ func (client *client) ProcEvent(msg *queue.Message) bool {
 				if err != nil {
 					plog.Error("paracross ProcEvent leader sync msg", "err", err)
 				}
+			case P2pSubDkgDeal:
+				client.blsSignCli.rcvDkgDealMsg(sub.GetDkgDeal())
 			default:
 				plog.Error("paracross ProcEvent not support", "ty", sub.GetTy())
 			}
@@ -13,6 +13,7 @@ import (
 const (
 	P2pSubCommitTx      = 1
 	P2pSubLeaderSyncMsg = 2
+	P2pSubDkgDeal       = 3
 	moduleName          = "consensus"
 )
 
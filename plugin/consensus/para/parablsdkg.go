@@ -0,0 +1,641 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package para
+
+import (
+	"bytes"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/phoreproject/bls"
+	"github.com/phoreproject/bls/g1pubs"
+	"github.com/pkg/errors"
+)
+
+/*
+nodegroup门限bls签名的DKG(分布式密钥生成)，没有可信的dealer：
+1. nodegroup的n个节点按nodegroup顺序编号1..n，门限t=2n/3+1，每个节点作为dealer随机生成t-1次多项式f_i，
+   广播系数的G1承诺C_ik=a_ik*G1，以及给每个节点j的分片f_i(j)，分片用节点j注册的bls公钥做ECIES加密，deal用dealer的bls私钥签名
+2. 节点j收到deal后解密自己的分片，校验f_i(j)*G1=∑C_ik*j^k，不通过的deal丢弃
+3. 收齐n个有效deal后，群公钥为∑C_i0，节点j的分片私钥为∑f_i(j)，节点m的分片公钥为∑∑C_ik*m^k，用来校验部分签名
+4. 部分签名就是分片私钥的普通bls签名，任意t个部分签名按拉格朗日系数在0点插值合成群签名，和群私钥∑a_i0直接签名相同，用普通bls验签
+5. 没有投诉轮，dealer只给部分节点错误分片时这些节点无法完成DKG，群公钥需要超过2/3节点投票一致才在链上生效
+*/
+
+const (
+	dkgCheckInt     = 30 //30s检查一次nodegroup变化和群公钥状态
+	dkgScalarLen    = 32
+	dkgG1Len        = 48
+	dkgSignatureLen = 96
+)
+
+var dkgFrModulus = bls.RFieldModulus.ToBig()
+
+//门限需要超过节点数的2/3，和主链isCommitDone一致
+func dkgThreshold(nodes int) int {
+	return nodes*2/3 + 1
+}
+
+func dkgRandScalar() (*big.Int, error) {
+	for {
+		x, err := rand.Int(rand.Reader, dkgFrModulus)
+		if err != nil {
+			return nil, err
+		}
+		if x.Sign() > 0 {
+			return x, nil
+		}
+	}
+}
+
+func dkgFrRepr(x *big.Int) *bls.FRRepr {
+	repr, _ := bls.FRReprFromBigInt(new(big.Int).Mod(x, dkgFrModulus))
+	return repr
+}
+
+func dkgScalarBytes(x *big.Int) []byte {
+	b := new(big.Int).Mod(x, dkgFrModulus).Bytes()
+	return append(make([]byte, dkgScalarLen-len(b)), b...)
+}
+
+func dkgG1Mul(p *bls.G1Projective, x *big.Int) *bls.G1Projective {
+	return p.MulFR(dkgFrRepr(x))
+}
+
+func dkgG1Bytes(p *bls.G1Projective) []byte {
+	b := bls.CompressG1(p.ToAffine())
+	return b[:]
+}
+
+func dkgG1FromBytes(data []byte) (*bls.G1Projective, error) {
+	if len(data) != dkgG1Len {
+		return nil, errors.Wrapf(pt.ErrParaBlsDkgDeal, "g1 len=%d", len(data))
+	}
+	var b [dkgG1Len]byte
+	copy(b[:], data)
+	p, err := bls.DecompressG1(b)
+	if err != nil {
+		return nil, errors.Wrapf(pt.ErrParaBlsDkgDeal, "decompress g1=%s,err=%s", common.ToHex(data), err)
+	}
+	return p.ToProjective(), nil
+}
+
+//bls私钥对应的标量
+func dkgPriKeyScalar(priKey crypto.PrivKey) (*big.Int, error) {
+	data := priKey.Bytes()
+	if len(data) != dkgScalarLen {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "bls prikey len=%d", len(data))
+	}
+	var b [dkgScalarLen]byte
+	copy(b[:], data)
+	f := g1pubs.DeserializeSecretKey(b).GetFRElement()
+	if f == nil {
+		return nil, errors.Wrap(types.ErrInvalidParam, "bls prikey")
+	}
+	return f.ToRepr().ToBig(), nil
+}
+
+//f(x)=∑a_k*x^k
+func evalDkgPoly(coefs []*big.Int, x int64) *big.Int {
+	xb := big.NewInt(x)
+	r := new(big.Int).Set(coefs[len(coefs)-1])
+	for k := len(coefs) - 2; k >= 0; k-- {
+		r.Mul(r, xb)
+		r.Add(r, coefs[k])
+		r.Mod(r, dkgFrModulus)
+	}
+	return r
+}
+
+//f(x)*G1=∑C_k*x^k
+func evalDkgCommits(commits []*bls.G1Projective, x int64) *bls.G1Projective {
+	xb := big.NewInt(x)
+	r := commits[len(commits)-1].Copy()
+	for k := len(commits) - 2; k >= 0; k-- {
+		r = dkgG1Mul(r, xb).Add(commits[k])
+	}
+	return r
+}
+
+//分片加密密钥sha256(r*PK||dealer||addr)
+func dkgShareKey(shared *bls.G1Projective, dealer, addr string) []byte {
+	data := append(dkgG1Bytes(shared), []byte(dealer+addr)...)
+	return common.Sha256(data)
+}
+
+func dkgXor(a, b []byte) []byte {
+	r := make([]byte, len(a))
+	for i := range a {
+		r[i] = a[i] ^ b[i]
+	}
+	return r
+}
+
+func dkgNodeIndex(nodes []string, addr string) int64 {
+	found, i := hasCommited(nodes, addr)
+	if !found {
+		return 0
+	}
+	return int64(i + 1)
+}
+
+type blsDkg struct {
+	cryptoCli crypto.Crypto
+	selfID    string
+	selfIdx   int64
+	priKey    *big.Int
+	nodes     []string
+	nodeStr   string
+	threshold int
+	deal      *pt.ParaBlsDkgDeal
+	deals     map[string]*pt.ParaBlsDkgDeal
+	commits   map[string][]*bls.G1Projective
+	shares    map[string]*big.Int
+	getPubKey func(addr string) (crypto.PubKey, error)
+}
+
+//开始一轮DKG并生成自己的deal，getPubKey获取节点注册的bls公钥
+func newBlsDkg(cryptoCli crypto.Crypto, selfID string, priKey crypto.PrivKey, nodes []string,
+	getPubKey func(addr string) (crypto.PubKey, error)) (*blsDkg, error) {
+	d := &blsDkg{
+		cryptoCli: cryptoCli,
+		selfID:    selfID,
+		selfIdx:   dkgNodeIndex(nodes, selfID),
+		nodes:     nodes,
+		nodeStr:   strings.Join(nodes, ","),
+		threshold: dkgThreshold(len(nodes)),
+		deals:     make(map[string]*pt.ParaBlsDkgDeal),
+		commits:   make(map[string][]*bls.G1Projective),
+		shares:    make(map[string]*big.Int),
+		getPubKey: getPubKey,
+	}
+	if d.selfIdx <= 0 {
+		return nil, errors.Wrapf(pt.ErrParaNodeAddrNotExisted, "self=%s,nodes=%s", selfID, d.nodeStr)
+	}
+	var err error
+	d.priKey, err = dkgPriKeyScalar(priKey)
+	if err != nil {
+		return nil, err
+	}
+	d.deal, err = d.createDeal(priKey)
+	if err != nil {
+		return nil, err
+	}
+	err = d.rcvDeal(d.deal)
+	if err != nil {
+		return nil, errors.Wrap(err, "self deal")
+	}
+	return d, nil
+}
+
+func (d *blsDkg) createDeal(priKey crypto.PrivKey) (*pt.ParaBlsDkgDeal, error) {
+	coefs := make([]*big.Int, d.threshold)
+	deal := &pt.ParaBlsDkgDeal{Nodes: d.nodeStr, Threshold: int32(d.threshold), Dealer: d.selfID}
+	for k := range coefs {
+		a, err := dkgRandScalar()
+		if err != nil {
+			return nil, err
+		}
+		coefs[k] = a
+		deal.Commits = append(deal.Commits, dkgG1Bytes(dkgG1Mul(bls.G1ProjectiveOne, a)))
+	}
+
+	for i, addr := range d.nodes {
+		pub, err := d.getPubKey(addr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bls pubkey addr=%s", addr)
+		}
+		pubPoint, err := dkgG1FromBytes(pub.Bytes())
+		if err != nil {
+			return nil, errors.Wrapf(err, "bls pubkey addr=%s", addr)
+		}
+		r, err := dkgRandScalar()
+		if err != nil {
+			return nil, err
+		}
+		key := dkgShareKey(dkgG1Mul(pubPoint, r), d.selfID, addr)
+		share := dkgScalarBytes(evalDkgPoly(coefs, int64(i+1)))
+		deal.Shares = append(deal.Shares, &pt.ParaBlsDkgShare{
+			Addr:      addr,
+			Ephemeral: dkgG1Bytes(dkgG1Mul(bls.G1ProjectiveOne, r)),
+			EncShare:  dkgXor(share, key),
+		})
+	}
+	deal.Sign = priKey.Sign(types.Encode(deal)).Bytes()
+	return deal, nil
+}
+
+func (d *blsDkg) checkDealSign(deal *pt.ParaBlsDkgDeal) error {
+	if deal.Nodes != d.nodeStr || int(deal.Threshold) != d.threshold {
+		return errors.Wrapf(pt.ErrParaBlsDkgDeal, "deal nodes=%s,threshold=%d,self nodes=%s,threshold=%d",
+			deal.Nodes, deal.Threshold, d.nodeStr, d.threshold)
+	}
+	if dkgNodeIndex(d.nodes, deal.Dealer) <= 0 {
+		return errors.Wrapf(pt.ErrParaNodeAddrNotExisted, "dealer=%s", deal.Dealer)
+	}
+	pub, err := d.getPubKey(deal.Dealer)
+	if err != nil {
+		return errors.Wrapf(err, "bls pubkey dealer=%s", deal.Dealer)
+	}
+	sig, err := d.cryptoCli.SignatureFromBytes(deal.Sign)
+	if err != nil {
+		return errors.Wrapf(err, "deal sign dealer=%s", deal.Dealer)
+	}
+	unsigned := proto.Clone(deal).(*pt.ParaBlsDkgDeal)
+	unsigned.Sign = nil
+	if !pub.VerifyBytes(types.Encode(unsigned), sig) {
+		return errors.Wrapf(pt.ErrBlsSignVerify, "deal dealer=%s", deal.Dealer)
+	}
+	return nil
+}
+
+//dealer重新开始DKG后deal和之前不同
+func (d *blsDkg) isDealChanged(deal *pt.ParaBlsDkgDeal) bool {
+	old, ok := d.deals[deal.Dealer]
+	return ok && !proto.Equal(old, deal)
+}
+
+func (d *blsDkg) rcvDeal(deal *pt.ParaBlsDkgDeal) error {
+	//dealer重新开始DKG时替换之前的deal
+	if old, ok := d.deals[deal.Dealer]; ok && proto.Equal(old, deal) {
+		return nil
+	}
+	err := d.checkDealSign(deal)
+	if err != nil {
+		return err
+	}
+	if len(deal.Commits) != d.threshold || len(deal.Shares) != len(d.nodes) {
+		return errors.Wrapf(pt.ErrParaBlsDkgDeal, "dealer=%s commits=%d,shares=%d", deal.Dealer, len(deal.Commits), len(deal.Shares))
+	}
+	var commits []*bls.G1Projective
+	for _, c := range deal.Commits {
+		p, err := dkgG1FromBytes(c)
+		if err != nil {
+			return errors.Wrapf(err, "dealer=%s", deal.Dealer)
+		}
+		commits = append(commits, p)
+	}
+
+	//解密并校验自己的分片
+	s := deal.Shares[d.selfIdx-1]
+	if s.Addr != d.selfID || len(s.EncShare) != dkgScalarLen {
+		return errors.Wrapf(pt.ErrParaBlsDkgDeal, "dealer=%s share addr=%s", deal.Dealer, s.Addr)
+	}
+	ephemeral, err := dkgG1FromBytes(s.Ephemeral)
+	if err != nil {
+		return errors.Wrapf(err, "dealer=%s", deal.Dealer)
+	}
+	key := dkgShareKey(dkgG1Mul(ephemeral, d.priKey), deal.Dealer, d.selfID)
+	share := new(big.Int).SetBytes(dkgXor(s.EncShare, key))
+	if share.Cmp(dkgFrModulus) >= 0 || !dkgG1Mul(bls.G1ProjectiveOne, share).Equal(evalDkgCommits(commits, d.selfIdx)) {
+		return errors.Wrapf(pt.ErrParaBlsDkgDeal, "dealer=%s share verify", deal.Dealer)
+	}
+
+	d.deals[deal.Dealer] = deal
+	d.commits[deal.Dealer] = commits
+	d.shares[deal.Dealer] = share
+	return nil
+}
+
+func (d *blsDkg) isComplete() bool {
+	return len(d.shares) == len(d.nodes)
+}
+
+func (d *blsDkg) groupKey() (*blsGroupKey, error) {
+	if !d.isComplete() {
+		return nil, errors.Wrapf(pt.ErrParaBlsDkgDeal, "deals=%d,nodes=%d", len(d.shares), len(d.nodes))
+	}
+	//合并所有dealer的多项式承诺和分片
+	sum := make([]*bls.G1Projective, d.threshold)
+	share := big.NewInt(0)
+	for _, addr := range d.nodes {
+		for k, c := range d.commits[addr] {
+			if sum[k] == nil {
+				sum[k] = c.Copy()
+				continue
+			}
+			sum[k] = sum[k].Add(c)
+		}
+		share.Add(share, d.shares[addr])
+	}
+	share.Mod(share, dkgFrModulus)
+
+	key := &blsGroupKey{
+		nodes:       d.nodes,
+		nodeStr:     d.nodeStr,
+		threshold:   d.threshold,
+		groupPubKey: dkgG1Bytes(sum[0]),
+		share:       share,
+		verifyKeys:  make(map[string]*g1pubs.PublicKey),
+	}
+	for i, addr := range d.nodes {
+		key.verifyKeys[addr] = g1pubs.NewPublicKeyFromG1(evalDkgCommits(sum, int64(i+1)).ToAffine())
+	}
+	if !dkgG1Mul(bls.G1ProjectiveOne, share).Equal(key.verifyKeys[d.selfID].GetPoint()) {
+		return nil, errors.Wrapf(pt.ErrParaBlsDkgDeal, "self share verify,nodes=%s", d.nodeStr)
+	}
+	return key, nil
+}
+
+type blsGroupKey struct {
+	nodes       []string
+	nodeStr     string
+	threshold   int
+	groupPubKey []byte
+	share       *big.Int
+	verifyKeys  map[string]*g1pubs.PublicKey
+}
+
+func (k *blsGroupKey) partialSign(msg []byte) []byte {
+	sig := g1pubs.Sign(msg, g1pubs.KeyFromFQRepr(dkgFrRepr(k.share))).Serialize()
+	return sig[:]
+}
+
+func dkgSignature(data []byte) (*g1pubs.Signature, error) {
+	if len(data) != dkgSignatureLen {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "sign len=%d", len(data))
+	}
+	var b [dkgSignatureLen]byte
+	copy(b[:], data)
+	return g1pubs.DeserializeSignature(b)
+}
+
+func (k *blsGroupKey) verifyPartial(addr string, msg, sign []byte) error {
+	pub, ok := k.verifyKeys[addr]
+	if !ok {
+		return errors.Wrapf(pt.ErrParaNodeAddrNotExisted, "addr=%s", addr)
+	}
+	sig, err := dkgSignature(sign)
+	if err != nil {
+		return errors.Wrapf(err, "addr=%s", addr)
+	}
+	if !g1pubs.Verify(msg, pub, sig) {
+		return errors.Wrapf(pt.ErrBlsSignVerify, "partial sign addr=%s", addr)
+	}
+	return nil
+}
+
+//取前threshold个部分签名，λ_j=∏x_m/(x_m-x_j)，群签名=∑λ_j*σ_j
+func (k *blsGroupKey) combinePartials(addrs []string, signs [][]byte) ([]byte, error) {
+	var xs []*big.Int
+	var sigs []*g1pubs.Signature
+	for i, addr := range addrs {
+		if i >= len(signs) || len(signs[i]) == 0 {
+			continue
+		}
+		idx := dkgNodeIndex(k.nodes, addr)
+		if idx <= 0 {
+			continue
+		}
+		sig, err := dkgSignature(signs[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "addr=%s", addr)
+		}
+		xs = append(xs, big.NewInt(idx))
+		sigs = append(sigs, sig)
+		if len(sigs) == k.threshold {
+			break
+		}
+	}
+	if len(sigs) < k.threshold {
+		return nil, errors.Wrapf(pt.ErrBlsSignVerify, "partial signs=%d less than threshold=%d", len(sigs), k.threshold)
+	}
+
+	var sum *bls.G2Projective
+	for j, xj := range xs {
+		lambda := big.NewInt(1)
+		for m, xm := range xs {
+			if m == j {
+				continue
+			}
+			den := new(big.Int).Sub(xm, xj)
+			den.Mod(den, dkgFrModulus)
+			lambda.Mul(lambda, xm)
+			lambda.Mul(lambda, den.ModInverse(den, dkgFrModulus))
+			lambda.Mod(lambda, dkgFrModulus)
+		}
+		p := sigs[j].GetPoint().MulFR(dkgFrRepr(lambda))
+		if sum == nil {
+			sum = p
+			continue
+		}
+		sum = sum.Add(p)
+	}
+	sig := g1pubs.NewSignatureFromG2(sum.ToAffine()).Serialize()
+	return sig[:], nil
+}
+
+/*
+平行链节点的DKG流程：
+1. 打开blsThresholdSign后，每隔dkgCheckInt检查nodegroup，自己在nodegroup且和当前DKG的nodes不一致时(nodeJoin/nodeQuit)开始新的DKG，通过bls p2p topic广播自己的deal，未完成时定期重发
+2. 收到nodegroup不同的deal先缓存，dealer的deal发生变化说明dealer重新开始了DKG(比如重启)，替换它的deal重新计算群公钥，自己的多项式不变，重发自己的deal给对方
+3. 完成后发送BlsGroupKey交易投票，链上生效的群公钥和本地一致后，commit交易附带部分签名，leader收集到门限个部分签名后合成群签名发送，否则仍然使用聚合签名
+*/
+func (b *blsClient) procDkg() {
+	defer b.paraClient.wg.Done()
+	if len(b.selfID) <= 0 || !b.thresholdSign {
+		return
+	}
+
+	ticker := time.NewTicker(dkgCheckInt * time.Second)
+	defer ticker.Stop()
+out:
+	for {
+		select {
+		case deal := <-b.rcvDkgDealCh:
+			b.rcvDkgDeal(deal)
+		case <-ticker.C:
+			b.checkDkg()
+		case <-b.quit:
+			break out
+		}
+	}
+}
+
+func (b *blsClient) rcvDkgDealMsg(deal *pt.ParaBlsDkgDeal) {
+	if !b.thresholdSign || deal == nil {
+		return
+	}
+	//deal会定期重发，处理不过来直接丢弃
+	select {
+	case b.rcvDkgDealCh <- deal:
+	default:
+		plog.Info("rcvDkgDealMsg channel full", "dealer", deal.Dealer)
+	}
+}
+
+func (b *blsClient) rcvDkgDeal(deal *pt.ParaBlsDkgDeal) {
+	if b.dkg == nil || deal.Nodes != b.dkg.nodeStr {
+		//本节点还未开始对应nodegroup的DKG，先缓存
+		if len(b.dkgPending) < maxRcvTxCount {
+			b.dkgPending[deal.Dealer] = deal
+		}
+		return
+	}
+	_, exist := b.dkg.deals[deal.Dealer]
+	changed := b.dkg.isDealChanged(deal)
+	if err := b.dkg.rcvDeal(deal); err != nil {
+		plog.Error("rcvDkgDeal", "dealer", deal.Dealer, "err", err)
+		return
+	}
+	switch {
+	case changed:
+		//dealer重新开始了DKG(比如重启)，替换它的deal后重新计算群公钥，并重发自己的deal
+		plog.Info("rcvDkgDeal dealer restart dkg", "dealer", deal.Dealer, "nodes", deal.Nodes)
+		b.groupKey = nil
+		b.setActiveGroupKey(nil)
+		b.sendDkgDeal()
+	case exist && b.groupKey != nil:
+		//对方未完成DKG，可能没有收到自己的deal
+		b.sendDkgDeal()
+	}
+	b.finishDkg()
+}
+
+func (b *blsClient) resetDkg() {
+	b.dkg = nil
+	b.groupKey = nil
+	b.setActiveGroupKey(nil)
+}
+
+func (b *blsClient) startDkg(nodes []string) {
+	b.resetDkg()
+	if b.blsPriKey == nil {
+		return
+	}
+	dkg, err := newBlsDkg(b.cryptoCli, b.selfID, b.blsPriKey, nodes, b.getBlsPubKey)
+	if err != nil {
+		plog.Error("startDkg", "nodes", nodes, "err", err)
+		return
+	}
+	b.dkg = dkg
+	plog.Info("startDkg", "nodes", dkg.nodeStr, "threshold", dkg.threshold)
+
+	pending := b.dkgPending
+	b.dkgPending = make(map[string]*pt.ParaBlsDkgDeal)
+	for _, deal := range pending {
+		if deal.Nodes != dkg.nodeStr {
+			continue
+		}
+		if err := dkg.rcvDeal(deal); err != nil {
+			plog.Error("startDkg pending deal", "dealer", deal.Dealer, "err", err)
+		}
+	}
+	b.sendDkgDeal()
+	b.finishDkg()
+}
+
+func (b *blsClient) sendDkgDeal() {
+	act := &pt.ParaP2PSubMsg{Ty: P2pSubDkgDeal, Value: &pt.ParaP2PSubMsg_DkgDeal{DkgDeal: b.dkg.deal}}
+	err := b.paraClient.SendPubP2PMsg(paraBlsSignTopic, types.Encode(act))
+	if err != nil {
+		plog.Error("sendDkgDeal", "err", err)
+	}
+}
+
+func (b *blsClient) finishDkg() {
+	if b.groupKey != nil || !b.dkg.isComplete() {
+		return
+	}
+	key, err := b.dkg.groupKey()
+	if err != nil {
+		plog.Error("finishDkg", "err", err)
+		return
+	}
+	b.groupKey = key
+	plog.Info("finishDkg", "nodes", key.nodeStr, "threshold", key.threshold, "groupKey", common.ToHex(key.groupPubKey))
+	b.updateGroupKey()
+}
+
+func (b *blsClient) checkDkg() {
+	nodes, nodeStr := b.getSuperNodes()
+	if found, _ := hasCommited(nodes, b.selfID); !found {
+		b.resetDkg()
+		return
+	}
+	//nodegroup变化后重新DKG
+	if b.dkg == nil || b.dkg.nodeStr != nodeStr {
+		b.startDkg(nodes)
+		return
+	}
+	if b.groupKey == nil {
+		b.sendDkgDeal()
+		return
+	}
+	b.updateGroupKey()
+}
+
+//投票内容和执行器一致
+func isBlsGroupKeyVoted(stat *pt.ParaBlsGroupKeyStatus, addr string, key *blsGroupKey) bool {
+	if stat.Nodes != key.nodeStr || stat.Votes == nil {
+		return false
+	}
+	found, i := hasCommited(stat.Votes.Addrs, addr)
+	vote := common.ToHex(types.Encode(&pt.ParaBlsGroupKey{Threshold: int32(key.threshold), GroupPubKey: key.groupPubKey}))
+	return found && stat.Votes.Votes[i] == vote
+}
+
+func (b *blsClient) updateGroupKey() {
+	stat, err := b.getChainBlsGroupKey()
+	if err != nil {
+		return
+	}
+	key := b.groupKey
+	if stat.Nodes == key.nodeStr && int(stat.Threshold) == key.threshold && bytes.Equal(stat.GroupPubKey, key.groupPubKey) {
+		if b.getActiveGroupKey() == nil {
+			plog.Info("updateGroupKey active", "nodes", key.nodeStr, "groupKey", common.ToHex(key.groupPubKey))
+		}
+		b.setActiveGroupKey(key)
+		return
+	}
+	b.setActiveGroupKey(nil)
+	if isBlsGroupKeyVoted(stat, b.selfID, key) {
+		return
+	}
+	cfg := b.paraClient.GetAPI().GetConfig()
+	vote := &pt.ParaBlsGroupKey{Title: cfg.GetTitle(), Nodes: key.nodeStr, Threshold: int32(key.threshold), GroupPubKey: key.groupPubKey}
+	err = b.paraClient.commitMsgClient.sendBlsGroupKey(vote)
+	if err != nil {
+		plog.Error("updateGroupKey vote", "err", err)
+	}
+}
+
+func (b *blsClient) getChainBlsGroupKey() (*pt.ParaBlsGroupKeyStatus, error) {
+	cfg := b.paraClient.GetAPI().GetConfig()
+	ret, err := b.paraClient.GetAPI().QueryChain(&types.ChainExecutor{
+		Driver:   "paracross",
+		FuncName: "GetBlsGroupKey",
+		Param:    types.Encode(&pt.ReqParacrossNodeInfo{Title: cfg.GetTitle()}),
+	})
+	if err != nil {
+		plog.Error("blssign.GetBlsGroupKey ", "err", err.Error())
+		return nil, err
+	}
+	resp, ok := ret.(*pt.ParaBlsGroupKeyStatus)
+	if !ok {
+		plog.Error("blssign.GetBlsGroupKey rsp nok")
+		return nil, types.ErrInvalidParam
+	}
+	return resp, nil
+}
+
+func (b *blsClient) setActiveGroupKey(key *blsGroupKey) {
+	b.groupKeyMutex.Lock()
+	defer b.groupKeyMutex.Unlock()
+	b.activeGroupKey = key
+}
+
+func (b *blsClient) getActiveGroupKey() *blsGroupKey {
+	b.groupKeyMutex.Lock()
+	defer b.groupKeyMutex.Unlock()
+	return b.activeGroupKey
+}
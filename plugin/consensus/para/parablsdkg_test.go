@@ -0,0 +1,209 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package para
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	_ "github.com/33cn/plugin/plugin/crypto/bls"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type dkgTestNodes struct {
+	cryptoCli crypto.Crypto
+	addrs     []string
+	priKeys   map[string]crypto.PrivKey
+}
+
+func newDkgTestNodes(t *testing.T, n int) *dkgTestNodes {
+	cryptoCli, err := crypto.New("bls")
+	assert.Nil(t, err)
+	nodes := &dkgTestNodes{cryptoCli: cryptoCli, priKeys: make(map[string]crypto.PrivKey)}
+	for i := 0; i < n; i++ {
+		addr := string(rune('A'+i)) + "node"
+		nodes.addrs = append(nodes.addrs, addr)
+		nodes.priKeys[addr], err = cryptoCli.GenKey()
+		assert.Nil(t, err)
+	}
+	return nodes
+}
+
+func (n *dkgTestNodes) getPubKey(addr string) (crypto.PubKey, error) {
+	priKey, ok := n.priKeys[addr]
+	if !ok {
+		return nil, pt.ErrParaNodeAddrNotExisted
+	}
+	return priKey.PubKey(), nil
+}
+
+//全部节点在进程内交换deal完成DKG
+func (n *dkgTestNodes) runDkg(t *testing.T) []*blsDkg {
+	var dkgs []*blsDkg
+	for _, addr := range n.addrs {
+		d, err := newBlsDkg(n.cryptoCli, addr, n.priKeys[addr], n.addrs, n.getPubKey)
+		assert.Nil(t, err)
+		dkgs = append(dkgs, d)
+	}
+	for _, d := range dkgs {
+		for _, peer := range dkgs {
+			assert.Nil(t, d.rcvDeal(peer.deal))
+		}
+		assert.True(t, d.isComplete())
+	}
+	return dkgs
+}
+
+func TestBlsDkgThresholdSign(t *testing.T) {
+	nodes := newDkgTestNodes(t, 4)
+	dkgs := nodes.runDkg(t)
+
+	var keys []*blsGroupKey
+	for _, d := range dkgs {
+		key, err := d.groupKey()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, key.threshold)
+		keys = append(keys, key)
+	}
+	for _, key := range keys[1:] {
+		assert.Equal(t, keys[0].groupPubKey, key.groupPubKey)
+	}
+
+	msg := types.Encode(&pt.ParacrossNodeStatus{Title: "user.p.test.", Height: 10})
+	var signs [][]byte
+	for i, key := range keys {
+		sign := key.partialSign(msg)
+		assert.Nil(t, keys[0].verifyPartial(nodes.addrs[i], msg, sign))
+		signs = append(signs, sign)
+	}
+	//部分签名和签名节点不一致
+	assert.Equal(t, pt.ErrBlsSignVerify, errors.Cause(keys[0].verifyPartial(nodes.addrs[1], msg, signs[0])))
+
+	//任意门限个部分签名合成的群签名一致，并可以用群公钥验签
+	sign1, err := keys[0].combinePartials(nodes.addrs[:3], signs[:3])
+	assert.Nil(t, err)
+	sign2, err := keys[3].combinePartials(nodes.addrs[1:], signs[1:])
+	assert.Nil(t, err)
+	assert.Equal(t, sign1, sign2)
+	pub, err := nodes.cryptoCli.PubKeyFromBytes(keys[0].groupPubKey)
+	assert.Nil(t, err)
+	sig, err := nodes.cryptoCli.SignatureFromBytes(sign1)
+	assert.Nil(t, err)
+	assert.True(t, pub.VerifyBytes(msg, sig))
+
+	//缺少的部分签名不计数
+	_, err = keys[0].combinePartials(nodes.addrs, [][]byte{signs[0], nil, signs[2], nil})
+	assert.Equal(t, pt.ErrBlsSignVerify, errors.Cause(err))
+}
+
+func TestBlsDkgBadDeal(t *testing.T) {
+	nodes := newDkgTestNodes(t, 4)
+	var dkgs []*blsDkg
+	for _, addr := range nodes.addrs[:2] {
+		d, err := newBlsDkg(nodes.cryptoCli, addr, nodes.priKeys[addr], nodes.addrs, nodes.getPubKey)
+		assert.Nil(t, err)
+		dkgs = append(dkgs, d)
+	}
+	d := dkgs[0]
+
+	//分片被篡改后重新签名
+	deal := proto.Clone(dkgs[1].deal).(*pt.ParaBlsDkgDeal)
+	deal.Shares[0].EncShare[0] ^= 1
+	deal.Sign = nil
+	deal.Sign = nodes.priKeys[deal.Dealer].Sign(types.Encode(deal)).Bytes()
+	assert.Equal(t, pt.ErrParaBlsDkgDeal, errors.Cause(d.rcvDeal(deal)))
+
+	//签名不正确
+	deal = proto.Clone(dkgs[1].deal).(*pt.ParaBlsDkgDeal)
+	deal.Commits[1] = deal.Commits[0]
+	assert.Equal(t, pt.ErrBlsSignVerify, errors.Cause(d.rcvDeal(deal)))
+
+	//nodegroup不一致
+	deal = proto.Clone(dkgs[1].deal).(*pt.ParaBlsDkgDeal)
+	deal.Nodes = "Anode,Bnode"
+	assert.Equal(t, pt.ErrParaBlsDkgDeal, errors.Cause(d.rcvDeal(deal)))
+
+	assert.Nil(t, d.rcvDeal(dkgs[1].deal))
+	assert.Equal(t, 2, len(d.shares))
+	assert.False(t, d.isComplete())
+	_, err := d.groupKey()
+	assert.Equal(t, pt.ErrParaBlsDkgDeal, errors.Cause(err))
+
+	//不在nodegroup中不能参与
+	_, err = newBlsDkg(nodes.cryptoCli, "other", nodes.priKeys[nodes.addrs[0]], nodes.addrs, nodes.getPubKey)
+	assert.Equal(t, pt.ErrParaNodeAddrNotExisted, errors.Cause(err))
+}
+
+func TestBlsDkgDealerRestart(t *testing.T) {
+	nodes := newDkgTestNodes(t, 4)
+	dkgs := nodes.runDkg(t)
+	old, err := dkgs[0].groupKey()
+	assert.Nil(t, err)
+
+	//节点D重启后重新开始DKG，其他节点替换D的deal，D收到其他节点的deal后群公钥重新一致
+	restart, err := newBlsDkg(nodes.cryptoCli, nodes.addrs[3], nodes.priKeys[nodes.addrs[3]], nodes.addrs, nodes.getPubKey)
+	assert.Nil(t, err)
+	dkgs[3] = restart
+	for _, d := range dkgs[:3] {
+		assert.True(t, d.isDealChanged(restart.deal))
+		assert.Nil(t, d.rcvDeal(restart.deal))
+		assert.Nil(t, restart.rcvDeal(d.deal))
+	}
+	var keys []*blsGroupKey
+	for _, d := range dkgs {
+		key, err := d.groupKey()
+		assert.Nil(t, err)
+		keys = append(keys, key)
+	}
+	assert.NotEqual(t, old.groupPubKey, keys[0].groupPubKey)
+	assert.Equal(t, keys[0].groupPubKey, keys[3].groupPubKey)
+}
+
+func TestAggregateThresholdCommit(t *testing.T) {
+	nodes := newDkgTestNodes(t, 4)
+	dkgs := nodes.runDkg(t)
+	var keys []*blsGroupKey
+	for _, d := range dkgs {
+		key, err := d.groupKey()
+		assert.Nil(t, err)
+		keys = append(keys, key)
+	}
+
+	status := &pt.ParacrossNodeStatus{Title: "user.p.test.", Height: 1}
+	msg := types.Encode(status)
+	var commits []*pt.ParacrossCommitAction
+	for i, addr := range nodes.addrs[:3] {
+		commits = append(commits, &pt.ParacrossCommitAction{
+			Status: status,
+			Bls: &pt.ParacrossCommitBlsInfo{Addrs: []string{addr}, Sign: nodes.priKeys[addr].Sign(msg).Bytes(),
+				PartialSign: keys[i].partialSign(msg)},
+		})
+	}
+	pool := make(map[int64]*pt.ParaBlsSignSumDetails)
+	integrateCommits(pool, commits)
+	dones := filterDoneCommits(len(nodes.addrs), pool)
+	assert.Equal(t, 1, len(dones))
+
+	b := &blsClient{cryptoCli: nodes.cryptoCli}
+	//群公钥未生效时使用聚合签名
+	acts, err := b.aggregateCommit2Action(nodes.addrs, dones)
+	assert.Nil(t, err)
+	assert.Nil(t, acts[0].Bls.ThresholdSign)
+	assert.NotNil(t, acts[0].Bls.AddrsMap)
+
+	b.setActiveGroupKey(keys[0])
+	acts, err = b.aggregateCommit2Action(nodes.addrs, dones)
+	assert.Nil(t, err)
+	assert.Nil(t, acts[0].Bls.Sign)
+	pub, err := nodes.cryptoCli.PubKeyFromBytes(keys[0].groupPubKey)
+	assert.Nil(t, err)
+	sig, err := nodes.cryptoCli.SignatureFromBytes(acts[0].Bls.ThresholdSign)
+	assert.Nil(t, err)
+	assert.True(t, pub.VerifyBytes(types.Encode(acts[0].Status), sig))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
 
 	"github.com/pkg/errors"
@@ -38,6 +39,8 @@ type blsClient struct {
 	blsPriKey       crypto.PrivKey
 	blsPubKey       crypto.PubKey
 	peersBlsPubKey  map[string]crypto.PubKey
+	peersMutex      sync.Mutex
+	blsPopDone      bool
 	commitsPool     map[int64]*pt.ParaBlsSignSumDetails
 	rcvCommitTxCh   chan []*pt.ParacrossCommitAction
 	leaderOffset    int32
@@ -45,6 +48,15 @@ type blsClient struct {
 	feedDog         uint32
 	quit            chan struct{}
 	mutex           sync.Mutex
+
+	//nodegroup DKG门限签名，dkg相关只在procDkg中处理
+	thresholdSign  bool
+	dkg            *blsDkg
+	dkgPending     map[string]*pt.ParaBlsDkgDeal
+	rcvDkgDealCh   chan *pt.ParaBlsDkgDeal
+	groupKey       *blsGroupKey
+	activeGroupKey *blsGroupKey
+	groupKeyMutex  sync.Mutex
 }
 
 func newBlsClient(para *client, cfg *subConfig) *blsClient {
@@ -63,6 +75,9 @@ func newBlsClient(para *client, cfg *subConfig) *blsClient {
 	if cfg.BlsLeaderSwitchIntval > 0 {
 		b.leaderSwitchInt = cfg.BlsLeaderSwitchIntval
 	}
+	b.thresholdSign = cfg.BlsSign && cfg.BlsThresholdSign
+	b.dkgPending = make(map[string]*pt.ParaBlsDkgDeal)
+	b.rcvDkgDealCh = make(chan *pt.ParaBlsDkgDeal, maxRcvTxCount)
 
 	return b
 }
@@ -106,6 +121,7 @@ out:
 				plog.Info("procLeaderSync watchdog, not in nodegroup", "self", b.selfID)
 				continue
 			}
+			b.checkBlsPop()
 			//至少1分钟内要收到leader喂狗消息，否则认为leader挂了，index++
 			if atomic.LoadUint32(&b.feedDog) == 0 {
 				nodes, leader, _, off, _ := b.getLeaderInfo()
@@ -293,6 +309,14 @@ func (b *blsClient) checkCommitTx(txs []*types.Transaction) ([]*pt.ParacrossComm
 		if err != nil {
 			return nil, errors.Wrapf(pt.ErrBlsSignVerify, "from=%s", tx.From())
 		}
+		//门限部分签名校验不通过只丢弃部分签名，仍可以聚合签名
+		if len(commit.Bls.PartialSign) > 0 {
+			key := b.getActiveGroupKey()
+			if key == nil || key.verifyPartial(tx.From(), types.Encode(commit.Status), commit.Bls.PartialSign) != nil {
+				plog.Info("checkCommitTx drop partial sign", "from", tx.From(), "height", commit.Status.Height)
+				commit.Bls.PartialSign = nil
+			}
+		}
 		commits = append(commits, commit)
 	}
 
@@ -319,12 +343,14 @@ func integrateCommits(pool map[int64]*pt.ParaBlsSignSumDetails, commits []*pt.Pa
 		if found {
 			a.Msgs[i] = types.Encode(cmt.Status)
 			a.Signs[i] = cmt.Bls.Sign
+			a.PartialSigns[i] = cmt.Bls.PartialSign
 			continue
 		}
 
 		a.Addrs = append(a.Addrs, cmt.Bls.Addrs[0])
 		a.Msgs = append(a.Msgs, types.Encode(cmt.Status))
 		a.Signs = append(a.Signs, cmt.Bls.Sign)
+		a.PartialSigns = append(a.PartialSigns, cmt.Bls.PartialSign)
 	}
 }
 
@@ -361,6 +387,7 @@ func filterDoneCommits(peers int, pool map[int64]*pt.ParaBlsSignSumDetails) []*p
 			if bytes.Equal([]byte(hash), m) {
 				a.Addrs = append(a.Addrs, v.Addrs[j])
 				a.Signs = append(a.Signs, v.Signs[j])
+				a.PartialSigns = append(a.PartialSigns, v.PartialSigns[j])
 			}
 		}
 		pool[i] = a
@@ -386,15 +413,26 @@ func filterDoneCommits(peers int, pool map[int64]*pt.ParaBlsSignSumDetails) []*p
 
 }
 
-//聚合多个签名为一个签名，并设置地址bitmap
+//聚合多个签名为一个签名，并设置地址bitmap，群公钥生效且部分签名达到门限时合成门限签名
 func (b *blsClient) aggregateCommit2Action(nodes []string, commits []*pt.ParaBlsSignSumDetails) ([]*pt.ParacrossCommitAction, error) {
 	var notify []*pt.ParacrossCommitAction
+	key := b.getActiveGroupKey()
 	for _, v := range commits {
 		a := &pt.ParacrossCommitAction{Bls: &pt.ParacrossCommitBlsInfo{}}
 		s := &pt.ParacrossNodeStatus{}
 		types.Decode(v.Msgs[0], s)
 		a.Status = s
 
+		if key != nil && key.nodeStr == strings.Join(nodes, ",") {
+			sign, err := key.combinePartials(v.Addrs, v.PartialSigns)
+			if err == nil {
+				a.Bls.ThresholdSign = sign
+				notify = append(notify, a)
+				continue
+			}
+			plog.Info("AggregateCommit2Action threshold sign", "height", v.Height, "err", err)
+		}
+
 		sign, err := b.aggregateSigns(v.Signs)
 		if err != nil {
 			return nil, errors.Wrapf(err, "bls aggregate=%s", v.Addrs)
@@ -462,6 +500,16 @@ func (b *blsClient) secp256Prikey2BlsPub(key string) (string, error) {
 	return common.ToHex(serial[:]), nil
 }
 
+//transfer secp256 Private key to bls pub key's proof of possession
+func (b *blsClient) secp256Prikey2BlsPop(key string) (string, error) {
+	secpPrkKey, err := getSecpPriKey(key)
+	if err != nil {
+		plog.Error("getSecpPriKey", "err", err)
+		return "", err
+	}
+	return b.genBlsPop(b.getBlsPriKey(secpPrkKey.Bytes()))
+}
+
 func (b *blsClient) blsSign(commits []*pt.ParacrossCommitAction) error {
 	for _, cmt := range commits {
 		data := types.Encode(cmt.Status)
@@ -473,18 +521,62 @@ func (b *blsClient) blsSign(commits []*pt.ParacrossCommitAction) error {
 			return errors.Wrapf(types.ErrInvalidParam, "addr=%s,height=%d", b.selfID, cmt.Status.Height)
 		}
 		cmt.Bls.Sign = sign
+		if key := b.getActiveGroupKey(); key != nil {
+			cmt.Bls.PartialSign = key.partialSign(data)
+		}
 		plog.Info("bls sign msg", "data", common.ToHex(data), "height", cmt.Status.Height, "sign", len(cmt.Bls.Sign), "src", len(sign))
 	}
 	return nil
 }
 
 func (b *blsClient) getBlsPubKey(addr string) (crypto.PubKey, error) {
+	pubKey, _, err := b.getBlsPubKeyWithPop(addr)
+	return pubKey, err
+}
+
+//只缓存有pop的公钥，没有pop的公钥每次从statedb获取，补充pop后可以及时更新
+func (b *blsClient) getBlsPubKeyWithPop(addr string) (crypto.PubKey, bool, error) {
 	//先从缓存中获取
-	if v, ok := b.peersBlsPubKey[addr]; ok {
-		return v, nil
+	b.peersMutex.Lock()
+	v, ok := b.peersBlsPubKey[addr]
+	b.peersMutex.Unlock()
+	if ok {
+		return v, true, nil
 	}
 
 	//缓存没有，则从statedb获取
+	resp, err := b.getNodeAddrInfo(addr)
+	if err != nil {
+		return nil, false, err
+	}
+
+	s, err := common.FromHex(resp.BlsPubKey)
+	if err != nil {
+		plog.Error("commitmsg.getNode pubkey nok", "pubkey", resp.BlsPubKey)
+		return nil, false, err
+	}
+	pubKey, err := b.cryptoCli.PubKeyFromBytes(s)
+	if err != nil {
+		plog.Error("verifyBlsSign.DeserializePublicKey", "key", addr)
+		return nil, false, err
+	}
+	if len(resp.BlsPop) == 0 {
+		return pubKey, false, nil
+	}
+	err = verifyBlsPop(pubKey, resp.BlsPop)
+	if err != nil {
+		plog.Error("getBlsPubKey verify pop", "addr", addr, "pub", resp.BlsPubKey, "err", err)
+		return nil, false, err
+	}
+	plog.Info("getBlsPubKey", "addr", addr, "pub", resp.BlsPubKey, "serial", pubKey.Bytes())
+	b.peersMutex.Lock()
+	b.peersBlsPubKey[addr] = pubKey
+	b.peersMutex.Unlock()
+
+	return pubKey, true, nil
+}
+
+func (b *blsClient) getNodeAddrInfo(addr string) (*pt.ParaNodeAddrIdStatus, error) {
 	cfg := b.paraClient.GetAPI().GetConfig()
 	ret, err := b.paraClient.GetAPI().QueryChain(&types.ChainExecutor{
 		Driver:   "paracross",
@@ -498,31 +590,83 @@ func (b *blsClient) getBlsPubKey(addr string) (crypto.PubKey, error) {
 	resp, ok := ret.(*pt.ParaNodeAddrIdStatus)
 	if !ok {
 		plog.Error("commitmsg.getNodeGroupAddrs rsp nok")
-		return nil, err
+		return nil, types.ErrInvalidParam
 	}
+	return resp, nil
+}
 
-	s, err := common.FromHex(resp.BlsPubKey)
+func verifyBlsPop(pubKey crypto.PubKey, pop string) error {
+	p, err := common.FromHex(pop)
+	if err != nil || len(p) != bls.BLSSignatureLength {
+		return errors.Wrapf(pt.ErrParaBlsPop, "pop=%s", pop)
+	}
+	drv := bls.Driver{}
+	sig, err := drv.SignatureFromBytes(p)
 	if err != nil {
-		plog.Error("commitmsg.getNode pubkey nok", "pubkey", resp.BlsPubKey)
-		return nil, err
+		return errors.Wrapf(pt.ErrParaBlsPop, "DeserializeSignature=%s", pop)
 	}
-	pubKey, err := b.cryptoCli.PubKeyFromBytes(s)
+	err = drv.VerifyPop(pubKey, sig)
 	if err != nil {
-		plog.Error("verifyBlsSign.DeserializePublicKey", "key", addr)
-		return nil, err
+		return errors.Wrapf(pt.ErrParaBlsPop, "err=%s", err.Error())
 	}
-	plog.Info("getBlsPubKey", "addr", addr, "pub", resp.BlsPubKey, "serial", pubKey.Bytes())
-	b.peersBlsPubKey[addr] = pubKey
+	return nil
+}
 
-	return pubKey, nil
+func (b *blsClient) genBlsPop(priKey crypto.PrivKey) (string, error) {
+	pop, err := bls.Driver{}.GenPop(priKey)
+	if err != nil {
+		return "", err
+	}
+	return common.ToHex(pop.Bytes()), nil
+}
+
+//ForkParaBlsPop之前注册的公钥没有pop，fork之后自己的公钥没有pop时发送modify交易补充
+func (b *blsClient) checkBlsPop() {
+	if b.blsPopDone || b.blsPriKey == nil {
+		return
+	}
+	stat, err := b.getNodeAddrInfo(b.selfID)
+	if err != nil {
+		return
+	}
+	if len(stat.BlsPop) > 0 {
+		b.blsPopDone = true
+		return
+	}
+	pub := common.ToHex(b.blsPubKey.Bytes())
+	if stat.BlsPubKey != pub {
+		plog.Info("checkBlsPop registered pubkey not match", "self", b.selfID, "registered", stat.BlsPubKey, "local", pub)
+		return
+	}
+	block, err := b.paraClient.getLastLocalBlock()
+	if err != nil {
+		return
+	}
+	cfg := b.paraClient.GetAPI().GetConfig()
+	if !cfg.IsDappFork(block.MainHeight, pt.ParaX, pt.ForkParaBlsPop) {
+		return
+	}
+	pop, err := b.genBlsPop(b.blsPriKey)
+	if err != nil {
+		plog.Error("checkBlsPop genBlsPop", "err", err)
+		return
+	}
+	err = b.paraClient.commitMsgClient.sendBlsPop(pub, pop)
+	if err != nil {
+		plog.Error("checkBlsPop sendBlsPop", "err", err)
+	}
 }
 
 func (b *blsClient) verifyBlsSign(addr string, commit *pt.ParacrossCommitAction) error {
 	//1. 获取对应公钥
-	pubKey, err := b.getBlsPubKey(addr)
+	pubKey, hasPop, err := b.getBlsPubKeyWithPop(addr)
 	if err != nil {
 		return errors.Wrapf(err, "pub key not exist to addr=%s", addr)
 	}
+	//和执行器一致，fork之后没有pop的公钥不参与聚合
+	if !hasPop && b.paraClient.GetAPI().GetConfig().IsDappFork(commit.Status.MainBlockHeight, pt.ParaX, pt.ForkParaBlsPop) {
+		return errors.Wrapf(pt.ErrParaBlsPop, "addr=%s", addr)
+	}
 	//2.　获取bls签名
 	sig, err := b.cryptoCli.SignatureFromBytes(commit.Bls.Sign)
 	if err != nil {
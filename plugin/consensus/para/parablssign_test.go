@@ -13,6 +13,7 @@ import (
 	"github.com/33cn/chain33/common/crypto"
 	_ "github.com/33cn/plugin/plugin/crypto/bls"
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -44,6 +45,29 @@ func TestBlsSignMain(t *testing.T) {
 	testSecpPrikey2BlsPub(t, cryptoCli)
 	testBlsSign(t, cryptoCli)
 	testVerifyBlsSign(t, cryptoCli)
+	testBlsPop(t, cryptoCli)
+}
+
+func testBlsPop(t *testing.T, cryptCli crypto.Crypto) {
+	cli := blsClient{}
+	cli.cryptoCli = cryptCli
+	key := "0xcacb1f5d51700aea07fca2246ab43b0917d70405c65edea9b5063d72eb5c6b71"
+	pub, err := cli.secp256Prikey2BlsPub(key)
+	assert.NoError(t, err)
+	pop, err := cli.secp256Prikey2BlsPop(key)
+	assert.NoError(t, err)
+
+	p, err := common.FromHex(pub)
+	assert.NoError(t, err)
+	pubKey, err := cryptCli.PubKeyFromBytes(p)
+	assert.NoError(t, err)
+	assert.NoError(t, verifyBlsPop(pubKey, pop))
+
+	//其他公钥的pop验证不通过
+	other, err := cli.secp256Prikey2BlsPop("0x6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b")
+	assert.NoError(t, err)
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(verifyBlsPop(pubKey, other)))
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(verifyBlsPop(pubKey, "")))
 }
 
 func testSecpPrikey2BlsPub(t *testing.T, cryptCli crypto.Crypto) {
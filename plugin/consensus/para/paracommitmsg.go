@@ -909,6 +909,38 @@ func (client *commitMsgClient) getMainConsensusStatus() (*pt.ParacrossStatus, er
 }
 
 //node group会在主链和平行链都同时配置,只本地查询就可以
+//bls门限签名群公钥投票交易直接发送，未上链会定期重新投票
+func (client *commitMsgClient) sendBlsGroupKey(key *pt.ParaBlsGroupKey) error {
+	if client.privateKey == nil {
+		return errors.Wrap(types.ErrInvalidParam, "private key nil")
+	}
+	cfg := client.paraClient.GetAPI().GetConfig()
+	tx, err := pt.CreateRawBlsGroupKeyTx4MainChain(cfg, key, paracross.GetExecName(cfg), atomic.LoadInt64(&client.txFeeRate))
+	if err != nil {
+		return err
+	}
+	tx.Sign(types.SECP256K1, client.privateKey)
+	plog.Info("paracommitmsg sendBlsGroupKey", "txhash", common.ToHex(tx.Hash()), "nodes", key.Nodes,
+		"groupKey", common.ToHex(key.GroupPubKey))
+	return client.sendCommitTxOut(tx)
+}
+
+//fork之前注册的bls公钥通过modify交易补充pop，未上链会定期重新发送
+func (client *commitMsgClient) sendBlsPop(pubKey, pop string) error {
+	if client.privateKey == nil {
+		return errors.Wrap(types.ErrInvalidParam, "private key nil")
+	}
+	cfg := client.paraClient.GetAPI().GetConfig()
+	config := &pt.ParaNodeAddrConfig{Title: cfg.GetTitle(), Op: pt.ParaOpModify, Addr: client.authAccount, BlsPubKey: pubKey, BlsPop: pop}
+	tx, err := pt.CreateRawNodeConfigTx4MainChain(cfg, config, paracross.GetExecName(cfg), atomic.LoadInt64(&client.txFeeRate))
+	if err != nil {
+		return err
+	}
+	tx.Sign(types.SECP256K1, client.privateKey)
+	plog.Info("paracommitmsg sendBlsPop", "txhash", common.ToHex(tx.Hash()), "addr", client.authAccount, "pubKey", pubKey)
+	return client.sendCommitTxOut(tx)
+}
+
 func (client *commitMsgClient) getNodeGroupAddrs() (string, error) {
 	cfg := client.paraClient.GetAPI().GetConfig()
 	ret, err := client.paraClient.GetAPI().QueryChain(&types.ChainExecutor{
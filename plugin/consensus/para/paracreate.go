@@ -34,7 +34,7 @@ func (client *client) createLocalGenesisBlock(genesis *types.Block) error {
 	return client.alignLocalBlock2ChainBlock(genesis)
 }
 
-func getNewBlock(lastBlock *pt.ParaLocalDbBlock, txs []*types.Transaction, mainBlock *types.ParaTxDetail) *pt.ParaLocalDbBlock {
+func getNewBlock(cfg *types.Chain33Config, lastBlock *pt.ParaLocalDbBlock, txs []*types.Transaction, mainBlock *types.ParaTxDetail) *pt.ParaLocalDbBlock {
 	var newblock pt.ParaLocalDbBlock
 
 	newblock.Height = lastBlock.Height + 1
@@ -43,12 +43,13 @@ func getNewBlock(lastBlock *pt.ParaLocalDbBlock, txs []*types.Transaction, mainB
 	newblock.ParentMainHash = lastBlock.MainHash
 	newblock.BlockTime = mainBlock.Header.BlockTime
 	newblock.Txs = txs
+	newblock.CrossIns = paraexec.FilterParaCrossIns(cfg, mainBlock)
 
 	return &newblock
 }
 
 func (client *client) createLocalBlock(lastBlock *pt.ParaLocalDbBlock, txs []*types.Transaction, mainBlock *types.ParaTxDetail) error {
-	err := client.addLocalBlock(getNewBlock(lastBlock, txs, mainBlock))
+	err := client.addLocalBlock(getNewBlock(client.GetAPI().GetConfig(), lastBlock, txs, mainBlock))
 	if err != nil {
 		return err
 	}
@@ -367,6 +368,14 @@ func (client *client) requestFilterParaTxs(currSeq int64, count int64, preMainBl
 		plog.Error("requestFilterParaTxs ret nil", "curSeq", currSeq, "count", count, "preMainBlockHash", hex.EncodeToString(preMainBlockHash))
 		return nil, types.ErrNotFound
 	}
+	//校验主链区块头、交易根和共识证明，多个主链节点一致才处理
+	if client.subCfg.MainVerifyOpen {
+		err = client.mainVerifyCli.verifyMainBlocks(details)
+		if err != nil {
+			plog.Error("requestFilterParaTxs mainVerify", "curSeq", currSeq, "count", count, "err", err)
+			return nil, err
+		}
+	}
 
 	return details, nil
 }
@@ -472,7 +481,7 @@ func (client *client) procLocalAddBlock(mainBlock *types.ParaTxDetail, lastBlock
 		}
 		plog.Debug("Create empty block", "newHeight", lastBlock.Height+1)
 	}
-	return getNewBlock(lastBlock, txs, mainBlock)
+	return getNewBlock(cfg, lastBlock, txs, mainBlock)
 
 }
 
@@ -509,11 +518,12 @@ func (client *client) procLocalAddBlocks(mainBlocks *types.ParaTxDetails) error
 func (client *client) CreateBlock() {
 	defer client.wg.Done()
 
-	if !client.subCfg.JumpDownloadClose {
+	//主链区块校验打开时，不使用未校验的快速下载
+	if !client.subCfg.JumpDownloadClose && !client.subCfg.MainVerifyOpen {
 		client.jumpDldCli.tryJumpDownload()
 	}
 
-	if client.subCfg.MultiDownloadOpen {
+	if client.subCfg.MultiDownloadOpen && !client.subCfg.MainVerifyOpen {
 		client.multiDldCli.tryMultiServerDownload()
 	}
 
@@ -544,6 +554,10 @@ out:
 			paraTxs, err := client.RequestTx(currSeq, count, lastSeqMainHash)
 			if err != nil {
 				currSeq, lastSeqMainHash, err = client.processHashNotMatchError(currSeq, lastSeqMainHash, err)
+				//主链区块校验不通过或主链节点不一致，等待后重新请求
+				if err != nil && client.subCfg.MainVerifyOpen {
+					time.Sleep(time.Second * time.Duration(client.subCfg.WriteBlockSeconds))
+				}
 				continue
 			}
 
@@ -0,0 +1,589 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package para
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/difficulty"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/rpc/grpcclient"
+	"github.com/33cn/chain33/types"
+	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	tickettypes "github.com/33cn/plugin/plugin/dapp/ticket/types"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
+	"github.com/pkg/errors"
+)
+
+const (
+	mainConsensusTicket     = "ticket"
+	mainConsensusTendermint = "tendermint"
+
+	defaultMainSignName      = "ed25519"
+	maxVerifiedMainHeaderNum = 256 //内存中保留的已校验主链区块头数量
+
+	//主链ticket难度调整的区块间隔和最大调整倍数，和主链mver.consensus.ticket的targetTimespan/targetTimePerBlock,retargetAdjustmentFactor一致
+	defaultMainRetargetBlocks = 144
+	defaultMainRetargetFactor = 4
+)
+
+//主链共识证明校验，ticket校验挖矿难度，tendermint校验验证者的commit签名
+type mainConsensusVerifier interface {
+	verify(getter mainTxGetter, header *types.Header) error
+}
+
+//从主链获取共识相关交易
+type mainTxGetter interface {
+	getMainMinerTx(header *types.Header) (*types.TransactionDetail, error)
+	getMainHeader(height int64) (*types.Header, error)
+	getMainParent(header *types.Header) (*types.Header, error)
+}
+
+type mainVerifyClient struct {
+	paraClient *client
+	conns      []*connectCli
+	minAgree   int
+	verifier   mainConsensusVerifier
+	//已校验的主链区块头，按高度递增，回滚时从尾部删除
+	headers []*types.Header
+}
+
+func newMainVerifyCli(para *client, cfg *subConfig) *mainVerifyClient {
+	m := &mainVerifyClient{paraClient: para, minAgree: int(cfg.MainVerifyMinAgree)}
+	if !cfg.MainVerifyOpen {
+		return m
+	}
+	switch cfg.MainConsensus {
+	case mainConsensusTicket:
+		m.verifier = newTicketVerifier(cfg.MainRetargetBlocks, cfg.MainRetargetFactor)
+	case mainConsensusTendermint:
+		verifier, err := newTendermintVerifier(cfg.MainSignName, cfg.MainValidators)
+		if err != nil {
+			panic(fmt.Sprintf("para mainValidators config not correct,err=%s", err.Error()))
+		}
+		m.verifier = verifier
+	case "":
+	default:
+		panic(fmt.Sprintf("para mainConsensus=%s not support", cfg.MainConsensus))
+	}
+	return m
+}
+
+func (m *mainVerifyClient) getConns() []*connectCli {
+	if len(m.conns) > 0 {
+		return m.conns
+	}
+	cfg := m.paraClient.GetAPI().GetConfig()
+	ips := strings.Split(m.paraClient.subCfg.ParaRemoteGrpcClient, ",")
+	for _, ip := range ips {
+		conn, err := grpcclient.NewMainChainClient(cfg, ip)
+		if err != nil {
+			plog.Error("mainVerify connect", "ip", ip, "err", err)
+			continue
+		}
+		m.conns = append(m.conns, &connectCli{conn: conn, ip: ip})
+	}
+	//缺省要求配置的所有主链节点一致
+	if m.minAgree <= 0 {
+		m.minAgree = len(ips)
+	}
+	return m.conns
+}
+
+//校验请求到的主链区块，任何一项校验失败或多个主链节点不一致都不推进平行链区块
+func (m *mainVerifyClient) verifyMainBlocks(details *types.ParaTxDetails) error {
+	cfg := m.paraClient.GetAPI().GetConfig()
+	for _, detail := range details.Items {
+		err := verifyMainHeader(detail.Header, m.paraClient.subCfg.MainBlockHashForkHeight)
+		if err != nil {
+			return err
+		}
+		err = verifyMainChildProof(cfg, detail)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := m.checkMainNodesAgree(cfg.GetTitle(), details)
+	if err != nil {
+		return err
+	}
+
+	for _, detail := range details.Items {
+		if detail.Type == types.AddBlock && m.verifier != nil {
+			err = m.verifier.verify(m, detail.Header)
+			if err != nil {
+				plog.Error("mainVerify consensus", "height", detail.Header.Height, "hash", common.ToHex(detail.Header.Hash), "err", err)
+				return err
+			}
+		}
+		err = m.updateHeaderChain(detail)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//AddBlock需要和已校验的区块头相连，DelBlock需要是已校验区块头的最后一个，本地缓存为空时以本次区块为起点
+func (m *mainVerifyClient) updateHeaderChain(detail *types.ParaTxDetail) error {
+	header := detail.Header
+	if len(m.headers) == 0 {
+		if detail.Type == types.AddBlock {
+			m.headers = append(m.headers, header)
+		}
+		return nil
+	}
+	last := m.headers[len(m.headers)-1]
+	if detail.Type == types.DelBlock {
+		if last.Height != header.Height || !bytes.Equal(last.Hash, header.Hash) {
+			plog.Error("mainVerify del block not match", "height", header.Height, "hash", common.ToHex(header.Hash),
+				"lastHeight", last.Height, "lastHash", common.ToHex(last.Hash))
+			return errors.Wrapf(pt.ErrParaMainBlockVerify, "del block height=%d not last verified", header.Height)
+		}
+		m.headers = m.headers[:len(m.headers)-1]
+		return nil
+	}
+	if last.Height+1 != header.Height || !bytes.Equal(last.Hash, header.ParentHash) {
+		//本地重新搜索匹配后不连续，以本地记录的主链hash校验为准重新开始
+		plog.Info("mainVerify header chain restart", "height", header.Height, "lastHeight", last.Height)
+		m.headers = m.headers[:0]
+	}
+	m.headers = append(m.headers, header)
+	if len(m.headers) > maxVerifiedMainHeaderNum {
+		m.headers = m.headers[len(m.headers)-maxVerifiedMainHeaderNum:]
+	}
+	return nil
+}
+
+//其他主链节点按高度获取相同区块，区块hash和本平行链交易根hash都需要一致
+func (m *mainVerifyClient) checkMainNodesAgree(title string, details *types.ParaTxDetails) error {
+	var heights []int64
+	for _, detail := range details.Items {
+		if detail.Type == types.AddBlock {
+			heights = append(heights, detail.Header.Height)
+		}
+	}
+	if len(heights) == 0 {
+		return nil
+	}
+	conns := m.getConns()
+	var replies []*types.ParaTxDetails
+	for _, conn := range conns {
+		reply, err := conn.conn.GetParaTxByHeight(context.Background(), &types.ReqParaTxByHeight{Items: heights, Title: title})
+		if err != nil {
+			plog.Debug("mainVerify GetParaTxByHeight", "ip", conn.ip, "start", heights[0], "err", err)
+			continue
+		}
+		replies = append(replies, reply)
+	}
+	return checkMainBlocksAgree(details, replies, m.minAgree)
+}
+
+func checkMainBlocksAgree(details *types.ParaTxDetails, replies []*types.ParaTxDetails, minAgree int) error {
+	for _, detail := range details.Items {
+		if detail.Type != types.AddBlock {
+			continue
+		}
+		agree := 0
+		for _, reply := range replies {
+			for _, item := range reply.Items {
+				if item == nil || item.Header == nil || item.Header.Height != detail.Header.Height {
+					continue
+				}
+				if !bytes.Equal(item.Header.Hash, detail.Header.Hash) || !bytes.Equal(item.ChildHash, detail.ChildHash) ||
+					len(item.TxDetails) != len(detail.TxDetails) {
+					plog.Error("mainVerify nodes disagree", "height", detail.Header.Height, "hash", common.ToHex(detail.Header.Hash),
+						"otherHash", common.ToHex(item.Header.Hash), "childHash", common.ToHex(detail.ChildHash),
+						"otherChildHash", common.ToHex(item.ChildHash))
+					return errors.Wrapf(pt.ErrParaMainNodesDisagree, "height=%d", detail.Header.Height)
+				}
+				agree++
+				break
+			}
+		}
+		if agree < minAgree {
+			plog.Debug("mainVerify nodes not enough", "height", detail.Header.Height, "agree", agree, "minAgree", minAgree)
+			return errors.Wrapf(pt.ErrParaMainNodesNotEnough, "height=%d,agree=%d,min=%d", detail.Header.Height, agree, minAgree)
+		}
+	}
+	return nil
+}
+
+//和chain33 Block.HashByForkHeight的计算方式一致，TxCount取header中的值
+func calcMainHeaderHash(header *types.Header, forkHeight int64) []byte {
+	head := &types.Header{
+		Version:    header.Version,
+		ParentHash: header.ParentHash,
+		TxHash:     header.TxHash,
+		BlockTime:  header.BlockTime,
+		Height:     header.Height,
+	}
+	if header.Height >= forkHeight {
+		head.Difficulty = header.Difficulty
+		head.StateHash = header.StateHash
+		head.TxCount = header.TxCount
+	}
+	return common.Sha256(types.Encode(head))
+}
+
+func verifyMainHeader(header *types.Header, forkHeight int64) error {
+	if header == nil {
+		return errors.Wrap(pt.ErrParaMainBlockVerify, "nil header")
+	}
+	hash := calcMainHeaderHash(header, forkHeight)
+	if !bytes.Equal(hash, header.Hash) {
+		plog.Error("mainVerify header hash", "height", header.Height, "hash", common.ToHex(header.Hash), "calc", common.ToHex(hash))
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "header hash height=%d", header.Height)
+	}
+	return nil
+}
+
+//ForkRootHash之后本平行链交易的单层merkle根为ChildHash，ChildHash通过Proofs可以计算出区块TxHash
+func verifyMainChildProof(cfg *types.Chain33Config, detail *types.ParaTxDetail) error {
+	if !cfg.IsFork(detail.Header.Height, "ForkRootHash") {
+		return nil
+	}
+	if len(detail.TxDetails) == 0 {
+		if len(detail.ChildHash) != 0 {
+			return errors.Wrapf(pt.ErrParaMainBlockVerify, "height=%d child hash without txs", detail.Header.Height)
+		}
+		return nil
+	}
+	var hashes [][]byte
+	for _, tx := range detail.TxDetails {
+		hashes = append(hashes, tx.Tx.FullHash())
+	}
+	childHash := merkle.GetMerkleRoot(hashes)
+	if !bytes.Equal(childHash, detail.ChildHash) {
+		plog.Error("mainVerify child hash", "height", detail.Header.Height, "childHash", common.ToHex(detail.ChildHash),
+			"calc", common.ToHex(childHash))
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "child hash height=%d", detail.Header.Height)
+	}
+	root := merkle.GetMerkleRootFromBranch(detail.Proofs, childHash, detail.Index)
+	if !bytes.Equal(root, detail.Header.TxHash) {
+		plog.Error("mainVerify tx root", "height", detail.Header.Height, "txHash", common.ToHex(detail.Header.TxHash),
+			"calc", common.ToHex(root))
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "tx root height=%d", detail.Header.Height)
+	}
+	return nil
+}
+
+//主链区块的第一笔交易属于主链子链的第一笔，每一层的index都为0
+func verifyMainFirstTxProof(cfg *types.Chain33Config, header *types.Header, detail *types.TransactionDetail) error {
+	if detail.Tx == nil || detail.Height != header.Height || detail.Index != 0 {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "first tx height=%d,index=%d", detail.Height, detail.Index)
+	}
+	var root []byte
+	if !cfg.IsFork(header.Height, "ForkRootHash") {
+		root = merkle.GetMerkleRootFromBranch(detail.Proofs, detail.Tx.Hash(), 0)
+	} else {
+		root = detail.Tx.FullHash()
+		for _, proof := range detail.TxProofs {
+			if proof.Index != 0 {
+				return errors.Wrapf(pt.ErrParaMainBlockVerify, "first tx proof index=%d", proof.Index)
+			}
+			root = merkle.GetMerkleRootFromBranch(proof.Proofs, root, 0)
+		}
+	}
+	if !bytes.Equal(root, header.TxHash) {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "first tx root height=%d", header.Height)
+	}
+	return nil
+}
+
+func (m *mainVerifyClient) getMainMinerTx(header *types.Header) (*types.TransactionDetail, error) {
+	overview, err := m.paraClient.GetBlockOverviewOnMain(header.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(overview.TxHashes) == 0 {
+		return nil, errors.Wrapf(types.ErrEmptyTx, "main height=%d", header.Height)
+	}
+	detail, err := m.paraClient.QueryTxOnMainByHash(overview.TxHashes[0])
+	if err != nil {
+		return nil, err
+	}
+	err = verifyMainFirstTxProof(m.paraClient.GetAPI().GetConfig(), header, detail)
+	if err != nil {
+		return nil, err
+	}
+	return detail, nil
+}
+
+func (m *mainVerifyClient) getMainHeader(height int64) (*types.Header, error) {
+	headers, err := m.paraClient.GetBlockHeaders(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, err
+	}
+	header := headers.Items[0]
+	err = verifyMainHeader(header, m.paraClient.subCfg.MainBlockHashForkHeight)
+	if err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+//父区块优先从已校验的区块头链获取，本地缓存为空或不相连时从主链获取，以本次区块为起点
+func (m *mainVerifyClient) getMainParent(header *types.Header) (*types.Header, error) {
+	if len(m.headers) > 0 {
+		last := m.headers[len(m.headers)-1]
+		if last.Height+1 == header.Height && bytes.Equal(last.Hash, header.ParentHash) {
+			return last, nil
+		}
+	}
+	parent, err := m.getMainHeader(header.Height - 1)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(parent.Hash, header.ParentHash) {
+		return nil, errors.Wrapf(pt.ErrParaMainBlockVerify, "height=%d parent not match", header.Height)
+	}
+	return parent, nil
+}
+
+type ticketVerifier struct {
+	retargetBlocks int64
+	retargetFactor int64
+}
+
+func newTicketVerifier(retargetBlocks, retargetFactor int64) *ticketVerifier {
+	if retargetBlocks <= 0 {
+		retargetBlocks = defaultMainRetargetBlocks
+	}
+	if retargetFactor <= 0 {
+		retargetFactor = defaultMainRetargetFactor
+	}
+	return &ticketVerifier{retargetBlocks: retargetBlocks, retargetFactor: retargetFactor}
+}
+
+//区块头难度由已校验的父区块推导，ticket挖矿交易的hash需要满足该难度，ticket本身的有效性需要主链状态，平行链无法校验
+func (t *ticketVerifier) verify(getter mainTxGetter, header *types.Header) error {
+	if header.Height == 0 {
+		return nil
+	}
+	parent, err := getter.getMainParent(header)
+	if err != nil {
+		return err
+	}
+	err = t.checkBits(parent, header)
+	if err != nil {
+		return err
+	}
+	detail, err := getter.getMainMinerTx(header)
+	if err != nil {
+		return err
+	}
+	return verifyTicketMiner(header, detail.Tx)
+}
+
+//非调整高度难度和父区块一致，调整高度的targetTimespan随主链分叉变化，只校验不超过retargetFactor倍的调整范围
+func (t *ticketVerifier) checkBits(parent, header *types.Header) error {
+	if header.Height <= t.retargetBlocks || header.Height%t.retargetBlocks != 0 {
+		if header.Difficulty != parent.Difficulty {
+			return errors.Wrapf(types.ErrBlockHeaderDifficulty, "height=%d,bits=%x,parent bits=%x", header.Height, header.Difficulty, parent.Difficulty)
+		}
+		return nil
+	}
+	old := difficulty.CompactToBig(parent.Difficulty)
+	factor := big.NewInt(t.retargetFactor)
+	minTarget := difficulty.CompactToBig(difficulty.BigToCompact(new(big.Int).Div(old, factor)))
+	maxTarget := difficulty.CompactToBig(difficulty.BigToCompact(new(big.Int).Mul(old, factor)))
+	target := difficulty.CompactToBig(header.Difficulty)
+	if target.Cmp(minTarget) < 0 || target.Cmp(maxTarget) > 0 {
+		return errors.Wrapf(types.ErrBlockHeaderDifficulty, "height=%d,bits=%x,parent bits=%x retarget", header.Height, header.Difficulty, parent.Difficulty)
+	}
+	return nil
+}
+
+func verifyTicketMiner(header *types.Header, tx *types.Transaction) error {
+	var action tickettypes.TicketAction
+	err := types.Decode(tx.Payload, &action)
+	if err != nil {
+		return err
+	}
+	miner := action.GetMiner()
+	if action.Ty != tickettypes.TicketActionMiner || miner == nil {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "height=%d not ticket miner tx", header.Height)
+	}
+	if miner.Bits != header.Difficulty {
+		return errors.Wrapf(types.ErrBlockHeaderDifficulty, "height=%d", header.Height)
+	}
+	s := fmt.Sprintf("%d:%s:%x", header.BlockTime, miner.TicketId, miner.Modify)
+	if len(miner.PrivHash) != 0 {
+		s = s + ":" + string(miner.PrivHash)
+	}
+	hash := common.Sha2Sum([]byte(s))
+	current := difficulty.CompactToBig(difficulty.BigToCompact(difficulty.HashToBig(hash[:])))
+	if current.Cmp(difficulty.CompactToBig(header.Difficulty)) > 0 {
+		return errors.Wrapf(types.ErrCoinBaseTarget, "height=%d", header.Height)
+	}
+	return nil
+}
+
+type tendermintVerifier struct {
+	crypto crypto.Crypto
+	//validator address -> pubkey
+	validators map[string]crypto.PubKey
+}
+
+func newTendermintVerifier(signName string, pubkeys []string) (*tendermintVerifier, error) {
+	if signName == "" {
+		signName = defaultMainSignName
+	}
+	signType, ok := ttypes.SignMap[signName]
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "signName=%s", signName)
+	}
+	cr, err := crypto.New(types.GetSignName("", signType))
+	if err != nil {
+		return nil, err
+	}
+	if len(pubkeys) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidParam, "mainValidators empty")
+	}
+	v := &tendermintVerifier{crypto: cr, validators: make(map[string]crypto.PubKey)}
+	for _, key := range pubkeys {
+		b, err := common.FromHex(key)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := cr.PubKeyFromBytes(b)
+		if err != nil {
+			return nil, err
+		}
+		v.validators[string(ttypes.GenAddressByPubKey(pub))] = pub
+	}
+	return v, nil
+}
+
+func getTendermintBlockInfo(tx *types.Transaction) (*tmtypes.TendermintBlockInfo, error) {
+	var action tmtypes.ValNodeAction
+	err := types.Decode(tx.Payload, &action)
+	if err != nil {
+		return nil, err
+	}
+	info := action.GetBlockInfo()
+	if action.Ty != tmtypes.ValNodeActionBlockInfo || info == nil || info.Block == nil || info.Block.Header == nil {
+		return nil, errors.Wrap(pt.ErrParaMainBlockVerify, "not tendermint block info tx")
+	}
+	return info, nil
+}
+
+//获取区块的blockInfo交易，tendermint block header记录的高度、时间和交易数需要和主链区块头一致
+func getMainTendermintBlock(getter mainTxGetter, header *types.Header) (*tmtypes.TendermintBlock, error) {
+	detail, err := getter.getMainMinerTx(header)
+	if err != nil {
+		return nil, err
+	}
+	info, err := getTendermintBlockInfo(detail.Tx)
+	if err != nil {
+		return nil, err
+	}
+	err = checkTendermintHeader(info.Block, header)
+	if err != nil {
+		return nil, err
+	}
+	return info.Block, nil
+}
+
+func checkTendermintHeader(block *tmtypes.TendermintBlock, header *types.Header) error {
+	h := block.Header
+	if h.Height != header.Height || h.Time != header.BlockTime || h.NumTxs != header.TxCount {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "tendermint header height=%d,time=%d,txs=%d not match main height=%d,time=%d,txs=%d",
+			h.Height, h.Time, h.NumTxs, header.Height, header.BlockTime, header.TxCount)
+	}
+	if block.Data != nil && !bytes.Equal(block.Data.TxHash, header.TxHash) {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "tendermint block tx root height=%d", header.Height)
+	}
+	return nil
+}
+
+func getNextMainTendermintBlock(getter mainTxGetter, header *types.Header) (*types.Header, *tmtypes.TendermintBlock, error) {
+	next, err := getter.getMainHeader(header.Height + 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(next.ParentHash, header.Hash) {
+		return nil, nil, errors.Wrapf(pt.ErrParaMainNodesDisagree, "height=%d next parent not match", header.Height)
+	}
+	block, err := getMainTendermintBlock(getter, next)
+	if err != nil {
+		return nil, nil, err
+	}
+	return next, block, nil
+}
+
+//区块h的tendermint block由区块h+1的LastCommit确认，需要配置的验证者中超过2/3签名，
+//tendermint block header不包含chain33区块hash，区块h的hash由区块h+1 header的LastResultsHash记录，区块h+1再由区块h+2的LastCommit确认
+func (t *tendermintVerifier) verify(getter mainTxGetter, header *types.Header) error {
+	if header.Height == 0 {
+		return nil
+	}
+	block, err := getMainTendermintBlock(getter, header)
+	if err != nil {
+		return err
+	}
+	next, nextBlock, err := getNextMainTendermintBlock(getter, header)
+	if err != nil {
+		return err
+	}
+	err = t.verifyCommit(block, nextBlock.LastCommit)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(nextBlock.Header.LastResultsHash, header.Hash) {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "tendermint last results hash height=%d", header.Height)
+	}
+	_, nextNextBlock, err := getNextMainTendermintBlock(getter, next)
+	if err != nil {
+		return err
+	}
+	return t.verifyCommit(nextBlock, nextNextBlock.LastCommit)
+}
+
+func (t *tendermintVerifier) verifyCommit(block *tmtypes.TendermintBlock, commit *tmtypes.TendermintCommit) error {
+	if commit == nil || commit.AggVote != nil {
+		return errors.Wrap(types.ErrNotSupport, "tendermint commit nil or aggregate vote")
+	}
+	blockHash := (&ttypes.TendermintBlock{TendermintBlock: block}).Hash()
+	if len(blockHash) == 0 || commit.BlockID == nil || !bytes.Equal(commit.BlockID.Hash, blockHash) {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "tendermint commit block id height=%d", block.Header.Height)
+	}
+	signed := make(map[string]bool)
+	for _, item := range commit.Precommits {
+		if item == nil || len(item.Signature) == 0 {
+			continue
+		}
+		pub, ok := t.validators[string(item.ValidatorAddress)]
+		if !ok || signed[string(item.ValidatorAddress)] {
+			continue
+		}
+		if item.Height != block.Header.Height || item.Type != uint32(ttypes.VoteTypePrecommit) ||
+			item.BlockID == nil || !bytes.Equal(item.BlockID.Hash, blockHash) {
+			continue
+		}
+		sig, err := t.crypto.SignatureFromBytes(item.Signature)
+		if err != nil {
+			continue
+		}
+		if !pub.VerifyBytes(ttypes.SignBytes(block.Header.ChainID, &ttypes.Vote{Vote: item}), sig) {
+			continue
+		}
+		signed[string(item.ValidatorAddress)] = true
+	}
+	if len(signed)*3 <= len(t.validators)*2 {
+		return errors.Wrapf(pt.ErrParaMainBlockVerify, "tendermint commit height=%d signed=%d,validators=%d",
+			block.Header.Height, len(signed), len(t.validators))
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package para
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/merkle"
+	"github.com/33cn/chain33/types"
+	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	tickettypes "github.com/33cn/plugin/plugin/dapp/ticket/types"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMainBlock(cfg *types.Chain33Config, height int64, title string) (*types.Block, *types.ParaTxDetail) {
+	txs := []*types.Transaction{
+		{Execer: []byte("ticket"), Payload: []byte("miner"), Nonce: 1},
+		{Execer: []byte("coins"), Payload: []byte("main"), Nonce: 2},
+		{Execer: []byte(title + "coins"), Payload: []byte("para1"), Nonce: 3},
+		{Execer: []byte(title + "coins"), Payload: []byte("para2"), Nonce: 4},
+		{Execer: []byte("user.p.other.coins"), Payload: []byte("other"), Nonce: 5},
+	}
+	block := &types.Block{Height: height, BlockTime: 100, Difficulty: 1, Txs: txs, ParentHash: []byte("parent")}
+	block.TxHash = merkle.CalcMerkleRoot(cfg, height, txs)
+
+	_, childChains := merkle.CalcMultiLayerMerkleInfo(cfg, height, txs)
+	var hashes [][]byte
+	detail := &types.ParaTxDetail{Type: types.AddBlock, Header: block.GetHeader(cfg)}
+	for i, child := range childChains {
+		hashes = append(hashes, child.ChildHash)
+		if child.Title == title {
+			detail.ChildHash = child.ChildHash
+			detail.Index = uint32(i)
+		}
+	}
+	detail.Proofs = merkle.GetMerkleBranch(hashes, detail.Index)
+	detail.TxDetails = []*types.TxDetail{{Tx: txs[2], Index: 2}, {Tx: txs[3], Index: 3}}
+	return block, detail
+}
+
+func TestVerifyMainHeader(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	block, detail := createMainBlock(cfg, 10, "user.p.test.")
+
+	detail.Header.Hash = block.HashByForkHeight(5)
+	assert.Nil(t, verifyMainHeader(detail.Header, 5))
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifyMainHeader(detail.Header, 20)))
+
+	detail.Header.Hash = block.HashByForkHeight(20)
+	assert.Nil(t, verifyMainHeader(detail.Header, 20))
+
+	detail.Header.StateHash = []byte("state")
+	assert.Nil(t, verifyMainHeader(detail.Header, 20))
+	detail.Header.TxHash = []byte("txhash")
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifyMainHeader(detail.Header, 20)))
+}
+
+func TestVerifyMainChildProof(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	_, detail := createMainBlock(cfg, 10, "user.p.test.")
+	assert.Nil(t, verifyMainChildProof(cfg, detail))
+
+	//少返回一笔交易
+	txDetails := detail.TxDetails
+	detail.TxDetails = txDetails[:1]
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifyMainChildProof(cfg, detail)))
+
+	//伪造交易，同时伪造childHash
+	fake := &types.Transaction{Execer: []byte("user.p.test.coins"), Payload: []byte("fake")}
+	detail.TxDetails = []*types.TxDetail{txDetails[0], {Tx: fake}}
+	detail.ChildHash = merkle.GetMerkleRoot([][]byte{txDetails[0].Tx.FullHash(), fake.FullHash()})
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifyMainChildProof(cfg, detail)))
+
+	//没有交易时不能有childHash
+	detail.TxDetails = nil
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifyMainChildProof(cfg, detail)))
+	detail.ChildHash = nil
+	assert.Nil(t, verifyMainChildProof(cfg, detail))
+}
+
+func TestCheckMainBlocksAgree(t *testing.T) {
+	h1 := &types.ParaTxDetail{Type: types.AddBlock, Header: &types.Header{Height: 1, Hash: []byte("h1")}, ChildHash: []byte("c1")}
+	h2 := &types.ParaTxDetail{Type: types.AddBlock, Header: &types.Header{Height: 2, Hash: []byte("h2")}}
+	del := &types.ParaTxDetail{Type: types.DelBlock, Header: &types.Header{Height: 2, Hash: []byte("h2")}}
+	details := &types.ParaTxDetails{Items: []*types.ParaTxDetail{h1, h2, del}}
+
+	same := &types.ParaTxDetails{Items: []*types.ParaTxDetail{h1, h2}}
+	assert.Nil(t, checkMainBlocksAgree(details, []*types.ParaTxDetails{same, same}, 2))
+	assert.Equal(t, pt.ErrParaMainNodesNotEnough, errors.Cause(checkMainBlocksAgree(details, []*types.ParaTxDetails{same}, 2)))
+
+	//落后的节点不计数
+	lag := &types.ParaTxDetails{Items: []*types.ParaTxDetail{h1}}
+	assert.Equal(t, pt.ErrParaMainNodesNotEnough, errors.Cause(checkMainBlocksAgree(details, []*types.ParaTxDetails{same, lag}, 2)))
+
+	diff := &types.ParaTxDetails{Items: []*types.ParaTxDetail{
+		{Header: &types.Header{Height: 1, Hash: []byte("h1")}, ChildHash: []byte("c2")}, h2}}
+	assert.Equal(t, pt.ErrParaMainNodesDisagree, errors.Cause(checkMainBlocksAgree(details, []*types.ParaTxDetails{same, same, diff}, 2)))
+}
+
+func TestVerifyMainHeaderChain(t *testing.T) {
+	m := &mainVerifyClient{}
+	h1 := &types.Header{Height: 1, Hash: []byte("h1")}
+	h2 := &types.Header{Height: 2, Hash: []byte("h2"), ParentHash: []byte("h1")}
+	assert.Nil(t, m.updateHeaderChain(&types.ParaTxDetail{Type: types.AddBlock, Header: h1}))
+	assert.Nil(t, m.updateHeaderChain(&types.ParaTxDetail{Type: types.AddBlock, Header: h2}))
+	assert.Equal(t, 2, len(m.headers))
+
+	//回滚的区块需要是最后一个已校验区块
+	err := m.updateHeaderChain(&types.ParaTxDetail{Type: types.DelBlock, Header: h1})
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(err))
+	assert.Nil(t, m.updateHeaderChain(&types.ParaTxDetail{Type: types.DelBlock, Header: h2}))
+	assert.Equal(t, 1, len(m.headers))
+}
+
+func TestVerifyTicketMiner(t *testing.T) {
+	miner := &tickettypes.TicketAction{
+		Ty:    tickettypes.TicketActionMiner,
+		Value: &tickettypes.TicketAction_Miner{Miner: &tickettypes.TicketMiner{Bits: 0x2100ffff, TicketId: "ticket1", Modify: []byte("modify")}},
+	}
+	tx := &types.Transaction{Execer: []byte("ticket"), Payload: types.Encode(miner)}
+	header := &types.Header{Height: 10, BlockTime: 100, Difficulty: 0x2100ffff}
+	assert.Nil(t, verifyTicketMiner(header, tx))
+
+	header.Difficulty = 0x1d00ffff
+	assert.Equal(t, types.ErrBlockHeaderDifficulty, errors.Cause(verifyTicketMiner(header, tx)))
+
+	miner.GetMiner().Bits = 0x1d00ffff
+	tx.Payload = types.Encode(miner)
+	assert.Equal(t, types.ErrCoinBaseTarget, errors.Cause(verifyTicketMiner(header, tx)))
+}
+
+func TestTicketCheckBits(t *testing.T) {
+	verifier := newTicketVerifier(0, 0)
+	parent := &types.Header{Height: 9, Difficulty: 0x1f00ffff}
+	header := &types.Header{Height: 10, Difficulty: 0x1f00ffff}
+	assert.Nil(t, verifier.checkBits(parent, header))
+	//非调整高度难度需要和父区块一致
+	header.Difficulty = 0x1f0fffff
+	assert.Equal(t, types.ErrBlockHeaderDifficulty, errors.Cause(verifier.checkBits(parent, header)))
+
+	//调整高度只能在父区块难度的retargetFactor倍范围内
+	parent = &types.Header{Height: 287, Difficulty: 0x1e00ffff}
+	header = &types.Header{Height: 288, Difficulty: 0x1e03fffc}
+	assert.Nil(t, verifier.checkBits(parent, header))
+	header.Difficulty = 0x1d3fffc0
+	assert.Nil(t, verifier.checkBits(parent, header))
+	header.Difficulty = 0x1e04ffff
+	assert.Equal(t, types.ErrBlockHeaderDifficulty, errors.Cause(verifier.checkBits(parent, header)))
+	header.Difficulty = 0x1d3f0000
+	assert.Equal(t, types.ErrBlockHeaderDifficulty, errors.Cause(verifier.checkBits(parent, header)))
+}
+
+type mockMainGetter struct {
+	headers map[int64]*types.Header
+	txs     map[int64]*types.Transaction
+}
+
+func (g *mockMainGetter) getMainMinerTx(header *types.Header) (*types.TransactionDetail, error) {
+	tx, ok := g.txs[header.Height]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	return &types.TransactionDetail{Tx: tx, Height: header.Height}, nil
+}
+
+func (g *mockMainGetter) getMainHeader(height int64) (*types.Header, error) {
+	header, ok := g.headers[height]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	return header, nil
+}
+
+func (g *mockMainGetter) getMainParent(header *types.Header) (*types.Header, error) {
+	return g.getMainHeader(header.Height - 1)
+}
+
+func TestMainVerifyGetParent(t *testing.T) {
+	m := &mainVerifyClient{}
+	h1 := &types.Header{Height: 1, Hash: []byte("h1")}
+	h2 := &types.Header{Height: 2, Hash: []byte("h2"), ParentHash: []byte("h1")}
+	assert.Nil(t, m.updateHeaderChain(&types.ParaTxDetail{Type: types.AddBlock, Header: h1}))
+	parent, err := m.getMainParent(h2)
+	assert.Nil(t, err)
+	assert.Equal(t, h1, parent)
+}
+
+func TestVerifyMainFirstTxProof(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	block, _ := createMainBlock(cfg, 10, "user.p.test.")
+	header := block.GetHeader(cfg)
+
+	//主链交易子链和子链根两层证明
+	_, childChains := merkle.CalcMultiLayerMerkleInfo(cfg, 10, block.Txs)
+	var childHashes [][]byte
+	for _, child := range childChains {
+		childHashes = append(childHashes, child.ChildHash)
+	}
+	detail := &types.TransactionDetail{Tx: block.Txs[0], Height: 10, TxProofs: []*types.TxProof{
+		{Proofs: merkle.GetMerkleBranch([][]byte{block.Txs[0].FullHash(), block.Txs[1].FullHash()}, 0)},
+		{Proofs: merkle.GetMerkleBranch(childHashes, 0)},
+	}}
+	assert.Nil(t, verifyMainFirstTxProof(cfg, header, detail))
+
+	detail.Tx = block.Txs[1]
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifyMainFirstTxProof(cfg, header, detail)))
+	detail.Tx = block.Txs[0]
+	detail.Index = 1
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifyMainFirstTxProof(cfg, header, detail)))
+}
+
+func TestTendermintVerifyCommit(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	var privs []crypto.PrivKey
+	var pubs []string
+	for i := 0; i < 3; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, priv)
+		pubs = append(pubs, common.ToHex(priv.PubKey().Bytes()))
+	}
+	verifier, err := newTendermintVerifier("", pubs)
+	assert.Nil(t, err)
+
+	block := &tmtypes.TendermintBlock{
+		Header:     &tmtypes.TendermintBlockHeader{ChainID: "main", Height: 10, ValidatorsHash: []byte("validators")},
+		LastCommit: &tmtypes.TendermintCommit{},
+	}
+	blockHash := (&ttypes.TendermintBlock{TendermintBlock: block}).Hash()
+	makeCommit := func(signers []crypto.PrivKey) *tmtypes.TendermintCommit {
+		commit := &tmtypes.TendermintCommit{BlockID: &tmtypes.BlockID{Hash: blockHash}}
+		for _, priv := range signers {
+			vote := &tmtypes.Vote{
+				ValidatorAddress: ttypes.GenAddressByPubKey(priv.PubKey()),
+				Height:           10,
+				Type:             uint32(ttypes.VoteTypePrecommit),
+				BlockID:          &tmtypes.BlockID{Hash: blockHash},
+			}
+			vote.Signature = priv.Sign(ttypes.SignBytes("main", &ttypes.Vote{Vote: vote})).Bytes()
+			commit.Precommits = append(commit.Precommits, vote)
+		}
+		return commit
+	}
+
+	assert.Nil(t, verifier.verifyCommit(block, makeCommit(privs)))
+	//签名不超过2/3
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verifyCommit(block, makeCommit(privs[:2]))))
+	//重复签名不计数
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verifyCommit(block, makeCommit([]crypto.PrivKey{privs[0], privs[0], privs[1]}))))
+
+	//非配置的验证者签名不计数
+	other, err := cr.GenKey()
+	assert.Nil(t, err)
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verifyCommit(block, makeCommit([]crypto.PrivKey{privs[0], privs[1], other}))))
+
+	//签名被篡改
+	commit := makeCommit(privs)
+	commit.Precommits[2].Height = 11
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verifyCommit(block, commit)))
+}
+
+func TestTendermintVerify(t *testing.T) {
+	cr, err := crypto.New(types.GetSignName("", types.ED25519))
+	assert.Nil(t, err)
+	var privs []crypto.PrivKey
+	var pubs []string
+	for i := 0; i < 3; i++ {
+		priv, err := cr.GenKey()
+		assert.Nil(t, err)
+		privs = append(privs, priv)
+		pubs = append(pubs, common.ToHex(priv.PubKey().Bytes()))
+	}
+	verifier, err := newTendermintVerifier("", pubs)
+	assert.Nil(t, err)
+
+	//主链区块10-12，区块h的chain33 hash记录在区块h+1的LastResultsHash，区块h由区块h+1的LastCommit确认
+	getter := &mockMainGetter{headers: make(map[int64]*types.Header), txs: make(map[int64]*types.Transaction)}
+	blocks := make(map[int64]*tmtypes.TendermintBlock)
+	var lastHash []byte
+	lastCommit := &tmtypes.TendermintCommit{}
+	for height := int64(10); height <= 12; height++ {
+		header := &types.Header{Height: height, BlockTime: 100 + height, TxCount: 1, ParentHash: lastHash,
+			Hash: []byte(fmt.Sprintf("main%d", height))}
+		block := &tmtypes.TendermintBlock{
+			Header: &tmtypes.TendermintBlockHeader{ChainID: "main", Height: height, Time: header.BlockTime, NumTxs: 1,
+				ValidatorsHash: []byte("validators"), LastResultsHash: lastHash},
+			LastCommit: lastCommit,
+		}
+		blockHash := (&ttypes.TendermintBlock{TendermintBlock: block}).Hash()
+		lastCommit = &tmtypes.TendermintCommit{BlockID: &tmtypes.BlockID{Hash: blockHash}}
+		for _, priv := range privs {
+			vote := &tmtypes.Vote{
+				ValidatorAddress: ttypes.GenAddressByPubKey(priv.PubKey()),
+				Height:           height,
+				Type:             uint32(ttypes.VoteTypePrecommit),
+				BlockID:          &tmtypes.BlockID{Hash: blockHash},
+			}
+			vote.Signature = priv.Sign(ttypes.SignBytes("main", &ttypes.Vote{Vote: vote})).Bytes()
+			lastCommit.Precommits = append(lastCommit.Precommits, vote)
+		}
+		getter.headers[height] = header
+		blocks[height] = block
+		lastHash = header.Hash
+	}
+	//最后一个区块只用来提供LastCommit
+	getter.headers[13] = &types.Header{Height: 13, BlockTime: 113, TxCount: 1, ParentHash: lastHash, Hash: []byte("main13")}
+	blocks[13] = &tmtypes.TendermintBlock{
+		Header:     &tmtypes.TendermintBlockHeader{ChainID: "main", Height: 13, Time: 113, NumTxs: 1, LastResultsHash: lastHash},
+		LastCommit: lastCommit,
+	}
+	setTx := func(height int64) {
+		action := &tmtypes.ValNodeAction{
+			Ty:    tmtypes.ValNodeActionBlockInfo,
+			Value: &tmtypes.ValNodeAction_BlockInfo{BlockInfo: &tmtypes.TendermintBlockInfo{Block: blocks[height]}},
+		}
+		getter.txs[height] = &types.Transaction{Execer: []byte("valnode"), Payload: types.Encode(action)}
+	}
+	for height := int64(10); height <= 13; height++ {
+		setTx(height)
+	}
+	assert.Nil(t, verifier.verify(getter, getter.headers[10]))
+	assert.Nil(t, verifier.verify(getter, getter.headers[11]))
+
+	//已签名的blockInfo交易放到伪造的主链区块中，高度或时间不一致
+	fake := *getter.headers[10]
+	fake.BlockTime = 200
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verify(getter, &fake)))
+	fake = *getter.headers[10]
+	fake.Height = 12
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verify(getter, &fake)))
+
+	//高度、时间和交易数一致，但区块hash和下一区块记录的LastResultsHash不一致
+	fake = *getter.headers[10]
+	fake.Hash = []byte("fake")
+	getter.headers[11].ParentHash = fake.Hash
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verify(getter, &fake)))
+	getter.headers[11].ParentHash = getter.headers[10].Hash
+
+	//区块h+1的LastResultsHash需要由区块h+2的LastCommit确认
+	blocks[11].Header.LastResultsHash = []byte("fake")
+	setTx(11)
+	getter.headers[10].Hash = []byte("fake")
+	getter.headers[11].ParentHash = []byte("fake")
+	assert.Equal(t, pt.ErrParaMainBlockVerify, errors.Cause(verifier.verify(getter, getter.headers[10])))
+}
@@ -85,12 +85,21 @@ func (client *client) Query_BlsPubKey(req *types.ReqString) (types.Message, erro
 			return nil, err
 		}
 		pub.Key = p
+		pub.Pop, err = client.blsSignCli.secp256Prikey2BlsPop(req.Data)
+		if err != nil {
+			return nil, err
+		}
 		return &pub, nil
 	}
 	//缺省获取钱包的
 	if nil != client.blsSignCli.blsPubKey {
 		t := client.blsSignCli.blsPubKey.Bytes()
 		pub.Key = common.ToHex(t[:])
+		pop, err := client.blsSignCli.genBlsPop(client.blsSignCli.blsPriKey)
+		if err != nil {
+			return nil, err
+		}
+		pub.Pop = pop
 		return &pub, nil
 	}
 
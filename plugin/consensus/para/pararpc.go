@@ -144,6 +144,16 @@ func (client *client) QueryTxOnMainByHash(hash []byte) (*types.TransactionDetail
 	return detail, nil
 }
 
+func (client *client) GetBlockOverviewOnMain(hash []byte) (*types.BlockOverview, error) {
+	overview, err := client.grpcClient.GetBlockOverview(context.Background(), &types.ReqHash{Hash: hash})
+	if err != nil {
+		plog.Error("GetBlockOverviewOnMain Not found", "hash", common.ToHex(hash))
+		return nil, err
+	}
+
+	return overview, nil
+}
+
 func (client *client) GetParaHeightsByTitle(req *types.ReqHeightByTitle) (*types.ReplyHeightByTitle, error) {
 	//from blockchain db
 	heights, err := client.grpcClient.LoadParaTxByTitle(context.Background(), req)
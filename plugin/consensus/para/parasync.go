@@ -398,6 +398,7 @@ func (client *blockSyncClient) addMinerTx(preStateHash []byte, block *types.Bloc
 	tx, err := pt.CreateRawMinerTx(cfg, &pt.ParacrossMinerAction{
 		Status:          status,
 		IsSelfConsensus: client.paraClient.commitMsgClient.isSelfConsEnable(status.Height),
+		CrossIns:        localBlock.CrossIns,
 	})
 	if err != nil {
 		return err
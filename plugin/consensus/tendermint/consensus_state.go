@@ -16,6 +16,7 @@ import (
 	"github.com/33cn/chain33/common/merkle"
 	"github.com/33cn/chain33/types"
 	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
 	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
 )
 
@@ -1178,7 +1179,9 @@ func (cs *ConsensusState) finalizeCommit(height int64) {
 			tendermintlog.Info("finalizeCommit validators of statecopy update", "update-valnodes", valNodes)
 			prevValSet := stateCopy.LastValidators.Copy()
 			nextValSet := prevValSet.Copy()
-			err := updateValidators(nextValSet, valNodes.Nodes)
+			cfg := cs.client.GetAPI().GetConfig()
+			needPop := ttypes.CryptoName == bls.Name && cfg.IsDappFork(block.Header.Height, tmtypes.ValNodeX, tmtypes.ForkBlsPop)
+			err := updateValidators(nextValSet, valNodes.Nodes, needPop)
 			if err != nil {
 				tendermintlog.Error("Error changing validator set", "error", err)
 			}
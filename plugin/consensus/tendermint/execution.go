@@ -9,7 +9,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/33cn/chain33/common/crypto"
 	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
 	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
 )
 
@@ -96,7 +98,13 @@ func updateState(s State, blockID ttypes.BlockID, block *ttypes.TendermintBlock)
 	}, nil
 }
 
-func updateValidators(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode) error {
+// updateValidators 应用valnode交易的验证者变更
+// ForkBlsPop之后(needPop)的bls验证者规则:
+//   - fork时已在集合中的验证者(包括创世验证者)显式豁免pop，权重不变时保持原样继续参与聚合验签
+//   - 新加入(包括移除后重新加入)和调整权重的验证者必须携带有效pop，与valnode执行器的校验一致，
+//     因此豁免的验证者可以通过相同权重的valnode交易补充登记pop
+//   - 移除验证者(power为0)不需要pop
+func updateValidators(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode, needPop bool) error {
 	// If more or equal than 1/3 of total voting power changed in one block, then
 	// a light client could never prove the transition externally. See
 	// ./lite/doc.go for details on how a light client tracks validators.
@@ -121,6 +129,13 @@ func updateValidators(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNod
 			return fmt.Errorf("Power (%d) overflows int64", v.Power)
 		}
 
+		if needPop && power != 0 {
+			if err := verifyValidatorPop(pubkey, v.Pop); err != nil {
+				tendermintlog.Error("updateValidators skip validator without valid pop", "address", fmt.Sprintf("%X", address), "err", err)
+				continue
+			}
+		}
+
 		_, val := currentSet.GetByAddress(address)
 		if val == nil {
 			// add val
@@ -146,6 +161,19 @@ func updateValidators(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNod
 	return nil
 }
 
+//同消息聚合验签需要验证者公钥的proof of possession，防止rogue key攻击
+func verifyValidatorPop(pubkey crypto.PubKey, pop []byte) error {
+	if len(pop) != bls.BLSSignatureLength {
+		return errors.New("bls validator pop is empty or invalid")
+	}
+	drv := bls.Driver{}
+	sig, err := drv.SignatureFromBytes(pop)
+	if err != nil {
+		return err
+	}
+	return drv.VerifyPop(pubkey, sig)
+}
+
 func changeInVotingPowerMoreOrEqualToOneThird(currentSet *ttypes.ValidatorSet, updates []*tmtypes.ValNode) (bool, error) {
 	threshold := currentSet.TotalVotingPower() * 1 / 3
 	acc := int64(0)
@@ -0,0 +1,75 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tendermint
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common/crypto"
+	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
+	tmtypes "github.com/33cn/plugin/plugin/dapp/valnode/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateValidatorsPop(t *testing.T) {
+	defer func(cr crypto.Crypto) { ttypes.ConsensusCrypto = cr }(ttypes.ConsensusCrypto)
+	drv := bls.Driver{}
+	ttypes.ConsensusCrypto = drv
+
+	var vals []*ttypes.Validator
+	for i := 0; i < 3; i++ {
+		priv, err := drv.GenKey()
+		assert.Nil(t, err)
+		vals = append(vals, ttypes.NewValidator(priv.PubKey(), 10))
+	}
+	newVal := func() (crypto.PubKey, []byte) {
+		priv, err := drv.GenKey()
+		assert.Nil(t, err)
+		pop, err := drv.GenPop(priv)
+		assert.Nil(t, err)
+		return priv.PubKey(), pop.Bytes()
+	}
+
+	pub1, pop1 := newVal()
+	pub2, pop2 := newVal()
+	set := ttypes.NewValidatorSet(vals)
+	updates := []*tmtypes.ValNode{
+		{PubKey: pub1.Bytes(), Power: 1, Pop: pop1},
+		//pop不匹配的新验证者不加入
+		{PubKey: pub2.Bytes(), Power: 1, Pop: pop1},
+		//已有验证者调整权重需要pop
+		{PubKey: vals[0].PubKey, Power: 11},
+	}
+	assert.Nil(t, updateValidators(set, updates, true))
+	assert.Equal(t, 4, set.Size())
+	assert.True(t, set.HasAddress(ttypes.GenAddressByPubKey(pub1)))
+	assert.False(t, set.HasAddress(ttypes.GenAddressByPubKey(pub2)))
+	_, val := set.GetByAddress(vals[0].Address)
+	assert.Equal(t, int64(10), val.VotingPower)
+	//fork时已有的验证者没有pop也保留在集合中
+	for _, v := range vals {
+		assert.True(t, set.HasAddress(v.Address))
+	}
+
+	//已有验证者携带pop登记并调整权重
+	pub3, pop3 := newVal()
+	set = ttypes.NewValidatorSet([]*ttypes.Validator{vals[0], vals[1], vals[2], ttypes.NewValidator(pub3, 10)})
+	assert.Nil(t, updateValidators(set, []*tmtypes.ValNode{{PubKey: pub3.Bytes(), Power: 11, Pop: pop3}}, true))
+	_, val = set.GetByAddress(ttypes.GenAddressByPubKey(pub3))
+	assert.Equal(t, int64(11), val.VotingPower)
+
+	//移除不需要pop，移除后重新加入按新验证者处理
+	assert.Nil(t, updateValidators(set, []*tmtypes.ValNode{{PubKey: pub3.Bytes(), Power: 0}}, true))
+	assert.False(t, set.HasAddress(ttypes.GenAddressByPubKey(pub3)))
+	assert.Nil(t, updateValidators(set, []*tmtypes.ValNode{{PubKey: pub3.Bytes(), Power: 1}}, true))
+	assert.False(t, set.HasAddress(ttypes.GenAddressByPubKey(pub3)))
+
+	//fork之前不校验pop
+	set = ttypes.NewValidatorSet(vals)
+	assert.Nil(t, updateValidators(set, []*tmtypes.ValNode{{PubKey: pub2.Bytes(), Power: 1}}, false))
+	assert.True(t, set.HasAddress(ttypes.GenAddressByPubKey(pub2)))
+	assert.Nil(t, verifyValidatorPop(pub2, pop2))
+}
@@ -7,6 +7,7 @@ package bls
 import (
 	"bytes"
 	"crypto/rand"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 
@@ -151,6 +152,40 @@ func (d Driver) VerifyAggregatedN(pubs []crypto.PubKey, ms [][]byte, sig crypto.
 	return errors.New("bls signature mismatch")
 }
 
+//proof of possession使用单独的domain做hash，和普通消息签名区分
+var popDomain = [8]byte{'B', 'L', 'S', '_', 'P', 'O', 'P'}
+
+func popMsg(pub crypto.PubKey) [32]byte {
+	return sha256.Sum256(pub.Bytes())
+}
+
+// GenPop create proof of possession of the private key, signature of the public key in pop domain
+func (d Driver) GenPop(priv crypto.PrivKey) (crypto.Signature, error) {
+	privBLS, ok := priv.(PrivKeyBLS)
+	if !ok {
+		return nil, errors.New("invalid bls private key")
+	}
+	sk := g1pubs.DeserializeSecretKey(privBLS)
+	sig := g1pubs.SignWithDomain(popMsg(privBLS.PubKey()), sk, popDomain)
+	return SignatureBLS(sig.Serialize()), nil
+}
+
+// VerifyPop verify proof of possession of the public key, same-message aggregate needs pop to prevent rogue key attack
+func (d Driver) VerifyPop(pub crypto.PubKey, pop crypto.Signature) error {
+	g1pub, err := ConvertToPublicKey(pub)
+	if err != nil {
+		return err
+	}
+	g1sig, err := ConvertToSignature(pop)
+	if err != nil {
+		return err
+	}
+	if g1pubs.VerifyWithDomain(popMsg(pub), g1pub, g1sig, popDomain) {
+		return nil
+	}
+	return errors.New("bls proof of possession mismatch")
+}
+
 // ConvertToSignature convert to BLS Signature
 func ConvertToSignature(sig crypto.Signature) (*g1pubs.Signature, error) {
 	// unwrap if needed
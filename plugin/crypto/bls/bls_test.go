@@ -11,6 +11,7 @@ import (
 	"github.com/33cn/chain33/common"
 
 	"github.com/33cn/chain33/common/crypto"
+	"github.com/phoreproject/bls/g1pubs"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -117,6 +118,45 @@ func TestAggregate(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestPop(t *testing.T) {
+	sk, _ := blsDrv.GenKey()
+	pk := sk.PubKey()
+	pop, err := blsDrv.GenPop(sk)
+	assert.NoError(t, err)
+	assert.NoError(t, blsDrv.VerifyPop(pk, pop))
+
+	//pop和普通消息签名不通用
+	assert.False(t, pk.VerifyBytes(pk.Bytes(), pop))
+	assert.Error(t, blsDrv.VerifyPop(pk, sk.Sign(pk.Bytes())))
+
+	sk2, _ := blsDrv.GenKey()
+	assert.Error(t, blsDrv.VerifyPop(sk2.PubKey(), pop))
+}
+
+func TestPopRogueKey(t *testing.T) {
+	m := []byte("message to be signed. 将要做签名的消息")
+	honest, _ := blsDrv.GenKey()
+	attacker, _ := blsDrv.GenKey()
+
+	//构造rogue key: attacker - honest，聚合公钥等于attacker公钥
+	honestPub, err := ConvertToPublicKey(honest.PubKey())
+	assert.NoError(t, err)
+	attackerPub, err := ConvertToPublicKey(attacker.PubKey())
+	assert.NoError(t, err)
+	neg := honestPub.GetPoint().Copy()
+	neg.NegAssign()
+	rogue := PubKeyBLS(g1pubs.NewPublicKeyFromG1(attackerPub.GetPoint().Add(neg).ToAffine()).Serialize())
+
+	//attacker单独签名即可伪造honest参与的聚合签名
+	err = blsDrv.VerifyAggregatedOne([]crypto.PubKey{honest.PubKey(), rogue}, m, attacker.Sign(m))
+	assert.NoError(t, err)
+
+	//attacker没有rogue key的私钥，无法生成pop
+	pop, err := blsDrv.GenPop(attacker)
+	assert.NoError(t, err)
+	assert.Error(t, blsDrv.VerifyPop(rogue, pop))
+}
+
 //benchmark
 func BenchmarkBLSAggregateSignature(b *testing.B) {
 	msg := []byte(">16 character identical message")
@@ -41,6 +41,8 @@ func AutonomyCmd() *cobra.Command {
 		VoteProposalProjectCmd(),
 		PubVoteProposalProjectCmd(),
 		TerminateProposalProjectCmd(),
+		VoteMilestoneCmd(),
+		TerminateMilestoneCmd(),
 		ShowProposalProjectCmd(),
 	)
 
@@ -69,6 +71,17 @@ func AutonomyCmd() *cobra.Command {
 		ShowProposalChangeCmd(),
 	)
 
+	// param
+	cmd.AddCommand(
+		ProposalParamCmd(),
+		RevokeProposalParamCmd(),
+		VoteProposalParamCmd(),
+		PubVoteProposalParamCmd(),
+		TerminateProposalParamCmd(),
+		ShowProposalParamCmd(),
+		ShowParamCmd(),
+	)
+
 	return cmd
 }
 
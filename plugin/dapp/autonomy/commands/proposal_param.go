@@ -0,0 +1,385 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"strings"
+
+	"encoding/json"
+
+	jsonrpc "github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+	"github.com/spf13/cobra"
+)
+
+// ProposalParamCmd 创建提案命令
+func ProposalParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposalParam",
+		Short: "create proposal param",
+		Run:   proposalParam,
+	}
+	addProposalParamFlags(cmd)
+	return cmd
+}
+
+func addProposalParamFlags(cmd *cobra.Command) {
+	cmd.Flags().Int32P("year", "y", 0, "year")
+	cmd.Flags().Int32P("month", "m", 0, "month")
+	cmd.Flags().Int32P("day", "d", 0, "day")
+	cmd.Flags().Int64P("startBlock", "s", 0, "start block height")
+	cmd.MarkFlagRequired("startBlock")
+	cmd.Flags().Int64P("endBlock", "e", 0, "end block height")
+	cmd.MarkFlagRequired("endBlock")
+	cmd.Flags().Int64P("activeBlock", "a", 0, "active block height, must not be less than end block height")
+	cmd.MarkFlagRequired("activeBlock")
+
+	cmd.Flags().StringP("key", "k", "", "config key, eg: tendermint-manager")
+	cmd.MarkFlagRequired("key")
+	cmd.Flags().StringP("values", "v", "", "config values: value1-value2......valueN")
+	cmd.MarkFlagRequired("values")
+	cmd.Flags().StringP("description", "c", "", "description")
+}
+
+func proposalParam(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	year, _ := cmd.Flags().GetInt32("year")
+	month, _ := cmd.Flags().GetInt32("month")
+	day, _ := cmd.Flags().GetInt32("day")
+
+	startBlock, _ := cmd.Flags().GetInt64("startBlock")
+	endBlock, _ := cmd.Flags().GetInt64("endBlock")
+	activeBlock, _ := cmd.Flags().GetInt64("activeBlock")
+	key, _ := cmd.Flags().GetString("key")
+	values, _ := cmd.Flags().GetString("values")
+	description, _ := cmd.Flags().GetString("description")
+
+	params := &auty.ProposalParam{
+		Year:             year,
+		Month:            month,
+		Day:              day,
+		Key:              key,
+		Values:           strings.Split(values, "-"),
+		Description:      description,
+		ActiveHeight:     activeBlock,
+		StartBlockHeight: startBlock,
+		EndBlockHeight:   endBlock,
+	}
+
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(auty.AutonomyX),
+		ActionName: "PropParam",
+		Payload:    payLoad,
+	}
+
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// RevokeProposalParamCmd 撤销提案
+func RevokeProposalParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revokeParam",
+		Short: "revoke proposal param",
+		Run:   revokeProposalParam,
+	}
+	addRevokeProposalParamFlags(cmd)
+	return cmd
+}
+
+func addRevokeProposalParamFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("proposalID", "p", "", "proposal ID")
+	cmd.MarkFlagRequired("proposalID")
+}
+
+func revokeProposalParam(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ID, _ := cmd.Flags().GetString("proposalID")
+
+	params := &auty.RevokeProposalParam{
+		ProposalID: ID,
+	}
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(auty.AutonomyX),
+		ActionName: "RvkPropParam",
+		Payload:    payLoad,
+	}
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// VoteProposalParamCmd 董事会投票提案
+func VoteProposalParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voteParam",
+		Short: "vote proposal param",
+		Run:   voteProposalParam,
+	}
+	addVoteProposalParamFlags(cmd)
+	return cmd
+}
+
+func addVoteProposalParamFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("proposalID", "p", "", "proposal ID")
+	cmd.MarkFlagRequired("proposalID")
+	cmd.Flags().Int32P("approve", "r", 1, "is approve, default true")
+}
+
+func voteProposalParam(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ID, _ := cmd.Flags().GetString("proposalID")
+	approve, _ := cmd.Flags().GetInt32("approve")
+
+	params := &auty.VoteProposalParam{
+		ProposalID: ID,
+		Approve:    approve != 0,
+	}
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(auty.AutonomyX),
+		ActionName: "VotePropParam",
+		Payload:    payLoad,
+	}
+
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// PubVoteProposalParamCmd 全体持票人否决提案
+func PubVoteProposalParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pubVoteParam",
+		Short: "pub vote proposal param",
+		Run:   pubVoteProposalParam,
+	}
+	addPubVoteProposalParamFlags(cmd)
+	return cmd
+}
+
+func addPubVoteProposalParamFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("proposalID", "p", "", "proposal ID")
+	cmd.MarkFlagRequired("proposalID")
+	cmd.Flags().Int32P("oppose", "s", 1, "is oppose, default true")
+	cmd.Flags().StringP("originAddr", "o", "", "origin address: addr1-addr2......addrN")
+}
+
+func pubVoteProposalParam(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ID, _ := cmd.Flags().GetString("proposalID")
+	oppose, _ := cmd.Flags().GetInt32("oppose")
+	originAddr, _ := cmd.Flags().GetString("originAddr")
+
+	var originAddrs []string
+	if len(originAddr) > 0 {
+		originAddrs = strings.Split(originAddr, "-")
+	}
+
+	params := &auty.PubVoteProposalParam{
+		ProposalID: ID,
+		Oppose:     oppose != 0,
+		OriginAddr: originAddrs,
+	}
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(auty.AutonomyX),
+		ActionName: "PubVotePropParam",
+		Payload:    payLoad,
+	}
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// TerminateProposalParamCmd 终止提案
+func TerminateProposalParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminateParam",
+		Short: "terminate proposal param",
+		Run:   terminateProposalParam,
+	}
+	addTerminateProposalParamFlags(cmd)
+	return cmd
+}
+
+func addTerminateProposalParamFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("proposalID", "p", "", "proposal ID")
+	cmd.MarkFlagRequired("proposalID")
+}
+
+func terminateProposalParam(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ID, _ := cmd.Flags().GetString("proposalID")
+
+	params := &auty.TerminateProposalParam{
+		ProposalID: ID,
+	}
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(auty.AutonomyX),
+		ActionName: "TmintPropParam",
+		Payload:    payLoad,
+	}
+
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// ShowProposalParamCmd 显示提案查询信息
+func ShowProposalParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "showParam",
+		Short: "show proposal param info",
+		Run:   showProposalParam,
+	}
+	addShowProposalParamflags(cmd)
+	return cmd
+}
+
+func addShowProposalParamflags(cmd *cobra.Command) {
+	cmd.Flags().Uint32P("type", "y", 0, "type(0:query by hash; 1:list)")
+	cmd.MarkFlagRequired("type")
+
+	cmd.Flags().StringP("proposalID", "p", "", "proposal ID")
+
+	cmd.Flags().Uint32P("status", "s", 0, "status")
+	cmd.Flags().StringP("addr", "a", "", "address")
+	cmd.Flags().Int32P("count", "c", 1, "count, default is 1")
+	cmd.Flags().Int32P("direction", "d", 0, "direction, default is reserve")
+	cmd.Flags().Int64P("height", "t", -1, "height, default is -1")
+	cmd.Flags().Int32P("index", "i", -1, "index, default is -1")
+}
+
+func showProposalParam(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	typ, _ := cmd.Flags().GetUint32("type")
+	propID, _ := cmd.Flags().GetString("proposalID")
+	status, _ := cmd.Flags().GetUint32("status")
+	addr, _ := cmd.Flags().GetString("addr")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	height, _ := cmd.Flags().GetInt64("height")
+	index, _ := cmd.Flags().GetInt32("index")
+
+	var params rpctypes.Query4Jrpc
+	var rep interface{}
+	params.Execer = auty.AutonomyX
+	if 0 == typ {
+		req := types.ReqString{
+			Data: propID,
+		}
+		params.FuncName = auty.GetProposalParam
+		params.Payload = types.MustPBToJSON(&req)
+	} else if 1 == typ {
+		req := auty.ReqQueryProposalParam{
+			Status:    int32(status),
+			Addr:      addr,
+			Count:     count,
+			Direction: direction,
+			Height:    height,
+			Index:     index,
+		}
+		params.FuncName = auty.ListProposalParam
+		params.Payload = types.MustPBToJSON(&req)
+	}
+	rep = &auty.ReplyQueryProposalParam{}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, rep)
+	ctx.Run()
+}
+
+// ShowParamCmd 显示提案设置的链上配置参数及修改历史
+func ShowParamCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "showParamValue",
+		Short: "show config param value set by proposal and change history",
+		Run:   showParam,
+	}
+	addShowParamflags(cmd)
+	return cmd
+}
+
+func addShowParamflags(cmd *cobra.Command) {
+	cmd.Flags().Uint32P("type", "y", 0, "type(0:query current value; 1:list change history)")
+	cmd.Flags().StringP("key", "k", "", "config key")
+
+	cmd.Flags().Int32P("count", "c", 1, "count, default is 1")
+	cmd.Flags().Int32P("direction", "d", 0, "direction, default is reserve")
+	cmd.Flags().Int64P("height", "t", -1, "height, default is -1")
+	cmd.Flags().Int32P("index", "i", -1, "index, default is -1")
+}
+
+func showParam(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	typ, _ := cmd.Flags().GetUint32("type")
+	key, _ := cmd.Flags().GetString("key")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	height, _ := cmd.Flags().GetInt64("height")
+	index, _ := cmd.Flags().GetInt32("index")
+
+	var params rpctypes.Query4Jrpc
+	var rep interface{}
+	params.Execer = auty.AutonomyX
+	if 0 == typ {
+		req := types.ReqString{
+			Data: key,
+		}
+		params.FuncName = auty.GetAutonomyParam
+		params.Payload = types.MustPBToJSON(&req)
+		rep = &auty.AutonomyParam{}
+	} else {
+		req := auty.ReqQueryParamChange{
+			Key:       key,
+			Count:     count,
+			Direction: direction,
+			Height:    height,
+			Index:     index,
+		}
+		params.FuncName = auty.ListParamChange
+		params.Payload = types.MustPBToJSON(&req)
+		rep = &auty.ReplyQueryParamChange{}
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, rep)
+	ctx.Run()
+}
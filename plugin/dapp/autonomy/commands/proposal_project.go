@@ -6,6 +6,9 @@ package commands
 
 import (
 	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
 
 	"strings"
 
@@ -50,6 +53,7 @@ func addProposalProjectFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("endBlock")
 	cmd.Flags().Int32P("projectNeedBlockNum", "n", 0, "project complete need time(unit is block number)")
 	cmd.MarkFlagRequired("projectNeedBlockNum")
+	cmd.Flags().StringP("milestones", "x", "", "project milestones: amount1:description1,amount2:description2......, sum of amount must equal project amount")
 }
 
 func proposalProject(cmd *cobra.Command, args []string) {
@@ -75,6 +79,24 @@ func proposalProject(cmd *cobra.Command, args []string) {
 	startBlock, _ := cmd.Flags().GetInt64("startBlock")
 	endBlock, _ := cmd.Flags().GetInt64("endBlock")
 	projectNeedBlockNum, _ := cmd.Flags().GetInt32("projectNeedBlockNum")
+	milestones, _ := cmd.Flags().GetString("milestones")
+
+	var projectMilestones []*auty.ProjectMilestone
+	if len(milestones) > 0 {
+		for _, item := range strings.Split(milestones, ",") {
+			fields := strings.SplitN(item, ":", 2)
+			msAmount, err := strconv.ParseInt(fields[0], 10, 64)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, "invalid milestone amount", fields[0])
+				return
+			}
+			ms := &auty.ProjectMilestone{Amount: msAmount * types.Coin}
+			if len(fields) > 1 {
+				ms.Description = fields[1]
+			}
+			projectMilestones = append(projectMilestones, ms)
+		}
+	}
 
 	params := &auty.ProposalProject{
 		Year:                year,
@@ -91,6 +113,7 @@ func proposalProject(cmd *cobra.Command, args []string) {
 		StartBlockHeight:    startBlock,
 		EndBlockHeight:      endBlock,
 		ProjectNeedBlockNum: projectNeedBlockNum,
+		Milestones:          projectMilestones,
 	}
 
 	payLoad, err := json.Marshal(params)
@@ -295,6 +318,92 @@ func terminateProposalProject(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// VoteMilestoneCmd 董事会确认项目里程碑
+func VoteMilestoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voteMilestone",
+		Short: "vote project milestone",
+		Run:   voteMilestone,
+	}
+	addVoteMilestoneFlags(cmd)
+	return cmd
+}
+
+func addVoteMilestoneFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("proposalID", "p", "", "proposal ID")
+	cmd.MarkFlagRequired("proposalID")
+	cmd.Flags().Int32P("index", "i", 0, "milestone index, start from 0")
+	cmd.Flags().Int32P("approve", "r", 1, "is approve, default true")
+}
+
+func voteMilestone(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ID, _ := cmd.Flags().GetString("proposalID")
+	index, _ := cmd.Flags().GetInt32("index")
+	approve, _ := cmd.Flags().GetInt32("approve")
+
+	params := &auty.VoteProjectMilestone{
+		ProposalID: ID,
+		Index:      index,
+		Approve:    approve != 0,
+	}
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(auty.AutonomyX),
+		ActionName: "VoteMilestone",
+		Payload:    payLoad,
+	}
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// TerminateMilestoneCmd 终止项目里程碑
+func TerminateMilestoneCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "terminateMilestone",
+		Short: "terminate project milestones and refund escrow amount",
+		Run:   terminateMilestone,
+	}
+	addTerminateMilestoneFlags(cmd)
+	return cmd
+}
+
+func addTerminateMilestoneFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("proposalID", "p", "", "proposal ID")
+	cmd.MarkFlagRequired("proposalID")
+}
+
+func terminateMilestone(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ID, _ := cmd.Flags().GetString("proposalID")
+
+	params := &auty.TerminateProjectMilestone{
+		ProposalID: ID,
+	}
+	payLoad, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(auty.AutonomyX),
+		ActionName: "TmintMilestone",
+		Payload:    payLoad,
+	}
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
 // ShowProposalProjectCmd 显示提案查询信息
 func ShowProposalProjectCmd() *cobra.Command {
 	cmd := &cobra.Command{
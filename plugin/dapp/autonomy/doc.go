@@ -30,8 +30,16 @@
 //3）需要按照要求在填入项目相关信息，包括项目地址、第一阶段提案hash（针对项目多阶段提案）、上一阶段提案hash（针对项目多阶段提案）、项目阶段性简述（md格式）、承包人、项目经费、经费细则（md格式）、收款地址。
 //4）提案通过董事会之后，根据项目金额大小来决定是否进入公示期，公示期周期固定为1周的区块高度，从董事会实际投票结束的高度向后推算一周区块高度。有超过30%的否决票，则该提案不通过。
 //5）根据每个不同阶段，完成的情况，追缴上一阶段的金额，开始阶段预付一定金额，最后一个阶段支付尾款，尾款的控制由董事会进行把控。
-//6）董事会成员可以对提案进行评论，评论将以交易的形式提出并且与提案相关联，显示到提案区，参与评论的截止时间以实际提案结束投票高度为准。
+//6）提案可以声明多个里程碑及对应经费，里程碑经费之和需等于项目经费；提案通过后经费托管在承包商的合约账户中冻结，
+//董事会按顺序投票确认每个里程碑后释放对应经费；里程碑被董事会否决或者提案人、承包商终止项目时，未释放的经费退回基金。
+//7）董事会成员可以对提案进行评论，评论将以交易的形式提出并且与提案相关联，显示到提案区，参与评论的截止时间以实际提案结束投票高度为准。
 //
 //（3）提案投票自治系统参数修改，如达成董事会投票的参与率/通过率，全体持票人重大项目的否决率，提案费用，重大项目金额阈值的修改。
+//
+//（4）提案修改链上配置参数，如tendermint-manager、oracle-publish-event等白名单内的manage配置项；
+//1）提案需要指定参与有效投票的区块高度区间以及参数生效高度，生效高度不能小于投票结束高度；
+//2）董事会投票通过后进入公示期，公示期内全体持票人可以投否决票，达到否决比例则提案不通过；
+//3）公示期结束后终止提案，参数写入自治合约，到达生效高度后生效；之后manage再修改该配置项则以manage为准，即以最后修改的为准；
+//4）参数修改记录可以通过ListParamChange查询。
 
 package autonomy
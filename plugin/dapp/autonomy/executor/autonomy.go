@@ -15,6 +15,8 @@ import (
 type subConfig struct {
 	Total      string `json:"total"`
 	UseBalance bool   `json:"useBalance"`
+	// ParamKeys 允许提案修改的链上配置项
+	ParamKeys []string `json:"paramKeys"`
 }
 
 var (
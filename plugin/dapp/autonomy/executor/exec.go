@@ -67,6 +67,18 @@ func (a *Autonomy) Exec_TmintPropProject(payload *auty.TerminateProposalProject,
 	return action.tmintPropProject(payload)
 }
 
+// Exec_VoteMilestone 董事会确认项目里程碑
+func (a *Autonomy) Exec_VoteMilestone(payload *auty.VoteProjectMilestone, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(a, tx, int32(index))
+	return action.voteMilestone(payload)
+}
+
+// Exec_TmintMilestone 终止项目里程碑，退回剩余托管资金
+func (a *Autonomy) Exec_TmintMilestone(payload *auty.TerminateProjectMilestone, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(a, tx, int32(index))
+	return action.tmintMilestone(payload)
+}
+
 // 提案规则相关
 
 // Exec_PropRule 创建提案规则
@@ -130,3 +142,35 @@ func (a *Autonomy) Exec_TmintPropChange(payload *auty.TerminateProposalChange, t
 	action := newAction(a, tx, int32(index))
 	return action.tmintPropChange(payload)
 }
+
+// 提案修改链上配置参数相关
+
+// Exec_PropParam 创建参数修改提案
+func (a *Autonomy) Exec_PropParam(payload *auty.ProposalParam, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(a, tx, int32(index))
+	return action.propParam(payload)
+}
+
+// Exec_RvkPropParam 撤销参数修改提案
+func (a *Autonomy) Exec_RvkPropParam(payload *auty.RevokeProposalParam, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(a, tx, int32(index))
+	return action.rvkPropParam(payload)
+}
+
+// Exec_VotePropParam 董事会投票参数修改提案
+func (a *Autonomy) Exec_VotePropParam(payload *auty.VoteProposalParam, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(a, tx, int32(index))
+	return action.votePropParam(payload)
+}
+
+// Exec_PubVotePropParam 全体持票人否决参数修改提案
+func (a *Autonomy) Exec_PubVotePropParam(payload *auty.PubVoteProposalParam, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(a, tx, int32(index))
+	return action.pubVotePropParam(payload)
+}
+
+// Exec_TmintPropParam 终止参数修改提案
+func (a *Autonomy) Exec_TmintPropParam(payload *auty.TerminateProposalParam, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(a, tx, int32(index))
+	return action.tmintPropParam(payload)
+}
@@ -58,6 +58,16 @@ func (a *Autonomy) ExecLocal_TmintPropProject(payload *auty.TerminateProposalPro
 	return a.execAutoLocalProject(tx, receiptData)
 }
 
+// ExecLocal_VoteMilestone 董事会确认项目里程碑
+func (a *Autonomy) ExecLocal_VoteMilestone(payload *auty.VoteProjectMilestone, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return a.execAutoLocalProject(tx, receiptData)
+}
+
+// ExecLocal_TmintMilestone 终止项目里程碑
+func (a *Autonomy) ExecLocal_TmintMilestone(payload *auty.TerminateProjectMilestone, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return a.execAutoLocalProject(tx, receiptData)
+}
+
 // 提案规则相关
 
 // ExecLocal_PropRule 创建提案规则
@@ -106,3 +116,30 @@ func (a *Autonomy) ExecLocal_VotePropChange(payload *auty.VoteProposalChange, tx
 func (a *Autonomy) ExecLocal_TmintPropChange(payload *auty.TerminateProposalChange, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return a.execAutoLocalChange(tx, receiptData)
 }
+
+// 提案修改链上配置参数相关
+
+// ExecLocal_PropParam 创建参数修改提案
+func (a *Autonomy) ExecLocal_PropParam(payload *auty.ProposalParam, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return a.execAutoLocalParam(tx, receiptData, index)
+}
+
+// ExecLocal_RvkPropParam 撤销参数修改提案
+func (a *Autonomy) ExecLocal_RvkPropParam(payload *auty.RevokeProposalParam, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return a.execAutoLocalParam(tx, receiptData, index)
+}
+
+// ExecLocal_VotePropParam 董事会投票参数修改提案
+func (a *Autonomy) ExecLocal_VotePropParam(payload *auty.VoteProposalParam, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return a.execAutoLocalParam(tx, receiptData, index)
+}
+
+// ExecLocal_PubVotePropParam 全体持票人否决参数修改提案
+func (a *Autonomy) ExecLocal_PubVotePropParam(payload *auty.PubVoteProposalParam, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return a.execAutoLocalParam(tx, receiptData, index)
+}
+
+// ExecLocal_TmintPropParam 终止参数修改提案
+func (a *Autonomy) ExecLocal_TmintPropParam(payload *auty.TerminateProposalParam, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return a.execAutoLocalParam(tx, receiptData, index)
+}
@@ -41,13 +41,18 @@ func boardVotesRecord(txHash string) []byte {
 
 var (
 	// project
-	projectPrefix = idPrefix + "project" + "-"
+	projectPrefix              = idPrefix + "project" + "-"
+	milestoneVotesRecordPrefix = projectPrefix + "milestone" + "-"
 )
 
 func propProjectID(txHash string) []byte {
 	return []byte(fmt.Sprintf("%s%s", projectPrefix, txHash))
 }
 
+func milestoneVotesRecord(txHash string, index int32) []byte {
+	return []byte(fmt.Sprintf("%s%s-%d", milestoneVotesRecordPrefix, txHash, index))
+}
+
 var (
 	// rule
 	rulePrefix = idPrefix + "rule" + "-"
@@ -79,3 +84,12 @@ var (
 func propChangeID(txHash string) []byte {
 	return []byte(fmt.Sprintf("%s%s", changePrefix, txHash))
 }
+
+var (
+	// param
+	paramPrefix = idPrefix + "param" + "-"
+)
+
+func propParamID(txHash string) []byte {
+	return []byte(fmt.Sprintf("%s%s", paramPrefix, txHash))
+}
@@ -0,0 +1,257 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+)
+
+const (
+	maxMilestones = 20 // 单个项目最多里程碑数
+)
+
+// checkMilestones 检查里程碑经费之和是否等于项目经费，并初始化里程碑状态
+func (a *action) checkMilestones(prob *auty.ProposalProject) error {
+	if len(prob.Milestones) == 0 {
+		return nil
+	}
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, auty.AutonomyX, auty.ForkAutonomyMilestone) {
+		return auty.ErrAutonomyForkNotActive
+	}
+	if len(prob.Milestones) > maxMilestones {
+		return auty.ErrMilestoneAmount
+	}
+	var sum int64
+	for _, ms := range prob.Milestones {
+		if ms.Amount <= 0 {
+			return auty.ErrMilestoneAmount
+		}
+		sum += ms.Amount
+		ms.Status = auty.AutonomyStatusMilestoneWait
+		ms.Boards = nil
+		ms.BoardVoteRes = nil
+		ms.RealBlockHeight = 0
+	}
+	if sum != prob.Amount {
+		return auty.ErrMilestoneAmount
+	}
+	return nil
+}
+
+// payProject 提案通过后支付项目经费
+// 没有里程碑时一次性支付给承包商，有里程碑时将经费冻结在承包商的合约账户中托管，由董事会逐个确认后释放
+func (a *action) payProject(cur *auty.AutonomyProposalProject) (*types.Receipt, error) {
+	prj := cur.PropProject
+	receipt, err := a.coinsAccount.ExecDeposit(prj.ToAddr, a.execaddr, prj.Amount)
+	if err != nil || len(prj.Milestones) == 0 {
+		return receipt, err
+	}
+
+	receiptFrozen, err := a.coinsAccount.ExecFrozen(prj.ToAddr, a.execaddr, prj.Amount)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, receiptFrozen.KV...)
+	receipt.Logs = append(receipt.Logs, receiptFrozen.Logs...)
+	for _, ms := range prj.Milestones {
+		ms.Status = auty.AutonomyStatusMilestoneEscrow
+	}
+	return receipt, nil
+}
+
+// cancelMilestones 提案未通过，里程碑不再托管资金
+func cancelMilestones(cur *auty.AutonomyProposalProject) {
+	for _, ms := range cur.PropProject.GetMilestones() {
+		if ms.Status == auty.AutonomyStatusMilestoneWait {
+			ms.Status = auty.AutonomyStatusMilestoneCancel
+		}
+	}
+}
+
+// refundMilestones 将所有未释放的托管资金退回基金
+func (a *action) refundMilestones(cur *auty.AutonomyProposalProject) (*types.Receipt, error) {
+	var amount int64
+	for _, ms := range cur.PropProject.Milestones {
+		if ms.Status == auty.AutonomyStatusMilestoneEscrow {
+			amount += ms.Amount
+			ms.Status = auty.AutonomyStatusMilestoneRefund
+			ms.RealBlockHeight = a.height
+		}
+	}
+	if amount == 0 {
+		return &types.Receipt{}, nil
+	}
+
+	toAddr := cur.PropProject.ToAddr
+	receipt, err := a.coinsAccount.ExecActive(toAddr, a.execaddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	receiptWithdraw, err := a.coinsAccount.ExecWithdraw(a.execaddr, toAddr, amount)
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, receiptWithdraw.KV...)
+	receipt.Logs = append(receipt.Logs, receiptWithdraw.Logs...)
+	return receipt, nil
+}
+
+func (a *action) voteMilestone(voteMs *auty.VoteProjectMilestone) (*types.Receipt, error) {
+	cur, err := a.getProposalProject(voteMs.ProposalID)
+	if err != nil {
+		alog.Error("voteMilestone ", "addr", a.fromaddr, "execaddr", a.execaddr, "getProposalProject failed",
+			voteMs.ProposalID, "err", err)
+		return nil, err
+	}
+	pre := copyAutonomyProposalProject(cur)
+
+	milestones := cur.PropProject.Milestones
+	if voteMs.Index < 0 || int(voteMs.Index) >= len(milestones) {
+		err := auty.ErrMilestoneIndex
+		alog.Error("voteMilestone ", "addr", a.fromaddr, "index", voteMs.Index, "ProposalID",
+			voteMs.ProposalID, "err", err)
+		return nil, err
+	}
+	// 里程碑需要按顺序确认
+	for i := 0; i < int(voteMs.Index); i++ {
+		if milestones[i].Status != auty.AutonomyStatusMilestoneRelease {
+			err := auty.ErrMilestoneIndex
+			alog.Error("voteMilestone ", "addr", a.fromaddr, "index", voteMs.Index, "prev milestone not release",
+				i, "err", err)
+			return nil, err
+		}
+	}
+	ms := milestones[voteMs.Index]
+	if ms.Status != auty.AutonomyStatusMilestoneEscrow {
+		err := auty.ErrProposalStatus
+		alog.Error("voteMilestone ", "addr", a.fromaddr, "status", ms.Status, "ProposalID",
+			voteMs.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 首次投票时记录当前生效的董事会
+	if ms.BoardVoteRes == nil {
+		pboard, err := a.getActiveBoard()
+		if err != nil {
+			alog.Error("voteMilestone ", "addr", a.fromaddr, "execaddr", a.execaddr, "get getActiveBoard failed", err)
+			return nil, err
+		}
+		ms.Boards = pboard.Boards
+		ms.BoardVoteRes = &auty.VoteResult{TotalVotes: int32(len(pboard.Boards))}
+	}
+
+	// 董事会成员验证
+	var isBoard bool
+	for _, addr := range ms.Boards {
+		if addr == a.fromaddr {
+			isBoard = true
+			break
+		}
+	}
+	if !isBoard {
+		err = auty.ErrNoActiveBoard
+		alog.Error("voteMilestone ", "addr", a.fromaddr, "this addr is not active board member",
+			voteMs.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 检查是否已经参与投票
+	recordKey := milestoneVotesRecord(voteMs.ProposalID, voteMs.Index)
+	votes, err := a.checkVotesRecord([]string{a.fromaddr}, recordKey)
+	if err != nil {
+		alog.Error("voteMilestone ", "addr", a.fromaddr, "execaddr", a.execaddr, "checkVotesRecord failed",
+			voteMs.ProposalID, "err", err)
+		return nil, err
+	}
+	votes.Address = append(votes.Address, a.fromaddr)
+	if voteMs.Approve {
+		ms.BoardVoteRes.ApproveVotes++
+	} else {
+		ms.BoardVoteRes.OpposeVotes++
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	ratio := float32(cur.CurRule.BoardApproveRatio) / 100.0
+	total := float32(ms.BoardVoteRes.TotalVotes)
+	if float32(ms.BoardVoteRes.ApproveVotes)/total >= ratio {
+		// 里程碑确认，释放对应的托管资金
+		receipt, err := a.coinsAccount.ExecActive(cur.PropProject.ToAddr, a.execaddr, ms.Amount)
+		if err != nil {
+			alog.Error("voteMilestone ", "addr", cur.PropProject.ToAddr, "execaddr", a.execaddr, "ExecActive amount", ms.Amount, "err", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+		ms.BoardVoteRes.Pass = true
+		ms.Status = auty.AutonomyStatusMilestoneRelease
+		ms.RealBlockHeight = a.height
+	} else if float32(ms.BoardVoteRes.TotalVotes-ms.BoardVoteRes.OpposeVotes)/total < ratio {
+		// 赞成票已不可能达到通过比例，项目失败，退回剩余托管资金
+		receipt, err := a.refundMilestones(cur)
+		if err != nil {
+			alog.Error("voteMilestone ", "addr", cur.PropProject.ToAddr, "execaddr", a.execaddr, "refundMilestones fail", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+
+	kv = append(kv, &types.KeyValue{Key: propProjectID(voteMs.ProposalID), Value: types.Encode(cur)})
+	kv = append(kv, &types.KeyValue{Key: recordKey, Value: types.Encode(votes)})
+
+	receiptLog := getProjectReceiptLog(pre, cur, auty.TyLogVoteMilestone)
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) tmintMilestone(tmintMs *auty.TerminateProjectMilestone) (*types.Receipt, error) {
+	cur, err := a.getProposalProject(tmintMs.ProposalID)
+	if err != nil {
+		alog.Error("tmintMilestone ", "addr", a.fromaddr, "execaddr", a.execaddr, "getProposalProject failed",
+			tmintMs.ProposalID, "err", err)
+		return nil, err
+	}
+	pre := copyAutonomyProposalProject(cur)
+
+	// 只有提案人或者承包商可以终止项目
+	if a.fromaddr != cur.Address && a.fromaddr != cur.PropProject.ToAddr {
+		err := auty.ErrRevokeProposalPower
+		alog.Error("tmintMilestone ", "addr", a.fromaddr, "execaddr", a.execaddr, "ProposalID",
+			tmintMs.ProposalID, "err", err)
+		return nil, err
+	}
+
+	var escrow bool
+	for _, ms := range cur.PropProject.Milestones {
+		if ms.Status == auty.AutonomyStatusMilestoneEscrow {
+			escrow = true
+			break
+		}
+	}
+	if !escrow {
+		err := auty.ErrProposalStatus
+		alog.Error("tmintMilestone ", "addr", a.fromaddr, "no escrow milestone", tmintMs.ProposalID, "err", err)
+		return nil, err
+	}
+
+	receipt, err := a.refundMilestones(cur)
+	if err != nil {
+		alog.Error("tmintMilestone ", "addr", cur.PropProject.ToAddr, "execaddr", a.execaddr, "refundMilestones fail", err)
+		return nil, err
+	}
+	logs := receipt.Logs
+	kv := receipt.KV
+	kv = append(kv, &types.KeyValue{Key: propProjectID(tmintMs.ProposalID), Value: types.Encode(cur)})
+
+	receiptLog := getProjectReceiptLog(pre, cur, auty.TyLogTmintMilestone)
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
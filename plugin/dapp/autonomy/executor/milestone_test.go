@@ -0,0 +1,203 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func execMilestoneTx(t *testing.T, exec drivers.Driver, stateDB dbm.KV, kvdb dbm.KVDB, val *auty.AutonomyAction, privKey string) (*types.Transaction, error) {
+	tx, err := types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(auty.AutonomyX), types.Encode(val))
+	assert.NoError(t, err)
+	tx, err = signTx(tx, privKey)
+	assert.NoError(t, err)
+
+	receipt, err := exec.Exec(tx, int(1))
+	if err != nil {
+		return tx, err
+	}
+	for _, kv := range receipt.KV {
+		stateDB.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, int(1))
+	assert.NoError(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	return tx, nil
+}
+
+func voteMilestoneAction(proposalID string, index int32, approve bool) *auty.AutonomyAction {
+	return &auty.AutonomyAction{
+		Ty: auty.AutonomyActionVoteMilestone,
+		Value: &auty.AutonomyAction_VoteMilestone{VoteMilestone: &auty.VoteProjectMilestone{
+			ProposalID: proposalID,
+			Index:      index,
+			Approve:    approve,
+		}},
+	}
+}
+
+func TestProjectMilestone(t *testing.T) {
+	chainTestCfg.RegisterDappFork(auty.AutonomyX, auty.ForkAutonomyMilestone, 0)
+	env, exec, stateDB, kvdb := InitEnv()
+	InitBoard(stateDB)
+	InitFund(stateDB, testFundAmount)
+
+	prob := &auty.ProposalProject{
+		Year:             2019,
+		Month:            7,
+		Day:              10,
+		Amount:           testProjectAmount,
+		ToAddr:           AddrD,
+		StartBlockHeight: env.blockHeight + 5,
+		EndBlockHeight:   env.blockHeight + startEndBlockPeriod + 10,
+		Milestones: []*auty.ProjectMilestone{
+			{Description: "design", Amount: testProjectAmount * 3 / 10},
+			{Description: "release", Amount: testProjectAmount / 2},
+		},
+	}
+	propAction := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionPropProject,
+		Value: &auty.AutonomyAction_PropProject{PropProject: prob},
+	}
+	// 里程碑经费之和必须等于项目经费
+	_, err := execMilestoneTx(t, exec, stateDB, kvdb, propAction, PrivKeyA)
+	assert.Equal(t, auty.ErrMilestoneAmount, err)
+
+	prob.Milestones[1].Amount = testProjectAmount * 7 / 10
+	tx, err := execMilestoneTx(t, exec, stateDB, kvdb, propAction, PrivKeyA)
+	assert.NoError(t, err)
+	env.txHash = common.ToHex(tx.Hash())
+	env.startHeight = prob.StartBlockHeight
+	env.endHeight = prob.EndBlockHeight
+
+	// 提案通过后经费托管在承包商的合约账户中
+	voteProposalProject(t, env, exec, stateDB, kvdb, true)
+	accCoin := account.NewCoinsAccount(chainTestCfg)
+	accCoin.SetDB(stateDB)
+	acc := accCoin.LoadExecAccount(AddrD, autonomyAddr)
+	assert.Equal(t, int64(0), acc.Balance)
+	assert.Equal(t, testProjectAmount, acc.Frozen)
+
+	getProject := func() *auty.AutonomyProposalProject {
+		cur, err := newAction(exec.(*Autonomy), tx, 0).getProposalProject(env.txHash)
+		assert.NoError(t, err)
+		return cur
+	}
+	cur := getProject()
+	assert.Equal(t, int32(auty.AutonomyStatusTmintPropProject), cur.Status)
+	for _, ms := range cur.PropProject.Milestones {
+		assert.Equal(t, int32(auty.AutonomyStatusMilestoneEscrow), ms.Status)
+	}
+
+	// 里程碑需按顺序确认
+	exec.SetEnv(env.endHeight+1, env.blockTime, env.difficulty)
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteMilestoneAction(env.txHash, 1, true), PrivKeyA)
+	assert.Equal(t, auty.ErrMilestoneIndex, err)
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteMilestoneAction(env.txHash, 0, true), PrivKey11)
+	assert.NoError(t, err)
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteMilestoneAction(env.txHash, 0, true), PrivKey11)
+	assert.Equal(t, auty.ErrRepeatVoteAddr, err)
+
+	// 21个董事会成员中11个赞成，确认第一个里程碑
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC, PrivKeyD, PrivKey1, PrivKey2, PrivKey3, PrivKey4, PrivKey5, PrivKey6} {
+		_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteMilestoneAction(env.txHash, 0, true), key)
+		assert.NoError(t, err)
+	}
+	acc = accCoin.LoadExecAccount(AddrD, autonomyAddr)
+	assert.Equal(t, testProjectAmount*3/10, acc.Balance)
+	assert.Equal(t, testProjectAmount*7/10, acc.Frozen)
+	cur = getProject()
+	assert.Equal(t, int32(auty.AutonomyStatusMilestoneRelease), cur.PropProject.Milestones[0].Status)
+	assert.Equal(t, int32(11), cur.PropProject.Milestones[0].BoardVoteRes.ApproveVotes)
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteMilestoneAction(env.txHash, 0, true), PrivKey7)
+	assert.Equal(t, auty.ErrProposalStatus, err)
+
+	// 只有提案人或承包商可以终止，终止后剩余托管资金退回基金
+	terminate := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionTmintMilestone,
+		Value: &auty.AutonomyAction_TmintMilestone{TmintMilestone: &auty.TerminateProjectMilestone{ProposalID: env.txHash}},
+	}
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, terminate, PrivKeyB)
+	assert.Equal(t, auty.ErrRevokeProposalPower, err)
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, terminate, PrivKeyA)
+	assert.NoError(t, err)
+	acc = accCoin.LoadExecAccount(AddrD, autonomyAddr)
+	assert.Equal(t, testProjectAmount*3/10, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, terminate, PrivKeyA)
+	assert.Equal(t, auty.ErrProposalStatus, err)
+
+	// 里程碑状态可以通过ListProposalProject查询
+	req := &auty.ReqQueryProposalProject{Addr: AddrA, Count: 1}
+	rsp, err := exec.Query(auty.ListProposalProject, types.Encode(req))
+	assert.NoError(t, err)
+	milestones := rsp.(*auty.ReplyQueryProposalProject).PropProjects[0].PropProject.Milestones
+	assert.Equal(t, 2, len(milestones))
+	assert.Equal(t, int32(auty.AutonomyStatusMilestoneRelease), milestones[0].Status)
+	assert.Equal(t, int32(auty.AutonomyStatusMilestoneRefund), milestones[1].Status)
+	assert.Equal(t, env.endHeight+1, milestones[1].RealBlockHeight)
+}
+
+func TestProjectMilestoneReject(t *testing.T) {
+	chainTestCfg.RegisterDappFork(auty.AutonomyX, auty.ForkAutonomyMilestone, 0)
+	env, exec, stateDB, kvdb := InitEnv()
+	InitBoard(stateDB)
+	InitFund(stateDB, testFundAmount)
+
+	prob := &auty.ProposalProject{
+		Amount:           testProjectAmount,
+		ToAddr:           AddrD,
+		StartBlockHeight: env.blockHeight + 5,
+		EndBlockHeight:   env.blockHeight + startEndBlockPeriod + 10,
+		Milestones: []*auty.ProjectMilestone{
+			{Amount: testProjectAmount / 2},
+			{Amount: testProjectAmount / 2},
+		},
+	}
+	propAction := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionPropProject,
+		Value: &auty.AutonomyAction_PropProject{PropProject: prob},
+	}
+	tx, err := execMilestoneTx(t, exec, stateDB, kvdb, propAction, PrivKeyA)
+	assert.NoError(t, err)
+	env.txHash = common.ToHex(tx.Hash())
+	env.startHeight = prob.StartBlockHeight
+	env.endHeight = prob.EndBlockHeight
+	voteProposalProject(t, env, exec, stateDB, kvdb, true)
+
+	// 11个董事会成员反对，赞成票已无法达到51%，项目失败
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC, PrivKeyD, PrivKey1, PrivKey2, PrivKey3, PrivKey4, PrivKey5, PrivKey6} {
+		_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteMilestoneAction(env.txHash, 0, false), key)
+		assert.NoError(t, err)
+	}
+	accCoin := account.NewCoinsAccount(chainTestCfg)
+	accCoin.SetDB(stateDB)
+	acc := accCoin.LoadExecAccount(AddrD, autonomyAddr)
+	assert.Equal(t, testProjectAmount, acc.Frozen)
+
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteMilestoneAction(env.txHash, 0, false), PrivKey7)
+	assert.NoError(t, err)
+	acc = accCoin.LoadExecAccount(AddrD, autonomyAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, int64(0), acc.Balance)
+
+	cur, err := newAction(exec.(*Autonomy), tx, 0).getProposalProject(env.txHash)
+	assert.NoError(t, err)
+	assert.False(t, cur.PropProject.Milestones[0].BoardVoteRes.Pass)
+	for _, ms := range cur.PropProject.Milestones {
+		assert.Equal(t, int32(auty.AutonomyStatusMilestoneRefund), ms.Status)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+)
+
+func (a *Autonomy) execAutoLocalParam(tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	set, err := a.execLocalParam(receiptData, index)
+	if err != nil {
+		return set, err
+	}
+	dbSet := &types.LocalDBSet{}
+	dbSet.KV = a.AddRollbackKV(tx, tx.Execer, set.KV)
+	return dbSet, nil
+}
+
+func (a *Autonomy) execLocalParam(receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	table := NewParamTable(a.GetLocalDB())
+	changeTable := NewParamChangeTable(a.GetLocalDB())
+	for _, log := range receiptData.Logs {
+		switch log.Ty {
+		case auty.TyLogPropParam,
+			auty.TyLogRvkPropParam,
+			auty.TyLogVotePropParam,
+			auty.TyLogPubVotePropParam,
+			auty.TyLogTmintPropParam:
+			{
+				var receipt auty.ReceiptProposalParam
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				err = table.Replace(receipt.Current)
+				if err != nil {
+					return nil, err
+				}
+				// 记录参数修改历史
+				if receipt.Current.Applied && (receipt.Prev == nil || !receipt.Prev.Applied) {
+					prop := receipt.Current.PropParam
+					err = changeTable.Replace(&auty.ParamChangeRecord{
+						Key:          prop.Key,
+						Values:       prop.Values,
+						ActiveHeight: prop.ActiveHeight,
+						ProposalID:   receipt.Current.ProposalID,
+						Height:       a.GetHeight(),
+						Index:        int32(index),
+					})
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		default:
+			break
+		}
+	}
+	kvs, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	changeKvs, err := changeTable.Save()
+	if err != nil {
+		return nil, err
+	}
+	dbSet := &types.LocalDBSet{}
+	dbSet.KV = append(dbSet.KV, kvs...)
+	dbSet.KV = append(dbSet.KV, changeKvs...)
+	return dbSet, nil
+}
+
+func (a *Autonomy) getProposalParam(req *types.ReqString) (types.Message, error) {
+	if req == nil {
+		return nil, types.ErrInvalidParam
+	}
+	value, err := a.GetStateDB().Get(propParamID(req.Data))
+	if err != nil {
+		return nil, err
+	}
+	prop := &auty.AutonomyProposalParam{}
+	err = types.Decode(value, prop)
+	if err != nil {
+		return nil, err
+	}
+	rep := &auty.ReplyQueryProposalParam{}
+	rep.PropParams = append(rep.PropParams, prop)
+	return rep, nil
+}
+
+func (a *Autonomy) listProposalParam(req *auty.ReqQueryProposalParam) (types.Message, error) {
+	if req == nil {
+		return nil, types.ErrInvalidParam
+	}
+	localDb := a.GetLocalDB()
+	query := NewParamTable(localDb).GetQuery(localDb)
+	var primary []byte
+	if req.Height > 0 {
+		primary = []byte(dapp.HeightIndexStr(req.Height, int64(req.Index)))
+	}
+	indexName := ""
+	if req.Status > 0 && req.Addr != "" {
+		indexName = "addr_status"
+	} else if req.Status > 0 {
+		indexName = "status"
+	} else if req.Addr != "" {
+		indexName = "addr"
+	}
+
+	cur := &ParamRow{
+		AutonomyProposalParam: &auty.AutonomyProposalParam{},
+	}
+	cur.Address = req.Addr
+	cur.Status = req.Status
+	cur.Height = req.Height
+	cur.Index = req.Index
+	prefix, err := cur.Get(indexName)
+	if err != nil {
+		alog.Error("Get", "indexName", indexName, "err", err)
+		return nil, err
+	}
+
+	rows, err := query.ListIndex(indexName, prefix, primary, req.Count, req.Direction)
+	if err != nil {
+		alog.Error("query List failed", "indexName", indexName, "prefix", "prefix", "key", string(primary), "err", err)
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, types.ErrNotFound
+	}
+
+	var rep auty.ReplyQueryProposalParam
+	for _, row := range rows {
+		r, ok := row.Data.(*auty.AutonomyProposalParam)
+		if !ok {
+			alog.Error("listProposalParam", "err", "bad row type")
+			return nil, types.ErrDecode
+		}
+		rep.PropParams = append(rep.PropParams, r)
+	}
+	return &rep, nil
+}
+
+func (a *Autonomy) getAutonomyParam(req *types.ReqString) (types.Message, error) {
+	if req == nil {
+		return nil, types.ErrInvalidParam
+	}
+	value, err := a.GetStateDB().Get(auty.ParamKey(req.Data))
+	if err != nil {
+		return nil, err
+	}
+	param := &auty.AutonomyParam{}
+	err = types.Decode(value, param)
+	if err != nil {
+		return nil, err
+	}
+	return param, nil
+}
+
+func (a *Autonomy) listParamChange(req *auty.ReqQueryParamChange) (types.Message, error) {
+	if req == nil {
+		return nil, types.ErrInvalidParam
+	}
+	localDb := a.GetLocalDB()
+	query := NewParamChangeTable(localDb).GetQuery(localDb)
+	var primary []byte
+	if req.Height > 0 {
+		primary = []byte(dapp.HeightIndexStr(req.Height, int64(req.Index)))
+	}
+	indexName := ""
+	if req.Key != "" {
+		indexName = "key"
+	}
+
+	cur := &ParamChangeRow{
+		ParamChangeRecord: &auty.ParamChangeRecord{Key: req.Key, Height: req.Height, Index: req.Index},
+	}
+	prefix, err := cur.Get(indexName)
+	if err != nil {
+		alog.Error("Get", "indexName", indexName, "err", err)
+		return nil, err
+	}
+
+	rows, err := query.ListIndex(indexName, prefix, primary, req.Count, req.Direction)
+	if err != nil {
+		alog.Error("query List failed", "indexName", indexName, "prefix", "prefix", "key", string(primary), "err", err)
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, types.ErrNotFound
+	}
+
+	var rep auty.ReplyQueryParamChange
+	for _, row := range rows {
+		r, ok := row.Data.(*auty.ParamChangeRecord)
+		if !ok {
+			alog.Error("listParamChange", "err", "bad row type")
+			return nil, types.ErrDecode
+		}
+		rep.Changes = append(rep.Changes, r)
+	}
+	return &rep, nil
+}
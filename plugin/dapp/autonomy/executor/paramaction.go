@@ -0,0 +1,489 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+)
+
+// 默认允许提案修改的链上配置项
+var defaultParamKeys = []string{
+	"tendermint-manager",
+	"oracle-publish-event",
+}
+
+func isParamKeyAllowed(key string) bool {
+	keys := defaultParamKeys
+	if len(subcfg.ParamKeys) > 0 {
+		keys = subcfg.ParamKeys
+	}
+	for _, k := range keys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *action) propParam(prob *auty.ProposalParam) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, auty.AutonomyX, auty.ForkAutonomyParam) {
+		return nil, auty.ErrAutonomyForkNotActive
+	}
+
+	if !isParamKeyAllowed(prob.Key) {
+		alog.Error("propParam ", "key", prob.Key, "error", auty.ErrParamKeyNotAllow)
+		return nil, auty.ErrParamKeyNotAllow
+	}
+
+	if prob.StartBlockHeight < a.height || prob.EndBlockHeight < a.height ||
+		prob.StartBlockHeight+startEndBlockPeriod > prob.EndBlockHeight {
+		alog.Error("propParam height invaild", "StartBlockHeight", prob.StartBlockHeight, "EndBlockHeight",
+			prob.EndBlockHeight, "height", a.height)
+		return nil, auty.ErrSetBlockHeight
+	}
+
+	if prob.ActiveHeight < prob.EndBlockHeight {
+		alog.Error("propParam active height invaild", "ActiveHeight", prob.ActiveHeight, "EndBlockHeight", prob.EndBlockHeight)
+		return nil, auty.ErrParamActiveHeight
+	}
+
+	// 获取董事会成员
+	pboard, err := a.getActiveBoard()
+	if err != nil {
+		alog.Error("propParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "get getActiveBoard failed", err)
+		return nil, err
+	}
+	// 获取当前生效提案规则
+	rule, err := a.getActiveRule()
+	if err != nil {
+		alog.Error("propParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "getActiveRule failed", err)
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	// 冻结提案金
+	receipt, err := a.coinsAccount.ExecFrozen(a.fromaddr, a.execaddr, rule.ProposalAmount)
+	if err != nil {
+		alog.Error("propParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "ExecFrozen proposal amount", rule.ProposalAmount, "error", err)
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kv = append(kv, receipt.KV...)
+
+	// 修改链上参数都需要经过公示期
+	cur := &auty.AutonomyProposalParam{
+		PropParam:    prob,
+		CurRule:      rule,
+		Boards:       pboard.Boards,
+		BoardVoteRes: &auty.VoteResult{TotalVotes: int32(len(pboard.Boards))},
+		PubVote:      &auty.PublicVote{Publicity: true},
+		Status:       auty.AutonomyStatusProposalParam,
+		Address:      a.fromaddr,
+		Height:       a.height,
+		Index:        a.index,
+		ProposalID:   common.ToHex(a.txhash),
+	}
+	kv = append(kv, &types.KeyValue{Key: propParamID(common.ToHex(a.txhash)), Value: types.Encode(cur)})
+	receiptLog := getParamReceiptLog(nil, cur, auty.TyLogPropParam)
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) rvkPropParam(rvkProb *auty.RevokeProposalParam) (*types.Receipt, error) {
+	cur, err := a.getProposalParam(rvkProb.ProposalID)
+	if err != nil {
+		alog.Error("rvkPropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "getProposalParam failed",
+			rvkProb.ProposalID, "err", err)
+		return nil, err
+	}
+	pre := copyAutonomyProposalParam(cur)
+
+	// 检查当前状态
+	if cur.Status != auty.AutonomyStatusProposalParam {
+		err := auty.ErrProposalStatus
+		alog.Error("rvkPropParam ", "addr", a.fromaddr, "status", cur.Status, "status is not match",
+			rvkProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	start := cur.GetPropParam().StartBlockHeight
+	if a.height >= start {
+		err := auty.ErrRevokeProposalPeriod
+		alog.Error("rvkPropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "ProposalID",
+			rvkProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	if a.fromaddr != cur.Address {
+		err := auty.ErrRevokeProposalPower
+		alog.Error("rvkPropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "ProposalID",
+			rvkProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	// 解冻提案金
+	receipt, err := a.coinsAccount.ExecActive(a.fromaddr, a.execaddr, cur.CurRule.ProposalAmount)
+	if err != nil {
+		alog.Error("rvkPropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "ExecActive amount", cur.CurRule.ProposalAmount, "err", err)
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kv = append(kv, receipt.KV...)
+
+	cur.Status = auty.AutonomyStatusRvkPropParam
+
+	kv = append(kv, &types.KeyValue{Key: propParamID(rvkProb.ProposalID), Value: types.Encode(cur)})
+
+	receiptLog := getParamReceiptLog(pre, cur, auty.TyLogRvkPropParam)
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) votePropParam(voteProb *auty.VoteProposalParam) (*types.Receipt, error) {
+	cur, err := a.getProposalParam(voteProb.ProposalID)
+	if err != nil {
+		alog.Error("votePropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "getProposalParam failed",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+	pre := copyAutonomyProposalParam(cur)
+
+	// 检查当前状态
+	if cur.Status != auty.AutonomyStatusProposalParam && cur.Status != auty.AutonomyStatusVotePropParam {
+		err := auty.ErrProposalStatus
+		alog.Error("votePropParam ", "addr", a.fromaddr, "status", cur.Status, "ProposalID",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	start := cur.GetPropParam().StartBlockHeight
+	end := cur.GetPropParam().EndBlockHeight
+	real := cur.GetPropParam().RealEndBlockHeight
+	if a.height < start || a.height > end || real != 0 {
+		err := auty.ErrVotePeriod
+		alog.Error("votePropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "ProposalID",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 董事会成员验证
+	var isBoard bool
+	for _, addr := range cur.Boards {
+		if addr == a.fromaddr {
+			isBoard = true
+			break
+		}
+	}
+	if !isBoard {
+		err = auty.ErrNoActiveBoard
+		alog.Error("votePropParam ", "addr", a.fromaddr, "this addr is not active board member",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 检查是否已经参与投票
+	votes, err := a.checkVotesRecord([]string{a.fromaddr}, boardVotesRecord(voteProb.ProposalID))
+	if err != nil {
+		alog.Error("votePropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "checkVotesRecord boardVotesRecord failed",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 更新已经投票地址
+	votes.Address = append(votes.Address, a.fromaddr)
+	// 更新投票结果
+	if voteProb.Approve {
+		cur.BoardVoteRes.ApproveVotes++
+	} else {
+		cur.BoardVoteRes.OpposeVotes++
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	// 首次进入投票期,即将提案金转入自治系统地址
+	if cur.Status == auty.AutonomyStatusProposalParam {
+		receipt, err := a.coinsAccount.ExecTransferFrozen(cur.Address, a.execaddr, a.execaddr, cur.CurRule.ProposalAmount)
+		if err != nil {
+			alog.Error("votePropParam ", "addr", cur.Address, "execaddr", a.execaddr, "ExecTransferFrozen amount fail", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+
+	ty := auty.TyLogVotePropParam
+	cur.Status = auty.AutonomyStatusVotePropParam
+	if cur.BoardVoteRes.TotalVotes != 0 &&
+		float32(cur.BoardVoteRes.ApproveVotes)/float32(cur.BoardVoteRes.TotalVotes) >= float32(cur.CurRule.BoardApproveRatio)/100.0 {
+		cur.BoardVoteRes.Pass = true
+		cur.PropParam.RealEndBlockHeight = a.height
+		// 进入公示期默认为该提案通过，只有反对票达到否决率才不会通过该提案
+		cur.Status = auty.AutonomyStatusPubVotePropParam
+		cur.PubVote.PubPass = true
+		ty = auty.TyLogPubVotePropParam
+	}
+
+	kv = append(kv, &types.KeyValue{Key: propParamID(voteProb.ProposalID), Value: types.Encode(cur)})
+	// 更新VotesRecord
+	kv = append(kv, &types.KeyValue{Key: boardVotesRecord(voteProb.ProposalID), Value: types.Encode(votes)})
+
+	receiptLog := getParamReceiptLog(pre, cur, int32(ty))
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) pubVotePropParam(voteProb *auty.PubVoteProposalParam) (*types.Receipt, error) {
+	cur, err := a.getProposalParam(voteProb.ProposalID)
+	if err != nil {
+		alog.Error("pubVotePropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "getProposalParam failed",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+	pre := copyAutonomyProposalParam(cur)
+
+	// 检查当前状态
+	if cur.Status != auty.AutonomyStatusPubVotePropParam {
+		err := auty.ErrProposalStatus
+		alog.Error("pubVotePropParam ", "addr", a.fromaddr, "status", cur.Status, "ProposalID",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 只能在公示期内否决
+	start := cur.GetPropParam().StartBlockHeight
+	if a.height > cur.PropParam.RealEndBlockHeight+int64(cur.CurRule.PublicPeriod) {
+		err := auty.ErrVotePeriod
+		alog.Error("pubVotePropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "ProposalID",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	if len(voteProb.OriginAddr) > 0 {
+		for _, board := range voteProb.OriginAddr {
+			if err := address.CheckAddress(board); err != nil {
+				alog.Error("pubVotePropParam ", "addr", board, "check toAddr error", err)
+				return nil, types.ErrInvalidAddress
+			}
+		}
+		// 挖矿地址验证
+		addr, err := a.verifyMinerAddr(voteProb.OriginAddr, a.fromaddr)
+		if err != nil {
+			alog.Error("pubVotePropParam ", "from addr", a.fromaddr, "error addr", addr, "ProposalID",
+				voteProb.ProposalID, "err", err)
+			return nil, err
+		}
+	}
+
+	// 本次参与投票地址
+	var addrs []string
+	if len(voteProb.OriginAddr) == 0 {
+		addrs = append(addrs, a.fromaddr)
+	} else {
+		addrs = append(addrs, voteProb.OriginAddr...)
+	}
+
+	// 检查是否已经参与投票
+	votes, err := a.checkVotesRecord(addrs, votesRecord(voteProb.ProposalID))
+	if err != nil {
+		alog.Error("pubVotePropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "checkVotesRecord failed",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+	// 更新投票记录
+	votes.Address = append(votes.Address, addrs...)
+
+	if cur.GetPubVote().TotalVotes == 0 { //需要统计总票数
+		vtCouts, err := a.getTotalVotes(start)
+		if err != nil {
+			return nil, err
+		}
+		cur.PubVote.TotalVotes = vtCouts
+	}
+
+	// 获取该地址票数
+	vtCouts, err := a.batchGetAddressVotes(addrs, start)
+	if err != nil {
+		alog.Error("pubVotePropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "batchGetAddressVotes failed",
+			voteProb.ProposalID, "err", err)
+		return nil, err
+	}
+	if voteProb.Oppose { //投反对票
+		cur.PubVote.OpposeVotes += vtCouts
+	}
+
+	ty := auty.TyLogPubVotePropParam
+	if cur.PubVote.TotalVotes != 0 &&
+		float32(cur.PubVote.OpposeVotes)/float32(cur.PubVote.TotalVotes) >= float32(cur.CurRule.PubOpposeRatio)/100.0 {
+		cur.PubVote.PubPass = false
+		cur.Status = auty.AutonomyStatusTmintPropParam
+		ty = auty.TyLogTmintPropParam
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	kv = append(kv, &types.KeyValue{Key: propParamID(voteProb.ProposalID), Value: types.Encode(cur)})
+	// 更新VotesRecord
+	kv = append(kv, &types.KeyValue{Key: votesRecord(voteProb.ProposalID), Value: types.Encode(votes)})
+
+	receiptLog := getParamReceiptLog(pre, cur, int32(ty))
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) tmintPropParam(tmintProb *auty.TerminateProposalParam) (*types.Receipt, error) {
+	cur, err := a.getProposalParam(tmintProb.ProposalID)
+	if err != nil {
+		alog.Error("tmintPropParam ", "addr", a.fromaddr, "execaddr", a.execaddr, "getProposalParam failed",
+			tmintProb.ProposalID, "err", err)
+		return nil, err
+	}
+	pre := copyAutonomyProposalParam(cur)
+
+	// 检查当前状态
+	if cur.Status == auty.AutonomyStatusTmintPropParam ||
+		cur.Status == auty.AutonomyStatusRvkPropParam {
+		err := auty.ErrProposalStatus
+		alog.Error("tmintPropParam ", "addr", a.fromaddr, "status", cur.Status, "status is not match",
+			tmintProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 公示期间不能终止
+	if cur.BoardVoteRes.Pass && a.height <= cur.PropParam.RealEndBlockHeight+int64(cur.CurRule.PublicPeriod) {
+		err := auty.ErrTerminatePeriod
+		alog.Error("tmintPropParam ", "addr", a.fromaddr, "status", cur.Status,
+			"in publicity vote period can not terminate", tmintProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	// 董事会投票期间不能终止
+	end := cur.GetPropParam().EndBlockHeight
+	if !cur.BoardVoteRes.Pass && a.height <= end {
+		err := auty.ErrTerminatePeriod
+		alog.Error("tmintPropParam ", "addr", a.fromaddr, "status", cur.Status, "height", a.height,
+			"in board vote period can not terminate", tmintProb.ProposalID, "err", err)
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	// 如果为提案状态，则扣除提案费
+	if cur.Status == auty.AutonomyStatusProposalParam {
+		receipt, err := a.coinsAccount.ExecTransferFrozen(cur.Address, a.execaddr, a.execaddr, cur.CurRule.ProposalAmount)
+		if err != nil {
+			alog.Error("tmintPropParam ", "addr", cur.Address, "execaddr", a.execaddr, "ExecTransferFrozen amount fail", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+
+	if cur.BoardVoteRes.Pass && cur.PubVote.PubPass {
+		// 董事会通过且全体持票人未否决，写入治理参数
+		kv = append(kv, a.applyParam(cur))
+		cur.Applied = true
+	} else {
+		cur.PropParam.RealEndBlockHeight = a.height
+	}
+	cur.Status = auty.AutonomyStatusTmintPropParam
+
+	kv = append(kv, &types.KeyValue{Key: propParamID(tmintProb.ProposalID), Value: types.Encode(cur)})
+
+	receiptLog := getParamReceiptLog(pre, cur, auty.TyLogTmintPropParam)
+	logs = append(logs, receiptLog)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// applyParam 写入治理参数，在生效高度之前继续使用之前的参数，同时记录当前manage配置的hash
+func (a *action) applyParam(cur *auty.AutonomyProposalParam) *types.KeyValue {
+	prob := cur.PropParam
+	param := &auty.AutonomyParam{
+		Key:          prob.Key,
+		Values:       prob.Values,
+		ActiveHeight: prob.ActiveHeight,
+		ProposalID:   cur.ProposalID,
+		ManageHash:   auty.ManageValueHash(a.api.GetConfig(), a.db, prob.Key, a.height),
+	}
+	value, err := a.db.Get(auty.ParamKey(prob.Key))
+	if err == nil {
+		old := &auty.AutonomyParam{}
+		if err = types.Decode(value, old); err == nil {
+			// 之前的修改尚未生效，则直接被本次修改覆盖
+			if a.height >= old.ActiveHeight {
+				old.Prev = nil
+				param.Prev = old
+			} else {
+				param.Prev = old.Prev
+			}
+		}
+	}
+	return &types.KeyValue{Key: auty.ParamKey(prob.Key), Value: types.Encode(param)}
+}
+
+func (a *action) getProposalParam(ID string) (*auty.AutonomyProposalParam, error) {
+	value, err := a.db.Get(propParamID(ID))
+	if err != nil {
+		return nil, err
+	}
+	cur := &auty.AutonomyProposalParam{}
+	err = types.Decode(value, cur)
+	if err != nil {
+		return nil, err
+	}
+	return cur, nil
+}
+
+// getParamReceiptLog 根据提案信息获取log
+func getParamReceiptLog(pre, cur *auty.AutonomyProposalParam, ty int32) *types.ReceiptLog {
+	log := &types.ReceiptLog{}
+	log.Ty = ty
+	r := &auty.ReceiptProposalParam{Prev: pre, Current: cur}
+	log.Log = types.Encode(r)
+	return log
+}
+
+func copyAutonomyProposalParam(cur *auty.AutonomyProposalParam) *auty.AutonomyProposalParam {
+	if cur == nil {
+		return nil
+	}
+	newAut := *cur
+	if cur.PropParam != nil {
+		newParam := *cur.GetPropParam()
+		newAut.PropParam = &newParam
+	}
+	if cur.CurRule != nil {
+		newRule := *cur.GetCurRule()
+		newAut.CurRule = &newRule
+	}
+	if len(cur.Boards) > 0 {
+		newAut.Boards = make([]string, len(cur.Boards))
+		copy(newAut.Boards, cur.Boards)
+	}
+	if cur.BoardVoteRes != nil {
+		newRes := *cur.GetBoardVoteRes()
+		newAut.BoardVoteRes = &newRes
+	}
+	if cur.PubVote != nil {
+		newPub := *cur.GetPubVote()
+		newAut.PubVote = &newPub
+	}
+	return &newAut
+}
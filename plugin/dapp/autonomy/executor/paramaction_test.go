@@ -0,0 +1,142 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposalParam(t *testing.T) {
+	chainTestCfg.RegisterDappFork(auty.AutonomyX, auty.ForkAutonomyParam, 0)
+	env, exec, stateDB, kvdb := InitEnv()
+	InitBoard(stateDB)
+	InitRule(stateDB)
+	setManageConfig(stateDB, "tendermint-manager", AddrA)
+
+	prob := &auty.ProposalParam{
+		Key:              "not-allowed-key",
+		Values:           []string{AddrB},
+		StartBlockHeight: env.blockHeight + 5,
+		EndBlockHeight:   env.blockHeight + startEndBlockPeriod + 10,
+	}
+	prob.ActiveHeight = prob.EndBlockHeight + int64(publicPeriod) + 100
+	propAction := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionPropParam,
+		Value: &auty.AutonomyAction_PropParam{PropParam: prob},
+	}
+	// 不在白名单内的配置项不能提案修改
+	_, err := execMilestoneTx(t, exec, stateDB, kvdb, propAction, PrivKeyA)
+	assert.Equal(t, auty.ErrParamKeyNotAllow, err)
+
+	prob.Key = "tendermint-manager"
+	activeHeight := prob.ActiveHeight
+	prob.ActiveHeight = prob.EndBlockHeight - 1
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, propAction, PrivKeyA)
+	assert.Equal(t, auty.ErrParamActiveHeight, err)
+
+	prob.ActiveHeight = activeHeight
+	tx, err := execMilestoneTx(t, exec, stateDB, kvdb, propAction, PrivKeyA)
+	assert.NoError(t, err)
+	proposalID := common.ToHex(tx.Hash())
+
+	// 21个董事会成员中11个赞成，进入公示期
+	exec.SetEnv(prob.StartBlockHeight+1, env.blockTime, env.difficulty)
+	voteAction := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionVotePropParam,
+		Value: &auty.AutonomyAction_VotePropParam{VotePropParam: &auty.VoteProposalParam{ProposalID: proposalID, Approve: true}},
+	}
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC, PrivKeyD, PrivKey1, PrivKey2, PrivKey3, PrivKey4, PrivKey5, PrivKey6, PrivKey7} {
+		_, err = execMilestoneTx(t, exec, stateDB, kvdb, voteAction, key)
+		assert.NoError(t, err)
+	}
+	cur, err := newAction(exec.(*Autonomy), tx, 0).getProposalParam(proposalID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(auty.AutonomyStatusPubVotePropParam), cur.Status)
+	assert.True(t, cur.BoardVoteRes.Pass)
+
+	// 公示期内不能终止
+	tmintAction := &auty.AutonomyAction{
+		Ty:    auty.AutonomyActionTmintPropParam,
+		Value: &auty.AutonomyAction_TmintPropParam{TmintPropParam: &auty.TerminateProposalParam{ProposalID: proposalID}},
+	}
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, tmintAction, PrivKeyA)
+	assert.Equal(t, auty.ErrTerminatePeriod, err)
+
+	// 公示期结束后终止，参数写入自治合约
+	tmintHeight := cur.PropParam.RealEndBlockHeight + int64(publicPeriod) + 1
+	exec.SetEnv(tmintHeight, env.blockTime, env.difficulty)
+	_, err = execMilestoneTx(t, exec, stateDB, kvdb, tmintAction, PrivKeyA)
+	assert.NoError(t, err)
+	cur, err = newAction(exec.(*Autonomy), tx, 0).getProposalParam(proposalID)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(auty.AutonomyStatusTmintPropParam), cur.Status)
+	assert.True(t, cur.Applied)
+
+	// 生效高度之前仍使用manage中的配置
+	values, err := auty.GetConfigValues(chainTestCfg, stateDB, "tendermint-manager", activeHeight-1, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{AddrA}, values)
+	values, err = auty.GetConfigValues(chainTestCfg, stateDB, "tendermint-manager", activeHeight, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{AddrB}, values)
+
+	rsp, err := exec.Query(auty.GetAutonomyParam, types.Encode(&types.ReqString{Data: "tendermint-manager"}))
+	assert.NoError(t, err)
+	assert.Equal(t, proposalID, rsp.(*auty.AutonomyParam).ProposalID)
+
+	rsp, err = exec.Query(auty.ListParamChange, types.Encode(&auty.ReqQueryParamChange{Key: "tendermint-manager", Count: 10}))
+	assert.NoError(t, err)
+	changes := rsp.(*auty.ReplyQueryParamChange).Changes
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, proposalID, changes[0].ProposalID)
+	assert.Equal(t, activeHeight, changes[0].ActiveHeight)
+	assert.Equal(t, tmintHeight, changes[0].Height)
+
+	rsp, err = exec.Query(auty.ListProposalParam, types.Encode(&auty.ReqQueryProposalParam{Status: auty.AutonomyStatusTmintPropParam, Count: 10}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rsp.(*auty.ReplyQueryProposalParam).PropParams))
+
+	// 提案生效后manage再次修改, 以manage为准
+	setManageConfig(stateDB, "tendermint-manager", AddrC)
+	values, err = auty.GetConfigValues(chainTestCfg, stateDB, "tendermint-manager", activeHeight, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{AddrC}, values)
+}
+
+func setManageConfig(stateDB dbm.KV, key string, values ...string) {
+	item := &types.ConfigItem{
+		Key:   types.ManageKey(key),
+		Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: values}},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+}
+
+func TestGetConfigValuesConfigKey(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(auty.AutonomyX, auty.ForkAutonomyParam, 10)
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	item := &types.ConfigItem{
+		Key:   types.ConfigKey("oracle-publish-event"),
+		Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{AddrA}}},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+
+	// 分叉之前只有原来读取 config- 的执行器回退读取
+	_, err := auty.GetConfigValues(cfg, stateDB, "oracle-publish-event", 9, false)
+	assert.Equal(t, types.ErrNotFound, err)
+	values, err := auty.GetConfigValues(cfg, stateDB, "oracle-publish-event", 9, true)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{AddrA}, values)
+	values, err = auty.GetConfigValues(cfg, stateDB, "oracle-publish-event", 10, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{AddrA}, values)
+}
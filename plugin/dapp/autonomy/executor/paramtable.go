@@ -0,0 +1,130 @@
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/db"
+
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
+)
+
+/*
+table  struct
+data:  autonomy param
+index: status, addr
+*/
+
+var paramOpt = &table.Option{
+	Prefix:  "LODB-autonomy",
+	Name:    "param",
+	Primary: "heightindex",
+	Index:   []string{"addr", "status", "addr_status"},
+}
+
+//NewParamTable 新建表
+func NewParamTable(kvdb db.KV) *table.Table {
+	rowmeta := NewParamRow()
+	table, err := table.NewTable(rowmeta, kvdb, paramOpt)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//ParamRow table meta 结构
+type ParamRow struct {
+	*auty.AutonomyProposalParam
+}
+
+//NewParamRow 新建一个meta 结构
+func NewParamRow() *ParamRow {
+	return &ParamRow{AutonomyProposalParam: &auty.AutonomyProposalParam{}}
+}
+
+//CreateRow 新建数据行(注意index 数据一定也要保存到数据中,不能就保存heightindex)
+func (r *ParamRow) CreateRow() *table.Row {
+	return &table.Row{Data: &auty.AutonomyProposalParam{}}
+}
+
+//SetPayload 设置数据
+func (r *ParamRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*auty.AutonomyProposalParam); ok {
+		r.AutonomyProposalParam = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (r *ParamRow) Get(key string) ([]byte, error) {
+	if key == "heightindex" {
+		return []byte(dapp.HeightIndexStr(r.Height, int64(r.Index))), nil
+	} else if key == "status" {
+		return []byte(fmt.Sprintf("%2d", r.Status)), nil
+	} else if key == "addr" {
+		return []byte(r.Address), nil
+	} else if key == "addr_status" {
+		return []byte(fmt.Sprintf("%s:%2d", r.Address, r.Status)), nil
+	}
+	return nil, types.ErrNotFound
+}
+
+/*
+table  struct
+data:  autonomy param change history
+index: key
+*/
+
+var paramChangeOpt = &table.Option{
+	Prefix:  "LODB-autonomy",
+	Name:    "paramchange",
+	Primary: "heightindex",
+	Index:   []string{"key"},
+}
+
+//NewParamChangeTable 新建表
+func NewParamChangeTable(kvdb db.KV) *table.Table {
+	rowmeta := NewParamChangeRow()
+	table, err := table.NewTable(rowmeta, kvdb, paramChangeOpt)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//ParamChangeRow table meta 结构
+type ParamChangeRow struct {
+	*auty.ParamChangeRecord
+}
+
+//NewParamChangeRow 新建一个meta 结构
+func NewParamChangeRow() *ParamChangeRow {
+	return &ParamChangeRow{ParamChangeRecord: &auty.ParamChangeRecord{}}
+}
+
+//CreateRow 新建数据行
+func (r *ParamChangeRow) CreateRow() *table.Row {
+	return &table.Row{Data: &auty.ParamChangeRecord{}}
+}
+
+//SetPayload 设置数据
+func (r *ParamChangeRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*auty.ParamChangeRecord); ok {
+		r.ParamChangeRecord = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (r *ParamChangeRow) Get(key string) ([]byte, error) {
+	if key == "heightindex" {
+		return []byte(dapp.HeightIndexStr(r.Height, int64(r.Index))), nil
+	} else if key == "key" {
+		return []byte(r.Key), nil
+	}
+	return nil, types.ErrNotFound
+}
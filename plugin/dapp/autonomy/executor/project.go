@@ -28,7 +28,9 @@ func (a *Autonomy) execLocalProject(receiptData *types.ReceiptData) (*types.Loca
 			auty.TyLogRvkPropProject,
 			auty.TyLogVotePropProject,
 			auty.TyLogPubVotePropProject,
-			auty.TyLogTmintPropProject:
+			auty.TyLogTmintPropProject,
+			auty.TyLogVoteMilestone,
+			auty.TyLogTmintMilestone:
 			{
 				var receipt auty.ReceiptProposalProject
 				err := types.Decode(log.Log, &receipt)
@@ -36,6 +36,11 @@ func (a *action) propProject(prob *auty.ProposalProject) (*types.Receipt, error)
 		return nil, err
 	}
 
+	if err := a.checkMilestones(prob); err != nil {
+		alog.Error("propProject milestones invaild", "amount", prob.Amount, "milestones", len(prob.Milestones), "error", err)
+		return nil, err
+	}
+
 	// 获取董事会成员
 	pboard, err := a.getActiveBoard()
 	if err != nil {
@@ -154,6 +159,7 @@ func (a *action) rvkPropProject(rvkProb *auty.RevokeProposalProject) (*types.Rec
 	kv = append(kv, receipt.KV...)
 
 	cur.Status = auty.AutonomyStatusRvkPropProject
+	cancelMilestones(cur)
 
 	kv = append(kv, &types.KeyValue{Key: propProjectID(rvkProb.ProposalID), Value: types.Encode(cur)})
 
@@ -254,7 +260,7 @@ func (a *action) votePropProject(voteProb *auty.VoteProposalProject) (*types.Rec
 		} else {
 			cur.Status = auty.AutonomyStatusTmintPropProject
 			// 提案通过，将工程金额从基金付款给承包商
-			receipt, err := a.coinsAccount.ExecDeposit(cur.PropProject.ToAddr, a.execaddr, cur.PropProject.Amount)
+			receipt, err := a.payProject(cur)
 			if err != nil {
 				alog.Error("votePropProject ", "addr", cur.PropProject.ToAddr, "execaddr", a.execaddr, "Transfer to contractor project amount fail", err)
 				return nil, err
@@ -384,6 +390,7 @@ func (a *action) pubVotePropProject(voteProb *auty.PubVoteProposalProject) (*typ
 	if !cur.PubVote.PubPass {
 		cur.Status = auty.AutonomyStatusTmintPropProject
 		ty = auty.TyLogTmintPropProject
+		cancelMilestones(cur)
 	}
 	kv = append(kv, &types.KeyValue{Key: key, Value: types.Encode(cur)})
 
@@ -473,7 +480,7 @@ func (a *action) tmintPropProject(tmintProb *auty.TerminateProposalProject) (*ty
 	if (cur.PubVote.Publicity && cur.PubVote.PubPass) || // 需要公示且公示通过
 		(!cur.PubVote.Publicity && cur.BoardVoteRes.Pass) { // 不需要公示且董事会通过
 		// 提案通过，将工程金额从基金付款给承包商
-		receipt, err := a.coinsAccount.ExecDeposit(cur.PropProject.ToAddr, a.execaddr, cur.PropProject.Amount)
+		receipt, err := a.payProject(cur)
 		if err != nil {
 			alog.Error("tmintPropProject ", "addr", cur.PropProject.ToAddr, "execaddr", a.execaddr, "Transfer to contractor project amount fail", err)
 			return nil, err
@@ -488,6 +495,8 @@ func (a *action) tmintPropProject(tmintProb *auty.TerminateProposalProject) (*ty
 			return nil, err
 		}
 		kv = append(kv, pakv)
+	} else {
+		cancelMilestones(cur)
 	}
 
 	cur.Status = auty.AutonomyStatusTmintPropProject
@@ -548,6 +557,17 @@ func copyAutonomyProposalProject(cur *auty.AutonomyProposalProject) *auty.Autono
 	if cur.PropProject != nil {
 		newProject := *cur.GetPropProject()
 		newAut.PropProject = &newProject
+		if len(cur.PropProject.Milestones) > 0 {
+			newProject.Milestones = make([]*auty.ProjectMilestone, len(cur.PropProject.Milestones))
+			for i, ms := range cur.PropProject.Milestones {
+				newMs := *ms
+				if ms.BoardVoteRes != nil {
+					newRes := *ms.BoardVoteRes
+					newMs.BoardVoteRes = &newRes
+				}
+				newProject.Milestones[i] = &newMs
+			}
+		}
 	}
 	if cur.CurRule != nil {
 		newRule := *cur.GetCurRule()
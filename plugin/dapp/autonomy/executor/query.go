@@ -63,3 +63,23 @@ func (a *Autonomy) Query_GetProposalChange(in *types.ReqString) (types.Message,
 func (a *Autonomy) Query_ListProposalChange(in *auty.ReqQueryProposalChange) (types.Message, error) {
 	return a.listProposalChange(in)
 }
+
+// Query_GetProposalParam 查询参数修改提案
+func (a *Autonomy) Query_GetProposalParam(in *types.ReqString) (types.Message, error) {
+	return a.getProposalParam(in)
+}
+
+// Query_ListProposalParam 批量查询参数修改提案
+func (a *Autonomy) Query_ListProposalParam(in *auty.ReqQueryProposalParam) (types.Message, error) {
+	return a.listProposalParam(in)
+}
+
+// Query_GetAutonomyParam 查询提案设置的链上配置参数
+func (a *Autonomy) Query_GetAutonomyParam(in *types.ReqString) (types.Message, error) {
+	return a.getAutonomyParam(in)
+}
+
+// Query_ListParamChange 查询链上配置参数修改历史
+func (a *Autonomy) Query_ListParamChange(in *auty.ReqQueryParamChange) (types.Message, error) {
+	return a.listParamChange(in)
+}
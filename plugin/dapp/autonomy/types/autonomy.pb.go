@@ -43,6 +43,13 @@ type AutonomyAction struct {
 	//	*AutonomyAction_RvkPropChange
 	//	*AutonomyAction_VotePropChange
 	//	*AutonomyAction_TmintPropChange
+	//	*AutonomyAction_VoteMilestone
+	//	*AutonomyAction_TmintMilestone
+	//	*AutonomyAction_PropParam
+	//	*AutonomyAction_RvkPropParam
+	//	*AutonomyAction_VotePropParam
+	//	*AutonomyAction_PubVotePropParam
+	//	*AutonomyAction_TmintPropParam
 	Value                isAutonomyAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,20,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -155,6 +162,34 @@ type AutonomyAction_TmintPropChange struct {
 	TmintPropChange *TerminateProposalChange `protobuf:"bytes,19,opt,name=tmintPropChange,proto3,oneof"`
 }
 
+type AutonomyAction_VoteMilestone struct {
+	VoteMilestone *VoteProjectMilestone `protobuf:"bytes,21,opt,name=voteMilestone,proto3,oneof"`
+}
+
+type AutonomyAction_TmintMilestone struct {
+	TmintMilestone *TerminateProjectMilestone `protobuf:"bytes,22,opt,name=tmintMilestone,proto3,oneof"`
+}
+
+type AutonomyAction_PropParam struct {
+	PropParam *ProposalParam `protobuf:"bytes,23,opt,name=propParam,proto3,oneof"`
+}
+
+type AutonomyAction_RvkPropParam struct {
+	RvkPropParam *RevokeProposalParam `protobuf:"bytes,24,opt,name=rvkPropParam,proto3,oneof"`
+}
+
+type AutonomyAction_VotePropParam struct {
+	VotePropParam *VoteProposalParam `protobuf:"bytes,25,opt,name=votePropParam,proto3,oneof"`
+}
+
+type AutonomyAction_PubVotePropParam struct {
+	PubVotePropParam *PubVoteProposalParam `protobuf:"bytes,26,opt,name=pubVotePropParam,proto3,oneof"`
+}
+
+type AutonomyAction_TmintPropParam struct {
+	TmintPropParam *TerminateProposalParam `protobuf:"bytes,27,opt,name=tmintPropParam,proto3,oneof"`
+}
+
 func (*AutonomyAction_PropBoard) isAutonomyAction_Value() {}
 
 func (*AutonomyAction_RvkPropBoard) isAutonomyAction_Value() {}
@@ -193,6 +228,20 @@ func (*AutonomyAction_VotePropChange) isAutonomyAction_Value() {}
 
 func (*AutonomyAction_TmintPropChange) isAutonomyAction_Value() {}
 
+func (*AutonomyAction_VoteMilestone) isAutonomyAction_Value() {}
+
+func (*AutonomyAction_TmintMilestone) isAutonomyAction_Value() {}
+
+func (*AutonomyAction_PropParam) isAutonomyAction_Value() {}
+
+func (*AutonomyAction_RvkPropParam) isAutonomyAction_Value() {}
+
+func (*AutonomyAction_VotePropParam) isAutonomyAction_Value() {}
+
+func (*AutonomyAction_PubVotePropParam) isAutonomyAction_Value() {}
+
+func (*AutonomyAction_TmintPropParam) isAutonomyAction_Value() {}
+
 func (m *AutonomyAction) GetValue() isAutonomyAction_Value {
 	if m != nil {
 		return m.Value
@@ -333,6 +382,55 @@ func (m *AutonomyAction) GetTmintPropChange() *TerminateProposalChange {
 	return nil
 }
 
+func (m *AutonomyAction) GetVoteMilestone() *VoteProjectMilestone {
+	if x, ok := m.GetValue().(*AutonomyAction_VoteMilestone); ok {
+		return x.VoteMilestone
+	}
+	return nil
+}
+
+func (m *AutonomyAction) GetTmintMilestone() *TerminateProjectMilestone {
+	if x, ok := m.GetValue().(*AutonomyAction_TmintMilestone); ok {
+		return x.TmintMilestone
+	}
+	return nil
+}
+
+func (m *AutonomyAction) GetPropParam() *ProposalParam {
+	if x, ok := m.GetValue().(*AutonomyAction_PropParam); ok {
+		return x.PropParam
+	}
+	return nil
+}
+
+func (m *AutonomyAction) GetRvkPropParam() *RevokeProposalParam {
+	if x, ok := m.GetValue().(*AutonomyAction_RvkPropParam); ok {
+		return x.RvkPropParam
+	}
+	return nil
+}
+
+func (m *AutonomyAction) GetVotePropParam() *VoteProposalParam {
+	if x, ok := m.GetValue().(*AutonomyAction_VotePropParam); ok {
+		return x.VotePropParam
+	}
+	return nil
+}
+
+func (m *AutonomyAction) GetPubVotePropParam() *PubVoteProposalParam {
+	if x, ok := m.GetValue().(*AutonomyAction_PubVotePropParam); ok {
+		return x.PubVotePropParam
+	}
+	return nil
+}
+
+func (m *AutonomyAction) GetTmintPropParam() *TerminateProposalParam {
+	if x, ok := m.GetValue().(*AutonomyAction_TmintPropParam); ok {
+		return x.TmintPropParam
+	}
+	return nil
+}
+
 func (m *AutonomyAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -362,6 +460,13 @@ func (*AutonomyAction) XXX_OneofWrappers() []interface{} {
 		(*AutonomyAction_RvkPropChange)(nil),
 		(*AutonomyAction_VotePropChange)(nil),
 		(*AutonomyAction_TmintPropChange)(nil),
+		(*AutonomyAction_VoteMilestone)(nil),
+		(*AutonomyAction_TmintMilestone)(nil),
+		(*AutonomyAction_PropParam)(nil),
+		(*AutonomyAction_RvkPropParam)(nil),
+		(*AutonomyAction_VotePropParam)(nil),
+		(*AutonomyAction_PubVotePropParam)(nil),
+		(*AutonomyAction_TmintPropParam)(nil),
 	}
 }
 
@@ -374,37 +479,44 @@ func init() {
 }
 
 var fileDescriptor_0246b47df8434d60 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x40, 0xd3, 0x40, 0xfa, 0x31, 0x8e, 0x9d, 0x32, 0x2d, 0x60, 0xc2, 0x57, 0xc5, 0xa9, 0xa7,
-	0x48, 0x14, 0x24, 0x24, 0xa4, 0x4a, 0x6d, 0x83, 0x42, 0x84, 0x84, 0x88, 0xac, 0xaa, 0x77, 0x27,
-	0x2c, 0x10, 0x1a, 0x7b, 0xad, 0xcd, 0x3a, 0x52, 0x7e, 0x00, 0xff, 0x1b, 0x79, 0x3c, 0x5e, 0x7b,
-	0x37, 0xee, 0x2d, 0xf6, 0xce, 0x7b, 0xd1, 0x3c, 0x27, 0x86, 0x20, 0xce, 0xb5, 0x4c, 0x65, 0xb2,
-	0x1d, 0x65, 0x4a, 0x6a, 0x89, 0x3d, 0xbd, 0xcd, 0xc4, 0x7a, 0xe8, 0xcd, 0x65, 0xac, 0x7e, 0x96,
-	0xf7, 0x86, 0x7e, 0xa6, 0xe4, 0x5f, 0xb1, 0xd0, 0x7c, 0x09, 0x2a, 0x5f, 0x09, 0xfe, 0xdc, 0x5f,
-	0xfc, 0x89, 0xd3, 0xdf, 0x7c, 0xf5, 0xee, 0x1f, 0x40, 0x70, 0xcd, 0xbe, 0xeb, 0x85, 0x5e, 0xca,
-	0x14, 0x3f, 0xc2, 0x51, 0xa6, 0x64, 0x76, 0x53, 0xe8, 0xc2, 0xbd, 0xb3, 0xbd, 0x73, 0xef, 0xe2,
-	0x74, 0x44, 0xdf, 0x31, 0x9a, 0x29, 0x99, 0xc9, 0x75, 0xbc, 0xa2, 0xb3, 0x69, 0x27, 0xaa, 0x07,
-	0xf1, 0x0a, 0xfa, 0x6a, 0x73, 0x3f, 0x33, 0x60, 0x97, 0xc0, 0x21, 0x83, 0x91, 0xd8, 0xc8, 0x7b,
-	0xe1, 0xe2, 0x16, 0x81, 0x57, 0xe0, 0x6f, 0xa4, 0x16, 0xb5, 0xe2, 0x11, 0x29, 0x42, 0x56, 0xdc,
-	0xf1, 0x59, 0x53, 0x60, 0x03, 0xf8, 0x15, 0x02, 0x9d, 0x2c, 0x53, 0x5d, 0x2b, 0x1e, 0x93, 0xe2,
-	0x35, 0x2b, 0x6e, 0x85, 0x4a, 0x96, 0x69, 0xbc, 0xeb, 0x71, 0x30, 0xfc, 0x0c, 0x5e, 0xb1, 0xd9,
-	0xac, 0x8c, 0x18, 0xf6, 0xc8, 0xf2, 0xcc, 0x89, 0xc0, 0xa7, 0xd3, 0x4e, 0xd4, 0x1c, 0xc6, 0x09,
-	0x04, 0xbc, 0x56, 0x85, 0xef, 0x13, 0xfe, 0xaa, 0x35, 0x45, 0x2d, 0x71, 0x28, 0x9c, 0xc0, 0xa0,
-	0xda, 0xae, 0x12, 0x1d, 0x58, 0x4d, 0x9b, 0x41, 0x6a, 0x8d, 0x0b, 0xe1, 0x0f, 0xc0, 0x2c, 0x9f,
-	0xdf, 0x39, 0xaa, 0x43, 0x2b, 0xcc, 0xac, 0x1e, 0xb0, 0x6d, 0x2d, 0x28, 0x7e, 0x87, 0x63, 0x93,
-	0xab, 0xd2, 0x1d, 0x91, 0xee, 0xed, 0x43, 0x9d, 0x6b, 0xe1, 0x0e, 0x8a, 0xef, 0xe1, 0xb0, 0xc8,
-	0x17, 0xe5, 0x2b, 0x11, 0x02, 0x69, 0x4e, 0x9c, 0xd0, 0xc5, 0xd1, 0xb4, 0x13, 0x99, 0x31, 0xbc,
-	0x04, 0x8f, 0x63, 0x11, 0xe5, 0x11, 0xf5, 0xa2, 0xb5, 0x2f, 0xb3, 0xcd, 0x79, 0xbc, 0x84, 0x7e,
-	0x15, 0x89, 0xf8, 0x3e, 0xf1, 0xcf, 0x5b, 0xb2, 0x32, 0x6d, 0x8d, 0xe3, 0x17, 0xf0, 0xcd, 0x12,
-	0xc4, 0xfb, 0xd6, 0xf3, 0xdd, 0x59, 0x9e, 0x25, 0x36, 0x54, 0xac, 0xad, 0x55, 0x9c, 0xae, 0x7f,
-	0x09, 0x15, 0x06, 0xd6, 0xda, 0xb7, 0x7c, 0x7b, 0x92, 0xa7, 0xc5, 0x6f, 0xd3, 0x8c, 0xe1, 0x05,
-	0x78, 0x0b, 0x99, 0x24, 0xa2, 0xb4, 0x84, 0x03, 0xa2, 0x02, 0xa6, 0xc6, 0xe5, 0x49, 0xb1, 0x6b,
-	0x63, 0x08, 0x3f, 0x01, 0x14, 0xd9, 0xc6, 0xf4, 0x9f, 0x0f, 0x8f, 0x09, 0x79, 0xea, 0xf4, 0x2d,
-	0x0f, 0xa7, 0x9d, 0xa8, 0x31, 0x8a, 0x63, 0xf0, 0xb9, 0x19, 0xb3, 0x4f, 0x88, 0x7d, 0xd9, 0x5a,
-	0xd9, 0x18, 0x6c, 0x06, 0xc7, 0x10, 0x54, 0xe9, 0xd8, 0x82, 0xd6, 0xb3, 0x6a, 0xb6, 0x36, 0x0e,
-	0x07, 0xc1, 0x6f, 0x30, 0x30, 0xe9, 0xd8, 0x72, 0x42, 0x96, 0x37, 0x0f, 0x15, 0x37, 0x2a, 0x17,
-	0xc4, 0x00, 0xba, 0x7a, 0x1b, 0x9e, 0x9e, 0xed, 0x9d, 0xf7, 0xa2, 0xae, 0xde, 0xde, 0x1c, 0x40,
-	0x6f, 0x13, 0xaf, 0x72, 0x31, 0xdf, 0xa7, 0xd7, 0xe1, 0x87, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x43, 0xb3, 0xd4, 0x92, 0x5d, 0x05, 0x00, 0x00,
+	// 612 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x5d, 0x6f, 0xda, 0x30,
+	0x14, 0x86, 0x29, 0x1b, 0xfd, 0x38, 0x21, 0x81, 0x9d, 0x7e, 0xa5, 0x74, 0x1f, 0x68, 0x57, 0xbd,
+	0x42, 0x5a, 0x37, 0x69, 0xd2, 0xa4, 0x4a, 0x6d, 0x99, 0x18, 0xab, 0x54, 0x0d, 0x45, 0x55, 0xef,
+	0x03, 0xf3, 0x36, 0x56, 0x12, 0x47, 0xc1, 0x41, 0xe2, 0xff, 0xee, 0x87, 0x4c, 0x3e, 0xb1, 0x93,
+	0xd8, 0x84, 0xde, 0xd5, 0xf1, 0x79, 0x9e, 0x72, 0x5e, 0xa2, 0x17, 0xf0, 0xc2, 0x4c, 0xf0, 0x98,
+	0x47, 0xeb, 0x41, 0x92, 0x72, 0xc1, 0xb1, 0x25, 0xd6, 0x09, 0x5b, 0xf6, 0x9c, 0x29, 0x0f, 0xd3,
+	0x9f, 0xf9, 0xb3, 0x9e, 0x9b, 0xa4, 0xfc, 0x2f, 0x9b, 0x09, 0x75, 0x84, 0x34, 0x5b, 0x30, 0xf5,
+	0x77, 0x7b, 0xf6, 0x27, 0x8c, 0x7f, 0xeb, 0x93, 0x93, 0x84, 0x69, 0x18, 0xe5, 0x87, 0xf7, 0xff,
+	0x5c, 0xf0, 0x6e, 0x94, 0xfc, 0x66, 0x26, 0xe6, 0x3c, 0xc6, 0x4f, 0x70, 0x90, 0xa4, 0x3c, 0xb9,
+	0x95, 0x6e, 0x7f, 0xa7, 0xbf, 0x73, 0xe1, 0x5c, 0x1e, 0x0d, 0xe8, 0x1f, 0x0e, 0x26, 0x29, 0x4f,
+	0xf8, 0x32, 0x5c, 0xd0, 0xdd, 0xb8, 0x11, 0x94, 0x83, 0x78, 0x0d, 0xed, 0x74, 0xf5, 0x34, 0x29,
+	0xc0, 0x26, 0x81, 0x3d, 0x05, 0x06, 0x6c, 0xc5, 0x9f, 0x98, 0x8d, 0x1b, 0x04, 0x5e, 0x83, 0xbb,
+	0xe2, 0x82, 0x95, 0x8a, 0x17, 0xa4, 0xf0, 0x95, 0xe2, 0x91, 0x8b, 0x0d, 0x81, 0x09, 0xe0, 0x37,
+	0xf0, 0x44, 0x34, 0x8f, 0x45, 0xa9, 0x78, 0x49, 0x8a, 0x37, 0x4a, 0xf1, 0xc0, 0xd2, 0x68, 0x1e,
+	0x87, 0x9b, 0x1e, 0x0b, 0xc3, 0x2f, 0xe0, 0xc8, 0xcd, 0x26, 0x79, 0xa2, 0x7e, 0x8b, 0x2c, 0x27,
+	0x56, 0x08, 0xea, 0x76, 0xdc, 0x08, 0xaa, 0xc3, 0x38, 0x02, 0x4f, 0xad, 0xa5, 0xf1, 0x5d, 0xc2,
+	0x5f, 0xd7, 0x46, 0x51, 0x4a, 0x2c, 0x0a, 0x47, 0xd0, 0xd1, 0xdb, 0x69, 0xd1, 0x9e, 0x91, 0x69,
+	0x35, 0x90, 0x52, 0x63, 0x43, 0xf8, 0x03, 0x30, 0xc9, 0xa6, 0x8f, 0x96, 0x6a, 0xdf, 0x08, 0x66,
+	0x92, 0x4d, 0xeb, 0x6d, 0x35, 0x28, 0xde, 0x43, 0xb7, 0x88, 0x4b, 0xeb, 0x0e, 0x48, 0xf7, 0x6e,
+	0x5b, 0xce, 0xa5, 0x70, 0x03, 0xc5, 0x0f, 0xb0, 0x2f, 0xe3, 0x0b, 0xb2, 0x05, 0xf3, 0x81, 0x34,
+	0x87, 0x56, 0xd0, 0xf2, 0x6a, 0xdc, 0x08, 0x8a, 0x31, 0xbc, 0x02, 0x47, 0x85, 0x45, 0x94, 0x43,
+	0xd4, 0x59, 0x6d, 0xbe, 0x8a, 0xad, 0xce, 0xe3, 0x15, 0xb4, 0x75, 0x48, 0xc4, 0xb7, 0x89, 0x3f,
+	0xad, 0x89, 0x55, 0xd1, 0xc6, 0x38, 0x7e, 0x05, 0xb7, 0x58, 0x82, 0x78, 0xd7, 0xf8, 0x7e, 0x37,
+	0x96, 0x57, 0x12, 0x13, 0x92, 0x6b, 0x8b, 0x34, 0x8c, 0x97, 0xbf, 0x58, 0xea, 0x7b, 0xc6, 0xda,
+	0x0f, 0xea, 0xf1, 0x28, 0x8b, 0xe5, 0xbb, 0x59, 0x8c, 0xe1, 0x25, 0x38, 0x33, 0x1e, 0x45, 0x2c,
+	0xb7, 0xf8, 0x1d, 0xa2, 0x3c, 0x45, 0x0d, 0xf3, 0x1b, 0xb9, 0x6b, 0x65, 0x08, 0x3f, 0x03, 0xc8,
+	0xd8, 0x86, 0x54, 0x00, 0x7e, 0x97, 0x90, 0x63, 0x2b, 0xdf, 0xfc, 0x72, 0xdc, 0x08, 0x2a, 0xa3,
+	0x38, 0x04, 0x57, 0x65, 0xa6, 0xd8, 0x57, 0xc4, 0x9e, 0xd7, 0xa6, 0x5c, 0x18, 0x4c, 0x06, 0x87,
+	0xe0, 0xe9, 0xe8, 0x94, 0x05, 0x8d, 0xef, 0xaa, 0x9a, 0x75, 0xe1, 0xb0, 0x10, 0xbc, 0x83, 0x4e,
+	0x11, 0x9d, 0xb2, 0x1c, 0x92, 0xe5, 0xed, 0xb6, 0xc4, 0x0b, 0x95, 0x0d, 0xca, 0xad, 0xa4, 0xfd,
+	0x7e, 0xbe, 0x60, 0x4b, 0xc1, 0x63, 0xe6, 0x1f, 0x1b, 0x5b, 0xa9, 0xcf, 0x23, 0xdf, 0xcb, 0x62,
+	0x44, 0xd7, 0x4c, 0xf1, 0x00, 0xef, 0x54, 0xcd, 0x94, 0x96, 0x13, 0xb2, 0xf4, 0x6b, 0x3e, 0x8f,
+	0xad, 0xb2, 0x48, 0x5d, 0xb6, 0x13, 0x59, 0xc9, 0xfe, 0x69, 0x6d, 0xd9, 0xd2, 0x9d, 0x2e, 0x5b,
+	0x3a, 0x54, 0xca, 0x36, 0x07, 0xfd, 0x67, 0xca, 0x56, 0xe3, 0x06, 0x51, 0x2d, 0xdb, 0x5c, 0x71,
+	0xb6, 0xb5, 0x6c, 0xb5, 0xc0, 0x04, 0xf0, 0x3b, 0x74, 0xab, 0xe5, 0x40, 0x92, 0x9e, 0x91, 0xa6,
+	0xdd, 0x2a, 0xca, 0xb3, 0x81, 0x19, 0xbd, 0x9d, 0x8b, 0xce, 0x9f, 0xef, 0x6d, 0xad, 0xb2, 0x30,
+	0xf4, 0xa0, 0x29, 0xd6, 0xfe, 0x51, 0x7f, 0xe7, 0xa2, 0x15, 0x34, 0xc5, 0xfa, 0x76, 0x0f, 0x5a,
+	0xab, 0x70, 0x91, 0xb1, 0xe9, 0x2e, 0xfd, 0xda, 0x7d, 0xfc, 0x3f, 0x00, 0x9c, 0x4e, 0x26, 0xbd,
+	0x49, 0x07, 0x00, 0x00,
 }
@@ -30,6 +30,15 @@ const (
 	AutonomyActionVotePropChange
 	AutonomyActionTmintPropChange
 
+	AutonomyActionVoteMilestone
+	AutonomyActionTmintMilestone
+
+	AutonomyActionPropParam
+	AutonomyActionRvkPropParam
+	AutonomyActionVotePropParam
+	AutonomyActionPubVotePropParam
+	AutonomyActionTmintPropParam
+
 	//log for autonomy
 	TyLogPropBoard      = 2101
 	TyLogRvkPropBoard   = 2102
@@ -41,6 +50,8 @@ const (
 	TyLogVotePropProject    = 2113
 	TyLogPubVotePropProject = 2114
 	TyLogTmintPropProject   = 2115
+	TyLogVoteMilestone      = 2116
+	TyLogTmintMilestone     = 2117
 
 	TyLogPropRule      = 2121
 	TyLogRvkPropRule   = 2122
@@ -53,6 +64,12 @@ const (
 	TyLogRvkPropChange   = 2142
 	TyLogVotePropChange  = 2143
 	TyLogTmintPropChange = 2144
+
+	TyLogPropParam        = 2151
+	TyLogRvkPropParam     = 2152
+	TyLogVotePropParam    = 2153
+	TyLogPubVotePropParam = 2154
+	TyLogTmintPropParam   = 2155
 )
 
 // Board status
@@ -72,6 +89,24 @@ const (
 	AutonomyStatusTmintPropProject
 )
 
+// Milestone status
+const (
+	AutonomyStatusMilestoneWait    = iota + 1 // 等待提案通过
+	AutonomyStatusMilestoneEscrow             // 资金已托管，等待董事会确认
+	AutonomyStatusMilestoneRelease            // 董事会确认，资金已支付
+	AutonomyStatusMilestoneRefund             // 项目失败或终止，托管资金已退回
+	AutonomyStatusMilestoneCancel             // 提案未通过，资金未托管
+)
+
+// Param status
+const (
+	AutonomyStatusProposalParam = iota + 1
+	AutonomyStatusRvkPropParam
+	AutonomyStatusVotePropParam
+	AutonomyStatusPubVotePropParam
+	AutonomyStatusTmintPropParam
+)
+
 // Rule status
 const (
 	AutonomyStatusProposalRule = iota + 1
@@ -111,6 +146,14 @@ const (
 	GetProposalChange = "GetProposalChange"
 	// ListProposalChange 查询多个
 	ListProposalChange = "ListProposalChange"
+	// GetProposalParam 用于在cmd里面的区分不同的查询
+	GetProposalParam = "GetProposalParam"
+	// ListProposalParam 查询多个
+	ListProposalParam = "ListProposalParam"
+	// GetAutonomyParam 查询当前的治理参数
+	GetAutonomyParam = "GetAutonomyParam"
+	// ListParamChange 查询治理参数修改历史
+	ListParamChange = "ListParamChange"
 )
 
 //包的名字可以通过配置文件来配置
@@ -122,3 +165,10 @@ var (
 	// TicketX 该模块需要查询ticket合约下的账户余额
 	TicketX = "ticket"
 )
+
+const (
+	// ForkAutonomyMilestone 提案项目支持里程碑分期支付
+	ForkAutonomyMilestone = "ForkAutonomyMilestone"
+	// ForkAutonomyParam 支持提案修改链上配置参数
+	ForkAutonomyParam = "ForkAutonomyParam"
+)
@@ -39,4 +39,14 @@ var (
 	ErrNotEnoughFund = errors.New("ErrNotEnoughFund")
 	// ErrSetBlockHeight block height not match
 	ErrSetBlockHeight = errors.New("ErrSetBlockHeight")
+	// ErrMilestoneAmount 里程碑经费与项目经费不符
+	ErrMilestoneAmount = errors.New("ErrMilestoneAmount")
+	// ErrMilestoneIndex 里程碑序号错误
+	ErrMilestoneIndex = errors.New("ErrMilestoneIndex")
+	// ErrAutonomyForkNotActive fork未生效
+	ErrAutonomyForkNotActive = errors.New("ErrAutonomyForkNotActive")
+	// ErrParamKeyNotAllow 配置项不在白名单中
+	ErrParamKeyNotAllow = errors.New("ErrParamKeyNotAllow")
+	// ErrParamActiveHeight 参数生效高度错误
+	ErrParamActiveHeight = errors.New("ErrParamActiveHeight")
 )
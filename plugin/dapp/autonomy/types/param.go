@@ -0,0 +1,72 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// ParamKey 治理参数在自治合约中的存储key
+func ParamKey(key string) []byte {
+	return []byte("mavl-" + AutonomyX + "-cfg-" + key)
+}
+
+// manage 中的配置, ForkExecKey 之前保存在 config- 下
+// ForkAutonomyParam 之后统一回退读取 config-, 之前只有 configKey 为 true 的执行器回退读取, 保持原来的规则
+func getManageValue(cfg *types.Chain33Config, db dbm.KV, key string, height int64, configKey bool) ([]byte, error) {
+	value, err := db.Get([]byte(types.ManageKey(key)))
+	if err != nil && (configKey || cfg.IsDappFork(height, AutonomyX, ForkAutonomyParam)) {
+		return db.Get([]byte(types.ConfigKey(key)))
+	}
+	return value, err
+}
+
+// ManageValueHash 当前 manage 中配置的hash, 提案生效时记录, 用于判断 manage 之后是否修改过
+func ManageValueHash(cfg *types.Chain33Config, db dbm.KV, key string, height int64) []byte {
+	value, _ := getManageValue(cfg, db, key, height, false)
+	return common.Sha256(value)
+}
+
+// 在height高度生效的治理参数
+func getParamValues(db dbm.KV, key string, height int64) (*AutonomyParam, bool) {
+	value, err := db.Get(ParamKey(key))
+	if err != nil {
+		return nil, false
+	}
+	param := &AutonomyParam{}
+	if err = types.Decode(value, param); err != nil {
+		return nil, false
+	}
+	if height < param.ActiveHeight {
+		param = param.Prev
+	}
+	if param == nil {
+		return nil, false
+	}
+	return param, true
+}
+
+// GetConfigValues 获取在height高度生效的链上配置, 自治提案和 manage 都可以修改, 以最后修改的为准
+// 提案修改之后 manage 又修改了该配置, 或者提案尚未生效时使用 manage 中的配置
+// configKey 表示分叉之前该执行器在 manage- 中没有配置时是否读取 config- 下的配置
+func GetConfigValues(cfg *types.Chain33Config, db dbm.KV, key string, height int64, configKey bool) ([]string, error) {
+	manageValue, manageErr := getManageValue(cfg, db, key, height, configKey)
+	param, ok := getParamValues(db, key, height)
+	if ok && bytes.Equal(param.ManageHash, common.Sha256(manageValue)) {
+		return param.Values, nil
+	}
+	if manageErr != nil {
+		return nil, manageErr
+	}
+	var item types.ConfigItem
+	if err := types.Decode(manageValue, &item); err != nil {
+		return nil, err
+	}
+	return item.GetArr().GetValue(), nil
+}
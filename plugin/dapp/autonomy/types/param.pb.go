@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: param.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type AutonomyProposalParam struct {
+	PropParam *ProposalParam `protobuf:"bytes,1,opt,name=propParam,proto3" json:"propParam,omitempty"`
+	// 投票该提案的规则
+	CurRule *RuleConfig `protobuf:"bytes,2,opt,name=curRule,proto3" json:"curRule,omitempty"`
+	// 投票该提案的董事会成员
+	Boards []string `protobuf:"bytes,3,rep,name=boards,proto3" json:"boards,omitempty"`
+	// 董事会投票结果
+	BoardVoteRes *VoteResult `protobuf:"bytes,4,opt,name=boardVoteRes,proto3" json:"boardVoteRes,omitempty"`
+	// 公示投票
+	PubVote *PublicVote `protobuf:"bytes,5,opt,name=pubVote,proto3" json:"pubVote,omitempty"`
+	// 状态
+	Status     int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	Address    string `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	Height     int64  `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Index      int32  `protobuf:"varint,9,opt,name=index,proto3" json:"index,omitempty"`
+	ProposalID string `protobuf:"bytes,10,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	// 参数是否已经写入
+	Applied              bool     `protobuf:"varint,11,opt,name=applied,proto3" json:"applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutonomyProposalParam) Reset()         { *m = AutonomyProposalParam{} }
+func (m *AutonomyProposalParam) String() string { return proto.CompactTextString(m) }
+func (*AutonomyProposalParam) ProtoMessage()    {}
+func (*AutonomyProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{0}
+}
+
+func (m *AutonomyProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutonomyProposalParam.Unmarshal(m, b)
+}
+func (m *AutonomyProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutonomyProposalParam.Marshal(b, m, deterministic)
+}
+func (m *AutonomyProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutonomyProposalParam.Merge(m, src)
+}
+func (m *AutonomyProposalParam) XXX_Size() int {
+	return xxx_messageInfo_AutonomyProposalParam.Size(m)
+}
+func (m *AutonomyProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutonomyProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutonomyProposalParam proto.InternalMessageInfo
+
+func (m *AutonomyProposalParam) GetPropParam() *ProposalParam {
+	if m != nil {
+		return m.PropParam
+	}
+	return nil
+}
+
+func (m *AutonomyProposalParam) GetCurRule() *RuleConfig {
+	if m != nil {
+		return m.CurRule
+	}
+	return nil
+}
+
+func (m *AutonomyProposalParam) GetBoards() []string {
+	if m != nil {
+		return m.Boards
+	}
+	return nil
+}
+
+func (m *AutonomyProposalParam) GetBoardVoteRes() *VoteResult {
+	if m != nil {
+		return m.BoardVoteRes
+	}
+	return nil
+}
+
+func (m *AutonomyProposalParam) GetPubVote() *PublicVote {
+	if m != nil {
+		return m.PubVote
+	}
+	return nil
+}
+
+func (m *AutonomyProposalParam) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *AutonomyProposalParam) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AutonomyProposalParam) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AutonomyProposalParam) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AutonomyProposalParam) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *AutonomyProposalParam) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+type ProposalParam struct {
+	// 提案时间
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// 参数相关
+	Key          string   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Values       []string `protobuf:"bytes,5,rep,name=values,proto3" json:"values,omitempty"`
+	Description  string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ActiveHeight int64    `protobuf:"varint,7,opt,name=activeHeight,proto3" json:"activeHeight,omitempty"`
+	// 投票相关
+	StartBlockHeight     int64    `protobuf:"varint,8,opt,name=startBlockHeight,proto3" json:"startBlockHeight,omitempty"`
+	EndBlockHeight       int64    `protobuf:"varint,9,opt,name=endBlockHeight,proto3" json:"endBlockHeight,omitempty"`
+	RealEndBlockHeight   int64    `protobuf:"varint,10,opt,name=realEndBlockHeight,proto3" json:"realEndBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposalParam) Reset()         { *m = ProposalParam{} }
+func (m *ProposalParam) String() string { return proto.CompactTextString(m) }
+func (*ProposalParam) ProtoMessage()    {}
+func (*ProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{1}
+}
+
+func (m *ProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposalParam.Unmarshal(m, b)
+}
+func (m *ProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposalParam.Marshal(b, m, deterministic)
+}
+func (m *ProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposalParam.Merge(m, src)
+}
+func (m *ProposalParam) XXX_Size() int {
+	return xxx_messageInfo_ProposalParam.Size(m)
+}
+func (m *ProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposalParam proto.InternalMessageInfo
+
+func (m *ProposalParam) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *ProposalParam) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *ProposalParam) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *ProposalParam) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ProposalParam) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ProposalParam) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ProposalParam) GetActiveHeight() int64 {
+	if m != nil {
+		return m.ActiveHeight
+	}
+	return 0
+}
+
+func (m *ProposalParam) GetStartBlockHeight() int64 {
+	if m != nil {
+		return m.StartBlockHeight
+	}
+	return 0
+}
+
+func (m *ProposalParam) GetEndBlockHeight() int64 {
+	if m != nil {
+		return m.EndBlockHeight
+	}
+	return 0
+}
+
+func (m *ProposalParam) GetRealEndBlockHeight() int64 {
+	if m != nil {
+		return m.RealEndBlockHeight
+	}
+	return 0
+}
+
+type RevokeProposalParam struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeProposalParam) Reset()         { *m = RevokeProposalParam{} }
+func (m *RevokeProposalParam) String() string { return proto.CompactTextString(m) }
+func (*RevokeProposalParam) ProtoMessage()    {}
+func (*RevokeProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{2}
+}
+
+func (m *RevokeProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeProposalParam.Unmarshal(m, b)
+}
+func (m *RevokeProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeProposalParam.Marshal(b, m, deterministic)
+}
+func (m *RevokeProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeProposalParam.Merge(m, src)
+}
+func (m *RevokeProposalParam) XXX_Size() int {
+	return xxx_messageInfo_RevokeProposalParam.Size(m)
+}
+func (m *RevokeProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeProposalParam proto.InternalMessageInfo
+
+func (m *RevokeProposalParam) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+type VoteProposalParam struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Approve              bool     `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteProposalParam) Reset()         { *m = VoteProposalParam{} }
+func (m *VoteProposalParam) String() string { return proto.CompactTextString(m) }
+func (*VoteProposalParam) ProtoMessage()    {}
+func (*VoteProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{3}
+}
+
+func (m *VoteProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteProposalParam.Unmarshal(m, b)
+}
+func (m *VoteProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteProposalParam.Marshal(b, m, deterministic)
+}
+func (m *VoteProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteProposalParam.Merge(m, src)
+}
+func (m *VoteProposalParam) XXX_Size() int {
+	return xxx_messageInfo_VoteProposalParam.Size(m)
+}
+func (m *VoteProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteProposalParam proto.InternalMessageInfo
+
+func (m *VoteProposalParam) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *VoteProposalParam) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type PubVoteProposalParam struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Oppose               bool     `protobuf:"varint,2,opt,name=oppose,proto3" json:"oppose,omitempty"`
+	OriginAddr           []string `protobuf:"bytes,3,rep,name=originAddr,proto3" json:"originAddr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PubVoteProposalParam) Reset()         { *m = PubVoteProposalParam{} }
+func (m *PubVoteProposalParam) String() string { return proto.CompactTextString(m) }
+func (*PubVoteProposalParam) ProtoMessage()    {}
+func (*PubVoteProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{4}
+}
+
+func (m *PubVoteProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PubVoteProposalParam.Unmarshal(m, b)
+}
+func (m *PubVoteProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PubVoteProposalParam.Marshal(b, m, deterministic)
+}
+func (m *PubVoteProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PubVoteProposalParam.Merge(m, src)
+}
+func (m *PubVoteProposalParam) XXX_Size() int {
+	return xxx_messageInfo_PubVoteProposalParam.Size(m)
+}
+func (m *PubVoteProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_PubVoteProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PubVoteProposalParam proto.InternalMessageInfo
+
+func (m *PubVoteProposalParam) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *PubVoteProposalParam) GetOppose() bool {
+	if m != nil {
+		return m.Oppose
+	}
+	return false
+}
+
+func (m *PubVoteProposalParam) GetOriginAddr() []string {
+	if m != nil {
+		return m.OriginAddr
+	}
+	return nil
+}
+
+type TerminateProposalParam struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminateProposalParam) Reset()         { *m = TerminateProposalParam{} }
+func (m *TerminateProposalParam) String() string { return proto.CompactTextString(m) }
+func (*TerminateProposalParam) ProtoMessage()    {}
+func (*TerminateProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{5}
+}
+
+func (m *TerminateProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateProposalParam.Unmarshal(m, b)
+}
+func (m *TerminateProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateProposalParam.Marshal(b, m, deterministic)
+}
+func (m *TerminateProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateProposalParam.Merge(m, src)
+}
+func (m *TerminateProposalParam) XXX_Size() int {
+	return xxx_messageInfo_TerminateProposalParam.Size(m)
+}
+func (m *TerminateProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateProposalParam proto.InternalMessageInfo
+
+func (m *TerminateProposalParam) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+// 治理参数
+type AutonomyParam struct {
+	Key          string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values       []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	ActiveHeight int64    `protobuf:"varint,3,opt,name=activeHeight,proto3" json:"activeHeight,omitempty"`
+	ProposalID   string   `protobuf:"bytes,4,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	// 生效高度之前使用的参数
+	Prev *AutonomyParam `protobuf:"bytes,5,opt,name=prev,proto3" json:"prev,omitempty"`
+	// 提案生效时 manage 中该配置的hash, manage 之后修改过则以 manage 为准
+	ManageHash           []byte   `protobuf:"bytes,6,opt,name=manageHash,proto3" json:"manageHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AutonomyParam) Reset()         { *m = AutonomyParam{} }
+func (m *AutonomyParam) String() string { return proto.CompactTextString(m) }
+func (*AutonomyParam) ProtoMessage()    {}
+func (*AutonomyParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{6}
+}
+
+func (m *AutonomyParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutonomyParam.Unmarshal(m, b)
+}
+func (m *AutonomyParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutonomyParam.Marshal(b, m, deterministic)
+}
+func (m *AutonomyParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutonomyParam.Merge(m, src)
+}
+func (m *AutonomyParam) XXX_Size() int {
+	return xxx_messageInfo_AutonomyParam.Size(m)
+}
+func (m *AutonomyParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutonomyParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutonomyParam proto.InternalMessageInfo
+
+func (m *AutonomyParam) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AutonomyParam) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *AutonomyParam) GetActiveHeight() int64 {
+	if m != nil {
+		return m.ActiveHeight
+	}
+	return 0
+}
+
+func (m *AutonomyParam) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *AutonomyParam) GetPrev() *AutonomyParam {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *AutonomyParam) GetManageHash() []byte {
+	if m != nil {
+		return m.ManageHash
+	}
+	return nil
+}
+
+// receipt
+type ReceiptProposalParam struct {
+	Prev                 *AutonomyProposalParam `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *AutonomyProposalParam `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ReceiptProposalParam) Reset()         { *m = ReceiptProposalParam{} }
+func (m *ReceiptProposalParam) String() string { return proto.CompactTextString(m) }
+func (*ReceiptProposalParam) ProtoMessage()    {}
+func (*ReceiptProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{7}
+}
+
+func (m *ReceiptProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptProposalParam.Unmarshal(m, b)
+}
+func (m *ReceiptProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptProposalParam.Marshal(b, m, deterministic)
+}
+func (m *ReceiptProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptProposalParam.Merge(m, src)
+}
+func (m *ReceiptProposalParam) XXX_Size() int {
+	return xxx_messageInfo_ReceiptProposalParam.Size(m)
+}
+func (m *ReceiptProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptProposalParam proto.InternalMessageInfo
+
+func (m *ReceiptProposalParam) GetPrev() *AutonomyProposalParam {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptProposalParam) GetCurrent() *AutonomyProposalParam {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+// query
+type ReqQueryProposalParam struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int32    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqQueryProposalParam) Reset()         { *m = ReqQueryProposalParam{} }
+func (m *ReqQueryProposalParam) String() string { return proto.CompactTextString(m) }
+func (*ReqQueryProposalParam) ProtoMessage()    {}
+func (*ReqQueryProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{8}
+}
+
+func (m *ReqQueryProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqQueryProposalParam.Unmarshal(m, b)
+}
+func (m *ReqQueryProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqQueryProposalParam.Marshal(b, m, deterministic)
+}
+func (m *ReqQueryProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqQueryProposalParam.Merge(m, src)
+}
+func (m *ReqQueryProposalParam) XXX_Size() int {
+	return xxx_messageInfo_ReqQueryProposalParam.Size(m)
+}
+func (m *ReqQueryProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqQueryProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqQueryProposalParam proto.InternalMessageInfo
+
+func (m *ReqQueryProposalParam) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReqQueryProposalParam) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqQueryProposalParam) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqQueryProposalParam) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqQueryProposalParam) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqQueryProposalParam) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReplyQueryProposalParam struct {
+	PropParams           []*AutonomyProposalParam `protobuf:"bytes,1,rep,name=propParams,proto3" json:"propParams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReplyQueryProposalParam) Reset()         { *m = ReplyQueryProposalParam{} }
+func (m *ReplyQueryProposalParam) String() string { return proto.CompactTextString(m) }
+func (*ReplyQueryProposalParam) ProtoMessage()    {}
+func (*ReplyQueryProposalParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{9}
+}
+
+func (m *ReplyQueryProposalParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyQueryProposalParam.Unmarshal(m, b)
+}
+func (m *ReplyQueryProposalParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyQueryProposalParam.Marshal(b, m, deterministic)
+}
+func (m *ReplyQueryProposalParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyQueryProposalParam.Merge(m, src)
+}
+func (m *ReplyQueryProposalParam) XXX_Size() int {
+	return xxx_messageInfo_ReplyQueryProposalParam.Size(m)
+}
+func (m *ReplyQueryProposalParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyQueryProposalParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyQueryProposalParam proto.InternalMessageInfo
+
+func (m *ReplyQueryProposalParam) GetPropParams() []*AutonomyProposalParam {
+	if m != nil {
+		return m.PropParams
+	}
+	return nil
+}
+
+type ParamChangeRecord struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Values               []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	ActiveHeight         int64    `protobuf:"varint,3,opt,name=activeHeight,proto3" json:"activeHeight,omitempty"`
+	ProposalID           string   `protobuf:"bytes,4,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int32    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParamChangeRecord) Reset()         { *m = ParamChangeRecord{} }
+func (m *ParamChangeRecord) String() string { return proto.CompactTextString(m) }
+func (*ParamChangeRecord) ProtoMessage()    {}
+func (*ParamChangeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{10}
+}
+
+func (m *ParamChangeRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParamChangeRecord.Unmarshal(m, b)
+}
+func (m *ParamChangeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParamChangeRecord.Marshal(b, m, deterministic)
+}
+func (m *ParamChangeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamChangeRecord.Merge(m, src)
+}
+func (m *ParamChangeRecord) XXX_Size() int {
+	return xxx_messageInfo_ParamChangeRecord.Size(m)
+}
+func (m *ParamChangeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamChangeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamChangeRecord proto.InternalMessageInfo
+
+func (m *ParamChangeRecord) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *ParamChangeRecord) GetActiveHeight() int64 {
+	if m != nil {
+		return m.ActiveHeight
+	}
+	return 0
+}
+
+func (m *ParamChangeRecord) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *ParamChangeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParamChangeRecord) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReqQueryParamChange struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int32    `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqQueryParamChange) Reset()         { *m = ReqQueryParamChange{} }
+func (m *ReqQueryParamChange) String() string { return proto.CompactTextString(m) }
+func (*ReqQueryParamChange) ProtoMessage()    {}
+func (*ReqQueryParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{11}
+}
+
+func (m *ReqQueryParamChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqQueryParamChange.Unmarshal(m, b)
+}
+func (m *ReqQueryParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqQueryParamChange.Marshal(b, m, deterministic)
+}
+func (m *ReqQueryParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqQueryParamChange.Merge(m, src)
+}
+func (m *ReqQueryParamChange) XXX_Size() int {
+	return xxx_messageInfo_ReqQueryParamChange.Size(m)
+}
+func (m *ReqQueryParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqQueryParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqQueryParamChange proto.InternalMessageInfo
+
+func (m *ReqQueryParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReqQueryParamChange) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqQueryParamChange) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqQueryParamChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqQueryParamChange) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReplyQueryParamChange struct {
+	Changes              []*ParamChangeRecord `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReplyQueryParamChange) Reset()         { *m = ReplyQueryParamChange{} }
+func (m *ReplyQueryParamChange) String() string { return proto.CompactTextString(m) }
+func (*ReplyQueryParamChange) ProtoMessage()    {}
+func (*ReplyQueryParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e1747c98d2fc1d5, []int{12}
+}
+
+func (m *ReplyQueryParamChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyQueryParamChange.Unmarshal(m, b)
+}
+func (m *ReplyQueryParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyQueryParamChange.Marshal(b, m, deterministic)
+}
+func (m *ReplyQueryParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyQueryParamChange.Merge(m, src)
+}
+func (m *ReplyQueryParamChange) XXX_Size() int {
+	return xxx_messageInfo_ReplyQueryParamChange.Size(m)
+}
+func (m *ReplyQueryParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyQueryParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyQueryParamChange proto.InternalMessageInfo
+
+func (m *ReplyQueryParamChange) GetChanges() []*ParamChangeRecord {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AutonomyProposalParam)(nil), "types.AutonomyProposalParam")
+	proto.RegisterType((*ProposalParam)(nil), "types.ProposalParam")
+	proto.RegisterType((*RevokeProposalParam)(nil), "types.RevokeProposalParam")
+	proto.RegisterType((*VoteProposalParam)(nil), "types.VoteProposalParam")
+	proto.RegisterType((*PubVoteProposalParam)(nil), "types.PubVoteProposalParam")
+	proto.RegisterType((*TerminateProposalParam)(nil), "types.TerminateProposalParam")
+	proto.RegisterType((*AutonomyParam)(nil), "types.AutonomyParam")
+	proto.RegisterType((*ReceiptProposalParam)(nil), "types.ReceiptProposalParam")
+	proto.RegisterType((*ReqQueryProposalParam)(nil), "types.ReqQueryProposalParam")
+	proto.RegisterType((*ReplyQueryProposalParam)(nil), "types.ReplyQueryProposalParam")
+	proto.RegisterType((*ParamChangeRecord)(nil), "types.ParamChangeRecord")
+	proto.RegisterType((*ReqQueryParamChange)(nil), "types.ReqQueryParamChange")
+	proto.RegisterType((*ReplyQueryParamChange)(nil), "types.ReplyQueryParamChange")
+}
+
+func init() {
+	proto.RegisterFile("param.proto", fileDescriptor_1e1747c98d2fc1d5)
+}
+
+var fileDescriptor_1e1747c98d2fc1d5 = []byte{
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6b, 0xdb, 0x48,
+	0x14, 0x47, 0x96, 0x65, 0x47, 0xcf, 0xc9, 0x92, 0x4c, 0x9c, 0xec, 0xb0, 0x84, 0xc5, 0xe8, 0xb0,
+	0x98, 0x5d, 0x30, 0x4b, 0x96, 0x2c, 0x7b, 0xd8, 0x4b, 0x9a, 0x16, 0x52, 0x4a, 0xc1, 0x1d, 0x4a,
+	0x7b, 0x1e, 0x4b, 0x53, 0x5b, 0x44, 0xd6, 0x4c, 0x47, 0x23, 0x53, 0xdf, 0x7a, 0xed, 0xa5, 0xdf,
+	0xa2, 0xe7, 0x7e, 0x84, 0x1e, 0xfb, 0xb5, 0xca, 0x3c, 0x49, 0x89, 0xc6, 0x71, 0x68, 0x7d, 0xe9,
+	0xed, 0xfd, 0x7e, 0xfe, 0xbd, 0x3f, 0x7a, 0xef, 0xcd, 0x33, 0x0c, 0x14, 0xd7, 0x7c, 0x39, 0x51,
+	0x5a, 0x1a, 0x49, 0x02, 0xb3, 0x56, 0xa2, 0xf8, 0xed, 0x20, 0x8b, 0xe5, 0x72, 0x29, 0xf3, 0x8a,
+	0x8d, 0x3e, 0xfa, 0x70, 0x72, 0x59, 0x1a, 0x99, 0xcb, 0xe5, 0x7a, 0xaa, 0xa5, 0x92, 0x05, 0xcf,
+	0xa6, 0xd6, 0x8b, 0x9c, 0x43, 0xa8, 0xb4, 0x54, 0x08, 0xa8, 0x37, 0xf2, 0xc6, 0x83, 0xf3, 0xe1,
+	0x04, 0x63, 0x4c, 0x1c, 0x21, 0xbb, 0x93, 0x91, 0xbf, 0xa0, 0x1f, 0x97, 0x9a, 0x95, 0x99, 0xa0,
+	0x1d, 0xf4, 0x38, 0xaa, 0x3d, 0x2c, 0x75, 0x25, 0xf3, 0x37, 0xe9, 0x9c, 0x35, 0x0a, 0x72, 0x0a,
+	0xbd, 0x99, 0xe4, 0x3a, 0x29, 0xa8, 0x3f, 0xf2, 0xc7, 0x21, 0xab, 0x11, 0xb9, 0x80, 0x7d, 0xb4,
+	0x5e, 0x49, 0x23, 0x98, 0x28, 0x68, 0xd7, 0x89, 0x54, 0xb3, 0x65, 0x66, 0x98, 0x23, 0xb3, 0xb9,
+	0x55, 0x39, 0xb3, 0x88, 0x06, 0x8e, 0xc7, 0xb4, 0x9c, 0x65, 0x69, 0x8c, 0xb2, 0x46, 0x61, 0x73,
+	0x17, 0x86, 0x9b, 0xb2, 0xa0, 0xbd, 0x91, 0x37, 0x0e, 0x58, 0x8d, 0x08, 0x85, 0x3e, 0x4f, 0x12,
+	0x2d, 0x8a, 0x82, 0xf6, 0x47, 0xde, 0x38, 0x64, 0x0d, 0xb4, 0x1e, 0x0b, 0x91, 0xce, 0x17, 0x86,
+	0xee, 0x8d, 0xbc, 0xb1, 0xcf, 0x6a, 0x44, 0x86, 0x10, 0xa4, 0x79, 0x22, 0xde, 0xd1, 0x10, 0x03,
+	0x55, 0x80, 0xfc, 0x0e, 0xa0, 0xea, 0x26, 0x3d, 0x7d, 0x4c, 0x01, 0x43, 0xb5, 0x18, 0xcc, 0xa3,
+	0x54, 0x96, 0x8a, 0x84, 0x0e, 0x46, 0xde, 0x78, 0x8f, 0x35, 0x30, 0xfa, 0xd2, 0x81, 0x03, 0x77,
+	0x10, 0x04, 0xba, 0x6b, 0xc1, 0x35, 0xce, 0x20, 0x60, 0x68, 0xdb, 0xac, 0x4b, 0x99, 0x9b, 0x05,
+	0xb6, 0x39, 0x60, 0x15, 0x20, 0x87, 0xe0, 0x27, 0x7c, 0x4d, 0x7d, 0xe4, 0xac, 0x69, 0x99, 0x1b,
+	0xb1, 0xc6, 0x16, 0x86, 0xcc, 0x9a, 0xf6, 0x3b, 0x56, 0x3c, 0x2b, 0x45, 0x41, 0x83, 0xaa, 0xeb,
+	0x15, 0x22, 0x23, 0x18, 0x24, 0xa2, 0x88, 0x75, 0xaa, 0x4c, 0x2a, 0x73, 0x6c, 0x4b, 0xc8, 0xda,
+	0x14, 0x89, 0x60, 0x9f, 0xc7, 0x26, 0x5d, 0x89, 0xeb, 0xaa, 0x0f, 0x7d, 0xec, 0x83, 0xc3, 0x91,
+	0x3f, 0xe1, 0xb0, 0x30, 0x5c, 0x9b, 0x47, 0x99, 0x8c, 0x6f, 0xae, 0xdb, 0xfd, 0xba, 0xc7, 0x93,
+	0x3f, 0xe0, 0x17, 0x91, 0x27, 0x6d, 0x65, 0x88, 0xca, 0x0d, 0x96, 0x4c, 0x80, 0x68, 0xc1, 0xb3,
+	0x27, 0xae, 0x16, 0x50, 0xbb, 0xe5, 0x97, 0xe8, 0x02, 0x8e, 0x99, 0x58, 0xc9, 0x1b, 0xe1, 0xb6,
+	0xd1, 0x1d, 0x89, 0xb7, 0x39, 0x92, 0xe8, 0x39, 0x1c, 0xd9, 0xd5, 0xd8, 0xc9, 0xa9, 0x9e, 0xa3,
+	0x96, 0xab, 0x6a, 0xe1, 0xf7, 0x58, 0x03, 0xa3, 0x1c, 0x86, 0xd3, 0x72, 0xb6, 0x7b, 0xc4, 0x53,
+	0xe8, 0x49, 0xa5, 0x64, 0xd1, 0x04, 0xac, 0x91, 0xf5, 0x93, 0x3a, 0x9d, 0xa7, 0xf9, 0x65, 0x92,
+	0xe8, 0xfa, 0xc5, 0xb4, 0x98, 0xe8, 0x3f, 0x38, 0x7d, 0x29, 0xf4, 0x32, 0xcd, 0xf9, 0x8e, 0x19,
+	0xa3, 0xaf, 0x1e, 0x1c, 0xdc, 0x9e, 0x00, 0xf4, 0xa8, 0xb7, 0xc6, 0xdb, 0xb6, 0x35, 0x1d, 0x67,
+	0x6b, 0x36, 0x77, 0xc2, 0xdf, 0xb2, 0x13, 0x6e, 0xfe, 0xee, 0xbd, 0x2f, 0x1e, 0x43, 0x57, 0x69,
+	0xb1, 0xa2, 0x81, 0x73, 0x63, 0x9c, 0x8a, 0x18, 0x2a, 0x6c, 0xa4, 0x25, 0xcf, 0xf9, 0x5c, 0x5c,
+	0xf3, 0x62, 0x81, 0x2b, 0xba, 0xcf, 0x5a, 0x4c, 0xf4, 0xde, 0x83, 0x21, 0x13, 0xb1, 0x48, 0x95,
+	0x71, 0x5b, 0xf0, 0x77, 0x9d, 0xa2, 0x3a, 0x63, 0x67, 0x9b, 0x29, 0xda, 0xda, 0x3a, 0xd5, 0xbf,
+	0x78, 0xc9, 0xb4, 0xc8, 0x0d, 0xed, 0xfc, 0x80, 0x53, 0x23, 0x8e, 0x3e, 0x79, 0x70, 0xc2, 0xc4,
+	0xdb, 0x17, 0xa5, 0xd0, 0xae, 0xa4, 0x75, 0x72, 0x3c, 0xe7, 0xe4, 0x10, 0xe8, 0xda, 0x1b, 0x83,
+	0x69, 0x42, 0x86, 0xb6, 0x7d, 0xde, 0xb1, 0x2c, 0x73, 0x53, 0x3f, 0xe5, 0x0a, 0x90, 0x33, 0x08,
+	0x93, 0x54, 0x8b, 0x18, 0x1f, 0x68, 0x17, 0x7f, 0xb9, 0x23, 0x5a, 0x07, 0x2a, 0xd8, 0x7e, 0xa0,
+	0x7a, 0xad, 0x03, 0x15, 0xbd, 0x86, 0x5f, 0x99, 0x50, 0xd9, 0x7a, 0x4b, 0xa1, 0xff, 0x57, 0xf3,
+	0x42, 0x60, 0x8b, 0xf5, 0xbf, 0xfb, 0xf5, 0x2d, 0x7d, 0xf4, 0xd9, 0x83, 0x23, 0x34, 0xaf, 0x16,
+	0x3c, 0x9f, 0x0b, 0x26, 0x62, 0xa9, 0x93, 0x9f, 0xbc, 0x51, 0xbb, 0xb5, 0xe2, 0x83, 0x07, 0xc7,
+	0xb7, 0x23, 0xbb, 0xab, 0x7c, 0x4b, 0xcd, 0xb7, 0x63, 0xe9, 0x3c, 0x38, 0x16, 0xff, 0xe1, 0xb1,
+	0x74, 0xb7, 0xd7, 0x12, 0xb4, 0x6b, 0x79, 0x06, 0x27, 0xad, 0xb1, 0xb4, 0x8a, 0x39, 0x87, 0x7e,
+	0x8c, 0x56, 0x33, 0x11, 0xda, 0xfc, 0xbb, 0x6d, 0xf6, 0x9a, 0x35, 0xc2, 0x59, 0x0f, 0xff, 0xe2,
+	0xff, 0xf9, 0x36, 0x00, 0xba, 0x2d, 0x6d, 0x8a, 0x07, 0x08, 0x00, 0x00,
+}
@@ -153,13 +153,15 @@ type ProposalProject struct {
 	// 支付相关
 	ToAddr string `protobuf:"bytes,11,opt,name=toAddr,proto3" json:"toAddr,omitempty"`
 	// 投票相关
-	StartBlockHeight     int64    `protobuf:"varint,12,opt,name=startBlockHeight,proto3" json:"startBlockHeight,omitempty"`
-	EndBlockHeight       int64    `protobuf:"varint,13,opt,name=endBlockHeight,proto3" json:"endBlockHeight,omitempty"`
-	RealEndBlockHeight   int64    `protobuf:"varint,14,opt,name=realEndBlockHeight,proto3" json:"realEndBlockHeight,omitempty"`
-	ProjectNeedBlockNum  int32    `protobuf:"varint,15,opt,name=projectNeedBlockNum,proto3" json:"projectNeedBlockNum,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	StartBlockHeight    int64 `protobuf:"varint,12,opt,name=startBlockHeight,proto3" json:"startBlockHeight,omitempty"`
+	EndBlockHeight      int64 `protobuf:"varint,13,opt,name=endBlockHeight,proto3" json:"endBlockHeight,omitempty"`
+	RealEndBlockHeight  int64 `protobuf:"varint,14,opt,name=realEndBlockHeight,proto3" json:"realEndBlockHeight,omitempty"`
+	ProjectNeedBlockNum int32 `protobuf:"varint,15,opt,name=projectNeedBlockNum,proto3" json:"projectNeedBlockNum,omitempty"`
+	// 里程碑相关
+	Milestones           []*ProjectMilestone `protobuf:"bytes,16,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *ProposalProject) Reset()         { *m = ProposalProject{} }
@@ -292,6 +294,92 @@ func (m *ProposalProject) GetProjectNeedBlockNum() int32 {
 	return 0
 }
 
+func (m *ProposalProject) GetMilestones() []*ProjectMilestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+type ProjectMilestone struct {
+	Description          string      `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount               int64       `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status               int32       `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Boards               []string    `protobuf:"bytes,4,rep,name=boards,proto3" json:"boards,omitempty"`
+	BoardVoteRes         *VoteResult `protobuf:"bytes,5,opt,name=boardVoteRes,proto3" json:"boardVoteRes,omitempty"`
+	RealBlockHeight      int64       `protobuf:"varint,6,opt,name=realBlockHeight,proto3" json:"realBlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ProjectMilestone) Reset()         { *m = ProjectMilestone{} }
+func (m *ProjectMilestone) String() string { return proto.CompactTextString(m) }
+func (*ProjectMilestone) ProtoMessage()    {}
+func (*ProjectMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8340e6318dfdfac2, []int{2}
+}
+
+func (m *ProjectMilestone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProjectMilestone.Unmarshal(m, b)
+}
+func (m *ProjectMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProjectMilestone.Marshal(b, m, deterministic)
+}
+func (m *ProjectMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMilestone.Merge(m, src)
+}
+func (m *ProjectMilestone) XXX_Size() int {
+	return xxx_messageInfo_ProjectMilestone.Size(m)
+}
+func (m *ProjectMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMilestone proto.InternalMessageInfo
+
+func (m *ProjectMilestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ProjectMilestone) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ProjectMilestone) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ProjectMilestone) GetBoards() []string {
+	if m != nil {
+		return m.Boards
+	}
+	return nil
+}
+
+func (m *ProjectMilestone) GetBoardVoteRes() *VoteResult {
+	if m != nil {
+		return m.BoardVoteRes
+	}
+	return nil
+}
+
+func (m *ProjectMilestone) GetRealBlockHeight() int64 {
+	if m != nil {
+		return m.RealBlockHeight
+	}
+	return 0
+}
+
 type RevokeProposalProject struct {
 	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -303,7 +391,7 @@ func (m *RevokeProposalProject) Reset()         { *m = RevokeProposalProject{} }
 func (m *RevokeProposalProject) String() string { return proto.CompactTextString(m) }
 func (*RevokeProposalProject) ProtoMessage()    {}
 func (*RevokeProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{2}
+	return fileDescriptor_8340e6318dfdfac2, []int{3}
 }
 
 func (m *RevokeProposalProject) XXX_Unmarshal(b []byte) error {
@@ -343,7 +431,7 @@ func (m *VoteProposalProject) Reset()         { *m = VoteProposalProject{} }
 func (m *VoteProposalProject) String() string { return proto.CompactTextString(m) }
 func (*VoteProposalProject) ProtoMessage()    {}
 func (*VoteProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{3}
+	return fileDescriptor_8340e6318dfdfac2, []int{4}
 }
 
 func (m *VoteProposalProject) XXX_Unmarshal(b []byte) error {
@@ -391,7 +479,7 @@ func (m *PubVoteProposalProject) Reset()         { *m = PubVoteProposalProject{}
 func (m *PubVoteProposalProject) String() string { return proto.CompactTextString(m) }
 func (*PubVoteProposalProject) ProtoMessage()    {}
 func (*PubVoteProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{4}
+	return fileDescriptor_8340e6318dfdfac2, []int{5}
 }
 
 func (m *PubVoteProposalProject) XXX_Unmarshal(b []byte) error {
@@ -444,7 +532,7 @@ func (m *TerminateProposalProject) Reset()         { *m = TerminateProposalProje
 func (m *TerminateProposalProject) String() string { return proto.CompactTextString(m) }
 func (*TerminateProposalProject) ProtoMessage()    {}
 func (*TerminateProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{5}
+	return fileDescriptor_8340e6318dfdfac2, []int{6}
 }
 
 func (m *TerminateProposalProject) XXX_Unmarshal(b []byte) error {
@@ -472,6 +560,100 @@ func (m *TerminateProposalProject) GetProposalID() string {
 	return ""
 }
 
+type VoteProjectMilestone struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	Index                int32    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Approve              bool     `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteProjectMilestone) Reset()         { *m = VoteProjectMilestone{} }
+func (m *VoteProjectMilestone) String() string { return proto.CompactTextString(m) }
+func (*VoteProjectMilestone) ProtoMessage()    {}
+func (*VoteProjectMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8340e6318dfdfac2, []int{7}
+}
+
+func (m *VoteProjectMilestone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteProjectMilestone.Unmarshal(m, b)
+}
+func (m *VoteProjectMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteProjectMilestone.Marshal(b, m, deterministic)
+}
+func (m *VoteProjectMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteProjectMilestone.Merge(m, src)
+}
+func (m *VoteProjectMilestone) XXX_Size() int {
+	return xxx_messageInfo_VoteProjectMilestone.Size(m)
+}
+func (m *VoteProjectMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteProjectMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteProjectMilestone proto.InternalMessageInfo
+
+func (m *VoteProjectMilestone) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
+func (m *VoteProjectMilestone) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *VoteProjectMilestone) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type TerminateProjectMilestone struct {
+	ProposalID           string   `protobuf:"bytes,1,opt,name=proposalID,proto3" json:"proposalID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TerminateProjectMilestone) Reset()         { *m = TerminateProjectMilestone{} }
+func (m *TerminateProjectMilestone) String() string { return proto.CompactTextString(m) }
+func (*TerminateProjectMilestone) ProtoMessage()    {}
+func (*TerminateProjectMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8340e6318dfdfac2, []int{8}
+}
+
+func (m *TerminateProjectMilestone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TerminateProjectMilestone.Unmarshal(m, b)
+}
+func (m *TerminateProjectMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TerminateProjectMilestone.Marshal(b, m, deterministic)
+}
+func (m *TerminateProjectMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateProjectMilestone.Merge(m, src)
+}
+func (m *TerminateProjectMilestone) XXX_Size() int {
+	return xxx_messageInfo_TerminateProjectMilestone.Size(m)
+}
+func (m *TerminateProjectMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateProjectMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateProjectMilestone proto.InternalMessageInfo
+
+func (m *TerminateProjectMilestone) GetProposalID() string {
+	if m != nil {
+		return m.ProposalID
+	}
+	return ""
+}
+
 // receipt
 type ReceiptProposalProject struct {
 	Prev                 *AutonomyProposalProject `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
@@ -485,7 +667,7 @@ func (m *ReceiptProposalProject) Reset()         { *m = ReceiptProposalProject{}
 func (m *ReceiptProposalProject) String() string { return proto.CompactTextString(m) }
 func (*ReceiptProposalProject) ProtoMessage()    {}
 func (*ReceiptProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{6}
+	return fileDescriptor_8340e6318dfdfac2, []int{9}
 }
 
 func (m *ReceiptProposalProject) XXX_Unmarshal(b []byte) error {
@@ -532,7 +714,7 @@ func (m *LocalProposalProject) Reset()         { *m = LocalProposalProject{} }
 func (m *LocalProposalProject) String() string { return proto.CompactTextString(m) }
 func (*LocalProposalProject) ProtoMessage()    {}
 func (*LocalProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{7}
+	return fileDescriptor_8340e6318dfdfac2, []int{10}
 }
 
 func (m *LocalProposalProject) XXX_Unmarshal(b []byte) error {
@@ -584,7 +766,7 @@ func (m *ReqQueryProposalProject) Reset()         { *m = ReqQueryProposalProject
 func (m *ReqQueryProposalProject) String() string { return proto.CompactTextString(m) }
 func (*ReqQueryProposalProject) ProtoMessage()    {}
 func (*ReqQueryProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{8}
+	return fileDescriptor_8340e6318dfdfac2, []int{11}
 }
 
 func (m *ReqQueryProposalProject) XXX_Unmarshal(b []byte) error {
@@ -658,7 +840,7 @@ func (m *ReplyQueryProposalProject) Reset()         { *m = ReplyQueryProposalPro
 func (m *ReplyQueryProposalProject) String() string { return proto.CompactTextString(m) }
 func (*ReplyQueryProposalProject) ProtoMessage()    {}
 func (*ReplyQueryProposalProject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8340e6318dfdfac2, []int{9}
+	return fileDescriptor_8340e6318dfdfac2, []int{12}
 }
 
 func (m *ReplyQueryProposalProject) XXX_Unmarshal(b []byte) error {
@@ -689,10 +871,13 @@ func (m *ReplyQueryProposalProject) GetPropProjects() []*AutonomyProposalProject
 func init() {
 	proto.RegisterType((*AutonomyProposalProject)(nil), "types.AutonomyProposalProject")
 	proto.RegisterType((*ProposalProject)(nil), "types.ProposalProject")
+	proto.RegisterType((*ProjectMilestone)(nil), "types.ProjectMilestone")
 	proto.RegisterType((*RevokeProposalProject)(nil), "types.RevokeProposalProject")
 	proto.RegisterType((*VoteProposalProject)(nil), "types.VoteProposalProject")
 	proto.RegisterType((*PubVoteProposalProject)(nil), "types.PubVoteProposalProject")
 	proto.RegisterType((*TerminateProposalProject)(nil), "types.TerminateProposalProject")
+	proto.RegisterType((*VoteProjectMilestone)(nil), "types.VoteProjectMilestone")
+	proto.RegisterType((*TerminateProjectMilestone)(nil), "types.TerminateProjectMilestone")
 	proto.RegisterType((*ReceiptProposalProject)(nil), "types.ReceiptProposalProject")
 	proto.RegisterType((*LocalProposalProject)(nil), "types.LocalProposalProject")
 	proto.RegisterType((*ReqQueryProposalProject)(nil), "types.ReqQueryProposalProject")
@@ -704,50 +889,56 @@ func init() {
 }
 
 var fileDescriptor_8340e6318dfdfac2 = []byte{
-	// 713 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5f, 0x6f, 0xd3, 0x3e,
-	0x14, 0x55, 0x96, 0xa6, 0x5d, 0x6f, 0xbb, 0x3f, 0x3f, 0x6f, 0xbf, 0xce, 0x4c, 0x68, 0xaa, 0xf2,
-	0x80, 0x2a, 0x90, 0x2a, 0x34, 0x84, 0x98, 0x78, 0xdb, 0x18, 0x12, 0x48, 0x68, 0x14, 0x83, 0x78,
-	0x45, 0x69, 0xe2, 0x75, 0xd9, 0xd2, 0xd8, 0x38, 0xce, 0x44, 0xbf, 0x00, 0x5f, 0x85, 0x8f, 0xc8,
-	0x0b, 0x0f, 0xc8, 0xd7, 0x0e, 0x4b, 0xba, 0x22, 0xb6, 0x37, 0x9f, 0x73, 0xcf, 0xbd, 0x76, 0xae,
-	0x7d, 0x4f, 0x60, 0x43, 0x2a, 0x71, 0xc9, 0x63, 0x3d, 0x96, 0x4a, 0x68, 0x41, 0x02, 0xbd, 0x90,
-	0xbc, 0xd8, 0xdf, 0xc8, 0x62, 0x31, 0x9f, 0x8b, 0xdc, 0xb2, 0xe1, 0xaf, 0x35, 0xd8, 0x3b, 0x2e,
-	0xb5, 0xc8, 0xc5, 0x7c, 0x31, 0x51, 0x42, 0x8a, 0x22, 0xca, 0x26, 0x36, 0x8f, 0x1c, 0x41, 0x4f,
-	0x2a, 0x21, 0x1d, 0xa4, 0xde, 0xd0, 0x1b, 0xf5, 0x0e, 0x07, 0x63, 0xac, 0x33, 0x5e, 0x12, 0xb3,
-	0xba, 0x94, 0x3c, 0x81, 0x4e, 0x5c, 0x2a, 0x56, 0x66, 0x9c, 0xae, 0x61, 0xd6, 0x7f, 0x2e, 0xcb,
-	0x50, 0xaf, 0x44, 0x7e, 0x9e, 0xce, 0x58, 0xa5, 0x20, 0x03, 0x68, 0x4f, 0x45, 0xa4, 0x92, 0x82,
-	0xfa, 0x43, 0x7f, 0xd4, 0x65, 0x0e, 0x91, 0xe7, 0xd0, 0xc7, 0xd5, 0x67, 0xa1, 0x39, 0xe3, 0x05,
-	0x6d, 0x35, 0x2a, 0x39, 0xb6, 0xcc, 0x34, 0x6b, 0xc8, 0xcc, 0xde, 0xb2, 0x9c, 0x1a, 0x44, 0x83,
-	0x46, 0xc6, 0xa4, 0x9c, 0x66, 0x69, 0x8c, 0xb2, 0x4a, 0x61, 0xf6, 0x2e, 0x74, 0xa4, 0xcb, 0x82,
-	0xb6, 0x87, 0xde, 0x28, 0x60, 0x0e, 0x11, 0x0a, 0x9d, 0x28, 0x49, 0x14, 0x2f, 0x0a, 0xda, 0x19,
-	0x7a, 0xa3, 0x2e, 0xab, 0xa0, 0xc9, 0xb8, 0xe0, 0xe9, 0xec, 0x42, 0xd3, 0xf5, 0xa1, 0x37, 0xf2,
-	0x99, 0x43, 0x64, 0x17, 0x82, 0x34, 0x4f, 0xf8, 0x37, 0xda, 0xc5, 0x42, 0x16, 0x90, 0x03, 0x00,
-	0xe9, 0x1a, 0xf5, 0xf6, 0x94, 0x02, 0x96, 0xaa, 0x31, 0xe1, 0x4f, 0x1f, 0xb6, 0x96, 0xdb, 0x4e,
-	0xa0, 0xb5, 0xe0, 0x91, 0xc2, 0x7e, 0x07, 0x0c, 0xd7, 0xa6, 0xfa, 0x5c, 0xe4, 0xfa, 0x02, 0xdb,
-	0x19, 0x30, 0x0b, 0xc8, 0x36, 0xf8, 0x49, 0xb4, 0xa0, 0x3e, 0x72, 0x66, 0x69, 0xf6, 0x3b, 0x4f,
-	0x55, 0xa1, 0x3f, 0xea, 0x68, 0xc6, 0xb1, 0x63, 0x5d, 0x56, 0x63, 0xc8, 0x43, 0xe8, 0x66, 0x51,
-	0x15, 0x0e, 0x30, 0x7c, 0x43, 0xb8, 0xd3, 0x26, 0x65, 0xac, 0x53, 0x91, 0x63, 0x47, 0xec, 0x69,
-	0x1d, 0x43, 0x86, 0xd0, 0x4b, 0x78, 0x11, 0xab, 0x54, 0xa2, 0xc0, 0x76, 0xa6, 0x4e, 0x99, 0x0a,
-	0xb1, 0xc8, 0xb5, 0x8a, 0x62, 0x2d, 0x14, 0x76, 0xa8, 0xcb, 0x6a, 0x8c, 0xe9, 0x5e, 0x34, 0x17,
-	0x65, 0xae, 0xb1, 0x4d, 0x3e, 0x73, 0x88, 0x84, 0xd0, 0xb7, 0xab, 0x53, 0xae, 0xa3, 0x34, 0x73,
-	0x9d, 0x6a, 0x70, 0x26, 0x57, 0x8b, 0xe3, 0x24, 0x51, 0xb4, 0x87, 0x51, 0x87, 0xc8, 0x63, 0xd8,
-	0x2e, 0x74, 0xa4, 0xf4, 0x49, 0x26, 0xe2, 0xab, 0x37, 0xf6, 0x6e, 0xfa, 0x58, 0xfd, 0x16, 0x4f,
-	0x1e, 0xc1, 0x26, 0xcf, 0x93, 0xba, 0x72, 0x03, 0x95, 0x4b, 0x2c, 0x19, 0x03, 0x51, 0x3c, 0xca,
-	0x5e, 0x37, 0xb5, 0x9b, 0xa8, 0x5d, 0x11, 0x21, 0x4f, 0x61, 0xc7, 0x4d, 0xdb, 0x19, 0xe7, 0x36,
-	0x72, 0x56, 0xce, 0xe9, 0x16, 0xde, 0xcc, 0xaa, 0x50, 0xf8, 0x02, 0xfe, 0x67, 0xfc, 0x5a, 0x5c,
-	0xf1, 0xe5, 0xeb, 0x6f, 0x3e, 0x19, 0xef, 0xd6, 0x93, 0x79, 0x0f, 0x3b, 0xe6, 0xe9, 0xde, 0x33,
-	0x0d, 0x5f, 0xb4, 0x94, 0x4a, 0x5c, 0xdb, 0x91, 0x5c, 0x67, 0x15, 0x0c, 0x25, 0x0c, 0x26, 0x76,
-	0x1c, 0xee, 0x5b, 0x73, 0x00, 0x6d, 0x21, 0xa5, 0x28, 0xaa, 0x92, 0x0e, 0x99, 0x3c, 0xa1, 0xd2,
-	0x59, 0x9a, 0xe3, 0x6d, 0xd9, 0xa9, 0xae, 0x31, 0xe1, 0x4b, 0xa0, 0x9f, 0xb8, 0x9a, 0xa7, 0x79,
-	0x74, 0xef, 0x3d, 0xc3, 0xef, 0x1e, 0x0c, 0x18, 0x8f, 0x79, 0x2a, 0xf5, 0x72, 0xea, 0x21, 0xb4,
-	0xa4, 0xe2, 0xd7, 0xce, 0xa8, 0x0e, 0xdc, 0xd8, 0xff, 0xc5, 0xdd, 0x18, 0x6a, 0xc9, 0x11, 0x3a,
-	0x95, 0xe2, 0xb9, 0x76, 0x4e, 0xf5, 0xaf, 0xb4, 0x4a, 0x1e, 0x66, 0xb0, 0xfb, 0x4e, 0xc4, 0x18,
-	0x58, 0x72, 0xcd, 0x8e, 0xb5, 0xc2, 0xcb, 0x3b, 0x1e, 0xa4, 0x92, 0x93, 0x7d, 0x58, 0x37, 0xde,
-	0xcc, 0x73, 0x5d, 0xd0, 0x35, 0x6c, 0xda, 0x1f, 0x1c, 0xfe, 0xf0, 0x60, 0x8f, 0xf1, 0xaf, 0x1f,
-	0x4a, 0xae, 0x6e, 0xf9, 0xf4, 0x8d, 0x89, 0x79, 0x0d, 0x13, 0x23, 0xd0, 0x32, 0xae, 0x85, 0x1f,
-	0xd6, 0x65, 0xb8, 0x36, 0x46, 0x12, 0xe3, 0xfc, 0x59, 0xd3, 0xb0, 0xc0, 0xd8, 0x42, 0x92, 0x2a,
-	0x6e, 0xe7, 0xbe, 0x85, 0x91, 0x1b, 0xa2, 0x66, 0x79, 0xc1, 0x6a, 0xcb, 0x6b, 0xd7, 0x2c, 0x2f,
-	0xfc, 0x02, 0x0f, 0x18, 0x97, 0xd9, 0x62, 0xe5, 0x51, 0x4f, 0xa0, 0x5f, 0xfb, 0x4f, 0x98, 0x03,
-	0xfb, 0x77, 0xe8, 0x50, 0x23, 0x67, 0xda, 0xc6, 0x3f, 0xd7, 0xb3, 0xdf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x53, 0x84, 0x13, 0x84, 0xe0, 0x06, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x55, 0x9a, 0xa6, 0x5b, 0x6f, 0xbb, 0xad, 0x78, 0xa3, 0xf3, 0x26, 0x34, 0x55, 0x79, 0x40,
+	0x15, 0x48, 0x15, 0x1a, 0x42, 0x9b, 0xe0, 0x69, 0x63, 0x48, 0x20, 0xc1, 0x28, 0x06, 0xf1, 0x8a,
+	0xd2, 0xc4, 0xeb, 0xb2, 0x25, 0xb1, 0x71, 0x9c, 0x89, 0xfe, 0x00, 0xbf, 0xc2, 0x2b, 0x3f, 0xc4,
+	0x9f, 0xf0, 0x80, 0xec, 0x38, 0x6b, 0x92, 0x16, 0xb6, 0xf1, 0xe6, 0x7b, 0x7c, 0xee, 0xf5, 0xed,
+	0x71, 0x8e, 0x6f, 0x61, 0x8d, 0x0b, 0x76, 0x41, 0x7d, 0x39, 0xe2, 0x82, 0x49, 0x86, 0x1c, 0x39,
+	0xe3, 0x34, 0xdd, 0x5d, 0x8b, 0x7c, 0x16, 0xc7, 0x2c, 0xc9, 0x51, 0xf7, 0x77, 0x03, 0xb6, 0x8f,
+	0x32, 0xc9, 0x12, 0x16, 0xcf, 0xc6, 0x82, 0x71, 0x96, 0x7a, 0xd1, 0x38, 0xcf, 0x43, 0x87, 0xd0,
+	0xe1, 0x82, 0x71, 0x13, 0x62, 0x6b, 0x60, 0x0d, 0x3b, 0xfb, 0xfd, 0x91, 0xae, 0x33, 0xaa, 0x91,
+	0x49, 0x99, 0x8a, 0x1e, 0xc3, 0x8a, 0x9f, 0x09, 0x92, 0x45, 0x14, 0x37, 0x74, 0xd6, 0x3d, 0x93,
+	0xa5, 0xa0, 0x97, 0x2c, 0x39, 0x0b, 0xa7, 0xa4, 0x60, 0xa0, 0x3e, 0xb4, 0x26, 0xcc, 0x13, 0x41,
+	0x8a, 0xed, 0x81, 0x3d, 0x6c, 0x13, 0x13, 0xa1, 0x67, 0xd0, 0xd5, 0xab, 0xcf, 0x4c, 0x52, 0x42,
+	0x53, 0xdc, 0xac, 0x54, 0x32, 0x68, 0x16, 0x49, 0x52, 0xa1, 0xa9, 0xb3, 0x79, 0x36, 0x51, 0x11,
+	0x76, 0x2a, 0x19, 0xe3, 0x6c, 0x12, 0x85, 0xbe, 0xa6, 0x15, 0x0c, 0x75, 0x76, 0x2a, 0x3d, 0x99,
+	0xa5, 0xb8, 0x35, 0xb0, 0x86, 0x0e, 0x31, 0x11, 0xc2, 0xb0, 0xe2, 0x05, 0x81, 0xa0, 0x69, 0x8a,
+	0x57, 0x06, 0xd6, 0xb0, 0x4d, 0x8a, 0x50, 0x65, 0x9c, 0xd3, 0x70, 0x7a, 0x2e, 0xf1, 0xea, 0xc0,
+	0x1a, 0xda, 0xc4, 0x44, 0x68, 0x0b, 0x9c, 0x30, 0x09, 0xe8, 0x37, 0xdc, 0xd6, 0x85, 0xf2, 0x00,
+	0xed, 0x01, 0x70, 0x23, 0xd4, 0x9b, 0x13, 0x0c, 0xba, 0x54, 0x09, 0x71, 0x7f, 0x36, 0x61, 0xa3,
+	0x2e, 0x3b, 0x82, 0xe6, 0x8c, 0x7a, 0x42, 0xeb, 0xed, 0x10, 0xbd, 0x56, 0xd5, 0x63, 0x96, 0xc8,
+	0x73, 0x2d, 0xa7, 0x43, 0xf2, 0x00, 0xf5, 0xc0, 0x0e, 0xbc, 0x19, 0xb6, 0x35, 0xa6, 0x96, 0xea,
+	0xbc, 0xb3, 0x50, 0xa4, 0xf2, 0xa3, 0xf4, 0xa6, 0x54, 0x2b, 0xd6, 0x26, 0x25, 0x04, 0x3d, 0x80,
+	0x76, 0xe4, 0x15, 0xdb, 0x8e, 0xde, 0x9e, 0x03, 0xa6, 0xdb, 0x20, 0xf3, 0x65, 0xc8, 0x12, 0xdc,
+	0xba, 0xee, 0xd6, 0x20, 0x68, 0x00, 0x9d, 0x80, 0xa6, 0xbe, 0x08, 0xb9, 0x26, 0xe4, 0xca, 0x94,
+	0x21, 0x55, 0xc1, 0x67, 0x89, 0x14, 0x9e, 0x2f, 0x99, 0xd0, 0x0a, 0xb5, 0x49, 0x09, 0x51, 0xea,
+	0x79, 0x31, 0xcb, 0x12, 0xa9, 0x65, 0xb2, 0x89, 0x89, 0x90, 0x0b, 0xdd, 0x7c, 0x75, 0x42, 0xa5,
+	0x17, 0x46, 0x46, 0xa9, 0x0a, 0xa6, 0x72, 0x25, 0x3b, 0x0a, 0x02, 0x81, 0x3b, 0x7a, 0xd7, 0x44,
+	0xe8, 0x11, 0xf4, 0x52, 0xe9, 0x09, 0x79, 0x1c, 0x31, 0xff, 0xf2, 0x75, 0x7e, 0x37, 0x5d, 0x5d,
+	0x7d, 0x01, 0x47, 0x0f, 0x61, 0x9d, 0x26, 0x41, 0x99, 0xb9, 0xa6, 0x99, 0x35, 0x14, 0x8d, 0x00,
+	0x09, 0xea, 0x45, 0xaf, 0xaa, 0xdc, 0x75, 0xcd, 0x5d, 0xb2, 0x83, 0x9e, 0xc0, 0xa6, 0x71, 0xdb,
+	0x29, 0xa5, 0xf9, 0xce, 0x69, 0x16, 0xe3, 0x0d, 0x7d, 0x33, 0xcb, 0xb6, 0xd0, 0x01, 0x40, 0x1c,
+	0x46, 0x34, 0x95, 0x2c, 0xa1, 0x29, 0xee, 0x0d, 0xec, 0x61, 0x67, 0x7f, 0x7b, 0xee, 0x2d, 0xc5,
+	0x7f, 0x57, 0xec, 0x93, 0x12, 0xd5, 0xfd, 0x65, 0x41, 0xaf, 0x4e, 0xa8, 0xdf, 0x8c, 0xb5, 0x78,
+	0x33, 0x73, 0xe5, 0x1b, 0x15, 0xe5, 0xe7, 0x0e, 0xb0, 0x2b, 0x0e, 0x98, 0xbb, 0xb2, 0xf9, 0x4f,
+	0x57, 0x3a, 0xb7, 0x73, 0xe5, 0x10, 0x36, 0x94, 0x6c, 0x65, 0x35, 0x5b, 0xba, 0x8f, 0x3a, 0xec,
+	0x1e, 0xc0, 0x7d, 0x42, 0xaf, 0xd8, 0x25, 0xad, 0xfb, 0xa2, 0xea, 0x25, 0x6b, 0xc1, 0x4b, 0xef,
+	0x61, 0x53, 0x9d, 0x76, 0xc7, 0x34, 0x6d, 0x75, 0xce, 0x05, 0xbb, 0xca, 0xdf, 0xaa, 0x55, 0x52,
+	0x84, 0x2e, 0x87, 0xfe, 0x38, 0x9b, 0xfc, 0x4f, 0xcd, 0x3e, 0xb4, 0x18, 0xe7, 0x2c, 0x2d, 0x4a,
+	0x9a, 0x48, 0xe5, 0x31, 0x11, 0x4e, 0xc3, 0x44, 0x7f, 0xc6, 0xf9, 0x73, 0x57, 0x42, 0xdc, 0xe7,
+	0x80, 0x3f, 0x51, 0x11, 0x87, 0x89, 0x77, 0xe7, 0x33, 0xdd, 0x33, 0xd8, 0x32, 0xad, 0x56, 0x3f,
+	0x8d, 0x9b, 0x7a, 0xbd, 0x7e, 0xb8, 0x1a, 0xe5, 0x87, 0xab, 0xa4, 0x8a, 0x5d, 0x55, 0xe5, 0x05,
+	0xec, 0x94, 0x7b, 0xbc, 0xd3, 0x61, 0xee, 0x77, 0x0b, 0xfa, 0x84, 0xfa, 0x34, 0xe4, 0xb2, 0xfe,
+	0xfb, 0xf6, 0xa1, 0xc9, 0x05, 0xbd, 0x32, 0x63, 0x66, 0xcf, 0x7c, 0x50, 0x7f, 0x99, 0x4d, 0x44,
+	0x73, 0xd1, 0xa1, 0x9e, 0x33, 0x82, 0x9a, 0xaf, 0xfa, 0xe6, 0xb4, 0x82, 0xee, 0x46, 0xb0, 0xf5,
+	0x96, 0xf9, 0x5e, 0x54, 0x23, 0xa8, 0x8a, 0xf9, 0x20, 0xbb, 0xb8, 0x65, 0x23, 0x05, 0x1d, 0xed,
+	0xc2, 0xaa, 0x9a, 0xac, 0x34, 0x91, 0x29, 0x6e, 0xe8, 0x9b, 0xbd, 0x8e, 0xdd, 0x1f, 0x16, 0x6c,
+	0x13, 0xfa, 0xf5, 0x43, 0x46, 0xc5, 0xc2, 0x94, 0x9d, 0x1b, 0xd0, 0xaa, 0x18, 0x10, 0x41, 0x53,
+	0xcd, 0x1c, 0xfd, 0xc3, 0xda, 0x44, 0xaf, 0xd5, 0x5d, 0xf9, 0xda, 0xc3, 0xb9, 0x57, 0xf3, 0x40,
+	0x3d, 0xea, 0x41, 0x28, 0x68, 0xfe, 0x6a, 0x37, 0xf5, 0xce, 0x1c, 0x28, 0x0d, 0x2c, 0x67, 0xf9,
+	0xc0, 0x6a, 0x95, 0xee, 0xdd, 0xfd, 0x02, 0x3b, 0x84, 0xf2, 0x68, 0xb6, 0xb4, 0xd5, 0x63, 0xe8,
+	0x96, 0xa6, 0xbc, 0x6a, 0xd8, 0xbe, 0x85, 0x42, 0x95, 0x9c, 0x49, 0x4b, 0xff, 0xef, 0x78, 0xfa,
+	0x67, 0x00, 0x74, 0xac, 0x3a, 0x7e, 0x9e, 0x08, 0x00, 0x00,
 }
@@ -22,6 +22,8 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(AutonomyX, "Enable", 0)
+	cfg.RegisterDappFork(AutonomyX, ForkAutonomyMilestone, types.MaxHeight)
+	cfg.RegisterDappFork(AutonomyX, ForkAutonomyParam, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -60,6 +62,8 @@ func (a *AutonomyType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogVotePropProject:    {Ty: reflect.TypeOf(ReceiptProposalProject{}), Name: "LogVotePropProject"},
 		TyLogPubVotePropProject: {Ty: reflect.TypeOf(ReceiptProposalProject{}), Name: "LogPubVotePropProject"},
 		TyLogTmintPropProject:   {Ty: reflect.TypeOf(ReceiptProposalProject{}), Name: "LogTmintPropProject"},
+		TyLogVoteMilestone:      {Ty: reflect.TypeOf(ReceiptProposalProject{}), Name: "LogVoteMilestone"},
+		TyLogTmintMilestone:     {Ty: reflect.TypeOf(ReceiptProposalProject{}), Name: "LogTmintMilestone"},
 
 		TyLogPropRule:      {Ty: reflect.TypeOf(ReceiptProposalRule{}), Name: "LogPropRule"},
 		TyLogRvkPropRule:   {Ty: reflect.TypeOf(ReceiptProposalRule{}), Name: "LogRvkPropRule"},
@@ -72,6 +76,12 @@ func (a *AutonomyType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogRvkPropChange:   {Ty: reflect.TypeOf(ReceiptProposalChange{}), Name: "LogRvkPropChange"},
 		TyLogVotePropChange:  {Ty: reflect.TypeOf(ReceiptProposalChange{}), Name: "LogVotePropChange"},
 		TyLogTmintPropChange: {Ty: reflect.TypeOf(ReceiptProposalChange{}), Name: "LogTmintPropChange"},
+
+		TyLogPropParam:        {Ty: reflect.TypeOf(ReceiptProposalParam{}), Name: "LogPropParam"},
+		TyLogRvkPropParam:     {Ty: reflect.TypeOf(ReceiptProposalParam{}), Name: "LogRvkPropParam"},
+		TyLogVotePropParam:    {Ty: reflect.TypeOf(ReceiptProposalParam{}), Name: "LogVotePropParam"},
+		TyLogPubVotePropParam: {Ty: reflect.TypeOf(ReceiptProposalParam{}), Name: "LogPubVotePropParam"},
+		TyLogTmintPropParam:   {Ty: reflect.TypeOf(ReceiptProposalParam{}), Name: "LogTmintPropParam"},
 	}
 }
 
@@ -106,5 +116,14 @@ func (a *AutonomyType) GetTypeMap() map[string]int32 {
 		"RvkPropChange":   AutonomyActionRvkPropChange,
 		"VotePropChange":  AutonomyActionVotePropChange,
 		"TmintPropChange": AutonomyActionTmintPropChange,
+
+		"VoteMilestone":  AutonomyActionVoteMilestone,
+		"TmintMilestone": AutonomyActionTmintMilestone,
+
+		"PropParam":        AutonomyActionPropParam,
+		"RvkPropParam":     AutonomyActionRvkPropParam,
+		"VotePropParam":    AutonomyActionVotePropParam,
+		"PubVotePropParam": AutonomyActionPubVotePropParam,
+		"TmintPropParam":   AutonomyActionTmintPropParam,
 	}
 }
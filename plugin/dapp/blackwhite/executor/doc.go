@@ -16,6 +16,8 @@ package executor
 
 约束条件：限制每局最多 20 BTY
 
+随机数：输赢只由玩家自己提交-揭示的黑白选择决定，没有需要外部随机数的步骤，所以不使用randao合约
+
 
 status: create -> play -> show -> done(timeout done)
 
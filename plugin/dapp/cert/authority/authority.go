@@ -13,6 +13,7 @@ import (
 
 	"bytes"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
@@ -49,6 +50,8 @@ type Authority struct {
 	validCertCache [][]byte
 	// 历史证书缓存
 	HistoryCertCache *HistoryCertData
+	// 当前加载的链上证书数据hash
+	chainCertHash []byte
 }
 
 // HistoryCertData 历史变更记录
@@ -92,6 +95,7 @@ func (auth *Authority) Init(conf *ty.Authority) error {
 	auth.validator = vldt
 
 	auth.validCertCache = make([][]byte, 0)
+	auth.chainCertHash = nil
 	auth.HistoryCertCache = &HistoryCertData{authConfig, -1, -1}
 
 	IsAuthEnable = true
@@ -141,6 +145,7 @@ func (auth *Authority) ReloadCert(store *types.HistoryCertStore) error {
 
 	// 清空有效证书缓存
 	auth.validCertCache = auth.validCertCache[:0]
+	auth.chainCertHash = nil
 
 	// 更新最新历史数据
 	auth.HistoryCertCache = &HistoryCertData{auth.authConfig, store.CurHeigth, store.NxtHeight}
@@ -148,6 +153,34 @@ func (auth *Authority) ReloadCert(store *types.HistoryCertStore) error {
 	return nil
 }
 
+// CheckCertStore 校验链上证书数据能否构建校验器
+func (auth *Authority) CheckCertStore(store *types.HistoryCertStore) error {
+	if len(store.Rootcerts) == 0 {
+		return ty.ErrCertAuthority
+	}
+	_, err := core.GetLocalValidator(newAuthConfig(store), auth.signType)
+	return err
+}
+
+// ReloadCertFromChain 根据链上证书数据重建校验器，数据未变化时不重复加载
+func (auth *Authority) ReloadCertFromChain(store *types.HistoryCertStore) error {
+	if !IsAuthEnable {
+		return nil
+	}
+
+	hash := common.Sha256(types.Encode(store))
+	if bytes.Equal(hash, auth.chainCertHash) {
+		return nil
+	}
+	err := auth.ReloadCert(store)
+	if err != nil {
+		return err
+	}
+	auth.chainCertHash = hash
+
+	return nil
+}
+
 // ReloadCertByHeght 从新的authdir下的文件更新证书，用于证书更新
 func (auth *Authority) ReloadCertByHeght(currentHeight int64) error {
 	if !IsAuthEnable {
@@ -170,6 +203,7 @@ func (auth *Authority) ReloadCertByHeght(currentHeight int64) error {
 
 	// 清空有效证书缓存
 	auth.validCertCache = auth.validCertCache[:0]
+	auth.chainCertHash = nil
 
 	// 更新最新历史数据
 	auth.HistoryCertCache = &HistoryCertData{auth.authConfig, currentHeight, -1}
@@ -265,6 +299,18 @@ func (auth *Authority) Validate(signature *types.Signature) error {
 	return nil
 }
 
+// GetCertAttrs 获取签名中证书的权限属性
+func (auth *Authority) GetCertAttrs(signature *types.Signature) (*core.CertAttrs, error) {
+	cert, _, err := utils.DecodeCertFromSignature(signature.Signature)
+	if err != nil {
+		return nil, err
+	}
+	if len(cert) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	return core.GetCertAttrs(cert, auth.signType)
+}
+
 // ToHistoryCertStore 历史数据转成store可存储的历史数据
 func (certdata *HistoryCertData) ToHistoryCertStore(store *types.HistoryCertStore) {
 	if store == nil {
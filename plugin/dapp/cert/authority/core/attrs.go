@@ -0,0 +1,70 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package core
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	ty "github.com/33cn/plugin/plugin/dapp/cert/types"
+	"github.com/tjfoc/gmsm/sm2"
+)
+
+// RoleExtensionOID 证书角色私有扩展，值为UTF8String，多个角色以逗号分隔
+var RoleExtensionOID = asn1.ObjectIdentifier{1, 2, 156, 33, 1, 1}
+
+// CertAttrs 证书中用于权限控制的属性
+type CertAttrs struct {
+	OUs    []string
+	Roles  []string
+	Issuer string
+}
+
+// GetCertAttrs 解析证书的OU、角色扩展和签发CA
+func GetCertAttrs(certByte []byte, signType int) (*CertAttrs, error) {
+	pemCert, _ := pem.Decode(certByte)
+	if pemCert == nil {
+		return nil, fmt.Errorf("GetCertAttrs error: could not decode pem bytes")
+	}
+
+	var subject, issuer pkix.Name
+	var exts []pkix.Extension
+	if signType == ty.AuthSM2 {
+		cert, err := sm2.ParseCertificate(pemCert.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("GetCertAttrs error: failed to parse sm2 cert, err %s", err)
+		}
+		subject, issuer, exts = cert.Subject, cert.Issuer, cert.Extensions
+	} else {
+		cert, err := x509.ParseCertificate(pemCert.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("GetCertAttrs error: failed to parse x509 cert, err %s", err)
+		}
+		subject, issuer, exts = cert.Subject, cert.Issuer, cert.Extensions
+	}
+
+	attrs := &CertAttrs{OUs: subject.OrganizationalUnit, Issuer: issuer.CommonName}
+	for _, ext := range exts {
+		if !ext.Id.Equal(RoleExtensionOID) {
+			continue
+		}
+		var roles string
+		_, err := asn1.Unmarshal(ext.Value, &roles)
+		if err != nil {
+			return nil, fmt.Errorf("GetCertAttrs error: failed to unmarshal role extension, err %s", err)
+		}
+		for _, role := range strings.Split(roles, ",") {
+			if role = strings.TrimSpace(role); role != "" {
+				attrs.Roles = append(attrs.Roles, role)
+			}
+		}
+	}
+
+	return attrs, nil
+}
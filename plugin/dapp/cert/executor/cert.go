@@ -73,6 +73,17 @@ func (c *Cert) CheckTx(tx *types.Transaction, index int) error {
 		return ct.ErrInitializeAuthority
 	}
 
+	// 链上证书生效后以状态数据为准，各节点校验结果一致
+	if c.GetAPI().GetConfig().IsDappFork(c.GetHeight(), ct.CertX, ct.ForkCertAuthority) {
+		loaded, err := c.loadAuthorityFromChain()
+		if err != nil {
+			return err
+		}
+		if loaded {
+			return c.validateTx(tx)
+		}
+	}
+
 	// 重启
 	if authority.Author.HistoryCertCache.CurHeight == -1 {
 		err := c.loadHistoryByPrefix()
@@ -99,7 +110,7 @@ func (c *Cert) CheckTx(tx *types.Transaction, index int) error {
 	}
 
 	// auth校验
-	return authority.Author.Validate(tx.GetSignature())
+	return c.validateTx(tx)
 }
 
 /**
@@ -161,6 +172,13 @@ func (c *Cert) loadHistoryByHeight() error {
 	return ct.ErrGetHistoryCertData
 }
 
+/**
+根据状态数据中的链上证书重建校验器，链上还没有证书时返回false
+*/
+func (c *Cert) loadAuthorityFromChain() (bool, error) {
+	return loadAuthority(c.GetStateDB())
+}
+
 // CheckReceiptExecOk return true to check if receipt ty is ok
 func (c *Cert) CheckReceiptExecOk() bool {
 	return true
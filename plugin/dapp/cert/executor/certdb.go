@@ -0,0 +1,249 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	manager "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/cert/authority"
+	ct "github.com/33cn/plugin/plugin/dapp/cert/types"
+	"github.com/pkg/errors"
+)
+
+func calcCertAuthorityKey() []byte {
+	return []byte("mavl-cert-authority")
+}
+
+func calcCertAdminKey() []byte {
+	return []byte("mavl-cert-admin")
+}
+
+type action struct {
+	api      client.QueueProtocolAPI
+	db       dbm.KV
+	fromaddr string
+	height   int64
+}
+
+func newAction(c *Cert, tx *types.Transaction) *action {
+	return &action{
+		api:      c.GetAPI(),
+		db:       c.GetStateDB(),
+		fromaddr: tx.From(),
+		height:   c.GetHeight(),
+	}
+}
+
+func getCertAuthority(db dbm.KV) (*ct.CertAuthorityStore, error) {
+	value, err := db.Get(calcCertAuthorityKey())
+	if err != nil {
+		return nil, err
+	}
+	var store ct.CertAuthorityStore
+	err = types.Decode(value, &store)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode cert authority")
+	}
+	return &store, nil
+}
+
+//其他执行器校验权限策略时也需要按状态数据重建校验器，不能依赖cert执行器的CheckTx
+func loadAuthority(db dbm.KV) (bool, error) {
+	store, err := getCertAuthority(db)
+	if err == types.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, authority.Author.ReloadCertFromChain(toHistoryCertStore(store))
+}
+
+//未设置管理员时返回空地址
+func getCertAdmin(db dbm.KV) (string, error) {
+	value, err := db.Get(calcCertAdminKey())
+	if err == types.ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var admin ct.CertAdmin
+	err = types.Decode(value, &admin)
+	if err != nil {
+		return "", errors.Wrap(err, "decode cert admin")
+	}
+	return admin.Addr, nil
+}
+
+//链上证书数据转成历史证书格式，用于重建校验器
+func toHistoryCertStore(store *ct.CertAuthorityStore) *types.HistoryCertStore {
+	return &types.HistoryCertStore{
+		Rootcerts:         store.RootCerts,
+		IntermediateCerts: store.IntermediateCerts,
+		RevocationList:    store.RevocationList,
+		CurHeigth:         store.Height,
+		NxtHeight:         -1,
+	}
+}
+
+func isSuperManager(cfg *types.Chain33Config, addr string) bool {
+	confManager := types.ConfSub(cfg, manager.ManageX)
+	for _, m := range confManager.GStrList("superManager") {
+		if addr == m {
+			return true
+		}
+	}
+	return false
+}
+
+//设置了管理员以管理员为准，否则由超级管理员管理
+func (a *action) checkAdmin() error {
+	admin, err := getCertAdmin(a.db)
+	if err != nil {
+		return err
+	}
+	if admin != "" {
+		if a.fromaddr != admin {
+			return errors.Wrapf(ct.ErrCertAdmin, "from=%s,admin=%s", a.fromaddr, admin)
+		}
+		return nil
+	}
+	if !isSuperManager(a.api.GetConfig(), a.fromaddr) {
+		return errors.Wrapf(ct.ErrCertAdmin, "from=%s is not super manager", a.fromaddr)
+	}
+	return nil
+}
+
+func (a *action) checkFork() error {
+	if !a.api.GetConfig().IsDappFork(a.height, ct.CertX, ct.ForkCertAuthority) {
+		return errors.Wrapf(types.ErrNotSupport, "height=%d before %s", a.height, ct.ForkCertAuthority)
+	}
+	if !authority.IsAuthEnable {
+		return ct.ErrInitializeAuthority
+	}
+	return nil
+}
+
+func containCert(certs [][]byte, cert []byte) bool {
+	for _, c := range certs {
+		if bytes.Equal(c, cert) {
+			return true
+		}
+	}
+	return false
+}
+
+//重复的证书忽略
+func addCerts(certs, adds [][]byte) [][]byte {
+	ret := append([][]byte{}, certs...)
+	for _, c := range adds {
+		if !containCert(ret, c) {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func delCerts(certs, dels [][]byte) ([][]byte, error) {
+	for _, c := range dels {
+		if !containCert(certs, c) {
+			return nil, errors.Wrap(ct.ErrCertAuthority, "delete cert not exist")
+		}
+	}
+	var ret [][]byte
+	for _, c := range certs {
+		if !containCert(dels, c) {
+			ret = append(ret, c)
+		}
+	}
+	return ret, nil
+}
+
+func (a *action) authority(payload *ct.CertAuthority) (*types.Receipt, error) {
+	err := a.checkFork()
+	if err != nil {
+		return nil, err
+	}
+	if len(payload.RootCerts) == 0 && len(payload.IntermediateCerts) == 0 && len(payload.RevocationList) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidParam, "no cert")
+	}
+	err = a.checkAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := getCertAuthority(a.db)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if prev == nil {
+		prev = &ct.CertAuthorityStore{}
+	}
+
+	current := &ct.CertAuthorityStore{Height: a.height}
+	switch payload.Op {
+	case ct.CertOpAdd:
+		current.RootCerts = addCerts(prev.RootCerts, payload.RootCerts)
+		current.IntermediateCerts = addCerts(prev.IntermediateCerts, payload.IntermediateCerts)
+		current.RevocationList = addCerts(prev.RevocationList, payload.RevocationList)
+	case ct.CertOpDel:
+		if current.RootCerts, err = delCerts(prev.RootCerts, payload.RootCerts); err != nil {
+			return nil, errors.Wrap(err, "root")
+		}
+		if current.IntermediateCerts, err = delCerts(prev.IntermediateCerts, payload.IntermediateCerts); err != nil {
+			return nil, errors.Wrap(err, "intermediate")
+		}
+		if current.RevocationList, err = delCerts(prev.RevocationList, payload.RevocationList); err != nil {
+			return nil, errors.Wrap(err, "crl")
+		}
+	default:
+		return nil, errors.Wrapf(types.ErrInvalidParam, "op=%d", payload.Op)
+	}
+
+	//变更后的证书必须能构建校验器，否则各节点无法校验后续交易
+	err = authority.Author.CheckCertStore(toHistoryCertStore(current))
+	if err != nil {
+		return nil, errors.Wrapf(ct.ErrCertAuthority, "check cert store:%s", err)
+	}
+
+	log := &ct.ReceiptCertAuthority{Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: calcCertAuthorityKey(), Value: types.Encode(current)}},
+		Logs: []*types.ReceiptLog{{Ty: ct.TyLogCertAuthority, Log: types.Encode(log)}},
+	}, nil
+}
+
+func (a *action) admin(payload *ct.CertAdmin) (*types.Receipt, error) {
+	err := a.checkFork()
+	if err != nil {
+		return nil, err
+	}
+	err = address.CheckAddress(payload.Addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "addr=%s", payload.Addr)
+	}
+	err = a.checkAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := getCertAdmin(a.db)
+	if err != nil {
+		return nil, err
+	}
+	log := &ct.ReceiptCertAdmin{Prev: prev, Current: payload.Addr}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: calcCertAdminKey(), Value: types.Encode(payload)}},
+		Logs: []*types.ReceiptLog{{Ty: ct.TyLogCertAdmin, Log: types.Encode(log)}},
+	}, nil
+}
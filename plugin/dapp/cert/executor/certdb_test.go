@@ -0,0 +1,204 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ecdsa_util "github.com/33cn/plugin/plugin/crypto/ecdsa"
+	"github.com/33cn/plugin/plugin/dapp/cert/authority"
+	"github.com/33cn/plugin/plugin/dapp/cert/authority/utils"
+	ct "github.com/33cn/plugin/plugin/dapp/cert/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type testCA struct {
+	key  *ecdsa.PrivateKey
+	cert *x509.Certificate
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          []byte(name),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return &testCA{key, cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (ca *testCA) issue(t *testing.T, serial int64) (*ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "User"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.Nil(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func (ca *testCA) crl(t *testing.T, serial int64) []byte {
+	revoked := []pkix.RevokedCertificate{{SerialNumber: big.NewInt(serial), RevocationTime: time.Now()}}
+	der, err := ca.cert.CreateCRL(rand.Reader, ca.key, revoked, time.Now(), time.Now().Add(time.Hour))
+	assert.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der})
+}
+
+func certSignature(t *testing.T, key *ecdsa.PrivateKey, cert []byte) *types.Signature {
+	sig, err := utils.EncodeCertToSignature([]byte("sign"), cert)
+	assert.Nil(t, err)
+	return &types.Signature{Ty: ct.AuthECDSA, Pubkey: ecdsa_util.SerializePublicKey(&key.PublicKey), Signature: sig}
+}
+
+func TestCertAuthority(t *testing.T) {
+	//本地证书目录只有ca1
+	ca1 := newTestCA(t, "ca1")
+	ca2 := newTestCA(t, "ca2")
+	dir, err := ioutil.TempDir("", "certauth")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "cacerts"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "cacerts", "ca1.pem"), ca1.pem, 0644))
+	defer func() { authority.IsAuthEnable = false }()
+	assert.Nil(t, authority.Author.Init(&ct.Authority{Enable: true, CryptoPath: dir, SignType: "auth_ecdsa"}))
+
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"test\"", 1))
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 1024)
+	c := newCert().(*Cert)
+	c.SetAPI(api)
+	c.SetStateDB(stateDB)
+	c.SetEnv(10, 0, 0)
+
+	secp, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	adminKey, _ := secp.GenKey()
+	otherKey, _ := secp.GenKey()
+	exec := func(priv crypto.PrivKey, action *ct.CertAction) error {
+		tx := &types.Transaction{Execer: []byte(ct.CertX), Payload: types.Encode(action)}
+		tx.Sign(types.SECP256K1, priv)
+		var receipt *types.Receipt
+		if action.GetAdmin() != nil {
+			receipt, err = c.Exec_Admin(action.GetAdmin(), tx, 0)
+		} else {
+			receipt, err = c.Exec_Authority(action.GetAuthority(), tx, 0)
+		}
+		if err == nil {
+			for _, kv := range receipt.KV {
+				stateDB.Set(kv.Key, kv.Value)
+			}
+		}
+		return err
+	}
+	authAction := func(op int32, roots, crls [][]byte) *ct.CertAction {
+		return &ct.CertAction{Ty: ct.CertActionAuthority, Value: &ct.CertAction_Authority{
+			Authority: &ct.CertAuthority{Op: op, RootCerts: roots, RevocationList: crls}}}
+	}
+
+	//fork之前不支持
+	err = exec(adminKey, authAction(ct.CertOpAdd, [][]byte{ca2.pem}, nil))
+	assert.Equal(t, types.ErrNotSupport, errors.Cause(err))
+	cfg.RegisterDappFork(ct.CertX, ct.ForkCertAuthority, 0)
+
+	//设置管理员之前只有超级管理员可以操作
+	tx := &types.Transaction{}
+	tx.Sign(types.SECP256K1, adminKey)
+	adminAddr := tx.From()
+	err = exec(adminKey, &ct.CertAction{Ty: ct.CertActionAdmin, Value: &ct.CertAction_Admin{Admin: &ct.CertAdmin{Addr: adminAddr}}})
+	assert.Equal(t, ct.ErrCertAdmin, errors.Cause(err))
+	stateDB.Set(calcCertAdminKey(), types.Encode(&ct.CertAdmin{Addr: adminAddr}))
+
+	err = exec(otherKey, authAction(ct.CertOpAdd, [][]byte{ca2.pem}, nil))
+	assert.Equal(t, ct.ErrCertAdmin, errors.Cause(err))
+
+	//链上没有证书时使用本地证书
+	userKey, userCert := ca1.issue(t, 100)
+	loaded, err := c.loadAuthorityFromChain()
+	assert.Nil(t, err)
+	assert.False(t, loaded)
+	assert.Nil(t, authority.Author.Validate(certSignature(t, userKey, userCert)))
+
+	//链上证书生效后以链上为准，ca1签发的证书不再有效
+	assert.Nil(t, exec(adminKey, authAction(ct.CertOpAdd, [][]byte{ca2.pem}, nil)))
+	loaded, err = c.loadAuthorityFromChain()
+	assert.Nil(t, err)
+	assert.True(t, loaded)
+	assert.NotNil(t, authority.Author.Validate(certSignature(t, userKey, userCert)))
+
+	assert.Nil(t, exec(adminKey, authAction(ct.CertOpAdd, [][]byte{ca1.pem}, nil)))
+	_, err = c.loadAuthorityFromChain()
+	assert.Nil(t, err)
+	assert.Nil(t, authority.Author.Validate(certSignature(t, userKey, userCert)))
+
+	//吊销用户证书
+	crl := ca1.crl(t, 100)
+	assert.Nil(t, exec(adminKey, authAction(ct.CertOpAdd, nil, [][]byte{crl})))
+	_, err = c.loadAuthorityFromChain()
+	assert.Nil(t, err)
+	assert.NotNil(t, authority.Author.Validate(certSignature(t, userKey, userCert)))
+
+	store, err := getCertAuthority(stateDB)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(store.RootCerts))
+	assert.Equal(t, 1, len(store.RevocationList))
+	assert.Equal(t, int64(10), store.Height)
+
+	//无效证书和删除全部根证书都不允许
+	err = exec(adminKey, authAction(ct.CertOpAdd, [][]byte{[]byte("invalid")}, nil))
+	assert.Equal(t, ct.ErrCertAuthority, errors.Cause(err))
+	err = exec(adminKey, authAction(ct.CertOpDel, [][]byte{ca1.pem, ca2.pem}, nil))
+	assert.Equal(t, ct.ErrCertAuthority, errors.Cause(err))
+	err = exec(adminKey, authAction(ct.CertOpDel, nil, [][]byte{ca2.crl(t, 1)}))
+	assert.Equal(t, ct.ErrCertAuthority, errors.Cause(err))
+
+	//撤销吊销列表
+	assert.Nil(t, exec(adminKey, authAction(ct.CertOpDel, nil, [][]byte{crl})))
+	_, err = c.loadAuthorityFromChain()
+	assert.Nil(t, err)
+	assert.Nil(t, authority.Author.Validate(certSignature(t, userKey, userCert)))
+
+	//管理员转移
+	tx = &types.Transaction{}
+	tx.Sign(types.SECP256K1, otherKey)
+	err = exec(adminKey, &ct.CertAction{Ty: ct.CertActionAdmin, Value: &ct.CertAction_Admin{Admin: &ct.CertAdmin{Addr: tx.From()}}})
+	assert.Nil(t, err)
+	err = exec(adminKey, authAction(ct.CertOpAdd, [][]byte{ca2.pem}, nil))
+	assert.Equal(t, ct.ErrCertAdmin, errors.Cause(err))
+}
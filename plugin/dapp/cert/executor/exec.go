@@ -0,0 +1,28 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ct "github.com/33cn/plugin/plugin/dapp/cert/types"
+)
+
+// Exec_Authority 链上证书和吊销列表变更
+func (c *Cert) Exec_Authority(payload *ct.CertAuthority, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(c, tx)
+	return action.authority(payload)
+}
+
+// Exec_Admin 链上证书管理员变更
+func (c *Cert) Exec_Admin(payload *ct.CertAdmin, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(c, tx)
+	return action.admin(payload)
+}
+
+// Exec_Policy 执行器权限策略变更
+func (c *Cert) Exec_Policy(payload *ct.CertPolicySet, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(c, tx)
+	return action.policy(payload)
+}
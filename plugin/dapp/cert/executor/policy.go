@@ -0,0 +1,182 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"strings"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/cert/authority"
+	"github.com/33cn/plugin/plugin/dapp/cert/authority/core"
+	ct "github.com/33cn/plugin/plugin/dapp/cert/types"
+	"github.com/pkg/errors"
+)
+
+//action名称不区分大小写，和执行器类型ActionName的返回值一致
+func calcCertPolicyKey(execer, action string) []byte {
+	return []byte(fmt.Sprintf("mavl-cert-policy-%s-%s", execer, strings.ToLower(action)))
+}
+
+func getCertPolicy(db dbm.KV, execer, action string) (*ct.CertPolicy, error) {
+	value, err := db.Get(calcCertPolicyKey(execer, action))
+	if err != nil {
+		return nil, err
+	}
+	var policy ct.CertPolicy
+	err = types.Decode(value, &policy)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode cert policy")
+	}
+	return &policy, nil
+}
+
+//删除的策略保留为没有属性要求的空策略
+func isEmptyPolicy(policy *ct.CertPolicy) bool {
+	return policy == nil || (len(policy.Ous) == 0 && len(policy.Roles) == 0 && len(policy.Issuers) == 0)
+}
+
+//先匹配具体action的策略，没有时匹配执行器下所有action的策略，都没有返回nil
+func findCertPolicy(db dbm.KV, execer, action string) (*ct.CertPolicy, error) {
+	for _, act := range []string{action, ""} {
+		policy, err := getCertPolicy(db, execer, act)
+		if err == nil {
+			if isEmptyPolicy(policy) {
+				continue
+			}
+			return policy, nil
+		}
+		if err != types.ErrNotFound {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func containAny(required, has []string) bool {
+	for _, r := range required {
+		for _, h := range has {
+			if r == h {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func checkCertPolicy(policy *ct.CertPolicy, attrs *core.CertAttrs) error {
+	name := policy.Execer
+	if policy.Action != "" {
+		name += "." + policy.Action
+	}
+	if len(policy.Ous) > 0 && !containAny(policy.Ous, attrs.OUs) {
+		return errors.Wrapf(ct.ErrCertPermission, "%s requires OU in %v, cert OU %v", name, policy.Ous, attrs.OUs)
+	}
+	if len(policy.Roles) > 0 && !containAny(policy.Roles, attrs.Roles) {
+		return errors.Wrapf(ct.ErrCertPermission, "%s requires role in %v, cert role %v", name, policy.Roles, attrs.Roles)
+	}
+	if len(policy.Issuers) > 0 && !containAny(policy.Issuers, []string{attrs.Issuer}) {
+		return errors.Wrapf(ct.ErrCertPermission, "%s requires issuer in %v, cert issuer %s", name, policy.Issuers, attrs.Issuer)
+	}
+	return nil
+}
+
+// CheckPolicy 按交易的执行器和action查找权限策略，校验签名证书的属性
+// 证书签名的交易不经过cert执行器的CheckTx，ct.PolicyExecers中的执行器需要在各自的CheckTx中调用
+// 策略只约束交易，Query没有签名不做校验，隐私审计等数据的查询权限需要在查询接口之外控制
+func CheckPolicy(cfg *types.Chain33Config, db dbm.KV, height int64, tx *types.Transaction) error {
+	if !cfg.IsDappFork(height, ct.CertX, ct.ForkCertPolicy) {
+		return nil
+	}
+	return checkPermission(db, tx)
+}
+
+func checkPermission(db dbm.KV, tx *types.Transaction) error {
+	execer := string(types.GetRealExecName(tx.Execer))
+	action := "unknown"
+	if exec := types.LoadExecutorType(execer); exec != nil {
+		action = exec.ActionName(tx)
+	}
+	policy, err := findCertPolicy(db, execer, action)
+	if err != nil || policy == nil {
+		return err
+	}
+
+	ty := tx.GetSignature().GetTy()
+	if ty != ct.AuthECDSA && ty != ct.AuthSM2 {
+		return errors.Wrapf(ct.ErrCertPermission, "%s.%s requires cert signature, sign type %d", execer, action, ty)
+	}
+	//证书属性只在证书由链上(或本地)根证书签发时可信，自签名证书可以填写任意OU
+	if !authority.IsAuthEnable {
+		return ct.ErrInitializeAuthority
+	}
+	_, err = loadAuthority(db)
+	if err != nil {
+		return err
+	}
+	err = authority.Author.Validate(tx.GetSignature())
+	if err != nil {
+		return errors.Wrapf(ct.ErrCertPermission, "%s.%s validate cert:%s", execer, action, err)
+	}
+	attrs, err := authority.Author.GetCertAttrs(tx.GetSignature())
+	if err != nil {
+		return errors.Wrapf(ct.ErrCertPermission, "get cert attrs:%s", err)
+	}
+	return checkCertPolicy(policy, attrs)
+}
+
+//证书校验通过后，再按链上权限策略校验证书属性
+func (c *Cert) validateTx(tx *types.Transaction) error {
+	err := authority.Author.Validate(tx.GetSignature())
+	if err != nil {
+		return err
+	}
+	return CheckPolicy(c.GetAPI().GetConfig(), c.GetStateDB(), c.GetHeight(), tx)
+}
+
+func (a *action) policy(payload *ct.CertPolicySet) (*types.Receipt, error) {
+	if !a.api.GetConfig().IsDappFork(a.height, ct.CertX, ct.ForkCertPolicy) {
+		return nil, errors.Wrapf(types.ErrNotSupport, "height=%d before %s", a.height, ct.ForkCertPolicy)
+	}
+	policy := payload.Policy
+	if policy == nil || policy.Execer == "" {
+		return nil, errors.Wrap(types.ErrInvalidParam, "policy execer is empty")
+	}
+	if !ct.PolicyExecers[policy.Execer] {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "execer %s does not check cert policy", policy.Execer)
+	}
+	err := a.checkAdmin()
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := getCertPolicy(a.db, policy.Execer, policy.Action)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+
+	current := &ct.CertPolicy{Execer: policy.Execer, Action: policy.Action}
+	switch payload.Op {
+	case ct.CertOpAdd:
+		if isEmptyPolicy(policy) {
+			return nil, errors.Wrap(types.ErrInvalidParam, "policy has no attribute")
+		}
+		current = policy
+	case ct.CertOpDel:
+		if isEmptyPolicy(prev) {
+			return nil, errors.Wrapf(types.ErrNotFound, "policy %s-%s", policy.Execer, policy.Action)
+		}
+	default:
+		return nil, errors.Wrapf(types.ErrInvalidParam, "op=%d", payload.Op)
+	}
+
+	log := &ct.ReceiptCertPolicy{Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: calcCertPolicyKey(policy.Execer, policy.Action), Value: types.Encode(current)}},
+		Logs: []*types.ReceiptLog{{Ty: ct.TyLogCertPolicy, Log: types.Encode(log)}},
+	}, nil
+}
@@ -0,0 +1,178 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/cert/authority"
+	"github.com/33cn/plugin/plugin/dapp/cert/authority/core"
+	ct "github.com/33cn/plugin/plugin/dapp/cert/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func (ca *testCA) issueWithAttrs(t *testing.T, ous []string, roles string) (*ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "User", OrganizationalUnit: ous},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	if roles != "" {
+		value, err := asn1.Marshal(roles)
+		assert.Nil(t, err)
+		tmpl.ExtraExtensions = []pkix.Extension{{Id: core.RoleExtensionOID, Value: value}}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	assert.Nil(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func selfSignedWithAttrs(t *testing.T, ous []string) (*ecdsa.PrivateKey, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "User", OrganizationalUnit: ous},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.Nil(t, err)
+	return key, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestGetCertAttrs(t *testing.T) {
+	ca := newTestCA(t, "ca1")
+	_, cert := ca.issueWithAttrs(t, []string{"issuer", "dev"}, "admin, auditor")
+	attrs, err := core.GetCertAttrs(cert, ct.AuthECDSA)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"issuer", "dev"}, attrs.OUs)
+	assert.Equal(t, []string{"admin", "auditor"}, attrs.Roles)
+	assert.Equal(t, "ca1", attrs.Issuer)
+
+	policy := &ct.CertPolicy{Execer: "token", Action: "TokenPreCreate", Ous: []string{"issuer"}}
+	assert.Nil(t, checkCertPolicy(policy, attrs))
+	policy.Roles = []string{"operator"}
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(checkCertPolicy(policy, attrs)))
+	policy.Roles = []string{"auditor"}
+	policy.Issuers = []string{"ca2"}
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(checkCertPolicy(policy, attrs)))
+}
+
+func TestCertPolicy(t *testing.T) {
+	ca := newTestCA(t, "ca1")
+	dir, err := ioutil.TempDir("", "certpolicy")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "cacerts"), 0755))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, "cacerts", "ca1.pem"), ca.pem, 0644))
+	defer func() { authority.IsAuthEnable = false }()
+	assert.Nil(t, authority.Author.Init(&ct.Authority{Enable: true, CryptoPath: dir, SignType: "auth_ecdsa"}))
+
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"test\"", 1))
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 1024)
+	c := newCert().(*Cert)
+	c.SetAPI(api)
+	c.SetStateDB(stateDB)
+	c.SetEnv(10, 0, 0)
+
+	secp, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	adminKey, _ := secp.GenKey()
+	tx := &types.Transaction{}
+	tx.Sign(types.SECP256K1, adminKey)
+	stateDB.Set(calcCertAdminKey(), types.Encode(&ct.CertAdmin{Addr: tx.From()}))
+	setPolicy := func(op int32, policy *ct.CertPolicy) error {
+		tx := &types.Transaction{Execer: []byte(ct.CertX)}
+		tx.Sign(types.SECP256K1, adminKey)
+		receipt, err := c.Exec_Policy(&ct.CertPolicySet{Op: op, Policy: policy}, tx, 0)
+		if err == nil {
+			for _, kv := range receipt.KV {
+				stateDB.Set(kv.Key, kv.Value)
+			}
+		}
+		return err
+	}
+	normalTx := func(key *ecdsa.PrivateKey, cert []byte) *types.Transaction {
+		action := &ct.CertAction{Ty: ct.CertActionNormal, Value: &ct.CertAction_Normal{Normal: &ct.CertNormal{Key: "k"}}}
+		tx := &types.Transaction{Execer: []byte(ct.CertX), Payload: types.Encode(action)}
+		tx.Signature = certSignature(t, key, cert)
+		return tx
+	}
+
+	issuerKey, issuerCert := ca.issueWithAttrs(t, []string{"issuer"}, "")
+	auditorKey, auditorCert := ca.issueWithAttrs(t, []string{"auditor"}, "auditor")
+
+	policy := &ct.CertPolicy{Execer: ct.CertX, Action: "Normal", Ous: []string{"issuer"}}
+	err = setPolicy(ct.CertOpAdd, policy)
+	assert.Equal(t, types.ErrNotSupport, errors.Cause(err))
+	cfg.RegisterDappFork(ct.CertX, ct.ForkCertPolicy, 0)
+
+	//没有策略时证书有效即可
+	assert.Nil(t, c.validateTx(normalTx(auditorKey, auditorCert)))
+
+	assert.Nil(t, setPolicy(ct.CertOpAdd, policy))
+	assert.Nil(t, c.validateTx(normalTx(issuerKey, issuerCert)))
+	err = c.validateTx(normalTx(auditorKey, auditorCert))
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(err))
+
+	//非证书签名的交易在有策略时拒绝
+	tx = normalTx(issuerKey, issuerCert)
+	tx.Sign(types.SECP256K1, adminKey)
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(checkPermission(stateDB, tx)))
+
+	//属性匹配但不是受信根证书签发的证书拒绝，包括同名的伪造CA和自签名证书
+	rogue := newTestCA(t, "ca1")
+	rogueKey, rogueCert := rogue.issueWithAttrs(t, []string{"issuer"}, "")
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(checkPermission(stateDB, normalTx(rogueKey, rogueCert))))
+	selfKey, selfCert := selfSignedWithAttrs(t, []string{"issuer"})
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(checkPermission(stateDB, normalTx(selfKey, selfCert))))
+	assert.Nil(t, checkPermission(stateDB, normalTx(issuerKey, issuerCert)))
+
+	//执行器级别策略在没有action策略时生效
+	assert.Nil(t, setPolicy(ct.CertOpAdd, &ct.CertPolicy{Execer: ct.CertX, Roles: []string{"auditor"}}))
+	assert.Nil(t, setPolicy(ct.CertOpDel, &ct.CertPolicy{Execer: ct.CertX, Action: "Normal"}))
+	assert.Nil(t, c.validateTx(normalTx(auditorKey, auditorCert)))
+	err = c.validateTx(normalTx(issuerKey, issuerCert))
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(err))
+
+	err = setPolicy(ct.CertOpDel, &ct.CertPolicy{Execer: ct.CertX, Action: "Normal"})
+	assert.Equal(t, types.ErrNotFound, errors.Cause(err))
+	err = setPolicy(ct.CertOpAdd, &ct.CertPolicy{Execer: ct.CertX})
+	assert.Equal(t, types.ErrInvalidParam, errors.Cause(err))
+	//不在CheckTx中校验策略的执行器不能设置策略
+	err = setPolicy(ct.CertOpAdd, &ct.CertPolicy{Execer: "coins", Ous: []string{"issuer"}})
+	assert.Equal(t, types.ErrInvalidParam, errors.Cause(err))
+
+	p, err := c.Query_GetCertPolicy(&ct.CertPolicy{Execer: ct.CertX, Action: "Normal"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"auditor"}, p.(*ct.CertPolicy).Roles)
+}
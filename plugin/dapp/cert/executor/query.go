@@ -0,0 +1,39 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	ct "github.com/33cn/plugin/plugin/dapp/cert/types"
+)
+
+// Query_GetCertAuthority 查询链上证书和吊销列表
+func (c *Cert) Query_GetCertAuthority(in *types.ReqNil) (types.Message, error) {
+	return getCertAuthority(c.GetStateDB())
+}
+
+// Query_GetCertAdmin 查询链上证书管理员
+func (c *Cert) Query_GetCertAdmin(in *types.ReqNil) (types.Message, error) {
+	admin, err := getCertAdmin(c.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyString{Data: admin}, nil
+}
+
+// Query_GetCertPolicy 查询执行器action对应的权限策略
+func (c *Cert) Query_GetCertPolicy(in *ct.CertPolicy) (types.Message, error) {
+	if in == nil || in.Execer == "" {
+		return nil, types.ErrInvalidParam
+	}
+	policy, err := findCertPolicy(c.GetStateDB(), in.Execer, in.Action)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, types.ErrNotFound
+	}
+	return policy, nil
+}
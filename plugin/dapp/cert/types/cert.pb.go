@@ -89,6 +89,9 @@ type CertAction struct {
 	//	*CertAction_New
 	//	*CertAction_Update
 	//	*CertAction_Normal
+	//	*CertAction_Authority
+	//	*CertAction_Admin
+	//	*CertAction_Policy
 	Value                isCertAction_Value `protobuf_oneof:"value"`
 	Ty                   int32              `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -137,12 +140,30 @@ type CertAction_Normal struct {
 	Normal *CertNormal `protobuf:"bytes,3,opt,name=normal,proto3,oneof"`
 }
 
+type CertAction_Authority struct {
+	Authority *CertAuthority `protobuf:"bytes,5,opt,name=authority,proto3,oneof"`
+}
+
+type CertAction_Admin struct {
+	Admin *CertAdmin `protobuf:"bytes,6,opt,name=admin,proto3,oneof"`
+}
+
+type CertAction_Policy struct {
+	Policy *CertPolicySet `protobuf:"bytes,7,opt,name=policy,proto3,oneof"`
+}
+
 func (*CertAction_New) isCertAction_Value() {}
 
 func (*CertAction_Update) isCertAction_Value() {}
 
 func (*CertAction_Normal) isCertAction_Value() {}
 
+func (*CertAction_Authority) isCertAction_Value() {}
+
+func (*CertAction_Admin) isCertAction_Value() {}
+
+func (*CertAction_Policy) isCertAction_Value() {}
+
 func (m *CertAction) GetValue() isCertAction_Value {
 	if m != nil {
 		return m.Value
@@ -171,6 +192,27 @@ func (m *CertAction) GetNormal() *CertNormal {
 	return nil
 }
 
+func (m *CertAction) GetAuthority() *CertAuthority {
+	if x, ok := m.GetValue().(*CertAction_Authority); ok {
+		return x.Authority
+	}
+	return nil
+}
+
+func (m *CertAction) GetAdmin() *CertAdmin {
+	if x, ok := m.GetValue().(*CertAction_Admin); ok {
+		return x.Admin
+	}
+	return nil
+}
+
+func (m *CertAction) GetPolicy() *CertPolicySet {
+	if x, ok := m.GetValue().(*CertAction_Policy); ok {
+		return x.Policy
+	}
+	return nil
+}
+
 func (m *CertAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -184,6 +226,9 @@ func (*CertAction) XXX_OneofWrappers() []interface{} {
 		(*CertAction_New)(nil),
 		(*CertAction_Update)(nil),
 		(*CertAction_Normal)(nil),
+		(*CertAction_Authority)(nil),
+		(*CertAction_Admin)(nil),
+		(*CertAction_Policy)(nil),
 	}
 }
 
@@ -328,6 +373,435 @@ func (m *CertNormal) GetValue() []byte {
 	return nil
 }
 
+// 链上证书变更，op: 1 添加，2 删除
+type CertAuthority struct {
+	Op                   int32    `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
+	RootCerts            [][]byte `protobuf:"bytes,2,rep,name=rootCerts,proto3" json:"rootCerts,omitempty"`
+	IntermediateCerts    [][]byte `protobuf:"bytes,3,rep,name=intermediateCerts,proto3" json:"intermediateCerts,omitempty"`
+	RevocationList       [][]byte `protobuf:"bytes,4,rep,name=revocationList,proto3" json:"revocationList,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertAuthority) Reset()         { *m = CertAuthority{} }
+func (m *CertAuthority) String() string { return proto.CompactTextString(m) }
+func (*CertAuthority) ProtoMessage()    {}
+func (*CertAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{5}
+}
+
+func (m *CertAuthority) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertAuthority.Unmarshal(m, b)
+}
+func (m *CertAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertAuthority.Marshal(b, m, deterministic)
+}
+func (m *CertAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertAuthority.Merge(m, src)
+}
+func (m *CertAuthority) XXX_Size() int {
+	return xxx_messageInfo_CertAuthority.Size(m)
+}
+func (m *CertAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertAuthority proto.InternalMessageInfo
+
+func (m *CertAuthority) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *CertAuthority) GetRootCerts() [][]byte {
+	if m != nil {
+		return m.RootCerts
+	}
+	return nil
+}
+
+func (m *CertAuthority) GetIntermediateCerts() [][]byte {
+	if m != nil {
+		return m.IntermediateCerts
+	}
+	return nil
+}
+
+func (m *CertAuthority) GetRevocationList() [][]byte {
+	if m != nil {
+		return m.RevocationList
+	}
+	return nil
+}
+
+// 变更链上证书管理员
+type CertAdmin struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertAdmin) Reset()         { *m = CertAdmin{} }
+func (m *CertAdmin) String() string { return proto.CompactTextString(m) }
+func (*CertAdmin) ProtoMessage()    {}
+func (*CertAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{6}
+}
+
+func (m *CertAdmin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertAdmin.Unmarshal(m, b)
+}
+func (m *CertAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertAdmin.Marshal(b, m, deterministic)
+}
+func (m *CertAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertAdmin.Merge(m, src)
+}
+func (m *CertAdmin) XXX_Size() int {
+	return xxx_messageInfo_CertAdmin.Size(m)
+}
+func (m *CertAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertAdmin proto.InternalMessageInfo
+
+func (m *CertAdmin) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+// 链上证书状态，height为最近一次变更的区块高度
+type CertAuthorityStore struct {
+	RootCerts            [][]byte `protobuf:"bytes,1,rep,name=rootCerts,proto3" json:"rootCerts,omitempty"`
+	IntermediateCerts    [][]byte `protobuf:"bytes,2,rep,name=intermediateCerts,proto3" json:"intermediateCerts,omitempty"`
+	RevocationList       [][]byte `protobuf:"bytes,3,rep,name=revocationList,proto3" json:"revocationList,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertAuthorityStore) Reset()         { *m = CertAuthorityStore{} }
+func (m *CertAuthorityStore) String() string { return proto.CompactTextString(m) }
+func (*CertAuthorityStore) ProtoMessage()    {}
+func (*CertAuthorityStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{7}
+}
+
+func (m *CertAuthorityStore) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertAuthorityStore.Unmarshal(m, b)
+}
+func (m *CertAuthorityStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertAuthorityStore.Marshal(b, m, deterministic)
+}
+func (m *CertAuthorityStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertAuthorityStore.Merge(m, src)
+}
+func (m *CertAuthorityStore) XXX_Size() int {
+	return xxx_messageInfo_CertAuthorityStore.Size(m)
+}
+func (m *CertAuthorityStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertAuthorityStore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertAuthorityStore proto.InternalMessageInfo
+
+func (m *CertAuthorityStore) GetRootCerts() [][]byte {
+	if m != nil {
+		return m.RootCerts
+	}
+	return nil
+}
+
+func (m *CertAuthorityStore) GetIntermediateCerts() [][]byte {
+	if m != nil {
+		return m.IntermediateCerts
+	}
+	return nil
+}
+
+func (m *CertAuthorityStore) GetRevocationList() [][]byte {
+	if m != nil {
+		return m.RevocationList
+	}
+	return nil
+}
+
+func (m *CertAuthorityStore) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ReceiptCertAuthority struct {
+	Prev                 *CertAuthorityStore `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *CertAuthorityStore `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReceiptCertAuthority) Reset()         { *m = ReceiptCertAuthority{} }
+func (m *ReceiptCertAuthority) String() string { return proto.CompactTextString(m) }
+func (*ReceiptCertAuthority) ProtoMessage()    {}
+func (*ReceiptCertAuthority) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{8}
+}
+
+func (m *ReceiptCertAuthority) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptCertAuthority.Unmarshal(m, b)
+}
+func (m *ReceiptCertAuthority) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptCertAuthority.Marshal(b, m, deterministic)
+}
+func (m *ReceiptCertAuthority) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptCertAuthority.Merge(m, src)
+}
+func (m *ReceiptCertAuthority) XXX_Size() int {
+	return xxx_messageInfo_ReceiptCertAuthority.Size(m)
+}
+func (m *ReceiptCertAuthority) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptCertAuthority.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptCertAuthority proto.InternalMessageInfo
+
+func (m *ReceiptCertAuthority) GetPrev() *CertAuthorityStore {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptCertAuthority) GetCurrent() *CertAuthorityStore {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptCertAdmin struct {
+	Prev                 string   `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              string   `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptCertAdmin) Reset()         { *m = ReceiptCertAdmin{} }
+func (m *ReceiptCertAdmin) String() string { return proto.CompactTextString(m) }
+func (*ReceiptCertAdmin) ProtoMessage()    {}
+func (*ReceiptCertAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{9}
+}
+
+func (m *ReceiptCertAdmin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptCertAdmin.Unmarshal(m, b)
+}
+func (m *ReceiptCertAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptCertAdmin.Marshal(b, m, deterministic)
+}
+func (m *ReceiptCertAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptCertAdmin.Merge(m, src)
+}
+func (m *ReceiptCertAdmin) XXX_Size() int {
+	return xxx_messageInfo_ReceiptCertAdmin.Size(m)
+}
+func (m *ReceiptCertAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptCertAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptCertAdmin proto.InternalMessageInfo
+
+func (m *ReceiptCertAdmin) GetPrev() string {
+	if m != nil {
+		return m.Prev
+	}
+	return ""
+}
+
+func (m *ReceiptCertAdmin) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
+// 执行器权限策略，action为空表示执行器下所有action，各属性列表非空时证书需满足其一
+type CertPolicy struct {
+	Execer               string   `protobuf:"bytes,1,opt,name=execer,proto3" json:"execer,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Ous                  []string `protobuf:"bytes,3,rep,name=ous,proto3" json:"ous,omitempty"`
+	Roles                []string `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	Issuers              []string `protobuf:"bytes,5,rep,name=issuers,proto3" json:"issuers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertPolicy) Reset()         { *m = CertPolicy{} }
+func (m *CertPolicy) String() string { return proto.CompactTextString(m) }
+func (*CertPolicy) ProtoMessage()    {}
+func (*CertPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{10}
+}
+
+func (m *CertPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertPolicy.Unmarshal(m, b)
+}
+func (m *CertPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertPolicy.Marshal(b, m, deterministic)
+}
+func (m *CertPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertPolicy.Merge(m, src)
+}
+func (m *CertPolicy) XXX_Size() int {
+	return xxx_messageInfo_CertPolicy.Size(m)
+}
+func (m *CertPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertPolicy proto.InternalMessageInfo
+
+func (m *CertPolicy) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *CertPolicy) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *CertPolicy) GetOus() []string {
+	if m != nil {
+		return m.Ous
+	}
+	return nil
+}
+
+func (m *CertPolicy) GetRoles() []string {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *CertPolicy) GetIssuers() []string {
+	if m != nil {
+		return m.Issuers
+	}
+	return nil
+}
+
+// 权限策略变更，op: 1 设置，2 删除
+type CertPolicySet struct {
+	Op                   int32       `protobuf:"varint,1,opt,name=op,proto3" json:"op,omitempty"`
+	Policy               *CertPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CertPolicySet) Reset()         { *m = CertPolicySet{} }
+func (m *CertPolicySet) String() string { return proto.CompactTextString(m) }
+func (*CertPolicySet) ProtoMessage()    {}
+func (*CertPolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{11}
+}
+
+func (m *CertPolicySet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertPolicySet.Unmarshal(m, b)
+}
+func (m *CertPolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertPolicySet.Marshal(b, m, deterministic)
+}
+func (m *CertPolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertPolicySet.Merge(m, src)
+}
+func (m *CertPolicySet) XXX_Size() int {
+	return xxx_messageInfo_CertPolicySet.Size(m)
+}
+func (m *CertPolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertPolicySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertPolicySet proto.InternalMessageInfo
+
+func (m *CertPolicySet) GetOp() int32 {
+	if m != nil {
+		return m.Op
+	}
+	return 0
+}
+
+func (m *CertPolicySet) GetPolicy() *CertPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type ReceiptCertPolicy struct {
+	Prev                 *CertPolicy `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *CertPolicy `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptCertPolicy) Reset()         { *m = ReceiptCertPolicy{} }
+func (m *ReceiptCertPolicy) String() string { return proto.CompactTextString(m) }
+func (*ReceiptCertPolicy) ProtoMessage()    {}
+func (*ReceiptCertPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a142e29cbef9b1cf, []int{12}
+}
+
+func (m *ReceiptCertPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptCertPolicy.Unmarshal(m, b)
+}
+func (m *ReceiptCertPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptCertPolicy.Marshal(b, m, deterministic)
+}
+func (m *ReceiptCertPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptCertPolicy.Merge(m, src)
+}
+func (m *ReceiptCertPolicy) XXX_Size() int {
+	return xxx_messageInfo_ReceiptCertPolicy.Size(m)
+}
+func (m *ReceiptCertPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptCertPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptCertPolicy proto.InternalMessageInfo
+
+func (m *ReceiptCertPolicy) GetPrev() *CertPolicy {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptCertPolicy) GetCurrent() *CertPolicy {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
 type Authority struct {
 	Enable               bool     `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
 	CryptoPath           string   `protobuf:"bytes,2,opt,name=cryptoPath,proto3" json:"cryptoPath,omitempty"`
@@ -341,7 +815,7 @@ func (m *Authority) Reset()         { *m = Authority{} }
 func (m *Authority) String() string { return proto.CompactTextString(m) }
 func (*Authority) ProtoMessage()    {}
 func (*Authority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a142e29cbef9b1cf, []int{5}
+	return fileDescriptor_a142e29cbef9b1cf, []int{13}
 }
 
 func (m *Authority) XXX_Unmarshal(b []byte) error {
@@ -389,6 +863,14 @@ func init() {
 	proto.RegisterType((*CertNew)(nil), "types.CertNew")
 	proto.RegisterType((*CertUpdate)(nil), "types.CertUpdate")
 	proto.RegisterType((*CertNormal)(nil), "types.CertNormal")
+	proto.RegisterType((*CertAuthority)(nil), "types.CertAuthority")
+	proto.RegisterType((*CertAdmin)(nil), "types.CertAdmin")
+	proto.RegisterType((*CertAuthorityStore)(nil), "types.CertAuthorityStore")
+	proto.RegisterType((*ReceiptCertAuthority)(nil), "types.ReceiptCertAuthority")
+	proto.RegisterType((*ReceiptCertAdmin)(nil), "types.ReceiptCertAdmin")
+	proto.RegisterType((*CertPolicy)(nil), "types.CertPolicy")
+	proto.RegisterType((*CertPolicySet)(nil), "types.CertPolicySet")
+	proto.RegisterType((*ReceiptCertPolicy)(nil), "types.ReceiptCertPolicy")
 	proto.RegisterType((*Authority)(nil), "types.Authority")
 }
 
@@ -397,24 +879,45 @@ func init() {
 }
 
 var fileDescriptor_a142e29cbef9b1cf = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4a, 0xf3, 0x40,
-	0x14, 0xed, 0x24, 0xfd, 0xbd, 0xfd, 0x28, 0x9f, 0x83, 0x48, 0x70, 0x21, 0x25, 0xab, 0x82, 0x10,
-	0xb0, 0xfa, 0x02, 0xd5, 0x4d, 0xdd, 0x14, 0x19, 0xea, 0x5a, 0xa6, 0xe9, 0xd5, 0x06, 0xd3, 0x4c,
-	0x98, 0xde, 0x58, 0xe6, 0x79, 0x7c, 0x51, 0x99, 0x1f, 0x25, 0x82, 0x0b, 0xdd, 0xe5, 0xdc, 0x73,
-	0x4e, 0xee, 0x39, 0xdc, 0x01, 0xc8, 0x51, 0x53, 0x56, 0x6b, 0x45, 0x8a, 0xf7, 0xc8, 0xd4, 0x78,
-	0x48, 0x9f, 0xa1, 0x7b, 0x87, 0x9a, 0xf8, 0x19, 0xf4, 0x2d, 0x79, 0xbf, 0x4d, 0xd8, 0x94, 0xcd,
-	0xfe, 0x89, 0x80, 0xf8, 0x05, 0x40, 0xae, 0x51, 0x12, 0xae, 0x8b, 0x3d, 0x26, 0xd1, 0x94, 0xcd,
-	0x62, 0xd1, 0x9a, 0xf0, 0xff, 0x10, 0xbf, 0xa2, 0x49, 0xe2, 0x29, 0x9b, 0x8d, 0x84, 0xfd, 0xe4,
-	0xa7, 0xd0, 0x7b, 0x93, 0x65, 0x83, 0x49, 0xd7, 0xfd, 0xc8, 0x83, 0xf4, 0x9d, 0x01, 0xd8, 0x45,
-	0x8b, 0x9c, 0x0a, 0x55, 0xf1, 0x14, 0xe2, 0x0a, 0x8f, 0x6e, 0xd7, 0x78, 0x3e, 0xc9, 0x5c, 0x96,
-	0xcc, 0xf2, 0x2b, 0x3c, 0x2e, 0x3b, 0xc2, 0x92, 0xfc, 0x12, 0xfa, 0x4d, 0xbd, 0x95, 0xe4, 0xd7,
-	0x8e, 0xe7, 0x27, 0x2d, 0xd9, 0xa3, 0x23, 0x96, 0x1d, 0x11, 0x24, 0x56, 0x5c, 0x29, 0xbd, 0x97,
-	0xa5, 0x8b, 0xf2, 0x5d, 0xbc, 0x72, 0x84, 0x15, 0x7b, 0x09, 0x9f, 0x40, 0x44, 0xc6, 0xe5, 0xeb,
-	0x89, 0x88, 0xcc, 0xed, 0x20, 0x44, 0x4e, 0xaf, 0x60, 0x10, 0x42, 0x7c, 0x16, 0x63, 0x3f, 0x14,
-	0x8b, 0xda, 0xc5, 0x6e, 0x7c, 0x2f, 0x1f, 0xe8, 0xaf, 0x2e, 0x9f, 0xec, 0xd7, 0xae, 0x27, 0x18,
-	0x2d, 0x1a, 0xda, 0x29, 0x5d, 0x90, 0xb1, 0x17, 0xc3, 0x4a, 0x6e, 0x4a, 0x74, 0xbe, 0xa1, 0x08,
-	0xc8, 0x5f, 0xcc, 0xd4, 0xa4, 0x1e, 0x24, 0xed, 0x9c, 0x7f, 0x24, 0x5a, 0x13, 0x7e, 0x0e, 0xc3,
-	0x43, 0xf1, 0x52, 0xad, 0x4d, 0x8d, 0xe1, 0x6c, 0x5f, 0x78, 0xd3, 0x77, 0x6f, 0xe3, 0xfa, 0x23,
-	0x00, 0x00, 0xff, 0xff, 0x1d, 0xbc, 0xa5, 0x33, 0x29, 0x02, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdb, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0xed, 0x38, 0xa9, 0xa7, 0x07, 0xb5, 0xab, 0xea, 0xd7, 0xfe, 0x08, 0x41, 0x64, 0x09,
+	0x14, 0x54, 0x88, 0x44, 0xdb, 0x07, 0xa0, 0x70, 0x13, 0x10, 0xaa, 0xaa, 0x6d, 0xb9, 0x46, 0x5b,
+	0x67, 0x68, 0x56, 0x24, 0x5e, 0x6b, 0xbd, 0x69, 0x31, 0x17, 0xbc, 0x04, 0x2f, 0xc0, 0x3b, 0xf0,
+	0x82, 0x68, 0x0f, 0x8e, 0x9d, 0x26, 0x12, 0xe5, 0x6e, 0x67, 0xe6, 0x9b, 0xd3, 0x37, 0xb3, 0x03,
+	0x90, 0xa1, 0xd2, 0xa3, 0x42, 0x49, 0x2d, 0x49, 0xac, 0xab, 0x02, 0xcb, 0xf4, 0x0b, 0x74, 0xdf,
+	0xa1, 0xd2, 0xe4, 0x3f, 0xe8, 0x19, 0xe3, 0xfb, 0x09, 0x0d, 0x06, 0xc1, 0x70, 0x87, 0x79, 0x89,
+	0x3c, 0x01, 0xc8, 0x14, 0x72, 0x8d, 0x57, 0x62, 0x8e, 0x34, 0x1c, 0x04, 0xc3, 0x88, 0xb5, 0x34,
+	0x64, 0x1f, 0xa2, 0xaf, 0x58, 0xd1, 0x68, 0x10, 0x0c, 0x13, 0x66, 0x9e, 0xe4, 0x10, 0xe2, 0x5b,
+	0x3e, 0x5b, 0x20, 0xed, 0xda, 0x40, 0x4e, 0x48, 0x7f, 0x87, 0x00, 0x26, 0xd1, 0x59, 0xa6, 0x85,
+	0xcc, 0x49, 0x0a, 0x51, 0x8e, 0x77, 0x36, 0xd7, 0xf6, 0xf1, 0xde, 0xc8, 0xd6, 0x32, 0x32, 0xf6,
+	0x73, 0xbc, 0x1b, 0x77, 0x98, 0x31, 0x92, 0x23, 0xe8, 0x2d, 0x8a, 0x09, 0xd7, 0x2e, 0xed, 0xf6,
+	0xf1, 0x41, 0x0b, 0xf6, 0xc9, 0x1a, 0xc6, 0x1d, 0xe6, 0x21, 0x06, 0x9c, 0x4b, 0x35, 0xe7, 0x33,
+	0x1a, 0xad, 0x81, 0xcf, 0xad, 0xc1, 0x80, 0x1d, 0x84, 0x9c, 0x42, 0xc2, 0x17, 0x7a, 0x2a, 0x95,
+	0xd0, 0x15, 0x8d, 0x2d, 0xfe, 0xb0, 0x85, 0x3f, 0xab, 0x6d, 0xe3, 0x0e, 0x6b, 0x80, 0x64, 0x08,
+	0x31, 0x9f, 0xcc, 0x45, 0x4e, 0x7b, 0xd6, 0x63, 0xbf, 0xed, 0x61, 0xf4, 0xe3, 0x0e, 0x73, 0x00,
+	0x32, 0x82, 0x5e, 0x21, 0x67, 0x22, 0xab, 0x68, 0x7f, 0x2d, 0xf8, 0x85, 0x35, 0x5c, 0xa2, 0x36,
+	0xf5, 0x38, 0x14, 0xd9, 0x83, 0x50, 0x57, 0x96, 0xaf, 0x98, 0x85, 0xba, 0x7a, 0xdb, 0xf7, 0x14,
+	0xa6, 0xaf, 0xa1, 0xef, 0x49, 0xa9, 0x89, 0x0e, 0x36, 0x10, 0x1d, 0xb6, 0x89, 0x3e, 0x05, 0x68,
+	0x08, 0xfa, 0x57, 0x2f, 0xc7, 0xd4, 0x83, 0xbd, 0x7e, 0x06, 0xb0, 0xbb, 0x42, 0x98, 0xe9, 0x44,
+	0x16, 0xd6, 0x31, 0x66, 0xa1, 0x2c, 0xc8, 0x63, 0x48, 0x94, 0x94, 0xda, 0x80, 0x4a, 0x1a, 0x0e,
+	0xa2, 0xe1, 0x0e, 0x6b, 0x14, 0xe4, 0x25, 0x1c, 0x88, 0x5c, 0xa3, 0x9a, 0xe3, 0x44, 0x70, 0x8d,
+	0x0e, 0x15, 0x59, 0xd4, 0xba, 0x81, 0x3c, 0x87, 0x3d, 0x85, 0xb7, 0x32, 0xe3, 0x66, 0x83, 0x3e,
+	0x8a, 0x52, 0xd3, 0xae, 0x85, 0xde, 0xd3, 0xa6, 0x4f, 0x21, 0x59, 0xce, 0x84, 0x10, 0xe8, 0xf2,
+	0xc9, 0x44, 0xf9, 0x5e, 0xec, 0x3b, 0xfd, 0x15, 0x00, 0x59, 0x29, 0xfb, 0x52, 0x4b, 0x85, 0xab,
+	0xb5, 0x06, 0x0f, 0xaa, 0x35, 0x7c, 0x78, 0xad, 0xd1, 0xa6, 0x5a, 0xcd, 0xb7, 0x9b, 0xa2, 0xb8,
+	0x99, 0x6a, 0x3b, 0xfd, 0x88, 0x79, 0x29, 0xfd, 0x0e, 0x87, 0x0c, 0x33, 0x14, 0x85, 0x5e, 0xe5,
+	0xf7, 0x15, 0x74, 0x0b, 0x85, 0xb7, 0xfe, 0xe3, 0xfc, 0xbf, 0x69, 0x69, 0x6d, 0x33, 0xcc, 0xc2,
+	0xc8, 0x09, 0xf4, 0xb3, 0x85, 0x52, 0x98, 0x6b, 0x1a, 0xfe, 0xcd, 0xa3, 0x46, 0xa6, 0x6f, 0x60,
+	0xbf, 0x9d, 0xbb, 0xa6, 0x71, 0x99, 0x37, 0xf1, 0xc1, 0xe9, 0x6a, 0xf0, 0xa4, 0x89, 0xf0, 0x03,
+	0xa0, 0x59, 0x75, 0xd3, 0x23, 0x7e, 0xc3, 0x0c, 0xeb, 0x21, 0x78, 0xc9, 0xe8, 0xb9, 0xbd, 0x06,
+	0xde, 0xdd, 0x4b, 0x66, 0xfb, 0xe4, 0xc2, 0xed, 0x41, 0xc2, 0xcc, 0xd3, 0x6c, 0x9f, 0x92, 0x33,
+	0x2c, 0xed, 0xc0, 0x13, 0xe6, 0x04, 0x93, 0x5f, 0x94, 0xe5, 0x02, 0x55, 0x49, 0x63, 0xab, 0xaf,
+	0xc5, 0xf4, 0x03, 0xec, 0x36, 0xf9, 0x2f, 0x51, 0xaf, 0xad, 0xe5, 0x8b, 0xe5, 0x07, 0x5d, 0x3f,
+	0x2d, 0xce, 0xab, 0xfe, 0x9b, 0xe9, 0x0d, 0x1c, 0xb4, 0xd8, 0xf0, 0x2d, 0x3d, 0x5b, 0x19, 0xc3,
+	0x06, 0x6f, 0xc7, 0xd0, 0xd1, 0x7d, 0xfa, 0x37, 0x20, 0x97, 0xa4, 0x7d, 0x86, 0xa4, 0x99, 0xb3,
+	0xe1, 0x2c, 0xe7, 0xd7, 0x33, 0xb4, 0x29, 0xb6, 0x98, 0x97, 0xdc, 0x39, 0xae, 0x0a, 0x2d, 0x2f,
+	0xb8, 0x9e, 0x7a, 0xde, 0x5a, 0x1a, 0xf2, 0x08, 0xb6, 0x4a, 0x71, 0x93, 0x5f, 0x55, 0x05, 0xfa,
+	0x9b, 0xbc, 0x94, 0xaf, 0x7b, 0xf6, 0xf0, 0x9f, 0xfc, 0x19, 0x00, 0x9e, 0x13, 0x03, 0x7c, 0x06,
+	0x06, 0x00, 0x00,
 }
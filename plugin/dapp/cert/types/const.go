@@ -10,8 +10,24 @@ var (
 	// ExecerCert cert执行器字节
 	ExecerCert = []byte(CertX)
 	actionName = map[string]int32{
-		"New":    CertActionNew,
-		"Update": CertActionUpdate,
-		"Normal": CertActionNormal,
+		"New":       CertActionNew,
+		"Update":    CertActionUpdate,
+		"Normal":    CertActionNormal,
+		"Authority": CertActionAuthority,
+		"Admin":     CertActionAdmin,
+		"Policy":    CertActionPolicy,
 	}
+	// PolicyExecers 在CheckTx中按权限策略校验证书属性的执行器，只能为这些执行器设置策略
+	PolicyExecers = map[string]bool{
+		CertX:   true,
+		"token": true,
+		"trade": true,
+	}
+)
+
+const (
+	//ForkCertAuthority 证书和吊销列表上链，各节点根据链上数据重建校验器
+	ForkCertAuthority = "ForkCertAuthority"
+	//ForkCertPolicy 按链上权限策略校验证书属性
+	ForkCertPolicy = "ForkCertPolicy"
 )
@@ -15,4 +15,10 @@ var (
 	ErrUnknowAuthSignType = errors.New("ErrUnknowAuthSignType")
 	// ErrInitializeAuthority 初始化校验器失败
 	ErrInitializeAuthority = errors.New("ErrInitializeAuthority")
+	// ErrCertAdmin 非证书管理员
+	ErrCertAdmin = errors.New("ErrCertAdmin")
+	// ErrCertAuthority 链上证书数据无效
+	ErrCertAuthority = errors.New("ErrCertAuthority")
+	// ErrCertPermission 证书属性不满足权限策略
+	ErrCertPermission = errors.New("ErrCertPermission")
 )
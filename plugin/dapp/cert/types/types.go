@@ -4,13 +4,35 @@
 
 package types
 
-import "github.com/33cn/chain33/types"
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
 
 //cert
 const (
 	CertActionNew    = 1
 	CertActionUpdate = 2
 	CertActionNormal = 3
+	//CertActionAuthority 链上证书变更
+	CertActionAuthority = 5
+	//CertActionAdmin 链上证书管理员变更
+	CertActionAdmin = 6
+	//CertActionPolicy 权限策略变更
+	CertActionPolicy = 7
+
+	//TyLogCertAuthority 链上证书变更log
+	TyLogCertAuthority = 1101
+	//TyLogCertAdmin 链上证书管理员变更log
+	TyLogCertAdmin = 1102
+	//TyLogCertPolicy 权限策略变更log
+	TyLogCertPolicy = 1103
+
+	//CertOpAdd 添加证书
+	CertOpAdd = 1
+	//CertOpDel 删除证书
+	CertOpDel = 2
 
 	AuthECDSA = 257
 	AuthSM2   = 258
@@ -26,6 +48,8 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(CertX, "Enable", 0)
+	cfg.RegisterDappFork(CertX, ForkCertAuthority, types.MaxHeight)
+	cfg.RegisterDappFork(CertX, ForkCertPolicy, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -58,7 +82,11 @@ func (b *CertType) GetName() string {
 
 // GetLogMap 获取logmap
 func (b *CertType) GetLogMap() map[int64]*types.LogInfo {
-	return nil
+	return map[int64]*types.LogInfo{
+		TyLogCertAuthority: {Ty: reflect.TypeOf(ReceiptCertAuthority{}), Name: "LogCertAuthority"},
+		TyLogCertAdmin:     {Ty: reflect.TypeOf(ReceiptCertAdmin{}), Name: "LogCertAdmin"},
+		TyLogCertPolicy:    {Ty: reflect.TypeOf(ReceiptCertPolicy{}), Name: "LogCertPolicy"},
+	}
 }
 
 // GetTypeMap 获取类型map
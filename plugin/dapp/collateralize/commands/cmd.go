@@ -27,6 +27,8 @@ func CollateralizeCmd() *cobra.Command {
 		CollateralizePriceFeedRawTxCmd(),
 		CollateralizeRetrieveRawTxCmd(),
 		CollateralizeManageRawTxCmd(),
+		CollateralizeBidRawTxCmd(),
+		CollateralizeAuctionSettleRawTxCmd(),
 		CollateralizeQueryCmd(),
 	)
 
@@ -87,6 +89,7 @@ func addCollateralizeBorrowFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("collateralizeID")
 	cmd.Flags().Float64P("value", "v", 0, "value")
 	cmd.MarkFlagRequired("value")
+	cmd.Flags().Int32P("collType", "c", 0, "collateral type, 0 or 1:bty, 2:btc, 3:eth")
 }
 
 //CollateralizeBorrow ...
@@ -100,11 +103,12 @@ func CollateralizeBorrow(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	collateralizeID, _ := cmd.Flags().GetString("collateralizeID")
 	value, _ := cmd.Flags().GetFloat64("value")
+	collType, _ := cmd.Flags().GetInt32("collType")
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     cfg.ExecName(pkt.CollateralizeX),
 		ActionName: "CollateralizeBorrow",
-		Payload:    []byte(fmt.Sprintf("{\"collateralizeID\":\"%s\",\"value\":%f}", collateralizeID, value)),
+		Payload:    []byte(fmt.Sprintf("{\"collateralizeID\":\"%s\",\"value\":%f,\"collType\":%d}", collateralizeID, value, collType)),
 	}
 
 	var res string
@@ -213,6 +217,7 @@ func addCollateralizePriceFeedFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("price")
 	cmd.Flags().Uint64P("volume", "v", 0, "volume")
 	cmd.MarkFlagRequired("volume")
+	cmd.Flags().Int32P("collType", "c", 0, "collateral type, 0 or 1:bty, 2:btc, 3:eth")
 }
 
 //CollateralizePriceFeed ...
@@ -226,11 +231,12 @@ func CollateralizePriceFeed(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	price, _ := cmd.Flags().GetFloat64("price")
 	volume, _ := cmd.Flags().GetUint64("volume")
+	collType, _ := cmd.Flags().GetInt32("collType")
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     cfg.ExecName(pkt.CollateralizeX),
 		ActionName: "CollateralizePriceFeed",
-		Payload:    []byte(fmt.Sprintf("{\"price\":[ %f ], \"volume\":[ %d ]}", price, volume)),
+		Payload:    []byte(fmt.Sprintf("{\"price\":[ %f ], \"volume\":[ %d ], \"collType\":%d}", price, volume, collType)),
 	}
 
 	var res string
@@ -296,6 +302,13 @@ func addCollateralizeManageFlags(cmd *cobra.Command) {
 	cmd.Flags().Float64P("stabilityFeeRatio", "s", 0, "stabilityFeeRatio")
 	cmd.Flags().Uint64P("period", "p", 0, "period")
 	cmd.Flags().Float64P("totalBalance", "t", 0, "totalBalance")
+	cmd.Flags().Int64P("auctionPeriod", "a", 0, "liquidation auction period")
+	cmd.Flags().Int32P("collType", "c", 0, "collateral type to config, 2:btc, 3:eth")
+	cmd.Flags().StringP("assetExec", "e", "", "executor of the collateral asset")
+	cmd.Flags().StringP("assetSymbol", "y", "", "symbol of the collateral asset")
+	cmd.Flags().Int32P("minFeeders", "m", 0, "min feeders of a price feed round, at least 3")
+	cmd.Flags().Float64P("maxDeviation", "x", 0, "max deviation from last accepted price, 0 means no limit")
+	cmd.Flags().Int64P("twapWindow", "w", 0, "time weighted average price window in seconds")
 }
 
 //CollateralizeManage ...
@@ -312,12 +325,94 @@ func CollateralizeManage(cmd *cobra.Command, args []string) {
 	stabilityFeeRatio, _ := cmd.Flags().GetFloat64("stabilityFeeRatio")
 	period, _ := cmd.Flags().GetUint64("period")
 	totalBalance, _ := cmd.Flags().GetFloat64("totalBalance")
+	auctionPeriod, _ := cmd.Flags().GetInt64("auctionPeriod")
+	collType, _ := cmd.Flags().GetInt32("collType")
+	assetExec, _ := cmd.Flags().GetString("assetExec")
+	assetSymbol, _ := cmd.Flags().GetString("assetSymbol")
+	minFeeders, _ := cmd.Flags().GetInt32("minFeeders")
+	maxDeviation, _ := cmd.Flags().GetFloat64("maxDeviation")
+	twapWindow, _ := cmd.Flags().GetInt64("twapWindow")
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     cfg.ExecName(pkt.CollateralizeX),
 		ActionName: "CollateralizeManage",
-		Payload: []byte(fmt.Sprintf("{\"debtCeiling\":%f, \"liquidationRatio\":%f, \"stabilityFeeRatio\":%f, \"period\":%d, \"totalBalance\":%f}",
-			debtCeiling, liquidationRatio, stabilityFeeRatio, period, totalBalance)),
+		Payload: []byte(fmt.Sprintf("{\"debtCeiling\":%f, \"liquidationRatio\":%f, \"stabilityFeeRatio\":%f, \"period\":%d, \"totalBalance\":%f, \"auctionPeriod\":%d, \"collType\":%d, \"assetExec\":\"%s\", \"assetSymbol\":\"%s\", \"minFeeders\":%d, \"maxDeviation\":%f, \"twapWindow\":%d}",
+			debtCeiling, liquidationRatio, stabilityFeeRatio, period, totalBalance, auctionPeriod, collType, assetExec, assetSymbol, minFeeders, maxDeviation, twapWindow)),
+	}
+
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CollateralizeBidRawTxCmd 清算拍卖出价
+func CollateralizeBidRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid",
+		Short: "Bid for a liquidation auction",
+		Run:   CollateralizeBid,
+	}
+	addCollateralizeBidFlags(cmd)
+	return cmd
+}
+
+func addCollateralizeBidFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("auctionID", "u", "", "auction ID")
+	cmd.MarkFlagRequired("auctionID")
+	cmd.Flags().Float64P("amount", "m", 0, "bid amount of ccny")
+	cmd.MarkFlagRequired("amount")
+}
+
+//CollateralizeBid ...
+func CollateralizeBid(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	if cfg == nil {
+		panic(fmt.Sprintln("can not find CliSysParam title", title))
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	auctionID, _ := cmd.Flags().GetString("auctionID")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(pkt.CollateralizeX),
+		ActionName: "CollateralizeBid",
+		Payload:    []byte(fmt.Sprintf("{\"auctionId\":\"%s\",\"amount\":%f}", auctionID, amount)),
+	}
+
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CollateralizeAuctionSettleRawTxCmd 清算拍卖结算
+func CollateralizeAuctionSettleRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle",
+		Short: "Settle a finished liquidation auction",
+		Run:   CollateralizeAuctionSettle,
+	}
+	cmd.Flags().StringP("auctionID", "u", "", "auction ID")
+	cmd.MarkFlagRequired("auctionID")
+	return cmd
+}
+
+//CollateralizeAuctionSettle ...
+func CollateralizeAuctionSettle(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	if cfg == nil {
+		panic(fmt.Sprintln("can not find CliSysParam title", title))
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	auctionID, _ := cmd.Flags().GetString("auctionID")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(pkt.CollateralizeX),
+		ActionName: "CollateralizeAuctionSettle",
+		Payload:    []byte(fmt.Sprintf("{\"auctionId\":\"%s\"}", auctionID)),
 	}
 
 	var res string
@@ -355,17 +450,23 @@ func CollateralizeQueryPriceCmd() *cobra.Command {
 		Short: "Query latest price",
 		Run:   CollateralizeQueryPrice,
 	}
+	cmd.Flags().Int32P("collType", "c", 0, "collateral type, 0 or 1:bty, 2:btc, 3:eth")
 	return cmd
 }
 
 //CollateralizeQueryPrice ...
 func CollateralizeQueryPrice(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	collType, _ := cmd.Flags().GetInt32("collType")
 
 	var params rpctypes.Query4Jrpc
 	params.Execer = pkt.CollateralizeX
 
 	params.FuncName = "CollateralizePrice"
+	if collType > pkt.CollateralizeAssetTypeBty {
+		params.FuncName = "CollateralizePriceByType"
+		params.Payload = types.MustPBToJSON(&pkt.ReqCollateralizeAsset{CollType: collType})
+	}
 	var res pkt.RepCollateralizePrice
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 	ctx.Run()
@@ -417,10 +518,83 @@ func CollateralizeQueryCmd() *cobra.Command {
 		CollateralizeQueryCfgCmd(),
 		CollateralizeQueryPriceCmd(),
 		CollateralizeQueryUserBalanceCmd(),
+		CollateralizeQueryAssetCmd(),
+		CollateralizeQueryAuctionCmd(),
+		CollateralizeQueryFeedRoundCmd(),
 	)
 	return cmd
 }
 
+//CollateralizeQueryAssetCmd ...
+func CollateralizeQueryAssetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset",
+		Short: "Query collateral asset config",
+		Run:   CollateralizeQueryAsset,
+	}
+	cmd.Flags().Int32P("collType", "c", 0, "collateral type, 2:btc, 3:eth")
+	cmd.MarkFlagRequired("collType")
+	return cmd
+}
+
+//CollateralizeQueryAsset ...
+func CollateralizeQueryAsset(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	collType, _ := cmd.Flags().GetInt32("collType")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = pkt.CollateralizeX
+	params.FuncName = "CollateralizeAssetConfig"
+	params.Payload = types.MustPBToJSON(&pkt.ReqCollateralizeAsset{CollType: collType})
+	var res pkt.CollateralizeAssetConfig
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+//CollateralizeQueryAuctionCmd ...
+func CollateralizeQueryAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auction",
+		Short: "Query liquidation auctions",
+		Run:   CollateralizeQueryAuction,
+	}
+	cmd.Flags().StringP("auctionID", "u", "", "auction ID")
+	cmd.Flags().StringP("borrower", "a", "", "borrower address")
+	cmd.Flags().Int32P("status", "s", 0, "auction status, 1:open, 2:settled, 3:unsold")
+	return cmd
+}
+
+//CollateralizeQueryAuction ...
+func CollateralizeQueryAuction(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	auctionID, _ := cmd.Flags().GetString("auctionID")
+	borrower, _ := cmd.Flags().GetString("borrower")
+	status, _ := cmd.Flags().GetInt32("status")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = pkt.CollateralizeX
+
+	if auctionID != "" && borrower == "" && status == 0 {
+		params.FuncName = "CollateralizeAuctionByID"
+		params.Payload = types.MustPBToJSON(&pkt.ReqCollateralizeAuction{AuctionId: auctionID})
+		var res pkt.CollateralizeAuction
+		ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+		return
+	}
+
+	if borrower == "" && status == 0 {
+		fmt.Println("Error: requires at least one of auctionID, borrower or status")
+		cmd.Help()
+		return
+	}
+	params.FuncName = "CollateralizeAuctions"
+	params.Payload = types.MustPBToJSON(&pkt.ReqCollateralizeAuctions{Status: status, Borrower: borrower, AuctionId: auctionID})
+	var res pkt.RepCollateralizeAuctions
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
 func addCollateralizeQueryFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("collateralizeID", "g", "", "collateralize ID")
 	cmd.Flags().StringP("address", "a", "", "address")
@@ -527,7 +701,46 @@ func CollateralizeQuery(cmd *cobra.Command, args []string) {
 		ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 		ctx.Run()
 	} else {
-		fmt.Println("Error: requeres at least one of collId, address or status")
+		fmt.Println("Error: requires at least one of collId, address or status")
 		cmd.Help()
 	}
 }
+
+//CollateralizeQueryFeedRoundCmd ...
+func CollateralizeQueryFeedRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round",
+		Short: "Query price feed rounds",
+		Run:   CollateralizeQueryFeedRound,
+	}
+	cmd.Flags().Int32P("collType", "c", 0, "collateral type, 0 or 1:bty, 2:btc, 3:eth")
+	cmd.Flags().Int64P("roundID", "r", 0, "round ID, 0 means the latest round")
+	cmd.Flags().Int32P("count", "n", 0, "list rounds from roundID in descending order")
+	return cmd
+}
+
+//CollateralizeQueryFeedRound ...
+func CollateralizeQueryFeedRound(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	collType, _ := cmd.Flags().GetInt32("collType")
+	roundID, _ := cmd.Flags().GetInt64("roundID")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = pkt.CollateralizeX
+
+	if count == 0 {
+		params.FuncName = "CollateralizeFeedRound"
+		params.Payload = types.MustPBToJSON(&pkt.ReqCollateralizeFeedRound{CollType: collType, RoundId: roundID})
+		var res pkt.CollateralizeFeedRound
+		ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+		return
+	}
+
+	params.FuncName = "CollateralizeFeedRounds"
+	params.Payload = types.MustPBToJSON(&pkt.ReqCollateralizeFeedRounds{CollType: collType, RoundId: roundID, Count: count})
+	var res pkt.RepCollateralizeFeedRounds
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -0,0 +1,330 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math/big"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/collateralize/types"
+)
+
+// AuctionDB def
+type AuctionDB struct {
+	pty.CollateralizeAuction
+}
+
+// GetKVSet for AuctionDB
+func (auction *AuctionDB) GetKVSet() (kvset []*types.KeyValue) {
+	value := types.Encode(&auction.CollateralizeAuction)
+	kvset = append(kvset, &types.KeyValue{Key: AuctionKey(auction.AuctionId), Value: value})
+	return kvset
+}
+
+// Save for AuctionDB
+func (auction *AuctionDB) Save(db dbm.KV) {
+	set := auction.GetKVSet()
+	for i := 0; i < len(set); i++ {
+		db.Set(set[i].GetKey(), set[i].Value)
+	}
+}
+
+// AuctionKey Key for CollateralizeAuction
+func AuctionKey(id string) (key []byte) {
+	key = append(key, []byte("mavl-"+pty.CollateralizeX+"-auction-")...)
+	key = append(key, []byte(id)...)
+	return key
+}
+
+// GetAuctionReceiptLog generate logs for Collateralize auction
+func (action *Action) GetAuctionReceiptLog(ty int32, auction *pty.CollateralizeAuction) *types.ReceiptLog {
+	log := &types.ReceiptLog{}
+	log.Ty = ty
+
+	c := &pty.ReceiptCollateralizeAuction{}
+	c.AuctionId = auction.AuctionId
+	c.CollateralizeId = auction.CollateralizeId
+	c.Borrower = auction.Borrower
+	c.Bidder = auction.Bidder
+	c.Status = auction.Status
+
+	log.Log = types.Encode(c)
+
+	return log
+}
+
+// 起拍价按清算时的价格取抵押物市值的AuctionReserveRate，不超过需要偿还的债务
+func calcAuctionReserve(collValue int64, price int64, debt int64) int64 {
+	// collValue*price 可能超出int64
+	value := new(big.Int).Mul(big.NewInt(collValue), big.NewInt(price))
+	value.Mul(value, big.NewInt(AuctionReserveRate))
+	value.Div(value, big.NewInt(1e8))
+	if !value.IsInt64() || value.Int64() > debt {
+		return debt
+	}
+	return value.Int64()
+}
+
+// 借贷记录被清算时发起拍卖，抵押物仍冻结在放贷人账户中直到拍卖结算
+func (action *Action) startAuction(coll *pty.Collateralize, record *pty.BorrowRecord, price int64) (*types.Receipt, error) {
+	period := int64(DefaultAuctionPeriod)
+	collcfg, err := getCollateralizeConfig(action.db)
+	if err == nil && collcfg.AuctionPeriod > 0 {
+		period = collcfg.AuctionPeriod
+	}
+
+	fee := ((record.DebtValue * coll.StabilityFeeRatio) / 1e8) * 1e4
+	auction := &AuctionDB{}
+	auction.AuctionId = record.RecordId
+	auction.CollateralizeId = coll.CollateralizeId
+	auction.RecordId = record.RecordId
+	auction.Borrower = record.AccountAddr
+	auction.CollType = record.CollType
+	auction.CollateralValue = record.CollateralValue
+	auction.DebtValue = record.DebtValue + fee
+	auction.Reserve = calcAuctionReserve(record.CollateralValue, price, auction.DebtValue)
+	auction.StartTime = action.blocktime
+	auction.EndTime = action.blocktime + period
+	auction.Status = pty.CollateralizeAuctionStatusOpen
+	auction.Save(action.db)
+
+	log := action.GetAuctionReceiptLog(pty.TyLogCollateralizeAuction, &auction.CollateralizeAuction)
+	return &types.Receipt{Ty: types.ExecOk, KV: auction.GetKVSet(), Logs: []*types.ReceiptLog{log}}, nil
+}
+
+// CollateralizeBid 清算拍卖出价，出价的ccny冻结在出价人账户，被超过时解冻
+func (action *Action) CollateralizeBid(bid *pty.CollateralizeBid) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	auctionInfo, err := queryAuctionByID(action.db, bid.AuctionId)
+	if err != nil {
+		clog.Error("CollateralizeBid", "AuctionId", bid.AuctionId, "error", err)
+		return nil, err
+	}
+	auction := &AuctionDB{*auctionInfo}
+
+	if auction.Status != pty.CollateralizeAuctionStatusOpen || action.blocktime >= auction.EndTime {
+		clog.Error("CollateralizeBid", "AuctionId", bid.AuctionId, "status", auction.Status, "endTime", auction.EndTime, "error", pty.ErrAuctionStatus)
+		return nil, pty.ErrAuctionStatus
+	}
+
+	// 出价检查，首次出价不能低于起拍价，非首次出价需要比当前出价高出最小加价比例
+	if bid.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+	if bid.Amount < auction.Reserve {
+		clog.Error("CollateralizeBid", "AuctionId", bid.AuctionId, "amount", bid.Amount, "reserve", auction.Reserve, "error", pty.ErrAuctionBidTooLow)
+		return nil, pty.ErrAuctionBidTooLow
+	}
+	if auction.BidAmount > 0 && bid.Amount < auction.BidAmount+(auction.BidAmount*AuctionBidIncrement)/1e4 {
+		clog.Error("CollateralizeBid", "AuctionId", bid.AuctionId, "amount", bid.Amount, "current", auction.BidAmount, "error", pty.ErrAuctionBidTooLow)
+		return nil, pty.ErrAuctionBidTooLow
+	}
+
+	// 退还上一个出价人
+	if auction.Bidder != "" {
+		receipt, err := action.tokenAccount.ExecActive(auction.Bidder, action.execaddr, auction.BidAmount)
+		if err != nil {
+			clog.Error("CollateralizeBid.ExecActive", "addr", auction.Bidder, "execaddr", action.execaddr, "amount", auction.BidAmount, "error", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+
+	// 冻结出价
+	if !action.CheckExecTokenAccount(action.fromaddr, bid.Amount, false) {
+		clog.Error("CollateralizeBid.CheckExecTokenAccount", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", bid.Amount, "error", types.ErrInsufficientBalance)
+		return nil, types.ErrInsufficientBalance
+	}
+	receipt, err := action.tokenAccount.ExecFrozen(action.fromaddr, action.execaddr, bid.Amount)
+	if err != nil {
+		clog.Error("CollateralizeBid.Frozen", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", bid.Amount, "error", err)
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kv = append(kv, receipt.KV...)
+
+	auction.Bidder = action.fromaddr
+	auction.BidAmount = bid.Amount
+	auction.Save(action.db)
+	kv = append(kv, auction.GetKVSet()...)
+	logs = append(logs, action.GetAuctionReceiptLog(pty.TyLogCollateralizeBid, &auction.CollateralizeAuction))
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// CollateralizeAuctionSettle 拍卖结束后任何人都可以结算
+// 成交时出价偿还债务，超出债务部分退还借贷人，抵押物转给出价人；没有达到起拍价的出价即流拍，抵押物转给担保账户
+func (action *Action) CollateralizeAuctionSettle(settle *pty.CollateralizeAuctionSettle) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	auctionInfo, err := queryAuctionByID(action.db, settle.AuctionId)
+	if err != nil {
+		clog.Error("CollateralizeAuctionSettle", "AuctionId", settle.AuctionId, "error", err)
+		return nil, err
+	}
+	auction := &AuctionDB{*auctionInfo}
+
+	if auction.Status != pty.CollateralizeAuctionStatusOpen {
+		clog.Error("CollateralizeAuctionSettle", "AuctionId", settle.AuctionId, "status", auction.Status, "error", pty.ErrAuctionStatus)
+		return nil, pty.ErrAuctionStatus
+	}
+	if action.blocktime < auction.EndTime {
+		clog.Error("CollateralizeAuctionSettle", "AuctionId", settle.AuctionId, "endTime", auction.EndTime, "error", pty.ErrAuctionNotEnd)
+		return nil, pty.ErrAuctionNotEnd
+	}
+
+	collateralize, err := queryCollateralizeByID(action.db, auction.CollateralizeId)
+	if err != nil {
+		clog.Error("CollateralizeAuctionSettle", "CollateralizeId", auction.CollateralizeId, "error", err)
+		return nil, err
+	}
+	coll := &CollateralizeDB{*collateralize}
+
+	record, err := queryCollateralizeRecordByID(action.db, auction.CollateralizeId, auction.RecordId)
+	if err != nil {
+		clog.Error("CollateralizeAuctionSettle", "RecordId", auction.RecordId, "error", err)
+		return nil, err
+	}
+
+	collAccount, err := action.getCollAccount(auction.CollType)
+	if err != nil {
+		clog.Error("CollateralizeAuctionSettle.getCollAccount", "collType", auction.CollType, "error", err)
+		return nil, err
+	}
+
+	// 流拍，抵押物转给担保账户
+	receiver := auction.Bidder
+	if auction.Bidder == "" {
+		receiver, err = getGuarantorAddr(action.db)
+		if err != nil {
+			clog.Error("CollateralizeAuctionSettle", "getGuarantorAddr", err)
+			return nil, err
+		}
+		auction.Status = pty.CollateralizeAuctionStatusUnsold
+	} else {
+		repay := auction.BidAmount
+		if repay > auction.DebtValue {
+			repay = auction.DebtValue
+		}
+
+		// 出价偿还给放贷人
+		receipt, err := action.tokenAccount.ExecTransferFrozen(auction.Bidder, coll.CreateAddr, action.execaddr, repay)
+		if err != nil {
+			clog.Error("CollateralizeAuctionSettle.ExecTransferFrozen", "addr", auction.Bidder, "execaddr", action.execaddr, "amount", repay, "error", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+
+		// 本金部分重新冻结为可放贷金额
+		principal := repay
+		if principal > record.DebtValue {
+			principal = record.DebtValue
+		}
+		receipt, err = action.tokenAccount.ExecFrozen(coll.CreateAddr, action.execaddr, principal)
+		if err != nil {
+			clog.Error("CollateralizeAuctionSettle.Frozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", principal, "error", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+		coll.Balance += principal
+
+		// 超出债务的部分退还借贷人
+		auction.Surplus = auction.BidAmount - repay
+		if auction.Surplus > 0 {
+			receipt, err = action.tokenAccount.ExecTransferFrozen(auction.Bidder, auction.Borrower, action.execaddr, auction.Surplus)
+			if err != nil {
+				clog.Error("CollateralizeAuctionSettle.ExecTransferFrozen", "addr", auction.Bidder, "execaddr", action.execaddr, "surplus", auction.Surplus, "error", err)
+				return nil, err
+			}
+			logs = append(logs, receipt.Logs...)
+			kv = append(kv, receipt.KV...)
+		}
+		auction.Status = pty.CollateralizeAuctionStatusSettled
+	}
+
+	// 抵押物转移
+	receipt, err := collAccount.ExecTransferFrozen(coll.CreateAddr, receiver, action.execaddr, auction.CollateralValue)
+	if err != nil {
+		clog.Error("CollateralizeAuctionSettle.ExecTransferFrozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", auction.CollateralValue, "error", err)
+		return nil, err
+	}
+	logs = append(logs, receipt.Logs...)
+	kv = append(kv, receipt.KV...)
+
+	addCollBalance(&coll.Collateralize, auction.CollType, -auction.CollateralValue)
+	coll.Save(action.db)
+	kv = append(kv, coll.GetKVSet()...)
+
+	auction.PreStatus = pty.CollateralizeAuctionStatusOpen
+	auction.Save(action.db)
+	kv = append(kv, auction.GetKVSet()...)
+	logs = append(logs, action.GetAuctionReceiptLog(pty.TyLogCollateralizeSettle, &auction.CollateralizeAuction))
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// 查找清算拍卖
+func queryAuctionByID(db dbm.KV, auctionID string) (*pty.CollateralizeAuction, error) {
+	data, err := db.Get(AuctionKey(auctionID))
+	if err != nil {
+		clog.Debug("queryAuctionByID", "error", err)
+		return nil, pty.ErrAuctionNotExist
+	}
+
+	var auction pty.CollateralizeAuction
+	err = types.Decode(data, &auction)
+	if err != nil {
+		clog.Debug("queryAuctionByID", "decode", err)
+		return nil, err
+	}
+	return &auction, nil
+}
+
+func queryAuctions(db dbm.KV, localdb dbm.KVDB, req *pty.ReqCollateralizeAuctions) ([]*pty.CollateralizeAuction, error) {
+	query := pty.NewAuctionTable(localdb).GetQuery(localdb)
+	var primary []byte
+	if len(req.AuctionId) > 0 {
+		primary = []byte(req.AuctionId)
+	}
+
+	var data = &pty.ReceiptCollateralizeAuction{
+		Borrower: req.Borrower,
+		Status:   req.Status,
+	}
+
+	var rows []*table.Row
+	var err error
+	if len(req.Borrower) != 0 && req.Status != 0 {
+		rows, err = query.List("borrower_status", data, primary, DefaultCount, ListDESC)
+	} else if len(req.Borrower) != 0 {
+		rows, err = query.List("borrower", data, primary, DefaultCount, ListDESC)
+	} else {
+		rows, err = query.List("status", data, primary, DefaultCount, ListDESC)
+	}
+	if err != nil {
+		clog.Debug("queryAuctions.List", "error", err)
+		return nil, err
+	}
+
+	var auctions []*pty.CollateralizeAuction
+	for _, row := range rows {
+		auction, err := queryAuctionByID(db, row.Data.(*pty.ReceiptCollateralizeAuction).AuctionId)
+		if err != nil {
+			clog.Debug("queryAuctions.queryAuctionByID", "error", err)
+			continue
+		}
+		auctions = append(auctions, auction)
+	}
+
+	return auctions, nil
+}
@@ -0,0 +1,194 @@
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pkt "github.com/33cn/plugin/plugin/dapp/collateralize/types"
+	tokenE "github.com/33cn/plugin/plugin/dapp/token/executor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func initAuctionEnv() *execEnv {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	cfg.RegisterDappFork(pkt.CollateralizeX, pkt.ForkCollateralizeTableUpdate, 0)
+	cfg.RegisterDappFork(pkt.CollateralizeX, pkt.ForkCollateralizeMultiColl, 0)
+	// 单个喂价地址直接喂价, 喂价轮次的测试中再开启
+	cfg.RegisterDappFork(pkt.CollateralizeX, pkt.ForkCollateralizePriceRound, types.MaxHeight)
+	InitExecType()
+	_, ldb, kvdb := util.CreateTestDB()
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+
+	execAddr := dapp.ExecAddress(pkt.CollateralizeX)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+
+	// A: 管理员及放贷人，B: 喂价及借贷人，C: 担保账户及拍卖出价人
+	tokenAcc, _ := account.NewAccountDB(cfg, tokenE.GetName(), pkt.CCNYTokenName, stateDB)
+	btcAcc, _ := account.NewAccountDB(cfg, tokenE.GetName(), "BTC", stateDB)
+	tokenAcc.SaveExecAccount(execAddr, &types.Account{Balance: totalToken, Addr: string(Nodes[0])})
+	tokenAcc.SaveExecAccount(execAddr, &types.Account{Balance: types.Coin / 10, Addr: string(Nodes[1])})
+	tokenAcc.SaveExecAccount(execAddr, &types.Account{Balance: totalToken, Addr: string(Nodes[2])})
+	btcAcc.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: string(Nodes[1])})
+	manageKeySet("issuance-manage", string(Nodes[0]), stateDB)
+	addrKeySet(string(Nodes[0]), stateDB)
+	manageKeySet("issuance-price-feed", string(Nodes[1]), stateDB)
+	manageKeySet("issuance-guarantor", string(Nodes[2]), stateDB)
+
+	return &execEnv{
+		blockTime:   1539918074,
+		blockHeight: 10,
+		difficulty:  1539918074,
+		kvdb:        kvdb,
+		api:         api,
+		db:          stateDB,
+		execAddr:    execAddr,
+		cfg:         cfg,
+		ldb:         ldb,
+	}
+}
+
+func execAuctionTx(t *testing.T, exec dapp.Driver, env *execEnv, tx *types.Transaction, privKey string) error {
+	tx.Execer = []byte(pkt.CollateralizeX)
+	tx, err := signTx(tx, privKey)
+	assert.Nil(t, err)
+
+	receipt, err := exec.Exec(tx, int(1))
+	if err != nil {
+		return err
+	}
+	for _, kv := range receipt.KV {
+		env.db.Set(kv.Key, kv.Value)
+	}
+
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, int(1))
+	assert.Nil(t, err)
+	util.SaveKVList(env.ldb, set.KV)
+	return nil
+}
+
+func TestCollateralizeAuction(t *testing.T) {
+	env := initAuctionEnv()
+	exec := newCollateralize()
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.db)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+
+	tx, _ := pkt.CreateRawCollateralizeManageTx(env.cfg, &pkt.CollateralizeManageTx{
+		LiquidationRatio: 0.25, DebtCeiling: 1000, StabilityFeeRatio: 0.0001, TotalBalance: 10000, AuctionPeriod: 100})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyA))
+
+	// 配置btc抵押物
+	tx, _ = pkt.CreateRawCollateralizeManageTx(env.cfg, &pkt.CollateralizeManageTx{
+		CollType: pkt.CollateralizeAssetTypeBtc, AssetExec: tokenE.GetName(), AssetSymbol: "BTC", LiquidationRatio: 0.5})
+	assert.Equal(t, pkt.ErrPermissionDeny, execAuctionTx(t, exec, env, tx, PrivKeyB))
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyA))
+	res, err := exec.Query("CollateralizeAssetConfig", types.Encode(&pkt.ReqCollateralizeAsset{CollType: pkt.CollateralizeAssetTypeBtc}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5000), res.(*pkt.CollateralizeAssetConfig).LiquidationRatio)
+
+	tx, _ = pkt.CreateRawCollateralizeCreateTx(env.cfg, &pkt.CollateralizeCreateTx{TotalBalance: 1000})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyA))
+	collateralizeID := common.ToHex(tx.Hash())
+
+	// btc喂价，不影响bty价格
+	tx, _ = pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{CollType: pkt.CollateralizeAssetTypeBtc, Price: []float64{100}, Volume: []int64{100}})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyB))
+	res, err = exec.Query("CollateralizePriceByType", types.Encode(&pkt.ReqCollateralizeAsset{CollType: pkt.CollateralizeAssetTypeBtc}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100*1e4), res.(*pkt.RepCollateralizePrice).Price)
+	_, err = exec.Query("CollateralizePrice", nil)
+	assert.NotNil(t, err)
+
+	// 质押btc借出100ccny，清算比例0.5，需要2个btc
+	tx, _ = pkt.CreateRawCollateralizeBorrowTx(env.cfg, &pkt.CollateralizeBorrowTx{CollateralizeID: collateralizeID, Value: 100, CollType: pkt.CollateralizeAssetTypeBtc})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyB))
+	recordID := common.ToHex(tx.Hash())
+	btcAcc, _ := account.NewAccountDB(env.cfg, tokenE.GetName(), "BTC", env.db)
+	assert.Equal(t, 2*types.Coin, btcAcc.LoadExecAccount(string(Nodes[0]), env.execAddr).Frozen)
+	res, err = exec.Query("CollateralizeInfoByID", types.Encode(&pkt.ReqCollateralizeInfo{CollateralizeId: collateralizeID}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), res.(*pkt.RepCollateralizeCurrentInfo).CollBalance)
+	assert.Equal(t, 2*types.Coin, res.(*pkt.RepCollateralizeCurrentInfo).CollBalances[0].Balance)
+
+	// btc价格跌破清算价格，进入拍卖
+	tx, _ = pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{CollType: pkt.CollateralizeAssetTypeBtc, Price: []float64{50}, Volume: []int64{100}})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyB))
+	res, err = exec.Query("CollateralizeAuctionByID", types.Encode(&pkt.ReqCollateralizeAuction{AuctionId: recordID}))
+	assert.Nil(t, err)
+	auction := res.(*pkt.CollateralizeAuction)
+	assert.Equal(t, int32(pkt.CollateralizeAuctionStatusOpen), auction.Status)
+	assert.Equal(t, 2*types.Coin, auction.CollateralValue)
+	assert.Equal(t, 100*types.Coin+types.Coin/100, auction.DebtValue)
+	assert.Equal(t, env.blockTime+100, auction.EndTime)
+	res, err = exec.Query("CollateralizeAuctions", types.Encode(&pkt.ReqCollateralizeAuctions{Status: pkt.CollateralizeAuctionStatusOpen}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res.(*pkt.RepCollateralizeAuctions).Auctions))
+	res, err = exec.Query("CollateralizeRecordByID", types.Encode(&pkt.ReqCollateralizeRecord{CollateralizeId: collateralizeID, RecordId: recordID}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.CollateralizeUserStatusSystemLiquidate), res.(*pkt.RepCollateralizeRecord).Record.Status)
+
+	// 起拍价为清算时抵押物市值的90%
+	assert.Equal(t, 90*types.Coin, auction.Reserve)
+
+	// 出价，低于起拍价或加价不足1%失败，被超过后退还
+	tx, _ = pkt.CreateRawCollateralizeBidTx(env.cfg, &pkt.CollateralizeBidTx{AuctionID: recordID, Amount: 50})
+	assert.Equal(t, pkt.ErrAuctionBidTooLow, execAuctionTx(t, exec, env, tx, PrivKeyB))
+	tx, _ = pkt.CreateRawCollateralizeBidTx(env.cfg, &pkt.CollateralizeBidTx{AuctionID: recordID, Amount: 90})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyB))
+	tx, _ = pkt.CreateRawCollateralizeBidTx(env.cfg, &pkt.CollateralizeBidTx{AuctionID: recordID, Amount: 90.1})
+	assert.Equal(t, pkt.ErrAuctionBidTooLow, execAuctionTx(t, exec, env, tx, PrivKeyC))
+	tx, _ = pkt.CreateRawCollateralizeBidTx(env.cfg, &pkt.CollateralizeBidTx{AuctionID: recordID, Amount: 150})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyC))
+	tokenAcc, _ := account.NewAccountDB(env.cfg, tokenE.GetName(), pkt.CCNYTokenName, env.db)
+	assert.Equal(t, int64(0), tokenAcc.LoadExecAccount(string(Nodes[1]), env.execAddr).Frozen)
+	assert.Equal(t, 150*types.Coin, tokenAcc.LoadExecAccount(string(Nodes[2]), env.execAddr).Frozen)
+
+	// 拍卖结束前不能结算
+	tx, _ = pkt.CreateRawCollateralizeAuctionSettleTx(env.cfg, &pkt.CollateralizeAuctionSettleTx{AuctionID: recordID})
+	assert.Equal(t, pkt.ErrAuctionNotEnd, execAuctionTx(t, exec, env, tx, PrivKeyB))
+
+	lenderFrozen := tokenAcc.LoadExecAccount(string(Nodes[0]), env.execAddr).Frozen
+	exec.SetEnv(env.blockHeight+1, env.blockTime+100, env.difficulty)
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyB))
+	assert.Equal(t, pkt.ErrAuctionStatus, execAuctionTx(t, exec, env, tx, PrivKeyB))
+
+	// 出价人得到抵押物，债务本金回到放贷池，超出债务部分退还借贷人
+	assert.Equal(t, 2*types.Coin, btcAcc.LoadExecAccount(string(Nodes[2]), env.execAddr).Balance)
+	assert.Equal(t, int64(0), btcAcc.LoadExecAccount(string(Nodes[0]), env.execAddr).Frozen)
+	assert.Equal(t, int64(0), tokenAcc.LoadExecAccount(string(Nodes[2]), env.execAddr).Frozen)
+	assert.Equal(t, lenderFrozen+100*types.Coin, tokenAcc.LoadExecAccount(string(Nodes[0]), env.execAddr).Frozen)
+	assert.Equal(t, types.Coin/10+150*types.Coin-types.Coin/100, tokenAcc.LoadExecAccount(string(Nodes[1]), env.execAddr).Balance)
+
+	res, err = exec.Query("CollateralizeAuctionByID", types.Encode(&pkt.ReqCollateralizeAuction{AuctionId: recordID}))
+	assert.Nil(t, err)
+	auction = res.(*pkt.CollateralizeAuction)
+	assert.Equal(t, int32(pkt.CollateralizeAuctionStatusSettled), auction.Status)
+	assert.Equal(t, 50*types.Coin-types.Coin/100, auction.Surplus)
+	res, err = exec.Query("CollateralizeAuctions", types.Encode(&pkt.ReqCollateralizeAuctions{Borrower: string(Nodes[1]), Status: pkt.CollateralizeAuctionStatusSettled}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res.(*pkt.RepCollateralizeAuctions).Auctions))
+	res, err = exec.Query("CollateralizeInfoByID", types.Encode(&pkt.ReqCollateralizeInfo{CollateralizeId: collateralizeID}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1000*types.Coin, res.(*pkt.RepCollateralizeCurrentInfo).Balance)
+	assert.Equal(t, int64(0), res.(*pkt.RepCollateralizeCurrentInfo).CollBalances[0].Balance)
+}
+
+func TestCalcAuctionReserve(t *testing.T) {
+	// 市值高于债务时以债务为起拍价
+	assert.Equal(t, 100*types.Coin, calcAuctionReserve(2*types.Coin, 100*1e4, 100*types.Coin))
+	assert.Equal(t, 45*types.Coin, calcAuctionReserve(types.Coin, 50*1e4, 100*types.Coin))
+	// 乘积溢出int64时不会回绕成很小的起拍价
+	assert.Equal(t, 100*types.Coin, calcAuctionReserve(1e10*types.Coin, 1e8*1e4, 100*types.Coin))
+}
@@ -78,6 +78,8 @@ func initEnv() *execEnv {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	cfg.SetTitleOnlyForTest("chain33")
 	cfg.RegisterDappFork(pkt.CollateralizeX, pkt.ForkCollateralizeTableUpdate, 0)
+	// 单个喂价地址直接喂价, 喂价轮次见 pricefeed_test.go
+	cfg.RegisterDappFork(pkt.CollateralizeX, pkt.ForkCollateralizePriceRound, types.MaxHeight)
 	Init(pkt.CollateralizeX, cfg, nil)
 	_, ldb, kvdb := util.CreateTestDB()
 
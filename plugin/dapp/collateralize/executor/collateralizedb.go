@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"fmt"
+
 	"github.com/33cn/chain33/common/db/table"
 
 	"github.com/33cn/chain33/account"
@@ -35,6 +37,9 @@ const (
 	DefaultTotalBalance       = 0               // 默认放贷总额
 	PriceWarningRate          = 1.3 * 1e4       // 价格提前预警率
 	ExpireWarningTime         = 3600 * 24 * 10  // 提前10天超时预警
+	DefaultAuctionPeriod      = 3600 * 24       // 默认清算拍卖时长
+	AuctionBidIncrement       = 0.01 * 1e4      // 拍卖最小加价比例
+	AuctionReserveRate        = 0.9 * 1e4       // 起拍价占抵押物市值的比例
 )
 
 // CollateralizeDB def
@@ -82,6 +87,18 @@ func PriceKey() (key []byte) {
 	return key
 }
 
+//AssetKey Key for CollateralizeAssetConfig
+func AssetKey(collType int32) (key []byte) {
+	key = append(key, []byte(fmt.Sprintf("mavl-%s-asset-%d", pty.CollateralizeX, collType))...)
+	return key
+}
+
+//AssetPriceKey Key for 非bty抵押物喂价
+func AssetPriceKey(collType int32) (key []byte) {
+	key = append(key, []byte(fmt.Sprintf("mavl-%s-price-%d", pty.CollateralizeX, collType))...)
+	return key
+}
+
 // Action struct
 type Action struct {
 	coinsAccount  *account.DB // bty账户
@@ -248,7 +265,29 @@ func (action *Action) CollateralizeManage(manage *pty.CollateralizeManage) (*typ
 		return nil, pty.ErrPermissionDeny
 	}
 
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	isMultiColl := cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeMultiColl)
+
+	// 配置抵押物类型
+	if manage.AssetConfig != nil {
+		if !isMultiColl {
+			return nil, types.ErrNotAllow
+		}
+		return action.collateralizeAssetConfig(manage.AssetConfig)
+	}
+
+	// 配置喂价参数
+	if manage.FeedConfig != nil {
+		if !cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizePriceRound) {
+			return nil, types.ErrNotAllow
+		}
+		return action.collateralizeFeedConfig(manage.FeedConfig)
+	}
+
 	// 配置借贷参数
+	if manage.AuctionPeriod < 0 {
+		return nil, pty.ErrRiskParam
+	}
 	if manage.DebtCeiling < 0 || manage.LiquidationRatio < 0 || manage.LiquidationRatio >= 10000 ||
 		manage.StabilityFeeRatio < 0 || manage.StabilityFeeRatio >= 10000 {
 		return nil, pty.ErrRiskParam
@@ -295,6 +334,14 @@ func (action *Action) CollateralizeManage(manage *pty.CollateralizeManage) (*typ
 	} else {
 		collConfig.TotalBalance = manConfig.TotalBalance
 	}
+
+	if isMultiColl {
+		if manage.AuctionPeriod != 0 {
+			collConfig.AuctionPeriod = manage.AuctionPeriod
+		} else {
+			collConfig.AuctionPeriod = manConfig.AuctionPeriod
+		}
+	}
 	collConfig.CurrentTime = action.blocktime
 
 	value := types.Encode(collConfig)
@@ -321,6 +368,89 @@ func getCollateralizeConfig(db dbm.KV) (*pty.CollateralizeManage, error) {
 	return &collCfg, nil
 }
 
+// 设置抵押物类型，bty为内置类型不需要配置
+func (action *Action) collateralizeAssetConfig(asset *pty.CollateralizeAssetConfig) (*types.Receipt, error) {
+	if asset.CollType <= pty.CollateralizeAssetTypeBty || len(asset.AssetExec) == 0 || len(asset.AssetSymbol) == 0 {
+		clog.Error("collateralizeAssetConfig", "collType", asset.CollType, "exec", asset.AssetExec, "symbol", asset.AssetSymbol, "error", pty.ErrAssetType)
+		return nil, pty.ErrAssetType
+	}
+	if asset.LiquidationRatio < 0 || asset.LiquidationRatio >= 10000 {
+		return nil, pty.ErrRiskParam
+	}
+
+	value := types.Encode(asset)
+	action.db.Set(AssetKey(asset.CollType), value)
+	kv := []*types.KeyValue{{Key: AssetKey(asset.CollType), Value: value}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: nil}, nil
+}
+
+func getAssetConfig(db dbm.KV, collType int32) (*pty.CollateralizeAssetConfig, error) {
+	data, err := db.Get(AssetKey(collType))
+	if err != nil {
+		clog.Debug("getAssetConfig", "collType", collType, "error", err)
+		return nil, pty.ErrAssetType
+	}
+
+	var asset pty.CollateralizeAssetConfig
+	err = types.Decode(data, &asset)
+	if err != nil {
+		clog.Debug("getAssetConfig", "decode", err)
+		return nil, err
+	}
+	return &asset, nil
+}
+
+// 0和CollateralizeAssetTypeBty都表示bty
+func isBtyColl(collType int32) bool {
+	return collType == 0 || collType == pty.CollateralizeAssetTypeBty
+}
+
+// 获取抵押物所在账户
+func (action *Action) getCollAccount(collType int32) (*account.DB, error) {
+	if isBtyColl(collType) {
+		return action.coinsAccount, nil
+	}
+
+	asset, err := getAssetConfig(action.db, collType)
+	if err != nil {
+		return nil, err
+	}
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	return account.NewAccountDB(cfg, asset.AssetExec, asset.AssetSymbol, action.db)
+}
+
+// 获取抵押物对应的清算比例
+func getCollLiquidationRatio(db dbm.KV, coll *pty.Collateralize, collType int32) (int64, error) {
+	if isBtyColl(collType) {
+		return coll.LiquidationRatio, nil
+	}
+
+	asset, err := getAssetConfig(db, collType)
+	if err != nil {
+		return 0, err
+	}
+	if asset.LiquidationRatio != 0 {
+		return asset.LiquidationRatio, nil
+	}
+	return coll.LiquidationRatio, nil
+}
+
+// 更新抵押物数量
+func addCollBalance(coll *pty.Collateralize, collType int32, value int64) {
+	if isBtyColl(collType) {
+		coll.CollBalance += value
+		return
+	}
+
+	for _, balance := range coll.CollBalances {
+		if balance.CollType == collType {
+			balance.Balance += value
+			return
+		}
+	}
+	coll.CollBalances = append(coll.CollBalances, &pty.CollateralBalance{CollType: collType, Balance: value})
+}
+
 func isSuperAddr(addr string, db dbm.KV) bool {
 	data, err := db.Get(AddrKey())
 	if err != nil {
@@ -497,9 +627,34 @@ func getLatestPrice(db dbm.KV) (int64, error) {
 	return price.BtyPrice, nil
 }
 
+// 获取指定类型抵押物最近价格
+func getLatestPriceByType(db dbm.KV, collType int32) (int64, error) {
+	if isBtyColl(collType) {
+		return getLatestPrice(db)
+	}
+
+	data, err := db.Get(AssetPriceKey(collType))
+	if err != nil {
+		clog.Error("getLatestPriceByType", "collType", collType, "get", err)
+		return -1, err
+	}
+	var price pty.AssetPriceRecord
+	err = types.Decode(data, &price)
+	if err != nil {
+		clog.Error("getLatestPriceByType", "decode", err)
+		return -1, err
+	}
+
+	return price.Price, nil
+}
+
 // CheckExecAccountBalance 检查账户抵押物余额
 func (action *Action) CheckExecAccountBalance(fromAddr string, ToFrozen, ToActive int64) bool {
-	acc := action.coinsAccount.LoadExecAccount(fromAddr, action.execaddr)
+	return checkCollAccountBalance(action.coinsAccount, action.execaddr, fromAddr, ToFrozen, ToActive)
+}
+
+func checkCollAccountBalance(collAccount *account.DB, execaddr string, fromAddr string, ToFrozen, ToActive int64) bool {
+	acc := collAccount.LoadExecAccount(fromAddr, execaddr)
 	if acc.GetBalance() >= ToFrozen && acc.GetFrozen() >= ToActive {
 		return true
 	}
@@ -562,28 +717,45 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 	}
 	clog.Debug("CollateralizeBorrow", "value", borrow.GetValue())
 
+	// 抵押物类型，分叉前只支持bty
+	var collType int32
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	if cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeMultiColl) && !isBtyColl(borrow.CollType) {
+		collType = borrow.CollType
+	}
+	collAccount, err := action.getCollAccount(collType)
+	if err != nil {
+		clog.Error("CollateralizeBorrow.getCollAccount", "CollID", coll.CollateralizeId, "collType", collType, "error", err)
+		return nil, err
+	}
+	liquidationRatio, err := getCollLiquidationRatio(action.db, &coll.Collateralize, collType)
+	if err != nil {
+		clog.Error("CollateralizeBorrow.getCollLiquidationRatio", "CollID", coll.CollateralizeId, "collType", collType, "error", err)
+		return nil, err
+	}
+
 	// 获取抵押物价格
-	lastPrice, err := getLatestPrice(action.db)
+	lastPrice, err := getLatestPriceByType(action.db, collType)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.getLatestPrice", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "error", err)
 		return nil, err
 	}
 
 	// 根据价格和需要借贷的金额，计算需要质押的抵押物数量
-	btyFrozen, err := getBtyNumToFrozen(borrow.GetValue(), lastPrice, coll.LiquidationRatio)
+	btyFrozen, err := getBtyNumToFrozen(borrow.GetValue(), lastPrice, liquidationRatio)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.getBtyNumToFrozen", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "error", err)
 		return nil, err
 	}
 
 	// 检查抵押物账户余额
-	if !action.CheckExecAccountBalance(action.fromaddr, btyFrozen, 0) {
+	if !checkCollAccountBalance(collAccount, action.execaddr, action.fromaddr, btyFrozen, 0) {
 		clog.Error("CollateralizeBorrow.CheckExecAccountBalance", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "balance", btyFrozen, "error", types.ErrNoBalance)
 		return nil, types.ErrNoBalance
 	}
 
 	// 抵押物转账
-	receipt, err := action.coinsAccount.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, btyFrozen)
+	receipt, err := collAccount.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, btyFrozen)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.ExecTransfer", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", btyFrozen)
 		return nil, err
@@ -592,7 +764,7 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 	kv = append(kv, receipt.KV...)
 
 	// 抵押物冻结
-	receipt, err = action.coinsAccount.ExecFrozen(coll.CreateAddr, action.execaddr, btyFrozen)
+	receipt, err = collAccount.ExecFrozen(coll.CreateAddr, action.execaddr, btyFrozen)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.Frozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", btyFrozen)
 		return nil, err
@@ -618,9 +790,10 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 	borrowRecord.StartTime = action.blocktime
 	borrowRecord.CollateralPrice = lastPrice
 	borrowRecord.DebtValue = borrow.GetValue()
-	borrowRecord.LiquidationPrice = (coll.LiquidationRatio * lastPrice * pty.CollateralizePreLiquidationRatio) / 1e8
+	borrowRecord.LiquidationPrice = (liquidationRatio * lastPrice * pty.CollateralizePreLiquidationRatio) / 1e8
 	borrowRecord.Status = pty.CollateralizeUserStatusCreate
 	borrowRecord.ExpireTime = action.blocktime + coll.Period
+	borrowRecord.CollType = collType
 
 	// 记录当前借贷的最高自动清算价格
 	if coll.LatestLiquidationPrice < borrowRecord.LiquidationPrice {
@@ -631,7 +804,7 @@ func (action *Action) CollateralizeBorrow(borrow *pty.CollateralizeBorrow) (*typ
 	coll.BorrowRecords = append(coll.BorrowRecords, borrowRecord)
 	coll.Status = pty.CollateralizeStatusCreated
 	coll.Balance -= borrow.GetValue()
-	coll.CollBalance += btyFrozen
+	addCollBalance(&coll.Collateralize, collType, btyFrozen)
 	coll.LatestExpireTime = getLatestExpireTime(&coll.Collateralize)
 	coll.Save(action.db)
 	kv = append(kv, coll.GetKVSet()...)
@@ -680,6 +853,12 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 		return nil, pty.ErrRecordNotExist
 	}
 
+	collAccount, err := action.getCollAccount(borrowRecord.CollType)
+	if err != nil {
+		clog.Error("CollateralizeRepay.getCollAccount", "CollID", repay.CollateralizeId, "collType", borrowRecord.CollType, "error", err)
+		return nil, err
+	}
+
 	// 借贷金额+利息
 	fee := ((borrowRecord.DebtValue * coll.StabilityFeeRatio) / 1e8) * 1e4
 	realRepay := borrowRecord.DebtValue + fee
@@ -709,7 +888,7 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 	kv = append(kv, receipt.KV...)
 
 	// 抵押物归还
-	receipt, err = action.coinsAccount.ExecTransferFrozen(coll.CreateAddr, action.fromaddr, action.execaddr, borrowRecord.CollateralValue)
+	receipt, err = collAccount.ExecTransferFrozen(coll.CreateAddr, action.fromaddr, action.execaddr, borrowRecord.CollateralValue)
 	if err != nil {
 		clog.Error("CollateralizeRepay.ExecTransferFrozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", borrowRecord.CollateralValue)
 		return nil, err
@@ -723,7 +902,7 @@ func (action *Action) CollateralizeRepay(repay *pty.CollateralizeRepay) (*types.
 
 	// 保存
 	coll.Balance += borrowRecord.DebtValue
-	coll.CollBalance -= borrowRecord.CollateralValue
+	addCollBalance(&coll.Collateralize, borrowRecord.CollType, -borrowRecord.CollateralValue)
 	coll.BorrowRecords = append(coll.BorrowRecords[:index], coll.BorrowRecords[index+1:]...)
 	coll.InvalidRecords = append(coll.InvalidRecords, borrowRecord)
 	coll.LatestLiquidationPrice = getLatestLiquidationPrice(&coll.Collateralize)
@@ -779,21 +958,27 @@ func (action *Action) CollateralizeAppend(cAppend *pty.CollateralizeAppend) (*ty
 
 	clog.Debug("CollateralizeAppend", "value", cAppend.CollateralValue)
 
+	collAccount, err := action.getCollAccount(borrowRecord.CollType)
+	if err != nil {
+		clog.Error("CollateralizeAppend.getCollAccount", "CollID", coll.CollateralizeId, "collType", borrowRecord.CollType, "error", err)
+		return nil, err
+	}
+
 	// 获取抵押物价格
-	lastPrice, err := getLatestPrice(action.db)
+	lastPrice, err := getLatestPriceByType(action.db, borrowRecord.CollType)
 	if err != nil {
 		clog.Error("CollateralizeBorrow", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "error", err)
 		return nil, err
 	}
 
 	// 检查抵押物账户余额
-	if !action.CheckExecAccountBalance(action.fromaddr, cAppend.CollateralValue, 0) {
+	if !checkCollAccountBalance(collAccount, action.execaddr, action.fromaddr, cAppend.CollateralValue, 0) {
 		clog.Error("CollateralizeBorrow.CheckExecAccountBalance", "CollID", coll.CollateralizeId, "addr", action.fromaddr, "execaddr", action.execaddr, "amount", cAppend.CollateralValue, "error", types.ErrNoBalance)
 		return nil, types.ErrNoBalance
 	}
 
 	// 抵押物转账
-	receipt, err := action.coinsAccount.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
+	receipt, err := collAccount.ExecTransfer(action.fromaddr, coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.ExecTransfer", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", cAppend.CollateralValue, "error", err)
 		return nil, err
@@ -802,7 +987,7 @@ func (action *Action) CollateralizeAppend(cAppend *pty.CollateralizeAppend) (*ty
 	kv = append(kv, receipt.KV...)
 
 	// 抵押物冻结
-	receipt, err = action.coinsAccount.ExecFrozen(coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
+	receipt, err = collAccount.ExecFrozen(coll.CreateAddr, action.execaddr, cAppend.CollateralValue)
 	if err != nil {
 		clog.Error("CollateralizeBorrow.Frozen", "addr", coll.CreateAddr, "execaddr", action.execaddr, "amount", cAppend.CollateralValue, "error", err)
 		return nil, err
@@ -823,7 +1008,7 @@ func (action *Action) CollateralizeAppend(cAppend *pty.CollateralizeAppend) (*ty
 	}
 
 	// 记录当前借贷的最高自动清算价格
-	coll.CollBalance += cAppend.CollateralValue
+	addCollBalance(&coll.Collateralize, borrowRecord.CollType, cAppend.CollateralValue)
 	coll.LatestLiquidationPrice = getLatestLiquidationPrice(&coll.Collateralize)
 	coll.LatestExpireTime = getLatestExpireTime(&coll.Collateralize)
 	// append操作不更新Index
@@ -906,13 +1091,19 @@ func removeLiquidateRecord(borrowRecords []*pty.BorrowRecord, remove pty.BorrowR
 	return borrowRecords
 }
 
-// 系统清算
-func (action *Action) systemLiquidation(coll *pty.Collateralize, price int64) (*types.Receipt, error) {
+// 系统清算，分叉后只处理喂价对应类型的抵押物，且清算的抵押物进入拍卖
+func (action *Action) systemLiquidation(coll *pty.Collateralize, price int64, collType int32) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 	var removeRecord []*pty.BorrowRecord
 
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	isMultiColl := cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeMultiColl)
 	for _, borrowRecord := range coll.BorrowRecords {
+		if isMultiColl && borrowRecord.CollType != collType {
+			continue
+		}
+
 		if (borrowRecord.LiquidationPrice*PriceWarningRate)/1e4 < price {
 			// 价格恢复，告警记录恢复
 			if borrowRecord.Status == pty.CollateralizeUserStatusWarning {
@@ -929,6 +1120,27 @@ func (action *Action) systemLiquidation(coll *pty.Collateralize, price int64) (*
 			// 价格低于清算线，记录清算
 			clog.Debug("systemLiquidation", "coll id", borrowRecord.CollateralizeId, "record id", borrowRecord.RecordId, "account", borrowRecord.AccountAddr, "price", price)
 
+			// 抵押物进入清算拍卖
+			if isMultiColl {
+				receipt, err := action.startAuction(coll, borrowRecord, price)
+				if err != nil {
+					clog.Error("systemLiquidation", "record id", borrowRecord.RecordId, "startAuction", err)
+					continue
+				}
+				logs = append(logs, receipt.Logs...)
+				kv = append(kv, receipt.KV...)
+
+				borrowRecord.LiquidateTime = action.blocktime
+				borrowRecord.PreStatus = borrowRecord.Status
+				borrowRecord.Status = pty.CollateralizeUserStatusSystemLiquidate
+				coll.InvalidRecords = append(coll.InvalidRecords, borrowRecord)
+				removeRecord = append(removeRecord, borrowRecord)
+
+				log := action.GetFeedReceiptLog(coll, borrowRecord)
+				logs = append(logs, log)
+				continue
+			}
+
 			getGuarantorAddr, err := getGuarantorAddr(action.db)
 			if err != nil {
 				if err != nil {
@@ -1008,8 +1220,14 @@ func (action *Action) expireLiquidation(coll *pty.Collateralize) (*types.Receipt
 				}
 			}
 
+			collAccount, err := action.getCollAccount(borrowRecord.CollType)
+			if err != nil {
+				clog.Error("expireLiquidation", "record id", borrowRecord.RecordId, "getCollAccount", err)
+				continue
+			}
+
 			// 抵押物转移
-			receipt, err := action.coinsAccount.ExecTransferFrozen(coll.CreateAddr, getGuarantorAddr, action.execaddr, borrowRecord.CollateralValue)
+			receipt, err := collAccount.ExecTransferFrozen(coll.CreateAddr, getGuarantorAddr, action.execaddr, borrowRecord.CollateralValue)
 			if err != nil {
 				clog.Error("expireLiquidation", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", borrowRecord.CollateralValue, "error", err)
 				continue
@@ -1023,7 +1241,7 @@ func (action *Action) expireLiquidation(coll *pty.Collateralize) (*types.Receipt
 			borrowRecord.Status = pty.CollateralizeUserStatusExpireLiquidate
 			coll.InvalidRecords = append(coll.InvalidRecords, borrowRecord)
 			removeRecord = append(removeRecord, borrowRecord)
-			coll.CollBalance -= borrowRecord.CollateralValue
+			addCollBalance(coll, borrowRecord.CollType, -borrowRecord.CollateralValue)
 
 			log := action.GetFeedReceiptLog(coll, borrowRecord)
 			logs = append(logs, log)
@@ -1097,6 +1315,34 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 		return nil, pty.ErrPriceInvalid
 	}
 
+	// 分叉后按抵押物类型分别喂价
+	var collType int32
+	cfg := action.Collateralize.GetAPI().GetConfig()
+	if cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizeMultiColl) && !isBtyColl(feed.CollType) {
+		collType = feed.CollType
+		if _, err := getAssetConfig(action.db, collType); err != nil {
+			clog.Error("CollateralizePriceFeed", "collType", collType, "error", err)
+			return nil, err
+		}
+	}
+
+	// 分叉后多个喂价地址按轮次喂价，使用时间加权平均价格判断清算
+	liquidationPrice := price
+	isPriceRound := cfg.IsDappFork(action.height, pty.CollateralizeX, pty.ForkCollateralizePriceRound)
+	if isPriceRound {
+		accepted, median, twap, receipt, err := action.feedRound(collType, price)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+		if !accepted {
+			return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+		}
+		price = median
+		liquidationPrice = twap
+	}
+
 	ids, err := queryCollateralizeByStatus(action.localDB, pty.CollateralizeStatusCreated, "")
 	if err != nil {
 		clog.Debug("CollateralizePriceFeed", "get collateralize record error", err)
@@ -1121,7 +1367,7 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 		}
 
 		// 系统清算判断
-		receipt, err := action.systemLiquidation(coll, price)
+		receipt, err := action.systemLiquidation(coll, liquidationPrice, collType)
 		if err != nil {
 			clog.Error("CollateralizePriceFeed", "Collateralize ID", coll.CollateralizeId, "system liquidation error", err)
 			continue
@@ -1131,11 +1377,21 @@ func (action *Action) CollateralizeFeed(feed *pty.CollateralizeFeed) (*types.Rec
 	}
 
 	var priceRecord pty.AssetPriceRecord
-	priceRecord.BtyPrice = price
 	priceRecord.RecordTime = action.blocktime
+	if isPriceRound {
+		priceRecord.Twap = liquidationPrice
+	}
+	priceKey := PriceKey()
+	if isBtyColl(collType) {
+		priceRecord.BtyPrice = price
+	} else {
+		priceRecord.CollType = collType
+		priceRecord.Price = price
+		priceKey = AssetPriceKey(collType)
+	}
 
 	// 最近喂价记录
-	pricekv := &types.KeyValue{Key: PriceKey(), Value: types.Encode(&priceRecord)}
+	pricekv := &types.KeyValue{Key: priceKey, Value: types.Encode(&priceRecord)}
 	action.db.Set(pricekv.Key, pricekv.Value)
 	kv = append(kv, pricekv)
 
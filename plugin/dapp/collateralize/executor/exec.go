@@ -50,3 +50,15 @@ func (c *Collateralize) Exec_Manage(payload *pty.CollateralizeManage, tx *types.
 	actiondb := NewCollateralizeAction(c, tx, index)
 	return actiondb.CollateralizeManage(payload)
 }
+
+// Exec_Bid Action
+func (c *Collateralize) Exec_Bid(payload *pty.CollateralizeBid, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewCollateralizeAction(c, tx, index)
+	return actiondb.CollateralizeBid(payload)
+}
+
+// Exec_AuctionSettle Action
+func (c *Collateralize) Exec_AuctionSettle(payload *pty.CollateralizeAuctionSettle, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewCollateralizeAction(c, tx, index)
+	return actiondb.CollateralizeAuctionSettle(payload)
+}
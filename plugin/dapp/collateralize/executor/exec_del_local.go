@@ -53,3 +53,13 @@ func (c *Collateralize) ExecDelLocal_Retrieve(payload *pty.CollateralizeRetrieve
 func (c *Collateralize) ExecDelLocal_Manage(payload *pty.CollateralizeManage, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return c.execDelLocal(tx, receiptData)
 }
+
+// ExecDelLocal_Bid Action
+func (c *Collateralize) ExecDelLocal_Bid(payload *pty.CollateralizeBid, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.execDelLocal(tx, receiptData)
+}
+
+// ExecDelLocal_AuctionSettle Action
+func (c *Collateralize) ExecDelLocal_AuctionSettle(payload *pty.CollateralizeAuctionSettle, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.execDelLocal(tx, receiptData)
+}
@@ -13,7 +13,7 @@ import (
 
 func (c *Collateralize) execLocal(tx *types.Transaction, receipt *types.ReceiptData) (*types.LocalDBSet, error) {
 	set := &types.LocalDBSet{}
-	var collTable, recordTable *table.Table
+	var collTable, recordTable, auctionTable *table.Table
 
 	cfg := c.GetAPI().GetConfig()
 	if cfg.IsDappFork(c.GetHeight(), pty.CollateralizeX, pty.ForkCollateralizeTableUpdate) {
@@ -48,6 +48,20 @@ func (c *Collateralize) execLocal(tx *types.Transaction, receipt *types.ReceiptD
 					return nil, err
 				}
 			}
+		} else if item.Ty >= pty.TyLogCollateralizeAuction && item.Ty <= pty.TyLogCollateralizeSettle {
+			var auctionLog pty.ReceiptCollateralizeAuction
+			err := types.Decode(item.Log, &auctionLog)
+			if err != nil {
+				return nil, err
+			}
+
+			if auctionTable == nil {
+				auctionTable = pty.NewAuctionTable(c.GetLocalDB())
+			}
+			err = auctionTable.Replace(&auctionLog)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -67,6 +81,14 @@ func (c *Collateralize) execLocal(tx *types.Transaction, receipt *types.ReceiptD
 		set.KV = append(set.KV, kvs...)
 	}
 
+	if auctionTable != nil {
+		kvs, err := auctionTable.Save()
+		if err != nil {
+			return nil, err
+		}
+		set.KV = append(set.KV, kvs...)
+	}
+
 	set.KV = c.AddRollbackKV(tx, []byte(pty.CollateralizeX), set.KV)
 	return set, nil
 }
@@ -105,3 +127,13 @@ func (c *Collateralize) ExecLocal_Retrieve(payload *pty.CollateralizeRetrieve, t
 func (c *Collateralize) ExecLocal_Manage(payload *pty.CollateralizeManage, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return c.execLocal(tx, receiptData)
 }
+
+// ExecLocal_Bid Action
+func (c *Collateralize) ExecLocal_Bid(payload *pty.CollateralizeBid, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.execLocal(tx, receiptData)
+}
+
+// ExecLocal_AuctionSettle Action
+func (c *Collateralize) ExecLocal_AuctionSettle(payload *pty.CollateralizeAuctionSettle, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return c.execLocal(tx, receiptData)
+}
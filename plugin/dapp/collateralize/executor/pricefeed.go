@@ -0,0 +1,227 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/collateralize/types"
+	"github.com/33cn/plugin/plugin/dapp/pricefeed"
+)
+
+//FeedConfigKey Key for CollateralizeFeedConfig
+func FeedConfigKey() (key []byte) {
+	key = append(key, []byte("mavl-"+pty.CollateralizeX+"-feed-config")...)
+	return key
+}
+
+//RoundIDKey Key for 最新喂价轮次ID
+func RoundIDKey(collType int32) (key []byte) {
+	key = append(key, []byte(fmt.Sprintf("mavl-%s-round-%d", pty.CollateralizeX, collType))...)
+	return key
+}
+
+//RoundKey Key for CollateralizeFeedRound
+func RoundKey(collType int32, roundID int64) (key []byte) {
+	key = append(key, []byte(fmt.Sprintf("mavl-%s-round-%d-%020d", pty.CollateralizeX, collType, roundID))...)
+	return key
+}
+
+//PriceHistoryKey Key for CollateralizePriceHistory
+func PriceHistoryKey(collType int32) (key []byte) {
+	key = append(key, []byte(fmt.Sprintf("mavl-%s-history-%d", pty.CollateralizeX, collType))...)
+	return key
+}
+
+func getFeedConfig(db dbm.KV) *pty.CollateralizeFeedConfig {
+	feedCfg := &pty.CollateralizeFeedConfig{MinFeeders: pricefeed.MinFeeders}
+	data, err := db.Get(FeedConfigKey())
+	if err != nil {
+		return feedCfg
+	}
+	err = types.Decode(data, feedCfg)
+	if err != nil {
+		clog.Error("getFeedConfig", "decode", err)
+	}
+	if feedCfg.MinFeeders < pricefeed.MinFeeders {
+		feedCfg.MinFeeders = pricefeed.MinFeeders
+	}
+	return feedCfg
+}
+
+// 设置喂价配置
+func (action *Action) collateralizeFeedConfig(feedCfg *pty.CollateralizeFeedConfig) (*types.Receipt, error) {
+	if !pricefeed.IsValidConfig(&pricefeed.Config{MinFeeders: feedCfg.MinFeeders, MaxDeviation: feedCfg.MaxDeviation, TwapWindow: feedCfg.TwapWindow}) {
+		return nil, pty.ErrRiskParam
+	}
+
+	value := types.Encode(feedCfg)
+	action.db.Set(FeedConfigKey(), value)
+	kv := []*types.KeyValue{{Key: FeedConfigKey(), Value: value}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: nil}, nil
+}
+
+// 获取最近的时间加权平均价格，没有喂价轮次时为0
+func getLatestTwap(db dbm.KV, collType int32) int64 {
+	key := PriceKey()
+	if !isBtyColl(collType) {
+		key = AssetPriceKey(collType)
+	}
+	data, err := db.Get(key)
+	if err != nil {
+		return 0
+	}
+	var price pty.AssetPriceRecord
+	err = types.Decode(data, &price)
+	if err != nil {
+		return 0
+	}
+	return price.Twap
+}
+
+func getLatestRoundID(db dbm.KV, collType int32) int64 {
+	data, err := db.Get(RoundIDKey(collType))
+	if err != nil {
+		return 0
+	}
+	var id types.Int64
+	err = types.Decode(data, &id)
+	if err != nil {
+		clog.Error("getLatestRoundID", "decode", err)
+		return 0
+	}
+	return id.Data
+}
+
+// feedStore 按 collateralize 的key和结构保存一种抵押物的喂价轮次
+type feedStore struct {
+	db       dbm.KV
+	collType int32
+}
+
+func (s *feedStore) GetLatestRoundID() int64 {
+	return getLatestRoundID(s.db, s.collType)
+}
+
+func (s *feedStore) SetLatestRoundID(roundID int64) *types.KeyValue {
+	value := types.Encode(&types.Int64{Data: roundID})
+	s.db.Set(RoundIDKey(s.collType), value)
+	return &types.KeyValue{Key: RoundIDKey(s.collType), Value: value}
+}
+
+func queryFeedRound(db dbm.KV, collType int32, roundID int64) (*pty.CollateralizeFeedRound, error) {
+	data, err := db.Get(RoundKey(collType, roundID))
+	if err != nil {
+		clog.Debug("queryFeedRound", "collType", collType, "roundID", roundID, "error", err)
+		return nil, err
+	}
+
+	var round pty.CollateralizeFeedRound
+	err = types.Decode(data, &round)
+	if err != nil {
+		clog.Debug("queryFeedRound", "decode", err)
+		return nil, err
+	}
+	return &round, nil
+}
+
+func (s *feedStore) GetRound(roundID int64) (*pricefeed.Round, error) {
+	round, err := queryFeedRound(s.db, s.collType, roundID)
+	if err != nil {
+		return nil, err
+	}
+	return &pricefeed.Round{
+		RoundID:   round.RoundId,
+		Feeders:   round.Feeders,
+		Prices:    round.Prices,
+		StartTime: round.StartTime,
+		CloseTime: round.CloseTime,
+		Median:    round.Median,
+		Twap:      round.Twap,
+		Status:    round.Status,
+	}, nil
+}
+
+func (s *feedStore) SetRound(round *pricefeed.Round) (*types.KeyValue, *types.ReceiptLog) {
+	value := types.Encode(&pty.CollateralizeFeedRound{
+		RoundId:   round.RoundID,
+		CollType:  s.collType,
+		Feeders:   round.Feeders,
+		Prices:    round.Prices,
+		StartTime: round.StartTime,
+		CloseTime: round.CloseTime,
+		Median:    round.Median,
+		Twap:      round.Twap,
+		Status:    round.Status,
+	})
+	s.db.Set(RoundKey(s.collType, round.RoundID), value)
+	return &types.KeyValue{Key: RoundKey(s.collType, round.RoundID), Value: value}, &types.ReceiptLog{Ty: pty.TyLogCollateralizeRound, Log: value}
+}
+
+func (s *feedStore) GetHistory() []*pricefeed.Point {
+	var history pty.CollateralizePriceHistory
+	data, err := s.db.Get(PriceHistoryKey(s.collType))
+	if err != nil {
+		return nil
+	}
+	err = types.Decode(data, &history)
+	if err != nil {
+		clog.Error("getPriceHistory", "decode", err)
+	}
+	points := make([]*pricefeed.Point, 0, len(history.Points))
+	for _, point := range history.Points {
+		points = append(points, &pricefeed.Point{Price: point.Price, Time: point.Time})
+	}
+	return points
+}
+
+func (s *feedStore) SetHistory(points []*pricefeed.Point) *types.KeyValue {
+	var history pty.CollateralizePriceHistory
+	for _, point := range points {
+		history.Points = append(history.Points, &pty.CollateralizePricePoint{Price: point.Price, Time: point.Time})
+	}
+	value := types.Encode(&history)
+	s.db.Set(PriceHistoryKey(s.collType), value)
+	return &types.KeyValue{Key: PriceHistoryKey(s.collType), Value: value}
+}
+
+func (s *feedStore) GetLastPrice() (int64, error) {
+	return getLatestPriceByType(s.db, s.collType)
+}
+
+// 记录喂价地址的报价，返回本轮是否产生了被接受的价格，以及被接受的中位数价格和时间加权平均价格
+func (action *Action) feedRound(collType int32, price int64) (bool, int64, int64, *types.Receipt, error) {
+	feedCfg := getFeedConfig(action.db)
+	cfg := &pricefeed.Config{MinFeeders: feedCfg.MinFeeders, MaxDeviation: feedCfg.MaxDeviation, TwapWindow: feedCfg.TwapWindow}
+	accepted, median, twap, receipt, err := pricefeed.Feed(&feedStore{db: action.db, collType: collType}, cfg, action.fromaddr, price, action.blocktime)
+	if err != nil {
+		clog.Error("feedRound", "addr", action.fromaddr, "collType", collType, "error", err)
+		return false, 0, 0, nil, err
+	}
+	return accepted, median, twap, receipt, nil
+}
+
+func queryFeedRounds(db dbm.KV, req *pty.ReqCollateralizeFeedRounds) []*pty.CollateralizeFeedRound {
+	roundID := req.RoundId
+	if roundID == 0 {
+		roundID = getLatestRoundID(db, req.CollType)
+	}
+	count := req.Count
+	if count <= 0 || count > MaxCount {
+		count = DefaultCount
+	}
+
+	var rounds []*pty.CollateralizeFeedRound
+	for ; roundID > 0 && int32(len(rounds)) < count; roundID-- {
+		round, err := queryFeedRound(db, req.CollType, roundID)
+		if err != nil {
+			break
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds
+}
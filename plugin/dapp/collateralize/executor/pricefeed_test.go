@@ -0,0 +1,105 @@
+package executor
+
+import (
+	"testing"
+
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	pkt "github.com/33cn/plugin/plugin/dapp/collateralize/types"
+	"github.com/33cn/plugin/plugin/dapp/pricefeed"
+	"github.com/stretchr/testify/assert"
+)
+
+func feedersKeySet(key string, values []string, env *execEnv) {
+	item := types.ConfigItem{Key: key, Ty: mty.ConfigItemArrayConfig}
+	item.Value = &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: values}}
+	env.db.Set([]byte(types.ManageKey(key)), types.Encode(&item))
+}
+
+func TestCollateralizeFeedRound(t *testing.T) {
+	env := initAuctionEnv()
+	env.cfg.RegisterDappFork(pkt.CollateralizeX, pkt.ForkCollateralizePriceRound, 0)
+	feedersKeySet("issuance-price-feed", []string{string(Nodes[0]), string(Nodes[1]), string(Nodes[2])}, env)
+
+	exec := newCollateralize()
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.db)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+
+	// 少于3个喂价地址时单个地址就能决定价格
+	tx, _ := pkt.CreateRawCollateralizeManageTx(env.cfg, &pkt.CollateralizeManageTx{MinFeeders: 2, MaxDeviation: 0.2, TwapWindow: 100})
+	assert.Equal(t, pkt.ErrRiskParam, execAuctionTx(t, exec, env, tx, PrivKeyA))
+	tx, _ = pkt.CreateRawCollateralizeManageTx(env.cfg, &pkt.CollateralizeManageTx{MinFeeders: 3, MaxDeviation: 0.2, TwapWindow: 100})
+	assert.Nil(t, execAuctionTx(t, exec, env, tx, PrivKeyA))
+	res, err := exec.Query("CollateralizeFeedConfig", types.Encode(&pkt.ReqCollateralizeAsset{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2000), res.(*pkt.CollateralizeFeedConfig).MaxDeviation)
+
+	feed := func(privKey string, price float64) error {
+		tx, _ := pkt.CreateRawCollateralizeFeedTx(env.cfg, &pkt.CollateralizeFeedTx{Price: []float64{price}, Volume: []int64{1}})
+		return execAuctionTx(t, exec, env, tx, privKey)
+	}
+	queryPrice := func() *pkt.RepCollateralizePrice {
+		res, err := exec.Query("CollateralizePrice", nil)
+		if err != nil {
+			return &pkt.RepCollateralizePrice{}
+		}
+		return res.(*pkt.RepCollateralizePrice)
+	}
+
+	// 第一轮，3个喂价地址取中位数
+	assert.Nil(t, feed(PrivKeyA, 100))
+	assert.Nil(t, feed(PrivKeyB, 110))
+	assert.Equal(t, pkt.ErrFeederDuplicate, feed(PrivKeyB, 110))
+	assert.Equal(t, int64(0), queryPrice().Price)
+	assert.Nil(t, feed(PrivKeyC, 90))
+	assert.Equal(t, int64(100*1e4), queryPrice().Price)
+	assert.Equal(t, int64(100*1e4), queryPrice().Twap)
+
+	// 第二轮，价格变动在偏离范围内，清算使用时间加权价格
+	exec.SetEnv(env.blockHeight+1, env.blockTime+50, env.difficulty)
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC} {
+		assert.Nil(t, feed(key, 120))
+	}
+	assert.Equal(t, int64(120*1e4), queryPrice().Price)
+	assert.Equal(t, int64(100*1e4), queryPrice().Twap)
+
+	// 第三轮，偏离过大被挂起
+	exec.SetEnv(env.blockHeight+2, env.blockTime+100, env.difficulty)
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC} {
+		assert.Nil(t, feed(key, 200))
+	}
+	assert.Equal(t, int64(120*1e4), queryPrice().Price)
+
+	// 第四轮，与挂起轮次价格接近，确认后接受
+	exec.SetEnv(env.blockHeight+3, env.blockTime+110, env.difficulty)
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC} {
+		assert.Nil(t, feed(key, 190))
+	}
+	assert.Equal(t, int64(190*1e4), queryPrice().Price)
+	assert.Equal(t, int64(112*1e4), queryPrice().Twap)
+
+	res, err = exec.Query("CollateralizeFeedRounds", types.Encode(&pkt.ReqCollateralizeFeedRounds{Count: 10}))
+	assert.Nil(t, err)
+	rounds := res.(*pkt.RepCollateralizeFeedRounds).Rounds
+	assert.Equal(t, 4, len(rounds))
+	assert.Equal(t, int64(4), rounds[0].RoundId)
+	assert.Equal(t, int32(pkt.CollateralizeRoundStatusAccepted), rounds[0].Status)
+	assert.Equal(t, int32(pkt.CollateralizeRoundStatusHeld), rounds[1].Status)
+	assert.Equal(t, int64(200*1e4), rounds[1].Median)
+	assert.Equal(t, 3, len(rounds[3].Feeders))
+
+	// 超时未凑齐喂价的轮次作废
+	exec.SetEnv(env.blockHeight+4, env.blockTime+200, env.difficulty)
+	assert.Nil(t, feed(PrivKeyA, 190))
+	exec.SetEnv(env.blockHeight+5, env.blockTime+200+pricefeed.RoundTimeout+1, env.difficulty)
+	assert.Nil(t, feed(PrivKeyA, 190))
+	res, err = exec.Query("CollateralizeFeedRound", types.Encode(&pkt.ReqCollateralizeFeedRound{RoundId: 5}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.CollateralizeRoundStatusExpired), res.(*pkt.CollateralizeFeedRound).Status)
+	res, err = exec.Query("CollateralizeFeedRound", types.Encode(&pkt.ReqCollateralizeFeedRound{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), res.(*pkt.CollateralizeFeedRound).RoundId)
+	assert.Equal(t, int32(pkt.CollateralizeRoundStatusOpen), res.(*pkt.CollateralizeFeedRound).Status)
+}
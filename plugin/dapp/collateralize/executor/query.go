@@ -28,6 +28,7 @@ func (c *Collateralize) Query_CollateralizeInfoByID(req *pty.ReqCollateralizeInf
 		Period:            coll.Period,
 		CollateralizeId:   coll.CollateralizeId,
 		CollBalance:       coll.CollBalance,
+		CollBalances:      coll.CollBalances,
 	}
 	info.BorrowRecords = append(info.BorrowRecords, coll.BorrowRecords...)
 	info.BorrowRecords = append(info.BorrowRecords, coll.InvalidRecords...)
@@ -56,6 +57,7 @@ func (c *Collateralize) Query_CollateralizeInfoByIDs(req *pty.ReqCollateralizeIn
 			Period:            coll.Period,
 			CollateralizeId:   coll.CollateralizeId,
 			CollBalance:       coll.CollBalance,
+			CollBalances:      coll.CollBalances,
 		}
 		info.BorrowRecords = append(info.BorrowRecords, coll.BorrowRecords...)
 		info.BorrowRecords = append(info.BorrowRecords, coll.InvalidRecords...)
@@ -161,6 +163,7 @@ func (c *Collateralize) Query_CollateralizeConfig(req *pty.ReqCollateralizeRecor
 		Period:            config.Period,
 		Balance:           balance,
 		CurrentTime:       config.CurrentTime,
+		AuctionPeriod:     config.AuctionPeriod,
 	}
 
 	return ret, nil
@@ -174,7 +177,7 @@ func (c *Collateralize) Query_CollateralizePrice(req *pty.ReqCollateralizeRecord
 		return nil, err
 	}
 
-	return &pty.RepCollateralizePrice{Price: price}, nil
+	return &pty.RepCollateralizePrice{Price: price, Twap: getLatestTwap(c.GetStateDB(), 0)}, nil
 }
 
 //Query_CollateralizeUserBalance ...
@@ -187,3 +190,79 @@ func (c *Collateralize) Query_CollateralizeUserBalance(req *pty.ReqCollateralize
 
 	return &pty.RepCollateralizeUserBalance{Balance: balance}, nil
 }
+
+//Query_CollateralizePriceByType ...
+func (c *Collateralize) Query_CollateralizePriceByType(req *pty.ReqCollateralizeAsset) (types.Message, error) {
+	price, err := getLatestPriceByType(c.GetStateDB(), req.CollType)
+	if err != nil {
+		clog.Error("Query_CollateralizePriceByType", "collType", req.CollType, "error", err)
+		return nil, err
+	}
+
+	return &pty.RepCollateralizePrice{Price: price, Twap: getLatestTwap(c.GetStateDB(), req.CollType)}, nil
+}
+
+//Query_CollateralizeAssetConfig ...
+func (c *Collateralize) Query_CollateralizeAssetConfig(req *pty.ReqCollateralizeAsset) (types.Message, error) {
+	asset, err := getAssetConfig(c.GetStateDB(), req.CollType)
+	if err != nil {
+		clog.Error("Query_CollateralizeAssetConfig", "collType", req.CollType, "error", err)
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+//Query_CollateralizeAuctionByID ...
+func (c *Collateralize) Query_CollateralizeAuctionByID(req *pty.ReqCollateralizeAuction) (types.Message, error) {
+	auction, err := queryAuctionByID(c.GetStateDB(), req.AuctionId)
+	if err != nil {
+		clog.Error("Query_CollateralizeAuctionByID", "id", req.AuctionId, "error", err)
+		return nil, err
+	}
+
+	return auction, nil
+}
+
+//Query_CollateralizeAuctions 根据状态或借贷人查询清算拍卖
+func (c *Collateralize) Query_CollateralizeAuctions(req *pty.ReqCollateralizeAuctions) (types.Message, error) {
+	auctions, err := queryAuctions(c.GetStateDB(), c.GetLocalDB(), req)
+	if err != nil {
+		clog.Error("Query_CollateralizeAuctions", "get collateralize auction error", err)
+		return nil, err
+	}
+
+	return &pty.RepCollateralizeAuctions{Auctions: auctions}, nil
+}
+
+//Query_CollateralizeFeedConfig ...
+func (c *Collateralize) Query_CollateralizeFeedConfig(req *pty.ReqCollateralizeAsset) (types.Message, error) {
+	return getFeedConfig(c.GetStateDB()), nil
+}
+
+//Query_CollateralizeFeedRound ...
+func (c *Collateralize) Query_CollateralizeFeedRound(req *pty.ReqCollateralizeFeedRound) (types.Message, error) {
+	collType := req.CollType
+	if isBtyColl(collType) {
+		collType = 0
+	}
+	roundID := req.RoundId
+	if roundID == 0 {
+		roundID = getLatestRoundID(c.GetStateDB(), collType)
+	}
+
+	round, err := queryFeedRound(c.GetStateDB(), collType, roundID)
+	if err != nil {
+		clog.Error("Query_CollateralizeFeedRound", "collType", collType, "roundID", roundID, "error", err)
+		return nil, err
+	}
+	return round, nil
+}
+
+//Query_CollateralizeFeedRounds ...
+func (c *Collateralize) Query_CollateralizeFeedRounds(req *pty.ReqCollateralizeFeedRounds) (types.Message, error) {
+	if isBtyColl(req.CollType) {
+		req.CollType = 0
+	}
+	return &pty.RepCollateralizeFeedRounds{Rounds: queryFeedRounds(c.GetStateDB(), req)}, nil
+}
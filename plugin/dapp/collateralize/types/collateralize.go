@@ -28,6 +28,8 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(CollateralizeX, "Enable", 0)
 	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizeTableUpdate, 0)
+	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizeMultiColl, types.MaxHeight)
+	cfg.RegisterDappFork(CollateralizeX, ForkCollateralizePriceRound, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -62,6 +64,10 @@ func (collateralize *CollateralizeType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogCollateralizeAppend:   {Ty: reflect.TypeOf(ReceiptCollateralize{}), Name: "LogCollateralizeAppend"},
 		TyLogCollateralizeFeed:     {Ty: reflect.TypeOf(ReceiptCollateralize{}), Name: "LogCollateralizeFeed"},
 		TyLogCollateralizeRetrieve: {Ty: reflect.TypeOf(ReceiptCollateralize{}), Name: "LogCollateralizeRetrieve"},
+		TyLogCollateralizeAuction:  {Ty: reflect.TypeOf(ReceiptCollateralizeAuction{}), Name: "LogCollateralizeAuction"},
+		TyLogCollateralizeBid:      {Ty: reflect.TypeOf(ReceiptCollateralizeAuction{}), Name: "LogCollateralizeBid"},
+		TyLogCollateralizeSettle:   {Ty: reflect.TypeOf(ReceiptCollateralizeAuction{}), Name: "LogCollateralizeSettle"},
+		TyLogCollateralizeRound:    {Ty: reflect.TypeOf(CollateralizeFeedRound{}), Name: "LogCollateralizeRound"},
 	}
 }
 
@@ -131,6 +137,22 @@ func (collateralize CollateralizeType) CreateTx(action string, message json.RawM
 			return nil, types.ErrInvalidParam
 		}
 		return CreateRawCollateralizeManageTx(cfg, &param)
+	} else if action == "CollateralizeBid" {
+		var param CollateralizeBidTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			llog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawCollateralizeBidTx(cfg, &param)
+	} else if action == "CollateralizeAuctionSettle" {
+		var param CollateralizeAuctionSettleTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			llog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawCollateralizeAuctionSettleTx(cfg, &param)
 	} else {
 		return nil, types.ErrNotSupport
 	}
@@ -139,13 +161,15 @@ func (collateralize CollateralizeType) CreateTx(action string, message json.RawM
 // GetTypeMap method
 func (collateralize CollateralizeType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Create":   CollateralizeActionCreate,
-		"Borrow":   CollateralizeActionBorrow,
-		"Repay":    CollateralizeActionRepay,
-		"Append":   CollateralizeActionAppend,
-		"Feed":     CollateralizeActionFeed,
-		"Retrieve": CollateralizeActionRetrieve,
-		"Manage":   CollateralizeActionManage,
+		"Create":        CollateralizeActionCreate,
+		"Borrow":        CollateralizeActionBorrow,
+		"Repay":         CollateralizeActionRepay,
+		"Append":        CollateralizeActionAppend,
+		"Feed":          CollateralizeActionFeed,
+		"Retrieve":      CollateralizeActionRetrieve,
+		"Manage":        CollateralizeActionManage,
+		"Bid":           CollateralizeActionBid,
+		"AuctionSettle": CollateralizeActionAuctionSettle,
 	}
 }
 
@@ -187,6 +211,7 @@ func CreateRawCollateralizeBorrowTx(cfg *types.Chain33Config, parm *Collateraliz
 	v := &CollateralizeBorrow{
 		CollateralizeId: parm.CollateralizeID,
 		Value:           int64(math.Trunc((parm.Value+0.0000001)*1e4)) * 1e4,
+		CollType:        parm.CollType,
 	}
 	borrow := &CollateralizeAction{
 		Ty:    CollateralizeActionBorrow,
@@ -273,7 +298,8 @@ func CreateRawCollateralizeFeedTx(cfg *types.Chain33Config, parm *CollateralizeF
 	}
 
 	v := &CollateralizeFeed{
-		Volume: parm.Volume,
+		CollType: parm.CollType,
+		Volume:   parm.Volume,
 	}
 
 	for _, r := range parm.Price {
@@ -341,6 +367,21 @@ func CreateRawCollateralizeManageTx(cfg *types.Chain33Config, parm *Collateraliz
 		StabilityFeeRatio: int64(math.Trunc((parm.StabilityFeeRatio + 0.0000001) * 1e4)),
 		Period:            parm.Period,
 		TotalBalance:      int64(math.Trunc((parm.TotalBalance+0.0000001)*1e4)) * 1e4,
+		AuctionPeriod:     parm.AuctionPeriod,
+	}
+	if parm.MinFeeders != 0 || parm.MaxDeviation != 0 || parm.TwapWindow != 0 {
+		v = &CollateralizeManage{FeedConfig: &CollateralizeFeedConfig{
+			MinFeeders:   parm.MinFeeders,
+			MaxDeviation: int64(math.Trunc((parm.MaxDeviation + 0.0000001) * 1e4)),
+			TwapWindow:   parm.TwapWindow,
+		}}
+	} else if parm.CollType != 0 {
+		v = &CollateralizeManage{AssetConfig: &CollateralizeAssetConfig{
+			CollType:         parm.CollType,
+			AssetExec:        parm.AssetExec,
+			AssetSymbol:      parm.AssetSymbol,
+			LiquidationRatio: int64(math.Trunc((parm.LiquidationRatio + 0.0000001) * 1e4)),
+		}}
 	}
 
 	manage := &CollateralizeAction{
@@ -361,3 +402,60 @@ func CreateRawCollateralizeManageTx(cfg *types.Chain33Config, parm *Collateraliz
 	}
 	return tx, nil
 }
+
+// CreateRawCollateralizeBidTx method
+func CreateRawCollateralizeBidTx(cfg *types.Chain33Config, parm *CollateralizeBidTx) (*types.Transaction, error) {
+	if parm == nil {
+		llog.Error("CreateRawCollateralizeBidTx", "parm", parm)
+		return nil, types.ErrInvalidParam
+	}
+
+	v := &CollateralizeBid{
+		AuctionId: parm.AuctionID,
+		Amount:    int64(math.Trunc((parm.Amount+0.0000001)*1e4)) * 1e4,
+	}
+	bid := &CollateralizeAction{
+		Ty:    CollateralizeActionBid,
+		Value: &CollateralizeAction_Bid{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(cfg.ExecName(CollateralizeX)),
+		Payload: types.Encode(bid),
+		Fee:     parm.Fee,
+		To:      address.ExecAddress(cfg.ExecName(CollateralizeX)),
+	}
+	name := cfg.ExecName(CollateralizeX)
+	tx, err := types.FormatTx(cfg, name, tx)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// CreateRawCollateralizeAuctionSettleTx method
+func CreateRawCollateralizeAuctionSettleTx(cfg *types.Chain33Config, parm *CollateralizeAuctionSettleTx) (*types.Transaction, error) {
+	if parm == nil {
+		llog.Error("CreateRawCollateralizeAuctionSettleTx", "parm", parm)
+		return nil, types.ErrInvalidParam
+	}
+
+	v := &CollateralizeAuctionSettle{
+		AuctionId: parm.AuctionID,
+	}
+	settle := &CollateralizeAction{
+		Ty:    CollateralizeActionAuctionSettle,
+		Value: &CollateralizeAction_AuctionSettle{v},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(cfg.ExecName(CollateralizeX)),
+		Payload: types.Encode(settle),
+		Fee:     parm.Fee,
+		To:      address.ExecAddress(cfg.ExecName(CollateralizeX)),
+	}
+	name := cfg.ExecName(CollateralizeX)
+	tx, err := types.FormatTx(cfg, name, tx)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
@@ -23,24 +23,25 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // 放贷信息
 type Collateralize struct {
-	CollateralizeId        string          `protobuf:"bytes,1,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
-	TotalBalance           int64           `protobuf:"varint,2,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
-	DebtCeiling            int64           `protobuf:"varint,3,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
-	LiquidationRatio       int64           `protobuf:"varint,4,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
-	StabilityFeeRatio      int64           `protobuf:"varint,5,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"`
-	CreateAddr             string          `protobuf:"bytes,6,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
-	Balance                int64           `protobuf:"varint,7,opt,name=balance,proto3" json:"balance,omitempty"`
-	BorrowRecords          []*BorrowRecord `protobuf:"bytes,8,rep,name=borrowRecords,proto3" json:"borrowRecords,omitempty"`
-	InvalidRecords         []*BorrowRecord `protobuf:"bytes,9,rep,name=InvalidRecords,proto3" json:"InvalidRecords,omitempty"`
-	Status                 int32           `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
-	LatestLiquidationPrice int64           `protobuf:"varint,11,opt,name=latestLiquidationPrice,proto3" json:"latestLiquidationPrice,omitempty"`
-	Period                 int64           `protobuf:"varint,12,opt,name=period,proto3" json:"period,omitempty"`
-	LatestExpireTime       int64           `protobuf:"varint,13,opt,name=latestExpireTime,proto3" json:"latestExpireTime,omitempty"`
-	CollBalance            int64           `protobuf:"varint,14,opt,name=collBalance,proto3" json:"collBalance,omitempty"`
-	PreStatus              int32           `protobuf:"varint,15,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}        `json:"-"`
-	XXX_unrecognized       []byte          `json:"-"`
-	XXX_sizecache          int32           `json:"-"`
+	CollateralizeId        string               `protobuf:"bytes,1,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
+	TotalBalance           int64                `protobuf:"varint,2,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
+	DebtCeiling            int64                `protobuf:"varint,3,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
+	LiquidationRatio       int64                `protobuf:"varint,4,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
+	StabilityFeeRatio      int64                `protobuf:"varint,5,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"`
+	CreateAddr             string               `protobuf:"bytes,6,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
+	Balance                int64                `protobuf:"varint,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	BorrowRecords          []*BorrowRecord      `protobuf:"bytes,8,rep,name=borrowRecords,proto3" json:"borrowRecords,omitempty"`
+	InvalidRecords         []*BorrowRecord      `protobuf:"bytes,9,rep,name=InvalidRecords,proto3" json:"InvalidRecords,omitempty"`
+	Status                 int32                `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	LatestLiquidationPrice int64                `protobuf:"varint,11,opt,name=latestLiquidationPrice,proto3" json:"latestLiquidationPrice,omitempty"`
+	Period                 int64                `protobuf:"varint,12,opt,name=period,proto3" json:"period,omitempty"`
+	LatestExpireTime       int64                `protobuf:"varint,13,opt,name=latestExpireTime,proto3" json:"latestExpireTime,omitempty"`
+	CollBalance            int64                `protobuf:"varint,14,opt,name=collBalance,proto3" json:"collBalance,omitempty"`
+	PreStatus              int32                `protobuf:"varint,15,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
+	CollBalances           []*CollateralBalance `protobuf:"bytes,16,rep,name=collBalances,proto3" json:"collBalances,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}             `json:"-"`
+	XXX_unrecognized       []byte               `json:"-"`
+	XXX_sizecache          int32                `json:"-"`
 }
 
 func (m *Collateralize) Reset()         { *m = Collateralize{} }
@@ -173,6 +174,61 @@ func (m *Collateralize) GetPreStatus() int32 {
 	return 0
 }
 
+func (m *Collateralize) GetCollBalances() []*CollateralBalance {
+	if m != nil {
+		return m.CollBalances
+	}
+	return nil
+}
+
+// 非bty抵押物数量
+type CollateralBalance struct {
+	CollType             int32    `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`
+	Balance              int64    `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralBalance) Reset()         { *m = CollateralBalance{} }
+func (m *CollateralBalance) String() string { return proto.CompactTextString(m) }
+func (*CollateralBalance) ProtoMessage()    {}
+func (*CollateralBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{1}
+}
+
+func (m *CollateralBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralBalance.Unmarshal(m, b)
+}
+func (m *CollateralBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralBalance.Marshal(b, m, deterministic)
+}
+func (m *CollateralBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralBalance.Merge(m, src)
+}
+func (m *CollateralBalance) XXX_Size() int {
+	return xxx_messageInfo_CollateralBalance.Size(m)
+}
+func (m *CollateralBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralBalance proto.InternalMessageInfo
+
+func (m *CollateralBalance) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+func (m *CollateralBalance) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
 // 借出记录
 type BorrowRecord struct {
 	AccountAddr          string   `protobuf:"bytes,1,opt,name=accountAddr,proto3" json:"accountAddr,omitempty"`
@@ -187,6 +243,7 @@ type BorrowRecord struct {
 	PreStatus            int32    `protobuf:"varint,10,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
 	RecordId             string   `protobuf:"bytes,11,opt,name=recordId,proto3" json:"recordId,omitempty"`
 	CollateralizeId      string   `protobuf:"bytes,12,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
+	CollType             int32    `protobuf:"varint,13,opt,name=collType,proto3" json:"collType,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -196,7 +253,7 @@ func (m *BorrowRecord) Reset()         { *m = BorrowRecord{} }
 func (m *BorrowRecord) String() string { return proto.CompactTextString(m) }
 func (*BorrowRecord) ProtoMessage()    {}
 func (*BorrowRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{1}
+	return fileDescriptor_a988fb4a61381972, []int{2}
 }
 
 func (m *BorrowRecord) XXX_Unmarshal(b []byte) error {
@@ -301,12 +358,22 @@ func (m *BorrowRecord) GetCollateralizeId() string {
 	return ""
 }
 
+func (m *BorrowRecord) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
 // 资产价格记录
 type AssetPriceRecord struct {
 	RecordTime           int64    `protobuf:"varint,1,opt,name=recordTime,proto3" json:"recordTime,omitempty"`
 	BtyPrice             int64    `protobuf:"varint,2,opt,name=btyPrice,proto3" json:"btyPrice,omitempty"`
 	BtcPrice             int64    `protobuf:"varint,3,opt,name=btcPrice,proto3" json:"btcPrice,omitempty"`
 	EthPrice             int64    `protobuf:"varint,4,opt,name=ethPrice,proto3" json:"ethPrice,omitempty"`
+	CollType             int32    `protobuf:"varint,5,opt,name=collType,proto3" json:"collType,omitempty"`
+	Price                int64    `protobuf:"varint,6,opt,name=price,proto3" json:"price,omitempty"`
+	Twap                 int64    `protobuf:"varint,7,opt,name=twap,proto3" json:"twap,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -316,7 +383,7 @@ func (m *AssetPriceRecord) Reset()         { *m = AssetPriceRecord{} }
 func (m *AssetPriceRecord) String() string { return proto.CompactTextString(m) }
 func (*AssetPriceRecord) ProtoMessage()    {}
 func (*AssetPriceRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{2}
+	return fileDescriptor_a988fb4a61381972, []int{3}
 }
 
 func (m *AssetPriceRecord) XXX_Unmarshal(b []byte) error {
@@ -365,6 +432,491 @@ func (m *AssetPriceRecord) GetEthPrice() int64 {
 	return 0
 }
 
+func (m *AssetPriceRecord) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+func (m *AssetPriceRecord) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *AssetPriceRecord) GetTwap() int64 {
+	if m != nil {
+		return m.Twap
+	}
+	return 0
+}
+
+// 喂价配置
+type CollateralizeFeedConfig struct {
+	MinFeeders           int32    `protobuf:"varint,1,opt,name=minFeeders,proto3" json:"minFeeders,omitempty"`
+	MaxDeviation         int64    `protobuf:"varint,2,opt,name=maxDeviation,proto3" json:"maxDeviation,omitempty"`
+	TwapWindow           int64    `protobuf:"varint,3,opt,name=twapWindow,proto3" json:"twapWindow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralizeFeedConfig) Reset()         { *m = CollateralizeFeedConfig{} }
+func (m *CollateralizeFeedConfig) String() string { return proto.CompactTextString(m) }
+func (*CollateralizeFeedConfig) ProtoMessage()    {}
+func (*CollateralizeFeedConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{4}
+}
+
+func (m *CollateralizeFeedConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizeFeedConfig.Unmarshal(m, b)
+}
+func (m *CollateralizeFeedConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizeFeedConfig.Marshal(b, m, deterministic)
+}
+func (m *CollateralizeFeedConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizeFeedConfig.Merge(m, src)
+}
+func (m *CollateralizeFeedConfig) XXX_Size() int {
+	return xxx_messageInfo_CollateralizeFeedConfig.Size(m)
+}
+func (m *CollateralizeFeedConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizeFeedConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizeFeedConfig proto.InternalMessageInfo
+
+func (m *CollateralizeFeedConfig) GetMinFeeders() int32 {
+	if m != nil {
+		return m.MinFeeders
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedConfig) GetMaxDeviation() int64 {
+	if m != nil {
+		return m.MaxDeviation
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedConfig) GetTwapWindow() int64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+// 喂价轮次
+type CollateralizeFeedRound struct {
+	RoundId              int64    `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	CollType             int32    `protobuf:"varint,2,opt,name=collType,proto3" json:"collType,omitempty"`
+	Feeders              []string `protobuf:"bytes,3,rep,name=feeders,proto3" json:"feeders,omitempty"`
+	Prices               []int64  `protobuf:"varint,4,rep,packed,name=prices,proto3" json:"prices,omitempty"`
+	StartTime            int64    `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	CloseTime            int64    `protobuf:"varint,6,opt,name=closeTime,proto3" json:"closeTime,omitempty"`
+	Median               int64    `protobuf:"varint,7,opt,name=median,proto3" json:"median,omitempty"`
+	Twap                 int64    `protobuf:"varint,8,opt,name=twap,proto3" json:"twap,omitempty"`
+	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralizeFeedRound) Reset()         { *m = CollateralizeFeedRound{} }
+func (m *CollateralizeFeedRound) String() string { return proto.CompactTextString(m) }
+func (*CollateralizeFeedRound) ProtoMessage()    {}
+func (*CollateralizeFeedRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{5}
+}
+
+func (m *CollateralizeFeedRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizeFeedRound.Unmarshal(m, b)
+}
+func (m *CollateralizeFeedRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizeFeedRound.Marshal(b, m, deterministic)
+}
+func (m *CollateralizeFeedRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizeFeedRound.Merge(m, src)
+}
+func (m *CollateralizeFeedRound) XXX_Size() int {
+	return xxx_messageInfo_CollateralizeFeedRound.Size(m)
+}
+func (m *CollateralizeFeedRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizeFeedRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizeFeedRound proto.InternalMessageInfo
+
+func (m *CollateralizeFeedRound) GetRoundId() int64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedRound) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedRound) GetFeeders() []string {
+	if m != nil {
+		return m.Feeders
+	}
+	return nil
+}
+
+func (m *CollateralizeFeedRound) GetPrices() []int64 {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *CollateralizeFeedRound) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedRound) GetCloseTime() int64 {
+	if m != nil {
+		return m.CloseTime
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedRound) GetMedian() int64 {
+	if m != nil {
+		return m.Median
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedRound) GetTwap() int64 {
+	if m != nil {
+		return m.Twap
+	}
+	return 0
+}
+
+func (m *CollateralizeFeedRound) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+// 价格点
+type CollateralizePricePoint struct {
+	Price                int64    `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralizePricePoint) Reset()         { *m = CollateralizePricePoint{} }
+func (m *CollateralizePricePoint) String() string { return proto.CompactTextString(m) }
+func (*CollateralizePricePoint) ProtoMessage()    {}
+func (*CollateralizePricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{6}
+}
+
+func (m *CollateralizePricePoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizePricePoint.Unmarshal(m, b)
+}
+func (m *CollateralizePricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizePricePoint.Marshal(b, m, deterministic)
+}
+func (m *CollateralizePricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizePricePoint.Merge(m, src)
+}
+func (m *CollateralizePricePoint) XXX_Size() int {
+	return xxx_messageInfo_CollateralizePricePoint.Size(m)
+}
+func (m *CollateralizePricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizePricePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizePricePoint proto.InternalMessageInfo
+
+func (m *CollateralizePricePoint) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *CollateralizePricePoint) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// 计算时间加权平均价格的历史价格
+type CollateralizePriceHistory struct {
+	Points               []*CollateralizePricePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CollateralizePriceHistory) Reset()         { *m = CollateralizePriceHistory{} }
+func (m *CollateralizePriceHistory) String() string { return proto.CompactTextString(m) }
+func (*CollateralizePriceHistory) ProtoMessage()    {}
+func (*CollateralizePriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{7}
+}
+
+func (m *CollateralizePriceHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizePriceHistory.Unmarshal(m, b)
+}
+func (m *CollateralizePriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizePriceHistory.Marshal(b, m, deterministic)
+}
+func (m *CollateralizePriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizePriceHistory.Merge(m, src)
+}
+func (m *CollateralizePriceHistory) XXX_Size() int {
+	return xxx_messageInfo_CollateralizePriceHistory.Size(m)
+}
+func (m *CollateralizePriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizePriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizePriceHistory proto.InternalMessageInfo
+
+func (m *CollateralizePriceHistory) GetPoints() []*CollateralizePricePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// 抵押物类型配置
+type CollateralizeAssetConfig struct {
+	CollType             int32    `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`
+	AssetExec            string   `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,3,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	LiquidationRatio     int64    `protobuf:"varint,4,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralizeAssetConfig) Reset()         { *m = CollateralizeAssetConfig{} }
+func (m *CollateralizeAssetConfig) String() string { return proto.CompactTextString(m) }
+func (*CollateralizeAssetConfig) ProtoMessage()    {}
+func (*CollateralizeAssetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{8}
+}
+
+func (m *CollateralizeAssetConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizeAssetConfig.Unmarshal(m, b)
+}
+func (m *CollateralizeAssetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizeAssetConfig.Marshal(b, m, deterministic)
+}
+func (m *CollateralizeAssetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizeAssetConfig.Merge(m, src)
+}
+func (m *CollateralizeAssetConfig) XXX_Size() int {
+	return xxx_messageInfo_CollateralizeAssetConfig.Size(m)
+}
+func (m *CollateralizeAssetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizeAssetConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizeAssetConfig proto.InternalMessageInfo
+
+func (m *CollateralizeAssetConfig) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+func (m *CollateralizeAssetConfig) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *CollateralizeAssetConfig) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *CollateralizeAssetConfig) GetLiquidationRatio() int64 {
+	if m != nil {
+		return m.LiquidationRatio
+	}
+	return 0
+}
+
+// 清算拍卖
+type CollateralizeAuction struct {
+	AuctionId            string   `protobuf:"bytes,1,opt,name=auctionId,proto3" json:"auctionId,omitempty"`
+	CollateralizeId      string   `protobuf:"bytes,2,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
+	RecordId             string   `protobuf:"bytes,3,opt,name=recordId,proto3" json:"recordId,omitempty"`
+	Borrower             string   `protobuf:"bytes,4,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	CollType             int32    `protobuf:"varint,5,opt,name=collType,proto3" json:"collType,omitempty"`
+	CollateralValue      int64    `protobuf:"varint,6,opt,name=collateralValue,proto3" json:"collateralValue,omitempty"`
+	DebtValue            int64    `protobuf:"varint,7,opt,name=debtValue,proto3" json:"debtValue,omitempty"`
+	StartTime            int64    `protobuf:"varint,8,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,9,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Bidder               string   `protobuf:"bytes,10,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	BidAmount            int64    `protobuf:"varint,11,opt,name=bidAmount,proto3" json:"bidAmount,omitempty"`
+	Status               int32    `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	Surplus              int64    `protobuf:"varint,13,opt,name=surplus,proto3" json:"surplus,omitempty"`
+	PreStatus            int32    `protobuf:"varint,14,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
+	Reserve              int64    `protobuf:"varint,15,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralizeAuction) Reset()         { *m = CollateralizeAuction{} }
+func (m *CollateralizeAuction) String() string { return proto.CompactTextString(m) }
+func (*CollateralizeAuction) ProtoMessage()    {}
+func (*CollateralizeAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{9}
+}
+
+func (m *CollateralizeAuction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizeAuction.Unmarshal(m, b)
+}
+func (m *CollateralizeAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizeAuction.Marshal(b, m, deterministic)
+}
+func (m *CollateralizeAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizeAuction.Merge(m, src)
+}
+func (m *CollateralizeAuction) XXX_Size() int {
+	return xxx_messageInfo_CollateralizeAuction.Size(m)
+}
+func (m *CollateralizeAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizeAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizeAuction proto.InternalMessageInfo
+
+func (m *CollateralizeAuction) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *CollateralizeAuction) GetCollateralizeId() string {
+	if m != nil {
+		return m.CollateralizeId
+	}
+	return ""
+}
+
+func (m *CollateralizeAuction) GetRecordId() string {
+	if m != nil {
+		return m.RecordId
+	}
+	return ""
+}
+
+func (m *CollateralizeAuction) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *CollateralizeAuction) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetCollateralValue() int64 {
+	if m != nil {
+		return m.CollateralValue
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetDebtValue() int64 {
+	if m != nil {
+		return m.DebtValue
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *CollateralizeAuction) GetBidAmount() int64 {
+	if m != nil {
+		return m.BidAmount
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetSurplus() int64 {
+	if m != nil {
+		return m.Surplus
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetPreStatus() int32 {
+	if m != nil {
+		return m.PreStatus
+	}
+	return 0
+}
+
+func (m *CollateralizeAuction) GetReserve() int64 {
+	if m != nil {
+		return m.Reserve
+	}
+	return 0
+}
+
 // action
 type CollateralizeAction struct {
 	// Types that are valid to be assigned to Value:
@@ -375,6 +927,8 @@ type CollateralizeAction struct {
 	//	*CollateralizeAction_Feed
 	//	*CollateralizeAction_Retrieve
 	//	*CollateralizeAction_Manage
+	//	*CollateralizeAction_Bid
+	//	*CollateralizeAction_AuctionSettle
 	Value                isCollateralizeAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                       `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
@@ -386,7 +940,7 @@ func (m *CollateralizeAction) Reset()         { *m = CollateralizeAction{} }
 func (m *CollateralizeAction) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeAction) ProtoMessage()    {}
 func (*CollateralizeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{3}
+	return fileDescriptor_a988fb4a61381972, []int{10}
 }
 
 func (m *CollateralizeAction) XXX_Unmarshal(b []byte) error {
@@ -439,6 +993,14 @@ type CollateralizeAction_Manage struct {
 	Manage *CollateralizeManage `protobuf:"bytes,7,opt,name=manage,proto3,oneof"`
 }
 
+type CollateralizeAction_Bid struct {
+	Bid *CollateralizeBid `protobuf:"bytes,8,opt,name=bid,proto3,oneof"`
+}
+
+type CollateralizeAction_AuctionSettle struct {
+	AuctionSettle *CollateralizeAuctionSettle `protobuf:"bytes,9,opt,name=auctionSettle,proto3,oneof"`
+}
+
 func (*CollateralizeAction_Create) isCollateralizeAction_Value() {}
 
 func (*CollateralizeAction_Borrow) isCollateralizeAction_Value() {}
@@ -453,6 +1015,10 @@ func (*CollateralizeAction_Retrieve) isCollateralizeAction_Value() {}
 
 func (*CollateralizeAction_Manage) isCollateralizeAction_Value() {}
 
+func (*CollateralizeAction_Bid) isCollateralizeAction_Value() {}
+
+func (*CollateralizeAction_AuctionSettle) isCollateralizeAction_Value() {}
+
 func (m *CollateralizeAction) GetValue() isCollateralizeAction_Value {
 	if m != nil {
 		return m.Value
@@ -509,6 +1075,20 @@ func (m *CollateralizeAction) GetManage() *CollateralizeManage {
 	return nil
 }
 
+func (m *CollateralizeAction) GetBid() *CollateralizeBid {
+	if x, ok := m.GetValue().(*CollateralizeAction_Bid); ok {
+		return x.Bid
+	}
+	return nil
+}
+
+func (m *CollateralizeAction) GetAuctionSettle() *CollateralizeAuctionSettle {
+	if x, ok := m.GetValue().(*CollateralizeAction_AuctionSettle); ok {
+		return x.AuctionSettle
+	}
+	return nil
+}
+
 func (m *CollateralizeAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -526,26 +1106,31 @@ func (*CollateralizeAction) XXX_OneofWrappers() []interface{} {
 		(*CollateralizeAction_Feed)(nil),
 		(*CollateralizeAction_Retrieve)(nil),
 		(*CollateralizeAction_Manage)(nil),
+		(*CollateralizeAction_Bid)(nil),
+		(*CollateralizeAction_AuctionSettle)(nil),
 	}
 }
 
 type CollateralizeManage struct {
-	DebtCeiling          int64    `protobuf:"varint,1,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
-	LiquidationRatio     int64    `protobuf:"varint,2,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
-	StabilityFeeRatio    int64    `protobuf:"varint,3,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"`
-	Period               int64    `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
-	TotalBalance         int64    `protobuf:"varint,5,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
-	CurrentTime          int64    `protobuf:"varint,6,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	DebtCeiling          int64                     `protobuf:"varint,1,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
+	LiquidationRatio     int64                     `protobuf:"varint,2,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
+	StabilityFeeRatio    int64                     `protobuf:"varint,3,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"`
+	Period               int64                     `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+	TotalBalance         int64                     `protobuf:"varint,5,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
+	CurrentTime          int64                     `protobuf:"varint,6,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	AuctionPeriod        int64                     `protobuf:"varint,7,opt,name=auctionPeriod,proto3" json:"auctionPeriod,omitempty"`
+	AssetConfig          *CollateralizeAssetConfig `protobuf:"bytes,8,opt,name=assetConfig,proto3" json:"assetConfig,omitempty"`
+	FeedConfig           *CollateralizeFeedConfig  `protobuf:"bytes,9,opt,name=feedConfig,proto3" json:"feedConfig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *CollateralizeManage) Reset()         { *m = CollateralizeManage{} }
 func (m *CollateralizeManage) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeManage) ProtoMessage()    {}
 func (*CollateralizeManage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{4}
+	return fileDescriptor_a988fb4a61381972, []int{11}
 }
 
 func (m *CollateralizeManage) XXX_Unmarshal(b []byte) error {
@@ -608,6 +1193,27 @@ func (m *CollateralizeManage) GetCurrentTime() int64 {
 	return 0
 }
 
+func (m *CollateralizeManage) GetAuctionPeriod() int64 {
+	if m != nil {
+		return m.AuctionPeriod
+	}
+	return 0
+}
+
+func (m *CollateralizeManage) GetAssetConfig() *CollateralizeAssetConfig {
+	if m != nil {
+		return m.AssetConfig
+	}
+	return nil
+}
+
+func (m *CollateralizeManage) GetFeedConfig() *CollateralizeFeedConfig {
+	if m != nil {
+		return m.FeedConfig
+	}
+	return nil
+}
+
 type CollateralizeAddr struct {
 	SuperAddrs           []string `protobuf:"bytes,1,rep,name=superAddrs,proto3" json:"superAddrs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -619,7 +1225,7 @@ func (m *CollateralizeAddr) Reset()         { *m = CollateralizeAddr{} }
 func (m *CollateralizeAddr) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeAddr) ProtoMessage()    {}
 func (*CollateralizeAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{5}
+	return fileDescriptor_a988fb4a61381972, []int{12}
 }
 
 func (m *CollateralizeAddr) XXX_Unmarshal(b []byte) error {
@@ -659,7 +1265,7 @@ func (m *CollateralizeCreate) Reset()         { *m = CollateralizeCreate{} }
 func (m *CollateralizeCreate) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeCreate) ProtoMessage()    {}
 func (*CollateralizeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{6}
+	return fileDescriptor_a988fb4a61381972, []int{13}
 }
 
 func (m *CollateralizeCreate) XXX_Unmarshal(b []byte) error {
@@ -691,6 +1297,7 @@ func (m *CollateralizeCreate) GetTotalBalance() int64 {
 type CollateralizeBorrow struct {
 	CollateralizeId      string   `protobuf:"bytes,1,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
 	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	CollType             int32    `protobuf:"varint,3,opt,name=collType,proto3" json:"collType,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -700,7 +1307,7 @@ func (m *CollateralizeBorrow) Reset()         { *m = CollateralizeBorrow{} }
 func (m *CollateralizeBorrow) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeBorrow) ProtoMessage()    {}
 func (*CollateralizeBorrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{7}
+	return fileDescriptor_a988fb4a61381972, []int{14}
 }
 
 func (m *CollateralizeBorrow) XXX_Unmarshal(b []byte) error {
@@ -735,6 +1342,13 @@ func (m *CollateralizeBorrow) GetValue() int64 {
 	return 0
 }
 
+func (m *CollateralizeBorrow) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
 // 质押清算
 type CollateralizeRepay struct {
 	CollateralizeId      string   `protobuf:"bytes,1,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
@@ -748,7 +1362,7 @@ func (m *CollateralizeRepay) Reset()         { *m = CollateralizeRepay{} }
 func (m *CollateralizeRepay) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeRepay) ProtoMessage()    {}
 func (*CollateralizeRepay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{8}
+	return fileDescriptor_a988fb4a61381972, []int{15}
 }
 
 func (m *CollateralizeRepay) XXX_Unmarshal(b []byte) error {
@@ -797,7 +1411,7 @@ func (m *CollateralizeAppend) Reset()         { *m = CollateralizeAppend{} }
 func (m *CollateralizeAppend) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeAppend) ProtoMessage()    {}
 func (*CollateralizeAppend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{9}
+	return fileDescriptor_a988fb4a61381972, []int{16}
 }
 
 func (m *CollateralizeAppend) XXX_Unmarshal(b []byte) error {
@@ -853,7 +1467,7 @@ func (m *CollateralizeFeed) Reset()         { *m = CollateralizeFeed{} }
 func (m *CollateralizeFeed) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeFeed) ProtoMessage()    {}
 func (*CollateralizeFeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{10}
+	return fileDescriptor_a988fb4a61381972, []int{17}
 }
 
 func (m *CollateralizeFeed) XXX_Unmarshal(b []byte) error {
@@ -888,11 +1502,99 @@ func (m *CollateralizeFeed) GetPrice() []int64 {
 	return nil
 }
 
-func (m *CollateralizeFeed) GetVolume() []int64 {
+func (m *CollateralizeFeed) GetVolume() []int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+// 清算拍卖出价
+type CollateralizeBid struct {
+	AuctionId            string   `protobuf:"bytes,1,opt,name=auctionId,proto3" json:"auctionId,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralizeBid) Reset()         { *m = CollateralizeBid{} }
+func (m *CollateralizeBid) String() string { return proto.CompactTextString(m) }
+func (*CollateralizeBid) ProtoMessage()    {}
+func (*CollateralizeBid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{18}
+}
+
+func (m *CollateralizeBid) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizeBid.Unmarshal(m, b)
+}
+func (m *CollateralizeBid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizeBid.Marshal(b, m, deterministic)
+}
+func (m *CollateralizeBid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizeBid.Merge(m, src)
+}
+func (m *CollateralizeBid) XXX_Size() int {
+	return xxx_messageInfo_CollateralizeBid.Size(m)
+}
+func (m *CollateralizeBid) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizeBid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizeBid proto.InternalMessageInfo
+
+func (m *CollateralizeBid) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *CollateralizeBid) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// 清算拍卖结算
+type CollateralizeAuctionSettle struct {
+	AuctionId            string   `protobuf:"bytes,1,opt,name=auctionId,proto3" json:"auctionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CollateralizeAuctionSettle) Reset()         { *m = CollateralizeAuctionSettle{} }
+func (m *CollateralizeAuctionSettle) String() string { return proto.CompactTextString(m) }
+func (*CollateralizeAuctionSettle) ProtoMessage()    {}
+func (*CollateralizeAuctionSettle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{19}
+}
+
+func (m *CollateralizeAuctionSettle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollateralizeAuctionSettle.Unmarshal(m, b)
+}
+func (m *CollateralizeAuctionSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollateralizeAuctionSettle.Marshal(b, m, deterministic)
+}
+func (m *CollateralizeAuctionSettle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralizeAuctionSettle.Merge(m, src)
+}
+func (m *CollateralizeAuctionSettle) XXX_Size() int {
+	return xxx_messageInfo_CollateralizeAuctionSettle.Size(m)
+}
+func (m *CollateralizeAuctionSettle) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralizeAuctionSettle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralizeAuctionSettle proto.InternalMessageInfo
+
+func (m *CollateralizeAuctionSettle) GetAuctionId() string {
 	if m != nil {
-		return m.Volume
+		return m.AuctionId
 	}
-	return nil
+	return ""
 }
 
 // 收回
@@ -908,7 +1610,7 @@ func (m *CollateralizeRetrieve) Reset()         { *m = CollateralizeRetrieve{} }
 func (m *CollateralizeRetrieve) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeRetrieve) ProtoMessage()    {}
 func (*CollateralizeRetrieve) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{11}
+	return fileDescriptor_a988fb4a61381972, []int{20}
 }
 
 func (m *CollateralizeRetrieve) XXX_Unmarshal(b []byte) error {
@@ -958,7 +1660,7 @@ func (m *ReceiptCollateralize) Reset()         { *m = ReceiptCollateralize{} }
 func (m *ReceiptCollateralize) String() string { return proto.CompactTextString(m) }
 func (*ReceiptCollateralize) ProtoMessage()    {}
 func (*ReceiptCollateralize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{12}
+	return fileDescriptor_a988fb4a61381972, []int{21}
 }
 
 func (m *ReceiptCollateralize) XXX_Unmarshal(b []byte) error {
@@ -1019,7 +1721,7 @@ func (m *CollateralizeRecords) Reset()         { *m = CollateralizeRecords{} }
 func (m *CollateralizeRecords) String() string { return proto.CompactTextString(m) }
 func (*CollateralizeRecords) ProtoMessage()    {}
 func (*CollateralizeRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{13}
+	return fileDescriptor_a988fb4a61381972, []int{22}
 }
 
 func (m *CollateralizeRecords) XXX_Unmarshal(b []byte) error {
@@ -1059,7 +1761,7 @@ func (m *ReqCollateralizeInfo) Reset()         { *m = ReqCollateralizeInfo{} }
 func (m *ReqCollateralizeInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqCollateralizeInfo) ProtoMessage()    {}
 func (*ReqCollateralizeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{14}
+	return fileDescriptor_a988fb4a61381972, []int{23}
 }
 
 func (m *ReqCollateralizeInfo) XXX_Unmarshal(b []byte) error {
@@ -1089,27 +1791,28 @@ func (m *ReqCollateralizeInfo) GetCollateralizeId() string {
 
 // 返回一期放贷信息
 type RepCollateralizeCurrentInfo struct {
-	Status               int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
-	TotalBalance         int64           `protobuf:"varint,2,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
-	DebtCeiling          int64           `protobuf:"varint,3,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
-	LiquidationRatio     int64           `protobuf:"varint,4,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
-	StabilityFeeRatio    int64           `protobuf:"varint,5,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"`
-	CreateAddr           string          `protobuf:"bytes,6,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
-	Balance              int64           `protobuf:"varint,7,opt,name=balance,proto3" json:"balance,omitempty"`
-	Period               int64           `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
-	CollateralizeId      string          `protobuf:"bytes,9,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
-	CollBalance          int64           `protobuf:"varint,10,opt,name=collBalance,proto3" json:"collBalance,omitempty"`
-	BorrowRecords        []*BorrowRecord `protobuf:"bytes,11,rep,name=borrowRecords,proto3" json:"borrowRecords,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Status               int32                `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	TotalBalance         int64                `protobuf:"varint,2,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
+	DebtCeiling          int64                `protobuf:"varint,3,opt,name=debtCeiling,proto3" json:"debtCeiling,omitempty"`
+	LiquidationRatio     int64                `protobuf:"varint,4,opt,name=liquidationRatio,proto3" json:"liquidationRatio,omitempty"`
+	StabilityFeeRatio    int64                `protobuf:"varint,5,opt,name=stabilityFeeRatio,proto3" json:"stabilityFeeRatio,omitempty"`
+	CreateAddr           string               `protobuf:"bytes,6,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
+	Balance              int64                `protobuf:"varint,7,opt,name=balance,proto3" json:"balance,omitempty"`
+	Period               int64                `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
+	CollateralizeId      string               `protobuf:"bytes,9,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
+	CollBalance          int64                `protobuf:"varint,10,opt,name=collBalance,proto3" json:"collBalance,omitempty"`
+	BorrowRecords        []*BorrowRecord      `protobuf:"bytes,11,rep,name=borrowRecords,proto3" json:"borrowRecords,omitempty"`
+	CollBalances         []*CollateralBalance `protobuf:"bytes,12,rep,name=collBalances,proto3" json:"collBalances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *RepCollateralizeCurrentInfo) Reset()         { *m = RepCollateralizeCurrentInfo{} }
 func (m *RepCollateralizeCurrentInfo) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizeCurrentInfo) ProtoMessage()    {}
 func (*RepCollateralizeCurrentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{15}
+	return fileDescriptor_a988fb4a61381972, []int{24}
 }
 
 func (m *RepCollateralizeCurrentInfo) XXX_Unmarshal(b []byte) error {
@@ -1207,6 +1910,13 @@ func (m *RepCollateralizeCurrentInfo) GetBorrowRecords() []*BorrowRecord {
 	return nil
 }
 
+func (m *RepCollateralizeCurrentInfo) GetCollBalances() []*CollateralBalance {
+	if m != nil {
+		return m.CollBalances
+	}
+	return nil
+}
+
 // 根据ID列表查询多期放贷信息
 type ReqCollateralizeInfos struct {
 	CollateralizeIds     []string `protobuf:"bytes,1,rep,name=collateralizeIds,proto3" json:"collateralizeIds,omitempty"`
@@ -1219,7 +1929,7 @@ func (m *ReqCollateralizeInfos) Reset()         { *m = ReqCollateralizeInfos{} }
 func (m *ReqCollateralizeInfos) String() string { return proto.CompactTextString(m) }
 func (*ReqCollateralizeInfos) ProtoMessage()    {}
 func (*ReqCollateralizeInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{16}
+	return fileDescriptor_a988fb4a61381972, []int{25}
 }
 
 func (m *ReqCollateralizeInfos) XXX_Unmarshal(b []byte) error {
@@ -1259,7 +1969,7 @@ func (m *RepCollateralizeCurrentInfos) Reset()         { *m = RepCollateralizeCu
 func (m *RepCollateralizeCurrentInfos) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizeCurrentInfos) ProtoMessage()    {}
 func (*RepCollateralizeCurrentInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{17}
+	return fileDescriptor_a988fb4a61381972, []int{26}
 }
 
 func (m *RepCollateralizeCurrentInfos) XXX_Unmarshal(b []byte) error {
@@ -1300,7 +2010,7 @@ func (m *ReqCollateralizeByStatus) Reset()         { *m = ReqCollateralizeByStat
 func (m *ReqCollateralizeByStatus) String() string { return proto.CompactTextString(m) }
 func (*ReqCollateralizeByStatus) ProtoMessage()    {}
 func (*ReqCollateralizeByStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{18}
+	return fileDescriptor_a988fb4a61381972, []int{27}
 }
 
 func (m *ReqCollateralizeByStatus) XXX_Unmarshal(b []byte) error {
@@ -1349,7 +2059,7 @@ func (m *ReqCollateralizeByAddr) Reset()         { *m = ReqCollateralizeByAddr{}
 func (m *ReqCollateralizeByAddr) String() string { return proto.CompactTextString(m) }
 func (*ReqCollateralizeByAddr) ProtoMessage()    {}
 func (*ReqCollateralizeByAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{19}
+	return fileDescriptor_a988fb4a61381972, []int{28}
 }
 
 func (m *ReqCollateralizeByAddr) XXX_Unmarshal(b []byte) error {
@@ -1403,7 +2113,7 @@ func (m *RepCollateralizeIDs) Reset()         { *m = RepCollateralizeIDs{} }
 func (m *RepCollateralizeIDs) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizeIDs) ProtoMessage()    {}
 func (*RepCollateralizeIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{20}
+	return fileDescriptor_a988fb4a61381972, []int{29}
 }
 
 func (m *RepCollateralizeIDs) XXX_Unmarshal(b []byte) error {
@@ -1446,7 +2156,7 @@ func (m *ReqCollateralizeRecordByAddr) Reset()         { *m = ReqCollateralizeRe
 func (m *ReqCollateralizeRecordByAddr) String() string { return proto.CompactTextString(m) }
 func (*ReqCollateralizeRecordByAddr) ProtoMessage()    {}
 func (*ReqCollateralizeRecordByAddr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{21}
+	return fileDescriptor_a988fb4a61381972, []int{30}
 }
 
 func (m *ReqCollateralizeRecordByAddr) XXX_Unmarshal(b []byte) error {
@@ -1509,7 +2219,7 @@ func (m *ReqCollateralizeRecordByStatus) Reset()         { *m = ReqCollateralize
 func (m *ReqCollateralizeRecordByStatus) String() string { return proto.CompactTextString(m) }
 func (*ReqCollateralizeRecordByStatus) ProtoMessage()    {}
 func (*ReqCollateralizeRecordByStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{22}
+	return fileDescriptor_a988fb4a61381972, []int{31}
 }
 
 func (m *ReqCollateralizeRecordByStatus) XXX_Unmarshal(b []byte) error {
@@ -1563,7 +2273,7 @@ func (m *RepCollateralizeRecords) Reset()         { *m = RepCollateralizeRecords
 func (m *RepCollateralizeRecords) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizeRecords) ProtoMessage()    {}
 func (*RepCollateralizeRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{23}
+	return fileDescriptor_a988fb4a61381972, []int{32}
 }
 
 func (m *RepCollateralizeRecords) XXX_Unmarshal(b []byte) error {
@@ -1604,7 +2314,7 @@ func (m *ReqCollateralizeRecord) Reset()         { *m = ReqCollateralizeRecord{}
 func (m *ReqCollateralizeRecord) String() string { return proto.CompactTextString(m) }
 func (*ReqCollateralizeRecord) ProtoMessage()    {}
 func (*ReqCollateralizeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{24}
+	return fileDescriptor_a988fb4a61381972, []int{33}
 }
 
 func (m *ReqCollateralizeRecord) XXX_Unmarshal(b []byte) error {
@@ -1651,7 +2361,7 @@ func (m *RepCollateralizeRecord) Reset()         { *m = RepCollateralizeRecord{}
 func (m *RepCollateralizeRecord) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizeRecord) ProtoMessage()    {}
 func (*RepCollateralizeRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{25}
+	return fileDescriptor_a988fb4a61381972, []int{34}
 }
 
 func (m *RepCollateralizeRecord) XXX_Unmarshal(b []byte) error {
@@ -1688,6 +2398,7 @@ type RepCollateralizeConfig struct {
 	TotalBalance         int64    `protobuf:"varint,5,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
 	Balance              int64    `protobuf:"varint,6,opt,name=balance,proto3" json:"balance,omitempty"`
 	CurrentTime          int64    `protobuf:"varint,7,opt,name=currentTime,proto3" json:"currentTime,omitempty"`
+	AuctionPeriod        int64    `protobuf:"varint,8,opt,name=auctionPeriod,proto3" json:"auctionPeriod,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1697,7 +2408,7 @@ func (m *RepCollateralizeConfig) Reset()         { *m = RepCollateralizeConfig{}
 func (m *RepCollateralizeConfig) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizeConfig) ProtoMessage()    {}
 func (*RepCollateralizeConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{26}
+	return fileDescriptor_a988fb4a61381972, []int{35}
 }
 
 func (m *RepCollateralizeConfig) XXX_Unmarshal(b []byte) error {
@@ -1767,9 +2478,17 @@ func (m *RepCollateralizeConfig) GetCurrentTime() int64 {
 	return 0
 }
 
+func (m *RepCollateralizeConfig) GetAuctionPeriod() int64 {
+	if m != nil {
+		return m.AuctionPeriod
+	}
+	return 0
+}
+
 // 返回最新抵押物价格
 type RepCollateralizePrice struct {
 	Price                int64    `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Twap                 int64    `protobuf:"varint,2,opt,name=twap,proto3" json:"twap,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1779,7 +2498,7 @@ func (m *RepCollateralizePrice) Reset()         { *m = RepCollateralizePrice{} }
 func (m *RepCollateralizePrice) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizePrice) ProtoMessage()    {}
 func (*RepCollateralizePrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{27}
+	return fileDescriptor_a988fb4a61381972, []int{36}
 }
 
 func (m *RepCollateralizePrice) XXX_Unmarshal(b []byte) error {
@@ -1807,6 +2526,13 @@ func (m *RepCollateralizePrice) GetPrice() int64 {
 	return 0
 }
 
+func (m *RepCollateralizePrice) GetTwap() int64 {
+	if m != nil {
+		return m.Twap
+	}
+	return 0
+}
+
 // 返回用户借贷总额
 type RepCollateralizeUserBalance struct {
 	Balance              int64    `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
@@ -1819,7 +2545,7 @@ func (m *RepCollateralizeUserBalance) Reset()         { *m = RepCollateralizeUse
 func (m *RepCollateralizeUserBalance) String() string { return proto.CompactTextString(m) }
 func (*RepCollateralizeUserBalance) ProtoMessage()    {}
 func (*RepCollateralizeUserBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a988fb4a61381972, []int{28}
+	return fileDescriptor_a988fb4a61381972, []int{37}
 }
 
 func (m *RepCollateralizeUserBalance) XXX_Unmarshal(b []byte) error {
@@ -1847,10 +2573,409 @@ func (m *RepCollateralizeUserBalance) GetBalance() int64 {
 	return 0
 }
 
+// exec_local 清算拍卖信息
+type ReceiptCollateralizeAuction struct {
+	AuctionId            string   `protobuf:"bytes,1,opt,name=auctionId,proto3" json:"auctionId,omitempty"`
+	CollateralizeId      string   `protobuf:"bytes,2,opt,name=collateralizeId,proto3" json:"collateralizeId,omitempty"`
+	Borrower             string   `protobuf:"bytes,3,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	Bidder               string   `protobuf:"bytes,4,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	Status               int32    `protobuf:"varint,5,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptCollateralizeAuction) Reset()         { *m = ReceiptCollateralizeAuction{} }
+func (m *ReceiptCollateralizeAuction) String() string { return proto.CompactTextString(m) }
+func (*ReceiptCollateralizeAuction) ProtoMessage()    {}
+func (*ReceiptCollateralizeAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{38}
+}
+
+func (m *ReceiptCollateralizeAuction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptCollateralizeAuction.Unmarshal(m, b)
+}
+func (m *ReceiptCollateralizeAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptCollateralizeAuction.Marshal(b, m, deterministic)
+}
+func (m *ReceiptCollateralizeAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptCollateralizeAuction.Merge(m, src)
+}
+func (m *ReceiptCollateralizeAuction) XXX_Size() int {
+	return xxx_messageInfo_ReceiptCollateralizeAuction.Size(m)
+}
+func (m *ReceiptCollateralizeAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptCollateralizeAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptCollateralizeAuction proto.InternalMessageInfo
+
+func (m *ReceiptCollateralizeAuction) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+func (m *ReceiptCollateralizeAuction) GetCollateralizeId() string {
+	if m != nil {
+		return m.CollateralizeId
+	}
+	return ""
+}
+
+func (m *ReceiptCollateralizeAuction) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *ReceiptCollateralizeAuction) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *ReceiptCollateralizeAuction) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+// 根据ID查询清算拍卖
+type ReqCollateralizeAuction struct {
+	AuctionId            string   `protobuf:"bytes,1,opt,name=auctionId,proto3" json:"auctionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCollateralizeAuction) Reset()         { *m = ReqCollateralizeAuction{} }
+func (m *ReqCollateralizeAuction) String() string { return proto.CompactTextString(m) }
+func (*ReqCollateralizeAuction) ProtoMessage()    {}
+func (*ReqCollateralizeAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{39}
+}
+
+func (m *ReqCollateralizeAuction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCollateralizeAuction.Unmarshal(m, b)
+}
+func (m *ReqCollateralizeAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCollateralizeAuction.Marshal(b, m, deterministic)
+}
+func (m *ReqCollateralizeAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCollateralizeAuction.Merge(m, src)
+}
+func (m *ReqCollateralizeAuction) XXX_Size() int {
+	return xxx_messageInfo_ReqCollateralizeAuction.Size(m)
+}
+func (m *ReqCollateralizeAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCollateralizeAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCollateralizeAuction proto.InternalMessageInfo
+
+func (m *ReqCollateralizeAuction) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+// 根据状态或借贷人查询清算拍卖
+type ReqCollateralizeAuctions struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Borrower             string   `protobuf:"bytes,2,opt,name=borrower,proto3" json:"borrower,omitempty"`
+	AuctionId            string   `protobuf:"bytes,3,opt,name=auctionId,proto3" json:"auctionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCollateralizeAuctions) Reset()         { *m = ReqCollateralizeAuctions{} }
+func (m *ReqCollateralizeAuctions) String() string { return proto.CompactTextString(m) }
+func (*ReqCollateralizeAuctions) ProtoMessage()    {}
+func (*ReqCollateralizeAuctions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{40}
+}
+
+func (m *ReqCollateralizeAuctions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCollateralizeAuctions.Unmarshal(m, b)
+}
+func (m *ReqCollateralizeAuctions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCollateralizeAuctions.Marshal(b, m, deterministic)
+}
+func (m *ReqCollateralizeAuctions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCollateralizeAuctions.Merge(m, src)
+}
+func (m *ReqCollateralizeAuctions) XXX_Size() int {
+	return xxx_messageInfo_ReqCollateralizeAuctions.Size(m)
+}
+func (m *ReqCollateralizeAuctions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCollateralizeAuctions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCollateralizeAuctions proto.InternalMessageInfo
+
+func (m *ReqCollateralizeAuctions) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReqCollateralizeAuctions) GetBorrower() string {
+	if m != nil {
+		return m.Borrower
+	}
+	return ""
+}
+
+func (m *ReqCollateralizeAuctions) GetAuctionId() string {
+	if m != nil {
+		return m.AuctionId
+	}
+	return ""
+}
+
+// 返回清算拍卖列表
+type RepCollateralizeAuctions struct {
+	Auctions             []*CollateralizeAuction `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *RepCollateralizeAuctions) Reset()         { *m = RepCollateralizeAuctions{} }
+func (m *RepCollateralizeAuctions) String() string { return proto.CompactTextString(m) }
+func (*RepCollateralizeAuctions) ProtoMessage()    {}
+func (*RepCollateralizeAuctions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{41}
+}
+
+func (m *RepCollateralizeAuctions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepCollateralizeAuctions.Unmarshal(m, b)
+}
+func (m *RepCollateralizeAuctions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepCollateralizeAuctions.Marshal(b, m, deterministic)
+}
+func (m *RepCollateralizeAuctions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepCollateralizeAuctions.Merge(m, src)
+}
+func (m *RepCollateralizeAuctions) XXX_Size() int {
+	return xxx_messageInfo_RepCollateralizeAuctions.Size(m)
+}
+func (m *RepCollateralizeAuctions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepCollateralizeAuctions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepCollateralizeAuctions proto.InternalMessageInfo
+
+func (m *RepCollateralizeAuctions) GetAuctions() []*CollateralizeAuction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+// 根据抵押物类型查询
+type ReqCollateralizeAsset struct {
+	CollType             int32    `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCollateralizeAsset) Reset()         { *m = ReqCollateralizeAsset{} }
+func (m *ReqCollateralizeAsset) String() string { return proto.CompactTextString(m) }
+func (*ReqCollateralizeAsset) ProtoMessage()    {}
+func (*ReqCollateralizeAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{42}
+}
+
+func (m *ReqCollateralizeAsset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCollateralizeAsset.Unmarshal(m, b)
+}
+func (m *ReqCollateralizeAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCollateralizeAsset.Marshal(b, m, deterministic)
+}
+func (m *ReqCollateralizeAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCollateralizeAsset.Merge(m, src)
+}
+func (m *ReqCollateralizeAsset) XXX_Size() int {
+	return xxx_messageInfo_ReqCollateralizeAsset.Size(m)
+}
+func (m *ReqCollateralizeAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCollateralizeAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCollateralizeAsset proto.InternalMessageInfo
+
+func (m *ReqCollateralizeAsset) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+// 查询喂价轮次
+type ReqCollateralizeFeedRound struct {
+	CollType             int32    `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`
+	RoundId              int64    `protobuf:"varint,2,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCollateralizeFeedRound) Reset()         { *m = ReqCollateralizeFeedRound{} }
+func (m *ReqCollateralizeFeedRound) String() string { return proto.CompactTextString(m) }
+func (*ReqCollateralizeFeedRound) ProtoMessage()    {}
+func (*ReqCollateralizeFeedRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{43}
+}
+
+func (m *ReqCollateralizeFeedRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCollateralizeFeedRound.Unmarshal(m, b)
+}
+func (m *ReqCollateralizeFeedRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCollateralizeFeedRound.Marshal(b, m, deterministic)
+}
+func (m *ReqCollateralizeFeedRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCollateralizeFeedRound.Merge(m, src)
+}
+func (m *ReqCollateralizeFeedRound) XXX_Size() int {
+	return xxx_messageInfo_ReqCollateralizeFeedRound.Size(m)
+}
+func (m *ReqCollateralizeFeedRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCollateralizeFeedRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCollateralizeFeedRound proto.InternalMessageInfo
+
+func (m *ReqCollateralizeFeedRound) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+func (m *ReqCollateralizeFeedRound) GetRoundId() int64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+// 查询喂价轮次列表，从roundId开始倒序，roundId为0时从最新轮次开始
+type ReqCollateralizeFeedRounds struct {
+	CollType             int32    `protobuf:"varint,1,opt,name=collType,proto3" json:"collType,omitempty"`
+	RoundId              int64    `protobuf:"varint,2,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqCollateralizeFeedRounds) Reset()         { *m = ReqCollateralizeFeedRounds{} }
+func (m *ReqCollateralizeFeedRounds) String() string { return proto.CompactTextString(m) }
+func (*ReqCollateralizeFeedRounds) ProtoMessage()    {}
+func (*ReqCollateralizeFeedRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{44}
+}
+
+func (m *ReqCollateralizeFeedRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqCollateralizeFeedRounds.Unmarshal(m, b)
+}
+func (m *ReqCollateralizeFeedRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqCollateralizeFeedRounds.Marshal(b, m, deterministic)
+}
+func (m *ReqCollateralizeFeedRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqCollateralizeFeedRounds.Merge(m, src)
+}
+func (m *ReqCollateralizeFeedRounds) XXX_Size() int {
+	return xxx_messageInfo_ReqCollateralizeFeedRounds.Size(m)
+}
+func (m *ReqCollateralizeFeedRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqCollateralizeFeedRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqCollateralizeFeedRounds proto.InternalMessageInfo
+
+func (m *ReqCollateralizeFeedRounds) GetCollType() int32 {
+	if m != nil {
+		return m.CollType
+	}
+	return 0
+}
+
+func (m *ReqCollateralizeFeedRounds) GetRoundId() int64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *ReqCollateralizeFeedRounds) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// 返回喂价轮次列表
+type RepCollateralizeFeedRounds struct {
+	Rounds               []*CollateralizeFeedRound `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *RepCollateralizeFeedRounds) Reset()         { *m = RepCollateralizeFeedRounds{} }
+func (m *RepCollateralizeFeedRounds) String() string { return proto.CompactTextString(m) }
+func (*RepCollateralizeFeedRounds) ProtoMessage()    {}
+func (*RepCollateralizeFeedRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a988fb4a61381972, []int{45}
+}
+
+func (m *RepCollateralizeFeedRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepCollateralizeFeedRounds.Unmarshal(m, b)
+}
+func (m *RepCollateralizeFeedRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepCollateralizeFeedRounds.Marshal(b, m, deterministic)
+}
+func (m *RepCollateralizeFeedRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepCollateralizeFeedRounds.Merge(m, src)
+}
+func (m *RepCollateralizeFeedRounds) XXX_Size() int {
+	return xxx_messageInfo_RepCollateralizeFeedRounds.Size(m)
+}
+func (m *RepCollateralizeFeedRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepCollateralizeFeedRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepCollateralizeFeedRounds proto.InternalMessageInfo
+
+func (m *RepCollateralizeFeedRounds) GetRounds() []*CollateralizeFeedRound {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Collateralize)(nil), "types.Collateralize")
+	proto.RegisterType((*CollateralBalance)(nil), "types.CollateralBalance")
 	proto.RegisterType((*BorrowRecord)(nil), "types.BorrowRecord")
 	proto.RegisterType((*AssetPriceRecord)(nil), "types.AssetPriceRecord")
+	proto.RegisterType((*CollateralizeFeedConfig)(nil), "types.CollateralizeFeedConfig")
+	proto.RegisterType((*CollateralizeFeedRound)(nil), "types.CollateralizeFeedRound")
+	proto.RegisterType((*CollateralizePricePoint)(nil), "types.CollateralizePricePoint")
+	proto.RegisterType((*CollateralizePriceHistory)(nil), "types.CollateralizePriceHistory")
+	proto.RegisterType((*CollateralizeAssetConfig)(nil), "types.CollateralizeAssetConfig")
+	proto.RegisterType((*CollateralizeAuction)(nil), "types.CollateralizeAuction")
 	proto.RegisterType((*CollateralizeAction)(nil), "types.CollateralizeAction")
 	proto.RegisterType((*CollateralizeManage)(nil), "types.CollateralizeManage")
 	proto.RegisterType((*CollateralizeAddr)(nil), "types.CollateralizeAddr")
@@ -1859,6 +2984,8 @@ func init() {
 	proto.RegisterType((*CollateralizeRepay)(nil), "types.CollateralizeRepay")
 	proto.RegisterType((*CollateralizeAppend)(nil), "types.CollateralizeAppend")
 	proto.RegisterType((*CollateralizeFeed)(nil), "types.CollateralizeFeed")
+	proto.RegisterType((*CollateralizeBid)(nil), "types.CollateralizeBid")
+	proto.RegisterType((*CollateralizeAuctionSettle)(nil), "types.CollateralizeAuctionSettle")
 	proto.RegisterType((*CollateralizeRetrieve)(nil), "types.CollateralizeRetrieve")
 	proto.RegisterType((*ReceiptCollateralize)(nil), "types.ReceiptCollateralize")
 	proto.RegisterType((*CollateralizeRecords)(nil), "types.CollateralizeRecords")
@@ -1877,6 +3004,14 @@ func init() {
 	proto.RegisterType((*RepCollateralizeConfig)(nil), "types.RepCollateralizeConfig")
 	proto.RegisterType((*RepCollateralizePrice)(nil), "types.RepCollateralizePrice")
 	proto.RegisterType((*RepCollateralizeUserBalance)(nil), "types.RepCollateralizeUserBalance")
+	proto.RegisterType((*ReceiptCollateralizeAuction)(nil), "types.ReceiptCollateralizeAuction")
+	proto.RegisterType((*ReqCollateralizeAuction)(nil), "types.ReqCollateralizeAuction")
+	proto.RegisterType((*ReqCollateralizeAuctions)(nil), "types.ReqCollateralizeAuctions")
+	proto.RegisterType((*RepCollateralizeAuctions)(nil), "types.RepCollateralizeAuctions")
+	proto.RegisterType((*ReqCollateralizeAsset)(nil), "types.ReqCollateralizeAsset")
+	proto.RegisterType((*ReqCollateralizeFeedRound)(nil), "types.ReqCollateralizeFeedRound")
+	proto.RegisterType((*ReqCollateralizeFeedRounds)(nil), "types.ReqCollateralizeFeedRounds")
+	proto.RegisterType((*RepCollateralizeFeedRounds)(nil), "types.RepCollateralizeFeedRounds")
 }
 
 func init() {
@@ -1884,81 +3019,123 @@ func init() {
 }
 
 var fileDescriptor_a988fb4a61381972 = []byte{
-	// 1208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x5b, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0xd7, 0x7b, 0xc9, 0x9e, 0x4d, 0xd2, 0x74, 0x92, 0x06, 0xd3, 0x46, 0xd1, 0x6a, 0x84,
-	0x44, 0x04, 0x34, 0x12, 0x29, 0xb7, 0xc2, 0x0b, 0xb9, 0xb4, 0xca, 0x22, 0x2a, 0x21, 0xd3, 0x22,
-	0xc4, 0x4d, 0xf2, 0xda, 0x93, 0x60, 0xc9, 0x59, 0x3b, 0xf6, 0x6c, 0x60, 0x79, 0xe0, 0x0d, 0x9e,
-	0x78, 0xe0, 0x89, 0x7f, 0xc0, 0x2b, 0x7f, 0x89, 0x9f, 0xc0, 0x5f, 0x40, 0x33, 0x67, 0x7c, 0x99,
-	0xb1, 0x5d, 0x6d, 0x50, 0x5f, 0xe8, 0x4b, 0xb5, 0x73, 0xe6, 0x3b, 0xc7, 0xdf, 0x7c, 0xe7, 0xcc,
-	0x99, 0xd3, 0xc0, 0x96, 0x1f, 0x47, 0x91, 0xc7, 0x59, 0xea, 0x45, 0xe1, 0x4f, 0xec, 0x20, 0x49,
-	0x63, 0x1e, 0x93, 0x1e, 0x5f, 0x24, 0x2c, 0xa3, 0x7f, 0x77, 0x61, 0xfd, 0xa4, 0xba, 0x4d, 0xf6,
-	0xe1, 0x96, 0x86, 0x9f, 0x04, 0x8e, 0x35, 0xb6, 0xf6, 0x87, 0xae, 0x69, 0x26, 0x14, 0xd6, 0x78,
-	0xcc, 0xbd, 0xe8, 0xd8, 0x8b, 0xbc, 0x99, 0xcf, 0x9c, 0xce, 0xd8, 0xda, 0xb7, 0x5d, 0xcd, 0x46,
-	0xc6, 0x30, 0x0a, 0xd8, 0x94, 0x9f, 0xb0, 0x30, 0x0a, 0x67, 0x17, 0x8e, 0x2d, 0x21, 0x55, 0x13,
-	0x79, 0x03, 0x36, 0xa3, 0xf0, 0x6a, 0x1e, 0x06, 0x1e, 0x0f, 0xe3, 0x99, 0x2b, 0xfe, 0x75, 0xba,
-	0x12, 0x56, 0xb3, 0x93, 0xb7, 0xe0, 0x76, 0xc6, 0xbd, 0x69, 0x18, 0x85, 0x7c, 0xf1, 0x98, 0x31,
-	0x04, 0xf7, 0x24, 0xb8, 0xbe, 0x41, 0xf6, 0x00, 0xfc, 0x94, 0x79, 0x9c, 0x1d, 0x05, 0x41, 0xea,
-	0xf4, 0xe5, 0x21, 0x2a, 0x16, 0xe2, 0xc0, 0x60, 0xaa, 0xa8, 0x0f, 0x64, 0x8c, 0x7c, 0x49, 0x1e,
-	0xc2, 0xfa, 0x34, 0x4e, 0xd3, 0xf8, 0x07, 0x97, 0xf9, 0x71, 0x1a, 0x64, 0xce, 0xea, 0xd8, 0xde,
-	0x1f, 0x1d, 0x6e, 0x1d, 0x48, 0xd1, 0x0e, 0x8e, 0x2b, 0x7b, 0xae, 0x8e, 0x24, 0x1f, 0xc1, 0xc6,
-	0x64, 0x76, 0xed, 0x45, 0x61, 0x90, 0xfb, 0x0e, 0xdb, 0x7d, 0x0d, 0x28, 0xd9, 0x81, 0x7e, 0xc6,
-	0x3d, 0x3e, 0xcf, 0x1c, 0x18, 0x5b, 0xfb, 0x3d, 0x57, 0xad, 0xc8, 0x7b, 0xb0, 0x23, 0x94, 0xcf,
-	0xf8, 0xa7, 0xa5, 0x22, 0x9f, 0xa5, 0xa1, 0xcf, 0x9c, 0x91, 0x24, 0xde, 0xb2, 0x2b, 0xe2, 0x25,
-	0x2c, 0x0d, 0xe3, 0xc0, 0x59, 0x93, 0x38, 0xb5, 0x92, 0x9a, 0x4b, 0x8f, 0x47, 0x3f, 0x26, 0x61,
-	0xca, 0x9e, 0x86, 0x97, 0xcc, 0x59, 0x57, 0x9a, 0x1b, 0x76, 0x91, 0x41, 0x91, 0xf8, 0x3c, 0xc9,
-	0x1b, 0x98, 0xc1, 0x8a, 0x89, 0xec, 0xc2, 0x30, 0x49, 0xd9, 0xe7, 0x48, 0xfc, 0x96, 0x24, 0x5e,
-	0x1a, 0xe8, 0x5f, 0x36, 0xac, 0x55, 0x0f, 0x2d, 0x02, 0x7a, 0xbe, 0x1f, 0xcf, 0x67, 0x5c, 0xe6,
-	0x05, 0x8b, 0xab, 0x6a, 0x12, 0x01, 0x33, 0xee, 0xa5, 0x5c, 0xf2, 0xc2, 0xaa, 0x2a, 0x0d, 0x7a,
-	0x81, 0x7e, 0xe1, 0x45, 0x73, 0xa6, 0xca, 0xca, 0x34, 0xeb, 0x48, 0xd4, 0xab, 0x6b, 0x22, 0x51,
-	0xa8, 0x5d, 0x18, 0x8a, 0x9a, 0xc4, 0x68, 0x58, 0x50, 0xa5, 0xc1, 0x28, 0x51, 0x0c, 0xd4, 0xaf,
-	0x95, 0x68, 0x21, 0xb9, 0x4a, 0xe1, 0x40, 0x4b, 0xe1, 0x6b, 0xb0, 0x9e, 0x63, 0x51, 0xef, 0x55,
-	0x19, 0x40, 0x37, 0x8a, 0x92, 0x65, 0x65, 0x4a, 0x86, 0x12, 0x52, 0xb1, 0xe8, 0x52, 0x83, 0x21,
-	0x35, 0xb9, 0x0b, 0xab, 0xa9, 0xd4, 0x78, 0x12, 0xc8, 0xc2, 0x18, 0xba, 0xc5, 0xba, 0xe9, 0x5a,
-	0xaf, 0x35, 0x5e, 0x6b, 0xfa, 0xab, 0x05, 0x9b, 0x47, 0x59, 0xc6, 0xb8, 0x3c, 0x90, 0x4a, 0xda,
-	0x1e, 0x00, 0x86, 0x92, 0xc4, 0x2c, 0x24, 0x56, 0x5a, 0xc4, 0xa7, 0xa7, 0x7c, 0x81, 0xd2, 0x60,
-	0xc6, 0x8a, 0x35, 0xee, 0xf9, 0xb8, 0x67, 0xe7, 0x7b, 0x7e, 0xb1, 0xc7, 0xf8, 0xf7, 0xd5, 0xdc,
-	0x14, 0x6b, 0xfa, 0xa7, 0x0d, 0x5b, 0x5a, 0x6f, 0x3a, 0xf2, 0x85, 0xce, 0xe4, 0x1d, 0xe8, 0xe3,
-	0x2d, 0x96, 0x3c, 0x46, 0x87, 0x77, 0xd5, 0xd5, 0xd2, 0xb0, 0x27, 0x12, 0x71, 0xb6, 0xe2, 0x2a,
-	0xac, 0xf0, 0xc2, 0x9b, 0x2a, 0xf9, 0xb5, 0x78, 0x61, 0xa1, 0x0a, 0x2f, 0xc4, 0x92, 0xb7, 0xa1,
-	0x97, 0xb2, 0xc4, 0x5b, 0x48, 0xe2, 0xa3, 0xc3, 0x57, 0x9b, 0x9c, 0x5c, 0x01, 0x38, 0x5b, 0x71,
-	0x11, 0x29, 0x3e, 0xe4, 0x25, 0x09, 0x9b, 0x05, 0xf2, 0x40, 0x2d, 0x1f, 0x3a, 0x92, 0x08, 0xf1,
-	0x21, 0xc4, 0x92, 0x03, 0xe8, 0x9e, 0x33, 0x16, 0xc8, 0xe2, 0x1b, 0x1d, 0x3a, 0x4d, 0x3e, 0x8f,
-	0x19, 0x13, 0x1e, 0x12, 0x47, 0x3e, 0x14, 0xb9, 0xe6, 0x69, 0xc8, 0xae, 0xb1, 0x16, 0x47, 0x87,
-	0xbb, 0xcd, 0xdc, 0x10, 0x73, 0xb6, 0xe2, 0x16, 0x78, 0xc1, 0xf0, 0xd2, 0x9b, 0x79, 0x17, 0xd8,
-	0xf7, 0x5a, 0x18, 0x3e, 0x91, 0x08, 0xc1, 0x10, 0xb1, 0x64, 0x03, 0x3a, 0x7c, 0xa1, 0x8a, 0xae,
-	0xc3, 0x17, 0xc7, 0x03, 0xe8, 0x5d, 0x8b, 0xeb, 0x41, 0xff, 0xb1, 0x8c, 0x3c, 0xa1, 0xab, 0xd9,
-	0xfb, 0xad, 0xe5, 0x7a, 0x7f, 0xe7, 0x26, 0xbd, 0xdf, 0x6e, 0xeb, 0xfd, 0x65, 0xe7, 0xeb, 0x6a,
-	0x9d, 0xcf, 0x7c, 0xb3, 0x7a, 0xcd, 0x6f, 0x96, 0x3f, 0x4f, 0x53, 0x36, 0xc3, 0x06, 0xd4, 0x57,
-	0x1d, 0xaf, 0x34, 0xd1, 0x07, 0x70, 0x5b, 0xcf, 0xa6, 0xe8, 0x5a, 0x7b, 0x00, 0xd9, 0x3c, 0x61,
-	0xa9, 0x58, 0x64, 0x8e, 0x35, 0xb6, 0xc5, 0x73, 0x53, 0x5a, 0xe8, 0x43, 0x43, 0x25, 0xac, 0xd0,
-	0x1a, 0x23, 0xab, 0xce, 0x88, 0x3e, 0x33, 0x5c, 0xb1, 0x4c, 0x6f, 0xf0, 0x54, 0x6f, 0xab, 0x5c,
-	0x29, 0x75, 0x55, 0xe2, 0xbe, 0x02, 0x52, 0x2f, 0xe4, 0x1b, 0x44, 0xad, 0xf6, 0x9b, 0x8e, 0xde,
-	0x6f, 0xe8, 0x2f, 0x66, 0x51, 0x60, 0xc5, 0xbf, 0x98, 0xe8, 0xcb, 0xbf, 0x01, 0xf4, 0x5b, 0x23,
-	0x55, 0xe2, 0x12, 0x89, 0xd0, 0x02, 0xf7, 0x74, 0x91, 0xa0, 0xde, 0x3d, 0xb7, 0x58, 0x0b, 0xa9,
-	0x12, 0xd5, 0xc6, 0x6c, 0x21, 0x55, 0x92, 0xb7, 0xf5, 0xeb, 0x38, 0x9a, 0x5f, 0x8a, 0xef, 0x08,
-	0xb3, 0x5a, 0xd1, 0xaf, 0xe1, 0x4e, 0xe3, 0x7d, 0xbb, 0xc1, 0x39, 0x2b, 0x63, 0x48, 0x47, 0x1b,
-	0x43, 0xe8, 0x1f, 0x16, 0x6c, 0xbb, 0xcc, 0x67, 0x61, 0xc2, 0xff, 0xeb, 0x8c, 0x66, 0x3c, 0xb6,
-	0x76, 0xfd, 0xb1, 0xad, 0xca, 0xdc, 0x35, 0x64, 0x2e, 0x1f, 0xb3, 0x5e, 0xf5, 0x31, 0xa3, 0x4f,
-	0x60, 0xdb, 0x38, 0x35, 0xce, 0x2f, 0xef, 0xc2, 0x20, 0x55, 0x53, 0x8f, 0x25, 0xa7, 0x9e, 0x7b,
-	0xaa, 0xb3, 0x34, 0x9d, 0xc2, 0xcd, 0xb1, 0xf4, 0x63, 0x71, 0xcc, 0x2b, 0x6d, 0x73, 0x32, 0x3b,
-	0x8f, 0x97, 0x3f, 0xa6, 0x18, 0x32, 0xee, 0xb9, 0x2c, 0xd1, 0xef, 0x17, 0x5e, 0x58, 0x19, 0xa9,
-	0x3c, 0x88, 0xa5, 0xbd, 0xca, 0x2f, 0xef, 0x08, 0x5b, 0x36, 0xc0, 0x55, 0xad, 0x01, 0x36, 0x68,
-	0x3a, 0x6c, 0x2d, 0x9d, 0xea, 0xe0, 0x07, 0xf5, 0xc1, 0xaf, 0x36, 0x26, 0x8f, 0x96, 0x1d, 0x93,
-	0xe9, 0x09, 0xdc, 0x69, 0x4a, 0x79, 0x26, 0xb4, 0x34, 0x88, 0xe4, 0xbd, 0xb4, 0x66, 0xa7, 0x5f,
-	0xc2, 0xee, 0x73, 0x92, 0x9e, 0x91, 0x0f, 0xa0, 0x17, 0x8a, 0x1f, 0xaa, 0x18, 0x69, 0x51, 0x8c,
-	0xad, 0x3e, 0x2e, 0x3a, 0xd0, 0x4f, 0xc0, 0x31, 0xe9, 0x1d, 0x2f, 0xd4, 0x94, 0xd5, 0x56, 0x4b,
-	0x3b, 0xd0, 0x17, 0x0c, 0x27, 0xa7, 0xaa, 0x5b, 0xa9, 0x15, 0xfd, 0x06, 0x76, 0xea, 0xb1, 0x64,
-	0xf6, 0x08, 0x74, 0xbd, 0x72, 0x04, 0x96, 0xbf, 0x2b, 0xd1, 0x3b, 0x2d, 0xd1, 0x6d, 0x2d, 0xfa,
-	0xeb, 0xb0, 0x65, 0x9e, 0x67, 0x72, 0x9a, 0x91, 0x4d, 0xb0, 0x27, 0xa7, 0xb9, 0x72, 0xe2, 0x27,
-	0xfd, 0xdd, 0x12, 0x6a, 0x5d, 0x35, 0xdc, 0x5b, 0xc5, 0x66, 0xf9, 0xa6, 0x92, 0xf3, 0xee, 0x34,
-	0xf2, 0xb6, 0x35, 0xde, 0xcf, 0x69, 0x2f, 0xf4, 0x67, 0xd8, 0x6b, 0x63, 0xa4, 0xb4, 0x5e, 0x9e,
-	0x53, 0x9b, 0x6e, 0xd5, 0xef, 0xdb, 0xc6, 0xf7, 0xcf, 0xe0, 0x15, 0x53, 0xbb, 0xbc, 0x93, 0xdd,
-	0x37, 0x3b, 0x59, 0x63, 0x51, 0x17, 0x1d, 0xec, 0xbb, 0x7a, 0x8e, 0xd5, 0xe0, 0xfc, 0x62, 0x5e,
-	0xd3, 0x47, 0x22, 0x7e, 0x13, 0x53, 0xf2, 0x26, 0xf4, 0x11, 0xa5, 0x86, 0xe1, 0x46, 0x9e, 0x0a,
-	0x42, 0x7f, 0xeb, 0xd4, 0xe3, 0x9c, 0xc4, 0xb3, 0xf3, 0xf0, 0xe2, 0x7f, 0x3b, 0xac, 0x55, 0x3a,
-	0x60, 0x5f, 0xef, 0x80, 0xc6, 0x18, 0x37, 0xa8, 0x8f, 0x71, 0xf7, 0x45, 0x13, 0xd2, 0xd5, 0xc0,
-	0xff, 0x95, 0x14, 0x33, 0x00, 0xca, 0x80, 0x0b, 0xfa, 0x7e, 0xfd, 0x8d, 0x79, 0x96, 0xb1, 0xb4,
-	0x81, 0x89, 0xa5, 0x31, 0x99, 0xf6, 0xe5, 0x9f, 0x5c, 0x1e, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x0c, 0xfe, 0xe3, 0xa0, 0x89, 0x11, 0x00, 0x00,
+	// 1880 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0x4b, 0x6f, 0xdc, 0x46,
+	0x12, 0x16, 0xc9, 0x79, 0xd6, 0x48, 0xb2, 0x44, 0xc9, 0x12, 0x2d, 0x6b, 0xb5, 0xda, 0xc6, 0x02,
+	0x2b, 0xac, 0x77, 0x05, 0x44, 0x8e, 0xed, 0xd8, 0x09, 0x82, 0xe8, 0x61, 0x63, 0x26, 0x88, 0x01,
+	0x87, 0x72, 0x1e, 0xc8, 0x0b, 0xe0, 0x0c, 0x5b, 0x36, 0x01, 0x0e, 0x49, 0x93, 0x1c, 0xd9, 0x13,
+	0x20, 0x41, 0x2e, 0xb9, 0xe7, 0x14, 0xe4, 0xe2, 0x4b, 0xfe, 0x41, 0xf2, 0x33, 0x82, 0x9c, 0xf2,
+	0x3b, 0xf2, 0x23, 0x82, 0x7e, 0x90, 0xec, 0x6e, 0x92, 0xe3, 0x91, 0xe1, 0x1c, 0x92, 0x8b, 0x30,
+	0x55, 0xfc, 0xba, 0xba, 0xba, 0xaa, 0xfa, 0xeb, 0xea, 0x16, 0xac, 0x8d, 0x42, 0xdf, 0x77, 0x52,
+	0x1c, 0x3b, 0xbe, 0xf7, 0x25, 0xde, 0x8f, 0xe2, 0x30, 0x0d, 0xcd, 0x66, 0x3a, 0x8d, 0x70, 0x82,
+	0x9e, 0x37, 0x61, 0xe9, 0x58, 0xfc, 0x6c, 0xee, 0xc1, 0x25, 0x09, 0x3f, 0x70, 0x2d, 0x6d, 0x57,
+	0xdb, 0xeb, 0xda, 0xaa, 0xda, 0x44, 0xb0, 0x98, 0x86, 0xa9, 0xe3, 0x1f, 0x39, 0xbe, 0x13, 0x8c,
+	0xb0, 0xa5, 0xef, 0x6a, 0x7b, 0x86, 0x2d, 0xe9, 0xcc, 0x5d, 0xe8, 0xb9, 0x78, 0x98, 0x1e, 0x63,
+	0xcf, 0xf7, 0x82, 0x47, 0x96, 0x41, 0x21, 0xa2, 0xca, 0xfc, 0x2f, 0xac, 0xf8, 0xde, 0x93, 0x89,
+	0xe7, 0x3a, 0xa9, 0x17, 0x06, 0x36, 0xf9, 0x6b, 0x35, 0x28, 0xac, 0xa4, 0x37, 0xff, 0x07, 0xab,
+	0x49, 0xea, 0x0c, 0x3d, 0xdf, 0x4b, 0xa7, 0xf7, 0x30, 0x66, 0xe0, 0x26, 0x05, 0x97, 0x3f, 0x98,
+	0x3b, 0x00, 0xa3, 0x18, 0x3b, 0x29, 0x3e, 0x74, 0xdd, 0xd8, 0x6a, 0xd1, 0x45, 0x08, 0x1a, 0xd3,
+	0x82, 0xf6, 0x90, 0xbb, 0xde, 0xa6, 0x36, 0x32, 0xd1, 0xbc, 0x0d, 0x4b, 0xc3, 0x30, 0x8e, 0xc3,
+	0xa7, 0x36, 0x1e, 0x85, 0xb1, 0x9b, 0x58, 0x9d, 0x5d, 0x63, 0xaf, 0x77, 0xb0, 0xb6, 0x4f, 0x83,
+	0xb6, 0x7f, 0x24, 0x7c, 0xb3, 0x65, 0xa4, 0xf9, 0x26, 0x2c, 0x0f, 0x82, 0x73, 0xc7, 0xf7, 0xdc,
+	0x6c, 0x6c, 0xb7, 0x7e, 0xac, 0x02, 0x35, 0x37, 0xa0, 0x95, 0xa4, 0x4e, 0x3a, 0x49, 0x2c, 0xd8,
+	0xd5, 0xf6, 0x9a, 0x36, 0x97, 0xcc, 0x9b, 0xb0, 0x41, 0x22, 0x9f, 0xa4, 0xef, 0x15, 0x11, 0x79,
+	0x10, 0x7b, 0x23, 0x6c, 0xf5, 0xa8, 0xe3, 0x35, 0x5f, 0x89, 0xbd, 0x08, 0xc7, 0x5e, 0xe8, 0x5a,
+	0x8b, 0x14, 0xc7, 0x25, 0x1a, 0x73, 0x3a, 0xe2, 0xee, 0xb3, 0xc8, 0x8b, 0xf1, 0x43, 0x6f, 0x8c,
+	0xad, 0x25, 0x1e, 0x73, 0x45, 0x4f, 0x32, 0x48, 0x12, 0x9f, 0x25, 0x79, 0x99, 0x65, 0x50, 0x50,
+	0x99, 0xdb, 0xd0, 0x8d, 0x62, 0x7c, 0xca, 0x1c, 0xbf, 0x44, 0x1d, 0x2f, 0x14, 0xe6, 0x5b, 0xb0,
+	0x28, 0x80, 0x13, 0x6b, 0x85, 0x86, 0xc3, 0xe2, 0xe1, 0x28, 0x6a, 0x8f, 0x03, 0x6c, 0x09, 0x8d,
+	0x06, 0xb0, 0x5a, 0x82, 0x98, 0x5b, 0xd0, 0x21, 0xa0, 0x87, 0xd3, 0x08, 0xd3, 0xda, 0x6c, 0xda,
+	0xb9, 0x2c, 0x26, 0x55, 0x97, 0x92, 0x8a, 0x7e, 0x31, 0x60, 0x51, 0x8c, 0x3e, 0x59, 0x99, 0x33,
+	0x1a, 0x85, 0x93, 0x20, 0xa5, 0x05, 0xc2, 0xaa, 0x5c, 0x54, 0x91, 0x95, 0x25, 0xa9, 0x13, 0xa7,
+	0x34, 0x40, 0xcc, 0x5c, 0xa1, 0x90, 0x77, 0xca, 0x87, 0x8e, 0x3f, 0xc1, 0xbc, 0xbe, 0x55, 0xb5,
+	0x8c, 0x64, 0x89, 0x6b, 0xa8, 0x48, 0x96, 0xb1, 0x6d, 0xe8, 0x92, 0xcd, 0xc1, 0xac, 0xb1, 0xca,
+	0x2e, 0x14, 0xca, 0x5e, 0x61, 0x86, 0x5a, 0xa5, 0xbd, 0x92, 0xe7, 0x9e, 0xd7, 0x52, 0x5b, 0xaa,
+	0xa5, 0x7f, 0xc3, 0x52, 0x86, 0x65, 0x89, 0xef, 0x50, 0x03, 0xb2, 0x92, 0xec, 0x1d, 0x5c, 0xd4,
+	0x46, 0x97, 0x42, 0x04, 0x8d, 0x9c, 0x73, 0x50, 0x73, 0xbe, 0x05, 0x9d, 0x98, 0xc6, 0x78, 0xe0,
+	0xd2, 0x0a, 0xed, 0xda, 0xb9, 0x5c, 0xc5, 0x2f, 0x8b, 0xd5, 0xfc, 0x22, 0xa6, 0x79, 0x49, 0x4e,
+	0x33, 0xfa, 0x55, 0x83, 0x95, 0xc3, 0x24, 0xc1, 0x29, 0x5d, 0x2c, 0x4f, 0xe8, 0x0e, 0x00, 0x9b,
+	0x86, 0x3a, 0xad, 0x31, 0xa7, 0x0b, 0x0d, 0x31, 0x38, 0x4c, 0xa7, 0x2c, 0x6c, 0x2c, 0x9b, 0xb9,
+	0xcc, 0xbe, 0x8d, 0xd8, 0x37, 0x23, 0xfb, 0x36, 0xca, 0xbf, 0xe1, 0xf4, 0xb1, 0x98, 0xb7, 0x5c,
+	0x96, 0x9c, 0x6c, 0x2a, 0xb5, 0xb8, 0x0e, 0xcd, 0x48, 0xc8, 0x11, 0x13, 0x4c, 0x13, 0x1a, 0xe9,
+	0x53, 0x27, 0xe2, 0x9c, 0x43, 0x7f, 0xa3, 0xaf, 0x60, 0x53, 0x62, 0xe1, 0x7b, 0x18, 0xbb, 0xc7,
+	0x61, 0x70, 0xe6, 0x3d, 0x22, 0x8b, 0x1a, 0x7b, 0x01, 0x51, 0xe0, 0x38, 0xe1, 0xe5, 0x2e, 0x68,
+	0x08, 0x0b, 0x8f, 0x9d, 0x67, 0x27, 0xf8, 0xdc, 0xa3, 0xc9, 0xcf, 0x58, 0x58, 0xd4, 0x11, 0x1b,
+	0x64, 0x9a, 0x8f, 0xbc, 0xc0, 0x0d, 0x9f, 0xf2, 0xe5, 0x09, 0x1a, 0xf4, 0x8d, 0x0e, 0x1b, 0xa5,
+	0xf9, 0xed, 0x70, 0x12, 0xb8, 0x64, 0x3f, 0xc5, 0xe4, 0x07, 0x3f, 0x06, 0x0c, 0x3b, 0x13, 0xa5,
+	0x95, 0xeb, 0xe5, 0x5d, 0x78, 0xc6, 0x3d, 0x36, 0x76, 0x8d, 0xbd, 0xae, 0x9d, 0x89, 0x94, 0x92,
+	0x48, 0x18, 0x12, 0xab, 0xb1, 0x6b, 0x50, 0x4a, 0xa2, 0x92, 0xbc, 0xd5, 0x9a, 0xea, 0x56, 0xdb,
+	0x86, 0xee, 0xc8, 0x0f, 0x13, 0x56, 0x8d, 0x2c, 0x9a, 0x85, 0x82, 0xd8, 0x1c, 0x63, 0xd7, 0x73,
+	0x02, 0x1e, 0x53, 0x2e, 0xe5, 0x91, 0xee, 0x14, 0x91, 0x16, 0xb6, 0x45, 0x57, 0xdc, 0x16, 0xe8,
+	0x58, 0xc9, 0x00, 0xcd, 0xee, 0x83, 0xd0, 0x0b, 0xd2, 0x22, 0x8d, 0x9a, 0x9a, 0xc6, 0x82, 0x16,
+	0xe8, 0x6f, 0x74, 0x0a, 0x57, 0xca, 0x46, 0xfa, 0x5e, 0x92, 0x86, 0xf1, 0xd4, 0xbc, 0x09, 0xad,
+	0x88, 0xd8, 0x23, 0x49, 0x24, 0x14, 0xb8, 0x53, 0xa2, 0x40, 0x69, 0x5a, 0x9b, 0xa3, 0xd1, 0x8f,
+	0x1a, 0x58, 0x12, 0x86, 0xd6, 0x3d, 0xaf, 0x8e, 0x59, 0x54, 0xb8, 0x0d, 0x5d, 0x87, 0x40, 0xef,
+	0x3e, 0xc3, 0x23, 0xea, 0x66, 0xd7, 0x2e, 0x14, 0x94, 0xfd, 0x88, 0x70, 0x3a, 0x1d, 0x0f, 0x43,
+	0xdf, 0x32, 0x38, 0xfb, 0x15, 0xaa, 0x8b, 0x9c, 0xcc, 0xe8, 0x37, 0x03, 0xd6, 0x65, 0x27, 0x27,
+	0x23, 0xf2, 0x95, 0x3a, 0xc1, 0x7e, 0xe6, 0x8d, 0x44, 0xa1, 0xa8, 0x22, 0x03, 0xbd, 0x96, 0x0c,
+	0x72, 0x4a, 0x31, 0x14, 0x4a, 0x21, 0x7b, 0x97, 0x12, 0x3b, 0x8e, 0xa9, 0x83, 0x5d, 0x3b, 0x97,
+	0x67, 0xee, 0xcf, 0x0a, 0x02, 0x6f, 0x55, 0x13, 0xb8, 0x44, 0xcb, 0x6d, 0x95, 0x96, 0xa5, 0xda,
+	0xed, 0xa8, 0xb5, 0x6b, 0x41, 0x1b, 0x07, 0xae, 0xc0, 0xa3, 0x99, 0x48, 0x6a, 0x71, 0xe8, 0xb9,
+	0x2e, 0x8e, 0x29, 0x83, 0x76, 0x6d, 0x2e, 0x11, 0x7b, 0x43, 0xcf, 0x3d, 0x1c, 0x93, 0x73, 0x88,
+	0x9f, 0xf0, 0x85, 0x42, 0xa8, 0xe0, 0x45, 0x89, 0xd8, 0x2d, 0x68, 0x27, 0x93, 0x38, 0xf2, 0x27,
+	0x09, 0x3f, 0xcb, 0x33, 0x51, 0x26, 0xeb, 0x65, 0x95, 0xac, 0xc9, 0x0e, 0xc7, 0x09, 0x8e, 0xcf,
+	0xb1, 0x75, 0x89, 0xef, 0x70, 0x26, 0xa2, 0xe7, 0x0d, 0x58, 0x93, 0x93, 0xca, 0x72, 0xfa, 0x3a,
+	0xb4, 0x58, 0x1b, 0x45, 0x13, 0xda, 0x3b, 0xd8, 0xaa, 0xaa, 0xe4, 0x63, 0x8a, 0xe8, 0x2f, 0xd8,
+	0x1c, 0x4b, 0x46, 0xb1, 0xac, 0x58, 0x7a, 0xfd, 0x28, 0x76, 0x40, 0x93, 0x51, 0x0c, 0x6b, 0xbe,
+	0x06, 0xcd, 0x18, 0x47, 0xce, 0x94, 0x26, 0xbd, 0x77, 0x70, 0xa5, 0x6a, 0x90, 0x4d, 0x00, 0xfd,
+	0x05, 0x9b, 0x21, 0xc9, 0x44, 0x4e, 0x14, 0xe1, 0xc0, 0xb5, 0x1a, 0xf5, 0x13, 0x1d, 0x52, 0x04,
+	0x99, 0x88, 0x61, 0xcd, 0x7d, 0x68, 0x10, 0x8e, 0xa2, 0x45, 0x52, 0xd5, 0x9f, 0x70, 0x56, 0xec,
+	0x2f, 0xd8, 0x14, 0x67, 0xde, 0x21, 0x05, 0x99, 0xc6, 0x1e, 0x3e, 0x67, 0x55, 0xd3, 0x3b, 0xd8,
+	0xae, 0xf6, 0x8d, 0x61, 0xfa, 0x0b, 0x76, 0x8e, 0x27, 0x1e, 0x8e, 0x9d, 0xc0, 0x79, 0xc4, 0x6a,
+	0xa9, 0xc6, 0xc3, 0xfb, 0x14, 0x41, 0x3c, 0x64, 0x58, 0xf3, 0x1a, 0x18, 0x43, 0xcf, 0xa5, 0x05,
+	0xd6, 0x3b, 0xd8, 0xac, 0x8c, 0x9e, 0x47, 0xfc, 0x23, 0x28, 0x73, 0x00, 0x4b, 0x7c, 0x9b, 0x9d,
+	0xe2, 0x34, 0xf5, 0x59, 0xed, 0xf5, 0x0e, 0xfe, 0x55, 0x19, 0x0b, 0x11, 0xd8, 0x5f, 0xb0, 0xe5,
+	0x91, 0xe6, 0x32, 0xe8, 0xe9, 0x94, 0x1f, 0xf2, 0x7a, 0x3a, 0x3d, 0x6a, 0x43, 0xf3, 0x9c, 0xd4,
+	0x3d, 0xfa, 0xc1, 0x80, 0xb5, 0x0a, 0x97, 0xd5, 0xa6, 0x5f, 0x9b, 0xaf, 0xe9, 0xd7, 0x2f, 0xd2,
+	0xf4, 0x1b, 0x75, 0x4d, 0x7f, 0xd1, 0xf2, 0x36, 0xa4, 0x96, 0x57, 0xbd, 0xac, 0x34, 0xab, 0x2f,
+	0x2b, 0xa3, 0x49, 0x1c, 0xe3, 0x20, 0x15, 0xce, 0x19, 0x51, 0x45, 0x9a, 0x27, 0x1e, 0x9b, 0x07,
+	0x6c, 0x12, 0xc6, 0x05, 0xb2, 0xd2, 0x3c, 0xe4, 0xd4, 0xca, 0x38, 0x9a, 0x27, 0xec, 0x9f, 0x95,
+	0x91, 0x2f, 0x60, 0xb6, 0x38, 0xc6, 0x7c, 0x1b, 0xe0, 0x2c, 0xef, 0x01, 0x78, 0xee, 0x76, 0xea,
+	0x6a, 0x92, 0x1b, 0x10, 0x46, 0xa0, 0xeb, 0xb0, 0x2a, 0x4f, 0x44, 0xda, 0xd9, 0x1d, 0x80, 0x64,
+	0x12, 0xe1, 0x98, 0x08, 0xec, 0x14, 0xea, 0xda, 0x82, 0x06, 0xdd, 0x86, 0xb5, 0x8a, 0x2d, 0x5c,
+	0x0a, 0x9d, 0x56, 0x0e, 0x1d, 0x7a, 0x02, 0x6b, 0x15, 0xfb, 0xf8, 0x02, 0x97, 0xc9, 0x75, 0x5e,
+	0x54, 0xbc, 0x0c, 0x98, 0x20, 0xb1, 0xb7, 0xa1, 0xb4, 0x80, 0x9f, 0x80, 0x59, 0x66, 0x81, 0x0b,
+	0xcc, 0x28, 0x9e, 0x28, 0xba, 0x7c, 0xa2, 0xa0, 0x6f, 0x35, 0x95, 0xf9, 0x18, 0x49, 0xbc, 0x12,
+	0xeb, 0xf3, 0x5f, 0x1c, 0xd0, 0xe7, 0xb0, 0x5a, 0xca, 0xf6, 0xcc, 0x33, 0x3f, 0xef, 0x55, 0x74,
+	0xda, 0x5d, 0x31, 0x81, 0x6c, 0x8a, 0xf3, 0xd0, 0x9f, 0x8c, 0x31, 0xed, 0xc6, 0x0c, 0x9b, 0x4b,
+	0xa8, 0x0f, 0x2b, 0x2a, 0x7f, 0xbc, 0xe0, 0xc0, 0xde, 0x80, 0x96, 0xc3, 0xce, 0x25, 0x96, 0x27,
+	0x2e, 0xa1, 0x3b, 0xb0, 0x55, 0x4f, 0x29, 0xb3, 0x6d, 0xa2, 0x4f, 0xe1, 0x72, 0x25, 0x65, 0x5e,
+	0x20, 0xda, 0xf5, 0xb7, 0xbe, 0xef, 0x35, 0x58, 0xb7, 0xf1, 0x08, 0x7b, 0x51, 0xfa, 0xb2, 0xef,
+	0x1c, 0xca, 0x3d, 0xd1, 0x28, 0xdf, 0x13, 0xc5, 0x64, 0x37, 0x94, 0x64, 0x17, 0xc7, 0x75, 0x53,
+	0x6a, 0x38, 0xef, 0x2b, 0x0d, 0x53, 0xf6, 0x06, 0x70, 0x83, 0x1c, 0xc7, 0xf4, 0x27, 0xef, 0x13,
+	0xaf, 0xf2, 0x6d, 0x5f, 0xb5, 0x0a, 0x3b, 0xc3, 0xa2, 0x77, 0xc8, 0x32, 0x9f, 0x48, 0x1f, 0x07,
+	0xc1, 0x59, 0x38, 0xff, 0x32, 0xd1, 0xef, 0x06, 0x5c, 0xb5, 0x71, 0x24, 0x33, 0x00, 0xe3, 0x3e,
+	0x6a, 0xa9, 0x58, 0x88, 0x26, 0xf5, 0x1d, 0x7f, 0xdf, 0x67, 0xa0, 0xe2, 0x2c, 0xe9, 0x48, 0x67,
+	0x49, 0x45, 0x4c, 0xbb, 0xb5, 0xa5, 0x23, 0x3e, 0x9e, 0x40, 0xf9, 0xf1, 0xa4, 0xf4, 0xd4, 0xd4,
+	0x9b, 0xfb, 0xa9, 0x49, 0x7d, 0x59, 0x59, 0xbc, 0xd0, 0xcb, 0xca, 0x31, 0x5c, 0xae, 0x2a, 0x98,
+	0x84, 0x64, 0x42, 0x59, 0x46, 0x76, 0x56, 0x94, 0xf4, 0xe8, 0x63, 0xd8, 0x9e, 0x51, 0x32, 0x89,
+	0xf9, 0x06, 0x34, 0x3d, 0xf2, 0x83, 0x97, 0x32, 0xca, 0x4b, 0xb9, 0x76, 0x8c, 0xcd, 0x06, 0xa0,
+	0x77, 0xc1, 0x52, 0xdd, 0x3b, 0x9a, 0xf2, 0x8e, 0xb5, 0xae, 0x12, 0x37, 0xa0, 0x45, 0x3c, 0x1c,
+	0x9c, 0x70, 0xc6, 0xe5, 0x12, 0xfa, 0x0c, 0x36, 0xca, 0xb6, 0x68, 0xee, 0x4d, 0x68, 0x38, 0xc5,
+	0xdb, 0x0f, 0xfd, 0x2d, 0x58, 0xd7, 0x6b, 0xac, 0x1b, 0x92, 0xf5, 0xff, 0xc0, 0x9a, 0xba, 0x9e,
+	0xc1, 0x49, 0x62, 0xae, 0x80, 0x31, 0x38, 0xc9, 0x22, 0x47, 0x7e, 0xa2, 0xef, 0x34, 0xd8, 0x56,
+	0xfd, 0x60, 0xb9, 0xe4, 0xde, 0xcc, 0x4f, 0x49, 0x99, 0xdf, 0x7a, 0xa5, 0xdf, 0x86, 0xe4, 0xf7,
+	0x0c, 0x72, 0x42, 0x5f, 0xc3, 0x4e, 0x9d, 0x47, 0x3c, 0xd6, 0xf3, 0xfb, 0x54, 0x17, 0xb7, 0x19,
+	0x37, 0x37, 0xd4, 0x87, 0x4d, 0x35, 0x76, 0x59, 0x75, 0xff, 0x5f, 0xe5, 0xc1, 0xca, 0x2d, 0x91,
+	0xf3, 0xdf, 0x17, 0xe5, 0x1c, 0x33, 0xc8, 0x2b, 0xea, 0x08, 0xee, 0xc2, 0x46, 0xb5, 0xa7, 0xe6,
+	0x35, 0x68, 0x31, 0x14, 0xbf, 0x0d, 0x55, 0xfa, 0xc9, 0x21, 0xe8, 0x67, 0xbd, 0x6c, 0x87, 0xb7,
+	0x7c, 0x7f, 0xd5, 0xae, 0x59, 0xe0, 0xcf, 0x96, 0xcc, 0x9f, 0x4a, 0x3f, 0xdd, 0x9e, 0xa3, 0x9f,
+	0xee, 0x54, 0xf4, 0xd3, 0xe8, 0x10, 0x2e, 0xab, 0x31, 0x63, 0x8f, 0x6f, 0xf5, 0x2f, 0x33, 0xe4,
+	0xd9, 0x47, 0x17, 0x1e, 0xd8, 0x6e, 0x95, 0xcf, 0xb6, 0x0f, 0x12, 0x1c, 0x57, 0xac, 0x41, 0x93,
+	0xfb, 0x87, 0x9f, 0x34, 0xb8, 0x5a, 0x75, 0xf2, 0xfe, 0x09, 0xef, 0x1b, 0xf9, 0x1b, 0x86, 0xa1,
+	0xbc, 0x61, 0x14, 0xef, 0x04, 0x0d, 0xe9, 0x9d, 0xa0, 0xae, 0xb5, 0xb8, 0x05, 0x9b, 0xea, 0x5e,
+	0x98, 0xcb, 0x5d, 0xe4, 0x83, 0x55, 0x33, 0xb0, 0x9e, 0x74, 0x45, 0xc7, 0x75, 0xc5, 0x71, 0x69,
+	0x36, 0x43, 0x9d, 0xed, 0x14, 0x2c, 0x35, 0x27, 0xf9, 0x6c, 0xb7, 0xa0, 0xc3, 0x81, 0x6a, 0x1b,
+	0x54, 0x85, 0xb7, 0x73, 0x30, 0xba, 0x5e, 0x3e, 0xd6, 0xe8, 0x25, 0x6b, 0x56, 0xd7, 0x8c, 0xde,
+	0x87, 0x2b, 0xea, 0xa0, 0xe2, 0x05, 0xf4, 0x05, 0xff, 0x6d, 0xc8, 0x5e, 0x47, 0x75, 0xe9, 0x75,
+	0x14, 0x3d, 0x86, 0xad, 0x5a, 0x93, 0xc9, 0xcb, 0xd9, 0x24, 0xe5, 0x4e, 0x7b, 0x4e, 0x4e, 0xf0,
+	0x4c, 0x40, 0xa7, 0xb0, 0xa5, 0x86, 0x51, 0x98, 0xe9, 0x06, 0xb4, 0xe8, 0xf0, 0x2c, 0x8c, 0xff,
+	0xa8, 0xbb, 0x44, 0x52, 0xbc, 0xcd, 0xc1, 0xc3, 0x16, 0xfd, 0x2f, 0xe1, 0xf5, 0x3f, 0x06, 0x00,
+	0x43, 0xd3, 0xa5, 0x87, 0x3c, 0x1c, 0x00, 0x00,
 }
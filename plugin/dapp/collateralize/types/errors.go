@@ -4,7 +4,11 @@
 
 package types
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/33cn/plugin/plugin/dapp/pricefeed"
+)
 
 // Errors for lottery
 var (
@@ -21,4 +25,9 @@ var (
 	ErrCollateralizeBalanceInvalid    = errors.New("ErrCollateralizeBalanceInvalid")
 	ErrPermissionDeny                 = errors.New("ErrPermissionDeny")
 	ErrCollateralizeRecordNotEmpty    = errors.New("ErrCollateralizeRecordNotEmpty")
+	ErrAuctionNotExist                = errors.New("ErrAuctionNotExist")
+	ErrAuctionStatus                  = errors.New("ErrAuctionStatus")
+	ErrAuctionNotEnd                  = errors.New("ErrAuctionNotEnd")
+	ErrAuctionBidTooLow               = errors.New("ErrAuctionBidTooLow")
+	ErrFeederDuplicate                = pricefeed.ErrFeederDuplicate
 )
@@ -122,3 +122,58 @@ func (tx *CollateralizeRecordRow) Get(key string) ([]byte, error) {
 	}
 	return nil, types.ErrNotFound
 }
+
+var optAuction = &table.Option{
+	Prefix:  "LODB-collateralize",
+	Name:    "auction",
+	Primary: "auctionid",
+	Index:   []string{"status", "borrower", "borrower_status"},
+}
+
+// NewAuctionTable 清算拍卖表
+func NewAuctionTable(kvdb db.KV) *table.Table {
+	rowmeta := NewAuctionRow()
+	table, err := table.NewTable(rowmeta, kvdb, optAuction)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//CollateralizeAuctionRow table meta 结构
+type CollateralizeAuctionRow struct {
+	*ReceiptCollateralizeAuction
+}
+
+//NewAuctionRow 新建一个meta 结构
+func NewAuctionRow() *CollateralizeAuctionRow {
+	return &CollateralizeAuctionRow{ReceiptCollateralizeAuction: &ReceiptCollateralizeAuction{}}
+}
+
+//CreateRow 新建数据行
+func (tx *CollateralizeAuctionRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ReceiptCollateralizeAuction{}}
+}
+
+//SetPayload 设置数据
+func (tx *CollateralizeAuctionRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ReceiptCollateralizeAuction); ok {
+		tx.ReceiptCollateralizeAuction = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (tx *CollateralizeAuctionRow) Get(key string) ([]byte, error) {
+	if key == "auctionid" {
+		return []byte(tx.AuctionId), nil
+	} else if key == "status" {
+		return []byte(fmt.Sprintf("%2d", tx.Status)), nil
+	} else if key == "borrower" {
+		return []byte(tx.Borrower), nil
+	} else if key == "borrower_status" {
+		return []byte(fmt.Sprintf("%s:%2d", tx.Borrower, tx.Status)), nil
+	}
+	return nil, types.ErrNotFound
+}
@@ -14,6 +14,7 @@ type CollateralizeCreateTx struct {
 type CollateralizeBorrowTx struct {
 	CollateralizeID string  `json:"collateralizeId"`
 	Value           float64 `json:"value"`
+	CollType        int32   `json:"collType"`
 	Fee             int64   `json:"fee"`
 }
 
@@ -34,9 +35,10 @@ type CollateralizeAppendTx struct {
 
 // CollateralizeFeedTx for construction
 type CollateralizeFeedTx struct {
-	Price  []float64 `json:"price"`
-	Volume []int64   `json:"volume"`
-	Fee    int64     `json:"fee"`
+	CollType int32     `json:"collType"`
+	Price    []float64 `json:"price"`
+	Volume   []int64   `json:"volume"`
+	Fee      int64     `json:"fee"`
 }
 
 // CollateralizeRetrieveTx for construction
@@ -53,5 +55,25 @@ type CollateralizeManageTx struct {
 	StabilityFeeRatio float64 `json:"stabilityFeeRatio"`
 	Period            int64   `json:"period"`
 	TotalBalance      float64 `json:"totalBalance"`
+	AuctionPeriod     int64   `json:"auctionPeriod"`
+	CollType          int32   `json:"collType"`
+	AssetExec         string  `json:"assetExec"`
+	AssetSymbol       string  `json:"assetSymbol"`
+	MinFeeders        int32   `json:"minFeeders"`
+	MaxDeviation      float64 `json:"maxDeviation"`
+	TwapWindow        int64   `json:"twapWindow"`
 	Fee               int64   `json:"fee"`
 }
+
+// CollateralizeBidTx for construction
+type CollateralizeBidTx struct {
+	AuctionID string  `json:"auctionId"`
+	Amount    float64 `json:"amount"`
+	Fee       int64   `json:"fee"`
+}
+
+// CollateralizeAuctionSettleTx for construction
+type CollateralizeAuctionSettleTx struct {
+	AuctionID string `json:"auctionId"`
+	Fee       int64  `json:"fee"`
+}
@@ -4,6 +4,8 @@
 
 package types
 
+import "github.com/33cn/plugin/plugin/dapp/pricefeed"
+
 //Collateralize op
 const (
 	CollateralizeActionCreate = 1 + iota
@@ -13,6 +15,8 @@ const (
 	CollateralizeActionFeed
 	CollateralizeActionRetrieve
 	CollateralizeActionManage
+	CollateralizeActionBid
+	CollateralizeActionAuctionSettle
 
 	//log for Collateralize
 	TyLogCollateralizeCreate   = 731
@@ -21,6 +25,10 @@ const (
 	TyLogCollateralizeAppend   = 734
 	TyLogCollateralizeFeed     = 735
 	TyLogCollateralizeRetrieve = 736
+	TyLogCollateralizeAuction  = 737
+	TyLogCollateralizeBid      = 738
+	TyLogCollateralizeSettle   = 739
+	TyLogCollateralizeRound    = 740
 )
 
 // Collateralize name
@@ -36,12 +44,27 @@ const (
 	CollateralizeStatusClose
 )
 
-//暂时只支持bty
-//const (
-//	CollateralizeAssetTypeBty = 1 + iota
-//	CollateralizeAssetTypeBtc
-//	CollateralizeAssetTypeEth
-//)
+//抵押物类型，非bty抵押物需要通过Manage配置所在执行器和符号
+const (
+	CollateralizeAssetTypeBty = 1 + iota
+	CollateralizeAssetTypeBtc
+	CollateralizeAssetTypeEth
+)
+
+//清算拍卖状态
+const (
+	CollateralizeAuctionStatusOpen = 1 + iota
+	CollateralizeAuctionStatusSettled
+	CollateralizeAuctionStatusUnsold
+)
+
+//喂价轮次状态
+const (
+	CollateralizeRoundStatusOpen     = pricefeed.RoundStatusOpen     // 等待更多喂价
+	CollateralizeRoundStatusAccepted = pricefeed.RoundStatusAccepted // 价格已接受
+	CollateralizeRoundStatusHeld     = pricefeed.RoundStatusHeld     // 偏离过大，等待下一轮确认
+	CollateralizeRoundStatusExpired  = pricefeed.RoundStatusExpired  // 超时未达到喂价人数
+)
 
 //collater ...
 const (
@@ -56,4 +79,6 @@ const (
 //fork ...
 var (
 	ForkCollateralizeTableUpdate = "ForkCollateralizeTableUpdate"
+	ForkCollateralizeMultiColl   = "ForkCollateralizeMultiColl"
+	ForkCollateralizePriceRound  = "ForkCollateralizePriceRound"
 )
@@ -19,6 +19,7 @@ import (
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 )
 
 var (
@@ -127,7 +128,8 @@ func (evm *EVMExecutor) IsFriend(myexec, writekey []byte, othertx *types.Transac
 			return true
 		}
 	}
-	return false
+	//多重签名账户通过MultiSigSubmitTx调用evm合约
+	return mty.IsSubmitTxFriend(evmtypes.ExecutorName, writekey, othertx)
 }
 
 // CheckReceiptExecOk return true to check if receipt ty is ok
@@ -17,6 +17,7 @@ import (
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 )
 
 // Exec 本合约执行逻辑
@@ -230,7 +231,7 @@ func getDataHashKey(addr common.Address) []byte {
 
 // 从交易信息中获取交易发起人地址
 func getCaller(tx *types.Transaction) common.Address {
-	return *common.StringToAddress(tx.From())
+	return *common.StringToAddress(mty.GetTxFrom(tx))
 }
 
 // 从交易信息中获取交易目标地址，在创建合约交易中，此地址为空
@@ -30,6 +30,7 @@ func GuessCmd() *cobra.Command {
 		GuessQueryRawTxCmd(),
 		GuessPublishRawTxCmd(),
 		GuessStopBetRawTxCmd(),
+		GuessSettleRawTxCmd(),
 	)
 
 	return cmd
@@ -62,6 +63,7 @@ func addGuessStartFlags(cmd *cobra.Command) {
 	cmd.Flags().Int64P("platFeeFactor", "p", 0, "plat fee factor, unit: 1/1000")
 	cmd.Flags().StringP("platFeeAddr", "q", "", "plat address to receive share")
 	cmd.Flags().Int64P("expireHeight", "e", 0, "expire height of the game, after this any addr can abort it")
+	cmd.Flags().StringP("oracleEventID", "r", "", "oracle event ID, bets stop at event time and the game is settled by the event result")
 }
 
 func guessStart(cmd *cobra.Command, args []string) {
@@ -80,8 +82,9 @@ func guessStart(cmd *cobra.Command, args []string) {
 	platFeeFactor, _ := cmd.Flags().GetInt64("platFeeFactor")
 	platFeeAddr, _ := cmd.Flags().GetString("platFeeAddr")
 	expireHeight, _ := cmd.Flags().GetInt64("expireHeight")
+	oracleEventID, _ := cmd.Flags().GetString("oracleEventID")
 
-	payload := fmt.Sprintf("{\"topic\":\"%s\", \"options\":\"%s\", \"category\":\"%s\", \"maxBetHeight\":%d, \"maxBetsOneTime\":%d,\"maxBetsNumber\":%d,\"devFeeFactor\":%d,\"platFeeFactor\":%d,\"expireHeight\":%d,\"devFeeAddr\":\"%s\",\"platFeeAddr\":\"%s\"}", topic, options, category, maxBetHeight, maxBetsOneTime, maxBetsNumber, devFeeFactor, platFeeFactor, expireHeight, devFeeAddr, platFeeAddr)
+	payload := fmt.Sprintf("{\"topic\":\"%s\", \"options\":\"%s\", \"category\":\"%s\", \"maxBetHeight\":%d, \"maxBetsOneTime\":%d,\"maxBetsNumber\":%d,\"devFeeFactor\":%d,\"platFeeFactor\":%d,\"expireHeight\":%d,\"devFeeAddr\":\"%s\",\"platFeeAddr\":\"%s\",\"oracleEventID\":\"%s\"}", topic, options, category, maxBetHeight, maxBetsOneTime, maxBetsNumber, devFeeFactor, platFeeFactor, expireHeight, devFeeAddr, platFeeAddr, oracleEventID)
 	params := &rpctypes.CreateTxIn{
 		Execer:     cfg.ExecName(gty.GuessX),
 		ActionName: gty.CreateStartTx,
@@ -205,6 +208,41 @@ func guessAbort(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+//GuessSettleRawTxCmd 构造Guess合约根据预言机结果结算(Settle)原始交易（未签名）的命令行
+func GuessSettleRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle",
+		Short: "settle a guess game by oracle event result",
+		Run:   guessSettle,
+	}
+	addGuessSettleFlags(cmd)
+	return cmd
+}
+
+func addGuessSettleFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("gameId", "g", "", "game Id")
+	cmd.MarkFlagRequired("gameId")
+}
+
+func guessSettle(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	gameID, _ := cmd.Flags().GetString("gameId")
+
+	payload := fmt.Sprintf("{\"gameID\":\"%s\"}", gameID)
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(gty.GuessX),
+		ActionName: gty.CreateSettleTx,
+		Payload:    []byte(payload),
+	}
+
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 //GuessPublishRawTxCmd 构造Guess合约的发布结果(Publish)原始交易（未签名）的命令行
 func GuessPublishRawTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
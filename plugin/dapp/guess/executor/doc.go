@@ -38,6 +38,7 @@ package executor
 5、现实世界的竞猜结果出现后，管理员在游戏超时时间之前公布游戏结果。
 6、（１）合约先对所有赌注收取一定比例的佣金，比如５‰给开发者地址，５‰给平台地址，（２）合约根据管理员输入的正确结果，对每个投注地址进行输赢判断，并将提取佣金后的剩余所有赌注对所有竞猜正确的地址按各自的投注额占比进行比例分配（比如Ａ选项正确，所有选Ａ的赌注共10000个BTY，某个地址向Ａ下注100BTY，则该地址分得1/100）。
 7、如果因为现实世界的突发异常导致竞猜不能继续（比如某场足球比赛因为不可抗力取消了），则管理员可以终止竞猜，合约将把所有地址的投注返还。
+8、竞猜结果是管理员或预言机公布的现实世界事件结果，不是链上随机数，所以不使用randao合约。
 8、如果游戏超时，管理员仍未公布结果，则任何地址都可以触发合约异常终止竞猜，合约中的投注返还给原投注地址。
 8、游戏状态：
    start(管理员)->bet(玩家)->stopbet(管理员)->publish(管理员)
@@ -52,4 +53,12 @@ package executor
    start(管理员)->publish(管理员)
 
    说明：这里的管理员不是特殊地址，而是谁创建本局竞猜游戏，谁就是本局竞猜游戏的管理员。
+
+五、绑定预言机事件（ForkGuessOracle）
+1、创建游戏时可以指定oracleEventID，事件必须已发布且尚未公布结果，事件的参考时间即为截止下注时间。
+2、到达参考时间或事件已预发布结果后，玩家不可再下注。
+3、管理员不能停止下注、公布结果或终止游戏，事件结果最终确定后任何地址都可以发起settle，合约按事件结果结算。
+4、事件被取消或事件结果不在竞猜选项中，settle将返还所有投注；游戏超时后仍可由任何地址终止游戏。
+   start(管理员)->bet(玩家)->settle(任何人)
+   start(管理员)->bet(玩家)->timeout->abort(任何人)
 */
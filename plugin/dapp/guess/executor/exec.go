@@ -38,3 +38,9 @@ func (c *Guess) Exec_Abort(payload *gty.GuessGameAbort, tx *types.Transaction, i
 	action := NewAction(c, tx, index)
 	return action.GameAbort(payload)
 }
+
+//Exec_Settle Guess执行器根据预言机事件结果结算游戏
+func (c *Guess) Exec_Settle(payload *gty.GuessGameSettle, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := NewAction(c, tx, index)
+	return action.GameSettle(payload)
+}
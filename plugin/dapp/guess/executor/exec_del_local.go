@@ -158,3 +158,8 @@ func (g *Guess) ExecDelLocal_Publish(payload *gty.GuessGamePublish, tx *types.Tr
 func (g *Guess) ExecDelLocal_Abort(payload *gty.GuessGameAbort, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return g.execLocal(receiptData)
 }
+
+//ExecDelLocal_Settle Guess执行器Settle交易撤销
+func (g *Guess) ExecDelLocal_Settle(payload *gty.GuessGameSettle, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return g.execDelLocal(receiptData)
+}
@@ -133,3 +133,8 @@ func (g *Guess) ExecLocal_Publish(payload *gty.GuessGamePublish, tx *types.Trans
 func (g *Guess) ExecLocal_Abort(payload *gty.GuessGameAbort, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return g.execLocal(receiptData)
 }
+
+//ExecLocal_Settle method
+func (g *Guess) ExecLocal_Settle(payload *gty.GuessGameSettle, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return g.execLocal(receiptData)
+}
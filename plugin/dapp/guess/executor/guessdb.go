@@ -57,6 +57,7 @@ type Action struct {
 	localDB      dbm.KVDB
 	index        int
 	mainHeight   int64
+	cfg          *types.Chain33Config
 }
 
 //NewAction 生成Action对象
@@ -75,6 +76,7 @@ func NewAction(guess *Guess, tx *types.Transaction, index int) *Action {
 		localDB:      guess.GetLocalDB(),
 		index:        index,
 		mainHeight:   guess.GetMainHeight(),
+		cfg:          guess.GetAPI().GetConfig(),
 	}
 }
 
@@ -292,6 +294,7 @@ func (action *Action) newGame(gameID string, start *gty.GuessGameStart) *gty.Gue
 		BetsNumber: 0,
 		//Index:       action.getIndex(game),
 		DrivenByAdmin: start.DrivenByAdmin,
+		OracleEventID: start.OracleEventID,
 	}
 
 	return game
@@ -333,6 +336,15 @@ func (action *Action) GameStart(start *gty.GuessGameStart) (*types.Receipt, erro
 		return nil, types.ErrInvalidParam
 	}
 
+	var oracleTime int64
+	if start.OracleEventID != "" {
+		var err error
+		oracleTime, err = action.checkOracleEvent(start)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if !action.checkTime(start) {
 		logger.Error("GameStart", "addr", action.fromaddr, "execaddr", action.execaddr,
 			"err", fmt.Sprintf("The height and time parameters are illegal:MaxHeight %d ,ExpireHeight %d", start.MaxBetHeight, start.ExpireHeight))
@@ -356,6 +368,7 @@ func (action *Action) GameStart(start *gty.GuessGameStart) (*types.Receipt, erro
 	game.Index = action.getIndex()
 	game.StartIndex = game.Index
 	game.Status = gty.GuessGameStatusStart
+	game.BetEndTime = oracleTime
 	game.BetStat = &gty.GuessBetStat{TotalBetTimes: 0, TotalBetsNumber: 0}
 	for i := 0; i < len(options); i++ {
 		item := &gty.GuessBetStatItem{Option: options[i], BetsNumber: 0, BetsTimes: 0}
@@ -481,6 +494,13 @@ func (action *Action) GameStopBet(pbBet *gty.GuessGameStopBet) (*types.Receipt,
 		return nil, gty.ErrGuessStatus
 	}
 
+	//绑定预言机事件的游戏在事件参考时间自动停止下注
+	if game.OracleEventID != "" {
+		logger.Error("GameStopBet", "addr", action.fromaddr, "execaddr", action.execaddr, "oracle game",
+			game.GameID, "eventID", game.OracleEventID)
+		return nil, gty.ErrOracleGame
+	}
+
 	//只有adminAddr可以发起stopBet
 	if game.AdminAddr != action.fromaddr {
 		logger.Error("GameStopBet", "addr", action.fromaddr, "execaddr", action.execaddr, "fromAddr is not adminAddr",
@@ -535,6 +555,13 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 		return nil, err
 	}
 
+	//绑定预言机事件的游戏只能根据事件结果结算
+	if game.OracleEventID != "" {
+		logger.Error("GamePublish", "addr", action.fromaddr, "execaddr", action.execaddr, "oracle game",
+			game.GameID, "eventID", game.OracleEventID)
+		return nil, gty.ErrOracleGame
+	}
+
 	//只有adminAddr可以发起publish
 	if game.AdminAddr != action.fromaddr {
 		logger.Error("GamePublish", "addr", action.fromaddr, "execaddr", action.execaddr, "fromAddr is not adminAddr",
@@ -562,7 +589,90 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 		return nil, types.ErrInvalidParam
 	}
 
-	game.Result = trimStr(publish.Result)
+	logs, kv, err = action.settleResult(game, publish.Result)
+	if err != nil {
+		return nil, err
+	}
+
+	var receiptLog *types.ReceiptLog
+	action.changeAllAddrIndex(game)
+	receiptLog = action.getReceiptLog(game, true, nil)
+
+	logs = append(logs, receiptLog)
+	kv = append(kv, action.saveGame(game)...)
+
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//GameAbort 撤销游戏动作执行
+func (action *Action) GameAbort(pbend *gty.GuessGameAbort) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	game, err := action.readGame(pbend.GetGameID())
+	if err != nil || game == nil {
+		logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "get game failed",
+			pbend.GetGameID(), "err", err)
+		return nil, err
+	}
+
+	if game.Status == gty.GuessGameStatusPublish || game.Status == gty.GuessGameStatusAbort {
+
+		logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "game status not allow abort",
+			game.Status)
+		return nil, gty.ErrGuessStatus
+	}
+
+	preStatus := game.Status
+	//根据区块链高度或时间刷新游戏状态。
+	action.refreshStatusByTime(game)
+
+	//如果游戏超时，则任何地址都可以Abort，否则只有创建游戏的地址可以Abort
+	//绑定预言机事件的游戏未超时时，只能在事件取消后通过Settle退还下注
+	//超时后事件已有结果的，也只能通过Settle结算，避免输家抢先撤销
+	if game.OracleEventID != "" && action.hasOracleResult(game) {
+		logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "oracle game has result",
+			game.GameID, "eventID", game.OracleEventID)
+		return nil, gty.ErrOracleGame
+	}
+	if game.Status != gty.GuessGameStatusTimeOut {
+		if game.OracleEventID != "" {
+			logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "oracle game",
+				game.GameID, "eventID", game.OracleEventID)
+			return nil, gty.ErrOracleGame
+		}
+		if game.AdminAddr != action.fromaddr {
+			logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "Only admin can abort",
+				action.fromaddr, "status", game.Status)
+			return nil, err
+		}
+	}
+
+	//激活冻结账户
+	logs, kv = action.refundBets(game)
+
+	if game.Status != preStatus {
+		//说明action.RefreshStatusByTime(game)调用时已经更新过状态和index了，这里直接再改状态就行了。
+		game.Status = gty.GuessGameStatusAbort
+	} else {
+		action.changeStatus(game, gty.GuessGameStatusAbort)
+	}
+
+	//状态发生变化，统一更新所有addr记录的index
+	action.changeAllAddrIndex(game)
+
+	receiptLog := action.getReceiptLog(game, true, nil)
+	logs = append(logs, receiptLog)
+	kv = append(kv, action.saveGame(game)...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+//settleResult 按结果结算游戏，所有下注先转入admin的合约账户，扣除佣金后按投注占比分配给赢家
+func (action *Action) settleResult(game *gty.GuessGame, result string) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	game.Result = trimStr(result)
 
 	//先遍历所有下注数据，转移资金到Admin账户合约地址；
 	for i := 0; i < len(game.Plays); i++ {
@@ -572,7 +682,7 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 		if err != nil {
 			logger.Error("GamePublish.ExecActive", "addr", player.Addr, "execaddr", action.execaddr, "amount", value,
 				"err", err)
-			return nil, err
+			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kv = append(kv, receipt.KV...)
@@ -582,7 +692,7 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 			//action.coinsAccount.ExecFrozen(game.AdminAddr, action.execaddr, value) // rollback
 			logger.Error("GamePublish", "addr", player.Addr, "execaddr", action.execaddr,
 				"amount", value, "err", err)
-			return nil, err
+			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kv = append(kv, receipt.KV...)
@@ -621,7 +731,7 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 			//action.coinsAccount.ExecFrozen(game.AdminAddr, action.execaddr, devFee) // rollback
 			logger.Error("GamePublish", "adminAddr", game.AdminAddr, "execaddr", action.execaddr,
 				"amount", devFee, "err", err)
-			return nil, err
+			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kv = append(kv, receipt.KV...)
@@ -637,7 +747,7 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 			//action.coinsAccount.ExecFrozen(game.AdminAddr, action.execaddr, platFee) // rollback
 			logger.Error("GamePublish", "adminAddr", game.AdminAddr, "execaddr", action.execaddr,
 				"amount", platFee, "err", err)
-			return nil, err
+			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kv = append(kv, receipt.KV...)
@@ -658,7 +768,7 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 				//action.coinsAccount.ExecFrozen(player.Addr, action.execaddr, value) // rollback
 				logger.Error("GamePublish", "addr", player.Addr, "execaddr", action.execaddr,
 					"amount", value, "err", err)
-				return nil, err
+				return nil, nil, err
 			}
 			logs = append(logs, receipt.Logs...)
 			kv = append(kv, receipt.KV...)
@@ -667,49 +777,13 @@ func (action *Action) GamePublish(publish *gty.GuessGamePublish) (*types.Receipt
 		}
 	}
 
-	var receiptLog *types.ReceiptLog
-	action.changeAllAddrIndex(game)
-	receiptLog = action.getReceiptLog(game, true, nil)
-
-	logs = append(logs, receiptLog)
-	kv = append(kv, action.saveGame(game)...)
-
-	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+	return logs, kv, nil
 }
 
-//GameAbort 撤销游戏动作执行
-func (action *Action) GameAbort(pbend *gty.GuessGameAbort) (*types.Receipt, error) {
+//refundBets 退还所有下注
+func (action *Action) refundBets(game *gty.GuessGame) ([]*types.ReceiptLog, []*types.KeyValue) {
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
-
-	game, err := action.readGame(pbend.GetGameID())
-	if err != nil || game == nil {
-		logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "get game failed",
-			pbend.GetGameID(), "err", err)
-		return nil, err
-	}
-
-	if game.Status == gty.GuessGameStatusPublish || game.Status == gty.GuessGameStatusAbort {
-
-		logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "game status not allow abort",
-			game.Status)
-		return nil, gty.ErrGuessStatus
-	}
-
-	preStatus := game.Status
-	//根据区块链高度或时间刷新游戏状态。
-	action.refreshStatusByTime(game)
-
-	//如果游戏超时，则任何地址都可以Abort，否则只有创建游戏的地址可以Abort
-	if game.Status != gty.GuessGameStatusTimeOut {
-		if game.AdminAddr != action.fromaddr {
-			logger.Error("GameAbort", "addr", action.fromaddr, "execaddr", action.execaddr, "Only admin can abort",
-				action.fromaddr, "status", game.Status)
-			return nil, err
-		}
-	}
-
-	//激活冻结账户
 	for i := 0; i < len(game.Plays); i++ {
 		player := game.Plays[i]
 		value := player.Bet.BetsNumber
@@ -725,21 +799,7 @@ func (action *Action) GameAbort(pbend *gty.GuessGameAbort) (*types.Receipt, erro
 		logs = append(logs, receipt.Logs...)
 		kv = append(kv, receipt.KV...)
 	}
-
-	if game.Status != preStatus {
-		//说明action.RefreshStatusByTime(game)调用时已经更新过状态和index了，这里直接再改状态就行了。
-		game.Status = gty.GuessGameStatusAbort
-	} else {
-		action.changeStatus(game, gty.GuessGameStatusAbort)
-	}
-
-	//状态发生变化，统一更新所有addr记录的index
-	action.changeAllAddrIndex(game)
-
-	receiptLog := action.getReceiptLog(game, true, nil)
-	logs = append(logs, receiptLog)
-	kv = append(kv, action.saveGame(game)...)
-	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+	return logs, kv
 }
 
 //getOptions 获得竞猜选项，并判断是否符合约定格式，类似"A:xxxx;B:xxxx;C:xxx"，“：”前为选项名称，不能重复，":"后为选项说明。
@@ -822,7 +882,7 @@ func (action *Action) refreshStatusByTime(game *gty.GuessGame) (canBet bool) {
 
 	// 检查区块高度是否超过最大可下注高度限制，看是否可以下注
 	heightDiff := mainHeight - game.StartHeight
-	if heightDiff >= game.MaxBetHeight {
+	if heightDiff >= game.MaxBetHeight || action.isOracleBetEnd(game) {
 		logger.Error("GameBet", "addr", action.fromaddr, "execaddr", action.execaddr, "Height over limit",
 			mainHeight, "startHeight", game.StartHeight, "MaxHeightDiff", game.GetMaxBetHeight())
 		if game.ExpireHeight > heightDiff {
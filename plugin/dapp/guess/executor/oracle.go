@@ -0,0 +1,129 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	gty "github.com/33cn/plugin/plugin/dapp/guess/types"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+)
+
+/*
+ * 绑定预言机事件的竞猜游戏
+ * 创建时事件必须处于已发布、尚未公布结果的状态，事件的结果公布参考时间即为截止下注时间，
+ * 事件结果最终确定后任何地址都可以发起Settle按事件结果结算，事件被取消则退还所有下注，
+ * 游戏创建者不能停止下注、公布结果或撤销游戏，超时后事件已有结果时也不能撤销
+ */
+
+//checkOracleEvent 检查绑定的预言机事件，返回截止下注时间
+func (action *Action) checkOracleEvent(start *gty.GuessGameStart) (int64, error) {
+	if !action.cfg.IsDappFork(action.height, gty.GuessX, gty.ForkGuessOracle) {
+		return 0, types.ErrNotSupport
+	}
+
+	status, err := oty.GetOracleStatus(action.db, start.OracleEventID)
+	if err != nil {
+		logger.Error("GameStart", "addr", action.fromaddr, "eventID", start.OracleEventID, "get oracle event failed", err)
+		return 0, gty.ErrOracleEvent
+	}
+	if status.GetStatus().GetStatus() != oty.EventPublished || status.Time <= action.blocktime {
+		logger.Error("GameStart", "addr", action.fromaddr, "eventID", start.OracleEventID, "status",
+			status.GetStatus().GetStatus(), "time", status.Time, "blocktime", action.blocktime)
+		return 0, gty.ErrOracleEvent
+	}
+
+	//不由管理员驱动，未设置高度限制时按最大值保护
+	start.DrivenByAdmin = false
+	if start.MaxBetHeight == 0 && start.ExpireHeight == 0 {
+		start.ExpireHeight = MaxExpireHeight
+	}
+	return status.Time, nil
+}
+
+//isOracleBetEnd 到达事件参考时间或事件已有结果时停止下注
+func (action *Action) isOracleBetEnd(game *gty.GuessGame) bool {
+	if game.OracleEventID == "" {
+		return false
+	}
+	if action.blocktime >= game.BetEndTime {
+		return true
+	}
+	status, err := oty.GetOracleStatus(action.db, game.OracleEventID)
+	return err != nil || status.GetStatus().GetStatus() != oty.EventPublished
+}
+
+//hasOracleResult 事件已经有结果(包括预发布和争议中)时，游戏只能通过Settle结算
+func (action *Action) hasOracleResult(game *gty.GuessGame) bool {
+	status, err := oty.GetOracleStatus(action.db, game.OracleEventID)
+	if err != nil {
+		return false
+	}
+	switch status.GetStatus().GetStatus() {
+	case oty.ResultPrePublished, oty.ResultDisputed, oty.ResultPublished:
+		return true
+	}
+	return false
+}
+
+//GameSettle 根据预言机事件结果结算游戏
+func (action *Action) GameSettle(settle *gty.GuessGameSettle) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	game, err := action.readGame(settle.GetGameID())
+	if err != nil || game == nil {
+		logger.Error("GameSettle", "addr", action.fromaddr, "execaddr", action.execaddr, "get game failed",
+			settle.GetGameID(), "err", err)
+		return nil, err
+	}
+
+	if game.OracleEventID == "" {
+		logger.Error("GameSettle", "addr", action.fromaddr, "execaddr", action.execaddr, "not oracle game", game.GameID)
+		return nil, gty.ErrOracleGame
+	}
+
+	if game.Status == gty.GuessGameStatusPublish || game.Status == gty.GuessGameStatusAbort {
+		logger.Error("GameSettle", "addr", action.fromaddr, "execaddr", action.execaddr, "Status error",
+			game.GetStatus())
+		return nil, gty.ErrGuessStatus
+	}
+
+	status, err := oty.GetOracleStatus(action.db, game.OracleEventID)
+	if err != nil {
+		logger.Error("GameSettle", "addr", action.fromaddr, "eventID", game.OracleEventID, "get oracle event failed", err)
+		return nil, err
+	}
+
+	switch status.GetStatus().GetStatus() {
+	case oty.ResultPublished:
+		options, _ := getOptions(game.GetOptions())
+		if isLegalOption(options, status.Result) {
+			logs, kv, err = action.settleResult(game, status.Result)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			//事件结果不在竞猜选项中，退还下注
+			logger.Info("GameSettle", "gameID", game.GameID, "oracle result not in options", status.Result)
+			logs, kv = action.refundBets(game)
+			action.changeStatus(game, gty.GuessGameStatusAbort)
+		}
+	case oty.EventAborted:
+		logs, kv = action.refundBets(game)
+		action.changeStatus(game, gty.GuessGameStatusAbort)
+	default:
+		logger.Error("GameSettle", "gameID", game.GameID, "eventID", game.OracleEventID, "oracle status",
+			status.GetStatus().GetStatus())
+		return nil, gty.ErrOracleNotFinal
+	}
+
+	//状态发生变化，统一更新所有addr记录的index
+	action.changeAllAddrIndex(game)
+
+	receiptLog := action.getReceiptLog(game, true, nil)
+	logs = append(logs, receiptLog)
+	kv = append(kv, action.saveGame(game)...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
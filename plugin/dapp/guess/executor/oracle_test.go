@@ -0,0 +1,286 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	gty "github.com/33cn/plugin/plugin/dapp/guess/types"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	PrivKeyA = "0x6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b" // 1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4
+	PrivKeyB = "0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4" // 1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR
+	PrivKeyC = "0xc2b31057b8692a56c7dd18199df71c1d21b781c0b6858c52997c9dbf778e8550" // 12evczYyX9ZKPYvwSEvRkRyTjpSrJuLudg
+	AddrA    = "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"
+	AddrB    = "1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR"
+	AddrC    = "12evczYyX9ZKPYvwSEvRkRyTjpSrJuLudg"
+
+	testBalance   = 1000 * types.Coin
+	testBlockTime = int64(1539918074)
+)
+
+type oracleEnv struct {
+	cfg      *types.Chain33Config
+	exec     dapp.Driver
+	db       dbm.KV
+	ldb      dbm.DB
+	acc      *account.DB
+	execAddr string
+}
+
+func initOracleEnv() *oracleEnv {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	cfg.RegisterDappFork(gty.GuessX, gty.ForkGuessOracle, 0)
+	InitExecType()
+	_, ldb, kvdb := util.CreateTestDB()
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+
+	execAddr := dapp.ExecAddress(gty.GuessX)
+	acc := account.NewCoinsAccount(cfg)
+	acc.SetDB(stateDB)
+	for _, addr := range []string{AddrA, AddrB, AddrC} {
+		acc.SaveExecAccount(execAddr, &types.Account{Addr: addr, Balance: testBalance})
+	}
+
+	exec := newGuessGame()
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	return &oracleEnv{cfg: cfg, exec: exec, db: stateDB, ldb: ldb, acc: acc, execAddr: execAddr}
+}
+
+func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
+	signType := types.SECP256K1
+	c, err := crypto.New(types.GetSignName(gty.GuessX, signType))
+	if err != nil {
+		return tx, err
+	}
+
+	bytes, err := common.FromHex(hexPrivKey[:])
+	if err != nil {
+		return tx, err
+	}
+
+	privKey, err := c.PrivKeyFromBytes(bytes)
+	if err != nil {
+		return tx, err
+	}
+
+	tx.Sign(int32(signType), privKey)
+	return tx, nil
+}
+
+func (env *oracleEnv) setEvent(eventID string, status int32, result string) {
+	event := &oty.OracleStatus{
+		EventID: eventID,
+		Time:    testBlockTime + 100,
+		Status:  &oty.EventStatus{Status: status},
+		Result:  result,
+	}
+	env.db.Set(oty.OracleStatusKey(eventID), types.Encode(event))
+}
+
+func (env *oracleEnv) execTx(t *testing.T, height, blockTime int64, action *gty.GuessGameAction, privKey string) (*types.Transaction, error) {
+	env.exec.SetEnv(height, blockTime, 0)
+	tx, err := types.CreateFormatTx(env.cfg, gty.GuessX, types.Encode(action))
+	assert.Nil(t, err)
+	tx, err = signTx(tx, privKey)
+	assert.Nil(t, err)
+
+	receipt, err := env.exec.Exec(tx, int(1))
+	if err != nil {
+		return tx, err
+	}
+	for _, kv := range receipt.KV {
+		env.db.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := env.exec.ExecLocal(tx, receiptData, int(1))
+	assert.Nil(t, err)
+	util.SaveKVList(env.ldb, set.KV)
+	return tx, nil
+}
+
+func (env *oracleEnv) readGame(t *testing.T, gameID string) *gty.GuessGame {
+	data, err := env.db.Get(Key(gameID))
+	assert.Nil(t, err)
+	game := &gty.GuessGame{}
+	assert.Nil(t, types.Decode(data, game))
+	return game
+}
+
+func startOracleGame(t *testing.T, env *oracleEnv, eventID string) string {
+	start := &gty.GuessGameAction{
+		Ty: gty.GuessGameActionStart,
+		Value: &gty.GuessGameAction_Start{Start: &gty.GuessGameStart{
+			Topic:          "WorldCup Final",
+			Options:        "A:France;B:Croatia",
+			MaxBetsOneTime: 100 * types.Coin,
+			MaxBetsNumber:  1000 * types.Coin,
+			OracleEventID:  eventID,
+		}},
+	}
+	tx, err := env.execTx(t, 10, testBlockTime, start, PrivKeyA)
+	assert.Nil(t, err)
+	return common.ToHex(tx.Hash())
+}
+
+func betAction(gameID, option string, bets int64) *gty.GuessGameAction {
+	return &gty.GuessGameAction{
+		Ty:    gty.GuessGameActionBet,
+		Value: &gty.GuessGameAction_Bet{Bet: &gty.GuessGameBet{GameID: gameID, Option: option, BetsNum: bets}},
+	}
+}
+
+func settleAction(gameID string) *gty.GuessGameAction {
+	return &gty.GuessGameAction{
+		Ty:    gty.GuessGameActionSettle,
+		Value: &gty.GuessGameAction_Settle{Settle: &gty.GuessGameSettle{GameID: gameID}},
+	}
+}
+
+func TestGuessOracleSettle(t *testing.T) {
+	env := initOracleEnv()
+	env.setEvent("event1", oty.EventPublished, "")
+
+	start := &gty.GuessGameAction{
+		Ty:    gty.GuessGameActionStart,
+		Value: &gty.GuessGameAction_Start{Start: &gty.GuessGameStart{Topic: "t", Options: "A:a;B:b", OracleEventID: "event-none"}},
+	}
+	_, err := env.execTx(t, 10, testBlockTime, start, PrivKeyA)
+	assert.Equal(t, gty.ErrOracleEvent, err)
+
+	gameID := startOracleGame(t, env, "event1")
+	game := env.readGame(t, gameID)
+	assert.Equal(t, testBlockTime+100, game.BetEndTime)
+	assert.False(t, game.DrivenByAdmin)
+
+	_, err = env.execTx(t, 11, testBlockTime+1, betAction(gameID, "A", 100*types.Coin), PrivKeyB)
+	assert.Nil(t, err)
+	_, err = env.execTx(t, 11, testBlockTime+1, betAction(gameID, "B", 100*types.Coin), PrivKeyC)
+	assert.Nil(t, err)
+
+	// 游戏创建者不能干预结果
+	publish := &gty.GuessGameAction{
+		Ty:    gty.GuessGameActionPublish,
+		Value: &gty.GuessGameAction_Publish{Publish: &gty.GuessGamePublish{GameID: gameID, Result: "B"}},
+	}
+	_, err = env.execTx(t, 12, testBlockTime+2, publish, PrivKeyA)
+	assert.Equal(t, gty.ErrOracleGame, err)
+	stopBet := &gty.GuessGameAction{
+		Ty:    gty.GuessGameActionStopBet,
+		Value: &gty.GuessGameAction_StopBet{StopBet: &gty.GuessGameStopBet{GameID: gameID}},
+	}
+	_, err = env.execTx(t, 12, testBlockTime+2, stopBet, PrivKeyA)
+	assert.Equal(t, gty.ErrOracleGame, err)
+	abort := &gty.GuessGameAction{
+		Ty:    gty.GuessGameActionAbort,
+		Value: &gty.GuessGameAction_Abort{Abort: &gty.GuessGameAbort{GameID: gameID}},
+	}
+	_, err = env.execTx(t, 12, testBlockTime+2, abort, PrivKeyA)
+	assert.Equal(t, gty.ErrOracleGame, err)
+
+	_, err = env.execTx(t, 12, testBlockTime+2, settleAction(gameID), PrivKeyC)
+	assert.Equal(t, gty.ErrOracleNotFinal, err)
+
+	// 到达事件参考时间后停止下注
+	_, err = env.execTx(t, 13, testBlockTime+100, betAction(gameID, "B", 100*types.Coin), PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(gty.GuessGameStatusStopBet), env.readGame(t, gameID).Status)
+	acc := env.acc.LoadExecAccount(AddrC, env.execAddr)
+	assert.Equal(t, 100*types.Coin, acc.Frozen)
+
+	// 事件结果确定后任何地址都可以结算
+	env.setEvent("event1", oty.ResultPublished, "A")
+	_, err = env.execTx(t, 14, testBlockTime+200, settleAction(gameID), PrivKeyC)
+	assert.Nil(t, err)
+	game = env.readGame(t, gameID)
+	assert.Equal(t, int32(gty.GuessGameStatusPublish), game.Status)
+	assert.Equal(t, "A", game.Result)
+	acc = env.acc.LoadExecAccount(AddrB, env.execAddr)
+	assert.Equal(t, testBalance+100*types.Coin, acc.Balance)
+	acc = env.acc.LoadExecAccount(AddrC, env.execAddr)
+	assert.Equal(t, testBalance-100*types.Coin, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	_, err = env.execTx(t, 15, testBlockTime+201, settleAction(gameID), PrivKeyC)
+	assert.Equal(t, gty.ErrGuessStatus, err)
+}
+
+func TestGuessOracleAbort(t *testing.T) {
+	env := initOracleEnv()
+	env.setEvent("event2", oty.EventPublished, "")
+	gameID := startOracleGame(t, env, "event2")
+
+	_, err := env.execTx(t, 11, testBlockTime+1, betAction(gameID, "A", 100*types.Coin), PrivKeyB)
+	assert.Nil(t, err)
+
+	// 事件已有预发布结果后不能继续下注
+	env.setEvent("event2", oty.ResultPrePublished, "A")
+	_, err = env.execTx(t, 12, testBlockTime+2, betAction(gameID, "B", 100*types.Coin), PrivKeyC)
+	assert.Nil(t, err)
+	acc := env.acc.LoadExecAccount(AddrC, env.execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	// 事件取消，退还所有下注
+	env.setEvent("event2", oty.EventAborted, "")
+	_, err = env.execTx(t, 13, testBlockTime+3, settleAction(gameID), PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(gty.GuessGameStatusAbort), env.readGame(t, gameID).Status)
+	acc = env.acc.LoadExecAccount(AddrB, env.execAddr)
+	assert.Equal(t, testBalance, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+}
+
+func TestGuessOracleAbortTimeout(t *testing.T) {
+	env := initOracleEnv()
+	env.setEvent("event3", oty.EventPublished, "")
+	gameID := startOracleGame(t, env, "event3")
+	_, err := env.execTx(t, 11, testBlockTime+1, betAction(gameID, "A", 100*types.Coin), PrivKeyB)
+	assert.Nil(t, err)
+	_, err = env.execTx(t, 11, testBlockTime+1, betAction(gameID, "B", 100*types.Coin), PrivKeyC)
+	assert.Nil(t, err)
+
+	// 超时后事件已有结果，不能撤销，只能按结果结算
+	env.setEvent("event3", oty.ResultPublished, "A")
+	abort := &gty.GuessGameAction{
+		Ty:    gty.GuessGameActionAbort,
+		Value: &gty.GuessGameAction_Abort{Abort: &gty.GuessGameAbort{GameID: gameID}},
+	}
+	timeout := int64(10 + MaxExpireHeight)
+	_, err = env.execTx(t, timeout, testBlockTime+200, abort, PrivKeyC)
+	assert.Equal(t, gty.ErrOracleGame, err)
+	_, err = env.execTx(t, timeout, testBlockTime+200, settleAction(gameID), PrivKeyC)
+	assert.Nil(t, err)
+	game := env.readGame(t, gameID)
+	assert.Equal(t, int32(gty.GuessGameStatusPublish), game.Status)
+	assert.Equal(t, "A", game.Result)
+
+	// 超时且事件没有结果时仍可以撤销
+	env.setEvent("event4", oty.EventPublished, "")
+	gameID = startOracleGame(t, env, "event4")
+	_, err = env.execTx(t, 11, testBlockTime+1, betAction(gameID, "A", 100*types.Coin), PrivKeyB)
+	assert.Nil(t, err)
+	abort.Value = &gty.GuessGameAction_Abort{Abort: &gty.GuessGameAbort{GameID: gameID}}
+	_, err = env.execTx(t, timeout, testBlockTime+200, abort, PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(gty.GuessGameStatusAbort), env.readGame(t, gameID).Status)
+}
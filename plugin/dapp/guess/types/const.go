@@ -12,6 +12,7 @@ const (
 	GuessGameActionAbort   = 8
 	GuessGameActionPublish = 9
 	GuessGameActionQuery   = 10
+	GuessGameActionSettle  = 17
 
 	GuessGameStatusStart   = 11
 	GuessGameStatusBet     = 12
@@ -78,8 +79,15 @@ const (
 
 	//CreateAbortTx 创建撤销游戏交易
 	CreateAbortTx = "Abort"
+
+	//CreateSettleTx 创建根据预言机结果结算交易
+	CreateSettleTx = "Settle"
 )
 
+//ForkGuessOracle 支持绑定预言机事件自动结算
+const ForkGuessOracle = "ForkGuessOracle"
+
+
 const (
 	//DevShareAddr default value
 	DevShareAddr = "1D6RFZNp2rh6QdbcZ1d7RWuBUz61We6SD7"
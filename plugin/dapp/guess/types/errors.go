@@ -15,4 +15,7 @@ var (
 	ErrParamAddressMustnotEmpty = errors.New("ErrParamAddressMustnotEmpty")
 	ErrGameNotExist             = errors.New("ErrGameNotExist")
 	ErrSaveTable                = errors.New("ErrSaveTable")
+	ErrOracleEvent              = errors.New("ErrOracleEvent")
+	ErrOracleGame               = errors.New("ErrOracleGame")
+	ErrOracleNotFinal           = errors.New("ErrOracleNotFinal")
 )
@@ -54,6 +54,8 @@ type GuessGame struct {
 	Index                int64          `protobuf:"varint,24,opt,name=index,proto3" json:"index,omitempty"`
 	PreIndex             int64          `protobuf:"varint,25,opt,name=preIndex,proto3" json:"preIndex,omitempty"`
 	DrivenByAdmin        bool           `protobuf:"varint,26,opt,name=drivenByAdmin,proto3" json:"drivenByAdmin,omitempty"`
+	OracleEventID        string         `protobuf:"bytes,27,opt,name=oracleEventID,proto3" json:"oracleEventID,omitempty"`
+	BetEndTime           int64          `protobuf:"varint,28,opt,name=betEndTime,proto3" json:"betEndTime,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -266,6 +268,20 @@ func (m *GuessGame) GetDrivenByAdmin() bool {
 	return false
 }
 
+func (m *GuessGame) GetOracleEventID() string {
+	if m != nil {
+		return m.OracleEventID
+	}
+	return ""
+}
+
+func (m *GuessGame) GetBetEndTime() int64 {
+	if m != nil {
+		return m.BetEndTime
+	}
+	return 0
+}
+
 // GuessPlayer 竞猜玩家信息
 type GuessPlayer struct {
 	Addr                 string    `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
@@ -515,6 +531,7 @@ type GuessGameAction struct {
 	//	*GuessGameAction_Abort
 	//	*GuessGameAction_Publish
 	//	*GuessGameAction_Query
+	//	*GuessGameAction_Settle
 	Value                isGuessGameAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                   `protobuf:"varint,7,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
@@ -575,6 +592,10 @@ type GuessGameAction_Query struct {
 	Query *GuessGameQuery `protobuf:"bytes,6,opt,name=query,proto3,oneof"`
 }
 
+type GuessGameAction_Settle struct {
+	Settle *GuessGameSettle `protobuf:"bytes,8,opt,name=settle,proto3,oneof"`
+}
+
 func (*GuessGameAction_Start) isGuessGameAction_Value() {}
 
 func (*GuessGameAction_Bet) isGuessGameAction_Value() {}
@@ -587,6 +608,8 @@ func (*GuessGameAction_Publish) isGuessGameAction_Value() {}
 
 func (*GuessGameAction_Query) isGuessGameAction_Value() {}
 
+func (*GuessGameAction_Settle) isGuessGameAction_Value() {}
+
 func (m *GuessGameAction) GetValue() isGuessGameAction_Value {
 	if m != nil {
 		return m.Value
@@ -636,6 +659,13 @@ func (m *GuessGameAction) GetQuery() *GuessGameQuery {
 	return nil
 }
 
+func (m *GuessGameAction) GetSettle() *GuessGameSettle {
+	if x, ok := m.GetValue().(*GuessGameAction_Settle); ok {
+		return x.Settle
+	}
+	return nil
+}
+
 func (m *GuessGameAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -652,6 +682,7 @@ func (*GuessGameAction) XXX_OneofWrappers() []interface{} {
 		(*GuessGameAction_Abort)(nil),
 		(*GuessGameAction_Publish)(nil),
 		(*GuessGameAction_Query)(nil),
+		(*GuessGameAction_Settle)(nil),
 	}
 }
 
@@ -669,6 +700,7 @@ type GuessGameStart struct {
 	PlatFeeAddr          string   `protobuf:"bytes,10,opt,name=platFeeAddr,proto3" json:"platFeeAddr,omitempty"`
 	ExpireHeight         int64    `protobuf:"varint,11,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	DrivenByAdmin        bool     `protobuf:"varint,12,opt,name=drivenByAdmin,proto3" json:"drivenByAdmin,omitempty"`
+	OracleEventID        string   `protobuf:"bytes,13,opt,name=oracleEventID,proto3" json:"oracleEventID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -783,6 +815,13 @@ func (m *GuessGameStart) GetDrivenByAdmin() bool {
 	return false
 }
 
+func (m *GuessGameStart) GetOracleEventID() string {
+	if m != nil {
+		return m.OracleEventID
+	}
+	return ""
+}
+
 // GuessGameBet 参与游戏下注
 type GuessGameBet struct {
 	GameID               string   `protobuf:"bytes,1,opt,name=gameID,proto3" json:"gameID,omitempty"`
@@ -967,6 +1006,46 @@ func (m *GuessGamePublish) GetResult() string {
 	return ""
 }
 
+// GuessGameSettle 根据预言机事件结果结算游戏，任何地址都可以发起
+type GuessGameSettle struct {
+	GameID               string   `protobuf:"bytes,1,opt,name=gameID,proto3" json:"gameID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GuessGameSettle) Reset()         { *m = GuessGameSettle{} }
+func (m *GuessGameSettle) String() string { return proto.CompactTextString(m) }
+func (*GuessGameSettle) ProtoMessage()    {}
+func (*GuessGameSettle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7574406c5d3430e8, []int{11}
+}
+
+func (m *GuessGameSettle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GuessGameSettle.Unmarshal(m, b)
+}
+func (m *GuessGameSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GuessGameSettle.Marshal(b, m, deterministic)
+}
+func (m *GuessGameSettle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuessGameSettle.Merge(m, src)
+}
+func (m *GuessGameSettle) XXX_Size() int {
+	return xxx_messageInfo_GuessGameSettle.Size(m)
+}
+func (m *GuessGameSettle) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuessGameSettle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuessGameSettle proto.InternalMessageInfo
+
+func (m *GuessGameSettle) GetGameID() string {
+	if m != nil {
+		return m.GameID
+	}
+	return ""
+}
+
 // GuessGameQuery 查询游戏结果
 type GuessGameQuery struct {
 	GameID               string   `protobuf:"bytes,1,opt,name=gameID,proto3" json:"gameID,omitempty"`
@@ -980,7 +1059,7 @@ func (m *GuessGameQuery) Reset()         { *m = GuessGameQuery{} }
 func (m *GuessGameQuery) String() string { return proto.CompactTextString(m) }
 func (*GuessGameQuery) ProtoMessage()    {}
 func (*GuessGameQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{11}
+	return fileDescriptor_7574406c5d3430e8, []int{12}
 }
 
 func (m *GuessGameQuery) XXX_Unmarshal(b []byte) error {
@@ -1033,7 +1112,7 @@ func (m *QueryGuessGameInfo) Reset()         { *m = QueryGuessGameInfo{} }
 func (m *QueryGuessGameInfo) String() string { return proto.CompactTextString(m) }
 func (*QueryGuessGameInfo) ProtoMessage()    {}
 func (*QueryGuessGameInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{12}
+	return fileDescriptor_7574406c5d3430e8, []int{13}
 }
 
 func (m *QueryGuessGameInfo) XXX_Unmarshal(b []byte) error {
@@ -1115,7 +1194,7 @@ func (m *ReplyGuessGameInfo) Reset()         { *m = ReplyGuessGameInfo{} }
 func (m *ReplyGuessGameInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyGuessGameInfo) ProtoMessage()    {}
 func (*ReplyGuessGameInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{13}
+	return fileDescriptor_7574406c5d3430e8, []int{14}
 }
 
 func (m *ReplyGuessGameInfo) XXX_Unmarshal(b []byte) error {
@@ -1155,7 +1234,7 @@ func (m *QueryGuessGameInfos) Reset()         { *m = QueryGuessGameInfos{} }
 func (m *QueryGuessGameInfos) String() string { return proto.CompactTextString(m) }
 func (*QueryGuessGameInfos) ProtoMessage()    {}
 func (*QueryGuessGameInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{14}
+	return fileDescriptor_7574406c5d3430e8, []int{15}
 }
 
 func (m *QueryGuessGameInfos) XXX_Unmarshal(b []byte) error {
@@ -1195,7 +1274,7 @@ func (m *ReplyGuessGameInfos) Reset()         { *m = ReplyGuessGameInfos{} }
 func (m *ReplyGuessGameInfos) String() string { return proto.CompactTextString(m) }
 func (*ReplyGuessGameInfos) ProtoMessage()    {}
 func (*ReplyGuessGameInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{15}
+	return fileDescriptor_7574406c5d3430e8, []int{16}
 }
 
 func (m *ReplyGuessGameInfos) XXX_Unmarshal(b []byte) error {
@@ -1248,7 +1327,7 @@ func (m *ReceiptGuessGame) Reset()         { *m = ReceiptGuessGame{} }
 func (m *ReceiptGuessGame) String() string { return proto.CompactTextString(m) }
 func (*ReceiptGuessGame) ProtoMessage()    {}
 func (*ReceiptGuessGame) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{16}
+	return fileDescriptor_7574406c5d3430e8, []int{17}
 }
 
 func (m *ReceiptGuessGame) XXX_Unmarshal(b []byte) error {
@@ -1384,7 +1463,7 @@ func (m *UserBet) Reset()         { *m = UserBet{} }
 func (m *UserBet) String() string { return proto.CompactTextString(m) }
 func (*UserBet) ProtoMessage()    {}
 func (*UserBet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{17}
+	return fileDescriptor_7574406c5d3430e8, []int{18}
 }
 
 func (m *UserBet) XXX_Unmarshal(b []byte) error {
@@ -1471,7 +1550,7 @@ func (m *GuessStartTxReq) Reset()         { *m = GuessStartTxReq{} }
 func (m *GuessStartTxReq) String() string { return proto.CompactTextString(m) }
 func (*GuessStartTxReq) ProtoMessage()    {}
 func (*GuessStartTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{18}
+	return fileDescriptor_7574406c5d3430e8, []int{19}
 }
 
 func (m *GuessStartTxReq) XXX_Unmarshal(b []byte) error {
@@ -1598,7 +1677,7 @@ func (m *GuessBetTxReq) Reset()         { *m = GuessBetTxReq{} }
 func (m *GuessBetTxReq) String() string { return proto.CompactTextString(m) }
 func (*GuessBetTxReq) ProtoMessage()    {}
 func (*GuessBetTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{19}
+	return fileDescriptor_7574406c5d3430e8, []int{20}
 }
 
 func (m *GuessBetTxReq) XXX_Unmarshal(b []byte) error {
@@ -1660,7 +1739,7 @@ func (m *GuessStopBetTxReq) Reset()         { *m = GuessStopBetTxReq{} }
 func (m *GuessStopBetTxReq) String() string { return proto.CompactTextString(m) }
 func (*GuessStopBetTxReq) ProtoMessage()    {}
 func (*GuessStopBetTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{20}
+	return fileDescriptor_7574406c5d3430e8, []int{21}
 }
 
 func (m *GuessStopBetTxReq) XXX_Unmarshal(b []byte) error {
@@ -1708,7 +1787,7 @@ func (m *GuessAbortTxReq) Reset()         { *m = GuessAbortTxReq{} }
 func (m *GuessAbortTxReq) String() string { return proto.CompactTextString(m) }
 func (*GuessAbortTxReq) ProtoMessage()    {}
 func (*GuessAbortTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{21}
+	return fileDescriptor_7574406c5d3430e8, []int{22}
 }
 
 func (m *GuessAbortTxReq) XXX_Unmarshal(b []byte) error {
@@ -1757,7 +1836,7 @@ func (m *GuessPublishTxReq) Reset()         { *m = GuessPublishTxReq{} }
 func (m *GuessPublishTxReq) String() string { return proto.CompactTextString(m) }
 func (*GuessPublishTxReq) ProtoMessage()    {}
 func (*GuessPublishTxReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{22}
+	return fileDescriptor_7574406c5d3430e8, []int{23}
 }
 
 func (m *GuessPublishTxReq) XXX_Unmarshal(b []byte) error {
@@ -1812,7 +1891,7 @@ func (m *GuessGameRecord) Reset()         { *m = GuessGameRecord{} }
 func (m *GuessGameRecord) String() string { return proto.CompactTextString(m) }
 func (*GuessGameRecord) ProtoMessage()    {}
 func (*GuessGameRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{23}
+	return fileDescriptor_7574406c5d3430e8, []int{24}
 }
 
 func (m *GuessGameRecord) XXX_Unmarshal(b []byte) error {
@@ -1860,7 +1939,7 @@ func (m *GuessGameRecords) Reset()         { *m = GuessGameRecords{} }
 func (m *GuessGameRecords) String() string { return proto.CompactTextString(m) }
 func (*GuessGameRecords) ProtoMessage()    {}
 func (*GuessGameRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7574406c5d3430e8, []int{24}
+	return fileDescriptor_7574406c5d3430e8, []int{25}
 }
 
 func (m *GuessGameRecords) XXX_Unmarshal(b []byte) error {
@@ -1907,6 +1986,7 @@ func init() {
 	proto.RegisterType((*GuessGameStopBet)(nil), "types.GuessGameStopBet")
 	proto.RegisterType((*GuessGameAbort)(nil), "types.GuessGameAbort")
 	proto.RegisterType((*GuessGamePublish)(nil), "types.GuessGamePublish")
+	proto.RegisterType((*GuessGameSettle)(nil), "types.GuessGameSettle")
 	proto.RegisterType((*GuessGameQuery)(nil), "types.GuessGameQuery")
 	proto.RegisterType((*QueryGuessGameInfo)(nil), "types.QueryGuessGameInfo")
 	proto.RegisterType((*ReplyGuessGameInfo)(nil), "types.ReplyGuessGameInfo")
@@ -1928,93 +2008,96 @@ func init() {
 }
 
 var fileDescriptor_7574406c5d3430e8 = []byte{
-	// 1367 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xc9, 0x8e, 0xdb, 0x46,
-	0x13, 0x1e, 0x92, 0xa2, 0x96, 0x92, 0x66, 0x46, 0xee, 0xf1, 0xc2, 0x7f, 0x60, 0x18, 0xfa, 0x09,
-	0xc3, 0x11, 0x02, 0xd8, 0x09, 0x64, 0x20, 0x08, 0x1c, 0xf8, 0x30, 0x8a, 0x61, 0x7b, 0x10, 0x20,
-	0x71, 0x68, 0x1b, 0xc9, 0x95, 0x92, 0x7a, 0x34, 0x04, 0x24, 0x92, 0x26, 0x5b, 0x03, 0xe9, 0x21,
-	0x72, 0xcb, 0x1b, 0x38, 0x87, 0xdc, 0xf2, 0x1a, 0x41, 0x4e, 0x79, 0xa4, 0xa0, 0xaa, 0x9b, 0x62,
-	0x93, 0xa2, 0x16, 0x07, 0xb9, 0xb1, 0x96, 0xee, 0xaa, 0xae, 0xed, 0x2b, 0x09, 0xda, 0xd3, 0x05,
-	0x4f, 0xd3, 0x27, 0x71, 0x12, 0x89, 0x88, 0xd9, 0x62, 0x15, 0xf3, 0xf4, 0xfc, 0x96, 0x48, 0xfc,
-	0x30, 0xf5, 0xc7, 0x22, 0x88, 0x42, 0x29, 0x71, 0xff, 0xae, 0x43, 0xeb, 0x15, 0x6a, 0xbe, 0xf2,
-	0xe7, 0x9c, 0xdd, 0x85, 0xfa, 0xd4, 0x9f, 0xf3, 0xcb, 0x17, 0x8e, 0xd1, 0x33, 0xfa, 0x2d, 0x4f,
-	0x51, 0xc8, 0x4f, 0x85, 0x2f, 0x16, 0xa9, 0x63, 0xf6, 0x8c, 0xbe, 0xed, 0x29, 0x8a, 0xdd, 0x87,
-	0x56, 0x9c, 0xf0, 0xb7, 0x52, 0x64, 0x91, 0x28, 0x67, 0xa0, 0x34, 0x15, 0x7e, 0x22, 0xde, 0x05,
-	0x73, 0xee, 0xd4, 0x7a, 0x46, 0xdf, 0xf2, 0x72, 0x06, 0xeb, 0x41, 0x9b, 0x88, 0xd7, 0x3c, 0x98,
-	0x5e, 0x0b, 0xc7, 0x26, 0xb9, 0xce, 0x5a, 0x6b, 0xbc, 0x5b, 0xbe, 0xf6, 0xd3, 0x6b, 0xa7, 0x4e,
-	0x2e, 0xe9, 0x2c, 0xf6, 0x00, 0x80, 0xc8, 0xcb, 0x70, 0xc2, 0x97, 0x4e, 0x83, 0xae, 0xd0, 0x38,
-	0xec, 0x36, 0xd8, 0x22, 0x8a, 0x83, 0xb1, 0xd3, 0xa4, 0xb3, 0x92, 0x60, 0xe7, 0xd0, 0x1c, 0xfb,
-	0x82, 0x4f, 0xa3, 0x64, 0xe5, 0xb4, 0x48, 0xb0, 0xa6, 0x99, 0x03, 0x8d, 0x28, 0xc6, 0xf8, 0xa4,
-	0x0e, 0x90, 0x28, 0x23, 0x99, 0x0b, 0x9d, 0xb9, 0xbf, 0x1c, 0xf2, 0xcc, 0xe1, 0x36, 0x59, 0x2b,
-	0xf0, 0xd8, 0x23, 0x38, 0x91, 0x74, 0xfa, 0x43, 0xc8, 0xe9, 0xd9, 0x1d, 0xd2, 0x2a, 0x71, 0xd9,
-	0x43, 0x38, 0x56, 0x9c, 0xef, 0x17, 0xf3, 0x11, 0x4f, 0x9c, 0x63, 0x52, 0x2b, 0x32, 0xd1, 0xe2,
-	0x84, 0xdf, 0xbc, 0xe4, 0xfc, 0xa5, 0x3f, 0x16, 0x51, 0xe2, 0x9c, 0x48, 0x8b, 0x3a, 0x0f, 0x23,
-	0x20, 0xe9, 0x8b, 0xc9, 0x24, 0x71, 0x4e, 0xc9, 0x65, 0x8d, 0x83, 0x96, 0xe2, 0x99, 0x2f, 0xf2,
-	0x4b, 0xba, 0xd2, 0x52, 0x81, 0x89, 0x91, 0x56, 0x0c, 0xba, 0xe6, 0x96, 0x8c, 0xb4, 0xc6, 0x42,
-	0x5f, 0xf8, 0x32, 0x0e, 0x12, 0xae, 0x5e, 0xcf, 0xa4, 0x2f, 0x3a, 0x0f, 0xf3, 0xed, 0x4f, 0xe6,
-	0x41, 0x48, 0x77, 0x9c, 0xd1, 0x1d, 0x39, 0x03, 0x3d, 0x1d, 0xe5, 0x0f, 0xbe, 0x2d, 0x73, 0x95,
-	0x73, 0x58, 0x1f, 0xec, 0x78, 0xe6, 0xaf, 0x52, 0xe7, 0x4e, 0xcf, 0xea, 0xb7, 0x07, 0xec, 0x09,
-	0xd5, 0xec, 0x13, 0x2a, 0xce, 0x37, 0x33, 0x7f, 0xc5, 0x13, 0x4f, 0x2a, 0x60, 0x35, 0x26, 0x3c,
-	0x5d, 0xcc, 0x84, 0x73, 0x57, 0x56, 0xa9, 0xa4, 0xd8, 0x63, 0x68, 0x8c, 0xb8, 0xc0, 0xe2, 0x73,
-	0xee, 0xf5, 0x8c, 0x7e, 0x7b, 0x70, 0xa6, 0xdf, 0x31, 0x94, 0x22, 0x2f, 0xd3, 0xc1, 0xe2, 0x08,
-	0xa8, 0x6e, 0x1c, 0xf2, 0x45, 0x12, 0x58, 0x1c, 0x71, 0xc2, 0x65, 0x41, 0xfd, 0x8f, 0x04, 0x6b,
-	0x1a, 0x83, 0x39, 0x49, 0x82, 0x1b, 0x1e, 0x0e, 0x57, 0x17, 0xf8, 0x2e, 0xe7, 0xbc, 0x67, 0xf4,
-	0x9b, 0x5e, 0x91, 0xe9, 0xbe, 0x80, 0xb6, 0xe6, 0x34, 0x63, 0x50, 0xf3, 0x31, 0x20, 0xb2, 0xa3,
-	0xe8, 0x9b, 0xfd, 0x1f, 0xac, 0x11, 0x17, 0xd4, 0x4c, 0xed, 0xc1, 0x69, 0xc9, 0x4b, 0x0f, 0x65,
-	0xee, 0xef, 0x06, 0x34, 0x33, 0x0e, 0xbe, 0x58, 0x96, 0x61, 0xd6, 0x97, 0x92, 0x2a, 0xc5, 0xd4,
-	0xdc, 0x88, 0xe9, 0x39, 0x34, 0x83, 0xf4, 0xa7, 0x20, 0x0c, 0x79, 0x42, 0xed, 0xd9, 0xf4, 0xd6,
-	0x34, 0xde, 0x19, 0x27, 0xd1, 0x55, 0x20, 0x54, 0x6b, 0x2a, 0x2a, 0x0f, 0x8b, 0xbd, 0x2d, 0x2c,
-	0xf5, 0x62, 0x58, 0xdc, 0x5f, 0x0c, 0xe8, 0xe8, 0x21, 0xc6, 0x38, 0x89, 0x48, 0xf8, 0xb3, 0x21,
-	0xa7, 0x56, 0x4f, 0xc9, 0x6b, 0xcb, 0x2b, 0x32, 0x59, 0x1f, 0x4e, 0x33, 0x46, 0xf1, 0x05, 0x65,
-	0x36, 0x7b, 0x0c, 0x76, 0x20, 0xf8, 0x1c, 0x47, 0x0c, 0x96, 0xc6, 0xbd, 0x8a, 0xb4, 0x5e, 0x0a,
-	0x3e, 0xf7, 0xa4, 0x96, 0x7b, 0x0d, 0xdd, 0xb2, 0xe8, 0x5f, 0x47, 0xf0, 0x3e, 0xb4, 0x90, 0x92,
-	0xcf, 0xb0, 0xe4, 0x0c, 0x5b, 0x33, 0xdc, 0xbf, 0x4c, 0x38, 0x5d, 0x4f, 0xcf, 0x0b, 0x9a, 0xab,
-	0xe8, 0x2c, 0x4d, 0x20, 0x32, 0xd4, 0x1e, 0xdc, 0xd1, 0x9d, 0x45, 0xb5, 0xb7, 0x34, 0xe1, 0x8e,
-	0x3c, 0xa9, 0xc5, 0x3e, 0xd3, 0x4b, 0xe1, 0xac, 0xac, 0x8c, 0xa3, 0xe5, 0x88, 0x0a, 0x82, 0x3d,
-	0x85, 0x46, 0x2a, 0xa2, 0x78, 0xc8, 0x05, 0xf9, 0x51, 0x0a, 0x83, 0xbc, 0x99, 0xc4, 0xaf, 0x8f,
-	0xbc, 0x4c, 0x13, 0x9d, 0xf1, 0x47, 0x51, 0x22, 0x73, 0x5c, 0xe1, 0xcc, 0x05, 0x0a, 0xd1, 0x19,
-	0xd2, 0x42, 0x1b, 0xf1, 0x62, 0x34, 0x0b, 0xd2, 0x6b, 0xca, 0x7e, 0x85, 0x8d, 0x37, 0x52, 0x8c,
-	0x36, 0x94, 0x26, 0xda, 0xf8, 0xb0, 0xe0, 0xc9, 0x8a, 0xea, 0xa2, 0xc2, 0xc6, 0x8f, 0x28, 0x44,
-	0x1b, 0xa4, 0xc5, 0x4e, 0xc0, 0x14, 0x2b, 0x9a, 0xd5, 0xb6, 0x67, 0x8a, 0xd5, 0xb0, 0x01, 0xf6,
-	0x8d, 0x3f, 0x5b, 0x70, 0xf7, 0x37, 0x0b, 0x4e, 0x8a, 0x51, 0xca, 0xe7, 0xb7, 0xa1, 0xcf, 0x6f,
-	0x6d, 0x46, 0x9b, 0xc5, 0x19, 0xad, 0x4f, 0x76, 0xab, 0x34, 0xd9, 0xcb, 0xf3, 0xbb, 0x76, 0xd0,
-	0xfc, 0xb6, 0x0f, 0x9b, 0xdf, 0xf5, 0x43, 0xe6, 0x77, 0x63, 0xef, 0xfc, 0x6e, 0xee, 0x9f, 0xdf,
-	0xad, 0x03, 0xe6, 0x37, 0xec, 0x9f, 0xdf, 0xed, 0x8a, 0xf9, 0xbd, 0x31, 0xde, 0x3a, 0x55, 0xe3,
-	0xed, 0x67, 0xd5, 0xec, 0xaa, 0x3c, 0x77, 0xed, 0x0c, 0xaa, 0xe3, 0xcc, 0x42, 0xc7, 0x39, 0x34,
-	0xa5, 0x31, 0x46, 0xaa, 0x9f, 0x32, 0xd2, 0xfd, 0x5c, 0xf5, 0xad, 0x56, 0xcb, 0xdb, 0x6e, 0x77,
-	0xfb, 0x5a, 0xad, 0x50, 0x11, 0x6f, 0xd5, 0x1c, 0x6a, 0xb7, 0xaa, 0xea, 0xdd, 0xe5, 0xb3, 0x42,
-	0x16, 0x53, 0x47, 0x16, 0xf7, 0x6b, 0xcd, 0x1a, 0x95, 0xf3, 0xd6, 0x1b, 0x64, 0x75, 0xe3, 0xe9,
-	0x63, 0xac, 0x6e, 0xf7, 0x4f, 0x03, 0x18, 0x9d, 0x58, 0x9f, 0xbf, 0x0c, 0xaf, 0xa2, 0xad, 0xc7,
-	0x33, 0xb0, 0x30, 0x35, 0xb0, 0xc8, 0x97, 0x2f, 0xab, 0xb0, 0x7c, 0xad, 0x07, 0x75, 0x4d, 0x1f,
-	0xd4, 0x05, 0x10, 0xb6, 0xcb, 0x20, 0xac, 0x37, 0x48, 0xbd, 0xd4, 0x20, 0x0f, 0x00, 0xe2, 0x24,
-	0x98, 0xfb, 0xc9, 0xea, 0x3b, 0x2e, 0x1b, 0xb4, 0xe5, 0x69, 0x1c, 0xf7, 0x19, 0x30, 0x8f, 0xc7,
-	0xb3, 0xd2, 0x4b, 0x1e, 0x42, 0x0d, 0x7d, 0x57, 0xd3, 0xae, 0x5b, 0x6e, 0x7e, 0x8f, 0xa4, 0xee,
-	0x17, 0x70, 0xb6, 0x19, 0x85, 0x14, 0x6b, 0x41, 0x3e, 0x1c, 0x21, 0xc2, 0xc2, 0x4e, 0x56, 0xa4,
-	0xfb, 0x1c, 0xce, 0x36, 0x8d, 0xa5, 0xec, 0x11, 0xd8, 0xa8, 0x21, 0xd5, 0xab, 0xcc, 0x49, 0xb1,
-	0xfb, 0xab, 0x05, 0x5d, 0x8f, 0x8f, 0x79, 0x10, 0x8b, 0x7c, 0xbb, 0x2d, 0x6e, 0x8b, 0xc6, 0xc6,
-	0xb6, 0x98, 0x27, 0xc5, 0x2c, 0x24, 0x65, 0xf7, 0x96, 0x9b, 0xa7, 0xa7, 0x56, 0x48, 0x4f, 0x96,
-	0x4a, 0x5b, 0x4b, 0x65, 0x21, 0x39, 0xf5, 0x8a, 0xe4, 0xac, 0x31, 0xb6, 0x51, 0x5a, 0x3d, 0xd6,
-	0xc9, 0x6e, 0x96, 0x50, 0x79, 0xeb, 0x26, 0xeb, 0x42, 0x47, 0x7a, 0xf2, 0xed, 0xb5, 0x1f, 0x4e,
-	0x39, 0x0d, 0x85, 0xa6, 0x57, 0xe0, 0xb1, 0xae, 0x04, 0x9f, 0x36, 0x89, 0x08, 0x65, 0xf2, 0xae,
-	0xed, 0xec, 0xc0, 0xc9, 0xe3, 0x0d, 0x9c, 0xcc, 0xca, 0xe0, 0x64, 0x67, 0x19, 0x7c, 0x34, 0xa0,
-	0xf1, 0x3e, 0xe5, 0x09, 0x76, 0xf6, 0xbe, 0x6c, 0xac, 0x5f, 0x6c, 0xea, 0x2f, 0xce, 0x73, 0x64,
-	0x55, 0x36, 0x4e, 0xad, 0xd8, 0x38, 0xea, 0x2d, 0xf6, 0x8e, 0xb7, 0xd4, 0xcb, 0x6f, 0x71, 0xff,
-	0xb0, 0x14, 0xaa, 0xbf, 0x95, 0x3f, 0x35, 0x3c, 0xfe, 0xe1, 0x3f, 0x45, 0xa2, 0xfb, 0xd0, 0x9a,
-	0xfb, 0xcb, 0x02, 0x0c, 0xe5, 0x8c, 0x0d, 0x9c, 0xb2, 0x0f, 0xc2, 0xa9, 0xfa, 0x61, 0x38, 0xd5,
-	0x38, 0x04, 0xa7, 0x9a, 0x7b, 0x71, 0xaa, 0xb5, 0x1f, 0xa7, 0xe0, 0x00, 0x9c, 0x6a, 0xef, 0xc7,
-	0xa9, 0x4e, 0x05, 0x4e, 0x75, 0xc1, 0xba, 0xe2, 0x5c, 0x15, 0x21, 0x7e, 0xba, 0x1c, 0x8e, 0xb3,
-	0x8d, 0x4f, 0xa6, 0xeb, 0x53, 0x41, 0x89, 0x41, 0x0d, 0x0b, 0x40, 0x21, 0x12, 0x7d, 0x67, 0x66,
-	0x6a, 0xb9, 0x99, 0xe7, 0x70, 0x4b, 0xd5, 0x05, 0x81, 0xd3, 0x6e, 0x53, 0xea, 0xb8, 0x99, 0x1f,
-	0xff, 0x46, 0x95, 0x15, 0xe1, 0xd5, 0xa7, 0x1e, 0x7e, 0xaf, 0x6c, 0x2b, 0x08, 0xdb, 0xfb, 0xcc,
-	0x2a, 0x1c, 0xcb, 0xae, 0xb5, 0xf2, 0x6b, 0x2f, 0xb5, 0x05, 0xd6, 0xe3, 0xe3, 0x28, 0x99, 0x6c,
-	0xbd, 0xb4, 0xd8, 0xb0, 0x66, 0xb9, 0x61, 0xdd, 0x89, 0x06, 0xb4, 0xf2, 0xaa, 0x94, 0x7d, 0x09,
-	0x8d, 0x44, 0x7e, 0xaa, 0x89, 0x7d, 0x77, 0x63, 0x32, 0x90, 0xd8, 0xcb, 0xd4, 0x4a, 0x28, 0x64,
-	0x96, 0x51, 0x68, 0xf0, 0xd1, 0x04, 0x9b, 0xfe, 0xda, 0x60, 0x5f, 0x01, 0xe4, 0x5d, 0xca, 0xaa,
-	0xf7, 0xec, 0xf3, 0xec, 0xc7, 0xd5, 0xfb, 0x30, 0x0d, 0xa6, 0xe1, 0xbb, 0xa5, 0x7b, 0xc4, 0x06,
-	0xda, 0x0f, 0xab, 0xaa, 0x85, 0xbb, 0xea, 0xcc, 0x33, 0xb5, 0xf4, 0x64, 0x6b, 0xc9, 0xb6, 0xdd,
-	0xbb, 0xea, 0x6c, 0xe6, 0xa7, 0x5c, 0x53, 0xaa, 0x57, 0xf0, 0x5d, 0x36, 0xb3, 0xa5, 0x65, 0xdb,
-	0x2e, 0x5e, 0x71, 0x76, 0x54, 0xa7, 0x7f, 0x77, 0x9e, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd2,
-	0x0f, 0x63, 0x87, 0x06, 0x12, 0x00, 0x00,
+	// 1421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5b, 0x8f, 0xdb, 0x44,
+	0x14, 0x5e, 0xdb, 0x71, 0x2e, 0xc7, 0xd9, 0x6c, 0x3a, 0xdb, 0x8b, 0x59, 0x56, 0x55, 0xb0, 0xaa,
+	0x12, 0x90, 0x5a, 0xaa, 0x54, 0x42, 0xa8, 0xa8, 0x0f, 0x1b, 0x7a, 0xd9, 0x08, 0x09, 0x8a, 0xdb,
+	0x0a, 0x5e, 0x9d, 0x64, 0x9a, 0xb5, 0x94, 0xd8, 0xae, 0x3d, 0x59, 0x25, 0x3f, 0x82, 0x37, 0xc4,
+	0x1f, 0xe8, 0x0b, 0x6f, 0xfc, 0x0d, 0xc4, 0x6f, 0xe2, 0x01, 0xcd, 0x99, 0xb1, 0x3d, 0x76, 0x9c,
+	0x4b, 0x11, 0x6f, 0x39, 0x97, 0x99, 0x73, 0xe6, 0xdc, 0xbe, 0xe3, 0x80, 0x35, 0x5b, 0xd2, 0x24,
+	0x79, 0x18, 0xc5, 0x21, 0x0b, 0x89, 0xc9, 0xd6, 0x11, 0x4d, 0xce, 0x6e, 0xb0, 0xd8, 0x0b, 0x12,
+	0x6f, 0xc2, 0xfc, 0x30, 0x10, 0x12, 0xe7, 0xf7, 0x06, 0xb4, 0x5e, 0x72, 0xcd, 0x97, 0xde, 0x82,
+	0x92, 0xdb, 0x50, 0x9f, 0x79, 0x0b, 0x3a, 0x7a, 0x66, 0x6b, 0x3d, 0xad, 0xdf, 0x72, 0x25, 0xc5,
+	0xf9, 0x09, 0xf3, 0xd8, 0x32, 0xb1, 0xf5, 0x9e, 0xd6, 0x37, 0x5d, 0x49, 0x91, 0x73, 0x68, 0x45,
+	0x31, 0x7d, 0x2d, 0x44, 0x06, 0x8a, 0x72, 0x06, 0x97, 0x26, 0xcc, 0x8b, 0xd9, 0x1b, 0x7f, 0x41,
+	0xed, 0x5a, 0x4f, 0xeb, 0x1b, 0x6e, 0xce, 0x20, 0x3d, 0xb0, 0x90, 0xb8, 0xa4, 0xfe, 0xec, 0x8a,
+	0xd9, 0x26, 0xca, 0x55, 0x56, 0xa6, 0xf1, 0x66, 0x75, 0xe9, 0x25, 0x57, 0x76, 0x1d, 0x5d, 0x52,
+	0x59, 0xe4, 0x2e, 0x00, 0x92, 0xa3, 0x60, 0x4a, 0x57, 0x76, 0x03, 0xaf, 0x50, 0x38, 0xe4, 0x26,
+	0x98, 0x2c, 0x8c, 0xfc, 0x89, 0xdd, 0xc4, 0xb3, 0x82, 0x20, 0x67, 0xd0, 0x9c, 0x78, 0x8c, 0xce,
+	0xc2, 0x78, 0x6d, 0xb7, 0x50, 0x90, 0xd1, 0xc4, 0x86, 0x46, 0x18, 0xf1, 0xf8, 0x24, 0x36, 0xa0,
+	0x28, 0x25, 0x89, 0x03, 0xed, 0x85, 0xb7, 0x1a, 0xd2, 0xd4, 0x61, 0x0b, 0xad, 0x15, 0x78, 0xe4,
+	0x3e, 0x74, 0x04, 0x9d, 0xfc, 0x18, 0x50, 0x7c, 0x76, 0x1b, 0xb5, 0x4a, 0x5c, 0x72, 0x0f, 0x8e,
+	0x25, 0xe7, 0x87, 0xe5, 0x62, 0x4c, 0x63, 0xfb, 0x18, 0xd5, 0x8a, 0x4c, 0x6e, 0x71, 0x4a, 0xaf,
+	0x5f, 0x50, 0xfa, 0xc2, 0x9b, 0xb0, 0x30, 0xb6, 0x3b, 0xc2, 0xa2, 0xca, 0xe3, 0x11, 0x10, 0xf4,
+	0xc5, 0x74, 0x1a, 0xdb, 0x27, 0xe8, 0xb2, 0xc2, 0xe1, 0x96, 0xa2, 0xb9, 0xc7, 0xf2, 0x4b, 0xba,
+	0xc2, 0x52, 0x81, 0xc9, 0x23, 0x2d, 0x19, 0x78, 0xcd, 0x0d, 0x11, 0x69, 0x85, 0xc5, 0x7d, 0xa1,
+	0xab, 0xc8, 0x8f, 0xa9, 0x7c, 0x3d, 0x11, 0xbe, 0xa8, 0x3c, 0x9e, 0x6f, 0x6f, 0xba, 0xf0, 0x03,
+	0xbc, 0xe3, 0x14, 0xef, 0xc8, 0x19, 0xdc, 0xd3, 0x71, 0xfe, 0xe0, 0x9b, 0x22, 0x57, 0x39, 0x87,
+	0xf4, 0xc1, 0x8c, 0xe6, 0xde, 0x3a, 0xb1, 0x6f, 0xf5, 0x8c, 0xbe, 0x35, 0x20, 0x0f, 0xb1, 0x66,
+	0x1f, 0x62, 0x71, 0xbe, 0x9a, 0x7b, 0x6b, 0x1a, 0xbb, 0x42, 0x81, 0x57, 0x63, 0x4c, 0x93, 0xe5,
+	0x9c, 0xd9, 0xb7, 0x45, 0x95, 0x0a, 0x8a, 0x3c, 0x80, 0xc6, 0x98, 0x32, 0x5e, 0x7c, 0xf6, 0x9d,
+	0x9e, 0xd6, 0xb7, 0x06, 0xa7, 0xea, 0x1d, 0x43, 0x21, 0x72, 0x53, 0x1d, 0x5e, 0x1c, 0x3e, 0xd6,
+	0x8d, 0x8d, 0xbe, 0x08, 0x82, 0x17, 0x47, 0x14, 0x53, 0x51, 0x50, 0x9f, 0xa0, 0x20, 0xa3, 0x79,
+	0x30, 0xa7, 0xb1, 0x7f, 0x4d, 0x83, 0xe1, 0xfa, 0x82, 0xbf, 0xcb, 0x3e, 0xeb, 0x69, 0xfd, 0xa6,
+	0x5b, 0x64, 0x72, 0xad, 0x30, 0xf6, 0x26, 0x73, 0xfa, 0xfc, 0x9a, 0x06, 0x6c, 0xf4, 0xcc, 0xfe,
+	0x14, 0xbd, 0x2c, 0x32, 0x65, 0x38, 0x9e, 0x07, 0x53, 0x2c, 0x93, 0xf3, 0x2c, 0x1c, 0x92, 0xe3,
+	0x3c, 0x03, 0x4b, 0x79, 0x3a, 0x21, 0x50, 0xf3, 0x78, 0x58, 0x45, 0x5f, 0xe2, 0x6f, 0xf2, 0x19,
+	0x18, 0x63, 0xca, 0xb0, 0x25, 0xad, 0xc1, 0x49, 0xe9, 0xad, 0x2e, 0x97, 0x39, 0x7f, 0x68, 0xd0,
+	0x4c, 0x39, 0x3c, 0x6e, 0xa2, 0x98, 0xd3, 0xee, 0x16, 0x54, 0x29, 0x33, 0xfa, 0x46, 0x66, 0xce,
+	0xa0, 0xe9, 0x27, 0x3f, 0xfb, 0x41, 0x40, 0x63, 0x6c, 0xf2, 0xa6, 0x9b, 0xd1, 0xfc, 0xce, 0x28,
+	0x0e, 0xdf, 0xf9, 0x4c, 0x36, 0xb8, 0xa4, 0xf2, 0xe0, 0x9a, 0xdb, 0x82, 0x5b, 0x2f, 0x06, 0xd7,
+	0xf9, 0x55, 0x83, 0xb6, 0x9a, 0x28, 0x1e, 0x47, 0x16, 0x32, 0x6f, 0x3e, 0xa4, 0x38, 0x30, 0x12,
+	0xf4, 0xda, 0x70, 0x8b, 0x4c, 0xd2, 0x87, 0x93, 0x94, 0x51, 0x7c, 0x41, 0x99, 0x4d, 0x1e, 0x80,
+	0xe9, 0x33, 0xba, 0xe0, 0x83, 0x8a, 0x17, 0xd8, 0x9d, 0x8a, 0xe2, 0x18, 0x31, 0xba, 0x70, 0x85,
+	0x96, 0x73, 0x05, 0xdd, 0xb2, 0xe8, 0x3f, 0x47, 0xf0, 0x1c, 0x5a, 0x9c, 0x12, 0xcf, 0x30, 0x50,
+	0x9c, 0x33, 0x9c, 0x7f, 0x74, 0x38, 0xc9, 0x66, 0xf0, 0x05, 0x4e, 0x67, 0xee, 0x2c, 0xce, 0x31,
+	0x34, 0x64, 0x0d, 0x6e, 0xa9, 0xce, 0x72, 0xb5, 0xd7, 0x38, 0x27, 0x8f, 0x5c, 0xa1, 0x45, 0x3e,
+	0x57, 0x4b, 0xe1, 0xb4, 0xac, 0xcc, 0x07, 0xd4, 0x11, 0x16, 0x04, 0x79, 0x0c, 0x8d, 0x84, 0x85,
+	0xd1, 0x90, 0x32, 0xf4, 0xa3, 0x14, 0x06, 0x71, 0x33, 0x8a, 0x2f, 0x8f, 0xdc, 0x54, 0x93, 0x3b,
+	0xe3, 0x8d, 0xc3, 0x58, 0xe4, 0xb8, 0xc2, 0x99, 0x8b, 0x71, 0x28, 0x9c, 0x41, 0x2d, 0x6e, 0x23,
+	0x5a, 0x8e, 0xe7, 0x7e, 0x72, 0x65, 0x9b, 0xd5, 0x36, 0x5e, 0x09, 0x31, 0xb7, 0x21, 0x35, 0xb9,
+	0x8d, 0xf7, 0x4b, 0x1a, 0xaf, 0xed, 0x7a, 0xb5, 0x8d, 0x9f, 0xb8, 0x90, 0xdb, 0x40, 0x2d, 0xf2,
+	0x08, 0xea, 0x09, 0x65, 0x6c, 0x4e, 0x71, 0xb4, 0x5b, 0x83, 0xdb, 0x1b, 0xcf, 0x40, 0xe9, 0xe5,
+	0x91, 0x2b, 0xf5, 0x48, 0x07, 0x74, 0xb6, 0x46, 0x8c, 0x30, 0x5d, 0x9d, 0xad, 0x87, 0x0d, 0x30,
+	0xaf, 0xbd, 0xf9, 0x92, 0x3a, 0x7f, 0x1b, 0xd0, 0x29, 0xc6, 0x35, 0xc7, 0x0d, 0x4d, 0xc5, 0x0d,
+	0x05, 0x1b, 0xf4, 0x22, 0x36, 0xa8, 0x88, 0x62, 0x94, 0x10, 0xa5, 0x8c, 0x1b, 0xb5, 0x83, 0x70,
+	0xc3, 0x3c, 0x0c, 0x37, 0xea, 0x87, 0xe0, 0x46, 0x63, 0x2f, 0x6e, 0x34, 0xf7, 0xe3, 0x46, 0xeb,
+	0x00, 0xdc, 0x80, 0xfd, 0xb8, 0x61, 0x55, 0xe0, 0xc6, 0xc6, 0x58, 0x6d, 0x1f, 0x34, 0x56, 0x8f,
+	0x2b, 0xc6, 0xaa, 0xf3, 0x0b, 0xb4, 0xb3, 0x5c, 0xca, 0x99, 0xb7, 0x6d, 0xa3, 0x91, 0x9d, 0xac,
+	0x17, 0x3a, 0xd9, 0x46, 0x0c, 0xe1, 0x91, 0x94, 0x7d, 0x9a, 0x92, 0xce, 0x97, 0xd0, 0xcd, 0x6e,
+	0x96, 0x3d, 0xb2, 0xed, 0x76, 0xa7, 0x0f, 0x9d, 0x62, 0x73, 0x6c, 0xd5, 0x1c, 0x42, 0xb7, 0xdc,
+	0x15, 0xbb, 0x7c, 0x96, 0xb8, 0xa7, 0xab, 0xb8, 0xe7, 0x7c, 0x01, 0x27, 0xa5, 0xb2, 0xdf, 0x6a,
+	0xee, 0x1b, 0xe8, 0x14, 0x3b, 0x6a, 0xab, 0x31, 0xd1, 0x2e, 0xdc, 0xd0, 0x31, 0x6f, 0x17, 0xe7,
+	0x2f, 0x0d, 0x08, 0x9e, 0xc8, 0xce, 0x8f, 0x82, 0x77, 0xe1, 0xd6, 0xe3, 0x29, 0x5e, 0xe9, 0x0a,
+	0x5e, 0xe5, 0x5b, 0xa4, 0x51, 0xd8, 0x22, 0x33, 0xac, 0xa8, 0xa9, 0x58, 0x51, 0xd8, 0x26, 0xcc,
+	0xf2, 0x36, 0xa1, 0x76, 0x5c, 0xbd, 0xd4, 0x71, 0x77, 0x01, 0xa2, 0xd8, 0x5f, 0x78, 0xf1, 0xfa,
+	0x7b, 0x2a, 0x3a, 0xbe, 0xe5, 0x2a, 0x1c, 0xe7, 0x09, 0x10, 0x97, 0x46, 0xf3, 0xd2, 0x4b, 0xee,
+	0x41, 0x8d, 0xfb, 0x2e, 0x07, 0x6e, 0xb7, 0x3c, 0x4f, 0x5c, 0x94, 0x3a, 0x5f, 0xc1, 0xe9, 0x66,
+	0x14, 0x12, 0x5e, 0x36, 0xe2, 0xe1, 0x1c, 0xa5, 0x0c, 0x3e, 0x1a, 0x24, 0xe9, 0x3c, 0x85, 0xd3,
+	0x4d, 0x63, 0x09, 0xb9, 0x0f, 0x26, 0xd7, 0x10, 0xea, 0x55, 0xe6, 0x84, 0xd8, 0xf9, 0xcd, 0x80,
+	0xae, 0x4b, 0x27, 0xd4, 0x8f, 0x58, 0x26, 0x2b, 0xad, 0xbd, 0xda, 0xc6, 0xda, 0x9b, 0x27, 0x45,
+	0x2f, 0x24, 0x65, 0xf7, 0xba, 0x9e, 0xa7, 0xa7, 0x56, 0x48, 0x4f, 0x9a, 0x4a, 0x53, 0x49, 0x65,
+	0x21, 0x39, 0xf5, 0x8a, 0xe4, 0x64, 0x30, 0xdf, 0x28, 0xed, 0x50, 0x59, 0xb2, 0x9b, 0xa5, 0xc5,
+	0x60, 0xeb, 0x4a, 0xee, 0x40, 0x5b, 0x78, 0xf2, 0xdd, 0x95, 0x17, 0xcc, 0x28, 0x4e, 0x99, 0xa6,
+	0x5b, 0xe0, 0x91, 0xae, 0xc0, 0x3f, 0x0b, 0x45, 0xfc, 0xa7, 0xd2, 0xe0, 0xed, 0x1d, 0x50, 0x7d,
+	0xbc, 0x01, 0xd5, 0x69, 0x19, 0x74, 0x76, 0x96, 0xc1, 0x07, 0x0d, 0x1a, 0x6f, 0x13, 0x1a, 0xf3,
+	0x21, 0xb0, 0x2f, 0x1b, 0xd9, 0x8b, 0x75, 0xf5, 0xc5, 0x79, 0x8e, 0x8c, 0xca, 0xc6, 0xa9, 0x15,
+	0x1b, 0x47, 0xbe, 0xc5, 0xdc, 0xf1, 0x96, 0x7a, 0xf9, 0x2d, 0xce, 0x9f, 0x86, 0x9c, 0x0c, 0xaf,
+	0xc5, 0x37, 0x93, 0x4b, 0xdf, 0xff, 0xaf, 0xd0, 0x76, 0x0e, 0xad, 0x85, 0xb7, 0x2a, 0xe0, 0x5a,
+	0xce, 0xd8, 0x00, 0x3e, 0xf3, 0x20, 0xe0, 0xab, 0x1f, 0x06, 0x7c, 0x8d, 0x43, 0x80, 0xaf, 0xb9,
+	0x17, 0xf8, 0x5a, 0xfb, 0x81, 0x0f, 0x0e, 0x00, 0x3e, 0x6b, 0x3f, 0xf0, 0xb5, 0x2b, 0x80, 0xaf,
+	0x0b, 0xc6, 0x3b, 0x4a, 0x65, 0x11, 0xf2, 0x9f, 0x0e, 0x85, 0xe3, 0x74, 0xe9, 0x14, 0xe9, 0xfa,
+	0x58, 0xfc, 0x22, 0x50, 0xe3, 0x05, 0x20, 0xc1, 0x0b, 0x7f, 0xa7, 0x66, 0x6a, 0xb9, 0x99, 0xa7,
+	0x70, 0x43, 0xd6, 0x45, 0x18, 0xed, 0x35, 0x25, 0x8f, 0xeb, 0xf9, 0xf1, 0x6f, 0x65, 0x59, 0x21,
+	0xb4, 0x7d, 0xec, 0xe1, 0xb7, 0xd2, 0xb6, 0x44, 0xbb, 0xbd, 0xcf, 0xac, 0x82, 0xbc, 0xf4, 0x5a,
+	0x23, 0xbf, 0x76, 0xa4, 0x80, 0xa0, 0x4b, 0x27, 0x61, 0x3c, 0xdd, 0x7a, 0x69, 0xb1, 0x61, 0xf5,
+	0x72, 0xc3, 0x3a, 0x53, 0xe8, 0x96, 0xae, 0x4a, 0xc8, 0x23, 0x68, 0xc4, 0xe2, 0xa7, 0x9c, 0xd8,
+	0x1b, 0x0b, 0xa7, 0xd0, 0x74, 0x53, 0xb5, 0x12, 0x0a, 0xe9, 0x65, 0x14, 0x1a, 0x7c, 0xd0, 0xc1,
+	0xc4, 0xff, 0x68, 0xc8, 0xd7, 0x00, 0x79, 0x97, 0x92, 0xea, 0x55, 0xff, 0x2c, 0xfd, 0xbe, 0x7b,
+	0x1b, 0x24, 0xfe, 0x2c, 0x78, 0xb3, 0x72, 0x8e, 0xc8, 0x40, 0xf9, 0xb6, 0xab, 0xda, 0xf9, 0xab,
+	0xce, 0x3c, 0x81, 0xb6, 0x9a, 0x79, 0xb2, 0x6d, 0xfd, 0xaf, 0x3a, 0x9b, 0xfa, 0x29, 0x36, 0x9a,
+	0xea, 0xaf, 0x80, 0x5d, 0x36, 0xd3, 0xfd, 0x66, 0xdb, 0xe7, 0x40, 0xc5, 0xd9, 0x71, 0x1d, 0xff,
+	0xa6, 0x7a, 0xfc, 0xef, 0x00, 0x25, 0xab, 0x36, 0xa1, 0xcf, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
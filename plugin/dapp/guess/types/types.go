@@ -20,6 +20,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(GuessX, "Enable", 0)
+	cfg.RegisterDappFork(GuessX, ForkGuessOracle, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -54,6 +55,7 @@ func (t *GuessType) GetTypeMap() map[string]int32 {
 		"Abort":   GuessGameActionAbort,
 		"Publish": GuessGameActionPublish,
 		"Query":   GuessGameActionQuery,
+		"Settle":  GuessGameActionSettle,
 	}
 }
 
@@ -7,7 +7,9 @@ package commands
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -27,6 +29,7 @@ func HashlockCmd() *cobra.Command {
 		HashlockLockCmd(),
 		HashlockUnlockCmd(),
 		HashlockSendCmd(),
+		HashlockQueryCmd(),
 	)
 
 	return cmd
@@ -45,7 +48,7 @@ func HashlockLockCmd() *cobra.Command {
 
 func addHashlockLockCmdFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("secret", "s", "", "secret information")
-	cmd.MarkFlagRequired("secret")
+	cmd.Flags().StringP("hash", "x", "", "hash of the secret in hex, used when the secret is held by the counterparty")
 	cmd.Flags().Float64P("amount", "a", 0.0, "locking amount")
 	cmd.MarkFlagRequired("amount")
 	cmd.Flags().Int64P("delay", "d", 60, "delay period (minimum 60 seconds)")
@@ -54,6 +57,10 @@ func addHashlockLockCmdFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("to")
 	cmd.Flags().StringP("return", "r", "", "return address")
 	cmd.MarkFlagRequired("return")
+	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, coins if empty")
+	cmd.Flags().StringP("asset_symbol", "y", "", "asset symbol")
+	cmd.Flags().Int32P("hash_type", "k", 0, "hash type, 0: sha256, 1: keccak256")
+	cmd.Flags().Int64P("timeout_height", "o", 0, "refund block height, replaces the delay period if set")
 
 	cmd.Flags().Float64P("fee", "f", 0.0, "transaction fee")
 }
@@ -68,6 +75,15 @@ func hashlockLockCmd(cmd *cobra.Command, args []string) {
 	returnAddr, _ := cmd.Flags().GetString("return")
 	delay, _ := cmd.Flags().GetInt64("delay")
 	amount, _ := cmd.Flags().GetFloat64("amount")
+	hash, _ := cmd.Flags().GetString("hash")
+	assetExec, _ := cmd.Flags().GetString("asset_exec")
+	assetSymbol, _ := cmd.Flags().GetString("asset_symbol")
+	hashType, _ := cmd.Flags().GetInt32("hash_type")
+	timeoutHeight, _ := cmd.Flags().GetInt64("timeout_height")
+	if secret == "" && hash == "" {
+		fmt.Println("secret or hash is required")
+		return
+	}
 
 	defaultFee := float64(cfg.GetMinTxFeeRate()) / float64(types.Coin)
 	fee, _ := cmd.Flags().GetFloat64("fee")
@@ -82,12 +98,17 @@ func hashlockLockCmd(cmd *cobra.Command, args []string) {
 	amountInt64 := int64(amount*types.InputPrecision) * types.Multiple1E4
 	feeInt64 := int64(fee*types.InputPrecision) * types.Multiple1E4
 	params := pty.HashlockLockTx{
-		Secret:     secret,
-		Amount:     amountInt64,
-		Time:       delay,
-		ToAddr:     toAddr,
-		ReturnAddr: returnAddr,
-		Fee:        feeInt64,
+		Secret:        secret,
+		Hash:          hash,
+		Amount:        amountInt64,
+		Time:          delay,
+		ToAddr:        toAddr,
+		ReturnAddr:    returnAddr,
+		Fee:           feeInt64,
+		AssetExec:     assetExec,
+		AssetSymbol:   assetSymbol,
+		HashType:      hashType,
+		TimeoutHeight: timeoutHeight,
 	}
 
 	payLoad, err := json.Marshal(params)
@@ -111,10 +132,17 @@ func HashlockUnlockCmd() *cobra.Command {
 		Short: "Create hashlock unlock transaction",
 		Run:   hashlockUnlockCmd,
 	}
-	addHashlockCmdFlags(cmd)
+	addHashlockUnlockCmdFlags(cmd)
 	return cmd
 }
 
+func addHashlockUnlockCmdFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("secret", "s", "", "secret information")
+	cmd.Flags().StringP("hash", "x", "", "hash of the secret in hex, used when the secret is held by the counterparty")
+
+	cmd.Flags().Float64P("fee", "f", 0.0, "transaction fee")
+}
+
 func addHashlockCmdFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("secret", "s", "", "secret information")
 	cmd.MarkFlagRequired("secret")
@@ -128,6 +156,11 @@ func hashlockUnlockCmd(cmd *cobra.Command, args []string) {
 
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	secret, _ := cmd.Flags().GetString("secret")
+	hash, _ := cmd.Flags().GetString("hash")
+	if secret == "" && hash == "" {
+		fmt.Println("secret or hash is required")
+		return
+	}
 
 	defaultFee := float64(cfg.GetMinTxFeeRate()) / float64(types.Coin)
 	fee, _ := cmd.Flags().GetFloat64("fee")
@@ -138,6 +171,7 @@ func hashlockUnlockCmd(cmd *cobra.Command, args []string) {
 	feeInt64 := int64(fee*types.InputPrecision) * types.Multiple1E4
 	params := pty.HashlockUnlockTx{
 		Secret: secret,
+		Hash:   hash,
 		Fee:    feeInt64,
 	}
 	payLoad, err := json.Marshal(params)
@@ -196,3 +230,60 @@ func hashlockSendCmd(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", paramWithExecAction, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// HashlockQueryCmd query hashlock by hash or address
+func HashlockQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query hashlock by hash, or list hashlocks of an address",
+		Run:   hashlockQueryCmd,
+	}
+	cmd.Flags().StringP("hash", "x", "", "hash in hex")
+	cmd.Flags().StringP("addr", "a", "", "to address or return address")
+	cmd.Flags().StringP("primary", "p", "", "hash in hex to list from")
+	cmd.Flags().Int32P("count", "c", 10, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "direction, 0: desc, 1: asc")
+	return cmd
+}
+
+func hashlockQueryCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	hash, _ := cmd.Flags().GetString("hash")
+	addr, _ := cmd.Flags().GetString("addr")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = pty.HashlockX
+	if hash != "" {
+		params.FuncName = "GetHashlockByHash"
+		params.Payload = types.MustPBToJSON(&types.ReqHash{Hash: mustFromHex(hash)})
+		var res pty.Hashlock
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+		return
+	}
+	if addr == "" {
+		fmt.Println("hash or addr is required")
+		return
+	}
+	req := &pty.ReqHashlockByAddr{Addr: addr, Count: count, Direction: direction}
+	if primary != "" {
+		req.PrimaryKey = mustFromHex(primary)
+	}
+	params.FuncName = "GetHashlockByAddr"
+	params.Payload = types.MustPBToJSON(req)
+	var res pty.ReplyHashlocks
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+func mustFromHex(s string) []byte {
+	data, err := common.FromHex(s)
+	if err != nil {
+		fmt.Println("invalid hex", s)
+		os.Exit(1)
+	}
+	return data
+}
@@ -31,7 +31,13 @@ func (h *Hashlock) Exec_Hlock(hlock *pty.HashlockLock, tx *types.Transaction, in
 		return nil, pty.ErrHashlockReturnAddrss
 	}
 
-	if hlock.Time <= minLockTime {
+	cfg := h.GetAPI().GetConfig()
+	if cfg.IsDappFork(h.GetHeight(), pty.HashlockX, pty.ForkHashlockAssetX) && hlock.TimeoutHeight > 0 {
+		if hlock.TimeoutHeight <= h.GetHeight() {
+			clog.Warn("exec hashlock timeout height passed")
+			return nil, pty.ErrHashlockTime
+		}
+	} else if hlock.Time <= minLockTime {
 		clog.Warn("exec hashlock time not enough")
 		return nil, pty.ErrHashlockTime
 	}
@@ -5,7 +5,6 @@
 package executor
 
 import (
-	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
 )
@@ -18,14 +17,15 @@ func (h *Hashlock) ExecLocal_Hlock(hlock *pty.HashlockLock, tx *types.Transactio
 	if err != nil {
 		return nil, err
 	}
-	return &types.LocalDBSet{KV: []*types.KeyValue{kv}}, nil
+	kvs := append([]*types.KeyValue{kv}, hashlockAddrKVs(hlock, false)...)
+	return &types.LocalDBSet{KV: kvs}, nil
 }
 
 // ExecLocal_Hsend Action
 func (h *Hashlock) ExecLocal_Hsend(hsend *pty.HashlockSend, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	info := pty.Hashlockquery{Time: 0, Status: hashlockSent, Amount: 0, CreateTime: 0, CurrentTime: 0}
 	clog.Error("ExecLocal", "info", info)
-	kv, err := UpdateHashReciver(h.GetLocalDB(), common.Sha256(hsend.Secret), info)
+	kv, err := UpdateHashReciver(h.GetLocalDB(), localHashlockID(h.GetLocalDB(), hsend.Secret), info)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (h *Hashlock) ExecLocal_Hsend(hsend *pty.HashlockSend, tx *types.Transactio
 func (h *Hashlock) ExecLocal_Hunlock(hunlock *pty.HashlockUnlock, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	info := pty.Hashlockquery{Time: 0, Status: hashlockUnlocked, Amount: 0, CreateTime: 0, CurrentTime: 0}
 	clog.Error("ExecLocal", "info", info)
-	kv, err := UpdateHashReciver(h.GetLocalDB(), common.Sha256(hunlock.Secret), info)
+	kv, err := UpdateHashReciver(h.GetLocalDB(), localUnlockID(h.GetLocalDB(), hunlock), info)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,152 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	crand "crypto/rand"
+	"math/rand"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func hashlockTx(priv crypto.PrivKey, action *pty.HashlockAction) *types.Transaction {
+	tx := &types.Transaction{Execer: []byte("hashlock"), Payload: types.Encode(action), Fee: 1e6, To: address.ExecAddress("hashlock")}
+	tx.Nonce = rand.Int63()
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestHashlockAsset(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	InitExecType()
+	h := newHashlock()
+	h.SetAPI(api)
+	_, _, kvdb := util.CreateTestDB()
+	h.SetStateDB(kvdb)
+	h.SetLocalDB(kvdb)
+	h.SetEnv(100, 1000, 0)
+
+	execAddr := address.ExecAddress("hashlock")
+	alice, alicePriv := genaddress()
+	bob, bobPriv := genaddress()
+	tokenDB, err := account.NewAccountDB(cfg, "token", "TEST", kvdb)
+	assert.Nil(t, err)
+	tokenDB.SaveExecAccount(execAddr, &types.Account{Addr: alice, Balance: 1000})
+
+	exec := func(priv crypto.PrivKey, action *pty.HashlockAction) error {
+		tx := hashlockTx(priv, action)
+		_, err := h.Exec(tx, 0)
+		if err != nil {
+			return err
+		}
+		set, err := h.ExecLocal(tx, &types.ReceiptData{Ty: types.ExecOk}, 0)
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			kvdb.Set(kv.Key, kv.Value)
+		}
+		return nil
+	}
+	lock := func(hash []byte, hashType int32, timeoutHeight int64) error {
+		hlock := &pty.HashlockLock{Amount: 300, Time: 70, Hash: hash, ToAddress: bob, ReturnAddress: alice,
+			AssetExec: "token", AssetSymbol: "TEST", HashType: hashType, TimeoutHeight: timeoutHeight}
+		return exec(alicePriv, &pty.HashlockAction{Ty: pty.HashlockActionLock, Value: &pty.HashlockAction_Hlock{Hlock: hlock}})
+	}
+	send := func(priv crypto.PrivKey, secret []byte) error {
+		return exec(priv, &pty.HashlockAction{Ty: pty.HashlockActionSend, Value: &pty.HashlockAction_Hsend{Hsend: &pty.HashlockSend{Secret: secret}}})
+	}
+	unlock := func(priv crypto.PrivKey, secret []byte) error {
+		return exec(priv, &pty.HashlockAction{Ty: pty.HashlockActionUnlock, Value: &pty.HashlockAction_Hunlock{Hunlock: &pty.HashlockUnlock{Secret: secret}}})
+	}
+	unlockByHash := func(priv crypto.PrivKey, hash []byte) error {
+		return exec(priv, &pty.HashlockAction{Ty: pty.HashlockActionUnlock, Value: &pty.HashlockAction_Hunlock{Hunlock: &pty.HashlockUnlock{Hash: hash}}})
+	}
+
+	secret1 := make([]byte, secretLen)
+	crand.Read(secret1)
+	hash1, err := pty.HashSecret(pty.HashTypeKeccak256, secret1)
+	assert.Nil(t, err)
+	secret2 := make([]byte, secretLen)
+	crand.Read(secret2)
+	hash2 := common.Sha256(secret2)
+
+	// 参数检查
+	assert.Equal(t, pty.ErrHashlockHashType, lock(hash1, 2, 110))
+	assert.Equal(t, pty.ErrHashlockHash, lock(hash1[:20], pty.HashTypeKeccak256, 110))
+	assert.Equal(t, pty.ErrHashlockTime, lock(hash1, pty.HashTypeKeccak256, 100))
+
+	// keccak256 锁定 token, 超时前用 secret 取走
+	assert.Nil(t, lock(hash1, pty.HashTypeKeccak256, 110))
+	assert.Nil(t, lock(hash2, pty.HashTypeSha256, 110))
+	acc := tokenDB.LoadExecAccount(alice, execAddr)
+	assert.Equal(t, int64(400), acc.Balance)
+	assert.Equal(t, int64(600), acc.Frozen)
+
+	assert.Equal(t, types.ErrNotFound, send(bobPriv, hash1))
+	assert.Equal(t, pty.ErrTime, unlock(alicePriv, secret1))
+	h.SetEnv(109, 5000, 0)
+	assert.Nil(t, send(bobPriv, secret1))
+	assert.Equal(t, int64(300), tokenDB.LoadExecAccount(bob, execAddr).Balance)
+
+	// 超过高度后不能再取, 只能退回
+	h.SetEnv(110, 5010, 0)
+	assert.Equal(t, pty.ErrTime, send(bobPriv, secret2))
+	assert.Nil(t, unlock(alicePriv, secret2))
+	acc = tokenDB.LoadExecAccount(alice, execAddr)
+	assert.Equal(t, int64(700), acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	// 按 hash 和地址查询
+	msg, err := h.Query("GetHashlockByHash", types.Encode(&types.ReqHash{Hash: hash1}))
+	assert.Nil(t, err)
+	hlock := msg.(*pty.Hashlock)
+	assert.Equal(t, int32(hashlockSent), hlock.Status)
+	assert.Equal(t, "token", hlock.AssetExec)
+	assert.Equal(t, int32(pty.HashTypeKeccak256), hlock.HashType)
+	assert.Equal(t, int64(100), hlock.CreateHeight)
+
+	msg, err = h.Query("GetHashlockByAddr", types.Encode(&pty.ReqHashlockByAddr{Addr: bob}))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(msg.(*pty.ReplyHashlocks).Hashlocks))
+	msg, err = h.Query("GetHashlockByAddr", types.Encode(&pty.ReqHashlockByAddr{Addr: alice, Count: 1}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(msg.(*pty.ReplyHashlocks).Hashlocks))
+
+	// keccak256 锁定的本地记录不能按 sha256(secret) 保存
+	assert.Equal(t, hash1, localHashlockID(kvdb, secret1))
+	_, err = kvdb.Get(calcHashlockIDKey(common.Sha256(secret1)))
+	assert.Equal(t, types.ErrNotFound, err)
+
+	// 用对方的 hash 锁定, 不知道 secret, 超时后按 hash 退回
+	secret3 := make([]byte, secretLen)
+	crand.Read(secret3)
+	hash3 := common.Sha256(secret3)
+	assert.Nil(t, lock(hash3, pty.HashTypeSha256, 120))
+	assert.Equal(t, int64(300), tokenDB.LoadExecAccount(alice, execAddr).Frozen)
+	assert.Equal(t, pty.ErrTime, unlockByHash(alicePriv, hash3))
+	h.SetEnv(120, 5100, 0)
+	assert.Equal(t, pty.ErrHashlockReturnAddrss, unlockByHash(bobPriv, hash3))
+	assert.Nil(t, unlockByHash(alicePriv, hash3))
+	acc = tokenDB.LoadExecAccount(alice, execAddr)
+	assert.Equal(t, int64(700), acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+	msg, err = h.Query("GetHashlockByHash", types.Encode(&types.ReqHash{Hash: hash3}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(hashlockUnlocked), msg.(*pty.Hashlock).Status)
+	_, err = kvdb.Get(calcHashlockIDKey(hash3))
+	assert.Nil(t, err)
+}
@@ -105,8 +105,25 @@ func (action *Action) Hashlocklock(hlock *pty.HashlockLock) (*types.Receipt, err
 	}
 
 	h := NewDB(hlock.Hash, action.fromaddr, hlock.ToAddress, action.blocktime, hlock.Amount, hlock.Time)
+	if cfg.IsDappFork(action.height, pty.HashlockX, pty.ForkHashlockAssetX) {
+		if len(hlock.Hash) != 32 {
+			return nil, pty.ErrHashlockHash
+		}
+		if hlock.HashType != pty.HashTypeSha256 && hlock.HashType != pty.HashTypeKeccak256 {
+			return nil, pty.ErrHashlockHashType
+		}
+		h.AssetExec = hlock.AssetExec
+		h.AssetSymbol = hlock.AssetSymbol
+		h.HashType = hlock.HashType
+		h.TimeoutHeight = hlock.TimeoutHeight
+		h.CreateHeight = action.height
+	}
+	accountDB, err := action.assetAccount(&h.Hashlock)
+	if err != nil {
+		return nil, err
+	}
 	//冻结子账户资金
-	receipt, err := action.coinsAccount.ExecFrozen(action.fromaddr, action.execaddr, hlock.Amount)
+	receipt, err := accountDB.ExecFrozen(action.fromaddr, action.execaddr, hlock.Amount)
 
 	if err != nil {
 		hlog.Error("Hashlocklock.Frozen", "addr", action.fromaddr, "execaddr", action.execaddr, "amount", hlock.Amount)
@@ -129,9 +146,9 @@ func (action *Action) Hashlockunlock(unlock *pty.HashlockUnlock) (*types.Receipt
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	hash, err := readHashlock(action.db, common.Sha256(unlock.Secret))
+	hash, err := action.readHashlockByUnlock(unlock)
 	if err != nil {
-		hlog.Error("Hashlockunlock", "unlock.Secret", unlock.Secret)
+		hlog.Error("Hashlockunlock", "unlock.Secret", unlock.Secret, "unlock.Hash", unlock.Hash)
 		return nil, err
 	}
 
@@ -145,14 +162,23 @@ func (action *Action) Hashlockunlock(unlock *pty.HashlockUnlock) (*types.Receipt
 		return nil, pty.ErrHashlockStatus
 	}
 
-	if action.blocktime-hash.GetCreateTime() < hash.Frozentime {
+	if hash.TimeoutHeight > 0 {
+		if action.height < hash.TimeoutHeight {
+			hlog.Error("Hashlockunlock", "action.height", action.height, "hash.TimeoutHeight", hash.TimeoutHeight)
+			return nil, pty.ErrTime
+		}
+	} else if action.blocktime-hash.GetCreateTime() < hash.Frozentime {
 		hlog.Error("Hashlockunlock", "action.blocktime-hash.GetCreateTime", action.blocktime-hash.GetCreateTime())
 		return nil, pty.ErrTime
 	}
 
 	//different with typedef in C
 	h := &DB{*hash}
-	receipt, errR := action.coinsAccount.ExecActive(h.ReturnAddress, action.execaddr, h.Amount)
+	accountDB, err := action.assetAccount(hash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, errR := accountDB.ExecActive(h.ReturnAddress, action.execaddr, h.Amount)
 	if errR != nil {
 		hlog.Error("ExecActive error", "ReturnAddress", h.ReturnAddress, "execaddr", action.execaddr, "amount", h.Amount)
 		return nil, errR
@@ -175,7 +201,7 @@ func (action *Action) Hashlocksend(send *pty.HashlockSend) (*types.Receipt, erro
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	hash, err := readHashlock(action.db, common.Sha256(send.Secret))
+	hash, err := action.readHashlockBySecret(send.Secret)
 	if err != nil {
 		hlog.Error("Hashlocksend", "send.Secret", send.Secret)
 		return nil, err
@@ -191,14 +217,23 @@ func (action *Action) Hashlocksend(send *pty.HashlockSend) (*types.Receipt, erro
 		return nil, pty.ErrHashlockSendAddress
 	}
 
-	if action.blocktime-hash.GetCreateTime() > hash.Frozentime {
+	if hash.TimeoutHeight > 0 {
+		if action.height >= hash.TimeoutHeight {
+			hlog.Error("Hashlocksend", "action.height", action.height, "hash.TimeoutHeight", hash.TimeoutHeight)
+			return nil, pty.ErrTime
+		}
+	} else if action.blocktime-hash.GetCreateTime() > hash.Frozentime {
 		hlog.Error("Hashlocksend", "action.blocktime-hash.GetCreateTime", action.blocktime-hash.GetCreateTime())
 		return nil, pty.ErrTime
 	}
 
 	//different with typedef in C
 	h := &DB{*hash}
-	receipt, errR := action.coinsAccount.ExecTransferFrozen(h.ReturnAddress, h.ToAddress, action.execaddr, h.Amount)
+	accountDB, err := action.assetAccount(hash)
+	if err != nil {
+		return nil, err
+	}
+	receipt, errR := accountDB.ExecTransferFrozen(h.ReturnAddress, h.ToAddress, action.execaddr, h.Amount)
 	if errR != nil {
 		hlog.Error("ExecTransferFrozen error", "ReturnAddress", h.ReturnAddress, "ToAddress", h.ToAddress, "execaddr", action.execaddr, "amount", h.Amount)
 		return nil, errR
@@ -214,6 +249,45 @@ func (action *Action) Hashlocksend(send *pty.HashlockSend) (*types.Receipt, erro
 	return receipt, nil
 }
 
+// 锁定的资产账户, 没有指定资产时为主币
+func (action *Action) assetAccount(hash *pty.Hashlock) (*account.DB, error) {
+	if hash.AssetExec == "" {
+		return action.coinsAccount, nil
+	}
+	return account.NewAccountDB(action.api.GetConfig(), hash.AssetExec, hash.AssetSymbol, action.db)
+}
+
+// 按 secret 查找 hashlock, 分叉后依次尝试 sha256 和 keccak256, 且 hash 算法需与锁定时一致
+func (action *Action) readHashlockBySecret(secret []byte) (*pty.Hashlock, error) {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.HashlockX, pty.ForkHashlockAssetX) {
+		return readHashlock(action.db, common.Sha256(secret))
+	}
+	for _, hashType := range []int32{pty.HashTypeSha256, pty.HashTypeKeccak256} {
+		id, _ := pty.HashSecret(hashType, secret)
+		hash, err := readHashlock(action.db, id)
+		if err == types.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hash.HashType == hashType {
+			return hash, nil
+		}
+	}
+	return nil, types.ErrNotFound
+}
+
+// 退回时分叉后可以直接按 hash 查找, 只知道对方 hash 的锁定没有 secret 也能超时退回
+func (action *Action) readHashlockByUnlock(unlock *pty.HashlockUnlock) (*pty.Hashlock, error) {
+	cfg := action.api.GetConfig()
+	if len(unlock.Hash) > 0 && cfg.IsDappFork(action.height, pty.HashlockX, pty.ForkHashlockAssetX) {
+		return readHashlock(action.db, unlock.Hash)
+	}
+	return action.readHashlockBySecret(unlock.Secret)
+}
+
 func readHashlock(db dbm.KV, id []byte) (*pty.Hashlock, error) {
 	data, err := db.Get(Key(id))
 	if err != nil {
@@ -238,6 +312,50 @@ func calcHashlockIDKey(id []byte) []byte {
 	return append([]byte("LODB-hashlock-"), id...)
 }
 
+func calcHashlockAddrPrefix(addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-hashlock-addr:%s:", addr))
+}
+
+func calcHashlockAddrKey(addr string, id []byte) []byte {
+	return append(calcHashlockAddrPrefix(addr), common.ToHex(id)...)
+}
+
+// 按地址索引 hashlock, toAddress 和 returnAddress 都可以查询到
+func hashlockAddrKVs(hlock *pty.HashlockLock, del bool) (kvs []*types.KeyValue) {
+	for _, addr := range []string{hlock.ToAddress, hlock.ReturnAddress} {
+		kv := &types.KeyValue{Key: calcHashlockAddrKey(addr, hlock.Hash), Value: hlock.Hash}
+		if del {
+			kv.Value = nil
+		}
+		kvs = append(kvs, kv)
+		if hlock.ToAddress == hlock.ReturnAddress {
+			break
+		}
+	}
+	return kvs
+}
+
+// 本地记录的 hashlock id, keccak256 锁定时不能直接用 sha256(secret)
+func localHashlockID(db dbm.KVDB, secret []byte) []byte {
+	id := common.Sha256(secret)
+	if _, err := db.Get(calcHashlockIDKey(id)); err == nil {
+		return id
+	}
+	keccak, _ := pty.HashSecret(pty.HashTypeKeccak256, secret)
+	if _, err := db.Get(calcHashlockIDKey(keccak)); err == nil {
+		return keccak
+	}
+	return id
+}
+
+// 退回交易的本地记录 id, 指定 hash 时直接使用
+func localUnlockID(db dbm.KVDB, unlock *pty.HashlockUnlock) []byte {
+	if len(unlock.Hash) > 0 {
+		return unlock.Hash
+	}
+	return localHashlockID(db, unlock.Secret)
+}
+
 // GeHashReciverKV gen KV
 func GeHashReciverKV(hashlockID []byte, information *pty.Hashlockquery) *types.KeyValue {
 	clog.Error("GeHashReciverKV action")
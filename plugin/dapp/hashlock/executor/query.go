@@ -4,7 +4,12 @@
 
 package executor
 
-import "github.com/33cn/chain33/types"
+import (
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/hashlock/types"
+)
+
+const maxQueryCount = 100
 
 // Query_GetHashlocKById get hashlock instance
 func (h *Hashlock) Query_GetHashlocKById(in []byte) (types.Message, error) {
@@ -12,3 +17,34 @@ func (h *Hashlock) Query_GetHashlocKById(in []byte) (types.Message, error) {
 	clog.Error("Query action")
 	return h.GetTxsByHashlockID(in, differTime)
 }
+
+// Query_GetHashlockByHash get hashlock state by hash
+func (h *Hashlock) Query_GetHashlockByHash(in *types.ReqHash) (types.Message, error) {
+	return readHashlock(h.GetStateDB(), in.Hash)
+}
+
+// Query_GetHashlockByAddr list hashlocks of toAddress or returnAddress
+func (h *Hashlock) Query_GetHashlockByAddr(in *pty.ReqHashlockByAddr) (types.Message, error) {
+	count := in.Count
+	if count <= 0 || count > maxQueryCount {
+		count = maxQueryCount
+	}
+	var primaryKey []byte
+	if len(in.PrimaryKey) != 0 {
+		primaryKey = calcHashlockAddrKey(in.Addr, in.PrimaryKey)
+	}
+	values, err := h.GetLocalDB().List(calcHashlockAddrPrefix(in.Addr), primaryKey, count, in.Direction)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pty.ReplyHashlocks{}
+	for _, id := range values {
+		hash, err := readHashlock(h.GetStateDB(), id)
+		if err != nil {
+			clog.Error("Query_GetHashlockByAddr", "id", id, "err", err)
+			return nil, err
+		}
+		reply.Hashlocks = append(reply.Hashlocks, hash)
+	}
+	return reply, nil
+}
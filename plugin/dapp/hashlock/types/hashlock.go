@@ -9,6 +9,7 @@ import (
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto/sha3"
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
 )
@@ -27,6 +28,7 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(HashlockX, "Enable", 0)
 	cfg.RegisterDappFork(HashlockX, ForkBadRepeatSecretX, 2715575)
+	cfg.RegisterDappFork(HashlockX, ForkHashlockAssetX, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -105,6 +107,18 @@ func (hashlock *HashlockType) GetLogMap() map[int64]*types.LogInfo {
 	return map[int64]*types.LogInfo{}
 }
 
+// HashSecret 按 hashType 计算 secret 的 hash
+func HashSecret(hashType int32, secret []byte) ([]byte, error) {
+	switch hashType {
+	case HashTypeSha256:
+		return common.Sha256(secret), nil
+	case HashTypeKeccak256:
+		hash := sha3.KeccakSum256(secret)
+		return hash[:], nil
+	}
+	return nil, ErrHashlockHashType
+}
+
 // CreateRawHashlockLockTx method
 func CreateRawHashlockLockTx(cfg *types.Chain33Config, parm *HashlockLockTx) (*types.Transaction, error) {
 	if parm == nil {
@@ -112,12 +126,28 @@ func CreateRawHashlockLockTx(cfg *types.Chain33Config, parm *HashlockLockTx) (*t
 		return nil, types.ErrInvalidParam
 	}
 
+	// 只知道对方 hash 时直接使用 hash 锁定
+	var hash []byte
+	var err error
+	if parm.Hash != "" {
+		hash, err = common.FromHex(parm.Hash)
+	} else {
+		hash, err = HashSecret(parm.HashType, []byte(parm.Secret))
+	}
+	if err != nil {
+		hlog.Error("CreateRawHashlockLockTx", "hash", parm.Hash, "err", err)
+		return nil, types.ErrInvalidParam
+	}
 	v := &HashlockLock{
 		Amount:        parm.Amount,
 		Time:          parm.Time,
-		Hash:          common.Sha256([]byte(parm.Secret)),
+		Hash:          hash,
 		ToAddress:     parm.ToAddr,
 		ReturnAddress: parm.ReturnAddr,
+		AssetExec:     parm.AssetExec,
+		AssetSymbol:   parm.AssetSymbol,
+		HashType:      parm.HashType,
+		TimeoutHeight: parm.TimeoutHeight,
 	}
 	lock := &HashlockAction{
 		Ty:    HashlockActionLock,
@@ -129,7 +159,7 @@ func CreateRawHashlockLockTx(cfg *types.Chain33Config, parm *HashlockLockTx) (*t
 		Fee:     parm.Fee,
 		To:      address.ExecAddress(cfg.ExecName(HashlockX)),
 	}
-	tx, err := types.FormatTx(cfg, cfg.ExecName(HashlockX), tx)
+	tx, err = types.FormatTx(cfg, cfg.ExecName(HashlockX), tx)
 	if err != nil {
 		return nil, err
 	}
@@ -143,8 +173,19 @@ func CreateRawHashlockUnlockTx(cfg *types.Chain33Config, parm *HashlockUnlockTx)
 		return nil, types.ErrInvalidParam
 	}
 
+	// 没有 secret 时按 hash 退回
+	var hash []byte
+	if parm.Hash != "" {
+		var err error
+		hash, err = common.FromHex(parm.Hash)
+		if err != nil {
+			hlog.Error("CreateRawHashlockUnlockTx", "hash", parm.Hash, "err", err)
+			return nil, types.ErrInvalidParam
+		}
+	}
 	v := &HashlockUnlock{
 		Secret: []byte(parm.Secret),
+		Hash:   hash,
 	}
 	unlock := &HashlockAction{
 		Ty:    HashlockActionUnlock,
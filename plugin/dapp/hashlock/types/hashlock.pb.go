@@ -29,6 +29,11 @@ type Hashlock struct {
 	ReturnAddress        string   `protobuf:"bytes,5,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
 	Amount               int64    `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	Frozentime           int64    `protobuf:"varint,7,opt,name=frozentime,proto3" json:"frozentime,omitempty"`
+	AssetExec            string   `protobuf:"bytes,8,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol          string   `protobuf:"bytes,9,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	HashType             int32    `protobuf:"varint,10,opt,name=hashType,proto3" json:"hashType,omitempty"`
+	TimeoutHeight        int64    `protobuf:"varint,11,opt,name=timeoutHeight,proto3" json:"timeoutHeight,omitempty"`
+	CreateHeight         int64    `protobuf:"varint,12,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -108,12 +113,54 @@ func (m *Hashlock) GetFrozentime() int64 {
 	return 0
 }
 
+func (m *Hashlock) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *Hashlock) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *Hashlock) GetHashType() int32 {
+	if m != nil {
+		return m.HashType
+	}
+	return 0
+}
+
+func (m *Hashlock) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func (m *Hashlock) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
 type HashlockLock struct {
-	Amount               int64    `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
-	Hash                 []byte   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
-	ToAddress            string   `protobuf:"bytes,4,opt,name=toAddress,proto3" json:"toAddress,omitempty"`
-	ReturnAddress        string   `protobuf:"bytes,5,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	Amount        int64  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Time          int64  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Hash          []byte `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	ToAddress     string `protobuf:"bytes,4,opt,name=toAddress,proto3" json:"toAddress,omitempty"`
+	ReturnAddress string `protobuf:"bytes,5,opt,name=returnAddress,proto3" json:"returnAddress,omitempty"`
+	// 锁定的资产, 为空时为主币
+	AssetExec   string `protobuf:"bytes,6,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol string `protobuf:"bytes,7,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	// hash 算法, 0: sha256, 1: keccak256
+	HashType int32 `protobuf:"varint,8,opt,name=hashType,proto3" json:"hashType,omitempty"`
+	// 大于0时按区块高度计算超时, 替代 time
+	TimeoutHeight        int64    `protobuf:"varint,9,opt,name=timeoutHeight,proto3" json:"timeoutHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -179,6 +226,34 @@ func (m *HashlockLock) GetReturnAddress() string {
 	return ""
 }
 
+func (m *HashlockLock) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *HashlockLock) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *HashlockLock) GetHashType() int32 {
+	if m != nil {
+		return m.HashType
+	}
+	return 0
+}
+
+func (m *HashlockLock) GetTimeoutHeight() int64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
 type HashlockSend struct {
 	Secret               []byte   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -337,7 +412,9 @@ func (m *HashRecv) GetInformation() *Hashlockquery {
 }
 
 type HashlockUnlock struct {
-	Secret               []byte   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Secret []byte `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// 锁定时只知道对方的 hash, 超时后按 hash 退回, 不需要 secret
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -375,6 +452,13 @@ func (m *HashlockUnlock) GetSecret() []byte {
 	return nil
 }
 
+func (m *HashlockUnlock) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // message for hashlock
 type HashlockAction struct {
 	// Types that are valid to be assigned to Value:
@@ -479,6 +563,109 @@ func (*HashlockAction) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// 按地址查询 hashlock, 包括作为 toAddress 和 returnAddress 的锁定
+type ReqHashlockByAddr struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	PrimaryKey           []byte   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqHashlockByAddr) Reset()         { *m = ReqHashlockByAddr{} }
+func (m *ReqHashlockByAddr) String() string { return proto.CompactTextString(m) }
+func (*ReqHashlockByAddr) ProtoMessage()    {}
+func (*ReqHashlockByAddr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acb83e90536b5ff8, []int{7}
+}
+
+func (m *ReqHashlockByAddr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqHashlockByAddr.Unmarshal(m, b)
+}
+func (m *ReqHashlockByAddr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqHashlockByAddr.Marshal(b, m, deterministic)
+}
+func (m *ReqHashlockByAddr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqHashlockByAddr.Merge(m, src)
+}
+func (m *ReqHashlockByAddr) XXX_Size() int {
+	return xxx_messageInfo_ReqHashlockByAddr.Size(m)
+}
+func (m *ReqHashlockByAddr) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqHashlockByAddr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqHashlockByAddr proto.InternalMessageInfo
+
+func (m *ReqHashlockByAddr) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqHashlockByAddr) GetPrimaryKey() []byte {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return nil
+}
+
+func (m *ReqHashlockByAddr) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqHashlockByAddr) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyHashlocks struct {
+	Hashlocks            []*Hashlock `protobuf:"bytes,1,rep,name=hashlocks,proto3" json:"hashlocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReplyHashlocks) Reset()         { *m = ReplyHashlocks{} }
+func (m *ReplyHashlocks) String() string { return proto.CompactTextString(m) }
+func (*ReplyHashlocks) ProtoMessage()    {}
+func (*ReplyHashlocks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acb83e90536b5ff8, []int{8}
+}
+
+func (m *ReplyHashlocks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyHashlocks.Unmarshal(m, b)
+}
+func (m *ReplyHashlocks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyHashlocks.Marshal(b, m, deterministic)
+}
+func (m *ReplyHashlocks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyHashlocks.Merge(m, src)
+}
+func (m *ReplyHashlocks) XXX_Size() int {
+	return xxx_messageInfo_ReplyHashlocks.Size(m)
+}
+func (m *ReplyHashlocks) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyHashlocks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyHashlocks proto.InternalMessageInfo
+
+func (m *ReplyHashlocks) GetHashlocks() []*Hashlock {
+	if m != nil {
+		return m.Hashlocks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Hashlock)(nil), "types.Hashlock")
 	proto.RegisterType((*HashlockLock)(nil), "types.HashlockLock")
@@ -487,6 +674,8 @@ func init() {
 	proto.RegisterType((*HashRecv)(nil), "types.HashRecv")
 	proto.RegisterType((*HashlockUnlock)(nil), "types.HashlockUnlock")
 	proto.RegisterType((*HashlockAction)(nil), "types.HashlockAction")
+	proto.RegisterType((*ReqHashlockByAddr)(nil), "types.ReqHashlockByAddr")
+	proto.RegisterType((*ReplyHashlocks)(nil), "types.ReplyHashlocks")
 }
 
 func init() {
@@ -494,32 +683,42 @@ func init() {
 }
 
 var fileDescriptor_acb83e90536b5ff8 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6a, 0xdb, 0x40,
-	0x10, 0xc7, 0xbd, 0xb2, 0x65, 0xd7, 0x23, 0xdb, 0x87, 0xed, 0x07, 0x3a, 0x94, 0x22, 0x44, 0x29,
-	0x82, 0x82, 0xa1, 0x2e, 0xf4, 0xee, 0xf6, 0x22, 0x43, 0x4f, 0x9b, 0xe4, 0x01, 0x64, 0x69, 0x8d,
-	0x4d, 0xec, 0x5d, 0x67, 0xb5, 0x32, 0x28, 0x8f, 0x11, 0xc8, 0xab, 0xe4, 0x71, 0xf2, 0x2c, 0x61,
-	0x47, 0x52, 0x56, 0x56, 0x92, 0x5b, 0x6e, 0x9a, 0xbf, 0x7e, 0x9a, 0x8f, 0xff, 0x8c, 0x60, 0xb6,
-	0x4d, 0xf2, 0xed, 0x5e, 0xa6, 0xd7, 0xf3, 0xa3, 0x92, 0x5a, 0x52, 0x57, 0x97, 0x47, 0x9e, 0x87,
-	0x8f, 0x04, 0x3e, 0xc4, 0xf5, 0x1b, 0xfa, 0x0d, 0xa0, 0xa1, 0x56, 0x99, 0x4f, 0x02, 0x12, 0x4d,
-	0x58, 0x4b, 0xa1, 0x5f, 0x60, 0x98, 0xeb, 0x44, 0x17, 0xb9, 0xef, 0x04, 0x24, 0x72, 0x59, 0x1d,
-	0x99, 0xef, 0xfe, 0x29, 0x9e, 0x68, 0x7e, 0xb9, 0x3b, 0x70, 0xbf, 0x1f, 0x90, 0xa8, 0xcf, 0x5a,
-	0x0a, 0xfd, 0x0a, 0x63, 0x2d, 0x97, 0x59, 0xa6, 0x78, 0x9e, 0xfb, 0x83, 0x80, 0x44, 0x63, 0x66,
-	0x05, 0xfa, 0x1d, 0xa6, 0x8a, 0xeb, 0x42, 0x89, 0x86, 0x70, 0x91, 0x38, 0x17, 0x4d, 0xed, 0xe4,
-	0x20, 0x0b, 0xa1, 0xfd, 0x21, 0xe6, 0xaf, 0x23, 0x53, 0x7b, 0xa3, 0xe4, 0x2d, 0x17, 0xda, 0xd4,
-	0x1e, 0x55, 0xb5, 0xad, 0x12, 0xde, 0x11, 0x98, 0x34, 0x03, 0xfe, 0x37, 0x43, 0xda, 0x44, 0xe4,
-	0x2c, 0x11, 0x85, 0x01, 0xa6, 0x70, 0x50, 0xc5, 0x67, 0xa3, 0x99, 0xf1, 0x71, 0xa4, 0x09, 0xc3,
-	0xe7, 0xf7, 0x18, 0x26, 0xfc, 0x61, 0x7b, 0xba, 0xe0, 0xa2, 0x32, 0x96, 0xa7, 0x8a, 0xeb, 0xda,
-	0xf4, 0x3a, 0x0a, 0xef, 0x09, 0x4c, 0x1b, 0xf0, 0xa6, 0xe0, 0xaa, 0x7c, 0xee, 0x92, 0xb4, 0xba,
-	0x7c, 0x6b, 0x2d, 0x76, 0xd2, 0x7e, 0xd7, 0xb2, 0xd4, 0xae, 0x6b, 0x50, 0x59, 0x66, 0x15, 0x1a,
-	0x80, 0x97, 0x16, 0x4a, 0x71, 0xa1, 0x11, 0x70, 0x11, 0x68, 0x4b, 0xe1, 0xba, 0x3a, 0x1a, 0xc6,
-	0xd3, 0x93, 0xc9, 0x16, 0xbf, 0x38, 0x1a, 0xab, 0xd0, 0x3f, 0xe0, 0xad, 0xc4, 0x46, 0xaa, 0x43,
-	0xa2, 0x77, 0x52, 0x60, 0x8b, 0xde, 0xe2, 0xd3, 0x1c, 0xcf, 0x6f, 0x7e, 0x36, 0x1c, 0x6b, 0x83,
-	0x61, 0x04, 0xb3, 0xe6, 0xed, 0x95, 0xd8, 0xd7, 0x9b, 0x7b, 0xd5, 0xa5, 0x07, 0x62, 0xd1, 0x65,
-	0x6a, 0x3e, 0xa6, 0x3f, 0xc1, 0xc5, 0x10, 0x49, 0x6f, 0xf1, 0xb1, 0x53, 0xce, 0x1c, 0x42, 0xdc,
-	0x63, 0x15, 0x83, 0x70, 0xce, 0x45, 0x56, 0xf7, 0xd6, 0x85, 0xcd, 0x86, 0x10, 0x36, 0x0c, 0xfd,
-	0x05, 0xa3, 0x6d, 0x81, 0xfd, 0xa0, 0xab, 0xde, 0xe2, 0x73, 0x07, 0xaf, 0x9a, 0x8d, 0x7b, 0xac,
-	0xe1, 0xe8, 0x0c, 0x1c, 0x5d, 0xa2, 0xcf, 0x2e, 0x73, 0x74, 0xf9, 0x77, 0x04, 0xee, 0x29, 0xd9,
-	0x17, 0x7c, 0x3d, 0xc4, 0x5f, 0xf1, 0xf7, 0x53, 0x00, 0x00, 0x00, 0xff, 0xff, 0x38, 0xf6, 0x91,
-	0xc1, 0x9c, 0x03, 0x00, 0x00,
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xd1, 0x8a, 0xd3, 0x40,
+	0x14, 0xdd, 0xa4, 0x9b, 0xb6, 0xb9, 0xe9, 0x56, 0x1c, 0x57, 0x19, 0x44, 0x24, 0x04, 0x91, 0x82,
+	0xb8, 0x60, 0x05, 0x9f, 0x04, 0xd9, 0x15, 0xa1, 0x8b, 0x3e, 0xcd, 0xae, 0x1f, 0x90, 0x26, 0x77,
+	0x6d, 0xb1, 0x4d, 0xba, 0x33, 0x93, 0xc5, 0xe8, 0xab, 0x9f, 0xa0, 0xbf, 0xe2, 0xf7, 0xc9, 0xdc,
+	0x24, 0x9d, 0xa4, 0x56, 0xf7, 0xc5, 0xb7, 0xdc, 0x33, 0x67, 0xe6, 0xdc, 0x7b, 0xe6, 0x4c, 0x60,
+	0xbc, 0x88, 0xd5, 0x62, 0x95, 0x27, 0x9f, 0x4f, 0x36, 0x32, 0xd7, 0x39, 0xf3, 0x74, 0xb9, 0x41,
+	0x15, 0x7d, 0xef, 0xc1, 0x70, 0x56, 0xaf, 0xb0, 0xc7, 0x00, 0x0d, 0xeb, 0x3c, 0xe5, 0x4e, 0xe8,
+	0x4c, 0x46, 0xa2, 0x85, 0xb0, 0x07, 0xd0, 0x57, 0x3a, 0xd6, 0x85, 0xe2, 0x6e, 0xe8, 0x4c, 0x3c,
+	0x51, 0x57, 0x66, 0xdf, 0x5b, 0x89, 0xb1, 0xc6, 0xcb, 0xe5, 0x1a, 0x79, 0x2f, 0x74, 0x26, 0x3d,
+	0xd1, 0x42, 0xd8, 0x23, 0xf0, 0x75, 0x7e, 0x9a, 0xa6, 0x12, 0x95, 0xe2, 0x87, 0xa1, 0x33, 0xf1,
+	0x85, 0x05, 0xd8, 0x13, 0x38, 0x92, 0xa8, 0x0b, 0x99, 0x35, 0x0c, 0x8f, 0x18, 0x5d, 0xd0, 0x68,
+	0xc7, 0xeb, 0xbc, 0xc8, 0x34, 0xef, 0xd3, 0xf9, 0x75, 0x65, 0xb4, 0xaf, 0x64, 0xfe, 0x15, 0x33,
+	0x6d, 0xb4, 0x07, 0x95, 0xb6, 0x45, 0x8c, 0x76, 0xac, 0x14, 0xea, 0x77, 0x5f, 0x30, 0xe1, 0xc3,
+	0x4a, 0x7b, 0x0b, 0xb0, 0x10, 0x02, 0x2a, 0x2e, 0xca, 0xf5, 0x3c, 0x5f, 0x71, 0x9f, 0xd6, 0xdb,
+	0x10, 0x7b, 0x08, 0x43, 0xe3, 0xc0, 0x65, 0xb9, 0x41, 0x0e, 0x34, 0xf5, 0xb6, 0x36, 0x9d, 0x1b,
+	0x8d, 0xbc, 0xd0, 0x33, 0x5c, 0x7e, 0x5a, 0x68, 0x1e, 0x90, 0x7c, 0x17, 0x64, 0x11, 0x8c, 0x12,
+	0xf2, 0xa2, 0x26, 0x8d, 0x88, 0xd4, 0xc1, 0xa2, 0x1f, 0x2e, 0x8c, 0x9a, 0x6b, 0xf8, 0x60, 0xae,
+	0xc2, 0x8e, 0xeb, 0x74, 0xc6, 0x65, 0x70, 0x48, 0x83, 0xba, 0x84, 0xd2, 0xb7, 0xc1, 0x4c, 0x4b,
+	0x64, 0xfc, 0x48, 0xd0, 0xf7, 0x7f, 0xb1, 0xbc, 0x63, 0x5d, 0xff, 0x16, 0xeb, 0x06, 0xff, 0xb6,
+	0x6e, 0x78, 0x9b, 0x75, 0xfe, 0x1e, 0xeb, 0xa2, 0xa7, 0xd6, 0x95, 0x0b, 0xcc, 0xaa, 0x00, 0x62,
+	0x22, 0x51, 0xd7, 0xe1, 0xac, 0xab, 0xe8, 0xa7, 0x03, 0x47, 0x0d, 0xf1, 0xba, 0x40, 0x59, 0x6e,
+	0x7d, 0x72, 0x5a, 0x3e, 0xfd, 0x2d, 0xbe, 0xd6, 0xeb, 0xde, 0x6e, 0xb4, 0x12, 0x1b, 0xeb, 0x43,
+	0x5a, 0x6b, 0x21, 0xc6, 0x81, 0xa4, 0x90, 0x12, 0x33, 0x4d, 0x04, 0x8f, 0x08, 0x6d, 0x28, 0x9a,
+	0x57, 0x8f, 0x4b, 0x60, 0x72, 0x63, 0x4e, 0x9b, 0xfd, 0xf1, 0xb8, 0x2c, 0xc2, 0x5e, 0x41, 0x70,
+	0x9e, 0x5d, 0xe5, 0x72, 0x1d, 0xeb, 0x65, 0x9e, 0x51, 0x8b, 0xc1, 0xf4, 0xf8, 0x84, 0x9e, 0xe9,
+	0x49, 0x67, 0x38, 0xd1, 0x26, 0x46, 0xaf, 0x61, 0xdc, 0xac, 0x7e, 0xcc, 0x56, 0x75, 0x76, 0xf6,
+	0xb9, 0xb4, 0xcd, 0x89, 0x6b, 0x73, 0x12, 0xfd, 0x72, 0xec, 0xf6, 0xd3, 0xc4, 0x1c, 0xc8, 0x9e,
+	0x81, 0x47, 0x25, 0xed, 0x0e, 0xa6, 0xf7, 0x76, 0x5a, 0x30, 0xf1, 0x9c, 0x1d, 0x88, 0x8a, 0x43,
+	0x64, 0x85, 0x59, 0xca, 0xdd, 0xbd, 0x64, 0x73, 0x6b, 0x44, 0x36, 0x1c, 0xf6, 0x02, 0x06, 0x8b,
+	0x82, 0x7a, 0x24, 0xa7, 0x83, 0xe9, 0xfd, 0x1d, 0x7a, 0x35, 0xc0, 0xec, 0x40, 0x34, 0x3c, 0x36,
+	0x06, 0x57, 0x97, 0xe4, 0xbd, 0x27, 0x5c, 0x5d, 0x9e, 0x0d, 0xc0, 0xbb, 0x89, 0x57, 0x05, 0x46,
+	0xdf, 0xe0, 0xae, 0xc0, 0xeb, 0x66, 0xe3, 0x59, 0x69, 0x42, 0x6b, 0x26, 0x8c, 0xd3, 0x54, 0x52,
+	0xe7, 0xbe, 0xa0, 0x6f, 0xe3, 0xfb, 0x46, 0x2e, 0xd7, 0xb1, 0x2c, 0xdf, 0x63, 0x59, 0xcf, 0xde,
+	0x42, 0xd8, 0x31, 0x78, 0xc9, 0xf6, 0xf2, 0x3d, 0x51, 0x15, 0x26, 0xfb, 0xe9, 0x52, 0x22, 0x39,
+	0x52, 0xcb, 0x5b, 0x20, 0x7a, 0x03, 0x63, 0x81, 0x9b, 0x55, 0xd9, 0xc8, 0x2b, 0xf6, 0x1c, 0xfc,
+	0xe6, 0x47, 0xa9, 0xb8, 0x13, 0xf6, 0x26, 0xc1, 0xf4, 0xce, 0xce, 0x70, 0xc2, 0x32, 0xe6, 0x7d,
+	0xfa, 0x09, 0xbf, 0xfc, 0x3d, 0x00, 0x42, 0x41, 0xaf, 0x69, 0x96, 0x05, 0x00, 0x00,
 }
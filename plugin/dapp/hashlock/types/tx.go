@@ -6,17 +6,23 @@ package types
 
 // HashlockLockTx for construction
 type HashlockLockTx struct {
-	Secret     string `json:"secret"`
-	Amount     int64  `json:"amount"`
-	Time       int64  `json:"time"`
-	ToAddr     string `json:"toAddr"`
-	ReturnAddr string `json:"returnAddr"`
-	Fee        int64  `json:"fee"`
+	Secret        string `json:"secret"`
+	Hash          string `json:"hash"`
+	Amount        int64  `json:"amount"`
+	Time          int64  `json:"time"`
+	ToAddr        string `json:"toAddr"`
+	ReturnAddr    string `json:"returnAddr"`
+	Fee           int64  `json:"fee"`
+	AssetExec     string `json:"assetExec"`
+	AssetSymbol   string `json:"assetSymbol"`
+	HashType      int32  `json:"hashType"`
+	TimeoutHeight int64  `json:"timeoutHeight"`
 }
 
 // HashlockUnlockTx for construction
 type HashlockUnlockTx struct {
 	Secret string `json:"secret"`
+	Hash   string `json:"hash"`
 	Fee    int64  `json:"fee"`
 }
 
@@ -15,4 +15,12 @@ const (
 var (
 	HashlockX            = "hashlock"
 	ForkBadRepeatSecretX = "ForkBadRepeatSecret"
+	// ForkHashlockAssetX 支持锁定 token 资产, keccak256 和按高度超时
+	ForkHashlockAssetX = "ForkHashlockAsset"
+)
+
+// hash type of hashlock
+const (
+	HashTypeSha256    = 0
+	HashTypeKeccak256 = 1
 )
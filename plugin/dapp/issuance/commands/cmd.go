@@ -251,7 +251,9 @@ func IssuanceManageRawTxCmd() *cobra.Command {
 
 func addIssuanceManageFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("addr", "a", "", "addr")
-	cmd.MarkFlagRequired("addr")
+	cmd.Flags().Int32P("minFeeders", "m", 0, "min feeders of a price feed round, at least 3")
+	cmd.Flags().Float64P("maxDeviation", "x", 0, "max deviation from last accepted price, 0 means no limit")
+	cmd.Flags().Int64P("twapWindow", "w", 0, "time weighted average price window in seconds")
 }
 
 //IssuanceManage ...
@@ -264,11 +266,19 @@ func IssuanceManage(cmd *cobra.Command, args []string) {
 
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	addr, _ := cmd.Flags().GetString("addr")
+	minFeeders, _ := cmd.Flags().GetInt32("minFeeders")
+	maxDeviation, _ := cmd.Flags().GetFloat64("maxDeviation")
+	twapWindow, _ := cmd.Flags().GetInt64("twapWindow")
 
+	addrs := "[]"
+	if addr != "" {
+		addrs = fmt.Sprintf("[\"%s\"]", addr)
+	}
 	params := &rpctypes.CreateTxIn{
 		Execer:     cfg.ExecName(pkt.IssuanceX),
 		ActionName: "IssuanceManage",
-		Payload:    []byte(fmt.Sprintf("{\"addr\":[\"%s\"]}", addr)),
+		Payload: []byte(fmt.Sprintf("{\"addr\":%s, \"minFeeders\":%d, \"maxDeviation\":%f, \"twapWindow\":%d}",
+			addrs, minFeeders, maxDeviation, twapWindow)),
 	}
 
 	var res string
@@ -344,6 +354,7 @@ func IssuanceQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		IssuacneQueryPriceCmd(),
 		IssuanceQueryUserBalanceCmd(),
+		IssuanceQueryFeedRoundCmd(),
 	)
 	return cmd
 }
@@ -450,3 +461,40 @@ func IssuanceQuery(cmd *cobra.Command, args []string) {
 		cmd.Help()
 	}
 }
+
+//IssuanceQueryFeedRoundCmd ...
+func IssuanceQueryFeedRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round",
+		Short: "Query price feed rounds",
+		Run:   IssuanceQueryFeedRound,
+	}
+	cmd.Flags().Int64P("roundID", "r", 0, "round ID, 0 means the latest round")
+	cmd.Flags().Int32P("count", "n", 0, "list rounds from roundID in descending order")
+	return cmd
+}
+
+//IssuanceQueryFeedRound ...
+func IssuanceQueryFeedRound(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	roundID, _ := cmd.Flags().GetInt64("roundID")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = pkt.IssuanceX
+
+	if count == 0 {
+		params.FuncName = "IssuanceFeedRound"
+		params.Payload = types.MustPBToJSON(&pkt.ReqIssuanceFeedRound{RoundId: roundID})
+		var res pkt.IssuanceFeedRound
+		ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+		return
+	}
+
+	params.FuncName = "IssuanceFeedRounds"
+	params.Payload = types.MustPBToJSON(&pkt.ReqIssuanceFeedRounds{RoundId: roundID, Count: count})
+	var res pkt.RepIssuanceFeedRounds
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
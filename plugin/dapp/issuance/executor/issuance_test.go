@@ -65,6 +65,8 @@ func initEnv() *execEnv {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	cfg.SetTitleOnlyForTest("chain33")
 	cfg.RegisterDappFork(pkt.IssuanceX, pkt.ForkIssuanceTableUpdate, 0)
+	// 单个喂价地址直接喂价, 喂价轮次见 pricefeed_test.go
+	cfg.RegisterDappFork(pkt.IssuanceX, pkt.ForkIssuancePriceRound, types.MaxHeight)
 	Init(pkt.IssuanceX, cfg, nil)
 	_, ldb, kvdb := util.CreateTestDB()
 
@@ -300,6 +300,15 @@ func (action *Action) IssuanceManage(manage *pty.IssuanceManage) (*types.Receipt
 		return nil, pty.ErrPermissionDeny
 	}
 
+	// 配置喂价参数
+	if manage.FeedConfig != nil {
+		cfg := action.Issuance.GetAPI().GetConfig()
+		if !cfg.IsDappFork(action.height, pty.IssuanceX, pty.ForkIssuancePriceRound) {
+			return nil, types.ErrNotAllow
+		}
+		return action.issuanceFeedConfig(manage.FeedConfig)
+	}
+
 	// 添加大户地址
 	var item types.ConfigItem
 	data, err := action.db.Get(AddrKey())
@@ -903,6 +912,24 @@ func (action *Action) IssuanceFeed(feed *pty.IssuanceFeed) (*types.Receipt, erro
 		return nil, pty.ErrPriceInvalid
 	}
 
+	// 分叉后多个喂价地址按轮次喂价，使用时间加权平均价格判断清算
+	liquidationPrice := price
+	cfg := action.Issuance.GetAPI().GetConfig()
+	isPriceRound := cfg.IsDappFork(action.height, pty.IssuanceX, pty.ForkIssuancePriceRound)
+	if isPriceRound {
+		accepted, median, twap, receipt, err := action.feedRound(price)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+		if !accepted {
+			return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+		}
+		price = median
+		liquidationPrice = twap
+	}
+
 	ids, err := queryIssuanceByStatus(action.localDB, pty.IssuanceStatusCreated, "")
 	if err != nil {
 		clog.Debug("IssuancePriceFeed", "get issuance record error", err)
@@ -927,7 +954,7 @@ func (action *Action) IssuanceFeed(feed *pty.IssuanceFeed) (*types.Receipt, erro
 		}
 
 		// 系统清算判断
-		receipt, err := action.systemLiquidation(issu, price)
+		receipt, err := action.systemLiquidation(issu, liquidationPrice)
 		if err != nil {
 			clog.Error("IssuancePriceFeed", "Issuance ID", issu.IssuanceId, "system liquidation error", err)
 			continue
@@ -939,6 +966,9 @@ func (action *Action) IssuanceFeed(feed *pty.IssuanceFeed) (*types.Receipt, erro
 	var priceRecord pty.IssuanceAssetPriceRecord
 	priceRecord.BtyPrice = price
 	priceRecord.RecordTime = action.blocktime
+	if isPriceRound {
+		priceRecord.Twap = liquidationPrice
+	}
 
 	// 最近喂价记录
 	pricekv := &types.KeyValue{Key: PriceKey(), Value: types.Encode(&priceRecord)}
@@ -0,0 +1,221 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/issuance/types"
+	"github.com/33cn/plugin/plugin/dapp/pricefeed"
+)
+
+// FeedConfigKey Key for IssuanceFeedConfig
+func FeedConfigKey() (key []byte) {
+	key = append(key, []byte("mavl-"+pty.IssuanceX+"-feed-config")...)
+	return key
+}
+
+// RoundIDKey Key for 最新喂价轮次ID
+func RoundIDKey() (key []byte) {
+	key = append(key, []byte("mavl-"+pty.IssuanceX+"-round")...)
+	return key
+}
+
+// RoundKey Key for IssuanceFeedRound
+func RoundKey(roundID int64) (key []byte) {
+	key = append(key, []byte(fmt.Sprintf("mavl-%s-round-%020d", pty.IssuanceX, roundID))...)
+	return key
+}
+
+// PriceHistoryKey Key for IssuancePriceHistory
+func PriceHistoryKey() (key []byte) {
+	key = append(key, []byte("mavl-"+pty.IssuanceX+"-history")...)
+	return key
+}
+
+func getFeedConfig(db dbm.KV) *pty.IssuanceFeedConfig {
+	feedCfg := &pty.IssuanceFeedConfig{MinFeeders: pricefeed.MinFeeders}
+	data, err := db.Get(FeedConfigKey())
+	if err != nil {
+		return feedCfg
+	}
+	err = types.Decode(data, feedCfg)
+	if err != nil {
+		clog.Error("getFeedConfig", "decode", err)
+	}
+	if feedCfg.MinFeeders < pricefeed.MinFeeders {
+		feedCfg.MinFeeders = pricefeed.MinFeeders
+	}
+	return feedCfg
+}
+
+// 设置喂价配置
+func (action *Action) issuanceFeedConfig(feedCfg *pty.IssuanceFeedConfig) (*types.Receipt, error) {
+	if !pricefeed.IsValidConfig(&pricefeed.Config{MinFeeders: feedCfg.MinFeeders, MaxDeviation: feedCfg.MaxDeviation, TwapWindow: feedCfg.TwapWindow}) {
+		return nil, pty.ErrRiskParam
+	}
+
+	value := types.Encode(feedCfg)
+	action.db.Set(FeedConfigKey(), value)
+	kv := []*types.KeyValue{{Key: FeedConfigKey(), Value: value}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: nil}, nil
+}
+
+// 获取最近的时间加权平均价格，没有喂价轮次时为0
+func getLatestTwap(db dbm.KV) int64 {
+	data, err := db.Get(PriceKey())
+	if err != nil {
+		return 0
+	}
+	var price pty.IssuanceAssetPriceRecord
+	err = types.Decode(data, &price)
+	if err != nil {
+		return 0
+	}
+	return price.Twap
+}
+
+func getLatestRoundID(db dbm.KV) int64 {
+	data, err := db.Get(RoundIDKey())
+	if err != nil {
+		return 0
+	}
+	var id types.Int64
+	err = types.Decode(data, &id)
+	if err != nil {
+		clog.Error("getLatestRoundID", "decode", err)
+		return 0
+	}
+	return id.Data
+}
+
+// feedStore 按 issuance 的key和结构保存喂价轮次
+type feedStore struct {
+	db dbm.KV
+}
+
+func (s *feedStore) GetLatestRoundID() int64 {
+	return getLatestRoundID(s.db)
+}
+
+func (s *feedStore) SetLatestRoundID(roundID int64) *types.KeyValue {
+	value := types.Encode(&types.Int64{Data: roundID})
+	s.db.Set(RoundIDKey(), value)
+	return &types.KeyValue{Key: RoundIDKey(), Value: value}
+}
+
+func queryFeedRound(db dbm.KV, roundID int64) (*pty.IssuanceFeedRound, error) {
+	data, err := db.Get(RoundKey(roundID))
+	if err != nil {
+		clog.Debug("queryFeedRound", "roundID", roundID, "error", err)
+		return nil, err
+	}
+
+	var round pty.IssuanceFeedRound
+	err = types.Decode(data, &round)
+	if err != nil {
+		clog.Debug("queryFeedRound", "decode", err)
+		return nil, err
+	}
+	return &round, nil
+}
+
+func (s *feedStore) GetRound(roundID int64) (*pricefeed.Round, error) {
+	round, err := queryFeedRound(s.db, roundID)
+	if err != nil {
+		return nil, err
+	}
+	return &pricefeed.Round{
+		RoundID:   round.RoundId,
+		Feeders:   round.Feeders,
+		Prices:    round.Prices,
+		StartTime: round.StartTime,
+		CloseTime: round.CloseTime,
+		Median:    round.Median,
+		Twap:      round.Twap,
+		Status:    round.Status,
+	}, nil
+}
+
+func (s *feedStore) SetRound(round *pricefeed.Round) (*types.KeyValue, *types.ReceiptLog) {
+	value := types.Encode(&pty.IssuanceFeedRound{
+		RoundId:   round.RoundID,
+		Feeders:   round.Feeders,
+		Prices:    round.Prices,
+		StartTime: round.StartTime,
+		CloseTime: round.CloseTime,
+		Median:    round.Median,
+		Twap:      round.Twap,
+		Status:    round.Status,
+	})
+	s.db.Set(RoundKey(round.RoundID), value)
+	return &types.KeyValue{Key: RoundKey(round.RoundID), Value: value}, &types.ReceiptLog{Ty: pty.TyLogIssuanceRound, Log: value}
+}
+
+func (s *feedStore) GetHistory() []*pricefeed.Point {
+	var history pty.IssuancePriceHistory
+	data, err := s.db.Get(PriceHistoryKey())
+	if err != nil {
+		return nil
+	}
+	err = types.Decode(data, &history)
+	if err != nil {
+		clog.Error("getPriceHistory", "decode", err)
+	}
+	points := make([]*pricefeed.Point, 0, len(history.Points))
+	for _, point := range history.Points {
+		points = append(points, &pricefeed.Point{Price: point.Price, Time: point.Time})
+	}
+	return points
+}
+
+func (s *feedStore) SetHistory(points []*pricefeed.Point) *types.KeyValue {
+	var history pty.IssuancePriceHistory
+	for _, point := range points {
+		history.Points = append(history.Points, &pty.IssuancePricePoint{Price: point.Price, Time: point.Time})
+	}
+	value := types.Encode(&history)
+	s.db.Set(PriceHistoryKey(), value)
+	return &types.KeyValue{Key: PriceHistoryKey(), Value: value}
+}
+
+func (s *feedStore) GetLastPrice() (int64, error) {
+	return getLatestPrice(s.db)
+}
+
+// 记录喂价地址的报价，返回本轮是否产生了被接受的价格，以及被接受的中位数价格和时间加权平均价格
+func (action *Action) feedRound(price int64) (bool, int64, int64, *types.Receipt, error) {
+	feedCfg := getFeedConfig(action.db)
+	cfg := &pricefeed.Config{MinFeeders: feedCfg.MinFeeders, MaxDeviation: feedCfg.MaxDeviation, TwapWindow: feedCfg.TwapWindow}
+	accepted, median, twap, receipt, err := pricefeed.Feed(&feedStore{db: action.db}, cfg, action.fromaddr, price, action.blocktime)
+	if err != nil {
+		clog.Error("feedRound", "addr", action.fromaddr, "error", err)
+		return false, 0, 0, nil, err
+	}
+	return accepted, median, twap, receipt, nil
+}
+
+func queryFeedRounds(db dbm.KV, req *pty.ReqIssuanceFeedRounds) []*pty.IssuanceFeedRound {
+	roundID := req.RoundId
+	if roundID == 0 {
+		roundID = getLatestRoundID(db)
+	}
+	count := req.Count
+	if count <= 0 || count > MaxCount {
+		count = DefaultCount
+	}
+
+	var rounds []*pty.IssuanceFeedRound
+	for ; roundID > 0 && int32(len(rounds)) < count; roundID-- {
+		round, err := queryFeedRound(db, roundID)
+		if err != nil {
+			break
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds
+}
@@ -0,0 +1,149 @@
+package executor
+
+import (
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	mty "github.com/33cn/chain33/system/dapp/manage/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pkt "github.com/33cn/plugin/plugin/dapp/issuance/types"
+	"github.com/33cn/plugin/plugin/dapp/pricefeed"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func initFeedEnv() *execEnv {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	cfg.RegisterDappFork(pkt.IssuanceX, pkt.ForkIssuanceTableUpdate, 0)
+	cfg.RegisterDappFork(pkt.IssuanceX, pkt.ForkIssuancePriceRound, 0)
+	InitExecType()
+	_, ldb, kvdb := util.CreateTestDB()
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+
+	manageKeySet("issuance-manage", string(Nodes[0]), stateDB)
+	item := types.ConfigItem{Key: "issuance-price-feed", Ty: mty.ConfigItemArrayConfig}
+	item.Value = &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{string(Nodes[0]), string(Nodes[1]), string(Nodes[2])}}}
+	stateDB.Set([]byte(types.ManageKey("issuance-price-feed")), types.Encode(&item))
+
+	return &execEnv{
+		blockTime:   1539918074,
+		blockHeight: 10,
+		difficulty:  1539918074,
+		kvdb:        kvdb,
+		api:         api,
+		db:          stateDB,
+		execAddr:    dapp.ExecAddress(pkt.IssuanceX),
+		cfg:         cfg,
+		ldb:         ldb,
+	}
+}
+
+func execFeedTx(t *testing.T, exec dapp.Driver, env *execEnv, tx *types.Transaction, privKey string) error {
+	tx.Execer = []byte(pkt.IssuanceX)
+	tx, err := signTx(tx, privKey)
+	assert.Nil(t, err)
+
+	receipt, err := exec.Exec(tx, int(1))
+	if err != nil {
+		return err
+	}
+	for _, kv := range receipt.KV {
+		env.db.Set(kv.Key, kv.Value)
+	}
+
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, int(1))
+	assert.Nil(t, err)
+	util.SaveKVList(env.ldb, set.KV)
+	return nil
+}
+
+func TestIssuanceFeedRound(t *testing.T) {
+	env := initFeedEnv()
+	exec := newIssuance()
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.db)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+
+	// 少于3个喂价地址时单个地址就能决定价格
+	tx, _ := pkt.CreateRawIssuanceManageTx(env.cfg, &pkt.IssuanceManageTx{MinFeeders: 1, MaxDeviation: 0.2, TwapWindow: 100})
+	assert.Equal(t, pkt.ErrRiskParam, execFeedTx(t, exec, env, tx, PrivKeyA))
+	tx, _ = pkt.CreateRawIssuanceManageTx(env.cfg, &pkt.IssuanceManageTx{MinFeeders: 3, MaxDeviation: 0.2, TwapWindow: 100})
+	assert.Nil(t, execFeedTx(t, exec, env, tx, PrivKeyA))
+	res, err := exec.Query("IssuanceFeedConfig", types.Encode(&pkt.ReqIssuanceRecords{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2000), res.(*pkt.IssuanceFeedConfig).MaxDeviation)
+
+	feed := func(privKey string, price float64) error {
+		tx, _ := pkt.CreateRawIssuanceFeedTx(env.cfg, &pkt.IssuanceFeedTx{Price: []float64{price}, Volume: []int64{1}})
+		return execFeedTx(t, exec, env, tx, privKey)
+	}
+	queryPrice := func() *pkt.RepIssuancePrice {
+		res, err := exec.Query("IssuancePrice", nil)
+		if err != nil {
+			return &pkt.RepIssuancePrice{}
+		}
+		return res.(*pkt.RepIssuancePrice)
+	}
+
+	// 第一轮，3个喂价地址取中位数
+	assert.Nil(t, feed(PrivKeyA, 1))
+	assert.Nil(t, feed(PrivKeyB, 1.1))
+	assert.Equal(t, pkt.ErrFeederDuplicate, feed(PrivKeyB, 1.1))
+	assert.Equal(t, int64(0), queryPrice().Price)
+	assert.Nil(t, feed(PrivKeyC, 0.9))
+	assert.Equal(t, int64(1e4), queryPrice().Price)
+	assert.Equal(t, int64(1e4), queryPrice().Twap)
+
+	// 第二轮，价格变动在偏离范围内，清算使用时间加权价格
+	exec.SetEnv(env.blockHeight+1, env.blockTime+50, env.difficulty)
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC} {
+		assert.Nil(t, feed(key, 1.2))
+	}
+	assert.Equal(t, int64(1.2*1e4), queryPrice().Price)
+	assert.Equal(t, int64(1e4), queryPrice().Twap)
+
+	// 第三轮，偏离过大被挂起
+	exec.SetEnv(env.blockHeight+2, env.blockTime+100, env.difficulty)
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC} {
+		assert.Nil(t, feed(key, 2))
+	}
+	assert.Equal(t, int64(1.2*1e4), queryPrice().Price)
+
+	// 第四轮，与挂起轮次价格接近，确认后接受
+	exec.SetEnv(env.blockHeight+3, env.blockTime+110, env.difficulty)
+	for _, key := range []string{PrivKeyA, PrivKeyB, PrivKeyC} {
+		assert.Nil(t, feed(key, 1.9))
+	}
+	assert.Equal(t, int64(1.9*1e4), queryPrice().Price)
+	assert.Equal(t, int64(1.12*1e4), queryPrice().Twap)
+
+	res, err = exec.Query("IssuanceFeedRounds", types.Encode(&pkt.ReqIssuanceFeedRounds{Count: 10}))
+	assert.Nil(t, err)
+	rounds := res.(*pkt.RepIssuanceFeedRounds).Rounds
+	assert.Equal(t, 4, len(rounds))
+	assert.Equal(t, int64(4), rounds[0].RoundId)
+	assert.Equal(t, int32(pkt.IssuanceRoundStatusAccepted), rounds[0].Status)
+	assert.Equal(t, int32(pkt.IssuanceRoundStatusHeld), rounds[1].Status)
+	assert.Equal(t, int64(2*1e4), rounds[1].Median)
+
+	// 超时未凑齐喂价的轮次作废
+	exec.SetEnv(env.blockHeight+4, env.blockTime+200, env.difficulty)
+	assert.Nil(t, feed(PrivKeyA, 1.9))
+	exec.SetEnv(env.blockHeight+5, env.blockTime+200+pricefeed.RoundTimeout+1, env.difficulty)
+	assert.Nil(t, feed(PrivKeyA, 1.9))
+	res, err = exec.Query("IssuanceFeedRound", types.Encode(&pkt.ReqIssuanceFeedRound{RoundId: 5}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.IssuanceRoundStatusExpired), res.(*pkt.IssuanceFeedRound).Status)
+	res, err = exec.Query("IssuanceFeedRound", types.Encode(&pkt.ReqIssuanceFeedRound{}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(6), res.(*pkt.IssuanceFeedRound).RoundId)
+}
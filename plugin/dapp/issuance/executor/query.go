@@ -127,7 +127,7 @@ func (c *Issuance) Query_IssuancePrice(req *pty.ReqIssuanceRecords) (types.Messa
 		return nil, err
 	}
 
-	return &pty.RepIssuancePrice{Price: price}, nil
+	return &pty.RepIssuancePrice{Price: price, Twap: getLatestTwap(c.GetStateDB())}, nil
 }
 
 //Query_IssuanceUserBalance ...
@@ -140,3 +140,28 @@ func (c *Issuance) Query_IssuanceUserBalance(req *pty.ReqIssuanceRecords) (types
 
 	return &pty.RepIssuanceUserBalance{Balance: balance}, nil
 }
+
+//Query_IssuanceFeedConfig ...
+func (c *Issuance) Query_IssuanceFeedConfig(req *pty.ReqIssuanceRecords) (types.Message, error) {
+	return getFeedConfig(c.GetStateDB()), nil
+}
+
+//Query_IssuanceFeedRound ...
+func (c *Issuance) Query_IssuanceFeedRound(req *pty.ReqIssuanceFeedRound) (types.Message, error) {
+	roundID := req.RoundId
+	if roundID == 0 {
+		roundID = getLatestRoundID(c.GetStateDB())
+	}
+
+	round, err := queryFeedRound(c.GetStateDB(), roundID)
+	if err != nil {
+		clog.Error("Query_IssuanceFeedRound", "roundID", roundID, "error", err)
+		return nil, err
+	}
+	return round, nil
+}
+
+//Query_IssuanceFeedRounds ...
+func (c *Issuance) Query_IssuanceFeedRounds(req *pty.ReqIssuanceFeedRounds) (types.Message, error) {
+	return &pty.RepIssuanceFeedRounds{Rounds: queryFeedRounds(c.GetStateDB(), req)}, nil
+}
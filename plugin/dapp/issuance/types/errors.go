@@ -4,7 +4,11 @@
 
 package types
 
-import "errors"
+import (
+	"errors"
+
+	"github.com/33cn/plugin/plugin/dapp/pricefeed"
+)
 
 // Errors for lottery
 var (
@@ -22,4 +26,5 @@ var (
 	ErrIssuanceBalanceInvalid    = errors.New("ErrIssuanceBalanceInvalid")
 	ErrPermissionDeny            = errors.New("ErrPermissionDeny")
 	ErrIssuanceRecordNotEmpty    = errors.New("ErrIssuanceRecordNotEmpty")
+	ErrFeederDuplicate           = pricefeed.ErrFeederDuplicate
 )
@@ -28,6 +28,7 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(IssuanceX, "Enable", 0)
 	cfg.RegisterDappFork(IssuanceX, ForkIssuanceTableUpdate, 0)
+	cfg.RegisterDappFork(IssuanceX, ForkIssuancePriceRound, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -61,6 +62,7 @@ func (issuance *IssuanceType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogIssuanceRepay:  {Ty: reflect.TypeOf(ReceiptIssuance{}), Name: "LogIssuanceRepay"},
 		TyLogIssuanceFeed:   {Ty: reflect.TypeOf(ReceiptIssuance{}), Name: "LogIssuanceFeed"},
 		TyLogIssuanceClose:  {Ty: reflect.TypeOf(ReceiptIssuance{}), Name: "LogIssuanceClose"},
+		TyLogIssuanceRound:  {Ty: reflect.TypeOf(IssuanceFeedRound{}), Name: "LogIssuanceRound"},
 	}
 }
 
@@ -297,6 +299,13 @@ func CreateRawIssuanceManageTx(cfg *types.Chain33Config, parm *IssuanceManageTx)
 	}
 
 	v := &IssuanceManage{SuperAddrs: parm.Addr}
+	if parm.MinFeeders != 0 || parm.MaxDeviation != 0 || parm.TwapWindow != 0 {
+		v = &IssuanceManage{FeedConfig: &IssuanceFeedConfig{
+			MinFeeders:   parm.MinFeeders,
+			MaxDeviation: int64(math.Trunc((parm.MaxDeviation + 0.0000001) * 1e4)),
+			TwapWindow:   parm.TwapWindow,
+		}}
+	}
 
 	manage := &IssuanceAction{
 		Ty:    IssuanceActionManage,
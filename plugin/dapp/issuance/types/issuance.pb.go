@@ -305,6 +305,7 @@ func (m *DebtRecord) GetIssuId() string {
 type IssuanceAssetPriceRecord struct {
 	RecordTime           int64    `protobuf:"varint,1,opt,name=recordTime,proto3" json:"recordTime,omitempty"`
 	BtyPrice             int64    `protobuf:"varint,2,opt,name=btyPrice,proto3" json:"btyPrice,omitempty"`
+	Twap                 int64    `protobuf:"varint,3,opt,name=twap,proto3" json:"twap,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -349,6 +350,253 @@ func (m *IssuanceAssetPriceRecord) GetBtyPrice() int64 {
 	return 0
 }
 
+func (m *IssuanceAssetPriceRecord) GetTwap() int64 {
+	if m != nil {
+		return m.Twap
+	}
+	return 0
+}
+
+// 喂价配置
+type IssuanceFeedConfig struct {
+	MinFeeders           int32    `protobuf:"varint,1,opt,name=minFeeders,proto3" json:"minFeeders,omitempty"`
+	MaxDeviation         int64    `protobuf:"varint,2,opt,name=maxDeviation,proto3" json:"maxDeviation,omitempty"`
+	TwapWindow           int64    `protobuf:"varint,3,opt,name=twapWindow,proto3" json:"twapWindow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssuanceFeedConfig) Reset()         { *m = IssuanceFeedConfig{} }
+func (m *IssuanceFeedConfig) String() string { return proto.CompactTextString(m) }
+func (*IssuanceFeedConfig) ProtoMessage()    {}
+func (*IssuanceFeedConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7110f4228953d675, []int{3}
+}
+
+func (m *IssuanceFeedConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssuanceFeedConfig.Unmarshal(m, b)
+}
+func (m *IssuanceFeedConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssuanceFeedConfig.Marshal(b, m, deterministic)
+}
+func (m *IssuanceFeedConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuanceFeedConfig.Merge(m, src)
+}
+func (m *IssuanceFeedConfig) XXX_Size() int {
+	return xxx_messageInfo_IssuanceFeedConfig.Size(m)
+}
+func (m *IssuanceFeedConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuanceFeedConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuanceFeedConfig proto.InternalMessageInfo
+
+func (m *IssuanceFeedConfig) GetMinFeeders() int32 {
+	if m != nil {
+		return m.MinFeeders
+	}
+	return 0
+}
+
+func (m *IssuanceFeedConfig) GetMaxDeviation() int64 {
+	if m != nil {
+		return m.MaxDeviation
+	}
+	return 0
+}
+
+func (m *IssuanceFeedConfig) GetTwapWindow() int64 {
+	if m != nil {
+		return m.TwapWindow
+	}
+	return 0
+}
+
+// 喂价轮次
+type IssuanceFeedRound struct {
+	RoundId              int64    `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Feeders              []string `protobuf:"bytes,2,rep,name=feeders,proto3" json:"feeders,omitempty"`
+	Prices               []int64  `protobuf:"varint,3,rep,packed,name=prices,proto3" json:"prices,omitempty"`
+	StartTime            int64    `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	CloseTime            int64    `protobuf:"varint,5,opt,name=closeTime,proto3" json:"closeTime,omitempty"`
+	Median               int64    `protobuf:"varint,6,opt,name=median,proto3" json:"median,omitempty"`
+	Twap                 int64    `protobuf:"varint,7,opt,name=twap,proto3" json:"twap,omitempty"`
+	Status               int32    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssuanceFeedRound) Reset()         { *m = IssuanceFeedRound{} }
+func (m *IssuanceFeedRound) String() string { return proto.CompactTextString(m) }
+func (*IssuanceFeedRound) ProtoMessage()    {}
+func (*IssuanceFeedRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7110f4228953d675, []int{4}
+}
+
+func (m *IssuanceFeedRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssuanceFeedRound.Unmarshal(m, b)
+}
+func (m *IssuanceFeedRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssuanceFeedRound.Marshal(b, m, deterministic)
+}
+func (m *IssuanceFeedRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuanceFeedRound.Merge(m, src)
+}
+func (m *IssuanceFeedRound) XXX_Size() int {
+	return xxx_messageInfo_IssuanceFeedRound.Size(m)
+}
+func (m *IssuanceFeedRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuanceFeedRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuanceFeedRound proto.InternalMessageInfo
+
+func (m *IssuanceFeedRound) GetRoundId() int64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *IssuanceFeedRound) GetFeeders() []string {
+	if m != nil {
+		return m.Feeders
+	}
+	return nil
+}
+
+func (m *IssuanceFeedRound) GetPrices() []int64 {
+	if m != nil {
+		return m.Prices
+	}
+	return nil
+}
+
+func (m *IssuanceFeedRound) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *IssuanceFeedRound) GetCloseTime() int64 {
+	if m != nil {
+		return m.CloseTime
+	}
+	return 0
+}
+
+func (m *IssuanceFeedRound) GetMedian() int64 {
+	if m != nil {
+		return m.Median
+	}
+	return 0
+}
+
+func (m *IssuanceFeedRound) GetTwap() int64 {
+	if m != nil {
+		return m.Twap
+	}
+	return 0
+}
+
+func (m *IssuanceFeedRound) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+// 价格点
+type IssuancePricePoint struct {
+	Price                int64    `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssuancePricePoint) Reset()         { *m = IssuancePricePoint{} }
+func (m *IssuancePricePoint) String() string { return proto.CompactTextString(m) }
+func (*IssuancePricePoint) ProtoMessage()    {}
+func (*IssuancePricePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7110f4228953d675, []int{5}
+}
+
+func (m *IssuancePricePoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssuancePricePoint.Unmarshal(m, b)
+}
+func (m *IssuancePricePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssuancePricePoint.Marshal(b, m, deterministic)
+}
+func (m *IssuancePricePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuancePricePoint.Merge(m, src)
+}
+func (m *IssuancePricePoint) XXX_Size() int {
+	return xxx_messageInfo_IssuancePricePoint.Size(m)
+}
+func (m *IssuancePricePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuancePricePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuancePricePoint proto.InternalMessageInfo
+
+func (m *IssuancePricePoint) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *IssuancePricePoint) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// 计算时间加权平均价格的历史价格
+type IssuancePriceHistory struct {
+	Points               []*IssuancePricePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *IssuancePriceHistory) Reset()         { *m = IssuancePriceHistory{} }
+func (m *IssuancePriceHistory) String() string { return proto.CompactTextString(m) }
+func (*IssuancePriceHistory) ProtoMessage()    {}
+func (*IssuancePriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7110f4228953d675, []int{6}
+}
+
+func (m *IssuancePriceHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssuancePriceHistory.Unmarshal(m, b)
+}
+func (m *IssuancePriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssuancePriceHistory.Marshal(b, m, deterministic)
+}
+func (m *IssuancePriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssuancePriceHistory.Merge(m, src)
+}
+func (m *IssuancePriceHistory) XXX_Size() int {
+	return xxx_messageInfo_IssuancePriceHistory.Size(m)
+}
+func (m *IssuancePriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssuancePriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssuancePriceHistory proto.InternalMessageInfo
+
+func (m *IssuancePriceHistory) GetPoints() []*IssuancePricePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
 // action
 type IssuanceAction struct {
 	// Types that are valid to be assigned to Value:
@@ -369,7 +617,7 @@ func (m *IssuanceAction) Reset()         { *m = IssuanceAction{} }
 func (m *IssuanceAction) String() string { return proto.CompactTextString(m) }
 func (*IssuanceAction) ProtoMessage()    {}
 func (*IssuanceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{3}
+	return fileDescriptor_7110f4228953d675, []int{7}
 }
 
 func (m *IssuanceAction) XXX_Unmarshal(b []byte) error {
@@ -499,17 +747,18 @@ func (*IssuanceAction) XXX_OneofWrappers() []interface{} {
 }
 
 type IssuanceManage struct {
-	SuperAddrs           []string `protobuf:"bytes,1,rep,name=superAddrs,proto3" json:"superAddrs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SuperAddrs           []string            `protobuf:"bytes,1,rep,name=superAddrs,proto3" json:"superAddrs,omitempty"`
+	FeedConfig           *IssuanceFeedConfig `protobuf:"bytes,2,opt,name=feedConfig,proto3" json:"feedConfig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *IssuanceManage) Reset()         { *m = IssuanceManage{} }
 func (m *IssuanceManage) String() string { return proto.CompactTextString(m) }
 func (*IssuanceManage) ProtoMessage()    {}
 func (*IssuanceManage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{4}
+	return fileDescriptor_7110f4228953d675, []int{8}
 }
 
 func (m *IssuanceManage) XXX_Unmarshal(b []byte) error {
@@ -537,6 +786,13 @@ func (m *IssuanceManage) GetSuperAddrs() []string {
 	return nil
 }
 
+func (m *IssuanceManage) GetFeedConfig() *IssuanceFeedConfig {
+	if m != nil {
+		return m.FeedConfig
+	}
+	return nil
+}
+
 // 创建发行
 type IssuanceCreate struct {
 	TotalBalance         int64    `protobuf:"varint,1,opt,name=totalBalance,proto3" json:"totalBalance,omitempty"`
@@ -552,7 +808,7 @@ func (m *IssuanceCreate) Reset()         { *m = IssuanceCreate{} }
 func (m *IssuanceCreate) String() string { return proto.CompactTextString(m) }
 func (*IssuanceCreate) ProtoMessage()    {}
 func (*IssuanceCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{5}
+	return fileDescriptor_7110f4228953d675, []int{9}
 }
 
 func (m *IssuanceCreate) XXX_Unmarshal(b []byte) error {
@@ -614,7 +870,7 @@ func (m *IssuanceDebt) Reset()         { *m = IssuanceDebt{} }
 func (m *IssuanceDebt) String() string { return proto.CompactTextString(m) }
 func (*IssuanceDebt) ProtoMessage()    {}
 func (*IssuanceDebt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{6}
+	return fileDescriptor_7110f4228953d675, []int{10}
 }
 
 func (m *IssuanceDebt) XXX_Unmarshal(b []byte) error {
@@ -662,7 +918,7 @@ func (m *IssuanceRepay) Reset()         { *m = IssuanceRepay{} }
 func (m *IssuanceRepay) String() string { return proto.CompactTextString(m) }
 func (*IssuanceRepay) ProtoMessage()    {}
 func (*IssuanceRepay) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{7}
+	return fileDescriptor_7110f4228953d675, []int{11}
 }
 
 func (m *IssuanceRepay) XXX_Unmarshal(b []byte) error {
@@ -711,7 +967,7 @@ func (m *IssuanceFeed) Reset()         { *m = IssuanceFeed{} }
 func (m *IssuanceFeed) String() string { return proto.CompactTextString(m) }
 func (*IssuanceFeed) ProtoMessage()    {}
 func (*IssuanceFeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{8}
+	return fileDescriptor_7110f4228953d675, []int{12}
 }
 
 func (m *IssuanceFeed) XXX_Unmarshal(b []byte) error {
@@ -765,7 +1021,7 @@ func (m *IssuanceClose) Reset()         { *m = IssuanceClose{} }
 func (m *IssuanceClose) String() string { return proto.CompactTextString(m) }
 func (*IssuanceClose) ProtoMessage()    {}
 func (*IssuanceClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{9}
+	return fileDescriptor_7110f4228953d675, []int{13}
 }
 
 func (m *IssuanceClose) XXX_Unmarshal(b []byte) error {
@@ -808,7 +1064,7 @@ func (m *ReceiptIssuance) Reset()         { *m = ReceiptIssuance{} }
 func (m *ReceiptIssuance) String() string { return proto.CompactTextString(m) }
 func (*ReceiptIssuance) ProtoMessage()    {}
 func (*ReceiptIssuance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{10}
+	return fileDescriptor_7110f4228953d675, []int{14}
 }
 
 func (m *ReceiptIssuance) XXX_Unmarshal(b []byte) error {
@@ -870,7 +1126,7 @@ func (m *ReceiptIssuanceID) Reset()         { *m = ReceiptIssuanceID{} }
 func (m *ReceiptIssuanceID) String() string { return proto.CompactTextString(m) }
 func (*ReceiptIssuanceID) ProtoMessage()    {}
 func (*ReceiptIssuanceID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{11}
+	return fileDescriptor_7110f4228953d675, []int{15}
 }
 
 func (m *ReceiptIssuanceID) XXX_Unmarshal(b []byte) error {
@@ -917,7 +1173,7 @@ func (m *IssuanceRecords) Reset()         { *m = IssuanceRecords{} }
 func (m *IssuanceRecords) String() string { return proto.CompactTextString(m) }
 func (*IssuanceRecords) ProtoMessage()    {}
 func (*IssuanceRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{12}
+	return fileDescriptor_7110f4228953d675, []int{16}
 }
 
 func (m *IssuanceRecords) XXX_Unmarshal(b []byte) error {
@@ -957,7 +1213,7 @@ func (m *ReqIssuanceInfo) Reset()         { *m = ReqIssuanceInfo{} }
 func (m *ReqIssuanceInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqIssuanceInfo) ProtoMessage()    {}
 func (*ReqIssuanceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{13}
+	return fileDescriptor_7110f4228953d675, []int{17}
 }
 
 func (m *ReqIssuanceInfo) XXX_Unmarshal(b []byte) error {
@@ -1006,7 +1262,7 @@ func (m *RepIssuanceCurrentInfo) Reset()         { *m = RepIssuanceCurrentInfo{}
 func (m *RepIssuanceCurrentInfo) String() string { return proto.CompactTextString(m) }
 func (*RepIssuanceCurrentInfo) ProtoMessage()    {}
 func (*RepIssuanceCurrentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{14}
+	return fileDescriptor_7110f4228953d675, []int{18}
 }
 
 func (m *RepIssuanceCurrentInfo) XXX_Unmarshal(b []byte) error {
@@ -1109,7 +1365,7 @@ func (m *ReqIssuanceInfos) Reset()         { *m = ReqIssuanceInfos{} }
 func (m *ReqIssuanceInfos) String() string { return proto.CompactTextString(m) }
 func (*ReqIssuanceInfos) ProtoMessage()    {}
 func (*ReqIssuanceInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{15}
+	return fileDescriptor_7110f4228953d675, []int{19}
 }
 
 func (m *ReqIssuanceInfos) XXX_Unmarshal(b []byte) error {
@@ -1149,7 +1405,7 @@ func (m *RepIssuanceCurrentInfos) Reset()         { *m = RepIssuanceCurrentInfos
 func (m *RepIssuanceCurrentInfos) String() string { return proto.CompactTextString(m) }
 func (*RepIssuanceCurrentInfos) ProtoMessage()    {}
 func (*RepIssuanceCurrentInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{16}
+	return fileDescriptor_7110f4228953d675, []int{20}
 }
 
 func (m *RepIssuanceCurrentInfos) XXX_Unmarshal(b []byte) error {
@@ -1190,7 +1446,7 @@ func (m *ReqIssuanceByStatus) Reset()         { *m = ReqIssuanceByStatus{} }
 func (m *ReqIssuanceByStatus) String() string { return proto.CompactTextString(m) }
 func (*ReqIssuanceByStatus) ProtoMessage()    {}
 func (*ReqIssuanceByStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{17}
+	return fileDescriptor_7110f4228953d675, []int{21}
 }
 
 func (m *ReqIssuanceByStatus) XXX_Unmarshal(b []byte) error {
@@ -1237,7 +1493,7 @@ func (m *RepIssuanceIDs) Reset()         { *m = RepIssuanceIDs{} }
 func (m *RepIssuanceIDs) String() string { return proto.CompactTextString(m) }
 func (*RepIssuanceIDs) ProtoMessage()    {}
 func (*RepIssuanceIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{18}
+	return fileDescriptor_7110f4228953d675, []int{22}
 }
 
 func (m *RepIssuanceIDs) XXX_Unmarshal(b []byte) error {
@@ -1280,7 +1536,7 @@ func (m *ReqIssuanceRecords) Reset()         { *m = ReqIssuanceRecords{} }
 func (m *ReqIssuanceRecords) String() string { return proto.CompactTextString(m) }
 func (*ReqIssuanceRecords) ProtoMessage()    {}
 func (*ReqIssuanceRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{19}
+	return fileDescriptor_7110f4228953d675, []int{23}
 }
 
 func (m *ReqIssuanceRecords) XXX_Unmarshal(b []byte) error {
@@ -1341,7 +1597,7 @@ func (m *RepIssuanceRecords) Reset()         { *m = RepIssuanceRecords{} }
 func (m *RepIssuanceRecords) String() string { return proto.CompactTextString(m) }
 func (*RepIssuanceRecords) ProtoMessage()    {}
 func (*RepIssuanceRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{20}
+	return fileDescriptor_7110f4228953d675, []int{24}
 }
 
 func (m *RepIssuanceRecords) XXX_Unmarshal(b []byte) error {
@@ -1381,7 +1637,7 @@ func (m *RepIssuanceDebtInfo) Reset()         { *m = RepIssuanceDebtInfo{} }
 func (m *RepIssuanceDebtInfo) String() string { return proto.CompactTextString(m) }
 func (*RepIssuanceDebtInfo) ProtoMessage()    {}
 func (*RepIssuanceDebtInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{21}
+	return fileDescriptor_7110f4228953d675, []int{25}
 }
 
 func (m *RepIssuanceDebtInfo) XXX_Unmarshal(b []byte) error {
@@ -1412,6 +1668,7 @@ func (m *RepIssuanceDebtInfo) GetRecord() *DebtRecord {
 // 返回最新抵押物价格
 type RepIssuancePrice struct {
 	Price                int64    `protobuf:"varint,1,opt,name=price,proto3" json:"price,omitempty"`
+	Twap                 int64    `protobuf:"varint,2,opt,name=twap,proto3" json:"twap,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1421,7 +1678,7 @@ func (m *RepIssuancePrice) Reset()         { *m = RepIssuancePrice{} }
 func (m *RepIssuancePrice) String() string { return proto.CompactTextString(m) }
 func (*RepIssuancePrice) ProtoMessage()    {}
 func (*RepIssuancePrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{22}
+	return fileDescriptor_7110f4228953d675, []int{26}
 }
 
 func (m *RepIssuancePrice) XXX_Unmarshal(b []byte) error {
@@ -1449,6 +1706,13 @@ func (m *RepIssuancePrice) GetPrice() int64 {
 	return 0
 }
 
+func (m *RepIssuancePrice) GetTwap() int64 {
+	if m != nil {
+		return m.Twap
+	}
+	return 0
+}
+
 // 返回用户发行总额
 type RepIssuanceUserBalance struct {
 	Balance              int64    `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
@@ -1461,7 +1725,7 @@ func (m *RepIssuanceUserBalance) Reset()         { *m = RepIssuanceUserBalance{}
 func (m *RepIssuanceUserBalance) String() string { return proto.CompactTextString(m) }
 func (*RepIssuanceUserBalance) ProtoMessage()    {}
 func (*RepIssuanceUserBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7110f4228953d675, []int{23}
+	return fileDescriptor_7110f4228953d675, []int{27}
 }
 
 func (m *RepIssuanceUserBalance) XXX_Unmarshal(b []byte) error {
@@ -1489,10 +1753,142 @@ func (m *RepIssuanceUserBalance) GetBalance() int64 {
 	return 0
 }
 
+// 查询喂价轮次，roundId为0时查询最新轮次
+type ReqIssuanceFeedRound struct {
+	RoundId              int64    `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqIssuanceFeedRound) Reset()         { *m = ReqIssuanceFeedRound{} }
+func (m *ReqIssuanceFeedRound) String() string { return proto.CompactTextString(m) }
+func (*ReqIssuanceFeedRound) ProtoMessage()    {}
+func (*ReqIssuanceFeedRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7110f4228953d675, []int{28}
+}
+
+func (m *ReqIssuanceFeedRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqIssuanceFeedRound.Unmarshal(m, b)
+}
+func (m *ReqIssuanceFeedRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqIssuanceFeedRound.Marshal(b, m, deterministic)
+}
+func (m *ReqIssuanceFeedRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqIssuanceFeedRound.Merge(m, src)
+}
+func (m *ReqIssuanceFeedRound) XXX_Size() int {
+	return xxx_messageInfo_ReqIssuanceFeedRound.Size(m)
+}
+func (m *ReqIssuanceFeedRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqIssuanceFeedRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqIssuanceFeedRound proto.InternalMessageInfo
+
+func (m *ReqIssuanceFeedRound) GetRoundId() int64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+// 查询喂价轮次列表，从roundId开始倒序，roundId为0时从最新轮次开始
+type ReqIssuanceFeedRounds struct {
+	RoundId              int64    `protobuf:"varint,1,opt,name=roundId,proto3" json:"roundId,omitempty"`
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqIssuanceFeedRounds) Reset()         { *m = ReqIssuanceFeedRounds{} }
+func (m *ReqIssuanceFeedRounds) String() string { return proto.CompactTextString(m) }
+func (*ReqIssuanceFeedRounds) ProtoMessage()    {}
+func (*ReqIssuanceFeedRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7110f4228953d675, []int{29}
+}
+
+func (m *ReqIssuanceFeedRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqIssuanceFeedRounds.Unmarshal(m, b)
+}
+func (m *ReqIssuanceFeedRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqIssuanceFeedRounds.Marshal(b, m, deterministic)
+}
+func (m *ReqIssuanceFeedRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqIssuanceFeedRounds.Merge(m, src)
+}
+func (m *ReqIssuanceFeedRounds) XXX_Size() int {
+	return xxx_messageInfo_ReqIssuanceFeedRounds.Size(m)
+}
+func (m *ReqIssuanceFeedRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqIssuanceFeedRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqIssuanceFeedRounds proto.InternalMessageInfo
+
+func (m *ReqIssuanceFeedRounds) GetRoundId() int64 {
+	if m != nil {
+		return m.RoundId
+	}
+	return 0
+}
+
+func (m *ReqIssuanceFeedRounds) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// 返回喂价轮次列表
+type RepIssuanceFeedRounds struct {
+	Rounds               []*IssuanceFeedRound `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RepIssuanceFeedRounds) Reset()         { *m = RepIssuanceFeedRounds{} }
+func (m *RepIssuanceFeedRounds) String() string { return proto.CompactTextString(m) }
+func (*RepIssuanceFeedRounds) ProtoMessage()    {}
+func (*RepIssuanceFeedRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7110f4228953d675, []int{30}
+}
+
+func (m *RepIssuanceFeedRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RepIssuanceFeedRounds.Unmarshal(m, b)
+}
+func (m *RepIssuanceFeedRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RepIssuanceFeedRounds.Marshal(b, m, deterministic)
+}
+func (m *RepIssuanceFeedRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepIssuanceFeedRounds.Merge(m, src)
+}
+func (m *RepIssuanceFeedRounds) XXX_Size() int {
+	return xxx_messageInfo_RepIssuanceFeedRounds.Size(m)
+}
+func (m *RepIssuanceFeedRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepIssuanceFeedRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepIssuanceFeedRounds proto.InternalMessageInfo
+
+func (m *RepIssuanceFeedRounds) GetRounds() []*IssuanceFeedRound {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Issuance)(nil), "types.Issuance")
 	proto.RegisterType((*DebtRecord)(nil), "types.DebtRecord")
 	proto.RegisterType((*IssuanceAssetPriceRecord)(nil), "types.IssuanceAssetPriceRecord")
+	proto.RegisterType((*IssuanceFeedConfig)(nil), "types.IssuanceFeedConfig")
+	proto.RegisterType((*IssuanceFeedRound)(nil), "types.IssuanceFeedRound")
+	proto.RegisterType((*IssuancePricePoint)(nil), "types.IssuancePricePoint")
+	proto.RegisterType((*IssuancePriceHistory)(nil), "types.IssuancePriceHistory")
 	proto.RegisterType((*IssuanceAction)(nil), "types.IssuanceAction")
 	proto.RegisterType((*IssuanceManage)(nil), "types.IssuanceManage")
 	proto.RegisterType((*IssuanceCreate)(nil), "types.IssuanceCreate")
@@ -1514,6 +1910,9 @@ func init() {
 	proto.RegisterType((*RepIssuanceDebtInfo)(nil), "types.RepIssuanceDebtInfo")
 	proto.RegisterType((*RepIssuancePrice)(nil), "types.RepIssuancePrice")
 	proto.RegisterType((*RepIssuanceUserBalance)(nil), "types.RepIssuanceUserBalance")
+	proto.RegisterType((*ReqIssuanceFeedRound)(nil), "types.ReqIssuanceFeedRound")
+	proto.RegisterType((*ReqIssuanceFeedRounds)(nil), "types.ReqIssuanceFeedRounds")
+	proto.RegisterType((*RepIssuanceFeedRounds)(nil), "types.RepIssuanceFeedRounds")
 }
 
 func init() {
@@ -1521,71 +1920,86 @@ func init() {
 }
 
 var fileDescriptor_7110f4228953d675 = []byte{
-	// 1041 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5f, 0x6f, 0xe3, 0x44,
-	0x10, 0xaf, 0xed, 0x38, 0x7f, 0x26, 0x6d, 0xda, 0xdb, 0x3b, 0x8a, 0x85, 0xe0, 0x14, 0xad, 0x78,
-	0xc8, 0x01, 0xea, 0x1d, 0x29, 0x42, 0xe2, 0x8d, 0xb6, 0x01, 0x1a, 0xc1, 0x21, 0x64, 0x8e, 0x13,
-	0xaf, 0xae, 0xbd, 0x77, 0xb2, 0xe4, 0xc6, 0x3e, 0xef, 0xa6, 0xba, 0x3c, 0xf3, 0x1d, 0x78, 0xe0,
-	0xbb, 0xf0, 0xc2, 0x67, 0xe0, 0x03, 0xa1, 0xd9, 0x5d, 0x7b, 0xd7, 0x9b, 0x54, 0xe9, 0x13, 0x2f,
-	0x55, 0x76, 0xf6, 0xb7, 0xb3, 0xb3, 0xf3, 0xfb, 0xcd, 0x8c, 0x0b, 0x93, 0x9c, 0xf3, 0x75, 0xb2,
-	0x4a, 0xd9, 0x59, 0x55, 0x97, 0xa2, 0x24, 0xa1, 0xd8, 0x54, 0x8c, 0xd3, 0x7f, 0x7a, 0x30, 0x5c,
-	0xea, 0x1d, 0xf2, 0x14, 0xa0, 0x41, 0x2d, 0xb3, 0xc8, 0x9b, 0x7a, 0xb3, 0x51, 0x6c, 0x59, 0x08,
-	0x85, 0x43, 0x51, 0x8a, 0xa4, 0xb8, 0x4c, 0x0a, 0xb4, 0x44, 0xfe, 0xd4, 0x9b, 0x05, 0x71, 0xc7,
-	0x46, 0xa6, 0x30, 0xce, 0xd8, 0x8d, 0xb8, 0x62, 0x79, 0x91, 0xaf, 0xde, 0x46, 0x81, 0x84, 0xd8,
-	0x26, 0xf2, 0x19, 0x9c, 0x14, 0xf9, 0xbb, 0x75, 0x9e, 0x25, 0x22, 0x2f, 0x57, 0x31, 0xfe, 0x8d,
-	0x7a, 0x12, 0xb6, 0x65, 0x27, 0x33, 0x38, 0x4e, 0xcb, 0xa2, 0x48, 0x04, 0xab, 0x93, 0xe2, 0x75,
-	0x52, 0xac, 0x59, 0x14, 0x4a, 0xa8, 0x6b, 0x26, 0x1f, 0xc3, 0x08, 0x2f, 0x51, 0x98, 0xbe, 0xc4,
-	0x18, 0x03, 0x39, 0x57, 0x51, 0xc5, 0x2c, 0x2d, 0xeb, 0x8c, 0x47, 0x83, 0x69, 0x30, 0x1b, 0xcf,
-	0x1f, 0x9d, 0xc9, 0x1c, 0x9c, 0x2d, 0xda, 0x9d, 0xd8, 0x46, 0x91, 0x6f, 0x60, 0x92, 0xaf, 0xee,
-	0x92, 0x22, 0xcf, 0x9a, 0x73, 0xc3, 0xfb, 0xce, 0x39, 0x40, 0x72, 0x0a, 0x7d, 0x2e, 0x12, 0xb1,
-	0xe6, 0xd1, 0x68, 0xea, 0xcd, 0xc2, 0x58, 0xaf, 0xc8, 0xd7, 0x70, 0x8a, 0x51, 0x73, 0xf1, 0x93,
-	0x79, 0xe9, 0x2f, 0x75, 0x9e, 0xb2, 0x08, 0x64, 0xc8, 0xf7, 0xec, 0xa2, 0xbf, 0x8a, 0xd5, 0x79,
-	0x99, 0x45, 0x63, 0x89, 0xd3, 0x2b, 0x99, 0x4b, 0x79, 0xe2, 0xbb, 0xf7, 0x55, 0x5e, 0xb3, 0x57,
-	0xf9, 0x2d, 0x8b, 0x0e, 0x75, 0x2e, 0x1d, 0x3b, 0xb2, 0x9b, 0xd6, 0x2c, 0x11, 0x0a, 0x75, 0x24,
-	0x51, 0x96, 0x85, 0x44, 0x30, 0xb8, 0xd1, 0xc4, 0x4e, 0xe4, 0x66, 0xb3, 0x6c, 0x74, 0xc1, 0xea,
-	0x8b, 0x2c, 0xab, 0xa3, 0x63, 0xa3, 0x0b, 0x65, 0xa1, 0x7f, 0x06, 0x00, 0x26, 0x19, 0x28, 0x81,
-	0x24, 0x4d, 0xcb, 0xf5, 0x4a, 0x48, 0xbc, 0xd2, 0x91, 0x6d, 0x42, 0xb2, 0xb8, 0x48, 0x6a, 0x21,
-	0x23, 0x51, 0x2a, 0x32, 0x86, 0x5d, 0xa4, 0x07, 0xbb, 0x49, 0xef, 0x20, 0x55, 0x1e, 0x7b, 0x2e,
-	0x52, 0x25, 0xb0, 0x23, 0x8f, 0xd0, 0x95, 0x47, 0x57, 0x92, 0xca, 0x51, 0x7f, 0x4b, 0x92, 0x2d,
-	0x15, 0x9a, 0xda, 0x41, 0x87, 0xda, 0x4f, 0xe1, 0xa8, 0xc1, 0xaa, 0x0c, 0x0f, 0xa5, 0x83, 0xae,
-	0x11, 0x53, 0xc9, 0x0c, 0x55, 0x23, 0x45, 0x82, 0xb1, 0x60, 0x9c, 0x55, 0xcd, 0x7e, 0x55, 0x17,
-	0x80, 0xbc, 0xc0, 0x18, 0xf0, 0x6e, 0x0c, 0x7a, 0xa9, 0x64, 0x30, 0x8a, 0xf5, 0x0a, 0xed, 0x48,
-	0xc7, 0x32, 0x93, 0xe4, 0x8f, 0x62, 0xbd, 0xa2, 0xaf, 0x21, 0x6a, 0x8a, 0xfb, 0x82, 0x73, 0x26,
-	0xe4, 0x0b, 0x34, 0x4b, 0x4f, 0x01, 0x6a, 0xf9, 0x4b, 0x46, 0xe2, 0xa9, 0x48, 0x8c, 0x85, 0x7c,
-	0x04, 0xc3, 0x1b, 0xb1, 0x51, 0xb9, 0x50, 0x14, 0xb5, 0x6b, 0xfa, 0xb7, 0x0f, 0x93, 0xd6, 0x71,
-	0x8a, 0xb9, 0x21, 0xcf, 0xa1, 0xaf, 0xb4, 0x24, 0x5d, 0x8d, 0xe7, 0x1f, 0xe8, 0x22, 0x69, 0x60,
-	0x57, 0x72, 0xf3, 0xfa, 0x20, 0xd6, 0x30, 0xf2, 0x0c, 0x7a, 0x18, 0xbd, 0xf4, 0x3d, 0x9e, 0x3f,
-	0x76, 0xe0, 0x28, 0xa7, 0xeb, 0x83, 0x58, 0x42, 0xc8, 0x17, 0x10, 0xd6, 0xac, 0x4a, 0x36, 0x52,
-	0x06, 0xe3, 0xf9, 0x13, 0x07, 0x1b, 0xe3, 0xde, 0xf5, 0x41, 0xac, 0x40, 0xe8, 0xf8, 0x0d, 0x63,
-	0x99, 0x54, 0xc2, 0xb6, 0xe3, 0xef, 0x19, 0xcb, 0xd0, 0x31, 0x42, 0xd0, 0x71, 0x5a, 0x94, 0x5c,
-	0x29, 0x62, 0xdb, 0xf1, 0x15, 0xee, 0xa1, 0x63, 0x09, 0xc2, 0x27, 0xde, 0x26, 0xab, 0xe4, 0xad,
-	0xd2, 0xc6, 0xf6, 0x13, 0x5f, 0xca, 0x4d, 0x7c, 0xa2, 0x82, 0x91, 0x09, 0xf8, 0x62, 0xa3, 0x59,
-	0xf4, 0xc5, 0xe6, 0x72, 0x00, 0xe1, 0x1d, 0xea, 0x8d, 0xbe, 0x30, 0xe9, 0x53, 0x87, 0x90, 0x0d,
-	0xbe, 0xae, 0x54, 0x3d, 0xf1, 0xc8, 0x9b, 0x06, 0x58, 0x62, 0xc6, 0x42, 0xff, 0xf2, 0xcc, 0x11,
-	0x95, 0xca, 0xad, 0x6e, 0xec, 0xed, 0xef, 0xc6, 0xfe, 0xc3, 0xba, 0x71, 0x70, 0x4f, 0x37, 0x36,
-	0x5d, 0xa8, 0x67, 0x77, 0x21, 0xba, 0x80, 0x43, 0x9b, 0xb7, 0xbd, 0x73, 0xe4, 0x89, 0xce, 0x83,
-	0x8e, 0x47, 0x27, 0xe5, 0x07, 0x38, 0xea, 0x30, 0xba, 0xd7, 0x8d, 0xa9, 0x06, 0xdf, 0xae, 0x06,
-	0xfa, 0xbb, 0x09, 0x07, 0xd9, 0x46, 0x25, 0x63, 0x3b, 0x78, 0xb5, 0xa9, 0x54, 0x92, 0xc2, 0xb8,
-	0x5d, 0x63, 0x28, 0x95, 0x96, 0x78, 0x80, 0xa1, 0x54, 0x4d, 0x8d, 0xdf, 0x95, 0xc5, 0xfa, 0x16,
-	0x1b, 0x0f, 0x9a, 0xf5, 0x8a, 0x3e, 0x37, 0x21, 0x4a, 0x6d, 0xec, 0x0b, 0x91, 0xfe, 0xe1, 0xc1,
-	0x71, 0xcc, 0x52, 0x96, 0x57, 0xe2, 0xc1, 0x53, 0xd6, 0x69, 0x9f, 0xfe, 0x76, 0xfb, 0x34, 0x0f,
-	0x0f, 0xdc, 0x36, 0xa0, 0x5b, 0x53, 0xcf, 0x6e, 0x4d, 0xf4, 0x47, 0x78, 0xe4, 0x04, 0xb1, 0x5c,
-	0x3c, 0x24, 0xbb, 0xda, 0x99, 0xdf, 0x71, 0x76, 0x05, 0xc7, 0x86, 0x26, 0x35, 0xed, 0x5e, 0xc0,
-	0xa0, 0xd6, 0x13, 0xd2, 0x93, 0x13, 0xf2, 0x54, 0x57, 0x86, 0x73, 0x6b, 0xdc, 0xc0, 0xe8, 0x97,
-	0x98, 0x96, 0x77, 0x6d, 0x34, 0xab, 0x37, 0xe5, 0xde, 0x54, 0xfe, 0xeb, 0xc3, 0x69, 0xcc, 0xaa,
-	0x36, 0xff, 0xeb, 0xba, 0x66, 0x2b, 0x21, 0x8f, 0x9a, 0x50, 0xbd, 0x4e, 0x4b, 0xfe, 0xff, 0xbf,
-	0x57, 0xac, 0x19, 0x1a, 0x76, 0x67, 0xe8, 0x8e, 0xa1, 0xd6, 0x7f, 0xc0, 0x97, 0xcc, 0xc0, 0x1d,
-	0x55, 0xa6, 0x06, 0x87, 0x9d, 0x2f, 0x01, 0x33, 0x02, 0x46, 0xf6, 0x08, 0x70, 0xa6, 0x3e, 0xb8,
-	0x53, 0x9f, 0x7e, 0x05, 0x27, 0x0e, 0x13, 0x1c, 0x73, 0x62, 0x12, 0xdf, 0x74, 0x23, 0xdb, 0x44,
-	0x7f, 0x86, 0x0f, 0x77, 0x73, 0xc1, 0xc9, 0x39, 0x84, 0x39, 0xfe, 0xd0, 0x52, 0xf8, 0xa4, 0x95,
-	0xc2, 0x2e, 0x78, 0xac, 0xb0, 0xf4, 0x25, 0x3c, 0xb6, 0xa2, 0xb8, 0xdc, 0x98, 0x79, 0xb7, 0x93,
-	0xd8, 0xae, 0x56, 0xfc, 0x2d, 0xad, 0x50, 0x98, 0x58, 0xf7, 0x2d, 0x17, 0x9c, 0x9c, 0x40, 0xb0,
-	0x5c, 0x34, 0x4f, 0xc1, 0x9f, 0xf4, 0x3d, 0x10, 0xeb, 0xca, 0x46, 0xca, 0xfb, 0xaa, 0x82, 0x40,
-	0x2f, 0x31, 0x55, 0x29, 0x7f, 0x5b, 0x51, 0x06, 0x9d, 0x28, 0x4d, 0x99, 0xf6, 0x3a, 0xfd, 0xe9,
-	0x02, 0x6f, 0xae, 0xdc, 0x9b, 0x3f, 0x77, 0x8b, 0x68, 0xc7, 0x67, 0x66, 0x5b, 0x3f, 0xdf, 0x62,
-	0xbe, 0x2a, 0xbb, 0xe9, 0xca, 0x42, 0x78, 0x06, 0x7d, 0x85, 0xd0, 0x43, 0x78, 0x87, 0x0b, 0x0d,
-	0xa0, 0x33, 0xe4, 0xbd, 0xf5, 0xa0, 0x3e, 0x6d, 0xda, 0x66, 0xa8, 0x46, 0x89, 0x5a, 0xd0, 0x79,
-	0xa7, 0xee, 0x7e, 0xe3, 0xac, 0x6e, 0x6a, 0xc7, 0x52, 0xbb, 0xd7, 0x51, 0xfb, 0x4d, 0x5f, 0xfe,
-	0x93, 0x71, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x57, 0x52, 0x66, 0x8a, 0x76, 0x0c, 0x00,
-	0x00,
+	// 1286 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6e, 0xe3, 0x36,
+	0x10, 0x8e, 0x25, 0xff, 0x8e, 0x13, 0x27, 0x61, 0x7e, 0xaa, 0x2d, 0xb6, 0x0b, 0x83, 0xe8, 0xc1,
+	0xdb, 0x16, 0xd9, 0x6c, 0x52, 0x14, 0x58, 0xa0, 0x28, 0x9a, 0xc4, 0xed, 0xc6, 0x68, 0xb7, 0x58,
+	0xb0, 0xdb, 0x9f, 0xab, 0x62, 0x31, 0x81, 0x5a, 0x5b, 0xd2, 0x4a, 0x74, 0x36, 0x3e, 0xf7, 0x1d,
+	0x7a, 0xe8, 0xbb, 0xf4, 0xd2, 0x67, 0xe8, 0xbd, 0xaf, 0x52, 0x0c, 0x49, 0x89, 0x94, 0xac, 0xc0,
+	0x39, 0xed, 0xc5, 0x10, 0x87, 0x1f, 0x67, 0x86, 0x33, 0xdf, 0x0c, 0xc7, 0x30, 0x08, 0xb3, 0x6c,
+	0xe1, 0x47, 0x53, 0x7e, 0x94, 0xa4, 0xb1, 0x88, 0x49, 0x4b, 0x2c, 0x13, 0x9e, 0xd1, 0x7f, 0x9a,
+	0xd0, 0x9d, 0xe8, 0x1d, 0xf2, 0x04, 0x20, 0x47, 0x4d, 0x02, 0xaf, 0x31, 0x6c, 0x8c, 0x7a, 0xcc,
+	0x92, 0x10, 0x0a, 0x9b, 0x22, 0x16, 0xfe, 0xec, 0xdc, 0x9f, 0xa1, 0xc4, 0x73, 0x86, 0x8d, 0x91,
+	0xcb, 0x4a, 0x32, 0x32, 0x84, 0x7e, 0xc0, 0xaf, 0xc4, 0x05, 0x0f, 0x67, 0x61, 0x74, 0xe3, 0xb9,
+	0x12, 0x62, 0x8b, 0xc8, 0x27, 0xb0, 0x33, 0x0b, 0xdf, 0x2e, 0xc2, 0xc0, 0x17, 0x61, 0x1c, 0x31,
+	0xfc, 0xf5, 0x9a, 0x12, 0xb6, 0x22, 0x27, 0x23, 0xd8, 0x9e, 0xc6, 0xb3, 0x99, 0x2f, 0x78, 0xea,
+	0xcf, 0x7e, 0xf6, 0x67, 0x0b, 0xee, 0xb5, 0x24, 0xb4, 0x2a, 0x26, 0x8f, 0xa1, 0x87, 0x46, 0x14,
+	0xa6, 0x2d, 0x31, 0x46, 0x40, 0x4e, 0x95, 0x57, 0x8c, 0x4f, 0xe3, 0x34, 0xc8, 0xbc, 0xce, 0xd0,
+	0x1d, 0xf5, 0x4f, 0x76, 0x8f, 0x64, 0x0c, 0x8e, 0xc6, 0xc5, 0x0e, 0xb3, 0x51, 0xe4, 0x05, 0x0c,
+	0xc2, 0xe8, 0xd6, 0x9f, 0x85, 0x41, 0x7e, 0xae, 0x7b, 0xdf, 0xb9, 0x0a, 0x90, 0x1c, 0x42, 0x3b,
+	0x13, 0xbe, 0x58, 0x64, 0x5e, 0x6f, 0xd8, 0x18, 0xb5, 0x98, 0x5e, 0x91, 0x2f, 0xe0, 0x10, 0xbd,
+	0xce, 0xc4, 0xf7, 0xe6, 0xa6, 0xaf, 0xd3, 0x70, 0xca, 0x3d, 0x90, 0x2e, 0xdf, 0xb3, 0x8b, 0xfa,
+	0x12, 0x9e, 0x86, 0x71, 0xe0, 0xf5, 0x25, 0x4e, 0xaf, 0x64, 0x2c, 0xe5, 0x89, 0x6f, 0xee, 0x92,
+	0x30, 0xe5, 0x6f, 0xc2, 0x39, 0xf7, 0x36, 0x75, 0x2c, 0x2b, 0x72, 0xcc, 0xee, 0x34, 0xe5, 0xbe,
+	0x50, 0xa8, 0x2d, 0x89, 0xb2, 0x24, 0xc4, 0x83, 0xce, 0x95, 0x4e, 0xec, 0x40, 0x6e, 0xe6, 0xcb,
+	0x9c, 0x17, 0x3c, 0x3d, 0x0b, 0x82, 0xd4, 0xdb, 0x36, 0xbc, 0x50, 0x12, 0xfa, 0xa7, 0x0b, 0x60,
+	0x82, 0x81, 0x14, 0xf0, 0xa7, 0xd3, 0x78, 0x11, 0x09, 0x89, 0x57, 0x3c, 0xb2, 0x45, 0x98, 0xac,
+	0x4c, 0xf8, 0xa9, 0x90, 0x9e, 0x28, 0x16, 0x19, 0x41, 0x5d, 0xd2, 0xdd, 0xfa, 0xa4, 0x97, 0x90,
+	0x2a, 0x8e, 0xcd, 0x2a, 0x52, 0x05, 0xb0, 0x44, 0x8f, 0x56, 0x95, 0x1e, 0x65, 0x4a, 0x2a, 0x45,
+	0xed, 0x15, 0x4a, 0x16, 0xa9, 0xd0, 0xa9, 0xed, 0x94, 0x52, 0xfb, 0x31, 0x6c, 0xe5, 0x58, 0x15,
+	0xe1, 0xae, 0x54, 0x50, 0x16, 0x62, 0x28, 0xb9, 0x49, 0x55, 0x4f, 0x25, 0xc1, 0x48, 0xd0, 0xcf,
+	0x24, 0xe5, 0x3f, 0x2a, 0x03, 0x20, 0x0d, 0x18, 0x01, 0xda, 0x46, 0xa7, 0x27, 0x8a, 0x06, 0x3d,
+	0xa6, 0x57, 0x28, 0xc7, 0x74, 0x4c, 0x02, 0x99, 0xfc, 0x1e, 0xd3, 0x2b, 0xfa, 0x1b, 0x78, 0x79,
+	0x71, 0x9f, 0x65, 0x19, 0x17, 0xf2, 0x06, 0x3a, 0x4b, 0x4f, 0x00, 0x52, 0xf9, 0x25, 0x3d, 0x69,
+	0x28, 0x4f, 0x8c, 0x84, 0x7c, 0x08, 0xdd, 0x2b, 0xb1, 0x54, 0xb1, 0x50, 0x29, 0x2a, 0xd6, 0x84,
+	0x40, 0x53, 0xbc, 0xf3, 0x13, 0x9d, 0x16, 0xf9, 0x4d, 0xef, 0x80, 0xe4, 0xb6, 0xbe, 0xe5, 0x3c,
+	0xb8, 0x88, 0xa3, 0xeb, 0xf0, 0x06, 0xad, 0xcc, 0xc3, 0x08, 0x05, 0x3c, 0xcd, 0xa4, 0x95, 0x16,
+	0xb3, 0x24, 0xd8, 0x52, 0xe6, 0xfe, 0xdd, 0x98, 0xdf, 0x86, 0x32, 0xc4, 0x79, 0x4b, 0xb1, 0x65,
+	0xa8, 0x03, 0x2d, 0xfc, 0x12, 0x46, 0x41, 0xfc, 0x4e, 0xdb, 0xb4, 0x24, 0xf4, 0xbf, 0x06, 0xec,
+	0xda, 0xa6, 0x59, 0xbc, 0x88, 0x02, 0xa4, 0x73, 0x8a, 0x1f, 0xba, 0x93, 0xb9, 0x2c, 0x5f, 0xe2,
+	0xce, 0xb5, 0x76, 0xc8, 0x19, 0xba, 0xa3, 0x1e, 0xcb, 0x97, 0xb2, 0xcc, 0xf0, 0x82, 0x99, 0xe7,
+	0x0e, 0x5d, 0x59, 0x66, 0x72, 0x55, 0xe6, 0x6b, 0xb3, 0xca, 0xd7, 0xc7, 0xd0, 0x9b, 0xce, 0xe2,
+	0x4c, 0xa5, 0x54, 0x73, 0xab, 0x10, 0xa0, 0xce, 0x39, 0x0f, 0x42, 0x3f, 0xd2, 0x8c, 0xd2, 0xab,
+	0x22, 0x86, 0x1d, 0x13, 0x43, 0x8b, 0x5b, 0x5d, 0x9b, 0x5b, 0xf4, 0x2b, 0x13, 0x5b, 0x99, 0x80,
+	0xd7, 0x71, 0x18, 0x09, 0xb2, 0x0f, 0x2d, 0xe9, 0x9f, 0xbe, 0x9f, 0x5a, 0x48, 0xbd, 0xa6, 0xac,
+	0xe4, 0x37, 0x9d, 0xc0, 0x7e, 0xe9, 0xfc, 0x65, 0x98, 0x89, 0x38, 0x5d, 0x92, 0xe7, 0xd0, 0x4e,
+	0x50, 0x15, 0x66, 0x06, 0x3b, 0xdb, 0x23, 0xdd, 0xd9, 0x56, 0x8d, 0x31, 0x0d, 0xa4, 0x7f, 0x3b,
+	0x30, 0x28, 0x38, 0x35, 0x95, 0xf9, 0x79, 0x06, 0x6d, 0xd5, 0x46, 0xa4, 0x23, 0xfd, 0x93, 0x83,
+	0x8a, 0x96, 0x0b, 0xb9, 0x79, 0xb9, 0xc1, 0x34, 0x8c, 0x3c, 0x85, 0x26, 0x12, 0x57, 0xba, 0xd8,
+	0x3f, 0xd9, 0xab, 0xc0, 0xb1, 0x93, 0x5c, 0x6e, 0x30, 0x09, 0x21, 0x9f, 0x41, 0x2b, 0xe5, 0x89,
+	0xbf, 0x94, 0x69, 0xef, 0x9f, 0xec, 0x57, 0xb0, 0x0c, 0xf7, 0x2e, 0x37, 0x98, 0x02, 0xa1, 0x62,
+	0x4c, 0xa5, 0xd7, 0xac, 0x55, 0x8c, 0xdc, 0x40, 0xc5, 0x08, 0x41, 0xc5, 0x32, 0x47, 0x5e, 0xab,
+	0x56, 0xf1, 0x05, 0xee, 0xa1, 0x62, 0x09, 0xc2, 0x2b, 0xce, 0xfd, 0xc8, 0xbf, 0x51, 0x6d, 0x61,
+	0xf5, 0x8a, 0xaf, 0xe4, 0x26, 0x5e, 0x51, 0xc1, 0xc8, 0x00, 0x1c, 0xb1, 0xd4, 0x05, 0xec, 0x88,
+	0xe5, 0x79, 0x07, 0x5a, 0xb7, 0xd8, 0x6a, 0xe8, 0xef, 0x30, 0x28, 0x1f, 0x42, 0x7a, 0x67, 0x8b,
+	0x44, 0xb5, 0x52, 0x95, 0x88, 0x1e, 0xb3, 0x24, 0xe4, 0x05, 0xc0, 0x75, 0x51, 0x50, 0x3a, 0x66,
+	0x8f, 0x6a, 0xae, 0xa6, 0x00, 0xcc, 0x02, 0xd3, 0xbf, 0x1a, 0x30, 0x28, 0x67, 0x61, 0xe5, 0x0d,
+	0x6f, 0xac, 0x7f, 0xc3, 0x9d, 0x87, 0xbd, 0xe1, 0xee, 0x3d, 0x6f, 0xb8, 0x79, 0xbb, 0x9a, 0xf6,
+	0xdb, 0x45, 0xc7, 0xb0, 0x69, 0xa7, 0x7c, 0xed, 0xf4, 0xb1, 0xaf, 0x43, 0xa8, 0xfd, 0xd1, 0xf1,
+	0x7c, 0x09, 0x5b, 0x25, 0x32, 0xac, 0x55, 0x63, 0x7a, 0xa8, 0x63, 0xf7, 0x50, 0xfa, 0x2b, 0x6c,
+	0xda, 0xd1, 0xc4, 0xfe, 0x87, 0x8f, 0xc8, 0x9b, 0x65, 0xc2, 0x75, 0xdf, 0x2a, 0xd6, 0xa6, 0xf2,
+	0x1c, 0xd9, 0x26, 0xd4, 0x02, 0x35, 0xdf, 0xc6, 0xb3, 0xc5, 0x9c, 0xe7, 0xdd, 0x43, 0xad, 0xe8,
+	0x33, 0xd8, 0x2a, 0xd1, 0x6a, 0x9d, 0x8b, 0xf4, 0x8f, 0x06, 0x6c, 0x33, 0x3e, 0xe5, 0x61, 0x22,
+	0x1e, 0x3c, 0x9b, 0x55, 0x1e, 0x5d, 0x67, 0xf5, 0xd1, 0x35, 0x17, 0x77, 0xab, 0x8f, 0x87, 0x6e,
+	0x3a, 0xcd, 0x52, 0xd3, 0xf9, 0x0e, 0x76, 0x2b, 0x4e, 0x4c, 0xc6, 0x0f, 0x89, 0xae, 0x56, 0xe6,
+	0x94, 0x94, 0x5d, 0xc0, 0xb6, 0x49, 0x93, 0x9a, 0x91, 0x8e, 0xa1, 0xa3, 0x9e, 0x9b, 0xbc, 0xfb,
+	0x1c, 0x6a, 0x52, 0x57, 0xac, 0xb2, 0x1c, 0x46, 0x9f, 0x63, 0x58, 0xde, 0x16, 0xde, 0x44, 0xd7,
+	0xf1, 0xda, 0x50, 0xfe, 0xeb, 0xc0, 0x21, 0xe3, 0x49, 0x11, 0xff, 0x45, 0x9a, 0xf2, 0x48, 0xc8,
+	0xa3, 0xc6, 0xd5, 0x46, 0xe9, 0x21, 0x7f, 0xff, 0x53, 0xae, 0x35, 0x79, 0xb5, 0xca, 0x93, 0x57,
+	0xcd, 0x28, 0xd4, 0x7e, 0xc0, 0xfc, 0xdb, 0xa9, 0x0e, 0x38, 0xa6, 0x06, 0xbb, 0xa5, 0xf9, 0xd1,
+	0x0c, 0x0e, 0x3d, 0x7b, 0x70, 0xa8, 0xcc, 0x8a, 0x50, 0x9d, 0x15, 0xe9, 0xe7, 0xb0, 0x53, 0xc9,
+	0x44, 0x86, 0x31, 0x31, 0x81, 0xcf, 0x1b, 0x99, 0x2d, 0xa2, 0x3f, 0xc0, 0x07, 0xf5, 0xb9, 0xc8,
+	0xc8, 0x29, 0xb4, 0x42, 0xfc, 0xd0, 0x54, 0xf8, 0xa8, 0xa0, 0x42, 0x1d, 0x9c, 0x29, 0x2c, 0x7d,
+	0x05, 0x7b, 0x96, 0x17, 0xe7, 0x4b, 0x33, 0x25, 0xd5, 0x26, 0xb6, 0xcc, 0x15, 0x67, 0x85, 0x2b,
+	0x14, 0x06, 0x96, 0xbd, 0xc9, 0x38, 0x23, 0x3b, 0xe0, 0x4e, 0xc6, 0xf9, 0x55, 0xf0, 0x13, 0xa7,
+	0x1c, 0xcb, 0x64, 0x4e, 0xe5, 0x75, 0x55, 0x41, 0xa0, 0xe9, 0x9b, 0xaa, 0x94, 0xdf, 0x96, 0x97,
+	0x6e, 0xc9, 0x4b, 0x53, 0xa6, 0xcd, 0x52, 0x7f, 0x3a, 0x03, 0x62, 0x79, 0x97, 0x5b, 0xfe, 0xb4,
+	0x5a, 0x44, 0x35, 0x7f, 0x4e, 0x8a, 0xfa, 0xf9, 0x1a, 0xf6, 0x2c, 0x15, 0x88, 0x90, 0x85, 0xf0,
+	0x14, 0xda, 0x0a, 0xa1, 0xdf, 0xef, 0x1a, 0x15, 0x1a, 0x40, 0xbf, 0x84, 0x1d, 0x4b, 0x83, 0x1a,
+	0x06, 0xef, 0x1f, 0x43, 0x70, 0xbc, 0x71, 0xac, 0x11, 0xf1, 0xa4, 0x54, 0x8b, 0x3f, 0x65, 0x3c,
+	0xcd, 0xeb, 0xc9, 0xaa, 0x80, 0x46, 0xa9, 0x02, 0xe8, 0x31, 0xec, 0x5b, 0x01, 0x7f, 0xc0, 0x78,
+	0x47, 0x5f, 0xc2, 0x41, 0xdd, 0x89, 0xec, 0xfe, 0x23, 0x78, 0x05, 0xd9, 0x27, 0x75, 0xd3, 0x52,
+	0x0b, 0x3a, 0x81, 0x03, 0xcb, 0x5d, 0x4b, 0xd1, 0x31, 0xb4, 0xe5, 0xc9, 0x3c, 0xe6, 0x5e, 0xcd,
+	0x6b, 0x2c, 0xa1, 0x4c, 0xe3, 0xae, 0xda, 0xf2, 0x4f, 0xf7, 0xe9, 0xff, 0x03, 0x00, 0x5a, 0x9c,
+	0x56, 0x55, 0x86, 0x0f, 0x00, 0x00,
 }
@@ -42,6 +42,9 @@ type IssuanceCloseTx struct {
 
 // IssuanceManageTx for construction
 type IssuanceManageTx struct {
-	Addr []string `json:"addr"`
-	Fee  int64    `json:"fee"`
+	Addr         []string `json:"addr"`
+	MinFeeders   int32    `json:"minFeeders"`
+	MaxDeviation float64  `json:"maxDeviation"`
+	TwapWindow   int64    `json:"twapWindow"`
+	Fee          int64    `json:"fee"`
 }
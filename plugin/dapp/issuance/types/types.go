@@ -4,6 +4,8 @@
 
 package types
 
+import "github.com/33cn/plugin/plugin/dapp/pricefeed"
+
 //Issuance op
 const (
 	IssuanceActionCreate = 1 + iota // 创建借贷
@@ -19,6 +21,7 @@ const (
 	TyLogIssuanceRepay  = 743
 	TyLogIssuanceFeed   = 745
 	TyLogIssuanceClose  = 746
+	TyLogIssuanceRound  = 747
 )
 
 // Issuance name
@@ -44,6 +47,14 @@ const (
 	IssuanceUserStatusClose
 )
 
+//喂价轮次状态
+const (
+	IssuanceRoundStatusOpen     = pricefeed.RoundStatusOpen     // 等待更多喂价
+	IssuanceRoundStatusAccepted = pricefeed.RoundStatusAccepted // 价格已接受
+	IssuanceRoundStatusHeld     = pricefeed.RoundStatusHeld     // 偏离过大，等待下一轮确认
+	IssuanceRoundStatusExpired  = pricefeed.RoundStatusExpired  // 超时未达到喂价人数
+)
+
 //type ...
 const (
 	PriceFeedKey = "issuance-price-feed"
@@ -55,4 +66,5 @@ const (
 //fork ...
 var (
 	ForkIssuanceTableUpdate = "ForkIssuanceTableUpdate"
+	ForkIssuancePriceRound  = "ForkIssuancePriceRound"
 )
@@ -11,6 +11,7 @@ import (
 	"math/rand"
 	"testing"
 
+	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
@@ -22,6 +23,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	rt "github.com/33cn/plugin/plugin/dapp/lottery/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
 )
 
 var (
@@ -135,6 +137,102 @@ func TestExecDrawLottery(t *testing.T) {
 	// mock message between randnum nextstep
 }
 
+func TestFindLuckyNumRandao(t *testing.T) {
+	stateDB, _ := db.NewGoMemDB("1", "2", 100)
+	lott := NewLotteryDB("lotteryid", 30, 40, 10, creatorAddr)
+	lott.Randao = true
+	lott.Round = 1
+	lott.LastTransToPurState = 10
+	action := &Action{db: stateDB}
+
+	// 尚未绑定随机数轮次
+	if _, err := action.findLuckyNum(false, lott); err != types.ErrNotFound {
+		t.Error(err)
+	}
+
+	round := &rty.RandaoRound{
+		RoundID:         "roundid",
+		Creator:         creatorAddr,
+		Status:          rty.RandaoStatusFinished,
+		CommitEndHeight: 40,
+		Result:          common.Sha256([]byte("random")),
+	}
+	stateDB.Set(rty.RoundKey(round.RoundID), types.Encode(round))
+	stateDB.Set(rty.ConsumerKey(creatorAddr, rt.RandaoConsumer(lott.LotteryId, lott.Round)), []byte(round.RoundID))
+	num, err := action.findLuckyNum(false, lott)
+	if err != nil || num != int64(rty.RandomUint64(round.Result, nil)%luckyNumMol) {
+		t.Error(num, err)
+	}
+
+	// 轮次提交截止高度早于购买截止高度，不能用于开奖
+	lott.PurBlockNum = 31
+	if _, err = action.findLuckyNum(false, lott); err != rty.ErrRandaoCommitEnd {
+		t.Error(err)
+	}
+
+	// 提交-揭示轮次的最少揭示人数低于要求，不能用于开奖
+	lott.PurBlockNum = 30
+	round.Mode = rty.RandaoModeCommitReveal
+	round.MinReveals = rt.RandaoMinReveals - 1
+	stateDB.Set(rty.RoundKey(round.RoundID), types.Encode(round))
+	if _, err = action.findLuckyNum(false, lott); err != rty.ErrRandaoMinReveals {
+		t.Error(err)
+	}
+	round.MinReveals = rt.RandaoMinReveals
+	stateDB.Set(rty.RoundKey(round.RoundID), types.Encode(round))
+	if _, err = action.findLuckyNum(false, lott); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLotteryDrawRandaoVoid(t *testing.T) {
+	stateDB, _ := db.NewGoMemDB("1", "2", 100)
+	execAddr := address.ExecAddress(rt.LotteryX)
+	coinsAccount := account.NewCoinsAccount(chainTestCfg)
+	coinsAccount.SetDB(stateDB)
+	coinsAccount.SaveExecAccount(execAddr, &types.Account{Addr: creatorAddr, Frozen: 500 * decimal})
+
+	lott := NewLotteryDB("voidid", 30, 40, 10, creatorAddr)
+	lott.Randao = true
+	lott.Round = 1
+	lott.Status = rt.LotteryPurchase
+	lott.LastTransToPurState = 10
+	lott.Fund = 500
+	lott.BuyAmount = 300
+	lott.TotalAddrNum = 2
+	lott.PurRecords = []*rt.PurchaseRecords{
+		{Addr: buyAddr, AmountOneRound: 100, Record: []*rt.PurchaseRecord{{Amount: 100, Number: 12345}}},
+		{Addr: "1PUiGcbsccfxW3zuvHXZBJfznziph5miAo", AmountOneRound: 200, Record: []*rt.PurchaseRecord{{Amount: 200, Number: 1}}},
+	}
+	lott.Save(stateDB)
+
+	// 绑定的轮次揭示人数不足失败，业务标识不能重新绑定，本期作废退款
+	round := &rty.RandaoRound{RoundID: "voidround", Creator: creatorAddr, Status: rty.RandaoStatusFailed, CommitEndHeight: 40}
+	stateDB.Set(rty.RoundKey(round.RoundID), types.Encode(round))
+	stateDB.Set(rty.ConsumerKey(creatorAddr, rt.RandaoConsumer(lott.LotteryId, lott.Round)), []byte(round.RoundID))
+
+	action := &Action{coinsAccount: coinsAccount, db: stateDB, fromaddr: creatorAddr, height: 60, execaddr: execAddr, api: lottery.(*Lottery).GetAPI()}
+	receipt, err := action.LotteryDraw(&rt.LotteryDraw{LotteryId: lott.LotteryId})
+	if err != nil || receipt.Ty != types.ExecOk {
+		t.Fatal(err)
+	}
+	if acc := coinsAccount.LoadExecAccount(creatorAddr, execAddr); acc.Frozen != 200*decimal {
+		t.Error("creator frozen", acc.Frozen)
+	}
+	if acc := coinsAccount.LoadExecAccount(buyAddr, execAddr); acc.Balance != 100*decimal {
+		t.Error("buyer balance", acc.Balance)
+	}
+	saved, err := findLottery(stateDB, lott.LotteryId)
+	if err != nil || saved.Status != rt.LotteryDrawed || saved.Fund != 200 || len(saved.PurRecords) != 0 || saved.BuyAmount != 0 {
+		t.Error(saved, err)
+	}
+	var drawLog rt.ReceiptLottery
+	types.Decode(receipt.Logs[len(receipt.Logs)-1].Log, &drawLog)
+	if drawLog.LuckyNumber != voidLuckyNum || drawLog.BuyAmount != 300 {
+		t.Error(drawLog.LuckyNumber, drawLog.BuyAmount)
+	}
+}
+
 func genaddress() (string, crypto.PrivKey) {
 	cr, err := crypto.New(types.GetSignName("", types.SECP256K1))
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/lottery/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
 )
 
 const (
@@ -49,9 +50,10 @@ const (
 )
 
 const (
-	luckyNumMol = 100000
-	decimal     = types.Coin //1e8
-	blockNum    = 5
+	luckyNumMol  = 100000
+	decimal      = types.Coin //1e8
+	blockNum     = 5
+	voidLuckyNum = -1 //randao轮次无法产生随机数时本期作废，开奖记录的号码为-1
 )
 
 const (
@@ -252,6 +254,12 @@ func (action *Action) LotteryCreate(create *pty.LotteryCreate) (*types.Receipt,
 		return nil, pty.ErrLotteryDrawBlockLimit
 	}
 
+	cfg := action.api.GetConfig()
+	// 平行链上购买截止以主链高度计算，无法与randao轮次的本链高度对应
+	if create.Randao && (!cfg.IsDappFork(action.height, pty.LotteryX, pty.ForkLotteryRandao) || cfg.IsPara()) {
+		return nil, pty.ErrLotteryRandaoNotSupport
+	}
+
 	_, err := findLottery(action.db, lotteryID)
 	if err != types.ErrNotFound {
 		llog.Error("LotteryCreate", "LotteryCreate repeated", lotteryID)
@@ -265,8 +273,8 @@ func (action *Action) LotteryCreate(create *pty.LotteryCreate) (*types.Receipt,
 	lott.DevRewardRatio = create.DevRewardRatio
 	lott.TotalAddrNum = 0
 	lott.BuyAmount = 0
+	lott.Randao = create.Randao
 	llog.Debug("LotteryCreate", "OpRewardRatio", lott.OpRewardRatio, "DevRewardRatio", lott.DevRewardRatio)
-	cfg := action.api.GetConfig()
 	if cfg.IsPara() {
 		lott.CreateOnMain = action.lottery.GetMainHeight()
 	}
@@ -455,6 +463,10 @@ func (action *Action) LotteryDraw(draw *pty.LotteryDraw) (*types.Receipt, error)
 
 	rec, updateInfo, gainInfos, luckyAddrNum, totalFund, factor, err := action.checkDraw(lott)
 	if err != nil {
+		// 业务标识绑定的轮次失败后不能重新绑定，本期作废并退款
+		if lott.Randao && rty.IsConsumerVoid(err) {
+			return action.voidDraw(lott, preStatus)
+		}
 		return nil, err
 	}
 	kv = append(kv, rec.KV...)
@@ -470,6 +482,49 @@ func (action *Action) LotteryDraw(draw *pty.LotteryDraw) (*types.Receipt, error)
 	return receipt, nil
 }
 
+// voidDraw randao轮次失败、提交截止高度早于购买截止高度或者最少揭示人数不足，本期购买金额全部退还
+func (action *Action) voidDraw(lott *LotteryDB, preStatus int32) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	var gainInfos pty.LotteryGainInfos
+
+	llog.Info("LotteryDraw void", "lotteryID", lott.LotteryId, "round", lott.Round, "buyAmount", lott.BuyAmount)
+	addrNumThisRound := lott.TotalAddrNum
+	buyAmountThisRound := lott.BuyAmount
+	for _, recs := range lott.PurRecords {
+		gainInfos.Gains = append(gainInfos.Gains, &pty.LotteryGainInfo{Addr: recs.Addr, BuyAmount: recs.AmountOneRound, FundAmount: 0})
+		if recs.AmountOneRound <= 0 {
+			continue
+		}
+		receipt, err := action.coinsAccount.ExecTransferFrozen(lott.CreateAddr, recs.Addr, action.execaddr, decimal*recs.AmountOneRound)
+		if err != nil {
+			llog.Error("LotteryDraw void", "addr", recs.Addr, "amount", recs.AmountOneRound, "err", err)
+			return nil, err
+		}
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	}
+
+	lott.Fund -= lott.BuyAmount
+	for i := range lott.PurRecords {
+		lott.PurRecords[i].Record = lott.PurRecords[i].Record[0:0]
+	}
+	lott.PurRecords = lott.PurRecords[0:0]
+	lott.LastTransToDrawState = action.height
+	lott.Status = pty.LotteryDrawed
+	lott.TotalPurchasedTxNum = 0
+	lott.TotalAddrNum = 0
+	lott.BuyAmount = 0
+
+	lott.Save(action.db)
+	kv = append(kv, lott.GetKVSet()...)
+
+	receiptLog := action.GetDrawReceiptLog(&lott.Lottery, preStatus, lott.Round, voidLuckyNum, &pty.LotteryUpdateBuyInfo{}, addrNumThisRound,
+		buyAmountThisRound, &gainInfos, 0, 0, 0)
+	logs = append(logs, receiptLog)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
 // LotteryClose Action
 func (action *Action) LotteryClose(draw *pty.LotteryClose) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
@@ -543,7 +598,16 @@ func (action *Action) LotteryClose(draw *pty.LotteryClose) (*types.Receipt, erro
 //random used for verification in solo
 func (action *Action) findLuckyNum(isSolo bool, lott *LotteryDB) (int64, error) {
 	var num int64
-	if isSolo {
+	if lott.Randao {
+		// 随机数轮次的提交截止高度不能早于本期购买截止高度，且揭示人数不能过少，保证购买期间无人能预知开奖号码
+		random, err := rty.GetConsumerRandom(action.db, lott.CreateAddr, pty.RandaoConsumer(lott.LotteryId, lott.Round),
+			lott.LastTransToPurState+lott.PurBlockNum, pty.RandaoMinReveals)
+		if err != nil {
+			llog.Error("findLuckyNum randao", "lotteryID", lott.LotteryId, "round", lott.Round, "err", err)
+			return -1, err
+		}
+		num = int64(rty.RandomUint64(random, nil) % luckyNumMol)
+	} else if isSolo {
 		//used for internal verification
 		num = 12345
 	} else {
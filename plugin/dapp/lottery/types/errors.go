@@ -26,4 +26,5 @@ var (
 	ErrNodeNotExist             = errors.New("ErrNodeNotExist")
 	ErrEmptyMinerTx             = errors.New("ErrEmptyMinerTx")
 	ErrRewardFactor             = errors.New("ErrRewardFactor")
+	ErrLotteryRandaoNotSupport  = errors.New("ErrLotteryRandaoNotSupport")
 )
@@ -26,6 +26,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(LotteryX, "Enable", 0)
+	cfg.RegisterDappFork(LotteryX, ForkLotteryRandao, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -129,6 +130,7 @@ func CreateRawLotteryCreateTx(cfg *types.Chain33Config, parm *LotteryCreateTx) (
 		DrawBlockNum:   parm.DrawBlockNum,
 		OpRewardRatio:  parm.OpRewardRatio,
 		DevRewardRatio: parm.DevRewardRatio,
+		Randao:         parm.Randao,
 	}
 	create := &LotteryAction{
 		Ty:    LotteryActionCreate,
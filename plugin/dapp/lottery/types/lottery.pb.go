@@ -170,6 +170,7 @@ type Lottery struct {
 	PurRecords                 []*PurchaseRecords `protobuf:"bytes,20,rep,name=purRecords,proto3" json:"purRecords,omitempty"`
 	TotalAddrNum               int64              `protobuf:"varint,21,opt,name=totalAddrNum,proto3" json:"totalAddrNum,omitempty"`
 	BuyAmount                  int64              `protobuf:"varint,22,opt,name=buyAmount,proto3" json:"buyAmount,omitempty"`
+	Randao                     bool               `protobuf:"varint,23,opt,name=randao,proto3" json:"randao,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}           `json:"-"`
 	XXX_unrecognized           []byte             `json:"-"`
 	XXX_sizecache              int32              `json:"-"`
@@ -347,6 +348,13 @@ func (m *Lottery) GetBuyAmount() int64 {
 	return 0
 }
 
+func (m *Lottery) GetRandao() bool {
+	if m != nil {
+		return m.Randao
+	}
+	return false
+}
+
 type MissingRecord struct {
 	Times                []int32  `protobuf:"varint,1,rep,packed,name=times,proto3" json:"times,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -509,6 +517,7 @@ type LotteryCreate struct {
 	DrawBlockNum         int64    `protobuf:"varint,2,opt,name=drawBlockNum,proto3" json:"drawBlockNum,omitempty"`
 	OpRewardRatio        int64    `protobuf:"varint,3,opt,name=opRewardRatio,proto3" json:"opRewardRatio,omitempty"`
 	DevRewardRatio       int64    `protobuf:"varint,4,opt,name=devRewardRatio,proto3" json:"devRewardRatio,omitempty"`
+	Randao               bool     `protobuf:"varint,5,opt,name=randao,proto3" json:"randao,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -567,6 +576,13 @@ func (m *LotteryCreate) GetDevRewardRatio() int64 {
 	return 0
 }
 
+func (m *LotteryCreate) GetRandao() bool {
+	if m != nil {
+		return m.Randao
+	}
+	return false
+}
+
 type LotteryBuy struct {
 	LotteryId            string   `protobuf:"bytes,1,opt,name=lotteryId,proto3" json:"lotteryId,omitempty"`
 	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -2327,100 +2343,101 @@ func init() {
 }
 
 var fileDescriptor_2cce7afd61783b10 = []byte{
-	// 1509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0x1c, 0xc5,
-	0x13, 0xf7, 0x7c, 0xed, 0x7a, 0x6b, 0xbd, 0xeb, 0xb8, 0xed, 0x38, 0xf3, 0xcf, 0x1f, 0x59, 0xd6,
-	0x88, 0x20, 0x4b, 0x09, 0x2b, 0x58, 0x3e, 0x84, 0x20, 0x42, 0xc4, 0x21, 0x61, 0x2d, 0xe5, 0x4b,
-	0x1d, 0x23, 0x0e, 0x70, 0x19, 0xef, 0x4c, 0xe2, 0x51, 0xd6, 0x33, 0xcb, 0x4c, 0x4f, 0xec, 0x11,
-	0x17, 0xae, 0x9c, 0x91, 0x78, 0x01, 0x2e, 0x1c, 0x79, 0x04, 0x4e, 0xbc, 0x01, 0x48, 0xbc, 0x05,
-	0x8f, 0x80, 0xfa, 0x63, 0xa6, 0x7b, 0x7a, 0x67, 0x3d, 0x36, 0xc9, 0x81, 0x93, 0xa7, 0xab, 0xab,
-	0xbb, 0xaa, 0xab, 0x7e, 0x55, 0xf5, 0x5b, 0xc3, 0x60, 0x96, 0x10, 0x12, 0xa6, 0xc5, 0x68, 0x9e,
-	0x26, 0x24, 0x41, 0x0e, 0x29, 0xe6, 0x61, 0xe6, 0x1d, 0xc3, 0xf0, 0x49, 0x9e, 0x4e, 0x8f, 0xfd,
-	0x2c, 0xc4, 0xe1, 0x34, 0x49, 0x03, 0xb4, 0x0d, 0x1d, 0xff, 0x24, 0xc9, 0x63, 0xe2, 0x1a, 0xbb,
-	0xc6, 0x9e, 0x85, 0xc5, 0x8a, 0xca, 0xe3, 0xfc, 0xe4, 0x28, 0x4c, 0x5d, 0x93, 0xcb, 0xf9, 0x0a,
-	0x6d, 0x81, 0x13, 0xc5, 0x41, 0x78, 0xe6, 0x5a, 0x4c, 0xcc, 0x17, 0xe8, 0x0a, 0x58, 0xa7, 0x7e,
-	0xe1, 0xda, 0x4c, 0x46, 0x3f, 0xbd, 0x9f, 0x0c, 0x58, 0xaf, 0x9b, 0xca, 0xd0, 0xdb, 0xd0, 0x49,
-	0xd9, 0xa7, 0x6b, 0xec, 0x5a, 0x7b, 0xfd, 0xf1, 0xd5, 0x11, 0xf3, 0x6a, 0x54, 0xd7, 0xc3, 0x42,
-	0x09, 0xb9, 0xd0, 0x7d, 0x96, 0xc7, 0xc1, 0x57, 0x51, 0x2c, 0x7c, 0x28, 0x97, 0xe8, 0x2d, 0x18,
-	0x72, 0x37, 0x1f, 0xc7, 0x21, 0x4e, 0xf2, 0x38, 0x10, 0xde, 0x68, 0x52, 0x84, 0xc0, 0xf6, 0x83,
-	0x20, 0x65, 0x7e, 0xf5, 0x30, 0xfb, 0xf6, 0xfe, 0xe8, 0x40, 0xf7, 0x01, 0x8f, 0x0d, 0x7a, 0x03,
-	0x7a, 0x22, 0x4c, 0x07, 0x01, 0x7b, 0x7f, 0x0f, 0x4b, 0x01, 0x0d, 0x41, 0x46, 0x7c, 0x92, 0x67,
-	0xcc, 0xbc, 0x83, 0xc5, 0x0a, 0x79, 0xb0, 0x36, 0x4d, 0x43, 0x9f, 0x84, 0x93, 0x30, 0x7a, 0x7e,
-	0x4c, 0x84, 0xed, 0x9a, 0x8c, 0x5a, 0xa6, 0xce, 0x8a, 0x88, 0xb0, 0x6f, 0xb4, 0x0b, 0xfd, 0x79,
-	0x9e, 0xee, 0xcf, 0x92, 0xe9, 0x8b, 0x47, 0xf9, 0x89, 0xeb, 0xb0, 0x2d, 0x55, 0x44, 0x6f, 0x0e,
-	0x52, 0xff, 0xb4, 0x52, 0xe9, 0xf0, 0x9b, 0x55, 0x19, 0x7a, 0x07, 0x36, 0x67, 0x7e, 0x46, 0x0e,
-	0x53, 0x3f, 0xce, 0x0e, 0x93, 0x27, 0x79, 0xfa, 0x94, 0xf8, 0x24, 0x74, 0xbb, 0x4c, 0xb5, 0x69,
-	0x0b, 0x8d, 0x61, 0x4b, 0x11, 0x7f, 0x9e, 0xfa, 0xa7, 0xfc, 0xc8, 0x2a, 0x3b, 0xd2, 0xb8, 0x47,
-	0xad, 0x90, 0x84, 0xf8, 0xb3, 0x32, 0x35, 0xc1, 0xe1, 0x19, 0x75, 0x08, 0xb8, 0x95, 0x86, 0x2d,
-	0xb4, 0x03, 0xc0, 0x23, 0x70, 0x87, 0x46, 0xbc, 0xcf, 0x82, 0xa9, 0x48, 0x28, 0x70, 0x52, 0x96,
-	0xaa, 0x35, 0x0e, 0x1c, 0xb6, 0xa0, 0x31, 0x99, 0xe5, 0xd3, 0x17, 0xc5, 0x23, 0x8e, 0xb5, 0x01,
-	0x8f, 0x89, 0x22, 0x92, 0xd1, 0x7e, 0x1c, 0x3f, 0xf4, 0xa3, 0xd8, 0x1d, 0xaa, 0xd1, 0xe6, 0x32,
-	0x74, 0x1b, 0xfe, 0xd7, 0xf0, 0x70, 0x71, 0x60, 0x9d, 0x1d, 0x58, 0xae, 0x80, 0x3e, 0x85, 0xeb,
-	0x4d, 0x31, 0x10, 0xc7, 0xaf, 0xb0, 0xe3, 0xe7, 0x68, 0xa0, 0xdb, 0x30, 0x3c, 0x89, 0xb2, 0x2c,
-	0x8a, 0x9f, 0x0b, 0xa0, 0xbb, 0x1b, 0x0c, 0xde, 0x5b, 0x02, 0xde, 0x0f, 0xd5, 0x4d, 0xac, 0xe9,
-	0xa2, 0x37, 0x61, 0x90, 0xcc, 0x71, 0x78, 0xea, 0xa7, 0x01, 0xf6, 0x49, 0x94, 0xb8, 0x88, 0x19,
-	0xac, 0x0b, 0x29, 0xe2, 0x83, 0xf0, 0xa5, 0xaa, 0xb6, 0xc9, 0x11, 0x5f, 0x97, 0xa2, 0x0f, 0x01,
-	0xe6, 0x79, 0x5a, 0xfa, 0xb1, 0xc5, 0xfc, 0xd8, 0x6e, 0x2c, 0xb3, 0x0c, 0x2b, 0x9a, 0x34, 0xca,
-	0x2c, 0xa9, 0x34, 0x55, 0x34, 0xd1, 0x57, 0x79, 0x94, 0x55, 0x19, 0xad, 0x96, 0xa3, 0xbc, 0xb8,
-	0xc3, 0xbb, 0xc5, 0x36, 0x53, 0x90, 0x02, 0xef, 0x06, 0x0c, 0x6a, 0x0f, 0xa5, 0x09, 0x27, 0xd1,
-	0x49, 0x98, 0xb1, 0x62, 0x77, 0x30, 0x5f, 0x78, 0x7f, 0x1a, 0x30, 0x10, 0xe5, 0x77, 0x67, 0x4a,
-	0xa2, 0x24, 0x46, 0x23, 0xe8, 0xf0, 0x64, 0xb2, 0x0a, 0x94, 0x61, 0x13, 0x5a, 0x77, 0x79, 0x59,
-	0xad, 0x60, 0xa1, 0x85, 0x6e, 0x80, 0x75, 0x94, 0x17, 0xac, 0x26, 0xfb, 0xe3, 0x8d, 0xba, 0xf2,
-	0x7e, 0x5e, 0x4c, 0x56, 0x30, 0xdd, 0x47, 0x7b, 0x60, 0xd3, 0xba, 0x61, 0xd5, 0xd9, 0x1f, 0xa3,
-	0xba, 0x1e, 0x4d, 0xe1, 0x64, 0x05, 0x33, 0x0d, 0x74, 0x13, 0x9c, 0xe9, 0x2c, 0xc9, 0x42, 0x56,
-	0xac, 0xfd, 0xf1, 0xa6, 0x66, 0x9f, 0x6e, 0x4d, 0x56, 0x30, 0xd7, 0x41, 0x43, 0x30, 0x49, 0xc1,
-	0xea, 0xc0, 0xc1, 0x26, 0x29, 0xf6, 0xbb, 0xe0, 0xbc, 0xf4, 0x67, 0x79, 0xe8, 0xfd, 0x2c, 0x1f,
-	0xc6, 0x5d, 0xd6, 0xeb, 0xdd, 0x68, 0xaf, 0x77, 0xb3, 0xa1, 0xde, 0x17, 0xf0, 0x61, 0x5d, 0x0c,
-	0x1f, 0x76, 0x13, 0x3e, 0xbc, 0x19, 0x80, 0x0c, 0x55, 0x7b, 0xff, 0x13, 0xa3, 0xc1, 0x5c, 0x32,
-	0x1a, 0xac, 0xda, 0x68, 0x58, 0x1c, 0x02, 0x37, 0xa1, 0xaf, 0x04, 0xfc, 0x7c, 0x73, 0xde, 0x2d,
-	0x58, 0x53, 0x43, 0xde, 0xa2, 0xfd, 0xbb, 0x0d, 0x43, 0x1c, 0x4e, 0xc3, 0x68, 0x4e, 0x5e, 0xad,
-	0x9b, 0xef, 0x00, 0xcc, 0xd3, 0xf0, 0xe5, 0x53, 0xbe, 0x67, 0xb1, 0x3d, 0x45, 0xd2, 0x34, 0x43,
-	0x64, 0x2f, 0x73, 0xd4, 0x5e, 0x26, 0xe3, 0xd2, 0xa9, 0xc5, 0x45, 0xc6, 0xb1, 0x5b, 0x8b, 0xa3,
-	0xd6, 0xfb, 0x56, 0x17, 0x7b, 0x1f, 0x02, 0x9b, 0x56, 0x8d, 0xdb, 0xe3, 0x53, 0x84, 0x7e, 0xd3,
-	0xdb, 0xc8, 0xd9, 0xc4, 0xcf, 0x8e, 0x19, 0x08, 0x7b, 0x58, 0xac, 0xd0, 0x27, 0x00, 0xf9, 0x3c,
-	0xf0, 0x49, 0x78, 0x10, 0x3f, 0x4b, 0x58, 0xff, 0xed, 0x8f, 0xff, 0x5f, 0x87, 0xf2, 0x97, 0x6c,
-	0x7f, 0x3f, 0x2f, 0xa8, 0x0a, 0x56, 0xd4, 0xcb, 0xd4, 0xad, 0x55, 0xa9, 0x93, 0x73, 0x7e, 0xa0,
-	0xce, 0x79, 0xbd, 0x4d, 0x0c, 0xdb, 0xda, 0xc4, 0xba, 0xd6, 0x26, 0xd0, 0x07, 0xd0, 0x7b, 0xee,
-	0x47, 0x31, 0xb5, 0x9a, 0xb1, 0xde, 0xda, 0x1f, 0x5f, 0xab, 0x7b, 0xf9, 0x45, 0xb9, 0x8d, 0xa5,
-	0x26, 0x35, 0xcc, 0x02, 0x53, 0x1a, 0xde, 0xe0, 0x86, 0x55, 0x19, 0x35, 0xcc, 0x1c, 0xb9, 0x4f,
-	0x33, 0xc3, 0xbb, 0xa8, 0x14, 0xd0, 0xb8, 0x3d, 0xf3, 0xa7, 0x24, 0x49, 0x45, 0xe7, 0x14, 0x2b,
-	0x6f, 0x44, 0x71, 0xf4, 0xad, 0xb0, 0xcd, 0x82, 0x71, 0x3e, 0xf0, 0xbe, 0x86, 0x0d, 0xa9, 0x2f,
-	0x62, 0xd9, 0x02, 0xbd, 0x12, 0x42, 0x66, 0x13, 0x84, 0x2c, 0x05, 0x42, 0xde, 0x2f, 0x06, 0x6c,
-	0xd5, 0x6e, 0x9f, 0x44, 0x19, 0x49, 0x5a, 0xb1, 0x7d, 0x61, 0x03, 0x54, 0x3a, 0x65, 0x89, 0xb1,
-	0x19, 0xd0, 0xf9, 0x82, 0xde, 0x1e, 0x44, 0x69, 0xc8, 0xfa, 0x31, 0xc3, 0xb4, 0x83, 0xa5, 0x40,
-	0x42, 0xa1, 0xa3, 0x40, 0xc1, 0x3b, 0x80, 0x4d, 0xe9, 0xe9, 0x03, 0x9a, 0x87, 0x0b, 0x44, 0xa2,
-	0x72, 0xca, 0xdc, 0xb5, 0xe4, 0xab, 0xbf, 0x37, 0x60, 0x5b, 0xbb, 0xeb, 0x62, 0xef, 0x56, 0xae,
-	0x6b, 0x7a, 0xa3, 0xb5, 0xf4, 0x8d, 0xb6, 0xf6, 0x46, 0xef, 0x6f, 0xe6, 0xc2, 0x7c, 0x56, 0x08,
-	0x27, 0x1e, 0x25, 0xe9, 0x89, 0x3f, 0x63, 0x2f, 0xd2, 0xe9, 0x9e, 0xd1, 0x40, 0xf7, 0xb4, 0x56,
-	0x6f, 0xb6, 0xb7, 0x7a, 0xab, 0xa1, 0xd5, 0xd7, 0x29, 0x94, 0xbd, 0x40, 0xa1, 0x16, 0x46, 0x81,
-	0x73, 0xb1, 0x51, 0xd0, 0x69, 0x1c, 0x05, 0x7f, 0xd9, 0x70, 0x4d, 0x7d, 0xf2, 0xdd, 0x3c, 0x4d,
-	0xc3, 0x98, 0xb0, 0x37, 0xcb, 0x66, 0x69, 0xd4, 0x9a, 0x65, 0x49, 0x6b, 0x4d, 0x85, 0xd6, 0x2e,
-	0x21, 0xa4, 0xd6, 0xe5, 0x09, 0xa9, 0x7d, 0x79, 0x42, 0xea, 0x2c, 0x27, 0xa4, 0x15, 0x38, 0x3a,
-	0xe7, 0x10, 0xce, 0xee, 0x62, 0xd3, 0x3d, 0x97, 0x4c, 0xae, 0xbe, 0x1a, 0x99, 0xec, 0xb5, 0x92,
-	0x49, 0x0d, 0x49, 0xd0, 0x8e, 0xa4, 0x7e, 0x03, 0x92, 0x16, 0x29, 0xe9, 0xda, 0x25, 0x28, 0xa9,
-	0xde, 0xe5, 0x07, 0x6d, 0x5d, 0x7e, 0xa8, 0x93, 0xc1, 0x7d, 0xd8, 0x51, 0xa1, 0x25, 0xaa, 0xf9,
-	0x81, 0x12, 0x65, 0x2d, 0x0f, 0x06, 0xeb, 0x07, 0xaa, 0xc8, 0x3b, 0xa0, 0xad, 0x50, 0xde, 0xf1,
-	0xf4, 0x38, 0x39, 0x65, 0xd8, 0x7c, 0x17, 0xba, 0xa9, 0x78, 0x14, 0xff, 0x19, 0x79, 0x6d, 0x81,
-	0x03, 0x8a, 0x77, 0x95, 0x7a, 0xde, 0x3d, 0xd8, 0x2c, 0xeb, 0x9a, 0xdd, 0x2d, 0x7f, 0xfb, 0xc6,
-	0xa5, 0xf9, 0xe6, 0x81, 0x5d, 0x23, 0x3e, 0xde, 0x6f, 0x06, 0x5c, 0xd1, 0x8d, 0x5c, 0xf6, 0x92,
-	0x25, 0x7d, 0x99, 0x4e, 0xfa, 0x62, 0x5e, 0x96, 0x00, 0xfb, 0x2e, 0x87, 0xb2, 0xd3, 0x30, 0x94,
-	0xd5, 0x4e, 0x5c, 0xb1, 0x84, 0x6e, 0x23, 0x4b, 0x58, 0x55, 0x59, 0x82, 0x77, 0x1f, 0x36, 0xf4,
-	0x17, 0x64, 0xff, 0x26, 0xa2, 0x3f, 0x98, 0xd5, 0x45, 0x14, 0xc1, 0x2d, 0xb1, 0x68, 0xee, 0xd3,
-	0xa5, 0xdf, 0x56, 0xa3, 0xdf, 0x76, 0x8d, 0xdd, 0xe8, 0x90, 0x74, 0xda, 0x20, 0xd9, 0xd1, 0x89,
-	0x87, 0xce, 0x20, 0xba, 0x6d, 0x0c, 0x62, 0x75, 0x39, 0x83, 0xe8, 0xd5, 0x18, 0xc4, 0x04, 0xd0,
-	0x42, 0x28, 0x32, 0x34, 0xd6, 0x83, 0xea, 0x2e, 0xfe, 0x04, 0xd1, 0xa3, 0x7a, 0xbb, 0xc2, 0x17,
-	0xa7, 0x6a, 0x38, 0x9c, 0xca, 0x9c, 0x1b, 0x7a, 0xce, 0x29, 0x5e, 0x4c, 0x89, 0x17, 0x25, 0xb7,
-	0xd5, 0xe9, 0xf6, 0xdc, 0x56, 0xaa, 0xd2, 0x8b, 0x5f, 0x0d, 0xd8, 0x6a, 0x62, 0x8c, 0x68, 0x1f,
-	0xba, 0x47, 0xfc, 0x53, 0xdc, 0xb5, 0x77, 0x0e, 0xbf, 0x1c, 0x89, 0xbf, 0xf7, 0x62, 0x92, 0x16,
-	0xb8, 0x3c, 0x78, 0xfd, 0x10, 0xd6, 0xd4, 0x0d, 0x0a, 0xf2, 0x17, 0x61, 0x21, 0x46, 0x3b, 0xfd,
-	0x44, 0x23, 0xf1, 0x8b, 0x4a, 0xfc, 0xc2, 0x73, 0x97, 0xf8, 0x9b, 0x61, 0xae, 0xf6, 0xb1, 0xf9,
-	0x91, 0xe1, 0xbd, 0x0f, 0xae, 0xda, 0x2b, 0xca, 0x49, 0xc0, 0xa6, 0xa6, 0x0b, 0x5d, 0x4a, 0x88,
-	0xc2, 0x8c, 0x47, 0xa0, 0x87, 0xcb, 0xa5, 0xf7, 0x59, 0x15, 0xee, 0x8a, 0x73, 0xa2, 0x5b, 0xe0,
-	0x50, 0xd6, 0x59, 0x46, 0x6b, 0xbb, 0x99, 0x9b, 0x62, 0xae, 0xe4, 0x4d, 0x61, 0x5d, 0xdb, 0xa9,
-	0xb8, 0x98, 0xa1, 0x70, 0xb1, 0x1a, 0x32, 0x4d, 0x1d, 0x99, 0x3b, 0x00, 0x74, 0x90, 0x8a, 0x6d,
-	0x5e, 0x0d, 0x8a, 0xc4, 0xfb, 0xae, 0xca, 0x2b, 0x35, 0x22, 0x4a, 0xed, 0xb5, 0x9b, 0x91, 0x45,
-	0x6a, 0xab, 0x8c, 0x54, 0x82, 0x5b, 0x1a, 0x6f, 0x07, 0xb7, 0xd4, 0x95, 0xb0, 0xfa, 0xd1, 0x80,
-	0xab, 0x92, 0xe5, 0x51, 0x8d, 0xff, 0x00, 0xb9, 0xf5, 0xbe, 0x01, 0x54, 0x77, 0xea, 0x75, 0xf2,
-	0xf9, 0xa3, 0x0e, 0xfb, 0xef, 0xeb, 0x7b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x47, 0x0e,
-	0xbc, 0x8e, 0x15, 0x00, 0x00,
+	// 1525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
+	0x12, 0x37, 0x45, 0x51, 0xb2, 0x46, 0x96, 0x6c, 0xaf, 0x15, 0x9b, 0x2f, 0xef, 0xc1, 0x30, 0x88,
+	0x97, 0x07, 0x03, 0xc9, 0x13, 0x5a, 0xf5, 0x0f, 0x8a, 0x36, 0x28, 0x1a, 0xa5, 0x49, 0x65, 0x20,
+	0xff, 0xb0, 0x71, 0xd1, 0x43, 0x7b, 0xa1, 0x45, 0x26, 0x26, 0x22, 0x93, 0xea, 0x72, 0x19, 0x87,
+	0xe8, 0xa5, 0xd7, 0x9e, 0x0b, 0xf4, 0x33, 0xf4, 0xd8, 0x7b, 0x2f, 0x3d, 0xf5, 0x23, 0x14, 0xe8,
+	0x87, 0x28, 0xd0, 0x8f, 0x50, 0xec, 0x1f, 0x6a, 0x97, 0x2b, 0xca, 0xb4, 0x9b, 0x1c, 0x7a, 0x32,
+	0x77, 0x76, 0x76, 0x67, 0x76, 0xe6, 0x37, 0x33, 0x3f, 0x19, 0x7a, 0xb3, 0x84, 0xd2, 0x90, 0xe4,
+	0xc3, 0x39, 0x49, 0x68, 0x82, 0x1c, 0x9a, 0xcf, 0xc3, 0xd4, 0x3b, 0x85, 0xfe, 0x93, 0x8c, 0x4c,
+	0x4f, 0xfd, 0x34, 0xc4, 0xe1, 0x34, 0x21, 0x01, 0xda, 0x85, 0x96, 0x7f, 0x96, 0x64, 0x31, 0x75,
+	0xad, 0x03, 0xeb, 0xd0, 0xc6, 0x72, 0xc5, 0xe4, 0x71, 0x76, 0x76, 0x12, 0x12, 0xb7, 0x21, 0xe4,
+	0x62, 0x85, 0x06, 0xe0, 0x44, 0x71, 0x10, 0xbe, 0x72, 0x6d, 0x2e, 0x16, 0x0b, 0xb4, 0x05, 0xf6,
+	0xb9, 0x9f, 0xbb, 0x4d, 0x2e, 0x63, 0x9f, 0xde, 0x0f, 0x16, 0x6c, 0x96, 0x4d, 0xa5, 0xe8, 0xff,
+	0xd0, 0x22, 0xfc, 0xd3, 0xb5, 0x0e, 0xec, 0xc3, 0xee, 0xe8, 0xda, 0x90, 0x7b, 0x35, 0x2c, 0xeb,
+	0x61, 0xa9, 0x84, 0x5c, 0x68, 0x3f, 0xcb, 0xe2, 0xe0, 0x8b, 0x28, 0x96, 0x3e, 0x14, 0x4b, 0xf4,
+	0x3f, 0xe8, 0x0b, 0x37, 0x1f, 0xc7, 0x21, 0x4e, 0xb2, 0x38, 0x90, 0xde, 0x18, 0x52, 0x84, 0xa0,
+	0xe9, 0x07, 0x01, 0xe1, 0x7e, 0x75, 0x30, 0xff, 0xf6, 0xfe, 0x68, 0x41, 0xfb, 0x81, 0x88, 0x0d,
+	0xfa, 0x0f, 0x74, 0x64, 0x98, 0x8e, 0x02, 0xfe, 0xfe, 0x0e, 0x56, 0x02, 0x16, 0x82, 0x94, 0xfa,
+	0x34, 0x4b, 0xb9, 0x79, 0x07, 0xcb, 0x15, 0xf2, 0x60, 0x63, 0x4a, 0x42, 0x9f, 0x86, 0x93, 0x30,
+	0x7a, 0x7e, 0x4a, 0xa5, 0xed, 0x92, 0x8c, 0x59, 0x66, 0xce, 0xca, 0x88, 0xf0, 0x6f, 0x74, 0x00,
+	0xdd, 0x79, 0x46, 0xc6, 0xb3, 0x64, 0xfa, 0xe2, 0x51, 0x76, 0xe6, 0x3a, 0x7c, 0x4b, 0x17, 0xb1,
+	0x9b, 0x03, 0xe2, 0x9f, 0x2f, 0x54, 0x5a, 0xe2, 0x66, 0x5d, 0x86, 0xde, 0x82, 0x9d, 0x99, 0x9f,
+	0xd2, 0x63, 0xe2, 0xc7, 0xe9, 0x71, 0xf2, 0x24, 0x23, 0x4f, 0xa9, 0x4f, 0x43, 0xb7, 0xcd, 0x55,
+	0xab, 0xb6, 0xd0, 0x08, 0x06, 0x9a, 0xf8, 0x53, 0xe2, 0x9f, 0x8b, 0x23, 0xeb, 0xfc, 0x48, 0xe5,
+	0x1e, 0xb3, 0x42, 0x13, 0xea, 0xcf, 0x8a, 0xd4, 0x04, 0xc7, 0xaf, 0x98, 0x43, 0x20, 0xac, 0x54,
+	0x6c, 0xa1, 0x7d, 0x00, 0x11, 0x81, 0x3b, 0x2c, 0xe2, 0x5d, 0x1e, 0x4c, 0x4d, 0xc2, 0x80, 0x43,
+	0x78, 0xaa, 0x36, 0x04, 0x70, 0x48, 0x22, 0x63, 0x32, 0xcb, 0xa6, 0x2f, 0xf2, 0x47, 0x02, 0x6b,
+	0x3d, 0x11, 0x13, 0x4d, 0xa4, 0xa2, 0xfd, 0x38, 0x7e, 0xe8, 0x47, 0xb1, 0xdb, 0xd7, 0xa3, 0x2d,
+	0x64, 0xe8, 0x36, 0xfc, 0xab, 0xe2, 0xe1, 0xf2, 0xc0, 0x26, 0x3f, 0xb0, 0x5a, 0x01, 0x7d, 0x0c,
+	0xd7, 0xab, 0x62, 0x20, 0x8f, 0x6f, 0xf1, 0xe3, 0x17, 0x68, 0xa0, 0xdb, 0xd0, 0x3f, 0x8b, 0xd2,
+	0x34, 0x8a, 0x9f, 0x4b, 0xa0, 0xbb, 0xdb, 0x1c, 0xde, 0x03, 0x09, 0xef, 0x87, 0xfa, 0x26, 0x36,
+	0x74, 0xd1, 0x7f, 0xa1, 0x97, 0xcc, 0x71, 0x78, 0xee, 0x93, 0x00, 0xfb, 0x34, 0x4a, 0x5c, 0xc4,
+	0x0d, 0x96, 0x85, 0x0c, 0xf1, 0x41, 0xf8, 0x52, 0x57, 0xdb, 0x11, 0x88, 0x2f, 0x4b, 0xd1, 0xfb,
+	0x00, 0xf3, 0x8c, 0x14, 0x7e, 0x0c, 0xb8, 0x1f, 0xbb, 0x95, 0x65, 0x96, 0x62, 0x4d, 0x93, 0x45,
+	0x99, 0x27, 0x95, 0xa5, 0x8a, 0x25, 0xfa, 0x9a, 0x88, 0xb2, 0x2e, 0x63, 0xd5, 0x72, 0x92, 0xe5,
+	0x77, 0x44, 0xb7, 0xd8, 0xe5, 0x0a, 0x4a, 0xc0, 0xaa, 0x85, 0xf8, 0x71, 0xe0, 0x27, 0xee, 0xde,
+	0x81, 0x75, 0xb8, 0x8e, 0xe5, 0xca, 0xbb, 0x01, 0xbd, 0x52, 0x00, 0x18, 0x10, 0x68, 0x74, 0x16,
+	0xa6, 0xbc, 0x09, 0x38, 0x58, 0x2c, 0xbc, 0xdf, 0x2c, 0xe8, 0xc9, 0xb2, 0xbc, 0x33, 0xa5, 0x51,
+	0x12, 0xa3, 0x21, 0xb4, 0x44, 0x92, 0x79, 0x65, 0xaa, 0x70, 0x4a, 0xad, 0xbb, 0xa2, 0xdc, 0xd6,
+	0xb0, 0xd4, 0x42, 0x37, 0xc0, 0x3e, 0xc9, 0x72, 0x5e, 0xab, 0xdd, 0xd1, 0x76, 0x59, 0x79, 0x9c,
+	0xe5, 0x93, 0x35, 0xcc, 0xf6, 0xd1, 0x21, 0x34, 0x59, 0x3d, 0xf1, 0xaa, 0xed, 0x8e, 0x50, 0x59,
+	0x8f, 0xa5, 0x76, 0xb2, 0x86, 0xb9, 0x06, 0xba, 0x09, 0xce, 0x74, 0x96, 0xa4, 0x21, 0x2f, 0xe2,
+	0xee, 0x68, 0xc7, 0xb0, 0xcf, 0xb6, 0x26, 0x6b, 0x58, 0xe8, 0xa0, 0x3e, 0x34, 0x68, 0xce, 0xeb,
+	0xc3, 0xc1, 0x0d, 0x9a, 0x8f, 0xdb, 0xe0, 0xbc, 0xf4, 0x67, 0x59, 0xe8, 0xfd, 0xac, 0x1e, 0x26,
+	0x5c, 0x36, 0xfb, 0x80, 0x55, 0xdf, 0x07, 0x1a, 0x15, 0x7d, 0x60, 0x09, 0x37, 0xf6, 0xe5, 0x70,
+	0xd3, 0xac, 0xc4, 0x8d, 0xca, 0x9e, 0x53, 0xca, 0xde, 0x0c, 0x40, 0x85, 0xb0, 0xbe, 0x5f, 0xca,
+	0x51, 0xd2, 0x58, 0x31, 0x4a, 0xec, 0xd2, 0x28, 0x59, 0x1e, 0x1a, 0x37, 0xa1, 0xab, 0x25, 0xe2,
+	0x62, 0x73, 0xde, 0x2d, 0xd8, 0xd0, 0x53, 0x51, 0xa3, 0xfd, 0x6b, 0x13, 0xfa, 0x38, 0x9c, 0x86,
+	0xd1, 0x9c, 0xbe, 0x5e, 0xf7, 0xdf, 0x07, 0x98, 0x93, 0xf0, 0xe5, 0x53, 0xb1, 0x67, 0xf3, 0x3d,
+	0x4d, 0x52, 0x35, 0x73, 0x54, 0xef, 0x73, 0xf4, 0xde, 0xa7, 0xe2, 0xd2, 0x2a, 0xc5, 0x45, 0xc5,
+	0xb1, 0x5d, 0x8a, 0xa3, 0xd1, 0x2b, 0xd7, 0x97, 0x7b, 0x25, 0x82, 0x26, 0xab, 0x26, 0xb7, 0x23,
+	0xa6, 0x0e, 0xfb, 0x66, 0xb7, 0xd1, 0x57, 0x13, 0x3f, 0x3d, 0xe5, 0xe0, 0xec, 0x60, 0xb9, 0x42,
+	0x1f, 0x01, 0x64, 0xf3, 0xc0, 0xa7, 0xe1, 0x51, 0xfc, 0x2c, 0xe1, 0xfd, 0xba, 0x3b, 0xfa, 0x77,
+	0x19, 0xe2, 0x9f, 0xf3, 0xfd, 0x71, 0x96, 0x33, 0x15, 0xac, 0xa9, 0x17, 0xa9, 0xdb, 0x58, 0xa4,
+	0x4e, 0xf1, 0x82, 0x9e, 0xce, 0x0b, 0xcc, 0xb6, 0xd2, 0xaf, 0x6b, 0x2b, 0x9b, 0x66, 0x5b, 0x79,
+	0x0f, 0x3a, 0xcf, 0xfd, 0x28, 0x66, 0x56, 0x53, 0xde, 0x8b, 0xbb, 0xa3, 0xbd, 0xb2, 0x97, 0x9f,
+	0x15, 0xdb, 0x58, 0x69, 0x32, 0xc3, 0x3c, 0x30, 0x85, 0xe1, 0x6d, 0x61, 0x58, 0x97, 0x31, 0xc3,
+	0xdc, 0x91, 0xfb, 0x2c, 0x33, 0xa2, 0xeb, 0x2a, 0x01, 0x8b, 0xdb, 0x33, 0x7f, 0x4a, 0x13, 0x22,
+	0x3b, 0xad, 0x5c, 0x79, 0x43, 0x86, 0xa3, 0xaf, 0xa5, 0x6d, 0x1e, 0x8c, 0x8b, 0x81, 0xf7, 0x25,
+	0x6c, 0x2b, 0xfd, 0x71, 0x76, 0x89, 0x23, 0x0b, 0x08, 0x35, 0xaa, 0x20, 0x64, 0x6b, 0x10, 0xf2,
+	0x7e, 0xb4, 0x60, 0x50, 0xba, 0x7d, 0x12, 0xa5, 0x34, 0x21, 0xf9, 0x9b, 0x32, 0xc0, 0xa4, 0x53,
+	0x9e, 0x98, 0x26, 0x07, 0xba, 0x58, 0xb0, 0xdb, 0x83, 0x88, 0x84, 0xbc, 0x4f, 0x73, 0x4c, 0x3b,
+	0x58, 0x09, 0x14, 0x14, 0x5a, 0x1a, 0x14, 0xbc, 0x23, 0xd8, 0x51, 0x9e, 0x3e, 0x60, 0x79, 0xb8,
+	0x44, 0x24, 0x16, 0x4e, 0x35, 0x0e, 0x6c, 0xf5, 0xea, 0x6f, 0x2d, 0xd8, 0x35, 0xee, 0xba, 0xdc,
+	0xbb, 0xb5, 0xeb, 0xaa, 0xde, 0x68, 0xaf, 0x7c, 0x63, 0xd3, 0x78, 0xa3, 0xf7, 0x27, 0x77, 0x61,
+	0x3e, 0xcb, 0xa5, 0x13, 0x8f, 0x12, 0x72, 0xe6, 0xcf, 0xf8, 0x8b, 0x4c, 0x7a, 0x68, 0x55, 0xd0,
+	0x43, 0x63, 0x04, 0x34, 0xea, 0x47, 0x80, 0x5d, 0x31, 0x02, 0xca, 0x94, 0xab, 0xb9, 0x44, 0xb9,
+	0x96, 0x46, 0x84, 0x73, 0xb9, 0x11, 0xd1, 0xaa, 0x1a, 0x11, 0xde, 0xef, 0x4d, 0xd8, 0xd3, 0x9f,
+	0x7c, 0x37, 0x23, 0x24, 0x8c, 0x29, 0x7f, 0xb3, 0x6a, 0x96, 0x56, 0xa9, 0x59, 0x16, 0x34, 0xb8,
+	0xa1, 0xd1, 0xe0, 0x15, 0x04, 0xd6, 0xbe, 0x3a, 0x81, 0x6d, 0x5e, 0x9d, 0xc0, 0x3a, 0xab, 0x09,
+	0xec, 0x02, 0x1c, 0xad, 0x0b, 0x08, 0x6a, 0x7b, 0xb9, 0xe9, 0x5e, 0x48, 0x3e, 0xd7, 0x5f, 0x8f,
+	0x7c, 0x76, 0x6a, 0xc9, 0xa7, 0x81, 0x24, 0xa8, 0x47, 0x52, 0xb7, 0x02, 0x49, 0xcb, 0x14, 0x76,
+	0xe3, 0x0a, 0x14, 0xd6, 0xec, 0xf2, 0xbd, 0xba, 0x2e, 0xdf, 0x37, 0xba, 0xbc, 0x37, 0x86, 0x7d,
+	0x1d, 0x5a, 0xb2, 0x9a, 0x1f, 0x68, 0x51, 0x36, 0xf2, 0x60, 0xf1, 0x7e, 0xa0, 0x8b, 0xbc, 0x23,
+	0x18, 0xe8, 0x77, 0x3c, 0x3d, 0x4d, 0xce, 0x39, 0x36, 0xdf, 0x86, 0x36, 0x91, 0x8f, 0x12, 0x3f,
+	0x3b, 0xf7, 0x96, 0xb8, 0xa1, 0x7c, 0x57, 0xa1, 0xe7, 0xdd, 0x83, 0x9d, 0xa2, 0xae, 0xf9, 0xdd,
+	0xea, 0xb7, 0x72, 0x5c, 0x98, 0xaf, 0x1e, 0xd8, 0x25, 0xe2, 0xe3, 0xfd, 0x62, 0xc1, 0x96, 0x69,
+	0xe4, 0xaa, 0x97, 0xac, 0xe8, 0xcb, 0x6c, 0xd2, 0xe7, 0xf3, 0xa2, 0x04, 0xf8, 0x77, 0x31, 0x94,
+	0x9d, 0x8a, 0xa1, 0xac, 0x77, 0xe2, 0x05, 0x4b, 0x68, 0x57, 0xb2, 0x84, 0x75, 0x9d, 0x25, 0x78,
+	0xf7, 0x61, 0xdb, 0x7c, 0x41, 0xfa, 0x77, 0x22, 0xfa, 0x5d, 0x63, 0x71, 0x11, 0x43, 0x70, 0x4d,
+	0x2c, 0xaa, 0xfb, 0x74, 0xe1, 0xb7, 0x5d, 0xe9, 0x77, 0xb3, 0xc4, 0x6e, 0x4c, 0x48, 0x3a, 0x75,
+	0x90, 0x6c, 0x99, 0xc4, 0xc3, 0x64, 0x10, 0xed, 0x3a, 0x06, 0xb1, 0xbe, 0x9a, 0x41, 0x74, 0x4a,
+	0x0c, 0x62, 0x02, 0x68, 0x29, 0x14, 0x29, 0x1a, 0x99, 0x41, 0x75, 0x97, 0x7f, 0x9a, 0x98, 0x51,
+	0xbd, 0x0d, 0x5b, 0x25, 0xaa, 0x86, 0xc3, 0xa9, 0xca, 0xb9, 0x65, 0xe6, 0x9c, 0xe1, 0xa5, 0xa1,
+	0xf0, 0xa2, 0xe5, 0x76, 0x71, 0xba, 0x3e, 0xb7, 0x0b, 0x55, 0xe5, 0xc5, 0x4f, 0x16, 0x0c, 0xaa,
+	0x18, 0x23, 0x1a, 0x43, 0xfb, 0x44, 0x7c, 0xca, 0xbb, 0x0e, 0x2f, 0xe0, 0x97, 0x43, 0xf9, 0xf7,
+	0x5e, 0x4c, 0x49, 0x8e, 0x8b, 0x83, 0xd7, 0x8f, 0x61, 0x43, 0xdf, 0x60, 0x20, 0x7f, 0x11, 0xe6,
+	0x72, 0xb4, 0xb3, 0x4f, 0x34, 0x94, 0xbf, 0xb4, 0xe4, 0x2f, 0x3f, 0x77, 0x85, 0xbf, 0x29, 0x16,
+	0x6a, 0x1f, 0x36, 0x3e, 0xb0, 0xbc, 0x77, 0xc1, 0xd5, 0x7b, 0x45, 0x31, 0x09, 0xf8, 0xd4, 0x74,
+	0xa1, 0xcd, 0x08, 0x51, 0x98, 0x8a, 0x08, 0x74, 0x70, 0xb1, 0xf4, 0x3e, 0x81, 0x2d, 0x93, 0x73,
+	0xa2, 0x5b, 0xe0, 0x30, 0xd6, 0x59, 0x44, 0x6b, 0xb7, 0x9a, 0x9b, 0x62, 0xa1, 0xe4, 0x4d, 0x61,
+	0xd3, 0xd8, 0x59, 0x70, 0x31, 0x4b, 0xe3, 0x62, 0x25, 0x64, 0x36, 0x4c, 0x64, 0xee, 0x03, 0xb0,
+	0x41, 0x2a, 0xb7, 0x45, 0x35, 0x68, 0x12, 0xef, 0x1b, 0xd8, 0xd6, 0x8c, 0xc8, 0x52, 0x7b, 0xe3,
+	0x66, 0x54, 0x91, 0x36, 0x75, 0x46, 0xaa, 0xc0, 0xad, 0x8c, 0xd7, 0x83, 0x5b, 0xe9, 0x2a, 0x58,
+	0x7d, 0x6f, 0xc1, 0x35, 0xc5, 0xf2, 0x98, 0xc6, 0x3f, 0x80, 0xdc, 0x7a, 0x5f, 0x01, 0x2a, 0x3b,
+	0xf5, 0x26, 0xf9, 0xfc, 0x49, 0x8b, 0xff, 0xb7, 0xf6, 0x9d, 0xbf, 0x06, 0x00, 0x6d, 0xc7, 0x80,
+	0xaa, 0xbe, 0x15, 0x00, 0x00,
 }
@@ -11,6 +11,7 @@ type LotteryCreateTx struct {
 	Fee            int64 `json:"fee"`
 	OpRewardRatio  int64 `json:"opRewardRatio"`
 	DevRewardRatio int64 `json:"devRewardRatio"`
+	Randao         bool  `json:"randao"`
 }
 
 // LotteryBuyTx for construction
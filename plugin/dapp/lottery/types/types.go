@@ -4,6 +4,8 @@
 
 package types
 
+import "fmt"
+
 //Lottery op
 const (
 	LotteryActionCreate = 1 + iota
@@ -24,6 +26,18 @@ const (
 	LotteryX = "lottery"
 )
 
+// ForkLotteryRandao 支持使用randao合约的随机数开奖
+const ForkLotteryRandao = "ForkLotteryRandao"
+
+// RandaoConsumer 彩票每一期开奖使用的randao轮次业务标识，由彩票创建者以该标识创建随机数轮次
+func RandaoConsumer(lotteryID string, round int64) string {
+	return fmt.Sprintf("%s-%s-%d", LotteryX, lotteryID, round)
+}
+
+// RandaoMinReveals 提交-揭示模式的开奖轮次至少需要的揭示人数，创建者不能参与提交
+const RandaoMinReveals = 3
+
+
 //Lottery status
 const (
 	LotteryCreated = 1 + iota
@@ -52,6 +52,7 @@ func MultiSigAccountCmd() *cobra.Command {
 		GetMultiSigAccAssetsCmd(),
 		GetMultiSigAccAllAddressCmd(),
 		GetMultiSigAccByOwnerCmd(),
+		CreateMultiSigAccTimeLockModifyCmd(),
 	)
 	return cmd
 }
@@ -83,6 +84,9 @@ func MultiSigTxCmd() *cobra.Command {
 		CreateMultiSigConfirmTxCmd(),
 		CreateMultiSigAccTransferInCmd(),
 		CreateMultiSigAccTransferOutCmd(),
+		CreateMultiSigSubmitTxCmd(),
+		CreateMultiSigCancelTxCmd(),
+		CreateMultiSigExecTxCmd(),
 		GetMultiSigAccTxCountCmd(),
 		GetMultiSigTxidsCmd(),
 		GetMultiSigTxInfoCmd(),
@@ -558,6 +562,8 @@ func createMultiSigAccTransferOutFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("amount", "a", 0, "transaction amount")
 	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().Int64P("expire", "x", 0, "expire height of the multisig tx, 0 means never expire")
 }
 
 func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
@@ -568,24 +574,177 @@ func createMultiSigAccTransferOut(cmd *cobra.Command, args []string) {
 	symbol, _ := cmd.Flags().GetString("symbol")
 	note, _ := cmd.Flags().GetString("note")
 	amount, _ := cmd.Flags().GetFloat64("amount")
+	expire, _ := cmd.Flags().GetInt64("expire")
 
 	if float64(types.MaxCoin/types.Coin) < amount {
 		fmt.Fprintln(os.Stderr, types.ErrAmount)
 		return
 	}
 	params := &mty.MultiSigExecTransferFrom{
-		Symbol:   symbol,
-		Amount:   int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4,
-		Note:     note,
-		Execname: execer,
-		From:     from,
-		To:       to,
+		Symbol:       symbol,
+		Amount:       int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4,
+		Note:         note,
+		Execname:     execer,
+		From:         from,
+		To:           to,
+		ExpireHeight: expire,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccTransferOutTx", params, &res)
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigSubmitTxCmd create raw MultiSigSubmitTx transaction
+func CreateMultiSigSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Create a transaction which calls other executor as multisig account",
+		Run:   createMultiSigSubmitTx,
+	}
+	createMultiSigSubmitTxFlags(cmd)
+	return cmd
+}
+
+func createMultiSigSubmitTxFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().StringP("execer", "e", "", "executor to be called")
+	cmd.MarkFlagRequired("execer")
+
+	cmd.Flags().StringP("payload", "p", "", "action payload of the executor (hex)")
+	cmd.MarkFlagRequired("payload")
+
+	cmd.Flags().StringP("to", "t", "", "to address of the inner transaction, default is the executor address")
+
+	cmd.Flags().Int64P("expire", "x", 0, "expire height of the multisig tx, 0 means never expire")
+}
+
+func createMultiSigSubmitTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	execer, _ := cmd.Flags().GetString("execer")
+	payload, _ := cmd.Flags().GetString("payload")
+	to, _ := cmd.Flags().GetString("to")
+	expire, _ := cmd.Flags().GetInt64("expire")
+
+	params := &mty.SubmitTxParam{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          execer,
+		Payload:         payload,
+		To:              to,
+		ExpireHeight:    expire,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigSubmitTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigCancelTxCmd create raw MultiSigCancelTx transaction
+func CreateMultiSigCancelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Create a cancel transaction, the submitter cancels directly and other owners vote",
+		Run:   createMultiSigCancelTx,
+	}
+	createMultiSigTxIDFlags(cmd)
+	return cmd
+}
+
+func createMultiSigTxIDFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().Uint64P("txid", "i", 0, "txid of  multisig transaction")
+	cmd.MarkFlagRequired("txid")
+}
+
+func createMultiSigCancelTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	params := &mty.MultiSigCancelTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigCancelTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigExecTxCmd create raw MultiSigExecTx transaction
+func CreateMultiSigExecTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec",
+		Short: "Create a transaction to execute the multisig tx whose timelock is unlocked",
+		Run:   createMultiSigExecTx,
+	}
+	createMultiSigTxIDFlags(cmd)
+	return cmd
+}
+
+func createMultiSigExecTx(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	params := &mty.MultiSigExecTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigExecTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigAccTimeLockModifyCmd create raw MultiSigAccOperate transaction to modify timelock
+func CreateMultiSigAccTimeLockModifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timelock",
+		Short: "Create a modify timelock transaction",
+		Run:   createMultiSigAccTimeLockModify,
+	}
+	createMultiSigAccTimeLockModifyFlags(cmd)
+	return cmd
+}
+
+func createMultiSigAccTimeLockModifyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().Int64P("blocks", "b", 0, "blocks to wait after the tx is confirmed, 0 means disable timelock")
+	cmd.MarkFlagRequired("blocks")
+
+	cmd.Flags().Float64P("threshold", "t", 0, "transfer amount above the threshold needs to wait")
+
+	cmd.Flags().Int64P("expire", "x", 0, "expire height of the multisig tx, 0 means never expire")
+}
+
+func createMultiSigAccTimeLockModify(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	blocks, _ := cmd.Flags().GetInt64("blocks")
+	threshold, _ := cmd.Flags().GetFloat64("threshold")
+	expire, _ := cmd.Flags().GetInt64("expire")
+
+	if blocks < 0 || threshold < 0 || float64(types.MaxCoin/types.Coin) < threshold {
+		fmt.Fprintln(os.Stderr, "input parameter invalid!")
+		return
+	}
+	params := &mty.MultiSigAccOperate{
+		MultiSigAccAddr: multiSigAddr,
+		TimeLock: &mty.TimeLock{
+			Blocks:    blocks,
+			Threshold: uint64(math.Trunc((threshold+0.0000001)*1e4)) * 1e4,
+		},
+		ExpireHeight: expire,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 //GetMultiSigAccCountCmd 获取已经创建的多重签名账户数量
 func GetMultiSigAccCountCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -718,6 +877,7 @@ func parseAccInfo(view interface{}) (interface{}, error) {
 		DailyLimits:    dailyLimitResults,
 		TxCount:        res.TxCount,
 		RequiredWeight: res.RequiredWeight,
+		TimeLock:       res.TimeLock,
 	}
 
 	return result, nil
@@ -783,6 +943,12 @@ func getMultiSigTxidsCmdFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("executed", "x", "t", "whether executed tx (0/f/false for No; 1/t/true for Yes)")
 
+	cmd.Flags().StringP("expired", "d", "t", "whether expired tx (0/f/false for No; 1/t/true for Yes)")
+
+	cmd.Flags().StringP("timelocked", "l", "t", "whether timelocked tx (0/f/false for No; 1/t/true for Yes)")
+
+	cmd.Flags().StringP("cancelled", "c", "t", "whether cancelled tx (0/f/false for No; 1/t/true for Yes)")
+
 }
 
 func getMultiSigTxids(cmd *cobra.Command, args []string) {
@@ -810,12 +976,25 @@ func getMultiSigTxids(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	var status [3]bool
+	for i, name := range []string{"expired", "timelocked", "cancelled"} {
+		flag, _ := cmd.Flags().GetString(name)
+		status[i], err = strconv.ParseBool(flag)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return
+		}
+	}
+
 	req := mty.ReqMultiSigTxids{
 		MultiSigAddr: addr,
 		FromTxId:     start,
 		ToTxId:       end,
 		Pending:      pendingBool,
 		Executed:     executedBool,
+		Expired:      status[0],
+		TimeLocked:   status[1],
+		Cancelled:    status[2],
 	}
 
 	var params rpctypes.Query4Jrpc
@@ -1095,6 +1274,7 @@ func getMultiSigAccAllAddress(cmd *cobra.Command, args []string) {
 	ctx.Run()
 }
 
+
 func isValidDailylimit(dailylimit float64) error {
 	if dailylimit < 0 || float64(types.MaxCoin/types.Coin) < dailylimit {
 		return mty.ErrInvalidDailyLimit
@@ -18,6 +18,13 @@ package multisig
 多重签名账户的转入和转出：转入时，to地址必须是多重签名地址，from地址必须是非多重签名地址；
 					 转出时，from地址必须是多重签名地址，to地址必须是非多重签名地址； 传出交易需要校验权重
 
+多重签名账户调用其他合约：owner提交对其他合约的调用(execer+payload)，权重满足后由多重签名合约执行，
+					 内部交易的from地址是多重签名地址，只能调用token和evm合约
+
+交易的过期和撤销：提交交易时可以指定过期高度，超过此高度还未满足权重的交易不能再被确认；
+					 提交者可以直接撤销还未执行的交易，其他owner投票撤销，撤销的权重满足requiredWeight时交易被撤销
+账户时间锁：权重满足的交易需要等待指定的区块数之后才能由owner执行(tx exec)，每日限额之内以及不大于threshold的转账不受限制
+
 cli 命令行主要分三块：account 账户相关的，owner 相关的以及tx交易相关的
 cli multisig
 Available Commands:
@@ -36,6 +43,7 @@ Available Commands:
   info        get multisig account info
   owner       get multisig accounts by the owner
   unspent     get assets unspent today amount
+  timelock    Create a modify timelock transaction
   weight      Create a modify required weight transaction
 
 cli multisig  owner
@@ -47,10 +55,13 @@ Available Commands:
 
 cli multisig  tx
 Available Commands:
+  cancel           Create a cancel transaction
   confirm          Create a confirm transaction
   confirmed_weight get the weight of the transaction confirmed.
   count            get multisig tx count
+  exec             Create a transaction to execute the multisig tx whose timelock is unlocked
   info             get multisig account tx info
+  submit           Create a submit transaction to call other executor
   transfer_in      Create a transfer to multisig account transaction
   transfer_out     Create a transfer from multisig account transaction
   txids            get multisig txids
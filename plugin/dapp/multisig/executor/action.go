@@ -28,13 +28,14 @@ type action struct {
 	index        int32
 	execaddr     string
 	api          client.QueueProtocolAPI
+	multiSig     *MultiSig
 }
 
 func newAction(t *MultiSig, tx *types.Transaction, index int32) *action {
 	hash := tx.Hash()
 	fromaddr := tx.From()
 	return &action{t.GetCoinsAccount(), t.GetStateDB(), t.GetLocalDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), index, dapp.ExecAddress(string(tx.Execer)), t.GetAPI(), t}
 }
 
 //MultiSigAccCreate 创建多重签名账户
@@ -76,6 +77,18 @@ func (a *action) MultiSigAccCreate(accountCreate *mty.MultiSigAccCreate) (*types
 	multiSigAccount.TxCount = 0
 	multiSigAccount.RequiredWeight = accountCreate.RequiredWeight
 
+	//账户的时间锁
+	if accountCreate.TimeLock != nil {
+		cfg := a.api.GetConfig()
+		if !cfg.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+			return nil, types.ErrNotSupport
+		}
+		if err := checkTimeLock(accountCreate.TimeLock); err != nil {
+			return nil, err
+		}
+		multiSigAccount.TimeLock = accountCreate.TimeLock
+	}
+
 	//获取资产的每日限额设置
 	if accountCreate.DailyLimit != nil {
 		symbol := accountCreate.DailyLimit.Symbol
@@ -139,7 +152,7 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 	}
 
 	//dailylimit每日限额属性的修改需要校验assets资产的合法性
-	if !AccountOperate.OperateFlag {
+	if AccountOperate.TimeLock == nil && !AccountOperate.OperateFlag {
 		execer := AccountOperate.DailyLimit.Execer
 		symbol := AccountOperate.DailyLimit.Symbol
 		err := mty.IsAssetsInvalid(execer, symbol)
@@ -157,6 +170,14 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	if err := a.setMultiSigTxExpire(newMultiSigTx, AccountOperate.ExpireHeight); err != nil {
+		return nil, err
+	}
+	//账户时间锁的修改
+	cfg := a.api.GetConfig()
+	if AccountOperate.TimeLock != nil && !cfg.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+		return nil, types.ErrNotSupport
+	}
 
 	return a.executeAccOperateTx(multiSigAccount, newMultiSigTx, AccountOperate, confirmOwner, true)
 }
@@ -194,6 +215,9 @@ func (a *action) MultiSigOwnerOperate(AccOwnerOperate *mty.MultiSigOwnerOperate)
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	if err := a.setMultiSigTxExpire(newMultiSigTx, AccOwnerOperate.ExpireHeight); err != nil {
+		return nil, err
+	}
 
 	return a.executeOwnerOperateTx(multiSigAccount, newMultiSigTx, AccOwnerOperate, confirmOwner, true)
 }
@@ -240,6 +264,9 @@ func (a *action) MultiSigExecTransferFrom(multiSigAccTransfer *mty.MultiSigExecT
 	newMultiSigTx.MultiSigAddr = multiSigAccAddr
 	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
 	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	if err := a.setMultiSigTxExpire(newMultiSigTx, multiSigAccTransfer.ExpireHeight); err != nil {
+		return nil, err
+	}
 
 	//确认并执行此交易
 	return a.executeTransferTx(multiSigAcc, newMultiSigTx, multiSigAccTransfer, confirmOwner, mty.IsSubmit)
@@ -330,9 +357,9 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 		multisiglog.Error("MultiSigConfirmTx:getMultiSigAccTxFromDb", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "err", err)
 		return nil, mty.ErrTxidNotExist
 	}
-	//已经被执行的交易不可以再确认/撤销
-	if multiSigTx.Executed {
-		return nil, mty.ErrTxHasExecuted
+	//已经被执行,撤销,过期或者进入时间锁的交易不可以再确认/撤销
+	if err := checkConfirmTxStatus(multiSigTx, a.height); err != nil {
+		return nil, err
 	}
 	//此owneraddr是否已经确认过此txid对应的交易
 	findindex, exist := isOwnerConfirmedTx(multiSigTx, owneraddr)
@@ -363,6 +390,11 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	if !isConfirm || !ConfirmTx.ConfirmOrRevoke {
 		return a.confirmTransaction(multiSigTx, multiSigTxOwner, ConfirmTx.ConfirmOrRevoke)
 	}
+	return a.executeConfirmedTx(multiSigAcc, multiSigTx, owner)
+}
+
+//权重满足后根据交易类型执行交易，owner为本次新增的确认owner，时间锁解锁后执行时为nil
+func (a *action) executeConfirmedTx(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, owner *mty.Owner) (*types.Receipt, error) {
 	//获取txhash对应交易详细信息
 	tx, err := getTxByHash(a.api, multiSigTx.TxHash)
 	if err != nil {
@@ -383,8 +415,11 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	} else if multiSigTx.TxType == mty.TransferOperate {
 		transfer := payload.GetMultiSigExecTransferFrom()
 		return a.executeTransferTx(multiSigAcc, multiSigTx, transfer, owner, mty.IsConfirm)
+	} else if multiSigTx.TxType == mty.SubmitTxOperate {
+		submit := payload.GetMultiSigSubmitTx()
+		return a.executeSubmitTx(multiSigAcc, multiSigTx, submit, owner, mty.IsConfirm)
 	}
-	multisiglog.Error("MultiSigConfirmTx:GetMultiSigTx", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "TxType unknown", multiSigTx.TxType)
+	multisiglog.Error("executeConfirmedTx:GetMultiSigTx", "multiSigAccAddr", multiSigAcc.MultiSigAddr, "TxId", multiSigTx.Txid, "TxType unknown", multiSigTx.TxType)
 	return nil, mty.ErrTxTypeNoMatch
 }
 
@@ -718,7 +753,10 @@ func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner,
 	if subOrConfirm {
 		receiptLogTx.TxHash = multiSigTx.TxHash
 		receiptLogTx.TxType = multiSigTx.TxType
+		receiptLogTx.ExpireHeight = multiSigTx.ExpireHeight
 	}
+	receiptLogTx.ExecReceipt = multiSigTx.ExecReceipt
+	receiptLogTx.UnlockHeight = multiSigTx.UnlockHeight
 
 	receiptLog.Ty = mty.TyLogMultiSigTx
 	receiptLog.Log = types.Encode(receiptLogTx)
@@ -752,6 +790,10 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	amount := transfer.Amount
 	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
 	underLimit, newlastday := isUnderLimit(a.blocktime, uint64(amount), curDailyLimit)
+	//每日限额之内的转账不受时间锁限制
+	if confirmed && !underLimit {
+		confirmed = a.isTimeLockPassed(multiSigAcc, newMultiSigTx, amount)
+	}
 
 	//新的一天更新lastday和spenttoday的值
 	if newlastday != 0 {
@@ -831,7 +873,7 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, accountOperate *mty.MultiSigAccOperate, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
 
 	//确认权重是否已达到要求
-	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
+	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx) && a.isTimeLockPassed(multiSigAcc, newMultiSigTx, 0)
 	prevExecuted := newMultiSigTx.Executed
 
 	var logs []*types.ReceiptLog
@@ -843,8 +885,14 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
 	if confirmed {
-		//修改账户RequiredWeight的操作
-		if accountOperate.OperateFlag {
+		//修改账户时间锁的操作
+		if accountOperate.TimeLock != nil {
+			accAttrkv, accAttrReceiptLog, err = a.multiSigTimeLockModify(multiSigAcc.MultiSigAddr, accountOperate.TimeLock)
+			if err != nil {
+				multisiglog.Error("executeAccOperateTx", "multiSigTimeLockModify", err)
+				return nil, err
+			}
+		} else if accountOperate.OperateFlag { //修改账户RequiredWeight的操作
 			accAttrkv, accAttrReceiptLog, err = a.multiSigWeightModify(multiSigAcc.MultiSigAddr, accountOperate.NewRequiredWeight)
 			if err != nil {
 				multisiglog.Error("executeAccOperateTx", "multiSigWeightModify", err)
@@ -892,7 +940,7 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	var receiptLog *types.ReceiptLog
 	var err error
 	//确认权重是否已达到要求
-	confirmed := isConfirmed(multiSigAccount.RequiredWeight, newMultiSigTx)
+	confirmed := isConfirmed(multiSigAccount.RequiredWeight, newMultiSigTx) && a.isTimeLockPassed(multiSigAccount, newMultiSigTx, 0)
 	prevExecuted := newMultiSigTx.Executed
 
 	flag := accountOperate.OperateFlag
@@ -44,3 +44,21 @@ func (m *MultiSig) Exec_MultiSigExecTransferFrom(payload *mty.MultiSigExecTransf
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigExecTransferFrom(payload)
 }
+
+//Exec_MultiSigSubmitTx 多重签名账户调用其他合约，权重满足后以多重签名地址执行
+func (m *MultiSig) Exec_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigSubmitTx(payload)
+}
+
+//Exec_MultiSigCancelTx 撤销多重签名账户上还未执行的交易
+func (m *MultiSig) Exec_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigCancelTx(payload)
+}
+
+//Exec_MultiSigExecTx 时间锁解锁之后执行多重签名账户上的交易
+func (m *MultiSig) Exec_MultiSigExecTx(payload *mty.MultiSigExecTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigExecTx(payload)
+}
@@ -86,3 +86,42 @@ func (m *MultiSig) ExecDelLocal_MultiSigExecTransferFrom(payload *mty.MultiSigEx
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecDelLocal_MultiSigSubmitTx 多重签名账户调用其他合约的交易回滚
+func (m *MultiSig) ExecDelLocal_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecDelLocal_MultiSigCancelTx 多重签名账户上交易撤销的回滚
+func (m *MultiSig) ExecDelLocal_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecDelLocal_MultiSigExecTx 时间锁解锁之后执行交易的回滚
+func (m *MultiSig) ExecDelLocal_MultiSigExecTx(payload *mty.MultiSigExecTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
@@ -91,3 +91,45 @@ func (m *MultiSig) ExecLocal_MultiSigExecTransferFrom(payload *mty.MultiSigExecT
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecLocal_MultiSigSubmitTx 多重签名账户调用其他合约的交易
+func (m *MultiSig) ExecLocal_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigSubmitTx", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecLocal_MultiSigCancelTx 多重签名账户上交易的撤销
+func (m *MultiSig) ExecLocal_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigCancelTx", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecLocal_MultiSigExecTx 时间锁解锁之后执行的交易
+func (m *MultiSig) ExecLocal_MultiSigExecTx(payload *mty.MultiSigExecTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecTx", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
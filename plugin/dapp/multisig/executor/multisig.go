@@ -12,6 +12,8 @@ multiSig合约主要实现如下功能：
 //多重签名账户交易的确认和撤销
 //合约中外部账户转账到多重签名账户，Addr --->multiSigAddr
 //合约中多重签名账户转账到外部账户，multiSigAddr--->Addr
+//多重签名账户调用其他合约，权重满足后以多重签名地址执行
+//多重签名账户交易的过期，撤销以及账户的时间锁
 */
 
 import (
@@ -127,6 +129,24 @@ func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
 		//assets check
 		return mty.IsAssetsInvalid(ato.GetExecname(), ato.GetSymbol())
 	}
+	//MultiSigSubmitTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigSubmitTx); ok {
+		return checkSubmitTx(ato)
+	}
+	//MultiSigCancelTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigCancelTx); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
+	//MultiSigExecTx 交易的检测
+	if ato, ok := payload.(*mty.MultiSigExecTx); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
 
 	return nil
 }
@@ -172,6 +192,10 @@ func checkAccountCreateTx(ato *mty.MultiSigAccCreate) error {
 		return mty.ErrMaxOwnerCount
 	}
 
+	if err := checkTimeLock(ato.GetTimeLock()); err != nil {
+		return err
+	}
+
 	dailyLimit := ato.GetDailyLimit()
 	//assets check
 	return mty.IsAssetsInvalid(dailyLimit.GetExecer(), dailyLimit.GetSymbol())
@@ -223,6 +247,10 @@ func checkAccountOperateTx(ato *mty.MultiSigAccOperate) error {
 	if err := address.CheckMultiSignAddress(MultiSigAccAddr); err != nil {
 		return types.ErrInvalidAddress
 	}
+	//时间锁的修改
+	if ato.TimeLock != nil {
+		return checkTimeLock(ato.TimeLock)
+	}
 
 	if ato.OperateFlag == mty.AccWeightOp {
 		NewWeight := ato.GetNewRequiredWeight()
@@ -377,6 +405,32 @@ func (m *MultiSig) execLocalMultiSigReceipt(receiptData *types.ReceiptData, tx *
 				}
 				set = append(set, kv...)
 			}
+		case mty.TyLogMultiSigAccTimeLockModify:
+			{
+				var receipt mty.ReceiptTimeLockModify
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv, err := m.saveMultiSigAccTimeLock(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
+		case mty.TyLogMultiSigTxCancel:
+			{
+				var receipt mty.ReceiptTxCancel
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv, err := m.saveMultiSigTxCancel(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
 		default:
 			break
 		}
@@ -417,12 +471,20 @@ func (m *MultiSig) saveMultiSigTransfer(tx *types.Transaction, SubmitOrConfirm,
 			return set, nil
 		}
 	} else {
-		if action.Ty != mty.ActionMultiSigConfirmTx || action.GetMultiSigConfirmTx() == nil {
+		//确认交易或者时间锁解锁后执行的交易
+		var multiSigAccAddr string
+		var txid uint64
+		if action.Ty == mty.ActionMultiSigConfirmTx && action.GetMultiSigConfirmTx() != nil {
+			multiSigAccAddr = action.GetMultiSigConfirmTx().MultiSigAccAddr
+			txid = action.GetMultiSigConfirmTx().TxId
+		} else if action.Ty == mty.ActionMultiSigExecTx && action.GetMultiSigExecTx() != nil {
+			multiSigAccAddr = action.GetMultiSigExecTx().MultiSigAccAddr
+			txid = action.GetMultiSigExecTx().TxId
+		} else {
 			return nil, mty.ErrActionTyNoMatch
 		}
 		//通过需要确认的txid从数据库中获取对应的multiSigTx信息，然后根据txhash查询具体的交易详情
-		multiSigConfirmTx := action.GetMultiSigConfirmTx()
-		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigConfirmTx.MultiSigAccAddr, multiSigConfirmTx.TxId)
+		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAccAddr, txid)
 		if err != nil {
 			return set, err
 		}
@@ -740,6 +802,11 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 	temMultiSigTx.TxHash = execTx.TxHash
 	temMultiSigTx.TxType = execTx.TxType
 	temMultiSigTx.Executed = false
+	temMultiSigTx.ExpireHeight = execTx.ExpireHeight
+	cfg := m.GetAPI().GetConfig()
+	if cfg.IsDappFork(m.GetHeight(), mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+		temMultiSigTx.Submitter = owner.GetOwnerAddr()
+	}
 	//获取多重签名交易信息从db中
 	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAddr, txid)
 	if err != nil {
@@ -762,26 +829,44 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 		multiSigTx = temMultiSigTx
 	}
 
-	index, exist := isOwnerConfirmedTx(multiSigTx, owner.OwnerAddr)
+	//时间锁解锁后执行的交易没有新增的确认owner
+	index, exist := isOwnerConfirmedTx(multiSigTx, owner.GetOwnerAddr())
 	if addOrRollback { //正常添加交易
-		if !exist { //add Confirmed Owner and modify Executed
-			multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner, owner)
-			if prevExecuted != multiSigTx.Executed {
-				return nil, mty.ErrExecutedNoMatch
-			}
-			multiSigTx.Executed = curExecuted
-		} else {
+		if owner != nil && exist {
 			multisiglog.Error("saveMultiSigTx", "addOrRollback", addOrRollback, "execTx", execTx, "index", index, "exist", exist)
 			return nil, mty.ErrOwnerNoMatch
 		}
+		//add Confirmed Owner and modify Executed
+		if owner != nil {
+			multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner, owner)
+		}
+		if prevExecuted != multiSigTx.Executed {
+			return nil, mty.ErrExecutedNoMatch
+		}
+		multiSigTx.Executed = curExecuted
+		if execTx.ExecReceipt != nil {
+			multiSigTx.ExecReceipt = execTx.ExecReceipt
+		}
+		if execTx.UnlockHeight != 0 {
+			multiSigTx.UnlockHeight = execTx.UnlockHeight
+		}
 	} else { //回滚删除交易
-		if exist { //回滚已经 add Confirmed Owner and modify Executed
-			multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner[0:index], multiSigTx.ConfirmedOwner[index+1:]...)
-			multiSigTx.Executed = prevExecuted
-		} else {
+		if owner != nil && !exist {
 			multisiglog.Error("saveMultiSigTx", "addOrRollback", addOrRollback, "execTx", execTx, "index", index, "exist", exist)
 			return nil, mty.ErrOwnerNoMatch
 		}
+		//回滚已经 add Confirmed Owner and modify Executed
+		if owner != nil {
+			multiSigTx.ConfirmedOwner = append(multiSigTx.ConfirmedOwner[0:index], multiSigTx.ConfirmedOwner[index+1:]...)
+		}
+		multiSigTx.Executed = prevExecuted
+		if !prevExecuted {
+			multiSigTx.ExecReceipt = nil
+		}
+		//未执行的交易记录的解锁高度是本交易设置的
+		if !curExecuted {
+			multiSigTx.UnlockHeight = 0
+		}
 	}
 	//submit交易的回滚需要将对应txid的值设置成nil
 	setNil := true
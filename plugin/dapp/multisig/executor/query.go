@@ -116,7 +116,8 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 	return &mty.Uint64{Data: multiSigAcc.TxCount}, nil
 }
 
-//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed
+//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed, expired, timeLocked, cancelled
+//交易的状态根据当前的区块高度计算
 //输入：
 //message ReqMultiSigTxids {
 //  string multisigaddr = 1;
@@ -124,6 +125,9 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 //	uint64 totxid = 3;
 //	bool   pending = 4;
 //	bool   executed	= 5;
+//	bool   expired	= 6;
+//	bool   timeLocked = 7;
+//	bool   cancelled = 8;
 // 返回:
 //message ReplyMultiSigTxids {
 //  string 			multisigaddr = 1;
@@ -147,6 +151,11 @@ func (m *MultiSig) Query_MultiSigTxids(in *mty.ReqMultiSigTxids) (types.Message,
 		return nil, types.ErrInvalidParam
 	}
 
+	header, err := m.GetAPI().GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+
 	multiSigTxids := &mty.ReplyMultiSigTxids{}
 	multiSigTxids.MultiSigAddr = addr
 	for txid := in.FromTxId; txid <= in.ToTxId; txid++ {
@@ -156,9 +165,28 @@ func (m *MultiSig) Query_MultiSigTxids(in *mty.ReqMultiSigTxids) (types.Message,
 			continue
 		}
 		findTxid := txid
-		//查找Pending/Executed的交易txid
-		if in.Pending && !multiSigTx.Executed || in.Executed && multiSigTx.Executed {
-			multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+		//查找Pending/Executed/Expired/TimeLocked/Cancelled的交易txid
+		switch getMultiSigTxStatus(multiSigTx, header.Height) {
+		case mty.TxStatusPending:
+			if in.Pending {
+				multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+			}
+		case mty.TxStatusExecuted:
+			if in.Executed {
+				multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+			}
+		case mty.TxStatusExpired:
+			if in.Expired {
+				multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+			}
+		case mty.TxStatusTimeLocked:
+			if in.TimeLocked {
+				multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+			}
+		case mty.TxStatusCancelled:
+			if in.Cancelled {
+				multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
+			}
 		}
 	}
 	return multiSigTxids, nil
@@ -194,6 +222,12 @@ func (m *MultiSig) Query_MultiSigTxInfo(in *mty.ReqMultiSigTxInfo) (types.Messag
 		multiSigTx = &mty.MultiSigTx{}
 	} else { //由于代码中使用hex.EncodeToString()接口转换的，没有加0x，为了方便上层统一处理再次返回时增加0x即可
 		multiSigTx.TxHash = "0x" + multiSigTx.TxHash
+		//根据当前高度计算交易的状态：pending, executed, expired, timeLocked, cancelled
+		header, err := m.GetAPI().GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		multiSigTx.Status = getMultiSigTxStatus(multiSigTx, header.Height)
 	}
 	return multiSigTx, nil
 }
@@ -0,0 +1,229 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+/*
+MultiSigSubmitTx 多重签名账户调用其他合约：
+1. owner提交交易时记录在多重签名账户的交易列表中，权重满足要求时直接执行，否则等待其他owner确认
+2. 执行时构造一笔内部交易，由框架加载对应的执行器执行，内部交易的from地址是多重签名地址
+   多重签名地址和普通地址的版本号不同，不能作为交易的from地址，内部交易使用InnerTxSignType的签名保存多重签名地址，
+   被调用的执行器通过mty.GetTxFrom获取from地址，外部交易使用此签名类型时签名检查不能通过
+3. 内部交易的receipt记录在MultiSigTx中，kv和log合并到本交易的receipt中，
+   框架只允许交易写入本执行器前缀(LODB-multisig-)的本地数据，其他前缀会panic，
+   所以被调用执行器的ExecLocal不会执行，token的交易列表和evm的合约表等本地索引中没有内部交易，通过MultiSigTx的ExecReceipt查询
+4. 内部交易写入的kv先按照框架对普通交易的写权限规则检查，合并到本交易后框架再以本交易检查，
+   只有token和evm在IsFriend中允许多重签名合约写入自己的数据，coins等系统执行器的数据不能写入
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+)
+
+func checkSubmitTx(ato *mty.MultiSigSubmitTx) error {
+	if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+		return types.ErrInvalidAddress
+	}
+	execer := []byte(ato.GetExecer())
+	if !types.IsAllowExecName(execer, execer) {
+		return types.ErrExecNameNotAllow
+	}
+	//不允许嵌套调用多重签名合约，只能调用允许多重签名合约写入数据的执行器
+	if !mty.IsSubmitTxExecer(execer) {
+		return mty.ErrSubmitTxExecer
+	}
+	if ato.GetTo() != "" {
+		if err := address.CheckAddress(ato.GetTo()); err != nil {
+			return types.ErrInvalidAddress
+		}
+	}
+	return nil
+}
+
+//MultiSigSubmitTx 多重签名账户提交调用其他合约的交易，权重满足时直接执行
+func (a *action) MultiSigSubmitTx(submit *mty.MultiSigSubmitTx) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigSubmitTxX) {
+		return nil, types.ErrNotSupport
+	}
+	multiSigAccAddr := submit.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigSubmitTx", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+	if multiSigAcc == nil {
+		multisiglog.Error("MultiSigSubmitTx:getMultiSigAccFromDb is nil", "MultiSigAccAddr", multiSigAccAddr)
+		return nil, types.ErrAccountNotExist
+	}
+
+	//校验交易提交者是否是本账户的owner
+	owneraddr := a.fromaddr
+	ownerWeight, isowner := isOwner(multiSigAcc, owneraddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+
+	//生成新的txid,并将此交易信息添加到Txs列表中
+	newMultiSigTx := &mty.MultiSigTx{}
+	newMultiSigTx.Txid = multiSigAcc.TxCount
+	newMultiSigTx.TxHash = hex.EncodeToString(a.txhash)
+	newMultiSigTx.Executed = false
+	newMultiSigTx.TxType = mty.SubmitTxOperate
+	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
+	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+	if err := a.setMultiSigTxExpire(newMultiSigTx, submit.ExpireHeight); err != nil {
+		return nil, err
+	}
+
+	return a.executeSubmitTx(multiSigAcc, newMultiSigTx, submit, confirmOwner, mty.IsSubmit)
+}
+
+//确认并执行调用其他合约的交易：区分submitTx和confirmtx阶段。
+func (a *action) executeSubmitTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, submit *mty.MultiSigSubmitTx, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
+	if submit == nil {
+		return nil, types.ErrInvalidParam
+	}
+	//确认权重是否已达到要求
+	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx) && a.isTimeLockPassed(multiSigAcc, newMultiSigTx, 0)
+	prevExecuted := newMultiSigTx.Executed
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	//权重满足，执行内部交易，执行失败时本交易也失败，等待再次确认
+	if confirmed {
+		receipt, err := a.execInnerTx(multiSigAcc.MultiSigAddr, submit, newMultiSigTx.TxHash)
+		if err != nil {
+			multisiglog.Error("executeSubmitTx:execInnerTx", "multiSigAddr", multiSigAcc.MultiSigAddr, "txid", newMultiSigTx.Txid, "err", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+
+		//标识此交易已经被执行，并记录内部交易的receipt
+		newMultiSigTx.Executed = true
+		newMultiSigTx.ExecReceipt = &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	}
+
+	//更新multiSigAcc状态:txcount有增加在submit阶段
+	if subOrConfirm {
+		keyvalue, receiptlog, err := a.receiptTxCountUpdate(multiSigAcc.MultiSigAddr)
+		if err != nil {
+			multisiglog.Error("executeSubmitTx:receiptTxCountUpdate", "error", err)
+			return nil, err
+		}
+		kv = append(kv, keyvalue)
+		logs = append(logs, receiptlog)
+	}
+	//更新newMultiSigTx的状态：MultiSigTx增加一个确认owner，交易的执行状态可能有更新
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	logs = append(logs, receiptlogtx)
+	kv = append(kv, keyvaluetx)
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   kv,
+		Logs: logs,
+	}, nil
+}
+
+//构造内部交易，nonce由提交交易的hash生成，保证不同提交的内部交易hash不同
+func newInnerTx(multiSigAddr string, submit *mty.MultiSigSubmitTx, txHash string) (*types.Transaction, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil || len(hash) < 8 {
+		return nil, mty.ErrTxHashNoMatch
+	}
+	to := submit.To
+	if to == "" {
+		to = address.ExecAddress(submit.Execer)
+	}
+	tx := &types.Transaction{
+		Execer:    []byte(submit.Execer),
+		Payload:   submit.Payload,
+		To:        to,
+		Nonce:     int64(binary.BigEndian.Uint64(hash[:8]) >> 1),
+		Signature: mty.NewInnerTxSignature(multiSigAddr),
+	}
+	return tx, nil
+}
+
+//加载被调用的执行器，使用当前区块的执行环境执行内部交易
+func (a *action) execInnerTx(multiSigAddr string, submit *mty.MultiSigSubmitTx, txHash string) (*types.Receipt, error) {
+	tx, err := newInnerTx(multiSigAddr, submit, txHash)
+	if err != nil {
+		return nil, err
+	}
+	cfg := a.api.GetConfig()
+	if err := dapp.CheckAddress(cfg, tx.GetRealToAddr(), a.height); err != nil {
+		return nil, err
+	}
+	m := a.multiSig
+	driver := dapp.LoadDriverAllow(a.api, tx, int(a.index), a.height)
+	driver.SetCoinsAccount(m.GetCoinsAccount())
+	driver.SetStateDB(a.db)
+	driver.SetLocalDB(a.localdb)
+	driver.SetEnv(a.height, a.blocktime, m.GetDifficulty())
+	driver.SetBlockInfo(m.GetParentHash(), m.GetLastHash(), m.GetMainHeight())
+	driver.SetTxs(m.GetTxs())
+	driver.SetReceipt(m.GetReceipt())
+
+	if err := driver.CheckTx(tx, int(a.index)); err != nil {
+		return nil, err
+	}
+	receipt, err := driver.Exec(tx, int(a.index))
+	if err != nil {
+		return nil, err
+	}
+	if receipt == nil {
+		receipt = &types.Receipt{Ty: types.ExecOk}
+	}
+	if receipt.Ty != types.ExecOk {
+		return nil, mty.ErrSubmitTxExecFailed
+	}
+	if err := a.checkInnerKeyAllow(tx, driver.GetDriverName(), receipt.KV); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+//和框架对普通交易的写权限检查一致：执行器自己的数据，执行器地址下的账户，或者key所属的执行器在IsFriend中允许；
+//另外key所属的执行器必须允许多重签名合约写入，否则合并到本交易后不能通过框架的检查
+func (a *action) checkInnerKeyAllow(tx *types.Transaction, realExecer string, kvs []*types.KeyValue) error {
+	cfg := a.api.GetConfig()
+	exec := cfg.GetParaExec(tx.Execer)
+	for _, kv := range kvs {
+		keyExecer, err := types.FindExecer(kv.Key)
+		if err != nil {
+			return types.ErrNotAllowKey
+		}
+		keyExecAddr, isExecKey := types.GetExecKey(kv.Key)
+		if !isExecKey || keyExecAddr != a.execaddr {
+			if !mty.IsSubmitTxKey(string(keyExecer), kv.Key) {
+				multisiglog.Error("checkInnerKeyAllow", "key", string(kv.Key), "execer", string(tx.Execer))
+				return types.ErrNotAllowKey
+			}
+		}
+		if bytes.Equal(keyExecer, exec) || (isExecKey && keyExecAddr == address.ExecAddress(string(tx.Execer))) {
+			continue
+		}
+		execdriver := keyExecer
+		if isExecKey && keyExecAddr == address.ExecAddress(realExecer) {
+			execdriver = []byte(realExecer)
+		}
+		driver := dapp.LoadDriverAllow(a.api, &types.Transaction{Execer: execdriver}, int(a.index), a.height)
+		if !driver.IsFriend(execdriver, kv.Key, tx) {
+			multisiglog.Error("checkInnerKeyAllow", "key", string(kv.Key), "execer", string(tx.Execer))
+			return types.ErrNotAllowKey
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	dbmock "github.com/33cn/chain33/common/db/mocks"
+	cty "github.com/33cn/chain33/system/dapp/coins/types"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func init() {
+	tokenexec.Init(tokenty.TokenX, chainTestCfg, nil)
+}
+
+func multiSigSubmitTx(parm *mty.MultiSigSubmitTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigSubmitTx,
+		Value: &mty.MultiSigAction_MultiSigSubmitTx{MultiSigSubmitTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+//多重签名账户以多重签名地址调用token合约转账
+func TestMultiSigSubmitTx(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		chainTestCfg.GetDappFork(mty.MultiSigX, mty.ForkMultiSigSubmitTxX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localDB := new(dbmock.KVDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	multiSigAddr, err := testMultiSigAccCreate(t, driver, env, localDB)
+	assert.Nil(t, err)
+
+	//多重签名地址持有token
+	total := int64(100 * types.Coin)
+	tokenAcc, err := account.NewAccountDB(chainTestCfg, tokenty.TokenX, "TEST", stateDB)
+	assert.Nil(t, err)
+	tokenAcc.SaveAccount(&types.Account{Addr: multiSigAddr, Balance: total})
+
+	amount := int64(types.Coin)
+	transfer := &tokenty.TokenAction{
+		Ty:    tokenty.ActionTransfer,
+		Value: &tokenty.TokenAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: "TEST", Amount: amount}},
+	}
+	param := &mty.MultiSigSubmitTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          tokenty.TokenX,
+		Payload:         types.Encode(transfer),
+		To:              AddrB,
+	}
+
+	//不能调用多重签名合约自己，也不能调用coins等不允许多重签名合约写入数据的执行器
+	tx, _ := multiSigSubmitTx(&mty.MultiSigSubmitTx{MultiSigAccAddr: multiSigAddr, Execer: mty.MultiSigX})
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrSubmitTxExecer, driver.CheckTx(tx, env.index))
+	coinsTransfer := &cty.CoinsAction{
+		Ty:    cty.CoinsActionTransfer,
+		Value: &cty.CoinsAction_Transfer{Transfer: &types.AssetsTransfer{Amount: amount}},
+	}
+	tx, _ = multiSigSubmitTx(&mty.MultiSigSubmitTx{MultiSigAccAddr: multiSigAddr, Execer: "coins", Payload: types.Encode(coinsTransfer), To: AddrB})
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Equal(t, mty.ErrSubmitTxExecer, driver.CheckTx(tx, env.index))
+
+	//非owner不能提交
+	tx, _ = multiSigSubmitTx(param)
+	tx, _ = signTx(tx, PrivKeyB)
+	_, err = driver.Exec(tx, env.index)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+
+	//AddrC的权重不够，交易只被记录
+	tx, _ = multiSigSubmitTx(param)
+	tx, _ = signTx(tx, PrivKeyC)
+	assert.Nil(t, driver.CheckTx(tx, env.index))
+	receipt, err := driver.Exec(tx, env.index)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(receipt.Logs))
+	var receiptTx mty.ReceiptMultiSigTx
+	assert.Nil(t, types.Decode(receipt.Logs[1].Log, &receiptTx))
+	assert.False(t, receiptTx.CurExecuted)
+	assert.Nil(t, receiptTx.ExecReceipt)
+	assert.Equal(t, total, tokenAcc.LoadAccount(multiSigAddr).Balance)
+
+	txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+
+	//AddrD确认后执行内部交易
+	txConfirm, _ := multiSigConfirmTx(&mty.MultiSigConfirmTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            receiptTx.MultiSigTxOwner.Txid,
+		ConfirmOrRevoke: true,
+	})
+	txConfirm, _ = signTx(txConfirm, PrivKeyD)
+	receipt, err = driver.Exec(txConfirm, env.index)
+	assert.Nil(t, err)
+	assert.Equal(t, total-amount, tokenAcc.LoadAccount(multiSigAddr).Balance)
+	assert.Equal(t, amount, tokenAcc.LoadAccount(AddrB).Balance)
+
+	last := receipt.Logs[len(receipt.Logs)-1]
+	assert.Equal(t, int32(mty.TyLogMultiSigTx), last.Ty)
+	assert.Nil(t, types.Decode(last.Log, &receiptTx))
+	assert.True(t, receiptTx.CurExecuted)
+	assert.Equal(t, int32(types.ExecOk), receiptTx.ExecReceipt.Ty)
+	assert.Equal(t, int32(types.TyLogTransfer), receiptTx.ExecReceipt.Logs[0].Ty)
+
+	multiSigTx, err := getMultiSigAccTxFromDb(stateDB, multiSigAddr, receiptTx.MultiSigTxOwner.Txid)
+	assert.Nil(t, err)
+	assert.True(t, multiSigTx.Executed)
+	assert.Equal(t, len(receiptTx.ExecReceipt.Logs), len(multiSigTx.ExecReceipt.Logs))
+
+	//已经执行的交易不能再确认
+	txConfirm, _ = multiSigConfirmTx(&mty.MultiSigConfirmTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            receiptTx.MultiSigTxOwner.Txid,
+		ConfirmOrRevoke: true,
+	})
+	txConfirm, _ = signTx(txConfirm, PrivKeyC)
+	_, err = driver.Exec(txConfirm, env.index)
+	assert.Equal(t, mty.ErrTxHasExecuted, err)
+}
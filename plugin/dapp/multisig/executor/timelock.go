@@ -0,0 +1,273 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+/*
+多重签名交易的过期高度，撤销以及账户时间锁：
+1. 提交交易时可以指定过期高度，超过此高度还未满足权重的交易不能再被确认
+2. 交易的提交者可以直接撤销还未执行的交易，其他owner投票撤销，撤销的权重满足requiredWeight时交易被撤销
+3. 账户设置时间锁之后，权重满足的交易不会立即执行，记录解锁高度，解锁之后由owner发送MultiSigExecTx执行。
+   转账金额不大于threshold的交易不受时间锁限制，owner/account属性的修改以及调用其他合约的交易总是需要等待，
+   所以时间锁本身的修改也需要等待，owner有足够的时间撤销被盗私钥提交的交易
+4. 已经进入时间锁的交易不再过期，也不能再确认或者撤销确认，只能执行或者撤销
+*/
+
+import (
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+)
+
+func checkTimeLock(timeLock *mty.TimeLock) error {
+	if timeLock != nil && timeLock.Blocks < 0 {
+		return mty.ErrInvalidTimeLock
+	}
+	return nil
+}
+
+//设置新提交交易的提交者和过期高度，分叉之前不记录
+func (a *action) setMultiSigTxExpire(multiSigTx *mty.MultiSigTx, expireHeight int64) error {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+		if expireHeight != 0 {
+			return types.ErrNotSupport
+		}
+		return nil
+	}
+	if expireHeight != 0 && expireHeight <= a.height {
+		return mty.ErrInvalidExpireHeight
+	}
+	multiSigTx.Submitter = a.fromaddr
+	multiSigTx.ExpireHeight = expireHeight
+	return nil
+}
+
+//交易是否需要等待时间锁，非转账交易的amount无意义
+func needTimeLock(timeLock *mty.TimeLock, txType uint64, amount int64) bool {
+	if timeLock == nil || timeLock.Blocks <= 0 {
+		return false
+	}
+	if txType == mty.TransferOperate {
+		return uint64(amount) > timeLock.Threshold
+	}
+	return true
+}
+
+//权重满足之后检查时间锁，返回交易是否可以执行。需要等待的交易记录解锁高度
+func (a *action) isTimeLockPassed(multiSigAcc *mty.MultiSig, multiSigTx *mty.MultiSigTx, amount int64) bool {
+	if multiSigTx.UnlockHeight != 0 {
+		return a.height >= multiSigTx.UnlockHeight
+	}
+	if !needTimeLock(multiSigAcc.TimeLock, multiSigTx.TxType, amount) {
+		return true
+	}
+	multiSigTx.UnlockHeight = a.height + multiSigAcc.TimeLock.Blocks
+	multisiglog.Info("isTimeLockPassed", "multiSigAddr", multiSigTx.MultiSigAddr, "txid", multiSigTx.Txid, "unlockHeight", multiSigTx.UnlockHeight)
+	return false
+}
+
+//确认或者撤销确认之前检查交易的状态
+func checkConfirmTxStatus(multiSigTx *mty.MultiSigTx, height int64) error {
+	switch getMultiSigTxStatus(multiSigTx, height) {
+	case mty.TxStatusExecuted:
+		return mty.ErrTxHasExecuted
+	case mty.TxStatusCancelled:
+		return mty.ErrTxCancelled
+	case mty.TxStatusTimeLocked:
+		return mty.ErrTxTimeLocked
+	case mty.TxStatusExpired:
+		return mty.ErrTxExpired
+	}
+	return nil
+}
+
+//根据指定高度计算交易的状态
+func getMultiSigTxStatus(multiSigTx *mty.MultiSigTx, height int64) int32 {
+	if multiSigTx.Executed {
+		return mty.TxStatusExecuted
+	}
+	if multiSigTx.Cancelled {
+		return mty.TxStatusCancelled
+	}
+	if multiSigTx.UnlockHeight != 0 {
+		return mty.TxStatusTimeLocked
+	}
+	if multiSigTx.ExpireHeight != 0 && height > multiSigTx.ExpireHeight {
+		return mty.TxStatusExpired
+	}
+	return mty.TxStatusPending
+}
+
+//撤销交易的权重是否已达到要求
+func isCancelled(requiredWeight uint64, multiSigTx *mty.MultiSigTx) bool {
+	var totalweight uint64
+	for _, owner := range multiSigTx.CancelOwner {
+		totalweight += owner.Weight
+	}
+	return totalweight >= requiredWeight
+}
+
+//owner是否已经投票撤销过某个txid
+func isOwnerCancelledTx(multiSigTx *mty.MultiSigTx, ownerAddr string) bool {
+	for _, owner := range multiSigTx.CancelOwner {
+		if owner.OwnerAddr == ownerAddr {
+			return true
+		}
+	}
+	return false
+}
+
+//获取owner可以操作的交易，交易必须已经存在并且还未执行或者撤销
+func (a *action) getOwnerMultiSigTx(multiSigAccAddr string, txid uint64) (*mty.MultiSig, *mty.MultiSigTx, uint64, error) {
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("getOwnerMultiSigTx:getMultiSigAccFromDb", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, nil, 0, err
+	}
+	if multiSigAcc == nil {
+		return nil, nil, 0, types.ErrAccountNotExist
+	}
+	ownerWeight, isowner := isOwner(multiSigAcc, a.fromaddr)
+	if !isowner {
+		return nil, nil, 0, mty.ErrIsNotOwner
+	}
+	if txid >= multiSigAcc.TxCount {
+		return nil, nil, 0, mty.ErrInvalidTxid
+	}
+	multiSigTx, err := getMultiSigAccTxFromDb(a.db, multiSigAccAddr, txid)
+	if err != nil {
+		multisiglog.Error("getOwnerMultiSigTx:getMultiSigAccTxFromDb", "multiSigAccAddr", multiSigAccAddr, "txid", txid, "err", err)
+		return nil, nil, 0, mty.ErrTxidNotExist
+	}
+	if multiSigTx.Executed {
+		return nil, nil, 0, mty.ErrTxHasExecuted
+	}
+	if multiSigTx.Cancelled {
+		return nil, nil, 0, mty.ErrTxCancelled
+	}
+	return multiSigAcc, multiSigTx, ownerWeight, nil
+}
+
+//MultiSigCancelTx 撤销还未执行的交易，提交者直接撤销，其他owner投票撤销
+func (a *action) MultiSigCancelTx(cancel *mty.MultiSigCancelTx) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+		return nil, types.ErrNotSupport
+	}
+	multiSigAcc, multiSigTx, ownerWeight, err := a.getOwnerMultiSigTx(cancel.MultiSigAccAddr, cancel.TxId)
+	if err != nil {
+		return nil, err
+	}
+	prev := types.Clone(multiSigTx).(*mty.MultiSigTx)
+	if multiSigTx.Submitter == a.fromaddr {
+		multiSigTx.Cancelled = true
+	} else {
+		if isOwnerCancelledTx(multiSigTx, a.fromaddr) {
+			return nil, mty.ErrDupCancelled
+		}
+		multiSigTx.CancelOwner = append(multiSigTx.CancelOwner, &mty.Owner{OwnerAddr: a.fromaddr, Weight: ownerWeight})
+		multiSigTx.Cancelled = isCancelled(multiSigAcc.RequiredWeight, multiSigTx)
+	}
+
+	receiptLog := &types.ReceiptLog{
+		Ty:  mty.TyLogMultiSigTxCancel,
+		Log: types.Encode(&mty.ReceiptTxCancel{Prev: prev, Cur: multiSigTx}),
+	}
+	key, value := setMultiSigAccTxToDb(a.db, multiSigTx)
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: key, Value: value}},
+		Logs: []*types.ReceiptLog{receiptLog},
+	}, nil
+}
+
+//MultiSigExecTx 时间锁解锁之后执行交易，执行时重新检查权重是否满足
+func (a *action) MultiSigExecTx(exec *mty.MultiSigExecTx) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimeLockX) {
+		return nil, types.ErrNotSupport
+	}
+	multiSigAcc, multiSigTx, _, err := a.getOwnerMultiSigTx(exec.MultiSigAccAddr, exec.TxId)
+	if err != nil {
+		return nil, err
+	}
+	if multiSigTx.UnlockHeight == 0 {
+		return nil, mty.ErrTxNotTimeLocked
+	}
+	if a.height < multiSigTx.UnlockHeight {
+		return nil, mty.ErrTxTimeLocked
+	}
+	if !isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) {
+		return nil, mty.ErrTotalWeightNotEnough
+	}
+	//没有新增确认的owner
+	return a.executeConfirmedTx(multiSigAcc, multiSigTx, nil)
+}
+
+//多重签名账户时间锁的修改
+func (a *action) multiSigTimeLockModify(multiSigAccAddr string, timeLock *mty.TimeLock) (*types.KeyValue, *types.ReceiptLog, error) {
+	multiSigAccount, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("multiSigTimeLockModify", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, nil, err
+	}
+	if multiSigAccount == nil {
+		return nil, nil, types.ErrAccountNotExist
+	}
+	if err := checkTimeLock(timeLock); err != nil {
+		return nil, nil, err
+	}
+	receipt := &mty.ReceiptTimeLockModify{
+		MultiSigAddr: multiSigAccAddr,
+		PrevTimeLock: multiSigAccount.TimeLock,
+		CurTimeLock:  timeLock,
+	}
+	multiSigAccount.TimeLock = timeLock
+	receiptLog := &types.ReceiptLog{Ty: mty.TyLogMultiSigAccTimeLockModify, Log: types.Encode(receipt)}
+
+	key, value := setMultiSigAccToDb(a.db, multiSigAccount)
+	return &types.KeyValue{Key: key, Value: value}, receiptLog, nil
+}
+
+//localdb中账户时间锁的更新
+func (m *MultiSig) saveMultiSigAccTimeLock(timeLockOp mty.ReceiptTimeLockModify, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSig, err := getMultiSigAccount(m.GetLocalDB(), timeLockOp.MultiSigAddr)
+	if err != nil || multiSig == nil {
+		return nil, err
+	}
+	if addOrRollback {
+		multiSig.TimeLock = timeLockOp.CurTimeLock
+	} else {
+		multiSig.TimeLock = timeLockOp.PrevTimeLock
+	}
+	err = setMultiSigAccount(m.GetLocalDB(), multiSig, true)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.KeyValue{getMultiSigAccountKV(multiSig, true)}, nil
+}
+
+//localdb中交易撤销状态的更新
+func (m *MultiSig) saveMultiSigTxCancel(cancelTx mty.ReceiptTxCancel, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), cancelTx.Cur.MultiSigAddr, cancelTx.Cur.Txid)
+	if err != nil {
+		return nil, err
+	}
+	if multiSigTx == nil {
+		multisiglog.Error("saveMultiSigTxCancel", "addOrRollback", addOrRollback, "cancelTx", cancelTx)
+		return nil, mty.ErrTxidNotExist
+	}
+	state := cancelTx.Cur
+	if !addOrRollback {
+		state = cancelTx.Prev
+	}
+	multiSigTx.Cancelled = state.Cancelled
+	multiSigTx.CancelOwner = state.CancelOwner
+
+	err = setMultiSigTx(m.GetLocalDB(), multiSigTx, true)
+	if err != nil {
+		return nil, err
+	}
+	return []*types.KeyValue{getMultiSigTxKV(multiSigTx, true)}, nil
+}
@@ -0,0 +1,213 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func multiSigCancelTx(parm *mty.MultiSigCancelTx) (*types.Transaction, error) {
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigCancelTx,
+		Value: &mty.MultiSigAction_MultiSigCancelTx{MultiSigCancelTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+func multiSigExecTx(parm *mty.MultiSigExecTx) (*types.Transaction, error) {
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigExecTx,
+		Value: &mty.MultiSigAction_MultiSigExecTx{MultiSigExecTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+//执行交易并将ExecLocal的结果写入localdb
+func execAndLocal(t *testing.T, driver drivers.Driver, tx *types.Transaction, privKey string) (*types.Receipt, error) {
+	tx, _ = signTx(tx, privKey)
+	if err := driver.CheckTx(tx, 0); err != nil {
+		return nil, err
+	}
+	receipt, err := driver.Exec(tx, 0)
+	if err != nil {
+		return nil, err
+	}
+	set, err := driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		driver.(*MultiSig).GetLocalDB().Set(kv.Key, kv.Value)
+	}
+	return receipt, nil
+}
+
+func TestMultiSigTimeLock(t *testing.T) {
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	localMem, _ := dbm.NewGoMemDB("local", "local", 100)
+	localDB := dbm.NewKVDB(localMem)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+	api.On("GetLastHeader").Return(&types.Header{Height: 13}, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(10, 1539918074, 2)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+	m := driver.(*MultiSig)
+
+	//创建带时间锁的账户，AddrC的权重不够，AddrD的权重满足要求
+	tx, _ := multiSigAccCreate(&mty.MultiSigAccCreate{
+		Owners:         []*mty.Owner{{OwnerAddr: AddrC, Weight: AddrCWeight}, {OwnerAddr: AddrD, Weight: AddrDWeight}},
+		RequiredWeight: Requiredweight,
+		DailyLimit:     &mty.SymbolDailyLimit{Symbol: Symbol, Execer: Asset, DailyLimit: CoinsBtyDailylimit},
+		TimeLock:       &mty.TimeLock{Blocks: -1},
+	})
+	tx, _ = signTx(tx, PrivKeyA)
+	assert.Equal(t, mty.ErrInvalidTimeLock, driver.CheckTx(tx, 0))
+
+	tx, _ = multiSigAccCreate(&mty.MultiSigAccCreate{
+		Owners:         []*mty.Owner{{OwnerAddr: AddrC, Weight: AddrCWeight}, {OwnerAddr: AddrD, Weight: AddrDWeight}},
+		RequiredWeight: Requiredweight,
+		DailyLimit:     &mty.SymbolDailyLimit{Symbol: Symbol, Execer: Asset, DailyLimit: CoinsBtyDailylimit},
+		TimeLock:       &mty.TimeLock{Blocks: 5},
+	})
+	receipt, err := execAndLocal(t, driver, tx, PrivKeyA)
+	assert.Nil(t, err)
+	var acc mty.MultiSig
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &acc))
+	multiSigAddr := acc.MultiSigAddr
+	assert.Equal(t, int64(5), acc.TimeLock.Blocks)
+
+	modifyWeight := func(weight uint64, expire int64) *types.Transaction {
+		tx, _ := multiSigAccOperate(&mty.MultiSigAccOperate{
+			MultiSigAccAddr:   multiSigAddr,
+			NewRequiredWeight: weight,
+			OperateFlag:       mty.AccWeightOp,
+			ExpireHeight:      expire,
+		})
+		return tx
+	}
+	//过期高度必须大于当前高度
+	_, err = execAndLocal(t, driver, modifyWeight(6, 10), PrivKeyC)
+	assert.Equal(t, mty.ErrInvalidExpireHeight, err)
+
+	//txid 0: 12高度之后过期
+	_, err = execAndLocal(t, driver, modifyWeight(6, 12), PrivKeyC)
+	assert.Nil(t, err)
+	//txid 1: 提交者直接撤销
+	_, err = execAndLocal(t, driver, modifyWeight(6, 0), PrivKeyC)
+	assert.Nil(t, err)
+	tx, _ = multiSigCancelTx(&mty.MultiSigCancelTx{MultiSigAccAddr: multiSigAddr, TxId: 1})
+	_, err = execAndLocal(t, driver, tx, PrivKeyC)
+	assert.Nil(t, err)
+	_, err = execAndLocal(t, driver, tx, PrivKeyD)
+	assert.Equal(t, mty.ErrTxCancelled, err)
+	//txid 2: AddrD投票撤销，权重满足
+	_, err = execAndLocal(t, driver, modifyWeight(6, 0), PrivKeyC)
+	assert.Nil(t, err)
+	tx, _ = multiSigCancelTx(&mty.MultiSigCancelTx{MultiSigAccAddr: multiSigAddr, TxId: 2})
+	cancelTx, _ := signTx(tx, PrivKeyD)
+	cancelReceipt, err := execAndLocal(t, driver, tx, PrivKeyD)
+	assert.Nil(t, err)
+
+	//过期之后不能再确认
+	driver.SetEnv(13, 1539918074, 2)
+	confirm, _ := multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: 0, ConfirmOrRevoke: true})
+	_, err = execAndLocal(t, driver, confirm, PrivKeyD)
+	assert.Equal(t, mty.ErrTxExpired, err)
+
+	//txid 3: 权重满足，进入时间锁，18高度解锁
+	submit := modifyWeight(NewRequiredweight, 0)
+	receipt, err = execAndLocal(t, driver, submit, PrivKeyD)
+	assert.Nil(t, err)
+	var receiptTx mty.ReceiptMultiSigTx
+	assert.Nil(t, types.Decode(receipt.Logs[len(receipt.Logs)-1].Log, &receiptTx))
+	assert.False(t, receiptTx.CurExecuted)
+	assert.Equal(t, int64(18), receiptTx.UnlockHeight)
+	submit, _ = signTx(submit, PrivKeyD)
+	txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: submit}}}
+	api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{submit.Hash()}}).Return(txDetails, nil)
+
+	//AddrC投票撤销，权重不够
+	tx, _ = multiSigCancelTx(&mty.MultiSigCancelTx{MultiSigAccAddr: multiSigAddr, TxId: 3})
+	_, err = execAndLocal(t, driver, tx, PrivKeyC)
+	assert.Nil(t, err)
+	_, err = execAndLocal(t, driver, tx, PrivKeyC)
+	assert.Equal(t, mty.ErrDupCancelled, err)
+
+	//进入时间锁的交易不能再确认
+	confirm, _ = multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: 3, ConfirmOrRevoke: true})
+	_, err = execAndLocal(t, driver, confirm, PrivKeyC)
+	assert.Equal(t, mty.ErrTxTimeLocked, err)
+
+	//查询各个状态的交易
+	queryTxids := func(req *mty.ReqMultiSigTxids) []uint64 {
+		req.MultiSigAddr = multiSigAddr
+		req.ToTxId = 3
+		reply, err := m.Query_MultiSigTxids(req)
+		assert.Nil(t, err)
+		return reply.(*mty.ReplyMultiSigTxids).Txids
+	}
+	assert.Nil(t, queryTxids(&mty.ReqMultiSigTxids{Pending: true, Executed: true}))
+	assert.Equal(t, []uint64{0}, queryTxids(&mty.ReqMultiSigTxids{Expired: true}))
+	assert.Equal(t, []uint64{1, 2}, queryTxids(&mty.ReqMultiSigTxids{Cancelled: true}))
+	assert.Equal(t, []uint64{3}, queryTxids(&mty.ReqMultiSigTxids{TimeLocked: true}))
+
+	info, err := m.Query_MultiSigTxInfo(&mty.ReqMultiSigTxInfo{MultiSigAddr: multiSigAddr, TxId: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(mty.TxStatusTimeLocked), info.(*mty.MultiSigTx).Status)
+	assert.Equal(t, int64(18), info.(*mty.MultiSigTx).UnlockHeight)
+	assert.Equal(t, AddrD, info.(*mty.MultiSigTx).Submitter)
+	assert.Equal(t, 1, len(info.(*mty.MultiSigTx).CancelOwner))
+
+	//解锁之前不能执行
+	execTx, _ := multiSigExecTx(&mty.MultiSigExecTx{MultiSigAccAddr: multiSigAddr, TxId: 3})
+	_, err = execAndLocal(t, driver, execTx, PrivKeyC)
+	assert.Equal(t, mty.ErrTxTimeLocked, err)
+	execTx, _ = multiSigExecTx(&mty.MultiSigExecTx{MultiSigAccAddr: multiSigAddr, TxId: 0})
+	_, err = execAndLocal(t, driver, execTx, PrivKeyC)
+	assert.Equal(t, mty.ErrTxNotTimeLocked, err)
+
+	//解锁之后由任意owner执行
+	driver.SetEnv(18, 1539918074, 2)
+	execTx, _ = multiSigExecTx(&mty.MultiSigExecTx{MultiSigAccAddr: multiSigAddr, TxId: 3})
+	execTx, _ = signTx(execTx, PrivKeyC)
+	receipt, err = execAndLocal(t, driver, execTx, PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(mty.TyLogMultiSigAccWeightModify), receipt.Logs[0].Ty)
+	multiSigAcc, err := getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, NewRequiredweight, multiSigAcc.RequiredWeight)
+
+	info, err = m.Query_MultiSigTxInfo(&mty.ReqMultiSigTxInfo{MultiSigAddr: multiSigAddr, TxId: 3})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(mty.TxStatusExecuted), info.(*mty.MultiSigTx).Status)
+	assert.Equal(t, 1, len(info.(*mty.MultiSigTx).ConfirmedOwner))
+
+	//回滚执行和撤销交易
+	set, err := driver.ExecDelLocal(execTx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		localDB.Set(kv.Key, kv.Value)
+	}
+	set, err = driver.ExecDelLocal(cancelTx, &types.ReceiptData{Ty: cancelReceipt.Ty, Logs: cancelReceipt.Logs}, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		localDB.Set(kv.Key, kv.Value)
+	}
+	assert.Equal(t, []uint64{2}, queryTxids(&mty.ReqMultiSigTxids{Pending: true}))
+	assert.Equal(t, []uint64{3}, queryTxids(&mty.ReqMultiSigTxids{TimeLocked: true}))
+	localAcc, err := getMultiSigAccount(localDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, Requiredweight, localAcc.RequiredWeight)
+}
@@ -7,6 +7,7 @@ package rpc
 import (
 	"encoding/hex"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 )
@@ -97,6 +98,59 @@ func (c *Jrpc) MultiSigAccTransferOutTx(param *mty.MultiSigExecTransferFrom, res
 	return nil
 }
 
+// MultiSigSubmitTx :构造多重签名账户调用其他合约的交易
+func (c *Jrpc) MultiSigSubmitTx(param *mty.SubmitTxParam, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	payload, err := common.FromHex(param.Payload)
+	if err != nil {
+		return err
+	}
+	v := &mty.MultiSigSubmitTx{
+		MultiSigAccAddr: param.MultiSigAccAddr,
+		Execer:          param.Execer,
+		Payload:         payload,
+		To:              param.To,
+		ExpireHeight:    param.ExpireHeight,
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigSubmitTx", v)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigCancelTx :构造撤销多重签名账户上交易的交易
+func (c *Jrpc) MultiSigCancelTx(param *mty.MultiSigCancelTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigCancelTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigExecTx :构造执行时间锁已经解锁的交易
+func (c *Jrpc) MultiSigExecTx(param *mty.MultiSigExecTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigExecTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
 // MultiSigAddresList 获取owner地址上的多重签名账户列表{multiSigAddr，owneraddr，weight}
 func (c *Jrpc) MultiSigAddresList(in *types.ReqString, result *interface{}) error {
 	v := *in
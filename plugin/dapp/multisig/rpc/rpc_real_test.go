@@ -13,8 +13,10 @@ import (
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
 	"github.com/33cn/chain33/util/testnode"
 	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -104,6 +106,70 @@ func TestMultiSigAccount(t *testing.T) {
 	testAbnormal(t, mocker, jrpcClient)
 }
 
+//多重签名账户调用token合约预创建token，内部交易经过框架的写权限检查，token的创建者是多重签名地址
+func TestMultiSigSubmitTx(t *testing.T) {
+	mocker := testnode.New("--free--", nil)
+	defer mocker.Close()
+	mocker.Listen()
+	jrpcClient := getRPCClient(t, mocker)
+	gen := mocker.GetGenesisKey()
+	multiSigAccAddr := testAccCreateTx(t, mocker, jrpcClient)
+
+	//token预创建需要先配置黑名单
+	err := mocker.SendHot()
+	assert.Nil(t, err)
+	tx := util.CreateManageTx(mocker.GetAPI().GetConfig(), mocker.GetHotKey(), "token-blacklist", "add", "BTY")
+	reply, err := mocker.GetAPI().SendTx(tx)
+	assert.Nil(t, err)
+	detail, err := mocker.WaitTx(reply.GetMsg())
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	action := &tokenty.TokenAction{
+		Ty: tokenty.TokenActionPreCreate,
+		Value: &tokenty.TokenAction_TokenPreCreate{TokenPreCreate: &tokenty.TokenPreCreate{
+			Name:   "MSTOKEN",
+			Symbol: "MST",
+			Total:  10000 * types.Coin,
+			Owner:  GenAddr,
+		}},
+	}
+	req := &mty.SubmitTxParam{
+		MultiSigAccAddr: multiSigAccAddr,
+		Execer:          tokenty.TokenX,
+		Payload:         common.ToHex(types.Encode(action)),
+	}
+	var res string
+	err = jrpcClient.Call("multisig.MultiSigSubmitTx", req, &res)
+	assert.Nil(t, err)
+	tx = getTx(t, res)
+	tx.Sign(types.SECP256K1, gen)
+	reply, err = mocker.GetAPI().SendTx(tx)
+	assert.Nil(t, err)
+	detail, err = mocker.WaitTx(reply.GetMsg())
+	assert.Nil(t, err)
+	assert.Equal(t, int32(types.ExecOk), detail.Receipt.Ty)
+
+	//token合约写入的预创建信息，creator是多重签名地址
+	key := []byte("mavl-token-create-ot-" + GenAddr + "-MST")
+	values, err := mocker.GetAPI().StoreGet(&types.StoreGet{StateHash: mocker.GetLastBlock().StateHash, Keys: [][]byte{key}})
+	assert.Nil(t, err)
+	var token tokenty.Token
+	err = types.Decode(values.Values[0], &token)
+	assert.Nil(t, err)
+	assert.Equal(t, multiSigAccAddr, token.Creator)
+	assert.Equal(t, int32(tokenty.TokenStatusPreCreated), token.Status)
+
+	//coins的数据不允许多重签名合约写入
+	req.Execer = "coins"
+	err = jrpcClient.Call("multisig.MultiSigSubmitTx", req, &res)
+	assert.Nil(t, err)
+	tx = getTx(t, res)
+	tx.Sign(types.SECP256K1, gen)
+	_, err = mocker.GetAPI().SendTx(tx)
+	assert.Equal(t, mty.ErrSubmitTxExecer, err)
+}
+
 //创建多重签名账户
 func testAccCreateTx(t *testing.T, mocker *testnode.Chain33Mock, jrpcClient *jsonclient.JSONClient) string {
 	gen := mocker.GetGenesisKey()
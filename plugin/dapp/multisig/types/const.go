@@ -24,6 +24,7 @@ var (
 	OwnerOperate    uint64 = 1
 	AccountOperate  uint64 = 2
 	TransferOperate uint64 = 3
+	SubmitTxOperate uint64 = 4
 	//IsSubmit ：
 	IsSubmit  = true
 	IsConfirm = false
@@ -37,6 +38,33 @@ var (
 	Multisiglog = log15.New("module", MultiSigX)
 )
 
+// ForkMultiSigSubmitTxX 多重签名账户可以提交调用其他合约的交易
+const ForkMultiSigSubmitTxX = "ForkMultiSigSubmitTx"
+
+// ForkMultiSigTimeLockX 多重签名交易的过期高度，撤销以及账户时间锁
+const ForkMultiSigTimeLockX = "ForkMultiSigTimeLock"
+
+//InnerTxSignType MultiSigSubmitTx内部交易的签名类型，Pubkey中保存多重签名地址，
+//没有对应的签名算法，外部交易使用此类型时签名检查不能通过
+const InnerTxSignType = 0x4d530001
+
+//submitTxExecers MultiSigSubmitTx可以调用的执行器，这些执行器以多重签名地址作为内部交易的from地址，
+//并在IsFriend中允许多重签名合约写入自己的数据，coins等系统执行器不允许写入，需要使用多重签名账户的转账交易。
+//paracross不允许调用：平行链只扫描主链上执行器为user.p.title.paracross的交易，内部交易的跨链转账在平行链上不会到账
+var submitTxExecers = map[string]bool{
+	"token": true,
+	"evm":   true,
+}
+
+//多重签名交易的状态，只在查询时根据当前高度计算
+const (
+	TxStatusPending    = 1 //等待确认
+	TxStatusExecuted   = 2 //已经执行
+	TxStatusExpired    = 3 //超过过期高度还未满足权重
+	TxStatusTimeLocked = 4 //权重已满足，等待时间锁解锁后执行
+	TxStatusCancelled  = 5 //已经撤销
+)
+
 // MultiSig 交易的actionid
 const (
 	ActionMultiSigAccCreate        = 10000
@@ -45,6 +73,9 @@ const (
 	ActionMultiSigConfirmTx        = 10003
 	ActionMultiSigExecTransferTo   = 10004
 	ActionMultiSigExecTransferFrom = 10005
+	ActionMultiSigSubmitTx         = 10006
+	ActionMultiSigCancelTx         = 10007
+	ActionMultiSigExecTx           = 10008
 )
 
 //多重签名账户执行输出的logid
@@ -67,6 +98,9 @@ const (
 	TyLogMultiSigTx       = 10011 //在Submit提交交易阶段才会有更新
 	TyLogTxCountUpdate    = 10012 //txcount只在在Submit阶段提交新的交易是才会增加计数
 
+	TyLogMultiSigAccTimeLockModify = 10013 //输出修改前后的时间锁
+	TyLogMultiSigTxCancel          = 10014 //交易撤销投票或者被撤销，输出修改前后的交易信息
+
 )
 
 //AccAssetsResult 账户资产cli的显示，主要是amount需要转换成浮点型字符串
@@ -97,6 +131,7 @@ type MultiSigResult struct {
 	DailyLimits    []*DailyLimitResult `json:"dailyLimits,omitempty"`
 	TxCount        uint64              `json:"txCount,omitempty"`
 	RequiredWeight uint64              `json:"requiredWeight,omitempty"`
+	TimeLock       *TimeLock           `json:"timeLock,omitempty"`
 }
 
 //UnSpentAssetsResult 每日限额之内未花费额度的显示cli
@@ -106,6 +141,15 @@ type UnSpentAssetsResult struct {
 	UnSpent string `json:"unspent,omitempty"`
 }
 
+//SubmitTxParam 构造MultiSigSubmitTx交易的rpc参数，payload使用hex编码
+type SubmitTxParam struct {
+	MultiSigAccAddr string `json:"multiSigAccAddr"`
+	Execer          string `json:"execer"`
+	Payload         string `json:"payload"`
+	To              string `json:"to,omitempty"`
+	ExpireHeight    int64  `json:"expireHeight,omitempty"`
+}
+
 //IsAssetsInvalid 资产的合法性检测，Symbol：必须全部大写，例如：BTY,coins.BTY。exec：必须在types.AllowUserExec中存在
 func IsAssetsInvalid(exec, symbol string) error {
 
@@ -126,3 +170,4 @@ func IsAssetsInvalid(exec, symbol string) error {
 	//Symbol不做检测
 	return nil
 }
+
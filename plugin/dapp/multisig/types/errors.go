@@ -41,4 +41,13 @@ var (
 	ErrInvalidExec          = errors.New("ErrInvalidExec")
 	ErrInvalidWeight        = errors.New("ErrInvalidWeight")
 	ErrInvalidDailyLimit    = errors.New("ErrInvalidDailyLimit")
+	ErrSubmitTxExecer       = errors.New("ErrSubmitTxExecer")
+	ErrSubmitTxExecFailed   = errors.New("ErrSubmitTxExecFailed")
+	ErrInvalidExpireHeight  = errors.New("ErrInvalidExpireHeight")
+	ErrInvalidTimeLock      = errors.New("ErrInvalidTimeLock")
+	ErrTxExpired            = errors.New("ErrTxExpired")
+	ErrTxCancelled          = errors.New("ErrTxCancelled")
+	ErrTxTimeLocked         = errors.New("ErrTxTimeLocked")
+	ErrTxNotTimeLocked      = errors.New("ErrTxNotTimeLocked")
+	ErrDupCancelled         = errors.New("ErrDupCancelled")
 )
@@ -32,6 +32,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 // DailyLimit: 不同资产的每日限额，通过symbol来区分，本连的原生币，以及跨链过来的其他链的原生币
 // txCount:记录此多重签名地址上提交的withdraw交易数
 // requiredweight:确认一笔withdraw交易需要的权重。
+// timeLock:账户的时间锁，权重满足的交易需要等待指定的区块数之后才能执行
 type MultiSig struct {
 	CreateAddr           string        `protobuf:"bytes,1,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
 	MultiSigAddr         string        `protobuf:"bytes,2,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
@@ -39,6 +40,7 @@ type MultiSig struct {
 	DailyLimits          []*DailyLimit `protobuf:"bytes,4,rep,name=dailyLimits,proto3" json:"dailyLimits,omitempty"`
 	TxCount              uint64        `protobuf:"varint,5,opt,name=txCount,proto3" json:"txCount,omitempty"`
 	RequiredWeight       uint64        `protobuf:"varint,6,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	TimeLock             *TimeLock     `protobuf:"bytes,7,opt,name=timeLock,proto3" json:"timeLock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -111,6 +113,63 @@ func (m *MultiSig) GetRequiredWeight() uint64 {
 	return 0
 }
 
+func (m *MultiSig) GetTimeLock() *TimeLock {
+	if m != nil {
+		return m.TimeLock
+	}
+	return nil
+}
+
+// 多重签名账户的时间锁
+// blocks: 权重满足后需要等待的区块数，0表示不启用时间锁
+// threshold: 转账金额大于此值时才需要等待，owner/account属性的修改以及调用其他合约的交易总是需要等待
+type TimeLock struct {
+	Blocks               int64    `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Threshold            uint64   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TimeLock) Reset()         { *m = TimeLock{} }
+func (m *TimeLock) String() string { return proto.CompactTextString(m) }
+func (*TimeLock) ProtoMessage()    {}
+func (*TimeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{1}
+}
+
+func (m *TimeLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimeLock.Unmarshal(m, b)
+}
+func (m *TimeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimeLock.Marshal(b, m, deterministic)
+}
+func (m *TimeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeLock.Merge(m, src)
+}
+func (m *TimeLock) XXX_Size() int {
+	return xxx_messageInfo_TimeLock.Size(m)
+}
+func (m *TimeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeLock proto.InternalMessageInfo
+
+func (m *TimeLock) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *TimeLock) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
 //这个地址是否已经确认某个交易
 type ConfirmedOwner struct {
 	ConfirmedOwner       []*Owner `protobuf:"bytes,1,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
@@ -123,7 +182,7 @@ func (m *ConfirmedOwner) Reset()         { *m = ConfirmedOwner{} }
 func (m *ConfirmedOwner) String() string { return proto.CompactTextString(m) }
 func (*ConfirmedOwner) ProtoMessage()    {}
 func (*ConfirmedOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{1}
+	return fileDescriptor_62b8b91adf3febfa, []int{2}
 }
 
 func (m *ConfirmedOwner) XXX_Unmarshal(b []byte) error {
@@ -154,22 +213,29 @@ func (m *ConfirmedOwner) GetConfirmedOwner() []*Owner {
 //记录提交的交易详情，在满足确认条件后执行data中的交易
 // txHash:用于存贮提交的确认交易。存贮在localdb中，通过txhash可以获取
 type MultiSigTx struct {
-	Txid                 uint64   `protobuf:"varint,1,opt,name=txid,proto3" json:"txid,omitempty"`
-	TxHash               string   `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	Executed             bool     `protobuf:"varint,3,opt,name=executed,proto3" json:"executed,omitempty"`
-	TxType               uint64   `protobuf:"varint,4,opt,name=txType,proto3" json:"txType,omitempty"`
-	MultiSigAddr         string   `protobuf:"bytes,5,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
-	ConfirmedOwner       []*Owner `protobuf:"bytes,6,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Txid                 uint64             `protobuf:"varint,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	TxHash               string             `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Executed             bool               `protobuf:"varint,3,opt,name=executed,proto3" json:"executed,omitempty"`
+	TxType               uint64             `protobuf:"varint,4,opt,name=txType,proto3" json:"txType,omitempty"`
+	MultiSigAddr         string             `protobuf:"bytes,5,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	ConfirmedOwner       []*Owner           `protobuf:"bytes,6,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
+	ExecReceipt          *types.ReceiptData `protobuf:"bytes,7,opt,name=execReceipt,proto3" json:"execReceipt,omitempty"`
+	Submitter            string             `protobuf:"bytes,8,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	ExpireHeight         int64              `protobuf:"varint,9,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	UnlockHeight         int64              `protobuf:"varint,10,opt,name=unlockHeight,proto3" json:"unlockHeight,omitempty"`
+	Cancelled            bool               `protobuf:"varint,11,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	CancelOwner          []*Owner           `protobuf:"bytes,12,rep,name=cancelOwner,proto3" json:"cancelOwner,omitempty"`
+	Status               int32              `protobuf:"varint,13,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *MultiSigTx) Reset()         { *m = MultiSigTx{} }
 func (m *MultiSigTx) String() string { return proto.CompactTextString(m) }
 func (*MultiSigTx) ProtoMessage()    {}
 func (*MultiSigTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{2}
+	return fileDescriptor_62b8b91adf3febfa, []int{3}
 }
 
 func (m *MultiSigTx) XXX_Unmarshal(b []byte) error {
@@ -232,6 +298,55 @@ func (m *MultiSigTx) GetConfirmedOwner() []*Owner {
 	return nil
 }
 
+func (m *MultiSigTx) GetExecReceipt() *types.ReceiptData {
+	if m != nil {
+		return m.ExecReceipt
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetUnlockHeight() int64 {
+	if m != nil {
+		return m.UnlockHeight
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *MultiSigTx) GetCancelOwner() []*Owner {
+	if m != nil {
+		return m.CancelOwner
+	}
+	return nil
+}
+
+func (m *MultiSigTx) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
 // owner 结构体：owner账户地址，以及权重
 type Owner struct {
 	OwnerAddr            string   `protobuf:"bytes,1,opt,name=ownerAddr,proto3" json:"ownerAddr,omitempty"`
@@ -245,7 +360,7 @@ func (m *Owner) Reset()         { *m = Owner{} }
 func (m *Owner) String() string { return proto.CompactTextString(m) }
 func (*Owner) ProtoMessage()    {}
 func (*Owner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{3}
+	return fileDescriptor_62b8b91adf3febfa, []int{4}
 }
 
 func (m *Owner) XXX_Unmarshal(b []byte) error {
@@ -298,7 +413,7 @@ func (m *DailyLimit) Reset()         { *m = DailyLimit{} }
 func (m *DailyLimit) String() string { return proto.CompactTextString(m) }
 func (*DailyLimit) ProtoMessage()    {}
 func (*DailyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{4}
+	return fileDescriptor_62b8b91adf3febfa, []int{5}
 }
 
 func (m *DailyLimit) XXX_Unmarshal(b []byte) error {
@@ -367,7 +482,7 @@ func (m *SymbolDailyLimit) Reset()         { *m = SymbolDailyLimit{} }
 func (m *SymbolDailyLimit) String() string { return proto.CompactTextString(m) }
 func (*SymbolDailyLimit) ProtoMessage()    {}
 func (*SymbolDailyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{5}
+	return fileDescriptor_62b8b91adf3febfa, []int{6}
 }
 
 func (m *SymbolDailyLimit) XXX_Unmarshal(b []byte) error {
@@ -418,6 +533,9 @@ type MultiSigAction struct {
 	//	*MultiSigAction_MultiSigConfirmTx
 	//	*MultiSigAction_MultiSigExecTransferTo
 	//	*MultiSigAction_MultiSigExecTransferFrom
+	//	*MultiSigAction_MultiSigSubmitTx
+	//	*MultiSigAction_MultiSigCancelTx
+	//	*MultiSigAction_MultiSigExecTx
 	Value                isMultiSigAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -429,7 +547,7 @@ func (m *MultiSigAction) Reset()         { *m = MultiSigAction{} }
 func (m *MultiSigAction) String() string { return proto.CompactTextString(m) }
 func (*MultiSigAction) ProtoMessage()    {}
 func (*MultiSigAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{6}
+	return fileDescriptor_62b8b91adf3febfa, []int{7}
 }
 
 func (m *MultiSigAction) XXX_Unmarshal(b []byte) error {
@@ -478,6 +596,18 @@ type MultiSigAction_MultiSigExecTransferFrom struct {
 	MultiSigExecTransferFrom *MultiSigExecTransferFrom `protobuf:"bytes,6,opt,name=multiSigExecTransferFrom,proto3,oneof"`
 }
 
+type MultiSigAction_MultiSigSubmitTx struct {
+	MultiSigSubmitTx *MultiSigSubmitTx `protobuf:"bytes,8,opt,name=multiSigSubmitTx,proto3,oneof"`
+}
+
+type MultiSigAction_MultiSigCancelTx struct {
+	MultiSigCancelTx *MultiSigCancelTx `protobuf:"bytes,9,opt,name=multiSigCancelTx,proto3,oneof"`
+}
+
+type MultiSigAction_MultiSigExecTx struct {
+	MultiSigExecTx *MultiSigExecTx `protobuf:"bytes,10,opt,name=multiSigExecTx,proto3,oneof"`
+}
+
 func (*MultiSigAction_MultiSigAccCreate) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigOwnerOperate) isMultiSigAction_Value() {}
@@ -490,6 +620,12 @@ func (*MultiSigAction_MultiSigExecTransferTo) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigExecTransferFrom) isMultiSigAction_Value() {}
 
+func (*MultiSigAction_MultiSigSubmitTx) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_MultiSigCancelTx) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_MultiSigExecTx) isMultiSigAction_Value() {}
+
 func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
 	if m != nil {
 		return m.Value
@@ -539,6 +675,27 @@ func (m *MultiSigAction) GetMultiSigExecTransferFrom() *MultiSigExecTransferFrom
 	return nil
 }
 
+func (m *MultiSigAction) GetMultiSigSubmitTx() *MultiSigSubmitTx {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigSubmitTx); ok {
+		return x.MultiSigSubmitTx
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetMultiSigCancelTx() *MultiSigCancelTx {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigCancelTx); ok {
+		return x.MultiSigCancelTx
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetMultiSigExecTx() *MultiSigExecTx {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigExecTx); ok {
+		return x.MultiSigExecTx
+	}
+	return nil
+}
+
 func (m *MultiSigAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -555,6 +712,9 @@ func (*MultiSigAction) XXX_OneofWrappers() []interface{} {
 		(*MultiSigAction_MultiSigConfirmTx)(nil),
 		(*MultiSigAction_MultiSigExecTransferTo)(nil),
 		(*MultiSigAction_MultiSigExecTransferFrom)(nil),
+		(*MultiSigAction_MultiSigSubmitTx)(nil),
+		(*MultiSigAction_MultiSigCancelTx)(nil),
+		(*MultiSigAction_MultiSigExecTx)(nil),
 	}
 }
 
@@ -563,6 +723,7 @@ type MultiSigAccCreate struct {
 	Owners               []*Owner          `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
 	RequiredWeight       uint64            `protobuf:"varint,2,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
 	DailyLimit           *SymbolDailyLimit `protobuf:"bytes,3,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	TimeLock             *TimeLock         `protobuf:"bytes,4,opt,name=timeLock,proto3" json:"timeLock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -572,7 +733,7 @@ func (m *MultiSigAccCreate) Reset()         { *m = MultiSigAccCreate{} }
 func (m *MultiSigAccCreate) String() string { return proto.CompactTextString(m) }
 func (*MultiSigAccCreate) ProtoMessage()    {}
 func (*MultiSigAccCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{7}
+	return fileDescriptor_62b8b91adf3febfa, []int{8}
 }
 
 func (m *MultiSigAccCreate) XXX_Unmarshal(b []byte) error {
@@ -614,6 +775,13 @@ func (m *MultiSigAccCreate) GetDailyLimit() *SymbolDailyLimit {
 	return nil
 }
 
+func (m *MultiSigAccCreate) GetTimeLock() *TimeLock {
+	if m != nil {
+		return m.TimeLock
+	}
+	return nil
+}
+
 //对MultiSigAccount账户owner的操作：add/del/replace/modify
 type MultiSigOwnerOperate struct {
 	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
@@ -621,6 +789,7 @@ type MultiSigOwnerOperate struct {
 	NewOwner             string   `protobuf:"bytes,3,opt,name=newOwner,proto3" json:"newOwner,omitempty"`
 	NewWeight            uint64   `protobuf:"varint,4,opt,name=newWeight,proto3" json:"newWeight,omitempty"`
 	OperateFlag          uint64   `protobuf:"varint,5,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,6,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -630,7 +799,7 @@ func (m *MultiSigOwnerOperate) Reset()         { *m = MultiSigOwnerOperate{} }
 func (m *MultiSigOwnerOperate) String() string { return proto.CompactTextString(m) }
 func (*MultiSigOwnerOperate) ProtoMessage()    {}
 func (*MultiSigOwnerOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{8}
+	return fileDescriptor_62b8b91adf3febfa, []int{9}
 }
 
 func (m *MultiSigOwnerOperate) XXX_Unmarshal(b []byte) error {
@@ -686,13 +855,23 @@ func (m *MultiSigOwnerOperate) GetOperateFlag() uint64 {
 	return 0
 }
 
+func (m *MultiSigOwnerOperate) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 //对MultiSigAccount账户的操作：modify/add:SymbolDailyLimit,requiredweight
 //修改或者添加每日限额，或者请求权重的值。
+// timeLock不为空时修改账户的时间锁，忽略operateFlag
 type MultiSigAccOperate struct {
 	MultiSigAccAddr      string            `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
 	DailyLimit           *SymbolDailyLimit `protobuf:"bytes,2,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
 	NewRequiredWeight    uint64            `protobuf:"varint,3,opt,name=newRequiredWeight,proto3" json:"newRequiredWeight,omitempty"`
 	OperateFlag          bool              `protobuf:"varint,4,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	ExpireHeight         int64             `protobuf:"varint,5,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	TimeLock             *TimeLock         `protobuf:"bytes,6,opt,name=timeLock,proto3" json:"timeLock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -702,7 +881,7 @@ func (m *MultiSigAccOperate) Reset()         { *m = MultiSigAccOperate{} }
 func (m *MultiSigAccOperate) String() string { return proto.CompactTextString(m) }
 func (*MultiSigAccOperate) ProtoMessage()    {}
 func (*MultiSigAccOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{9}
+	return fileDescriptor_62b8b91adf3febfa, []int{10}
 }
 
 func (m *MultiSigAccOperate) XXX_Unmarshal(b []byte) error {
@@ -751,6 +930,20 @@ func (m *MultiSigAccOperate) GetOperateFlag() bool {
 	return false
 }
 
+func (m *MultiSigAccOperate) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *MultiSigAccOperate) GetTimeLock() *TimeLock {
+	if m != nil {
+		return m.TimeLock
+	}
+	return nil
+}
+
 //多重签名合约中账户之间转币操作:增加一个from的字段实现MultiSigAddr--->addr之间的转账
 //需要判断from地址是否是多重签名地址
 //将MultiSig合约中from地址上execname+symbol的资产转移到to地址
@@ -761,6 +954,7 @@ type MultiSigExecTransferFrom struct {
 	Execname             string   `protobuf:"bytes,4,opt,name=execname,proto3" json:"execname,omitempty"`
 	To                   string   `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
 	From                 string   `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,7,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -770,7 +964,7 @@ func (m *MultiSigExecTransferFrom) Reset()         { *m = MultiSigExecTransferFr
 func (m *MultiSigExecTransferFrom) String() string { return proto.CompactTextString(m) }
 func (*MultiSigExecTransferFrom) ProtoMessage()    {}
 func (*MultiSigExecTransferFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{10}
+	return fileDescriptor_62b8b91adf3febfa, []int{11}
 }
 
 func (m *MultiSigExecTransferFrom) XXX_Unmarshal(b []byte) error {
@@ -833,6 +1027,13 @@ func (m *MultiSigExecTransferFrom) GetFrom() string {
 	return ""
 }
 
+func (m *MultiSigExecTransferFrom) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 //多重签名合约中账户之间转币操作: addr --->MultiSigAddr之间的转账
 //需要判断to地址是否是多重签名地址
 //将MultiSig合约中签名地址上execname+symbol的资产转移到to地址
@@ -851,7 +1052,7 @@ func (m *MultiSigExecTransferTo) Reset()         { *m = MultiSigExecTransferTo{}
 func (m *MultiSigExecTransferTo) String() string { return proto.CompactTextString(m) }
 func (*MultiSigExecTransferTo) ProtoMessage()    {}
 func (*MultiSigExecTransferTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{11}
+	return fileDescriptor_62b8b91adf3febfa, []int{12}
 }
 
 func (m *MultiSigExecTransferTo) XXX_Unmarshal(b []byte) error {
@@ -923,7 +1124,7 @@ func (m *MultiSigConfirmTx) Reset()         { *m = MultiSigConfirmTx{} }
 func (m *MultiSigConfirmTx) String() string { return proto.CompactTextString(m) }
 func (*MultiSigConfirmTx) ProtoMessage()    {}
 func (*MultiSigConfirmTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{12}
+	return fileDescriptor_62b8b91adf3febfa, []int{13}
 }
 
 func (m *MultiSigConfirmTx) XXX_Unmarshal(b []byte) error {
@@ -965,6 +1166,178 @@ func (m *MultiSigConfirmTx) GetConfirmOrRevoke() bool {
 	return false
 }
 
+// 撤销多重签名账户上还未执行的交易：提交者可以直接撤销，其他owner投票撤销，撤销的权重满足requiredWeight时交易被撤销
+type MultiSigCancelTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigCancelTx) Reset()         { *m = MultiSigCancelTx{} }
+func (m *MultiSigCancelTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigCancelTx) ProtoMessage()    {}
+func (*MultiSigCancelTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{14}
+}
+
+func (m *MultiSigCancelTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigCancelTx.Unmarshal(m, b)
+}
+func (m *MultiSigCancelTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigCancelTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigCancelTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigCancelTx.Merge(m, src)
+}
+func (m *MultiSigCancelTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigCancelTx.Size(m)
+}
+func (m *MultiSigCancelTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigCancelTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigCancelTx proto.InternalMessageInfo
+
+func (m *MultiSigCancelTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigCancelTx) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+// 时间锁解锁之后由owner触发执行交易
+type MultiSigExecTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigExecTx) Reset()         { *m = MultiSigExecTx{} }
+func (m *MultiSigExecTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigExecTx) ProtoMessage()    {}
+func (*MultiSigExecTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{15}
+}
+
+func (m *MultiSigExecTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigExecTx.Unmarshal(m, b)
+}
+func (m *MultiSigExecTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigExecTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigExecTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigExecTx.Merge(m, src)
+}
+func (m *MultiSigExecTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigExecTx.Size(m)
+}
+func (m *MultiSigExecTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigExecTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigExecTx proto.InternalMessageInfo
+
+func (m *MultiSigExecTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigExecTx) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+// 多重签名账户提交任意合约的交易，权重满足后由多重签名合约调用对应执行器执行
+// execer: 被调用的执行器名字
+// payload: 被调用执行器的action
+// to: 内部交易的to地址，为空时使用execer对应的合约地址
+// 内部交易的from地址是多重签名地址，只能调用token和evm合约
+type MultiSigSubmitTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	To                   string   `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	ExpireHeight         int64    `protobuf:"varint,5,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigSubmitTx) Reset()         { *m = MultiSigSubmitTx{} }
+func (m *MultiSigSubmitTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigSubmitTx) ProtoMessage()    {}
+func (*MultiSigSubmitTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{16}
+}
+
+func (m *MultiSigSubmitTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigSubmitTx.Unmarshal(m, b)
+}
+func (m *MultiSigSubmitTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigSubmitTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigSubmitTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigSubmitTx.Merge(m, src)
+}
+func (m *MultiSigSubmitTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigSubmitTx.Size(m)
+}
+func (m *MultiSigSubmitTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigSubmitTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigSubmitTx proto.InternalMessageInfo
+
+func (m *MultiSigSubmitTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigSubmitTx) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigSubmitTx) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *MultiSigSubmitTx) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *MultiSigSubmitTx) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
 //获取所有多重签名账号
 type ReqMultiSigAccs struct {
 	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -978,7 +1351,7 @@ func (m *ReqMultiSigAccs) Reset()         { *m = ReqMultiSigAccs{} }
 func (m *ReqMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccs) ProtoMessage()    {}
 func (*ReqMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{13}
+	return fileDescriptor_62b8b91adf3febfa, []int{17}
 }
 
 func (m *ReqMultiSigAccs) XXX_Unmarshal(b []byte) error {
@@ -1024,7 +1397,7 @@ func (m *ReplyMultiSigAccs) Reset()         { *m = ReplyMultiSigAccs{} }
 func (m *ReplyMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccs) ProtoMessage()    {}
 func (*ReplyMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{14}
+	return fileDescriptor_62b8b91adf3febfa, []int{18}
 }
 
 func (m *ReplyMultiSigAccs) XXX_Unmarshal(b []byte) error {
@@ -1064,7 +1437,7 @@ func (m *ReqMultiSigAccInfo) Reset()         { *m = ReqMultiSigAccInfo{} }
 func (m *ReqMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccInfo) ProtoMessage()    {}
 func (*ReqMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{15}
+	return fileDescriptor_62b8b91adf3febfa, []int{19}
 }
 
 func (m *ReqMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
@@ -1109,7 +1482,7 @@ func (m *ReplyMultiSigAccInfo) Reset()         { *m = ReplyMultiSigAccInfo{} }
 func (m *ReplyMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccInfo) ProtoMessage()    {}
 func (*ReplyMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{16}
+	return fileDescriptor_62b8b91adf3febfa, []int{20}
 }
 
 func (m *ReplyMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
@@ -1172,13 +1545,16 @@ func (m *ReplyMultiSigAccInfo) GetRequiredWeight() uint64 {
 	return 0
 }
 
-//获取txids设置过滤条件和区间，pending, executed
+// 获取txids设置过滤条件和区间，pending, executed, expired, timeLocked, cancelled
 type ReqMultiSigTxids struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	FromTxId             uint64   `protobuf:"varint,2,opt,name=fromTxId,proto3" json:"fromTxId,omitempty"`
 	ToTxId               uint64   `protobuf:"varint,3,opt,name=toTxId,proto3" json:"toTxId,omitempty"`
 	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
 	Executed             bool     `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	Expired              bool     `protobuf:"varint,6,opt,name=expired,proto3" json:"expired,omitempty"`
+	TimeLocked           bool     `protobuf:"varint,7,opt,name=timeLocked,proto3" json:"timeLocked,omitempty"`
+	Cancelled            bool     `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1188,7 +1564,7 @@ func (m *ReqMultiSigTxids) Reset()         { *m = ReqMultiSigTxids{} }
 func (m *ReqMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxids) ProtoMessage()    {}
 func (*ReqMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{17}
+	return fileDescriptor_62b8b91adf3febfa, []int{21}
 }
 
 func (m *ReqMultiSigTxids) XXX_Unmarshal(b []byte) error {
@@ -1244,6 +1620,27 @@ func (m *ReqMultiSigTxids) GetExecuted() bool {
 	return false
 }
 
+func (m *ReqMultiSigTxids) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *ReqMultiSigTxids) GetTimeLocked() bool {
+	if m != nil {
+		return m.TimeLocked
+	}
+	return false
+}
+
+func (m *ReqMultiSigTxids) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
 type ReplyMultiSigTxids struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	Txids                []uint64 `protobuf:"varint,2,rep,packed,name=txids,proto3" json:"txids,omitempty"`
@@ -1256,7 +1653,7 @@ func (m *ReplyMultiSigTxids) Reset()         { *m = ReplyMultiSigTxids{} }
 func (m *ReplyMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxids) ProtoMessage()    {}
 func (*ReplyMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{18}
+	return fileDescriptor_62b8b91adf3febfa, []int{22}
 }
 
 func (m *ReplyMultiSigTxids) XXX_Unmarshal(b []byte) error {
@@ -1304,7 +1701,7 @@ func (m *ReqMultiSigTxInfo) Reset()         { *m = ReqMultiSigTxInfo{} }
 func (m *ReqMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxInfo) ProtoMessage()    {}
 func (*ReqMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{19}
+	return fileDescriptor_62b8b91adf3febfa, []int{23}
 }
 
 func (m *ReqMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1350,7 +1747,7 @@ func (m *ReplyMultiSigTxInfo) Reset()         { *m = ReplyMultiSigTxInfo{} }
 func (m *ReplyMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxInfo) ProtoMessage()    {}
 func (*ReplyMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{20}
+	return fileDescriptor_62b8b91adf3febfa, []int{24}
 }
 
 func (m *ReplyMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1392,7 +1789,7 @@ func (m *ReqMultiSigAccUnSpentToday) Reset()         { *m = ReqMultiSigAccUnSpen
 func (m *ReqMultiSigAccUnSpentToday) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccUnSpentToday) ProtoMessage()    {}
 func (*ReqMultiSigAccUnSpentToday) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{21}
+	return fileDescriptor_62b8b91adf3febfa, []int{25}
 }
 
 func (m *ReqMultiSigAccUnSpentToday) XXX_Unmarshal(b []byte) error {
@@ -1445,7 +1842,7 @@ func (m *ReplyUnSpentAssets) Reset()         { *m = ReplyUnSpentAssets{} }
 func (m *ReplyUnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnSpentAssets) ProtoMessage()    {}
 func (*ReplyUnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{22}
+	return fileDescriptor_62b8b91adf3febfa, []int{26}
 }
 
 func (m *ReplyUnSpentAssets) XXX_Unmarshal(b []byte) error {
@@ -1485,7 +1882,7 @@ func (m *UnSpentAssets) Reset()         { *m = UnSpentAssets{} }
 func (m *UnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*UnSpentAssets) ProtoMessage()    {}
 func (*UnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{23}
+	return fileDescriptor_62b8b91adf3febfa, []int{27}
 }
 
 func (m *UnSpentAssets) XXX_Unmarshal(b []byte) error {
@@ -1532,7 +1929,7 @@ func (m *ReceiptMultiSig) Reset()         { *m = ReceiptMultiSig{} }
 func (m *ReceiptMultiSig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSig) ProtoMessage()    {}
 func (*ReceiptMultiSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{24}
+	return fileDescriptor_62b8b91adf3febfa, []int{28}
 }
 
 func (m *ReceiptMultiSig) XXX_Unmarshal(b []byte) error {
@@ -1573,7 +1970,7 @@ func (m *ReceiptOwnerAddOrDel) Reset()         { *m = ReceiptOwnerAddOrDel{} }
 func (m *ReceiptOwnerAddOrDel) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerAddOrDel) ProtoMessage()    {}
 func (*ReceiptOwnerAddOrDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{25}
+	return fileDescriptor_62b8b91adf3febfa, []int{29}
 }
 
 func (m *ReceiptOwnerAddOrDel) XXX_Unmarshal(b []byte) error {
@@ -1631,7 +2028,7 @@ func (m *ReceiptOwnerModOrRep) Reset()         { *m = ReceiptOwnerModOrRep{} }
 func (m *ReceiptOwnerModOrRep) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerModOrRep) ProtoMessage()    {}
 func (*ReceiptOwnerModOrRep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{26}
+	return fileDescriptor_62b8b91adf3febfa, []int{30}
 }
 
 func (m *ReceiptOwnerModOrRep) XXX_Unmarshal(b []byte) error {
@@ -1695,7 +2092,7 @@ func (m *ReceiptWeightModify) Reset()         { *m = ReceiptWeightModify{} }
 func (m *ReceiptWeightModify) String() string { return proto.CompactTextString(m) }
 func (*ReceiptWeightModify) ProtoMessage()    {}
 func (*ReceiptWeightModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{27}
+	return fileDescriptor_62b8b91adf3febfa, []int{31}
 }
 
 func (m *ReceiptWeightModify) XXX_Unmarshal(b []byte) error {
@@ -1753,7 +2150,7 @@ func (m *ReceiptDailyLimitOperate) Reset()         { *m = ReceiptDailyLimitOpera
 func (m *ReceiptDailyLimitOperate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptDailyLimitOperate) ProtoMessage()    {}
 func (*ReceiptDailyLimitOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{28}
+	return fileDescriptor_62b8b91adf3febfa, []int{32}
 }
 
 func (m *ReceiptDailyLimitOperate) XXX_Unmarshal(b []byte) error {
@@ -1817,7 +2214,7 @@ func (m *ReceiptConfirmTx) Reset()         { *m = ReceiptConfirmTx{} }
 func (m *ReceiptConfirmTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptConfirmTx) ProtoMessage()    {}
 func (*ReceiptConfirmTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{29}
+	return fileDescriptor_62b8b91adf3febfa, []int{33}
 }
 
 func (m *ReceiptConfirmTx) XXX_Unmarshal(b []byte) error {
@@ -1866,7 +2263,7 @@ func (m *ReceiptAccDailyLimitUpdate) Reset()         { *m = ReceiptAccDailyLimit
 func (m *ReceiptAccDailyLimitUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptAccDailyLimitUpdate) ProtoMessage()    {}
 func (*ReceiptAccDailyLimitUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{30}
+	return fileDescriptor_62b8b91adf3febfa, []int{34}
 }
 
 func (m *ReceiptAccDailyLimitUpdate) XXX_Unmarshal(b []byte) error {
@@ -1910,22 +2307,25 @@ func (m *ReceiptAccDailyLimitUpdate) GetCurDailyLimit() *DailyLimit {
 
 //执行MultiSigAcc相关的交易可能会修改tx的执行状态和增加确认owner
 type ReceiptMultiSigTx struct {
-	MultiSigTxOwner      *MultiSigTxOwner `protobuf:"bytes,1,opt,name=multiSigTxOwner,proto3" json:"multiSigTxOwner,omitempty"`
-	PrevExecuted         bool             `protobuf:"varint,2,opt,name=prevExecuted,proto3" json:"prevExecuted,omitempty"`
-	CurExecuted          bool             `protobuf:"varint,3,opt,name=curExecuted,proto3" json:"curExecuted,omitempty"`
-	SubmitOrConfirm      bool             `protobuf:"varint,4,opt,name=submitOrConfirm,proto3" json:"submitOrConfirm,omitempty"`
-	TxHash               string           `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	TxType               uint64           `protobuf:"varint,6,opt,name=txType,proto3" json:"txType,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	MultiSigTxOwner      *MultiSigTxOwner   `protobuf:"bytes,1,opt,name=multiSigTxOwner,proto3" json:"multiSigTxOwner,omitempty"`
+	PrevExecuted         bool               `protobuf:"varint,2,opt,name=prevExecuted,proto3" json:"prevExecuted,omitempty"`
+	CurExecuted          bool               `protobuf:"varint,3,opt,name=curExecuted,proto3" json:"curExecuted,omitempty"`
+	SubmitOrConfirm      bool               `protobuf:"varint,4,opt,name=submitOrConfirm,proto3" json:"submitOrConfirm,omitempty"`
+	TxHash               string             `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	TxType               uint64             `protobuf:"varint,6,opt,name=txType,proto3" json:"txType,omitempty"`
+	ExecReceipt          *types.ReceiptData `protobuf:"bytes,7,opt,name=execReceipt,proto3" json:"execReceipt,omitempty"`
+	ExpireHeight         int64              `protobuf:"varint,8,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
+	UnlockHeight         int64              `protobuf:"varint,9,opt,name=unlockHeight,proto3" json:"unlockHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *ReceiptMultiSigTx) Reset()         { *m = ReceiptMultiSigTx{} }
 func (m *ReceiptMultiSigTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSigTx) ProtoMessage()    {}
 func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{31}
+	return fileDescriptor_62b8b91adf3febfa, []int{35}
 }
 
 func (m *ReceiptMultiSigTx) XXX_Unmarshal(b []byte) error {
@@ -1988,6 +2388,133 @@ func (m *ReceiptMultiSigTx) GetTxType() uint64 {
 	return 0
 }
 
+func (m *ReceiptMultiSigTx) GetExecReceipt() *types.ReceiptData {
+	if m != nil {
+		return m.ExecReceipt
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigTx) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+func (m *ReceiptMultiSigTx) GetUnlockHeight() int64 {
+	if m != nil {
+		return m.UnlockHeight
+	}
+	return 0
+}
+
+// 账户时间锁的修改
+// TyLogMultiSigAccTimeLockModify = 10013
+type ReceiptTimeLockModify struct {
+	MultiSigAddr         string    `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	PrevTimeLock         *TimeLock `protobuf:"bytes,2,opt,name=prevTimeLock,proto3" json:"prevTimeLock,omitempty"`
+	CurTimeLock          *TimeLock `protobuf:"bytes,3,opt,name=curTimeLock,proto3" json:"curTimeLock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ReceiptTimeLockModify) Reset()         { *m = ReceiptTimeLockModify{} }
+func (m *ReceiptTimeLockModify) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTimeLockModify) ProtoMessage()    {}
+func (*ReceiptTimeLockModify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{36}
+}
+
+func (m *ReceiptTimeLockModify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTimeLockModify.Unmarshal(m, b)
+}
+func (m *ReceiptTimeLockModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTimeLockModify.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTimeLockModify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTimeLockModify.Merge(m, src)
+}
+func (m *ReceiptTimeLockModify) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTimeLockModify.Size(m)
+}
+func (m *ReceiptTimeLockModify) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTimeLockModify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTimeLockModify proto.InternalMessageInfo
+
+func (m *ReceiptTimeLockModify) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ReceiptTimeLockModify) GetPrevTimeLock() *TimeLock {
+	if m != nil {
+		return m.PrevTimeLock
+	}
+	return nil
+}
+
+func (m *ReceiptTimeLockModify) GetCurTimeLock() *TimeLock {
+	if m != nil {
+		return m.CurTimeLock
+	}
+	return nil
+}
+
+// 交易撤销状态的更新，记录更新前后的交易信息
+// TyLogMultiSigTxCancel = 10014
+type ReceiptTxCancel struct {
+	Prev                 *MultiSigTx `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Cur                  *MultiSigTx `protobuf:"bytes,2,opt,name=cur,proto3" json:"cur,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptTxCancel) Reset()         { *m = ReceiptTxCancel{} }
+func (m *ReceiptTxCancel) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTxCancel) ProtoMessage()    {}
+func (*ReceiptTxCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{37}
+}
+
+func (m *ReceiptTxCancel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTxCancel.Unmarshal(m, b)
+}
+func (m *ReceiptTxCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTxCancel.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTxCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTxCancel.Merge(m, src)
+}
+func (m *ReceiptTxCancel) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTxCancel.Size(m)
+}
+func (m *ReceiptTxCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTxCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTxCancel proto.InternalMessageInfo
+
+func (m *ReceiptTxCancel) GetPrev() *MultiSigTx {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTxCancel) GetCur() *MultiSigTx {
+	if m != nil {
+		return m.Cur
+	}
+	return nil
+}
+
 type ReceiptTxCountUpdate struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	CurTxCount           uint64   `protobuf:"varint,2,opt,name=curTxCount,proto3" json:"curTxCount,omitempty"`
@@ -2000,7 +2527,7 @@ func (m *ReceiptTxCountUpdate) Reset()         { *m = ReceiptTxCountUpdate{} }
 func (m *ReceiptTxCountUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTxCountUpdate) ProtoMessage()    {}
 func (*ReceiptTxCountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{32}
+	return fileDescriptor_62b8b91adf3febfa, []int{38}
 }
 
 func (m *ReceiptTxCountUpdate) XXX_Unmarshal(b []byte) error {
@@ -2048,7 +2575,7 @@ func (m *MultiSigTxOwner) Reset()         { *m = MultiSigTxOwner{} }
 func (m *MultiSigTxOwner) String() string { return proto.CompactTextString(m) }
 func (*MultiSigTxOwner) ProtoMessage()    {}
 func (*MultiSigTxOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{33}
+	return fileDescriptor_62b8b91adf3febfa, []int{39}
 }
 
 func (m *MultiSigTxOwner) XXX_Unmarshal(b []byte) error {
@@ -2101,7 +2628,7 @@ func (m *Uint64) Reset()         { *m = Uint64{} }
 func (m *Uint64) String() string { return proto.CompactTextString(m) }
 func (*Uint64) ProtoMessage()    {}
 func (*Uint64) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{34}
+	return fileDescriptor_62b8b91adf3febfa, []int{40}
 }
 
 func (m *Uint64) XXX_Unmarshal(b []byte) error {
@@ -2143,7 +2670,7 @@ func (m *AccountAssets) Reset()         { *m = AccountAssets{} }
 func (m *AccountAssets) String() string { return proto.CompactTextString(m) }
 func (*AccountAssets) ProtoMessage()    {}
 func (*AccountAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{35}
+	return fileDescriptor_62b8b91adf3febfa, []int{41}
 }
 
 func (m *AccountAssets) XXX_Unmarshal(b []byte) error {
@@ -2199,7 +2726,7 @@ func (m *ReqAccAssets) Reset()         { *m = ReqAccAssets{} }
 func (m *ReqAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccAssets) ProtoMessage()    {}
 func (*ReqAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{36}
+	return fileDescriptor_62b8b91adf3febfa, []int{42}
 }
 
 func (m *ReqAccAssets) XXX_Unmarshal(b []byte) error {
@@ -2252,7 +2779,7 @@ func (m *ReplyAccAssets) Reset()         { *m = ReplyAccAssets{} }
 func (m *ReplyAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccAssets) ProtoMessage()    {}
 func (*ReplyAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{37}
+	return fileDescriptor_62b8b91adf3febfa, []int{43}
 }
 
 func (m *ReplyAccAssets) XXX_Unmarshal(b []byte) error {
@@ -2294,7 +2821,7 @@ func (m *AccAssets) Reset()         { *m = AccAssets{} }
 func (m *AccAssets) String() string { return proto.CompactTextString(m) }
 func (*AccAssets) ProtoMessage()    {}
 func (*AccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{38}
+	return fileDescriptor_62b8b91adf3febfa, []int{44}
 }
 
 func (m *AccAssets) XXX_Unmarshal(b []byte) error {
@@ -2348,7 +2875,7 @@ func (m *Assets) Reset()         { *m = Assets{} }
 func (m *Assets) String() string { return proto.CompactTextString(m) }
 func (*Assets) ProtoMessage()    {}
 func (*Assets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{39}
+	return fileDescriptor_62b8b91adf3febfa, []int{45}
 }
 
 func (m *Assets) XXX_Unmarshal(b []byte) error {
@@ -2395,7 +2922,7 @@ func (m *AccAddress) Reset()         { *m = AccAddress{} }
 func (m *AccAddress) String() string { return proto.CompactTextString(m) }
 func (*AccAddress) ProtoMessage()    {}
 func (*AccAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{40}
+	return fileDescriptor_62b8b91adf3febfa, []int{46}
 }
 
 func (m *AccAddress) XXX_Unmarshal(b []byte) error {
@@ -2437,7 +2964,7 @@ func (m *OwnerAttr) Reset()         { *m = OwnerAttr{} }
 func (m *OwnerAttr) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttr) ProtoMessage()    {}
 func (*OwnerAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{41}
+	return fileDescriptor_62b8b91adf3febfa, []int{47}
 }
 
 func (m *OwnerAttr) XXX_Unmarshal(b []byte) error {
@@ -2490,7 +3017,7 @@ func (m *OwnerAttrs) Reset()         { *m = OwnerAttrs{} }
 func (m *OwnerAttrs) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttrs) ProtoMessage()    {}
 func (*OwnerAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{42}
+	return fileDescriptor_62b8b91adf3febfa, []int{48}
 }
 
 func (m *OwnerAttrs) XXX_Unmarshal(b []byte) error {
@@ -2520,6 +3047,7 @@ func (m *OwnerAttrs) GetItems() []*OwnerAttr {
 
 func init() {
 	proto.RegisterType((*MultiSig)(nil), "types.MultiSig")
+	proto.RegisterType((*TimeLock)(nil), "types.TimeLock")
 	proto.RegisterType((*ConfirmedOwner)(nil), "types.ConfirmedOwner")
 	proto.RegisterType((*MultiSigTx)(nil), "types.MultiSigTx")
 	proto.RegisterType((*Owner)(nil), "types.Owner")
@@ -2532,6 +3060,9 @@ func init() {
 	proto.RegisterType((*MultiSigExecTransferFrom)(nil), "types.MultiSigExecTransferFrom")
 	proto.RegisterType((*MultiSigExecTransferTo)(nil), "types.MultiSigExecTransferTo")
 	proto.RegisterType((*MultiSigConfirmTx)(nil), "types.MultiSigConfirmTx")
+	proto.RegisterType((*MultiSigCancelTx)(nil), "types.MultiSigCancelTx")
+	proto.RegisterType((*MultiSigExecTx)(nil), "types.MultiSigExecTx")
+	proto.RegisterType((*MultiSigSubmitTx)(nil), "types.MultiSigSubmitTx")
 	proto.RegisterType((*ReqMultiSigAccs)(nil), "types.ReqMultiSigAccs")
 	proto.RegisterType((*ReplyMultiSigAccs)(nil), "types.ReplyMultiSigAccs")
 	proto.RegisterType((*ReqMultiSigAccInfo)(nil), "types.ReqMultiSigAccInfo")
@@ -2551,6 +3082,8 @@ func init() {
 	proto.RegisterType((*ReceiptConfirmTx)(nil), "types.ReceiptConfirmTx")
 	proto.RegisterType((*ReceiptAccDailyLimitUpdate)(nil), "types.ReceiptAccDailyLimitUpdate")
 	proto.RegisterType((*ReceiptMultiSigTx)(nil), "types.ReceiptMultiSigTx")
+	proto.RegisterType((*ReceiptTimeLockModify)(nil), "types.ReceiptTimeLockModify")
+	proto.RegisterType((*ReceiptTxCancel)(nil), "types.ReceiptTxCancel")
 	proto.RegisterType((*ReceiptTxCountUpdate)(nil), "types.ReceiptTxCountUpdate")
 	proto.RegisterType((*MultiSigTxOwner)(nil), "types.MultiSigTxOwner")
 	proto.RegisterType((*Uint64)(nil), "types.Uint64")
@@ -2569,104 +3102,129 @@ func init() {
 }
 
 var fileDescriptor_62b8b91adf3febfa = []byte{
-	// 1572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x18, 0xdd, 0x6e, 0xdc, 0x44,
-	0x37, 0xf6, 0xfe, 0x24, 0x7b, 0x92, 0x6c, 0x93, 0xf9, 0xa2, 0x7c, 0x26, 0x94, 0x12, 0x8d, 0x4a,
-	0xb5, 0xaa, 0x20, 0x42, 0x69, 0xa1, 0x14, 0x09, 0xd4, 0xa5, 0x69, 0x95, 0xaa, 0xa4, 0x29, 0xd3,
-	0xad, 0x2a, 0x21, 0x71, 0xe1, 0xd8, 0x93, 0xd4, 0x62, 0xd7, 0xde, 0xda, 0xde, 0x64, 0x17, 0x90,
-	0xca, 0x25, 0x2f, 0x00, 0x17, 0x5c, 0x20, 0x1e, 0x82, 0x0b, 0x9e, 0x00, 0x5e, 0x80, 0xb7, 0xe0,
-	0x1a, 0x71, 0x8b, 0xe6, 0xcf, 0x9e, 0xb1, 0xbd, 0x91, 0x2b, 0x15, 0x84, 0xb8, 0xf3, 0x39, 0x73,
-	0xe6, 0xfc, 0xff, 0x79, 0xa0, 0x3b, 0x9a, 0x0c, 0xd3, 0x20, 0x09, 0x4e, 0x76, 0xc6, 0x71, 0x94,
-	0x46, 0xa8, 0x95, 0xce, 0xc6, 0x34, 0xd9, 0x5a, 0x75, 0x3d, 0x2f, 0x9a, 0x84, 0xa9, 0xc0, 0xe2,
-	0xdf, 0x2d, 0x58, 0x3a, 0x60, 0x84, 0x8f, 0x82, 0x13, 0x74, 0x09, 0xc0, 0x8b, 0xa9, 0x9b, 0xd2,
-	0xbe, 0xef, 0xc7, 0x8e, 0xb5, 0x6d, 0xf5, 0x3a, 0x44, 0xc3, 0x20, 0x0c, 0x2b, 0x23, 0x49, 0xcb,
-	0x29, 0x6c, 0x4e, 0x61, 0xe0, 0xd0, 0x65, 0x68, 0x47, 0x67, 0x21, 0x8d, 0x13, 0xa7, 0xb1, 0xdd,
-	0xe8, 0x2d, 0xef, 0xae, 0xec, 0x70, 0xb9, 0x3b, 0x87, 0x0c, 0x49, 0xe4, 0x19, 0xba, 0x06, 0xcb,
-	0xbe, 0x1b, 0x0c, 0x67, 0x1f, 0x07, 0xa3, 0x20, 0x4d, 0x9c, 0x26, 0x27, 0x5d, 0x97, 0xa4, 0x7b,
-	0xd9, 0x09, 0xd1, 0xa9, 0x90, 0x03, 0x8b, 0xe9, 0xf4, 0x36, 0x53, 0xde, 0x69, 0x6d, 0x5b, 0xbd,
-	0x26, 0x51, 0x20, 0xba, 0x02, 0xdd, 0x98, 0x3e, 0x9b, 0x04, 0x31, 0xf5, 0x9f, 0xd0, 0xe0, 0xe4,
-	0x69, 0xea, 0xb4, 0x39, 0x41, 0x01, 0x8b, 0xef, 0x42, 0xf7, 0x76, 0x14, 0x1e, 0x07, 0xf1, 0x88,
-	0xfa, 0x5c, 0x21, 0x74, 0x1d, 0xba, 0x9e, 0x81, 0x71, 0xac, 0x0a, 0xb5, 0x0b, 0x34, 0xf8, 0x17,
-	0x0b, 0x40, 0x79, 0x6d, 0x30, 0x45, 0x08, 0x9a, 0xe9, 0x34, 0xf0, 0xb9, 0xc7, 0x9a, 0x84, 0x7f,
-	0xa3, 0x4d, 0x68, 0xa7, 0xd3, 0x7d, 0x37, 0x79, 0x2a, 0xbd, 0x24, 0x21, 0xb4, 0x05, 0x4b, 0x74,
-	0x4a, 0xbd, 0x49, 0x4a, 0x7d, 0xa7, 0xb1, 0x6d, 0xf5, 0x96, 0x48, 0x06, 0x8b, 0x3b, 0x83, 0xd9,
-	0x98, 0x3a, 0x4d, 0xce, 0x49, 0x42, 0x25, 0xbf, 0xb7, 0x2a, 0xfc, 0x5e, 0x36, 0xa4, 0x5d, 0xc3,
-	0x90, 0x0f, 0xa0, 0x25, 0xfc, 0x70, 0x11, 0x3a, 0x3c, 0x34, 0x5a, 0xe4, 0x73, 0x04, 0x53, 0xec,
-	0x4c, 0xf8, 0xd5, 0x16, 0x8a, 0x09, 0x08, 0x7f, 0x67, 0x01, 0xe4, 0xd1, 0x62, 0x64, 0xc9, 0x6c,
-	0x74, 0x14, 0x0d, 0x25, 0x07, 0x09, 0x31, 0x3c, 0xb3, 0x91, 0xaa, 0x8c, 0x91, 0x10, 0xcb, 0xb7,
-	0x3c, 0xbe, 0xdc, 0x1b, 0x4d, 0xa2, 0x61, 0xd8, 0x79, 0x32, 0xa6, 0x61, 0x3a, 0x88, 0x7c, 0x77,
-	0x26, 0x7d, 0xa2, 0x61, 0x58, 0x42, 0x0c, 0xdd, 0x24, 0xdd, 0x73, 0x67, 0xdc, 0x25, 0x0d, 0xa2,
-	0x40, 0x7c, 0x04, 0x6b, 0x8f, 0xb8, 0xec, 0xbf, 0x4f, 0x3b, 0xfc, 0x6d, 0x13, 0xba, 0x2a, 0x09,
-	0xfa, 0x5e, 0x1a, 0x44, 0x21, 0xda, 0x87, 0xf5, 0x2c, 0x28, 0x9e, 0x77, 0x9b, 0x57, 0x0e, 0x97,
-	0xb6, 0xbc, 0xeb, 0xc8, 0x38, 0x1c, 0x14, 0xcf, 0xf7, 0x17, 0x48, 0xf9, 0x12, 0xfa, 0x04, 0x36,
-	0x14, 0x92, 0x07, 0xe8, 0x70, 0x4c, 0x63, 0xc6, 0xcc, 0xe6, 0xcc, 0x5e, 0x2d, 0x30, 0xd3, 0x49,
-	0xf6, 0x17, 0x48, 0xe5, 0x55, 0x74, 0x1f, 0x90, 0x26, 0x47, 0x31, 0x6c, 0x70, 0x86, 0xaf, 0x94,
-	0xb5, 0xcb, 0xd9, 0x55, 0x5c, 0xd3, 0x2d, 0x95, 0x15, 0x35, 0x98, 0xf2, 0x08, 0x95, 0x2d, 0xcd,
-	0xce, 0x75, 0x4b, 0x33, 0x24, 0x7a, 0x02, 0x9b, 0x0a, 0x79, 0x67, 0x4a, 0xbd, 0x41, 0xec, 0x86,
-	0xc9, 0x31, 0x8d, 0x07, 0x11, 0x8f, 0xe9, 0xf2, 0xee, 0x6b, 0x05, 0x76, 0x26, 0xd1, 0xfe, 0x02,
-	0x99, 0x73, 0x1d, 0x7d, 0x06, 0x4e, 0xd5, 0xc9, 0xdd, 0x38, 0x1a, 0xf1, 0xf6, 0xb0, 0xbc, 0xfb,
-	0xfa, 0x39, 0xac, 0x19, 0xd9, 0xfe, 0x02, 0x99, 0xcb, 0x02, 0x75, 0xc1, 0x1e, 0xcc, 0x9c, 0xc5,
-	0x6d, 0xab, 0xd7, 0x22, 0xf6, 0x60, 0xf6, 0xd1, 0x22, 0xb4, 0x4e, 0xdd, 0xe1, 0x84, 0xe2, 0xef,
-	0x2d, 0x58, 0x2f, 0x45, 0x59, 0xeb, 0x8b, 0xd6, 0x39, 0x7d, 0xb1, 0xdc, 0xc8, 0xec, 0xaa, 0x46,
-	0x86, 0x6e, 0x94, 0x72, 0x73, 0x79, 0xf7, 0xff, 0x92, 0x63, 0x31, 0xf1, 0x8d, 0xa4, 0xfd, 0xd9,
-	0x82, 0x8d, 0xaa, 0xac, 0x41, 0x3d, 0xb8, 0xa0, 0x85, 0x59, 0x6b, 0x03, 0x45, 0x34, 0xeb, 0x60,
-	0xd1, 0x50, 0xf6, 0x18, 0x51, 0x31, 0x19, 0xcc, 0xce, 0x42, 0x7a, 0x26, 0xce, 0x1a, 0xe2, 0x4c,
-	0xc1, 0xac, 0xc5, 0x84, 0xf4, 0x4c, 0x9a, 0x25, 0x8a, 0x39, 0x47, 0xa0, 0x6d, 0x58, 0x8e, 0x84,
-	0x2a, 0x77, 0x87, 0xee, 0x89, 0x6c, 0xf0, 0x3a, 0x0a, 0xff, 0x6a, 0x01, 0x2a, 0xe7, 0xe7, 0x0b,
-	0x28, 0x6e, 0x3a, 0xcd, 0xae, 0xed, 0x34, 0xf4, 0x26, 0xac, 0x87, 0xf4, 0x8c, 0x98, 0x81, 0x11,
-	0x0d, 0xa1, 0x7c, 0x50, 0xb4, 0xa4, 0xc9, 0x9b, 0xbc, 0x61, 0xc9, 0x0f, 0x16, 0x38, 0xf3, 0x72,
-	0xee, 0xbc, 0x36, 0xe5, 0x8e, 0xf8, 0xf0, 0xb3, 0x79, 0xaf, 0x93, 0x10, 0x1b, 0x3e, 0x61, 0x24,
-	0x0b, 0xb9, 0x43, 0xf8, 0xb7, 0x1a, 0x32, 0xa1, 0x3b, 0x12, 0xa3, 0xa4, 0x43, 0x32, 0x98, 0xe5,
-	0x6d, 0x1a, 0xc9, 0x11, 0x62, 0xa7, 0x11, 0xbb, 0x7f, 0xac, 0x4a, 0xa2, 0x43, 0xf8, 0x37, 0xfe,
-	0xc6, 0x82, 0xcd, 0xea, 0x7a, 0xfb, 0xa7, 0xd5, 0xc3, 0x5f, 0xe6, 0xc5, 0x94, 0xf7, 0x8c, 0xfa,
-	0x31, 0xe7, 0xa3, 0xf9, 0x9e, 0x2f, 0xcb, 0x88, 0x7f, 0xb3, 0xdb, 0x72, 0x0c, 0x1e, 0xc6, 0x84,
-	0x9e, 0x46, 0x9f, 0x53, 0x39, 0x89, 0x8b, 0x68, 0x7c, 0x13, 0x2e, 0x10, 0xfa, 0x4c, 0x4b, 0xba,
-	0x04, 0x6d, 0x40, 0x2b, 0x49, 0xdd, 0x38, 0xe5, 0x02, 0x1b, 0x44, 0x00, 0x68, 0x0d, 0x1a, 0x34,
-	0xf4, 0xa5, 0xe9, 0xec, 0x13, 0xbf, 0x05, 0xeb, 0x84, 0x8e, 0x87, 0x33, 0xe3, 0xb2, 0x03, 0x8b,
-	0xae, 0xef, 0xc7, 0x34, 0x11, 0x5d, 0xa0, 0x43, 0x14, 0x88, 0x3f, 0x04, 0x64, 0x4a, 0xba, 0x17,
-	0x1e, 0x47, 0xf5, 0xed, 0xc4, 0x7f, 0x5a, 0xb0, 0x51, 0x94, 0xc7, 0x59, 0xfc, 0xe7, 0x77, 0xba,
-	0x1f, 0x2d, 0x58, 0xd3, 0x5c, 0x37, 0x98, 0x06, 0x7e, 0x52, 0xb2, 0xca, 0xaa, 0xb0, 0x6a, 0x0b,
-	0x96, 0x58, 0xb2, 0x0f, 0xf2, 0xf4, 0xc8, 0x60, 0xbe, 0x89, 0x45, 0xfc, 0xa4, 0x21, 0x37, 0x31,
-	0x0e, 0x31, 0x75, 0xc7, 0x34, 0xf4, 0x83, 0x50, 0xd5, 0xb5, 0x02, 0x8d, 0xbd, 0xae, 0x65, 0xee,
-	0x75, 0xf8, 0x01, 0x0b, 0xae, 0x16, 0x9b, 0xfa, 0x3a, 0x6e, 0x40, 0x8b, 0x6d, 0x93, 0x89, 0x63,
-	0x6f, 0x37, 0x7a, 0x4d, 0x22, 0x00, 0x7c, 0x9f, 0xe5, 0x96, 0x66, 0x31, 0x0f, 0x74, 0x1d, 0x76,
-	0x15, 0xd5, 0x80, 0x1f, 0xc2, 0xff, 0x0a, 0xca, 0x71, 0x76, 0x37, 0xe5, 0x0f, 0x44, 0x86, 0x91,
-	0x7b, 0xcc, 0x7a, 0x61, 0x66, 0x0e, 0xa6, 0xa4, 0x40, 0x88, 0xc7, 0xb0, 0x65, 0xe6, 0xf2, 0xe3,
-	0xf0, 0x51, 0xbe, 0xb4, 0xd5, 0xd1, 0x73, 0xde, 0x4a, 0x96, 0x37, 0x9f, 0x86, 0xde, 0x7c, 0xf0,
-	0x43, 0xe9, 0x60, 0x29, 0xa8, 0x9f, 0x24, 0x34, 0x4d, 0xd0, 0xfb, 0xb0, 0x3a, 0xd1, 0x11, 0x32,
-	0x7b, 0x37, 0xa4, 0x05, 0x06, 0x31, 0x31, 0x49, 0xf1, 0x03, 0x58, 0x35, 0x99, 0xbd, 0x01, 0x6d,
-	0x57, 0x70, 0x11, 0x7e, 0x58, 0x95, 0x5c, 0xe4, 0x75, 0x79, 0x58, 0x68, 0x83, 0x4d, 0xd5, 0x06,
-	0xf1, 0x3b, 0xac, 0x93, 0x78, 0x34, 0x18, 0xa7, 0xd9, 0xdf, 0x56, 0x0d, 0x47, 0xe0, 0x2f, 0x58,
-	0x55, 0xf3, 0x6b, 0x87, 0x72, 0x19, 0x3f, 0x8c, 0xf7, 0xe8, 0xb0, 0x96, 0x13, 0x31, 0xb4, 0xa2,
-	0x6c, 0x48, 0x17, 0x8b, 0x56, 0x1c, 0xb1, 0xac, 0x75, 0x25, 0x4f, 0xf5, 0x37, 0xa2, 0x60, 0xfc,
-	0x93, 0x65, 0x0a, 0x3f, 0x88, 0x7c, 0xd6, 0x18, 0xc7, 0xb5, 0x84, 0x5f, 0x85, 0xce, 0x38, 0xa6,
-	0xa7, 0x87, 0x73, 0x15, 0xc8, 0x8f, 0xd1, 0xdb, 0xb0, 0xe2, 0x4d, 0xe2, 0x98, 0x86, 0x69, 0xbe,
-	0x38, 0x14, 0xc9, 0x0d, 0x0a, 0xa6, 0xf6, 0x48, 0x6a, 0x23, 0xeb, 0x30, 0x83, 0xf1, 0x73, 0x96,
-	0xcf, 0x5c, 0x6b, 0xd1, 0x20, 0x0e, 0x22, 0x3f, 0x38, 0xae, 0x97, 0x76, 0x97, 0x00, 0x98, 0x56,
-	0xc6, 0xe6, 0xa5, 0x61, 0xd0, 0x65, 0x58, 0x95, 0x6a, 0x18, 0x3b, 0x80, 0x89, 0xc4, 0xbf, 0x59,
-	0xe0, 0x48, 0x0d, 0xf2, 0xae, 0xa7, 0xb6, 0x95, 0x3a, 0x6a, 0xdc, 0x84, 0x2e, 0x13, 0xba, 0x57,
-	0xdc, 0x55, 0x2a, 0x7a, 0x69, 0x81, 0x10, 0xdd, 0xe0, 0x1a, 0xee, 0x15, 0x57, 0xc3, 0x8a, 0x9b,
-	0x26, 0x1d, 0x5b, 0x5a, 0x78, 0xe0, 0x85, 0xb7, 0xd4, 0xd2, 0xa2, 0xa1, 0xf0, 0xd7, 0xbc, 0xcf,
-	0x72, 0xb3, 0xf2, 0x41, 0x7c, 0x2b, 0x1f, 0x50, 0x83, 0xa9, 0xfa, 0x7f, 0x66, 0x12, 0x37, 0x4b,
-	0x6d, 0x42, 0xc4, 0xb1, 0x48, 0x8e, 0xae, 0xc2, 0x9a, 0xfa, 0x27, 0xcd, 0xa6, 0xb1, 0xcd, 0xa5,
-	0x97, 0xf0, 0x2c, 0x23, 0xb7, 0xa4, 0x0a, 0x7d, 0xcf, 0xcb, 0xb5, 0x7f, 0x3c, 0xf6, 0xff, 0xc5,
-	0xbe, 0xc5, 0x7f, 0x58, 0xac, 0x5f, 0x1b, 0xc5, 0xff, 0x52, 0x5c, 0x87, 0x61, 0x85, 0xa9, 0x78,
-	0x47, 0x8d, 0x1d, 0xe1, 0x36, 0x03, 0xc7, 0xe2, 0xea, 0x4d, 0xe2, 0x3b, 0xe6, 0x8b, 0x83, 0x8e,
-	0x62, 0x3b, 0x46, 0x32, 0x39, 0x62, 0x29, 0x1a, 0xcb, 0xb8, 0xca, 0xe8, 0x17, 0xd1, 0xda, 0x93,
-	0x46, 0xcb, 0x78, 0xd2, 0xc8, 0x9f, 0x2d, 0xda, 0xfa, 0xb3, 0x05, 0xfe, 0x34, 0xeb, 0x1f, 0x03,
-	0x31, 0xd3, 0x5f, 0x20, 0x4e, 0x6c, 0x6d, 0x99, 0xc4, 0xf2, 0x9e, 0x2a, 0xc5, 0x1c, 0x83, 0x9f,
-	0xc3, 0x85, 0x83, 0xb2, 0x3b, 0xea, 0x0d, 0xc0, 0x40, 0x1b, 0x80, 0x81, 0x5f, 0xf1, 0x72, 0x52,
-	0xd5, 0x80, 0x8a, 0x2f, 0x27, 0x17, 0xa1, 0xfd, 0x38, 0x08, 0xd3, 0x77, 0xaf, 0x33, 0x9e, 0xbe,
-	0x9b, 0xba, 0xea, 0xf5, 0x87, 0x7d, 0xe3, 0x18, 0x56, 0xfb, 0xe2, 0x9d, 0x4d, 0x8e, 0x8f, 0x3a,
-	0xca, 0xe5, 0x23, 0xc6, 0xae, 0x37, 0x62, 0x1a, 0xfa, 0xa6, 0x8d, 0x23, 0x58, 0x21, 0xf4, 0x19,
-	0x5b, 0x08, 0x5f, 0xba, 0xc8, 0x0d, 0x68, 0x05, 0x49, 0x7f, 0xa8, 0x66, 0x84, 0x00, 0xf0, 0x2d,
-	0xe8, 0xf2, 0xa9, 0x9b, 0x8b, 0xdc, 0x81, 0x8e, 0xab, 0x00, 0xf9, 0x9f, 0xbb, 0xa6, 0x38, 0x2a,
-	0x3c, 0xc9, 0x49, 0xf0, 0x57, 0xd0, 0xc9, 0x2f, 0xd7, 0x9c, 0xb0, 0x97, 0x00, 0x62, 0xea, 0x9d,
-	0xf6, 0xf5, 0x9f, 0x0d, 0x0d, 0x83, 0x7a, 0xb0, 0x28, 0x9f, 0x38, 0x65, 0x1c, 0xbb, 0xb9, 0x06,
-	0x0c, 0x4b, 0xd4, 0x31, 0x7e, 0x0f, 0xda, 0xfd, 0xcc, 0xa5, 0x72, 0xdf, 0xb0, 0xe6, 0xec, 0x1b,
-	0xb6, 0xb1, 0x6f, 0x5c, 0x01, 0x90, 0x8b, 0x37, 0x4d, 0xce, 0xdb, 0xea, 0x29, 0x74, 0xc4, 0xdc,
-	0x4e, 0xd3, 0x7a, 0xf9, 0x69, 0x3c, 0xc3, 0xd9, 0xf3, 0x9f, 0xe1, 0x1a, 0xc6, 0x33, 0xdc, 0x75,
-	0x80, 0x4c, 0x4c, 0x82, 0xae, 0x40, 0x2b, 0x48, 0xe9, 0xa8, 0x18, 0x80, 0x8c, 0x82, 0x88, 0xe3,
-	0xa3, 0x36, 0x7f, 0x01, 0xbe, 0xf6, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x1b, 0x43, 0x0b,
-	0x29, 0x16, 0x00, 0x00,
+	// 1973 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x19, 0xdb, 0x6e, 0x1c, 0x49,
+	0xd5, 0x3d, 0x37, 0xcf, 0x9c, 0xb1, 0xc7, 0x9e, 0x5a, 0x6f, 0x68, 0x4c, 0x08, 0x56, 0xb1, 0xbb,
+	0x1a, 0x2d, 0x60, 0x81, 0x13, 0x58, 0x82, 0x04, 0xc4, 0xc4, 0x89, 0xbc, 0xda, 0x75, 0x1c, 0x2a,
+	0x1d, 0xad, 0x84, 0xb4, 0x0f, 0xed, 0xee, 0x72, 0xd2, 0xca, 0x4c, 0xf7, 0xa4, 0xbb, 0x26, 0x9e,
+	0x01, 0xa4, 0xe5, 0x91, 0x2f, 0x40, 0x3c, 0xc3, 0x2f, 0xf0, 0x05, 0xbc, 0xc0, 0x07, 0xf0, 0xc8,
+	0x23, 0x7f, 0xc0, 0x03, 0x3c, 0xa2, 0xba, 0x75, 0x57, 0x75, 0xf7, 0x38, 0x1d, 0x76, 0x41, 0x88,
+	0xb7, 0x3e, 0x97, 0x3a, 0x75, 0x2e, 0x75, 0x2e, 0x55, 0x0d, 0xa3, 0xd9, 0x62, 0xca, 0xa2, 0x2c,
+	0x7a, 0x76, 0x38, 0x4f, 0x13, 0x96, 0xa0, 0x2e, 0x5b, 0xcd, 0x69, 0xb6, 0xbf, 0xed, 0x07, 0x41,
+	0xb2, 0x88, 0x99, 0xc4, 0xee, 0x8f, 0x59, 0xea, 0xc7, 0x99, 0x1f, 0xb0, 0x28, 0x89, 0x25, 0x0a,
+	0xff, 0xb6, 0x05, 0xfd, 0x33, 0xbe, 0xf6, 0x49, 0xf4, 0x0c, 0xdd, 0x02, 0x08, 0x52, 0xea, 0x33,
+	0x7a, 0x1c, 0x86, 0xa9, 0xeb, 0x1c, 0x38, 0x93, 0x01, 0x31, 0x30, 0x08, 0xc3, 0xd6, 0x4c, 0xf1,
+	0x0a, 0x8e, 0x96, 0xe0, 0xb0, 0x70, 0xe8, 0x1d, 0xe8, 0x25, 0x57, 0x31, 0x4d, 0x33, 0xb7, 0x7d,
+	0xd0, 0x9e, 0x0c, 0x8f, 0xb6, 0x0e, 0x85, 0x2a, 0x87, 0xe7, 0x1c, 0x49, 0x14, 0x0d, 0xdd, 0x86,
+	0x61, 0xe8, 0x47, 0xd3, 0xd5, 0xc7, 0xd1, 0x2c, 0x62, 0x99, 0xdb, 0x11, 0xac, 0x63, 0xc5, 0x7a,
+	0x92, 0x53, 0x88, 0xc9, 0x85, 0x5c, 0xd8, 0x64, 0xcb, 0xfb, 0xdc, 0x1e, 0xb7, 0x7b, 0xe0, 0x4c,
+	0x3a, 0x44, 0x83, 0xe8, 0x3d, 0x18, 0xa5, 0xf4, 0xe5, 0x22, 0x4a, 0x69, 0xf8, 0x09, 0x8d, 0x9e,
+	0x3d, 0x67, 0x6e, 0x4f, 0x30, 0x94, 0xb0, 0xe8, 0x1b, 0xd0, 0x67, 0xd1, 0x8c, 0x7e, 0x9c, 0x04,
+	0x2f, 0xdc, 0xcd, 0x03, 0x67, 0x32, 0x3c, 0xda, 0x51, 0x7b, 0x7a, 0x0a, 0x4d, 0x72, 0x06, 0x7c,
+	0x0f, 0xfa, 0x1a, 0x8b, 0x6e, 0x40, 0xef, 0x62, 0x9a, 0x04, 0x2f, 0x32, 0xe1, 0x95, 0x36, 0x51,
+	0x10, 0xba, 0x09, 0x03, 0xf6, 0x3c, 0xa5, 0xd9, 0xf3, 0x64, 0x1a, 0x0a, 0x77, 0x74, 0x48, 0x81,
+	0xc0, 0x0f, 0x61, 0x74, 0x3f, 0x89, 0x2f, 0xa3, 0x74, 0x46, 0x43, 0x61, 0x3f, 0xba, 0x03, 0xa3,
+	0xc0, 0xc2, 0xb8, 0x4e, 0x8d, 0x97, 0x4a, 0x3c, 0xf8, 0xcf, 0x6d, 0x00, 0x1d, 0x24, 0x6f, 0x89,
+	0x10, 0x74, 0xd8, 0x32, 0x0a, 0x85, 0x2a, 0x1d, 0x22, 0xbe, 0xb9, 0x82, 0x6c, 0x79, 0xea, 0x67,
+	0xcf, 0x55, 0x50, 0x14, 0x84, 0xf6, 0xa1, 0x4f, 0x97, 0x34, 0x58, 0x30, 0x1a, 0xba, 0xed, 0x03,
+	0x67, 0xd2, 0x27, 0x39, 0x2c, 0xd7, 0x78, 0xab, 0x39, 0x75, 0x3b, 0x42, 0x92, 0x82, 0x2a, 0x61,
+	0xee, 0xd6, 0x84, 0xb9, 0x6a, 0x48, 0xef, 0xf5, 0x86, 0xa0, 0x3b, 0x30, 0xe4, 0xbb, 0x13, 0x1a,
+	0xd0, 0x68, 0xce, 0x54, 0x08, 0x90, 0x5a, 0xa2, 0xb0, 0x27, 0x3e, 0xf3, 0x89, 0xc9, 0xc6, 0x9d,
+	0x9c, 0x2d, 0x2e, 0x66, 0x11, 0x63, 0x34, 0x75, 0xfb, 0x42, 0x99, 0x02, 0xc1, 0xb5, 0xa5, 0xcb,
+	0x79, 0x94, 0xd2, 0x53, 0x19, 0xf9, 0x81, 0x08, 0x90, 0x85, 0xe3, 0x3c, 0x8b, 0x98, 0x47, 0x4c,
+	0xf1, 0x80, 0xe4, 0x31, 0x71, 0x7c, 0x97, 0xc0, 0x8f, 0x03, 0x3a, 0x9d, 0xd2, 0xd0, 0x1d, 0x0a,
+	0x57, 0x15, 0x08, 0x74, 0x08, 0x43, 0x09, 0x48, 0x63, 0xb7, 0x6a, 0x8c, 0x35, 0x19, 0xb8, 0x6f,
+	0x33, 0xe6, 0xb3, 0x45, 0xe6, 0x6e, 0x1f, 0x38, 0x93, 0x2e, 0x51, 0x10, 0xfe, 0x21, 0x74, 0x25,
+	0xc3, 0x4d, 0x18, 0x88, 0x5c, 0x30, 0x52, 0xad, 0x40, 0xf0, 0xe5, 0x57, 0x52, 0x55, 0x79, 0xa8,
+	0x14, 0x84, 0x7f, 0xe3, 0x00, 0x14, 0xe9, 0x21, 0x76, 0x59, 0xcd, 0x2e, 0x92, 0xa9, 0x92, 0xa0,
+	0x20, 0x8e, 0xe7, 0x0e, 0xa4, 0x3a, 0x45, 0x15, 0xc4, 0x13, 0xbc, 0x48, 0x28, 0x71, 0x1e, 0x3a,
+	0xc4, 0xc0, 0x70, 0x7a, 0x36, 0xa7, 0x31, 0xf3, 0x92, 0xd0, 0x5f, 0xa9, 0x53, 0x61, 0x60, 0x78,
+	0x06, 0x4e, 0xfd, 0x8c, 0x9d, 0xf8, 0x2b, 0x71, 0x28, 0xda, 0x44, 0x83, 0xf8, 0x02, 0x76, 0x9f,
+	0x88, 0xbd, 0xff, 0x73, 0xda, 0xe1, 0xbf, 0x75, 0x61, 0xa4, 0xd3, 0xe0, 0x58, 0x14, 0x31, 0x74,
+	0x0a, 0xe3, 0xfc, 0x58, 0x06, 0xc1, 0x7d, 0x51, 0xaa, 0xc4, 0x6e, 0xc3, 0x23, 0x57, 0x05, 0xe7,
+	0xac, 0x4c, 0x3f, 0xdd, 0x20, 0xd5, 0x45, 0xe8, 0xa7, 0xb0, 0xa7, 0x91, 0x22, 0x40, 0xe7, 0x73,
+	0x9a, 0x72, 0x61, 0x2d, 0x21, 0xec, 0x2b, 0x25, 0x61, 0x26, 0xcb, 0xe9, 0x06, 0xa9, 0x5d, 0x8a,
+	0x3e, 0x02, 0x64, 0xec, 0xa3, 0x05, 0xb6, 0x85, 0xc0, 0x2f, 0x57, 0xb5, 0x2b, 0xc4, 0xd5, 0x2c,
+	0x33, 0x2d, 0x55, 0x35, 0xc5, 0x5b, 0xba, 0x9d, 0x5a, 0x4b, 0x73, 0xba, 0x69, 0x69, 0x8e, 0x44,
+	0x9f, 0xc0, 0x0d, 0x8d, 0x7c, 0xb0, 0xa4, 0x81, 0xc7, 0x7b, 0xc2, 0x25, 0x4d, 0xbd, 0x44, 0xc4,
+	0x74, 0x78, 0xf4, 0xd5, 0x92, 0x38, 0x9b, 0xe9, 0x74, 0x83, 0xac, 0x59, 0x8e, 0x3e, 0x05, 0xb7,
+	0x8e, 0xf2, 0x30, 0x4d, 0x66, 0xa2, 0x1e, 0x0f, 0x8f, 0xbe, 0x76, 0x8d, 0x68, 0xce, 0x76, 0xba,
+	0x41, 0xd6, 0x8a, 0x40, 0x0f, 0x60, 0x57, 0xd3, 0x9e, 0x88, 0xec, 0xf7, 0x96, 0xa2, 0x1a, 0x0c,
+	0x8f, 0xbe, 0x54, 0x12, 0xab, 0xc9, 0xa7, 0x1b, 0xa4, 0xb2, 0xc4, 0x14, 0x73, 0x5f, 0x24, 0xac,
+	0xb7, 0x74, 0x07, 0xb5, 0x62, 0x34, 0xd9, 0x14, 0xa3, 0x71, 0xe8, 0xc7, 0x30, 0xb2, 0x34, 0x5d,
+	0x8a, 0xa2, 0x32, 0x3c, 0x7a, 0xbb, 0xce, 0x44, 0x2e, 0xa2, 0xc4, 0x8e, 0x46, 0xd0, 0xf2, 0x56,
+	0xa2, 0x04, 0x76, 0x49, 0xcb, 0x5b, 0xfd, 0x64, 0x13, 0xba, 0xaf, 0xfc, 0xe9, 0x82, 0xe2, 0x3f,
+	0x39, 0x30, 0xae, 0x1c, 0x5a, 0xa3, 0xaf, 0x3a, 0xd7, 0xf4, 0xd5, 0x6a, 0x23, 0x6c, 0xd5, 0x36,
+	0xc2, 0x0f, 0x2a, 0xa9, 0x56, 0x98, 0x5f, 0xce, 0x63, 0xab, 0x42, 0x98, 0x1d, 0xb4, 0xf3, 0xba,
+	0x0e, 0xfa, 0x57, 0x07, 0xf6, 0xea, 0x32, 0x06, 0x4d, 0x60, 0xc7, 0x38, 0xe2, 0x46, 0x09, 0x2c,
+	0xa3, 0x79, 0xff, 0x4a, 0xa6, 0xaa, 0xc3, 0xc8, 0x6a, 0x91, 0xc3, 0x9c, 0x16, 0xd3, 0x2b, 0x49,
+	0x6b, 0x4b, 0x9a, 0x86, 0x79, 0x79, 0x8d, 0xe9, 0x95, 0xf2, 0x81, 0x2c, 0x64, 0x05, 0x02, 0x1d,
+	0xc0, 0x30, 0x91, 0xaa, 0x3c, 0x9c, 0xfa, 0xcf, 0xd4, 0x34, 0x61, 0xa2, 0x2a, 0x5d, 0xa5, 0x57,
+	0xed, 0x2a, 0x7c, 0x76, 0x42, 0xd5, 0xfc, 0x7d, 0x03, 0xe3, 0xec, 0x28, 0xb4, 0x9a, 0x47, 0xe1,
+	0x9b, 0x30, 0x8e, 0xe9, 0x15, 0xb1, 0x23, 0x2d, 0x0b, 0x66, 0x95, 0x50, 0xb6, 0xb6, 0x23, 0x7a,
+	0xdb, 0xb5, 0xd6, 0x76, 0x6b, 0x7a, 0xa8, 0x19, 0xf9, 0xde, 0xeb, 0x22, 0xff, 0x47, 0x07, 0xdc,
+	0x75, 0x49, 0x7e, 0x5d, 0x5f, 0xf0, 0x67, 0x62, 0xbc, 0x6b, 0xc9, 0x21, 0x4b, 0x42, 0x7c, 0xde,
+	0x89, 0x13, 0x55, 0x39, 0x07, 0x44, 0x7c, 0xeb, 0xb9, 0x26, 0xf6, 0x67, 0x72, 0x7a, 0x19, 0x90,
+	0x1c, 0xe6, 0x99, 0xc5, 0x12, 0x35, 0xb5, 0xb4, 0x58, 0xc2, 0xd7, 0x5f, 0xea, 0x1a, 0x34, 0x20,
+	0xe2, 0xbb, 0x62, 0xf1, 0x66, 0x4d, 0x7c, 0x7f, 0xed, 0xc0, 0x8d, 0xfa, 0x22, 0xf8, 0xdf, 0x36,
+	0x01, 0xff, 0xa2, 0x28, 0x09, 0x45, 0x21, 0x6f, 0x7e, 0xd0, 0xc4, 0xc4, 0xf8, 0xa1, 0x9e, 0x50,
+	0xc5, 0x37, 0x5f, 0xad, 0xa6, 0xb3, 0xf3, 0x94, 0xd0, 0x57, 0xc9, 0x0b, 0xaa, 0x06, 0xc4, 0x32,
+	0x1a, 0x3f, 0x86, 0xdd, 0x72, 0x49, 0xfc, 0x7c, 0x7b, 0xe3, 0x47, 0x30, 0xb2, 0x1c, 0xfb, 0x79,
+	0xe5, 0xfd, 0xce, 0x29, 0x54, 0xcc, 0x0b, 0x7d, 0x73, 0x91, 0xeb, 0x06, 0x12, 0x17, 0x36, 0xe7,
+	0xfe, 0x6a, 0x9a, 0xf8, 0x72, 0x76, 0xde, 0x22, 0x1a, 0x54, 0xf1, 0xe9, 0xe4, 0x47, 0xac, 0x41,
+	0x02, 0xe1, 0xbb, 0xb0, 0x43, 0xe8, 0x4b, 0xa3, 0x60, 0x64, 0x68, 0x0f, 0xba, 0x19, 0xf3, 0x53,
+	0xa6, 0x6e, 0x15, 0x12, 0x40, 0xbb, 0xd0, 0xa6, 0x71, 0xa8, 0x4e, 0x10, 0xff, 0xc4, 0xdf, 0x82,
+	0x31, 0xa1, 0xf3, 0xe9, 0xca, 0x5a, 0xec, 0xc2, 0xa6, 0x1f, 0x86, 0x29, 0xcd, 0x64, 0x4b, 0x18,
+	0x10, 0x0d, 0xe2, 0x1f, 0x01, 0xb2, 0x77, 0xfa, 0x30, 0xbe, 0x4c, 0x9a, 0xfb, 0x03, 0xff, 0xc3,
+	0x81, 0xbd, 0xf2, 0x7e, 0x42, 0xc4, 0xff, 0xfb, 0x05, 0x11, 0xff, 0xd3, 0x81, 0x5d, 0xc3, 0x75,
+	0xde, 0x32, 0x0a, 0xb3, 0x8a, 0x55, 0x4e, 0x8d, 0x55, 0xfb, 0xd0, 0xe7, 0x75, 0xc5, 0x2b, 0x4e,
+	0x66, 0x0e, 0x8b, 0x7b, 0x56, 0x22, 0x28, 0x6d, 0x75, 0xcf, 0x12, 0x90, 0x38, 0x5e, 0x34, 0x0e,
+	0xa3, 0x58, 0xd7, 0x64, 0x0d, 0x5a, 0xb7, 0xb6, 0x6e, 0xe9, 0xd6, 0xe6, 0xc2, 0xa6, 0x3c, 0x56,
+	0xa1, 0xb0, 0xa1, 0x4f, 0x34, 0xc8, 0xa3, 0xa3, 0x0b, 0x30, 0x0d, 0x45, 0x45, 0xeb, 0x13, 0x03,
+	0x63, 0xdf, 0x70, 0xfa, 0xa5, 0x1b, 0x0e, 0x7e, 0x04, 0xc8, 0x8a, 0x79, 0x73, 0xdb, 0xf7, 0xa0,
+	0xcb, 0xef, 0xa0, 0x99, 0xdb, 0x3a, 0x68, 0x4f, 0x3a, 0x44, 0x02, 0xf8, 0x23, 0x18, 0x5b, 0x9e,
+	0x14, 0x07, 0xa8, 0x89, 0xb8, 0xba, 0x04, 0x7f, 0x0c, 0x6f, 0x95, 0x94, 0x13, 0xe2, 0xee, 0x16,
+	0x43, 0x98, 0xc4, 0xa8, 0xd9, 0x7f, 0x5c, 0x1a, 0xc2, 0xbc, 0x25, 0x29, 0x31, 0xe2, 0x39, 0xec,
+	0xdb, 0x39, 0xf2, 0x34, 0x7e, 0x52, 0x5c, 0x74, 0x9a, 0xe8, 0xb9, 0xae, 0x6a, 0x14, 0xbd, 0xa1,
+	0x6d, 0xf6, 0x06, 0xfc, 0x58, 0x39, 0x58, 0x6d, 0x74, 0x9c, 0x65, 0x94, 0x65, 0xe8, 0x07, 0xb0,
+	0xbd, 0x30, 0x11, 0x2a, 0x2b, 0xf6, 0x94, 0x05, 0x16, 0x33, 0xb1, 0x59, 0xf1, 0x23, 0xd8, 0xb6,
+	0x85, 0xbd, 0x0b, 0x3d, 0x5f, 0x4a, 0x91, 0x7e, 0xd8, 0x56, 0x52, 0xd4, 0x72, 0x45, 0x2c, 0x75,
+	0xa9, 0x8e, 0xee, 0x52, 0xf8, 0xbb, 0xb0, 0xa3, 0xee, 0xdc, 0xf9, 0x93, 0x50, 0x03, 0x47, 0xe0,
+	0x9f, 0xc3, 0x9e, 0x5a, 0x76, 0xae, 0x2e, 0xb0, 0xe7, 0xe9, 0x09, 0x9d, 0x36, 0x72, 0x22, 0x86,
+	0x6e, 0x92, 0x0f, 0x77, 0xe5, 0x62, 0x20, 0x49, 0x3c, 0x1b, 0x7c, 0x25, 0x53, 0xbf, 0x61, 0x68,
+	0x18, 0xff, 0xc1, 0xb1, 0x37, 0x3f, 0x4b, 0x42, 0xde, 0xb7, 0xe6, 0x8d, 0x36, 0x7f, 0x1f, 0x06,
+	0xf3, 0x94, 0xbe, 0x3a, 0x5f, 0xab, 0x40, 0x41, 0x46, 0xdf, 0x86, 0xad, 0x60, 0x91, 0xa6, 0x34,
+	0x66, 0xc5, 0xc0, 0x59, 0x66, 0xb7, 0x38, 0xb8, 0xda, 0x33, 0xa5, 0x8d, 0xca, 0xef, 0x1c, 0xc6,
+	0x9f, 0xc1, 0x5b, 0x4a, 0x6b, 0x59, 0x78, 0xce, 0x92, 0x30, 0xba, 0x6c, 0x76, 0xec, 0x6e, 0x01,
+	0x70, 0xad, 0xac, 0xf1, 0xde, 0xc0, 0xa0, 0x77, 0x60, 0x5b, 0xa9, 0x61, 0xcd, 0x85, 0x36, 0x12,
+	0xff, 0xc5, 0x01, 0x37, 0x7f, 0x70, 0xd1, 0x15, 0x54, 0x4f, 0xb0, 0x4d, 0xd4, 0xb8, 0x0b, 0x23,
+	0xbe, 0xe9, 0x49, 0x79, 0x7e, 0xad, 0xa9, 0xd1, 0x25, 0x46, 0xf4, 0x81, 0xd0, 0xf0, 0xa4, 0x7c,
+	0xff, 0xa8, 0x59, 0x69, 0xf3, 0xf1, 0x41, 0x56, 0x04, 0x5e, 0x7a, 0x4b, 0x0f, 0xb2, 0x06, 0x0a,
+	0xff, 0x4a, 0xd4, 0x6f, 0x61, 0x56, 0x31, 0x27, 0xdd, 0x2b, 0x1a, 0x9f, 0xb7, 0xd4, 0xaf, 0x6e,
+	0x7c, 0xc7, 0x1b, 0x95, 0x32, 0x21, 0xe3, 0x58, 0x66, 0x47, 0xef, 0xc3, 0xae, 0x7e, 0xc9, 0xca,
+	0x87, 0xa5, 0x96, 0xd8, 0xbd, 0x82, 0xe7, 0x27, 0x72, 0x5f, 0xa9, 0x70, 0x1c, 0x04, 0x85, 0xf6,
+	0x4f, 0xe7, 0xe1, 0xff, 0xb0, 0x6f, 0xf1, 0xdf, 0x5b, 0x30, 0x56, 0x6a, 0x17, 0xee, 0xf8, 0x02,
+	0x5c, 0x87, 0x61, 0x8b, 0xab, 0xf8, 0x40, 0xb7, 0x33, 0xe9, 0x36, 0x0b, 0xc7, 0xe3, 0x1a, 0x2c,
+	0xd2, 0x07, 0xf6, 0x3b, 0xa5, 0x89, 0xe2, 0xb3, 0x8b, 0x7c, 0xf1, 0x3b, 0x4f, 0x55, 0x5c, 0x55,
+	0xf4, 0xcb, 0x68, 0xe3, 0x21, 0xb4, 0x6b, 0x3d, 0x84, 0x16, 0x8f, 0x9d, 0x3d, 0xeb, 0xb1, 0xf3,
+	0xdf, 0x7b, 0x92, 0x2c, 0xcf, 0x7b, 0xfd, 0x06, 0x8f, 0x8e, 0x83, 0xea, 0xa3, 0x23, 0xfe, 0xbd,
+	0x03, 0x6f, 0x2b, 0x99, 0xfa, 0x16, 0xf5, 0x06, 0xa5, 0xe0, 0xb6, 0xf4, 0xad, 0x5e, 0xe9, 0xb6,
+	0xea, 0xaf, 0x65, 0x16, 0x13, 0xfa, 0x8e, 0x70, 0x76, 0xbe, 0xa6, 0x5d, 0xbf, 0xc6, 0xe4, 0xc1,
+	0x9f, 0xe6, 0x7d, 0xc1, 0x5b, 0xca, 0x1b, 0x00, 0x7a, 0x17, 0x3a, 0x5c, 0xea, 0xfa, 0x7e, 0x2b,
+	0xc8, 0xe8, 0xeb, 0xd0, 0x0e, 0x16, 0xa9, 0xdb, 0x5a, 0xc7, 0xc5, 0xa9, 0xf8, 0x67, 0x79, 0x09,
+	0xf7, 0xe4, 0xb8, 0xf6, 0x06, 0xa9, 0xc2, 0x27, 0xd2, 0x45, 0xaa, 0xd6, 0xe9, 0x6a, 0x58, 0x60,
+	0xf0, 0x67, 0xb0, 0x73, 0x56, 0x3d, 0x91, 0xcd, 0x66, 0x90, 0xc8, 0x98, 0x41, 0xa2, 0xb0, 0xe6,
+	0xc9, 0xbb, 0xae, 0x07, 0x94, 0x78, 0xf0, 0x4d, 0xe8, 0x3d, 0x8d, 0x62, 0xf6, 0xbd, 0x3b, 0x5c,
+	0x66, 0xe8, 0x33, 0x5f, 0x3f, 0xdb, 0xf3, 0x6f, 0x9c, 0xc2, 0xf6, 0xb1, 0xfc, 0x45, 0xa3, 0x3a,
+	0x78, 0x13, 0xe5, 0x8a, 0x2e, 0xdf, 0x6a, 0xd6, 0xe5, 0xdb, 0xe6, 0x5d, 0x14, 0x27, 0xb0, 0x45,
+	0xe8, 0x4b, 0x3e, 0xeb, 0x7f, 0xe1, 0x5b, 0xee, 0x41, 0x37, 0xca, 0x8e, 0xa7, 0xba, 0x4d, 0x4b,
+	0x00, 0xdf, 0x83, 0x91, 0x18, 0x7c, 0x8a, 0x2d, 0x0f, 0x61, 0xe0, 0x6b, 0x40, 0xbd, 0x67, 0xed,
+	0x6a, 0x89, 0x1a, 0x4f, 0x0a, 0x16, 0xfc, 0x4b, 0x18, 0x14, 0x8b, 0x1b, 0x0e, 0x39, 0xb7, 0x00,
+	0x52, 0x1a, 0xbc, 0x3a, 0x36, 0xaf, 0xe3, 0x06, 0x06, 0x4d, 0x60, 0x53, 0xfd, 0x1d, 0x53, 0x71,
+	0x1c, 0x15, 0x1a, 0x70, 0x2c, 0xd1, 0x64, 0xfc, 0x7d, 0xe8, 0x1d, 0xe7, 0x2e, 0x55, 0x23, 0x9f,
+	0xb3, 0x66, 0xe4, 0x6b, 0x59, 0x23, 0xdf, 0x7b, 0x00, 0xea, 0x4e, 0x45, 0xb3, 0xeb, 0x2e, 0x6c,
+	0x14, 0x06, 0x72, 0x74, 0x62, 0xac, 0xd9, 0xf9, 0xb4, 0xfe, 0x1e, 0xb4, 0xd6, 0xff, 0x3d, 0x68,
+	0x5b, 0x7f, 0x0f, 0xee, 0x00, 0xe4, 0xdb, 0xf0, 0xb7, 0xc2, 0x6e, 0xc4, 0xe8, 0xac, 0x1c, 0x80,
+	0x9c, 0x83, 0x48, 0xf2, 0x45, 0x4f, 0xfc, 0x29, 0xbc, 0xfd, 0xaf, 0x01, 0x00, 0x9e, 0x79, 0xd6,
+	0x89, 0x64, 0x1c, 0x00, 0x00,
 }
@@ -5,6 +5,7 @@
 package types
 
 import (
+	"bytes"
 	"reflect"
 
 	"github.com/33cn/chain33/types"
@@ -19,6 +20,8 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(MultiSigX, "Enable", 0)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigSubmitTxX, types.MaxHeight)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigTimeLockX, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -58,6 +61,9 @@ func (m *MultiSigType) GetTypeMap() map[string]int32 {
 		"MultiSigConfirmTx":        ActionMultiSigConfirmTx,
 		"MultiSigExecTransferTo":   ActionMultiSigExecTransferTo,
 		"MultiSigExecTransferFrom": ActionMultiSigExecTransferFrom,
+		"MultiSigSubmitTx":         ActionMultiSigSubmitTx,
+		"MultiSigCancelTx":         ActionMultiSigCancelTx,
+		"MultiSigExecTx":           ActionMultiSigExecTx,
 	}
 }
 
@@ -81,6 +87,9 @@ func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogDailyLimitUpdate: {Ty: reflect.TypeOf(ReceiptAccDailyLimitUpdate{}), Name: "LogAccDailyLimitUpdate"},
 		TyLogMultiSigTx:       {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigAccTx"},
 		TyLogTxCountUpdate:    {Ty: reflect.TypeOf(ReceiptTxCountUpdate{}), Name: "LogTxCountUpdate"},
+
+		TyLogMultiSigAccTimeLockModify: {Ty: reflect.TypeOf(ReceiptTimeLockModify{}), Name: "LogMultiSigAccTimeLockModify"},
+		TyLogMultiSigTxCancel:          {Ty: reflect.TypeOf(ReceiptTxCancel{}), Name: "LogMultiSigTxCancel"},
 	}
 }
 
@@ -113,6 +122,53 @@ func (m MultiSigType) ActionName(tx *types.Transaction) string {
 		return "MultiSigExecTransfer"
 	} else if g.Ty == ActionMultiSigExecTransferFrom && g.GetMultiSigExecTransferFrom() != nil {
 		return "MultiSigAccExecTransfer"
+	} else if g.Ty == ActionMultiSigSubmitTx && g.GetMultiSigSubmitTx() != nil {
+		return "MultiSigSubmitTx"
+	} else if g.Ty == ActionMultiSigCancelTx && g.GetMultiSigCancelTx() != nil {
+		return "MultiSigCancelTx"
+	} else if g.Ty == ActionMultiSigExecTx && g.GetMultiSigExecTx() != nil {
+		return "MultiSigExecTx"
 	}
 	return "unknown"
 }
+
+//IsSubmitTx 交易是否可能执行MultiSigSubmitTx的内部交易
+func IsSubmitTx(tx *types.Transaction) bool {
+	if tx == nil || string(types.GetRealExecName(tx.Execer)) != MultiSigX {
+		return false
+	}
+	var action MultiSigAction
+	if err := types.Decode(tx.Payload, &action); err != nil {
+		return false
+	}
+	return action.GetMultiSigSubmitTx() != nil || action.GetMultiSigConfirmTx() != nil || action.GetMultiSigExecTx() != nil
+}
+
+//IsSubmitTxExecer 执行器是否可以被MultiSigSubmitTx调用
+func IsSubmitTxExecer(execer []byte) bool {
+	return submitTxExecers[string(types.GetRealExecName(execer))]
+}
+
+//IsSubmitTxKey 内部交易写入的key是否属于可以被MultiSigSubmitTx调用的执行器
+func IsSubmitTxKey(driverName string, writekey []byte) bool {
+	return submitTxExecers[driverName] && bytes.HasPrefix(writekey, []byte("mavl-"+driverName+"-"))
+}
+
+//IsSubmitTxFriend 被调用的执行器在IsFriend中允许多重签名合约写入自己的数据，
+//内部交易写入的key已经由多重签名合约按照普通交易的写权限规则检查过
+func IsSubmitTxFriend(driverName string, writekey []byte, othertx *types.Transaction) bool {
+	return IsSubmitTxKey(driverName, writekey) && IsSubmitTx(othertx)
+}
+
+//NewInnerTxSignature MultiSigSubmitTx内部交易的签名，只用于标识内部交易的from地址
+func NewInnerTxSignature(multiSigAddr string) *types.Signature {
+	return &types.Signature{Ty: InnerTxSignType, Pubkey: []byte(multiSigAddr)}
+}
+
+//GetTxFrom 交易的from地址，MultiSigSubmitTx的内部交易是多重签名地址，被调用的执行器需要以此获取from地址
+func GetTxFrom(tx *types.Transaction) string {
+	if tx.GetSignature().GetTy() == InnerTxSignType {
+		return string(tx.GetSignature().GetPubkey())
+	}
+	return tx.From()
+}
@@ -8,6 +8,7 @@ package commands
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -34,6 +35,14 @@ func OracleCmd() *cobra.Command {
 		OracleAbortPrePubResultRawTxCmd(),
 		OraclePublishResultRawTxCmd(),
 		OracleQueryRawTxCmd(),
+		OracleReporterStakeRawTxCmd(),
+		OracleReporterUnstakeRawTxCmd(),
+		OracleReportResultRawTxCmd(),
+		OracleSettleEventRawTxCmd(),
+		OracleDisputeResultRawTxCmd(),
+		OracleFinalizeEventRawTxCmd(),
+		OracleQuerySettlementCmd(),
+		OracleQueryReporterCmd(),
 	)
 
 	return cmd
@@ -85,6 +94,15 @@ func addPublishEventFlags(cmd *cobra.Command) {
 		fmt.Printf("MarkFlagRequired introduction Error: %v", err)
 		return
 	}
+
+	cmd.Flags().Int32P("aggregation", "g", 0, "report aggregation, 1:median 2:majority, 0 means results published by publisher")
+	cmd.Flags().Int64P("report_period", "p", 0, "seconds after time that reporters can report")
+	cmd.Flags().Int64P("dispute_period", "d", 0, "seconds after settlement that result can be disputed, also the time publisher has to rule a dispute")
+	cmd.Flags().Int32P("min_reports", "n", 1, "min reports needed to settle the event")
+	cmd.Flags().Float64P("min_stake", "k", 1, "stake locked for each report, at least 1")
+	cmd.Flags().Float64P("slash", "x", 0, "stake slashed when report disagrees with final result")
+	cmd.Flags().Int64P("tolerance", "o", 0, "deviation allowed from median result")
+	cmd.Flags().Float64P("bond", "b", 0, "bond frozen to dispute the settled result, must be positive")
 }
 
 func publishEvent(cmd *cobra.Command, args []string) {
@@ -134,6 +152,34 @@ func publishEvent(cmd *cobra.Command, args []string) {
 		ActionName: oraclety.CreateEventPublishTx,
 		Payload:    []byte(fmt.Sprintf("{\"type\":\"%s\",\"subType\":\"%s\",\"time\":%d, \"content\":\"%s\", \"introduction\":\"%s\"}", ty, subType, t.Unix(), content, introduction)),
 	}
+	aggregation, _ := cmd.Flags().GetInt32("aggregation")
+	if aggregation != 0 {
+		reportPeriod, _ := cmd.Flags().GetInt64("report_period")
+		disputePeriod, _ := cmd.Flags().GetInt64("dispute_period")
+		minReports, _ := cmd.Flags().GetInt32("min_reports")
+		minStake, _ := cmd.Flags().GetFloat64("min_stake")
+		slash, _ := cmd.Flags().GetFloat64("slash")
+		tolerance, _ := cmd.Flags().GetInt64("tolerance")
+		bond, _ := cmd.Flags().GetFloat64("bond")
+		payload := &oraclety.EventPublish{
+			Type:         ty,
+			SubType:      subType,
+			Time:         t.Unix(),
+			Content:      content,
+			Introduction: introduction,
+			Rule: &oraclety.ReportRule{
+				Aggregation:   aggregation,
+				ReportPeriod:  reportPeriod,
+				DisputePeriod: disputePeriod,
+				MinReports:    minReports,
+				MinStake:      int64(math.Trunc((minStake+0.0000001)*1e4)) * 1e4,
+				SlashAmount:   int64(math.Trunc((slash+0.0000001)*1e4)) * 1e4,
+				Tolerance:     tolerance,
+				DisputeBond:   int64(math.Trunc((bond+0.0000001)*1e4)) * 1e4,
+			},
+		}
+		params.Payload = types.MustPBToJSON(payload)
+	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
 	ctx.RunWithoutMarshal()
@@ -393,7 +439,7 @@ func addOracleQueryFlags(cmd *cobra.Command) {
 		return
 	}
 
-	cmd.Flags().StringP("status", "s", "", "status, number 1-5")
+	cmd.Flags().StringP("status", "s", "", "status, number 1-6")
 	err = cmd.MarkFlagRequired("status")
 	if err != nil {
 		fmt.Printf("MarkFlagRequired status Error: %v", err)
@@ -472,8 +518,8 @@ func oracleQuery(cmd *cobra.Command, args []string) {
 		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
 		ctx.Run()
 	} else if statusStr != "" {
-		if status < 0 || status > 5 {
-			fmt.Println("Error: status must be 1-5")
+		if status < 0 || status > 6 {
+			fmt.Println("Error: status must be 1-6")
 			cmd.Help()
 			return
 		} else if addr != "" {
@@ -491,3 +537,197 @@ func oracleQuery(cmd *cobra.Command, args []string) {
 		cmd.Help()
 	}
 }
+
+// OracleReporterStakeRawTxCmd 上报人质押
+func OracleReporterStakeRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stake",
+		Short: "stake coins in oracle to become a reporter",
+		Run:   reporterStake,
+	}
+	addReporterAmountFlags(cmd)
+	return cmd
+}
+
+// OracleReporterUnstakeRawTxCmd 上报人取回质押
+func OracleReporterUnstakeRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unstake",
+		Short: "withdraw stake not locked by reports",
+		Run:   reporterUnstake,
+	}
+	addReporterAmountFlags(cmd)
+	return cmd
+}
+
+func addReporterAmountFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64P("amount", "a", 0, "amount of coins")
+	err := cmd.MarkFlagRequired("amount")
+	if err != nil {
+		fmt.Printf("MarkFlagRequired amount Error: %v", err)
+		return
+	}
+}
+
+func reporterStake(cmd *cobra.Command, args []string) {
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	createOracleTx(cmd, oraclety.CreateReporterStakeTx, &oraclety.ReporterStake{Amount: int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4})
+}
+
+func reporterUnstake(cmd *cobra.Command, args []string) {
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	createOracleTx(cmd, oraclety.CreateReporterUnstakeTx, &oraclety.ReporterUnstake{Amount: int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4})
+}
+
+// OracleReportResultRawTxCmd 上报人上报结果
+func OracleReportResultRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report_result",
+		Short: "report result of an event as a staked reporter",
+		Run:   reportResult,
+	}
+	addPrePublishResultFlags(cmd)
+	return cmd
+}
+
+func reportResult(cmd *cobra.Command, args []string) {
+	eventID, _ := cmd.Flags().GetString("eventID")
+	source, _ := cmd.Flags().GetString("source")
+	result, _ := cmd.Flags().GetString("result")
+	createOracleTx(cmd, oraclety.CreateResultReportTx, &oraclety.ResultReport{EventID: eventID, Source: source, Result: result})
+}
+
+// OracleSettleEventRawTxCmd 聚合上报结果
+func OracleSettleEventRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle",
+		Short: "settle reports of an event after report period",
+		Run:   settleEvent,
+	}
+	addAbortPublishEventFlags(cmd)
+	return cmd
+}
+
+func settleEvent(cmd *cobra.Command, args []string) {
+	eventID, _ := cmd.Flags().GetString("eventID")
+	createOracleTx(cmd, oraclety.CreateEventSettleTx, &oraclety.EventSettle{EventID: eventID})
+}
+
+// OracleDisputeResultRawTxCmd 对聚合结果发起争议
+func OracleDisputeResultRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute",
+		Short: "dispute settled result in dispute period",
+		Run:   disputeResult,
+	}
+	addAbortPublishEventFlags(cmd)
+	cmd.Flags().StringP("result", "r", "", "result disputer believes")
+	err := cmd.MarkFlagRequired("result")
+	if err != nil {
+		fmt.Printf("MarkFlagRequired result Error: %v", err)
+	}
+	return cmd
+}
+
+func disputeResult(cmd *cobra.Command, args []string) {
+	eventID, _ := cmd.Flags().GetString("eventID")
+	result, _ := cmd.Flags().GetString("result")
+	createOracleTx(cmd, oraclety.CreateResultDisputeTx, &oraclety.ResultDispute{EventID: eventID, Result: result})
+}
+
+// OracleFinalizeEventRawTxCmd 确认无争议或者超时未裁决的结果
+func OracleFinalizeEventRawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize",
+		Short: "finalize settled result after dispute period, or after an unruled dispute expires",
+		Run:   finalizeEvent,
+	}
+	addAbortPublishEventFlags(cmd)
+	return cmd
+}
+
+func finalizeEvent(cmd *cobra.Command, args []string) {
+	eventID, _ := cmd.Flags().GetString("eventID")
+	createOracleTx(cmd, oraclety.CreateEventFinalizeTx, &oraclety.EventFinalize{EventID: eventID})
+}
+
+func createOracleTx(cmd *cobra.Command, actionName string, payload types.Message) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(oraclety.OracleX),
+		ActionName: actionName,
+		Payload:    types.MustPBToJSON(payload),
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// OracleQuerySettlementCmd 查询聚合结果及上报记录
+func OracleQuerySettlementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query_reports",
+		Short: "query settlement and reports of an event, or reports of a reporter",
+		Run:   querySettlement,
+	}
+	cmd.Flags().StringP("eventID", "e", "", "eventID")
+	cmd.Flags().StringP("reporter", "a", "", "reporter address")
+	cmd.Flags().StringP("primary", "p", "", "last report id, to get next page data")
+	return cmd
+}
+
+func querySettlement(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	eventID, _ := cmd.Flags().GetString("eventID")
+	reporter, _ := cmd.Flags().GetString("reporter")
+	primary, _ := cmd.Flags().GetString("primary")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = oraclety.OracleX
+	params.Payload = types.MustPBToJSON(&oraclety.QueryOracleReports{EventID: eventID, Reporter: reporter, Primary: primary})
+	if eventID != "" {
+		params.FuncName = oraclety.FuncNameQueryOracleSettlement
+		var res oraclety.ReplyOracleSettlement
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+	} else if reporter != "" {
+		params.FuncName = oraclety.FuncNameQueryReportsByReporter
+		var res oraclety.ReplyOracleReports
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+	} else {
+		fmt.Println("Error: requeres one of eventID, reporter")
+		cmd.Help()
+	}
+}
+
+// OracleQueryReporterCmd 查询上报人质押
+func OracleQueryReporterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query_reporter",
+		Short: "query stake of a reporter",
+		Run:   queryReporter,
+	}
+	cmd.Flags().StringP("addr", "a", "", "reporter address")
+	err := cmd.MarkFlagRequired("addr")
+	if err != nil {
+		fmt.Printf("MarkFlagRequired addr Error: %v", err)
+	}
+	return cmd
+}
+
+func queryReporter(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = oraclety.OracleX
+	params.FuncName = oraclety.FuncNameQueryOracleReporter
+	params.Payload = types.MustPBToJSON(&oraclety.QueryOracleReporter{Addr: addr})
+	var res oraclety.OracleReporter
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
@@ -16,4 +16,14 @@ package executor
 3.1 数据预发布者撤销预发布数据
 4 数据最终发布者发布数据
 5 通过查询接口查询问题结果
+
+多方上报模式(ForkOracleReporter之后)
+
+1 问题发布者发布问题时指定上报规则(聚合方式、上报期、争议期、最少上报数、质押及罚没金额)
+2 上报人质押后在上报期内上报结果
+3 上报期结束后任何人可以聚合结果，上报数不足时事件取消
+4 争议期内发布者以外的地址可以冻结保证金发起争议，由问题发布者从已有结果中裁决最终结果
+5 争议期结束后任何人可以确认结果，与最终结果不一致的上报人被罚没；
+  发布者超时未裁决时任何人可以按聚合结果确认
+6 通过QueryOracleSettlement查询聚合结果及各上报人的提交
 */
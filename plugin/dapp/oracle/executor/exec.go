@@ -35,3 +35,33 @@ func (o *oracle) Exec_ResultPublish(payload *oty.ResultPublish, tx *types.Transa
 	action := newOracleAction(o, tx, index)
 	return action.resultPublish(payload)
 }
+
+func (o *oracle) Exec_ReporterStake(payload *oty.ReporterStake, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	return action.reporterStake(payload)
+}
+
+func (o *oracle) Exec_ReporterUnstake(payload *oty.ReporterUnstake, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	return action.reporterUnstake(payload)
+}
+
+func (o *oracle) Exec_ResultReport(payload *oty.ResultReport, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	return action.resultReport(payload)
+}
+
+func (o *oracle) Exec_EventSettle(payload *oty.EventSettle, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	return action.eventSettle(payload)
+}
+
+func (o *oracle) Exec_ResultDispute(payload *oty.ResultDispute, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	return action.resultDispute(payload)
+}
+
+func (o *oracle) Exec_EventFinalize(payload *oty.EventFinalize, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newOracleAction(o, tx, index)
+	return action.eventFinalize(payload)
+}
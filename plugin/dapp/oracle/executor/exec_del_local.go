@@ -14,7 +14,28 @@ import (
 func (o *oracle) execDelLocal(receipt *types.ReceiptData) (*types.LocalDBSet, error) {
 	set := &types.LocalDBSet{}
 	table := oty.NewTable(o.GetLocalDB())
+	reportTable := oty.NewReportTable(o.GetLocalDB())
 	for _, item := range receipt.Logs {
+		if item.Ty == oty.TyLogResultReport {
+			var reportlog oty.ReceiptOracleReport
+			err := types.Decode(item.Log, &reportlog)
+			if err != nil {
+				return nil, err
+			}
+			err = reportTable.Del([]byte(oty.ReportID(reportlog.EventID, reportlog.Reporter)))
+			if err != nil {
+				return nil, err
+			}
+			kvs, err := reportTable.Save()
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
+			continue
+		}
+		if !isOracleStatusLog(item.Ty) {
+			continue
+		}
 		var oraclelog oty.ReceiptOracle
 		err := types.Decode(item.Log, &oraclelog)
 		if err != nil {
@@ -64,3 +85,27 @@ func (o *oracle) ExecDelLocal_ResultAbort(payload *oty.ResultAbort, tx *types.Tr
 func (o *oracle) ExecDelLocal_ResultPublish(payload *oty.ResultPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execDelLocal(receiptData)
 }
+
+func (o *oracle) ExecDelLocal_ReporterStake(payload *oty.ReporterStake, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_ReporterUnstake(payload *oty.ReporterUnstake, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_ResultReport(payload *oty.ResultReport, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_EventSettle(payload *oty.EventSettle, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_ResultDispute(payload *oty.ResultDispute, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
+
+func (o *oracle) ExecDelLocal_EventFinalize(payload *oty.EventFinalize, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execDelLocal(receiptData)
+}
@@ -17,8 +17,24 @@ func (o *oracle) execLocal(receipt *types.ReceiptData) (*types.LocalDBSet, error
 		return set, nil
 	}
 	table := oty.NewTable(o.GetLocalDB())
+	reportTable := oty.NewReportTable(o.GetLocalDB())
 	for _, item := range receipt.Logs {
-		if item.Ty >= oty.TyLogEventPublish && item.Ty <= oty.TyLogResultPublish {
+		if item.Ty == oty.TyLogResultReport {
+			var reportlog oty.ReceiptOracleReport
+			err := types.Decode(item.Log, &reportlog)
+			if err != nil {
+				return nil, err
+			}
+			err = reportTable.Add(&reportlog)
+			if err != nil {
+				return nil, err
+			}
+			kvs, err := reportTable.Save()
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
+		} else if isOracleStatusLog(item.Ty) {
 			var oraclelog oty.ReceiptOracle
 			err := types.Decode(item.Log, &oraclelog)
 			if err != nil {
@@ -38,6 +54,16 @@ func (o *oracle) execLocal(receipt *types.ReceiptData) (*types.LocalDBSet, error
 	return set, nil
 }
 
+//isOracleStatusLog 记录事件状态变化的日志
+func isOracleStatusLog(ty int32) bool {
+	switch ty {
+	case oty.TyLogEventPublish, oty.TyLogEventAbort, oty.TyLogResultPrePublish, oty.TyLogResultAbort, oty.TyLogResultPublish,
+		oty.TyLogEventSettle, oty.TyLogResultDispute, oty.TyLogEventFinalize:
+		return true
+	}
+	return false
+}
+
 func (o *oracle) ExecLocal_EventPublish(payload *oty.EventPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execLocal(receiptData)
 }
@@ -57,3 +83,27 @@ func (o *oracle) ExecLocal_ResultAbort(payload *oty.ResultAbort, tx *types.Trans
 func (o *oracle) ExecLocal_ResultPublish(payload *oty.ResultPublish, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return o.execLocal(receiptData)
 }
+
+func (o *oracle) ExecLocal_ReporterStake(payload *oty.ReporterStake, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_ReporterUnstake(payload *oty.ReporterUnstake, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_ResultReport(payload *oty.ResultReport, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_EventSettle(payload *oty.EventSettle, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_ResultDispute(payload *oty.ResultDispute, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
+
+func (o *oracle) ExecLocal_EventFinalize(payload *oty.EventFinalize, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return o.execLocal(receiptData)
+}
@@ -9,11 +9,14 @@ package executor
 import (
 	"fmt"
 
+	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/common/db/table"
 
 	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
 	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
 )
 
@@ -66,25 +69,27 @@ func (o *OracleDB) save(db dbm.KV) error {
 
 // Key for oracle
 func Key(id string) (key []byte) {
-	key = append(key, []byte("mavl-"+oty.OracleX+"-")...)
-	key = append(key, []byte(id)...)
-	return key
+	return oty.OracleStatusKey(id)
 }
 
 type oracleAction struct {
-	db        dbm.KV
-	txhash    []byte
-	fromaddr  string
-	blocktime int64
-	height    int64
-	index     int
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	blocktime    int64
+	height       int64
+	index        int
+	coinsAccount *account.DB
+	execaddr     string
+	cfg          *types.Chain33Config
 }
 
 func newOracleAction(o *oracle, tx *types.Transaction, index int) *oracleAction {
 	hash := tx.Hash()
 	fromaddr := tx.From()
 	return &oracleAction{o.GetStateDB(), hash, fromaddr,
-		o.GetBlockTime(), o.GetHeight(), index}
+		o.GetBlockTime(), o.GetHeight(), index, o.GetCoinsAccount(),
+		dapp.ExecAddress(string(tx.Execer)), o.GetAPI().GetConfig()}
 }
 
 func (action *oracleAction) eventPublish(event *oty.EventPublish) (*types.Receipt, error) {
@@ -99,10 +104,20 @@ func (action *oracleAction) eventPublish(event *oty.EventPublish) (*types.Receip
 		return nil, oty.ErrTimeMustBeFuture
 	}
 	// 是否是事件发布者
-	if !isEventPublisher(action.fromaddr, action.db, false) {
+	if !isEventPublisher(action.cfg, action.fromaddr, action.db, action.height, false) {
 		return nil, oty.ErrNoPrivilege
 	}
 
+	// 多方上报的事件需要校验上报规则
+	if event.Rule != nil {
+		if !action.cfg.IsDappFork(action.height, oty.OracleX, oty.ForkOracleReporterX) {
+			return nil, types.ErrActionNotSupport
+		}
+		if err := checkReportRule(event.Rule); err != nil {
+			return nil, err
+		}
+	}
+
 	_, err := findOracleStatus(action.db, eventID)
 	if err != types.ErrNotFound {
 		olog.Error("EventPublish", "EventPublish repeated eventID", eventID)
@@ -110,6 +125,7 @@ func (action *oracleAction) eventPublish(event *oty.EventPublish) (*types.Receip
 	}
 
 	eventStatus := NewOracleDB(eventID, action.fromaddr, event.Type, event.SubType, event.Content, event.Introduction, event.Time, action.GetIndex())
+	eventStatus.Rule = event.Rule
 	olog.Debug("eventPublish", "PublisherAddr", eventStatus.Addr, "EventID", eventStatus.EventID, "Event", eventStatus.Content)
 
 	if err := eventStatus.save(action.db); err != nil {
@@ -130,7 +146,7 @@ func (action *oracleAction) eventAbort(event *oty.EventAbort) (*types.Receipt, e
 	var receipt *types.Receipt
 
 	//只有发布问题的人能取消问题
-	if !isEventPublisher(action.fromaddr, action.db, false) {
+	if !isEventPublisher(action.cfg, action.fromaddr, action.db, action.height, false) {
 		return nil, oty.ErrNoPrivilege
 	}
 
@@ -147,6 +163,15 @@ func (action *oracleAction) eventAbort(event *oty.EventAbort) (*types.Receipt, e
 		return nil, oty.ErrEventAbortNotAllowed
 	}
 
+	//取消多方上报的事件时解锁上报人的质押
+	if ora.Rule != nil {
+		kvs, err := action.unlockReports(ora)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, kvs...)
+	}
+
 	updateStatus(ora, action.GetIndex(), action.fromaddr, oty.EventAborted)
 
 	if err := ora.save(action.db); err != nil {
@@ -167,7 +192,7 @@ func (action *oracleAction) resultPrePublish(event *oty.ResultPrePublish) (*type
 	var receipt *types.Receipt
 
 	//只有发布问题的人能取消问题
-	if !isEventPublisher(action.fromaddr, action.db, false) {
+	if !isEventPublisher(action.cfg, action.fromaddr, action.db, action.height, false) {
 		return nil, oty.ErrNoPrivilege
 	}
 
@@ -179,6 +204,10 @@ func (action *oracleAction) resultPrePublish(event *oty.ResultPrePublish) (*type
 
 	ora := &OracleDB{*oracleStatus}
 
+	if ora.Rule != nil {
+		return nil, oty.ErrReportEventNotAllowed
+	}
+
 	if ora.Status.Status != oty.EventPublished && ora.Status.Status != oty.ResultAborted {
 		olog.Error("ResultPrePublish", "ResultPrePublish can not pre-publish", ora.Status.Status)
 		return nil, oty.ErrResultPrePublishNotAllowed
@@ -206,7 +235,7 @@ func (action *oracleAction) resultAbort(event *oty.ResultAbort) (*types.Receipt,
 	var receipt *types.Receipt
 
 	//只有发布问题的人能取消预发布
-	if !isEventPublisher(action.fromaddr, action.db, false) {
+	if !isEventPublisher(action.cfg, action.fromaddr, action.db, action.height, false) {
 		return nil, oty.ErrNoPrivilege
 	}
 
@@ -218,6 +247,10 @@ func (action *oracleAction) resultAbort(event *oty.ResultAbort) (*types.Receipt,
 
 	ora := &OracleDB{*oracleStatus}
 
+	if ora.Rule != nil {
+		return nil, oty.ErrReportEventNotAllowed
+	}
+
 	if ora.Status.Status != oty.ResultPrePublished {
 		olog.Error("ResultAbort", "ResultAbort can not abort", ora.Status.Status)
 		return nil, oty.ErrPrePublishAbortNotAllowed
@@ -245,7 +278,7 @@ func (action *oracleAction) resultPublish(event *oty.ResultPublish) (*types.Rece
 	var receipt *types.Receipt
 
 	//只有发布问题的人能取消预发布
-	if !isEventPublisher(action.fromaddr, action.db, false) {
+	if !isEventPublisher(action.cfg, action.fromaddr, action.db, action.height, false) {
 		return nil, oty.ErrNoPrivilege
 	}
 
@@ -257,7 +290,22 @@ func (action *oracleAction) resultPublish(event *oty.ResultPublish) (*types.Rece
 
 	ora := &OracleDB{*oracleStatus}
 
-	if ora.Status.Status != oty.ResultPrePublished {
+	//多方上报的事件只有发生争议时才由发布者裁决最终结果
+	if ora.Rule != nil {
+		if ora.Status.Status != oty.ResultDisputed || isArbitrationExpired(ora, action.blocktime) {
+			olog.Error("ResultPublish", "ResultPublish report event not disputed", ora.Status.Status)
+			return nil, oty.ErrResultPublishNotAllowed
+		}
+		if !isReportCandidate(ora, event.Result) {
+			return nil, oty.ErrResultNotReported
+		}
+		receipt, err := action.finalizeReports(ora, event.Result, true)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
+	} else if ora.Status.Status != oty.ResultPrePublished {
 		olog.Error("ResultPublish", "ResultPublish can not abort", ora.Status.Status)
 		return nil, oty.ErrResultPublishNotAllowed
 	}
@@ -296,43 +344,22 @@ func (action *oracleAction) getOracleCommonRecipt(status *oty.OracleStatus, logT
 	return log
 }
 
-func getManageKey(key string, db dbm.KV) ([]byte, error) {
-	manageKey := types.ManageKey(key)
-	value, err := db.Get([]byte(manageKey))
-	if err != nil {
-		return nil, err
-	}
-	return value, nil
-}
-
-func isEventPublisher(addr string, db dbm.KV, isSolo bool) bool {
+func isEventPublisher(cfg *types.Chain33Config, addr string, db dbm.KV, height int64, isSolo bool) bool {
 	if isSolo {
 		return true
 	}
-	value, err := getManageKey(publishEventKey, db)
+	// 自治合约提案和manage都可以设置发布者
+	publishers, err := auty.GetConfigValues(cfg, db, publishEventKey, height, false)
 	if err != nil {
-		olog.Error("OracleEventPublish", "publishEventKey", publishEventKey)
+		olog.Error("OracleEventPublish", "publishEventKey", publishEventKey, "err", err)
 		return false
 	}
-	if value == nil {
-		olog.Error("OracleEventPublish found nil value")
-		return false
-	}
-
-	var item types.ConfigItem
-	err = types.Decode(value, &item)
-	if err != nil {
-		olog.Error("OracleEventPublish", "Decode", value)
-		return false
-	}
-
-	for _, op := range item.GetArr().Value {
+	for _, op := range publishers {
 		if op == addr {
 			return true
 		}
 	}
 	return false
-
 }
 
 func findOracleStatus(db dbm.KV, eventID string) (*oty.OracleStatus, error) {
@@ -377,7 +404,7 @@ func getOracleLisByIDs(db dbm.KV, infos *oty.QueryOracleInfos) (types.Message, e
 }
 
 func getEventIDListByStatus(db dbm.KVDB, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultDisputed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	data := &oty.ReceiptOracle{
@@ -388,7 +415,7 @@ func getEventIDListByStatus(db dbm.KVDB, status int32, eventID string) (types.Me
 }
 
 func getEventIDListByAddrAndStatus(db dbm.KVDB, addr string, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultDisputed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	if len(addr) == 0 {
@@ -404,7 +431,7 @@ func getEventIDListByAddrAndStatus(db dbm.KVDB, addr string, status int32, event
 }
 
 func getEventIDListByTypeAndStatus(db dbm.KVDB, ty string, status int32, eventID string) (types.Message, error) {
-	if status <= oty.NoEvent || status > oty.ResultPublished {
+	if status <= oty.NoEvent || status > oty.ResultDisputed {
 		return nil, oty.ErrParamStatusInvalid
 	}
 	if len(ty) == 0 {
@@ -44,3 +44,18 @@ func (o *oracle) Query_QueryEventIDsByTypeAndStatus(in *oty.QueryEventID) (types
 	}
 	return eventIds, nil
 }
+
+//查询多方上报事件的聚合结果及各上报人的提交
+func (o *oracle) Query_QueryOracleSettlement(in *oty.QueryOracleReports) (types.Message, error) {
+	return getOracleSettlement(o.GetStateDB(), in.EventID)
+}
+
+//查询上报人的上报记录
+func (o *oracle) Query_QueryReportsByReporter(in *oty.QueryOracleReports) (types.Message, error) {
+	return getReportsByReporter(o.GetLocalDB(), in.Reporter, in.Primary)
+}
+
+//查询上报人的质押信息
+func (o *oracle) Query_QueryOracleReporter(in *oty.QueryOracleReporter) (types.Message, error) {
+	return getReporter(o.GetStateDB(), in.Addr)
+}
@@ -0,0 +1,565 @@
+/*
+ * Copyright Fuzamei Corp. 2018 All Rights Reserved.
+ * Use of this source code is governed by a BSD-style
+ * license that can be found in the LICENSE file.
+ */
+
+package executor
+
+import (
+	"sort"
+	"strconv"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+)
+
+/*
+多方上报模式
+
+1 发布事件时带上ReportRule，事件结果不再由单个发布者决定
+2 上报人先在合约中质押(ReporterStake)，每次上报锁定rule.minStake
+3 从事件time开始的reportPeriod内，上报人提交结果(ResultReport)
+4 上报窗口结束后任何人可以聚合(EventSettle)，按中位数或多数得出结果，
+  上报数不足或没有多数时事件取消，解锁质押
+5 聚合后disputePeriod内除发布者外任何人可以冻结disputeBond发起争议(ResultDispute)，
+  争议发起后disputePeriod内由发布者通过ResultPublish裁决，
+  裁决结果只能是聚合结果、某个上报结果或争议结果
+6 争议期结束且无争议时任何人可以确认结果(EventFinalize)；
+  发布者在裁决期内没有裁决时任何人也可以按聚合结果确认，保证金退回
+7 最终结果确定后，与之不一致的上报人被罚没slashAmount，只分给一致的上报人，
+  没有一致的上报人时不罚没；争议失败时保证金分给一致的上报人，争议成功时退回
+*/
+
+func reporterKey(addr string) (key []byte) {
+	key = append(key, []byte("mavl-"+oty.OracleX+"-reporter-")...)
+	key = append(key, []byte(addr)...)
+	return key
+}
+
+func getReporter(db dbm.KV, addr string) (*oty.OracleReporter, error) {
+	data, err := db.Get(reporterKey(addr))
+	if err != nil {
+		return nil, err
+	}
+	var reporter oty.OracleReporter
+	err = types.Decode(data, &reporter)
+	if err != nil {
+		return nil, err
+	}
+	return &reporter, nil
+}
+
+func saveReporter(db dbm.KV, reporter *oty.OracleReporter) []*types.KeyValue {
+	kv := &types.KeyValue{Key: reporterKey(reporter.Addr), Value: types.Encode(reporter)}
+	db.Set(kv.Key, kv.Value)
+	return []*types.KeyValue{kv}
+}
+
+func checkReportRule(rule *oty.ReportRule) error {
+	if rule.Aggregation != oty.AggregationMedian && rule.Aggregation != oty.AggregationMajority {
+		return oty.ErrInvalidReportRule
+	}
+	if rule.ReportPeriod <= 0 || rule.DisputePeriod <= 0 {
+		return oty.ErrInvalidReportRule
+	}
+	if rule.MinReports <= 0 || rule.MinReports > oty.MaxReportsPerEvent {
+		return oty.ErrInvalidReportRule
+	}
+	if rule.MinStake < oty.MinReportStake || rule.SlashAmount < 0 || rule.SlashAmount > rule.MinStake {
+		return oty.ErrInvalidReportRule
+	}
+	if rule.Tolerance < 0 || rule.DisputeBond <= 0 {
+		return oty.ErrInvalidReportRule
+	}
+	return nil
+}
+
+func checkReportResult(rule *oty.ReportRule, result string) error {
+	if rule.Aggregation == oty.AggregationMedian {
+		if _, err := strconv.ParseInt(result, 10, 64); err != nil {
+			return oty.ErrResultNotNumeric
+		}
+	}
+	return nil
+}
+
+// aggregateReports 按规则聚合上报结果，没有得出结果时返回false
+func aggregateReports(rule *oty.ReportRule, reports []*oty.OracleReport) (string, bool) {
+	if len(reports) == 0 || len(reports) < int(rule.MinReports) {
+		return "", false
+	}
+	if rule.Aggregation == oty.AggregationMedian {
+		values := make([]int64, 0, len(reports))
+		for _, r := range reports {
+			v, err := strconv.ParseInt(r.Result, 10, 64)
+			if err != nil {
+				return "", false
+			}
+			values = append(values, v)
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		n := len(values)
+		median := values[n/2]
+		if n%2 == 0 {
+			low := values[n/2-1]
+			median = low + (median-low)/2
+		}
+		return strconv.FormatInt(median, 10), true
+	}
+
+	counts := make(map[string]int)
+	for _, r := range reports {
+		counts[r.Result]++
+		if counts[r.Result]*2 > len(reports) {
+			return r.Result, true
+		}
+	}
+	return "", false
+}
+
+// isResultAgreed 上报结果与最终结果是否一致，中位数模式允许tolerance的偏差
+func isResultAgreed(rule *oty.ReportRule, result, final string) bool {
+	if rule.Aggregation == oty.AggregationMedian {
+		v, err := strconv.ParseInt(result, 10, 64)
+		if err != nil {
+			return false
+		}
+		f, err := strconv.ParseInt(final, 10, 64)
+		if err != nil {
+			return false
+		}
+		diff := v - f
+		if diff < 0 {
+			diff = -diff
+		}
+		return diff <= rule.Tolerance
+	}
+	return result == final
+}
+
+// isReportCandidate 裁决结果必须是聚合结果、某个上报结果或者争议结果，发布者不能给出任意值
+func isReportCandidate(ora *OracleDB, result string) bool {
+	if result == ora.SettledResult || (ora.Dispute != nil && result == ora.Dispute.Result) {
+		return true
+	}
+	for _, r := range ora.Reports {
+		if r.Result == result {
+			return true
+		}
+	}
+	return false
+}
+
+// isArbitrationExpired 争议发起后disputePeriod内发布者没有裁决，任何人可以按聚合结果确认
+func isArbitrationExpired(ora *OracleDB, blocktime int64) bool {
+	return ora.Dispute != nil && blocktime >= ora.Dispute.Time+ora.Rule.DisputePeriod
+}
+
+func (action *oracleAction) checkReporterFork() error {
+	if !action.cfg.IsDappFork(action.height, oty.OracleX, oty.ForkOracleReporterX) {
+		return types.ErrActionNotSupport
+	}
+	return nil
+}
+
+func (action *oracleAction) findReportEvent(eventID string) (*OracleDB, error) {
+	oracleStatus, err := findOracleStatus(action.db, eventID)
+	if err == types.ErrNotFound {
+		return nil, oty.ErrEventIDNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if oracleStatus.Rule == nil {
+		return nil, oty.ErrNotReportEvent
+	}
+	return &OracleDB{*oracleStatus}, nil
+}
+
+func (action *oracleAction) reporterStake(stake *oty.ReporterStake) (*types.Receipt, error) {
+	if err := action.checkReporterFork(); err != nil {
+		return nil, err
+	}
+	if stake.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+
+	receipt, err := action.coinsAccount.ExecFrozen(action.fromaddr, action.execaddr, stake.Amount)
+	if err != nil {
+		olog.Error("ReporterStake", "addr", action.fromaddr, "amount", stake.Amount, "err", err)
+		return nil, err
+	}
+
+	reporter, err := getReporter(action.db, action.fromaddr)
+	if err == types.ErrNotFound {
+		reporter = &oty.OracleReporter{Addr: action.fromaddr}
+	} else if err != nil {
+		return nil, err
+	}
+	prev := *reporter
+	reporter.Stake += stake.Amount
+
+	receipt.KV = append(receipt.KV, saveReporter(action.db, reporter)...)
+	log := &oty.ReceiptOracleReporter{Prev: &prev, Cur: reporter}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: oty.TyLogReporterStake, Log: types.Encode(log)})
+	return receipt, nil
+}
+
+func (action *oracleAction) reporterUnstake(unstake *oty.ReporterUnstake) (*types.Receipt, error) {
+	if err := action.checkReporterFork(); err != nil {
+		return nil, err
+	}
+	if unstake.Amount <= 0 {
+		return nil, types.ErrAmount
+	}
+
+	reporter, err := getReporter(action.db, action.fromaddr)
+	if err == types.ErrNotFound {
+		return nil, oty.ErrReporterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	//进行中的事件锁定的质押不能取回
+	if reporter.Stake-reporter.Locked < unstake.Amount {
+		return nil, oty.ErrStakeNotEnough
+	}
+
+	receipt, err := action.coinsAccount.ExecActive(action.fromaddr, action.execaddr, unstake.Amount)
+	if err != nil {
+		olog.Error("ReporterUnstake", "addr", action.fromaddr, "amount", unstake.Amount, "err", err)
+		return nil, err
+	}
+	prev := *reporter
+	reporter.Stake -= unstake.Amount
+
+	receipt.KV = append(receipt.KV, saveReporter(action.db, reporter)...)
+	log := &oty.ReceiptOracleReporter{Prev: &prev, Cur: reporter}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: oty.TyLogReporterUnstake, Log: types.Encode(log)})
+	return receipt, nil
+}
+
+func (action *oracleAction) resultReport(report *oty.ResultReport) (*types.Receipt, error) {
+	if err := action.checkReporterFork(); err != nil {
+		return nil, err
+	}
+	ora, err := action.findReportEvent(report.EventID)
+	if err != nil {
+		return nil, err
+	}
+	rule := ora.Rule
+	if ora.Status.Status != oty.EventPublished {
+		return nil, oty.ErrReportNotInPeriod
+	}
+	if action.blocktime < ora.Time || action.blocktime >= ora.Time+rule.ReportPeriod {
+		return nil, oty.ErrReportNotInPeriod
+	}
+	if len(ora.Reports) >= oty.MaxReportsPerEvent {
+		return nil, oty.ErrReportsExceed
+	}
+	for _, r := range ora.Reports {
+		if r.Reporter == action.fromaddr {
+			return nil, oty.ErrReportRepeated
+		}
+	}
+	if err := checkReportResult(rule, report.Result); err != nil {
+		return nil, err
+	}
+
+	reporter, err := getReporter(action.db, action.fromaddr)
+	if err == types.ErrNotFound {
+		return nil, oty.ErrReporterNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if reporter.Stake-reporter.Locked < rule.MinStake {
+		return nil, oty.ErrStakeNotEnough
+	}
+	reporter.Locked += rule.MinStake
+
+	ora.Reports = append(ora.Reports, &oty.OracleReport{
+		Reporter: action.fromaddr,
+		Result:   report.Result,
+		Source:   report.Source,
+		Time:     action.blocktime,
+	})
+
+	var kv []*types.KeyValue
+	kv = append(kv, saveReporter(action.db, reporter)...)
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	kv = append(kv, ora.GetKVSet()...)
+
+	log := &oty.ReceiptOracleReport{
+		EventID:  report.EventID,
+		Reporter: action.fromaddr,
+		Result:   report.Result,
+		Source:   report.Source,
+		Time:     action.blocktime,
+	}
+	logs := []*types.ReceiptLog{{Ty: oty.TyLogResultReport, Log: types.Encode(log)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (action *oracleAction) eventSettle(settle *oty.EventSettle) (*types.Receipt, error) {
+	if err := action.checkReporterFork(); err != nil {
+		return nil, err
+	}
+	ora, err := action.findReportEvent(settle.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if ora.Status.Status != oty.EventPublished || action.blocktime < ora.Time+ora.Rule.ReportPeriod {
+		return nil, oty.ErrEventSettleNotAllowed
+	}
+
+	var kv []*types.KeyValue
+	result, ok := aggregateReports(ora.Rule, ora.Reports)
+	if !ok {
+		//上报数不足或者没有多数结果，事件取消
+		kvs, err := action.unlockReports(ora)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, kvs...)
+		updateStatus(ora, action.GetIndex(), action.fromaddr, oty.EventAborted)
+	} else {
+		ora.SettledResult = result
+		ora.Result = result
+		ora.SettleTime = action.blocktime
+		updateStatus(ora, action.GetIndex(), action.fromaddr, oty.ResultPrePublished)
+	}
+
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	kv = append(kv, ora.GetKVSet()...)
+	logs := []*types.ReceiptLog{action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogEventSettle)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (action *oracleAction) resultDispute(dispute *oty.ResultDispute) (*types.Receipt, error) {
+	if err := action.checkReporterFork(); err != nil {
+		return nil, err
+	}
+	ora, err := action.findReportEvent(dispute.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if ora.Status.Status != oty.ResultPrePublished || action.blocktime >= ora.SettleTime+ora.Rule.DisputePeriod {
+		return nil, oty.ErrResultDisputeNotAllowed
+	}
+	if err := checkReportResult(ora.Rule, dispute.Result); err != nil {
+		return nil, err
+	}
+	//发布者负责裁决，不能自己发起争议
+	if isEventPublisher(action.cfg, action.fromaddr, action.db, action.height, false) {
+		return nil, oty.ErrNoPrivilege
+	}
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if ora.Rule.DisputeBond > 0 {
+		receipt, err = action.coinsAccount.ExecFrozen(action.fromaddr, action.execaddr, ora.Rule.DisputeBond)
+		if err != nil {
+			olog.Error("ResultDispute", "addr", action.fromaddr, "bond", ora.Rule.DisputeBond, "err", err)
+			return nil, err
+		}
+	}
+
+	ora.Dispute = &oty.OracleDispute{
+		Addr:   action.fromaddr,
+		Result: dispute.Result,
+		Bond:   ora.Rule.DisputeBond,
+		Time:   action.blocktime,
+	}
+	updateStatus(ora, action.GetIndex(), action.fromaddr, oty.ResultDisputed)
+
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, ora.GetKVSet()...)
+	receipt.Logs = append(receipt.Logs, action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogResultDispute))
+	return receipt, nil
+}
+
+func (action *oracleAction) eventFinalize(finalize *oty.EventFinalize) (*types.Receipt, error) {
+	if err := action.checkReporterFork(); err != nil {
+		return nil, err
+	}
+	ora, err := action.findReportEvent(finalize.EventID)
+	if err != nil {
+		return nil, err
+	}
+	switch ora.Status.Status {
+	case oty.ResultPrePublished:
+		if action.blocktime < ora.SettleTime+ora.Rule.DisputePeriod {
+			return nil, oty.ErrEventFinalizeNotAllowed
+		}
+	case oty.ResultDisputed:
+		if !isArbitrationExpired(ora, action.blocktime) {
+			return nil, oty.ErrEventFinalizeNotAllowed
+		}
+	default:
+		return nil, oty.ErrEventFinalizeNotAllowed
+	}
+
+	receipt, err := action.finalizeReports(ora, ora.SettledResult, false)
+	if err != nil {
+		return nil, err
+	}
+	updateStatus(ora, action.GetIndex(), action.fromaddr, oty.ResultPublished)
+
+	if err := ora.save(action.db); err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, ora.GetKVSet()...)
+	receipt.Logs = append(receipt.Logs, action.getOracleCommonRecipt(&ora.OracleStatus, oty.TyLogEventFinalize))
+	return receipt, nil
+}
+
+// unlockReports 事件取消时解锁所有上报人的质押
+func (action *oracleAction) unlockReports(ora *OracleDB) ([]*types.KeyValue, error) {
+	var kv []*types.KeyValue
+	for _, r := range ora.Reports {
+		reporter, err := getReporter(action.db, r.Reporter)
+		if err != nil {
+			return nil, err
+		}
+		reporter.Locked -= ora.Rule.MinStake
+		kv = append(kv, saveReporter(action.db, reporter)...)
+	}
+	return kv, nil
+}
+
+// finalizeReports 按最终结果解锁质押，罚没不一致的上报人并处理争议保证金
+// ruled为false表示争议没有经过裁决，保证金退回
+func (action *oracleAction) finalizeReports(ora *OracleDB, final string, ruled bool) (*types.Receipt, error) {
+	rule := ora.Rule
+	if err := checkReportResult(rule, final); err != nil {
+		return nil, err
+	}
+
+	var honest []string
+	for _, r := range ora.Reports {
+		if isResultAgreed(rule, r.Result, final) {
+			honest = append(honest, r.Reporter)
+		}
+	}
+	disputeWin := ora.Dispute != nil && !isResultAgreed(rule, ora.SettledResult, final)
+
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	for _, r := range ora.Reports {
+		reporter, err := getReporter(action.db, r.Reporter)
+		if err != nil {
+			return nil, err
+		}
+		reporter.Locked -= rule.MinStake
+		//罚没只分给一致的上报人，没有一致的上报人时不罚没
+		if !isResultAgreed(rule, r.Result, final) && len(honest) > 0 && rule.SlashAmount > 0 {
+			rc, err := action.distribute(r.Reporter, honest, rule.SlashAmount)
+			if err != nil {
+				return nil, err
+			}
+			mergeReceipt(receipt, rc)
+			reporter.Stake -= rule.SlashAmount
+			r.Slashed = true
+		}
+		receipt.KV = append(receipt.KV, saveReporter(action.db, reporter)...)
+	}
+
+	if ora.Dispute != nil && ora.Dispute.Bond > 0 {
+		var rc *types.Receipt
+		var err error
+		if !ruled || disputeWin || len(honest) == 0 {
+			rc, err = action.coinsAccount.ExecActive(ora.Dispute.Addr, action.execaddr, ora.Dispute.Bond)
+		} else {
+			rc, err = action.distribute(ora.Dispute.Addr, honest, ora.Dispute.Bond)
+		}
+		if err != nil {
+			return nil, err
+		}
+		mergeReceipt(receipt, rc)
+	}
+	return receipt, nil
+}
+
+// distribute 把from冻结的amount平均转给beneficiaries，余数给第一个
+func (action *oracleAction) distribute(from string, beneficiaries []string, amount int64) (*types.Receipt, error) {
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	share := amount / int64(len(beneficiaries))
+	for i, to := range beneficiaries {
+		value := share
+		if i == 0 {
+			value += amount - share*int64(len(beneficiaries))
+		}
+		if value == 0 {
+			continue
+		}
+		var rc *types.Receipt
+		var err error
+		if to == from {
+			rc, err = action.coinsAccount.ExecActive(from, action.execaddr, value)
+		} else {
+			rc, err = action.coinsAccount.ExecTransferFrozen(from, to, action.execaddr, value)
+		}
+		if err != nil {
+			olog.Error("distribute", "from", from, "to", to, "amount", value, "err", err)
+			return nil, err
+		}
+		mergeReceipt(receipt, rc)
+	}
+	return receipt, nil
+}
+
+func mergeReceipt(receipt, add *types.Receipt) {
+	receipt.KV = append(receipt.KV, add.KV...)
+	receipt.Logs = append(receipt.Logs, add.Logs...)
+}
+
+func getOracleSettlement(db dbm.KV, eventID string) (types.Message, error) {
+	status, err := findOracleStatus(db, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if status.Rule == nil {
+		return nil, oty.ErrNotReportEvent
+	}
+	reply := &oty.ReplyOracleSettlement{
+		EventID:       status.EventID,
+		Status:        status.GetStatus().Status,
+		Rule:          status.Rule,
+		SettledResult: status.SettledResult,
+		SettleTime:    status.SettleTime,
+		Dispute:       status.Dispute,
+		Reports:       status.Reports,
+	}
+	if status.SettleTime > 0 {
+		reply.DisputeEnd = status.SettleTime + status.Rule.DisputePeriod
+	}
+	if reply.Status == oty.ResultPublished {
+		reply.Result = status.Result
+	}
+	return reply, nil
+}
+
+func getReportsByReporter(db dbm.KVDB, reporter, primary string) (types.Message, error) {
+	if len(reporter) == 0 {
+		return nil, oty.ErrParamAddressMustnotEmpty
+	}
+	query := oty.NewReportTable(db).GetQuery(db)
+	var prim []byte
+	if len(primary) > 0 {
+		prim = []byte(primary)
+	}
+	rows, err := query.List("reporter", &oty.ReceiptOracleReport{Reporter: reporter}, prim, oty.DefaultCount, oty.ListDESC)
+	if err != nil {
+		return nil, err
+	}
+	reply := &oty.ReplyOracleReports{}
+	for _, row := range rows {
+		reply.Reports = append(reply.Reports, row.Data.(*oty.ReceiptOracleReport))
+	}
+	return reply, nil
+}
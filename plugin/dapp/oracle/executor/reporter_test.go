@@ -0,0 +1,386 @@
+/*
+ * Copyright Fuzamei Corp. 2018 All Rights Reserved.
+ * Use of this source code is governed by a BSD-style
+ * license that can be found in the LICENSE file.
+ */
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/queue"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	oty "github.com/33cn/plugin/plugin/dapp/oracle/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	PrivKeyB = "0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4" // 1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR
+	PrivKeyC = "0x7a80a1f75d7360c6123c32a78ecf978c1ac55636f87892df38d8b85a9aeff115" // 1NLHPEcbTWWxxU3dGUZBhayjrCHD3psX7k
+	PrivKeyD = "0xcacb1f5d51700aea07fca2246ab43b0917d70405c65edea9b5063d72eb5c6b71" // 1MCftFynyvG2F4ED5mdHYgziDxx6vDrScs
+)
+
+type reportEnv struct {
+	t       *testing.T
+	cfg     *types.Chain33Config
+	exec    *oracle
+	stateDB dbm.DB
+	kvdb    dbm.KVDB
+	accDB   *account.DB
+}
+
+func newReportEnv(t *testing.T) *reportEnv {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	InitExecType()
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 1000)
+	_, _, kvdb := util.CreateTestDB()
+
+	execAddr := address.ExecAddress(oty.OracleX)
+	accDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	for _, addr := range Nodes {
+		accDB.SaveExecAccount(execAddr, &types.Account{Addr: string(addr), Balance: 100 * types.Coin})
+	}
+
+	item := &types.ConfigItem{
+		Key: "mavl-manage-oracle-publish-event",
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: []string{string(Nodes[0])}},
+		},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+
+	exec := newOracle().(*oracle)
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	return &reportEnv{t: t, cfg: cfg, exec: exec, stateDB: stateDB, kvdb: kvdb, accDB: accDB}
+}
+
+func (e *reportEnv) exe(blockTime int64, priv string, action *oty.OracleAction) (*types.Receipt, error) {
+	tx, err := types.CreateFormatTx(e.cfg, e.cfg.ExecName(oty.OracleX), types.Encode(action))
+	assert.Nil(e.t, err)
+	tx, err = signTx(tx, priv)
+	assert.Nil(e.t, err)
+	e.exec.SetEnv(10, blockTime, 1539918074)
+	receipt, err := e.exec.Exec(tx, 1)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range receipt.KV {
+		e.stateDB.Set(kv.Key, kv.Value)
+	}
+	set, err := e.exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(e.t, err)
+	for _, kv := range set.KV {
+		e.kvdb.Set(kv.Key, kv.Value)
+	}
+	return receipt, nil
+}
+
+func (e *reportEnv) execAccount(addr []byte) *types.Account {
+	return e.accDB.LoadExecAccount(string(addr), address.ExecAddress(oty.OracleX))
+}
+
+func (e *reportEnv) settlement(eventID string) *oty.ReplyOracleSettlement {
+	msg, err := e.exec.Query(oty.FuncNameQueryOracleSettlement, types.Encode(&oty.QueryOracleReports{EventID: eventID}))
+	assert.Nil(e.t, err)
+	return msg.(*oty.ReplyOracleSettlement)
+}
+
+func publishAction(time int64, rule *oty.ReportRule) *oty.OracleAction {
+	return &oty.OracleAction{Ty: oty.ActionEventPublish, Value: &oty.OracleAction_EventPublish{
+		EventPublish: &oty.EventPublish{Type: "football", SubType: "Premier League", Time: time, Content: "score", Rule: rule}}}
+}
+
+func stakeAction(amount int64) *oty.OracleAction {
+	return &oty.OracleAction{Ty: oty.ActionReporterStake, Value: &oty.OracleAction_ReporterStake{ReporterStake: &oty.ReporterStake{Amount: amount}}}
+}
+
+func reportAction(eventID, result string) *oty.OracleAction {
+	return &oty.OracleAction{Ty: oty.ActionResultReport, Value: &oty.OracleAction_ResultReport{ResultReport: &oty.ResultReport{EventID: eventID, Result: result}}}
+}
+
+func settleAction(eventID string) *oty.OracleAction {
+	return &oty.OracleAction{Ty: oty.ActionEventSettle, Value: &oty.OracleAction_EventSettle{EventSettle: &oty.EventSettle{EventID: eventID}}}
+}
+
+func finalizeAction(eventID string) *oty.OracleAction {
+	return &oty.OracleAction{Ty: oty.ActionEventFinalize, Value: &oty.OracleAction_EventFinalize{EventFinalize: &oty.EventFinalize{EventID: eventID}}}
+}
+
+func disputeAction(eventID, result string) *oty.OracleAction {
+	return &oty.OracleAction{Ty: oty.ActionResultDispute, Value: &oty.OracleAction_ResultDispute{ResultDispute: &oty.ResultDispute{EventID: eventID, Result: result}}}
+}
+
+func publishResultAction(eventID, result string) *oty.OracleAction {
+	return &oty.OracleAction{Ty: oty.ActionResultPublish, Value: &oty.OracleAction_ResultPublish{ResultPublish: &oty.ResultPublish{EventID: eventID, Result: result, Source: "board"}}}
+}
+
+func getEventID(t *testing.T, receipt *types.Receipt) string {
+	var status oty.ReceiptOracle
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &status))
+	return status.EventID
+}
+
+func TestOracleReportMedian(t *testing.T) {
+	env := newReportEnv(t)
+	start := int64(1539918074)
+	rule := &oty.ReportRule{
+		Aggregation:   oty.AggregationMedian,
+		ReportPeriod:  100,
+		DisputePeriod: 50,
+		MinReports:    2,
+		MinStake:      5 * types.Coin,
+		SlashAmount:   2 * types.Coin,
+		Tolerance:     1,
+		DisputeBond:   3 * types.Coin,
+	}
+
+	//非法规则
+	_, err := env.exe(start, PrivKeyA, publishAction(start+10, &oty.ReportRule{Aggregation: oty.AggregationMedian}))
+	assert.Equal(t, oty.ErrInvalidReportRule, err)
+	//质押低于下限或者没有争议保证金
+	low := *rule
+	low.MinStake = oty.MinReportStake - 1
+	low.SlashAmount = 0
+	_, err = env.exe(start, PrivKeyA, publishAction(start+10, &low))
+	assert.Equal(t, oty.ErrInvalidReportRule, err)
+	noBond := *rule
+	noBond.DisputeBond = 0
+	_, err = env.exe(start, PrivKeyA, publishAction(start+10, &noBond))
+	assert.Equal(t, oty.ErrInvalidReportRule, err)
+
+	receipt, err := env.exe(start, PrivKeyA, publishAction(start+10, rule))
+	assert.Nil(t, err)
+	eventID := getEventID(t, receipt)
+	eventTime := start + 10
+
+	for _, priv := range []string{PrivKeyB, PrivKeyC, PrivKeyD} {
+		_, err = env.exe(start, priv, stakeAction(10*types.Coin))
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 90*types.Coin, env.execAccount(Nodes[1]).Balance)
+	assert.Equal(t, 10*types.Coin, env.execAccount(Nodes[1]).Frozen)
+
+	//上报期之前不能上报
+	_, err = env.exe(start+5, PrivKeyB, reportAction(eventID, "100"))
+	assert.Equal(t, oty.ErrReportNotInPeriod, err)
+
+	_, err = env.exe(eventTime, PrivKeyB, reportAction(eventID, "100"))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime, PrivKeyB, reportAction(eventID, "100"))
+	assert.Equal(t, oty.ErrReportRepeated, err)
+	_, err = env.exe(eventTime, PrivKeyC, reportAction(eventID, "abc"))
+	assert.Equal(t, oty.ErrResultNotNumeric, err)
+	_, err = env.exe(eventTime, PrivKeyC, reportAction(eventID, "101"))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime+1, PrivKeyD, reportAction(eventID, "120"))
+	assert.Nil(t, err)
+	//没有质押不能上报
+	_, err = env.exe(eventTime+1, PrivKeyA, reportAction(eventID, "100"))
+	assert.Equal(t, oty.ErrReporterNotFound, err)
+
+	//多方上报的事件不能由发布者预发布结果
+	_, err = env.exe(eventTime+1, PrivKeyA, &oty.OracleAction{Ty: oty.ActionResultPrePublish,
+		Value: &oty.OracleAction_ResultPrePublish{ResultPrePublish: &oty.ResultPrePublish{EventID: eventID, Result: "100"}}})
+	assert.Equal(t, oty.ErrReportEventNotAllowed, err)
+
+	//锁定的质押不能取回
+	_, err = env.exe(eventTime+1, PrivKeyB, &oty.OracleAction{Ty: oty.ActionReporterUnstake,
+		Value: &oty.OracleAction_ReporterUnstake{ReporterUnstake: &oty.ReporterUnstake{Amount: 6 * types.Coin}}})
+	assert.Equal(t, oty.ErrStakeNotEnough, err)
+
+	_, err = env.exe(eventTime+99, PrivKeyA, settleAction(eventID))
+	assert.Equal(t, oty.ErrEventSettleNotAllowed, err)
+	_, err = env.exe(eventTime+100, PrivKeyA, settleAction(eventID))
+	assert.Nil(t, err)
+	settled := env.settlement(eventID)
+	assert.Equal(t, int32(oty.ResultPrePublished), settled.Status)
+	assert.Equal(t, "101", settled.SettledResult)
+	assert.Equal(t, eventTime+150, settled.DisputeEnd)
+	assert.Equal(t, 3, len(settled.Reports))
+
+	_, err = env.exe(eventTime+149, PrivKeyA, finalizeAction(eventID))
+	assert.Equal(t, oty.ErrEventFinalizeNotAllowed, err)
+	_, err = env.exe(eventTime+150, PrivKeyB, finalizeAction(eventID))
+	assert.Nil(t, err)
+
+	settled = env.settlement(eventID)
+	assert.Equal(t, int32(oty.ResultPublished), settled.Status)
+	assert.Equal(t, "101", settled.Result)
+	assert.False(t, settled.Reports[0].Slashed)
+	assert.False(t, settled.Reports[1].Slashed)
+	assert.True(t, settled.Reports[2].Slashed)
+
+	//D被罚没2个币，B和C各分得1个
+	assert.Equal(t, 8*types.Coin, env.execAccount(Nodes[3]).Frozen)
+	assert.Equal(t, 91*types.Coin, env.execAccount(Nodes[1]).Balance)
+	assert.Equal(t, 91*types.Coin, env.execAccount(Nodes[2]).Balance)
+
+	msg, err := env.exec.Query(oty.FuncNameQueryOracleReporter, types.Encode(&oty.QueryOracleReporter{Addr: string(Nodes[3])}))
+	assert.Nil(t, err)
+	assert.Equal(t, 8*types.Coin, msg.(*oty.OracleReporter).Stake)
+	assert.Equal(t, int64(0), msg.(*oty.OracleReporter).Locked)
+
+	msg, err = env.exec.Query(oty.FuncNameQueryReportsByReporter, types.Encode(&oty.QueryOracleReports{Reporter: string(Nodes[2])}))
+	assert.Nil(t, err)
+	reports := msg.(*oty.ReplyOracleReports).Reports
+	assert.Equal(t, 1, len(reports))
+	assert.Equal(t, eventID, reports[0].EventID)
+	assert.Equal(t, "101", reports[0].Result)
+
+	//解锁后可以取回质押
+	_, err = env.exe(eventTime+151, PrivKeyB, &oty.OracleAction{Ty: oty.ActionReporterUnstake,
+		Value: &oty.OracleAction_ReporterUnstake{ReporterUnstake: &oty.ReporterUnstake{Amount: 10 * types.Coin}}})
+	assert.Nil(t, err)
+	assert.Equal(t, 101*types.Coin, env.execAccount(Nodes[1]).Balance)
+}
+
+func TestOracleReportDispute(t *testing.T) {
+	env := newReportEnv(t)
+	start := int64(1539918074)
+	rule := &oty.ReportRule{
+		Aggregation:   oty.AggregationMajority,
+		ReportPeriod:  100,
+		DisputePeriod: 50,
+		MinReports:    3,
+		MinStake:      5 * types.Coin,
+		SlashAmount:   2 * types.Coin,
+		DisputeBond:   3 * types.Coin,
+	}
+
+	receipt, err := env.exe(start, PrivKeyA, publishAction(start+10, rule))
+	assert.Nil(t, err)
+	eventID := getEventID(t, receipt)
+	eventTime := start + 10
+
+	for _, priv := range []string{PrivKeyB, PrivKeyC, PrivKeyD} {
+		_, err = env.exe(start, priv, stakeAction(10*types.Coin))
+		assert.Nil(t, err)
+	}
+	_, err = env.exe(eventTime, PrivKeyB, reportAction(eventID, "home"))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime, PrivKeyC, reportAction(eventID, "home"))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime, PrivKeyD, reportAction(eventID, "away"))
+	assert.Nil(t, err)
+
+	_, err = env.exe(eventTime+100, PrivKeyA, settleAction(eventID))
+	assert.Nil(t, err)
+	assert.Equal(t, "home", env.settlement(eventID).SettledResult)
+
+	//D发起争议，冻结保证金；发布者负责裁决，不能发起争议
+	dispute := disputeAction(eventID, "away")
+	_, err = env.exe(eventTime+120, PrivKeyA, dispute)
+	assert.Equal(t, oty.ErrNoPrivilege, err)
+	_, err = env.exe(eventTime+150, PrivKeyD, dispute)
+	assert.Equal(t, oty.ErrResultDisputeNotAllowed, err)
+	_, err = env.exe(eventTime+120, PrivKeyD, dispute)
+	assert.Nil(t, err)
+	assert.Equal(t, 13*types.Coin, env.execAccount(Nodes[3]).Frozen)
+	assert.Equal(t, int32(oty.ResultDisputed), env.settlement(eventID).Status)
+
+	//有争议时不能直接确认，由发布者从已有结果中裁决
+	_, err = env.exe(eventTime+150, PrivKeyB, finalizeAction(eventID))
+	assert.Equal(t, oty.ErrEventFinalizeNotAllowed, err)
+	_, err = env.exe(eventTime+150, PrivKeyA, publishResultAction(eventID, "draw"))
+	assert.Equal(t, oty.ErrResultNotReported, err)
+	_, err = env.exe(eventTime+150, PrivKeyA, publishResultAction(eventID, "away"))
+	assert.Nil(t, err)
+
+	settled := env.settlement(eventID)
+	assert.Equal(t, int32(oty.ResultPublished), settled.Status)
+	assert.Equal(t, "away", settled.Result)
+	assert.True(t, settled.Reports[0].Slashed)
+	assert.True(t, settled.Reports[1].Slashed)
+	assert.False(t, settled.Reports[2].Slashed)
+
+	//争议成功，保证金退回，B和C的罚没转给D
+	accD := env.execAccount(Nodes[3])
+	assert.Equal(t, 10*types.Coin, accD.Frozen)
+	assert.Equal(t, 94*types.Coin, accD.Balance)
+	assert.Equal(t, 8*types.Coin, env.execAccount(Nodes[1]).Frozen)
+
+	//上报数不足时事件取消并解锁质押
+	receipt, err = env.exe(start, PrivKeyA, publishAction(start+300, rule))
+	assert.Nil(t, err)
+	eventID = getEventID(t, receipt)
+	_, err = env.exe(start+300, PrivKeyB, reportAction(eventID, "home"))
+	assert.Nil(t, err)
+	_, err = env.exe(start+400, PrivKeyC, settleAction(eventID))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(oty.EventAborted), env.settlement(eventID).Status)
+	msg, err := env.exec.Query(oty.FuncNameQueryOracleReporter, types.Encode(&oty.QueryOracleReporter{Addr: string(Nodes[1])}))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), msg.(*oty.OracleReporter).Locked)
+}
+
+func TestOracleReportDisputeTimeout(t *testing.T) {
+	env := newReportEnv(t)
+	start := int64(1539918074)
+	rule := &oty.ReportRule{
+		Aggregation:   oty.AggregationMajority,
+		ReportPeriod:  100,
+		DisputePeriod: 50,
+		MinReports:    3,
+		MinStake:      5 * types.Coin,
+		SlashAmount:   2 * types.Coin,
+		DisputeBond:   3 * types.Coin,
+	}
+
+	receipt, err := env.exe(start, PrivKeyA, publishAction(start+10, rule))
+	assert.Nil(t, err)
+	eventID := getEventID(t, receipt)
+	eventTime := start + 10
+
+	for _, priv := range []string{PrivKeyB, PrivKeyC, PrivKeyD} {
+		_, err = env.exe(start, priv, stakeAction(10*types.Coin))
+		assert.Nil(t, err)
+	}
+	_, err = env.exe(eventTime, PrivKeyB, reportAction(eventID, "home"))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime, PrivKeyC, reportAction(eventID, "home"))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime, PrivKeyD, reportAction(eventID, "away"))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime+100, PrivKeyA, settleAction(eventID))
+	assert.Nil(t, err)
+	_, err = env.exe(eventTime+120, PrivKeyD, disputeAction(eventID, "away"))
+	assert.Nil(t, err)
+
+	//裁决期内不能确认，超时后发布者不能再裁决，任何人可以按聚合结果确认
+	_, err = env.exe(eventTime+169, PrivKeyB, finalizeAction(eventID))
+	assert.Equal(t, oty.ErrEventFinalizeNotAllowed, err)
+	_, err = env.exe(eventTime+170, PrivKeyA, publishResultAction(eventID, "away"))
+	assert.Equal(t, oty.ErrResultPublishNotAllowed, err)
+	_, err = env.exe(eventTime+170, PrivKeyB, finalizeAction(eventID))
+	assert.Nil(t, err)
+
+	settled := env.settlement(eventID)
+	assert.Equal(t, int32(oty.ResultPublished), settled.Status)
+	assert.Equal(t, "home", settled.Result)
+	assert.False(t, settled.Reports[0].Slashed)
+	assert.False(t, settled.Reports[1].Slashed)
+	assert.True(t, settled.Reports[2].Slashed)
+
+	//未经裁决的争议退回保证金，D的罚没分给B和C
+	accD := env.execAccount(Nodes[3])
+	assert.Equal(t, 8*types.Coin, accD.Frozen)
+	assert.Equal(t, 90*types.Coin, accD.Balance)
+	assert.Equal(t, 91*types.Coin, env.execAccount(Nodes[1]).Balance)
+	assert.Equal(t, 91*types.Coin, env.execAccount(Nodes[2]).Balance)
+	for _, addr := range Nodes[1:4] {
+		msg, err := env.exec.Query(oty.FuncNameQueryOracleReporter, types.Encode(&oty.QueryOracleReporter{Addr: string(addr)}))
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), msg.(*oty.OracleReporter).Locked)
+	}
+}
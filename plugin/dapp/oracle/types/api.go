@@ -0,0 +1,30 @@
+/*
+ * Copyright Fuzamei Corp. 2018 All Rights Reserved.
+ * Use of this source code is governed by a BSD-style
+ * license that can be found in the LICENSE file.
+ */
+
+package types
+
+import (
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+// OracleStatusKey 事件在状态数据库中的key
+func OracleStatusKey(eventID string) []byte {
+	return []byte("mavl-" + OracleX + "-" + eventID)
+}
+
+// GetOracleStatus 读取事件状态，供其他合约根据事件结果结算
+func GetOracleStatus(db dbm.KV, eventID string) (*OracleStatus, error) {
+	data, err := db.Get(OracleStatusKey(eventID))
+	if err != nil {
+		return nil, err
+	}
+	status := &OracleStatus{}
+	if err = types.Decode(data, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
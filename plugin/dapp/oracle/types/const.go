@@ -11,6 +11,8 @@ import "errors"
 var (
 	// OracleX oracle name
 	OracleX = "oracle"
+	// ForkOracleReporterX 多方质押上报的分叉
+	ForkOracleReporterX = "ForkOracleReporter"
 )
 
 // oracle action type
@@ -20,6 +22,12 @@ const (
 	ActionResultPublish
 	ActionEventAbort
 	ActionResultAbort
+	ActionReporterStake
+	ActionReporterUnstake
+	ActionResultReport
+	ActionEventSettle
+	ActionResultDispute
+	ActionEventFinalize
 )
 
 // oracle status
@@ -30,6 +38,19 @@ const (
 	ResultPrePublished
 	ResultAborted
 	ResultPublished
+	ResultDisputed
+)
+
+// aggregation of reports
+const (
+	// AggregationMedian 取上报数值的中位数
+	AggregationMedian = 1
+	// AggregationMajority 取超过半数的上报结果
+	AggregationMajority = 2
+	// MaxReportsPerEvent 单个事件最多接受的上报数
+	MaxReportsPerEvent = 100
+	// MinReportStake 每次上报锁定质押的下限，避免零成本上报
+	MinReportStake = 1e8
 )
 
 // log type define
@@ -39,6 +60,12 @@ const (
 	TyLogResultPrePublish = 812
 	TyLogResultAbort      = 813
 	TyLogResultPublish    = 814
+	TyLogReporterStake    = 815
+	TyLogReporterUnstake  = 816
+	TyLogResultReport     = 817
+	TyLogEventSettle      = 818
+	TyLogResultDispute    = 819
+	TyLogEventFinalize    = 820
 )
 
 // executor action and function define
@@ -51,6 +78,12 @@ const (
 	FuncNameQueryEventIDByAddrAndStatus = "QueryEventIDsByAddrAndStatus"
 	// FuncNameQueryEventIDByTypeAndStatus 根据事件类型和状态查询eventID
 	FuncNameQueryEventIDByTypeAndStatus = "QueryEventIDsByTypeAndStatus"
+	// FuncNameQueryOracleSettlement 查询事件的聚合结果及各上报人的提交
+	FuncNameQueryOracleSettlement = "QueryOracleSettlement"
+	// FuncNameQueryReportsByReporter 查询上报人的上报记录
+	FuncNameQueryReportsByReporter = "QueryReportsByReporter"
+	// FuncNameQueryOracleReporter 查询上报人的质押信息
+	FuncNameQueryOracleReporter = "QueryOracleReporter"
 	// CreateEventPublishTx 创建发布事件交易
 	CreateEventPublishTx = "EventPublish"
 	// CreateAbortEventPublishTx 创建取消发布事件交易
@@ -61,6 +94,18 @@ const (
 	CreateAbortResultPrePublishTx = "ResultAbort"
 	// CreateResultPublishTx 创建预发布事件结果交易
 	CreateResultPublishTx = "ResultPublish"
+	// CreateReporterStakeTx 创建上报人质押交易
+	CreateReporterStakeTx = "ReporterStake"
+	// CreateReporterUnstakeTx 创建上报人取回质押交易
+	CreateReporterUnstakeTx = "ReporterUnstake"
+	// CreateResultReportTx 创建上报结果交易
+	CreateResultReportTx = "ResultReport"
+	// CreateEventSettleTx 创建聚合上报结果交易
+	CreateEventSettleTx = "EventSettle"
+	// CreateResultDisputeTx 创建争议交易
+	CreateResultDisputeTx = "ResultDispute"
+	// CreateEventFinalizeTx 创建确认最终结果交易
+	CreateEventFinalizeTx = "EventFinalize"
 )
 
 // query param define
@@ -85,4 +130,17 @@ var (
 	ErrParamStatusInvalid         = errors.New("ErrParamStatusInvalid")
 	ErrParamAddressMustnotEmpty   = errors.New("ErrParamAddressMustnotEmpty")
 	ErrParamTypeMustNotEmpty      = errors.New("ErrParamTypeMustNotEmpty")
+	ErrInvalidReportRule          = errors.New("ErrInvalidReportRule")
+	ErrNotReportEvent             = errors.New("ErrNotReportEvent")
+	ErrReportEventNotAllowed      = errors.New("ErrReportEventNotAllowed")
+	ErrReportNotInPeriod          = errors.New("ErrReportNotInPeriod")
+	ErrReportRepeated             = errors.New("ErrReportRepeated")
+	ErrReportsExceed              = errors.New("ErrReportsExceed")
+	ErrResultNotNumeric           = errors.New("ErrResultNotNumeric")
+	ErrStakeNotEnough             = errors.New("ErrStakeNotEnough")
+	ErrEventSettleNotAllowed      = errors.New("ErrEventSettleNotAllowed")
+	ErrResultDisputeNotAllowed    = errors.New("ErrResultDisputeNotAllowed")
+	ErrEventFinalizeNotAllowed    = errors.New("ErrEventFinalizeNotAllowed")
+	ErrReporterNotFound           = errors.New("ErrReporterNotFound")
+	ErrResultNotReported          = errors.New("ErrResultNotReported")
 )
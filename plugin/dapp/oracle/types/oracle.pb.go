@@ -23,20 +23,25 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 //事件
 type OracleStatus struct {
-	EventID              string       `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
-	Addr                 string       `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	Type                 string       `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	SubType              string       `protobuf:"bytes,4,opt,name=subType,proto3" json:"subType,omitempty"`
-	Time                 int64        `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
-	Content              string       `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	Introduction         string       `protobuf:"bytes,7,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	Status               *EventStatus `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	Source               string       `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
-	Result               string       `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
-	PreStatus            *EventStatus `protobuf:"bytes,11,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	EventID              string          `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Addr                 string          `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Type                 string          `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	SubType              string          `protobuf:"bytes,4,opt,name=subType,proto3" json:"subType,omitempty"`
+	Time                 int64           `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Content              string          `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Introduction         string          `protobuf:"bytes,7,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Status               *EventStatus    `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Source               string          `protobuf:"bytes,9,opt,name=source,proto3" json:"source,omitempty"`
+	Result               string          `protobuf:"bytes,10,opt,name=result,proto3" json:"result,omitempty"`
+	PreStatus            *EventStatus    `protobuf:"bytes,11,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
+	Rule                 *ReportRule     `protobuf:"bytes,12,opt,name=rule,proto3" json:"rule,omitempty"`
+	Reports              []*OracleReport `protobuf:"bytes,13,rep,name=reports,proto3" json:"reports,omitempty"`
+	SettledResult        string          `protobuf:"bytes,14,opt,name=settledResult,proto3" json:"settledResult,omitempty"`
+	SettleTime           int64           `protobuf:"varint,15,opt,name=settleTime,proto3" json:"settleTime,omitempty"`
+	Dispute              *OracleDispute  `protobuf:"bytes,16,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *OracleStatus) Reset()         { *m = OracleStatus{} }
@@ -141,6 +146,328 @@ func (m *OracleStatus) GetPreStatus() *EventStatus {
 	return nil
 }
 
+func (m *OracleStatus) GetRule() *ReportRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *OracleStatus) GetReports() []*OracleReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+func (m *OracleStatus) GetSettledResult() string {
+	if m != nil {
+		return m.SettledResult
+	}
+	return ""
+}
+
+func (m *OracleStatus) GetSettleTime() int64 {
+	if m != nil {
+		return m.SettleTime
+	}
+	return 0
+}
+
+func (m *OracleStatus) GetDispute() *OracleDispute {
+	if m != nil {
+		return m.Dispute
+	}
+	return nil
+}
+
+// 多方上报规则
+type ReportRule struct {
+	Aggregation          int32    `protobuf:"varint,1,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	ReportPeriod         int64    `protobuf:"varint,2,opt,name=reportPeriod,proto3" json:"reportPeriod,omitempty"`
+	DisputePeriod        int64    `protobuf:"varint,3,opt,name=disputePeriod,proto3" json:"disputePeriod,omitempty"`
+	MinReports           int32    `protobuf:"varint,4,opt,name=minReports,proto3" json:"minReports,omitempty"`
+	MinStake             int64    `protobuf:"varint,5,opt,name=minStake,proto3" json:"minStake,omitempty"`
+	SlashAmount          int64    `protobuf:"varint,6,opt,name=slashAmount,proto3" json:"slashAmount,omitempty"`
+	Tolerance            int64    `protobuf:"varint,7,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	DisputeBond          int64    `protobuf:"varint,8,opt,name=disputeBond,proto3" json:"disputeBond,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportRule) Reset()         { *m = ReportRule{} }
+func (m *ReportRule) String() string { return proto.CompactTextString(m) }
+func (*ReportRule) ProtoMessage()    {}
+func (*ReportRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{1}
+}
+
+func (m *ReportRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportRule.Unmarshal(m, b)
+}
+func (m *ReportRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportRule.Marshal(b, m, deterministic)
+}
+func (m *ReportRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportRule.Merge(m, src)
+}
+func (m *ReportRule) XXX_Size() int {
+	return xxx_messageInfo_ReportRule.Size(m)
+}
+func (m *ReportRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportRule proto.InternalMessageInfo
+
+func (m *ReportRule) GetAggregation() int32 {
+	if m != nil {
+		return m.Aggregation
+	}
+	return 0
+}
+
+func (m *ReportRule) GetReportPeriod() int64 {
+	if m != nil {
+		return m.ReportPeriod
+	}
+	return 0
+}
+
+func (m *ReportRule) GetDisputePeriod() int64 {
+	if m != nil {
+		return m.DisputePeriod
+	}
+	return 0
+}
+
+func (m *ReportRule) GetMinReports() int32 {
+	if m != nil {
+		return m.MinReports
+	}
+	return 0
+}
+
+func (m *ReportRule) GetMinStake() int64 {
+	if m != nil {
+		return m.MinStake
+	}
+	return 0
+}
+
+func (m *ReportRule) GetSlashAmount() int64 {
+	if m != nil {
+		return m.SlashAmount
+	}
+	return 0
+}
+
+func (m *ReportRule) GetTolerance() int64 {
+	if m != nil {
+		return m.Tolerance
+	}
+	return 0
+}
+
+func (m *ReportRule) GetDisputeBond() int64 {
+	if m != nil {
+		return m.DisputeBond
+	}
+	return 0
+}
+
+// 上报人的一次上报
+type OracleReport struct {
+	Reporter             string   `protobuf:"bytes,1,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Result               string   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Source               string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Time                 int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Slashed              bool     `protobuf:"varint,5,opt,name=slashed,proto3" json:"slashed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OracleReport) Reset()         { *m = OracleReport{} }
+func (m *OracleReport) String() string { return proto.CompactTextString(m) }
+func (*OracleReport) ProtoMessage()    {}
+func (*OracleReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{2}
+}
+
+func (m *OracleReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleReport.Unmarshal(m, b)
+}
+func (m *OracleReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleReport.Marshal(b, m, deterministic)
+}
+func (m *OracleReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleReport.Merge(m, src)
+}
+func (m *OracleReport) XXX_Size() int {
+	return xxx_messageInfo_OracleReport.Size(m)
+}
+func (m *OracleReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleReport proto.InternalMessageInfo
+
+func (m *OracleReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *OracleReport) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *OracleReport) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *OracleReport) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *OracleReport) GetSlashed() bool {
+	if m != nil {
+		return m.Slashed
+	}
+	return false
+}
+
+type OracleDispute struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Result               string   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Bond                 int64    `protobuf:"varint,3,opt,name=bond,proto3" json:"bond,omitempty"`
+	Time                 int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OracleDispute) Reset()         { *m = OracleDispute{} }
+func (m *OracleDispute) String() string { return proto.CompactTextString(m) }
+func (*OracleDispute) ProtoMessage()    {}
+func (*OracleDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{3}
+}
+
+func (m *OracleDispute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleDispute.Unmarshal(m, b)
+}
+func (m *OracleDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleDispute.Marshal(b, m, deterministic)
+}
+func (m *OracleDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleDispute.Merge(m, src)
+}
+func (m *OracleDispute) XXX_Size() int {
+	return xxx_messageInfo_OracleDispute.Size(m)
+}
+func (m *OracleDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleDispute proto.InternalMessageInfo
+
+func (m *OracleDispute) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *OracleDispute) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *OracleDispute) GetBond() int64 {
+	if m != nil {
+		return m.Bond
+	}
+	return 0
+}
+
+func (m *OracleDispute) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// 上报人质押信息
+type OracleReporter struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Stake                int64    `protobuf:"varint,2,opt,name=stake,proto3" json:"stake,omitempty"`
+	Locked               int64    `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OracleReporter) Reset()         { *m = OracleReporter{} }
+func (m *OracleReporter) String() string { return proto.CompactTextString(m) }
+func (*OracleReporter) ProtoMessage()    {}
+func (*OracleReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{4}
+}
+
+func (m *OracleReporter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OracleReporter.Unmarshal(m, b)
+}
+func (m *OracleReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OracleReporter.Marshal(b, m, deterministic)
+}
+func (m *OracleReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleReporter.Merge(m, src)
+}
+func (m *OracleReporter) XXX_Size() int {
+	return xxx_messageInfo_OracleReporter.Size(m)
+}
+func (m *OracleReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleReporter proto.InternalMessageInfo
+
+func (m *OracleReporter) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *OracleReporter) GetStake() int64 {
+	if m != nil {
+		return m.Stake
+	}
+	return 0
+}
+
+func (m *OracleReporter) GetLocked() int64 {
+	if m != nil {
+		return m.Locked
+	}
+	return 0
+}
+
 // action
 type OracleAction struct {
 	// Types that are valid to be assigned to Value:
@@ -149,6 +476,12 @@ type OracleAction struct {
 	//	*OracleAction_ResultPrePublish
 	//	*OracleAction_ResultPublish
 	//	*OracleAction_ResultAbort
+	//	*OracleAction_ReporterStake
+	//	*OracleAction_ReporterUnstake
+	//	*OracleAction_ResultReport
+	//	*OracleAction_EventSettle
+	//	*OracleAction_ResultDispute
+	//	*OracleAction_EventFinalize
 	Value                isOracleAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -160,7 +493,7 @@ func (m *OracleAction) Reset()         { *m = OracleAction{} }
 func (m *OracleAction) String() string { return proto.CompactTextString(m) }
 func (*OracleAction) ProtoMessage()    {}
 func (*OracleAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{1}
+	return fileDescriptor_b544994cdab50f02, []int{5}
 }
 
 func (m *OracleAction) XXX_Unmarshal(b []byte) error {
@@ -205,6 +538,30 @@ type OracleAction_ResultAbort struct {
 	ResultAbort *ResultAbort `protobuf:"bytes,5,opt,name=resultAbort,proto3,oneof"`
 }
 
+type OracleAction_ReporterStake struct {
+	ReporterStake *ReporterStake `protobuf:"bytes,8,opt,name=reporterStake,proto3,oneof"`
+}
+
+type OracleAction_ReporterUnstake struct {
+	ReporterUnstake *ReporterUnstake `protobuf:"bytes,9,opt,name=reporterUnstake,proto3,oneof"`
+}
+
+type OracleAction_ResultReport struct {
+	ResultReport *ResultReport `protobuf:"bytes,10,opt,name=resultReport,proto3,oneof"`
+}
+
+type OracleAction_EventSettle struct {
+	EventSettle *EventSettle `protobuf:"bytes,11,opt,name=eventSettle,proto3,oneof"`
+}
+
+type OracleAction_ResultDispute struct {
+	ResultDispute *ResultDispute `protobuf:"bytes,12,opt,name=resultDispute,proto3,oneof"`
+}
+
+type OracleAction_EventFinalize struct {
+	EventFinalize *EventFinalize `protobuf:"bytes,13,opt,name=eventFinalize,proto3,oneof"`
+}
+
 func (*OracleAction_EventPublish) isOracleAction_Value() {}
 
 func (*OracleAction_EventAbort) isOracleAction_Value() {}
@@ -215,6 +572,18 @@ func (*OracleAction_ResultPublish) isOracleAction_Value() {}
 
 func (*OracleAction_ResultAbort) isOracleAction_Value() {}
 
+func (*OracleAction_ReporterStake) isOracleAction_Value() {}
+
+func (*OracleAction_ReporterUnstake) isOracleAction_Value() {}
+
+func (*OracleAction_ResultReport) isOracleAction_Value() {}
+
+func (*OracleAction_EventSettle) isOracleAction_Value() {}
+
+func (*OracleAction_ResultDispute) isOracleAction_Value() {}
+
+func (*OracleAction_EventFinalize) isOracleAction_Value() {}
+
 func (m *OracleAction) GetValue() isOracleAction_Value {
 	if m != nil {
 		return m.Value
@@ -257,6 +626,48 @@ func (m *OracleAction) GetResultAbort() *ResultAbort {
 	return nil
 }
 
+func (m *OracleAction) GetReporterStake() *ReporterStake {
+	if x, ok := m.GetValue().(*OracleAction_ReporterStake); ok {
+		return x.ReporterStake
+	}
+	return nil
+}
+
+func (m *OracleAction) GetReporterUnstake() *ReporterUnstake {
+	if x, ok := m.GetValue().(*OracleAction_ReporterUnstake); ok {
+		return x.ReporterUnstake
+	}
+	return nil
+}
+
+func (m *OracleAction) GetResultReport() *ResultReport {
+	if x, ok := m.GetValue().(*OracleAction_ResultReport); ok {
+		return x.ResultReport
+	}
+	return nil
+}
+
+func (m *OracleAction) GetEventSettle() *EventSettle {
+	if x, ok := m.GetValue().(*OracleAction_EventSettle); ok {
+		return x.EventSettle
+	}
+	return nil
+}
+
+func (m *OracleAction) GetResultDispute() *ResultDispute {
+	if x, ok := m.GetValue().(*OracleAction_ResultDispute); ok {
+		return x.ResultDispute
+	}
+	return nil
+}
+
+func (m *OracleAction) GetEventFinalize() *EventFinalize {
+	if x, ok := m.GetValue().(*OracleAction_EventFinalize); ok {
+		return x.EventFinalize
+	}
+	return nil
+}
+
 func (m *OracleAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -272,6 +683,12 @@ func (*OracleAction) XXX_OneofWrappers() []interface{} {
 		(*OracleAction_ResultPrePublish)(nil),
 		(*OracleAction_ResultPublish)(nil),
 		(*OracleAction_ResultAbort)(nil),
+		(*OracleAction_ReporterStake)(nil),
+		(*OracleAction_ReporterUnstake)(nil),
+		(*OracleAction_ResultReport)(nil),
+		(*OracleAction_EventSettle)(nil),
+		(*OracleAction_ResultDispute)(nil),
+		(*OracleAction_EventFinalize)(nil),
 	}
 }
 
@@ -287,7 +704,7 @@ func (m *EventStatus) Reset()         { *m = EventStatus{} }
 func (m *EventStatus) String() string { return proto.CompactTextString(m) }
 func (*EventStatus) ProtoMessage()    {}
 func (*EventStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{2}
+	return fileDescriptor_b544994cdab50f02, []int{6}
 }
 
 func (m *EventStatus) XXX_Unmarshal(b []byte) error {
@@ -323,21 +740,22 @@ func (m *EventStatus) GetStatus() int32 {
 }
 
 type EventPublish struct {
-	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
-	SubType              string   `protobuf:"bytes,3,opt,name=subType,proto3" json:"subType,omitempty"`
-	Time                 int64    `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
-	Content              string   `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Introduction         string   `protobuf:"bytes,6,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Type                 string      `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SubType              string      `protobuf:"bytes,3,opt,name=subType,proto3" json:"subType,omitempty"`
+	Time                 int64       `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	Content              string      `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Introduction         string      `protobuf:"bytes,6,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Rule                 *ReportRule `protobuf:"bytes,7,opt,name=rule,proto3" json:"rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *EventPublish) Reset()         { *m = EventPublish{} }
 func (m *EventPublish) String() string { return proto.CompactTextString(m) }
 func (*EventPublish) ProtoMessage()    {}
 func (*EventPublish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{3}
+	return fileDescriptor_b544994cdab50f02, []int{7}
 }
 
 func (m *EventPublish) XXX_Unmarshal(b []byte) error {
@@ -393,6 +811,13 @@ func (m *EventPublish) GetIntroduction() string {
 	return ""
 }
 
+func (m *EventPublish) GetRule() *ReportRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
 type EventAbort struct {
 	EventID              string   `protobuf:"bytes,2,opt,name=eventID,proto3" json:"eventID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -404,7 +829,7 @@ func (m *EventAbort) Reset()         { *m = EventAbort{} }
 func (m *EventAbort) String() string { return proto.CompactTextString(m) }
 func (*EventAbort) ProtoMessage()    {}
 func (*EventAbort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{4}
+	return fileDescriptor_b544994cdab50f02, []int{8}
 }
 
 func (m *EventAbort) XXX_Unmarshal(b []byte) error {
@@ -445,7 +870,7 @@ func (m *ResultPrePublish) Reset()         { *m = ResultPrePublish{} }
 func (m *ResultPrePublish) String() string { return proto.CompactTextString(m) }
 func (*ResultPrePublish) ProtoMessage()    {}
 func (*ResultPrePublish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{5}
+	return fileDescriptor_b544994cdab50f02, []int{9}
 }
 
 func (m *ResultPrePublish) XXX_Unmarshal(b []byte) error {
@@ -500,7 +925,7 @@ func (m *ResultPublish) Reset()         { *m = ResultPublish{} }
 func (m *ResultPublish) String() string { return proto.CompactTextString(m) }
 func (*ResultPublish) ProtoMessage()    {}
 func (*ResultPublish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{6}
+	return fileDescriptor_b544994cdab50f02, []int{10}
 }
 
 func (m *ResultPublish) XXX_Unmarshal(b []byte) error {
@@ -553,7 +978,7 @@ func (m *ResultAbort) Reset()         { *m = ResultAbort{} }
 func (m *ResultAbort) String() string { return proto.CompactTextString(m) }
 func (*ResultAbort) ProtoMessage()    {}
 func (*ResultAbort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{7}
+	return fileDescriptor_b544994cdab50f02, []int{11}
 }
 
 func (m *ResultAbort) XXX_Unmarshal(b []byte) error {
@@ -581,6 +1006,264 @@ func (m *ResultAbort) GetEventID() string {
 	return ""
 }
 
+type ReporterStake struct {
+	Amount               int64    `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReporterStake) Reset()         { *m = ReporterStake{} }
+func (m *ReporterStake) String() string { return proto.CompactTextString(m) }
+func (*ReporterStake) ProtoMessage()    {}
+func (*ReporterStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{12}
+}
+
+func (m *ReporterStake) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReporterStake.Unmarshal(m, b)
+}
+func (m *ReporterStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReporterStake.Marshal(b, m, deterministic)
+}
+func (m *ReporterStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterStake.Merge(m, src)
+}
+func (m *ReporterStake) XXX_Size() int {
+	return xxx_messageInfo_ReporterStake.Size(m)
+}
+func (m *ReporterStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterStake proto.InternalMessageInfo
+
+func (m *ReporterStake) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ReporterUnstake struct {
+	Amount               int64    `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReporterUnstake) Reset()         { *m = ReporterUnstake{} }
+func (m *ReporterUnstake) String() string { return proto.CompactTextString(m) }
+func (*ReporterUnstake) ProtoMessage()    {}
+func (*ReporterUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{13}
+}
+
+func (m *ReporterUnstake) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReporterUnstake.Unmarshal(m, b)
+}
+func (m *ReporterUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReporterUnstake.Marshal(b, m, deterministic)
+}
+func (m *ReporterUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReporterUnstake.Merge(m, src)
+}
+func (m *ReporterUnstake) XXX_Size() int {
+	return xxx_messageInfo_ReporterUnstake.Size(m)
+}
+func (m *ReporterUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReporterUnstake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReporterUnstake proto.InternalMessageInfo
+
+func (m *ReporterUnstake) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ResultReport struct {
+	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Result               string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultReport) Reset()         { *m = ResultReport{} }
+func (m *ResultReport) String() string { return proto.CompactTextString(m) }
+func (*ResultReport) ProtoMessage()    {}
+func (*ResultReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{14}
+}
+
+func (m *ResultReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultReport.Unmarshal(m, b)
+}
+func (m *ResultReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultReport.Marshal(b, m, deterministic)
+}
+func (m *ResultReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultReport.Merge(m, src)
+}
+func (m *ResultReport) XXX_Size() int {
+	return xxx_messageInfo_ResultReport.Size(m)
+}
+func (m *ResultReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultReport proto.InternalMessageInfo
+
+func (m *ResultReport) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *ResultReport) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ResultReport) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+type EventSettle struct {
+	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventSettle) Reset()         { *m = EventSettle{} }
+func (m *EventSettle) String() string { return proto.CompactTextString(m) }
+func (*EventSettle) ProtoMessage()    {}
+func (*EventSettle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{15}
+}
+
+func (m *EventSettle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventSettle.Unmarshal(m, b)
+}
+func (m *EventSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventSettle.Marshal(b, m, deterministic)
+}
+func (m *EventSettle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSettle.Merge(m, src)
+}
+func (m *EventSettle) XXX_Size() int {
+	return xxx_messageInfo_EventSettle.Size(m)
+}
+func (m *EventSettle) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSettle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSettle proto.InternalMessageInfo
+
+func (m *EventSettle) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+type ResultDispute struct {
+	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Result               string   `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResultDispute) Reset()         { *m = ResultDispute{} }
+func (m *ResultDispute) String() string { return proto.CompactTextString(m) }
+func (*ResultDispute) ProtoMessage()    {}
+func (*ResultDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{16}
+}
+
+func (m *ResultDispute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultDispute.Unmarshal(m, b)
+}
+func (m *ResultDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultDispute.Marshal(b, m, deterministic)
+}
+func (m *ResultDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultDispute.Merge(m, src)
+}
+func (m *ResultDispute) XXX_Size() int {
+	return xxx_messageInfo_ResultDispute.Size(m)
+}
+func (m *ResultDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultDispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultDispute proto.InternalMessageInfo
+
+func (m *ResultDispute) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *ResultDispute) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+type EventFinalize struct {
+	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventFinalize) Reset()         { *m = EventFinalize{} }
+func (m *EventFinalize) String() string { return proto.CompactTextString(m) }
+func (*EventFinalize) ProtoMessage()    {}
+func (*EventFinalize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{17}
+}
+
+func (m *EventFinalize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventFinalize.Unmarshal(m, b)
+}
+func (m *EventFinalize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventFinalize.Marshal(b, m, deterministic)
+}
+func (m *EventFinalize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalize.Merge(m, src)
+}
+func (m *EventFinalize) XXX_Size() int {
+	return xxx_messageInfo_EventFinalize.Size(m)
+}
+func (m *EventFinalize) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalize proto.InternalMessageInfo
+
+func (m *EventFinalize) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
 // localDB
 type EventRecord struct {
 	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
@@ -593,7 +1276,7 @@ func (m *EventRecord) Reset()         { *m = EventRecord{} }
 func (m *EventRecord) String() string { return proto.CompactTextString(m) }
 func (*EventRecord) ProtoMessage()    {}
 func (*EventRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{8}
+	return fileDescriptor_b544994cdab50f02, []int{18}
 }
 
 func (m *EventRecord) XXX_Unmarshal(b []byte) error {
@@ -632,7 +1315,7 @@ func (m *QueryOracleInfos) Reset()         { *m = QueryOracleInfos{} }
 func (m *QueryOracleInfos) String() string { return proto.CompactTextString(m) }
 func (*QueryOracleInfos) ProtoMessage()    {}
 func (*QueryOracleInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{9}
+	return fileDescriptor_b544994cdab50f02, []int{19}
 }
 
 func (m *QueryOracleInfos) XXX_Unmarshal(b []byte) error {
@@ -671,7 +1354,7 @@ func (m *ReplyEventIDs) Reset()         { *m = ReplyEventIDs{} }
 func (m *ReplyEventIDs) String() string { return proto.CompactTextString(m) }
 func (*ReplyEventIDs) ProtoMessage()    {}
 func (*ReplyEventIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{10}
+	return fileDescriptor_b544994cdab50f02, []int{20}
 }
 
 func (m *ReplyEventIDs) XXX_Unmarshal(b []byte) error {
@@ -713,7 +1396,7 @@ func (m *QueryEventID) Reset()         { *m = QueryEventID{} }
 func (m *QueryEventID) String() string { return proto.CompactTextString(m) }
 func (*QueryEventID) ProtoMessage()    {}
 func (*QueryEventID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{11}
+	return fileDescriptor_b544994cdab50f02, []int{21}
 }
 
 func (m *QueryEventID) XXX_Unmarshal(b []byte) error {
@@ -777,7 +1460,7 @@ func (m *ReceiptOracle) Reset()         { *m = ReceiptOracle{} }
 func (m *ReceiptOracle) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOracle) ProtoMessage()    {}
 func (*ReceiptOracle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{12}
+	return fileDescriptor_b544994cdab50f02, []int{22}
 }
 
 func (m *ReceiptOracle) XXX_Unmarshal(b []byte) error {
@@ -844,7 +1527,7 @@ func (m *ReplyOracleStatusList) Reset()         { *m = ReplyOracleStatusList{} }
 func (m *ReplyOracleStatusList) String() string { return proto.CompactTextString(m) }
 func (*ReplyOracleStatusList) ProtoMessage()    {}
 func (*ReplyOracleStatusList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b544994cdab50f02, []int{13}
+	return fileDescriptor_b544994cdab50f02, []int{23}
 }
 
 func (m *ReplyOracleStatusList) XXX_Unmarshal(b []byte) error {
@@ -872,8 +1555,367 @@ func (m *ReplyOracleStatusList) GetStatus() []*OracleStatus {
 	return nil
 }
 
+type ReceiptOracleReporter struct {
+	Prev                 *OracleReporter `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Cur                  *OracleReporter `protobuf:"bytes,2,opt,name=cur,proto3" json:"cur,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReceiptOracleReporter) Reset()         { *m = ReceiptOracleReporter{} }
+func (m *ReceiptOracleReporter) String() string { return proto.CompactTextString(m) }
+func (*ReceiptOracleReporter) ProtoMessage()    {}
+func (*ReceiptOracleReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{24}
+}
+
+func (m *ReceiptOracleReporter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptOracleReporter.Unmarshal(m, b)
+}
+func (m *ReceiptOracleReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptOracleReporter.Marshal(b, m, deterministic)
+}
+func (m *ReceiptOracleReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptOracleReporter.Merge(m, src)
+}
+func (m *ReceiptOracleReporter) XXX_Size() int {
+	return xxx_messageInfo_ReceiptOracleReporter.Size(m)
+}
+func (m *ReceiptOracleReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptOracleReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptOracleReporter proto.InternalMessageInfo
+
+func (m *ReceiptOracleReporter) GetPrev() *OracleReporter {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptOracleReporter) GetCur() *OracleReporter {
+	if m != nil {
+		return m.Cur
+	}
+	return nil
+}
+
+type ReceiptOracleReport struct {
+	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Reporter             string   `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Result               string   `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	Source               string   `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	Time                 int64    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptOracleReport) Reset()         { *m = ReceiptOracleReport{} }
+func (m *ReceiptOracleReport) String() string { return proto.CompactTextString(m) }
+func (*ReceiptOracleReport) ProtoMessage()    {}
+func (*ReceiptOracleReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{25}
+}
+
+func (m *ReceiptOracleReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptOracleReport.Unmarshal(m, b)
+}
+func (m *ReceiptOracleReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptOracleReport.Marshal(b, m, deterministic)
+}
+func (m *ReceiptOracleReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptOracleReport.Merge(m, src)
+}
+func (m *ReceiptOracleReport) XXX_Size() int {
+	return xxx_messageInfo_ReceiptOracleReport.Size(m)
+}
+func (m *ReceiptOracleReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptOracleReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptOracleReport proto.InternalMessageInfo
+
+func (m *ReceiptOracleReport) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *ReceiptOracleReport) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *ReceiptOracleReport) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ReceiptOracleReport) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ReceiptOracleReport) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type QueryOracleReporter struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryOracleReporter) Reset()         { *m = QueryOracleReporter{} }
+func (m *QueryOracleReporter) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleReporter) ProtoMessage()    {}
+func (*QueryOracleReporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{26}
+}
+
+func (m *QueryOracleReporter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryOracleReporter.Unmarshal(m, b)
+}
+func (m *QueryOracleReporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryOracleReporter.Marshal(b, m, deterministic)
+}
+func (m *QueryOracleReporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleReporter.Merge(m, src)
+}
+func (m *QueryOracleReporter) XXX_Size() int {
+	return xxx_messageInfo_QueryOracleReporter.Size(m)
+}
+func (m *QueryOracleReporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleReporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleReporter proto.InternalMessageInfo
+
+func (m *QueryOracleReporter) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type QueryOracleReports struct {
+	EventID              string   `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Reporter             string   `protobuf:"bytes,2,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	Primary              string   `protobuf:"bytes,3,opt,name=primary,proto3" json:"primary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryOracleReports) Reset()         { *m = QueryOracleReports{} }
+func (m *QueryOracleReports) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleReports) ProtoMessage()    {}
+func (*QueryOracleReports) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{27}
+}
+
+func (m *QueryOracleReports) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryOracleReports.Unmarshal(m, b)
+}
+func (m *QueryOracleReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryOracleReports.Marshal(b, m, deterministic)
+}
+func (m *QueryOracleReports) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleReports.Merge(m, src)
+}
+func (m *QueryOracleReports) XXX_Size() int {
+	return xxx_messageInfo_QueryOracleReports.Size(m)
+}
+func (m *QueryOracleReports) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleReports.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleReports proto.InternalMessageInfo
+
+func (m *QueryOracleReports) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *QueryOracleReports) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *QueryOracleReports) GetPrimary() string {
+	if m != nil {
+		return m.Primary
+	}
+	return ""
+}
+
+type ReplyOracleReports struct {
+	Reports              []*ReceiptOracleReport `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ReplyOracleReports) Reset()         { *m = ReplyOracleReports{} }
+func (m *ReplyOracleReports) String() string { return proto.CompactTextString(m) }
+func (*ReplyOracleReports) ProtoMessage()    {}
+func (*ReplyOracleReports) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{28}
+}
+
+func (m *ReplyOracleReports) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyOracleReports.Unmarshal(m, b)
+}
+func (m *ReplyOracleReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyOracleReports.Marshal(b, m, deterministic)
+}
+func (m *ReplyOracleReports) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyOracleReports.Merge(m, src)
+}
+func (m *ReplyOracleReports) XXX_Size() int {
+	return xxx_messageInfo_ReplyOracleReports.Size(m)
+}
+func (m *ReplyOracleReports) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyOracleReports.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyOracleReports proto.InternalMessageInfo
+
+func (m *ReplyOracleReports) GetReports() []*ReceiptOracleReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
+// 事件的聚合及上报详情
+type ReplyOracleSettlement struct {
+	EventID              string          `protobuf:"bytes,1,opt,name=eventID,proto3" json:"eventID,omitempty"`
+	Status               int32           `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Rule                 *ReportRule     `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	SettledResult        string          `protobuf:"bytes,4,opt,name=settledResult,proto3" json:"settledResult,omitempty"`
+	Result               string          `protobuf:"bytes,5,opt,name=result,proto3" json:"result,omitempty"`
+	SettleTime           int64           `protobuf:"varint,6,opt,name=settleTime,proto3" json:"settleTime,omitempty"`
+	DisputeEnd           int64           `protobuf:"varint,7,opt,name=disputeEnd,proto3" json:"disputeEnd,omitempty"`
+	Dispute              *OracleDispute  `protobuf:"bytes,8,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	Reports              []*OracleReport `protobuf:"bytes,9,rep,name=reports,proto3" json:"reports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyOracleSettlement) Reset()         { *m = ReplyOracleSettlement{} }
+func (m *ReplyOracleSettlement) String() string { return proto.CompactTextString(m) }
+func (*ReplyOracleSettlement) ProtoMessage()    {}
+func (*ReplyOracleSettlement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b544994cdab50f02, []int{29}
+}
+
+func (m *ReplyOracleSettlement) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyOracleSettlement.Unmarshal(m, b)
+}
+func (m *ReplyOracleSettlement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyOracleSettlement.Marshal(b, m, deterministic)
+}
+func (m *ReplyOracleSettlement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyOracleSettlement.Merge(m, src)
+}
+func (m *ReplyOracleSettlement) XXX_Size() int {
+	return xxx_messageInfo_ReplyOracleSettlement.Size(m)
+}
+func (m *ReplyOracleSettlement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyOracleSettlement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyOracleSettlement proto.InternalMessageInfo
+
+func (m *ReplyOracleSettlement) GetEventID() string {
+	if m != nil {
+		return m.EventID
+	}
+	return ""
+}
+
+func (m *ReplyOracleSettlement) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReplyOracleSettlement) GetRule() *ReportRule {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+func (m *ReplyOracleSettlement) GetSettledResult() string {
+	if m != nil {
+		return m.SettledResult
+	}
+	return ""
+}
+
+func (m *ReplyOracleSettlement) GetResult() string {
+	if m != nil {
+		return m.Result
+	}
+	return ""
+}
+
+func (m *ReplyOracleSettlement) GetSettleTime() int64 {
+	if m != nil {
+		return m.SettleTime
+	}
+	return 0
+}
+
+func (m *ReplyOracleSettlement) GetDisputeEnd() int64 {
+	if m != nil {
+		return m.DisputeEnd
+	}
+	return 0
+}
+
+func (m *ReplyOracleSettlement) GetDispute() *OracleDispute {
+	if m != nil {
+		return m.Dispute
+	}
+	return nil
+}
+
+func (m *ReplyOracleSettlement) GetReports() []*OracleReport {
+	if m != nil {
+		return m.Reports
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OracleStatus)(nil), "types.OracleStatus")
+	proto.RegisterType((*ReportRule)(nil), "types.ReportRule")
+	proto.RegisterType((*OracleReport)(nil), "types.OracleReport")
+	proto.RegisterType((*OracleDispute)(nil), "types.OracleDispute")
+	proto.RegisterType((*OracleReporter)(nil), "types.OracleReporter")
 	proto.RegisterType((*OracleAction)(nil), "types.OracleAction")
 	proto.RegisterType((*EventStatus)(nil), "types.EventStatus")
 	proto.RegisterType((*EventPublish)(nil), "types.EventPublish")
@@ -881,12 +1923,24 @@ func init() {
 	proto.RegisterType((*ResultPrePublish)(nil), "types.ResultPrePublish")
 	proto.RegisterType((*ResultPublish)(nil), "types.ResultPublish")
 	proto.RegisterType((*ResultAbort)(nil), "types.ResultAbort")
+	proto.RegisterType((*ReporterStake)(nil), "types.ReporterStake")
+	proto.RegisterType((*ReporterUnstake)(nil), "types.ReporterUnstake")
+	proto.RegisterType((*ResultReport)(nil), "types.ResultReport")
+	proto.RegisterType((*EventSettle)(nil), "types.EventSettle")
+	proto.RegisterType((*ResultDispute)(nil), "types.ResultDispute")
+	proto.RegisterType((*EventFinalize)(nil), "types.EventFinalize")
 	proto.RegisterType((*EventRecord)(nil), "types.EventRecord")
 	proto.RegisterType((*QueryOracleInfos)(nil), "types.QueryOracleInfos")
 	proto.RegisterType((*ReplyEventIDs)(nil), "types.ReplyEventIDs")
 	proto.RegisterType((*QueryEventID)(nil), "types.QueryEventID")
 	proto.RegisterType((*ReceiptOracle)(nil), "types.ReceiptOracle")
 	proto.RegisterType((*ReplyOracleStatusList)(nil), "types.ReplyOracleStatusList")
+	proto.RegisterType((*ReceiptOracleReporter)(nil), "types.ReceiptOracleReporter")
+	proto.RegisterType((*ReceiptOracleReport)(nil), "types.ReceiptOracleReport")
+	proto.RegisterType((*QueryOracleReporter)(nil), "types.QueryOracleReporter")
+	proto.RegisterType((*QueryOracleReports)(nil), "types.QueryOracleReports")
+	proto.RegisterType((*ReplyOracleReports)(nil), "types.ReplyOracleReports")
+	proto.RegisterType((*ReplyOracleSettlement)(nil), "types.ReplyOracleSettlement")
 }
 
 func init() {
@@ -894,42 +1948,81 @@ func init() {
 }
 
 var fileDescriptor_b544994cdab50f02 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x8b, 0xdb, 0x3c,
-	0x10, 0x8e, 0x3f, 0xf7, 0xdd, 0xb1, 0xf7, 0x25, 0x55, 0xbf, 0x74, 0xe8, 0x21, 0xf8, 0xd0, 0x4d,
-	0x3f, 0x08, 0x25, 0x0b, 0x85, 0x42, 0x7b, 0x48, 0xd9, 0x40, 0x16, 0x0a, 0xdd, 0xaa, 0xb9, 0x14,
-	0x7a, 0x49, 0x1c, 0x95, 0x35, 0xa4, 0x96, 0x91, 0xe5, 0x85, 0xfc, 0x82, 0xde, 0xfa, 0xc3, 0x7a,
-	0xee, 0x0f, 0x2a, 0x1a, 0x29, 0x89, 0x9c, 0x4d, 0x02, 0x85, 0xde, 0x34, 0x33, 0x8f, 0x1e, 0xcf,
-	0xc7, 0xa3, 0x31, 0xa4, 0x42, 0xce, 0xf2, 0x25, 0x1f, 0x54, 0x52, 0x28, 0x41, 0x22, 0xb5, 0xaa,
-	0x78, 0x9d, 0xfd, 0xf2, 0x21, 0xfd, 0x88, 0xfe, 0xcf, 0x6a, 0xa6, 0x9a, 0x9a, 0x50, 0x38, 0xe1,
-	0xb7, 0xbc, 0x54, 0x57, 0x97, 0xd4, 0xeb, 0x79, 0xfd, 0x53, 0xb6, 0x36, 0x09, 0x81, 0x70, 0xb6,
-	0x58, 0x48, 0xea, 0xa3, 0x1b, 0xcf, 0xda, 0xa7, 0x79, 0x68, 0x60, 0x7c, 0xfa, 0xac, 0x19, 0xea,
-	0x66, 0x3e, 0xd5, 0xee, 0xd0, 0x30, 0x58, 0x13, 0xd1, 0xc5, 0x77, 0x4e, 0xa3, 0x9e, 0xd7, 0x0f,
-	0x18, 0x9e, 0x35, 0x3a, 0x17, 0xa5, 0xe2, 0xa5, 0xa2, 0xb1, 0x41, 0x5b, 0x93, 0x64, 0x90, 0x16,
-	0xa5, 0x92, 0x62, 0xd1, 0xe4, 0xaa, 0x10, 0x25, 0x3d, 0xc1, 0x70, 0xcb, 0x47, 0x9e, 0x43, 0x5c,
-	0x63, 0xde, 0xf4, 0xbf, 0x9e, 0xd7, 0x4f, 0x86, 0x64, 0x80, 0x65, 0x0d, 0xc6, 0x3a, 0x67, 0x53,
-	0x11, 0xb3, 0x08, 0xf2, 0x08, 0xe2, 0x5a, 0x34, 0x32, 0xe7, 0xf4, 0x14, 0x99, 0xac, 0xa5, 0xfd,
-	0x92, 0xd7, 0xcd, 0x52, 0x51, 0x30, 0x7e, 0x63, 0x91, 0x57, 0x70, 0x5a, 0x49, 0xdb, 0x16, 0x9a,
-	0x1c, 0xa4, 0xdf, 0x82, 0xb2, 0xdf, 0x9b, 0x66, 0x8e, 0x4c, 0x7a, 0x6f, 0x20, 0xc5, 0xee, 0x5d,
-	0x37, 0xf3, 0x65, 0x51, 0xdf, 0x60, 0x47, 0x93, 0xe1, 0x7d, 0x97, 0xc5, 0x86, 0x26, 0x1d, 0xd6,
-	0x82, 0x92, 0x0b, 0x00, 0xb4, 0x47, 0x73, 0x21, 0x15, 0xf6, 0x3c, 0x19, 0xde, 0x73, 0x2f, 0x62,
-	0x60, 0xd2, 0x61, 0x0e, 0x8c, 0x8c, 0xa1, 0x6b, 0x92, 0xbf, 0x96, 0x7c, 0xfd, 0xcd, 0x00, 0xaf,
-	0x3e, 0xb6, 0x57, 0xd9, 0x4e, 0x78, 0xd2, 0x61, 0x77, 0xae, 0x90, 0xb7, 0x70, 0x66, 0x7d, 0x96,
-	0x23, 0x44, 0x8e, 0x07, 0x6d, 0x8e, 0x0d, 0x41, 0x1b, 0x4c, 0x5e, 0x43, 0x62, 0x1c, 0x26, 0xf5,
-	0xa8, 0xd5, 0x39, 0xb6, 0x8d, 0x4c, 0x3a, 0xcc, 0x05, 0x92, 0xff, 0xc1, 0x9f, 0xae, 0x70, 0xca,
-	0x11, 0xf3, 0xa7, 0xab, 0xf7, 0x27, 0x10, 0xdd, 0xce, 0x96, 0x0d, 0xcf, 0xde, 0x41, 0xe2, 0x34,
-	0x5c, 0xcf, 0x4b, 0x54, 0x23, 0xad, 0x44, 0x23, 0x50, 0x6b, 0xe1, 0x7c, 0xcd, 0xb0, 0x7c, 0xe4,
-	0xb0, 0x56, 0xf6, 0xd3, 0x83, 0xd4, 0x6d, 0xf5, 0x46, 0xb4, 0xfe, 0x7e, 0xd1, 0x06, 0xfb, 0x45,
-	0x1b, 0xee, 0x17, 0x6d, 0x74, 0x5c, 0xb4, 0xf1, 0x5d, 0xd1, 0x66, 0x4f, 0x01, 0xb6, 0x13, 0x74,
-	0x1f, 0x9c, 0xdf, 0x7a, 0x70, 0xd9, 0x57, 0xe8, 0xee, 0x8e, 0xeb, 0x30, 0xda, 0x91, 0x77, 0x70,
-	0x40, 0xde, 0xa1, 0x2b, 0xef, 0xec, 0x0b, 0x9c, 0xb5, 0x06, 0xf9, 0x0f, 0xa9, 0xcf, 0x21, 0x71,
-	0xe6, 0x7c, 0xa4, 0xc2, 0x73, 0x3b, 0x59, 0xc6, 0x73, 0x21, 0x17, 0x87, 0x77, 0x4f, 0xf6, 0x12,
-	0xba, 0x9f, 0x1a, 0x2e, 0x57, 0xe6, 0x75, 0x5d, 0x95, 0xdf, 0xc4, 0xce, 0xa6, 0x0a, 0x5c, 0xf4,
-	0x33, 0x5d, 0x5a, 0xb5, 0x5c, 0x8d, 0x8d, 0x7d, 0x0c, 0x7a, 0x03, 0x29, 0x12, 0x8f, 0x9d, 0x52,
-	0x8d, 0x88, 0x3c, 0x57, 0x44, 0x7f, 0xb3, 0xfc, 0xd6, 0x5f, 0x0a, 0xdb, 0x25, 0xfc, 0xf0, 0x74,
-	0x56, 0x39, 0x2f, 0x2a, 0x65, 0xaa, 0x38, 0xb2, 0x6a, 0x0f, 0x48, 0x79, 0x93, 0x45, 0xb0, 0x27,
-	0x8b, 0xd0, 0xc9, 0xe2, 0x89, 0xbb, 0xba, 0x62, 0xa4, 0x70, 0xd6, 0xd4, 0x25, 0x3c, 0xc4, 0xf6,
-	0xb8, 0x7b, 0xff, 0x43, 0x51, 0x2b, 0xf2, 0xc2, 0x29, 0x3e, 0x70, 0x16, 0x95, 0x0b, 0x5c, 0xe7,
-	0x32, 0x8f, 0xf1, 0x3f, 0x72, 0xf1, 0x27, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xd5, 0xe6, 0xbc, 0x57,
-	0x06, 0x00, 0x00,
+	// 1209 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0x8f, 0xff, 0x24, 0x69, 0x26, 0xc9, 0x5d, 0xd9, 0x5e, 0x8b, 0x75, 0x3a, 0x9d, 0xaa, 0x15,
+	0xd0, 0x96, 0x3f, 0x15, 0xba, 0x43, 0x48, 0x48, 0xf0, 0xd0, 0xaa, 0x41, 0x39, 0x84, 0x44, 0xd9,
+	0x16, 0x09, 0x24, 0x5e, 0xdc, 0x78, 0x69, 0xad, 0x3a, 0x76, 0xb4, 0xb6, 0x2b, 0x85, 0x2f, 0x00,
+	0x6f, 0xbc, 0xdd, 0x37, 0xe1, 0x23, 0xf0, 0xc8, 0x77, 0x42, 0x3b, 0xbb, 0x8e, 0x77, 0x13, 0xc7,
+	0xc7, 0x21, 0xde, 0x3c, 0xb3, 0x3f, 0xcf, 0xff, 0xd9, 0x99, 0x85, 0x51, 0x26, 0xc2, 0x59, 0xc2,
+	0x4f, 0x17, 0x22, 0x2b, 0x32, 0xd2, 0x2d, 0x96, 0x0b, 0x9e, 0xd3, 0xd7, 0x3e, 0x8c, 0xbe, 0x43,
+	0xfe, 0x55, 0x11, 0x16, 0x65, 0x4e, 0x02, 0xe8, 0xf3, 0x07, 0x9e, 0x16, 0xaf, 0x2e, 0x02, 0xe7,
+	0xd0, 0x39, 0x1e, 0xb0, 0x8a, 0x24, 0x04, 0xfc, 0x30, 0x8a, 0x44, 0xe0, 0x22, 0x1b, 0xbf, 0x25,
+	0x4f, 0xca, 0x09, 0x3c, 0xc5, 0x93, 0xdf, 0x52, 0x42, 0x5e, 0xde, 0x5c, 0x4b, 0xb6, 0xaf, 0x24,
+	0x68, 0x12, 0xd1, 0xf1, 0x9c, 0x07, 0xdd, 0x43, 0xe7, 0xd8, 0x63, 0xf8, 0x2d, 0xd1, 0xb3, 0x2c,
+	0x2d, 0x78, 0x5a, 0x04, 0x3d, 0x85, 0xd6, 0x24, 0xa1, 0x30, 0x8a, 0xd3, 0x42, 0x64, 0x51, 0x39,
+	0x2b, 0xe2, 0x2c, 0x0d, 0xfa, 0x78, 0x6c, 0xf1, 0xc8, 0x87, 0xd0, 0xcb, 0xd1, 0xee, 0x60, 0xe7,
+	0xd0, 0x39, 0x1e, 0xbe, 0x20, 0xa7, 0xe8, 0xd6, 0xe9, 0x44, 0xda, 0xac, 0x3c, 0x62, 0x1a, 0x41,
+	0x0e, 0xa0, 0x97, 0x67, 0xa5, 0x98, 0xf1, 0x60, 0x80, 0x92, 0x34, 0x25, 0xf9, 0x82, 0xe7, 0x65,
+	0x52, 0x04, 0xa0, 0xf8, 0x8a, 0x22, 0x9f, 0xc2, 0x60, 0x21, 0x74, 0x58, 0x82, 0xe1, 0x56, 0xf1,
+	0x35, 0x88, 0xbc, 0x0f, 0xbe, 0x28, 0x13, 0x1e, 0x8c, 0x10, 0xfc, 0x8e, 0x06, 0x33, 0xbe, 0xc8,
+	0x44, 0xc1, 0xca, 0x84, 0x33, 0x3c, 0x26, 0x9f, 0x40, 0x5f, 0x20, 0x2f, 0x0f, 0xc6, 0x87, 0xde,
+	0xf1, 0xf0, 0xc5, 0x9e, 0x46, 0xaa, 0x44, 0x68, 0x7c, 0x85, 0x21, 0xef, 0xc1, 0x38, 0xe7, 0x45,
+	0x91, 0xf0, 0x88, 0x29, 0x33, 0x1f, 0xa1, 0x99, 0x36, 0x93, 0x3c, 0x07, 0x50, 0x8c, 0x6b, 0x19,
+	0xe1, 0xc7, 0x18, 0x61, 0x83, 0x43, 0x4e, 0xa1, 0x1f, 0xc5, 0xf9, 0xa2, 0x2c, 0x78, 0xb0, 0x8b,
+	0xe6, 0x3d, 0xb1, 0x94, 0x5e, 0xa8, 0x33, 0x56, 0x81, 0xe8, 0x6b, 0x17, 0xa0, 0xb6, 0x9c, 0x1c,
+	0xc2, 0x30, 0xbc, 0xbd, 0x15, 0xfc, 0x36, 0xc4, 0x5c, 0xc8, 0xd2, 0xe8, 0x32, 0x93, 0x25, 0xd3,
+	0xa5, 0x2c, 0xbe, 0xe4, 0x22, 0xce, 0x22, 0x2c, 0x13, 0x8f, 0x59, 0x3c, 0xe9, 0x8a, 0x96, 0xaf,
+	0x41, 0x1e, 0x82, 0x6c, 0xa6, 0x74, 0x65, 0x1e, 0xa7, 0x4c, 0x87, 0xc8, 0x47, 0x55, 0x06, 0x87,
+	0x3c, 0x85, 0x9d, 0x79, 0x9c, 0x5e, 0x15, 0xe1, 0x7d, 0x55, 0x4a, 0x2b, 0x5a, 0xda, 0x99, 0x27,
+	0x61, 0x7e, 0x77, 0x36, 0xcf, 0x4a, 0x5d, 0x52, 0x1e, 0x33, 0x59, 0xe4, 0x19, 0x0c, 0x8a, 0x2c,
+	0xe1, 0x22, 0x4c, 0x67, 0x1c, 0x6b, 0xca, 0x63, 0x35, 0x43, 0xfe, 0xaf, 0x8d, 0x39, 0xcf, 0xd2,
+	0x08, 0xab, 0xca, 0x63, 0x26, 0x8b, 0xfe, 0xee, 0x54, 0x1d, 0xa3, 0xec, 0x91, 0xe6, 0x28, 0x27,
+	0xb9, 0xd0, 0x2d, 0xb3, 0xa2, 0x8d, 0xda, 0x72, 0xad, 0xda, 0xaa, 0x6b, 0xd1, 0xb3, 0x6a, 0xb1,
+	0xea, 0x10, 0xdf, 0xee, 0x10, 0xb4, 0x9f, 0x47, 0xe8, 0xed, 0x0e, 0xab, 0x48, 0x3a, 0x83, 0xb1,
+	0x95, 0xbd, 0x55, 0x8b, 0x3a, 0x46, 0x8b, 0x6e, 0x33, 0x81, 0x80, 0x7f, 0x23, 0x5d, 0x54, 0x29,
+	0xc0, 0xef, 0x26, 0xf5, 0x94, 0xc1, 0x23, 0xd3, 0x5d, 0x2e, 0x1a, 0xb5, 0x3c, 0x81, 0x6e, 0x8e,
+	0x09, 0x51, 0x69, 0x57, 0x84, 0xd4, 0x9d, 0x64, 0xb3, 0x7b, 0x5e, 0x69, 0xd1, 0x14, 0xfd, 0xab,
+	0x5b, 0xc5, 0xf0, 0x4c, 0xf5, 0xf1, 0x17, 0x30, 0xc2, 0x6b, 0xe6, 0xb2, 0xbc, 0x49, 0xe2, 0xfc,
+	0x0e, 0x45, 0xd7, 0x7d, 0x31, 0x31, 0x8e, 0xa6, 0x1d, 0x66, 0x41, 0xc9, 0x4b, 0x00, 0xa4, 0xcf,
+	0x6e, 0x32, 0xa1, 0x7c, 0xac, 0x5b, 0x6f, 0xb2, 0x3a, 0x98, 0x76, 0x98, 0x01, 0x23, 0x13, 0xd8,
+	0x55, 0x61, 0xb8, 0x14, 0xbc, 0xd2, 0xe9, 0xe1, 0xaf, 0xef, 0xae, 0xba, 0xd6, 0x3e, 0x9e, 0x76,
+	0xd8, 0xc6, 0x2f, 0xe4, 0x4b, 0x18, 0x6b, 0x9e, 0x96, 0xe1, 0x5b, 0xad, 0xc5, 0xcc, 0xb3, 0x69,
+	0x87, 0xd9, 0x60, 0xf2, 0x39, 0x0c, 0x15, 0x43, 0x99, 0xde, 0xb5, 0xae, 0x18, 0x56, 0x9f, 0x4c,
+	0x3b, 0xcc, 0x04, 0x2a, 0xad, 0x2a, 0x17, 0xaa, 0x09, 0x76, 0xd6, 0xb4, 0x1a, 0x67, 0x4a, 0xab,
+	0xc1, 0x20, 0xe7, 0xf0, 0xb8, 0x62, 0xfc, 0x90, 0xaa, 0x9c, 0x0d, 0xf0, 0xff, 0x83, 0xb5, 0xff,
+	0xf5, 0xe9, 0xb4, 0xc3, 0xd6, 0x7f, 0x90, 0xe9, 0x52, 0x06, 0x29, 0x6c, 0x00, 0x56, 0xba, 0x98,
+	0x71, 0x24, 0xd3, 0x65, 0x42, 0xa5, 0xd3, 0x98, 0x87, 0x2b, 0xbc, 0x9a, 0x1a, 0xef, 0x55, 0x3c,
+	0x91, 0x4e, 0x1b, 0xc0, 0x3a, 0xd4, 0xba, 0xd6, 0x83, 0xd1, 0x9a, 0xd3, 0xc6, 0x59, 0x1d, 0x6a,
+	0xcd, 0x90, 0x7f, 0xa3, 0xb0, 0xaf, 0xe3, 0x34, 0x4c, 0xe2, 0x5f, 0x79, 0x30, 0xb6, 0xfe, 0x9e,
+	0x98, 0x67, 0xf2, 0x6f, 0x0b, 0x4c, 0x1e, 0x81, 0x7b, 0xbd, 0xc4, 0xbb, 0xa2, 0xcb, 0xdc, 0xeb,
+	0xe5, 0x79, 0x1f, 0xba, 0x0f, 0x61, 0x52, 0x72, 0xfa, 0x15, 0x0c, 0x8d, 0x51, 0x20, 0xcb, 0x3d,
+	0x5b, 0x9c, 0xd5, 0xad, 0xa1, 0x29, 0xc9, 0xd7, 0x53, 0xca, 0x45, 0x19, 0x9a, 0xa2, 0x7f, 0x3a,
+	0x30, 0x32, 0x6b, 0x7b, 0x35, 0x4e, 0xdd, 0xe6, 0x71, 0xea, 0x35, 0x8f, 0x53, 0xbf, 0x79, 0x9c,
+	0x76, 0xdb, 0xc7, 0x69, 0xaf, 0x61, 0x9c, 0x56, 0x03, 0xac, 0xdf, 0x3a, 0xc0, 0xe8, 0x07, 0x00,
+	0x75, 0x67, 0x99, 0x1b, 0x83, 0x6b, 0x6d, 0x0c, 0xf4, 0x67, 0xd8, 0x5d, 0x6f, 0xa3, 0xed, 0xe8,
+	0xad, 0x77, 0x62, 0x7d, 0x81, 0xf9, 0xe6, 0x05, 0x46, 0x7f, 0x82, 0xb1, 0xd5, 0x60, 0xff, 0xa3,
+	0xe8, 0x23, 0x18, 0x1a, 0xfd, 0xd7, 0xe2, 0xe1, 0x11, 0x8c, 0x55, 0x74, 0xaa, 0xee, 0x3a, 0x80,
+	0x5e, 0xa8, 0x46, 0x8f, 0xa3, 0x6e, 0x3c, 0x45, 0xd1, 0x13, 0x78, 0xbc, 0xd6, 0x57, 0x5b, 0xa1,
+	0x3f, 0xc2, 0xc8, 0xec, 0xa0, 0x96, 0x8d, 0xac, 0x76, 0xcb, 0xdd, 0xe2, 0x96, 0xb7, 0xee, 0x96,
+	0xd1, 0x61, 0xdb, 0x05, 0xd3, 0xb3, 0x2a, 0xb4, 0x55, 0xff, 0xb4, 0xda, 0xd0, 0x34, 0x5e, 0xe8,
+	0x09, 0x8c, 0xad, 0xae, 0x6a, 0xd1, 0x56, 0x99, 0xc5, 0xf8, 0x2c, 0x13, 0x51, 0x0b, 0xf0, 0x63,
+	0xd8, 0xfd, 0xbe, 0xe4, 0x62, 0xa9, 0x46, 0xc7, 0xab, 0xf4, 0x97, 0x6c, 0x6d, 0x5f, 0xf5, 0x4c,
+	0xf4, 0x09, 0xe6, 0x26, 0x59, 0x4e, 0x14, 0xdd, 0x06, 0xbd, 0x83, 0x11, 0x0a, 0x9e, 0x18, 0x81,
+	0x55, 0x0d, 0xeb, 0x98, 0x0d, 0xfb, 0x36, 0x2b, 0x70, 0xa5, 0xc9, 0xb7, 0x5d, 0xf8, 0xcd, 0x91,
+	0x56, 0xcd, 0x78, 0xbc, 0x28, 0x94, 0x17, 0x6f, 0x48, 0x6f, 0xc3, 0xb5, 0xb1, 0xb2, 0xc2, 0x6b,
+	0xb0, 0xc2, 0x37, 0xac, 0x78, 0x66, 0x2e, 0xb0, 0x3d, 0x14, 0x51, 0x33, 0xe8, 0x05, 0xec, 0x63,
+	0x78, 0xcc, 0xed, 0xff, 0xdb, 0x38, 0x2f, 0xc8, 0x47, 0x86, 0xf3, 0x9b, 0xdb, 0xa9, 0xbd, 0x54,
+	0xd3, 0x7b, 0xd8, 0xb7, 0xdc, 0x59, 0x2d, 0x09, 0x27, 0xe0, 0x2f, 0x04, 0x7f, 0xd0, 0x93, 0x7c,
+	0xbf, 0x61, 0xc3, 0xe5, 0x82, 0x21, 0x84, 0x1c, 0x81, 0x37, 0x2b, 0x45, 0xe0, 0xb6, 0x21, 0x25,
+	0x82, 0xfe, 0xe1, 0xc0, 0x5e, 0x83, 0xb6, 0x96, 0x10, 0x9a, 0xbb, 0x99, 0xbb, 0x75, 0x37, 0xf3,
+	0xb6, 0xec, 0x66, 0x7e, 0xe3, 0x6e, 0x66, 0xbc, 0x5e, 0xe8, 0x09, 0xec, 0x19, 0x15, 0xd9, 0xb6,
+	0x21, 0xd1, 0x08, 0xc8, 0x06, 0x34, 0xff, 0x8f, 0xa6, 0x07, 0xd0, 0x5f, 0x88, 0x78, 0x1e, 0x8a,
+	0x65, 0x35, 0x13, 0x34, 0x49, 0xbf, 0x01, 0x62, 0x64, 0xb5, 0xd2, 0xf2, 0x59, 0xfd, 0xe2, 0x50,
+	0x39, 0x7d, 0xba, 0xba, 0xda, 0x37, 0xa2, 0xb9, 0x7a, 0x78, 0xd0, 0xbf, 0x5d, 0xbb, 0x44, 0xf0,
+	0xd6, 0x98, 0xcb, 0x59, 0xf2, 0xf6, 0x35, 0x5b, 0x4d, 0x16, 0xaf, 0xfd, 0x69, 0xb4, 0xf1, 0xd6,
+	0xf1, 0x9b, 0xde, 0x3a, 0x75, 0xe6, 0xba, 0x56, 0xe6, 0xec, 0x37, 0x50, 0x6f, 0xe3, 0x0d, 0xf4,
+	0x1c, 0x40, 0x6f, 0xf2, 0x93, 0x34, 0xd2, 0xbb, 0xbf, 0xc1, 0x31, 0xdf, 0x48, 0x3b, 0xff, 0xe2,
+	0x8d, 0x64, 0x3e, 0xe4, 0x06, 0x6f, 0x7e, 0xc8, 0xdd, 0xf4, 0xf0, 0xe5, 0xfd, 0xf2, 0x9f, 0x01,
+	0x00, 0x88, 0xa8, 0xef, 0x92, 0x89, 0x0f, 0x00, 0x00,
 }
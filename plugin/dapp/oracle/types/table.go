@@ -69,3 +69,65 @@ func (tx *OracleRow) Get(key string) ([]byte, error) {
 	}
 	return nil, types.ErrNotFound
 }
+
+/*
+table  struct
+data:  oracle_report
+index: reporter
+*/
+
+var reportOpt = &table.Option{
+	Prefix:  "LODB",
+	Name:    "oracle_report",
+	Primary: "id",
+	Index:   []string{"reporter"},
+}
+
+//NewReportTable 新建上报记录表
+func NewReportTable(kvdb db.KV) *table.Table {
+	rowmeta := NewReportRow()
+	table, err := table.NewTable(rowmeta, kvdb, reportOpt)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//ReportRow 上报记录 meta 结构
+type ReportRow struct {
+	*ReceiptOracleReport
+}
+
+//NewReportRow 新建一个meta 结构
+func NewReportRow() *ReportRow {
+	return &ReportRow{ReceiptOracleReport: &ReceiptOracleReport{}}
+}
+
+//CreateRow 新建数据行
+func (r *ReportRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ReceiptOracleReport{}}
+}
+
+//SetPayload 设置数据
+func (r *ReportRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ReceiptOracleReport); ok {
+		r.ReceiptOracleReport = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (r *ReportRow) Get(key string) ([]byte, error) {
+	if key == "id" {
+		return []byte(ReportID(r.EventID, r.Reporter)), nil
+	} else if key == "reporter" {
+		return []byte(r.Reporter), nil
+	}
+	return nil, types.ErrNotFound
+}
+
+//ReportID 上报记录的主键
+func ReportID(eventID, reporter string) string {
+	return fmt.Sprintf("%s:%s", eventID, reporter)
+}
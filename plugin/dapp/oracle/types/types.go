@@ -22,6 +22,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(OracleX, "Enable", 0)
+	cfg.RegisterDappFork(OracleX, ForkOracleReporterX, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -60,6 +61,12 @@ func (o *OracleType) GetTypeMap() map[string]int32 {
 		"ResultPrePublish": ActionResultPrePublish,
 		"ResultAbort":      ActionResultAbort,
 		"ResultPublish":    ActionResultPublish,
+		"ReporterStake":    ActionReporterStake,
+		"ReporterUnstake":  ActionReporterUnstake,
+		"ResultReport":     ActionResultReport,
+		"EventSettle":      ActionEventSettle,
+		"ResultDispute":    ActionResultDispute,
+		"EventFinalize":    ActionEventFinalize,
 	}
 }
 
@@ -71,5 +78,11 @@ func (o *OracleType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogResultPrePublish: {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultPrePublish"},
 		TyLogResultAbort:      {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultAbort"},
 		TyLogResultPublish:    {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultPublish"},
+		TyLogReporterStake:    {Ty: reflect.TypeOf(ReceiptOracleReporter{}), Name: "LogReporterStake"},
+		TyLogReporterUnstake:  {Ty: reflect.TypeOf(ReceiptOracleReporter{}), Name: "LogReporterUnstake"},
+		TyLogResultReport:     {Ty: reflect.TypeOf(ReceiptOracleReport{}), Name: "LogResultReport"},
+		TyLogEventSettle:      {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogEventSettle"},
+		TyLogResultDispute:    {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogResultDispute"},
+		TyLogEventFinalize:    {Ty: reflect.TypeOf(ReceiptOracle{}), Name: "LogEventFinalize"},
 	}
 }
@@ -264,6 +264,8 @@ func addCreateCrossAssetTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("note", "n", "", "transaction note info")
 
+	cmd.Flags().StringP("toPara", "p", "", "target para chain title for para to para transfer, like user.p.test2.")
+
 }
 
 func createCrossAssetTransfer(cmd *cobra.Command, args []string) {
@@ -272,6 +274,7 @@ func createCrossAssetTransfer(cmd *cobra.Command, args []string) {
 	note, _ := cmd.Flags().GetString("note")
 	symbol, _ := cmd.Flags().GetString("symbol")
 	amount, _ := cmd.Flags().GetFloat64("amount")
+	toPara, _ := cmd.Flags().GetString("toPara")
 
 	if amount < 0 {
 		fmt.Fprintln(os.Stderr, "amount < 0")
@@ -292,6 +295,7 @@ func createCrossAssetTransfer(cmd *cobra.Command, args []string) {
 	config.ToAddr = toAddr
 	config.Note = note
 	config.Amount = amountInt64
+	config.ToParaTitle = toPara
 
 	params := &rpctypes.CreateTxIn{
 		Execer:     execName,
@@ -348,17 +352,22 @@ func addNodeJoinFlags(cmd *cobra.Command) {
 	cmd.Flags().Float64P("coins", "c", 0, "frozen coins amount, should not less nodegroup's setting")
 	cmd.MarkFlagRequired("coins")
 
+	cmd.Flags().StringP("blspub", "p", "", "bls sign pub key for addr's private key (optional)")
+	cmd.Flags().StringP("blspop", "o", "", "bls pub key's proof of possession, get by bls pubkey cmd (optional)")
 }
 
 func createNodeJoinTx(cmd *cobra.Command, args []string) {
 	opAddr, _ := cmd.Flags().GetString("addr")
 	coins, _ := cmd.Flags().GetFloat64("coins")
+	blspub, _ := cmd.Flags().GetString("blspub")
+	blspop, _ := cmd.Flags().GetString("blspop")
 	paraName, _ := cmd.Flags().GetString("paraName")
 	if !strings.HasPrefix(paraName, "user.p") {
 		fmt.Fprintln(os.Stderr, "paraName is not right, paraName format like `user.p.guodun.`")
 		return
 	}
-	payload := &pt.ParaNodeAddrConfig{Title: paraName, Op: 1, Addr: opAddr, CoinsFrozen: int64(math.Trunc((coins+0.0000001)*1e4)) * 1e4}
+	payload := &pt.ParaNodeAddrConfig{Title: paraName, Op: 1, Addr: opAddr, BlsPubKey: blspub, BlsPop: blspop,
+		CoinsFrozen: int64(math.Trunc((coins+0.0000001)*1e4)) * 1e4}
 	params := &rpctypes.CreateTxIn{
 		Execer:     getRealExecName(paraName, pt.ParaX),
 		ActionName: "NodeConfig",
@@ -496,6 +505,7 @@ func addNodeModifyFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("addr")
 	cmd.Flags().StringP("pubkey", "p", "", "operating target apply id")
 	cmd.MarkFlagRequired("pubkey")
+	cmd.Flags().StringP("pop", "o", "", "bls pub key's proof of possession, get by bls pubkey cmd")
 
 }
 
@@ -503,11 +513,12 @@ func createNodeModifyTx(cmd *cobra.Command, args []string) {
 	paraName, _ := cmd.Flags().GetString("paraName")
 	addr, _ := cmd.Flags().GetString("addr")
 	pubkey, _ := cmd.Flags().GetString("pubkey")
+	pop, _ := cmd.Flags().GetString("pop")
 	if !strings.HasPrefix(paraName, "user.p") {
 		fmt.Fprintln(os.Stderr, "paraName is not right, paraName format like `user.p.guodun.`")
 		return
 	}
-	payload := &pt.ParaNodeAddrConfig{Title: paraName, Op: pt.ParaOpModify, Addr: addr, BlsPubKey: pubkey}
+	payload := &pt.ParaNodeAddrConfig{Title: paraName, Op: pt.ParaOpModify, Addr: addr, BlsPubKey: pubkey, BlsPop: pop}
 	params := &rpctypes.CreateTxIn{
 		Execer:     getRealExecName(paraName, pt.ParaX),
 		ActionName: "NodeConfig",
@@ -878,6 +889,7 @@ func addNodeGroupApplyCmdFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("addrs")
 
 	cmd.Flags().StringP("blspubs", "p", "", "bls sign pub key for addr's private key,split by ',' (optional)")
+	cmd.Flags().StringP("blspops", "o", "", "bls pub key's proof of possession,split by ',' (optional)")
 
 	cmd.Flags().Float64P("coins", "c", 0, "coins amount to frozen, not less config")
 	cmd.MarkFlagRequired("coins")
@@ -888,6 +900,7 @@ func nodeGroupApply(cmd *cobra.Command, args []string) {
 	paraName, _ := cmd.Flags().GetString("paraName")
 	addrs, _ := cmd.Flags().GetString("addrs")
 	blspubs, _ := cmd.Flags().GetString("blspubs")
+	blspops, _ := cmd.Flags().GetString("blspops")
 	coins, _ := cmd.Flags().GetFloat64("coins")
 
 	if !strings.HasPrefix(paraName, "user.p") {
@@ -895,7 +908,7 @@ func nodeGroupApply(cmd *cobra.Command, args []string) {
 		return
 	}
 
-	payload := &pt.ParaNodeGroupConfig{Title: paraName, Op: 1, Addrs: addrs, BlsPubKeys: blspubs, CoinsFrozen: int64(math.Trunc((coins+0.0000001)*1e4)) * 1e4}
+	payload := &pt.ParaNodeGroupConfig{Title: paraName, Op: 1, Addrs: addrs, BlsPubKeys: blspubs, BlsPops: blspops, CoinsFrozen: int64(math.Trunc((coins+0.0000001)*1e4)) * 1e4}
 	params := &rpctypes.CreateTxIn{
 		Execer:     getRealExecName(paraName, pt.ParaX),
 		ActionName: "NodeGroupConfig",
@@ -1084,7 +1097,7 @@ func cmtTxInfo(cmd *cobra.Command, args []string) {
 func blsPubKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "pubkey",
-		Short: "get bls pub key by secp256 prikey or current wallet bls pubkey",
+		Short: "get bls pub key and pop by secp256 prikey or current wallet bls pubkey",
 		Run:   blsPubKey,
 	}
 	return cmd
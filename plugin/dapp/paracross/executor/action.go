@@ -428,9 +428,11 @@ func getValidAddrs(nodes map[string]struct{}, addrs []string) []string {
 //bls签名共识交易验证 大约平均耗时3ms (2~4ms)
 func (a *action) procBlsSign(nodesArry []string, commit *pt.ParacrossCommitAction) ([]string, error) {
 	signAddrs := util.GetAddrsByBitMap(nodesArry, commit.Bls.AddrsMap)
+	cfg := a.api.GetConfig()
+	needPop := cfg.IsDappFork(commit.Status.MainBlockHeight, pt.ParaX, pt.ForkParaBlsPop)
 	var pubs []string
 	for _, addr := range signAddrs {
-		pub, err := getAddrBlsPubKey(a.db, commit.Status.Title, addr)
+		pub, err := getAddrBlsPubKey(a.db, commit.Status.Title, addr, needPop)
 		if err != nil {
 			return nil, errors.Wrapf(err, "pubkey not exist to addr=%s", addr)
 		}
@@ -503,7 +505,11 @@ func (a *action) Commit(commit *pt.ParacrossCommitAction) (*types.Receipt, error
 	//获取commitAddrs, bls sign 包含多个账户的聚合签名
 	commitAddrs := []string{a.fromaddr}
 	if commit.Bls != nil {
-		addrs, err := a.procBlsSign(nodesArry, commit)
+		procSign := a.procBlsSign
+		if len(commit.Bls.ThresholdSign) > 0 {
+			procSign = a.procBlsThresholdSign
+		}
+		addrs, err := procSign(nodesArry, commit)
 		if err != nil {
 			return nil, errors.Wrap(err, "procBlsSign")
 		}
@@ -695,6 +701,13 @@ func (a *action) commitTxDoneStep2(nodeStatus *pt.ParacrossNodeStatus, stat *pt.
 		return nil, err
 	}
 	receipt = mergeReceipt(receipt, r)
+
+	//向本平行链投递其他平行链转入的资产
+	r, err = a.deliverParaCrossIns(nodeStatus.Title)
+	if err != nil {
+		return nil, err
+	}
+	receipt = mergeReceipt(receipt, r)
 	return receipt, nil
 }
 
@@ -891,7 +904,12 @@ func execCrossTx(a *action, cross *types.TransactionDetail, crossTxHash []byte)
 			return nil, err
 		}
 		if act == pt.ParacrossMainAssetWithdraw || act == pt.ParacrossParaAssetTransfer {
-			receipt, err := a.crossAssetTransfer(payload.GetCrossAssetTransfer(), act, cross.Tx)
+			var receipt *types.Receipt
+			if a.isParaCrossTransfer(payload.GetCrossAssetTransfer()) {
+				receipt, err = a.routeParaCrossTransfer(payload.GetCrossAssetTransfer(), act, cross.Tx)
+			} else {
+				receipt, err = a.crossAssetTransfer(payload.GetCrossAssetTransfer(), act, cross.Tx)
+			}
 			if err != nil {
 				clog.Crit("paracross.Commit crossAssetTransfer Tx failed", "error", err, "act", act, "txHash", common.ToHex(crossTxHash))
 				return nil, err
@@ -1243,6 +1261,12 @@ func (a *action) CrossAssetTransfer(transfer *pt.CrossAssetTransfer) (*types.Rec
 	if act == pt.ParacrossNoneTransfer {
 		return nil, errors.Wrap(err, "non action")
 	}
+	if a.isParaCrossTransfer(transfer) {
+		err = a.checkParaCrossTransfer(transfer, act, string(a.tx.Execer))
+		if err != nil {
+			return nil, errors.Wrap(err, "checkParaCrossTransfer")
+		}
+	}
 	// 需要平行链先执行， 达成共识时，继续执行
 	if !isPara && (act == pt.ParacrossMainAssetWithdraw || act == pt.ParacrossParaAssetTransfer) {
 		return nil, nil
@@ -1312,6 +1336,16 @@ func (a *action) Miner(miner *pt.ParacrossMinerAction) (*types.Receipt, error) {
 		}
 	}
 
+	//主链投递的其他平行链转入资产
+	if len(miner.CrossIns) > 0 {
+		r, err := a.creditParaCrossIns(miner.CrossIns)
+		if err != nil {
+			clog.Error("paracross miner crossIn err", "height", miner.Status.Height, "err", err)
+			return nil, err
+		}
+		minerReceipt = mergeReceipt(minerReceipt, r)
+	}
+
 	return minerReceipt, nil
 }
 
@@ -1330,12 +1364,9 @@ func (a *action) isAllowTransfer() error {
 	if err != nil {
 		return errors.Wrapf(types.ErrInvalidParam, "not para chain exec=%s", string(a.tx.Execer))
 	}
-	nodes, _, err := a.getNodesGroup(string(tempTitle))
+	err = a.isAllowTitleTransfer(string(tempTitle))
 	if err != nil {
-		return errors.Wrapf(err, "nodegroup not config,title=%s", tempTitle)
-	}
-	if len(nodes) == 0 {
-		return errors.Wrapf(types.ErrNotSupport, "nodegroup not create,title=%s", tempTitle)
+		return err
 	}
 	//2. 非跨链执行器不允许
 	if !types.IsParaExecName(string(a.tx.Execer)) {
@@ -1345,6 +1376,17 @@ func (a *action) isAllowTransfer() error {
 	return nil
 }
 
+func (a *action) isAllowTitleTransfer(title string) error {
+	nodes, _, err := a.getNodesGroup(title)
+	if err != nil {
+		return errors.Wrapf(err, "nodegroup not config,title=%s", title)
+	}
+	if len(nodes) == 0 {
+		return errors.Wrapf(types.ErrNotSupport, "nodegroup not create,title=%s", title)
+	}
+	return nil
+}
+
 /*
 func (a *Paracross) CrossLimits(tx *types.Transaction, index int) bool {
 	if tx.GroupCount < 2 {
@@ -0,0 +1,177 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+)
+
+/*
+nodegroup门限bls签名(ForkParaBlsThreshold)：
+1. nodegroup节点在平行链内部通过DKG生成一个群公钥，每个节点只持有自己的分片私钥，任意threshold个节点的部分签名可以合成群签名，
+   threshold需要超过节点数的2/3，这样一个群签名就等同于超过2/3节点commit
+2. 每个节点发送BlsGroupKey交易投票，nodes必须为当前nodegroup，超过2/3节点投票相同的群公钥和门限后生效
+3. nodegroup变化(nodeJoin/nodeQuit)后当前nodegroup和群公钥的nodes不一致，群公钥失效，节点重新DKG后投票，nodes变化时清空之前的投票
+4. commit交易携带thresholdSign时只用生效的群公钥验签，不再需要每个节点的bls公钥和地址bitmap
+*/
+
+func getBlsGroupKey(db dbm.KV, title string) (*pt.ParaBlsGroupKeyStatus, error) {
+	val, err := db.Get(calcParaBlsGroupKey(title))
+	if err != nil {
+		if isNotFound(err) {
+			return &pt.ParaBlsGroupKeyStatus{Title: title, Votes: &pt.ParaNodeVoteDetail{}}, nil
+		}
+		return nil, err
+	}
+	var stat pt.ParaBlsGroupKeyStatus
+	err = types.Decode(val, &stat)
+	if err != nil {
+		return nil, err
+	}
+	if stat.Votes == nil {
+		stat.Votes = &pt.ParaNodeVoteDetail{}
+	}
+	return &stat, nil
+}
+
+func checkBlsGroupKey(cryptoCli crypto.Crypto, key *pt.ParaBlsGroupKey, nodes int) error {
+	if int(key.Threshold) > nodes || !isCommitDone(nodes, int(key.Threshold)) {
+		return errors.Wrapf(types.ErrInvalidParam, "threshold=%d should over 2/3 of nodes=%d", key.Threshold, nodes)
+	}
+	_, err := cryptoCli.PubKeyFromBytes(key.GroupPubKey)
+	if err != nil {
+		return errors.Wrapf(err, "group pubkey=%s", common.ToHex(key.GroupPubKey))
+	}
+	return nil
+}
+
+//投票内容为门限和群公钥
+func blsGroupKeyVote(key *pt.ParaBlsGroupKey) string {
+	return common.ToHex(types.Encode(&pt.ParaBlsGroupKey{Threshold: key.Threshold, GroupPubKey: key.GroupPubKey}))
+}
+
+func makeBlsGroupKeyReceipt(addr string, prev, current *pt.ParaBlsGroupKeyStatus) *types.Receipt {
+	key := calcParaBlsGroupKey(current.Title)
+	log := &pt.ReceiptParaBlsGroupKey{Addr: addr, Prev: prev, Current: current}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: key, Value: types.Encode(current)}},
+		Logs: []*types.ReceiptLog{{Ty: pt.TyLogParaBlsGroupKey, Log: types.Encode(log)}},
+	}
+}
+
+func (a *action) blsGroupKey(key *pt.ParaBlsGroupKey) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.exec.GetMainHeight(), pt.ParaX, pt.ForkParaBlsThreshold) {
+		return nil, errors.Wrap(types.ErrNotAllow, "ForkParaBlsThreshold not reach")
+	}
+	if !types.IsSpecificParaExecName(key.Title, string(a.tx.Execer)) {
+		return nil, errors.Wrapf(pt.ErrInvalidTitle, "title=%s,exec=%s", key.Title, string(a.tx.Execer))
+	}
+	nodesMap, nodesArry, err := a.getNodesGroup(key.Title)
+	if err != nil {
+		return nil, errors.Wrap(err, "getNodesGroup")
+	}
+	if !validNode(a.fromaddr, nodesMap) {
+		return nil, errors.Wrapf(pt.ErrParaNodeAddrNotExisted, "invalid node=%s", a.fromaddr)
+	}
+	if key.Nodes != strings.Join(nodesArry, ",") {
+		return nil, errors.Wrapf(types.ErrInvalidParam, "key nodes=%s not nodegroup=%s", key.Nodes, strings.Join(nodesArry, ","))
+	}
+	err = checkBlsGroupKey(a.exec.cryptoCli, key, len(nodesArry))
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := getBlsGroupKey(a.db, key.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getBlsGroupKey title=%s", key.Title)
+	}
+	prev := proto.Clone(stat).(*pt.ParaBlsGroupKeyStatus)
+	//nodegroup变化后重新投票
+	if stat.Nodes != key.Nodes {
+		stat = &pt.ParaBlsGroupKeyStatus{Title: key.Title, Nodes: key.Nodes, Votes: &pt.ParaNodeVoteDetail{}}
+	}
+	vote := blsGroupKeyVote(key)
+	found, index := hasVoted(stat.Votes.Addrs, a.fromaddr)
+	if found {
+		stat.Votes.Votes[index] = vote
+	} else {
+		stat.Votes.Addrs = append(stat.Votes.Addrs, a.fromaddr)
+		stat.Votes.Votes = append(stat.Votes.Votes, vote)
+	}
+
+	var votes [][]byte
+	for _, v := range stat.Votes.Votes {
+		votes = append(votes, []byte(v))
+	}
+	most, mostVote := GetMostCommit(votes)
+	if isCommitDone(len(nodesArry), most) {
+		var done pt.ParaBlsGroupKey
+		data, err := common.FromHex(mostVote)
+		if err != nil {
+			return nil, errors.Wrapf(err, "vote=%s", mostVote)
+		}
+		err = types.Decode(data, &done)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decode vote=%s", mostVote)
+		}
+		if !bytes.Equal(done.GroupPubKey, stat.GroupPubKey) || done.Threshold != stat.Threshold {
+			stat.Threshold = done.Threshold
+			stat.GroupPubKey = done.GroupPubKey
+			stat.Height = a.height
+			clog.Info("paracross.blsGroupKey done", "title", key.Title, "nodes", key.Nodes, "threshold", stat.Threshold,
+				"groupKey", common.ToHex(stat.GroupPubKey))
+		}
+	}
+	return makeBlsGroupKeyReceipt(a.fromaddr, prev, stat), nil
+}
+
+//门限签名等同于nodegroup全部节点commit
+func (a *action) procBlsThresholdSign(nodesArry []string, commit *pt.ParacrossCommitAction) ([]string, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.exec.GetMainHeight(), pt.ParaX, pt.ForkParaBlsThreshold) {
+		return nil, errors.Wrap(types.ErrNotAllow, "ForkParaBlsThreshold not reach")
+	}
+	stat, err := getBlsGroupKey(a.db, commit.Status.Title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getBlsGroupKey title=%s", commit.Status.Title)
+	}
+	if len(stat.GroupPubKey) <= 0 || stat.Nodes != strings.Join(nodesArry, ",") {
+		return nil, errors.Wrapf(pt.ErrParaBlsGroupKeyNotActive, "key nodes=%s,nodegroup=%s", stat.Nodes, strings.Join(nodesArry, ","))
+	}
+	err = verifyBlsThresholdSign(a.exec.cryptoCli, stat.GroupPubKey, commit)
+	if err != nil {
+		clog.Error("paracross.Commit bls threshold sign verify", "nodes", nodesArry, "from", a.fromaddr)
+		return nil, err
+	}
+	return nodesArry, nil
+}
+
+func verifyBlsThresholdSign(cryptoCli crypto.Crypto, groupKey []byte, commit *pt.ParacrossCommitAction) error {
+	pub, err := cryptoCli.PubKeyFromBytes(groupKey)
+	if err != nil {
+		return errors.Wrapf(err, "DeserializePublicKey=%s", common.ToHex(groupKey))
+	}
+	sign, err := cryptoCli.SignatureFromBytes(commit.Bls.ThresholdSign)
+	if err != nil {
+		return errors.Wrapf(err, "DeserializeSignature,key=%s", common.ToHex(commit.Bls.ThresholdSign))
+	}
+	if !pub.VerifyBytes(types.Encode(commit.Status), sign) {
+		clog.Error("paracross.Commit bls threshold sign verify", "title", commit.Status.Title, "height", commit.Status.Height,
+			"sign", common.ToHex(commit.Bls.ThresholdSign))
+		return pt.ErrBlsSignVerify
+	}
+	return nil
+}
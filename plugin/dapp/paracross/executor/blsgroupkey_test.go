@@ -0,0 +1,162 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BlsGroupKeyTestSuite struct {
+	suite.Suite
+	stateDB  dbm.KV
+	mainCfg  *types.Chain33Config
+	exec     *Paracross
+	groupKey crypto.PrivKey
+}
+
+func TestBlsGroupKey(t *testing.T) {
+	suite.Run(t, new(BlsGroupKeyTestSuite))
+}
+
+func (suite *BlsGroupKeyTestSuite) SetupTest() {
+	suite.mainCfg = types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"test\"", 1))
+	suite.mainCfg.RegisterDappFork(pt.ParaX, pt.ForkParaBlsThreshold, 0)
+
+	suite.stateDB, _ = dbm.NewGoMemDB("state", "state", 1024)
+	suite.exec = newParacross().(*Paracross)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(suite.mainCfg, nil)
+	suite.exec.SetAPI(api)
+	suite.exec.SetStateDB(suite.stateDB)
+	suite.exec.SetEnv(MainBlockHeight, 0, 0)
+
+	suite.setNodeGroup(4)
+	var err error
+	suite.groupKey, err = suite.exec.cryptoCli.GenKey()
+	suite.Nil(err)
+}
+
+func (suite *BlsGroupKeyTestSuite) setNodeGroup(cnt int) {
+	nodeValue := makeNodeInfo(Title, Title, cnt)
+	suite.stateDB.Set(calcManageConfigNodesKey(Title), types.Encode(nodeValue))
+	suite.stateDB.Set(calcParaNodeGroupAddrsKey(Title), types.Encode(nodeValue))
+}
+
+func (suite *BlsGroupKeyTestSuite) nodeStr(cnt int) string {
+	var nodes []string
+	for _, n := range Nodes[:cnt] {
+		nodes = append(nodes, string(n))
+	}
+	return strings.Join(nodes, ",")
+}
+
+func (suite *BlsGroupKeyTestSuite) vote(privKey string, key *pt.ParaBlsGroupKey) (*types.Receipt, error) {
+	action := &pt.ParacrossAction{
+		Ty:    pt.ParacrossActionBlsGroupKey,
+		Value: &pt.ParacrossAction_BlsGroupKey{BlsGroupKey: key},
+	}
+	tx, err := types.CreateFormatTx(suite.mainCfg, Title+pt.ParaX, types.Encode(action))
+	suite.Nil(err)
+	tx, err = signTx(suite.Suite, tx, privKey)
+	suite.Nil(err)
+	receipt, err := newAction(suite.exec, tx).blsGroupKey(key)
+	if err == nil {
+		for _, kv := range receipt.KV {
+			suite.stateDB.Set(kv.Key, kv.Value)
+		}
+	}
+	return receipt, err
+}
+
+func (suite *BlsGroupKeyTestSuite) commitAction() *action {
+	tx, err := types.CreateFormatTx(suite.mainCfg, Title+pt.ParaX, nil)
+	suite.Nil(err)
+	tx, err = signTx(suite.Suite, tx, PrivKeyA)
+	suite.Nil(err)
+	return newAction(suite.exec, tx)
+}
+
+func (suite *BlsGroupKeyTestSuite) TestVote() {
+	key := &pt.ParaBlsGroupKey{Title: Title, Nodes: suite.nodeStr(4), Threshold: 3, GroupPubKey: suite.groupKey.PubKey().Bytes()}
+
+	//门限需要超过2/3
+	_, err := suite.vote(PrivKeyA, &pt.ParaBlsGroupKey{Title: Title, Nodes: key.Nodes, Threshold: 2, GroupPubKey: key.GroupPubKey})
+	suite.Equal(types.ErrInvalidParam, errors.Cause(err))
+	//nodes需要和当前nodegroup一致
+	_, err = suite.vote(PrivKeyA, &pt.ParaBlsGroupKey{Title: Title, Nodes: suite.nodeStr(3), Threshold: 3, GroupPubKey: key.GroupPubKey})
+	suite.Equal(types.ErrInvalidParam, errors.Cause(err))
+
+	receipt, err := suite.vote(PrivKeyA, key)
+	suite.Nil(err)
+	suite.Equal(int32(pt.TyLogParaBlsGroupKey), receipt.Logs[0].Ty)
+	_, err = suite.vote(PrivKeyB, key)
+	suite.Nil(err)
+	stat, err := getBlsGroupKey(suite.stateDB, Title)
+	suite.Nil(err)
+	suite.Equal(2, len(stat.Votes.Addrs))
+	suite.Nil(stat.GroupPubKey)
+
+	//重复投票只更新
+	_, err = suite.vote(PrivKeyB, key)
+	suite.Nil(err)
+	_, err = suite.vote(PrivKeyC, key)
+	suite.Nil(err)
+	stat, err = getBlsGroupKey(suite.stateDB, Title)
+	suite.Nil(err)
+	suite.Equal(3, len(stat.Votes.Addrs))
+	suite.Equal(key.GroupPubKey, stat.GroupPubKey)
+	suite.Equal(int32(3), stat.Threshold)
+
+	//nodegroup变化后重新投票
+	suite.setNodeGroup(3)
+	newKey := &pt.ParaBlsGroupKey{Title: Title, Nodes: suite.nodeStr(3), Threshold: 3, GroupPubKey: key.GroupPubKey}
+	_, err = suite.vote(PrivKeyA, newKey)
+	suite.Nil(err)
+	stat, err = getBlsGroupKey(suite.stateDB, Title)
+	suite.Nil(err)
+	suite.Equal(newKey.Nodes, stat.Nodes)
+	suite.Equal(1, len(stat.Votes.Addrs))
+	suite.Nil(stat.GroupPubKey)
+}
+
+func (suite *BlsGroupKeyTestSuite) TestThresholdSign() {
+	status := &pt.ParacrossNodeStatus{Title: Title, Height: 10, BlockHash: CurBlock}
+	commit := &pt.ParacrossCommitAction{Status: status, Bls: &pt.ParacrossCommitBlsInfo{}}
+	commit.Bls.ThresholdSign = suite.groupKey.Sign(types.Encode(status)).Bytes()
+	nodes := strings.Split(suite.nodeStr(4), ",")
+
+	//群公钥未生效
+	_, err := suite.commitAction().procBlsThresholdSign(nodes, commit)
+	suite.Equal(pt.ErrParaBlsGroupKeyNotActive, errors.Cause(err))
+
+	key := &pt.ParaBlsGroupKey{Title: Title, Nodes: suite.nodeStr(4), Threshold: 3, GroupPubKey: suite.groupKey.PubKey().Bytes()}
+	for _, priv := range []string{PrivKeyA, PrivKeyB, PrivKeyC} {
+		_, err = suite.vote(priv, key)
+		suite.Nil(err)
+	}
+	addrs, err := suite.commitAction().procBlsThresholdSign(nodes, commit)
+	suite.Nil(err)
+	suite.Equal(nodes, addrs)
+
+	//签名和status不一致
+	status.Height = 11
+	_, err = suite.commitAction().procBlsThresholdSign(nodes, commit)
+	suite.Equal(pt.ErrBlsSignVerify, errors.Cause(err))
+	status.Height = 10
+
+	//nodegroup变化后群公钥失效
+	_, err = suite.commitAction().procBlsThresholdSign(nodes[:3], commit)
+	suite.Equal(pt.ErrParaBlsGroupKeyNotActive, errors.Cause(err))
+}
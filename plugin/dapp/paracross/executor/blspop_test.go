@@ -0,0 +1,92 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strings"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+func genBlsKeyPop(t *testing.T) (string, string) {
+	drv := bls.Driver{}
+	priv, err := drv.GenKey()
+	assert.Nil(t, err)
+	pop, err := drv.GenPop(priv)
+	assert.Nil(t, err)
+	return common.ToHex(priv.PubKey().Bytes()), common.ToHex(pop.Bytes())
+}
+
+func TestVerifyBlsPop(t *testing.T) {
+	pub, pop := genBlsKeyPop(t)
+	assert.Nil(t, verifyBlsPop(pub, pop))
+
+	pub2, pop2 := genBlsKeyPop(t)
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(verifyBlsPop(pub, pop2)))
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(verifyBlsPop(pub2, "")))
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(verifyBlsPop("0x1234", pop)))
+}
+
+func TestNodeModifyBlsPop(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"test\"", 1))
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 1024)
+	exec := newParacross().(*Paracross)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetEnv(MainBlockHeight, 0, 0)
+
+	s := suite.Suite{}
+	s.SetT(t)
+	addr := string(Nodes[0])
+	pub, pop := genBlsKeyPop(t)
+	modify := func(config *pt.ParaNodeAddrConfig) error {
+		tx, err := types.CreateFormatTx(cfg, Title+pt.ParaX, nil)
+		assert.Nil(t, err)
+		tx, err = signTx(s, tx, PrivKeyA)
+		assert.Nil(t, err)
+		receipt, err := newAction(exec, tx).nodeModify(config)
+		if err == nil {
+			for _, kv := range receipt.KV {
+				stateDB.Set(kv.Key, kv.Value)
+			}
+		}
+		return err
+	}
+
+	//fork之前注册的公钥没有pop
+	stat := &pt.ParaNodeAddrIdStatus{Title: Title, Addr: addr, Status: pt.ParaApplyJoined, BlsPubKey: pub}
+	stateDB.Set(calcParaNodeAddrKey(Title, addr), types.Encode(stat))
+	assert.Nil(t, modify(&pt.ParaNodeAddrConfig{Title: Title, Op: pt.ParaOpModify, Addr: addr, BlsPubKey: pub}))
+	_, err := getAddrBlsPubKey(stateDB, Title, addr, true)
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(err))
+	key, err := getAddrBlsPubKey(stateDB, Title, addr, false)
+	assert.Nil(t, err)
+	assert.Equal(t, pub, key)
+
+	//fork之后需要有效的pop，已注册的公钥通过modify补充pop
+	cfg.RegisterDappFork(pt.ParaX, pt.ForkParaBlsPop, 0)
+	err = modify(&pt.ParaNodeAddrConfig{Title: Title, Op: pt.ParaOpModify, Addr: addr, BlsPubKey: pub})
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(err))
+	_, pop2 := genBlsKeyPop(t)
+	err = modify(&pt.ParaNodeAddrConfig{Title: Title, Op: pt.ParaOpModify, Addr: addr, BlsPubKey: pub, BlsPop: pop2})
+	assert.Equal(t, pt.ErrParaBlsPop, errors.Cause(err))
+
+	assert.Nil(t, modify(&pt.ParaNodeAddrConfig{Title: Title, Op: pt.ParaOpModify, Addr: addr, BlsPubKey: pub, BlsPop: pop}))
+	key, err = getAddrBlsPubKey(stateDB, Title, addr, true)
+	assert.Nil(t, err)
+	assert.Equal(t, pub, key)
+}
@@ -125,3 +125,15 @@ func (e *Paracross) Exec_ParaBindMiner(payload *pt.ParaBindMinerCmd, tx *types.T
 	a := newAction(e, tx)
 	return a.bindMiner(payload)
 }
+
+//Exec_BlsGroupKey nodegroup bls threshold group key vote
+func (e *Paracross) Exec_BlsGroupKey(payload *pt.ParaBlsGroupKey, tx *types.Transaction, index int) (*types.Receipt, error) {
+	a := newAction(e, tx)
+	return a.blsGroupKey(payload)
+}
+
+//Exec_CrossInRefund refund para to para transfer not delivered in time
+func (e *Paracross) Exec_CrossInRefund(payload *pt.ParaCrossInRefund, tx *types.Transaction, index int) (*types.Receipt, error) {
+	a := newAction(e, tx)
+	return a.crossInRefund(payload)
+}
@@ -41,6 +41,12 @@ func (e *Paracross) ExecDelLocal_Commit(payload *pt.ParacrossCommitAction, tx *t
 				set.KV = append(set.KV, r.KV...)
 			}
 
+		} else if log.Ty == pt.TyLogParaCrossInDeliver {
+			kvs, err := e.updateLocalParaCrossIns(log, true)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
 		} else if log.Ty == pt.TyLogParacrossCommitRecord {
 			var g pt.ReceiptParacrossRecord
 			types.Decode(log.Log, &g)
@@ -98,6 +104,12 @@ func (e *Paracross) ExecDelLocal_NodeConfig(payload *pt.ParaNodeAddrConfig, tx *
 				set.KV = append(set.KV, r.KV...)
 			}
 
+		} else if log.Ty == pt.TyLogParaCrossInDeliver {
+			kvs, err := e.updateLocalParaCrossIns(log, true)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
 		}
 	}
 	return &set, nil
@@ -179,6 +191,15 @@ func (e *Paracross) ExecDelLocal_Miner(payload *pt.ParacrossMinerAction, tx *typ
 
 	var set types.LocalDBSet
 	set.KV = append(set.KV, &types.KeyValue{Key: pt.CalcMinerHeightKey(payload.Status.Title, payload.Status.Height), Value: nil})
+	for _, log := range receiptData.Logs {
+		if log.Ty == pt.TyLogParaCrossIn {
+			kvs, err := e.initLocalParaCrossIns(log, payload.Status, true)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
+		}
+	}
 
 	return &set, nil
 }
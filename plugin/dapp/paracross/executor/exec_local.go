@@ -44,6 +44,12 @@ func (e *Paracross) ExecLocal_Commit(payload *pt.ParacrossCommitAction, tx *type
 				set.KV = append(set.KV, r.KV...)
 			}
 
+		} else if log.Ty == pt.TyLogParaCrossInDeliver {
+			kvs, err := e.updateLocalParaCrossIns(log, false)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
 		} else if log.Ty == pt.TyLogParacrossCommitRecord {
 			var g pt.ReceiptParacrossRecord
 			types.Decode(log.Log, &g)
@@ -100,6 +106,12 @@ func (e *Paracross) ExecLocal_NodeConfig(payload *pt.ParaNodeAddrConfig, tx *typ
 				set.KV = append(set.KV, r.KV...)
 			}
 
+		} else if log.Ty == pt.TyLogParaCrossInDeliver {
+			kvs, err := e.updateLocalParaCrossIns(log, false)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
 		}
 	}
 	return &set, nil
@@ -300,6 +312,16 @@ func (e *Paracross) ExecLocal_Miner(payload *pt.ParacrossMinerAction, tx *types.
 		Key:   pt.CalcMinerHeightKey(payload.Status.Title, payload.Status.Height),
 		Value: types.Encode(payload.Status)})
 
+	for _, log := range receiptData.Logs {
+		if log.Ty == pt.TyLogParaCrossIn {
+			kvs, err := e.initLocalParaCrossIns(log, payload.Status, false)
+			if err != nil {
+				return nil, err
+			}
+			set.KV = append(set.KV, kvs...)
+		}
+	}
+
 	return &set, nil
 }
 
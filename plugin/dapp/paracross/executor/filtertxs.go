@@ -185,3 +185,32 @@ func CalcTxHashsHash(txHashs [][]byte) []byte {
 	data := types.Encode(totalTxHash)
 	return common.Sha256(data)
 }
+
+//FilterParaCrossIns 过滤主链区块中投递给本平行链的其他平行链转入资产
+func FilterParaCrossIns(cfg *types.Chain33Config, main *types.ParaTxDetail) []*pt.ParaCrossIn {
+	if !cfg.IsDappFork(main.Header.Height, pt.ParaX, pt.ForkParaCrossParaTransfer) {
+		return nil
+	}
+	var items []*pt.ParaCrossIn
+	for _, detail := range main.TxDetails {
+		if detail.Receipt == nil || detail.Receipt.Ty != types.ExecOk || !cfg.IsMyParaExecName(string(detail.Tx.Execer)) ||
+			!bytes.HasSuffix(detail.Tx.Execer, []byte(pt.ParaX)) {
+			continue
+		}
+		for _, log := range detail.Receipt.Logs {
+			if log.Ty != pt.TyLogParaCrossInDeliver {
+				continue
+			}
+			var rcpt pt.ReceiptParaCrossIn
+			err := types.Decode(log.Log, &rcpt)
+			if err != nil {
+				clog.Error("FilterParaCrossIns decode", "txhash", hex.EncodeToString(detail.Tx.Hash()), "err", err)
+				continue
+			}
+			if rcpt.Title == cfg.GetTitle() {
+				items = append(items, rcpt.Items...)
+			}
+		}
+	}
+	return items
+}
@@ -38,6 +38,12 @@ var (
 
 	paraBindMinderAddr string
 	paraBindMinderNode string
+
+	paraCrossInPrefix      string
+	paraCrossInQueuePrefix string
+	paraCrossInSeqPrefix   string
+
+	paraBlsGroupKeyPrefix string
 )
 
 func setPrefix() {
@@ -57,6 +63,14 @@ func setPrefix() {
 	paraBindMinderAddr = "mavl-paracross-bindmineraddr-"
 	paraBindMinderNode = "mavl-paracross-bindminernode-"
 
+	//平行链之间转移，待投递到目标平行链的资产，按转出交易hash保存，目标平行链的队列只记录序号到交易hash
+	paraCrossInPrefix = "mavl-paracross-crossin-"
+	paraCrossInQueuePrefix = "mavl-paracross-crossinqueue-"
+	paraCrossInSeqPrefix = "mavl-paracross-crossinseq-"
+
+	//nodegroup门限bls签名群公钥
+	paraBlsGroupKeyPrefix = "mavl-paracross-blsgroupkey-"
+
 	localTx = "LODB-paracross-titleHeightAddr-"
 	localTitle = "LODB-paracross-title-"
 	localTitleHeight = "LODB-paracross-titleHeight-"
@@ -195,3 +209,19 @@ func calcParaBindMinerAddr(node, bind string) []byte {
 func calcParaBindMinerNode() []byte {
 	return []byte(paraBindMinderNode)
 }
+
+func calcParaCrossInKey(txHash string) []byte {
+	return []byte(fmt.Sprintf(paraCrossInPrefix+"%s", txHash))
+}
+
+func calcParaCrossInQueueKey(title string) []byte {
+	return []byte(fmt.Sprintf(paraCrossInQueuePrefix+"%s", title))
+}
+
+func calcParaCrossInSeqKey(title string, seq int64) []byte {
+	return []byte(fmt.Sprintf(paraCrossInSeqPrefix+"%s-%020d", title, seq))
+}
+
+func calcParaBlsGroupKey(title string) []byte {
+	return []byte(fmt.Sprintf(paraBlsGroupKeyPrefix+"%s", title))
+}
@@ -237,14 +237,15 @@ func (c *Paracross) getCrossAssetTransferInfo(payload *pt.CrossAssetTransfer, tx
 	}
 
 	asset := &pt.ParacrossAsset{
-		From:       tx.From(),
-		To:         tx.To,
-		IsWithdraw: isWithDraw,
-		Amount:     amount,
-		TxHash:     common.ToHex(tx.Hash()),
-		Height:     c.GetHeight(),
-		Exec:       exec,
-		Symbol:     symbol,
+		From:        tx.From(),
+		To:          tx.To,
+		IsWithdraw:  isWithDraw,
+		Amount:      amount,
+		TxHash:      common.ToHex(tx.Hash()),
+		Height:      c.GetHeight(),
+		Exec:        exec,
+		Symbol:      symbol,
+		ToParaTitle: payload.ToParaTitle,
 	}
 	return asset, nil
 }
@@ -393,3 +394,80 @@ func (c *Paracross) allowIsParaTx(execer []byte) bool {
 func (c *Paracross) CheckReceiptExecOk() bool {
 	return true
 }
+
+//主链投递到目标平行链后更新源平行链转出记录
+func (c *Paracross) updateLocalParaCrossIn(item *pt.ParaCrossIn, isDel bool) (*types.KeyValue, error) {
+	hash, err := common.FromHex(item.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	key := calcLocalAssetKey(hash)
+	v, err := c.GetLocalDB().Get(key)
+	if err != nil {
+		return nil, err
+	}
+	var asset pt.ParacrossAsset
+	err = types.Decode(v, &asset)
+	if err != nil {
+		return nil, err
+	}
+	asset.CrossInHeight = c.GetHeight()
+	if isDel {
+		asset.CrossInHeight = 0
+	}
+	c.GetLocalDB().Set(key, types.Encode(&asset))
+	return &types.KeyValue{Key: key, Value: types.Encode(&asset)}, nil
+}
+
+func (c *Paracross) updateLocalParaCrossIns(log *types.ReceiptLog, isDel bool) ([]*types.KeyValue, error) {
+	var g pt.ReceiptParaCrossIn
+	err := types.Decode(log.Log, &g)
+	if err != nil {
+		return nil, err
+	}
+	var kvs []*types.KeyValue
+	for _, item := range g.Items {
+		kv, err := c.updateLocalParaCrossIn(item, isDel)
+		if err != nil {
+			clog.Error("updateLocalParaCrossIns", "title", g.Title, "txHash", item.TxHash, "err", err)
+			continue
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, nil
+}
+
+//目标平行链记录转入的资产
+func (c *Paracross) initLocalParaCrossIns(log *types.ReceiptLog, status *pt.ParacrossNodeStatus, isDel bool) ([]*types.KeyValue, error) {
+	var g pt.ReceiptParaCrossIn
+	err := types.Decode(log.Log, &g)
+	if err != nil {
+		return nil, err
+	}
+	var kvs []*types.KeyValue
+	for _, item := range g.Items {
+		hash, err := common.FromHex(item.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		key := calcLocalAssetKey(hash)
+		if isDel {
+			kvs = append(kvs, &types.KeyValue{Key: key, Value: nil})
+			continue
+		}
+		asset := &pt.ParacrossAsset{
+			From:          item.From,
+			To:            item.ToAddr,
+			Amount:        item.Amount,
+			TxHash:        item.TxHash,
+			Exec:          item.AssetExec,
+			Symbol:        item.AssetSymbol,
+			ToParaTitle:   g.Title,
+			ParaHeight:    status.Height,
+			Success:       true,
+			CrossInHeight: status.MainBlockHeight,
+		}
+		kvs = append(kvs, &types.KeyValue{Key: key, Value: types.Encode(asset)})
+	}
+	return kvs, nil
+}
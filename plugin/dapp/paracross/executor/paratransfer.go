@@ -0,0 +1,302 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+)
+
+/*
+平行链之间资产直接转移(ForkParaCrossParaTransfer)：
+1. 源平行链user.p.A.上发送user.p.A.paracross的CrossAssetTransfer交易，toParaTitle设置为目标平行链user.p.B.，
+   源平行链上和普通跨链转出执行一致，主链资产withdraw时销毁，平行链资产transfer到paracross合约
+2. 主链在A共识后处理跨链交易时，资产不转给toAddr，而是转入B在主链上的托管地址addr(user.p.B.paracross)，
+   和主链资产转入B的托管方式一致，同时把转入记录按转出交易hash保存，并在B的待投递队列中记录序号
+3. 主链在B共识完成时按序投递队列中的记录，每次最多paraCrossInDeliverMax个，产生TyLogParaCrossInDeliver日志
+4. 平行链B从主链区块中过滤出投递日志，放入miner交易，miner交易执行时给toAddr铸造对应的paracross资产
+5. 源平行链执行失败时，主链不处理，资产仍在源平行链上；主链路由时B不能接收跨链资产的，资产退回主链上的from地址
+6. 路由后超过paraCrossInTimeout个主链区块仍未投递的(如B长时间没有共识)，from地址可以发送CrossInRefund交易，
+   主链把资产从B的托管地址退回主链上的from地址，之后的投递会跳过该记录
+
+资产在主链上的表示：
+				源平行链资产								主链资产(目标平行链paracross资产的exec+symbol)
+主链资产：		user.p.A.paracross+coins.bty					coins+bty
+平行链资产：	user.p.A.coins+fzm								paracross+user.p.A.coins.fzm
+其他平行链资产：	user.p.A.paracross+paracross.user.p.C.coins.fzm	paracross+user.p.C.coins.fzm
+目标平行链B的原生资产需要直接withdraw回B，不支持此方式转移
+*/
+
+const (
+	//一次共识最多处理的待投递序号
+	paraCrossInDeliverMax = 100
+	//路由后超过该主链高度仍未投递的可以退回
+	paraCrossInTimeout = 10000
+)
+
+func (a *action) isParaCrossTransfer(transfer *pt.CrossAssetTransfer) bool {
+	cfg := a.api.GetConfig()
+	return len(transfer.ToParaTitle) > 0 && cfg.IsDappFork(a.exec.GetMainHeight(), pt.ParaX, pt.ForkParaCrossParaTransfer)
+}
+
+//获取平行链之间转移的资产在主链上的exec和symbol
+func getParaCrossMainAsset(transfer *pt.CrossAssetTransfer, act int64, fromTitle string) (string, string, error) {
+	newTransfer, err := amendTransferParam(transfer, act)
+	if err != nil {
+		return "", "", err
+	}
+	if act == pt.ParacrossParaAssetTransfer {
+		return pt.ParaX, fromTitle + newTransfer.AssetExec + "." + newTransfer.AssetSymbol, nil
+	}
+	return newTransfer.AssetExec, newTransfer.AssetSymbol, nil
+}
+
+func (a *action) checkParaCrossTransfer(transfer *pt.CrossAssetTransfer, act int64, txExecer string) error {
+	fromTitle, ok := types.GetParaExecTitleName(txExecer)
+	if !ok {
+		return errors.Wrapf(types.ErrInvalidParam, "not para chain exec=%s", txExecer)
+	}
+	toTitle, ok := types.GetParaExecTitleName(transfer.ToParaTitle)
+	if !ok || toTitle != transfer.ToParaTitle || toTitle == fromTitle {
+		return errors.Wrapf(pt.ErrParaCrossTitle, "from=%s,to=%s", fromTitle, transfer.ToParaTitle)
+	}
+	if act != pt.ParacrossMainAssetWithdraw && act != pt.ParacrossParaAssetTransfer {
+		return errors.Wrapf(types.ErrNotSupport, "para to para transfer act=%d", act)
+	}
+	exec, symbol, err := getParaCrossMainAsset(transfer, act, fromTitle)
+	if err != nil {
+		return err
+	}
+	if exec == pt.ParaX && strings.HasPrefix(symbol, toTitle) {
+		return errors.Wrapf(types.ErrNotSupport, "asset symbol=%s belong to title=%s, should withdraw", symbol, toTitle)
+	}
+	return address.CheckAddress(transfer.ToAddr)
+}
+
+func getParaCrossIn(db dbm.KV, txHash string) (*pt.ParaCrossIn, error) {
+	val, err := db.Get(calcParaCrossInKey(txHash))
+	if err != nil {
+		return nil, err
+	}
+	var item pt.ParaCrossIn
+	err = types.Decode(val, &item)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func getParaCrossInQueue(db dbm.KV, title string) (*pt.ParaCrossInQueue, error) {
+	var queue pt.ParaCrossInQueue
+	val, err := db.Get(calcParaCrossInQueueKey(title))
+	if err != nil {
+		if isNotFound(err) {
+			return &queue, nil
+		}
+		return nil, err
+	}
+	err = types.Decode(val, &queue)
+	if err != nil {
+		return nil, err
+	}
+	return &queue, nil
+}
+
+//目标平行链队列中待投递的记录，不包括已退回的
+func getParaCrossIns(db dbm.KV, title string) ([]*pt.ParaCrossIn, error) {
+	queue, err := getParaCrossInQueue(db, title)
+	if err != nil {
+		return nil, err
+	}
+	var items []*pt.ParaCrossIn
+	for seq := queue.Head; seq < queue.Tail; seq++ {
+		item, err := getParaCrossInBySeq(db, title, seq)
+		if err != nil {
+			return nil, err
+		}
+		if item.Status == pt.ParaCrossInPending {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func getParaCrossInBySeq(db dbm.KV, title string, seq int64) (*pt.ParaCrossIn, error) {
+	txHash, err := db.Get(calcParaCrossInSeqKey(title, seq))
+	if err != nil {
+		return nil, errors.Wrapf(err, "getParaCrossInBySeq title=%s,seq=%d", title, seq)
+	}
+	return getParaCrossIn(db, string(txHash))
+}
+
+func setParaCrossIn(db dbm.KV, item *pt.ParaCrossIn) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcParaCrossInKey(item.TxHash), Value: types.Encode(item)}
+	db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func setParaCrossInQueue(db dbm.KV, title string, queue *pt.ParaCrossInQueue) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcParaCrossInQueueKey(title), Value: types.Encode(queue)}
+	db.Set(kv.Key, kv.Value)
+	return kv
+}
+
+func makeParaCrossInReceipt(kvs []*types.KeyValue, title string, ty int32, items []*pt.ParaCrossIn) *types.Receipt {
+	log := &pt.ReceiptParaCrossIn{Title: title, Items: items}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   kvs,
+		Logs: []*types.ReceiptLog{{Ty: ty, Log: types.Encode(log)}},
+	}
+}
+
+//主链共识后把资产转到目标平行链托管地址，并加入目标平行链待投递队列，目标平行链不能接收时退回from地址
+func (a *action) routeParaCrossTransfer(transfer *pt.CrossAssetTransfer, act int64, crossTx *types.Transaction) (*types.Receipt, error) {
+	fromTitle, _ := types.GetParaExecTitleName(string(crossTx.Execer))
+	route := *transfer
+	err := a.checkParaCrossTransfer(transfer, act, string(crossTx.Execer))
+	if err == nil {
+		err = a.isAllowTitleTransfer(transfer.ToParaTitle)
+	}
+	if err != nil {
+		clog.Error("paracross.routeParaCrossTransfer refund", "from", fromTitle, "to", transfer.ToParaTitle,
+			"txHash", common.ToHex(crossTx.Hash()), "err", err)
+		route.ToAddr = crossTx.From()
+		return a.crossAssetTransfer(&route, act, crossTx)
+	}
+
+	exec, symbol, err := getParaCrossMainAsset(transfer, act, fromTitle)
+	if err != nil {
+		return nil, err
+	}
+	route.ToAddr = address.ExecAddress(transfer.ToParaTitle + pt.ParaX)
+	receipt, err := a.crossAssetTransfer(&route, act, crossTx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "routeParaCrossTransfer to=%s", transfer.ToParaTitle)
+	}
+
+	item := &pt.ParaCrossIn{
+		TxHash:      common.ToHex(crossTx.Hash()),
+		FromTitle:   fromTitle,
+		From:        crossTx.From(),
+		ToAddr:      transfer.ToAddr,
+		AssetExec:   exec,
+		AssetSymbol: symbol,
+		Amount:      transfer.Amount,
+		RouteHeight: a.height,
+		Status:      pt.ParaCrossInPending,
+		ToTitle:     transfer.ToParaTitle,
+	}
+	queue, err := getParaCrossInQueue(a.db, transfer.ToParaTitle)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getParaCrossInQueue title=%s", transfer.ToParaTitle)
+	}
+	seqKV := &types.KeyValue{Key: calcParaCrossInSeqKey(transfer.ToParaTitle, queue.Tail), Value: []byte(item.TxHash)}
+	a.db.Set(seqKV.Key, seqKV.Value)
+	queue.Tail++
+	kvs := []*types.KeyValue{setParaCrossIn(a.db, item), seqKV, setParaCrossInQueue(a.db, transfer.ToParaTitle, queue)}
+	clog.Debug("paracross.routeParaCrossTransfer", "from", fromTitle, "to", transfer.ToParaTitle, "exec", exec, "symbol", symbol,
+		"txHash", item.TxHash, "seq", queue.Tail-1)
+	return mergeReceipt(receipt, makeParaCrossInReceipt(kvs, transfer.ToParaTitle, pt.TyLogParaCrossRoute, []*pt.ParaCrossIn{item})), nil
+}
+
+//目标平行链共识完成时按序投递待转入记录，一次最多处理paraCrossInDeliverMax个序号，已退回的跳过
+func (a *action) deliverParaCrossIns(title string) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, pt.ParaX, pt.ForkParaCrossParaTransfer) || !types.IsSpecificParaExecName(title, string(a.tx.Execer)) {
+		return nil, nil
+	}
+	queue, err := getParaCrossInQueue(a.db, title)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getParaCrossInQueue title=%s", title)
+	}
+	if queue.Head >= queue.Tail {
+		return nil, nil
+	}
+
+	var kvs []*types.KeyValue
+	var items []*pt.ParaCrossIn
+	for visited := 0; queue.Head < queue.Tail && visited < paraCrossInDeliverMax; visited++ {
+		item, err := getParaCrossInBySeq(a.db, title, queue.Head)
+		if err != nil {
+			return nil, err
+		}
+		queue.Head++
+		if item.Status != pt.ParaCrossInPending {
+			continue
+		}
+		item.Status = pt.ParaCrossInDelivered
+		kvs = append(kvs, setParaCrossIn(a.db, item))
+		items = append(items, item)
+	}
+	kvs = append(kvs, setParaCrossInQueue(a.db, title, queue))
+	clog.Debug("paracross.deliverParaCrossIns", "title", title, "count", len(items), "head", queue.Head, "tail", queue.Tail)
+	return makeParaCrossInReceipt(kvs, title, pt.TyLogParaCrossInDeliver, items), nil
+}
+
+//目标平行链超过paraCrossInTimeout个主链区块仍未投递，发送地址在主链取回托管的资产，
+//和平行链执行失败时assetTransferRollback回滚一样，资产从托管地址退回主链上的from地址
+func (a *action) crossInRefund(refund *pt.ParaCrossInRefund) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if cfg.IsPara() || !cfg.IsDappFork(a.height, pt.ParaX, pt.ForkParaCrossParaTransfer) {
+		return nil, types.ErrNotSupport
+	}
+	item, err := getParaCrossIn(a.db, refund.TxHash)
+	if err != nil {
+		return nil, errors.Wrapf(err, "getParaCrossIn txHash=%s", refund.TxHash)
+	}
+	if a.fromaddr != item.From {
+		return nil, errors.Wrapf(types.ErrFromAddr, "from=%s,tx.from=%s", item.From, a.fromaddr)
+	}
+	if item.Status != pt.ParaCrossInPending || a.height-item.RouteHeight < paraCrossInTimeout {
+		return nil, errors.Wrapf(pt.ErrParaCrossInRefund, "status=%d,routeHeight=%d", item.Status, item.RouteHeight)
+	}
+	title := item.ToTitle
+	accDB, err := a.createAccount(cfg, a.db, item.AssetExec, item.AssetSymbol)
+	if err != nil {
+		return nil, errors.Wrapf(err, "crossInRefund.createAccount,exec=%s,symbol=%s", item.AssetExec, item.AssetSymbol)
+	}
+	custody := address.ExecAddress(title + pt.ParaX)
+	var receipt *types.Receipt
+	//paracross执行器下的资产路由时直接转到托管地址，其他资产在paracross执行器下
+	if item.AssetExec == pt.ParaX {
+		receipt, err = accDB.Transfer(custody, item.From, item.Amount)
+	} else {
+		receipt, err = accDB.ExecTransfer(custody, item.From, address.ExecAddress(pt.ParaX), item.Amount)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "crossInRefund,txHash=%s", item.TxHash)
+	}
+
+	item.Status = pt.ParaCrossInRefunded
+	clog.Debug("paracross.crossInRefund", "title", title, "txHash", item.TxHash, "curTx", common.ToHex(a.tx.Hash()))
+	return mergeReceipt(receipt, makeParaCrossInReceipt([]*types.KeyValue{setParaCrossIn(a.db, item)}, title, pt.TyLogParaCrossInRefund, []*pt.ParaCrossIn{item})), nil
+}
+
+//平行链miner交易给目标地址铸造主链投递过来的资产
+func (a *action) creditParaCrossIns(items []*pt.ParaCrossIn) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	for _, item := range items {
+		paraAcc, err := NewParaAccount(cfg, cfg.GetTitle(), item.AssetExec, item.AssetSymbol, a.db)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creditParaCrossIns,exec=%s,symbol=%s", item.AssetExec, item.AssetSymbol)
+		}
+		r, err := assetDepositBalance(paraAcc, item.ToAddr, item.Amount)
+		if err != nil {
+			return nil, errors.Wrapf(err, "creditParaCrossIns,txHash=%s", item.TxHash)
+		}
+		receipt = mergeReceipt(receipt, r)
+	}
+	log := &pt.ReceiptParaCrossIn{Title: cfg.GetTitle(), Items: items}
+	receipt.Logs = append(receipt.Logs, &types.ReceiptLog{Ty: pt.TyLogParaCrossIn, Log: types.Encode(log)})
+	return receipt, nil
+}
@@ -0,0 +1,252 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/paracross/testnode"
+	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// 平行链user.p.test1.转移到平行链user.p.test.(Title)
+//    主链路由、投递， 目标平行链挖矿交易转入
+
+var (
+	fromParaTitle = "user.p.test1."
+)
+
+type ParaCrossTransferTestSuite struct {
+	suite.Suite
+	stateDB dbm.KV
+	mainCfg *types.Chain33Config
+	paraCfg *types.Chain33Config
+	exec    *Paracross
+}
+
+func TestParaCrossTransfer(t *testing.T) {
+	suite.Run(t, new(ParaCrossTransferTestSuite))
+}
+
+func (suite *ParaCrossTransferTestSuite) SetupTest() {
+	suite.mainCfg = types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"test\"", 1))
+	suite.mainCfg.RegisterDappFork(pt.ParaX, pt.ForkParaCrossParaTransfer, 0)
+	suite.paraCfg = types.NewChain33Config(testnode.DefaultConfig)
+	suite.paraCfg.RegisterDappFork(pt.ParaX, pt.ForkParaCrossParaTransfer, 0)
+
+	suite.stateDB, _ = dbm.NewGoMemDB("state", "state", 1024)
+	suite.exec = newParacross().(*Paracross)
+	suite.setConfig(suite.mainCfg)
+	suite.exec.SetStateDB(suite.stateDB)
+	suite.exec.SetEnv(MainBlockHeight, 0, 0)
+
+	// 目标平行链已创建nodegroup
+	nodeValue := makeNodeInfo(Title, Title, 1)
+	suite.stateDB.Set(calcManageConfigNodesKey(Title), types.Encode(nodeValue))
+	suite.stateDB.Set(calcParaNodeGroupAddrsKey(Title), types.Encode(nodeValue))
+}
+
+func (suite *ParaCrossTransferTestSuite) setConfig(cfg *types.Chain33Config) {
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	suite.exec.SetAPI(api)
+}
+
+func (suite *ParaCrossTransferTestSuite) createCrossTx(execer string, transfer *pt.CrossAssetTransfer) *types.Transaction {
+	action := &pt.ParacrossAction{
+		Ty:    pt.ParacrossActionCrossAssetTransfer,
+		Value: &pt.ParacrossAction_CrossAssetTransfer{CrossAssetTransfer: transfer},
+	}
+	tx, err := types.CreateFormatTx(suite.mainCfg, execer, types.Encode(action))
+	suite.Nil(err)
+	tx, err = signTx(suite.Suite, tx, PrivKeyA)
+	suite.Nil(err)
+	return tx
+}
+
+func (suite *ParaCrossTransferTestSuite) refundTx(crossTx *types.Transaction) *types.Transaction {
+	tx, err := pt.CreateRawCrossInRefundTx4MainChain(suite.mainCfg, &pt.ParaCrossInRefund{TxHash: common.ToHex(crossTx.Hash())}, 0)
+	suite.Nil(err)
+	tx, err = signTx(suite.Suite, tx, PrivKeyA)
+	suite.Nil(err)
+	return tx
+}
+
+func (suite *ParaCrossTransferTestSuite) paraAssetTransfer(toTitle string) *pt.CrossAssetTransfer {
+	return &pt.CrossAssetTransfer{
+		AssetExec:   fromParaTitle + "coins",
+		AssetSymbol: "fzm",
+		Amount:      Amount,
+		ToAddr:      string(Nodes[1]),
+		ToParaTitle: toTitle,
+	}
+}
+
+func (suite *ParaCrossTransferTestSuite) TestCheck() {
+	a := newAction(suite.exec, suite.createCrossTx(fromParaTitle+pt.ParaX, suite.paraAssetTransfer(Title)))
+	execer := fromParaTitle + pt.ParaX
+
+	transfer := suite.paraAssetTransfer(Title)
+	suite.Nil(a.checkParaCrossTransfer(transfer, pt.ParacrossParaAssetTransfer, execer))
+
+	transfer = suite.paraAssetTransfer(fromParaTitle)
+	suite.Equal(pt.ErrParaCrossTitle, errors.Cause(a.checkParaCrossTransfer(transfer, pt.ParacrossParaAssetTransfer, execer)))
+	transfer = suite.paraAssetTransfer("user.p.test")
+	suite.Equal(pt.ErrParaCrossTitle, errors.Cause(a.checkParaCrossTransfer(transfer, pt.ParacrossParaAssetTransfer, execer)))
+
+	// 目标平行链原生资产需要withdraw
+	transfer = &pt.CrossAssetTransfer{AssetExec: fromParaTitle + pt.ParaX, AssetSymbol: "paracross." + Title + "coins.cny",
+		Amount: Amount, ToAddr: string(Nodes[1]), ToParaTitle: Title}
+	suite.Equal(types.ErrNotSupport, errors.Cause(a.checkParaCrossTransfer(transfer, pt.ParacrossMainAssetWithdraw, execer)))
+}
+
+func (suite *ParaCrossTransferTestSuite) TestRouteDeliverCredit() {
+	crossTx := suite.createCrossTx(fromParaTitle+pt.ParaX, suite.paraAssetTransfer(Title))
+	a := newAction(suite.exec, crossTx)
+
+	// 主链路由到目标平行链托管地址
+	receipt, err := a.routeParaCrossTransfer(suite.paraAssetTransfer(Title), pt.ParacrossParaAssetTransfer, crossTx)
+	suite.Nil(err)
+	suite.Equal(int32(pt.TyLogParaCrossRoute), receipt.Logs[len(receipt.Logs)-1].Ty)
+	mainAcc, err := NewParaAccount(suite.mainCfg, fromParaTitle, fromParaTitle+"coins", "fzm", suite.stateDB)
+	suite.Nil(err)
+	suite.Equal(Amount, mainAcc.LoadAccount(address.ExecAddress(Title+pt.ParaX)).Balance)
+	suite.Equal(int64(0), mainAcc.LoadAccount(string(Nodes[1])).Balance)
+
+	ins, err := getParaCrossIns(suite.stateDB, Title)
+	suite.Nil(err)
+	suite.Equal(1, len(ins))
+	suite.Equal(pt.ParaX, ins[0].AssetExec)
+	suite.Equal(fromParaTitle+"coins.fzm", ins[0].AssetSymbol)
+	suite.Equal(common.ToHex(crossTx.Hash()), ins[0].TxHash)
+
+	// 非目标平行链的交易不投递
+	titleTx, err := pt.CreateRawMinerTx(suite.paraCfg, &pt.ParacrossMinerAction{Status: &pt.ParacrossNodeStatus{Title: Title}})
+	suite.Nil(err)
+	receipt, err = newAction(suite.exec, crossTx).deliverParaCrossIns(Title)
+	suite.Nil(err)
+	suite.Nil(receipt)
+
+	receipt, err = newAction(suite.exec, titleTx).deliverParaCrossIns(Title)
+	suite.Nil(err)
+	suite.Equal(int32(pt.TyLogParaCrossInDeliver), receipt.Logs[0].Ty)
+	ins, err = getParaCrossIns(suite.stateDB, Title)
+	suite.Nil(err)
+	suite.Equal(0, len(ins))
+	// 已投递的不能再退回
+	suite.exec.SetEnv(MainBlockHeight+paraCrossInTimeout, 0, 0)
+	_, err = newAction(suite.exec, suite.refundTx(crossTx)).crossInRefund(&pt.ParaCrossInRefund{TxHash: common.ToHex(crossTx.Hash())})
+	suite.Equal(pt.ErrParaCrossInRefund, errors.Cause(err))
+	suite.exec.SetEnv(MainBlockHeight, 0, 0)
+
+	// 目标平行链从主链区块过滤出投递记录
+	main := &types.ParaTxDetail{
+		Header: &types.Header{Height: MainBlockHeight},
+		TxDetails: []*types.TxDetail{
+			{Tx: crossTx, Receipt: &types.ReceiptData{Ty: types.ExecPack}},
+			{Tx: titleTx, Receipt: &types.ReceiptData{Ty: types.ExecOk, Logs: receipt.Logs}},
+		},
+	}
+	items := FilterParaCrossIns(suite.paraCfg, main)
+	suite.Equal(1, len(items))
+	suite.Equal(0, len(FilterParaCrossIns(suite.mainCfg, main)))
+
+	// 目标平行链挖矿交易铸造资产
+	suite.setConfig(suite.paraCfg)
+	receipt, err = newAction(suite.exec, titleTx).creditParaCrossIns(items)
+	suite.Nil(err)
+	suite.Equal(int32(pt.TyLogParaCrossIn), receipt.Logs[len(receipt.Logs)-1].Ty)
+	paraAcc, err := NewParaAccount(suite.paraCfg, Title, pt.ParaX, fromParaTitle+"coins.fzm", suite.stateDB)
+	suite.Nil(err)
+	suite.Equal(Amount, paraAcc.LoadAccount(string(Nodes[1])).Balance)
+}
+
+func (suite *ParaCrossTransferTestSuite) TestRouteRefund() {
+	toTitle := "user.p.test2."
+	crossTx := suite.createCrossTx(fromParaTitle+pt.ParaX, suite.paraAssetTransfer(toTitle))
+	a := newAction(suite.exec, crossTx)
+
+	// 目标平行链没有nodegroup，退回主链上的发送地址
+	_, err := a.routeParaCrossTransfer(suite.paraAssetTransfer(toTitle), pt.ParacrossParaAssetTransfer, crossTx)
+	suite.Nil(err)
+	mainAcc, err := NewParaAccount(suite.mainCfg, fromParaTitle, fromParaTitle+"coins", "fzm", suite.stateDB)
+	suite.Nil(err)
+	suite.Equal(Amount, mainAcc.LoadAccount(crossTx.From()).Balance)
+	suite.Equal(int64(0), mainAcc.LoadAccount(address.ExecAddress(toTitle+pt.ParaX)).Balance)
+
+	ins, err := getParaCrossIns(suite.stateDB, toTitle)
+	suite.Nil(err)
+	suite.Equal(0, len(ins))
+}
+
+func (suite *ParaCrossTransferTestSuite) TestCrossInRefund() {
+	var txs []*types.Transaction
+	for i := 0; i < paraCrossInDeliverMax+1; i++ {
+		transfer := suite.paraAssetTransfer(Title)
+		transfer.Note = fmt.Sprintf("%d", i)
+		crossTx := suite.createCrossTx(fromParaTitle+pt.ParaX, transfer)
+		_, err := newAction(suite.exec, crossTx).routeParaCrossTransfer(transfer, pt.ParacrossParaAssetTransfer, crossTx)
+		suite.Nil(err)
+		txs = append(txs, crossTx)
+	}
+	mainAcc, err := NewParaAccount(suite.mainCfg, fromParaTitle, fromParaTitle+"coins", "fzm", suite.stateDB)
+	suite.Nil(err)
+	custody := address.ExecAddress(Title + pt.ParaX)
+	suite.Equal(Amount*int64(len(txs)), mainAcc.LoadAccount(custody).Balance)
+
+	// 超时之前不能退回，只有发送地址可以退回
+	refund := &pt.ParaCrossInRefund{TxHash: common.ToHex(txs[0].Hash())}
+	_, err = newAction(suite.exec, suite.refundTx(txs[0])).crossInRefund(refund)
+	suite.Equal(pt.ErrParaCrossInRefund, errors.Cause(err))
+	suite.exec.SetEnv(MainBlockHeight+paraCrossInTimeout, 0, 0)
+	otherTx, err := signTx(suite.Suite, suite.refundTx(txs[0]), PrivKeyB)
+	suite.Nil(err)
+	_, err = newAction(suite.exec, otherTx).crossInRefund(refund)
+	suite.Equal(types.ErrFromAddr, errors.Cause(err))
+	_, err = newAction(suite.exec, suite.refundTx(txs[0])).crossInRefund(refund)
+	suite.Nil(err)
+	_, err = newAction(suite.exec, suite.refundTx(txs[0])).crossInRefund(refund)
+	suite.Equal(pt.ErrParaCrossInRefund, errors.Cause(err))
+
+	refund = &pt.ParaCrossInRefund{TxHash: common.ToHex(txs[1].Hash())}
+	receipt, err := newAction(suite.exec, suite.refundTx(txs[1])).crossInRefund(refund)
+	suite.Nil(err)
+	suite.Equal(int32(pt.TyLogParaCrossInRefund), receipt.Logs[len(receipt.Logs)-1].Ty)
+	suite.Equal(Amount*int64(len(txs)-2), mainAcc.LoadAccount(custody).Balance)
+	suite.Equal(Amount*2, mainAcc.LoadAccount(txs[0].From()).Balance)
+	_, err = newAction(suite.exec, suite.refundTx(txs[1])).crossInRefund(refund)
+	suite.Equal(pt.ErrParaCrossInRefund, errors.Cause(err))
+
+	// 已退回的不再投递，一次最多处理paraCrossInDeliverMax个序号
+	titleTx, err := pt.CreateRawMinerTx(suite.paraCfg, &pt.ParacrossMinerAction{Status: &pt.ParacrossNodeStatus{Title: Title}})
+	suite.Nil(err)
+	receipt, err = newAction(suite.exec, titleTx).deliverParaCrossIns(Title)
+	suite.Nil(err)
+	var log pt.ReceiptParaCrossIn
+	suite.Nil(types.Decode(receipt.Logs[0].Log, &log))
+	suite.Equal(paraCrossInDeliverMax-2, len(log.Items))
+	suite.Equal(common.ToHex(txs[2].Hash()), log.Items[0].TxHash)
+	ins, err := getParaCrossIns(suite.stateDB, Title)
+	suite.Nil(err)
+	suite.Equal(1, len(ins))
+	suite.Equal(common.ToHex(txs[paraCrossInDeliverMax].Hash()), ins[0].TxHash)
+	receipt, err = newAction(suite.exec, titleTx).deliverParaCrossIns(Title)
+	suite.Nil(err)
+	suite.Nil(types.Decode(receipt.Logs[0].Log, &log))
+	suite.Equal(1, len(log.Items))
+	queue, err := getParaCrossInQueue(suite.stateDB, Title)
+	suite.Nil(err)
+	suite.Equal(queue.Tail, queue.Head)
+}
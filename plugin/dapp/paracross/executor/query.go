@@ -95,6 +95,21 @@ func (p *Paracross) Query_GetNodeGroupAddrs(in *pt.ReqParacrossNodeInfo) (types.
 	return &reply, nil
 }
 
+//Query_GetBlsGroupKey get nodegroup bls threshold group key
+func (p *Paracross) Query_GetBlsGroupKey(in *pt.ReqParacrossNodeInfo) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+
+	cfg := p.GetAPI().GetConfig()
+	if cfg.IsPara() {
+		in.Title = cfg.GetTitle()
+	} else if in.Title == "" {
+		return nil, errors.Wrap(types.ErrInvalidParam, "title is null")
+	}
+	return getBlsGroupKey(p.GetStateDB(), in.GetTitle())
+}
+
 //Query_GetNodeAddrInfo get specific node addr info
 func (p *Paracross) Query_GetNodeAddrInfo(in *pt.ReqParacrossNodeInfo) (types.Message, error) {
 	if in == nil || in.Addr == "" {
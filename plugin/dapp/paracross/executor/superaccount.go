@@ -14,6 +14,7 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	manager "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -208,15 +209,57 @@ func makeParaNodeGroupReceipt(title string, prev, current *types.ConfigItem) *ty
 	}
 }
 
-//get secp256 addr's bls pubkey
-func getAddrBlsPubKey(db dbm.KV, title, addr string) (string, error) {
+//get secp256 addr's bls pubkey, needPop时没有proof of possession的公钥不能参与聚合验签
+func getAddrBlsPubKey(db dbm.KV, title, addr string, needPop bool) (string, error) {
 	addrStat, err := getNodeAddr(db, title, addr)
 	if err != nil {
 		return "", errors.Wrapf(err, "nodeAddr:%s-%s get error", title, addr)
 	}
+	if needPop && len(addrStat.BlsPop) == 0 {
+		return "", errors.Wrapf(pt.ErrParaBlsPop, "nodeAddr:%s-%s pubkey without pop", title, addr)
+	}
 	return addrStat.BlsPubKey, nil
 }
 
+//校验bls公钥的proof of possession，防止构造的rogue key在同消息聚合签名中抵消其他节点的公钥
+func verifyBlsPop(pubKey, pop string) error {
+	drv := bls.Driver{}
+	k, err := common.FromHex(pubKey)
+	if err != nil {
+		return errors.Wrapf(pt.ErrParaBlsPop, "pubkey FromHex=%s", pubKey)
+	}
+	pub, err := drv.PubKeyFromBytes(k)
+	if err != nil {
+		return errors.Wrapf(pt.ErrParaBlsPop, "DeserializePublicKey=%s", pubKey)
+	}
+	p, err := common.FromHex(pop)
+	if err != nil || len(p) != bls.BLSSignatureLength {
+		return errors.Wrapf(pt.ErrParaBlsPop, "pop=%s", pop)
+	}
+	sig, err := drv.SignatureFromBytes(p)
+	if err != nil {
+		return errors.Wrapf(pt.ErrParaBlsPop, "DeserializeSignature=%s", pop)
+	}
+	err = drv.VerifyPop(pub, sig)
+	if err != nil {
+		return errors.Wrapf(pt.ErrParaBlsPop, "pubkey=%s,err=%s", pubKey, err.Error())
+	}
+	return nil
+}
+
+//ForkParaBlsPop之后设置bls公钥需要有效的pop，返回需要保存的pop，fork之前不保存
+func (a *action) checkBlsPop(pubKey, pop string) (string, error) {
+	cfg := a.api.GetConfig()
+	if len(pubKey) == 0 || !cfg.IsDappFork(a.exec.GetMainHeight(), pt.ParaX, pt.ForkParaBlsPop) {
+		return "", nil
+	}
+	err := verifyBlsPop(pubKey, pop)
+	if err != nil {
+		return "", err
+	}
+	return pop, nil
+}
+
 func (a *action) checkValidNode(config *pt.ParaNodeAddrConfig) (bool, error) {
 	nodes, _, err := getParacrossNodes(a.db, config.Title)
 	if err != nil {
@@ -248,6 +291,11 @@ func (a *action) nodeJoin(config *pt.ParaNodeAddrConfig) (*types.Receipt, error)
 			"coinFrozen not enough:%d,expected:%d", config.CoinsFrozen, nodeGroupStatus.CoinsFrozen)
 	}
 
+	blsPop, err := a.checkBlsPop(config.BlsPubKey, config.BlsPop)
+	if err != nil {
+		return nil, errors.Wrapf(err, "nodeJoin addr=%s", config.Addr)
+	}
+
 	receipt := &types.Receipt{Ty: types.ExecOk}
 	cfg := a.api.GetConfig()
 	if !cfg.IsPara() {
@@ -272,6 +320,7 @@ func (a *action) nodeJoin(config *pt.ParaNodeAddrConfig) (*types.Receipt, error)
 		Title:       config.Title,
 		TargetAddr:  config.Addr,
 		BlsPubKey:   config.BlsPubKey,
+		BlsPop:      blsPop,
 		FromAddr:    a.fromaddr,
 		Votes:       &pt.ParaNodeVoteDetail{},
 		CoinsFrozen: config.CoinsFrozen,
@@ -371,8 +420,15 @@ func (a *action) nodeModify(config *pt.ParaNodeAddrConfig) (*types.Receipt, erro
 		return nil, errors.Wrapf(types.ErrNotAllow, "addr create by:%s,not by:%s", config.Addr, a.fromaddr)
 	}
 
+	//已注册没有pop的公钥可以通过modify补充pop
+	blsPop, err := a.checkBlsPop(config.BlsPubKey, config.BlsPop)
+	if err != nil {
+		return nil, errors.Wrapf(err, "nodeModify addr=%s", config.Addr)
+	}
+
 	preStat := *addrStat
 	addrStat.BlsPubKey = config.BlsPubKey
+	addrStat.BlsPop = blsPop
 
 	return makeParaNodeStatusReceipt(a.fromaddr, &preStat, addrStat), nil
 }
@@ -467,6 +523,7 @@ func (a *action) updateNodeAddrStatus(stat *pt.ParaNodeIdStatus) (*types.Receipt
 		addrStat.Title = stat.Title
 		addrStat.Addr = stat.TargetAddr
 		addrStat.BlsPubKey = stat.BlsPubKey
+		addrStat.BlsPop = stat.BlsPop
 		addrStat.Status = pt.ParaApplyJoined
 		addrStat.ProposalId = stat.Id
 		addrStat.QuitId = ""
@@ -478,6 +535,11 @@ func (a *action) updateNodeAddrStatus(stat *pt.ParaNodeIdStatus) (*types.Receipt
 		addrStat.Status = pt.ParaApplyJoined
 		addrStat.ProposalId = stat.Id
 		addrStat.QuitId = ""
+		//重新加入时使用新注册的有pop的公钥
+		if len(stat.BlsPop) > 0 {
+			addrStat.BlsPubKey = stat.BlsPubKey
+			addrStat.BlsPop = stat.BlsPop
+		}
 		return makeParaNodeStatusReceipt(a.fromaddr, &preStat, addrStat), nil
 	}
 
@@ -806,9 +868,25 @@ func (a *action) nodeGroupApply(config *pt.ParaNodeGroupConfig) (*types.Receipt,
 		}
 	}
 
+	var blsPops []string
+	cfg := a.api.GetConfig()
+	if len(blsPubKeys) > 0 && cfg.IsDappFork(a.exec.GetMainHeight(), pt.ParaX, pt.ForkParaBlsPop) {
+		pops := getConfigAddrs(config.BlsPops)
+		if len(pops) != len(blsPubKeys) {
+			return nil, errors.Wrapf(pt.ErrParaBlsPop, "nodegroup apply blsPops length=%d not match blsPubkeys=%d",
+				len(pops), len(blsPubKeys))
+		}
+		for i, pub := range blsPubKeys {
+			pop, err := a.checkBlsPop(pub, pops[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "nodegroup apply addr=%s", addrs[i])
+			}
+			blsPops = append(blsPops, pop)
+		}
+	}
+
 	receipt := &types.Receipt{Ty: types.ExecOk}
 	//main chain
-	cfg := a.api.GetConfig()
 	if !cfg.IsPara() {
 		r, err := a.nodeGroupCoinsFrozen(a.fromaddr, config.CoinsFrozen, int64(len(addrs)))
 		if err != nil {
@@ -825,6 +903,7 @@ func (a *action) nodeGroupApply(config *pt.ParaNodeGroupConfig) (*types.Receipt,
 		Title:       config.Title,
 		TargetAddrs: strings.Join(addrs, ","),
 		BlsPubKeys:  strings.Join(blsPubKeys, ","),
+		BlsPops:     strings.Join(blsPops, ","),
 		CoinsFrozen: config.CoinsFrozen,
 		FromAddr:    a.fromaddr,
 		Height:      a.height,
@@ -1021,6 +1100,10 @@ func (a *action) nodeGroupCreate(status *pt.ParaNodeGroupStatus) (*types.Receipt
 	if len(status.BlsPubKeys) > 0 {
 		blsPubKeys = strings.Split(status.BlsPubKeys, ",")
 	}
+	var blsPops []string
+	if len(status.BlsPops) > 0 {
+		blsPops = strings.Split(status.BlsPops, ",")
+	}
 
 	//update addr status
 	for i, addr := range nodes {
@@ -1036,6 +1119,9 @@ func (a *action) nodeGroupCreate(status *pt.ParaNodeGroupStatus) (*types.Receipt
 		if len(blsPubKeys) > 0 {
 			stat.BlsPubKey = blsPubKeys[i]
 		}
+		if len(blsPops) > 0 {
+			stat.BlsPop = blsPops[i]
+		}
 		r := makeNodeConfigReceipt(a.fromaddr, nil, nil, stat)
 		receipt = mergeReceipt(receipt, r)
 
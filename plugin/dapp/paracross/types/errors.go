@@ -59,4 +59,20 @@ var (
 	ErrConsensClosed = errors.New("ErrConsensClosed")
 	//ErrBlsSignVerify bls12-381 aggregate sign verify
 	ErrBlsSignVerify = errors.New("ErrBlsSignVerify")
+	//ErrParaCrossTitle para to para cross transfer target title wrong
+	ErrParaCrossTitle = errors.New("ErrParaCrossTitle")
+	//ErrParaCrossInRefund para to para transfer delivered or not timeout, can not refund
+	ErrParaCrossInRefund = errors.New("ErrParaCrossInRefund")
+	//ErrParaMainBlockVerify main block header,tx root or consensus proof verify fail
+	ErrParaMainBlockVerify = errors.New("ErrParaMainBlockVerify")
+	//ErrParaMainNodesDisagree main nodes reply different block for same height
+	ErrParaMainNodesDisagree = errors.New("ErrParaMainNodesDisagree")
+	//ErrParaMainNodesNotEnough main nodes agreed count less than config
+	ErrParaMainNodesNotEnough = errors.New("ErrParaMainNodesNotEnough")
+	//ErrParaBlsGroupKeyNotActive bls threshold group key not voted or nodegroup changed
+	ErrParaBlsGroupKeyNotActive = errors.New("ErrParaBlsGroupKeyNotActive")
+	//ErrParaBlsDkgDeal bls dkg deal commitments or share verify fail
+	ErrParaBlsDkgDeal = errors.New("ErrParaBlsDkgDeal")
+	//ErrParaBlsPop bls pubkey proof of possession not exist or verify fail
+	ErrParaBlsPop = errors.New("ErrParaBlsPop")
 )
@@ -49,6 +49,16 @@ const (
 	TyLogParaCrossAssetTransfer = 670
 	TyLogParaBindMinerAddr      = 671
 	TyLogParaBindMinerNode      = 672
+	//TyLogParaCrossRoute 主链路由平行链之间的跨链转移
+	TyLogParaCrossRoute = 673
+	//TyLogParaCrossInDeliver 目标平行链共识时主链投递待转入资产
+	TyLogParaCrossInDeliver = 674
+	//TyLogParaCrossIn 目标平行链转入其他平行链资产
+	TyLogParaCrossIn = 675
+	//TyLogParaBlsGroupKey nodegroup门限签名群公钥投票
+	TyLogParaBlsGroupKey = 676
+	//TyLogParaCrossInRefund 超时未投递的平行链之间转移退回发送地址
+	TyLogParaCrossInRefund = 677
 )
 
 // action type
@@ -85,6 +95,10 @@ const (
 	ParacrossActionSelfStageConfig
 	// ParacrossActionCrossAssetTransfer crossChain asset transfer key
 	ParacrossActionCrossAssetTransfer
+	// ParacrossActionBlsGroupKey nodegroup bls threshold group key vote
+	ParacrossActionBlsGroupKey
+	// ParacrossActionCrossInRefund refund para to para transfer not delivered in time
+	ParacrossActionCrossInRefund
 )
 
 //paracross asset porcess
@@ -104,6 +118,16 @@ const (
 	ParacrossStatusCommitDone
 )
 
+// para to para transfer status
+const (
+	// ParaCrossInPending routed, wait to deliver to target para chain
+	ParaCrossInPending = iota + 1
+	// ParaCrossInDelivered delivered to target para chain
+	ParaCrossInDelivered
+	// ParaCrossInRefunded refunded to from addr on main chain
+	ParaCrossInRefunded
+)
+
 // config op
 const (
 	ParaOpNewApply = iota + 1
@@ -215,6 +239,56 @@ func createRawCommitTx(cfg *types.Chain33Config, commit *ParacrossCommitAction,
 	return tx, nil
 }
 
+// CreateRawBlsGroupKeyTx4MainChain create nodegroup bls group key vote tx
+func CreateRawBlsGroupKeyTx4MainChain(cfg *types.Chain33Config, key *ParaBlsGroupKey, name string, feeRate int64) (*types.Transaction, error) {
+	action := &ParacrossAction{
+		Ty:    ParacrossActionBlsGroupKey,
+		Value: &ParacrossAction_BlsGroupKey{key},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(name),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(name),
+		Expire:  types.Now().Unix() + int64(120), //120s
+	}
+	tx, err := types.FormatTx(cfg, name, tx)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate != 0 {
+		tx.Fee, err = tx.GetRealFee(feeRate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+// CreateRawNodeConfigTx4MainChain create super node config tx, used by para node to supply bls pop
+func CreateRawNodeConfigTx4MainChain(cfg *types.Chain33Config, config *ParaNodeAddrConfig, name string, feeRate int64) (*types.Transaction, error) {
+	action := &ParacrossAction{
+		Ty:    ParacrossActionNodeConfig,
+		Value: &ParacrossAction_NodeConfig{config},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(name),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(name),
+		Expire:  types.Now().Unix() + int64(120), //120s
+	}
+	tx, err := types.FormatTx(cfg, name, tx)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate != 0 {
+		tx.Fee, err = tx.GetRealFee(feeRate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
 // CreateRawAssetTransferTx create asset transfer tx
 func CreateRawAssetTransferTx(cfg *types.Chain33Config, param *types.CreateTx) (*types.Transaction, error) {
 	// 跨链交易需要在主链和平行链上执行， 所以应该可以在主链和平行链上构建
@@ -332,3 +406,28 @@ func GetDappForkHeight(cfg *types.Chain33Config, forkKey string) int64 {
 func IsParaForkHeight(cfg *types.Chain33Config, height int64, forkKey string) bool {
 	return height >= GetDappForkHeight(cfg, forkKey)
 }
+
+// CreateRawCrossInRefundTx4MainChain create refund tx for para to para transfer not delivered in time
+func CreateRawCrossInRefundTx4MainChain(cfg *types.Chain33Config, refund *ParaCrossInRefund, feeRate int64) (*types.Transaction, error) {
+	action := &ParacrossAction{
+		Ty:    ParacrossActionCrossInRefund,
+		Value: &ParacrossAction_CrossInRefund{refund},
+	}
+	tx := &types.Transaction{
+		Execer:  []byte(ParaX),
+		Payload: types.Encode(action),
+		To:      address.ExecAddress(ParaX),
+		Expire:  types.Now().Unix() + int64(120), //120s
+	}
+	tx, err := types.FormatTx(cfg, ParaX, tx)
+	if err != nil {
+		return nil, err
+	}
+	if feeRate != 0 {
+		tx.Fee, err = tx.GetRealFee(feeRate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
@@ -439,6 +439,7 @@ type ParaNodeAddrConfig struct {
 	Value                uint32   `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
 	CoinsFrozen          int64    `protobuf:"varint,6,opt,name=coinsFrozen,proto3" json:"coinsFrozen,omitempty"`
 	BlsPubKey            string   `protobuf:"bytes,7,opt,name=blsPubKey,proto3" json:"blsPubKey,omitempty"`
+	BlsPop               string   `protobuf:"bytes,8,opt,name=blsPop,proto3" json:"blsPop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -518,6 +519,13 @@ func (m *ParaNodeAddrConfig) GetBlsPubKey() string {
 	return ""
 }
 
+func (m *ParaNodeAddrConfig) GetBlsPop() string {
+	if m != nil {
+		return m.BlsPop
+	}
+	return ""
+}
+
 type ParaNodeVoteDetail struct {
 	Addrs                []string `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
 	Votes                []string `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes,omitempty"`
@@ -572,6 +580,7 @@ type ParaNodeAddrIdStatus struct {
 	Status               int32    `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
 	Title                string   `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
 	BlsPubKey            string   `protobuf:"bytes,6,opt,name=blsPubKey,proto3" json:"blsPubKey,omitempty"`
+	BlsPop               string   `protobuf:"bytes,7,opt,name=blsPop,proto3" json:"blsPop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -644,6 +653,13 @@ func (m *ParaNodeAddrIdStatus) GetBlsPubKey() string {
 	return ""
 }
 
+func (m *ParaNodeAddrIdStatus) GetBlsPop() string {
+	if m != nil {
+		return m.BlsPop
+	}
+	return ""
+}
+
 type ParaNodeIdStatus struct {
 	Id                   string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Status               int32               `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -654,6 +670,7 @@ type ParaNodeIdStatus struct {
 	FromAddr             string              `protobuf:"bytes,7,opt,name=fromAddr,proto3" json:"fromAddr,omitempty"`
 	Height               int64               `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
 	BlsPubKey            string              `protobuf:"bytes,9,opt,name=blsPubKey,proto3" json:"blsPubKey,omitempty"`
+	BlsPop               string              `protobuf:"bytes,10,opt,name=blsPop,proto3" json:"blsPop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
 	XXX_sizecache        int32               `json:"-"`
@@ -747,6 +764,13 @@ func (m *ParaNodeIdStatus) GetBlsPubKey() string {
 	return ""
 }
 
+func (m *ParaNodeIdStatus) GetBlsPop() string {
+	if m != nil {
+		return m.BlsPop
+	}
+	return ""
+}
+
 type ReceiptParaNodeConfig struct {
 	Addr                 string              `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Config               *ParaNodeAddrConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
@@ -967,6 +991,7 @@ type ParaNodeGroupConfig struct {
 	Addrs                string   `protobuf:"bytes,4,opt,name=addrs,proto3" json:"addrs,omitempty"`
 	CoinsFrozen          int64    `protobuf:"varint,5,opt,name=coinsFrozen,proto3" json:"coinsFrozen,omitempty"`
 	BlsPubKeys           string   `protobuf:"bytes,6,opt,name=blsPubKeys,proto3" json:"blsPubKeys,omitempty"`
+	BlsPops              string   `protobuf:"bytes,7,opt,name=blsPops,proto3" json:"blsPops,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1039,6 +1064,13 @@ func (m *ParaNodeGroupConfig) GetBlsPubKeys() string {
 	return ""
 }
 
+func (m *ParaNodeGroupConfig) GetBlsPops() string {
+	if m != nil {
+		return m.BlsPops
+	}
+	return ""
+}
+
 type ParaNodeGroupStatus struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1048,6 +1080,7 @@ type ParaNodeGroupStatus struct {
 	FromAddr             string   `protobuf:"bytes,6,opt,name=fromAddr,proto3" json:"fromAddr,omitempty"`
 	Height               int64    `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
 	BlsPubKeys           string   `protobuf:"bytes,8,opt,name=blsPubKeys,proto3" json:"blsPubKeys,omitempty"`
+	BlsPops              string   `protobuf:"bytes,9,opt,name=blsPops,proto3" json:"blsPops,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1134,6 +1167,13 @@ func (m *ParaNodeGroupStatus) GetBlsPubKeys() string {
 	return ""
 }
 
+func (m *ParaNodeGroupStatus) GetBlsPops() string {
+	if m != nil {
+		return m.BlsPops
+	}
+	return ""
+}
+
 type ReceiptParaNodeGroupConfig struct {
 	Addr                 string               `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Config               *ParaNodeGroupConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
@@ -2676,9 +2716,13 @@ func (m *ReplyQuerySelfStages) GetStageInfo() []*SelfConsensStageInfo {
 }
 
 type ParacrossCommitBlsInfo struct {
-	Sign                 []byte   `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
-	AddrsMap             []byte   `protobuf:"bytes,2,opt,name=addrsMap,proto3" json:"addrsMap,omitempty"`
-	Addrs                []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	Sign     []byte   `protobuf:"bytes,1,opt,name=sign,proto3" json:"sign,omitempty"`
+	AddrsMap []byte   `protobuf:"bytes,2,opt,name=addrsMap,proto3" json:"addrsMap,omitempty"`
+	Addrs    []string `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	//nodegroup门限群签名，设置后sign和addrsMap为空
+	ThresholdSign []byte `protobuf:"bytes,4,opt,name=thresholdSign,proto3" json:"thresholdSign,omitempty"`
+	//节点用DKG分片私钥的部分签名，只在平行链节点间广播
+	PartialSign          []byte   `protobuf:"bytes,5,opt,name=partialSign,proto3" json:"partialSign,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2730,6 +2774,20 @@ func (m *ParacrossCommitBlsInfo) GetAddrs() []string {
 	return nil
 }
 
+func (m *ParacrossCommitBlsInfo) GetThresholdSign() []byte {
+	if m != nil {
+		return m.ThresholdSign
+	}
+	return nil
+}
+
+func (m *ParacrossCommitBlsInfo) GetPartialSign() []byte {
+	if m != nil {
+		return m.PartialSign
+	}
+	return nil
+}
+
 type ParacrossCommitAction struct {
 	Status               *ParacrossNodeStatus    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	Bls                  *ParacrossCommitBlsInfo `protobuf:"bytes,2,opt,name=bls,proto3" json:"bls,omitempty"`
@@ -2778,11 +2836,13 @@ func (m *ParacrossCommitAction) GetBls() *ParacrossCommitBlsInfo {
 }
 
 type ParacrossMinerAction struct {
-	Status               *ParacrossNodeStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IsSelfConsensus      bool                 `protobuf:"varint,2,opt,name=isSelfConsensus,proto3" json:"isSelfConsensus,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Status          *ParacrossNodeStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IsSelfConsensus bool                 `protobuf:"varint,2,opt,name=isSelfConsensus,proto3" json:"isSelfConsensus,omitempty"`
+	//主链投递到本平行链的其他平行链跨链转账
+	CrossIns             []*ParaCrossIn `protobuf:"bytes,3,rep,name=crossIns,proto3" json:"crossIns,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *ParacrossMinerAction) Reset()         { *m = ParacrossMinerAction{} }
@@ -2824,13 +2884,22 @@ func (m *ParacrossMinerAction) GetIsSelfConsensus() bool {
 	return false
 }
 
+func (m *ParacrossMinerAction) GetCrossIns() []*ParaCrossIn {
+	if m != nil {
+		return m.CrossIns
+	}
+	return nil
+}
+
 type CrossAssetTransfer struct {
 	AssetExec   string `protobuf:"bytes,1,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	AssetSymbol string `protobuf:"bytes,2,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
 	Amount      int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	//default signed addr
-	ToAddr               string   `protobuf:"bytes,4,opt,name=toAddr,proto3" json:"toAddr,omitempty"`
-	Note                 string   `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	ToAddr string `protobuf:"bytes,4,opt,name=toAddr,proto3" json:"toAddr,omitempty"`
+	Note   string `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
+	//平行链之间直接转移的目标平行链title，如user.p.test2.
+	ToParaTitle          string   `protobuf:"bytes,6,opt,name=toParaTitle,proto3" json:"toParaTitle,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2896,6 +2965,598 @@ func (m *CrossAssetTransfer) GetNote() string {
 	return ""
 }
 
+func (m *CrossAssetTransfer) GetToParaTitle() string {
+	if m != nil {
+		return m.ToParaTitle
+	}
+	return ""
+}
+
+// 平行链之间转移经主链路由后投递到目标平行链的资产
+// assetExec+assetSymbol为资产在主链上的表示
+type ParaCrossIn struct {
+	TxHash      string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	FromTitle   string `protobuf:"bytes,2,opt,name=fromTitle,proto3" json:"fromTitle,omitempty"`
+	From        string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	ToAddr      string `protobuf:"bytes,4,opt,name=toAddr,proto3" json:"toAddr,omitempty"`
+	AssetExec   string `protobuf:"bytes,5,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	AssetSymbol string `protobuf:"bytes,6,opt,name=assetSymbol,proto3" json:"assetSymbol,omitempty"`
+	Amount      int64  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	//主链路由高度，超时未投递时发送地址可以在主链取回
+	RouteHeight          int64    `protobuf:"varint,8,opt,name=routeHeight,proto3" json:"routeHeight,omitempty"`
+	Status               int32    `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	ToTitle              string   `protobuf:"bytes,10,opt,name=toTitle,proto3" json:"toTitle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaCrossIn) Reset()         { *m = ParaCrossIn{} }
+func (m *ParaCrossIn) String() string { return proto.CompactTextString(m) }
+func (*ParaCrossIn) ProtoMessage()    {}
+func (*ParaCrossIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{45}
+}
+
+func (m *ParaCrossIn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaCrossIn.Unmarshal(m, b)
+}
+func (m *ParaCrossIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaCrossIn.Marshal(b, m, deterministic)
+}
+func (m *ParaCrossIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaCrossIn.Merge(m, src)
+}
+func (m *ParaCrossIn) XXX_Size() int {
+	return xxx_messageInfo_ParaCrossIn.Size(m)
+}
+func (m *ParaCrossIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaCrossIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaCrossIn proto.InternalMessageInfo
+
+func (m *ParaCrossIn) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ParaCrossIn) GetFromTitle() string {
+	if m != nil {
+		return m.FromTitle
+	}
+	return ""
+}
+
+func (m *ParaCrossIn) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ParaCrossIn) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+func (m *ParaCrossIn) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ParaCrossIn) GetAssetSymbol() string {
+	if m != nil {
+		return m.AssetSymbol
+	}
+	return ""
+}
+
+func (m *ParaCrossIn) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ParaCrossIn) GetRouteHeight() int64 {
+	if m != nil {
+		return m.RouteHeight
+	}
+	return 0
+}
+
+func (m *ParaCrossIn) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ParaCrossIn) GetToTitle() string {
+	if m != nil {
+		return m.ToTitle
+	}
+	return ""
+}
+
+// 目标平行链待投递队列，[head,tail)序号对应的转入记录按序投递
+type ParaCrossInQueue struct {
+	Head                 int64    `protobuf:"varint,1,opt,name=head,proto3" json:"head,omitempty"`
+	Tail                 int64    `protobuf:"varint,2,opt,name=tail,proto3" json:"tail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaCrossInQueue) Reset()         { *m = ParaCrossInQueue{} }
+func (m *ParaCrossInQueue) String() string { return proto.CompactTextString(m) }
+func (*ParaCrossInQueue) ProtoMessage()    {}
+func (*ParaCrossInQueue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{46}
+}
+
+func (m *ParaCrossInQueue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaCrossInQueue.Unmarshal(m, b)
+}
+func (m *ParaCrossInQueue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaCrossInQueue.Marshal(b, m, deterministic)
+}
+func (m *ParaCrossInQueue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaCrossInQueue.Merge(m, src)
+}
+func (m *ParaCrossInQueue) XXX_Size() int {
+	return xxx_messageInfo_ParaCrossInQueue.Size(m)
+}
+func (m *ParaCrossInQueue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaCrossInQueue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaCrossInQueue proto.InternalMessageInfo
+
+func (m *ParaCrossInQueue) GetHead() int64 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *ParaCrossInQueue) GetTail() int64 {
+	if m != nil {
+		return m.Tail
+	}
+	return 0
+}
+
+// 超时未投递到目标平行链的转移，主链退回发送地址
+type ParaCrossInRefund struct {
+	TxHash               string   `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaCrossInRefund) Reset()         { *m = ParaCrossInRefund{} }
+func (m *ParaCrossInRefund) String() string { return proto.CompactTextString(m) }
+func (*ParaCrossInRefund) ProtoMessage()    {}
+func (*ParaCrossInRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{47}
+}
+
+func (m *ParaCrossInRefund) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaCrossInRefund.Unmarshal(m, b)
+}
+func (m *ParaCrossInRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaCrossInRefund.Marshal(b, m, deterministic)
+}
+func (m *ParaCrossInRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaCrossInRefund.Merge(m, src)
+}
+func (m *ParaCrossInRefund) XXX_Size() int {
+	return xxx_messageInfo_ParaCrossInRefund.Size(m)
+}
+func (m *ParaCrossInRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaCrossInRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaCrossInRefund proto.InternalMessageInfo
+
+func (m *ParaCrossInRefund) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type ReceiptParaCrossIn struct {
+	Title                string         `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Items                []*ParaCrossIn `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReceiptParaCrossIn) Reset()         { *m = ReceiptParaCrossIn{} }
+func (m *ReceiptParaCrossIn) String() string { return proto.CompactTextString(m) }
+func (*ReceiptParaCrossIn) ProtoMessage()    {}
+func (*ReceiptParaCrossIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{48}
+}
+
+func (m *ReceiptParaCrossIn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptParaCrossIn.Unmarshal(m, b)
+}
+func (m *ReceiptParaCrossIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptParaCrossIn.Marshal(b, m, deterministic)
+}
+func (m *ReceiptParaCrossIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaCrossIn.Merge(m, src)
+}
+func (m *ReceiptParaCrossIn) XXX_Size() int {
+	return xxx_messageInfo_ReceiptParaCrossIn.Size(m)
+}
+func (m *ReceiptParaCrossIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptParaCrossIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptParaCrossIn proto.InternalMessageInfo
+
+func (m *ReceiptParaCrossIn) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ReceiptParaCrossIn) GetItems() []*ParaCrossIn {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// nodegroup节点DKG生成的门限签名群公钥投票
+type ParaBlsGroupKey struct {
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	//参与DKG的nodegroup节点,逗号分隔,和nodegroup顺序一致
+	Nodes                string   `protobuf:"bytes,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Threshold            int32    `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	GroupPubKey          []byte   `protobuf:"bytes,4,opt,name=groupPubKey,proto3" json:"groupPubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaBlsGroupKey) Reset()         { *m = ParaBlsGroupKey{} }
+func (m *ParaBlsGroupKey) String() string { return proto.CompactTextString(m) }
+func (*ParaBlsGroupKey) ProtoMessage()    {}
+func (*ParaBlsGroupKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{49}
+}
+
+func (m *ParaBlsGroupKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaBlsGroupKey.Unmarshal(m, b)
+}
+func (m *ParaBlsGroupKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaBlsGroupKey.Marshal(b, m, deterministic)
+}
+func (m *ParaBlsGroupKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaBlsGroupKey.Merge(m, src)
+}
+func (m *ParaBlsGroupKey) XXX_Size() int {
+	return xxx_messageInfo_ParaBlsGroupKey.Size(m)
+}
+func (m *ParaBlsGroupKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaBlsGroupKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaBlsGroupKey proto.InternalMessageInfo
+
+func (m *ParaBlsGroupKey) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ParaBlsGroupKey) GetNodes() string {
+	if m != nil {
+		return m.Nodes
+	}
+	return ""
+}
+
+func (m *ParaBlsGroupKey) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ParaBlsGroupKey) GetGroupPubKey() []byte {
+	if m != nil {
+		return m.GroupPubKey
+	}
+	return nil
+}
+
+type ParaBlsGroupKeyStatus struct {
+	Title     string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Nodes     string `protobuf:"bytes,2,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Threshold int32  `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	//超过2/3节点投票一致后生效
+	GroupPubKey          []byte              `protobuf:"bytes,4,opt,name=groupPubKey,proto3" json:"groupPubKey,omitempty"`
+	Height               int64               `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Votes                *ParaNodeVoteDetail `protobuf:"bytes,6,opt,name=votes,proto3" json:"votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ParaBlsGroupKeyStatus) Reset()         { *m = ParaBlsGroupKeyStatus{} }
+func (m *ParaBlsGroupKeyStatus) String() string { return proto.CompactTextString(m) }
+func (*ParaBlsGroupKeyStatus) ProtoMessage()    {}
+func (*ParaBlsGroupKeyStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{50}
+}
+
+func (m *ParaBlsGroupKeyStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaBlsGroupKeyStatus.Unmarshal(m, b)
+}
+func (m *ParaBlsGroupKeyStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaBlsGroupKeyStatus.Marshal(b, m, deterministic)
+}
+func (m *ParaBlsGroupKeyStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaBlsGroupKeyStatus.Merge(m, src)
+}
+func (m *ParaBlsGroupKeyStatus) XXX_Size() int {
+	return xxx_messageInfo_ParaBlsGroupKeyStatus.Size(m)
+}
+func (m *ParaBlsGroupKeyStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaBlsGroupKeyStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaBlsGroupKeyStatus proto.InternalMessageInfo
+
+func (m *ParaBlsGroupKeyStatus) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ParaBlsGroupKeyStatus) GetNodes() string {
+	if m != nil {
+		return m.Nodes
+	}
+	return ""
+}
+
+func (m *ParaBlsGroupKeyStatus) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ParaBlsGroupKeyStatus) GetGroupPubKey() []byte {
+	if m != nil {
+		return m.GroupPubKey
+	}
+	return nil
+}
+
+func (m *ParaBlsGroupKeyStatus) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ParaBlsGroupKeyStatus) GetVotes() *ParaNodeVoteDetail {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+type ReceiptParaBlsGroupKey struct {
+	Addr                 string                 `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Prev                 *ParaBlsGroupKeyStatus `protobuf:"bytes,2,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *ParaBlsGroupKeyStatus `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ReceiptParaBlsGroupKey) Reset()         { *m = ReceiptParaBlsGroupKey{} }
+func (m *ReceiptParaBlsGroupKey) String() string { return proto.CompactTextString(m) }
+func (*ReceiptParaBlsGroupKey) ProtoMessage()    {}
+func (*ReceiptParaBlsGroupKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{51}
+}
+
+func (m *ReceiptParaBlsGroupKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptParaBlsGroupKey.Unmarshal(m, b)
+}
+func (m *ReceiptParaBlsGroupKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptParaBlsGroupKey.Marshal(b, m, deterministic)
+}
+func (m *ReceiptParaBlsGroupKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptParaBlsGroupKey.Merge(m, src)
+}
+func (m *ReceiptParaBlsGroupKey) XXX_Size() int {
+	return xxx_messageInfo_ReceiptParaBlsGroupKey.Size(m)
+}
+func (m *ReceiptParaBlsGroupKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptParaBlsGroupKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptParaBlsGroupKey proto.InternalMessageInfo
+
+func (m *ReceiptParaBlsGroupKey) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReceiptParaBlsGroupKey) GetPrev() *ParaBlsGroupKeyStatus {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptParaBlsGroupKey) GetCurrent() *ParaBlsGroupKeyStatus {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ParaBlsDkgShare struct {
+	Addr string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	//临时公钥r*G1
+	Ephemeral            []byte   `protobuf:"bytes,2,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	EncShare             []byte   `protobuf:"bytes,3,opt,name=encShare,proto3" json:"encShare,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaBlsDkgShare) Reset()         { *m = ParaBlsDkgShare{} }
+func (m *ParaBlsDkgShare) String() string { return proto.CompactTextString(m) }
+func (*ParaBlsDkgShare) ProtoMessage()    {}
+func (*ParaBlsDkgShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{52}
+}
+
+func (m *ParaBlsDkgShare) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaBlsDkgShare.Unmarshal(m, b)
+}
+func (m *ParaBlsDkgShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaBlsDkgShare.Marshal(b, m, deterministic)
+}
+func (m *ParaBlsDkgShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaBlsDkgShare.Merge(m, src)
+}
+func (m *ParaBlsDkgShare) XXX_Size() int {
+	return xxx_messageInfo_ParaBlsDkgShare.Size(m)
+}
+func (m *ParaBlsDkgShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaBlsDkgShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaBlsDkgShare proto.InternalMessageInfo
+
+func (m *ParaBlsDkgShare) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ParaBlsDkgShare) GetEphemeral() []byte {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return nil
+}
+
+func (m *ParaBlsDkgShare) GetEncShare() []byte {
+	if m != nil {
+		return m.EncShare
+	}
+	return nil
+}
+
+// DKG dealer分发的多项式承诺和加密分片
+type ParaBlsDkgDeal struct {
+	Nodes                string             `protobuf:"bytes,1,opt,name=nodes,proto3" json:"nodes,omitempty"`
+	Threshold            int32              `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Dealer               string             `protobuf:"bytes,3,opt,name=dealer,proto3" json:"dealer,omitempty"`
+	Commits              [][]byte           `protobuf:"bytes,4,rep,name=commits,proto3" json:"commits,omitempty"`
+	Shares               []*ParaBlsDkgShare `protobuf:"bytes,5,rep,name=shares,proto3" json:"shares,omitempty"`
+	Sign                 []byte             `protobuf:"bytes,6,opt,name=sign,proto3" json:"sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ParaBlsDkgDeal) Reset()         { *m = ParaBlsDkgDeal{} }
+func (m *ParaBlsDkgDeal) String() string { return proto.CompactTextString(m) }
+func (*ParaBlsDkgDeal) ProtoMessage()    {}
+func (*ParaBlsDkgDeal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{53}
+}
+
+func (m *ParaBlsDkgDeal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaBlsDkgDeal.Unmarshal(m, b)
+}
+func (m *ParaBlsDkgDeal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaBlsDkgDeal.Marshal(b, m, deterministic)
+}
+func (m *ParaBlsDkgDeal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaBlsDkgDeal.Merge(m, src)
+}
+func (m *ParaBlsDkgDeal) XXX_Size() int {
+	return xxx_messageInfo_ParaBlsDkgDeal.Size(m)
+}
+func (m *ParaBlsDkgDeal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaBlsDkgDeal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaBlsDkgDeal proto.InternalMessageInfo
+
+func (m *ParaBlsDkgDeal) GetNodes() string {
+	if m != nil {
+		return m.Nodes
+	}
+	return ""
+}
+
+func (m *ParaBlsDkgDeal) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ParaBlsDkgDeal) GetDealer() string {
+	if m != nil {
+		return m.Dealer
+	}
+	return ""
+}
+
+func (m *ParaBlsDkgDeal) GetCommits() [][]byte {
+	if m != nil {
+		return m.Commits
+	}
+	return nil
+}
+
+func (m *ParaBlsDkgDeal) GetShares() []*ParaBlsDkgShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *ParaBlsDkgDeal) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
 type ParacrossAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*ParacrossAction_Commit
@@ -2910,6 +3571,8 @@ type ParacrossAction struct {
 	//	*ParacrossAction_SelfStageConfig
 	//	*ParacrossAction_CrossAssetTransfer
 	//	*ParacrossAction_ParaBindMiner
+	//	*ParacrossAction_BlsGroupKey
+	//	*ParacrossAction_CrossInRefund
 	Value                isParacrossAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                   `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
@@ -2921,7 +3584,7 @@ func (m *ParacrossAction) Reset()         { *m = ParacrossAction{} }
 func (m *ParacrossAction) String() string { return proto.CompactTextString(m) }
 func (*ParacrossAction) ProtoMessage()    {}
 func (*ParacrossAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{45}
+	return fileDescriptor_6a397e38c9ea6747, []int{54}
 }
 
 func (m *ParacrossAction) XXX_Unmarshal(b []byte) error {
@@ -2994,6 +3657,14 @@ type ParacrossAction_ParaBindMiner struct {
 	ParaBindMiner *ParaBindMinerCmd `protobuf:"bytes,13,opt,name=paraBindMiner,proto3,oneof"`
 }
 
+type ParacrossAction_BlsGroupKey struct {
+	BlsGroupKey *ParaBlsGroupKey `protobuf:"bytes,14,opt,name=blsGroupKey,proto3,oneof"`
+}
+
+type ParacrossAction_CrossInRefund struct {
+	CrossInRefund *ParaCrossInRefund `protobuf:"bytes,15,opt,name=crossInRefund,proto3,oneof"`
+}
+
 func (*ParacrossAction_Commit) isParacrossAction_Value() {}
 
 func (*ParacrossAction_Miner) isParacrossAction_Value() {}
@@ -3018,6 +3689,10 @@ func (*ParacrossAction_CrossAssetTransfer) isParacrossAction_Value() {}
 
 func (*ParacrossAction_ParaBindMiner) isParacrossAction_Value() {}
 
+func (*ParacrossAction_BlsGroupKey) isParacrossAction_Value() {}
+
+func (*ParacrossAction_CrossInRefund) isParacrossAction_Value() {}
+
 func (m *ParacrossAction) GetValue() isParacrossAction_Value {
 	if m != nil {
 		return m.Value
@@ -3109,6 +3784,20 @@ func (m *ParacrossAction) GetParaBindMiner() *ParaBindMinerCmd {
 	return nil
 }
 
+func (m *ParacrossAction) GetBlsGroupKey() *ParaBlsGroupKey {
+	if x, ok := m.GetValue().(*ParacrossAction_BlsGroupKey); ok {
+		return x.BlsGroupKey
+	}
+	return nil
+}
+
+func (m *ParacrossAction) GetCrossInRefund() *ParaCrossInRefund {
+	if x, ok := m.GetValue().(*ParacrossAction_CrossInRefund); ok {
+		return x.CrossInRefund
+	}
+	return nil
+}
+
 func (m *ParacrossAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -3131,6 +3820,8 @@ func (*ParacrossAction) XXX_OneofWrappers() []interface{} {
 		(*ParacrossAction_SelfStageConfig)(nil),
 		(*ParacrossAction_CrossAssetTransfer)(nil),
 		(*ParacrossAction_ParaBindMiner)(nil),
+		(*ParacrossAction_BlsGroupKey)(nil),
+		(*ParacrossAction_CrossInRefund)(nil),
 	}
 }
 
@@ -3149,7 +3840,7 @@ func (m *ReceiptParacrossCommit) Reset()         { *m = ReceiptParacrossCommit{}
 func (m *ReceiptParacrossCommit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossCommit) ProtoMessage()    {}
 func (*ReceiptParacrossCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{46}
+	return fileDescriptor_6a397e38c9ea6747, []int{55}
 }
 
 func (m *ReceiptParacrossCommit) XXX_Unmarshal(b []byte) error {
@@ -3209,7 +3900,7 @@ func (m *ReceiptParacrossMiner) Reset()         { *m = ReceiptParacrossMiner{} }
 func (m *ReceiptParacrossMiner) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossMiner) ProtoMessage()    {}
 func (*ReceiptParacrossMiner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{47}
+	return fileDescriptor_6a397e38c9ea6747, []int{56}
 }
 
 func (m *ReceiptParacrossMiner) XXX_Unmarshal(b []byte) error {
@@ -3262,7 +3953,7 @@ func (m *ReceiptParacrossDone) Reset()         { *m = ReceiptParacrossDone{} }
 func (m *ReceiptParacrossDone) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossDone) ProtoMessage()    {}
 func (*ReceiptParacrossDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{48}
+	return fileDescriptor_6a397e38c9ea6747, []int{57}
 }
 
 func (m *ReceiptParacrossDone) XXX_Unmarshal(b []byte) error {
@@ -3400,7 +4091,7 @@ func (m *ReceiptParacrossRecord) Reset()         { *m = ReceiptParacrossRecord{}
 func (m *ReceiptParacrossRecord) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossRecord) ProtoMessage()    {}
 func (*ReceiptParacrossRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{49}
+	return fileDescriptor_6a397e38c9ea6747, []int{58}
 }
 
 func (m *ReceiptParacrossRecord) XXX_Unmarshal(b []byte) error {
@@ -3448,7 +4139,7 @@ func (m *ParacrossTx) Reset()         { *m = ParacrossTx{} }
 func (m *ParacrossTx) String() string { return proto.CompactTextString(m) }
 func (*ParacrossTx) ProtoMessage()    {}
 func (*ParacrossTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{50}
+	return fileDescriptor_6a397e38c9ea6747, []int{59}
 }
 
 func (m *ParacrossTx) XXX_Unmarshal(b []byte) error {
@@ -3489,7 +4180,7 @@ func (m *ReqParacrossTitleHeight) Reset()         { *m = ReqParacrossTitleHeight
 func (m *ReqParacrossTitleHeight) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossTitleHeight) ProtoMessage()    {}
 func (*ReqParacrossTitleHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{51}
+	return fileDescriptor_6a397e38c9ea6747, []int{60}
 }
 
 func (m *ReqParacrossTitleHeight) XXX_Unmarshal(b []byte) error {
@@ -3544,7 +4235,7 @@ func (m *RespParacrossDone) Reset()         { *m = RespParacrossDone{} }
 func (m *RespParacrossDone) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossDone) ProtoMessage()    {}
 func (*RespParacrossDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{52}
+	return fileDescriptor_6a397e38c9ea6747, []int{61}
 }
 
 func (m *RespParacrossDone) XXX_Unmarshal(b []byte) error {
@@ -3639,7 +4330,7 @@ func (m *RespParacrossTitles) Reset()         { *m = RespParacrossTitles{} }
 func (m *RespParacrossTitles) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossTitles) ProtoMessage()    {}
 func (*RespParacrossTitles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{53}
+	return fileDescriptor_6a397e38c9ea6747, []int{62}
 }
 
 func (m *RespParacrossTitles) XXX_Unmarshal(b []byte) error {
@@ -3679,7 +4370,7 @@ func (m *ReqParacrossTitleHash) Reset()         { *m = ReqParacrossTitleHash{} }
 func (m *ReqParacrossTitleHash) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossTitleHash) ProtoMessage()    {}
 func (*ReqParacrossTitleHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{54}
+	return fileDescriptor_6a397e38c9ea6747, []int{63}
 }
 
 func (m *ReqParacrossTitleHash) XXX_Unmarshal(b []byte) error {
@@ -3726,12 +4417,16 @@ type ParacrossAsset struct {
 	Symbol     string `protobuf:"bytes,7,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	//跨链类型　0:to para, 1:to main
 	CrossType uint32 `protobuf:"varint,8,opt,name=crossType,proto3" json:"crossType,omitempty"`
+	//平行链之间转移的目标平行链
+	ToParaTitle string `protobuf:"bytes,9,opt,name=toParaTitle,proto3" json:"toParaTitle,omitempty"`
 	// 主链部分
 	Height int64 `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
 	// 平行链部分
-	CommitDoneHeight     int64    `protobuf:"varint,21,opt,name=commitDoneHeight,proto3" json:"commitDoneHeight,omitempty"`
-	ParaHeight           int64    `protobuf:"varint,22,opt,name=paraHeight,proto3" json:"paraHeight,omitempty"`
-	Success              bool     `protobuf:"varint,23,opt,name=success,proto3" json:"success,omitempty"`
+	CommitDoneHeight int64 `protobuf:"varint,21,opt,name=commitDoneHeight,proto3" json:"commitDoneHeight,omitempty"`
+	ParaHeight       int64 `protobuf:"varint,22,opt,name=paraHeight,proto3" json:"paraHeight,omitempty"`
+	Success          bool  `protobuf:"varint,23,opt,name=success,proto3" json:"success,omitempty"`
+	// 主链投递到目标平行链的高度
+	CrossInHeight        int64    `protobuf:"varint,24,opt,name=crossInHeight,proto3" json:"crossInHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3741,7 +4436,7 @@ func (m *ParacrossAsset) Reset()         { *m = ParacrossAsset{} }
 func (m *ParacrossAsset) String() string { return proto.CompactTextString(m) }
 func (*ParacrossAsset) ProtoMessage()    {}
 func (*ParacrossAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{55}
+	return fileDescriptor_6a397e38c9ea6747, []int{64}
 }
 
 func (m *ParacrossAsset) XXX_Unmarshal(b []byte) error {
@@ -3818,6 +4513,13 @@ func (m *ParacrossAsset) GetCrossType() uint32 {
 	return 0
 }
 
+func (m *ParacrossAsset) GetToParaTitle() string {
+	if m != nil {
+		return m.ToParaTitle
+	}
+	return ""
+}
+
 func (m *ParacrossAsset) GetHeight() int64 {
 	if m != nil {
 		return m.Height
@@ -3846,6 +4548,13 @@ func (m *ParacrossAsset) GetSuccess() bool {
 	return false
 }
 
+func (m *ParacrossAsset) GetCrossInHeight() int64 {
+	if m != nil {
+		return m.CrossInHeight
+	}
+	return 0
+}
+
 type ParaLocalDbBlock struct {
 	Height               int64                `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	MainHash             []byte               `protobuf:"bytes,2,opt,name=mainHash,proto3" json:"mainHash,omitempty"`
@@ -3853,6 +4562,7 @@ type ParaLocalDbBlock struct {
 	ParentMainHash       []byte               `protobuf:"bytes,4,opt,name=parentMainHash,proto3" json:"parentMainHash,omitempty"`
 	BlockTime            int64                `protobuf:"varint,5,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
 	Txs                  []*types.Transaction `protobuf:"bytes,6,rep,name=txs,proto3" json:"txs,omitempty"`
+	CrossIns             []*ParaCrossIn       `protobuf:"bytes,7,rep,name=crossIns,proto3" json:"crossIns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -3862,7 +4572,7 @@ func (m *ParaLocalDbBlock) Reset()         { *m = ParaLocalDbBlock{} }
 func (m *ParaLocalDbBlock) String() string { return proto.CompactTextString(m) }
 func (*ParaLocalDbBlock) ProtoMessage()    {}
 func (*ParaLocalDbBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{56}
+	return fileDescriptor_6a397e38c9ea6747, []int{65}
 }
 
 func (m *ParaLocalDbBlock) XXX_Unmarshal(b []byte) error {
@@ -3925,6 +4635,13 @@ func (m *ParaLocalDbBlock) GetTxs() []*types.Transaction {
 	return nil
 }
 
+func (m *ParaLocalDbBlock) GetCrossIns() []*ParaCrossIn {
+	if m != nil {
+		return m.CrossIns
+	}
+	return nil
+}
+
 type ParaLocalDbBlockInfo struct {
 	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	MainHash             string   `protobuf:"bytes,2,opt,name=mainHash,proto3" json:"mainHash,omitempty"`
@@ -3941,7 +4658,7 @@ func (m *ParaLocalDbBlockInfo) Reset()         { *m = ParaLocalDbBlockInfo{} }
 func (m *ParaLocalDbBlockInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaLocalDbBlockInfo) ProtoMessage()    {}
 func (*ParaLocalDbBlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{57}
+	return fileDescriptor_6a397e38c9ea6747, []int{66}
 }
 
 func (m *ParaLocalDbBlockInfo) XXX_Unmarshal(b []byte) error {
@@ -4009,6 +4726,7 @@ type ParaBlsSignSumDetails struct {
 	Addrs                []string `protobuf:"bytes,2,rep,name=addrs,proto3" json:"addrs,omitempty"`
 	Msgs                 [][]byte `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
 	Signs                [][]byte `protobuf:"bytes,4,rep,name=signs,proto3" json:"signs,omitempty"`
+	PartialSigns         [][]byte `protobuf:"bytes,5,rep,name=partialSigns,proto3" json:"partialSigns,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4018,7 +4736,7 @@ func (m *ParaBlsSignSumDetails) Reset()         { *m = ParaBlsSignSumDetails{} }
 func (m *ParaBlsSignSumDetails) String() string { return proto.CompactTextString(m) }
 func (*ParaBlsSignSumDetails) ProtoMessage()    {}
 func (*ParaBlsSignSumDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{58}
+	return fileDescriptor_6a397e38c9ea6747, []int{67}
 }
 
 func (m *ParaBlsSignSumDetails) XXX_Unmarshal(b []byte) error {
@@ -4067,6 +4785,13 @@ func (m *ParaBlsSignSumDetails) GetSigns() [][]byte {
 	return nil
 }
 
+func (m *ParaBlsSignSumDetails) GetPartialSigns() [][]byte {
+	if m != nil {
+		return m.PartialSigns
+	}
+	return nil
+}
+
 type ParaBlsSignSumDetailsShow struct {
 	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 	Addrs                []string `protobuf:"bytes,2,rep,name=addrs,proto3" json:"addrs,omitempty"`
@@ -4080,7 +4805,7 @@ func (m *ParaBlsSignSumDetailsShow) Reset()         { *m = ParaBlsSignSumDetails
 func (m *ParaBlsSignSumDetailsShow) String() string { return proto.CompactTextString(m) }
 func (*ParaBlsSignSumDetailsShow) ProtoMessage()    {}
 func (*ParaBlsSignSumDetailsShow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{59}
+	return fileDescriptor_6a397e38c9ea6747, []int{68}
 }
 
 func (m *ParaBlsSignSumDetailsShow) XXX_Unmarshal(b []byte) error {
@@ -4134,7 +4859,7 @@ func (m *ParaBlsSignSumInfo) Reset()         { *m = ParaBlsSignSumInfo{} }
 func (m *ParaBlsSignSumInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaBlsSignSumInfo) ProtoMessage()    {}
 func (*ParaBlsSignSumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{60}
+	return fileDescriptor_6a397e38c9ea6747, []int{69}
 }
 
 func (m *ParaBlsSignSumInfo) XXX_Unmarshal(b []byte) error {
@@ -4182,7 +4907,7 @@ func (m *LeaderSyncInfo) Reset()         { *m = LeaderSyncInfo{} }
 func (m *LeaderSyncInfo) String() string { return proto.CompactTextString(m) }
 func (*LeaderSyncInfo) ProtoMessage()    {}
 func (*LeaderSyncInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{61}
+	return fileDescriptor_6a397e38c9ea6747, []int{70}
 }
 
 func (m *LeaderSyncInfo) XXX_Unmarshal(b []byte) error {
@@ -4229,6 +4954,7 @@ type ParaP2PSubMsg struct {
 	// Types that are valid to be assigned to Value:
 	//	*ParaP2PSubMsg_CommitTx
 	//	*ParaP2PSubMsg_SyncMsg
+	//	*ParaP2PSubMsg_DkgDeal
 	Value                isParaP2PSubMsg_Value `protobuf_oneof:"value"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
@@ -4239,7 +4965,7 @@ func (m *ParaP2PSubMsg) Reset()         { *m = ParaP2PSubMsg{} }
 func (m *ParaP2PSubMsg) String() string { return proto.CompactTextString(m) }
 func (*ParaP2PSubMsg) ProtoMessage()    {}
 func (*ParaP2PSubMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{62}
+	return fileDescriptor_6a397e38c9ea6747, []int{71}
 }
 
 func (m *ParaP2PSubMsg) XXX_Unmarshal(b []byte) error {
@@ -4279,10 +5005,16 @@ type ParaP2PSubMsg_SyncMsg struct {
 	SyncMsg *LeaderSyncInfo `protobuf:"bytes,11,opt,name=syncMsg,proto3,oneof"`
 }
 
+type ParaP2PSubMsg_DkgDeal struct {
+	DkgDeal *ParaBlsDkgDeal `protobuf:"bytes,12,opt,name=dkgDeal,proto3,oneof"`
+}
+
 func (*ParaP2PSubMsg_CommitTx) isParaP2PSubMsg_Value() {}
 
 func (*ParaP2PSubMsg_SyncMsg) isParaP2PSubMsg_Value() {}
 
+func (*ParaP2PSubMsg_DkgDeal) isParaP2PSubMsg_Value() {}
+
 func (m *ParaP2PSubMsg) GetValue() isParaP2PSubMsg_Value {
 	if m != nil {
 		return m.Value
@@ -4304,11 +5036,19 @@ func (m *ParaP2PSubMsg) GetSyncMsg() *LeaderSyncInfo {
 	return nil
 }
 
+func (m *ParaP2PSubMsg) GetDkgDeal() *ParaBlsDkgDeal {
+	if x, ok := m.GetValue().(*ParaP2PSubMsg_DkgDeal); ok {
+		return x.DkgDeal
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ParaP2PSubMsg) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ParaP2PSubMsg_CommitTx)(nil),
 		(*ParaP2PSubMsg_SyncMsg)(nil),
+		(*ParaP2PSubMsg_DkgDeal)(nil),
 	}
 }
 
@@ -4325,7 +5065,7 @@ func (m *ElectionStatus) Reset()         { *m = ElectionStatus{} }
 func (m *ElectionStatus) String() string { return proto.CompactTextString(m) }
 func (*ElectionStatus) ProtoMessage()    {}
 func (*ElectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{63}
+	return fileDescriptor_6a397e38c9ea6747, []int{72}
 }
 
 func (m *ElectionStatus) XXX_Unmarshal(b []byte) error {
@@ -4362,6 +5102,7 @@ func (m *ElectionStatus) GetLeader() *LeaderSyncInfo {
 
 type BlsPubKey struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Pop                  string   `protobuf:"bytes,2,opt,name=pop,proto3" json:"pop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4371,7 +5112,7 @@ func (m *BlsPubKey) Reset()         { *m = BlsPubKey{} }
 func (m *BlsPubKey) String() string { return proto.CompactTextString(m) }
 func (*BlsPubKey) ProtoMessage()    {}
 func (*BlsPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{64}
+	return fileDescriptor_6a397e38c9ea6747, []int{73}
 }
 
 func (m *BlsPubKey) XXX_Unmarshal(b []byte) error {
@@ -4399,6 +5140,13 @@ func (m *BlsPubKey) GetKey() string {
 	return ""
 }
 
+func (m *BlsPubKey) GetPop() string {
+	if m != nil {
+		return m.Pop
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ParacrossStatusDetails)(nil), "types.ParacrossStatusDetails")
 	proto.RegisterType((*ParacrossStatusBlockDetails)(nil), "types.ParacrossStatusBlockDetails")
@@ -4445,6 +5193,15 @@ func init() {
 	proto.RegisterType((*ParacrossCommitAction)(nil), "types.ParacrossCommitAction")
 	proto.RegisterType((*ParacrossMinerAction)(nil), "types.ParacrossMinerAction")
 	proto.RegisterType((*CrossAssetTransfer)(nil), "types.CrossAssetTransfer")
+	proto.RegisterType((*ParaCrossIn)(nil), "types.ParaCrossIn")
+	proto.RegisterType((*ParaCrossInQueue)(nil), "types.ParaCrossInQueue")
+	proto.RegisterType((*ParaCrossInRefund)(nil), "types.ParaCrossInRefund")
+	proto.RegisterType((*ReceiptParaCrossIn)(nil), "types.ReceiptParaCrossIn")
+	proto.RegisterType((*ParaBlsGroupKey)(nil), "types.ParaBlsGroupKey")
+	proto.RegisterType((*ParaBlsGroupKeyStatus)(nil), "types.ParaBlsGroupKeyStatus")
+	proto.RegisterType((*ReceiptParaBlsGroupKey)(nil), "types.ReceiptParaBlsGroupKey")
+	proto.RegisterType((*ParaBlsDkgShare)(nil), "types.ParaBlsDkgShare")
+	proto.RegisterType((*ParaBlsDkgDeal)(nil), "types.ParaBlsDkgDeal")
 	proto.RegisterType((*ParacrossAction)(nil), "types.ParacrossAction")
 	proto.RegisterType((*ReceiptParacrossCommit)(nil), "types.ReceiptParacrossCommit")
 	proto.RegisterType((*ReceiptParacrossMiner)(nil), "types.ReceiptParacrossMiner")
@@ -4472,192 +5229,222 @@ func init() {
 }
 
 var fileDescriptor_6a397e38c9ea6747 = []byte{
-	// 2946 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x6f, 0x24, 0x47,
-	0xf5, 0xee, 0xf9, 0xf2, 0xcc, 0xb3, 0xc7, 0x5e, 0x77, 0xbc, 0xce, 0xc4, 0x49, 0x56, 0x56, 0x2b,
-	0xbf, 0xc8, 0x3f, 0xb2, 0xf1, 0x66, 0x9d, 0x10, 0x14, 0x21, 0x04, 0xb1, 0x77, 0x93, 0xb1, 0xb2,
-	0x0e, 0x9b, 0xb2, 0x03, 0x48, 0x11, 0x88, 0xf6, 0x4c, 0xd9, 0x6e, 0x65, 0xa6, 0x7b, 0xb6, 0xab,
-	0x27, 0x6b, 0x23, 0xa4, 0x70, 0x00, 0x6e, 0x48, 0x5c, 0x90, 0x00, 0x09, 0x2e, 0x70, 0x43, 0xe2,
-	0xc4, 0x99, 0x03, 0x12, 0x97, 0x88, 0x4b, 0x38, 0x72, 0xe3, 0x86, 0xc4, 0x91, 0x7f, 0x00, 0xbd,
-	0x57, 0x55, 0xdd, 0x55, 0xd5, 0x3d, 0x63, 0x27, 0x9b, 0x0b, 0xb7, 0xae, 0xd7, 0xaf, 0xaa, 0xde,
-	0xf7, 0x57, 0x37, 0xac, 0x4e, 0xc2, 0x34, 0x1c, 0xa4, 0x89, 0x10, 0x3b, 0x93, 0x34, 0xc9, 0x12,
-	0xbf, 0x99, 0x5d, 0x4e, 0xb8, 0xd8, 0x5c, 0xcb, 0xd2, 0x30, 0x16, 0xe1, 0x20, 0x8b, 0x92, 0x58,
-	0xbe, 0xd9, 0x5c, 0x1e, 0x24, 0xe3, 0x71, 0xbe, 0xba, 0x71, 0x32, 0x4a, 0x06, 0x1f, 0x0e, 0xce,
-	0xc3, 0x48, 0x41, 0x82, 0x07, 0xb0, 0xf1, 0x50, 0x1f, 0x76, 0x94, 0x85, 0xd9, 0x54, 0xdc, 0xe3,
-	0x59, 0x18, 0x8d, 0x84, 0xbf, 0x0e, 0xcd, 0x70, 0x38, 0x4c, 0x45, 0xcf, 0xdb, 0xaa, 0x6f, 0x77,
-	0x98, 0x5c, 0xf8, 0xcf, 0x41, 0x87, 0xce, 0xe8, 0x87, 0xe2, 0xbc, 0x57, 0xdb, 0xaa, 0x6f, 0x2f,
-	0xb3, 0x02, 0x10, 0x7c, 0x00, 0xcf, 0x3a, 0xa7, 0xed, 0xe1, 0x3b, 0x7d, 0xe4, 0x2d, 0x80, 0x1c,
-	0x57, 0x9e, 0xbb, 0xcc, 0x0c, 0x08, 0x1e, 0x9e, 0x5d, 0x30, 0x2e, 0xa6, 0xa3, 0x4c, 0xe8, 0xc3,
-	0x73, 0x40, 0xf0, 0xeb, 0x1a, 0xdc, 0xcc, 0x4f, 0xef, 0xf3, 0xe8, 0xec, 0x3c, 0x93, 0x77, 0xf8,
-	0x1b, 0xd0, 0x12, 0xf4, 0xd4, 0xf3, 0xb6, 0xbc, 0xed, 0x26, 0x53, 0x2b, 0x64, 0x21, 0x8b, 0xb2,
-	0x11, 0xef, 0xd5, 0xb6, 0x3c, 0x64, 0x81, 0x16, 0x88, 0x7d, 0x4e, 0xbb, 0x7b, 0xf5, 0x2d, 0x6f,
-	0xbb, 0xce, 0xd4, 0xca, 0xff, 0x0a, 0x2c, 0x0e, 0x25, 0xa1, 0xbd, 0xc6, 0x96, 0xb7, 0xbd, 0xb4,
-	0xfb, 0xfc, 0x0e, 0x89, 0x75, 0xa7, 0x5a, 0x40, 0x4c, 0x63, 0x23, 0x5b, 0xe3, 0x30, 0x8a, 0x25,
-	0x49, 0xbd, 0x26, 0x1d, 0x6a, 0x40, 0xfc, 0x4d, 0x68, 0xd3, 0x0a, 0x45, 0xd6, 0xda, 0xf2, 0xb6,
-	0x97, 0x59, 0xbe, 0xf6, 0xdf, 0x82, 0xe5, 0x13, 0x43, 0x44, 0xbd, 0x45, 0xba, 0x39, 0xa8, 0xbe,
-	0xd9, 0x14, 0x26, 0xb3, 0xf6, 0x05, 0xff, 0xf2, 0xa0, 0x57, 0x29, 0x1c, 0x26, 0x26, 0x5f, 0x90,
-	0x7c, 0x6c, 0x36, 0x1b, 0x73, 0xd9, 0x6c, 0xd2, 0x81, 0x05, 0x9b, 0x5b, 0xb0, 0x84, 0x86, 0x18,
-	0x65, 0x6f, 0x92, 0x49, 0xb5, 0xc8, 0xa4, 0x4c, 0x90, 0xbf, 0x0d, 0xab, 0x72, 0xb9, 0x97, 0x9b,
-	0xd7, 0x22, 0x61, 0xb9, 0xe0, 0xe0, 0x57, 0x1e, 0xac, 0x3a, 0x82, 0x29, 0x38, 0xf1, 0xaa, 0x39,
-	0xa9, 0x59, 0x9c, 0x58, 0x46, 0x5c, 0x27, 0x8d, 0x14, 0x80, 0xcf, 0xcc, 0xa7, 0xa1, 0xce, 0xe0,
-	0xf7, 0xa6, 0x1a, 0xf6, 0x93, 0x58, 0xf0, 0x58, 0x4c, 0xe7, 0x13, 0x89, 0xa2, 0x39, 0x2f, 0xee,
-	0x93, 0x94, 0x9a, 0x20, 0xff, 0x05, 0xe8, 0x0e, 0xe4, 0x51, 0x7d, 0x53, 0x2f, 0x36, 0xd0, 0xff,
-	0x12, 0xdc, 0x50, 0x80, 0x42, 0x82, 0x0d, 0xba, 0xa8, 0x04, 0x0f, 0xfe, 0xe4, 0x81, 0x8f, 0x64,
-	0xbe, 0x9b, 0x0c, 0x39, 0x8a, 0x7f, 0x3f, 0x89, 0x4f, 0xa3, 0xb3, 0x19, 0x04, 0xae, 0x40, 0x2d,
-	0x99, 0x10, 0x5d, 0x5d, 0x56, 0x4b, 0x26, 0xb8, 0x8e, 0x86, 0x44, 0x43, 0x87, 0xd5, 0xa2, 0xa1,
-	0xef, 0x43, 0x03, 0x63, 0x83, 0xba, 0x8c, 0x9e, 0xf1, 0xa4, 0x8f, 0xc2, 0xd1, 0x94, 0x93, 0x80,
-	0xba, 0x4c, 0x2e, 0xa4, 0x15, 0x44, 0xb1, 0x78, 0x2b, 0x4d, 0x7e, 0xc0, 0x63, 0xf2, 0x05, 0x64,
-	0xb5, 0x00, 0x49, 0xcd, 0x88, 0x87, 0xd3, 0x93, 0x77, 0xf8, 0x25, 0xf9, 0x42, 0x87, 0x15, 0x80,
-	0xe0, 0x1b, 0x05, 0xd5, 0xdf, 0x4a, 0x32, 0x2e, 0x6d, 0x7f, 0x46, 0xa0, 0x42, 0x0a, 0x92, 0x8c,
-	0xcb, 0x38, 0xd2, 0x61, 0x72, 0x11, 0xfc, 0xd1, 0x83, 0x75, 0x93, 0xf1, 0x83, 0xa1, 0xd2, 0x8d,
-	0x66, 0xc2, 0x33, 0x98, 0xb8, 0x05, 0x30, 0x49, 0x93, 0x49, 0x22, 0xc2, 0xd1, 0xc1, 0x50, 0xf9,
-	0x88, 0x01, 0x41, 0xf3, 0x7a, 0x34, 0x8d, 0xb2, 0x03, 0x2d, 0x0c, 0xb5, 0x32, 0xdc, 0xad, 0x51,
-	0xed, 0x6e, 0x4d, 0x53, 0xbc, 0x16, 0xcb, 0x2d, 0x97, 0xe5, 0x5f, 0xd4, 0xe0, 0x86, 0x26, 0x38,
-	0x27, 0x56, 0x6a, 0xc0, 0xcb, 0x35, 0x50, 0x5c, 0x58, 0xab, 0xbe, 0xb0, 0x6e, 0x5e, 0x78, 0x0b,
-	0x20, 0x0b, 0xd3, 0x33, 0x4e, 0x8e, 0xa7, 0xb4, 0x66, 0x40, 0x5c, 0x2d, 0x35, 0xcb, 0x5a, 0xba,
-	0xa3, 0x65, 0xdb, 0xa2, 0x68, 0xf5, 0x8c, 0x11, 0xad, 0x6c, 0xdd, 0x28, 0xb1, 0xa3, 0xcb, 0x9c,
-	0xa6, 0xc9, 0x98, 0x2e, 0x94, 0x5a, 0xcd, 0xd7, 0x86, 0x93, 0xb6, 0xcb, 0x4e, 0xaa, 0xe5, 0xd2,
-	0x71, 0xe5, 0xf2, 0x67, 0x0f, 0x6e, 0x32, 0x3e, 0xe0, 0xd1, 0x24, 0xd3, 0xd7, 0x2a, 0x23, 0xae,
-	0xd2, 0xe4, 0x5d, 0x68, 0x0d, 0xe8, 0x2d, 0x09, 0xa8, 0x4c, 0x71, 0xe1, 0x03, 0x4c, 0x21, 0xfa,
-	0x2f, 0x41, 0x63, 0x92, 0xf2, 0x8f, 0x48, 0x74, 0x4b, 0xbb, 0x4f, 0x3b, 0x1b, 0xb4, 0x2a, 0x18,
-	0x21, 0xf9, 0x77, 0x61, 0x71, 0x30, 0x4d, 0x53, 0x1e, 0x67, 0x2a, 0x75, 0xcc, 0xc4, 0xd7, 0x78,
-	0xc1, 0xef, 0x3c, 0x78, 0xde, 0x61, 0x00, 0xa9, 0x40, 0xb4, 0xf7, 0x27, 0xc3, 0x30, 0xe3, 0x96,
-	0xd0, 0x3c, 0x47, 0x68, 0x77, 0x14, 0x75, 0x92, 0x9d, 0x67, 0x2b, 0xd8, 0x71, 0x28, 0xfc, 0x72,
-	0x41, 0x61, 0xfd, 0xea, 0x3d, 0x39, 0x95, 0xff, 0xf1, 0xe0, 0x69, 0x87, 0x4a, 0xd2, 0x6e, 0x12,
-	0xf3, 0x92, 0x15, 0x56, 0x67, 0x13, 0xdb, 0xda, 0xea, 0x25, 0x6b, 0xc3, 0xf7, 0x49, 0x16, 0x8e,
-	0xf0, 0x68, 0xed, 0x30, 0x06, 0x84, 0x6a, 0x02, 0x5c, 0xe1, 0xb5, 0x64, 0x8b, 0x4d, 0x56, 0x00,
-	0x28, 0x16, 0x27, 0x22, 0xa3, 0x97, 0x2d, 0x7a, 0x99, 0xaf, 0xfd, 0x1e, 0x2c, 0xa2, 0xf5, 0x31,
-	0x91, 0x29, 0x9b, 0xd3, 0x4b, 0xbc, 0x73, 0x98, 0xc4, 0x5c, 0x32, 0x4b, 0x66, 0xd7, 0x64, 0x06,
-	0x04, 0x75, 0xf3, 0x94, 0x66, 0xf7, 0xed, 0x34, 0x99, 0x4e, 0x9e, 0x28, 0x3e, 0xe6, 0xf1, 0x49,
-	0xba, 0x9a, 0x8a, 0x4f, 0x57, 0x7b, 0x19, 0x55, 0x4b, 0xca, 0xde, 0x85, 0x8a, 0x0c, 0x06, 0x24,
-	0xf8, 0xb7, 0x4b, 0xe5, 0x17, 0x12, 0x1d, 0xb6, 0x60, 0xa9, 0xd0, 0x8e, 0xa6, 0xd9, 0x04, 0x5d,
-	0x83, 0x72, 0xd3, 0x72, 0x5b, 0x33, 0xdd, 0x7d, 0xd1, 0xad, 0x2e, 0x0c, 0x6e, 0xdb, 0x25, 0x6e,
-	0x3f, 0xf1, 0x60, 0xd3, 0xb1, 0x44, 0x53, 0x35, 0x55, 0x5e, 0xbf, 0xeb, 0x78, 0xfd, 0xa6, 0x63,
-	0xf2, 0xc6, 0xfe, 0xdc, 0xed, 0x77, 0x2c, 0xb7, 0xaf, 0xdc, 0x61, 0xf9, 0xd5, 0x6b, 0xae, 0xe7,
-	0xcf, 0xdb, 0x92, 0xbb, 0xd5, 0x4f, 0x3d, 0x58, 0x67, 0xfc, 0x51, 0x5e, 0x29, 0x50, 0x88, 0x88,
-	0x4f, 0x93, 0xd9, 0x16, 0x16, 0xe9, 0x04, 0x64, 0x66, 0xdc, 0xba, 0xc1, 0xec, 0xac, 0xa4, 0x63,
-	0x85, 0xd1, 0xa6, 0x1b, 0x46, 0xf7, 0x61, 0x83, 0x71, 0x31, 0xb1, 0x08, 0x91, 0x5a, 0xfe, 0x7f,
-	0xa8, 0x47, 0x43, 0x99, 0x53, 0xe7, 0x84, 0x33, 0xc4, 0x09, 0xde, 0xc6, 0x18, 0xe1, 0x1c, 0x42,
-	0x6c, 0x0b, 0xff, 0xb6, 0x79, 0xca, 0x3c, 0xd1, 0xd0, 0x41, 0x13, 0x99, 0xeb, 0xf6, 0xa2, 0x78,
-	0x78, 0x18, 0xc5, 0x3c, 0xdd, 0x1f, 0x0f, 0xc9, 0x2e, 0xa2, 0x78, 0xf8, 0x26, 0x35, 0x35, 0xaa,
-	0x7e, 0x35, 0x20, 0xc4, 0x5f, 0x14, 0x0f, 0xf7, 0xd1, 0xfc, 0x54, 0xf1, 0x54, 0x00, 0x8a, 0xe8,
-	0x83, 0xf7, 0xd9, 0xd1, 0x07, 0x21, 0xc1, 0x5f, 0x3d, 0x58, 0xb3, 0xae, 0x24, 0x2d, 0xcc, 0x28,
-	0x06, 0xf0, 0xd8, 0x23, 0xd3, 0x93, 0x0c, 0x88, 0x4d, 0x47, 0x7d, 0x3e, 0x1d, 0x0d, 0x97, 0x8e,
-	0xbc, 0x22, 0x3d, 0x8e, 0xc6, 0x5c, 0x79, 0x54, 0x01, 0x40, 0x8f, 0x93, 0xe5, 0xa9, 0x74, 0x1c,
-	0x55, 0x37, 0x19, 0xa0, 0xe0, 0xe7, 0x1e, 0xf4, 0x0c, 0xef, 0xb8, 0x9a, 0x9d, 0xdb, 0x56, 0x02,
-	0xe9, 0x19, 0x9a, 0xb1, 0xf6, 0x2a, 0x2b, 0xdf, 0x75, 0xb3, 0xc7, 0xec, 0x0d, 0xb9, 0x8d, 0xdf,
-	0x97, 0x55, 0x3a, 0xb2, 0x87, 0x18, 0xdf, 0x8c, 0x89, 0x4b, 0x31, 0x9d, 0xf0, 0x94, 0x84, 0x20,
-	0xa9, 0x29, 0x00, 0x68, 0xfb, 0x63, 0x3c, 0x46, 0xe7, 0x0f, 0x5a, 0x04, 0xdf, 0x29, 0xea, 0x1f,
-	0x3c, 0xe6, 0x41, 0x24, 0xb2, 0x19, 0x5e, 0xb2, 0x03, 0x2d, 0xda, 0x22, 0x4b, 0xbe, 0xa5, 0xdd,
-	0x0d, 0xc7, 0xdc, 0x14, 0x15, 0x4c, 0x61, 0x05, 0x1f, 0x97, 0x12, 0xb0, 0xbe, 0x40, 0x25, 0x60,
-	0x5d, 0x02, 0x78, 0x95, 0x29, 0x5d, 0x23, 0x97, 0x4b, 0x80, 0xda, 0x7c, 0xfc, 0x5c, 0x42, 0x8f,
-	0x31, 0x08, 0x48, 0xbf, 0xb1, 0xd8, 0x7b, 0x09, 0x1a, 0xa3, 0x48, 0x64, 0x57, 0xde, 0x8b, 0x48,
-	0xa8, 0x1a, 0xdd, 0xb5, 0x4a, 0xb6, 0xe7, 0xa8, 0x46, 0x21, 0x06, 0x3f, 0xd1, 0x56, 0x8f, 0x16,
-	0xb4, 0x7b, 0x18, 0x46, 0xf1, 0x61, 0x38, 0x31, 0x22, 0xb3, 0x37, 0xbb, 0x5b, 0xaa, 0xe9, 0x08,
-	0x52, 0xdd, 0x2d, 0xd5, 0xe7, 0x76, 0x4b, 0x0d, 0xbb, 0x2b, 0x0c, 0xee, 0xc9, 0x7a, 0xbe, 0x20,
-	0x83, 0xcc, 0x75, 0x07, 0x9a, 0x51, 0xc6, 0xc7, 0x3a, 0x6a, 0x58, 0xfc, 0x98, 0x04, 0x33, 0x89,
-	0x16, 0xfc, 0xb3, 0x2e, 0xf3, 0x60, 0x1e, 0x7b, 0x94, 0x47, 0xbe, 0x00, 0x5d, 0xbc, 0xa9, 0xe8,
-	0x86, 0x3c, 0x6a, 0xd6, 0x6c, 0x20, 0xf6, 0x9d, 0x05, 0xc0, 0x6c, 0xc1, 0x5c, 0xf0, 0x8c, 0x7c,
-	0x59, 0x48, 0xad, 0x61, 0x49, 0x2d, 0x80, 0xe5, 0x49, 0xca, 0x8b, 0xcb, 0x65, 0xa7, 0x68, 0xc1,
-	0x6c, 0xc9, 0xb6, 0xdc, 0x3e, 0x54, 0x9e, 0x80, 0xcc, 0x70, 0xd5, 0x0e, 0xeb, 0x13, 0x72, 0x18,
-	0x79, 0x54, 0x8e, 0xd0, 0x96, 0x27, 0xe4, 0x00, 0x94, 0x7d, 0x76, 0xb1, 0x9f, 0x4c, 0xe3, 0x4c,
-	0x50, 0x05, 0xdd, 0x65, 0xf9, 0x5a, 0xbe, 0x93, 0xa3, 0x95, 0x1e, 0xc8, 0x2e, 0x56, 0xaf, 0xb1,
-	0x72, 0xca, 0x2e, 0xe4, 0x90, 0x66, 0x89, 0xa6, 0x30, 0x7a, 0x49, 0xad, 0x28, 0x8a, 0xf9, 0x58,
-	0x6f, 0x5d, 0x96, 0x32, 0xb5, 0x80, 0x48, 0xb9, 0x02, 0xc8, 0x43, 0xba, 0x74, 0x88, 0x05, 0xf3,
-	0x6f, 0xc3, 0x5a, 0x9c, 0xc4, 0xfb, 0xd4, 0xdb, 0x1f, 0x6b, 0x22, 0x57, 0x88, 0xc8, 0xf2, 0x8b,
-	0x60, 0x0f, 0xd6, 0x8e, 0xf8, 0xe8, 0x54, 0x75, 0xd4, 0x47, 0x59, 0x78, 0xc6, 0x85, 0xff, 0xb2,
-	0x6d, 0x28, 0xda, 0x51, 0x5c, 0x44, 0x6d, 0x27, 0x0f, 0xe0, 0x86, 0xfb, 0x0a, 0x23, 0xab, 0xc8,
-	0xc2, 0x34, 0xeb, 0x9b, 0x86, 0x6f, 0x82, 0x50, 0xbf, 0x3c, 0x0e, 0x4f, 0x54, 0x59, 0xdb, 0x65,
-	0x6a, 0x15, 0xfc, 0xc3, 0x83, 0x75, 0xf7, 0x38, 0x32, 0xdf, 0xf9, 0xe5, 0x57, 0x37, 0x4f, 0xcc,
-	0x2f, 0x43, 0x53, 0xe0, 0x26, 0xa7, 0xc3, 0x28, 0x53, 0x4f, 0x58, 0x56, 0x4d, 0xd5, 0x70, 0x6a,
-	0xaa, 0x5b, 0x00, 0xfc, 0x82, 0x0f, 0xec, 0x01, 0x54, 0x01, 0xf9, 0xcc, 0xfd, 0x5a, 0xc0, 0x61,
-	0xe3, 0x41, 0x32, 0x08, 0x47, 0x9a, 0x98, 0x82, 0xbb, 0xbb, 0x9a, 0x6a, 0xcf, 0xea, 0x22, 0xaa,
-	0x24, 0xa1, 0x29, 0x27, 0x6b, 0x3a, 0x88, 0x87, 0xfc, 0x42, 0x45, 0x0f, 0xbd, 0x0c, 0x5e, 0x87,
-	0x15, 0x59, 0x7e, 0x21, 0x05, 0x95, 0xc2, 0xcb, 0xe7, 0x08, 0x35, 0x63, 0x8e, 0x10, 0x04, 0x70,
-	0x43, 0xee, 0xdb, 0x0f, 0xe3, 0x01, 0x1f, 0x55, 0xed, 0x0c, 0x3e, 0x55, 0x53, 0x22, 0x22, 0xe7,
-	0xaa, 0xfa, 0x3d, 0xbb, 0xd4, 0xf5, 0x7b, 0x76, 0x89, 0xd2, 0x92, 0x2c, 0xc2, 0x5c, 0xc5, 0xf4,
-	0x17, 0x34, 0x83, 0x2f, 0x41, 0x03, 0xc5, 0xd6, 0x5b, 0x22, 0xfc, 0x9b, 0x0a, 0xdf, 0xe6, 0xac,
-	0xbf, 0xc0, 0x08, 0x89, 0x5a, 0x51, 0xa2, 0x9a, 0x5c, 0xa7, 0x38, 0xde, 0x65, 0xa8, 0xbf, 0xc0,
-	0x14, 0xe2, 0xde, 0xa2, 0x12, 0x42, 0xf0, 0xe3, 0xa2, 0x06, 0xb6, 0x34, 0xa3, 0xd8, 0xbb, 0x63,
-	0xe5, 0xab, 0xb9, 0xaa, 0x29, 0x35, 0x85, 0xb5, 0xab, 0xf7, 0xe4, 0x79, 0xeb, 0x53, 0x0f, 0x9e,
-	0xab, 0x22, 0x63, 0x66, 0x67, 0x98, 0x9b, 0x7a, 0xed, 0x5a, 0xa6, 0x6e, 0xb7, 0x84, 0xf5, 0xf9,
-	0x2d, 0x61, 0x63, 0x5e, 0x4b, 0xd8, 0x9c, 0xdd, 0x12, 0xb6, 0xac, 0x96, 0x30, 0xf8, 0x18, 0x9e,
-	0xad, 0x62, 0x49, 0xa8, 0x52, 0xe0, 0xb6, 0x25, 0xda, 0xde, 0x0c, 0x06, 0x44, 0xb9, 0x5c, 0xaa,
-	0x5d, 0xb1, 0x21, 0x17, 0xea, 0x6f, 0x3d, 0xf0, 0x19, 0x7f, 0xf4, 0xde, 0x94, 0xa7, 0x97, 0x88,
-	0xa6, 0x62, 0x9c, 0x3d, 0xba, 0x2d, 0xa2, 0x87, 0xdb, 0x12, 0xac, 0x43, 0x73, 0x80, 0xa1, 0x52,
-	0x89, 0x4b, 0x2e, 0x50, 0x52, 0xc3, 0x28, 0xe5, 0xb2, 0x76, 0x56, 0x92, 0xca, 0x01, 0x46, 0xea,
-	0x6a, 0x5a, 0xa9, 0x6b, 0x1d, 0x9a, 0x11, 0xb9, 0xab, 0xec, 0xa8, 0xe5, 0x22, 0x78, 0x0f, 0xab,
-	0x95, 0xc9, 0xe8, 0xd2, 0xa5, 0xf0, 0x0d, 0x4a, 0x41, 0xd2, 0x46, 0x54, 0x24, 0x9e, 0x6b, 0x46,
-	0x05, 0x76, 0xf0, 0x3d, 0xe3, 0xe3, 0xc3, 0xbe, 0x9a, 0xf2, 0x0a, 0x5d, 0xb2, 0x8a, 0xe8, 0x2c,
-	0x56, 0x29, 0x9b, 0x9e, 0x51, 0xb1, 0xd4, 0x3a, 0x1f, 0x86, 0xb2, 0xdb, 0x5e, 0x66, 0xf9, 0xba,
-	0xe8, 0xb1, 0xeb, 0xc6, 0x0c, 0x30, 0xf8, 0xa1, 0xf1, 0xc1, 0x40, 0x9e, 0xaf, 0x9a, 0x86, 0x5d,
-	0x4b, 0xaa, 0x76, 0x67, 0xe2, 0x94, 0x11, 0xb9, 0xc4, 0xef, 0x40, 0xfd, 0x64, 0x24, 0x94, 0x42,
-	0x4b, 0x9f, 0x06, 0x2c, 0xf2, 0x19, 0x62, 0x06, 0x99, 0x1c, 0x35, 0xd2, 0x6b, 0x2a, 0xc2, 0x9e,
-	0xe0, 0xf2, 0x6d, 0x58, 0x8d, 0x84, 0x21, 0x4e, 0x95, 0x4d, 0xda, 0xcc, 0x05, 0x07, 0xbf, 0xf4,
-	0xc0, 0xdf, 0xc7, 0x53, 0xde, 0x14, 0x82, 0x67, 0xc7, 0x69, 0x18, 0x8b, 0x53, 0x9e, 0xa2, 0x25,
-	0x84, 0x08, 0xb8, 0x7f, 0xc1, 0x07, 0xba, 0xf4, 0xce, 0x01, 0x98, 0x06, 0x69, 0x71, 0x74, 0x39,
-	0x3e, 0x49, 0x46, 0xca, 0xac, 0x4c, 0x10, 0xda, 0x4a, 0x38, 0xce, 0x0d, 0xac, 0xce, 0xd4, 0x0a,
-	0xe1, 0x59, 0x62, 0x24, 0x25, 0xb5, 0x42, 0x05, 0xc6, 0xda, 0x03, 0x3b, 0x8c, 0x9e, 0x83, 0xdf,
-	0xb4, 0x8c, 0xc1, 0xbd, 0x12, 0xc6, 0xeb, 0xd8, 0xa3, 0xa3, 0xe8, 0x94, 0x30, 0x9e, 0xab, 0x16,
-	0xac, 0xc4, 0xa6, 0x98, 0x48, 0x6b, 0xff, 0x55, 0xdd, 0x2c, 0x94, 0xa7, 0x59, 0xae, 0xc0, 0x31,
-	0x50, 0x13, 0xae, 0xff, 0x35, 0xe8, 0x86, 0xa6, 0x54, 0x54, 0xcb, 0xae, 0x23, 0x36, 0x49, 0x4c,
-	0xe8, 0x97, 0xfd, 0x05, 0x66, 0x63, 0xe7, 0xdb, 0xbf, 0x1d, 0x65, 0xe7, 0xc3, 0x34, 0x7c, 0x4c,
-	0xcc, 0xb9, 0xdb, 0xf5, 0xcb, 0x7c, 0xbb, 0x06, 0xf8, 0xaf, 0x42, 0x3b, 0xd3, 0x17, 0xb7, 0xe6,
-	0x5f, 0x9c, 0x23, 0xe2, 0xa6, 0xc7, 0xfa, 0xba, 0xc5, 0xf9, 0xd7, 0xe5, 0x88, 0xfe, 0x7d, 0x58,
-	0xd1, 0x07, 0x1c, 0x27, 0xa4, 0xf1, 0xb6, 0x25, 0x25, 0xfb, 0x3e, 0x89, 0xd2, 0x5f, 0x60, 0xce,
-	0x26, 0xff, 0xab, 0x00, 0x71, 0x3e, 0x57, 0xa5, 0x02, 0x72, 0xde, 0xe4, 0xb4, 0xbf, 0xc0, 0x0c,
-	0x74, 0xff, 0x2d, 0x58, 0x8d, 0xed, 0x19, 0x8b, 0xca, 0xa7, 0x73, 0xa6, 0x30, 0xfd, 0x05, 0xe6,
-	0x6e, 0xf2, 0xf7, 0x60, 0x55, 0xe8, 0x60, 0xa3, 0xce, 0x91, 0x79, 0xd6, 0x6c, 0xef, 0x8c, 0xb7,
-	0x78, 0x86, 0xb3, 0xc1, 0x7f, 0x07, 0xfc, 0x41, 0xc9, 0x25, 0x54, 0xfe, 0xd5, 0x0c, 0x95, 0x7d,
-	0xa6, 0xbf, 0xc0, 0x2a, 0xb6, 0xf9, 0x5f, 0x87, 0xee, 0xc4, 0x6c, 0xad, 0x7a, 0xdd, 0x52, 0x9b,
-	0x66, 0x0e, 0x30, 0xd0, 0x0e, 0x2c, 0x7c, 0xa3, 0xde, 0x68, 0x62, 0xbd, 0x51, 0xa4, 0xf7, 0x4f,
-	0x3c, 0xd8, 0x30, 0x3a, 0x52, 0xc3, 0xfc, 0x67, 0x8d, 0xb7, 0x8c, 0xc2, 0xf2, 0x7a, 0x71, 0xe4,
-	0x15, 0x6b, 0xbc, 0x55, 0x72, 0x36, 0xeb, 0xc3, 0xa1, 0xcc, 0x65, 0xaf, 0xbb, 0x03, 0xae, 0xf9,
-	0x9b, 0xf2, 0x7c, 0xf6, 0x8e, 0x35, 0x9f, 0x2f, 0x7c, 0xf2, 0xf3, 0x84, 0xbf, 0xe0, 0x47, 0x0d,
-	0x4c, 0x3e, 0xf6, 0x69, 0x54, 0x69, 0xd8, 0xa5, 0x82, 0x57, 0x2a, 0x15, 0xb6, 0x60, 0x89, 0x56,
-	0x52, 0x8c, 0x4a, 0xe8, 0x26, 0xc8, 0x7f, 0x11, 0x56, 0xb0, 0x3c, 0x38, 0x0a, 0xc7, 0x5c, 0x21,
-	0xc9, 0x0c, 0xea, 0x40, 0x8b, 0xda, 0xb1, 0x51, 0xdd, 0xfd, 0x35, 0xdd, 0x9e, 0xb9, 0xe8, 0xcb,
-	0x5a, 0xf3, 0xfa, 0xb2, 0xc5, 0x39, 0x7d, 0x59, 0xdb, 0xe9, 0xcb, 0xac, 0x7e, 0xb1, 0xe3, 0xf6,
-	0x8b, 0x46, 0xd7, 0x06, 0x57, 0x74, 0x6d, 0x4b, 0xd7, 0xe9, 0xda, 0x96, 0x2b, 0xba, 0xb6, 0x52,
-	0x4f, 0xdd, 0xbd, 0x66, 0x4f, 0xbd, 0x52, 0xdd, 0x53, 0x6f, 0xc3, 0x2a, 0x7d, 0xe9, 0xbc, 0x5f,
-	0xb4, 0x2f, 0xab, 0x12, 0xd3, 0x01, 0x07, 0xdf, 0x2f, 0xfb, 0x06, 0xe3, 0x83, 0x24, 0x1d, 0x7e,
-	0x51, 0xbe, 0x11, 0xfc, 0x1f, 0x2c, 0xe5, 0xaf, 0x8f, 0x2f, 0x28, 0xb3, 0x5d, 0xe4, 0x73, 0x03,
-	0xcc, 0x6c, 0xb4, 0x92, 0xd3, 0xce, 0x62, 0x74, 0x7b, 0x8c, 0x76, 0xe0, 0x4e, 0x08, 0xae, 0xf3,
-	0x15, 0x3a, 0xf8, 0x43, 0x0d, 0xd6, 0xac, 0xb9, 0xe9, 0xff, 0x96, 0x45, 0x77, 0x3e, 0xaf, 0x45,
-	0x77, 0x0c, 0x8b, 0xae, 0xd0, 0x7f, 0xa7, 0x5a, 0xff, 0x6f, 0xc3, 0x53, 0x96, 0xb0, 0x48, 0xee,
-	0x18, 0xd0, 0x5a, 0x44, 0xb7, 0x3b, 0x2d, 0x2a, 0x09, 0x96, 0x29, 0x3c, 0x19, 0x98, 0x5c, 0xfd,
-	0x21, 0x0f, 0xd5, 0xda, 0x2b, 0x4d, 0xbf, 0xac, 0x1f, 0x5e, 0xfe, 0x56, 0x83, 0x95, 0xa2, 0xa4,
-	0xc1, 0x3c, 0x81, 0xe6, 0x88, 0x8d, 0xb9, 0x36, 0x47, 0x7c, 0xa6, 0x90, 0x9f, 0xe8, 0x6a, 0x3d,
-	0x4b, 0x50, 0xc9, 0x51, 0x9e, 0xba, 0x49, 0x3d, 0x6d, 0x66, 0x40, 0x0c, 0xdb, 0x6b, 0x98, 0xb6,
-	0x67, 0x54, 0x61, 0x4d, 0xab, 0x0a, 0xf3, 0xa1, 0x81, 0xed, 0xbe, 0xd2, 0x0b, 0x3d, 0x53, 0xe7,
-	0x20, 0xcb, 0x39, 0xf9, 0xf5, 0x4b, 0xad, 0x90, 0x21, 0xc9, 0xf8, 0xe5, 0x84, 0x93, 0x3e, 0xba,
-	0xac, 0x00, 0x18, 0xea, 0x07, 0x4b, 0xfd, 0xf4, 0x77, 0x01, 0x9a, 0x0d, 0xca, 0x52, 0x69, 0xea,
-	0x26, 0x61, 0x94, 0xe0, 0xf4, 0xdd, 0x3c, 0x4c, 0x43, 0x85, 0xb5, 0x21, 0xc7, 0x11, 0x05, 0x04,
-	0x03, 0x95, 0x98, 0x0e, 0x06, 0x5c, 0x88, 0xde, 0xd3, 0xc4, 0xba, 0x5e, 0x06, 0x7f, 0xf7, 0xe4,
-	0xb4, 0x97, 0x86, 0x0f, 0xf7, 0x4e, 0x28, 0x52, 0xcc, 0x9c, 0x4b, 0x9a, 0x93, 0xc5, 0x9a, 0xf3,
-	0x5b, 0xcd, 0x55, 0x53, 0xc9, 0x17, 0x61, 0x65, 0x12, 0x62, 0x9e, 0x3a, 0x34, 0x67, 0x93, 0xcb,
-	0xcc, 0x81, 0x5e, 0x31, 0x97, 0x7f, 0x01, 0xea, 0xd9, 0x85, 0xfc, 0x9b, 0x65, 0x69, 0xd7, 0x57,
-	0x96, 0x77, 0x5c, 0xfc, 0x83, 0xc5, 0xf0, 0x75, 0xf0, 0x17, 0xf5, 0xcf, 0x81, 0xc9, 0x14, 0x35,
-	0x39, 0xd7, 0x65, 0xac, 0xf3, 0xc4, 0x8c, 0x75, 0x3e, 0x23, 0x63, 0x37, 0x0a, 0xc6, 0x3a, 0x92,
-	0x89, 0x44, 0xb6, 0x52, 0x7b, 0x23, 0x71, 0x14, 0x9d, 0xc5, 0x47, 0xd3, 0xb1, 0xfe, 0xa7, 0x6b,
-	0x16, 0x13, 0x79, 0x47, 0x56, 0x33, 0xff, 0xca, 0xf0, 0xa1, 0x31, 0x16, 0x67, 0xb2, 0x4d, 0x5b,
-	0x66, 0xf4, 0x8c, 0x98, 0xd8, 0xdf, 0x89, 0x5e, 0x83, 0x80, 0x72, 0x11, 0x7c, 0x17, 0x9e, 0xa9,
-	0xbc, 0xf0, 0xe8, 0x3c, 0x79, 0xfc, 0x04, 0x97, 0x76, 0xe4, 0xa5, 0xc1, 0x89, 0x1e, 0x3d, 0xeb,
-	0xe3, 0x49, 0x23, 0xaf, 0x41, 0x23, 0x2a, 0xda, 0xd8, 0x2d, 0x6b, 0xf2, 0x5c, 0x41, 0x07, 0x23,
-	0x6c, 0xd9, 0x03, 0x4d, 0xa2, 0x81, 0xbe, 0x56, 0xad, 0x02, 0x06, 0x2b, 0x0f, 0x78, 0x38, 0xe4,
-	0xe9, 0xd1, 0x65, 0x3c, 0xd0, 0x43, 0xaa, 0x83, 0x7b, 0x7a, 0x30, 0x72, 0x70, 0x0f, 0x3d, 0xe1,
-	0x24, 0x14, 0xfc, 0x60, 0x78, 0xa1, 0x02, 0xb9, 0x5e, 0xe2, 0x99, 0xc9, 0xe9, 0xa9, 0xe0, 0x3a,
-	0x78, 0xab, 0x55, 0xf0, 0x33, 0x0f, 0xba, 0x48, 0xcf, 0xc3, 0xdd, 0x87, 0x47, 0xd3, 0x93, 0x43,
-	0x71, 0xa6, 0xca, 0x49, 0x4f, 0x97, 0x93, 0xfe, 0x2b, 0xd0, 0x1e, 0xa8, 0xe1, 0xa9, 0xaa, 0xb8,
-	0x2b, 0x2c, 0x13, 0xdb, 0x05, 0x8d, 0xe5, 0xdf, 0x85, 0x45, 0x71, 0x19, 0x0f, 0x0e, 0xc5, 0x99,
-	0x33, 0xc2, 0xb2, 0xa9, 0xef, 0x2f, 0x30, 0x8d, 0x57, 0xd4, 0xac, 0x1f, 0xc0, 0xca, 0xfd, 0x91,
-	0x9c, 0x27, 0xa8, 0xb1, 0xfb, 0x26, 0xb4, 0x23, 0x21, 0x77, 0x12, 0x55, 0x6d, 0x96, 0xaf, 0xfd,
-	0x97, 0xa1, 0x35, 0x92, 0x6f, 0x6a, 0x73, 0x2e, 0x62, 0x0a, 0x29, 0x78, 0x1e, 0x3a, 0x7b, 0xfa,
-	0x53, 0x25, 0xda, 0xe4, 0x87, 0xfc, 0x52, 0x09, 0x0f, 0x1f, 0x77, 0xdf, 0x80, 0x4e, 0xfe, 0x23,
-	0xa4, 0x7f, 0x1b, 0x5a, 0x07, 0x02, 0x4f, 0xf0, 0xbb, 0x79, 0x0a, 0x78, 0xf4, 0x6e, 0x34, 0xda,
-	0x5c, 0x53, 0xcb, 0x03, 0xb1, 0x1f, 0x4e, 0xcf, 0xce, 0xb3, 0xf7, 0x27, 0xc1, 0xc2, 0x49, 0x8b,
-	0xfe, 0x7e, 0x7c, 0xf5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x27, 0x7c, 0x4a, 0x4a, 0x29,
-	0x00, 0x00,
+	// 3429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0xdd, 0x6f, 0x1c, 0x57,
+	0xf5, 0x9e, 0xfd, 0xf2, 0xee, 0xf1, 0xae, 0xed, 0x4c, 0x1d, 0x67, 0x9a, 0xa6, 0x91, 0x35, 0xca,
+	0xaf, 0xf2, 0xef, 0x97, 0x34, 0x69, 0xdd, 0xfe, 0x8a, 0x5a, 0x84, 0x68, 0xed, 0xa4, 0x5d, 0xab,
+	0x49, 0x49, 0xaf, 0x5d, 0x40, 0xaa, 0x10, 0x8c, 0x77, 0xaf, 0xed, 0x51, 0x76, 0x67, 0x36, 0x33,
+	0xb3, 0x8d, 0x8d, 0x40, 0x45, 0x88, 0xf2, 0x8c, 0x04, 0x42, 0x82, 0x4a, 0xbc, 0x50, 0xf1, 0x82,
+	0xf8, 0x07, 0x90, 0x40, 0x08, 0x09, 0x1e, 0x2a, 0x5e, 0xfa, 0xca, 0x1b, 0x6f, 0xbc, 0xf3, 0x0f,
+	0xa0, 0x73, 0xee, 0xc7, 0xdc, 0x3b, 0x33, 0xbb, 0x76, 0x9a, 0xf2, 0xc0, 0x9b, 0xcf, 0x99, 0x73,
+	0xef, 0x3d, 0x5f, 0xf7, 0x7c, 0xdd, 0x35, 0xac, 0x4c, 0x82, 0x24, 0x18, 0x24, 0x71, 0x9a, 0xde,
+	0x9c, 0x24, 0x71, 0x16, 0xbb, 0xcd, 0xec, 0x74, 0xc2, 0xd3, 0xcb, 0x17, 0xb2, 0x24, 0x88, 0xd2,
+	0x60, 0x90, 0x85, 0x71, 0x24, 0xbe, 0x5c, 0xee, 0x0e, 0xe2, 0xf1, 0x58, 0x43, 0xab, 0x07, 0xa3,
+	0x78, 0xf0, 0x60, 0x70, 0x1c, 0x84, 0x12, 0xe3, 0xdf, 0x85, 0xf5, 0xfb, 0x6a, 0xb3, 0xbd, 0x2c,
+	0xc8, 0xa6, 0xe9, 0x6d, 0x9e, 0x05, 0xe1, 0x28, 0x75, 0xd7, 0xa0, 0x19, 0x0c, 0x87, 0x49, 0xea,
+	0x39, 0x1b, 0xf5, 0xcd, 0x0e, 0x13, 0x80, 0x7b, 0x05, 0x3a, 0xb4, 0x47, 0x3f, 0x48, 0x8f, 0xbd,
+	0xda, 0x46, 0x7d, 0xb3, 0xcb, 0x72, 0x84, 0xff, 0x3e, 0x3c, 0x53, 0xd8, 0x6d, 0x1b, 0xbf, 0xa9,
+	0x2d, 0xaf, 0x02, 0x68, 0x5a, 0xb1, 0x6f, 0x97, 0x19, 0x18, 0xdc, 0x3c, 0x3b, 0x61, 0x3c, 0x9d,
+	0x8e, 0xb2, 0x54, 0x6d, 0xae, 0x11, 0xfe, 0x2f, 0x6b, 0x70, 0x51, 0xef, 0xde, 0xe7, 0xe1, 0xd1,
+	0x71, 0x26, 0xce, 0x70, 0xd7, 0xa1, 0x95, 0xd2, 0x5f, 0x9e, 0xb3, 0xe1, 0x6c, 0x36, 0x99, 0x84,
+	0x50, 0x84, 0x2c, 0xcc, 0x46, 0xdc, 0xab, 0x6d, 0x38, 0x28, 0x02, 0x01, 0x48, 0x7d, 0x4c, 0xab,
+	0xbd, 0xfa, 0x86, 0xb3, 0x59, 0x67, 0x12, 0x72, 0xbf, 0x04, 0x8b, 0x43, 0xc1, 0xa8, 0xd7, 0xd8,
+	0x70, 0x36, 0x97, 0xb6, 0x9e, 0xbd, 0x49, 0x6a, 0xbd, 0x59, 0xad, 0x20, 0xb6, 0x38, 0xcc, 0xc5,
+	0x1a, 0x07, 0x61, 0x24, 0x58, 0xf2, 0x9a, 0xb4, 0xa9, 0x81, 0x71, 0x2f, 0x43, 0x9b, 0x20, 0x54,
+	0x59, 0x6b, 0xc3, 0xd9, 0xec, 0x32, 0x0d, 0xbb, 0x6f, 0x42, 0xf7, 0xc0, 0x50, 0x91, 0xb7, 0x48,
+	0x27, 0xfb, 0xd5, 0x27, 0x9b, 0xca, 0x64, 0xd6, 0x3a, 0xff, 0x9f, 0x0e, 0x78, 0x95, 0xca, 0x61,
+	0xe9, 0xe4, 0x0b, 0xd2, 0x8f, 0x2d, 0x66, 0x63, 0xae, 0x98, 0x4d, 0xda, 0x30, 0x17, 0x73, 0x03,
+	0x96, 0xd0, 0x11, 0xc3, 0xec, 0x0d, 0x72, 0xa9, 0x16, 0xb9, 0x94, 0x89, 0x72, 0x37, 0x61, 0x45,
+	0x80, 0xdb, 0xda, 0xbd, 0x16, 0x89, 0xaa, 0x88, 0xf6, 0x7f, 0xe1, 0xc0, 0x4a, 0x41, 0x31, 0xb9,
+	0x24, 0x4e, 0xb5, 0x24, 0x35, 0x4b, 0x12, 0xcb, 0x89, 0xeb, 0x64, 0x91, 0x1c, 0xf1, 0xd8, 0x72,
+	0x1a, 0xe6, 0xf4, 0x3f, 0x31, 0xcd, 0xb0, 0x13, 0x47, 0x29, 0x8f, 0xd2, 0xe9, 0x7c, 0x26, 0x51,
+	0x35, 0xc7, 0xf9, 0x79, 0x82, 0x53, 0x13, 0xe5, 0x5e, 0x83, 0xde, 0x40, 0x6c, 0xd5, 0x37, 0xed,
+	0x62, 0x23, 0xdd, 0xff, 0x83, 0x55, 0x89, 0xc8, 0x35, 0xd8, 0xa0, 0x83, 0x4a, 0x78, 0xff, 0x6f,
+	0x0e, 0xb8, 0xc8, 0xe6, 0x3b, 0xf1, 0x90, 0xa3, 0xfa, 0x77, 0xe2, 0xe8, 0x30, 0x3c, 0x9a, 0xc1,
+	0xe0, 0x32, 0xd4, 0xe2, 0x09, 0xf1, 0xd5, 0x63, 0xb5, 0x78, 0x82, 0x70, 0x38, 0x24, 0x1e, 0x3a,
+	0xac, 0x16, 0x0e, 0x5d, 0x17, 0x1a, 0x18, 0x1b, 0xe4, 0x61, 0xf4, 0x37, 0xee, 0xf4, 0x41, 0x30,
+	0x9a, 0x72, 0x52, 0x50, 0x8f, 0x09, 0x40, 0x78, 0x41, 0x18, 0xa5, 0x6f, 0x26, 0xf1, 0x77, 0x79,
+	0xe4, 0xb5, 0xa4, 0xa8, 0x39, 0x4a, 0x58, 0x26, 0xbd, 0x3f, 0x3d, 0x78, 0x9b, 0x9f, 0xd2, 0x5d,
+	0xe8, 0xb0, 0x1c, 0x81, 0xf6, 0x44, 0x20, 0x9e, 0x78, 0x6d, 0xfa, 0x24, 0x21, 0xff, 0xf5, 0x5c,
+	0x9a, 0xaf, 0xc7, 0x19, 0x17, 0x77, 0x62, 0x46, 0x00, 0x43, 0xce, 0xe2, 0x8c, 0x8b, 0xf8, 0xd2,
+	0x61, 0x02, 0xf0, 0xff, 0xea, 0xc0, 0x9a, 0xa9, 0x90, 0xdd, 0xa1, 0xb4, 0x99, 0x12, 0xce, 0x31,
+	0x84, 0xbb, 0x0a, 0x30, 0x49, 0xe2, 0x49, 0x9c, 0x06, 0xa3, 0xdd, 0xa1, 0xbc, 0x3b, 0x06, 0x06,
+	0xd9, 0x7c, 0x38, 0x0d, 0xb3, 0x5d, 0xa5, 0x24, 0x09, 0x19, 0xd7, 0xb0, 0x51, 0x7d, 0x0d, 0x9b,
+	0xa6, 0xda, 0x2d, 0x55, 0xb4, 0x66, 0xab, 0x62, 0xd1, 0x52, 0xc5, 0xef, 0x6a, 0xb0, 0xaa, 0x04,
+	0xd1, 0x42, 0x08, 0x8b, 0x39, 0xda, 0x62, 0x39, 0x23, 0xb5, 0x6a, 0x46, 0xea, 0x26, 0x23, 0x57,
+	0x01, 0xb2, 0x20, 0x39, 0xe2, 0x74, 0x51, 0xa5, 0x95, 0x0d, 0x4c, 0xd1, 0xaa, 0xcd, 0xb2, 0x55,
+	0x6f, 0x29, 0x9d, 0xb7, 0x28, 0xba, 0x3d, 0x6d, 0x44, 0x37, 0xdb, 0x66, 0xd2, 0x1c, 0x78, 0xc5,
+	0x0e, 0x93, 0x78, 0x4c, 0x07, 0x0a, 0xf9, 0x34, 0x6c, 0x5c, 0xea, 0x76, 0xf9, 0x52, 0x2b, 0x7d,
+	0x75, 0x66, 0xeb, 0x0b, 0x2c, 0x7d, 0xfd, 0xd1, 0x81, 0x8b, 0x8c, 0x0f, 0x78, 0x38, 0xc9, 0x14,
+	0x3b, 0xf2, 0x32, 0x54, 0x59, 0xfe, 0x45, 0x68, 0x0d, 0xe8, 0xab, 0x57, 0xab, 0x94, 0x24, 0xbf,
+	0x4b, 0x4c, 0x12, 0xba, 0xd7, 0xa1, 0x31, 0x49, 0xf8, 0x07, 0xa4, 0xd2, 0xa5, 0xad, 0x4b, 0x85,
+	0x05, 0xca, 0x44, 0x8c, 0x88, 0xdc, 0x17, 0x61, 0x71, 0x30, 0x4d, 0x12, 0x1e, 0x65, 0x5e, 0x63,
+	0x3e, 0xbd, 0xa2, 0xf3, 0x7f, 0xed, 0xc0, 0xb3, 0x05, 0x01, 0x90, 0x0b, 0x24, 0x7b, 0x6f, 0x32,
+	0x0c, 0x32, 0x6e, 0x29, 0xd3, 0x29, 0x28, 0xf3, 0x96, 0xe4, 0x4e, 0x88, 0xf3, 0x4c, 0x85, 0x38,
+	0x05, 0x0e, 0xff, 0x3f, 0xe7, 0xb0, 0x7e, 0xf6, 0x1a, 0xcd, 0xe5, 0xbf, 0x1c, 0xb8, 0x54, 0xe0,
+	0x92, 0xac, 0x1e, 0x47, 0xbc, 0xe4, 0x9d, 0xd5, 0x59, 0xc9, 0xf6, 0xc2, 0x7a, 0xc9, 0x0b, 0xf1,
+	0x7b, 0x9c, 0x05, 0x23, 0xdc, 0x5a, 0x5d, 0x30, 0x03, 0x43, 0xb5, 0x05, 0x42, 0x78, 0x2c, 0xf9,
+	0x68, 0x93, 0xe5, 0x08, 0x8a, 0xe9, 0x71, 0x9a, 0xd1, 0xc7, 0x16, 0x7d, 0xd4, 0xb0, 0xeb, 0xc1,
+	0x22, 0x7a, 0x25, 0x4b, 0x33, 0xe9, 0x8b, 0x0a, 0xc4, 0x33, 0x87, 0x71, 0xc4, 0x85, 0xb0, 0xe4,
+	0x8e, 0x4d, 0x66, 0x60, 0xfc, 0x3f, 0x38, 0xf0, 0x94, 0x12, 0xf7, 0xad, 0x24, 0x9e, 0x4e, 0x9e,
+	0x28, 0xce, 0xea, 0x78, 0x26, 0xae, 0xa0, 0x00, 0xce, 0x71, 0xfb, 0xa8, 0xea, 0x92, 0xf7, 0x20,
+	0x95, 0x91, 0xc4, 0xc0, 0xa0, 0x7c, 0xe2, 0x32, 0xa4, 0x4a, 0x3e, 0x09, 0xfa, 0x1f, 0xd5, 0x0a,
+	0xfc, 0x7f, 0x21, 0xf1, 0x64, 0x03, 0x96, 0x72, 0xbb, 0x29, 0x69, 0x4c, 0xd4, 0x39, 0x64, 0x32,
+	0x7d, 0xba, 0x35, 0x33, 0x40, 0x2c, 0x16, 0xeb, 0x17, 0x43, 0x0f, 0xed, 0x79, 0x7a, 0xe8, 0xd8,
+	0x7a, 0xf8, 0xd4, 0x81, 0xcb, 0x05, 0xef, 0x35, 0xcd, 0x59, 0x15, 0x29, 0xb6, 0x0a, 0x91, 0xe2,
+	0x72, 0xe1, 0x9a, 0x18, 0xeb, 0x75, 0xa8, 0xb8, 0x69, 0x85, 0x8a, 0xca, 0x15, 0xd6, 0x5d, 0x7c,
+	0xb9, 0x18, 0x2d, 0xe6, 0x2d, 0xd1, 0x57, 0xf1, 0xc7, 0x0e, 0xac, 0x31, 0xfe, 0x50, 0x57, 0x29,
+	0x14, 0x56, 0xa2, 0xc3, 0x78, 0xb6, 0x57, 0x86, 0x2a, 0xc9, 0x99, 0xd9, 0xbe, 0x6e, 0x08, 0x3b,
+	0x2b, 0xb1, 0x59, 0x21, 0xb9, 0x59, 0x08, 0xc9, 0xfe, 0x0e, 0xac, 0x33, 0x9e, 0x4e, 0x2c, 0x46,
+	0x84, 0xfd, 0xff, 0x17, 0xea, 0xe1, 0x50, 0xe4, 0xed, 0x39, 0x21, 0x10, 0x69, 0xfc, 0xb7, 0xe0,
+	0x52, 0x69, 0x13, 0x12, 0x3b, 0x75, 0x6f, 0x98, 0xbb, 0xcc, 0x53, 0x0d, 0x6d, 0x34, 0x11, 0x79,
+	0x73, 0x3b, 0x8c, 0x86, 0xf7, 0xc2, 0x88, 0x27, 0x3b, 0xe3, 0x21, 0x79, 0x4c, 0x18, 0x0d, 0xdf,
+	0xa0, 0x86, 0x4a, 0xd6, 0xce, 0x06, 0x86, 0xe4, 0x0b, 0xa3, 0xe1, 0x0e, 0x3a, 0xa6, 0x2c, 0xdc,
+	0x72, 0x44, 0x1e, 0xb1, 0xf0, 0x3c, 0x3b, 0x62, 0x21, 0xc6, 0xff, 0x8b, 0x03, 0x17, 0xac, 0x23,
+	0xc9, 0x0a, 0x33, 0x0a, 0x0e, 0xdc, 0x76, 0xcf, 0xbc, 0x63, 0x06, 0xc6, 0xe6, 0xa3, 0x3e, 0x9f,
+	0x8f, 0x46, 0x91, 0x0f, 0x5d, 0x0d, 0xef, 0x87, 0x63, 0x2e, 0xef, 0x5a, 0x8e, 0xc0, 0xbb, 0x48,
+	0x80, 0x2c, 0x3d, 0x65, 0xcd, 0x66, 0xa0, 0xfc, 0x9f, 0x38, 0xe0, 0x19, 0xb7, 0xe3, 0x6c, 0x71,
+	0x6e, 0x58, 0x49, 0xc7, 0x33, 0x2c, 0x63, 0xad, 0x95, 0x5e, 0xbe, 0x55, 0xcc, 0x38, 0xb3, 0x17,
+	0x68, 0x1f, 0xbf, 0x23, 0x3a, 0x04, 0x14, 0x0f, 0x29, 0xbe, 0x16, 0x91, 0x94, 0xe9, 0x74, 0xc2,
+	0x13, 0x52, 0x82, 0xe0, 0x26, 0x47, 0xa0, 0xef, 0x8f, 0x71, 0x1b, 0x95, 0x73, 0x08, 0xf0, 0xbf,
+	0x09, 0xab, 0xe6, 0x36, 0x77, 0xc3, 0x34, 0x9b, 0x71, 0x4b, 0x6e, 0x42, 0x8b, 0x96, 0x88, 0xb2,
+	0x72, 0x69, 0x6b, 0xbd, 0xe0, 0x6e, 0x92, 0x0b, 0x26, 0xa9, 0xfc, 0x0f, 0x4b, 0x49, 0x5b, 0x1d,
+	0x20, 0x93, 0xb6, 0x2a, 0x1b, 0x9c, 0xca, 0x32, 0x40, 0x11, 0x97, 0xcb, 0x86, 0xda, 0x7c, 0x7a,
+	0xad, 0xa1, 0x47, 0xb0, 0xa6, 0xee, 0x8d, 0x25, 0xde, 0x75, 0x68, 0x8c, 0xc2, 0x34, 0x3b, 0xf3,
+	0x5c, 0x24, 0x42, 0xd3, 0xa8, 0x8e, 0x59, 0x88, 0x3d, 0xc7, 0x34, 0x92, 0xd0, 0xff, 0x48, 0x79,
+	0x3d, 0x7a, 0xd0, 0xd6, 0xbd, 0x20, 0x8c, 0xee, 0x05, 0x13, 0x23, 0x66, 0x3b, 0xb3, 0x3b, 0xb5,
+	0x9a, 0x8a, 0x20, 0xd5, 0x9d, 0x5a, 0x7d, 0x6e, 0xa7, 0xd6, 0xb0, 0x3b, 0x52, 0xff, 0x36, 0xb8,
+	0x36, 0x1b, 0xe4, 0xae, 0x37, 0xa1, 0x19, 0x66, 0x7c, 0xac, 0xa2, 0x86, 0x25, 0x8f, 0xc9, 0x30,
+	0x13, 0x64, 0xfe, 0x3f, 0xea, 0xf0, 0x94, 0x15, 0x7b, 0xe4, 0x8d, 0xbc, 0x06, 0x3d, 0x3c, 0x29,
+	0xef, 0xc4, 0x1c, 0x6a, 0x14, 0x6d, 0x24, 0xf6, 0xbc, 0x39, 0xc2, 0x6c, 0xff, 0x8a, 0xe8, 0x19,
+	0x99, 0x34, 0xd7, 0x5a, 0xc3, 0xd2, 0x9a, 0x0f, 0xdd, 0x49, 0xc2, 0xf3, 0xc3, 0x45, 0x97, 0x6a,
+	0xe1, 0x6c, 0xcd, 0xb6, 0x8a, 0x3d, 0xb0, 0xd8, 0x01, 0x85, 0xe1, 0xb2, 0x15, 0x57, 0x3b, 0x68,
+	0x1c, 0xdd, 0x28, 0x4d, 0xd0, 0x16, 0x3b, 0x68, 0x04, 0xea, 0x3e, 0x3b, 0xd9, 0x89, 0xa7, 0x51,
+	0x26, 0xd2, 0x69, 0x8f, 0x69, 0x58, 0x7c, 0x13, 0x63, 0x1d, 0x2a, 0xc7, 0xbb, 0x4c, 0xc3, 0x98,
+	0x85, 0xb3, 0x13, 0x31, 0x20, 0x5a, 0xa2, 0x09, 0x90, 0x02, 0xa9, 0x0d, 0x46, 0x35, 0xef, 0xab,
+	0xa5, 0x5d, 0xa1, 0x53, 0x0b, 0x89, 0x9c, 0x4b, 0x84, 0xd8, 0xa4, 0x47, 0x9b, 0x58, 0x38, 0xf7,
+	0x06, 0x5c, 0x88, 0xe2, 0x68, 0x87, 0xe6, 0x0a, 0xfb, 0x8a, 0xc9, 0x65, 0x62, 0xb2, 0xfc, 0xc1,
+	0xdf, 0x86, 0x0b, 0x7b, 0x7c, 0x74, 0x28, 0xbb, 0xf9, 0xbd, 0x2c, 0x38, 0xe2, 0xa9, 0xfb, 0xbc,
+	0xed, 0x28, 0xea, 0xa2, 0x14, 0x09, 0x95, 0x9f, 0xdc, 0x85, 0xd5, 0xe2, 0x27, 0x8c, 0xac, 0x69,
+	0x16, 0x24, 0x59, 0xdf, 0x74, 0x7c, 0x13, 0x85, 0xf6, 0xe5, 0x51, 0x70, 0x20, 0x4b, 0xe1, 0x1e,
+	0x93, 0x90, 0xff, 0x77, 0x07, 0xd6, 0x8a, 0xdb, 0x91, 0xfb, 0xce, 0x2f, 0xcc, 0x7a, 0x3a, 0x31,
+	0x3f, 0x0f, 0xcd, 0x14, 0x17, 0x15, 0xba, 0x92, 0x32, 0xf7, 0x44, 0x65, 0x55, 0x5b, 0x8d, 0x42,
+	0xb5, 0x75, 0x15, 0x80, 0x9f, 0xf0, 0x81, 0x3d, 0xfc, 0xca, 0x31, 0x8f, 0xdd, 0xfb, 0xf9, 0x1c,
+	0xd6, 0xef, 0xc6, 0x83, 0x60, 0xa4, 0x98, 0xc9, 0xa5, 0x7b, 0x51, 0x71, 0xed, 0x58, 0x9d, 0x47,
+	0x95, 0x26, 0x14, 0xe7, 0xe4, 0x4d, 0xbb, 0xd1, 0x90, 0x9f, 0xc8, 0xe8, 0xa1, 0x40, 0xff, 0x15,
+	0x58, 0x16, 0xe5, 0x17, 0x72, 0x50, 0xa9, 0x3c, 0x3d, 0xc3, 0xa8, 0x19, 0x33, 0x0c, 0xdf, 0x87,
+	0x55, 0xb1, 0x6e, 0x27, 0x88, 0x06, 0x7c, 0x54, 0xb5, 0xd2, 0xff, 0x4c, 0x4e, 0xa8, 0x88, 0x9d,
+	0xb3, 0x6a, 0xfe, 0xec, 0x54, 0xd5, 0xfc, 0xd9, 0x29, 0x6a, 0x4b, 0x88, 0x08, 0x73, 0x0d, 0xd3,
+	0x5f, 0x50, 0x02, 0x5e, 0x87, 0x06, 0xaa, 0xcd, 0x5b, 0x22, 0xfa, 0x8b, 0x92, 0xde, 0x96, 0xac,
+	0xbf, 0xc0, 0x88, 0x88, 0xda, 0x57, 0xe2, 0xda, 0xeb, 0x5a, 0xdb, 0x17, 0x05, 0xea, 0x2f, 0x30,
+	0x49, 0xb8, 0xbd, 0x28, 0x95, 0xe0, 0xff, 0x28, 0xaf, 0x81, 0x2d, 0xcb, 0x48, 0xf1, 0x6e, 0x59,
+	0xf9, 0x6a, 0xae, 0x69, 0x4a, 0x8d, 0x64, 0xed, 0xec, 0x35, 0x3a, 0x6f, 0x7d, 0xe6, 0xc0, 0x95,
+	0x2a, 0x36, 0x66, 0x76, 0x93, 0xda, 0xd5, 0x6b, 0xe7, 0x72, 0x75, 0xbb, 0x8d, 0xac, 0xcf, 0x6f,
+	0x23, 0x1b, 0xf3, 0xda, 0xc8, 0xe6, 0xec, 0x36, 0xb2, 0x65, 0xb5, 0x91, 0xfe, 0x87, 0xf0, 0x4c,
+	0x95, 0x48, 0xa9, 0x2c, 0x05, 0x6e, 0x58, 0xaa, 0xf5, 0x66, 0x08, 0x90, 0x96, 0xcb, 0xa5, 0xda,
+	0x19, 0x0b, 0xb4, 0x52, 0x7f, 0xe5, 0x80, 0xcb, 0xf8, 0xc3, 0x77, 0xa7, 0x3c, 0x39, 0x45, 0x32,
+	0xf1, 0xbd, 0x30, 0x36, 0xce, 0xa3, 0x47, 0xb1, 0x25, 0x58, 0x83, 0xe6, 0x00, 0x43, 0xa5, 0x54,
+	0x97, 0x00, 0x50, 0x53, 0xc3, 0x30, 0xe1, 0xa2, 0x76, 0x96, 0x9a, 0xd2, 0x08, 0x23, 0x75, 0x35,
+	0xad, 0xd4, 0xb5, 0x06, 0xcd, 0x90, 0xae, 0xab, 0xe8, 0xc2, 0x05, 0xe0, 0xbf, 0x8b, 0xd5, 0xca,
+	0x64, 0x74, 0x5a, 0xe4, 0xf0, 0x55, 0x4a, 0x41, 0xc2, 0x47, 0x64, 0x24, 0x9e, 0xeb, 0x46, 0x39,
+	0xb5, 0xff, 0x1b, 0xc7, 0x78, 0xf9, 0xd8, 0x91, 0x23, 0xe6, 0x54, 0xd5, 0xac, 0x69, 0x78, 0x14,
+	0xc9, 0x9c, 0x4d, 0x7f, 0xa3, 0x65, 0xa9, 0xdf, 0xbe, 0x17, 0x88, 0x16, 0xbd, 0xcb, 0x34, 0x9c,
+	0x37, 0xe6, 0x75, 0x73, 0xd0, 0x78, 0x0d, 0x7a, 0xd9, 0x71, 0xc2, 0xd3, 0xe3, 0x78, 0x34, 0xdc,
+	0x0b, 0x8f, 0x84, 0x0e, 0xba, 0xcc, 0x46, 0x62, 0x12, 0x98, 0x04, 0x49, 0x16, 0x06, 0x23, 0xa2,
+	0x11, 0x99, 0xda, 0x44, 0xf9, 0xdf, 0x83, 0x8b, 0x05, 0x3e, 0x65, 0xf7, 0xb1, 0x65, 0x99, 0xc7,
+	0x6e, 0x71, 0x0a, 0xf5, 0x88, 0x36, 0xdd, 0x2d, 0xa8, 0x1f, 0x8c, 0x52, 0xaf, 0x56, 0xfd, 0xbe,
+	0x61, 0xa9, 0x81, 0x21, 0xa5, 0xff, 0x89, 0x1c, 0x8c, 0xd2, 0x77, 0x2a, 0xe7, 0x9e, 0xe0, 0xf4,
+	0x4d, 0x58, 0x09, 0x53, 0xc3, 0x30, 0x32, 0x2f, 0xb5, 0x59, 0x11, 0xed, 0xde, 0x84, 0x36, 0x6d,
+	0xb2, 0x1b, 0x09, 0xad, 0x2e, 0x6d, 0xb9, 0xc6, 0xfe, 0x3b, 0xe2, 0x13, 0xd3, 0x34, 0xfe, 0xef,
+	0x1d, 0x70, 0x09, 0xfb, 0x46, 0x9a, 0xf2, 0x6c, 0x3f, 0x09, 0xa2, 0xf4, 0x90, 0x27, 0xe8, 0x83,
+	0x01, 0x22, 0xee, 0x9c, 0xf0, 0x81, 0x2a, 0xfa, 0x35, 0x02, 0x75, 0x4f, 0xc0, 0xde, 0xe9, 0xf8,
+	0x20, 0x1e, 0x49, 0x87, 0x36, 0x51, 0xe8, 0xa5, 0xc1, 0x58, 0xbb, 0x76, 0x9d, 0x49, 0x08, 0xf1,
+	0x59, 0x6c, 0xa4, 0x43, 0x09, 0xa1, 0xe7, 0x44, 0xea, 0xee, 0x77, 0x18, 0xfd, 0x8d, 0xa7, 0x64,
+	0x31, 0x72, 0xbd, 0x4f, 0xe1, 0x5f, 0xdc, 0x7d, 0x13, 0xe5, 0x7f, 0x5c, 0x83, 0x25, 0x43, 0x2c,
+	0xda, 0xfd, 0x44, 0x57, 0x8d, 0x1d, 0x26, 0x21, 0x94, 0x06, 0xd3, 0xee, 0xbe, 0x31, 0x1c, 0xcb,
+	0x11, 0x78, 0x36, 0x02, 0xaa, 0x31, 0xc7, 0xbf, 0x67, 0xf2, 0x69, 0xe9, 0xa5, 0x79, 0x86, 0x5e,
+	0x5a, 0xf3, 0xf4, 0xb2, 0x68, 0xe9, 0x65, 0x03, 0x96, 0x92, 0x78, 0x9a, 0xf1, 0xbe, 0x39, 0xa0,
+	0x35, 0x51, 0x46, 0x4c, 0xe9, 0x58, 0xa3, 0x02, 0x4c, 0xd4, 0xb1, 0x90, 0x0c, 0x64, 0xa2, 0x16,
+	0xa0, 0xff, 0x1a, 0xac, 0x1a, 0xca, 0x79, 0x77, 0xca, 0xa7, 0x24, 0xeb, 0x31, 0x0f, 0x86, 0xb2,
+	0x66, 0xa2, 0xbf, 0x11, 0x87, 0x65, 0x84, 0xac, 0xa0, 0xe9, 0x6f, 0xff, 0x3a, 0x5c, 0x30, 0xd6,
+	0x32, 0x7e, 0x38, 0x8d, 0x86, 0xb3, 0xd4, 0xeb, 0xef, 0x83, 0x2b, 0xc3, 0xb0, 0x69, 0x8c, 0xea,
+	0xbc, 0xbd, 0xa9, 0xca, 0xbf, 0xda, 0x4c, 0xe7, 0x94, 0x95, 0xdf, 0xf7, 0x45, 0x29, 0xb0, 0x3d,
+	0x4a, 0x69, 0xe4, 0x80, 0xb3, 0xe8, 0xea, 0x2d, 0xd7, 0xa0, 0x19, 0x51, 0xd2, 0x91, 0x2d, 0x68,
+	0xa4, 0xf3, 0x8d, 0x0a, 0x18, 0x32, 0xbe, 0xe6, 0x08, 0xd4, 0xf7, 0x11, 0xee, 0x2a, 0x47, 0x2c,
+	0x22, 0xc2, 0x98, 0x28, 0x7c, 0xe9, 0xb9, 0x58, 0x38, 0x7f, 0xee, 0x6b, 0xd4, 0x7f, 0x84, 0x8b,
+	0x99, 0xd1, 0xfe, 0xb1, 0x8b, 0xc3, 0x9f, 0x3b, 0xb0, 0x6e, 0x18, 0xc9, 0xd4, 0x6a, 0xd5, 0xa4,
+	0xe1, 0x05, 0x6b, 0xd2, 0x70, 0xc5, 0xea, 0xe6, 0x0a, 0xfa, 0x90, 0xe9, 0xf3, 0x95, 0xe2, 0xb4,
+	0x61, 0xfe, 0x22, 0x9d, 0x42, 0xbf, 0xad, 0xcd, 0x7c, 0xfb, 0xc1, 0xd1, 0xde, 0x71, 0x90, 0xf0,
+	0x4a, 0x86, 0xae, 0x40, 0x87, 0x4f, 0x8e, 0xf9, 0x98, 0x27, 0xc1, 0x48, 0xe6, 0x91, 0x1c, 0x81,
+	0x49, 0x86, 0x47, 0x03, 0x5a, 0x2d, 0x9f, 0x25, 0x35, 0x8c, 0x11, 0x6e, 0x39, 0x3f, 0xe1, 0x36,
+	0x0f, 0x46, 0xb9, 0xad, 0x9c, 0x99, 0xb6, 0xaa, 0x15, 0x6d, 0xb5, 0x0e, 0xad, 0x21, 0x0f, 0x46,
+	0x5c, 0x0d, 0xf0, 0x24, 0x84, 0xf7, 0x4f, 0xbc, 0xb3, 0xe2, 0x0c, 0x8f, 0xda, 0x2e, 0x09, 0xe2,
+	0x68, 0x23, 0x45, 0x0e, 0x52, 0xaf, 0x59, 0x1a, 0x6d, 0x18, 0xe2, 0x32, 0x49, 0xa5, 0xb3, 0x67,
+	0x2b, 0xcf, 0x9e, 0xfe, 0xc7, 0x8b, 0xc6, 0x93, 0xad, 0x4c, 0x20, 0xaf, 0x40, 0x4b, 0x1c, 0xe1,
+	0x39, 0x25, 0x45, 0x97, 0x92, 0x1d, 0x55, 0xa4, 0x04, 0xbb, 0x2f, 0xa9, 0x51, 0x4d, 0xf9, 0xfd,
+	0xa1, 0x98, 0xa4, 0xb0, 0x4c, 0x26, 0x5a, 0xf7, 0x2b, 0xd0, 0x0b, 0xcc, 0xcc, 0xe0, 0x35, 0xac,
+	0x7a, 0x99, 0xb2, 0x46, 0xaa, 0x3e, 0xf6, 0x17, 0x98, 0x4d, 0xad, 0x97, 0x7f, 0x23, 0xcc, 0x8e,
+	0x87, 0x49, 0xf0, 0xc8, 0x6b, 0x56, 0x2c, 0x57, 0x1f, 0xf5, 0x72, 0x85, 0x70, 0x5f, 0x82, 0x76,
+	0xa6, 0x0e, 0x6e, 0xcd, 0x3f, 0x58, 0x13, 0xe2, 0xa2, 0x47, 0xea, 0xb8, 0xc5, 0xf9, 0xc7, 0x69,
+	0x42, 0xf7, 0x0e, 0x2c, 0xab, 0x0d, 0xf6, 0x63, 0x8a, 0xee, 0x6d, 0x4b, 0x4b, 0xf6, 0x79, 0x82,
+	0xa4, 0xbf, 0xc0, 0x0a, 0x8b, 0xdc, 0x2f, 0x03, 0x44, 0xfa, 0x25, 0xcc, 0xeb, 0x54, 0x5e, 0xce,
+	0xfc, 0xad, 0xab, 0xbf, 0xc0, 0x0c, 0x72, 0xf7, 0x4d, 0x58, 0x89, 0xec, 0x09, 0xb7, 0x07, 0xa5,
+	0x12, 0xa1, 0x30, 0x03, 0xef, 0x2f, 0xb0, 0xe2, 0x22, 0x77, 0x1b, 0x56, 0x52, 0x55, 0xea, 0xc9,
+	0x7d, 0x44, 0x97, 0x63, 0x7a, 0xa0, 0xf1, 0x15, 0xf7, 0x28, 0x2c, 0x70, 0xdf, 0x06, 0x77, 0x50,
+	0x2a, 0x0b, 0xbc, 0xae, 0x25, 0x50, 0xb9, 0x6e, 0xe8, 0x2f, 0xb0, 0x8a, 0x65, 0xee, 0x57, 0xa1,
+	0x37, 0x31, 0x07, 0x5b, 0x5e, 0xaf, 0x34, 0x24, 0x33, 0xc7, 0xc7, 0xe8, 0x07, 0x16, 0xbd, 0xfb,
+	0x1a, 0xce, 0x52, 0x75, 0x08, 0xf1, 0x96, 0x4b, 0xd2, 0x18, 0x01, 0xa6, 0xbf, 0xc0, 0x4c, 0x62,
+	0xf7, 0x75, 0x39, 0xfd, 0x50, 0x69, 0xcc, 0x5b, 0x29, 0x0d, 0x43, 0xad, 0x34, 0x87, 0xa7, 0x5b,
+	0x0b, 0x8c, 0x5e, 0xb3, 0x89, 0xbd, 0x66, 0xde, 0xda, 0x7d, 0x6a, 0x07, 0x55, 0xe3, 0xf2, 0xcd,
+	0x7a, 0xda, 0x30, 0x86, 0x0a, 0xe7, 0xab, 0xfc, 0x5e, 0xb0, 0x9e, 0x36, 0x4a, 0x57, 0xdd, 0xfa,
+	0xc1, 0x4a, 0x29, 0x10, 0x37, 0xce, 0xb1, 0x48, 0x07, 0xe2, 0xb7, 0xad, 0xf7, 0xdc, 0x3c, 0x22,
+	0x7c, 0x9e, 0x82, 0xd5, 0xff, 0x41, 0x03, 0xd6, 0x8a, 0xbb, 0x51, 0x97, 0x69, 0xb7, 0x89, 0x4e,
+	0xa9, 0x4d, 0xa4, 0xa2, 0x2f, 0x0b, 0x46, 0x42, 0x8d, 0x52, 0xe9, 0x26, 0xca, 0x7d, 0x0e, 0x96,
+	0xb1, 0x35, 0xdc, 0x0b, 0xc6, 0x5c, 0x12, 0x89, 0xbc, 0x5a, 0xc0, 0xe6, 0x69, 0xba, 0x51, 0x3d,
+	0xf9, 0x6b, 0x16, 0xe7, 0xa5, 0xf9, 0x4c, 0xae, 0x35, 0x6f, 0x26, 0xb7, 0x38, 0x67, 0x26, 0xd7,
+	0x2e, 0xcc, 0xe4, 0xac, 0x59, 0x61, 0xa7, 0x38, 0x2b, 0x34, 0x26, 0x76, 0x70, 0xc6, 0xc4, 0x6e,
+	0xe9, 0x3c, 0x13, 0xbb, 0x6e, 0xc5, 0xc4, 0xae, 0x34, 0x4f, 0xed, 0x9d, 0x73, 0x9e, 0xba, 0x5c,
+	0x3d, 0x4f, 0xc5, 0x5f, 0x1b, 0xe1, 0x2f, 0x6c, 0xee, 0xe4, 0xa3, 0xab, 0x15, 0x41, 0x59, 0x40,
+	0xfb, 0xdf, 0x29, 0xdf, 0x0d, 0xc6, 0x07, 0x71, 0x32, 0xfc, 0xa2, 0xee, 0x86, 0xff, 0x3f, 0xb0,
+	0xa4, 0x3f, 0xef, 0x9f, 0xcc, 0x2c, 0x4f, 0xe9, 0xa5, 0x2b, 0x7f, 0xb6, 0xa3, 0xe2, 0xb8, 0x38,
+	0x1d, 0x3e, 0xcf, 0xaf, 0x9f, 0xfc, 0xdf, 0xd6, 0xe0, 0x82, 0xf5, 0x66, 0xf6, 0xdf, 0xe5, 0xd1,
+	0x9d, 0xcf, 0xeb, 0xd1, 0x1d, 0xc3, 0xa3, 0x2b, 0xec, 0xdf, 0xa9, 0xb6, 0xff, 0x5b, 0xf0, 0x94,
+	0xa5, 0x2c, 0xd2, 0x3b, 0x06, 0xb4, 0x16, 0xf1, 0x5d, 0x7c, 0x29, 0x28, 0x29, 0x96, 0x49, 0x3a,
+	0x11, 0x98, 0x8a, 0xf6, 0x43, 0x19, 0xaa, 0xad, 0x57, 0x7a, 0xf9, 0xb0, 0x7e, 0x68, 0xf9, 0xb3,
+	0x3a, 0x2c, 0xeb, 0xad, 0x28, 0x4b, 0xe9, 0xfe, 0xcf, 0x31, 0xfa, 0x3f, 0x0c, 0xf9, 0xb1, 0x9a,
+	0xd4, 0x64, 0x31, 0x1a, 0x39, 0xd4, 0x85, 0x03, 0x99, 0xa7, 0xcd, 0x0c, 0x8c, 0xe1, 0x7b, 0x0d,
+	0xab, 0xf3, 0xcc, 0xfb, 0xbd, 0xa6, 0xd5, 0xef, 0xb9, 0xd0, 0xc0, 0x51, 0xaf, 0xb4, 0x0b, 0xfd,
+	0x8d, 0xb4, 0xa9, 0x68, 0x1c, 0xe5, 0x2f, 0x93, 0x04, 0x84, 0x02, 0x09, 0xc1, 0x4f, 0x27, 0x9c,
+	0xec, 0xd1, 0x63, 0x39, 0xa2, 0xd8, 0x25, 0x77, 0x4a, 0x5d, 0xb2, 0xe1, 0x20, 0x60, 0x39, 0x08,
+	0xfd, 0xee, 0x0d, 0x1d, 0x0b, 0xb5, 0x2d, 0x6d, 0x79, 0x91, 0x28, 0x4a, 0x78, 0xfa, 0xe5, 0x56,
+	0x90, 0x04, 0x92, 0x6a, 0x9d, 0xa8, 0x0c, 0x0c, 0x86, 0xb2, 0x74, 0x3a, 0x18, 0xf0, 0x34, 0xf5,
+	0x2e, 0x91, 0x72, 0x14, 0xa8, 0x43, 0xd9, 0xae, 0x7a, 0x6d, 0xf2, 0xe4, 0x6f, 0xf0, 0x4c, 0xa4,
+	0xff, 0x43, 0xf9, 0xeb, 0x2b, 0x1a, 0x60, 0xdf, 0x3e, 0xa0, 0x88, 0x33, 0xf3, 0x6d, 0xcb, 0x7c,
+	0x9d, 0xaa, 0x15, 0x7e, 0x16, 0x7a, 0xd6, 0xcb, 0xd6, 0x73, 0xb0, 0x3c, 0x09, 0x30, 0xdf, 0xdd,
+	0x33, 0xdf, 0xb7, 0xba, 0xac, 0x80, 0x3d, 0xe3, 0x6d, 0xf7, 0x1a, 0xd4, 0xb3, 0x13, 0xf1, 0x6b,
+	0xcc, 0xbc, 0x87, 0xdd, 0xcf, 0x7f, 0x43, 0xcc, 0xf0, 0xb3, 0x35, 0x8b, 0x59, 0x3c, 0xc7, 0x2c,
+	0xe6, 0xcf, 0x72, 0x64, 0x64, 0x2a, 0x81, 0xe6, 0x6a, 0xe7, 0x55, 0x44, 0xe7, 0x89, 0x15, 0xd1,
+	0x79, 0x4c, 0x45, 0xac, 0xe6, 0x8a, 0xe8, 0x90, 0xd0, 0xfe, 0x4f, 0xf3, 0xbe, 0x19, 0xa7, 0x70,
+	0x7b, 0xd3, 0xb1, 0xfa, 0x11, 0xf3, 0x2c, 0x29, 0xf4, 0x14, 0xb0, 0x66, 0x4e, 0x01, 0x5d, 0x68,
+	0x8c, 0xd3, 0x23, 0x31, 0xc4, 0xea, 0x32, 0xfa, 0x1b, 0x29, 0xb1, 0x2b, 0x52, 0x9d, 0x96, 0x00,
+	0xe8, 0xc9, 0x2d, 0x1f, 0xfb, 0x89, 0x6e, 0xab, 0xcb, 0x2c, 0x9c, 0xff, 0x2d, 0x78, 0xba, 0x92,
+	0xa9, 0xbd, 0xe3, 0xf8, 0xd1, 0x13, 0x30, 0xd6, 0x11, 0x8c, 0xf9, 0x07, 0xe0, 0xda, 0xdb, 0x93,
+	0xd9, 0x5e, 0x86, 0x46, 0x98, 0xcf, 0x57, 0x37, 0xec, 0x72, 0xb5, 0xcc, 0x07, 0x23, 0x6a, 0x31,
+	0x7a, 0x9a, 0x84, 0x03, 0x75, 0xac, 0x84, 0x7c, 0x06, 0xcb, 0x77, 0x79, 0x30, 0xe4, 0xc9, 0xde,
+	0x69, 0x34, 0x50, 0xaf, 0x27, 0xbb, 0xb7, 0xd5, 0xc4, 0x7e, 0xf7, 0x36, 0xfd, 0x0e, 0x27, 0x48,
+	0xf9, 0xee, 0xf0, 0x44, 0x66, 0x19, 0x05, 0xe2, 0x9e, 0xf1, 0xe1, 0x61, 0xca, 0x55, 0x66, 0x91,
+	0x90, 0xff, 0x27, 0x07, 0x7a, 0xc8, 0xcf, 0xfd, 0xad, 0xfb, 0x7b, 0xd3, 0x83, 0x7b, 0xe9, 0x91,
+	0xac, 0x75, 0x1d, 0x55, 0xeb, 0xba, 0x2f, 0x40, 0x7b, 0x20, 0x5f, 0xf5, 0x64, 0x33, 0x52, 0xe1,
+	0xee, 0xd8, 0x49, 0x29, 0x2a, 0x7c, 0x53, 0x4f, 0x4f, 0xa3, 0xc1, 0xbd, 0xf4, 0xa8, 0xf0, 0xb6,
+	0x62, 0x73, 0xdf, 0x5f, 0x60, 0x8a, 0x0e, 0x97, 0x0c, 0x45, 0x6b, 0xee, 0x75, 0xad, 0x25, 0x76,
+	0xdf, 0x8e, 0x4b, 0x24, 0x5d, 0x5e, 0x83, 0xbf, 0x0f, 0xcb, 0x77, 0x46, 0x62, 0x36, 0x2e, 0xe7,
+	0x33, 0x97, 0xa1, 0x1d, 0xa6, 0xe2, 0x30, 0x12, 0xa4, 0xcd, 0x34, 0xec, 0x3e, 0x0f, 0xad, 0x91,
+	0xf8, 0x52, 0x9b, 0xc3, 0x1b, 0x93, 0x44, 0xfe, 0x2d, 0xe8, 0x6c, 0xeb, 0x5f, 0x42, 0xae, 0x42,
+	0xfd, 0x01, 0x3f, 0x95, 0xfa, 0xae, 0x3f, 0x10, 0x98, 0x89, 0xfc, 0xe5, 0x59, 0x87, 0xe1, 0x9f,
+	0x5b, 0xaf, 0x42, 0x47, 0xff, 0x8b, 0x81, 0x7b, 0x03, 0x5a, 0xbb, 0x29, 0xee, 0xe9, 0xf6, 0x74,
+	0x92, 0x7b, 0xf8, 0x4e, 0x38, 0xba, 0x7c, 0x41, 0x82, 0xbb, 0xe9, 0x4e, 0x30, 0x3d, 0x3a, 0xce,
+	0xde, 0x9b, 0xf8, 0x0b, 0x07, 0x2d, 0xfa, 0xbf, 0x82, 0x97, 0xfe, 0x3d, 0x00, 0xfe, 0xdc, 0xb9,
+	0x60, 0xa4, 0x30, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
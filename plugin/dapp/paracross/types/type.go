@@ -28,6 +28,12 @@ var (
 	ForkParaSelfConsStages = "ForkParaSelfConsStages"
 	// ForkParaAssetTransferRbk 平行链资产转移平行链失败主链回滚
 	ForkParaAssetTransferRbk = "ForkParaAssetTransferRbk"
+	// ForkParaCrossParaTransfer 平行链之间直接跨链转移资产
+	ForkParaCrossParaTransfer = "ForkParaCrossParaTransfer"
+	// ForkParaBlsThreshold nodegroup DKG群公钥和门限bls签名共识
+	ForkParaBlsThreshold = "ForkParaBlsThreshold"
+	// ForkParaBlsPop 超级节点注册bls公钥需要proof of possession
+	ForkParaBlsPop = "ForkParaBlsPop"
 
 	// ParaConsSubConf sub
 	ParaConsSubConf = "consensus.sub.para"
@@ -56,6 +62,9 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ParaX, ForkCommitTx, 1850000)
 	cfg.RegisterDappFork(ParaX, ForkLoopCheckCommitTxDone, 3230000)
 	cfg.RegisterDappFork(ParaX, ForkParaAssetTransferRbk, 4500000)
+	cfg.RegisterDappFork(ParaX, ForkParaCrossParaTransfer, types.MaxHeight)
+	cfg.RegisterDappFork(ParaX, ForkParaBlsThreshold, types.MaxHeight)
+	cfg.RegisterDappFork(ParaX, ForkParaBlsPop, types.MaxHeight)
 
 	//只在平行链启用
 	cfg.RegisterDappFork(ParaX, ForkParaSelfConsStages, types.MaxHeight)
@@ -111,6 +120,11 @@ func (p *ParacrossType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogParaStageGroupUpdate:      {Ty: reflect.TypeOf(ReceiptSelfConsStagesUpdate{}), Name: "LogParaSelfConfStagesUpdate"},
 		TyLogParaBindMinerAddr:         {Ty: reflect.TypeOf(ReceiptParaBindMinerInfo{}), Name: "TyLogParaBindMinerAddrUpdate"},
 		TyLogParaBindMinerNode:         {Ty: reflect.TypeOf(ReceiptParaNodeBindListUpdate{}), Name: "TyLogParaBindNodeListUpdate"},
+		TyLogParaCrossRoute:            {Ty: reflect.TypeOf(ReceiptParaCrossIn{}), Name: "LogParaCrossRoute"},
+		TyLogParaCrossInDeliver:        {Ty: reflect.TypeOf(ReceiptParaCrossIn{}), Name: "LogParaCrossInDeliver"},
+		TyLogParaCrossIn:               {Ty: reflect.TypeOf(ReceiptParaCrossIn{}), Name: "LogParaCrossIn"},
+		TyLogParaBlsGroupKey:           {Ty: reflect.TypeOf(ReceiptParaBlsGroupKey{}), Name: "LogParaBlsGroupKey"},
+		TyLogParaCrossInRefund:         {Ty: reflect.TypeOf(ReceiptParaCrossIn{}), Name: "LogParaCrossInRefund"},
 	}
 }
 
@@ -129,6 +143,8 @@ func (p *ParacrossType) GetTypeMap() map[string]int32 {
 		"NodeGroupConfig":    ParacrossActionNodeGroupApply,
 		"SelfStageConfig":    ParacrossActionSelfStageConfig,
 		"ParaBindMiner":      ParacrossActionParaBindMiner,
+		"BlsGroupKey":        ParacrossActionBlsGroupKey,
+		"CrossInRefund":      ParacrossActionCrossInRefund,
 	}
 }
 
@@ -160,6 +160,7 @@ func addPokerbullPlayFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("value")
 	cmd.Flags().StringArrayP("address", "a", nil, "address")
 	cmd.MarkFlagRequired("address")
+	cmd.Flags().BoolP("randao", "d", false, "freeze bets and deal cards later with randao random of consumer pokerbull-<gameID>-<round>")
 }
 
 func pokerbullPlay(cmd *cobra.Command, args []string) {
@@ -171,11 +172,13 @@ func pokerbullPlay(cmd *cobra.Command, args []string) {
 	round, _ := cmd.Flags().GetUint32("round")
 	value, _ := cmd.Flags().GetUint64("value")
 	address, _ := cmd.Flags().GetStringArray("address")
+	randao, _ := cmd.Flags().GetBool("randao")
 
 	payload := &pkt.PBGamePlay{
 		GameId: gameID,
 		Value:  int64(value) * types.Coin,
 		Round:  int32(round),
+		Randao: randao,
 	}
 	payload.Address = make([]string, len(address))
 	copy(payload.Address, address)
@@ -12,6 +12,7 @@ import (
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
 	pkt "github.com/33cn/plugin/plugin/dapp/pokerbull/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -236,3 +237,98 @@ func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error
 	tx.Sign(int32(signType), privKey)
 	return tx, nil
 }
+
+func TestPokerbullRandao(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	cfg.SetDappFork(pkt.PokerBullX, pkt.ForkPokerBullRandao, 0)
+
+	total := 1000 * types.Coin
+	value := 5 * types.Coin
+	execAddr := dapp.ExecAddress(pkt.PokerBullX)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	acc := account.NewCoinsAccount(cfg)
+	acc.SetDB(stateDB)
+	for _, node := range Nodes {
+		acc.SaveExecAccount(execAddr, &types.Account{Addr: string(node), Balance: total})
+	}
+	addrs := []string{string(Nodes[0]), string(Nodes[1])}
+	newAction := func(height int64, from string) *Action {
+		return &Action{coinsAccount: acc, db: stateDB, txhash: common.Sha256([]byte(from)), fromaddr: from,
+			blocktime: height, height: height, execaddr: execAddr, cfg: cfg}
+	}
+	play := func(height int64, gameID string) (*types.Receipt, error) {
+		return newAction(height, pkt.PlatformSignAddress).GamePlay(&pkt.PBGamePlay{GameId: gameID, Round: 1, Value: value, Address: addrs, Randao: true})
+	}
+	bindRound := func(gameID string, status int32, commitEnd int64) {
+		round := &rty.RandaoRound{RoundID: "round-" + gameID, Creator: pkt.PlatformSignAddress, Status: status,
+			CommitEndHeight: commitEnd, Result: common.Sha256([]byte(gameID))}
+		stateDB.Set(rty.RoundKey(round.RoundID), types.Encode(round))
+		stateDB.Set(rty.ConsumerKey(pkt.PlatformSignAddress, pkt.RandaoConsumer(gameID, 1)), []byte(round.RoundID))
+	}
+	readGame := func(gameID string) *pkt.PokerBull {
+		game, err := newAction(0, "").readGame(gameID)
+		assert.Nil(t, err)
+		return game
+	}
+
+	// 加入时冻结赌注，轮次未绑定时不能发牌
+	_, err := play(10, "game1")
+	assert.Nil(t, err)
+	assert.Equal(t, value*PokerbullLeverageMax, acc.LoadExecAccount(addrs[0], execAddr).Frozen)
+	assert.True(t, readGame("game1").IsWaiting)
+	_, err = play(20, "game1")
+	assert.Equal(t, types.ErrNotFound, err)
+
+	// 轮次未产生结果时不能退出
+	bindRound("game1", rty.RandaoStatusCommit, 15)
+	_, err = newAction(20, addrs[0]).GameQuit(&pkt.PBGameQuit{GameId: "game1"})
+	assert.Equal(t, rty.ErrRandaoNotReady, err)
+
+	// 按轮次结果发牌
+	bindRound("game1", rty.RandaoStatusFinished, 15)
+	_, err = play(30, "game1")
+	assert.Nil(t, err)
+	game := readGame("game1")
+	assert.Equal(t, int32(pkt.PBGameActionQuit), game.Status)
+	assert.False(t, game.IsWaiting)
+	for _, addr := range addrs {
+		assert.Equal(t, int64(0), acc.LoadExecAccount(addr, execAddr).Frozen)
+	}
+	balanceA := acc.LoadExecAccount(addrs[0], execAddr).Balance
+	balanceB := acc.LoadExecAccount(addrs[1], execAddr).Balance
+	assert.NotEqual(t, balanceA, balanceB)
+
+	// 轮次失败不能重新绑定，退出时作废退款
+	_, err = play(40, "game2")
+	assert.Nil(t, err)
+	bindRound("game2", rty.RandaoStatusFailed, 45)
+	_, err = newAction(50, addrs[0]).GameQuit(&pkt.PBGameQuit{GameId: "game2"})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.PBGameActionQuit), readGame("game2").Status)
+	assert.Equal(t, balanceA, acc.LoadExecAccount(addrs[0], execAddr).Balance)
+	assert.Equal(t, int64(0), acc.LoadExecAccount(addrs[0], execAddr).Frozen)
+
+	// 轮次提交截止高度早于加入高度，发牌时作废退款
+	_, err = play(60, "game3")
+	assert.Nil(t, err)
+	bindRound("game3", rty.RandaoStatusFinished, 55)
+	_, err = play(70, "game3")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.PBGameActionQuit), readGame("game3").Status)
+	assert.Equal(t, balanceA, acc.LoadExecAccount(addrs[0], execAddr).Balance)
+	assert.Equal(t, balanceB, acc.LoadExecAccount(addrs[1], execAddr).Balance)
+
+	// 平台创建的提交-揭示轮次最少揭示人数过少，发牌时作废退款
+	_, err = play(80, "game4")
+	assert.Nil(t, err)
+	round := &rty.RandaoRound{RoundID: "round-game4", Creator: pkt.PlatformSignAddress, Mode: rty.RandaoModeCommitReveal,
+		Status: rty.RandaoStatusFinished, CommitEndHeight: 85, MinReveals: 1, Result: common.Sha256([]byte("game4"))}
+	stateDB.Set(rty.RoundKey(round.RoundID), types.Encode(round))
+	stateDB.Set(rty.ConsumerKey(pkt.PlatformSignAddress, pkt.RandaoConsumer("game4", 1)), []byte(round.RoundID))
+	_, err = play(90, "game4")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pkt.PBGameActionQuit), readGame("game4").Status)
+	assert.Equal(t, balanceA, acc.LoadExecAccount(addrs[0], execAddr).Balance)
+	assert.Equal(t, int64(0), acc.LoadExecAccount(addrs[0], execAddr).Frozen)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	pkt "github.com/33cn/plugin/plugin/dapp/pokerbull/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
 )
 
 // Action 斗牛action结构
@@ -30,6 +31,7 @@ type Action struct {
 	execaddr     string
 	localDB      dbm.Lister
 	index        int
+	cfg          *types.Chain33Config
 }
 
 // NewAction 创建action
@@ -38,7 +40,7 @@ func NewAction(pb *PokerBull, tx *types.Transaction, index int) *Action {
 	fromaddr := tx.From()
 
 	return &Action{pb.GetCoinsAccount(), pb.GetStateDB(), hash, fromaddr,
-		pb.GetBlockTime(), pb.GetHeight(), dapp.ExecAddress(string(tx.Execer)), pb.GetLocalDB(), index}
+		pb.GetBlockTime(), pb.GetHeight(), dapp.ExecAddress(string(tx.Execer)), pb.GetLocalDB(), index, pb.GetAPI().GetConfig()}
 }
 
 // CheckExecAccountBalance 检查账户余额
@@ -479,6 +481,81 @@ func (action *Action) genTxRnds(txhash []byte, playnum int32) ([]int64, error) {
 	return rands, nil
 }
 
+// genRandaoRnds 使用randao轮次的随机数为每个玩家生成发牌随机数，按玩家地址派生，与玩家顺序无关
+// 轮次的提交截止高度不能早于玩家加入游戏冻结赌注的高度，提交-揭示模式的最少揭示人数不能低于RandaoMinReveals
+func (action *Action) genRandaoRnds(game *pkt.PokerBull) ([]int64, error) {
+	joinHeight := game.Index / types.MaxTxsPerBlock
+	random, err := rty.GetConsumerRandom(action.db, pkt.PlatformSignAddress, pkt.RandaoConsumer(game.GameId, game.Round), joinHeight, pkt.RandaoMinReveals)
+	if err != nil {
+		return nil, err
+	}
+
+	rands := make([]int64, len(game.Players))
+	for i, player := range game.Players {
+		// 与genTxRnds一致取48位
+		rands[i] = int64(rty.RandomUint64(random, []byte(player.Address)) >> 16)
+	}
+	return rands, nil
+}
+
+// randaoJoin randao发牌的游戏先冻结所有玩家的赌注，等待平台以该局游戏的业务标识创建的随机数轮次结束后再发牌
+func (action *Action) randaoJoin(game *pkt.PokerBull, addrs []string) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	for _, addr := range addrs {
+		receipt, err := action.coinsAccount.ExecFrozen(addr, action.execaddr, game.GetValue()*PokerbullLeverageMax)
+		if err != nil {
+			logger.Error("GamePlay randao ExecFrozen", "GameID", game.GetGameId(), "addr", addr, "execaddr", action.execaddr,
+				"amount", game.GetValue()*PokerbullLeverageMax, "err", err)
+			return nil, nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+		game.Players = append(game.Players, &pkt.PBPlayer{Address: addr})
+	}
+	game.Randao = true
+	return logs, kv, nil
+}
+
+// randaoSettle 解冻所有玩家的赌注，随机数轮次产生结果后发牌结算，
+// 轮次失败、提交截止高度早于加入高度或者最少揭示人数不足时业务标识无法重新绑定，本局作废只退还赌注
+func (action *Action) randaoSettle(game *pkt.PokerBull) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	rands, err := action.genRandaoRnds(game)
+	if err != nil && !rty.IsConsumerVoid(err) {
+		return nil, nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	for _, player := range game.Players {
+		receipt, err := action.coinsAccount.ExecActive(player.Address, action.execaddr, game.GetValue()*PokerbullLeverageMax)
+		if err != nil {
+			logger.Error("GamePlay randao ExecActive", "GameID", game.GetGameId(), "addr", player.Address, "execaddr", action.execaddr,
+				"amount", game.GetValue()*PokerbullLeverageMax, "err", err)
+			return nil, nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+
+	game.IsWaiting = false
+	if err != nil {
+		logger.Info(fmt.Sprintf("Game void: %s round: %d", game.GameId, game.Round), "err", err)
+	} else {
+		for i, player := range game.Players {
+			player.TxHash = rands[i]
+		}
+		logger.Info(fmt.Sprintf("Game starting: %s round: %d", game.GameId, game.Round))
+		logsH, kvH, err := action.settleAccount("", game)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, logsH...)
+		kv = append(kv, kvH...)
+	}
+	return logs, kv, nil
+}
+
 func (action *Action) checkPlayerAddressExist(pbPlayers []*pkt.PBPlayer) bool {
 	for _, player := range pbPlayers {
 		if action.fromaddr == player.Address {
@@ -826,6 +903,21 @@ func (action *Action) GameQuit(pbquit *pkt.PBGameQuit) (*types.Receipt, error) {
 		}
 	}
 
+	// randao发牌的游戏绑定轮次后结果已经确定，不能通过退出来放弃，按轮次结果发牌或者作废
+	if game.Randao && game.IsWaiting {
+		_, err := rty.GetConsumerRound(action.db, pkt.PlatformSignAddress, pkt.RandaoConsumer(game.GameId, game.Round))
+		if err == nil {
+			logsH, kvH, err := action.randaoSettle(game)
+			if err != nil {
+				logger.Error("GameQuit", "GameID", pbquit.GetGameId(), "addr", action.fromaddr, "execaddr",
+					action.execaddr, "randao settle", "err", err)
+				return nil, err
+			}
+			logs = append(logs, logsH...)
+			kv = append(kv, kvH...)
+		}
+	}
+
 	// 如果游戏没有开始，激活冻结账户
 	if game.IsWaiting {
 		if game.Status == pkt.PBGameActionStart {
@@ -903,16 +995,24 @@ func (action *Action) GamePlay(pbplay *pkt.PBGamePlay) (*types.Receipt, error) {
 		return nil, fmt.Errorf("Invalid player number")
 	}
 
-	// 检查玩家地址余额
-	for _, addr := range pbplay.Address {
-		if !action.CheckExecAccountBalance(addr, pbplay.GetValue()*PokerbullLeverageMax, 0) {
-			logger.Error("GamePlay", "addr", addr, "execaddr", action.execaddr, "id", pbplay.GetGameId(), "err", types.ErrNoBalance)
-			return nil, types.ErrNoBalance
+	if pbplay.Randao && !action.cfg.IsDappFork(action.height, pkt.PokerBullX, pkt.ForkPokerBullRandao) {
+		logger.Error("Pokerbull game play", "GameID", pbplay.GetGameId(), "height", action.height, "err", "randao not support")
+		return nil, types.ErrNotSupport
+	}
+
+	game, _ := action.readGame(pbplay.GetGameId())
+
+	// 检查玩家地址余额，randao发牌的游戏赌注已经在加入时冻结
+	if game == nil || !game.Randao || !game.IsWaiting {
+		for _, addr := range pbplay.Address {
+			if !action.CheckExecAccountBalance(addr, pbplay.GetValue()*PokerbullLeverageMax, 0) {
+				logger.Error("GamePlay", "addr", addr, "execaddr", action.execaddr, "id", pbplay.GetGameId(), "err", types.ErrNoBalance)
+				return nil, types.ErrNoBalance
+			}
 		}
 	}
 
 	// 游戏存在则校验游戏状态，不存在则创建游戏
-	game, _ := action.readGame(pbplay.GetGameId())
 	if game != nil {
 		if game.Status == pkt.PBGameActionQuit {
 			logger.Error("Pokerbull game play", "GameID", pbplay.GetGameId(), "round", pbplay.Round, "value",
@@ -920,6 +1020,30 @@ func (action *Action) GamePlay(pbplay *pkt.PBGamePlay) (*types.Receipt, error) {
 			return nil, fmt.Errorf("already game over")
 		}
 
+		// randao发牌的游戏再次提交同一回合时发牌
+		if game.Randao && game.IsWaiting {
+			if game.Round != pbplay.Round {
+				logger.Error("Pokerbull game play", "GameID", pbplay.GetGameId(), "round", pbplay.Round, "value",
+					pbplay.Value, "players", strings.Join(pbplay.Address, ","), "err", "game round error")
+				return nil, fmt.Errorf("game round error")
+			}
+			logsH, kvH, err := action.randaoSettle(game)
+			if err != nil {
+				logger.Error("Pokerbull game play", "GameID", pbplay.GetGameId(), "round", pbplay.Round, "value",
+					pbplay.Value, "players", strings.Join(pbplay.Address, ","), "err", err)
+				return nil, err
+			}
+			logs = append(logs, logsH...)
+			kv = append(kv, kvH...)
+			game.PreStatus = game.Status
+			game.Status = pkt.PBGameActionQuit
+			game.QuitTime = action.blocktime
+			game.QuitTxHash = common.ToHex(action.txhash)
+			game.PrevIndex = game.Index
+			game.Index = action.getIndex(game)
+			return action.savePlayGame(game, pbplay, logs, kv)
+		}
+
 		if game.Round+1 != pbplay.Round {
 			logger.Error("Pokerbull game play", "GameID", pbplay.GetGameId(), "round", pbplay.Round, "value",
 				pbplay.Value, "players", strings.Join(pbplay.Address, ","), "err", "game round error")
@@ -957,6 +1081,17 @@ func (action *Action) GamePlay(pbplay *pkt.PBGamePlay) (*types.Receipt, error) {
 		}
 		game = gameNew
 
+		if pbplay.Randao {
+			logsH, kvH, err := action.randaoJoin(game, pbplay.Address)
+			if err != nil {
+				return nil, err
+			}
+			logs = append(logs, logsH...)
+			kv = append(kv, kvH...)
+			logger.Info(fmt.Sprintf("Game waiting randao: %s round: %d", game.GameId, game.Round))
+			return action.savePlayGame(game, pbplay, logs, kv)
+		}
+
 		// 获取发牌随机数
 		rands, err := action.genTxRnds(action.txhash, game.PlayerNum)
 		if err != nil {
@@ -991,6 +1126,10 @@ func (action *Action) GamePlay(pbplay *pkt.PBGamePlay) (*types.Receipt, error) {
 	game.Index = action.getIndex(game)
 	game.IsWaiting = false
 
+	return action.savePlayGame(game, pbplay, logs, kv)
+}
+
+func (action *Action) savePlayGame(game *pkt.PokerBull, pbplay *pkt.PBGamePlay, logs []*types.ReceiptLog, kv []*types.KeyValue) (*types.Receipt, error) {
 	receiptLog := action.GetReceiptLog(game)
 	logs = append(logs, receiptLog)
 	gamekv, err := action.saveGame(game)
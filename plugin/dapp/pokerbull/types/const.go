@@ -22,6 +22,9 @@ const (
 	PlayStyleDealer
 )
 
+// ForkPokerBullRandao 支持使用randao合约的随机数发牌
+const ForkPokerBullRandao = "ForkPokerBullRandao"
+
 const (
 	// TyLogPBGameStart log for start PBgame
 	TyLogPBGameStart = 721
@@ -40,6 +40,7 @@ type PokerBull struct {
 	IsWaiting            bool        `protobuf:"varint,15,opt,name=isWaiting,proto3" json:"isWaiting,omitempty"`
 	PreStatus            int32       `protobuf:"varint,16,opt,name=preStatus,proto3" json:"preStatus,omitempty"`
 	Round                int32       `protobuf:"varint,17,opt,name=round,proto3" json:"round,omitempty"`
+	Randao               bool        `protobuf:"varint,18,opt,name=randao,proto3" json:"randao,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -189,6 +190,13 @@ func (m *PokerBull) GetRound() int32 {
 	return 0
 }
 
+func (m *PokerBull) GetRandao() bool {
+	if m != nil {
+		return m.Randao
+	}
+	return false
+}
+
 //一把牌
 type PBHand struct {
 	Cards                []int32  `protobuf:"varint,1,rep,packed,name=cards,proto3" json:"cards,omitempty"`
@@ -753,6 +761,7 @@ type PBGamePlay struct {
 	Round                int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 	Value                int64    `protobuf:"varint,3,opt,name=value,proto3" json:"value,omitempty"`
 	Address              []string `protobuf:"bytes,4,rep,name=address,proto3" json:"address,omitempty"`
+	Randao               bool     `protobuf:"varint,5,opt,name=randao,proto3" json:"randao,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -811,6 +820,13 @@ func (m *PBGamePlay) GetAddress() []string {
 	return nil
 }
 
+func (m *PBGamePlay) GetRandao() bool {
+	if m != nil {
+		return m.Randao
+	}
+	return false
+}
+
 //根据状态和游戏人数查找
 type QueryPBGameListByStatusAndPlayerNum struct {
 	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -1714,71 +1730,72 @@ func init() {
 }
 
 var fileDescriptor_8d22e4ee2313e311 = []byte{
-	// 1054 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x17, 0x4b, 0x8f, 0xdb, 0x44,
-	0xb8, 0xb6, 0xe3, 0x3c, 0x3e, 0x77, 0x93, 0xed, 0x00, 0xab, 0x11, 0x42, 0x28, 0x72, 0x97, 0x36,
-	0x20, 0xd8, 0x43, 0x56, 0x02, 0x55, 0x48, 0x48, 0x09, 0x07, 0xb2, 0x52, 0x85, 0xb2, 0xb3, 0x95,
-	0x7a, 0x76, 0xe3, 0xe9, 0xd6, 0xd4, 0x6b, 0x67, 0xfd, 0xd8, 0x36, 0x57, 0x0e, 0xdc, 0xf8, 0x15,
-	0x1c, 0xf8, 0x63, 0xfc, 0x10, 0x34, 0xdf, 0xcc, 0xd8, 0x63, 0x6f, 0xac, 0x25, 0xdc, 0xfc, 0x3d,
-	0xe6, 0x7b, 0xbf, 0x0c, 0x93, 0x6d, 0xfa, 0x9e, 0x67, 0x6f, 0xca, 0x38, 0x3e, 0xdb, 0x66, 0x69,
-	0x91, 0x12, 0xb7, 0xd8, 0x6d, 0x79, 0xee, 0xff, 0xd9, 0x83, 0xd1, 0x5a, 0x90, 0x96, 0x65, 0x1c,
-	0x93, 0x13, 0xe8, 0x5f, 0x07, 0x37, 0xfc, 0x22, 0xa4, 0xd6, 0xd4, 0x9a, 0x8d, 0x98, 0x82, 0x04,
-	0x3e, 0x2f, 0x82, 0xa2, 0xcc, 0xa9, 0x3d, 0xb5, 0x66, 0x2e, 0x53, 0x10, 0xf9, 0x02, 0x46, 0x79,
-	0x11, 0x64, 0xc5, 0xab, 0xe8, 0x86, 0x53, 0x67, 0x6a, 0xcd, 0x1c, 0x56, 0x23, 0xc8, 0x14, 0x3c,
-	0x09, 0x7c, 0x5c, 0x05, 0xf9, 0x3b, 0xda, 0x43, 0x91, 0x26, 0x8a, 0x7c, 0x0a, 0xee, 0x5d, 0x10,
-	0x97, 0x9c, 0xba, 0xf8, 0x56, 0x02, 0xe4, 0x14, 0x5c, 0xb4, 0x96, 0xf6, 0xa7, 0xd6, 0xcc, 0x9b,
-	0x8f, 0xcf, 0xd0, 0xd4, 0xb3, 0xf5, 0x12, 0x0d, 0x65, 0x92, 0x48, 0xbe, 0x86, 0xc1, 0x36, 0x0e,
-	0x76, 0x3c, 0xcb, 0xe9, 0x60, 0xea, 0xcc, 0xbc, 0xf9, 0xa4, 0xe6, 0x43, 0x3c, 0xd3, 0x74, 0x61,
-	0xa6, 0xfc, 0xfc, 0xb5, 0xbc, 0xa1, 0x43, 0xf4, 0xa0, 0x46, 0x08, 0x41, 0x19, 0xcf, 0xcb, 0xb8,
-	0xc8, 0xe9, 0xa8, 0x25, 0x88, 0x21, 0x9e, 0x69, 0xba, 0xb0, 0x37, 0x4a, 0x42, 0xfe, 0x91, 0x82,
-	0xb4, 0x17, 0x01, 0x14, 0x9f, 0xf1, 0xbb, 0x0b, 0xa4, 0x78, 0x32, 0x0a, 0x15, 0x82, 0x7c, 0x0e,
-	0xc3, 0xdb, 0x32, 0x92, 0x21, 0x7a, 0x8c, 0xc4, 0x0a, 0x26, 0x5f, 0x02, 0xe0, 0xb7, 0x0c, 0xd0,
-	0x11, 0x06, 0xc8, 0xc0, 0x08, 0x7a, 0xc8, 0x83, 0x98, 0x67, 0x8b, 0x30, 0xcc, 0xe8, 0x58, 0xd2,
-	0x6b, 0x8c, 0xd0, 0x1c, 0xe5, 0xaf, 0x83, 0xa8, 0x88, 0x92, 0x6b, 0x3a, 0x99, 0x5a, 0xb3, 0x21,
-	0xab, 0x11, 0xca, 0xae, 0x2b, 0x99, 0xb8, 0x63, 0xe5, 0xb6, 0x46, 0x08, 0x5f, 0xb2, 0xb4, 0x4c,
-	0x42, 0xfa, 0x04, 0x29, 0x12, 0xf0, 0x7f, 0xb7, 0xa0, 0xbf, 0x5e, 0xae, 0x82, 0x24, 0x14, 0x0c,
-	0x9b, 0x20, 0x0b, 0x73, 0x6a, 0x4d, 0x1d, 0xc1, 0x80, 0x80, 0x28, 0x05, 0x19, 0x0d, 0x5d, 0x0a,
-	0x12, 0x22, 0x14, 0x06, 0x41, 0x18, 0x66, 0x3c, 0xcf, 0xb1, 0x10, 0x46, 0x4c, 0x83, 0x18, 0xb4,
-	0xfc, 0x75, 0x94, 0x60, 0x01, 0x0c, 0x99, 0x04, 0x44, 0x58, 0x62, 0x7e, 0xc7, 0xb3, 0xe0, 0x5a,
-	0x66, 0xdf, 0x65, 0x15, 0xec, 0x7f, 0x80, 0xa1, 0x4e, 0x22, 0x79, 0x0a, 0xee, 0xbb, 0x20, 0x51,
-	0x56, 0x78, 0xf3, 0xa3, 0x2a, 0x37, 0xc2, 0x46, 0x26, 0x69, 0xa6, 0x72, 0xbb, 0xa9, 0xfc, 0x04,
-	0xfa, 0x85, 0x8c, 0xae, 0x2c, 0x4f, 0x05, 0xa1, 0xf7, 0x3c, 0x08, 0x77, 0xda, 0x28, 0x04, 0xfc,
-	0xbf, 0x2c, 0xa1, 0x59, 0x66, 0xfd, 0xbf, 0x69, 0x3e, 0x81, 0xfe, 0x87, 0x28, 0x49, 0x78, 0xa6,
-	0x14, 0x2b, 0xa8, 0xe1, 0x9e, 0xd3, 0x74, 0x4f, 0xbc, 0x91, 0x39, 0x54, 0x2d, 0xa1, 0x20, 0xf2,
-	0x0c, 0xc6, 0xf2, 0xeb, 0x65, 0x33, 0x30, 0x2d, 0xac, 0xff, 0x02, 0x06, 0xaa, 0x17, 0x3a, 0x72,
-	0x44, 0x61, 0xb0, 0x4d, 0xa3, 0xa4, 0x50, 0x56, 0xb9, 0x4c, 0x83, 0xfe, 0x1f, 0x36, 0x3c, 0x5e,
-	0x2f, 0x7f, 0x09, 0x6e, 0xf8, 0x62, 0x53, 0x44, 0x69, 0x42, 0xbe, 0x01, 0x17, 0x1b, 0x12, 0x1b,
-	0xde, 0x9b, 0x93, 0xca, 0x49, 0xc1, 0x73, 0x25, 0x28, 0xab, 0x47, 0x4c, 0xb2, 0x90, 0x73, 0x18,
-	0x6e, 0xd2, 0xa4, 0x88, 0x92, 0x92, 0xa3, 0x5c, 0x6f, 0xfe, 0x59, 0x83, 0xfd, 0x67, 0x45, 0x5c,
-	0x3d, 0x62, 0x15, 0x23, 0x79, 0x0e, 0x3d, 0x51, 0xd0, 0x18, 0x04, 0x6f, 0xfe, 0xa4, 0xf1, 0xe0,
-	0xb2, 0x8c, 0x84, 0x78, 0x64, 0x10, 0x96, 0xdc, 0x96, 0x3c, 0x93, 0x19, 0x69, 0x5b, 0x72, 0x29,
-	0x28, 0xc2, 0x12, 0x64, 0x11, 0x42, 0x45, 0xff, 0x62, 0x7c, 0xda, 0x42, 0x45, 0xdd, 0x08, 0xa1,
-	0x82, 0x81, 0x8c, 0xc1, 0x2e, 0x76, 0xd8, 0xad, 0x2e, 0xb3, 0x8b, 0xdd, 0x72, 0xa0, 0x06, 0x8e,
-	0xbf, 0x00, 0xcf, 0xf0, 0xb1, 0x1e, 0x44, 0x96, 0x39, 0x88, 0x1a, 0x73, 0xc3, 0x6e, 0xcd, 0x0d,
-	0x7f, 0x06, 0xe3, 0xa6, 0xdf, 0x5d, 0xe3, 0xd3, 0x3f, 0x05, 0xa8, 0x1d, 0xee, 0xe4, 0xfa, 0x4a,
-	0x9b, 0x84, 0xce, 0x76, 0xb2, 0xfd, 0xa6, 0x85, 0x09, 0x47, 0x3b, 0x27, 0x76, 0xd5, 0xdd, 0xb6,
-	0xd1, 0xdd, 0xb5, 0x9b, 0x8e, 0xe9, 0xa6, 0xd1, 0x3d, 0xbd, 0xa9, 0x63, 0x74, 0x8f, 0x7f, 0x0b,
-	0x4f, 0xd1, 0x18, 0xa9, 0xf0, 0x65, 0x94, 0x17, 0xcb, 0x9d, 0x1c, 0x1f, 0x8b, 0x24, 0x5c, 0x57,
-	0x13, 0xb4, 0x5e, 0x0f, 0x56, 0x7b, 0x3d, 0x74, 0xc7, 0xaf, 0x1e, 0xa6, 0x8e, 0x31, 0x4c, 0xfd,
-	0x57, 0xba, 0x40, 0x19, 0xdf, 0xa4, 0x59, 0x78, 0xf0, 0x4a, 0xda, 0x2f, 0x75, 0x01, 0x4f, 0xa4,
-	0x54, 0x9c, 0xc9, 0x0f, 0x88, 0xae, 0x44, 0xd8, 0xa6, 0x88, 0x9f, 0xe0, 0xc8, 0x34, 0x2c, 0x27,
-	0xdf, 0x89, 0xbd, 0x81, 0x9f, 0x6a, 0x42, 0x7c, 0xd2, 0xa8, 0x43, 0xc9, 0xc6, 0x34, 0x8f, 0xbf,
-	0x02, 0x72, 0xcf, 0x84, 0x9c, 0xcc, 0xdb, 0x42, 0x68, 0x43, 0x88, 0xc1, 0x5b, 0x4b, 0x7a, 0x0f,
-	0x13, 0x23, 0x2b, 0x17, 0xc9, 0xdb, 0xb4, 0xd3, 0x15, 0x02, 0x3d, 0x91, 0x4b, 0x35, 0x9c, 0xf0,
-	0xdb, 0x88, 0x9c, 0xb3, 0x3f, 0x72, 0x3d, 0xd3, 0xed, 0x73, 0xf0, 0x18, 0xdf, 0xc6, 0x4a, 0x19,
-	0x39, 0x85, 0x9e, 0x10, 0xad, 0xc6, 0xc5, 0xb1, 0x36, 0x56, 0x5f, 0x10, 0x0c, 0xa9, 0xfe, 0xb7,
-	0x70, 0xdc, 0xb2, 0x10, 0x87, 0x92, 0x34, 0x4a, 0x7a, 0x3a, 0x62, 0x1a, 0xf4, 0x5f, 0xc0, 0xc4,
-	0x50, 0x21, 0xaa, 0x8c, 0x3c, 0x03, 0x57, 0x50, 0x75, 0x50, 0xee, 0xeb, 0x91, 0x64, 0x7f, 0x09,
-	0xc4, 0x50, 0xb4, 0xdc, 0x31, 0x2c, 0xf3, 0x83, 0x9a, 0xc2, 0xff, 0xc7, 0x02, 0x62, 0xe8, 0x7f,
-	0x48, 0x48, 0x57, 0xe1, 0x3d, 0xaf, 0x16, 0xa3, 0x1c, 0x75, 0xf7, 0xae, 0x08, 0xbd, 0x29, 0x1b,
-	0x4b, 0xbb, 0xd7, 0x5e, 0xda, 0xfb, 0x4f, 0x22, 0xe3, 0xd8, 0xe9, 0x3f, 0x70, 0xec, 0xe0, 0x82,
-	0x2e, 0xca, 0x2c, 0xa1, 0x03, 0xb9, 0xf1, 0x24, 0xe4, 0xff, 0x6d, 0xc3, 0x11, 0xe3, 0x1b, 0x1e,
-	0x6d, 0x0b, 0x95, 0xcb, 0x43, 0x3d, 0xd4, 0xc5, 0xe4, 0x18, 0xc5, 0xb4, 0xb7, 0x68, 0x9a, 0x17,
-	0x91, 0xdb, 0xbe, 0x88, 0x1a, 0x63, 0xa1, 0xbf, 0x67, 0x2c, 0xc8, 0x00, 0x0c, 0x5a, 0xa3, 0xb8,
-	0x0e, 0xda, 0xb0, 0x1d, 0x34, 0x5a, 0x87, 0x67, 0x24, 0x6b, 0xcb, 0x3c, 0xfd, 0xaa, 0x1b, 0x08,
-	0x3a, 0x6f, 0x20, 0xcf, 0x2c, 0x08, 0x1c, 0x41, 0x57, 0xf2, 0x4c, 0x65, 0xfc, 0xf6, 0xff, 0x2c,
-	0x07, 0x72, 0x0c, 0xce, 0x5b, 0xae, 0xe7, 0xac, 0xf8, 0xf4, 0x7f, 0x84, 0xc9, 0x7a, 0xa9, 0x57,
-	0x85, 0x14, 0xdc, 0x95, 0x00, 0xf5, 0xd8, 0xae, 0x1f, 0xff, 0x20, 0x76, 0xc3, 0x25, 0x1e, 0x86,
-	0x87, 0x3d, 0xfc, 0x5e, 0x6c, 0x0b, 0x6c, 0x91, 0x83, 0xde, 0xbd, 0xe9, 0xe3, 0x5f, 0xc2, 0xf9,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x22, 0xb5, 0x84, 0x10, 0x38, 0x0c, 0x00, 0x00,
+	// 1070 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x0e, 0x49, 0x51, 0x3f, 0xc3, 0x58, 0xb2, 0xb7, 0xad, 0xb1, 0x28, 0x8a, 0x42, 0x60, 0xdc,
+	0x44, 0x2d, 0x5a, 0x1f, 0x64, 0xa0, 0x45, 0x50, 0xa0, 0x80, 0xd4, 0x43, 0x65, 0x20, 0x28, 0xe4,
+	0x75, 0x80, 0x9c, 0x19, 0x71, 0xe3, 0x10, 0xa1, 0x49, 0x99, 0x3f, 0x4e, 0x74, 0xcd, 0xa1, 0x2f,
+	0x52, 0x14, 0x7d, 0xb1, 0x3e, 0x48, 0xb1, 0xb3, 0xbb, 0xe4, 0x92, 0x16, 0xe1, 0x38, 0x37, 0xce,
+	0xcf, 0xce, 0xce, 0xcc, 0xce, 0x7c, 0x33, 0x84, 0xc9, 0x36, 0x7d, 0xc7, 0xb3, 0xd7, 0x65, 0x1c,
+	0x9f, 0x6e, 0xb3, 0xb4, 0x48, 0x89, 0x5b, 0xec, 0xb6, 0x3c, 0xf7, 0xff, 0xe9, 0xc1, 0x68, 0x2d,
+	0x44, 0xcb, 0x32, 0x8e, 0xc9, 0x31, 0xf4, 0xaf, 0x82, 0x6b, 0x7e, 0x1e, 0x52, 0x6b, 0x6a, 0xcd,
+	0x46, 0x4c, 0x51, 0x82, 0x9f, 0x17, 0x41, 0x51, 0xe6, 0xd4, 0x9e, 0x5a, 0x33, 0x97, 0x29, 0x8a,
+	0x7c, 0x03, 0xa3, 0xbc, 0x08, 0xb2, 0xe2, 0x65, 0x74, 0xcd, 0xa9, 0x33, 0xb5, 0x66, 0x0e, 0xab,
+	0x19, 0x64, 0x0a, 0x9e, 0x24, 0x3e, 0xac, 0x82, 0xfc, 0x2d, 0xed, 0xa1, 0x49, 0x93, 0x45, 0xbe,
+	0x04, 0xf7, 0x36, 0x88, 0x4b, 0x4e, 0x5d, 0x3c, 0x2b, 0x09, 0x72, 0x02, 0x2e, 0x7a, 0x4b, 0xfb,
+	0x53, 0x6b, 0xe6, 0xcd, 0xc7, 0xa7, 0xe8, 0xea, 0xe9, 0x7a, 0x89, 0x8e, 0x32, 0x29, 0x24, 0xdf,
+	0xc3, 0x60, 0x1b, 0x07, 0x3b, 0x9e, 0xe5, 0x74, 0x30, 0x75, 0x66, 0xde, 0x7c, 0x52, 0xeb, 0x21,
+	0x9f, 0x69, 0xb9, 0x70, 0x53, 0x7e, 0xfe, 0x59, 0x5e, 0xd3, 0x21, 0x46, 0x50, 0x33, 0x84, 0xa1,
+	0x8c, 0xe7, 0x65, 0x5c, 0xe4, 0x74, 0xd4, 0x32, 0xc4, 0x90, 0xcf, 0xb4, 0x5c, 0xf8, 0x1b, 0x25,
+	0x21, 0xff, 0x40, 0x41, 0xfa, 0x8b, 0x04, 0x9a, 0xcf, 0xf8, 0xed, 0x39, 0x4a, 0x3c, 0x99, 0x85,
+	0x8a, 0x41, 0xbe, 0x86, 0xe1, 0x4d, 0x19, 0xc9, 0x14, 0x3d, 0x46, 0x61, 0x45, 0x93, 0x6f, 0x01,
+	0xf0, 0x5b, 0x26, 0xe8, 0x00, 0x13, 0x64, 0x70, 0x84, 0x3c, 0xe4, 0x41, 0xcc, 0xb3, 0x45, 0x18,
+	0x66, 0x74, 0x2c, 0xe5, 0x35, 0x47, 0xdc, 0x1c, 0xe5, 0xaf, 0x82, 0xa8, 0x88, 0x92, 0x2b, 0x3a,
+	0x99, 0x5a, 0xb3, 0x21, 0xab, 0x19, 0xca, 0xaf, 0x4b, 0xf9, 0x70, 0x87, 0x2a, 0x6c, 0xcd, 0x10,
+	0xb1, 0x64, 0x69, 0x99, 0x84, 0xf4, 0x08, 0x25, 0x92, 0x10, 0x2f, 0x9d, 0x05, 0x49, 0x18, 0xa4,
+	0x94, 0xa0, 0x39, 0x45, 0xf9, 0x1f, 0x2d, 0xe8, 0xaf, 0x97, 0xab, 0x20, 0x09, 0xc5, 0xc1, 0x4d,
+	0x90, 0x85, 0x39, 0xb5, 0xa6, 0x8e, 0x38, 0x88, 0x04, 0x1e, 0xc4, 0x2c, 0xe9, 0x12, 0x91, 0x14,
+	0xa1, 0x30, 0x08, 0xc2, 0x30, 0xe3, 0x79, 0x8e, 0x05, 0x32, 0x62, 0x9a, 0xc4, 0x64, 0xe6, 0xaf,
+	0xa2, 0x04, 0x0b, 0x63, 0xc8, 0x24, 0x21, 0xd2, 0x15, 0xf3, 0x5b, 0x9e, 0x05, 0x57, 0xb2, 0x2a,
+	0x5c, 0x56, 0xd1, 0xfe, 0x7b, 0x18, 0xea, 0xc7, 0x25, 0x4f, 0xc0, 0x7d, 0x1b, 0x24, 0xca, 0x0b,
+	0x6f, 0x7e, 0x50, 0xbd, 0x99, 0xf0, 0x91, 0x49, 0x99, 0x79, 0xb9, 0xdd, 0xbc, 0xfc, 0x18, 0xfa,
+	0x85, 0xcc, 0xba, 0x2c, 0x5b, 0x45, 0x61, 0x56, 0x78, 0x10, 0xee, 0xb4, 0x53, 0x48, 0xf8, 0x7f,
+	0x5b, 0x30, 0xd4, 0xd5, 0xf0, 0x69, 0x37, 0x1f, 0x43, 0xff, 0x7d, 0x94, 0x24, 0x3c, 0x53, 0x17,
+	0x2b, 0xaa, 0x11, 0x9e, 0xd3, 0x0c, 0x4f, 0x9c, 0x91, 0x6f, 0xab, 0x5a, 0x45, 0x51, 0xe4, 0x29,
+	0x8c, 0xe5, 0xd7, 0x8b, 0x66, 0x62, 0x5a, 0x5c, 0xff, 0x39, 0x0c, 0x54, 0x8f, 0x74, 0xbc, 0x11,
+	0x85, 0xc1, 0x36, 0x8d, 0x92, 0x42, 0x79, 0xe5, 0x32, 0x4d, 0xfa, 0x7f, 0xd9, 0xf0, 0x78, 0xbd,
+	0xfc, 0x23, 0xb8, 0xe6, 0x8b, 0x4d, 0x11, 0xa5, 0x09, 0xf9, 0x01, 0x5c, 0x6c, 0x54, 0x04, 0x02,
+	0x6f, 0x4e, 0xaa, 0x20, 0x85, 0xce, 0xa5, 0x90, 0xac, 0x1e, 0x31, 0xa9, 0x42, 0xce, 0x60, 0xb8,
+	0x49, 0x93, 0x22, 0x4a, 0x4a, 0x8e, 0x76, 0xbd, 0xf9, 0x57, 0x0d, 0xf5, 0xdf, 0x95, 0x70, 0xf5,
+	0x88, 0x55, 0x8a, 0xe4, 0x19, 0xf4, 0x44, 0xa1, 0x63, 0x12, 0xbc, 0xf9, 0x51, 0xe3, 0xc0, 0x45,
+	0x19, 0x09, 0xf3, 0xa8, 0x20, 0x3c, 0xb9, 0x29, 0x79, 0x26, 0x5f, 0xa4, 0xed, 0xc9, 0x85, 0x90,
+	0x08, 0x4f, 0x50, 0x45, 0x18, 0x15, 0x7d, 0x4d, 0xdd, 0x3d, 0x46, 0x45, 0xdd, 0x08, 0xa3, 0x42,
+	0x81, 0x8c, 0xc1, 0x2e, 0x76, 0xd8, 0xc5, 0x2e, 0xb3, 0x8b, 0xdd, 0x72, 0xa0, 0x80, 0xc8, 0x5f,
+	0x80, 0x67, 0xc4, 0x58, 0x03, 0x94, 0x65, 0x02, 0x54, 0x03, 0x4f, 0xec, 0x16, 0x9e, 0xf8, 0x33,
+	0x18, 0x37, 0xe3, 0xee, 0x82, 0x55, 0xff, 0x04, 0xa0, 0x0e, 0xb8, 0x53, 0xeb, 0x3b, 0xf0, 0x8c,
+	0x60, 0x3b, 0xd5, 0x3e, 0x5a, 0x00, 0x75, 0xa4, 0x5d, 0x6a, 0x75, 0xdb, 0xdb, 0x66, 0xdb, 0x57,
+	0x71, 0x3a, 0x66, 0x9c, 0x46, 0xfb, 0xf4, 0xa6, 0x4e, 0xab, 0x7d, 0x14, 0x4c, 0xb8, 0x0d, 0x98,
+	0xb8, 0x81, 0x27, 0xe8, 0xa5, 0x74, 0xe4, 0x45, 0x94, 0x17, 0xcb, 0x9d, 0xc4, 0x9b, 0x45, 0x12,
+	0xae, 0x2b, 0xc8, 0xad, 0xe7, 0x89, 0xd5, 0x9e, 0x27, 0xdd, 0x89, 0xad, 0xd1, 0xd7, 0x31, 0xd0,
+	0xd7, 0x7f, 0xa9, 0x2b, 0x97, 0xf1, 0x4d, 0x9a, 0x85, 0x0f, 0x9e, 0x61, 0xfb, 0xad, 0x2e, 0xe0,
+	0x48, 0x5a, 0x45, 0x10, 0xbf, 0xc7, 0x74, 0x65, 0xc2, 0x36, 0x4d, 0xfc, 0x06, 0x07, 0xa6, 0x63,
+	0x39, 0xf9, 0x49, 0x0c, 0x9a, 0x4d, 0xaa, 0xdb, 0xd2, 0x9b, 0x7f, 0xd1, 0x28, 0x50, 0xa9, 0xc6,
+	0xb4, 0x8e, 0xbf, 0x02, 0x72, 0xc7, 0x85, 0x9c, 0xcc, 0xdb, 0x46, 0x68, 0xc3, 0x88, 0xa1, 0x5b,
+	0x5b, 0x7a, 0x07, 0x13, 0xe3, 0x55, 0xce, 0x93, 0x37, 0x69, 0x67, 0x28, 0x04, 0x7a, 0xe2, 0x8d,
+	0x15, 0x6a, 0xe1, 0xb7, 0x91, 0x39, 0x67, 0x7f, 0xe6, 0x7a, 0x66, 0xd8, 0x67, 0xe0, 0x31, 0xbe,
+	0x8d, 0xd5, 0x65, 0xe4, 0x04, 0x7a, 0xc2, 0xb4, 0xc2, 0x91, 0x43, 0xed, 0xac, 0x5e, 0x39, 0x18,
+	0x4a, 0xfd, 0x1f, 0xe1, 0xb0, 0xe5, 0x21, 0xa2, 0x95, 0x74, 0x4a, 0x46, 0x3a, 0x62, 0x9a, 0xf4,
+	0x9f, 0xc3, 0xc4, 0xb8, 0x42, 0x54, 0x19, 0x79, 0x0a, 0xae, 0x90, 0xea, 0xa4, 0xdc, 0xbd, 0x47,
+	0x8a, 0xfd, 0x25, 0x10, 0xe3, 0xa2, 0xe5, 0x8e, 0xe9, 0xa9, 0xf7, 0xe9, 0xcd, 0xe2, 0xff, 0x67,
+	0x01, 0x31, 0xee, 0xbf, 0xcf, 0x48, 0x57, 0xe1, 0x3d, 0xab, 0x26, 0xa6, 0xc4, 0xc0, 0x3b, 0x6b,
+	0x87, 0x12, 0x37, 0xa7, 0x7c, 0xaf, 0x3d, 0xe5, 0xf7, 0xef, 0x50, 0xc6, 0x76, 0xd4, 0xbf, 0x67,
+	0x3b, 0xc2, 0xc9, 0x5d, 0x94, 0x59, 0x42, 0x07, 0x72, 0x14, 0x4a, 0xca, 0xff, 0xd7, 0x86, 0x03,
+	0xc6, 0x37, 0x3c, 0xda, 0x16, 0xea, 0x2d, 0x1f, 0x1a, 0xa1, 0x2e, 0x26, 0xc7, 0x28, 0xa6, 0xbd,
+	0x45, 0xd3, 0x5c, 0xa1, 0xdc, 0xf6, 0x0a, 0xd5, 0x80, 0x85, 0xfe, 0x1e, 0x58, 0x90, 0x09, 0x18,
+	0xb4, 0x30, 0xba, 0x4e, 0xda, 0xb0, 0x9d, 0x34, 0x5a, 0xa7, 0x67, 0x24, 0x6b, 0xcb, 0xdc, 0x15,
+	0xab, 0xa5, 0x09, 0x3a, 0x97, 0x26, 0xcf, 0x2c, 0x08, 0x84, 0xa0, 0x4b, 0xb9, 0xd7, 0x32, 0x7e,
+	0xf3, 0x39, 0x53, 0x83, 0x1c, 0x82, 0xf3, 0x86, 0x6b, 0xfc, 0x15, 0x9f, 0xfe, 0xaf, 0x30, 0x59,
+	0x2f, 0xf5, 0x0c, 0x91, 0x86, 0xbb, 0x1e, 0x40, 0x1d, 0xb6, 0xeb, 0xc3, 0xbf, 0x88, 0xa1, 0x71,
+	0x81, 0x9b, 0xe4, 0xc3, 0x0e, 0xfe, 0x2c, 0xa6, 0x08, 0xb6, 0xc8, 0x83, 0xce, 0xbd, 0xee, 0xe3,
+	0x6f, 0xc5, 0xd9, 0xff, 0x03, 0x00, 0x0a, 0xa1, 0xa1, 0xc8, 0x69, 0x0c, 0x00, 0x00,
 }
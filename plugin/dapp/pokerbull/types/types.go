@@ -5,6 +5,7 @@
 package types
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/33cn/chain33/types"
@@ -20,8 +21,17 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(PokerBullX, "Enable", 0)
+	cfg.RegisterDappFork(PokerBullX, ForkPokerBullRandao, types.MaxHeight)
 }
 
+// RandaoConsumer 每一回合发牌使用的randao轮次业务标识，玩家加入冻结赌注后由平台签名地址以该标识创建随机数轮次
+func RandaoConsumer(gameID string, round int32) string {
+	return fmt.Sprintf("%s-%s-%d", PokerBullX, gameID, round)
+}
+
+// RandaoMinReveals 提交-揭示模式的发牌轮次至少需要的揭示人数，平台签名地址作为创建者不能参与提交
+const RandaoMinReveals = 3
+
 //InitExecutor ...
 func InitExecutor(cfg *types.Chain33Config) {
 	types.RegistorExecutor(PokerBullX, NewType(cfg))
@@ -0,0 +1,210 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pricefeed 多地址喂价轮次，issuance 和 collateralize 等合约共用
+// 每轮各喂价地址报价一次，达到最少喂价人数后按中位数结束本轮，
+// 相对上次接受价格偏离过大的价格需要下一轮确认，接受的价格计入时间加权平均价格
+package pricefeed
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/33cn/chain33/types"
+)
+
+//setting
+const (
+	MinFeeders   = 3   // 每轮最少喂价地址数的下限，少于3个地址时单个地址就能决定中位数
+	RoundTimeout = 600 // 喂价轮次超时时间
+)
+
+//喂价轮次状态
+const (
+	RoundStatusOpen     = 1 + iota // 等待更多喂价
+	RoundStatusAccepted            // 价格已接受
+	RoundStatusHeld                // 偏离过大，等待下一轮确认
+	RoundStatusExpired             // 超时未达到喂价人数
+)
+
+// ErrFeederDuplicate 同一地址在一轮内重复喂价
+var ErrFeederDuplicate = errors.New("ErrFeederDuplicate")
+
+// Config 喂价配置
+type Config struct {
+	MinFeeders   int32 //每轮最少喂价地址数
+	MaxDeviation int64 //相对上次接受价格的最大偏离比例，为0时不检查
+	TwapWindow   int64 //时间加权平均价格窗口(秒)，为0时使用最新价格
+}
+
+// Round 喂价轮次
+type Round struct {
+	RoundID   int64
+	Feeders   []string
+	Prices    []int64
+	StartTime int64
+	CloseTime int64
+	Median    int64
+	Twap      int64
+	Status    int32
+}
+
+// Point 价格点
+type Point struct {
+	Price int64
+	Time  int64
+}
+
+// Store 轮次和历史价格的存取，由各合约按自己的key和数据结构实现
+type Store interface {
+	GetLatestRoundID() int64
+	SetLatestRoundID(roundID int64) *types.KeyValue
+	GetRound(roundID int64) (*Round, error)
+	// 保存轮次并返回对应的回执日志
+	SetRound(round *Round) (*types.KeyValue, *types.ReceiptLog)
+	GetHistory() []*Point
+	SetHistory(points []*Point) *types.KeyValue
+	// 上次接受的价格
+	GetLastPrice() (int64, error)
+}
+
+// IsValidConfig 检查喂价配置，MinFeeders 为0时使用下限
+func IsValidConfig(cfg *Config) bool {
+	if cfg.MinFeeders != 0 && cfg.MinFeeders < MinFeeders {
+		return false
+	}
+	return cfg.MaxDeviation >= 0 && cfg.MaxDeviation < 10000 && cfg.TwapWindow >= 0
+}
+
+// Median 中位数，偶数个取中间两个的平均值
+func Median(prices []int64) int64 {
+	sorted := make([]int64, len(prices))
+	copy(sorted, prices)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// Twap 时间加权平均价格，每个价格按其持续时间加权，窗口内没有持续时间时使用最新价格
+func Twap(points []*Point, now int64, window int64) int64 {
+	if len(points) == 0 {
+		return 0
+	}
+	latest := points[len(points)-1].Price
+	if window <= 0 {
+		return latest
+	}
+
+	start := now - window
+	var total, duration int64
+	for i, point := range points {
+		end := now
+		if i+1 < len(points) {
+			end = points[i+1].Time
+		}
+		begin := point.Time
+		if begin < start {
+			begin = start
+		}
+		if end <= begin {
+			continue
+		}
+		total += point.Price * (end - begin)
+		duration += end - begin
+	}
+
+	if duration == 0 {
+		return latest
+	}
+	return total / duration
+}
+
+// IsDeviated 价格是否超过允许的偏离比例
+func IsDeviated(price, base, maxDeviation int64) bool {
+	if maxDeviation == 0 || base <= 0 {
+		return false
+	}
+	diff := price - base
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff*1e4 > base*maxDeviation
+}
+
+// Feed 记录喂价地址的报价，达到最少喂价人数后按中位数结束本轮
+// 返回本轮是否产生了被接受的价格，以及被接受的中位数价格和时间加权平均价格
+func Feed(store Store, cfg *Config, feeder string, price, blocktime int64) (bool, int64, int64, *types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	minFeeders := cfg.MinFeeders
+	if minFeeders < MinFeeders {
+		minFeeders = MinFeeders
+	}
+
+	roundID := store.GetLatestRoundID()
+	round, err := store.GetRound(roundID)
+	if err == nil && round.Status == RoundStatusOpen && blocktime-round.StartTime > RoundTimeout {
+		// 超时轮次作废
+		round.Status = RoundStatusExpired
+		round.CloseTime = blocktime
+		roundKV, log := store.SetRound(round)
+		kv = append(kv, roundKV)
+		logs = append(logs, log)
+	}
+	if err != nil || round.Status != RoundStatusOpen {
+		roundID++
+		round = &Round{RoundID: roundID, StartTime: blocktime, Status: RoundStatusOpen}
+		kv = append(kv, store.SetLatestRoundID(roundID))
+	}
+
+	for _, addr := range round.Feeders {
+		if addr == feeder {
+			return false, 0, 0, nil, ErrFeederDuplicate
+		}
+	}
+	round.Feeders = append(round.Feeders, feeder)
+	round.Prices = append(round.Prices, price)
+
+	var accepted bool
+	var twap int64
+	if int32(len(round.Feeders)) >= minFeeders {
+		round.CloseTime = blocktime
+		round.Median = Median(round.Prices)
+
+		// 偏离过大的价格需要下一轮价格确认
+		round.Status = RoundStatusAccepted
+		lastPrice, err := store.GetLastPrice()
+		if err == nil && IsDeviated(round.Median, lastPrice, cfg.MaxDeviation) {
+			prev, err := store.GetRound(round.RoundID - 1)
+			if err != nil || prev.Status != RoundStatusHeld || IsDeviated(round.Median, prev.Median, cfg.MaxDeviation) {
+				round.Status = RoundStatusHeld
+			}
+		}
+
+		if round.Status == RoundStatusAccepted {
+			points := append(store.GetHistory(), &Point{Price: round.Median, Time: blocktime})
+
+			// 只保留窗口内的价格和窗口开始前最近的一个价格
+			start := blocktime - cfg.TwapWindow
+			for len(points) > 1 && points[1].Time <= start {
+				points = points[1:]
+			}
+			twap = Twap(points, blocktime, cfg.TwapWindow)
+			round.Twap = twap
+			accepted = true
+			kv = append(kv, store.SetHistory(points))
+		}
+	}
+
+	roundKV, log := store.SetRound(round)
+	kv = append(kv, roundKV)
+	logs = append(logs, log)
+
+	return accepted, round.Median, twap, &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
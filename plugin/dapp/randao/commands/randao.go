@@ -0,0 +1,324 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/common"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	jsonrpc "github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/spf13/cobra"
+)
+
+// RandaoCmd 随机数合约命令行
+func RandaoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "randao",
+		Short: "randao random beacon management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		CreateRoundCmd(),
+		CommitCmd(),
+		RevealCmd(),
+		VrfRevealCmd(),
+		SettleCmd(),
+		VrfProveCmd(),
+		ShowRoundCmd(),
+	)
+	return cmd
+}
+
+func createRandaoTx(cmd *cobra.Command, actionName string, param types.Message) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	pm := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(rty.RandaoX),
+		ActionName: actionName,
+		Payload:    types.MustPBToJSON(param),
+	}
+	var res string
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", pm, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRoundCmd 创建随机数轮次
+func CreateRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "create a random round",
+		Run:   createRound,
+	}
+	addCreateRoundFlags(cmd)
+	return cmd
+}
+
+func addCreateRoundFlags(cmd *cobra.Command) {
+	cmd.Flags().Int32P("mode", "m", rty.RandaoModeCommitReveal, "mode(1:commit-reveal; 2:vrf)")
+	cmd.Flags().StringP("consumer", "c", "", "consumer tag bound to the round, eg: lottery-<lotteryId>-<round>")
+	cmd.Flags().Int64P("deposit", "d", 0, "deposit of each participant")
+	cmd.MarkFlagRequired("deposit")
+	cmd.Flags().Int64P("commitPeriod", "p", 0, "commit period in blocks")
+	cmd.MarkFlagRequired("commitPeriod")
+	cmd.Flags().Int64P("revealPeriod", "r", 0, "reveal period in blocks")
+	cmd.MarkFlagRequired("revealPeriod")
+	cmd.Flags().Int32P("minReveals", "n", 1, "min reveals for commit-reveal mode")
+}
+
+func createRound(cmd *cobra.Command, args []string) {
+	mode, _ := cmd.Flags().GetInt32("mode")
+	consumer, _ := cmd.Flags().GetString("consumer")
+	deposit, _ := cmd.Flags().GetInt64("deposit")
+	commitPeriod, _ := cmd.Flags().GetInt64("commitPeriod")
+	revealPeriod, _ := cmd.Flags().GetInt64("revealPeriod")
+	minReveals, _ := cmd.Flags().GetInt32("minReveals")
+
+	params := &rty.RandaoCreate{
+		Mode:         mode,
+		Consumer:     consumer,
+		Deposit:      deposit,
+		CommitPeriod: commitPeriod,
+		RevealPeriod: revealPeriod,
+		MinReveals:   minReveals,
+	}
+	createRandaoTx(cmd, "Create", params)
+}
+
+// CommitCmd 提交secret的hash
+func CommitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit",
+		Short: "commit sha256 hash of a 32 bytes secret",
+		Run:   commit,
+	}
+	addCommitFlags(cmd)
+	return cmd
+}
+
+func addCommitFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("roundID", "i", "", "round ID")
+	cmd.MarkFlagRequired("roundID")
+	cmd.Flags().StringP("secret", "s", "", "32 bytes secret in hex, keep it for reveal")
+	cmd.MarkFlagRequired("secret")
+}
+
+func commit(cmd *cobra.Command, args []string) {
+	roundID, _ := cmd.Flags().GetString("roundID")
+	secret, _ := cmd.Flags().GetString("secret")
+
+	data, err := common.FromHex(secret)
+	if err != nil || len(data) != 32 {
+		fmt.Fprintln(os.Stderr, "secret must be 32 bytes in hex")
+		return
+	}
+	params := &rty.RandaoCommit{
+		RoundID: roundID,
+		Hash:    common.Sha256(data),
+	}
+	createRandaoTx(cmd, "Commit", params)
+}
+
+// RevealCmd 揭示secret
+func RevealCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal",
+		Short: "reveal the committed secret",
+		Run:   reveal,
+	}
+	addRevealFlags(cmd)
+	return cmd
+}
+
+func addRevealFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("roundID", "i", "", "round ID")
+	cmd.MarkFlagRequired("roundID")
+	cmd.Flags().StringP("secret", "s", "", "32 bytes secret in hex")
+	cmd.MarkFlagRequired("secret")
+}
+
+func reveal(cmd *cobra.Command, args []string) {
+	roundID, _ := cmd.Flags().GetString("roundID")
+	secret, _ := cmd.Flags().GetString("secret")
+
+	data, err := common.FromHex(secret)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := &rty.RandaoReveal{
+		RoundID: roundID,
+		Secret:  data,
+	}
+	createRandaoTx(cmd, "Reveal", params)
+}
+
+// VrfRevealCmd VRF模式下提交证明
+func VrfRevealCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vrfReveal",
+		Short: "reveal vrf proof of vrf mode round",
+		Run:   vrfReveal,
+	}
+	addVrfRevealFlags(cmd)
+	return cmd
+}
+
+func addVrfRevealFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("roundID", "i", "", "round ID")
+	cmd.MarkFlagRequired("roundID")
+	cmd.Flags().StringP("proof", "p", "", "vrf proof in hex, see vrfProve")
+	cmd.MarkFlagRequired("proof")
+}
+
+func vrfReveal(cmd *cobra.Command, args []string) {
+	roundID, _ := cmd.Flags().GetString("roundID")
+	proof, _ := cmd.Flags().GetString("proof")
+
+	data, err := common.FromHex(proof)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := &rty.RandaoVrfReveal{
+		RoundID: roundID,
+		Proof:   data,
+	}
+	createRandaoTx(cmd, "VrfReveal", params)
+}
+
+// SettleCmd 揭示期结束后结算轮次
+func SettleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle",
+		Short: "settle the round after reveal period",
+		Run:   settle,
+	}
+	cmd.Flags().StringP("roundID", "i", "", "round ID")
+	cmd.MarkFlagRequired("roundID")
+	return cmd
+}
+
+func settle(cmd *cobra.Command, args []string) {
+	roundID, _ := cmd.Flags().GetString("roundID")
+	createRandaoTx(cmd, "Settle", &rty.RandaoSettle{RoundID: roundID})
+}
+
+// VrfProveCmd 离线计算VRF证明
+func VrfProveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vrfProve",
+		Short: "evaluate vrf proof of vrf mode round offline",
+		Run:   vrfProve,
+	}
+	addVrfProveFlags(cmd)
+	return cmd
+}
+
+func addVrfProveFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("roundID", "i", "", "round ID")
+	cmd.MarkFlagRequired("roundID")
+	cmd.Flags().StringP("blockHash", "b", "", "hash of the block at round commitEndHeight")
+	cmd.MarkFlagRequired("blockHash")
+	cmd.Flags().StringP("privKey", "k", "", "private key of round creator")
+	cmd.MarkFlagRequired("privKey")
+}
+
+func vrfProve(cmd *cobra.Command, args []string) {
+	roundID, _ := cmd.Flags().GetString("roundID")
+	blockHash, _ := cmd.Flags().GetString("blockHash")
+	key, _ := cmd.Flags().GetString("privKey")
+
+	hash, err := common.FromHex(blockHash)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	bKey, err := common.FromHex(key)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), bKey)
+	vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
+	vrfHash, vrfProof := vrfPriv.Evaluate(rty.VrfInput(roundID, hash))
+	fmt.Println("hash:", hex.EncodeToString(vrfHash[:]))
+	fmt.Println("proof:", hex.EncodeToString(vrfProof))
+}
+
+// ShowRoundCmd 查询随机数轮次
+func ShowRoundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "show random round info",
+		Run:   showRound,
+	}
+	addShowRoundFlags(cmd)
+	return cmd
+}
+
+func addShowRoundFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32P("type", "y", 0, "type(0:query by round ID; 1:query by consumer; 2:list)")
+	cmd.Flags().StringP("roundID", "i", "", "round ID")
+	cmd.Flags().StringP("creator", "a", "", "creator address")
+	cmd.Flags().StringP("consumer", "c", "", "consumer tag")
+
+	cmd.Flags().Int32P("status", "s", 0, "status")
+	cmd.Flags().Int32P("count", "n", 1, "count, default is 1")
+	cmd.Flags().Int32P("direction", "d", 0, "direction, default is reserve")
+	cmd.Flags().Int64P("height", "t", -1, "height, default is -1")
+	cmd.Flags().Int32P("index", "x", -1, "index, default is -1")
+}
+
+func showRound(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	typ, _ := cmd.Flags().GetUint32("type")
+	roundID, _ := cmd.Flags().GetString("roundID")
+	creator, _ := cmd.Flags().GetString("creator")
+	consumer, _ := cmd.Flags().GetString("consumer")
+	status, _ := cmd.Flags().GetInt32("status")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+	height, _ := cmd.Flags().GetInt64("height")
+	index, _ := cmd.Flags().GetInt32("index")
+
+	var params rpctypes.Query4Jrpc
+	var rep interface{}
+	params.Execer = rty.RandaoX
+	switch typ {
+	case 0:
+		params.FuncName = rty.FuncNameGetRound
+		params.Payload = types.MustPBToJSON(&types.ReqString{Data: roundID})
+		rep = &rty.RandaoRound{}
+	case 1:
+		params.FuncName = rty.FuncNameGetConsumerRound
+		params.Payload = types.MustPBToJSON(&rty.ReqRandaoConsumer{Creator: creator, Consumer: consumer})
+		rep = &rty.RandaoRound{}
+	default:
+		req := &rty.ReqRandaoRounds{
+			Status:    status,
+			Creator:   creator,
+			Count:     count,
+			Direction: direction,
+			Height:    height,
+			Index:     index,
+		}
+		params.FuncName = rty.FuncNameListRounds
+		params.Payload = types.MustPBToJSON(req)
+		rep = &rty.ReplyRandaoRounds{}
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, rep)
+	ctx.Run()
+}
@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// package randao 提供了链上随机数合约的实现
+// 功能描述：为游戏类合约提供无法预知、无法被单方操纵的随机数。
+//
+// 随机数轮次支持两种模式
+//  1. 提交-揭示模式：创建者以外的参与者在提交期内冻结押金并提交sha256(secret)，在揭示期内揭示secret并取回押金，
+//     揭示期结束后任何人都可以结算，随机数为sha256(roundID||所有揭示的secret异或)。
+//     揭示人数不足时轮次失败。未揭示者的押金被罚没，平分给按时揭示的参与者，无人揭示时转入基金地址。
+//  1. VRF模式：创建者冻结押金，提交期结束后用交易签名私钥对sha256(roundID||提交截止高度的区块hash)计算VRF证明并提交，
+//     VRF输出即为随机数。创建者在揭示期内未提交证明时轮次失败，押金转入基金地址。
+//
+// 其他合约通过types.GetConsumerRandom读取业务标识绑定的随机数，
+// 同一创建者的同一业务标识只能绑定一次，且轮次的提交截止高度不能早于下注截止高度，
+// 提交-揭示模式的最少揭示人数不能低于业务要求，轮次失败或不满足要求时业务合约作废本局并退款。
+// 目前lottery(ForkLotteryRandao)和pokerbull(ForkPokerBullRandao)支持使用该随机数。
+// guess和blackwhite不使用：guess的结果是管理员或预言机公布的现实世界事件结果，不是随机数；
+// blackwhite的输赢由玩家自己提交-揭示的黑白选择决定，本身就是提交-揭示，没有需要外部随机数的步骤。
+
+package randao
@@ -0,0 +1,389 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+)
+
+const (
+	maxParticipants = 100       // 单个轮次最多参与人数
+	maxPeriod       = 17280 * 7 // 提交期和揭示期的最大区块数
+	secretLen       = 32        // secret固定32字节
+)
+
+type action struct {
+	api          client.QueueProtocolAPI
+	coinsAccount *account.DB
+	db           dbm.KV
+	txhash       []byte
+	fromaddr     string
+	pubkey       []byte
+	height       int64
+	index        int32
+	execaddr     string
+}
+
+func newAction(r *Randao, tx *types.Transaction, index int32) *action {
+	return &action{
+		api:          r.GetAPI(),
+		coinsAccount: r.GetCoinsAccount(),
+		db:           r.GetStateDB(),
+		txhash:       tx.Hash(),
+		fromaddr:     tx.From(),
+		pubkey:       tx.GetSignature().GetPubkey(),
+		height:       r.GetHeight(),
+		index:        index,
+		execaddr:     dapp.ExecAddress(string(tx.Execer)),
+	}
+}
+
+func (a *action) create(create *rty.RandaoCreate) (*types.Receipt, error) {
+	if create.Mode != rty.RandaoModeCommitReveal && create.Mode != rty.RandaoModeVrf {
+		return nil, rty.ErrRandaoMode
+	}
+	if create.Deposit <= 0 || create.CommitPeriod <= 0 || create.CommitPeriod > maxPeriod ||
+		create.RevealPeriod <= 0 || create.RevealPeriod > maxPeriod {
+		rlog.Error("randao create", "addr", a.fromaddr, "param", create, "err", rty.ErrRandaoParam)
+		return nil, rty.ErrRandaoParam
+	}
+	if create.Mode == rty.RandaoModeCommitReveal && (create.MinReveals <= 0 || create.MinReveals > maxParticipants) {
+		rlog.Error("randao create", "addr", a.fromaddr, "minReveals", create.MinReveals, "err", rty.ErrRandaoParam)
+		return nil, rty.ErrRandaoParam
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+
+	roundID := common.ToHex(a.txhash)
+	// 业务标识一经绑定不能更换，轮次失败时由业务合约作废退款，防止通过揭示人数不足让轮次失败后重新绑定来挑选结果
+	if create.Consumer != "" {
+		_, err := a.db.Get(rty.ConsumerKey(a.fromaddr, create.Consumer))
+		if err == nil {
+			rlog.Error("randao create", "addr", a.fromaddr, "consumer", create.Consumer, "err", rty.ErrRandaoConsumerExist)
+			return nil, rty.ErrRandaoConsumerExist
+		}
+		kv = append(kv, &types.KeyValue{Key: rty.ConsumerKey(a.fromaddr, create.Consumer), Value: []byte(roundID)})
+	}
+
+	round := &rty.RandaoRound{
+		RoundID:         roundID,
+		Creator:         a.fromaddr,
+		Mode:            create.Mode,
+		Status:          rty.RandaoStatusCommit,
+		Consumer:        create.Consumer,
+		Deposit:         create.Deposit,
+		CreateHeight:    a.height,
+		CommitEndHeight: a.height + create.CommitPeriod,
+		RevealEndHeight: a.height + create.CommitPeriod + create.RevealPeriod,
+		MinReveals:      create.MinReveals,
+		Index:           a.index,
+	}
+	// VRF模式由创建者使用交易签名公钥生成随机数，需冻结押金防止不提交证明
+	if create.Mode == rty.RandaoModeVrf {
+		round.MinReveals = 0
+		round.VrfPubKey = a.pubkey
+		receipt, err := a.coinsAccount.ExecFrozen(a.fromaddr, a.execaddr, create.Deposit)
+		if err != nil {
+			rlog.Error("randao create", "addr", a.fromaddr, "execaddr", a.execaddr, "ExecFrozen", create.Deposit, "err", err)
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	}
+
+	kv = append(kv, &types.KeyValue{Key: rty.RoundKey(roundID), Value: types.Encode(round)})
+	logs = append(logs, getReceiptLog(nil, round, rty.TyLogRandaoCreate))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) commit(commit *rty.RandaoCommit) (*types.Receipt, error) {
+	round, err := rty.GetRound(a.db, commit.RoundID)
+	if err != nil {
+		rlog.Error("randao commit", "addr", a.fromaddr, "round", commit.RoundID, "err", err)
+		return nil, err
+	}
+	pre := copyRound(round)
+
+	if round.Mode != rty.RandaoModeCommitReveal || round.Status != rty.RandaoStatusCommit {
+		return nil, rty.ErrRandaoStatus
+	}
+	if a.height > round.CommitEndHeight {
+		return nil, rty.ErrRandaoPeriod
+	}
+	// 创建者通常就是业务方，参与提交后可以与自己控制的地址配合预知结果
+	if a.fromaddr == round.Creator {
+		return nil, rty.ErrRandaoCreatorCommit
+	}
+	if len(commit.Hash) != len(common.Sha256(nil)) {
+		return nil, rty.ErrRandaoParam
+	}
+	if len(round.Participants) >= maxParticipants {
+		return nil, rty.ErrRandaoParticipantsFull
+	}
+	if getParticipant(round, a.fromaddr) != nil {
+		return nil, rty.ErrRandaoRepeatCommit
+	}
+
+	receipt, err := a.coinsAccount.ExecFrozen(a.fromaddr, a.execaddr, round.Deposit)
+	if err != nil {
+		rlog.Error("randao commit", "addr", a.fromaddr, "execaddr", a.execaddr, "ExecFrozen", round.Deposit, "err", err)
+		return nil, err
+	}
+	round.Participants = append(round.Participants, &rty.RandaoParticipant{Addr: a.fromaddr, CommitHash: commit.Hash})
+
+	kv := append(receipt.KV, &types.KeyValue{Key: rty.RoundKey(round.RoundID), Value: types.Encode(round)})
+	logs := append(receipt.Logs, getReceiptLog(pre, round, rty.TyLogRandaoCommit))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) reveal(reveal *rty.RandaoReveal) (*types.Receipt, error) {
+	round, err := rty.GetRound(a.db, reveal.RoundID)
+	if err != nil {
+		rlog.Error("randao reveal", "addr", a.fromaddr, "round", reveal.RoundID, "err", err)
+		return nil, err
+	}
+	pre := copyRound(round)
+
+	if round.Mode != rty.RandaoModeCommitReveal || round.Status != rty.RandaoStatusCommit {
+		return nil, rty.ErrRandaoStatus
+	}
+	if a.height <= round.CommitEndHeight || a.height > round.RevealEndHeight {
+		return nil, rty.ErrRandaoPeriod
+	}
+	participant := getParticipant(round, a.fromaddr)
+	if participant == nil {
+		return nil, rty.ErrRandaoNotParticipant
+	}
+	if participant.Revealed {
+		return nil, rty.ErrRandaoStatus
+	}
+	if len(reveal.Secret) != secretLen || !bytes.Equal(common.Sha256(reveal.Secret), participant.CommitHash) {
+		rlog.Error("randao reveal", "addr", a.fromaddr, "round", reveal.RoundID, "err", rty.ErrRandaoSecret)
+		return nil, rty.ErrRandaoSecret
+	}
+
+	// 按时揭示后退还押金
+	receipt, err := a.coinsAccount.ExecActive(a.fromaddr, a.execaddr, round.Deposit)
+	if err != nil {
+		rlog.Error("randao reveal", "addr", a.fromaddr, "execaddr", a.execaddr, "ExecActive", round.Deposit, "err", err)
+		return nil, err
+	}
+	participant.Secret = reveal.Secret
+	participant.Revealed = true
+
+	kv := append(receipt.KV, &types.KeyValue{Key: rty.RoundKey(round.RoundID), Value: types.Encode(round)})
+	logs := append(receipt.Logs, getReceiptLog(pre, round, rty.TyLogRandaoReveal))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (a *action) vrfReveal(reveal *rty.RandaoVrfReveal) (*types.Receipt, error) {
+	round, err := rty.GetRound(a.db, reveal.RoundID)
+	if err != nil {
+		rlog.Error("randao vrfReveal", "addr", a.fromaddr, "round", reveal.RoundID, "err", err)
+		return nil, err
+	}
+	pre := copyRound(round)
+
+	if round.Mode != rty.RandaoModeVrf || round.Status != rty.RandaoStatusCommit {
+		return nil, rty.ErrRandaoStatus
+	}
+	if a.fromaddr != round.Creator {
+		return nil, rty.ErrRandaoNotParticipant
+	}
+	if a.height <= round.CommitEndHeight || a.height > round.RevealEndHeight {
+		return nil, rty.ErrRandaoPeriod
+	}
+
+	input, err := a.vrfInput(round)
+	if err != nil {
+		return nil, err
+	}
+	hash, err := vrfVerify(round.VrfPubKey, input, reveal.Proof)
+	if err != nil {
+		rlog.Error("randao vrfReveal", "addr", a.fromaddr, "round", reveal.RoundID, "err", err)
+		return nil, err
+	}
+
+	receipt, err := a.coinsAccount.ExecActive(round.Creator, a.execaddr, round.Deposit)
+	if err != nil {
+		rlog.Error("randao vrfReveal", "addr", a.fromaddr, "execaddr", a.execaddr, "ExecActive", round.Deposit, "err", err)
+		return nil, err
+	}
+	round.VrfInput = input
+	round.VrfProof = reveal.Proof
+	round.Result = hash
+	round.ResultHeight = a.height
+	round.Status = rty.RandaoStatusFinished
+
+	kv := append(receipt.KV, &types.KeyValue{Key: rty.RoundKey(round.RoundID), Value: types.Encode(round)})
+	logs := append(receipt.Logs, getReceiptLog(pre, round, rty.TyLogRandaoVrfReveal))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// vrfInput VRF输入为轮次ID和种子区块hash，种子区块在轮次创建时尚未产生
+func (a *action) vrfInput(round *rty.RandaoRound) ([]byte, error) {
+	reply, err := a.api.GetBlockHash(&types.ReqInt{Height: round.CommitEndHeight})
+	if err != nil {
+		rlog.Error("randao vrfInput", "height", round.CommitEndHeight, "err", err)
+		return nil, err
+	}
+	return rty.VrfInput(round.RoundID, reply.Hash), nil
+}
+
+func vrfVerify(pub []byte, input []byte, proof []byte) ([]byte, error) {
+	pubKey, err := secp256k1.ParsePubKey(pub, secp256k1.S256())
+	if err != nil {
+		return nil, rty.ErrRandaoVrfVerify
+	}
+	vrfPub := &vrf.PublicKey{PublicKey: (*ecdsa.PublicKey)(pubKey)}
+	hash, err := vrfPub.ProofToHash(input, proof)
+	if err != nil {
+		return nil, rty.ErrRandaoVrfVerify
+	}
+	return hash[:], nil
+}
+
+func (a *action) settle(settle *rty.RandaoSettle) (*types.Receipt, error) {
+	round, err := rty.GetRound(a.db, settle.RoundID)
+	if err != nil {
+		rlog.Error("randao settle", "addr", a.fromaddr, "round", settle.RoundID, "err", err)
+		return nil, err
+	}
+	pre := copyRound(round)
+
+	if round.Status != rty.RandaoStatusCommit {
+		return nil, rty.ErrRandaoStatus
+	}
+	if a.height <= round.RevealEndHeight {
+		return nil, rty.ErrRandaoPeriod
+	}
+
+	var receipt *types.Receipt
+	if round.Mode == rty.RandaoModeVrf {
+		// 未按时提交VRF证明，押金转入基金
+		round.Status = rty.RandaoStatusFailed
+		receipt, err = a.coinsAccount.ExecTransferFrozen(round.Creator, a.fundAddr(), a.execaddr, round.Deposit)
+	} else {
+		receipt, err = a.settleCommitReveal(round)
+	}
+	if err != nil {
+		rlog.Error("randao settle", "addr", a.fromaddr, "round", settle.RoundID, "err", err)
+		return nil, err
+	}
+	round.ResultHeight = a.height
+
+	kv := append(receipt.KV, &types.KeyValue{Key: rty.RoundKey(round.RoundID), Value: types.Encode(round)})
+	logs := append(receipt.Logs, getReceiptLog(pre, round, rty.TyLogRandaoSettle))
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// settleCommitReveal 计算随机数并罚没未揭示参与者的押金
+// 随机数为轮次ID与所有揭示secret异或值的hash，罚没的押金由揭示者平分，没有揭示者时转入基金
+func (a *action) settleCommitReveal(round *rty.RandaoRound) (*types.Receipt, error) {
+	var revealers []string
+	var absentees []string
+	mix := make([]byte, secretLen)
+	for _, p := range round.Participants {
+		if !p.Revealed {
+			absentees = append(absentees, p.Addr)
+			continue
+		}
+		revealers = append(revealers, p.Addr)
+		for i := range mix {
+			mix[i] ^= p.Secret[i]
+		}
+	}
+	if len(revealers) >= int(round.MinReveals) {
+		round.Result = common.Sha256(append([]byte(round.RoundID), mix...))
+		round.Status = rty.RandaoStatusFinished
+	} else {
+		round.Status = rty.RandaoStatusFailed
+	}
+
+	receipt := &types.Receipt{}
+	if len(absentees) == 0 {
+		return receipt, nil
+	}
+	collector := a.fundAddr()
+	if len(revealers) > 0 {
+		collector = a.execaddr
+	}
+	for _, addr := range absentees {
+		r, err := a.coinsAccount.ExecTransferFrozen(addr, collector, a.execaddr, round.Deposit)
+		if err != nil {
+			return nil, err
+		}
+		receipt.KV = append(receipt.KV, r.KV...)
+		receipt.Logs = append(receipt.Logs, r.Logs...)
+	}
+	if len(revealers) == 0 {
+		return receipt, nil
+	}
+
+	total := round.Deposit * int64(len(absentees))
+	share := total / int64(len(revealers))
+	for i, addr := range revealers {
+		amount := share
+		// 除不尽的部分给第一个揭示者
+		if i == 0 {
+			amount += total - share*int64(len(revealers))
+		}
+		if amount == 0 {
+			continue
+		}
+		r, err := a.coinsAccount.ExecTransfer(a.execaddr, addr, a.execaddr, amount)
+		if err != nil {
+			return nil, err
+		}
+		receipt.KV = append(receipt.KV, r.KV...)
+		receipt.Logs = append(receipt.Logs, r.Logs...)
+	}
+	return receipt, nil
+}
+
+func (a *action) fundAddr() string {
+	cfg := a.api.GetConfig()
+	return cfg.MGStr("mver.consensus.fundKeyAddr", a.height)
+}
+
+func getParticipant(round *rty.RandaoRound, addr string) *rty.RandaoParticipant {
+	for _, p := range round.Participants {
+		if p.Addr == addr {
+			return p
+		}
+	}
+	return nil
+}
+
+func copyRound(round *rty.RandaoRound) *rty.RandaoRound {
+	if round == nil {
+		return nil
+	}
+	cp := *round
+	cp.Participants = make([]*rty.RandaoParticipant, len(round.Participants))
+	for i, p := range round.Participants {
+		participant := *p
+		cp.Participants[i] = &participant
+	}
+	return &cp
+}
+
+func getReceiptLog(pre, cur *rty.RandaoRound, ty int32) *types.ReceiptLog {
+	log := &types.ReceiptLog{Ty: ty}
+	log.Log = types.Encode(&rty.ReceiptRandao{Prev: pre, Current: cur})
+	return log
+}
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+)
+
+// Exec_Create 创建随机数轮次
+func (r *Randao) Exec_Create(payload *rty.RandaoCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, int32(index))
+	return action.create(payload)
+}
+
+// Exec_Commit 参与者提交secret的hash
+func (r *Randao) Exec_Commit(payload *rty.RandaoCommit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, int32(index))
+	return action.commit(payload)
+}
+
+// Exec_Reveal 参与者揭示secret
+func (r *Randao) Exec_Reveal(payload *rty.RandaoReveal, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, int32(index))
+	return action.reveal(payload)
+}
+
+// Exec_VrfReveal 创建者提交VRF证明
+func (r *Randao) Exec_VrfReveal(payload *rty.RandaoVrfReveal, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, int32(index))
+	return action.vrfReveal(payload)
+}
+
+// Exec_Settle 结算轮次，任何人都可以发起
+func (r *Randao) Exec_Settle(payload *rty.RandaoSettle, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(r, tx, int32(index))
+	return action.settle(payload)
+}
@@ -0,0 +1,66 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+)
+
+func (r *Randao) execAutoLocal(tx *types.Transaction, receiptData *types.ReceiptData) (*types.LocalDBSet, error) {
+	table := NewRoundTable(r.GetLocalDB())
+	for _, log := range receiptData.Logs {
+		switch log.Ty {
+		case rty.TyLogRandaoCreate,
+			rty.TyLogRandaoCommit,
+			rty.TyLogRandaoReveal,
+			rty.TyLogRandaoVrfReveal,
+			rty.TyLogRandaoSettle:
+			var receipt rty.ReceiptRandao
+			err := types.Decode(log.Log, &receipt)
+			if err != nil {
+				return nil, err
+			}
+			err = table.Replace(receipt.Current)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			break
+		}
+	}
+	kvs, err := table.Save()
+	if err != nil {
+		return nil, err
+	}
+	dbSet := &types.LocalDBSet{}
+	dbSet.KV = r.AddRollbackKV(tx, tx.Execer, kvs)
+	return dbSet, nil
+}
+
+// ExecLocal_Create 创建随机数轮次
+func (r *Randao) ExecLocal_Create(payload *rty.RandaoCreate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return r.execAutoLocal(tx, receiptData)
+}
+
+// ExecLocal_Commit 参与者提交secret的hash
+func (r *Randao) ExecLocal_Commit(payload *rty.RandaoCommit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return r.execAutoLocal(tx, receiptData)
+}
+
+// ExecLocal_Reveal 参与者揭示secret
+func (r *Randao) ExecLocal_Reveal(payload *rty.RandaoReveal, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return r.execAutoLocal(tx, receiptData)
+}
+
+// ExecLocal_VrfReveal 创建者提交VRF证明
+func (r *Randao) ExecLocal_VrfReveal(payload *rty.RandaoVrfReveal, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return r.execAutoLocal(tx, receiptData)
+}
+
+// ExecLocal_Settle 结算轮次
+func (r *Randao) ExecLocal_Settle(payload *rty.RandaoSettle, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return r.execAutoLocal(tx, receiptData)
+}
@@ -0,0 +1,81 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+)
+
+// Query_GetRound 查询随机数轮次
+func (r *Randao) Query_GetRound(in *types.ReqString) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return rty.GetRound(r.GetStateDB(), in.Data)
+}
+
+// Query_GetConsumerRound 查询业务标识绑定的随机数轮次
+func (r *Randao) Query_GetConsumerRound(in *rty.ReqRandaoConsumer) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return rty.GetConsumerRound(r.GetStateDB(), in.Creator, in.Consumer)
+}
+
+// Query_ListRounds 批量查询随机数轮次
+func (r *Randao) Query_ListRounds(in *rty.ReqRandaoRounds) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	localDb := r.GetLocalDB()
+	query := NewRoundTable(localDb).GetQuery(localDb)
+	var primary []byte
+	if in.Height > 0 {
+		primary = []byte(dapp.HeightIndexStr(in.Height, int64(in.Index)))
+	}
+	indexName := ""
+	if in.Status > 0 && in.Creator != "" {
+		indexName = "creator_status"
+	} else if in.Status > 0 {
+		indexName = "status"
+	} else if in.Creator != "" {
+		indexName = "creator"
+	}
+
+	var prefix []byte
+	if indexName != "" {
+		cur := NewRoundRow()
+		cur.Creator = in.Creator
+		cur.Status = in.Status
+		var err error
+		prefix, err = cur.Get(indexName)
+		if err != nil {
+			rlog.Error("Query_ListRounds Get", "indexName", indexName, "err", err)
+			return nil, err
+		}
+	}
+
+	rows, err := query.ListIndex(indexName, prefix, primary, in.Count, in.Direction)
+	if err != nil {
+		rlog.Error("Query_ListRounds query List failed", "indexName", indexName, "key", string(primary), "err", err)
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, types.ErrNotFound
+	}
+
+	var rep rty.ReplyRandaoRounds
+	for _, row := range rows {
+		round, ok := row.Data.(*rty.RandaoRound)
+		if !ok {
+			rlog.Error("Query_ListRounds", "err", "bad row type")
+			return nil, types.ErrDecode
+		}
+		rep.Rounds = append(rep.Rounds, round)
+	}
+	return &rep, nil
+}
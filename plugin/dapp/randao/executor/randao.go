@@ -0,0 +1,56 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+)
+
+var (
+	rlog       = log.New("module", "execs.randao")
+	driverName = rty.RandaoX
+)
+
+// Init 重命名执行器名称
+func Init(name string, cfg *types.Chain33Config, sub []byte) {
+	drivers.Register(cfg, GetName(), newRandao, cfg.GetDappFork(driverName, "Enable"))
+	InitExecType()
+}
+
+//InitExecType ...
+func InitExecType() {
+	ety := types.LoadExecutorType(driverName)
+	ety.InitFuncList(types.ListMethod(&Randao{}))
+}
+
+// Randao 执行器结构体
+type Randao struct {
+	drivers.DriverBase
+}
+
+func newRandao() drivers.Driver {
+	r := &Randao{}
+	r.SetChild(r)
+	r.SetExecutorType(types.LoadExecutorType(driverName))
+	return r
+}
+
+// GetName 获得执行器名字
+func GetName() string {
+	return newRandao().GetName()
+}
+
+// GetDriverName 获得驱动名字
+func (r *Randao) GetDriverName() string {
+	return driverName
+}
+
+// CheckReceiptExecOk return true to check if receipt ty is ok
+func (r *Randao) CheckReceiptExecOk() bool {
+	return true
+}
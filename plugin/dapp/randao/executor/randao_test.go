@@ -0,0 +1,337 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	dbm "github.com/33cn/chain33/common/db"
+	vrf "github.com/33cn/chain33/common/vrf/secp256k1"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+	secp256k1 "github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	PrivKeyA = "0x6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b" // 1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4
+	PrivKeyB = "0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4" // 1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR
+	PrivKeyC = "0xc2b31057b8692a56c7dd18199df71c1d21b781c0b6858c52997c9dbf778e8550" // 12evczYyX9ZKPYvwSEvRkRyTjpSrJuLudg
+	AddrA    = "1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"
+	AddrB    = "1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR"
+	PrivKeyD = "0xcacb1f5d51700aea07fca2246ab43b0917d70405c65edea9b5063d72eb5c6b71" // 1MCftFynyvG2F4ED5mdHYgziDxx6vDrScs
+	AddrC    = "12evczYyX9ZKPYvwSEvRkRyTjpSrJuLudg"
+	AddrD    = "1MCftFynyvG2F4ED5mdHYgziDxx6vDrScs"
+
+	testBalance = 1000 * types.Coin
+	testDeposit = 10 * types.Coin
+	seedHash    = common.Sha256([]byte("randao seed block"))
+)
+
+type execEnv struct {
+	cfg      *types.Chain33Config
+	exec     dapp.Driver
+	db       dbm.KV
+	ldb      dbm.DB
+	acc      *account.DB
+	execAddr string
+}
+
+func initEnv() *execEnv {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	cfg.RegisterDappFork(rty.RandaoX, "Enable", 0)
+	InitExecType()
+	_, ldb, kvdb := util.CreateTestDB()
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	api.On("GetBlockHash", mock.Anything).Return(&types.ReplyHash{Hash: seedHash}, nil)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+
+	execAddr := dapp.ExecAddress(rty.RandaoX)
+	acc := account.NewCoinsAccount(cfg)
+	acc.SetDB(stateDB)
+	for _, addr := range []string{AddrA, AddrB, AddrC, AddrD} {
+		acc.SaveExecAccount(execAddr, &types.Account{Addr: addr, Balance: testBalance})
+	}
+
+	exec := newRandao()
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	return &execEnv{cfg: cfg, exec: exec, db: stateDB, ldb: ldb, acc: acc, execAddr: execAddr}
+}
+
+func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
+	signType := types.SECP256K1
+	c, err := crypto.New(types.GetSignName(rty.RandaoX, signType))
+	if err != nil {
+		return tx, err
+	}
+
+	bytes, err := common.FromHex(hexPrivKey[:])
+	if err != nil {
+		return tx, err
+	}
+
+	privKey, err := c.PrivKeyFromBytes(bytes)
+	if err != nil {
+		return tx, err
+	}
+
+	tx.Sign(int32(signType), privKey)
+	return tx, nil
+}
+
+func (env *execEnv) execTx(t *testing.T, height int64, action *rty.RandaoAction, privKey string) (*types.Transaction, error) {
+	env.exec.SetEnv(height, 1539918074+height, 0)
+	tx, err := types.CreateFormatTx(env.cfg, rty.RandaoX, types.Encode(action))
+	assert.Nil(t, err)
+	tx, err = signTx(tx, privKey)
+	assert.Nil(t, err)
+
+	receipt, err := env.exec.Exec(tx, int(1))
+	if err != nil {
+		return tx, err
+	}
+	for _, kv := range receipt.KV {
+		env.db.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := env.exec.ExecLocal(tx, receiptData, int(1))
+	assert.Nil(t, err)
+	util.SaveKVList(env.ldb, set.KV)
+	return tx, nil
+}
+
+func createAction(create *rty.RandaoCreate) *rty.RandaoAction {
+	return &rty.RandaoAction{Ty: rty.RandaoActionCreate, Value: &rty.RandaoAction_Create{Create: create}}
+}
+
+func commitAction(roundID string, secret []byte) *rty.RandaoAction {
+	return &rty.RandaoAction{
+		Ty:    rty.RandaoActionCommit,
+		Value: &rty.RandaoAction_Commit{Commit: &rty.RandaoCommit{RoundID: roundID, Hash: common.Sha256(secret)}},
+	}
+}
+
+func revealAction(roundID string, secret []byte) *rty.RandaoAction {
+	return &rty.RandaoAction{
+		Ty:    rty.RandaoActionReveal,
+		Value: &rty.RandaoAction_Reveal{Reveal: &rty.RandaoReveal{RoundID: roundID, Secret: secret}},
+	}
+}
+
+func vrfRevealAction(roundID string, proof []byte) *rty.RandaoAction {
+	return &rty.RandaoAction{
+		Ty:    rty.RandaoActionVrfReveal,
+		Value: &rty.RandaoAction_VrfReveal{VrfReveal: &rty.RandaoVrfReveal{RoundID: roundID, Proof: proof}},
+	}
+}
+
+func settleAction(roundID string) *rty.RandaoAction {
+	return &rty.RandaoAction{Ty: rty.RandaoActionSettle, Value: &rty.RandaoAction_Settle{Settle: &rty.RandaoSettle{RoundID: roundID}}}
+}
+
+func TestRandaoCommitReveal(t *testing.T) {
+	env := initEnv()
+	create := &rty.RandaoCreate{
+		Mode:         rty.RandaoModeCommitReveal,
+		Consumer:     "game-1",
+		Deposit:      testDeposit,
+		CommitPeriod: 5,
+		RevealPeriod: 5,
+		MinReveals:   2,
+	}
+	tx, err := env.execTx(t, 10, createAction(create), PrivKeyA)
+	assert.Nil(t, err)
+	roundID := common.ToHex(tx.Hash())
+
+	// 同一业务标识不能绑定多个轮次
+	_, err = env.execTx(t, 10, createAction(create), PrivKeyA)
+	assert.Equal(t, rty.ErrRandaoConsumerExist, err)
+
+	secretA := common.Sha256([]byte("secret a"))
+	secretB := common.Sha256([]byte("secret b"))
+	secretC := common.Sha256([]byte("secret c"))
+	secretD := common.Sha256([]byte("secret d"))
+	// 创建者不能参与提交
+	_, err = env.execTx(t, 11, commitAction(roundID, secretA), PrivKeyA)
+	assert.Equal(t, rty.ErrRandaoCreatorCommit, err)
+	for _, p := range []struct {
+		key    string
+		secret []byte
+	}{{PrivKeyB, secretB}, {PrivKeyC, secretC}, {PrivKeyD, secretD}} {
+		_, err = env.execTx(t, 11, commitAction(roundID, p.secret), p.key)
+		assert.Nil(t, err)
+	}
+	_, err = env.execTx(t, 12, commitAction(roundID, secretB), PrivKeyB)
+	assert.Equal(t, rty.ErrRandaoRepeatCommit, err)
+	acc := env.acc.LoadExecAccount(AddrB, env.execAddr)
+	assert.Equal(t, testDeposit, acc.Frozen)
+
+	// 提交期内不能揭示，提交期结束后不能提交
+	_, err = env.execTx(t, 15, revealAction(roundID, secretB), PrivKeyB)
+	assert.Equal(t, rty.ErrRandaoPeriod, err)
+	_, err = env.execTx(t, 16, commitAction(roundID, secretB), PrivKeyB)
+	assert.Equal(t, rty.ErrRandaoPeriod, err)
+
+	_, err = env.execTx(t, 16, revealAction(roundID, secretC), PrivKeyB)
+	assert.Equal(t, rty.ErrRandaoSecret, err)
+	_, err = env.execTx(t, 16, revealAction(roundID, secretB), PrivKeyB)
+	assert.Nil(t, err)
+	_, err = env.execTx(t, 17, revealAction(roundID, secretC), PrivKeyC)
+	assert.Nil(t, err)
+	acc = env.acc.LoadExecAccount(AddrB, env.execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	_, err = rty.GetConsumerRandom(env.db, AddrA, "game-1", 15, 2)
+	assert.Equal(t, rty.ErrRandaoNotReady, err)
+
+	// 揭示期结束后结算，D未揭示，押金由B、C平分
+	_, err = env.execTx(t, 20, settleAction(roundID), PrivKeyC)
+	assert.Equal(t, rty.ErrRandaoPeriod, err)
+	_, err = env.execTx(t, 21, settleAction(roundID), PrivKeyC)
+	assert.Nil(t, err)
+	_, err = env.execTx(t, 22, settleAction(roundID), PrivKeyC)
+	assert.Equal(t, rty.ErrRandaoStatus, err)
+
+	acc = env.acc.LoadExecAccount(AddrD, env.execAddr)
+	assert.Equal(t, testBalance-testDeposit, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+	acc = env.acc.LoadExecAccount(AddrB, env.execAddr)
+	assert.Equal(t, testBalance+testDeposit/2, acc.Balance)
+	acc = env.acc.LoadExecAccount(AddrC, env.execAddr)
+	assert.Equal(t, testBalance+testDeposit/2, acc.Balance)
+
+	mix := make([]byte, len(secretB))
+	for i := range mix {
+		mix[i] = secretB[i] ^ secretC[i]
+	}
+	expect := common.Sha256(append([]byte(roundID), mix...))
+	random, err := rty.GetConsumerRandom(env.db, AddrA, "game-1", 15, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, expect, random)
+	// 轮次提交截止高度早于下注截止高度时不能使用
+	_, err = rty.GetConsumerRandom(env.db, AddrA, "game-1", 16, 2)
+	assert.Equal(t, rty.ErrRandaoCommitEnd, err)
+	// 轮次最少揭示人数低于业务要求时不能使用
+	_, err = rty.GetConsumerRandom(env.db, AddrA, "game-1", 15, 3)
+	assert.Equal(t, rty.ErrRandaoMinReveals, err)
+	assert.True(t, rty.IsConsumerVoid(err))
+
+	res, err := env.exec.Query(rty.FuncNameListRounds, types.Encode(&rty.ReqRandaoRounds{Creator: AddrA, Count: 10}))
+	assert.Nil(t, err)
+	rounds := res.(*rty.ReplyRandaoRounds).Rounds
+	assert.Equal(t, 1, len(rounds))
+	assert.Equal(t, int32(rty.RandaoStatusFinished), rounds[0].Status)
+	res, err = env.exec.Query(rty.FuncNameListRounds, types.Encode(&rty.ReqRandaoRounds{Count: 10}))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(res.(*rty.ReplyRandaoRounds).Rounds))
+}
+
+func TestRandaoMinReveals(t *testing.T) {
+	env := initEnv()
+	create := &rty.RandaoCreate{
+		Mode:         rty.RandaoModeCommitReveal,
+		Consumer:     "game-1",
+		Deposit:      testDeposit,
+		CommitPeriod: 5,
+		RevealPeriod: 5,
+		MinReveals:   2,
+	}
+	tx, err := env.execTx(t, 10, createAction(create), PrivKeyA)
+	assert.Nil(t, err)
+	roundID := common.ToHex(tx.Hash())
+
+	secret := common.Sha256([]byte("secret b"))
+	_, err = env.execTx(t, 11, commitAction(roundID, secret), PrivKeyB)
+	assert.Nil(t, err)
+	_, err = env.execTx(t, 11, commitAction(roundID, secret), PrivKeyC)
+	assert.Nil(t, err)
+
+	// 无人揭示，轮次失败，押金转入基金
+	_, err = env.execTx(t, 21, settleAction(roundID), PrivKeyA)
+	assert.Nil(t, err)
+	_, err = rty.GetConsumerRandom(env.db, AddrA, "game-1", 0, 2)
+	assert.Equal(t, rty.ErrRandaoFailed, err)
+	fund := env.cfg.MGStr("mver.consensus.fundKeyAddr", 21)
+	acc := env.acc.LoadExecAccount(fund, env.execAddr)
+	assert.Equal(t, 2*testDeposit, acc.Balance)
+
+	// 失败后业务标识也不能重新绑定轮次
+	_, err = env.execTx(t, 22, createAction(create), PrivKeyA)
+	assert.Equal(t, rty.ErrRandaoConsumerExist, err)
+	_, err = rty.GetConsumerRandom(env.db, AddrA, "game-1", 0, 2)
+	assert.Equal(t, rty.ErrRandaoFailed, err)
+}
+
+func TestRandaoVrf(t *testing.T) {
+	env := initEnv()
+	create := &rty.RandaoCreate{
+		Mode:         rty.RandaoModeVrf,
+		Consumer:     "game-2",
+		Deposit:      testDeposit,
+		CommitPeriod: 5,
+		RevealPeriod: 5,
+	}
+	tx, err := env.execTx(t, 30, createAction(create), PrivKeyA)
+	assert.Nil(t, err)
+	roundID := common.ToHex(tx.Hash())
+	acc := env.acc.LoadExecAccount(AddrA, env.execAddr)
+	assert.Equal(t, testDeposit, acc.Frozen)
+
+	bKey, _ := common.FromHex(PrivKeyA)
+	privKey, _ := secp256k1.PrivKeyFromBytes(secp256k1.S256(), bKey)
+	vrfPriv := &vrf.PrivateKey{PrivateKey: (*ecdsa.PrivateKey)(privKey)}
+	hash, proof := vrfPriv.Evaluate(rty.VrfInput(roundID, seedHash))
+
+	// 只有创建者可以在种子区块产生后提交证明
+	_, err = env.execTx(t, 36, vrfRevealAction(roundID, proof), PrivKeyB)
+	assert.Equal(t, rty.ErrRandaoNotParticipant, err)
+	_, err = env.execTx(t, 35, vrfRevealAction(roundID, proof), PrivKeyA)
+	assert.Equal(t, rty.ErrRandaoPeriod, err)
+	_, bad := vrfPriv.Evaluate(rty.VrfInput(roundID, nil))
+	_, err = env.execTx(t, 36, vrfRevealAction(roundID, bad), PrivKeyA)
+	assert.Equal(t, rty.ErrRandaoVrfVerify, err)
+
+	_, err = env.execTx(t, 36, vrfRevealAction(roundID, proof), PrivKeyA)
+	assert.Nil(t, err)
+	acc = env.acc.LoadExecAccount(AddrA, env.execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, testBalance, acc.Balance)
+	random, err := rty.GetConsumerRandom(env.db, AddrA, "game-2", 35, 2)
+	assert.Nil(t, err)
+	assert.True(t, bytes.Equal(hash[:], random))
+
+	// 未按时提交证明，押金转入基金
+	create.Consumer = "game-3"
+	tx, err = env.execTx(t, 40, createAction(create), PrivKeyA)
+	assert.Nil(t, err)
+	roundID = common.ToHex(tx.Hash())
+	_, err = env.execTx(t, 50, settleAction(roundID), PrivKeyB)
+	assert.Equal(t, rty.ErrRandaoPeriod, err)
+	_, err = env.execTx(t, 51, settleAction(roundID), PrivKeyB)
+	assert.Nil(t, err)
+	_, err = rty.GetConsumerRandom(env.db, AddrA, "game-3", 0, 2)
+	assert.Equal(t, rty.ErrRandaoFailed, err)
+	acc = env.acc.LoadExecAccount(AddrA, env.execAddr)
+	assert.Equal(t, testBalance-testDeposit, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+
+	res, err := env.exec.Query(rty.FuncNameGetConsumerRound, types.Encode(&rty.ReqRandaoConsumer{Creator: AddrA, Consumer: "game-3"}))
+	assert.Nil(t, err)
+	assert.Equal(t, int32(rty.RandaoStatusFailed), res.(*rty.RandaoRound).Status)
+}
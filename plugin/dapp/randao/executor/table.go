@@ -0,0 +1,76 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	rty "github.com/33cn/plugin/plugin/dapp/randao/types"
+)
+
+/*
+table  struct
+data:  randao round
+index: creator, status
+*/
+
+var roundOpt = &table.Option{
+	Prefix:  "LODB-randao",
+	Name:    "round",
+	Primary: "heightindex",
+	Index:   []string{"creator", "status", "creator_status"},
+}
+
+//NewRoundTable 新建表
+func NewRoundTable(kvdb db.KV) *table.Table {
+	rowmeta := NewRoundRow()
+	table, err := table.NewTable(rowmeta, kvdb, roundOpt)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//RoundRow table meta 结构
+type RoundRow struct {
+	*rty.RandaoRound
+}
+
+//NewRoundRow 新建一个meta 结构
+func NewRoundRow() *RoundRow {
+	return &RoundRow{RandaoRound: &rty.RandaoRound{}}
+}
+
+//CreateRow 新建数据行
+func (r *RoundRow) CreateRow() *table.Row {
+	return &table.Row{Data: &rty.RandaoRound{}}
+}
+
+//SetPayload 设置数据
+func (r *RoundRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*rty.RandaoRound); ok {
+		r.RandaoRound = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (r *RoundRow) Get(key string) ([]byte, error) {
+	if key == "heightindex" {
+		return []byte(dapp.HeightIndexStr(r.CreateHeight, int64(r.Index))), nil
+	} else if key == "status" {
+		return []byte(fmt.Sprintf("%2d", r.Status)), nil
+	} else if key == "creator" {
+		return []byte(r.Creator), nil
+	} else if key == "creator_status" {
+		return []byte(fmt.Sprintf("%s:%2d", r.Creator, r.Status)), nil
+	}
+	return nil, types.ErrNotFound
+}
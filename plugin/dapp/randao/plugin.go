@@ -0,0 +1,22 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package randao
+
+import (
+	"github.com/33cn/chain33/pluginmgr"
+	"github.com/33cn/plugin/plugin/dapp/randao/commands"
+	"github.com/33cn/plugin/plugin/dapp/randao/executor"
+	"github.com/33cn/plugin/plugin/dapp/randao/types"
+)
+
+func init() {
+	pluginmgr.Register(&pluginmgr.PluginBase{
+		Name:     types.RandaoX,
+		ExecName: executor.GetName(),
+		Exec:     executor.Init,
+		Cmd:      commands.RandaoCmd,
+		RPC:      nil,
+	})
+}
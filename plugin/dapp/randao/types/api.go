@@ -0,0 +1,98 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+)
+
+/*
+ * 供其他合约使用的随机数接口
+ * 业务方(如游戏创建者)以业务标识consumer创建随机数轮次，同一创建者的同一业务标识只能绑定一次，轮次失败后也不能重新绑定，
+ * 业务合约在结算时通过GetConsumerRandom读取该轮次的随机数，并要求轮次的提交截止高度不早于下注截止高度，
+ * 保证下注期间任何人都无法预知结果，也无法通过更换轮次来挑选结果。
+ * 提交-揭示模式下创建者不能参与提交，业务合约还要求轮次的最少揭示人数不低于minReveals，
+ * 避免创建者只让自己控制的少数地址参与而预知结果。
+ * IsConsumerVoid返回true时该业务标识再也无法产生可用的随机数，业务合约需要作废本局并退款
+ */
+
+// RoundKey 随机数轮次在状态数据库中的key
+func RoundKey(roundID string) []byte {
+	return []byte("mavl-" + RandaoX + "-round-" + roundID)
+}
+
+// ConsumerKey 业务标识绑定的随机数轮次
+func ConsumerKey(creator, consumer string) []byte {
+	return []byte("mavl-" + RandaoX + "-consumer-" + creator + "-" + consumer)
+}
+
+// GetRound 读取随机数轮次
+func GetRound(db dbm.KV, roundID string) (*RandaoRound, error) {
+	value, err := db.Get(RoundKey(roundID))
+	if err != nil {
+		return nil, err
+	}
+	round := &RandaoRound{}
+	if err = types.Decode(value, round); err != nil {
+		return nil, err
+	}
+	return round, nil
+}
+
+// GetConsumerRound 读取业务标识绑定的随机数轮次
+func GetConsumerRound(db dbm.KV, creator, consumer string) (*RandaoRound, error) {
+	value, err := db.Get(ConsumerKey(creator, consumer))
+	if err != nil {
+		return nil, err
+	}
+	return GetRound(db, string(value))
+}
+
+// GetConsumerRandom 获取业务标识绑定轮次产生的随机数
+// minCommitEnd为业务的下注截止高度，轮次的提交截止高度不能早于该高度
+// minReveals为提交-揭示模式下业务要求的最少揭示人数，VRF模式不检查
+func GetConsumerRandom(db dbm.KV, creator, consumer string, minCommitEnd int64, minReveals int32) ([]byte, error) {
+	round, err := GetConsumerRound(db, creator, consumer)
+	if err != nil {
+		return nil, err
+	}
+	if round.CommitEndHeight < minCommitEnd {
+		return nil, ErrRandaoCommitEnd
+	}
+	if round.Mode == RandaoModeCommitReveal && round.MinReveals < minReveals {
+		return nil, ErrRandaoMinReveals
+	}
+	switch round.Status {
+	case RandaoStatusFinished:
+		return round.Result, nil
+	case RandaoStatusFailed:
+		return nil, ErrRandaoFailed
+	default:
+		return nil, ErrRandaoNotReady
+	}
+}
+
+// IsConsumerVoid 业务标识绑定的轮次再也无法产生可用的随机数
+func IsConsumerVoid(err error) bool {
+	return err == ErrRandaoFailed || err == ErrRandaoCommitEnd || err == ErrRandaoMinReveals
+}
+
+// RandomUint64 由随机数和salt派生出一个uint64随机数，不同salt得到的结果相互独立
+func RandomUint64(random []byte, salt []byte) uint64 {
+	data := make([]byte, 0, len(random)+len(salt))
+	data = append(data, random...)
+	data = append(data, salt...)
+	return binary.BigEndian.Uint64(common.Sha256(data)[:8])
+}
+
+// VrfInput VRF模式的输入，由轮次ID和种子区块hash计算
+func VrfInput(roundID string, blockHash []byte) []byte {
+	input := append([]byte(roundID), blockHash...)
+	return common.Sha256(input)
+}
@@ -0,0 +1,53 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// action ty
+const (
+	RandaoActionCreate = iota + 1
+	RandaoActionCommit
+	RandaoActionReveal
+	RandaoActionVrfReveal
+	RandaoActionSettle
+
+	// log
+	TyLogRandaoCreate    = 2201
+	TyLogRandaoCommit    = 2202
+	TyLogRandaoReveal    = 2203
+	TyLogRandaoVrfReveal = 2204
+	TyLogRandaoSettle    = 2205
+)
+
+// 随机数产生方式
+const (
+	// RandaoModeCommitReveal 参与者提交-揭示
+	RandaoModeCommitReveal = iota + 1
+	// RandaoModeVrf 创建者使用VRF生成
+	RandaoModeVrf
+)
+
+// 轮次状态
+const (
+	// RandaoStatusCommit 进行中
+	RandaoStatusCommit = iota + 1
+	// RandaoStatusFinished 已产生随机数
+	RandaoStatusFinished
+	// RandaoStatusFailed 揭示人数不足或者VRF证明未提交
+	RandaoStatusFailed
+)
+
+// query func name
+const (
+	FuncNameGetRound         = "GetRound"
+	FuncNameGetConsumerRound = "GetConsumerRound"
+	FuncNameListRounds       = "ListRounds"
+)
+
+var (
+	// RandaoX 执行器名称
+	RandaoX = "randao"
+	// ExecerRandao 执行器名称
+	ExecerRandao = []byte(RandaoX)
+)
@@ -0,0 +1,40 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import "errors"
+
+var (
+	// ErrRandaoMode 不支持的随机数产生方式
+	ErrRandaoMode = errors.New("ErrRandaoMode")
+	// ErrRandaoParam 轮次参数错误
+	ErrRandaoParam = errors.New("ErrRandaoParam")
+	// ErrRandaoStatus 轮次状态错误
+	ErrRandaoStatus = errors.New("ErrRandaoStatus")
+	// ErrRandaoPeriod 不在允许的高度区间
+	ErrRandaoPeriod = errors.New("ErrRandaoPeriod")
+	// ErrRandaoRepeatCommit 重复提交
+	ErrRandaoRepeatCommit = errors.New("ErrRandaoRepeatCommit")
+	// ErrRandaoParticipantsFull 参与人数已满
+	ErrRandaoParticipantsFull = errors.New("ErrRandaoParticipantsFull")
+	// ErrRandaoNotParticipant 不是该轮次的参与者
+	ErrRandaoNotParticipant = errors.New("ErrRandaoNotParticipant")
+	// ErrRandaoSecret 揭示的secret与提交的hash不匹配
+	ErrRandaoSecret = errors.New("ErrRandaoSecret")
+	// ErrRandaoVrfVerify VRF证明验证失败
+	ErrRandaoVrfVerify = errors.New("ErrRandaoVrfVerify")
+	// ErrRandaoConsumerExist 业务标识已绑定了轮次
+	ErrRandaoConsumerExist = errors.New("ErrRandaoConsumerExist")
+	// ErrRandaoNotReady 随机数尚未产生
+	ErrRandaoNotReady = errors.New("ErrRandaoNotReady")
+	// ErrRandaoFailed 轮次失败，没有产生随机数
+	ErrRandaoFailed = errors.New("ErrRandaoFailed")
+	// ErrRandaoCommitEnd 轮次提交截止高度过早，结果可能被提前预知
+	ErrRandaoCommitEnd = errors.New("ErrRandaoCommitEnd")
+	// ErrRandaoCreatorCommit 创建者不能参与自己轮次的提交
+	ErrRandaoCreatorCommit = errors.New("ErrRandaoCreatorCommit")
+	// ErrRandaoMinReveals 轮次的最少揭示人数低于业务要求，结果可能被少数参与者预知
+	ErrRandaoMinReveals = errors.New("ErrRandaoMinReveals")
+)
@@ -0,0 +1,932 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: randao.proto
+
+package types
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/golang/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// 随机数轮次
+type RandaoRound struct {
+	RoundID              string               `protobuf:"bytes,1,opt,name=roundID,proto3" json:"roundID,omitempty"`
+	Creator              string               `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Mode                 int32                `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Status               int32                `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Consumer             string               `protobuf:"bytes,5,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Deposit              int64                `protobuf:"varint,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	CreateHeight         int64                `protobuf:"varint,7,opt,name=createHeight,proto3" json:"createHeight,omitempty"`
+	CommitEndHeight      int64                `protobuf:"varint,8,opt,name=commitEndHeight,proto3" json:"commitEndHeight,omitempty"`
+	RevealEndHeight      int64                `protobuf:"varint,9,opt,name=revealEndHeight,proto3" json:"revealEndHeight,omitempty"`
+	MinReveals           int32                `protobuf:"varint,10,opt,name=minReveals,proto3" json:"minReveals,omitempty"`
+	Participants         []*RandaoParticipant `protobuf:"bytes,11,rep,name=participants,proto3" json:"participants,omitempty"`
+	VrfPubKey            []byte               `protobuf:"bytes,12,opt,name=vrfPubKey,proto3" json:"vrfPubKey,omitempty"`
+	VrfInput             []byte               `protobuf:"bytes,13,opt,name=vrfInput,proto3" json:"vrfInput,omitempty"`
+	VrfProof             []byte               `protobuf:"bytes,14,opt,name=vrfProof,proto3" json:"vrfProof,omitempty"`
+	Result               []byte               `protobuf:"bytes,15,opt,name=result,proto3" json:"result,omitempty"`
+	ResultHeight         int64                `protobuf:"varint,16,opt,name=resultHeight,proto3" json:"resultHeight,omitempty"`
+	Index                int32                `protobuf:"varint,17,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RandaoRound) Reset()         { *m = RandaoRound{} }
+func (m *RandaoRound) String() string { return proto.CompactTextString(m) }
+func (*RandaoRound) ProtoMessage()    {}
+func (*RandaoRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{0}
+}
+
+func (m *RandaoRound) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoRound.Unmarshal(m, b)
+}
+func (m *RandaoRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoRound.Marshal(b, m, deterministic)
+}
+func (m *RandaoRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoRound.Merge(m, src)
+}
+func (m *RandaoRound) XXX_Size() int {
+	return xxx_messageInfo_RandaoRound.Size(m)
+}
+func (m *RandaoRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoRound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoRound proto.InternalMessageInfo
+
+func (m *RandaoRound) GetRoundID() string {
+	if m != nil {
+		return m.RoundID
+	}
+	return ""
+}
+
+func (m *RandaoRound) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *RandaoRound) GetMode() int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *RandaoRound) GetDeposit() int64 {
+	if m != nil {
+		return m.Deposit
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetCreateHeight() int64 {
+	if m != nil {
+		return m.CreateHeight
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetCommitEndHeight() int64 {
+	if m != nil {
+		return m.CommitEndHeight
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetRevealEndHeight() int64 {
+	if m != nil {
+		return m.RevealEndHeight
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetMinReveals() int32 {
+	if m != nil {
+		return m.MinReveals
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetParticipants() []*RandaoParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *RandaoRound) GetVrfPubKey() []byte {
+	if m != nil {
+		return m.VrfPubKey
+	}
+	return nil
+}
+
+func (m *RandaoRound) GetVrfInput() []byte {
+	if m != nil {
+		return m.VrfInput
+	}
+	return nil
+}
+
+func (m *RandaoRound) GetVrfProof() []byte {
+	if m != nil {
+		return m.VrfProof
+	}
+	return nil
+}
+
+func (m *RandaoRound) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *RandaoRound) GetResultHeight() int64 {
+	if m != nil {
+		return m.ResultHeight
+	}
+	return 0
+}
+
+func (m *RandaoRound) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// 提交-揭示模式的参与者
+type RandaoParticipant struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	CommitHash           []byte   `protobuf:"bytes,2,opt,name=commitHash,proto3" json:"commitHash,omitempty"`
+	Secret               []byte   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Revealed             bool     `protobuf:"varint,4,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RandaoParticipant) Reset()         { *m = RandaoParticipant{} }
+func (m *RandaoParticipant) String() string { return proto.CompactTextString(m) }
+func (*RandaoParticipant) ProtoMessage()    {}
+func (*RandaoParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{1}
+}
+
+func (m *RandaoParticipant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoParticipant.Unmarshal(m, b)
+}
+func (m *RandaoParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoParticipant.Marshal(b, m, deterministic)
+}
+func (m *RandaoParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoParticipant.Merge(m, src)
+}
+func (m *RandaoParticipant) XXX_Size() int {
+	return xxx_messageInfo_RandaoParticipant.Size(m)
+}
+func (m *RandaoParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoParticipant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoParticipant proto.InternalMessageInfo
+
+func (m *RandaoParticipant) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *RandaoParticipant) GetCommitHash() []byte {
+	if m != nil {
+		return m.CommitHash
+	}
+	return nil
+}
+
+func (m *RandaoParticipant) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *RandaoParticipant) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+type RandaoAction struct {
+	// Types that are valid to be assigned to Value:
+	//	*RandaoAction_Create
+	//	*RandaoAction_Commit
+	//	*RandaoAction_Reveal
+	//	*RandaoAction_VrfReveal
+	//	*RandaoAction_Settle
+	Value                isRandaoAction_Value `protobuf_oneof:"value"`
+	Ty                   int32                `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RandaoAction) Reset()         { *m = RandaoAction{} }
+func (m *RandaoAction) String() string { return proto.CompactTextString(m) }
+func (*RandaoAction) ProtoMessage()    {}
+func (*RandaoAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{2}
+}
+
+func (m *RandaoAction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoAction.Unmarshal(m, b)
+}
+func (m *RandaoAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoAction.Marshal(b, m, deterministic)
+}
+func (m *RandaoAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoAction.Merge(m, src)
+}
+func (m *RandaoAction) XXX_Size() int {
+	return xxx_messageInfo_RandaoAction.Size(m)
+}
+func (m *RandaoAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoAction proto.InternalMessageInfo
+
+type isRandaoAction_Value interface {
+	isRandaoAction_Value()
+}
+
+type RandaoAction_Create struct {
+	Create *RandaoCreate `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type RandaoAction_Commit struct {
+	Commit *RandaoCommit `protobuf:"bytes,2,opt,name=commit,proto3,oneof"`
+}
+
+type RandaoAction_Reveal struct {
+	Reveal *RandaoReveal `protobuf:"bytes,3,opt,name=reveal,proto3,oneof"`
+}
+
+type RandaoAction_VrfReveal struct {
+	VrfReveal *RandaoVrfReveal `protobuf:"bytes,4,opt,name=vrfReveal,proto3,oneof"`
+}
+
+type RandaoAction_Settle struct {
+	Settle *RandaoSettle `protobuf:"bytes,5,opt,name=settle,proto3,oneof"`
+}
+
+func (*RandaoAction_Create) isRandaoAction_Value() {}
+
+func (*RandaoAction_Commit) isRandaoAction_Value() {}
+
+func (*RandaoAction_Reveal) isRandaoAction_Value() {}
+
+func (*RandaoAction_VrfReveal) isRandaoAction_Value() {}
+
+func (*RandaoAction_Settle) isRandaoAction_Value() {}
+
+func (m *RandaoAction) GetValue() isRandaoAction_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *RandaoAction) GetCreate() *RandaoCreate {
+	if x, ok := m.GetValue().(*RandaoAction_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (m *RandaoAction) GetCommit() *RandaoCommit {
+	if x, ok := m.GetValue().(*RandaoAction_Commit); ok {
+		return x.Commit
+	}
+	return nil
+}
+
+func (m *RandaoAction) GetReveal() *RandaoReveal {
+	if x, ok := m.GetValue().(*RandaoAction_Reveal); ok {
+		return x.Reveal
+	}
+	return nil
+}
+
+func (m *RandaoAction) GetVrfReveal() *RandaoVrfReveal {
+	if x, ok := m.GetValue().(*RandaoAction_VrfReveal); ok {
+		return x.VrfReveal
+	}
+	return nil
+}
+
+func (m *RandaoAction) GetSettle() *RandaoSettle {
+	if x, ok := m.GetValue().(*RandaoAction_Settle); ok {
+		return x.Settle
+	}
+	return nil
+}
+
+func (m *RandaoAction) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*RandaoAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*RandaoAction_Create)(nil),
+		(*RandaoAction_Commit)(nil),
+		(*RandaoAction_Reveal)(nil),
+		(*RandaoAction_VrfReveal)(nil),
+		(*RandaoAction_Settle)(nil),
+	}
+}
+
+// 创建随机数轮次
+type RandaoCreate struct {
+	Mode                 int32    `protobuf:"varint,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Consumer             string   `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	Deposit              int64    `protobuf:"varint,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	CommitPeriod         int64    `protobuf:"varint,4,opt,name=commitPeriod,proto3" json:"commitPeriod,omitempty"`
+	RevealPeriod         int64    `protobuf:"varint,5,opt,name=revealPeriod,proto3" json:"revealPeriod,omitempty"`
+	MinReveals           int32    `protobuf:"varint,6,opt,name=minReveals,proto3" json:"minReveals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RandaoCreate) Reset()         { *m = RandaoCreate{} }
+func (m *RandaoCreate) String() string { return proto.CompactTextString(m) }
+func (*RandaoCreate) ProtoMessage()    {}
+func (*RandaoCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{3}
+}
+
+func (m *RandaoCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoCreate.Unmarshal(m, b)
+}
+func (m *RandaoCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoCreate.Marshal(b, m, deterministic)
+}
+func (m *RandaoCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoCreate.Merge(m, src)
+}
+func (m *RandaoCreate) XXX_Size() int {
+	return xxx_messageInfo_RandaoCreate.Size(m)
+}
+func (m *RandaoCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoCreate proto.InternalMessageInfo
+
+func (m *RandaoCreate) GetMode() int32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *RandaoCreate) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+func (m *RandaoCreate) GetDeposit() int64 {
+	if m != nil {
+		return m.Deposit
+	}
+	return 0
+}
+
+func (m *RandaoCreate) GetCommitPeriod() int64 {
+	if m != nil {
+		return m.CommitPeriod
+	}
+	return 0
+}
+
+func (m *RandaoCreate) GetRevealPeriod() int64 {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+func (m *RandaoCreate) GetMinReveals() int32 {
+	if m != nil {
+		return m.MinReveals
+	}
+	return 0
+}
+
+// 提交sha256(secret)
+type RandaoCommit struct {
+	RoundID              string   `protobuf:"bytes,1,opt,name=roundID,proto3" json:"roundID,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RandaoCommit) Reset()         { *m = RandaoCommit{} }
+func (m *RandaoCommit) String() string { return proto.CompactTextString(m) }
+func (*RandaoCommit) ProtoMessage()    {}
+func (*RandaoCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{4}
+}
+
+func (m *RandaoCommit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoCommit.Unmarshal(m, b)
+}
+func (m *RandaoCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoCommit.Marshal(b, m, deterministic)
+}
+func (m *RandaoCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoCommit.Merge(m, src)
+}
+func (m *RandaoCommit) XXX_Size() int {
+	return xxx_messageInfo_RandaoCommit.Size(m)
+}
+func (m *RandaoCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoCommit proto.InternalMessageInfo
+
+func (m *RandaoCommit) GetRoundID() string {
+	if m != nil {
+		return m.RoundID
+	}
+	return ""
+}
+
+func (m *RandaoCommit) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// 揭示secret
+type RandaoReveal struct {
+	RoundID              string   `protobuf:"bytes,1,opt,name=roundID,proto3" json:"roundID,omitempty"`
+	Secret               []byte   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RandaoReveal) Reset()         { *m = RandaoReveal{} }
+func (m *RandaoReveal) String() string { return proto.CompactTextString(m) }
+func (*RandaoReveal) ProtoMessage()    {}
+func (*RandaoReveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{5}
+}
+
+func (m *RandaoReveal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoReveal.Unmarshal(m, b)
+}
+func (m *RandaoReveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoReveal.Marshal(b, m, deterministic)
+}
+func (m *RandaoReveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoReveal.Merge(m, src)
+}
+func (m *RandaoReveal) XXX_Size() int {
+	return xxx_messageInfo_RandaoReveal.Size(m)
+}
+func (m *RandaoReveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoReveal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoReveal proto.InternalMessageInfo
+
+func (m *RandaoReveal) GetRoundID() string {
+	if m != nil {
+		return m.RoundID
+	}
+	return ""
+}
+
+func (m *RandaoReveal) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+// VRF模式下创建者提交证明
+type RandaoVrfReveal struct {
+	RoundID              string   `protobuf:"bytes,1,opt,name=roundID,proto3" json:"roundID,omitempty"`
+	Proof                []byte   `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RandaoVrfReveal) Reset()         { *m = RandaoVrfReveal{} }
+func (m *RandaoVrfReveal) String() string { return proto.CompactTextString(m) }
+func (*RandaoVrfReveal) ProtoMessage()    {}
+func (*RandaoVrfReveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{6}
+}
+
+func (m *RandaoVrfReveal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoVrfReveal.Unmarshal(m, b)
+}
+func (m *RandaoVrfReveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoVrfReveal.Marshal(b, m, deterministic)
+}
+func (m *RandaoVrfReveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoVrfReveal.Merge(m, src)
+}
+func (m *RandaoVrfReveal) XXX_Size() int {
+	return xxx_messageInfo_RandaoVrfReveal.Size(m)
+}
+func (m *RandaoVrfReveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoVrfReveal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoVrfReveal proto.InternalMessageInfo
+
+func (m *RandaoVrfReveal) GetRoundID() string {
+	if m != nil {
+		return m.RoundID
+	}
+	return ""
+}
+
+func (m *RandaoVrfReveal) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// 揭示期结束后结算轮次
+type RandaoSettle struct {
+	RoundID              string   `protobuf:"bytes,1,opt,name=roundID,proto3" json:"roundID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RandaoSettle) Reset()         { *m = RandaoSettle{} }
+func (m *RandaoSettle) String() string { return proto.CompactTextString(m) }
+func (*RandaoSettle) ProtoMessage()    {}
+func (*RandaoSettle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{7}
+}
+
+func (m *RandaoSettle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RandaoSettle.Unmarshal(m, b)
+}
+func (m *RandaoSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RandaoSettle.Marshal(b, m, deterministic)
+}
+func (m *RandaoSettle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RandaoSettle.Merge(m, src)
+}
+func (m *RandaoSettle) XXX_Size() int {
+	return xxx_messageInfo_RandaoSettle.Size(m)
+}
+func (m *RandaoSettle) XXX_DiscardUnknown() {
+	xxx_messageInfo_RandaoSettle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RandaoSettle proto.InternalMessageInfo
+
+func (m *RandaoSettle) GetRoundID() string {
+	if m != nil {
+		return m.RoundID
+	}
+	return ""
+}
+
+type ReceiptRandao struct {
+	Prev                 *RandaoRound `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *RandaoRound `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptRandao) Reset()         { *m = ReceiptRandao{} }
+func (m *ReceiptRandao) String() string { return proto.CompactTextString(m) }
+func (*ReceiptRandao) ProtoMessage()    {}
+func (*ReceiptRandao) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{8}
+}
+
+func (m *ReceiptRandao) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptRandao.Unmarshal(m, b)
+}
+func (m *ReceiptRandao) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptRandao.Marshal(b, m, deterministic)
+}
+func (m *ReceiptRandao) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptRandao.Merge(m, src)
+}
+func (m *ReceiptRandao) XXX_Size() int {
+	return xxx_messageInfo_ReceiptRandao.Size(m)
+}
+func (m *ReceiptRandao) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptRandao.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptRandao proto.InternalMessageInfo
+
+func (m *ReceiptRandao) GetPrev() *RandaoRound {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptRandao) GetCurrent() *RandaoRound {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqRandaoConsumer struct {
+	Creator              string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Consumer             string   `protobuf:"bytes,2,opt,name=consumer,proto3" json:"consumer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqRandaoConsumer) Reset()         { *m = ReqRandaoConsumer{} }
+func (m *ReqRandaoConsumer) String() string { return proto.CompactTextString(m) }
+func (*ReqRandaoConsumer) ProtoMessage()    {}
+func (*ReqRandaoConsumer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{9}
+}
+
+func (m *ReqRandaoConsumer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRandaoConsumer.Unmarshal(m, b)
+}
+func (m *ReqRandaoConsumer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRandaoConsumer.Marshal(b, m, deterministic)
+}
+func (m *ReqRandaoConsumer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRandaoConsumer.Merge(m, src)
+}
+func (m *ReqRandaoConsumer) XXX_Size() int {
+	return xxx_messageInfo_ReqRandaoConsumer.Size(m)
+}
+func (m *ReqRandaoConsumer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRandaoConsumer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRandaoConsumer proto.InternalMessageInfo
+
+func (m *ReqRandaoConsumer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ReqRandaoConsumer) GetConsumer() string {
+	if m != nil {
+		return m.Consumer
+	}
+	return ""
+}
+
+type ReqRandaoRounds struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int32    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqRandaoRounds) Reset()         { *m = ReqRandaoRounds{} }
+func (m *ReqRandaoRounds) String() string { return proto.CompactTextString(m) }
+func (*ReqRandaoRounds) ProtoMessage()    {}
+func (*ReqRandaoRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{10}
+}
+
+func (m *ReqRandaoRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRandaoRounds.Unmarshal(m, b)
+}
+func (m *ReqRandaoRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRandaoRounds.Marshal(b, m, deterministic)
+}
+func (m *ReqRandaoRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRandaoRounds.Merge(m, src)
+}
+func (m *ReqRandaoRounds) XXX_Size() int {
+	return xxx_messageInfo_ReqRandaoRounds.Size(m)
+}
+func (m *ReqRandaoRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRandaoRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRandaoRounds proto.InternalMessageInfo
+
+func (m *ReqRandaoRounds) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReqRandaoRounds) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ReqRandaoRounds) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqRandaoRounds) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqRandaoRounds) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqRandaoRounds) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ReplyRandaoRounds struct {
+	Rounds               []*RandaoRound `protobuf:"bytes,1,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplyRandaoRounds) Reset()         { *m = ReplyRandaoRounds{} }
+func (m *ReplyRandaoRounds) String() string { return proto.CompactTextString(m) }
+func (*ReplyRandaoRounds) ProtoMessage()    {}
+func (*ReplyRandaoRounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd84786404049879, []int{11}
+}
+
+func (m *ReplyRandaoRounds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyRandaoRounds.Unmarshal(m, b)
+}
+func (m *ReplyRandaoRounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyRandaoRounds.Marshal(b, m, deterministic)
+}
+func (m *ReplyRandaoRounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyRandaoRounds.Merge(m, src)
+}
+func (m *ReplyRandaoRounds) XXX_Size() int {
+	return xxx_messageInfo_ReplyRandaoRounds.Size(m)
+}
+func (m *ReplyRandaoRounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyRandaoRounds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyRandaoRounds proto.InternalMessageInfo
+
+func (m *ReplyRandaoRounds) GetRounds() []*RandaoRound {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RandaoRound)(nil), "types.RandaoRound")
+	proto.RegisterType((*RandaoParticipant)(nil), "types.RandaoParticipant")
+	proto.RegisterType((*RandaoAction)(nil), "types.RandaoAction")
+	proto.RegisterType((*RandaoCreate)(nil), "types.RandaoCreate")
+	proto.RegisterType((*RandaoCommit)(nil), "types.RandaoCommit")
+	proto.RegisterType((*RandaoReveal)(nil), "types.RandaoReveal")
+	proto.RegisterType((*RandaoVrfReveal)(nil), "types.RandaoVrfReveal")
+	proto.RegisterType((*RandaoSettle)(nil), "types.RandaoSettle")
+	proto.RegisterType((*ReceiptRandao)(nil), "types.ReceiptRandao")
+	proto.RegisterType((*ReqRandaoConsumer)(nil), "types.ReqRandaoConsumer")
+	proto.RegisterType((*ReqRandaoRounds)(nil), "types.ReqRandaoRounds")
+	proto.RegisterType((*ReplyRandaoRounds)(nil), "types.ReplyRandaoRounds")
+}
+
+func init() {
+	proto.RegisterFile("randao.proto", fileDescriptor_bd84786404049879)
+}
+
+var fileDescriptor_bd84786404049879 = []byte{
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0x4d, 0x6b, 0xdb, 0x4c,
+	0x10, 0x8e, 0x24, 0x4b, 0x4e, 0xc6, 0x4a, 0xfc, 0x66, 0xdf, 0x10, 0x96, 0x52, 0x8a, 0xd1, 0xa1,
+	0x88, 0xd2, 0xe6, 0x90, 0x42, 0x4f, 0x81, 0x36, 0xfd, 0x80, 0x84, 0x5e, 0xcc, 0x16, 0x7a, 0x57,
+	0xa4, 0x4d, 0x2d, 0xb0, 0xb5, 0xea, 0x6a, 0x65, 0x6a, 0xfa, 0x53, 0x7a, 0xea, 0xa1, 0xff, 0xa3,
+	0x3f, 0xad, 0xec, 0xec, 0xea, 0xcb, 0xb1, 0x73, 0xdb, 0x99, 0x79, 0x56, 0xb3, 0xf3, 0xcc, 0x33,
+	0x23, 0x08, 0x65, 0x52, 0x64, 0x89, 0xb8, 0x28, 0xa5, 0x50, 0x82, 0xf8, 0x6a, 0x53, 0xf2, 0x2a,
+	0xfa, 0x3d, 0x82, 0x09, 0x43, 0x3f, 0x13, 0x75, 0x91, 0x11, 0x0a, 0x63, 0xa9, 0x0f, 0xb7, 0x1f,
+	0xa9, 0x33, 0x73, 0xe2, 0x23, 0xd6, 0x98, 0x3a, 0x92, 0x4a, 0x9e, 0x28, 0x21, 0xa9, 0x6b, 0x22,
+	0xd6, 0x24, 0x04, 0x46, 0x2b, 0x91, 0x71, 0xea, 0xcd, 0x9c, 0xd8, 0x67, 0x78, 0x26, 0xe7, 0x10,
+	0x54, 0x2a, 0x51, 0x75, 0x45, 0x47, 0xe8, 0xb5, 0x16, 0x79, 0x02, 0x87, 0xa9, 0x28, 0xaa, 0x7a,
+	0xc5, 0x25, 0xf5, 0xf1, 0x33, 0xad, 0xad, 0x33, 0x64, 0xbc, 0x14, 0x55, 0xae, 0x68, 0x30, 0x73,
+	0x62, 0x8f, 0x35, 0x26, 0x89, 0x20, 0xc4, 0x64, 0xfc, 0x86, 0xe7, 0xdf, 0x16, 0x8a, 0x8e, 0x31,
+	0x3c, 0xf0, 0x91, 0x18, 0xa6, 0xa9, 0x58, 0xad, 0x72, 0xf5, 0xa9, 0xc8, 0x2c, 0xec, 0x10, 0x61,
+	0xdb, 0x6e, 0x8d, 0x94, 0x7c, 0xcd, 0x93, 0x65, 0x87, 0x3c, 0x32, 0xc8, 0x2d, 0x37, 0x79, 0x06,
+	0xb0, 0xca, 0x0b, 0x86, 0xde, 0x8a, 0x02, 0x56, 0xd2, 0xf3, 0x90, 0x2b, 0x08, 0xcb, 0x44, 0xaa,
+	0x3c, 0xcd, 0xcb, 0xa4, 0x50, 0x15, 0x9d, 0xcc, 0xbc, 0x78, 0x72, 0x49, 0x2f, 0x90, 0xdb, 0x0b,
+	0xc3, 0xeb, 0xbc, 0x03, 0xb0, 0x01, 0x9a, 0x3c, 0x85, 0xa3, 0xb5, 0xbc, 0x9f, 0xd7, 0x77, 0x9f,
+	0xf9, 0x86, 0x86, 0x33, 0x27, 0x0e, 0x59, 0xe7, 0xd0, 0x4c, 0xad, 0xe5, 0xfd, 0x6d, 0x51, 0xd6,
+	0x8a, 0x1e, 0x63, 0xb0, 0xb5, 0x6d, 0x6c, 0x2e, 0x85, 0xb8, 0xa7, 0x27, 0x6d, 0x0c, 0x6d, 0xcd,
+	0xbc, 0xe4, 0x55, 0xbd, 0x54, 0x74, 0x8a, 0x11, 0x6b, 0x69, 0x0e, 0xcd, 0xc9, 0x96, 0xfc, 0x9f,
+	0xe1, 0xb0, 0xef, 0x23, 0x67, 0xe0, 0xe7, 0x45, 0xc6, 0x7f, 0xd0, 0x53, 0x2c, 0xd5, 0x18, 0xd1,
+	0x4f, 0x38, 0x7d, 0x50, 0x8a, 0x6e, 0x7a, 0x92, 0x65, 0xd2, 0xaa, 0x04, 0xcf, 0x9a, 0x2e, 0xc3,
+	0xf5, 0x4d, 0x52, 0x2d, 0x50, 0x25, 0x21, 0xeb, 0x79, 0x50, 0x14, 0x3c, 0x95, 0x5c, 0xa1, 0x54,
+	0x42, 0x66, 0x2d, 0x5d, 0x8e, 0x61, 0x9e, 0x67, 0x28, 0x97, 0x43, 0xd6, 0xda, 0xd1, 0x2f, 0x17,
+	0x42, 0x93, 0xfd, 0x3a, 0x55, 0xb9, 0x28, 0xc8, 0x2b, 0x08, 0x4c, 0xdf, 0x31, 0xf5, 0xe4, 0xf2,
+	0xff, 0x01, 0xdb, 0x1f, 0x8c, 0x24, 0x0e, 0x98, 0x05, 0x21, 0x1c, 0x5f, 0x40, 0xdd, 0x5d, 0x70,
+	0xf3, 0x38, 0x0d, 0xc7, 0x93, 0x86, 0x9b, 0xd4, 0xd4, 0xdb, 0x01, 0x37, 0x7d, 0xd7, 0x70, 0x03,
+	0x22, 0x6f, 0xb0, 0x85, 0xc6, 0x8d, 0x4f, 0x9f, 0x5c, 0x9e, 0x0f, 0x6e, 0x7c, 0x6d, 0xa2, 0x37,
+	0x07, 0xac, 0x83, 0xea, 0x34, 0x15, 0x57, 0x6a, 0xc9, 0xa9, 0xbf, 0x23, 0xcd, 0x17, 0x0c, 0xe9,
+	0x34, 0x06, 0x44, 0x4e, 0xc0, 0x55, 0x1b, 0xab, 0x3f, 0x57, 0x6d, 0xde, 0x8f, 0xc1, 0x5f, 0x27,
+	0xcb, 0x9a, 0x47, 0x7f, 0x1d, 0x08, 0xfb, 0x85, 0xb7, 0xb3, 0xe8, 0xf4, 0x66, 0xb1, 0x3f, 0x73,
+	0xee, 0xfe, 0x99, 0xf3, 0x1e, 0xce, 0x1c, 0x72, 0x32, 0xe7, 0x32, 0x17, 0xa6, 0x31, 0x1e, 0x1b,
+	0xf8, 0x8c, 0xa6, 0x74, 0x41, 0x16, 0xe3, 0x37, 0x9a, 0xea, 0x7c, 0x5b, 0x33, 0x14, 0x6c, 0xcf,
+	0x50, 0x74, 0xd5, 0x56, 0x60, 0x3a, 0xb0, 0x7f, 0x03, 0x11, 0x18, 0x2d, 0x3a, 0x61, 0xe1, 0x39,
+	0x7a, 0xd7, 0xdc, 0xb6, 0xc4, 0xee, 0xbf, 0xdd, 0x89, 0xcf, 0xed, 0x8b, 0x2f, 0xba, 0x86, 0xe9,
+	0x56, 0xab, 0x1e, 0xf9, 0xc8, 0x19, 0xf8, 0x25, 0x4e, 0x9d, 0xf9, 0x86, 0x31, 0xa2, 0x18, 0xc2,
+	0x7e, 0xe3, 0xf6, 0xdf, 0x8f, 0x38, 0x1c, 0x33, 0x9e, 0xf2, 0xbc, 0x54, 0xe6, 0x02, 0x79, 0x0e,
+	0xa3, 0x52, 0xf2, 0xb5, 0xd5, 0x32, 0x19, 0xaa, 0x4d, 0x5f, 0x62, 0x18, 0x27, 0x2f, 0x61, 0x9c,
+	0xd6, 0x52, 0xf2, 0xa2, 0xd1, 0xf1, 0x2e, 0x68, 0x03, 0x89, 0x6e, 0xe1, 0x94, 0xf1, 0xef, 0x0d,
+	0xad, 0x5d, 0xab, 0x9b, 0x05, 0xee, 0x0c, 0x17, 0xf8, 0x23, 0x02, 0x89, 0xfe, 0x38, 0x30, 0x6d,
+	0xbf, 0x85, 0x69, 0xaa, 0xde, 0x72, 0x77, 0x06, 0xcb, 0x7d, 0xff, 0x2f, 0xe2, 0x0c, 0xfc, 0x54,
+	0xd4, 0x85, 0xb2, 0xff, 0x08, 0x63, 0xe8, 0x05, 0x98, 0xe5, 0x92, 0xe3, 0x5c, 0xdb, 0xff, 0x44,
+	0xe7, 0xd0, 0x59, 0x16, 0x66, 0x55, 0x19, 0x59, 0x59, 0xab, 0x5b, 0x52, 0x41, 0x7f, 0x49, 0xbd,
+	0xd5, 0x25, 0x97, 0xcb, 0xcd, 0xe0, 0xa1, 0x2f, 0x20, 0x40, 0xe6, 0xf5, 0x43, 0xbd, 0x3d, 0xa4,
+	0x59, 0xc4, 0x5d, 0x80, 0xff, 0xc5, 0xd7, 0xff, 0x06, 0x00, 0xb2, 0xad, 0x4b, 0xa5, 0x27, 0x07,
+	0x00, 0x00,
+}
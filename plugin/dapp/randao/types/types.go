@@ -0,0 +1,72 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"reflect"
+
+	"github.com/33cn/chain33/types"
+)
+
+func init() {
+	types.AllowUserExec = append(types.AllowUserExec, ExecerRandao)
+	types.RegFork(RandaoX, InitFork)
+	types.RegExec(RandaoX, InitExecutor)
+}
+
+//InitFork ...
+func InitFork(cfg *types.Chain33Config) {
+	cfg.RegisterDappFork(RandaoX, "Enable", types.MaxHeight)
+}
+
+//InitExecutor ...
+func InitExecutor(cfg *types.Chain33Config) {
+	types.RegistorExecutor(RandaoX, NewType(cfg))
+}
+
+// RandaoType 基础类型结构体
+type RandaoType struct {
+	types.ExecTypeBase
+}
+
+// NewType 生成新的基础类型
+func NewType(cfg *types.Chain33Config) *RandaoType {
+	c := &RandaoType{}
+	c.SetChild(c)
+	c.SetConfig(cfg)
+	return c
+}
+
+// GetName 获取执行器名称
+func (r *RandaoType) GetName() string {
+	return RandaoX
+}
+
+// GetPayload 获得空的Randao 的 Payload
+func (r *RandaoType) GetPayload() types.Message {
+	return &RandaoAction{}
+}
+
+// GetTypeMap 获得Action 方法列表
+func (r *RandaoType) GetTypeMap() map[string]int32 {
+	return map[string]int32{
+		"Create":    RandaoActionCreate,
+		"Commit":    RandaoActionCommit,
+		"Reveal":    RandaoActionReveal,
+		"VrfReveal": RandaoActionVrfReveal,
+		"Settle":    RandaoActionSettle,
+	}
+}
+
+// GetLogMap 获得日志类型列表
+func (r *RandaoType) GetLogMap() map[int64]*types.LogInfo {
+	return map[int64]*types.LogInfo{
+		TyLogRandaoCreate:    {Ty: reflect.TypeOf(ReceiptRandao{}), Name: "LogRandaoCreate"},
+		TyLogRandaoCommit:    {Ty: reflect.TypeOf(ReceiptRandao{}), Name: "LogRandaoCommit"},
+		TyLogRandaoReveal:    {Ty: reflect.TypeOf(ReceiptRandao{}), Name: "LogRandaoReveal"},
+		TyLogRandaoVrfReveal: {Ty: reflect.TypeOf(ReceiptRandao{}), Name: "LogRandaoVrfReveal"},
+		TyLogRandaoSettle:    {Ty: reflect.TypeOf(ReceiptRandao{}), Name: "LogRandaoSettle"},
+	}
+}
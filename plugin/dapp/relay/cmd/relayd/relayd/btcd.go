@@ -13,7 +13,6 @@ import (
 	log "github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/common/merkle"
 	ty "github.com/33cn/plugin/plugin/dapp/relay/types"
-	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 )
@@ -45,22 +44,10 @@ type (
 	blockDisconnected blockMeta
 )
 
-type params struct {
-	*chaincfg.Params
-	RPCClientPort string
-	RPCServerPort string
-}
-
-var mainNetParams = params{
-	Params:        &chaincfg.MainNetParams,
-	RPCClientPort: "8334",
-	RPCServerPort: "8332",
-}
-
 type btcdClient struct {
 	rpcClient           *rpcclient.Client
 	connConfig          *rpcclient.ConnConfig
-	chainParams         *chaincfg.Params
+	chainParams         *ty.ChainParams
 	reconnectAttempts   int
 	enqueueNotification chan interface{}
 	dequeueNotification chan interface{}
@@ -71,13 +58,13 @@ type btcdClient struct {
 	quitMtx             sync.Mutex
 }
 
-func newBtcd(config *rpcclient.ConnConfig, reconnectAttempts int) (BtcClient, error) {
+func newBtcd(config *rpcclient.ConnConfig, reconnectAttempts int, chainParams *ty.ChainParams) (BtcClient, error) {
 	if reconnectAttempts < 0 {
 		return nil, errors.New("ReconnectAttempts must be positive")
 	}
 	client := &btcdClient{
 		connConfig:          config,
-		chainParams:         mainNetParams.Params,
+		chainParams:         chainParams,
 		reconnectAttempts:   reconnectAttempts,
 		enqueueNotification: make(chan interface{}),
 		dequeueNotification: make(chan interface{}),
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/33cn/chain33/common/merkle"
+	ty "github.com/33cn/plugin/plugin/dapp/relay/types"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
@@ -36,7 +37,7 @@ func (s *suiteBctd) SetupSuite() {
 		HTTPPostMode: true,
 		Certificates: certs,
 	}
-	s.btc, _ = newBtcd(connCfg, reconnectAttempts)
+	s.btc, _ = newBtcd(connCfg, reconnectAttempts, ty.BtcMainNetParams)
 }
 
 func (s *suiteBctd) TestGetBlockHeader() {
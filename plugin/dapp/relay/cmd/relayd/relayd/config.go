@@ -24,6 +24,7 @@ type Config struct {
 	SyncSetupCount uint64
 	Chain33        Chain33
 	FirstBtcHeight uint64
+	ChainParams    string
 	Btcd           Btcd
 	Log            types.Log
 	Auth           Auth
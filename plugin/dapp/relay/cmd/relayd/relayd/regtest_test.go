@@ -0,0 +1,267 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package relayd
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/difficulty"
+	_ "github.com/33cn/chain33/system"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	typesmocks "github.com/33cn/chain33/types/mocks"
+	"github.com/33cn/plugin/plugin/dapp/relay/executor"
+	ty "github.com/33cn/plugin/plugin/dapp/relay/types"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+//the relay genesis address in default config 14KEKbYtKKQm4wMthSK9J4La4nAiidGozt
+const regtestRelayerKey = "CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944"
+
+var regtestCfg = types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(),
+	"[exec.sub.relay]", "[exec.sub.relay]\nchainParams=[\"BTC-regtest\"]", 1))
+
+func init() {
+	executor.Init(ty.RelayX, regtestCfg, nil)
+}
+
+//regtestNode the btc regtest node mines the real headers from regtest genesis in memory
+type regtestNode struct {
+	BtcClient
+	blocks []*ty.BtcHeader
+}
+
+func newRegtestNode() *regtestNode {
+	genesis := chaincfg.RegressionNetParams.GenesisBlock.Header
+	return &regtestNode{blocks: []*ty.BtcHeader{{
+		Hash:         genesis.BlockHash().String(),
+		Version:      uint32(genesis.Version),
+		MerkleRoot:   genesis.MerkleRoot.String(),
+		PreviousHash: genesis.PrevBlock.String(),
+		Time:         genesis.Timestamp.Unix(),
+		Bits:         int64(genesis.Bits),
+		Nonce:        uint64(genesis.Nonce),
+	}}}
+}
+
+func (n *regtestNode) tip() *ty.BtcHeader {
+	return n.blocks[len(n.blocks)-1]
+}
+
+//mine the blocks on the best chain, the branch tag makes different merkle root
+func (n *regtestNode) mine(count int, branch string) {
+	for i := 0; i < count; i++ {
+		pre := n.tip()
+		preHash, _ := chainhash.NewHashFromStr(pre.Hash)
+		merkleRoot := chainhash.Hash(sha256.Sum256([]byte(fmt.Sprintf("%s%d", branch, pre.Height+1))))
+		header := &wire.BlockHeader{
+			Version:    0x20000000,
+			PrevBlock:  *preHash,
+			MerkleRoot: merkleRoot,
+			Timestamp:  time.Unix(pre.Time+600, 0),
+			Bits:       ty.BtcRegTestParams.PowLimitBits,
+		}
+		for {
+			hash := header.BlockHash()
+			if difficulty.HashToBig(hash[:]).Cmp(ty.BtcRegTestParams.PowLimit) <= 0 {
+				break
+			}
+			header.Nonce++
+		}
+		n.blocks = append(n.blocks, &ty.BtcHeader{
+			Hash:         header.BlockHash().String(),
+			Height:       pre.Height + 1,
+			Version:      uint32(header.Version),
+			MerkleRoot:   merkleRoot.String(),
+			PreviousHash: pre.Hash,
+			Time:         header.Timestamp.Unix(),
+			Bits:         int64(header.Bits),
+			Nonce:        uint64(header.Nonce),
+		})
+	}
+}
+
+//reorg orphan the blocks higher than height and mine a new branch
+func (n *regtestNode) reorg(height uint64, count int, branch string) {
+	n.blocks = n.blocks[:height+1]
+	n.mine(count, branch)
+}
+
+func (n *regtestNode) GetLatestBlock() (*chainhash.Hash, uint64, error) {
+	hash, err := chainhash.NewHashFromStr(n.tip().Hash)
+	return hash, n.tip().Height, err
+}
+
+func (n *regtestNode) GetBlockHeader(height uint64) (*ty.BtcHeader, error) {
+	if height >= uint64(len(n.blocks)) {
+		return nil, types.ErrNotFound
+	}
+	header := *n.blocks[height]
+	return &header, nil
+}
+
+//regtestChain33 execute the relay txs on the relay executor with memory db, one tx per block
+type regtestChain33 struct {
+	t       *testing.T
+	relay   drivers.Driver
+	stateDB db.DB
+	localDB db.DB
+	height  int64
+	errs    []error
+}
+
+func newRegtestChain33(t *testing.T) *regtestChain33 {
+	relay, err := drivers.LoadDriver(ty.RelayX, 0)
+	assert.Nil(t, err)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(regtestCfg, nil)
+	c := &regtestChain33{t: t, relay: relay}
+	c.stateDB, _ = db.NewGoMemDB("regtestState", "", 0)
+	c.localDB, _ = db.NewGoMemDB("regtestLocal", "", 0)
+	relay.SetAPI(api)
+	relay.SetStateDB(c.stateDB)
+	relay.SetLocalDB(db.NewKVDB(c.localDB))
+	return c
+}
+
+func (c *regtestChain33) sendTransaction(ctx context.Context, tx *types.Transaction, opts ...grpc.CallOption) *types.Reply {
+	c.height++
+	c.relay.SetEnv(c.height, time.Now().Unix(), 1)
+	receipt, err := c.relay.Exec(tx, 0)
+	if err != nil {
+		c.errs = append(c.errs, err)
+		return &types.Reply{Msg: []byte(err.Error())}
+	}
+	for _, kv := range receipt.KV {
+		c.stateDB.Set(kv.Key, kv.Value)
+	}
+	set, err := c.relay.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 0)
+	assert.Nil(c.t, err)
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			c.localDB.Delete(kv.Key)
+			continue
+		}
+		c.localDB.Set(kv.Key, kv.Value)
+	}
+	return &types.Reply{IsOk: true, Msg: tx.Hash()}
+}
+
+func (c *regtestChain33) queryChain(ctx context.Context, in *types.ChainExecutor, opts ...grpc.CallOption) *types.Reply {
+	msg, err := c.relay.Query(in.FuncName, in.Param)
+	if err != nil {
+		return &types.Reply{Msg: []byte(err.Error())}
+	}
+	return &types.Reply{IsOk: true, Msg: types.Encode(msg)}
+}
+
+func newRegtestRelayd(t *testing.T, node *regtestNode, chain33 *regtestChain33) *Relayd {
+	grpcClient := &typesmocks.Chain33Client{}
+	grpcClient.On("SendTransaction", mock.Anything, mock.Anything).Return(chain33.sendTransaction, nil)
+	grpcClient.On("QueryChain", mock.Anything, mock.Anything).Return(chain33.queryChain, nil)
+
+	secp, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	key, err := common.FromHex(regtestRelayerKey)
+	assert.Nil(t, err)
+	priKey, err := secp.PrivKeyFromBytes(key)
+	assert.Nil(t, err)
+
+	memdb, _ := db.NewGoMemDB("regtestRelayd", "", 0)
+	return &Relayd{
+		config:           &Config{SyncSetup: 5, SyncSetupCount: 10},
+		db:               &relaydDB{memdb},
+		ctx:              context.Background(),
+		client33:         &Client33{Chain33Client: grpcClient},
+		btcClient:        node,
+		privateKey:       priKey,
+		isResetBtcHeight: true,
+		chainParams:      ty.BtcRegTestParams,
+	}
+}
+
+//step run one tick of relayd, persist the btc headers and sync to chain33
+func regtestStep(r *Relayd) {
+	_, height, _ := r.btcClient.GetLatestBlock()
+	atomic.StoreUint64(&r.latestBtcHeight, height)
+	r.persistBlockHeaders()
+	r.syncBlockHeaders()
+}
+
+func checkRegtestChain33(t *testing.T, r *Relayd, node *regtestNode, height uint64) {
+	ret, err := r.queryChain33WithBtcHeight()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(height), ret.CurHeight)
+	assert.Equal(t, node.blocks[height].Hash, ret.CurHash)
+	assert.Equal(t, int64(0), ret.BaseHeight)
+}
+
+func TestRegtestRelay(t *testing.T) {
+	node := newRegtestNode()
+	node.mine(20, "a")
+	chain33 := newRegtestChain33(t)
+	relayd := newRegtestRelayd(t, node, chain33)
+
+	//the headers from regtest genesis, the tip is synced at next round
+	regtestStep(relayd)
+	assert.Nil(t, chain33.errs)
+	checkRegtestChain33(t, relayd, node, 19)
+	regtestStep(relayd)
+	checkRegtestChain33(t, relayd, node, 19)
+
+	node.mine(3, "a")
+	regtestStep(relayd)
+	assert.Nil(t, chain33.errs)
+	checkRegtestChain33(t, relayd, node, 23)
+
+	//the longer branch from height 15 orphans the synced headers
+	orphan := node.blocks[18]
+	node.reorg(15, 10, "b")
+	regtestStep(relayd)
+	assert.Nil(t, chain33.errs)
+	checkRegtestChain33(t, relayd, node, 25)
+	header, err := relayd.queryChain33BtcHeader(orphan.Hash)
+	assert.Nil(t, err)
+	assert.Equal(t, orphan.Height, header.Height)
+	local, err := relayd.db.BlockHeader(uint64(18))
+	assert.Nil(t, err)
+	assert.Equal(t, node.blocks[18].Hash, local.Hash)
+
+}
+
+func TestRegtestRelayGenesis(t *testing.T) {
+	//the headers from mainnet genesis not accepted by regtest params
+	node := newRegtestNode()
+	genesis := chaincfg.MainNetParams.GenesisBlock.Header
+	node.blocks[0].Hash = genesis.BlockHash().String()
+	node.blocks[0].MerkleRoot = genesis.MerkleRoot.String()
+	node.blocks[0].Time = genesis.Timestamp.Unix()
+	node.blocks[0].Bits = int64(genesis.Bits)
+	node.blocks[0].Nonce = uint64(genesis.Nonce)
+	node.mine(3, "a")
+	chain33 := newRegtestChain33(t)
+	relayd := newRegtestRelayd(t, node, chain33)
+
+	regtestStep(relayd)
+	assert.Equal(t, []error{ty.ErrRelayBtcHeadHashErr}, chain33.errs)
+	ret, err := relayd.queryChain33WithBtcHeight()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(-1), ret.CurHeight)
+}
@@ -7,6 +7,7 @@ package relayd
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/rand"
 	"os"
@@ -39,6 +40,7 @@ type Relayd struct {
 	isPersisting      int32
 	isSnycing         int32
 	isResetBtcHeight  bool
+	chainParams       *ty.ChainParams
 }
 
 // NewRelayd create relayd instance
@@ -83,10 +85,19 @@ func NewRelayd(config *Config) *Relayd {
 
 	log.Info("NewRelayd", "current btc hegiht: ", height)
 
+	paramsName := config.ChainParams
+	if paramsName == "" {
+		paramsName = ty.BtcMainNetParams.Name
+	}
+	chainParams, err := ty.GetChainParams(paramsName)
+	if err != nil {
+		panic(err)
+	}
+
 	client33 := NewClient33(&config.Chain33)
 	var btc BtcClient
 	if config.BtcdOrWeb == 0 {
-		btc, err = newBtcd(config.Btcd.BitConnConfig(), config.Btcd.ReconnectAttempts)
+		btc, err = newBtcd(config.Btcd.BitConnConfig(), config.Btcd.ReconnectAttempts, chainParams)
 	} else {
 		btc, err = newBtcWeb()
 	}
@@ -139,6 +150,7 @@ func NewRelayd(config *Config) *Relayd {
 		isPersisting:      0,
 		isSnycing:         0,
 		isResetBtcHeight:  isResetBtcHeight,
+		chainParams:       chainParams,
 	}
 }
 
@@ -199,13 +211,24 @@ out:
 func (r *Relayd) persistBlockHeaders() {
 	atomic.StoreInt32(&r.isPersisting, 1)
 	defer atomic.StoreInt32(&r.isPersisting, 0)
+	i := atomic.LoadUint64(&r.knownBtcHeight)
 out:
-	for i := atomic.LoadUint64(&r.knownBtcHeight); i <= atomic.LoadUint64(&r.latestBtcHeight); i++ {
+	for i <= atomic.LoadUint64(&r.latestBtcHeight) {
 		header, err := r.btcClient.GetBlockHeader(i)
 		if err != nil {
 			log.Error("syncBlockHeaders", "GetBlockHeader error", err)
 			break out
 		}
+		if r.isBtcReorg(i, header) {
+			rewind, err := r.rewindBlockHeaders(i - 1)
+			if err != nil {
+				log.Error("persistBlockHeaders", "rewindBlockHeaders error", err)
+				break out
+			}
+			log.Info("persistBlockHeaders btc reorg", "height", i, "rewind to", rewind)
+			i = rewind
+			continue
+		}
 		data := types.Encode(header)
 		r.db.Set(makeHeightKey(i), data)
 		r.db.Set(currentBtcBlockheightKey, []byte(fmt.Sprintf("%d", i)))
@@ -213,11 +236,42 @@ out:
 		if i%10 == 0 || (atomic.LoadUint64(&r.latestBtcHeight)-i) < 10 {
 			log.Info("persistBlockHeaders", "current knownBtcHeight: ", i, "current latestBtcHeight: ", atomic.LoadUint64(&r.latestBtcHeight))
 		}
+		i++
+	}
+}
+
+// isBtcReorg the saved previous header is not the parent of the new header
+func (r *Relayd) isBtcReorg(height uint64, header *ty.BtcHeader) bool {
+	if height <= r.firstHeaderHeight {
+		return false
+	}
+	pre, err := r.db.BlockHeader(height - 1)
+	if err != nil {
+		return false
+	}
+	return pre.Hash != header.PreviousHash
+}
+
+// rewindBlockHeaders find the highest saved header still in btc best chain, return the height to persist from
+func (r *Relayd) rewindBlockHeaders(height uint64) (uint64, error) {
+	for ; height > r.firstHeaderHeight; height-- {
+		local, err := r.db.BlockHeader(height)
+		if err != nil {
+			return 0, err
+		}
+		remote, err := r.btcClient.GetBlockHeader(height)
+		if err != nil {
+			return 0, err
+		}
+		if local.Hash == remote.Hash {
+			return height + 1, nil
+		}
 	}
+	return r.firstHeaderHeight, nil
 }
 
 func (r *Relayd) queryChain33WithBtcHeight() (*ty.ReplayRelayQryBTCHeadHeight, error) {
-	payLoad := types.Encode(&ty.ReqRelayQryBTCHeadHeight{})
+	payLoad := types.Encode(&ty.ReqRelayQryBTCHeadHeight{Coin: r.chainParams.Coin})
 	query := types.ChainExecutor{
 		Driver:   ty.RelayX,
 		FuncName: "GetBTCHeaderCurHeight",
@@ -235,6 +289,58 @@ func (r *Relayd) queryChain33WithBtcHeight() (*ty.ReplayRelayQryBTCHeadHeight, e
 	return &result, nil
 }
 
+func (r *Relayd) queryChain33BtcHeader(hash string) (*ty.BtcHeader, error) {
+	payLoad := types.Encode(&ty.ReqRelayBtcHeader{Hash: hash, Coin: r.chainParams.Coin})
+	query := types.ChainExecutor{
+		Driver:   ty.RelayX,
+		FuncName: "GetBTCHeader",
+		Param:    payLoad,
+	}
+	ret, err := r.client33.QueryChain(r.ctx, &query)
+	if err != nil {
+		return nil, err
+	}
+	if !ret.GetIsOk() {
+		if string(ret.GetMsg()) == types.ErrNotFound.Error() {
+			return nil, types.ErrNotFound
+		}
+		return nil, errors.New(string(ret.GetMsg()))
+	}
+	var result ty.BtcHeader
+	err = types.Decode(ret.Msg, &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// findChain33ForkHeight the best chain in chain33 may be an orphaned btc branch after the headers submitted,
+// find the highest local header chain33 has saved, submit the new branch from it
+func (r *Relayd) findChain33ForkHeight(ret *ty.ReplayRelayQryBTCHeadHeight) (uint64, error) {
+	height := uint64(ret.CurHeight)
+	local, err := r.db.BlockHeader(height)
+	if ret.CurHash == "" || err != nil || local.Hash == ret.CurHash {
+		return height, nil
+	}
+
+	for height > uint64(ret.BaseHeight) {
+		height--
+		local, err := r.db.BlockHeader(height)
+		if err != nil {
+			return 0, err
+		}
+		_, err = r.queryChain33BtcHeader(local.Hash)
+		if err == nil {
+			log.Info("findChain33ForkHeight", "chain33 best", ret.CurHash, "fork height", height)
+			return height, nil
+		}
+		if err != types.ErrNotFound {
+			return 0, err
+		}
+	}
+	return 0, errors.New("no common btc header with chain33")
+}
+
 func (r *Relayd) syncBlockHeaders() {
 	atomic.StoreInt32(&r.isSnycing, 1)
 	defer atomic.StoreInt32(&r.isSnycing, 0)
@@ -258,7 +364,12 @@ func (r *Relayd) syncBlockHeaders() {
 			initIterHeight = r.firstHeaderHeight
 			total = knownBtcHeight - initIterHeight
 		} else {
-			initIterHeight = uint64(ret.CurHeight) + 1
+			forkHeight, err := r.findChain33ForkHeight(ret)
+			if err != nil {
+				log.Error("syncBlockHeaders", "findChain33ForkHeight error: ", err)
+				return
+			}
+			initIterHeight = forkHeight + 1
 			if initIterHeight >= knownBtcHeight {
 				return
 			}
@@ -300,7 +411,7 @@ func (r *Relayd) syncBlockHeaders() {
 			}
 			initIterHeight = breakHeight
 			log.Info("syncBlockHeaders", "len: ", len(headers))
-			btcHeaders := &ty.BtcHeaders{BtcHeader: headers}
+			btcHeaders := &ty.BtcHeaders{BtcHeader: headers, Coin: r.chainParams.Coin}
 			relayHeaders := &ty.RelayAction_BtcHeaders{BtcHeaders: btcHeaders}
 			action := &ty.RelayAction{
 				Value: relayHeaders,
@@ -379,6 +490,7 @@ func (r *Relayd) dealOrder() {
 func (r *Relayd) requestRelayOrders(status ty.RelayOrderStatus) (*ty.QueryRelayOrderResult, error) {
 	payLoad := types.Encode(&ty.ReqRelayAddrCoins{
 		Status: status,
+		Coins:  []string{r.chainParams.Coin},
 	})
 	query := types.ChainExecutor{
 		Driver:   ty.RelayX,
@@ -5,14 +5,17 @@
 package relayd
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	typesmocks "github.com/33cn/chain33/types/mocks"
 	types2 "github.com/33cn/plugin/plugin/dapp/relay/types"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -34,12 +37,12 @@ func TestGeneratePrivateKey(t *testing.T) {
 
 func TestDealOrder(t *testing.T) {
 	grpcClient := &typesmocks.Chain33Client{}
-	relayd := &Relayd{}
+	relayd := &Relayd{chainParams: types2.BtcMainNetParams}
 	relayd.client33 = &Client33{}
 	relayd.client33.Chain33Client = grpcClient
 	relayd.btcClient = &btcdClient{
 		connConfig:          nil,
-		chainParams:         mainNetParams.Params,
+		chainParams:         types2.BtcMainNetParams,
 		reconnectAttempts:   3,
 		enqueueNotification: make(chan interface{}),
 		dequeueNotification: make(chan interface{}),
@@ -55,3 +58,79 @@ func TestDealOrder(t *testing.T) {
 	grpcClient.On("SendTransaction", mock.Anything, mock.Anything).Return(nil, nil).Once()
 	relayd.dealOrder()
 }
+
+type reorgBtcClient struct {
+	BtcClient
+	headers map[uint64]*types2.BtcHeader
+}
+
+func (b *reorgBtcClient) GetBlockHeader(height uint64) (*types2.BtcHeader, error) {
+	header, ok := b.headers[height]
+	if !ok {
+		return nil, types.ErrNotFound
+	}
+	return header, nil
+}
+
+func newBtcChain(from uint64, branch string, count int, pre *types2.BtcHeader) []*types2.BtcHeader {
+	var headers []*types2.BtcHeader
+	for i := 0; i < count; i++ {
+		header := &types2.BtcHeader{Height: from + uint64(i), Hash: fmt.Sprintf("%s%d", branch, from+uint64(i))}
+		if pre != nil {
+			header.PreviousHash = pre.Hash
+		}
+		headers = append(headers, header)
+		pre = header
+	}
+	return headers
+}
+
+func newReorgRelayd(saved []*types2.BtcHeader) *Relayd {
+	memdb, _ := db.NewGoMemDB("relayd", "", 0)
+	relayd := &Relayd{db: &relaydDB{memdb}, firstHeaderHeight: 10, chainParams: types2.BtcMainNetParams}
+	for _, header := range saved {
+		relayd.db.Set(makeHeightKey(header.Height), types.Encode(header))
+	}
+	return relayd
+}
+
+func TestPersistBlockHeadersReorg(t *testing.T) {
+	chainA := newBtcChain(10, "a", 3, nil)
+	chainB := newBtcChain(11, "b", 3, chainA[0])
+	relayd := newReorgRelayd(chainA)
+	btc := &reorgBtcClient{headers: map[uint64]*types2.BtcHeader{10: chainA[0]}}
+	for _, header := range chainB {
+		btc.headers[header.Height] = header
+	}
+	relayd.btcClient = btc
+	relayd.knownBtcHeight = 12
+	relayd.latestBtcHeight = 13
+
+	relayd.persistBlockHeaders()
+	assert.Equal(t, uint64(13), relayd.knownBtcHeight)
+	for _, header := range chainB {
+		saved, err := relayd.db.BlockHeader(header.Height)
+		assert.Nil(t, err)
+		assert.Equal(t, header.Hash, saved.Hash)
+	}
+}
+
+func TestFindChain33ForkHeight(t *testing.T) {
+	chainA := newBtcChain(10, "a", 3, nil)
+	chainB := newBtcChain(11, "b", 3, chainA[0])
+	relayd := newReorgRelayd(append(chainA[:1], chainB...))
+	grpcClient := &typesmocks.Chain33Client{}
+	relayd.client33 = &Client33{}
+	relayd.client33.Chain33Client = grpcClient
+
+	height, err := relayd.findChain33ForkHeight(&types2.ReplayRelayQryBTCHeadHeight{CurHeight: 12, BaseHeight: 10, CurHash: chainB[1].Hash})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(12), height)
+
+	//chain33 best chain is orphaned branch a, b11 not submitted yet
+	grpcClient.On("QueryChain", mock.Anything, mock.Anything).Return(&types.Reply{Msg: []byte(types.ErrNotFound.Error())}, nil).Once()
+	grpcClient.On("QueryChain", mock.Anything, mock.Anything).Return(&types.Reply{IsOk: true, Msg: types.Encode(chainA[0])}, nil).Once()
+	height, err = relayd.findChain33ForkHeight(&types2.ReplayRelayQryBTCHeadHeight{CurHeight: 12, BaseHeight: 10, CurHash: chainA[2].Hash})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), height)
+}
@@ -32,7 +32,10 @@ func (r *relay) execDelLocal(receipt *types.ReceiptData) ([]*types.KeyValue, err
 				return nil, err
 			}
 
-			btc := newBtcStore(r.GetLocalDB())
+			btc := newCoinStore(r.GetLocalDB(), receipt.Coin)
+			if r.GetAPI().GetConfig().IsDappFork(r.GetHeight(), rty.RelayX, rty.ForkRelayBtcReorg) {
+				return btc.delBlockBranch(receipt)
+			}
 			for _, head := range receipt.Headers {
 				kv, err := btc.delBlockHead(head)
 				if err != nil {
@@ -32,7 +32,10 @@ func (r *relay) execLocal(receipt *types.ReceiptData) ([]*types.KeyValue, error)
 				return nil, err
 			}
 
-			btc := newBtcStore(r.GetLocalDB())
+			btc := newCoinStore(r.GetLocalDB(), receipt.Coin)
+			if r.GetAPI().GetConfig().IsDappFork(r.GetHeight(), rty.RelayX, rty.ForkRelayBtcReorg) {
+				return btc.saveBlockBranch(receipt)
+			}
 			for _, head := range receipt.Headers {
 				kv, err := btc.saveBlockHead(head)
 				if err != nil {
@@ -24,6 +24,12 @@ const (
 	relayBTCHeaderHash       = "LODB-relay-btcheader-hash"
 	relayBTCHeaderHeight     = "LODB-relay-btcheader-height"
 	relayBTCHeaderHeightList = "LODB-relay-btcheader-height-list"
+
+	relayCoinHeaderHash       = "LODB-relay-coinheader-hash-"
+	relayCoinHeaderHeight     = "LODB-relay-coinheader-height-"
+	relayCoinHeaderHeightList = "LODB-relay-coinheader-heightlist-"
+	relayCoinHeaderLastHeight = "LODB-relay-coinheader-lastheight-"
+	relayCoinHeaderBaseHeight = "LODB-relay-coinheader-baseheight-"
 )
 
 var (
@@ -46,6 +52,34 @@ func calcBtcHeaderKeyHeightList(height int64) []byte {
 	return []byte(key)
 }
 
+// isBtcCoin the BTC headers keep the keys before ForkRelayChainParams
+func isBtcCoin(coin string) bool {
+	return coin == "" || coin == "BTC"
+}
+
+func calcCoinHeaderKeyHash(coin, hash string) []byte {
+	return []byte(fmt.Sprintf(relayCoinHeaderHash+"%s-%s", coin, hash))
+}
+
+func calcCoinHeaderKeyHeight(coin string, height int64) []byte {
+	return []byte(fmt.Sprintf(relayCoinHeaderHeight+"%s-%d", coin, height))
+}
+
+func calcCoinHeaderPrefixHeightList(coin string) []byte {
+	return []byte(fmt.Sprintf(relayCoinHeaderHeightList+"%s-", coin))
+}
+
+func calcCoinHeaderKeyHeightList(coin string, height int64) []byte {
+	return []byte(fmt.Sprintf(relayCoinHeaderHeightList+"%s-%d", coin, height))
+}
+
+func calcBtcLastHeadKey(coin string) []byte {
+	if isBtcCoin(coin) {
+		return []byte(btcLastHead)
+	}
+	return []byte(btcLastHead + "-" + coin)
+}
+
 func calcOrderKeyStatus(order *ty.RelayOrder, status int32) []byte {
 	key := fmt.Sprintf(relayOrderSCAIH+"%d:%s:%s:%s:%d",
 		status, order.XCoin, order.CreaterAddr, order.Id, order.Height)
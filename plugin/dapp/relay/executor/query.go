@@ -34,7 +34,7 @@ func (r *relay) Query_GetBTCHeaderList(in *rTy.ReqRelayBtcHeaderHeightList) (typ
 	if in == nil {
 		return nil, types.ErrInvalidParam
 	}
-	db := newBtcStore(r.GetLocalDB())
+	db := newCoinStore(r.GetLocalDB(), in.Coin)
 	return db.getHeadHeightList(in)
 }
 
@@ -42,6 +42,14 @@ func (r *relay) Query_GetBTCHeaderCurHeight(in *rTy.ReqRelayQryBTCHeadHeight) (t
 	if in == nil {
 		return nil, types.ErrInvalidParam
 	}
-	db := newBtcStore(r.GetLocalDB())
+	db := newCoinStore(r.GetLocalDB(), in.Coin)
 	return db.getBtcCurHeight(in)
 }
+
+func (r *relay) Query_GetBTCHeader(in *rTy.ReqRelayBtcHeader) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	db := newCoinStore(r.GetLocalDB(), in.Coin)
+	return db.getBtcHeader(in)
+}
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"strings"
 	"testing"
 
 	apimock "github.com/33cn/chain33/client/mocks"
@@ -21,6 +22,10 @@ import (
 
 var chainTestCfg = types.NewChain33Config(types.GetDefaultCfgstring())
 
+//the header fixtures are mined with regtest bits, the header suites verify them with BTC-regtest params
+var regTestCfg = types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(),
+	"[exec.sub.relay]", "[exec.sub.relay]\nchainParams=[\"BTC-regtest\"]", 1))
+
 func init() {
 	Init(ty.RelayX, chainTestCfg, nil)
 }
@@ -268,9 +273,12 @@ func (s *suiteRelay) TestExec_4() {
 		Hash:        "6359f0868171b1d194cbee1af2f16ea598ae8fad666d9b012c8ed2b79a236ec4",
 	}
 
-	heightBytes := types.Encode(&types.Int64{Data: int64(1006)})
+	//the tx block should be in best chain with enough confirmations
+	heightBytes := types.Encode(&types.Int64{Data: int64(100006)})
 	s.kvdb.On("Get", mock.Anything).Return(heightBytes, nil).Once()
 	var head = &ty.BtcHeader{
+		Hash:       "000000000003ba27aa200b1cecaad478d2b00432346c3f1f3986da1afd33e506",
+		Height:     100000,
 		Version:    1,
 		MerkleRoot: "f3e94742aca4b5ef85488dc37c06c3282295ffec960994b2c0d5ac2a25a95766",
 	}
@@ -351,6 +359,7 @@ func (s *suiteRelay) TestExec_9_QryStatus5() {
 
 	heightBytes := types.Encode(&types.Int64{Data: int64(10)})
 	s.kvdb.On("Get", mock.Anything).Return(heightBytes, nil).Twice()
+	s.kvdb.On("Get", mock.Anything).Return(nil, types.ErrNotFound).Once()
 	msg, err := s.relay.Query_GetBTCHeaderCurHeight(addrCoins)
 	s.Nil(err)
 	//s.T().Log(msg)
@@ -378,7 +387,7 @@ func (s *suiteBtcHeader) SetupSuite() {
 	//accDb, _ := db.NewGoMemDB("relayTestDb", "test", 128)
 	relay := &relay{}
 	api := new(apimock.QueueProtocolAPI)
-	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+	api.On("GetConfig", mock.Anything).Return(regTestCfg, nil)
 	relay.SetAPI(api)
 	relay.SetStateDB(s.db)
 	relay.SetLocalDB(s.kvdb)
@@ -499,6 +508,10 @@ func (s *suiteBtcHeader) TestSaveBtcHead_1() {
 	receipt, err := s.relay.Exec(tx, 0)
 	s.Nil(err)
 
+	//regtest bits work 2 each block, accumulated from the first header
+	head0.ChainWork = "2"
+	head1.ChainWork = "4"
+	head2.ChainWork = "6"
 	s.testExecBtcHeadLocal(tx, receipt, headers)
 	s.testExecBtcHeadDelLocal(tx, receipt, headers)
 
@@ -19,16 +19,68 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 )
 
 type btcStore struct {
-	db dbm.KVDB
+	db   dbm.KVDB
+	coin string
 }
 
 func newBtcStore(db dbm.KVDB) *btcStore {
 	return &btcStore{db: db}
 }
 
+// newCoinStore the headers store of utxo coin, the BTC headers use the original keys
+func newCoinStore(db dbm.KVDB, coin string) *btcStore {
+	if isBtcCoin(coin) {
+		return newBtcStore(db)
+	}
+	return &btcStore{db: db, coin: coin}
+}
+
+func (b *btcStore) keyHash(hash string) []byte {
+	if b.coin == "" {
+		return calcBtcHeaderKeyHash(hash)
+	}
+	return calcCoinHeaderKeyHash(b.coin, hash)
+}
+
+func (b *btcStore) keyHeight(height int64) []byte {
+	if b.coin == "" {
+		return calcBtcHeaderKeyHeight(height)
+	}
+	return calcCoinHeaderKeyHeight(b.coin, height)
+}
+
+func (b *btcStore) keyHeightList(height int64) []byte {
+	if b.coin == "" {
+		return calcBtcHeaderKeyHeightList(height)
+	}
+	return calcCoinHeaderKeyHeightList(b.coin, height)
+}
+
+func (b *btcStore) prefixHeightList() []byte {
+	if b.coin == "" {
+		return []byte(relayBTCHeaderHeightList)
+	}
+	return calcCoinHeaderPrefixHeightList(b.coin)
+}
+
+func (b *btcStore) keyLastHeight() []byte {
+	if b.coin == "" {
+		return relayBTCHeaderLastHeight
+	}
+	return []byte(relayCoinHeaderLastHeight + b.coin)
+}
+
+func (b *btcStore) keyBaseHeight() []byte {
+	if b.coin == "" {
+		return relayBTCHeaderBaseHeight
+	}
+	return []byte(relayCoinHeaderBaseHeight + b.coin)
+}
+
 func (b *btcStore) getBtcHeadHeightFromDb(key []byte) (int64, error) {
 	val, err := b.db.Get(key)
 	if err != nil {
@@ -44,13 +96,13 @@ func (b *btcStore) getBtcHeadHeightFromDb(key []byte) (int64, error) {
 }
 
 func (b *btcStore) getLastBtcHeadHeight() (int64, error) {
-	key := relayBTCHeaderLastHeight
+	key := b.keyLastHeight()
 	return b.getBtcHeadHeightFromDb(key)
 }
 
 func (b *btcStore) getBtcHeadByHeight(height int64) (*ty.BtcHeader, error) {
 	var head ty.BtcHeader
-	key := calcBtcHeaderKeyHeight(height)
+	key := b.keyHeight(height)
 	val, err := b.db.Get(key)
 	if err != nil {
 		return nil, err
@@ -77,7 +129,46 @@ func (b *btcStore) getLastBtcHead() (*ty.BtcHeader, error) {
 	return head, nil
 }
 
+func (b *btcStore) getBtcHeadByHash(hash string) (*ty.BtcHeader, error) {
+	var head ty.BtcHeader
+	val, err := b.db.Get(b.keyHash(hash))
+	if err != nil {
+		return nil, err
+	}
+	err = types.Decode(val, &head)
+	if err != nil {
+		return nil, err
+	}
+
+	return &head, nil
+}
+
 func (b *btcStore) saveBlockHead(head *ty.BtcHeader) ([]*types.KeyValue, error) {
+	kv, err := b.saveBlockHeadHash(head)
+	if err != nil {
+		return nil, err
+	}
+	heightKv, err := b.saveBlockHeadHeight(head)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(kv, heightKv...), nil
+}
+
+func (b *btcStore) saveBlockHeadHash(head *ty.BtcHeader) ([]*types.KeyValue, error) {
+	val, err := proto.Marshal(head)
+	if err != nil {
+		relaylog.Error("saveBlockHead", "height", head.Height, "hash", head.Hash)
+		return nil, err
+	}
+
+	// hash:header
+	key := b.keyHash(head.Hash)
+	return []*types.KeyValue{{Key: key, Value: val}}, nil
+}
+
+func (b *btcStore) saveBlockHeadHeight(head *ty.BtcHeader) ([]*types.KeyValue, error) {
 	var kv []*types.KeyValue
 	var key []byte
 
@@ -85,18 +176,14 @@ func (b *btcStore) saveBlockHead(head *ty.BtcHeader) ([]*types.KeyValue, error)
 	if err != nil {
 		relaylog.Error("saveBlockHead", "height", head.Height, "hash", head.Hash)
 		return nil, err
-
 	}
 
-	// hash:header
-	key = calcBtcHeaderKeyHash(head.Hash)
-	kv = append(kv, &types.KeyValue{Key: key, Value: val})
 	// height:header
-	key = calcBtcHeaderKeyHeight(int64(head.Height))
+	key = b.keyHeight(int64(head.Height))
 	kv = append(kv, &types.KeyValue{Key: key, Value: val})
 
 	// prefix-height:height
-	key = calcBtcHeaderKeyHeightList(int64(head.Height))
+	key = b.keyHeightList(int64(head.Height))
 	heightBytes := types.Encode(&types.Int64{Data: int64(head.Height)})
 	kv = append(kv, &types.KeyValue{Key: key, Value: heightBytes})
 
@@ -107,30 +194,37 @@ func (b *btcStore) saveBlockLastHead(head *ty.ReceiptRelayRcvBTCHeaders) ([]*typ
 	var kv []*types.KeyValue
 
 	heightBytes := types.Encode(&types.Int64{Data: int64(head.NewHeight)})
-	key := relayBTCHeaderLastHeight
+	key := b.keyLastHeight()
 	kv = append(kv, &types.KeyValue{Key: key, Value: heightBytes})
 
 	heightBytes = types.Encode(&types.Int64{Data: int64(head.NewBaseHeight)})
-	key = relayBTCHeaderBaseHeight
+	key = b.keyBaseHeight()
 	kv = append(kv, &types.KeyValue{Key: key, Value: heightBytes})
 
 	return kv, nil
 }
 
 func (b *btcStore) delBlockHead(head *ty.BtcHeader) ([]*types.KeyValue, error) {
-	var kv []*types.KeyValue
+	kv := b.delBlockHeadHash(head)
+	return append(kv, b.delBlockHeadHeight(head)...), nil
+}
 
-	key := calcBtcHeaderKeyHash(head.Hash)
-	kv = append(kv, &types.KeyValue{Key: key, Value: nil})
+func (b *btcStore) delBlockHeadHash(head *ty.BtcHeader) []*types.KeyValue {
+	key := b.keyHash(head.Hash)
+	return []*types.KeyValue{{Key: key, Value: nil}}
+}
+
+func (b *btcStore) delBlockHeadHeight(head *ty.BtcHeader) []*types.KeyValue {
+	var kv []*types.KeyValue
 	// height:header
-	key = calcBtcHeaderKeyHeight(int64(head.Height))
+	key := b.keyHeight(int64(head.Height))
 	kv = append(kv, &types.KeyValue{Key: key, Value: nil})
 
 	// prefix-height:height
-	key = calcBtcHeaderKeyHeightList(int64(head.Height))
+	key = b.keyHeightList(int64(head.Height))
 	kv = append(kv, &types.KeyValue{Key: key, Value: nil})
 
-	return kv, nil
+	return kv
 }
 
 func (b *btcStore) delBlockLastHead(head *ty.ReceiptRelayRcvBTCHeaders) ([]*types.KeyValue, error) {
@@ -138,16 +232,75 @@ func (b *btcStore) delBlockLastHead(head *ty.ReceiptRelayRcvBTCHeaders) ([]*type
 	var key []byte
 
 	heightBytes := types.Encode(&types.Int64{Data: int64(head.LastHeight)})
-	key = relayBTCHeaderLastHeight
+	key = b.keyLastHeight()
 	kv = append(kv, &types.KeyValue{Key: key, Value: heightBytes})
 
 	heightBytes = types.Encode(&types.Int64{Data: int64(head.LastBaseHeight)})
-	key = relayBTCHeaderBaseHeight
+	key = b.keyBaseHeight()
 	kv = append(kv, &types.KeyValue{Key: key, Value: heightBytes})
 
 	return kv, nil
 }
 
+// saveBlockBranch save all the headers by hash, the headers attached to best chain by height,
+// and delete the detached heights higher than new best head
+func (b *btcStore) saveBlockBranch(receipt *ty.ReceiptRelayRcvBTCHeaders) ([]*types.KeyValue, error) {
+	var kv []*types.KeyValue
+	for _, head := range receipt.DetachHeaders {
+		if head.Height > receipt.NewHeight {
+			kv = append(kv, b.delBlockHeadHeight(head)...)
+		}
+	}
+
+	for _, head := range receipt.AttachHeaders {
+		val, err := b.saveBlockHeadHeight(head)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, val...)
+	}
+
+	for _, head := range receipt.Headers {
+		val, err := b.saveBlockHeadHash(head)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, val...)
+	}
+
+	val, err := b.saveBlockLastHead(receipt)
+	if err != nil {
+		return nil, err
+	}
+	return append(kv, val...), nil
+}
+
+// delBlockBranch rollback saveBlockBranch, delete the attached heights first then restore the detached
+func (b *btcStore) delBlockBranch(receipt *ty.ReceiptRelayRcvBTCHeaders) ([]*types.KeyValue, error) {
+	var kv []*types.KeyValue
+	for _, head := range receipt.AttachHeaders {
+		kv = append(kv, b.delBlockHeadHeight(head)...)
+	}
+
+	for _, head := range receipt.Headers {
+		kv = append(kv, b.delBlockHeadHash(head)...)
+	}
+
+	for _, head := range receipt.DetachHeaders {
+		val, err := b.saveBlockHeadHeight(head)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, val...)
+	}
+
+	val, err := b.delBlockLastHead(receipt)
+	if err != nil {
+		return nil, err
+	}
+	return append(kv, val...), nil
+}
+
 func decodeHeight(heightBytes []byte) (int64, error) {
 	var height types.Int64
 	err := types.Decode(heightBytes, &height)
@@ -166,7 +319,7 @@ func (b *btcStore) getBtcCurHeight(req *ty.ReqRelayQryBTCHeadHeight) (types.Mess
 		return nil, err
 	}
 
-	key := relayBTCHeaderBaseHeight
+	key := b.keyBaseHeight()
 	baseHeight, err := b.getBtcHeadHeightFromDb(key)
 	if err == types.ErrNotFound {
 		baseHeight = -1
@@ -176,11 +329,25 @@ func (b *btcStore) getBtcCurHeight(req *ty.ReqRelayQryBTCHeadHeight) (types.Mess
 	var replay ty.ReplayRelayQryBTCHeadHeight
 	replay.CurHeight = height
 	replay.BaseHeight = baseHeight
+	if height >= 0 {
+		head, err := b.getBtcHeadByHeight(height)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		replay.CurHash = head.GetHash()
+	}
 	return &replay, nil
 }
 
+func (b *btcStore) getBtcHeader(req *ty.ReqRelayBtcHeader) (types.Message, error) {
+	if req.Hash != "" {
+		return b.getBtcHeadByHash(req.Hash)
+	}
+	return b.getBtcHeadByHeight(req.Height)
+}
+
 func (b *btcStore) getMerkleRootFromHeader(blockhash string) (string, error) {
-	value, err := b.db.Get(calcBtcHeaderKeyHash(blockhash))
+	value, err := b.db.Get(b.keyHash(blockhash))
 	if err != nil {
 		return "", err
 	}
@@ -194,7 +361,7 @@ func (b *btcStore) getMerkleRootFromHeader(blockhash string) (string, error) {
 
 }
 
-func (b *btcStore) verifyBtcTx(verify *ty.RelayVerify, order *ty.RelayOrder) error {
+func checkBtcTxOrder(verify *ty.RelayVerify, order *ty.RelayOrder) error {
 	var foundtx bool
 	for _, outtx := range verify.GetTx().GetVout() {
 		if outtx.Address == order.XAddr && outtx.Value >= order.XAmount {
@@ -214,6 +381,35 @@ func (b *btcStore) verifyBtcTx(verify *ty.RelayVerify, order *ty.RelayOrder) err
 		relaylog.Error("verifyTx", "tx time not correct to accept", txTime.Sub(acceptTime), "to confirm time", confirmTime.Sub(txTime))
 		return ty.ErrRelayBtcTxTimeErr
 	}
+	return nil
+}
+
+func checkBtcTxMerkleRoot(verify *ty.RelayVerify, merkleRoot string) error {
+	rawHash, err := btcHashStrRevers(verify.GetTx().GetHash())
+	if err != nil {
+		return err
+	}
+	sibs := verify.GetSpv().GetBranchProof()
+
+	verifyRoot := merkle.GetMerkleRootFromBranch(sibs, rawHash, verify.GetSpv().GetTxIndex())
+	realMerkleRoot, err := btcHashStrRevers(merkleRoot)
+	if err != nil {
+		return err
+	}
+
+	rst := bytes.Equal(realMerkleRoot, verifyRoot)
+	if !rst {
+		return ty.ErrRelayVerify
+	}
+
+	return nil
+}
+
+func (b *btcStore) verifyBtcTx(verify *ty.RelayVerify, order *ty.RelayOrder) error {
+	err := checkBtcTxOrder(verify, order)
+	if err != nil {
+		return err
+	}
 
 	height, err := b.getLastBtcHeadHeight()
 	if err != nil {
@@ -224,29 +420,44 @@ func (b *btcStore) verifyBtcTx(verify *ty.RelayVerify, order *ty.RelayOrder) err
 		return ty.ErrRelayWaitBlocksErr
 	}
 
-	rawHash, err := btcHashStrRevers(verify.GetTx().GetHash())
+	str, err := b.getMerkleRootFromHeader(verify.GetSpv().GetBlockHash())
 	if err != nil {
 		return err
 	}
-	sibs := verify.GetSpv().GetBranchProof()
 
-	verifyRoot := merkle.GetMerkleRootFromBranch(sibs, rawHash, verify.GetSpv().GetTxIndex())
-	str, err := b.getMerkleRootFromHeader(verify.GetSpv().GetBlockHash())
+	return checkBtcTxMerkleRoot(verify, str)
+}
+
+// verifyBtcTxInBestChain the tx block should be in the best chain and have enough confirmations,
+// the block on the side branch may be orphaned
+func (b *btcStore) verifyBtcTxInBestChain(verify *ty.RelayVerify, order *ty.RelayOrder) error {
+	err := checkBtcTxOrder(verify, order)
 	if err != nil {
 		return err
 	}
-	realMerkleRoot, err := btcHashStrRevers(str)
+
+	height, err := b.getLastBtcHeadHeight()
 	if err != nil {
 		return err
 	}
 
-	rst := bytes.Equal(realMerkleRoot, verifyRoot)
-	if !rst {
-		return ty.ErrRelayVerify
+	head, err := b.getBtcHeadByHeight(int64(verify.GetSpv().GetHeight()))
+	if err == types.ErrNotFound {
+		return ty.ErrRelayBtcTxNotInBestChain
+	}
+	if err != nil {
+		return err
+	}
+	if head.Hash != verify.GetSpv().GetBlockHash() {
+		relaylog.Error("verifyTx", "block", verify.GetSpv().GetBlockHash(), "best chain block", head.Hash, "height", head.Height)
+		return ty.ErrRelayBtcTxNotInBestChain
 	}
 
-	return nil
+	if head.Height+uint64(order.XBlockWaits) > uint64(height) {
+		return ty.ErrRelayWaitBlocksErr
+	}
 
+	return checkBtcTxMerkleRoot(verify, head.MerkleRoot)
 }
 
 func (b *btcStore) verifyCmdBtcTx(verify *ty.RelayVerifyCli) error {
@@ -318,8 +529,8 @@ func reverse(h []byte) []byte {
 }
 
 func (b *btcStore) getHeadHeightList(req *ty.ReqRelayBtcHeaderHeightList) (types.Message, error) {
-	prefix := []byte(relayBTCHeaderHeightList)
-	key := calcBtcHeaderKeyHeightList(req.ReqHeight)
+	prefix := b.prefixHeightList()
+	key := b.keyHeightList(req.ReqHeight)
 
 	values, err := b.db.List(prefix, key, req.Counts, req.Direction)
 	if err != nil {
@@ -364,7 +575,49 @@ func btcWireHeader(head *ty.BtcHeader) (*wire.BlockHeader, error) {
 	return h, nil
 }
 
+// legacyBtcParams the BTC rules before ForkRelayChainParams, the powLimit of regression test network
+// only limits the retarget, and the bits before height 30000 is not checked as real BTC block's bits
+// not match with the calculation result
+var legacyBtcParams = func() *ty.ChainParams {
+	params := *ty.BtcMainNetParams
+	params.Name = "BTC-legacy"
+	params.GenesisHash = ""
+	params.PowLimit = ty.BtcRegTestParams.PowLimit
+	params.PowLimitBits = 0
+	params.CheckBitsHeight = 30000
+	return &params
+}()
+
+// getChainParams the coin's params configured by sub config chainParams, BTC-mainnet if not configured
+func getChainParams(cfg *types.Chain33Config, coin string) (*ty.ChainParams, error) {
+	if coin == "" {
+		coin = ty.BtcMainNetParams.Coin
+	}
+	names := types.ConfSub(cfg, driverName).GStrList("chainParams")
+	if len(names) == 0 {
+		names = []string{ty.BtcMainNetParams.Name}
+	}
+	for _, name := range names {
+		params, err := ty.GetChainParams(name)
+		if err != nil {
+			return nil, err
+		}
+		if params.Coin == coin {
+			return params, nil
+		}
+	}
+	return nil, errors.Wrapf(ty.ErrRelayCoinNotSupport, "coin=%s", coin)
+}
+
 func verifyBlockHeader(head *ty.BtcHeader, preHead *ty.RelayLastRcvBtcHeader, localDb dbm.KVDB) error {
+	btc := newBtcStore(localDb)
+	return verifyBtcHeader(legacyBtcParams, head, preHead, func(height uint64) (*ty.BtcHeader, error) {
+		return btc.getBtcHeadByHeight(int64(height))
+	})
+}
+
+// verifyBtcHeader verify the header with previous header, ancestor get the retarget header of previous header's chain
+func verifyBtcHeader(params *ty.ChainParams, head *ty.BtcHeader, preHead *ty.RelayLastRcvBtcHeader, ancestor func(height uint64) (*ty.BtcHeader, error)) error {
 	if head == nil {
 		return types.ErrInvalidParam
 	}
@@ -374,9 +627,13 @@ func verifyBlockHeader(head *ty.BtcHeader, preHead *ty.RelayLastRcvBtcHeader, lo
 		return ty.ErrRelayBtcHeadSequenceErr
 	}
 
-	//real BTC block not change the bits before height<30000, not match with the calculation result
-	if !head.IsReset && head.Height > 30000 {
-		newBits, err := calcNextRequiredDifficulty(preHead.Header, localDb)
+	if head.Height == 0 && params.GenesisHash != "" && head.Hash != params.GenesisHash {
+		relaylog.Error("verifyBtcHeader", "genesis", head.Hash, "params", params.Name)
+		return ty.ErrRelayBtcHeadHashErr
+	}
+
+	if !head.IsReset && !params.NoBitsCheck && head.Height > params.CheckBitsHeight {
+		newBits, err := calcNextBits(params, preHead.Header, head.Time, ancestor)
 		if err != nil && err != types.ErrNotFound {
 			return err
 		}
@@ -397,9 +654,16 @@ func verifyBlockHeader(head *ty.BtcHeader, preHead *ty.RelayLastRcvBtcHeader, lo
 	}
 
 	target := difficulty.CompactToBig(uint32(head.Bits))
+	if params.PowLimitBits != 0 && (target.Sign() <= 0 || target.Cmp(params.PowLimit) > 0) {
+		return ty.ErrRelayBtcHeadBitsErr
+	}
 
 	// The block hash must be less than the claimed target.
-	hashNum := difficulty.HashToBig(hash[:])
+	powHash, err := params.PowHash(btcHeader)
+	if err != nil {
+		return err
+	}
+	hashNum := difficulty.HashToBig(powHash[:])
 	if hashNum.Cmp(target) > 0 {
 		return ty.ErrRelayBtcHeadBitsErr
 	}
@@ -411,24 +675,24 @@ func verifyBlockHeader(head *ty.BtcHeader, preHead *ty.RelayLastRcvBtcHeader, lo
 // calcNextRequiredDifficulty calculates the required difficulty for the block
 // after the passed previous block node based on the difficulty retarget rules.
 func calcNextRequiredDifficulty(preHead *ty.BtcHeader, localDb dbm.KVDB) (int64, error) {
+	btc := newBtcStore(localDb)
+	return calcNextBits(legacyBtcParams, preHead, 0, func(height uint64) (*ty.BtcHeader, error) {
+		return btc.getBtcHeadByHeight(int64(height))
+	})
+}
+
+func calcNextBits(params *ty.ChainParams, preHead *ty.BtcHeader, newTime int64, ancestor func(height uint64) (*ty.BtcHeader, error)) (int64, error) {
 	if preHead == nil {
 		return 0, nil
 	}
+	if params.NoRetargeting {
+		return preHead.Bits, nil
+	}
 
-	// Genesis block.
-	targetTimespan := time.Hour * 24 * 14  // 14 days
-	TargetTimePerBlock := time.Minute * 10 // 10 minutes
-	retargetAdjustmentFactor := int64(4)   // 25% less, 400% more
-	timeSpan := int64(targetTimespan / time.Second)
-	timeBlock := int64(TargetTimePerBlock / time.Second)
-
-	// powLimit is the highest proof of work value a Bitcoin block
-	// can have for the regression test network.  It is the value 2^255 - 1.
-	bigOne := big.NewInt(1)
-	powLimit := new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
-	blocksPerRetarget := uint64(timeSpan / timeBlock)
-	minRetargetTimespan := timeSpan / retargetAdjustmentFactor
-	maxRetargetTimespan := timeSpan * retargetAdjustmentFactor
+	timeSpan := int64(params.TargetTimespan / time.Second)
+	blocksPerRetarget := params.BlocksPerRetarget()
+	minRetargetTimespan := timeSpan / params.RetargetAdjustmentFactor
+	maxRetargetTimespan := timeSpan * params.RetargetAdjustmentFactor
 
 	// Return the previous block's difficulty requirements if this block
 	// is not at a difficulty retarget interval.
@@ -436,6 +700,13 @@ func calcNextRequiredDifficulty(preHead *ty.BtcHeader, localDb dbm.KVDB) (int64,
 		// For networks that support it, allow special reduction of the
 		// required difficulty once too much time has elapsed without
 		// mining a block.
+		if params.ReduceMinDifficulty {
+			reductionTime := int64(params.MinDiffReductionTime / time.Second)
+			if newTime > preHead.Time+reductionTime {
+				return int64(params.PowLimitBits), nil
+			}
+			return findPrevTestNetBits(params, preHead, ancestor)
+		}
 
 		// For the main network (or any unrecognized networks), simply
 		// return the previous block's difficulty requirements.
@@ -443,9 +714,12 @@ func calcNextRequiredDifficulty(preHead *ty.BtcHeader, localDb dbm.KVDB) (int64,
 	}
 
 	// Get the block node at the previous retarget (targetTimespan days
-	// worth of blocks).
-	btc := newBtcStore(localDb)
-	firstHead, err := btc.getBtcHeadByHeight(int64(preHead.Height - (blocksPerRetarget - 1)))
+	// worth of blocks), litecoin goes back the full window except the first retarget.
+	blocksBack := blocksPerRetarget - 1
+	if params.RetargetFullWindow && preHead.Height+1 != blocksPerRetarget {
+		blocksBack = blocksPerRetarget
+	}
+	firstHead, err := ancestor(preHead.Height - blocksBack)
 	if err != nil {
 		return 0, err
 	}
@@ -470,11 +744,137 @@ func calcNextRequiredDifficulty(preHead *ty.BtcHeader, localDb dbm.KVDB) (int64,
 	newTarget.Div(newTarget, big.NewInt(timeSpan))
 
 	// Limit new value to the proof of work limit.
-	if newTarget.Cmp(powLimit) > 0 {
-		newTarget.Set(powLimit)
+	if newTarget.Cmp(params.PowLimit) > 0 {
+		newTarget.Set(params.PowLimit)
 	}
 
 	newTargetBits := difficulty.BigToCompact(newTarget)
 
 	return int64(newTargetBits), nil
 }
+
+// findPrevTestNetBits return the bits of the last block not mined by the min difficulty rule
+// or the block at retarget interval
+func findPrevTestNetBits(params *ty.ChainParams, preHead *ty.BtcHeader, ancestor func(height uint64) (*ty.BtcHeader, error)) (int64, error) {
+	blocksPerRetarget := params.BlocksPerRetarget()
+	cur := preHead
+	for cur.Height%blocksPerRetarget != 0 && uint32(cur.Bits) == params.PowLimitBits {
+		head, err := ancestor(cur.Height - 1)
+		if err != nil {
+			return 0, err
+		}
+		cur = head
+	}
+	return cur.Bits, nil
+}
+
+// maxBtcReorgDepth the max blocks of best chain can be switched out, about one day's btc blocks
+const maxBtcReorgDepth = 144
+
+// getChainWork the headers saved before ForkRelayBtcReorg have no chain work and count as 0,
+// so the branches fork before that are compared only by the work after the fork
+func getChainWork(head *ty.BtcHeader) *big.Int {
+	work, ok := new(big.Int).SetString(head.GetChainWork(), 10)
+	if !ok {
+		return big.NewInt(0)
+	}
+	return work
+}
+
+func calcChainWork(preHead, head *ty.BtcHeader) string {
+	work := difficulty.CalcWork(uint32(head.Bits))
+	if preHead != nil {
+		work.Add(work, getChainWork(preHead))
+	}
+	return work.String()
+}
+
+// isBestChain the header is saved in the height index of best chain
+func (b *btcStore) isBestChain(head *ty.BtcHeader) (bool, error) {
+	best, err := b.getBtcHeadByHeight(int64(head.Height))
+	if err == types.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return best.Hash == head.Hash, nil
+}
+
+// btcBranch the headers in receiving, not saved to localdb yet
+type btcBranch struct {
+	btc     *btcStore
+	headers map[string]*ty.BtcHeader
+}
+
+func newBtcBranch(btc *btcStore) *btcBranch {
+	return &btcBranch{btc: btc, headers: make(map[string]*ty.BtcHeader)}
+}
+
+func (br *btcBranch) getHeader(hash string) (*ty.BtcHeader, error) {
+	if head, ok := br.headers[hash]; ok {
+		return head, nil
+	}
+	return br.btc.getBtcHeadByHash(hash)
+}
+
+// ancestor walk back by previous hash until the best chain, then get the header by height
+func (br *btcBranch) ancestor(head *ty.BtcHeader, height uint64) (*ty.BtcHeader, error) {
+	cur := head
+	for cur.Height > height {
+		best, err := br.btc.isBestChain(cur)
+		if err != nil {
+			return nil, err
+		}
+		if best {
+			return br.btc.getBtcHeadByHeight(int64(height))
+		}
+		cur, err = br.getHeader(cur.PreviousHash)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cur.Height != height {
+		return nil, ty.ErrRelayBtcHeadSequenceErr
+	}
+	return cur, nil
+}
+
+// switchBranch find the fork point of the branch with best chain,
+// return the headers attached to and detached from the best chain
+func (b *btcStore) switchBranch(branch []*ty.BtcHeader, lastHead *ty.BtcHeader) ([]*ty.BtcHeader, []*ty.BtcHeader, error) {
+	var attach, detach []*ty.BtcHeader
+	cur, err := b.getBtcHeadByHash(branch[0].PreviousHash)
+	for {
+		if err == types.ErrNotFound {
+			return nil, nil, ty.ErrRelayBtcHeadSequenceErr
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if cur.Height+maxBtcReorgDepth < lastHead.Height {
+			relaylog.Error("switchBranch", "fork height", cur.Height, "best height", lastHead.Height)
+			return nil, nil, ty.ErrRelayBtcReorgTooDeep
+		}
+		var best bool
+		best, err = b.isBestChain(cur)
+		if err != nil {
+			return nil, nil, err
+		}
+		if best {
+			break
+		}
+		attach = append([]*ty.BtcHeader{cur}, attach...)
+		cur, err = b.getBtcHeadByHash(cur.PreviousHash)
+	}
+	attach = append(attach, branch...)
+
+	for height := cur.Height + 1; height <= lastHead.Height; height++ {
+		head, err := b.getBtcHeadByHeight(int64(height))
+		if err != nil {
+			return nil, nil, err
+		}
+		detach = append(detach, head)
+	}
+	return attach, detach, nil
+}
@@ -12,6 +12,7 @@ import (
 	ty "github.com/33cn/plugin/plugin/dapp/relay/types"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -459,4 +460,75 @@ func (s *suiteBtcStore) TestCalcNextRequiredDifficulty_2() {
 	newbits, _ := calcNextRequiredDifficulty(lastHead, s.kvdb)
 	s.Equal(newHead.Bits, newbits)
 
+	newbits, err := calcNextBits(ty.BtcMainNetParams, lastHead, newHead.Time, func(height uint64) (*ty.BtcHeader, error) {
+		s.Equal(firstHead.Height, height)
+		return firstHead, nil
+	})
+	s.Nil(err)
+	s.Equal(newHead.Bits, newbits)
+}
+
+func TestCalcNextBitsParams(t *testing.T) {
+	headers := make(map[uint64]*ty.BtcHeader)
+	var requested uint64
+	ancestor := func(height uint64) (*ty.BtcHeader, error) {
+		requested = height
+		head, ok := headers[height]
+		if !ok {
+			return nil, types.ErrNotFound
+		}
+		return head, nil
+	}
+
+	//testnet3 allows min difficulty block after 20 minutes, then back to the last normal bits
+	params := ty.BtcTestNet3Params
+	headers[4032] = &ty.BtcHeader{Height: 4032, Bits: 0x1c0ffff0, Time: 100000}
+	headers[4033] = &ty.BtcHeader{Height: 4033, Bits: int64(params.PowLimitBits), Time: 101300}
+	pre := headers[4033]
+	bits, err := calcNextBits(params, pre, pre.Time+1201, ancestor)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(params.PowLimitBits), bits)
+	bits, err = calcNextBits(params, pre, pre.Time+600, ancestor)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0x1c0ffff0), bits)
+	bits, err = calcNextBits(ty.BtcMainNetParams, pre, pre.Time+1201, ancestor)
+	assert.Nil(t, err)
+	assert.Equal(t, pre.Bits, bits)
+
+	//litecoin goes back the full window except the first retarget
+	params = ty.LtcMainNetParams
+	headers[2015] = &ty.BtcHeader{Height: 2015, Bits: 0x1c0ffff0, Time: 100000}
+	pre = &ty.BtcHeader{Height: 4031, Bits: 0x1c0ffff0, Time: 100000 + 302400}
+	bits, err = calcNextBits(params, pre, pre.Time+150, ancestor)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2015), requested)
+	assert.Equal(t, pre.Bits, bits)
+	_, err = calcNextBits(params, headers[2015], headers[2015].Time+150, ancestor)
+	assert.Equal(t, types.ErrNotFound, err)
+	assert.Equal(t, uint64(0), requested)
+
+	//the bitcoin takes one block less
+	headers[2016] = &ty.BtcHeader{Height: 2016, Bits: 0x1c0ffff0, Time: 100000 + 302400/2}
+	_, err = calcNextBits(ty.BtcMainNetParams, pre, pre.Time+600, ancestor)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2016), requested)
+
+	bits, err = calcNextBits(ty.BtcRegTestParams, pre, pre.Time+600, ancestor)
+	assert.Nil(t, err)
+	assert.Equal(t, pre.Bits, bits)
+}
+
+func TestVerifyBtcHeaderParams(t *testing.T) {
+	base := &ty.BtcHeader{Height: 9, Time: 1530862108, Hash: "604efe53975ab06cad8748fd703ad5bc960e8b752b2aae98f0f871a4a05abfc7"}
+	head := mineBtcHeader(base, "6359f0868171b1d194cbee1af2f16ea598ae8fad666d9b012c8ed2b79a236ec4")
+	head.IsReset = true
+	assert.Nil(t, verifyBtcHeader(ty.BtcRegTestParams, head, nil, nil))
+	assert.Nil(t, verifyBtcHeader(legacyBtcParams, head, nil, nil))
+	//regtest bits higher than mainnet pow limit
+	assert.Equal(t, ty.ErrRelayBtcHeadBitsErr, verifyBtcHeader(ty.BtcMainNetParams, head, nil, nil))
+
+	genesis := mineBtcHeader(&ty.BtcHeader{Height: ^uint64(0)}, head.MerkleRoot)
+	genesis.IsReset = true
+	assert.Equal(t, ty.ErrRelayBtcHeadHashErr, verifyBtcHeader(ty.BtcRegTestParams, genesis, nil, nil))
+	assert.Nil(t, verifyBtcHeader(legacyBtcParams, genesis, nil, nil))
 }
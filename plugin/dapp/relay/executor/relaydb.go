@@ -106,6 +106,30 @@ func newRelayDB(r *relay, tx *types.Transaction) *relayDB {
 		fromAddr, r.GetBlockTime(), r.GetHeight(), dapp.ExecAddress(string(tx.Execer)), btc, r.GetAPI()}
 }
 
+// coinStore the headers store of the order coin, all the orders use BTC headers before ForkRelayChainParams
+func (action *relayDB) coinStore(coin string) *btcStore {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.RelayX, ty.ForkRelayChainParams) {
+		return action.btc
+	}
+	return newCoinStore(action.btc.db, coin)
+}
+
+// checkCoinAddr the coin should have chain params configured and the addr valid on the chain after ForkRelayChainParams
+func (action *relayDB) checkCoinAddr(coin, addr string) error {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, ty.RelayX, ty.ForkRelayChainParams) {
+		return nil
+	}
+	params, err := getChainParams(cfg, coin)
+	if err != nil {
+		return err
+	}
+	if addr == "" {
+		return nil
+	}
+	return params.CheckAddress(addr)
+}
+
 func (action *relayDB) getOrderByID(orderID string) (*ty.RelayOrder, error) {
 	value, err := action.db.Get([]byte(calcRelayOrderID(orderID)))
 	if err != nil {
@@ -151,6 +175,11 @@ func (action *relayDB) create(order *ty.RelayCreate) (*types.Receipt, error) {
 	var receipt *types.Receipt
 	var err error
 
+	err = action.checkCoinAddr(order.XCoin, order.XAddr)
+	if err != nil {
+		return nil, err
+	}
+
 	accDb, err := action.createAccount(order.LocalCoinExec, order.LocalCoinSymbol)
 	if err != nil {
 		return nil, errors.Wrapf(err, "relay create,exec=%s,sym=%s", order.LocalCoinExec, order.LocalCoinSymbol)
@@ -190,7 +219,7 @@ func (action *relayDB) create(order *ty.RelayCreate) (*types.Receipt, error) {
 		LocalCoinSymbol: order.LocalCoinSymbol,
 	}
 
-	height, err := action.btc.getLastBtcHeadHeight()
+	height, err := action.coinStore(order.XCoin).getLastBtcHeadHeight()
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +240,7 @@ func (action *relayDB) checkRevokeOrder(order *ty.RelayOrder) error {
 	nowTime := time.Unix(action.blockTime, 0)
 	var nowBtcHeight, subHeight int64
 
-	nowBtcHeight, err := action.btc.getLastBtcHeadHeight()
+	nowBtcHeight, err := action.coinStore(order.XCoin).getLastBtcHeadHeight()
 	if err != nil {
 		return err
 	}
@@ -353,6 +382,10 @@ func (action *relayDB) accept(accept *ty.RelayAccept) (*types.Receipt, error) {
 			relaylog.Error("accept, for sell operation, coinAddr needed")
 			return nil, ty.ErrRelayOrderParamErr
 		}
+		err = action.checkCoinAddr(order.XCoin, accept.XAddr)
+		if err != nil {
+			return nil, err
+		}
 
 		order.XAddr = accept.XAddr
 		order.XBlockWaits = accept.XBlockWaits
@@ -369,7 +402,7 @@ func (action *relayDB) accept(accept *ty.RelayAccept) (*types.Receipt, error) {
 	order.AcceptAddr = action.fromAddr
 	order.AcceptTime = action.blockTime
 
-	height, err := action.btc.getLastBtcHeadHeight()
+	height, err := action.coinStore(order.XCoin).getLastBtcHeadHeight()
 	if err != nil {
 		return nil, err
 	}
@@ -507,7 +540,7 @@ func (action *relayDB) confirmTx(confirm *ty.RelayConfirmTx) (*types.Receipt, er
 	order.Status = ty.RelayOrderStatus_confirming
 	order.ConfirmTime = action.blockTime
 	order.XTxHash = confirm.TxHash
-	height, err := action.btc.getLastBtcHeadHeight()
+	height, err := action.coinStore(order.XCoin).getLastBtcHeadHeight()
 	if err != nil {
 		relaylog.Error("confirmTx Get Last BTC", "orderid", confirm.OrderId)
 		return nil, err
@@ -543,7 +576,11 @@ func (action *relayDB) verifyTx(verify *ty.RelayVerify) (*types.Receipt, error)
 		return nil, ty.ErrRelayOrderOnSell
 	}
 
-	err = action.btc.verifyBtcTx(verify, order)
+	if action.api.GetConfig().IsDappFork(action.height, ty.RelayX, ty.ForkRelayBtcReorg) {
+		err = action.coinStore(order.XCoin).verifyBtcTxInBestChain(verify, order)
+	} else {
+		err = action.btc.verifyBtcTx(verify, order)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -605,13 +642,13 @@ func (action *relayDB) verifyTx(verify *ty.RelayVerify) (*types.Receipt, error)
 
 }
 
-func saveBtcLastHead(db dbm.KV, head *ty.RelayLastRcvBtcHeader) (set []*types.KeyValue) {
+func saveBtcLastHead(db dbm.KV, coin string, head *ty.RelayLastRcvBtcHeader) (set []*types.KeyValue) {
 	if head == nil || head.Header == nil {
 		return nil
 	}
 
 	value := types.Encode(head)
-	key := []byte(btcLastHead)
+	key := calcBtcLastHeadKey(coin)
 	set = append(set, &types.KeyValue{Key: key, Value: value})
 
 	for i := 0; i < len(set); i++ {
@@ -620,8 +657,8 @@ func saveBtcLastHead(db dbm.KV, head *ty.RelayLastRcvBtcHeader) (set []*types.Ke
 	return set
 }
 
-func getBtcLastHead(db dbm.KV) (*ty.RelayLastRcvBtcHeader, error) {
-	value, err := db.Get([]byte(btcLastHead))
+func getBtcLastHead(db dbm.KV, coin string) (*ty.RelayLastRcvBtcHeader, error) {
+	value, err := db.Get(calcBtcLastHeadKey(coin))
 	if err != nil {
 		return nil, err
 	}
@@ -645,11 +682,28 @@ func (action *relayDB) saveBtcHeader(headers *ty.BtcHeaders, localDb dbm.KVDB) (
 		return nil, types.ErrFromAddr
 	}
 
-	lastHead, err := getBtcLastHead(action.db)
+	params := legacyBtcParams
+	if cfg.IsDappFork(action.height, ty.RelayX, ty.ForkRelayChainParams) {
+		var err error
+		params, err = getChainParams(cfg, headers.Coin)
+		if err != nil {
+			return nil, err
+		}
+	} else if !isBtcCoin(headers.Coin) {
+		return nil, errors.Wrapf(ty.ErrRelayCoinNotSupport, "coin=%s before %s", headers.Coin, ty.ForkRelayChainParams)
+	}
+	btc := newCoinStore(localDb, headers.Coin)
+	receipt.Coin = headers.Coin
+
+	lastHead, err := getBtcLastHead(action.db, headers.Coin)
 	if err != nil && err != types.ErrNotFound {
 		return nil, err
 	}
 
+	if cfg.IsDappFork(action.height, ty.RelayX, ty.ForkRelayBtcReorg) {
+		return action.saveBtcBranch(btc, params, receipt, headers.BtcHeader, lastHead)
+	}
+
 	if lastHead != nil {
 		preHead.Header = lastHead.Header
 		preHead.BaseHeight = lastHead.BaseHeight
@@ -662,7 +716,9 @@ func (action *relayDB) saveBtcHeader(headers *ty.BtcHeaders, localDb dbm.KVDB) (
 	log.Ty = ty.TyLogRelayRcvBTCHead
 
 	for _, head := range headers.BtcHeader {
-		err := verifyBlockHeader(head, preHead, localDb)
+		err := verifyBtcHeader(params, head, preHead, func(height uint64) (*ty.BtcHeader, error) {
+			return btc.getBtcHeadByHeight(int64(height))
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -679,6 +735,96 @@ func (action *relayDB) saveBtcHeader(headers *ty.BtcHeaders, localDb dbm.KVDB) (
 
 	log.Log = types.Encode(receipt)
 	logs = append(logs, log)
-	kv = saveBtcLastHead(action.db, preHead)
+	kv = saveBtcLastHead(action.db, headers.Coin, preHead)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
+
+// saveBtcBranch the headers may extend any saved header, the branch with most chain work is switched to best chain
+func (action *relayDB) saveBtcBranch(btc *btcStore, params *ty.ChainParams, receipt *ty.ReceiptRelayRcvBTCHeaders,
+	headers []*ty.BtcHeader, lastHead *ty.RelayLastRcvBtcHeader) (*types.Receipt, error) {
+	if len(headers) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+
+	branch := newBtcBranch(btc)
+	var preHead = &ty.RelayLastRcvBtcHeader{}
+	if lastHead != nil {
+		preHead.BaseHeight = lastHead.BaseHeight
+		receipt.LastHeight = lastHead.Header.Height
+		receipt.LastBaseHeight = lastHead.BaseHeight
+	}
+
+	//the first headers or reset headers set the base of best chain
+	first := headers[0]
+	reset := first.IsReset || lastHead == nil
+	extend := !reset && first.PreviousHash == lastHead.Header.Hash
+	if extend {
+		preHead.Header = lastHead.Header
+	} else if !reset {
+		parent, err := btc.getBtcHeadByHash(first.PreviousHash)
+		if err == types.ErrNotFound {
+			return nil, ty.ErrRelayBtcHeadSequenceErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		if parent.Height+maxBtcReorgDepth < lastHead.Header.Height {
+			return nil, ty.ErrRelayBtcReorgTooDeep
+		}
+		_, err = btc.getBtcHeadByHash(first.Hash)
+		if err == nil {
+			return nil, ty.ErrRelayBtcHeadExist
+		}
+		if err != types.ErrNotFound {
+			return nil, err
+		}
+		preHead.Header = parent
+	}
+
+	for i, head := range headers {
+		if head.IsReset && i > 0 {
+			return nil, ty.ErrRelayBtcHeadSequenceErr
+		}
+		err := verifyBtcHeader(params, head, preHead, func(height uint64) (*ty.BtcHeader, error) {
+			return branch.ancestor(preHead.Header, height)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		head.ChainWork = calcChainWork(preHead.Header, head)
+		if head.IsReset {
+			preHead.BaseHeight = head.Height
+		}
+		branch.headers[head.Hash] = head
+		preHead.Header = head
+		receipt.Headers = append(receipt.Headers, head)
+	}
+
+	var kv []*types.KeyValue
+	switch {
+	case reset || extend:
+		receipt.AttachHeaders = receipt.Headers
+	case getChainWork(preHead.Header).Cmp(getChainWork(lastHead.Header)) > 0:
+		attach, detach, err := btc.switchBranch(headers, lastHead.Header)
+		if err != nil {
+			return nil, err
+		}
+		relaylog.Info("saveBtcHeader switch best chain", "from", lastHead.Header.Hash, "to", preHead.Header.Hash,
+			"attach", len(attach), "detach", len(detach))
+		receipt.AttachHeaders = attach
+		receipt.DetachHeaders = detach
+	default:
+		//the side branch with less work only save the headers
+		preHead = lastHead
+	}
+
+	receipt.NewHeight = preHead.Header.Height
+	receipt.NewBaseHeight = preHead.BaseHeight
+	if len(receipt.AttachHeaders) > 0 {
+		kv = saveBtcLastHead(action.db, receipt.Coin, preHead)
+	}
+
+	log := &types.ReceiptLog{Ty: ty.TyLogRelayRcvBTCHead, Log: types.Encode(receipt)}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}, nil
+}
@@ -5,6 +5,7 @@
 package executor
 
 import (
+	"strings"
 	"testing"
 
 	apimock "github.com/33cn/chain33/client/mocks"
@@ -13,9 +14,11 @@ import (
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/db/mocks"
+	"github.com/33cn/chain33/common/difficulty"
 	"github.com/33cn/chain33/types"
 	ty "github.com/33cn/plugin/plugin/dapp/relay/types"
 	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
@@ -887,9 +890,12 @@ func (s *suiteVerify) TestVerify() {
 		Hash:        "6359f0868171b1d194cbee1af2f16ea598ae8fad666d9b012c8ed2b79a236ec4",
 	}
 
-	heightBytes := types.Encode(&types.Int64{Data: int64(1006)})
+	//the tx block should be in best chain with enough confirmations
+	heightBytes := types.Encode(&types.Int64{Data: int64(100006)})
 	s.kvdb.On("Get", mock.Anything).Return(heightBytes, nil).Once()
 	var head = &ty.BtcHeader{
+		Hash:       "000000000003ba27aa200b1cecaad478d2b00432346c3f1f3986da1afd33e506",
+		Height:     100000,
 		Version:    1,
 		MerkleRoot: "f3e94742aca4b5ef85488dc37c06c3282295ffec960994b2c0d5ac2a25a95766",
 	}
@@ -1118,7 +1124,7 @@ func (s *suiteSaveBtcHeader) SetupSuite() {
 	s.kvdb = new(mocks.KVDB)
 	relay := &relay{}
 	api := new(apimock.QueueProtocolAPI)
-	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+	api.On("GetConfig", mock.Anything).Return(regTestCfg, nil)
 	relay.SetAPI(api)
 	relay.SetStateDB(s.db)
 	relay.SetLocalDB(s.kvdb)
@@ -1270,6 +1276,8 @@ func (s *suiteSaveBtcHeader) TestSaveBtcHeader_3() {
 
 	head4Encode := types.Encode(lastHead)
 	s.db.On("Get", mock.Anything).Return(head4Encode, nil).Once()
+	//previous hash not found in saved headers
+	s.kvdb.On("Get", mock.Anything).Return(nil, types.ErrNotFound).Once()
 
 	_, err := s.relayDb.saveBtcHeader(headers, s.kvdb)
 	s.Equal(ty.ErrRelayBtcHeadSequenceErr, err)
@@ -1331,3 +1339,246 @@ func TestRunSuiteSaveBtcHeader(t *testing.T) {
 	log := new(suiteSaveBtcHeader)
 	suite.Run(t, log)
 }
+
+/////////////////////////////////////////////
+
+type suiteBtcBranch struct {
+	// Include our basic suite logic.
+	suite.Suite
+	stateDB db.DB
+	localDB db.DB
+	relay   *relay
+}
+
+func (s *suiteBtcBranch) SetupTest() {
+	s.stateDB, _ = db.NewGoMemDB("relayBranchState", "test", 128)
+	s.localDB, _ = db.NewGoMemDB("relayBranchLocal", "test", 128)
+	relay := &relay{}
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(regTestCfg, nil)
+	relay.SetAPI(api)
+	relay.SetStateDB(s.stateDB)
+	relay.SetLocalDB(db.NewKVDB(s.localDB))
+	relay.SetEnv(10, 1000, 1)
+	relay.SetIsFree(false)
+	relay.SetChild(relay)
+	relay.SetExecutorType(types.LoadExecutorType(driverName))
+	s.relay = relay
+}
+
+//mineBtcHeader regtest bits, about half of the nonce match the target
+func mineBtcHeader(pre *ty.BtcHeader, merkleRoot string) *ty.BtcHeader {
+	return mineCoinHeader(ty.BtcRegTestParams, pre, merkleRoot)
+}
+
+func mineCoinHeader(params *ty.ChainParams, pre *ty.BtcHeader, merkleRoot string) *ty.BtcHeader {
+	head := &ty.BtcHeader{
+		Height:       pre.Height + 1,
+		Version:      536870912,
+		MerkleRoot:   merkleRoot,
+		Time:         pre.Time + 600,
+		Bits:         int64(params.PowLimitBits),
+		PreviousHash: pre.Hash,
+	}
+	for {
+		h, _ := btcWireHeader(head)
+		hash := h.BlockHash()
+		head.Hash = hash.String()
+		pow, _ := params.PowHash(h)
+		if difficulty.HashToBig(pow[:]).Cmp(difficulty.CompactToBig(uint32(head.Bits))) <= 0 {
+			return head
+		}
+		head.Nonce++
+	}
+}
+
+func mineBtcBranch(pre *ty.BtcHeader, merkleRoots ...string) []*ty.BtcHeader {
+	var headers []*ty.BtcHeader
+	for _, root := range merkleRoots {
+		pre = mineBtcHeader(pre, root)
+		headers = append(headers, pre)
+	}
+	return headers
+}
+
+func (s *suiteBtcBranch) rcvHeaders(headers []*ty.BtcHeader) (*types.Transaction, *types.ReceiptData, error) {
+	return s.rcvCoinHeaders("", headers)
+}
+
+func (s *suiteBtcBranch) rcvCoinHeaders(coin string, headers []*ty.BtcHeader) (*types.Transaction, *types.ReceiptData, error) {
+	action := &ty.RelayAction{
+		Ty:    ty.RelayActionRcvBTCHeaders,
+		Value: &ty.RelayAction_BtcHeaders{BtcHeaders: &ty.BtcHeaders{BtcHeader: headers, Coin: coin}},
+	}
+	tx := &types.Transaction{Execer: []byte(ty.RelayX), To: address.ExecAddress(ty.RelayX), Payload: types.Encode(action)}
+	tx.Sign(types.SECP256K1, privFrom)
+	receipt, err := s.relay.Exec(tx, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, kv := range receipt.KV {
+		s.stateDB.Set(kv.Key, kv.Value)
+	}
+	data := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := s.relay.ExecLocal(tx, data, 0)
+	s.Nil(err)
+	s.setLocal(set)
+	return tx, data, nil
+}
+
+func (s *suiteBtcBranch) setLocal(set *types.LocalDBSet) {
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			s.localDB.Delete(kv.Key)
+			continue
+		}
+		s.localDB.Set(kv.Key, kv.Value)
+	}
+}
+
+func (s *suiteBtcBranch) checkBestChain(tip *ty.BtcHeader, headers ...*ty.BtcHeader) {
+	msg, err := s.relay.Query_GetBTCHeaderCurHeight(&ty.ReqRelayQryBTCHeadHeight{})
+	s.Nil(err)
+	cur := msg.(*ty.ReplayRelayQryBTCHeadHeight)
+	s.Equal(int64(tip.Height), cur.CurHeight)
+	s.Equal(tip.Hash, cur.CurHash)
+	for _, head := range headers {
+		msg, err := s.relay.Query_GetBTCHeader(&ty.ReqRelayBtcHeader{Height: int64(head.Height)})
+		s.Nil(err)
+		s.Equal(head.Hash, msg.(*ty.BtcHeader).Hash)
+	}
+}
+
+func (s *suiteBtcBranch) TestReorg() {
+	base := &ty.BtcHeader{Height: 9, Time: 1530862108, Hash: "604efe53975ab06cad8748fd703ad5bc960e8b752b2aae98f0f871a4a05abfc7"}
+	txA := "6359f0868171b1d194cbee1af2f16ea598ae8fad666d9b012c8ed2b79a236ec4"
+	txB := "8c14f0db3df150123e6f3dbbf30f8b955a8249b62ac1d1ff16284aefa3d06d87"
+	mainChain := mineBtcBranch(base, txA, txA, txA)
+	_, _, err := s.rcvHeaders(mainChain)
+	s.Nil(err)
+	s.checkBestChain(mainChain[2], mainChain...)
+	msg, err := s.relay.Query_GetBTCHeader(&ty.ReqRelayBtcHeader{Hash: mainChain[2].Hash})
+	s.Nil(err)
+	s.Equal("6", msg.(*ty.BtcHeader).ChainWork)
+
+	//side branch with less work only saved by hash
+	side := mineBtcBranch(mainChain[0], txB, txB, txB)
+	_, _, err = s.rcvHeaders(side[:1])
+	s.Nil(err)
+	s.checkBestChain(mainChain[2], mainChain...)
+	msg, err = s.relay.Query_GetBTCHeader(&ty.ReqRelayBtcHeader{Hash: side[0].Hash})
+	s.Nil(err)
+	s.Equal("4", msg.(*ty.BtcHeader).ChainWork)
+
+	_, _, err = s.rcvHeaders(side[:1])
+	s.Equal(ty.ErrRelayBtcHeadExist, err)
+	_, _, err = s.rcvHeaders(mineBtcBranch(&ty.BtcHeader{Height: 11, Hash: txB}, txB))
+	s.Equal(ty.ErrRelayBtcHeadSequenceErr, err)
+
+	//the branch with more work switch to best chain
+	tx, data, err := s.rcvHeaders(side[1:])
+	s.Nil(err)
+	s.checkBestChain(side[2], mainChain[0], side[0], side[1], side[2])
+	var log ty.ReceiptRelayRcvBTCHeaders
+	s.Nil(types.Decode(data.Logs[0].Log, &log))
+	s.Equal(3, len(log.AttachHeaders))
+	s.Equal(2, len(log.DetachHeaders))
+	s.Equal(mainChain[2].Height, log.LastHeight)
+	s.Equal(side[2].Height, log.NewHeight)
+
+	//tx in the orphaned block
+	btc := newBtcStore(s.relay.GetLocalDB())
+	order := &ty.RelayOrder{XAddr: "1Am9UTGfdnxabvcywYG2hvzr6qK8T3oUZT", XAmount: 100, AcceptTime: 100, ConfirmTime: 200, XBlockWaits: 1}
+	verify := func(head *ty.BtcHeader, txHash string) error {
+		return btc.verifyBtcTxInBestChain(&ty.RelayVerify{
+			Tx: &ty.BtcTransaction{
+				Vout: []*ty.Vout{{Address: order.XAddr, Value: order.XAmount}},
+				Time: 150,
+				Hash: txHash,
+			},
+			Spv: &ty.BtcSpv{Hash: txHash, BlockHash: head.Hash, Height: head.Height},
+		}, order)
+	}
+	s.Equal(ty.ErrRelayBtcTxNotInBestChain, verify(mainChain[1], txA))
+	s.Nil(verify(side[1], txB))
+	s.Equal(ty.ErrRelayVerify, verify(side[1], txA))
+	s.Equal(ty.ErrRelayWaitBlocksErr, verify(side[2], txB))
+
+	//rollback restore the old best chain
+	set, err := s.relay.ExecDelLocal(tx, data, 0)
+	s.Nil(err)
+	s.setLocal(set)
+	s.checkBestChain(mainChain[2], mainChain...)
+	_, err = btc.getBtcHeadByHash(side[2].Hash)
+	s.Equal(types.ErrNotFound, err)
+	_, err = btc.getBtcHeadByHash(side[0].Hash)
+	s.Nil(err)
+}
+
+func (s *suiteBtcBranch) TestCoinParams() {
+	ltcParams := *ty.BtcRegTestParams
+	ltcParams.Name = "LTC-regtest"
+	ltcParams.Coin = "LTC"
+	ltcParams.ScryptPow = true
+	ltcParams.Bech32HRPSegwit = "rltc"
+	ty.RegisterChainParams(&ltcParams)
+
+	base := &ty.BtcHeader{Height: 9, Time: 1530862108, Hash: "604efe53975ab06cad8748fd703ad5bc960e8b752b2aae98f0f871a4a05abfc7"}
+	txA := "6359f0868171b1d194cbee1af2f16ea598ae8fad666d9b012c8ed2b79a236ec4"
+	ltcChain := []*ty.BtcHeader{mineCoinHeader(&ltcParams, base, txA)}
+	ltcChain = append(ltcChain, mineCoinHeader(&ltcParams, ltcChain[0], txA))
+	_, _, err := s.rcvCoinHeaders("LTC", ltcChain)
+	s.Equal(ty.ErrRelayCoinNotSupport, errors.Cause(err))
+
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(),
+		"[exec.sub.relay]", "[exec.sub.relay]\nchainParams=[\"BTC-regtest\",\"LTC-regtest\"]", 1))
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	s.relay.SetAPI(api)
+
+	//the sha256 pow not enough for scrypt chain
+	btcChain := mineBtcBranch(base, txA, txA)
+	for _, head := range btcChain {
+		h, _ := btcWireHeader(head)
+		pow, _ := ltcParams.PowHash(h)
+		if difficulty.HashToBig(pow[:]).Cmp(difficulty.CompactToBig(uint32(head.Bits))) > 0 {
+			_, _, err = s.rcvCoinHeaders("LTC", []*ty.BtcHeader{head})
+			s.Equal(ty.ErrRelayBtcHeadBitsErr, err)
+			break
+		}
+	}
+
+	_, _, err = s.rcvCoinHeaders("LTC", ltcChain)
+	s.Nil(err)
+	_, _, err = s.rcvHeaders(btcChain[:1])
+	s.Nil(err)
+
+	//the coins' headers saved separately
+	msg, err := s.relay.Query_GetBTCHeaderCurHeight(&ty.ReqRelayQryBTCHeadHeight{Coin: "LTC"})
+	s.Nil(err)
+	s.Equal(ltcChain[1].Hash, msg.(*ty.ReplayRelayQryBTCHeadHeight).CurHash)
+	s.checkBestChain(btcChain[0], btcChain[0])
+	_, err = s.relay.Query_GetBTCHeader(&ty.ReqRelayBtcHeader{Hash: ltcChain[0].Hash})
+	s.Equal(types.ErrNotFound, err)
+	msg, err = s.relay.Query_GetBTCHeaderList(&ty.ReqRelayBtcHeaderHeightList{Coin: "LTC", Counts: 10})
+	s.Nil(err)
+	s.Equal([]int64{11, 10}, msg.(*ty.ReplyRelayBtcHeadHeightList).Heights)
+	lastHead, err := getBtcLastHead(s.stateDB, "LTC")
+	s.Nil(err)
+	s.Equal(ltcChain[1].Hash, lastHead.Header.Hash)
+
+	tx := &types.Transaction{Execer: []byte(ty.RelayX)}
+	tx.Sign(types.SECP256K1, privFrom)
+	action := newRelayDB(s.relay, tx)
+	s.Nil(action.checkCoinAddr("LTC", "mwh4zfX6aDhKoG3WSfNwS6fu8VcRQnbSYp"))
+	s.Equal(ty.ErrRelayCoinAddrErr, errors.Cause(action.checkCoinAddr("LTC", addrBtc)))
+	s.Equal(ty.ErrRelayCoinNotSupport, errors.Cause(action.checkCoinAddr("BCH", "")))
+	height, err := action.coinStore("LTC").getLastBtcHeadHeight()
+	s.Nil(err)
+	s.Equal(int64(ltcChain[1].Height), height)
+}
+
+func TestRunSuiteBtcBranch(t *testing.T) {
+	log := new(suiteBtcBranch)
+	suite.Run(t, log)
+}
@@ -0,0 +1,320 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/mr-tron/base58/base58"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+// ChainParams the rules of an utxo chain to verify the block headers and addresses relayed
+type ChainParams struct {
+	// Name coin-network, the key of params registry, such as BTC-mainnet
+	Name string
+	// Coin the relay order XCoin the params used for
+	Coin string
+	// Net the network magic the relayd node should be in
+	Net wire.BitcoinNet
+	// GenesisHash the header at height 0 must be genesis
+	GenesisHash string
+
+	// PowLimit the highest target a block can have
+	PowLimit *big.Int
+	// PowLimitBits the compact PowLimit, 0 means the target is not checked with PowLimit
+	PowLimitBits uint32
+	// ScryptPow the pow hash is scrypt(N=1024,r=1,p=1) of header, otherwise the block hash
+	ScryptPow bool
+
+	TargetTimespan           time.Duration
+	TargetTimePerBlock       time.Duration
+	RetargetAdjustmentFactor int64
+	// ReduceMinDifficulty the block can have PowLimitBits if no block mined after MinDiffReductionTime
+	ReduceMinDifficulty  bool
+	MinDiffReductionTime time.Duration
+	// NoRetargeting the bits never changes
+	NoRetargeting bool
+	// RetargetFullWindow the retarget timespan counts the full window blocks like litecoin,
+	// bitcoin counts one block less
+	RetargetFullWindow bool
+	// NoBitsCheck the bits is not calculated, only the pow is verified with the header's bits and PowLimit,
+	// used by the chain with per block difficulty adjustment such as BCH
+	NoBitsCheck bool
+	// CheckBitsHeight the bits of the headers not higher than the height are not calculated
+	CheckBitsHeight uint64
+
+	PubKeyHashAddrID byte
+	ScriptHashAddrID byte
+	// Bech32HRPSegwit the segwit address prefix, empty if not supported
+	Bech32HRPSegwit string
+	// CashAddrPrefix the BCH cash address prefix, empty if not supported
+	CashAddrPrefix string
+}
+
+// BlocksPerRetarget the blocks between difficulty retargets
+func (p *ChainParams) BlocksPerRetarget() uint64 {
+	return uint64(p.TargetTimespan / p.TargetTimePerBlock)
+}
+
+// PowHash the hash to compare with target
+func (p *ChainParams) PowHash(header *wire.BlockHeader) (chainhash.Hash, error) {
+	if !p.ScryptPow {
+		return header.BlockHash(), nil
+	}
+
+	var buf bytes.Buffer
+	err := header.Serialize(&buf)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	key, err := scrypt.Key(buf.Bytes(), buf.Bytes(), 1024, 1, 1, chainhash.HashSize)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	var hash chainhash.Hash
+	copy(hash[:], key)
+	return hash, nil
+}
+
+// CheckAddress check the address is the base58 p2pkh/p2sh, segwit or cash address of the chain
+func (p *ChainParams) CheckAddress(addr string) error {
+	if addr == "" {
+		return errors.Wrap(ErrRelayCoinAddrErr, "empty addr")
+	}
+	if p.checkBase58Addr(addr) {
+		return nil
+	}
+	if p.Bech32HRPSegwit != "" && checkBech32Addr(p.Bech32HRPSegwit, addr) {
+		return nil
+	}
+	if p.CashAddrPrefix != "" && checkCashAddr(p.CashAddrPrefix, addr) {
+		return nil
+	}
+	return errors.Wrapf(ErrRelayCoinAddrErr, "addr %s not %s address", addr, p.Name)
+}
+
+func (p *ChainParams) checkBase58Addr(addr string) bool {
+	data, err := base58.Decode(addr)
+	if err != nil || len(data) != 1+20+4 {
+		return false
+	}
+	if data[0] != p.PubKeyHashAddrID && data[0] != p.ScriptHashAddrID {
+		return false
+	}
+	first := sha256.Sum256(data[:21])
+	second := sha256.Sum256(first[:])
+	return bytes.Equal(second[:4], data[21:])
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+func decodeBase32(data string) ([]byte, bool) {
+	values := make([]byte, len(data))
+	for i := range data {
+		idx := strings.IndexByte(bech32Charset, data[i])
+		if idx < 0 {
+			return nil, false
+		}
+		values[i] = byte(idx)
+	}
+	return values, true
+}
+
+func bech32Polymod(values []byte) uint32 {
+	gen := []uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := 0; i < 5; i++ {
+			if (b>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
+
+// segwit v0 address uses bech32 checksum, v1+ uses bech32m
+func checkBech32Addr(hrp, addr string) bool {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return false
+	}
+	addr = strings.ToLower(addr)
+	pos := strings.LastIndexByte(addr, '1')
+	if pos < 1 || addr[:pos] != hrp || len(addr)-pos-1 < 7 || len(addr) > 90 {
+		return false
+	}
+	data, ok := decodeBase32(addr[pos+1:])
+	if !ok || data[0] > 16 {
+		return false
+	}
+
+	var values []byte
+	for i := range hrp {
+		values = append(values, hrp[i]>>5)
+	}
+	values = append(values, 0)
+	for i := range hrp {
+		values = append(values, hrp[i]&31)
+	}
+	values = append(values, data...)
+	chk := bech32Polymod(values)
+	if data[0] == 0 {
+		return chk == 1
+	}
+	return chk == 0x2bc830a3
+}
+
+func cashAddrPolymod(values []byte) uint64 {
+	gen := []uint64{0x98f2bc8e61, 0x79b76d99e2, 0xf33e5fb3c4, 0xae2eabe2a8, 0x1e4f43e470}
+	chk := uint64(1)
+	for _, v := range values {
+		b := chk >> 35
+		chk = (chk&0x07ffffffff)<<5 ^ uint64(v)
+		for i := 0; i < 5; i++ {
+			if (b>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk ^ 1
+}
+
+// the prefix can be omitted in cash address
+func checkCashAddr(prefix, addr string) bool {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return false
+	}
+	addr = strings.ToLower(addr)
+	if pos := strings.IndexByte(addr, ':'); pos >= 0 {
+		if addr[:pos] != prefix {
+			return false
+		}
+		addr = addr[pos+1:]
+	}
+	//160 bits hash with version byte and 40 bits checksum
+	if len(addr) != 42 {
+		return false
+	}
+	data, ok := decodeBase32(addr)
+	if !ok {
+		return false
+	}
+
+	var values []byte
+	for i := range prefix {
+		values = append(values, prefix[i]&31)
+	}
+	values = append(values, 0)
+	values = append(values, data...)
+	return cashAddrPolymod(values) == 0
+}
+
+var chainParams = make(map[string]*ChainParams)
+
+// RegisterChainParams register the params by name, the registered one would be replaced
+func RegisterChainParams(params *ChainParams) {
+	chainParams[params.Name] = params
+}
+
+// GetChainParams get the registered params by name
+func GetChainParams(name string) (*ChainParams, error) {
+	params, ok := chainParams[name]
+	if !ok {
+		return nil, errors.Wrapf(ErrRelayChainParamsNotFound, "name=%s", name)
+	}
+	return params, nil
+}
+
+func fromBtcdParams(name string, params *chaincfg.Params) *ChainParams {
+	return &ChainParams{
+		Name:                     name,
+		Coin:                     "BTC",
+		Net:                      params.Net,
+		GenesisHash:              params.GenesisHash.String(),
+		PowLimit:                 params.PowLimit,
+		PowLimitBits:             params.PowLimitBits,
+		TargetTimespan:           params.TargetTimespan,
+		TargetTimePerBlock:       params.TargetTimePerBlock,
+		RetargetAdjustmentFactor: params.RetargetAdjustmentFactor,
+		ReduceMinDifficulty:      params.ReduceMinDifficulty,
+		MinDiffReductionTime:     params.MinDiffReductionTime,
+		PubKeyHashAddrID:         params.PubKeyHashAddrID,
+		ScriptHashAddrID:         params.ScriptHashAddrID,
+		Bech32HRPSegwit:          params.Bech32HRPSegwit,
+	}
+}
+
+func newPowLimit(bits uint) *big.Int {
+	bigOne := big.NewInt(1)
+	return new(big.Int).Sub(new(big.Int).Lsh(bigOne, bits), bigOne)
+}
+
+var (
+	// BtcMainNetParams bitcoin main network
+	BtcMainNetParams = fromBtcdParams("BTC-mainnet", &chaincfg.MainNetParams)
+	// BtcTestNet3Params bitcoin test network 3
+	BtcTestNet3Params = fromBtcdParams("BTC-testnet3", &chaincfg.TestNet3Params)
+	// BtcRegTestParams bitcoin regression test network, the bits always be PowLimitBits
+	BtcRegTestParams = func() *ChainParams {
+		params := fromBtcdParams("BTC-regtest", &chaincfg.RegressionNetParams)
+		params.NoRetargeting = true
+		return params
+	}()
+
+	// LtcMainNetParams litecoin main network
+	LtcMainNetParams = &ChainParams{
+		Name:                     "LTC-mainnet",
+		Coin:                     "LTC",
+		Net:                      wire.BitcoinNet(0xdbb6c0fb),
+		GenesisHash:              "12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2",
+		PowLimit:                 newPowLimit(236),
+		PowLimitBits:             0x1e0fffff,
+		ScryptPow:                true,
+		TargetTimespan:           time.Hour * 84,    // 3.5 days
+		TargetTimePerBlock:       time.Second * 150, // 2.5 minutes
+		RetargetAdjustmentFactor: 4,                 // 25% less, 400% more
+		RetargetFullWindow:       true,
+		PubKeyHashAddrID:         0x30,
+		ScriptHashAddrID:         0x32,
+		Bech32HRPSegwit:          "ltc",
+	}
+
+	// BchMainNetParams bitcoin cash main network, the difficulty adjustment algorithm is not verified,
+	// the headers' bits rely on the relayer
+	BchMainNetParams = &ChainParams{
+		Name:                     "BCH-mainnet",
+		Coin:                     "BCH",
+		Net:                      wire.BitcoinNet(0xe8f3e1e3),
+		GenesisHash:              chaincfg.MainNetParams.GenesisHash.String(),
+		PowLimit:                 chaincfg.MainNetParams.PowLimit,
+		PowLimitBits:             chaincfg.MainNetParams.PowLimitBits,
+		TargetTimespan:           time.Hour * 24 * 14, // 14 days
+		TargetTimePerBlock:       time.Minute * 10,    // 10 minutes
+		RetargetAdjustmentFactor: 4,                   // 25% less, 400% more
+		NoBitsCheck:              true,
+		PubKeyHashAddrID:         0x00,
+		ScriptHashAddrID:         0x05,
+		CashAddrPrefix:           "bitcoincash",
+	}
+)
+
+func init() {
+	RegisterChainParams(BtcMainNetParams)
+	RegisterChainParams(BtcTestNet3Params)
+	RegisterChainParams(BtcRegTestParams)
+	RegisterChainParams(LtcMainNetParams)
+	RegisterChainParams(BchMainNetParams)
+}
@@ -0,0 +1,110 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetChainParams(t *testing.T) {
+	for _, name := range []string{"BTC-mainnet", "BTC-testnet3", "BTC-regtest", "LTC-mainnet", "BCH-mainnet"} {
+		params, err := GetChainParams(name)
+		assert.Nil(t, err)
+		assert.Equal(t, name, params.Name)
+		assert.Equal(t, uint64(2016), params.BlocksPerRetarget())
+	}
+	_, err := GetChainParams("DOGE-mainnet")
+	assert.Equal(t, ErrRelayChainParamsNotFound, errors.Cause(err))
+
+	assert.Equal(t, "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f", BtcMainNetParams.GenesisHash)
+	assert.Equal(t, uint32(0x207fffff), BtcRegTestParams.PowLimitBits)
+	assert.True(t, BtcRegTestParams.NoRetargeting)
+	assert.True(t, BtcTestNet3Params.ReduceMinDifficulty)
+}
+
+func TestCheckAddress(t *testing.T) {
+	valid := map[*ChainParams][]string{
+		BtcMainNetParams: {
+			"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4",
+			"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0",
+		},
+		BtcTestNet3Params: {"mwh4zfX6aDhKoG3WSfNwS6fu8VcRQnbSYp", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+		LtcMainNetParams:  {"LbQ4xpjwqrW8GxG3uEPrtCXLUiNzemdq2N", "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9"},
+		BchMainNetParams: {
+			"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+			"qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+			"1BpEi6DfDAUFd7GtittLSdBeYJvcoaVggu",
+		},
+	}
+	for params, addrs := range valid {
+		for _, addr := range addrs {
+			assert.Nil(t, params.CheckAddress(addr), addr)
+		}
+	}
+
+	invalid := map[*ChainParams][]string{
+		BtcMainNetParams: {
+			"",
+			"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb",
+			"mwh4zfX6aDhKoG3WSfNwS6fu8VcRQnbSYp",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
+			"bc1qw508D6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+			"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		},
+		BtcRegTestParams: {"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx"},
+		LtcMainNetParams: {"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		BchMainNetParams: {
+			"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b",
+			"bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+			"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		},
+	}
+	for params, addrs := range invalid {
+		for _, addr := range addrs {
+			assert.Equal(t, ErrRelayCoinAddrErr, errors.Cause(params.CheckAddress(addr)), addr)
+		}
+	}
+}
+
+func TestPowHash(t *testing.T) {
+	merkleRoot, err := chainhash.NewHashFromStr("97ddfbbae6be97fd6cdf3e7ca13232a3afff2353e29badfab7f73011edd4ced9")
+	assert.Nil(t, err)
+	genesis := &wire.BlockHeader{
+		Version:    1,
+		MerkleRoot: *merkleRoot,
+		Timestamp:  time.Unix(1317972665, 0),
+		Bits:       0x1e0ffff0,
+		Nonce:      2084524493,
+	}
+	hash := genesis.BlockHash()
+	assert.Equal(t, LtcMainNetParams.GenesisHash, hash.String())
+
+	pow, err := LtcMainNetParams.PowHash(genesis)
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, pow)
+	target := new(big.Int).Lsh(big.NewInt(0x0ffff0), 8*(0x1e-3))
+	assert.True(t, hashToBig(pow).Cmp(target) <= 0)
+	assert.True(t, target.Cmp(LtcMainNetParams.PowLimit) <= 0)
+
+	pow, err = BtcMainNetParams.PowHash(genesis)
+	assert.Nil(t, err)
+	assert.Equal(t, hash, pow)
+}
+
+func hashToBig(hash chainhash.Hash) *big.Int {
+	for i := 0; i < chainhash.HashSize/2; i++ {
+		hash[i], hash[chainhash.HashSize-1-i] = hash[chainhash.HashSize-1-i], hash[i]
+	}
+	return new(big.Int).SetBytes(hash[:])
+}
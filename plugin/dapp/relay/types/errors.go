@@ -43,4 +43,16 @@ var (
 	ErrRelayBtcHeadBitsErr = errors.New("ErrRelayBtcHeadBitsErr")
 	// ErrRelayBtcHeadNewBitsErr calc btc header new bits error
 	ErrRelayBtcHeadNewBitsErr = errors.New("ErrRelayBtcHeadNewBitsErr")
+	// ErrRelayBtcHeadExist btc header has been saved
+	ErrRelayBtcHeadExist = errors.New("ErrRelayBtcHeadExist")
+	// ErrRelayBtcReorgTooDeep btc header branch fork point too deep than best chain
+	ErrRelayBtcReorgTooDeep = errors.New("ErrRelayBtcReorgTooDeep")
+	// ErrRelayBtcTxNotInBestChain btc tx block not in best chain
+	ErrRelayBtcTxNotInBestChain = errors.New("ErrRelayBtcTxNotInBestChain")
+	// ErrRelayChainParamsNotFound chain params not registered
+	ErrRelayChainParamsNotFound = errors.New("ErrRelayChainParamsNotFound")
+	// ErrRelayCoinNotSupport coin chain params not configured
+	ErrRelayCoinNotSupport = errors.New("ErrRelayCoinNotSupport")
+	// ErrRelayCoinAddrErr coin addr not valid for the chain
+	ErrRelayCoinAddrErr = errors.New("ErrRelayCoinAddrErr")
 )
@@ -26,6 +26,12 @@ const (
 	TyLogRelayRcvBTCHead   = 356
 )
 
+// ForkRelayBtcReorg save btc headers by hash with chain work, the heaviest branch is the best chain
+const ForkRelayBtcReorg = "ForkRelayBtcReorg"
+
+// ForkRelayChainParams verify the headers and addresses by the chain params of order coin
+const ForkRelayChainParams = "ForkRelayChainParams"
+
 // relay
 const (
 	// RelayRevokeCreate revoke created order
@@ -57,6 +63,8 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(RelayX, "Enable", 570000)
+	cfg.RegisterDappFork(RelayX, ForkRelayBtcReorg, types.MaxHeight)
+	cfg.RegisterDappFork(RelayX, ForkRelayChainParams, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -804,18 +804,20 @@ func (m *RelayVerifyCli) GetBlockHash() string {
 }
 
 type BtcHeader struct {
-	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Confirmations        uint64   `protobuf:"varint,2,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
-	Height               uint64   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
-	Version              uint32   `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	MerkleRoot           string   `protobuf:"bytes,5,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
-	Time                 int64    `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
-	Nonce                uint64   `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Bits                 int64    `protobuf:"varint,8,opt,name=bits,proto3" json:"bits,omitempty"`
-	Difficulty           int64    `protobuf:"varint,9,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	PreviousHash         string   `protobuf:"bytes,10,opt,name=previousHash,proto3" json:"previousHash,omitempty"`
-	NextHash             string   `protobuf:"bytes,11,opt,name=nextHash,proto3" json:"nextHash,omitempty"`
-	IsReset              bool     `protobuf:"varint,12,opt,name=isReset,proto3" json:"isReset,omitempty"`
+	Hash          string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Confirmations uint64 `protobuf:"varint,2,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Height        uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Version       uint32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	MerkleRoot    string `protobuf:"bytes,5,opt,name=merkleRoot,proto3" json:"merkleRoot,omitempty"`
+	Time          int64  `protobuf:"varint,6,opt,name=time,proto3" json:"time,omitempty"`
+	Nonce         uint64 `protobuf:"varint,7,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Bits          int64  `protobuf:"varint,8,opt,name=bits,proto3" json:"bits,omitempty"`
+	Difficulty    int64  `protobuf:"varint,9,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	PreviousHash  string `protobuf:"bytes,10,opt,name=previousHash,proto3" json:"previousHash,omitempty"`
+	NextHash      string `protobuf:"bytes,11,opt,name=nextHash,proto3" json:"nextHash,omitempty"`
+	IsReset       bool   `protobuf:"varint,12,opt,name=isReset,proto3" json:"isReset,omitempty"`
+	// head (may not from 1)
+	ChainWork            string   `protobuf:"bytes,13,opt,name=chainWork,proto3" json:"chainWork,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -930,8 +932,16 @@ func (m *BtcHeader) GetIsReset() bool {
 	return false
 }
 
+func (m *BtcHeader) GetChainWork() string {
+	if m != nil {
+		return m.ChainWork
+	}
+	return ""
+}
+
 type BtcHeaders struct {
 	BtcHeader            []*BtcHeader `protobuf:"bytes,1,rep,name=btcHeader,proto3" json:"btcHeader,omitempty"`
+	Coin                 string       `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -969,6 +979,13 @@ func (m *BtcHeaders) GetBtcHeader() []*BtcHeader {
 	return nil
 }
 
+func (m *BtcHeaders) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
 type BtcTransaction struct {
 	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	BlockHeight          uint64   `protobuf:"varint,2,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
@@ -1282,6 +1299,9 @@ type ReceiptRelayRcvBTCHeaders struct {
 	NewHeight            uint64       `protobuf:"varint,3,opt,name=newHeight,proto3" json:"newHeight,omitempty"`
 	LastBaseHeight       uint64       `protobuf:"varint,4,opt,name=lastBaseHeight,proto3" json:"lastBaseHeight,omitempty"`
 	NewBaseHeight        uint64       `protobuf:"varint,5,opt,name=newBaseHeight,proto3" json:"newBaseHeight,omitempty"`
+	AttachHeaders        []*BtcHeader `protobuf:"bytes,6,rep,name=attachHeaders,proto3" json:"attachHeaders,omitempty"`
+	DetachHeaders        []*BtcHeader `protobuf:"bytes,7,rep,name=detachHeaders,proto3" json:"detachHeaders,omitempty"`
+	Coin                 string       `protobuf:"bytes,8,opt,name=coin,proto3" json:"coin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -1347,6 +1367,27 @@ func (m *ReceiptRelayRcvBTCHeaders) GetNewBaseHeight() uint64 {
 	return 0
 }
 
+func (m *ReceiptRelayRcvBTCHeaders) GetAttachHeaders() []*BtcHeader {
+	if m != nil {
+		return m.AttachHeaders
+	}
+	return nil
+}
+
+func (m *ReceiptRelayRcvBTCHeaders) GetDetachHeaders() []*BtcHeader {
+	if m != nil {
+		return m.DetachHeaders
+	}
+	return nil
+}
+
+func (m *ReceiptRelayRcvBTCHeaders) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
 type ReceiptRelayLog struct {
 	OrderId              string   `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
 	CurStatus            string   `protobuf:"bytes,2,opt,name=curStatus,proto3" json:"curStatus,omitempty"`
@@ -1738,6 +1779,7 @@ type ReqRelayBtcHeaderHeightList struct {
 	ReqHeight            int64    `protobuf:"varint,1,opt,name=reqHeight,proto3" json:"reqHeight,omitempty"`
 	Counts               int32    `protobuf:"varint,2,opt,name=counts,proto3" json:"counts,omitempty"`
 	Direction            int32    `protobuf:"varint,3,opt,name=direction,proto3" json:"direction,omitempty"`
+	Coin                 string   `protobuf:"bytes,4,opt,name=coin,proto3" json:"coin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1789,6 +1831,13 @@ func (m *ReqRelayBtcHeaderHeightList) GetDirection() int32 {
 	return 0
 }
 
+func (m *ReqRelayBtcHeaderHeightList) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
 type ReplyRelayBtcHeadHeightList struct {
 	Heights              []int64  `protobuf:"varint,1,rep,packed,name=heights,proto3" json:"heights,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1830,6 +1879,7 @@ func (m *ReplyRelayBtcHeadHeightList) GetHeights() []int64 {
 
 type ReqRelayQryBTCHeadHeight struct {
 	BaseHeight           int64    `protobuf:"varint,1,opt,name=baseHeight,proto3" json:"baseHeight,omitempty"`
+	Coin                 string   `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1867,9 +1917,18 @@ func (m *ReqRelayQryBTCHeadHeight) GetBaseHeight() int64 {
 	return 0
 }
 
+func (m *ReqRelayQryBTCHeadHeight) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
 type ReplayRelayQryBTCHeadHeight struct {
-	CurHeight            int64    `protobuf:"varint,1,opt,name=curHeight,proto3" json:"curHeight,omitempty"`
-	BaseHeight           int64    `protobuf:"varint,2,opt,name=baseHeight,proto3" json:"baseHeight,omitempty"`
+	CurHeight  int64 `protobuf:"varint,1,opt,name=curHeight,proto3" json:"curHeight,omitempty"`
+	BaseHeight int64 `protobuf:"varint,2,opt,name=baseHeight,proto3" json:"baseHeight,omitempty"`
+	// (base height can be change)
+	CurHash              string   `protobuf:"bytes,3,opt,name=curHash,proto3" json:"curHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1914,6 +1973,68 @@ func (m *ReplayRelayQryBTCHeadHeight) GetBaseHeight() int64 {
 	return 0
 }
 
+func (m *ReplayRelayQryBTCHeadHeight) GetCurHash() string {
+	if m != nil {
+		return m.CurHash
+	}
+	return ""
+}
+
+type ReqRelayBtcHeader struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Coin                 string   `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqRelayBtcHeader) Reset()         { *m = ReqRelayBtcHeader{} }
+func (m *ReqRelayBtcHeader) String() string { return proto.CompactTextString(m) }
+func (*ReqRelayBtcHeader) ProtoMessage()    {}
+func (*ReqRelayBtcHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9f69a7d5a802d584, []int{25}
+}
+
+func (m *ReqRelayBtcHeader) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRelayBtcHeader.Unmarshal(m, b)
+}
+func (m *ReqRelayBtcHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRelayBtcHeader.Marshal(b, m, deterministic)
+}
+func (m *ReqRelayBtcHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRelayBtcHeader.Merge(m, src)
+}
+func (m *ReqRelayBtcHeader) XXX_Size() int {
+	return xxx_messageInfo_ReqRelayBtcHeader.Size(m)
+}
+func (m *ReqRelayBtcHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRelayBtcHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRelayBtcHeader proto.InternalMessageInfo
+
+func (m *ReqRelayBtcHeader) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ReqRelayBtcHeader) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqRelayBtcHeader) GetCoin() string {
+	if m != nil {
+		return m.Coin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("types.RelayOrderStatus", RelayOrderStatus_name, RelayOrderStatus_value)
 	proto.RegisterType((*RelayAction)(nil), "types.RelayAction")
@@ -1941,6 +2062,7 @@ func init() {
 	proto.RegisterType((*ReplyRelayBtcHeadHeightList)(nil), "types.ReplyRelayBtcHeadHeightList")
 	proto.RegisterType((*ReqRelayQryBTCHeadHeight)(nil), "types.ReqRelayQryBTCHeadHeight")
 	proto.RegisterType((*ReplayRelayQryBTCHeadHeight)(nil), "types.ReplayRelayQryBTCHeadHeight")
+	proto.RegisterType((*ReqRelayBtcHeader)(nil), "types.ReqRelayBtcHeader")
 }
 
 func init() {
@@ -1948,101 +2070,106 @@ func init() {
 }
 
 var fileDescriptor_9f69a7d5a802d584 = []byte{
-	// 1524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xc1, 0x6e, 0xdb, 0x46,
-	0x13, 0x36, 0x25, 0x91, 0x16, 0x47, 0xb6, 0x23, 0x6f, 0xec, 0xfc, 0xfc, 0xdb, 0xa0, 0x31, 0x88,
-	0xb6, 0x70, 0x83, 0xc2, 0x05, 0x12, 0x04, 0x05, 0x8a, 0x5e, 0x2c, 0xa3, 0xa8, 0x02, 0x04, 0x4d,
-	0xb2, 0x36, 0x9c, 0x02, 0x3d, 0x14, 0x34, 0xb9, 0xb6, 0x89, 0xc8, 0xa4, 0xb2, 0x5c, 0x29, 0x52,
-	0x6f, 0x3d, 0x17, 0xbd, 0xf7, 0xdc, 0x63, 0x9f, 0xa0, 0xb7, 0x3e, 0x42, 0x0f, 0x7d, 0xa1, 0x62,
-	0x66, 0x97, 0xe4, 0x52, 0x96, 0x1d, 0xb5, 0xd7, 0xde, 0x38, 0x33, 0xdf, 0xee, 0xcc, 0xce, 0xce,
-	0x7c, 0x3b, 0x12, 0xf4, 0xa4, 0x18, 0x45, 0xf3, 0x83, 0xb1, 0xcc, 0x55, 0xce, 0x5c, 0x35, 0x1f,
-	0x8b, 0x22, 0xfc, 0xb1, 0x0d, 0x3d, 0x8e, 0xea, 0xc3, 0x58, 0xa5, 0x79, 0xc6, 0x3e, 0x05, 0x2f,
-	0x96, 0x22, 0x52, 0x22, 0x70, 0xf6, 0x9c, 0xfd, 0xde, 0x23, 0x76, 0x40, 0xb8, 0x03, 0xc2, 0x1c,
-	0x91, 0x65, 0xb8, 0xc6, 0x0d, 0x06, 0xd1, 0x51, 0x1c, 0x8b, 0xb1, 0x0a, 0x5a, 0xd7, 0xd1, 0x87,
-	0x64, 0x41, 0xb4, 0xc6, 0x20, 0x5a, 0x8a, 0x69, 0xfe, 0x5a, 0x04, 0xed, 0xeb, 0x68, 0x4e, 0x16,
-	0x44, 0x6b, 0x0c, 0x7b, 0x02, 0x7e, 0x9c, 0x67, 0xe7, 0xa9, 0xbc, 0x3a, 0x99, 0x05, 0x1d, 0x5a,
-	0xb0, 0xdb, 0x08, 0xa6, 0x34, 0x0e, 0xd7, 0x78, 0x8d, 0x44, 0x27, 0x53, 0x21, 0xd3, 0xf3, 0x79,
-	0xe0, 0x5e, 0x77, 0x72, 0x4a, 0x16, 0x74, 0xa2, 0x31, 0xe8, 0x44, 0x7f, 0x1d, 0x8d, 0xd2, 0xc0,
-	0xbb, 0xee, 0xe4, 0xb4, 0x34, 0xa2, 0x93, 0x0a, 0xc9, 0x1e, 0x03, 0x9c, 0xa9, 0x78, 0x28, 0xa2,
-	0x44, 0xc8, 0x22, 0x58, 0xa7, 0x75, 0xdb, 0x66, 0xdd, 0xa0, 0x32, 0x0c, 0xd7, 0xb8, 0x05, 0x63,
-	0x5b, 0xd0, 0x52, 0xf3, 0x00, 0xf6, 0x9c, 0x7d, 0x97, 0xb7, 0xd4, 0x7c, 0xb0, 0x0e, 0xee, 0x34,
-	0x1a, 0x4d, 0x44, 0xf8, 0xbb, 0x0b, 0x40, 0xde, 0x9e, 0xcb, 0x44, 0x48, 0xc4, 0xa5, 0x09, 0xa5,
-	0xdf, 0xe7, 0xad, 0x34, 0x61, 0x9f, 0x81, 0x57, 0xa8, 0x48, 0x4d, 0x0a, 0x4a, 0xf2, 0xd6, 0xa3,
-	0xff, 0xd9, 0x01, 0xd2, 0x92, 0x63, 0x32, 0x73, 0x03, 0xc3, 0x43, 0x8d, 0xa5, 0xd0, 0x4a, 0x4a,
-	0xf5, 0x2d, 0x6b, 0x6a, 0x24, 0xdb, 0x87, 0x3b, 0xa3, 0x3c, 0x8e, 0x46, 0x47, 0x79, 0x9a, 0x1d,
-	0x5e, 0xe5, 0x93, 0x4c, 0x51, 0xda, 0x3b, 0x7c, 0x51, 0xcd, 0xf6, 0xa0, 0xa7, 0x0b, 0x40, 0x1e,
-	0x26, 0x89, 0xa4, 0x44, 0xfb, 0xdc, 0x56, 0xb1, 0xfb, 0xe0, 0xe7, 0x63, 0x21, 0x23, 0xac, 0x29,
-	0xca, 0xeb, 0x26, 0xaf, 0x15, 0x6c, 0x07, 0xdc, 0x19, 0x6e, 0x47, 0x99, 0xf3, 0xb9, 0x16, 0x58,
-	0x00, 0xeb, 0x33, 0xe3, 0xb7, 0x4b, 0x7e, 0x4b, 0x91, 0xf0, 0xe4, 0xc9, 0x37, 0x78, 0xf2, 0x81,
-	0xf8, 0x93, 0xd9, 0x30, 0x2a, 0x2e, 0x29, 0xa9, 0x3e, 0x2f, 0x45, 0xf6, 0x01, 0x80, 0x0e, 0xe6,
-	0x24, 0xbd, 0x12, 0x41, 0x6f, 0xcf, 0xd9, 0x6f, 0x73, 0x4b, 0x83, 0x76, 0x5d, 0x92, 0xb4, 0xe9,
-	0x06, 0x2d, 0xb6, 0x34, 0xb5, 0x9d, 0xd6, 0x6f, 0xea, 0xf5, 0xb5, 0x86, 0xce, 0x6f, 0x0a, 0x0e,
-	0x01, 0x5b, 0x04, 0xb0, 0x55, 0xb8, 0xc3, 0x79, 0x9a, 0xa5, 0xc5, 0x25, 0x01, 0xee, 0xe8, 0x1d,
-	0x6a, 0x0d, 0x0b, 0x61, 0xc3, 0x48, 0xfa, 0x00, 0x7d, 0x8a, 0xa1, 0xa1, 0x63, 0xf7, 0xc0, 0xbb,
-	0x14, 0xe9, 0xc5, 0xa5, 0x0a, 0xb6, 0x69, 0xbd, 0x91, 0xf0, 0xdc, 0xdf, 0x0e, 0xb5, 0x81, 0xe9,
-	0x3c, 0x19, 0x11, 0xe3, 0x9a, 0x0d, 0x46, 0x79, 0xfc, 0xfa, 0x55, 0x94, 0xaa, 0x22, 0xb8, 0x4b,
-	0x79, 0xb7, 0x55, 0x8d, 0x3b, 0x3e, 0x9e, 0x5f, 0x9d, 0xe5, 0xa3, 0x60, 0x87, 0x5c, 0x2f, 0xaa,
-	0xd9, 0x87, 0xb0, 0x59, 0xa9, 0xbe, 0x9a, 0x89, 0x38, 0xd8, 0x25, 0x5c, 0x53, 0x19, 0xfe, 0xdc,
-	0x32, 0xf4, 0xa1, 0xa9, 0xa1, 0x79, 0xef, 0xce, 0x8d, 0xf7, 0xde, 0xba, 0xe1, 0xde, 0xdb, 0x37,
-	0xdc, 0x7b, 0xc7, 0xbe, 0xf7, 0x25, 0x75, 0xea, 0xde, 0x58, 0xa7, 0x76, 0x3e, 0xbc, 0x95, 0xf2,
-	0xb1, 0xbe, 0x62, 0x3e, 0xba, 0xcb, 0xf2, 0xf1, 0x7d, 0xc5, 0xa6, 0xc4, 0x78, 0x01, 0xac, 0xe7,
-	0xd8, 0x6c, 0x4f, 0xcb, 0x7e, 0x2e, 0xc5, 0xfa, 0x68, 0x2d, 0xfb, 0x68, 0x0b, 0x01, 0xb7, 0xaf,
-	0x05, 0x1c, 0xbe, 0x32, 0x0e, 0x34, 0x5d, 0xde, 0xe2, 0xe0, 0x1e, 0x78, 0x2a, 0x92, 0x17, 0x42,
-	0x53, 0xf3, 0x26, 0x37, 0x12, 0xea, 0x23, 0xa2, 0x7a, 0xb3, 0xbb, 0x91, 0xc2, 0x01, 0x6c, 0x35,
-	0x69, 0xf5, 0x1d, 0x7b, 0xeb, 0xba, 0xd5, 0xd1, 0x1b, 0x29, 0xcc, 0x4d, 0x70, 0x9a, 0x35, 0x6f,
-	0xd9, 0xe0, 0x23, 0x68, 0xa9, 0x99, 0x79, 0x33, 0x76, 0x6b, 0xde, 0x3c, 0x91, 0x51, 0x56, 0xe8,
-	0x78, 0x78, 0x4b, 0xcd, 0xd8, 0x03, 0x68, 0x17, 0xe3, 0xa9, 0x79, 0x2d, 0x36, 0x6b, 0xdc, 0xf1,
-	0x78, 0xca, 0xd1, 0x12, 0xfe, 0xe2, 0x98, 0xa8, 0x2b, 0x9e, 0xbe, 0x3d, 0xe5, 0x32, 0x7a, 0x7b,
-	0x32, 0x2b, 0x53, 0x4e, 0x02, 0xe2, 0xd5, 0xec, 0x69, 0x96, 0x88, 0x99, 0x49, 0x48, 0x29, 0x62,
-	0x0f, 0x5f, 0x09, 0xf9, 0x7a, 0x20, 0xa3, 0x2c, 0xbe, 0x34, 0x25, 0x68, 0x69, 0xb0, 0xd6, 0xcf,
-	0xf0, 0x62, 0x28, 0x11, 0x9a, 0x03, 0x6b, 0x45, 0xf8, 0x67, 0x0b, 0xfc, 0xea, 0x29, 0x60, 0x0c,
-	0x3a, 0x97, 0x08, 0xd3, 0x21, 0xd1, 0x37, 0x56, 0x94, 0xa1, 0x0c, 0xea, 0x0e, 0x4d, 0xef, 0x1d,
-	0xde, 0x54, 0x5a, 0x2c, 0xa0, 0x9b, 0xc3, 0x62, 0x81, 0xa9, 0x90, 0x05, 0x5e, 0x64, 0x47, 0xc7,
-	0x6d, 0xc4, 0x32, 0xee, 0x91, 0xe0, 0x79, 0xae, 0x4c, 0x60, 0x96, 0x06, 0x63, 0x51, 0xc8, 0x4a,
-	0x1e, 0xb1, 0x0a, 0x7d, 0x63, 0x6e, 0xb2, 0x3c, 0x8b, 0x05, 0x55, 0x7f, 0x87, 0x6b, 0x01, 0x91,
-	0x67, 0x58, 0x87, 0x5d, 0x8d, 0xc4, 0x6f, 0xdc, 0x3d, 0x49, 0xcf, 0xcf, 0xd3, 0x78, 0x32, 0x52,
-	0x73, 0x22, 0xe4, 0x36, 0xb7, 0x34, 0xc8, 0x6c, 0x63, 0x29, 0xa6, 0x69, 0x3e, 0x29, 0x2c, 0x6a,
-	0x6e, 0xe8, 0xd8, 0x7b, 0xd0, 0xcd, 0xc4, 0x4c, 0x91, 0xbd, 0x47, 0xf6, 0x4a, 0xc6, 0x73, 0xa5,
-	0x05, 0x17, 0x85, 0x50, 0x44, 0xcc, 0x5d, 0x5e, 0x8a, 0xe1, 0x97, 0x00, 0xf5, 0xdb, 0xca, 0x0e,
-	0xc0, 0xaf, 0xde, 0xd6, 0xc0, 0xd9, 0x6b, 0xef, 0xf7, 0x1e, 0xf5, 0x17, 0x5f, 0x60, 0x5e, 0x43,
-	0xc2, 0x3f, 0x1c, 0xd8, 0x6a, 0x96, 0xd8, 0xd2, 0x4b, 0xd9, 0x83, 0x9e, 0xbe, 0x43, 0x9d, 0x73,
-	0x7d, 0x25, 0xb6, 0x8a, 0xdd, 0x87, 0xf6, 0x34, 0xc5, 0xee, 0x41, 0x97, 0x60, 0x5c, 0x9e, 0xa6,
-	0x19, 0x47, 0x35, 0x7b, 0x00, 0x9d, 0x69, 0x3e, 0xc1, 0x97, 0x13, 0xcd, 0xbd, 0xd2, 0x9c, 0x4f,
-	0x14, 0x27, 0x43, 0x95, 0x7d, 0xd7, 0xca, 0xfe, 0xb5, 0x4a, 0xf0, 0x96, 0x54, 0x42, 0xf8, 0x04,
-	0xda, 0xa7, 0x9a, 0x2e, 0xa3, 0x24, 0x91, 0xa2, 0x28, 0xca, 0x02, 0x37, 0x22, 0x5e, 0xe2, 0x29,
-	0x0e, 0x14, 0x26, 0x6a, 0x2d, 0x84, 0x1c, 0x3a, 0xe8, 0x1e, 0x93, 0x1e, 0xe7, 0x69, 0x76, 0x16,
-	0x15, 0x7a, 0xb6, 0xeb, 0xf2, 0x4a, 0xb6, 0xf7, 0x6c, 0xdd, 0xb0, 0x67, 0xdb, 0xde, 0xf3, 0x57,
-	0x07, 0x3c, 0xdd, 0x87, 0x4b, 0x93, 0x58, 0x9e, 0xb1, 0x65, 0x9d, 0xf1, 0xa6, 0x3a, 0x6e, 0x74,
-	0x51, 0x67, 0xa1, 0x8b, 0xec, 0xee, 0x74, 0x9b, 0xdd, 0x89, 0x17, 0x45, 0x7d, 0xf8, 0x42, 0xe6,
-	0xf9, 0x79, 0xe0, 0xed, 0xb5, 0xf7, 0x37, 0xb8, 0xad, 0x0a, 0x23, 0xd8, 0x25, 0x6e, 0x78, 0x16,
-	0x15, 0x8a, 0xc7, 0xd3, 0xba, 0x19, 0xf7, 0xc1, 0xab, 0xea, 0xc6, 0x59, 0x5a, 0x37, 0xc6, 0x8e,
-	0xc5, 0x8e, 0xf9, 0x69, 0x14, 0x83, 0xa5, 0x09, 0xff, 0x72, 0xe0, 0xff, 0x5c, 0xc4, 0x22, 0x1d,
-	0x2b, 0xcd, 0xca, 0xf1, 0x74, 0x70, 0x72, 0x54, 0x96, 0xe8, 0x43, 0x58, 0xbf, 0x34, 0x23, 0xe2,
-	0x4d, 0x05, 0x5a, 0x02, 0xd0, 0xd3, 0x28, 0x2a, 0x54, 0xd3, 0x53, 0xad, 0xc1, 0x34, 0x65, 0xe2,
-	0xed, 0xd0, 0xce, 0x60, 0xad, 0x60, 0x1f, 0xc3, 0x16, 0x62, 0x07, 0x75, 0xac, 0x7a, 0x72, 0x5b,
-	0xd0, 0x62, 0xa1, 0x65, 0xe2, 0xad, 0x05, 0xd3, 0x0f, 0x67, 0x53, 0x19, 0xfe, 0xe4, 0xc2, 0x1d,
-	0xfb, 0x54, 0xcf, 0xf2, 0x8b, 0x5b, 0x68, 0xf5, 0x3e, 0xf8, 0xf1, 0xc4, 0x8c, 0x93, 0xa6, 0x7a,
-	0x6a, 0x05, 0x5a, 0x9b, 0xb3, 0xa8, 0xbf, 0xc2, 0xc8, 0xe9, 0xff, 0x9b, 0x91, 0x93, 0x9a, 0x28,
-	0xcd, 0x9e, 0x2f, 0x8c, 0x9d, 0x4d, 0xe5, 0x6a, 0xa3, 0xa7, 0xff, 0x5f, 0x1f, 0x3d, 0xf1, 0x7c,
-	0xc3, 0x7a, 0xf6, 0xc4, 0x91, 0x6c, 0xf9, 0x88, 0xc9, 0x56, 0x1a, 0xa9, 0xee, 0xae, 0x38, 0x52,
-	0xed, 0x2c, 0x1b, 0xa9, 0x7e, 0x73, 0x60, 0x9b, 0x8b, 0x37, 0x7a, 0xac, 0x4a, 0x12, 0x89, 0x86,
-	0x02, 0x29, 0x06, 0x29, 0xaa, 0xa4, 0x1d, 0xfc, 0xfe, 0xe7, 0x3f, 0x94, 0x76, 0xc0, 0xc5, 0xea,
-	0x28, 0x88, 0xcc, 0x7d, 0xae, 0x05, 0x4c, 0xe0, 0x38, 0xba, 0x10, 0xdf, 0x4c, 0xae, 0xce, 0x84,
-	0x1e, 0x3d, 0x5d, 0x6e, 0x69, 0x90, 0x48, 0x51, 0x3a, 0x4e, 0x7f, 0xd0, 0x2c, 0xee, 0xf2, 0x4a,
-	0x0e, 0xbf, 0x86, 0x3e, 0x17, 0xe3, 0xd1, 0xbc, 0x76, 0x59, 0xb0, 0xc7, 0xe6, 0x87, 0x37, 0x35,
-	0x4c, 0x49, 0x05, 0xdb, 0xd7, 0x62, 0xe3, 0x36, 0x2a, 0x8c, 0x60, 0xe7, 0xe5, 0x44, 0x48, 0x6b,
-	0xa3, 0x17, 0x91, 0x8c, 0xae, 0xac, 0x33, 0x3a, 0xab, 0x9d, 0xd1, 0x6a, 0xdc, 0x56, 0xa3, 0x71,
-	0xc3, 0x01, 0xec, 0x2e, 0xb8, 0xe0, 0xa2, 0x98, 0x8c, 0x14, 0xfb, 0x04, 0xbc, 0x77, 0xc5, 0x6a,
-	0x00, 0xe1, 0x1b, 0x78, 0xbf, 0xbc, 0x9b, 0x8a, 0xd4, 0x74, 0xa5, 0x3c, 0x4b, 0x0b, 0x62, 0x2d,
-	0x29, 0xde, 0x98, 0x4a, 0x72, 0xa8, 0x14, 0x6b, 0x05, 0x3e, 0x09, 0x31, 0x36, 0x99, 0xbe, 0x2f,
-	0x97, 0x1b, 0x09, 0x57, 0x25, 0xa9, 0x14, 0xf5, 0x94, 0xea, 0xf2, 0x5a, 0x11, 0x7e, 0x8e, 0x2e,
-	0xcb, 0x14, 0x1b, 0xa7, 0x96, 0xcb, 0x00, 0x49, 0x17, 0x25, 0x1d, 0x7d, 0x9b, 0x97, 0x62, 0xf8,
-	0x05, 0x04, 0x65, 0xac, 0x2f, 0xe5, 0xdc, 0xf0, 0xb4, 0x09, 0xa5, 0x49, 0xf4, 0x3a, 0x52, 0x9b,
-	0xe8, 0xbf, 0xd3, 0x4e, 0xa3, 0xf9, 0xf2, 0xe5, 0x9a, 0x03, 0x9b, 0xe7, 0xac, 0x14, 0x4b, 0x5e,
-	0x91, 0xc6, 0xe6, 0x0f, 0x73, 0x2c, 0x9a, 0xe6, 0xf5, 0xb1, 0x2e, 0x74, 0xd2, 0x2c, 0x55, 0xfd,
-	0x35, 0xd6, 0x83, 0xf5, 0xb1, 0xc8, 0x92, 0x34, 0xbb, 0xe8, 0x3b, 0x28, 0x60, 0xa7, 0xa1, 0xd0,
-	0x62, 0x5b, 0x00, 0xa6, 0xf1, 0x51, 0x6e, 0xb3, 0x0d, 0xe8, 0xea, 0x2e, 0x16, 0x49, 0xbf, 0x83,
-	0x52, 0x1c, 0x65, 0xb1, 0x18, 0x89, 0xa4, 0xef, 0xe2, 0x42, 0x7c, 0x86, 0xf3, 0x89, 0xea, 0x7b,
-	0x67, 0x1e, 0xfd, 0x05, 0xf4, 0xf8, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x34, 0x7d, 0xa0,
-	0x11, 0x12, 0x00, 0x00,
+	// 1602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0xb6, 0x48, 0x91, 0x92, 0x46, 0xb6, 0x22, 0x6f, 0xec, 0x94, 0x6d, 0x82, 0xc6, 0x20, 0xda,
+	0xc2, 0x0d, 0x0a, 0x17, 0x48, 0x90, 0xf6, 0x6c, 0x19, 0x45, 0x15, 0x20, 0xc8, 0xcf, 0xda, 0x70,
+	0x7a, 0x2b, 0xd6, 0xe4, 0xda, 0x22, 0x2c, 0x93, 0xca, 0x72, 0xa5, 0x48, 0xbd, 0xf5, 0xd0, 0x53,
+	0xd1, 0x7b, 0xcf, 0x3d, 0xf6, 0x09, 0x7a, 0xeb, 0x63, 0xf4, 0x29, 0xfa, 0x0e, 0xc5, 0xec, 0x2e,
+	0xc9, 0xa5, 0x2c, 0x39, 0x6e, 0xaf, 0xbd, 0x71, 0x66, 0xbf, 0xdd, 0x99, 0x9d, 0x9f, 0x6f, 0x47,
+	0x82, 0xae, 0xe0, 0x63, 0xb6, 0x38, 0x98, 0x88, 0x4c, 0x66, 0xc4, 0x93, 0x8b, 0x09, 0xcf, 0xc3,
+	0x1f, 0x5d, 0xe8, 0x52, 0x54, 0x1f, 0x46, 0x32, 0xc9, 0x52, 0xf2, 0x05, 0xf8, 0x91, 0xe0, 0x4c,
+	0xf2, 0xa0, 0xb1, 0xd7, 0xd8, 0xef, 0x3e, 0x26, 0x07, 0x0a, 0x77, 0xa0, 0x30, 0x47, 0x6a, 0x65,
+	0xb8, 0x41, 0x0d, 0x06, 0xd1, 0x2c, 0x8a, 0xf8, 0x44, 0x06, 0xce, 0x75, 0xf4, 0xa1, 0x5a, 0x41,
+	0xb4, 0xc6, 0x20, 0x5a, 0xf0, 0x59, 0x76, 0xc9, 0x03, 0xf7, 0x3a, 0x9a, 0xaa, 0x15, 0x44, 0x6b,
+	0x0c, 0x79, 0x0a, 0x9d, 0x28, 0x4b, 0xcf, 0x13, 0x71, 0x75, 0x32, 0x0f, 0x9a, 0x6a, 0xc3, 0x6e,
+	0xcd, 0x99, 0x62, 0x71, 0xb8, 0x41, 0x2b, 0x24, 0x1a, 0x99, 0x71, 0x91, 0x9c, 0x2f, 0x02, 0xef,
+	0xba, 0x91, 0x53, 0xb5, 0x82, 0x46, 0x34, 0x06, 0x8d, 0xe8, 0xaf, 0xa3, 0x71, 0x12, 0xf8, 0xd7,
+	0x8d, 0x9c, 0x16, 0x8b, 0x68, 0xa4, 0x44, 0x92, 0x27, 0x00, 0x67, 0x32, 0x1a, 0x72, 0x16, 0x73,
+	0x91, 0x07, 0x2d, 0xb5, 0x6f, 0xdb, 0xec, 0x1b, 0x94, 0x0b, 0xc3, 0x0d, 0x6a, 0xc1, 0x48, 0x0f,
+	0x1c, 0xb9, 0x08, 0x60, 0xaf, 0xb1, 0xef, 0x51, 0x47, 0x2e, 0x06, 0x2d, 0xf0, 0x66, 0x6c, 0x3c,
+	0xe5, 0xe1, 0x1f, 0x1e, 0x80, 0xb2, 0xf6, 0x52, 0xc4, 0x5c, 0x20, 0x2e, 0x89, 0x55, 0xf8, 0x3b,
+	0xd4, 0x49, 0x62, 0xf2, 0x25, 0xf8, 0xb9, 0x64, 0x72, 0x9a, 0xab, 0x20, 0xf7, 0x1e, 0x7f, 0x60,
+	0x3b, 0xa8, 0xb6, 0x1c, 0xab, 0x65, 0x6a, 0x60, 0x78, 0xa9, 0x89, 0xe0, 0x5a, 0x19, 0xb8, 0x37,
+	0xef, 0xa9, 0x90, 0x64, 0x1f, 0xee, 0x8c, 0xb3, 0x88, 0x8d, 0x8f, 0xb2, 0x24, 0x3d, 0xbc, 0xca,
+	0xa6, 0xa9, 0x54, 0x61, 0x6f, 0xd2, 0x65, 0x35, 0xd9, 0x83, 0xae, 0x2e, 0x00, 0x71, 0x18, 0xc7,
+	0x42, 0x05, 0xba, 0x43, 0x6d, 0x15, 0x79, 0x00, 0x9d, 0x6c, 0xc2, 0x05, 0xc3, 0x9a, 0x52, 0x71,
+	0xdd, 0xa2, 0x95, 0x82, 0xec, 0x80, 0x37, 0xc7, 0xe3, 0x54, 0xe4, 0x3a, 0x54, 0x0b, 0x24, 0x80,
+	0xd6, 0xdc, 0xd8, 0x6d, 0x2b, 0xbb, 0x85, 0xa8, 0xf0, 0xca, 0x52, 0xc7, 0xe0, 0x95, 0x0d, 0xc4,
+	0x9f, 0xcc, 0x87, 0x2c, 0x1f, 0xa9, 0xa0, 0x76, 0x68, 0x21, 0x92, 0x8f, 0x01, 0xb4, 0x33, 0x27,
+	0xc9, 0x15, 0x0f, 0xba, 0x7b, 0x8d, 0x7d, 0x97, 0x5a, 0x1a, 0x5c, 0xd7, 0x25, 0xa9, 0x0e, 0xdd,
+	0x54, 0x9b, 0x2d, 0x4d, 0xb5, 0xae, 0xf6, 0x6f, 0xe9, 0xfd, 0x95, 0x46, 0xdd, 0xdf, 0x14, 0x1c,
+	0x02, 0x7a, 0x0a, 0x60, 0xab, 0xf0, 0x84, 0xf3, 0x24, 0x4d, 0xf2, 0x91, 0x02, 0xdc, 0xd1, 0x27,
+	0x54, 0x1a, 0x12, 0xc2, 0xa6, 0x91, 0xf4, 0x05, 0xfa, 0xca, 0x87, 0x9a, 0x8e, 0xdc, 0x03, 0x7f,
+	0xc4, 0x93, 0x8b, 0x91, 0x0c, 0xb6, 0xd5, 0x7e, 0x23, 0xe1, 0xbd, 0xbf, 0x1b, 0xea, 0x05, 0xa2,
+	0xe3, 0x64, 0x44, 0xf4, 0x6b, 0x3e, 0x18, 0x67, 0xd1, 0xe5, 0x1b, 0x96, 0xc8, 0x3c, 0xb8, 0xab,
+	0xe2, 0x6e, 0xab, 0x6a, 0x39, 0x3e, 0x5e, 0x5c, 0x9d, 0x65, 0xe3, 0x60, 0x47, 0x99, 0x5e, 0x56,
+	0x93, 0x4f, 0x60, 0xab, 0x54, 0x7d, 0x33, 0xe7, 0x51, 0xb0, 0xab, 0x70, 0x75, 0x65, 0xf8, 0x8b,
+	0x03, 0x5d, 0x8b, 0x1a, 0xea, 0x79, 0x6f, 0xac, 0xcd, 0xbb, 0xb3, 0x26, 0xef, 0xee, 0x9a, 0xbc,
+	0x37, 0xed, 0xbc, 0xaf, 0xa8, 0x53, 0x6f, 0x6d, 0x9d, 0xda, 0xf1, 0xf0, 0x6f, 0x15, 0x8f, 0xd6,
+	0x2d, 0xe3, 0xd1, 0x5e, 0x15, 0x8f, 0xef, 0x4b, 0x36, 0x55, 0x8c, 0x17, 0x40, 0x2b, 0xc3, 0x66,
+	0x7b, 0x56, 0xf4, 0x73, 0x21, 0x56, 0x57, 0x73, 0xec, 0xab, 0x2d, 0x39, 0xec, 0x5e, 0x73, 0x38,
+	0x7c, 0x03, 0x5d, 0x8b, 0x2e, 0x6f, 0x30, 0x70, 0x0f, 0x7c, 0xc9, 0xc4, 0x05, 0xd7, 0xd4, 0xbc,
+	0x45, 0x8d, 0x84, 0x7a, 0xa6, 0xa8, 0xde, 0x9c, 0x6e, 0xa4, 0x70, 0x00, 0xbd, 0x3a, 0xad, 0xbe,
+	0xe7, 0x6c, 0x5d, 0xb7, 0xda, 0x7b, 0x23, 0x85, 0x19, 0x74, 0x2d, 0xd6, 0xbc, 0xe1, 0x80, 0x4f,
+	0xc1, 0x91, 0xf3, 0xc0, 0xa9, 0xf1, 0xed, 0x40, 0x46, 0x27, 0x82, 0xa5, 0xb9, 0xf6, 0x87, 0x3a,
+	0x72, 0x4e, 0x1e, 0x82, 0x9b, 0x4f, 0x66, 0xe6, 0xb5, 0xd8, 0xaa, 0x70, 0xc7, 0x93, 0x19, 0xc5,
+	0x95, 0xf0, 0xd7, 0x06, 0xf4, 0x2c, 0x8b, 0x48, 0xcd, 0x37, 0x86, 0x5c, 0xb0, 0x77, 0x27, 0xf3,
+	0x22, 0xe4, 0x4a, 0x40, 0xbc, 0x9c, 0x3f, 0x4b, 0x63, 0x3e, 0x37, 0x01, 0x29, 0x44, 0xec, 0xe1,
+	0x2b, 0x2e, 0x2e, 0x07, 0x82, 0xa5, 0xd1, 0xc8, 0x94, 0xa0, 0xa5, 0xc1, 0x5a, 0x3f, 0xc3, 0xc4,
+	0xa8, 0x40, 0x68, 0x0e, 0xac, 0x14, 0xe1, 0xdf, 0x0e, 0x74, 0xca, 0xa7, 0x80, 0x10, 0x68, 0x8e,
+	0x10, 0xa6, 0x5d, 0x52, 0xdf, 0x58, 0x51, 0x86, 0x32, 0x54, 0x77, 0x68, 0x7a, 0x6f, 0xd2, 0xba,
+	0xd2, 0x62, 0x01, 0xdd, 0x1c, 0x16, 0x0b, 0xcc, 0xb8, 0xc8, 0x31, 0x91, 0x4d, 0xed, 0xb7, 0x11,
+	0x0b, 0xbf, 0xc7, 0x9c, 0x66, 0x99, 0x34, 0x8e, 0x59, 0x1a, 0xf4, 0x45, 0x22, 0x2b, 0xf9, 0x8a,
+	0x55, 0xd4, 0x37, 0xc6, 0x26, 0xcd, 0xd2, 0x88, 0xab, 0xea, 0x6f, 0x52, 0x2d, 0x20, 0xf2, 0x0c,
+	0xeb, 0xb0, 0xad, 0x91, 0xf8, 0x8d, 0xa7, 0xc7, 0xc9, 0xf9, 0x79, 0x12, 0x4d, 0xc7, 0x72, 0xa1,
+	0x08, 0xd9, 0xa5, 0x96, 0x06, 0x99, 0x6d, 0x22, 0xf8, 0x2c, 0xc9, 0xa6, 0xb9, 0x45, 0xcd, 0x35,
+	0x1d, 0xf9, 0x08, 0xda, 0x29, 0x9f, 0x4b, 0xb5, 0xde, 0x55, 0xeb, 0xa5, 0x8c, 0xf7, 0x4a, 0x72,
+	0xca, 0x73, 0x2e, 0x15, 0x31, 0xb7, 0x69, 0x21, 0x62, 0xbc, 0xa3, 0x11, 0x4b, 0xd2, 0x37, 0x99,
+	0xb8, 0x54, 0xa4, 0xdc, 0xa1, 0x95, 0x22, 0x7c, 0x05, 0x50, 0xbd, 0xbc, 0xe4, 0x00, 0x3a, 0xe5,
+	0xcb, 0x1b, 0x34, 0xf6, 0xdc, 0xfd, 0xee, 0xe3, 0xfe, 0xf2, 0xfb, 0x4c, 0x2b, 0x08, 0xde, 0x34,
+	0xaa, 0x88, 0x49, 0x7d, 0x87, 0x7f, 0x36, 0xa0, 0x57, 0x2f, 0xca, 0x95, 0x69, 0xdc, 0x83, 0xae,
+	0xce, 0xba, 0xce, 0x92, 0x4e, 0xa2, 0xad, 0x22, 0x0f, 0xc0, 0x9d, 0x25, 0xd8, 0x6f, 0xe8, 0x06,
+	0x18, 0x37, 0x4e, 0x93, 0x94, 0xa2, 0x9a, 0x3c, 0x84, 0xe6, 0x2c, 0x9b, 0xe2, 0x5b, 0x8b, 0xcb,
+	0xdd, 0x62, 0x39, 0x9b, 0x4a, 0xaa, 0x16, 0xca, 0x7c, 0x79, 0x56, 0xbe, 0xae, 0xd5, 0x8e, 0xbf,
+	0xa2, 0x76, 0xc2, 0xa7, 0xe0, 0x9e, 0x6a, 0x82, 0x65, 0x71, 0x2c, 0x78, 0x9e, 0x17, 0x2d, 0x61,
+	0x44, 0x4c, 0xfb, 0x29, 0x8e, 0x20, 0xc6, 0x6b, 0x2d, 0x84, 0x14, 0x9a, 0x68, 0x1e, 0xd3, 0x84,
+	0x81, 0x38, 0x63, 0xb9, 0x9e, 0x06, 0xdb, 0xb4, 0x94, 0xed, 0x33, 0x9d, 0x35, 0x67, 0xba, 0xf6,
+	0x99, 0xbf, 0x35, 0xc0, 0xd7, 0x9d, 0xbb, 0x32, 0x88, 0xc5, 0x1d, 0x1d, 0xeb, 0x8e, 0xeb, 0x2a,
+	0xbf, 0xd6, 0x77, 0xcd, 0xa5, 0xbe, 0xb3, 0xfb, 0xd9, 0xab, 0xf7, 0x33, 0x26, 0x4a, 0x75, 0xee,
+	0x2b, 0x91, 0x65, 0xe7, 0x81, 0xbf, 0xe7, 0xee, 0x6f, 0x52, 0x5b, 0x15, 0x32, 0xd8, 0x55, 0x6c,
+	0xf2, 0x9c, 0xe5, 0x92, 0x46, 0xb3, 0xaa, 0x7d, 0xf7, 0xc1, 0x2f, 0x6b, 0xa9, 0xb1, 0xb2, 0x96,
+	0xcc, 0x3a, 0xb6, 0x07, 0xc6, 0xa7, 0x56, 0x0c, 0x96, 0x26, 0xfc, 0xcb, 0x81, 0x0f, 0x29, 0x8f,
+	0x78, 0x32, 0x91, 0x9a, 0xc7, 0xa3, 0xd9, 0xe0, 0xe4, 0xa8, 0x28, 0xdb, 0x47, 0xd0, 0x1a, 0xe9,
+	0xcf, 0xb5, 0x45, 0x5b, 0x00, 0xd0, 0xd2, 0x98, 0xe5, 0xb2, 0x6e, 0xa9, 0xd2, 0x60, 0x98, 0x52,
+	0xfe, 0x6e, 0x68, 0x47, 0xb0, 0x52, 0x90, 0xcf, 0xa0, 0x87, 0xd8, 0x41, 0xe5, 0xab, 0x9e, 0xf5,
+	0x96, 0xb4, 0x58, 0x68, 0x29, 0x7f, 0x67, 0xc1, 0xf4, 0x53, 0x5b, 0x57, 0x92, 0xaf, 0x60, 0x8b,
+	0x49, 0xc9, 0xa2, 0x51, 0x31, 0x12, 0xfb, 0x6b, 0xbc, 0xaf, 0xc3, 0x70, 0x5f, 0xcc, 0xed, 0x7d,
+	0xad, 0x75, 0xfb, 0x6a, 0xb0, 0xb2, 0x5d, 0xdb, 0x56, 0xbb, 0xfe, 0xec, 0xc1, 0x1d, 0x3b, 0xb2,
+	0xcf, 0xb3, 0x8b, 0x1b, 0x1e, 0x03, 0x24, 0x93, 0xa9, 0x19, 0x82, 0x4d, 0x05, 0x57, 0x0a, 0x5c,
+	0xad, 0x4f, 0xd0, 0x9d, 0x5b, 0x0c, 0xca, 0x9d, 0xff, 0x32, 0x28, 0xab, 0x46, 0x4e, 0xd2, 0x97,
+	0x4b, 0xc3, 0x72, 0x5d, 0x79, 0xbb, 0x81, 0xb9, 0xf3, 0x7f, 0x1f, 0x98, 0xf1, 0x7e, 0xc3, 0x6a,
+	0x62, 0x6e, 0xd2, 0x42, 0x5c, 0x9e, 0xab, 0xc8, 0xad, 0x06, 0xc1, 0xbb, 0xb7, 0x1c, 0x04, 0x77,
+	0x56, 0x0d, 0x82, 0xbf, 0x37, 0x60, 0x9b, 0xf2, 0xb7, 0x7a, 0x18, 0x8c, 0x63, 0x81, 0x0b, 0xaa,
+	0x6e, 0x91, 0x26, 0x0b, 0xea, 0xc3, 0xef, 0x7f, 0xff, 0xf3, 0x6e, 0x07, 0x3c, 0xac, 0x8e, 0x5c,
+	0x3d, 0x28, 0x1d, 0xaa, 0x05, 0x0c, 0xe0, 0x84, 0x5d, 0xf0, 0x17, 0xd3, 0xab, 0x33, 0xae, 0x07,
+	0x66, 0x8f, 0x5a, 0x1a, 0x24, 0x73, 0x94, 0x8e, 0x93, 0x1f, 0xf4, 0x4b, 0xe2, 0xd1, 0x52, 0x0e,
+	0xbf, 0x85, 0x3e, 0xe5, 0x93, 0xf1, 0xa2, 0x32, 0x99, 0x93, 0x27, 0xe6, 0xef, 0x82, 0x4c, 0x58,
+	0x74, 0xb4, 0x7d, 0xcd, 0x37, 0x6a, 0xa3, 0x42, 0x06, 0x3b, 0xaf, 0xa7, 0x5c, 0x58, 0x07, 0xbd,
+	0x62, 0x82, 0x5d, 0x59, 0x77, 0x6c, 0xdc, 0xee, 0x8e, 0x56, 0xe3, 0x3a, 0xb5, 0xc6, 0x0d, 0x07,
+	0xb0, 0xbb, 0x64, 0x82, 0xf2, 0x7c, 0x3a, 0x96, 0xe4, 0x73, 0xf0, 0xdf, 0xe7, 0xab, 0x01, 0x84,
+	0x3f, 0x35, 0xe0, 0x7e, 0x91, 0x9c, 0x92, 0x63, 0x74, 0xa9, 0x3c, 0x4f, 0x72, 0x45, 0x9d, 0x82,
+	0xbf, 0xd5, 0x0a, 0xe5, 0xb1, 0x4b, 0x2b, 0x05, 0xbe, 0x4b, 0x11, 0x76, 0x99, 0x4e, 0x98, 0x47,
+	0x8d, 0x84, 0xbb, 0xe2, 0x44, 0xf0, 0x6a, 0xb8, 0xf6, 0x68, 0xa5, 0x28, 0x29, 0xab, 0x69, 0x51,
+	0xd6, 0xd7, 0x70, 0xbf, 0x8a, 0xbb, 0x71, 0xc4, 0x72, 0x23, 0xc0, 0xd7, 0x00, 0x25, 0x7d, 0x25,
+	0x97, 0x16, 0x62, 0xf8, 0x02, 0x82, 0xc2, 0xff, 0xd7, 0x62, 0x61, 0x1e, 0x10, 0xe3, 0x5e, 0xfd,
+	0x05, 0xd2, 0xde, 0x5b, 0x9a, 0x95, 0xa3, 0xce, 0x54, 0x3b, 0xc2, 0x16, 0xab, 0x8f, 0xd4, 0x64,
+	0x59, 0x8f, 0x47, 0xa9, 0x58, 0xf1, 0xe4, 0xd5, 0x0d, 0x06, 0xd0, 0x42, 0x30, 0x76, 0xad, 0xa6,
+	0xd2, 0x42, 0x0c, 0x8f, 0xab, 0x1e, 0xb9, 0x79, 0x54, 0xae, 0x46, 0x01, 0xa7, 0xf6, 0x53, 0xb8,
+	0xb8, 0x8b, 0x5b, 0xdd, 0xe5, 0x51, 0x06, 0xfd, 0x2a, 0xe5, 0x86, 0xb1, 0xdb, 0xd0, 0x4c, 0xd2,
+	0x44, 0xf6, 0x37, 0x48, 0x17, 0x5a, 0x13, 0x9e, 0xc6, 0x49, 0x7a, 0xd1, 0x6f, 0xa0, 0x80, 0x0c,
+	0x80, 0x82, 0x43, 0x7a, 0x00, 0x86, 0x90, 0x50, 0x76, 0xc9, 0x26, 0xb4, 0x35, 0xbb, 0xf0, 0xb8,
+	0xdf, 0x44, 0x29, 0x62, 0x69, 0xc4, 0xc7, 0x3c, 0xee, 0x7b, 0xb8, 0x11, 0x47, 0x94, 0x6c, 0x2a,
+	0xfb, 0xfe, 0x99, 0xaf, 0xfe, 0x50, 0x7b, 0xf2, 0xcf, 0x00, 0x8f, 0x57, 0xce, 0x7d, 0x5f, 0x13,
+	0x00, 0x00,
 }
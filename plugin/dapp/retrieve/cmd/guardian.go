@@ -0,0 +1,282 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+
+	jsonrpc "github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/retrieve/rpc"
+	rt "github.com/33cn/plugin/plugin/dapp/retrieve/types"
+	"github.com/spf13/cobra"
+)
+
+// GuardianResult response
+type GuardianResult struct {
+	BackupAddress  string         `json:"backupAddress,omitempty"`
+	Guardians      []rpc.Guardian `json:"guardians"`
+	Threshold      int32          `json:"threshold"`
+	DelayPeriod    int64          `json:"delayPeriod"`
+	ApprovedWeight int32          `json:"approvedWeight"`
+	Approvers      []string       `json:"approvers,omitempty"`
+	RemainTime     int64          `json:"remainTime"`
+	Status         string         `json:"status"`
+}
+
+// GuardianCmd guardian retrieve cmds
+func GuardianCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "guardian",
+		Short: "Wallet retrieve by M-of-N guardians",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		GuardianSetCmd(),
+		GuardianPrepareCmd(),
+		GuardianApproveCmd(),
+		GuardianPerformCmd(),
+		GuardianCancelCmd(),
+		GuardianQueryCmd(),
+	)
+
+	return cmd
+}
+
+func getFee(cmd *cobra.Command) int64 {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	defaultFee := float64(cfg.GetMinTxFeeRate()) / float64(types.Coin)
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	if fee < defaultFee {
+		fee = defaultFee
+	}
+	return int64(fee*types.InputPrecision) * types.Multiple1E4
+}
+
+// GuardianSetCmd construct guardian set tx
+func GuardianSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set the guardians of the wallet, remove them if no guardian given",
+		Run:   guardianSetCmd,
+	}
+	cmd.Flags().StringP("default", "t", "", "default address")
+	cmd.MarkFlagRequired("default")
+	cmd.Flags().StringArrayP("guardian", "g", []string{}, "guardian address")
+	cmd.Flags().IntSliceP("weight", "w", []int{}, "guardian weight")
+	cmd.Flags().Int32P("threshold", "m", 0, "total weight of approvals required")
+	cmd.Flags().Int64P("delay", "d", 60, "delay period after approved (minimum 60 seconds)")
+
+	cmd.Flags().Float64P("fee", "f", 0.0, "transaction fee")
+	return cmd
+}
+
+func guardianSetCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	defaultAddr, _ := cmd.Flags().GetString("default")
+	guardians, _ := cmd.Flags().GetStringArray("guardian")
+	weights, _ := cmd.Flags().GetIntSlice("weight")
+	threshold, _ := cmd.Flags().GetInt32("threshold")
+	delay, _ := cmd.Flags().GetInt64("delay")
+
+	if len(guardians) != len(weights) {
+		fmt.Printf("guardian count must equal to weight count\n")
+		return
+	}
+	if len(guardians) != 0 && delay < 60 {
+		fmt.Println("delay period changed to 60")
+		delay = 60
+	}
+	params := rpc.RetrieveGuardianTx{
+		DefaultAddr: defaultAddr,
+		Guardians:   []rpc.Guardian{},
+		Threshold:   threshold,
+		DelayPeriod: delay,
+		Fee:         getFee(cmd),
+	}
+	for i := 0; i < len(guardians); i++ {
+		params.Guardians = append(params.Guardians, rpc.Guardian{Address: guardians[i], Weight: int32(weights[i])})
+	}
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "retrieve.CreateRawRetrieveGuardianTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GuardianPrepareCmd construct guardian prepare tx
+func GuardianPrepareCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prepare",
+		Short: "Start the retrieve to backup address by a guardian, replaces an unapproved one after 24 hours",
+		Run:   guardianPrepareCmd,
+	}
+	addRetrieveCmdFlags(cmd)
+	return cmd
+}
+
+func guardianPrepareCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	backup, _ := cmd.Flags().GetString("backup")
+	defaultAddr, _ := cmd.Flags().GetString("default")
+
+	params := rpc.RetrievePrepareTx{
+		BackupAddr:  backup,
+		DefaultAddr: defaultAddr,
+		Fee:         getFee(cmd),
+	}
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "retrieve.CreateRawRetrieveGuardianPrepareTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GuardianApproveCmd construct guardian approve tx
+func GuardianApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve",
+		Short: "Approve the retrieve by a guardian",
+		Run:   guardianApproveCmd,
+	}
+	addRetrieveCmdFlags(cmd)
+	return cmd
+}
+
+func guardianApproveCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	backup, _ := cmd.Flags().GetString("backup")
+	defaultAddr, _ := cmd.Flags().GetString("default")
+
+	params := rpc.RetrieveApproveTx{
+		BackupAddr:  backup,
+		DefaultAddr: defaultAddr,
+		Fee:         getFee(cmd),
+	}
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "retrieve.CreateRawRetrieveGuardianApproveTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GuardianPerformCmd construct guardian perform tx
+func GuardianPerformCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "perform",
+		Short: "Perform the approved retrieve",
+		Run:   guardianPerformCmd,
+	}
+	addPerformCmdFlags(cmd)
+	return cmd
+}
+
+func guardianPerformCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	backup, _ := cmd.Flags().GetString("backup")
+	defaultAddr, _ := cmd.Flags().GetString("default")
+	execs, _ := cmd.Flags().GetStringArray("exec")
+	symbols, _ := cmd.Flags().GetStringArray("symbol")
+
+	params := rpc.RetrievePerformTx{
+		BackupAddr:  backup,
+		DefaultAddr: defaultAddr,
+		Assets:      []rpc.Asset{},
+		Fee:         getFee(cmd),
+	}
+	if len(execs) != len(symbols) {
+		fmt.Printf("exec count must equal to symbol count\n")
+		return
+	}
+	for i := 0; i < len(execs); i++ {
+		params.Assets = append(params.Assets, rpc.Asset{Exec: execs[i], Symbol: symbols[i]})
+	}
+
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "retrieve.CreateRawRetrieveGuardianPerformTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GuardianCancelCmd construct guardian cancel tx
+func GuardianCancelCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel the guardian retrieve by the default address",
+		Run:   guardianCancelCmd,
+	}
+	addRetrieveCmdFlags(cmd)
+	return cmd
+}
+
+func guardianCancelCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	backup, _ := cmd.Flags().GetString("backup")
+	defaultAddr, _ := cmd.Flags().GetString("default")
+
+	params := rpc.RetrieveCancelTx{
+		BackupAddr:  backup,
+		DefaultAddr: defaultAddr,
+		Fee:         getFee(cmd),
+	}
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "retrieve.CreateRawRetrieveGuardianCancelTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GuardianQueryCmd cmds
+func GuardianQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "show guardians and retrieve status",
+		Run:   queryGuardianCmd,
+	}
+	cmd.Flags().StringP("default", "t", "", "default address")
+	cmd.MarkFlagRequired("default")
+	return cmd
+}
+
+func parseGuardianDetail(arg interface{}) (interface{}, error) {
+	res := arg.(*rt.RetrieveQuery)
+
+	result := GuardianResult{
+		BackupAddress:  res.BackupAddress,
+		Threshold:      res.Threshold,
+		DelayPeriod:    res.DelayPeriod,
+		ApprovedWeight: res.ApprovedWeight,
+		Approvers:      res.Approvers,
+		RemainTime:     res.RemainTime,
+	}
+	for _, g := range res.Guardians {
+		result.Guardians = append(result.Guardians, rpc.Guardian{Address: g.Address, Weight: g.Weight})
+	}
+	switch res.Status {
+	case rt.RetrieveBackup:
+		result.Status = "guarded"
+	case rt.RetrievePreapre:
+		if res.ApprovedWeight < res.Threshold {
+			result.Status = "prepared"
+		} else {
+			result.Status = "approved"
+		}
+	case rt.RetrievePerform:
+		result.Status = "performed"
+	default:
+		result.Status = "unknown"
+	}
+
+	return result, nil
+}
+
+func queryGuardianCmd(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	defaultAddr, _ := cmd.Flags().GetString("default")
+
+	req := &rt.ReqRetrieveInfo{
+		DefaultAddress: defaultAddr,
+	}
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = "retrieve"
+	params.FuncName = "GetGuardianRetrieveInfo"
+	params.Payload = types.MustPBToJSON(req)
+
+	var res rt.RetrieveQuery
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.SetResultCb(parseGuardianDetail)
+	ctx.Run()
+}
@@ -36,6 +36,7 @@ func RetrieveCmd() *cobra.Command {
 		PerformCmd(),
 		CancelCmd(),
 		RetrieveQueryCmd(),
+		GuardianCmd(),
 	)
 
 	return cmd
@@ -39,3 +39,41 @@ func (c *Retrieve) Exec_Cancel(cancel *rt.CancelRetrieve, tx *types.Transaction,
 	rlog.Debug("PreRetrieve action")
 	return actiondb.RetrieveCancel(cancel)
 }
+
+// Exec_Guardian Action
+func (c *Retrieve) Exec_Guardian(guardian *rt.GuardianRetrieve, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewRetrieveAcction(c, tx)
+	if len(guardian.Guardians) != 0 && guardian.DelayPeriod < minPeriod {
+		return nil, rt.ErrRetrievePeriodLimit
+	}
+	rlog.Debug("RetrieveGuardian action")
+	return actiondb.RetrieveGuardian(guardian)
+}
+
+// Exec_GuardianPrepare Action
+func (c *Retrieve) Exec_GuardianPrepare(pre *rt.PrepareRetrieve, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewRetrieveAcction(c, tx)
+	rlog.Debug("GuardianPrepare action")
+	return actiondb.RetrieveGuardianPrepare(pre)
+}
+
+// Exec_GuardianApprove Action
+func (c *Retrieve) Exec_GuardianApprove(approve *rt.ApproveRetrieve, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewRetrieveAcction(c, tx)
+	rlog.Debug("GuardianApprove action")
+	return actiondb.RetrieveGuardianApprove(approve)
+}
+
+// Exec_GuardianPerform Action
+func (c *Retrieve) Exec_GuardianPerform(perf *rt.PerformRetrieve, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewRetrieveAcction(c, tx)
+	rlog.Debug("GuardianPerform action")
+	return actiondb.RetrieveGuardianPerform(perf)
+}
+
+// Exec_GuardianCancel Action
+func (c *Retrieve) Exec_GuardianCancel(cancel *rt.CancelRetrieve, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewRetrieveAcction(c, tx)
+	rlog.Debug("GuardianCancel action")
+	return actiondb.RetrieveGuardianCancel(cancel)
+}
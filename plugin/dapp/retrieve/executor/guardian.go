@@ -0,0 +1,306 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math"
+
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	rt "github.com/33cn/plugin/plugin/dapp/retrieve/types"
+)
+
+// GuardianKey 监护人集合的状态key
+func GuardianKey(defaultAddress string) (key []byte) {
+	key = append(key, []byte("mavl-retrieve-guardian-")...)
+	key = append(key, defaultAddress...)
+	return key
+}
+
+func readGuardianSet(db dbm.KV, defaultAddress string) (*rt.GuardianSet, error) {
+	data, err := db.Get(GuardianKey(defaultAddress))
+	if err != nil {
+		rlog.Debug("readGuardianSet", "get", err)
+		return nil, err
+	}
+	var set rt.GuardianSet
+	err = types.Decode(data, &set)
+	if err != nil {
+		rlog.Debug("readGuardianSet", "decode", err)
+		return nil, err
+	}
+	return &set, nil
+}
+
+// 读取监护人集合, 不存在或者已经删除时返回 ErrRetrieveNoGuardian
+func (action *Action) getGuardianSet(defaultAddress string) (*rt.GuardianSet, error) {
+	set, err := readGuardianSet(action.db, defaultAddress)
+	if err == types.ErrNotFound {
+		return nil, rt.ErrRetrieveNoGuardian
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(set.Guardians) == 0 {
+		return nil, rt.ErrRetrieveNoGuardian
+	}
+	return set, nil
+}
+
+func (action *Action) saveGuardianSet(set *rt.GuardianSet) []*types.KeyValue {
+	kv := &types.KeyValue{Key: GuardianKey(set.DefaultAddress), Value: types.Encode(set)}
+	action.db.Set(kv.Key, kv.Value)
+	return []*types.KeyValue{kv}
+}
+
+func (action *Action) checkGuardianFork() error {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, rt.RetrieveX, rt.ForkRetriveGuardianX) {
+		return types.ErrNotSupport
+	}
+	return nil
+}
+
+func guardianWeight(set *rt.GuardianSet, addr string) int32 {
+	for _, g := range set.Guardians {
+		if g.Address == addr {
+			return g.Weight
+		}
+	}
+	return 0
+}
+
+// 找回进行中, 包括收集批准和等待延迟期两个阶段
+func isRecovering(set *rt.GuardianSet) bool {
+	return set.Recovery != nil && (set.Recovery.Status == rt.RetrieveGuardianPrepare || set.Recovery.Status == rt.RetrieveGuardianApprove)
+}
+
+// 收集批准阶段超过 GuardianPrepareExpire 仍未达到门限, 防止单个监护人发起的找回一直占用
+func isPrepareExpired(set *rt.GuardianSet, blocktime int64) bool {
+	return set.Recovery != nil && set.Recovery.Status == rt.RetrieveGuardianPrepare &&
+		blocktime-set.Recovery.PrepareTime >= rt.GuardianPrepareExpire
+}
+
+func checkGuardians(g *rt.GuardianRetrieve) error {
+	if len(g.Guardians) > rt.MaxGuardian {
+		return rt.ErrRetrieveGuardianLimit
+	}
+	var total int64
+	seen := make(map[string]bool)
+	for _, guardian := range g.Guardians {
+		if err := address.CheckAddress(guardian.Address); err != nil {
+			return err
+		}
+		if guardian.Address == g.DefaultAddress || seen[guardian.Address] || guardian.Weight <= 0 {
+			rlog.Debug("RetrieveGuardian", "guardian", guardian.Address, "weight", guardian.Weight)
+			return rt.ErrRetrieveGuardian
+		}
+		seen[guardian.Address] = true
+		total += int64(guardian.Weight)
+	}
+	// 总权重不超过 int32, 批准权重累加时不会溢出
+	if total > math.MaxInt32 {
+		return rt.ErrRetrieveGuardianLimit
+	}
+	if g.Threshold <= 0 || int64(g.Threshold) > total {
+		return rt.ErrRetrieveThreshold
+	}
+	return nil
+}
+
+// RetrieveGuardian 设置或者删除监护人集合, 只能由 defaultAddress 发起, 找回进行中时不能修改
+func (action *Action) RetrieveGuardian(g *rt.GuardianRetrieve) (*types.Receipt, error) {
+	if err := action.checkGuardianFork(); err != nil {
+		return nil, err
+	}
+	if action.fromaddr != g.DefaultAddress {
+		rlog.Debug("RetrieveGuardian", "action.fromaddr", action.fromaddr, "DefaultAddress", g.DefaultAddress)
+		return nil, rt.ErrRetrieveDefaultAddress
+	}
+	old, err := readGuardianSet(action.db, g.DefaultAddress)
+	if err != nil && err != types.ErrNotFound {
+		rlog.Error("RetrieveGuardian", "readGuardianSet", err)
+		return nil, err
+	}
+	if old != nil && isRecovering(old) {
+		return nil, rt.ErrRetrieveStatus
+	}
+
+	set := &rt.GuardianSet{DefaultAddress: g.DefaultAddress}
+	if len(g.Guardians) != 0 {
+		if err := checkGuardians(g); err != nil {
+			return nil, err
+		}
+		set.Guardians = g.Guardians
+		set.Threshold = g.Threshold
+		set.DelayPeriod = g.DelayPeriod
+	}
+	kv := action.saveGuardianSet(set)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv}, nil
+}
+
+// 记录监护人的批准, 权重达到门限后开始计算延迟期
+func (action *Action) approveRecovery(set *rt.GuardianSet, weight int32) {
+	recovery := set.Recovery
+	recovery.Approvers = append(recovery.Approvers, action.fromaddr)
+	recovery.ApprovedWeight += weight
+	if recovery.ApprovedWeight >= set.Threshold {
+		recovery.Status = rt.RetrieveGuardianApprove
+		recovery.ApproveTime = action.blocktime
+	}
+}
+
+// RetrieveGuardianPrepare 任一监护人发起找回, 发起人的权重计入批准,
+// 已有的找回过期后可以由任一监护人重新发起替换
+func (action *Action) RetrieveGuardianPrepare(pre *rt.PrepareRetrieve) (*types.Receipt, error) {
+	if err := action.checkGuardianFork(); err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(pre.BackupAddress); err != nil {
+		return nil, err
+	}
+	set, err := action.getGuardianSet(pre.DefaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	weight := guardianWeight(set, action.fromaddr)
+	if weight == 0 {
+		return nil, rt.ErrRetrieveNotGuardian
+	}
+	if isRecovering(set) && !isPrepareExpired(set, action.blocktime) {
+		rlog.Debug("RetrieveGuardianPrepare", "Status", set.Recovery.Status)
+		return nil, rt.ErrRetrieveStatus
+	}
+
+	set.Recovery = &rt.GuardianRecovery{BackupAddress: pre.BackupAddress, Status: rt.RetrieveGuardianPrepare, PrepareTime: action.blocktime}
+	action.approveRecovery(set, weight)
+	kv := action.saveGuardianSet(set)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv}, nil
+}
+
+// RetrieveGuardianApprove 其他监护人签名批准找回
+func (action *Action) RetrieveGuardianApprove(approve *rt.ApproveRetrieve) (*types.Receipt, error) {
+	if err := action.checkGuardianFork(); err != nil {
+		return nil, err
+	}
+	set, err := action.getGuardianSet(approve.DefaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	weight := guardianWeight(set, action.fromaddr)
+	if weight == 0 {
+		return nil, rt.ErrRetrieveNotGuardian
+	}
+	if set.Recovery == nil || set.Recovery.Status != rt.RetrieveGuardianPrepare || isPrepareExpired(set, action.blocktime) {
+		return nil, rt.ErrRetrieveStatus
+	}
+	if set.Recovery.BackupAddress != approve.BackupAddress {
+		rlog.Debug("RetrieveGuardianApprove", "BackupAddress", set.Recovery.BackupAddress, "approve", approve.BackupAddress)
+		return nil, rt.ErrRetrieveRelation
+	}
+	for _, addr := range set.Recovery.Approvers {
+		if addr == action.fromaddr {
+			return nil, rt.ErrRetrieveRepeatApprove
+		}
+	}
+
+	action.approveRecovery(set, weight)
+	kv := action.saveGuardianSet(set)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv}, nil
+}
+
+// RetrieveGuardianPerform 批准后经过延迟期, 由监护人或者 backupAddress 执行找回,
+// 执行后仍可继续找回其他资产, 直到 defaultAddress 重新设置监护人
+func (action *Action) RetrieveGuardianPerform(perfRet *rt.PerformRetrieve) (*types.Receipt, error) {
+	if err := action.checkGuardianFork(); err != nil {
+		return nil, err
+	}
+	set, err := action.getGuardianSet(perfRet.DefaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	recovery := set.Recovery
+	if recovery == nil || (recovery.Status != rt.RetrieveGuardianApprove && recovery.Status != rt.RetrieveGuardianPerform) {
+		return nil, rt.ErrRetrieveStatus
+	}
+	if recovery.BackupAddress != perfRet.BackupAddress {
+		return nil, rt.ErrRetrieveRelation
+	}
+	if action.fromaddr != recovery.BackupAddress && guardianWeight(set, action.fromaddr) == 0 {
+		rlog.Debug("RetrieveGuardianPerform", "action.fromaddr", action.fromaddr)
+		return nil, rt.ErrRetrievePerformAddress
+	}
+	if action.blocktime-recovery.ApproveTime < set.DelayPeriod {
+		rlog.Debug("RetrieveGuardianPerform", "ErrRetrievePeriodLimit")
+		return nil, rt.ErrRetrievePeriodLimit
+	}
+
+	receipt, err := action.RetrievePerformAssets(perfRet, perfRet.DefaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	recovery.Status = rt.RetrieveGuardianPerform
+	receipt.KV = append(receipt.KV, action.saveGuardianSet(set)...)
+	return receipt, nil
+}
+
+// RetrieveGuardianCancel defaultAddress 在执行找回之前取消
+func (action *Action) RetrieveGuardianCancel(cancel *rt.CancelRetrieve) (*types.Receipt, error) {
+	if err := action.checkGuardianFork(); err != nil {
+		return nil, err
+	}
+	if action.fromaddr != cancel.DefaultAddress {
+		rlog.Debug("RetrieveGuardianCancel", "action.fromaddr", action.fromaddr, "DefaultAddress", cancel.DefaultAddress)
+		return nil, rt.ErrRetrieveCancelAddress
+	}
+	set, err := action.getGuardianSet(cancel.DefaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	if !isRecovering(set) {
+		return nil, rt.ErrRetrieveStatus
+	}
+	if set.Recovery.BackupAddress != cancel.BackupAddress {
+		return nil, rt.ErrRetrieveRelation
+	}
+
+	set.Recovery = nil
+	kv := action.saveGuardianSet(set)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv}, nil
+}
+
+// 监护人找回状态转换为 RetrieveQuery
+func guardianRetrieveQuery(set *rt.GuardianSet, blocktime int64) *rt.RetrieveQuery {
+	info := &rt.RetrieveQuery{
+		DefaultAddress: set.DefaultAddress,
+		DelayPeriod:    set.DelayPeriod,
+		Status:         retrieveBackup,
+		Guardians:      set.Guardians,
+		Threshold:      set.Threshold,
+	}
+	recovery := set.Recovery
+	if recovery == nil {
+		return info
+	}
+	info.BackupAddress = recovery.BackupAddress
+	info.PrepareTime = recovery.PrepareTime
+	info.ApprovedWeight = recovery.ApprovedWeight
+	info.Approvers = recovery.Approvers
+	switch recovery.Status {
+	case rt.RetrieveGuardianPrepare:
+		info.Status = retrievePrepare
+		info.RemainTime = set.DelayPeriod
+	case rt.RetrieveGuardianApprove:
+		info.Status = retrievePrepare
+		info.RemainTime = set.DelayPeriod - (blocktime - recovery.ApproveTime)
+		if info.RemainTime < 0 {
+			info.RemainTime = 0
+		}
+	case rt.RetrieveGuardianPerform:
+		info.Status = retrievePerform
+	}
+	return info
+}
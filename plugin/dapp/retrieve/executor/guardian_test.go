@@ -0,0 +1,181 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/queue"
+	drivers "github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	rt "github.com/33cn/plugin/plugin/dapp/retrieve/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGuardianRetrieve(t *testing.T) (drivers.Driver, *account.DB) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, err := client.New(q.Client(), nil)
+	assert.Nil(t, err)
+	r := newRetrieve()
+	_, _, kvdb := util.CreateTestDB()
+	r.SetAPI(api)
+	r.SetStateDB(kvdb)
+	r.SetLocalDB(kvdb)
+	r.SetEnv(100, 1000, 0)
+
+	coins, err := account.NewAccountDB(cfg, "coins", cfg.GetCoinSymbol(), kvdb)
+	assert.Nil(t, err)
+	return r, coins
+}
+
+func guardianTx(priv crypto.PrivKey, action *rt.RetrieveAction) *types.Transaction {
+	tx := &types.Transaction{Execer: []byte("retrieve"), Payload: types.Encode(action), Fee: 1e6, To: address.ExecAddress("retrieve")}
+	tx.Nonce = rand.Int63()
+	tx.Sign(types.SECP256K1, priv)
+	return tx
+}
+
+func TestGuardianRetrieve(t *testing.T) {
+	r, coins := newGuardianRetrieve(t)
+	execAddr := address.ExecAddress("retrieve")
+	owner, ownerPriv := genaddress()
+	backup, backupPriv := genaddress()
+	other, otherPriv := genaddress()
+	var addrs []string
+	var privs []crypto.PrivKey
+	for i := 0; i < 3; i++ {
+		addr, priv := genaddress()
+		addrs = append(addrs, addr)
+		privs = append(privs, priv)
+	}
+	coins.SaveExecAccount(execAddr, &types.Account{Addr: owner, Balance: 100 * types.Coin})
+
+	exec := func(priv crypto.PrivKey, action *rt.RetrieveAction) error {
+		_, err := r.Exec(guardianTx(priv, action), 0)
+		return err
+	}
+	set := func(priv crypto.PrivKey, threshold int32, delay int64) error {
+		g := &rt.GuardianRetrieve{DefaultAddress: owner, Threshold: threshold, DelayPeriod: delay}
+		g.Guardians = []*rt.Guardian{{Address: addrs[0], Weight: 1}, {Address: addrs[1], Weight: 1}, {Address: addrs[2], Weight: 2}}
+		return exec(priv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardian, Value: &rt.RetrieveAction_Guardian{Guardian: g}})
+	}
+	prepare := func(priv crypto.PrivKey) error {
+		pre := &rt.PrepareRetrieve{BackupAddress: backup, DefaultAddress: owner}
+		return exec(priv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardianPrepare, Value: &rt.RetrieveAction_GuardianPrepare{GuardianPrepare: pre}})
+	}
+	approve := func(priv crypto.PrivKey, backupAddr string) error {
+		ap := &rt.ApproveRetrieve{BackupAddress: backupAddr, DefaultAddress: owner}
+		return exec(priv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardianApprove, Value: &rt.RetrieveAction_GuardianApprove{GuardianApprove: ap}})
+	}
+	perform := func(priv crypto.PrivKey) error {
+		perf := &rt.PerformRetrieve{BackupAddress: backup, DefaultAddress: owner}
+		return exec(priv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardianPerform, Value: &rt.RetrieveAction_GuardianPerform{GuardianPerform: perf}})
+	}
+	cancel := func(priv crypto.PrivKey) error {
+		c := &rt.CancelRetrieve{BackupAddress: backup, DefaultAddress: owner}
+		return exec(priv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardianCancel, Value: &rt.RetrieveAction_GuardianCancel{GuardianCancel: c}})
+	}
+	query := func() *rt.RetrieveQuery {
+		msg, err := r.Query("GetGuardianRetrieveInfo", types.Encode(&rt.ReqRetrieveInfo{DefaultAddress: owner}))
+		assert.Nil(t, err)
+		return msg.(*rt.RetrieveQuery)
+	}
+
+	// 设置监护人
+	assert.Equal(t, rt.ErrRetrieveNoGuardian, prepare(privs[0]))
+	assert.Equal(t, rt.ErrRetrieveDefaultAddress, set(otherPriv, 2, 100))
+	assert.Equal(t, rt.ErrRetrieveThreshold, set(ownerPriv, 5, 100))
+	assert.Equal(t, rt.ErrRetrievePeriodLimit, set(ownerPriv, 2, 10))
+	big := &rt.GuardianRetrieve{DefaultAddress: owner, Threshold: 2, DelayPeriod: 100}
+	big.Guardians = []*rt.Guardian{{Address: addrs[0], Weight: math.MaxInt32}, {Address: addrs[1], Weight: 1}}
+	assert.Equal(t, rt.ErrRetrieveGuardianLimit, exec(ownerPriv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardian, Value: &rt.RetrieveAction_Guardian{Guardian: big}}))
+	assert.Nil(t, set(ownerPriv, 2, 100))
+	info := query()
+	assert.Equal(t, int32(retrieveBackup), info.Status)
+	assert.Equal(t, 3, len(info.Guardians))
+
+	// 发起后由 defaultAddress 取消
+	assert.Equal(t, rt.ErrRetrieveNotGuardian, prepare(otherPriv))
+	assert.Nil(t, prepare(privs[0]))
+	assert.Equal(t, rt.ErrRetrieveStatus, prepare(privs[1]))
+	assert.Equal(t, rt.ErrRetrieveCancelAddress, cancel(privs[0]))
+	assert.Nil(t, cancel(ownerPriv))
+	assert.Equal(t, rt.ErrRetrieveStatus, approve(privs[1], backup))
+
+	// 单个监护人发起到自己控制的地址, 过期前其他监护人不能替换, 过期后不能再批准, 由其他监护人重新发起
+	prepareTo := func(priv crypto.PrivKey, backupAddr string) error {
+		pre := &rt.PrepareRetrieve{BackupAddress: backupAddr, DefaultAddress: owner}
+		return exec(priv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardianPrepare, Value: &rt.RetrieveAction_GuardianPrepare{GuardianPrepare: pre}})
+	}
+	assert.Nil(t, prepareTo(privs[0], other))
+	r.SetEnv(100, 1000+rt.GuardianPrepareExpire-1, 0)
+	assert.Equal(t, rt.ErrRetrieveStatus, prepare(privs[1]))
+	r.SetEnv(100, 1000+rt.GuardianPrepareExpire, 0)
+	assert.Equal(t, rt.ErrRetrieveStatus, approve(privs[2], other))
+	assert.Nil(t, prepare(privs[1]))
+	info = query()
+	assert.Equal(t, backup, info.BackupAddress)
+	assert.Equal(t, []string{addrs[1]}, info.Approvers)
+	assert.Nil(t, cancel(ownerPriv))
+	r.SetEnv(100, 1000, 0)
+
+	// 收集批准
+	assert.Nil(t, prepare(privs[0]))
+	assert.Equal(t, rt.ErrRetrieveRepeatApprove, approve(privs[0], backup))
+	assert.Equal(t, rt.ErrRetrieveRelation, approve(privs[1], other))
+	assert.Equal(t, rt.ErrRetrieveNotGuardian, approve(otherPriv, backup))
+	assert.Equal(t, rt.ErrRetrieveStatus, perform(backupPriv))
+	info = query()
+	assert.Equal(t, int32(retrievePrepare), info.Status)
+	assert.Equal(t, int32(1), info.ApprovedWeight)
+
+	r.SetEnv(101, 1010, 0)
+	assert.Nil(t, approve(privs[1], backup))
+	assert.Equal(t, rt.ErrRetrieveStatus, approve(privs[2], backup))
+	info = query()
+	assert.Equal(t, int32(2), info.ApprovedWeight)
+	assert.Equal(t, []string{addrs[0], addrs[1]}, info.Approvers)
+	assert.Equal(t, int64(100), info.RemainTime)
+
+	// 延迟期内不能执行, 监护人也不能修改
+	r.SetEnv(102, 1060, 0)
+	assert.Equal(t, rt.ErrRetrievePeriodLimit, perform(backupPriv))
+	assert.Equal(t, rt.ErrRetrieveStatus, set(ownerPriv, 2, 100))
+	assert.Equal(t, int64(50), query().RemainTime)
+
+	// 延迟期后执行
+	r.SetEnv(103, 1110, 0)
+	assert.Equal(t, rt.ErrRetrievePerformAddress, perform(otherPriv))
+	assert.Nil(t, perform(privs[2]))
+	assert.Equal(t, int64(0), coins.LoadExecAccount(owner, execAddr).Balance)
+	assert.Equal(t, 100*types.Coin, coins.LoadExecAccount(backup, execAddr).Balance)
+	assert.Equal(t, int32(retrievePerform), query().Status)
+	assert.Equal(t, rt.ErrRetrieveNoBalance, perform(backupPriv))
+	assert.Equal(t, rt.ErrRetrieveStatus, cancel(ownerPriv))
+
+	// 删除监护人
+	g := &rt.GuardianRetrieve{DefaultAddress: owner}
+	assert.Nil(t, exec(ownerPriv, &rt.RetrieveAction{Ty: rt.RetrieveActionGuardian, Value: &rt.RetrieveAction_Guardian{Guardian: g}}))
+	assert.Equal(t, rt.ErrRetrieveNoGuardian, prepare(privs[0]))
+	_, err := r.Query("GetGuardianRetrieveInfo", types.Encode(&rt.ReqRetrieveInfo{DefaultAddress: owner}))
+	assert.Equal(t, rt.ErrRetrieveNoGuardian, err)
+}
+
+func TestGuardianRetrieveFork(t *testing.T) {
+	g := &rt.GuardianRetrieve{DefaultAddress: defaultAddr, Threshold: 1, DelayPeriod: 100}
+	g.Guardians = []*rt.Guardian{{Address: backupAddr, Weight: 1}}
+	action := &rt.RetrieveAction{Ty: rt.RetrieveActionGuardian, Value: &rt.RetrieveAction_Guardian{Guardian: g}}
+	_, err := retrieve.Exec(guardianTx(defaultPriv, action), 0)
+	assert.Equal(t, types.ErrNotSupport, err)
+}
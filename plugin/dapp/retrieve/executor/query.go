@@ -44,3 +44,16 @@ func (r *Retrieve) Query_GetRetrieveInfo(in *rt.ReqRetrieveInfo) (types.Message,
 	}
 	return info, nil
 }
+
+// Query_GetGuardianRetrieveInfo get guardian set and recovery state of defaultAddress
+func (r *Retrieve) Query_GetGuardianRetrieveInfo(in *rt.ReqRetrieveInfo) (types.Message, error) {
+	rlog.Debug("Retrieve Query guardian", "defaddr", in.DefaultAddress)
+	set, err := readGuardianSet(r.GetStateDB(), in.DefaultAddress)
+	if err != nil {
+		return nil, err
+	}
+	if len(set.Guardians) == 0 {
+		return nil, rt.ErrRetrieveNoGuardian
+	}
+	return guardianRetrieveQuery(set, r.GetBlockTime()), nil
+}
@@ -79,3 +79,86 @@ func (c *Jrpc) CreateRawRetrieveCancelTx(in *RetrieveCancelTx, result *interface
 	*result = hex.EncodeToString(reply.Data)
 	return nil
 }
+
+// CreateRawRetrieveGuardianTx construct guardian set tx
+func (c *Jrpc) CreateRawRetrieveGuardianTx(in *RetrieveGuardianTx, result *interface{}) error {
+	head := &types.GuardianRetrieve{
+		DefaultAddress: in.DefaultAddr,
+		Threshold:      in.Threshold,
+		DelayPeriod:    in.DelayPeriod,
+	}
+	for _, g := range in.Guardians {
+		head.Guardians = append(head.Guardians, &types.Guardian{Address: g.Address, Weight: g.Weight})
+	}
+	reply, err := c.cli.Guardian(context.Background(), head)
+	if err != nil {
+		return err
+	}
+
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
+
+// CreateRawRetrieveGuardianPrepareTx construct guardian prepare tx
+func (c *Jrpc) CreateRawRetrieveGuardianPrepareTx(in *RetrievePrepareTx, result *interface{}) error {
+	head := &types.PrepareRetrieve{
+		BackupAddress:  in.BackupAddr,
+		DefaultAddress: in.DefaultAddr,
+	}
+	reply, err := c.cli.GuardianPrepare(context.Background(), head)
+	if err != nil {
+		return err
+	}
+
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
+
+// CreateRawRetrieveGuardianApproveTx construct guardian approve tx
+func (c *Jrpc) CreateRawRetrieveGuardianApproveTx(in *RetrieveApproveTx, result *interface{}) error {
+	head := &types.ApproveRetrieve{
+		BackupAddress:  in.BackupAddr,
+		DefaultAddress: in.DefaultAddr,
+	}
+	reply, err := c.cli.GuardianApprove(context.Background(), head)
+	if err != nil {
+		return err
+	}
+
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
+
+// CreateRawRetrieveGuardianPerformTx construct guardian perform tx
+func (c *Jrpc) CreateRawRetrieveGuardianPerformTx(in *RetrievePerformTx, result *interface{}) error {
+	head := &types.PerformRetrieve{
+		BackupAddress:  in.BackupAddr,
+		DefaultAddress: in.DefaultAddr,
+		Assets:         []*types.AssetSymbol{},
+	}
+	for i := 0; i < len(in.Assets); i++ {
+		head.Assets = append(head.Assets, &types.AssetSymbol{Exec: in.Assets[i].Exec, Symbol: in.Assets[i].Symbol})
+	}
+	reply, err := c.cli.GuardianPerform(context.Background(), head)
+	if err != nil {
+		return err
+	}
+
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
+
+// CreateRawRetrieveGuardianCancelTx construct guardian cancel tx
+func (c *Jrpc) CreateRawRetrieveGuardianCancelTx(in *RetrieveCancelTx, result *interface{}) error {
+	head := &types.CancelRetrieve{
+		BackupAddress:  in.BackupAddr,
+		DefaultAddress: in.DefaultAddr,
+	}
+	reply, err := c.cli.GuardianCancel(context.Background(), head)
+	if err != nil {
+		return err
+	}
+
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
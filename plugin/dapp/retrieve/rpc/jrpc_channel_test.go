@@ -46,6 +46,12 @@ func TestJRPCChannel(t *testing.T) {
 		{fn: testPerformCmd},
 		{fn: testCancelCmd},
 		{fn: testRetrieveQueryCmd},
+		{fn: testGuardianCmd},
+		{fn: testGuardianPrepareCmd},
+		{fn: testGuardianApproveCmd},
+		{fn: testGuardianPerformCmd},
+		{fn: testGuardianCancelCmd},
+		{fn: testGuardianQueryCmd},
 	}
 	for index, testCase := range testCases {
 		err := testCase.fn(t, jrpcClient)
@@ -110,3 +116,60 @@ func testRetrieveQueryCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
 	rep = &pty.RetrieveQuery{}
 	return jrpc.Call("Chain33.Query", params, rep)
 }
+
+func testGuardianCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := rpc.RetrieveGuardianTx{
+		DefaultAddr: "d",
+		Guardians:   []rpc.Guardian{{Address: "g1", Weight: 1}, {Address: "g2", Weight: 2}},
+		Threshold:   2,
+		DelayPeriod: 60,
+	}
+	var txS string
+	err := jrpc.Call("retrieve.CreateRawRetrieveGuardianTx", &params, &txS)
+	assert.Nil(t, err)
+	var tx types.Transaction
+	bytes, err := common.FromHex(txS)
+	if err != nil {
+		return err
+	}
+	err = types.Decode(bytes, &tx)
+	if err != nil {
+		return err
+	}
+	var p2 pty.RetrieveAction
+	err = types.Decode(tx.Payload, &p2)
+	assert.Equal(t, int32(pty.RetrieveActionGuardian), p2.Ty)
+	assert.Equal(t, 2, len(p2.GetGuardian().GetGuardians()))
+	return err
+}
+
+func testGuardianPrepareCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := rpc.RetrievePrepareTx{}
+	return jrpc.Call("retrieve.CreateRawRetrieveGuardianPrepareTx", params, nil)
+}
+
+func testGuardianApproveCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := rpc.RetrieveApproveTx{}
+	return jrpc.Call("retrieve.CreateRawRetrieveGuardianApproveTx", params, nil)
+}
+
+func testGuardianPerformCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := rpc.RetrievePerformTx{}
+	return jrpc.Call("retrieve.CreateRawRetrieveGuardianPerformTx", params, nil)
+}
+
+func testGuardianCancelCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	params := rpc.RetrieveCancelTx{}
+	return jrpc.Call("retrieve.CreateRawRetrieveGuardianCancelTx", params, nil)
+}
+
+func testGuardianQueryCmd(t *testing.T, jrpc *jsonclient.JSONClient) error {
+	var rep interface{}
+	var params rpctypes.Query4Jrpc
+	req := &pty.ReqRetrieveInfo{}
+	params.Execer = "retrieve"
+	params.FuncName = "GetGuardianRetrieveInfo"
+	params.Payload = types.MustPBToJSON(req)
+	rep = &pty.RetrieveQuery{}
+	return jrpc.Call("Chain33.Query", params, rep)
+}
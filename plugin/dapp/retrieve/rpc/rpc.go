@@ -69,3 +69,48 @@ func (c *channelClient) Cancel(ctx context.Context, v *rt.CancelRetrieve) (*type
 	data := types.Encode(tx)
 	return &types.UnsignTx{Data: data}, nil
 }
+
+func (c *channelClient) createRetrieveTx(action *rt.RetrieveAction) (*types.UnsignTx, error) {
+	cfg := c.GetConfig()
+	tx, err := types.CreateFormatTx(cfg, cfg.ExecName(rt.RetrieveX), types.Encode(action))
+	if err != nil {
+		return nil, err
+	}
+	data := types.Encode(tx)
+	return &types.UnsignTx{Data: data}, nil
+}
+
+func (c *channelClient) Guardian(ctx context.Context, v *rt.GuardianRetrieve) (*types.UnsignTx, error) {
+	return c.createRetrieveTx(&rt.RetrieveAction{
+		Ty:    rt.RetrieveActionGuardian,
+		Value: &rt.RetrieveAction_Guardian{Guardian: v},
+	})
+}
+
+func (c *channelClient) GuardianPrepare(ctx context.Context, v *rt.PrepareRetrieve) (*types.UnsignTx, error) {
+	return c.createRetrieveTx(&rt.RetrieveAction{
+		Ty:    rt.RetrieveActionGuardianPrepare,
+		Value: &rt.RetrieveAction_GuardianPrepare{GuardianPrepare: v},
+	})
+}
+
+func (c *channelClient) GuardianApprove(ctx context.Context, v *rt.ApproveRetrieve) (*types.UnsignTx, error) {
+	return c.createRetrieveTx(&rt.RetrieveAction{
+		Ty:    rt.RetrieveActionGuardianApprove,
+		Value: &rt.RetrieveAction_GuardianApprove{GuardianApprove: v},
+	})
+}
+
+func (c *channelClient) GuardianPerform(ctx context.Context, v *rt.PerformRetrieve) (*types.UnsignTx, error) {
+	return c.createRetrieveTx(&rt.RetrieveAction{
+		Ty:    rt.RetrieveActionGuardianPerform,
+		Value: &rt.RetrieveAction_GuardianPerform{GuardianPerform: v},
+	})
+}
+
+func (c *channelClient) GuardianCancel(ctx context.Context, v *rt.CancelRetrieve) (*types.UnsignTx, error) {
+	return c.createRetrieveTx(&rt.RetrieveAction{
+		Ty:    rt.RetrieveActionGuardianCancel,
+		Value: &rt.RetrieveAction_GuardianCancel{GuardianCancel: v},
+	})
+}
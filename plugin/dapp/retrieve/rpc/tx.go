@@ -39,3 +39,25 @@ type RetrieveCancelTx struct {
 	DefaultAddr string `json:"defaultAddr"`
 	Fee         int64  `json:"fee"`
 }
+
+// Guardian guardian address and weight
+type Guardian struct {
+	Address string `json:"address"`
+	Weight  int32  `json:"weight"`
+}
+
+// RetrieveGuardianTx construction
+type RetrieveGuardianTx struct {
+	DefaultAddr string     `json:"defaultAddr"`
+	Guardians   []Guardian `json:"guardians"`
+	Threshold   int32      `json:"threshold"`
+	DelayPeriod int64      `json:"delayPeriod"`
+	Fee         int64      `json:"fee"`
+}
+
+// RetrieveApproveTx construction
+type RetrieveApproveTx struct {
+	BackupAddr  string `json:"backupAddr"`
+	DefaultAddr string `json:"defaultAddr"`
+	Fee         int64  `json:"fee"`
+}
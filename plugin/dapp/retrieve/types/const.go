@@ -18,8 +18,27 @@ const (
 	RetrieveActionPerform = 2
 	RetrieveActionBackup  = 3
 	RetrieveActionCancel  = 4
+
+	RetrieveActionGuardian        = 5
+	RetrieveActionGuardianPrepare = 6
+	RetrieveActionGuardianApprove = 7
+	RetrieveActionGuardianPerform = 8
+	RetrieveActionGuardianCancel  = 9
+)
+
+// guardian retrieve status
+const (
+	RetrieveGuardianPrepare = iota + 1
+	RetrieveGuardianApprove
+	RetrieveGuardianPerform
 )
 
+// MaxGuardian 每个地址最多设置的监护人数
+const MaxGuardian = 20
+
+// GuardianPrepareExpire 发起后未达到门限的找回过期时间(秒), 过期后任一监护人可以重新发起
+const GuardianPrepareExpire = 24 * 3600
+
 // retrieve names
 var (
 	JRPCName  = "Retrieve"
@@ -32,8 +51,16 @@ var (
 		"Perform": RetrieveActionPerform,
 		"Backup":  RetrieveActionBackup,
 		"Cancel":  RetrieveActionCancel,
+
+		"Guardian":        RetrieveActionGuardian,
+		"GuardianPrepare": RetrieveActionGuardianPrepare,
+		"GuardianApprove": RetrieveActionGuardianApprove,
+		"GuardianPerform": RetrieveActionGuardianPerform,
+		"GuardianCancel":  RetrieveActionGuardianCancel,
 	}
 
 	ForkRetriveAssetX = "ForkRetriveAsset"
 	ForkRetriveX      = "ForkRetrive"
+	// ForkRetriveGuardianX 支持多监护人找回
+	ForkRetriveGuardianX = "ForkRetriveGuardian"
 )
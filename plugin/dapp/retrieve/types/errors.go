@@ -20,4 +20,10 @@ var (
 	ErrRetrieveRelateLimit     = errors.New("ErrRetrieveRelateLimit")
 	ErrRetrieveRelation        = errors.New("ErrRetrieveRelation")
 	ErrRetrieveNoBalance       = errors.New("ErrRetrieveNoBalance")
+	ErrRetrieveGuardian        = errors.New("ErrRetrieveGuardian")
+	ErrRetrieveGuardianLimit   = errors.New("ErrRetrieveGuardianLimit")
+	ErrRetrieveThreshold       = errors.New("ErrRetrieveThreshold")
+	ErrRetrieveNotGuardian     = errors.New("ErrRetrieveNotGuardian")
+	ErrRetrieveRepeatApprove   = errors.New("ErrRetrieveRepeatApprove")
+	ErrRetrieveNoGuardian      = errors.New("ErrRetrieveNoGuardian")
 )
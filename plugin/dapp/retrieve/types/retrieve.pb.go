@@ -152,6 +152,11 @@ type RetrieveAction struct {
 	//	*RetrieveAction_Perform
 	//	*RetrieveAction_Backup
 	//	*RetrieveAction_Cancel
+	//	*RetrieveAction_Guardian
+	//	*RetrieveAction_GuardianPrepare
+	//	*RetrieveAction_GuardianApprove
+	//	*RetrieveAction_GuardianPerform
+	//	*RetrieveAction_GuardianCancel
 	Value                isRetrieveAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,5,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -204,6 +209,26 @@ type RetrieveAction_Cancel struct {
 	Cancel *CancelRetrieve `protobuf:"bytes,4,opt,name=cancel,proto3,oneof"`
 }
 
+type RetrieveAction_Guardian struct {
+	Guardian *GuardianRetrieve `protobuf:"bytes,6,opt,name=guardian,proto3,oneof"`
+}
+
+type RetrieveAction_GuardianPrepare struct {
+	GuardianPrepare *PrepareRetrieve `protobuf:"bytes,7,opt,name=guardianPrepare,proto3,oneof"`
+}
+
+type RetrieveAction_GuardianApprove struct {
+	GuardianApprove *ApproveRetrieve `protobuf:"bytes,8,opt,name=guardianApprove,proto3,oneof"`
+}
+
+type RetrieveAction_GuardianPerform struct {
+	GuardianPerform *PerformRetrieve `protobuf:"bytes,9,opt,name=guardianPerform,proto3,oneof"`
+}
+
+type RetrieveAction_GuardianCancel struct {
+	GuardianCancel *CancelRetrieve `protobuf:"bytes,10,opt,name=guardianCancel,proto3,oneof"`
+}
+
 func (*RetrieveAction_Prepare) isRetrieveAction_Value() {}
 
 func (*RetrieveAction_Perform) isRetrieveAction_Value() {}
@@ -212,6 +237,16 @@ func (*RetrieveAction_Backup) isRetrieveAction_Value() {}
 
 func (*RetrieveAction_Cancel) isRetrieveAction_Value() {}
 
+func (*RetrieveAction_Guardian) isRetrieveAction_Value() {}
+
+func (*RetrieveAction_GuardianPrepare) isRetrieveAction_Value() {}
+
+func (*RetrieveAction_GuardianApprove) isRetrieveAction_Value() {}
+
+func (*RetrieveAction_GuardianPerform) isRetrieveAction_Value() {}
+
+func (*RetrieveAction_GuardianCancel) isRetrieveAction_Value() {}
+
 func (m *RetrieveAction) GetValue() isRetrieveAction_Value {
 	if m != nil {
 		return m.Value
@@ -247,6 +282,41 @@ func (m *RetrieveAction) GetCancel() *CancelRetrieve {
 	return nil
 }
 
+func (m *RetrieveAction) GetGuardian() *GuardianRetrieve {
+	if x, ok := m.GetValue().(*RetrieveAction_Guardian); ok {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (m *RetrieveAction) GetGuardianPrepare() *PrepareRetrieve {
+	if x, ok := m.GetValue().(*RetrieveAction_GuardianPrepare); ok {
+		return x.GuardianPrepare
+	}
+	return nil
+}
+
+func (m *RetrieveAction) GetGuardianApprove() *ApproveRetrieve {
+	if x, ok := m.GetValue().(*RetrieveAction_GuardianApprove); ok {
+		return x.GuardianApprove
+	}
+	return nil
+}
+
+func (m *RetrieveAction) GetGuardianPerform() *PerformRetrieve {
+	if x, ok := m.GetValue().(*RetrieveAction_GuardianPerform); ok {
+		return x.GuardianPerform
+	}
+	return nil
+}
+
+func (m *RetrieveAction) GetGuardianCancel() *CancelRetrieve {
+	if x, ok := m.GetValue().(*RetrieveAction_GuardianCancel); ok {
+		return x.GuardianCancel
+	}
+	return nil
+}
+
 func (m *RetrieveAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -261,6 +331,11 @@ func (*RetrieveAction) XXX_OneofWrappers() []interface{} {
 		(*RetrieveAction_Perform)(nil),
 		(*RetrieveAction_Backup)(nil),
 		(*RetrieveAction_Cancel)(nil),
+		(*RetrieveAction_Guardian)(nil),
+		(*RetrieveAction_GuardianPrepare)(nil),
+		(*RetrieveAction_GuardianApprove)(nil),
+		(*RetrieveAction_GuardianPerform)(nil),
+		(*RetrieveAction_GuardianCancel)(nil),
 	}
 }
 
@@ -515,6 +590,318 @@ func (m *CancelRetrieve) GetDefaultAddress() string {
 	return ""
 }
 
+// 监护人及其权重
+type Guardian struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Weight               int32    `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Guardian) Reset()         { *m = Guardian{} }
+func (m *Guardian) String() string { return proto.CompactTextString(m) }
+func (*Guardian) ProtoMessage()    {}
+func (*Guardian) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7b02fb18d30b6d, []int{8}
+}
+
+func (m *Guardian) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Guardian.Unmarshal(m, b)
+}
+func (m *Guardian) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Guardian.Marshal(b, m, deterministic)
+}
+func (m *Guardian) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Guardian.Merge(m, src)
+}
+func (m *Guardian) XXX_Size() int {
+	return xxx_messageInfo_Guardian.Size(m)
+}
+func (m *Guardian) XXX_DiscardUnknown() {
+	xxx_messageInfo_Guardian.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Guardian proto.InternalMessageInfo
+
+func (m *Guardian) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Guardian) GetWeight() int32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// 设置监护人集合, guardians 为空时删除
+type GuardianRetrieve struct {
+	DefaultAddress       string      `protobuf:"bytes,1,opt,name=defaultAddress,proto3" json:"defaultAddress,omitempty"`
+	Guardians            []*Guardian `protobuf:"bytes,2,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	Threshold            int32       `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	DelayPeriod          int64       `protobuf:"varint,4,opt,name=delayPeriod,proto3" json:"delayPeriod,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GuardianRetrieve) Reset()         { *m = GuardianRetrieve{} }
+func (m *GuardianRetrieve) String() string { return proto.CompactTextString(m) }
+func (*GuardianRetrieve) ProtoMessage()    {}
+func (*GuardianRetrieve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7b02fb18d30b6d, []int{9}
+}
+
+func (m *GuardianRetrieve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GuardianRetrieve.Unmarshal(m, b)
+}
+func (m *GuardianRetrieve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GuardianRetrieve.Marshal(b, m, deterministic)
+}
+func (m *GuardianRetrieve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianRetrieve.Merge(m, src)
+}
+func (m *GuardianRetrieve) XXX_Size() int {
+	return xxx_messageInfo_GuardianRetrieve.Size(m)
+}
+func (m *GuardianRetrieve) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianRetrieve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianRetrieve proto.InternalMessageInfo
+
+func (m *GuardianRetrieve) GetDefaultAddress() string {
+	if m != nil {
+		return m.DefaultAddress
+	}
+	return ""
+}
+
+func (m *GuardianRetrieve) GetGuardians() []*Guardian {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *GuardianRetrieve) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GuardianRetrieve) GetDelayPeriod() int64 {
+	if m != nil {
+		return m.DelayPeriod
+	}
+	return 0
+}
+
+// 监护人批准找回, backupAddress 需与发起时一致
+type ApproveRetrieve struct {
+	BackupAddress        string   `protobuf:"bytes,1,opt,name=backupAddress,proto3" json:"backupAddress,omitempty"`
+	DefaultAddress       string   `protobuf:"bytes,2,opt,name=defaultAddress,proto3" json:"defaultAddress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveRetrieve) Reset()         { *m = ApproveRetrieve{} }
+func (m *ApproveRetrieve) String() string { return proto.CompactTextString(m) }
+func (*ApproveRetrieve) ProtoMessage()    {}
+func (*ApproveRetrieve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7b02fb18d30b6d, []int{10}
+}
+
+func (m *ApproveRetrieve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveRetrieve.Unmarshal(m, b)
+}
+func (m *ApproveRetrieve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveRetrieve.Marshal(b, m, deterministic)
+}
+func (m *ApproveRetrieve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveRetrieve.Merge(m, src)
+}
+func (m *ApproveRetrieve) XXX_Size() int {
+	return xxx_messageInfo_ApproveRetrieve.Size(m)
+}
+func (m *ApproveRetrieve) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveRetrieve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveRetrieve proto.InternalMessageInfo
+
+func (m *ApproveRetrieve) GetBackupAddress() string {
+	if m != nil {
+		return m.BackupAddress
+	}
+	return ""
+}
+
+func (m *ApproveRetrieve) GetDefaultAddress() string {
+	if m != nil {
+		return m.DefaultAddress
+	}
+	return ""
+}
+
+// 进行中的监护人找回
+type GuardianRecovery struct {
+	BackupAddress        string   `protobuf:"bytes,1,opt,name=backupAddress,proto3" json:"backupAddress,omitempty"`
+	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	PrepareTime          int64    `protobuf:"varint,3,opt,name=prepareTime,proto3" json:"prepareTime,omitempty"`
+	ApproveTime          int64    `protobuf:"varint,4,opt,name=approveTime,proto3" json:"approveTime,omitempty"`
+	Approvers            []string `protobuf:"bytes,5,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	ApprovedWeight       int32    `protobuf:"varint,6,opt,name=approvedWeight,proto3" json:"approvedWeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GuardianRecovery) Reset()         { *m = GuardianRecovery{} }
+func (m *GuardianRecovery) String() string { return proto.CompactTextString(m) }
+func (*GuardianRecovery) ProtoMessage()    {}
+func (*GuardianRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7b02fb18d30b6d, []int{11}
+}
+
+func (m *GuardianRecovery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GuardianRecovery.Unmarshal(m, b)
+}
+func (m *GuardianRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GuardianRecovery.Marshal(b, m, deterministic)
+}
+func (m *GuardianRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianRecovery.Merge(m, src)
+}
+func (m *GuardianRecovery) XXX_Size() int {
+	return xxx_messageInfo_GuardianRecovery.Size(m)
+}
+func (m *GuardianRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianRecovery proto.InternalMessageInfo
+
+func (m *GuardianRecovery) GetBackupAddress() string {
+	if m != nil {
+		return m.BackupAddress
+	}
+	return ""
+}
+
+func (m *GuardianRecovery) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GuardianRecovery) GetPrepareTime() int64 {
+	if m != nil {
+		return m.PrepareTime
+	}
+	return 0
+}
+
+func (m *GuardianRecovery) GetApproveTime() int64 {
+	if m != nil {
+		return m.ApproveTime
+	}
+	return 0
+}
+
+func (m *GuardianRecovery) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *GuardianRecovery) GetApprovedWeight() int32 {
+	if m != nil {
+		return m.ApprovedWeight
+	}
+	return 0
+}
+
+// 监护人集合状态, 以 defaultAddress 为 key
+type GuardianSet struct {
+	DefaultAddress       string            `protobuf:"bytes,1,opt,name=defaultAddress,proto3" json:"defaultAddress,omitempty"`
+	Guardians            []*Guardian       `protobuf:"bytes,2,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	Threshold            int32             `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	DelayPeriod          int64             `protobuf:"varint,4,opt,name=delayPeriod,proto3" json:"delayPeriod,omitempty"`
+	Recovery             *GuardianRecovery `protobuf:"bytes,5,opt,name=recovery,proto3" json:"recovery,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GuardianSet) Reset()         { *m = GuardianSet{} }
+func (m *GuardianSet) String() string { return proto.CompactTextString(m) }
+func (*GuardianSet) ProtoMessage()    {}
+func (*GuardianSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef7b02fb18d30b6d, []int{12}
+}
+
+func (m *GuardianSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GuardianSet.Unmarshal(m, b)
+}
+func (m *GuardianSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GuardianSet.Marshal(b, m, deterministic)
+}
+func (m *GuardianSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianSet.Merge(m, src)
+}
+func (m *GuardianSet) XXX_Size() int {
+	return xxx_messageInfo_GuardianSet.Size(m)
+}
+func (m *GuardianSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianSet proto.InternalMessageInfo
+
+func (m *GuardianSet) GetDefaultAddress() string {
+	if m != nil {
+		return m.DefaultAddress
+	}
+	return ""
+}
+
+func (m *GuardianSet) GetGuardians() []*Guardian {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *GuardianSet) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *GuardianSet) GetDelayPeriod() int64 {
+	if m != nil {
+		return m.DelayPeriod
+	}
+	return 0
+}
+
+func (m *GuardianSet) GetRecovery() *GuardianRecovery {
+	if m != nil {
+		return m.Recovery
+	}
+	return nil
+}
+
 type ReqRetrieveInfo struct {
 	BackupAddress        string   `protobuf:"bytes,1,opt,name=backupAddress,proto3" json:"backupAddress,omitempty"`
 	DefaultAddress       string   `protobuf:"bytes,2,opt,name=defaultAddress,proto3" json:"defaultAddress,omitempty"`
@@ -529,7 +916,7 @@ func (m *ReqRetrieveInfo) Reset()         { *m = ReqRetrieveInfo{} }
 func (m *ReqRetrieveInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqRetrieveInfo) ProtoMessage()    {}
 func (*ReqRetrieveInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef7b02fb18d30b6d, []int{8}
+	return fileDescriptor_ef7b02fb18d30b6d, []int{13}
 }
 
 func (m *ReqRetrieveInfo) XXX_Unmarshal(b []byte) error {
@@ -579,22 +966,27 @@ func (m *ReqRetrieveInfo) GetAssetSymbol() string {
 }
 
 type RetrieveQuery struct {
-	BackupAddress        string   `protobuf:"bytes,1,opt,name=backupAddress,proto3" json:"backupAddress,omitempty"`
-	DefaultAddress       string   `protobuf:"bytes,2,opt,name=defaultAddress,proto3" json:"defaultAddress,omitempty"`
-	DelayPeriod          int64    `protobuf:"varint,3,opt,name=delayPeriod,proto3" json:"delayPeriod,omitempty"`
-	PrepareTime          int64    `protobuf:"varint,4,opt,name=prepareTime,proto3" json:"prepareTime,omitempty"`
-	RemainTime           int64    `protobuf:"varint,5,opt,name=remainTime,proto3" json:"remainTime,omitempty"`
-	Status               int32    `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	BackupAddress  string `protobuf:"bytes,1,opt,name=backupAddress,proto3" json:"backupAddress,omitempty"`
+	DefaultAddress string `protobuf:"bytes,2,opt,name=defaultAddress,proto3" json:"defaultAddress,omitempty"`
+	DelayPeriod    int64  `protobuf:"varint,3,opt,name=delayPeriod,proto3" json:"delayPeriod,omitempty"`
+	PrepareTime    int64  `protobuf:"varint,4,opt,name=prepareTime,proto3" json:"prepareTime,omitempty"`
+	RemainTime     int64  `protobuf:"varint,5,opt,name=remainTime,proto3" json:"remainTime,omitempty"`
+	Status         int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	// 监护人找回信息
+	Guardians            []*Guardian `protobuf:"bytes,7,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	Threshold            int32       `protobuf:"varint,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ApprovedWeight       int32       `protobuf:"varint,9,opt,name=approvedWeight,proto3" json:"approvedWeight,omitempty"`
+	Approvers            []string    `protobuf:"bytes,10,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *RetrieveQuery) Reset()         { *m = RetrieveQuery{} }
 func (m *RetrieveQuery) String() string { return proto.CompactTextString(m) }
 func (*RetrieveQuery) ProtoMessage()    {}
 func (*RetrieveQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef7b02fb18d30b6d, []int{9}
+	return fileDescriptor_ef7b02fb18d30b6d, []int{14}
 }
 
 func (m *RetrieveQuery) XXX_Unmarshal(b []byte) error {
@@ -657,6 +1049,34 @@ func (m *RetrieveQuery) GetStatus() int32 {
 	return 0
 }
 
+func (m *RetrieveQuery) GetGuardians() []*Guardian {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *RetrieveQuery) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *RetrieveQuery) GetApprovedWeight() int32 {
+	if m != nil {
+		return m.ApprovedWeight
+	}
+	return 0
+}
+
+func (m *RetrieveQuery) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RetrievePara)(nil), "types.RetrievePara")
 	proto.RegisterType((*Retrieve)(nil), "types.Retrieve")
@@ -666,6 +1086,11 @@ func init() {
 	proto.RegisterType((*AssetSymbol)(nil), "types.AssetSymbol")
 	proto.RegisterType((*PerformRetrieve)(nil), "types.PerformRetrieve")
 	proto.RegisterType((*CancelRetrieve)(nil), "types.CancelRetrieve")
+	proto.RegisterType((*Guardian)(nil), "types.Guardian")
+	proto.RegisterType((*GuardianRetrieve)(nil), "types.GuardianRetrieve")
+	proto.RegisterType((*ApproveRetrieve)(nil), "types.ApproveRetrieve")
+	proto.RegisterType((*GuardianRecovery)(nil), "types.GuardianRecovery")
+	proto.RegisterType((*GuardianSet)(nil), "types.GuardianSet")
 	proto.RegisterType((*ReqRetrieveInfo)(nil), "types.ReqRetrieveInfo")
 	proto.RegisterType((*RetrieveQuery)(nil), "types.RetrieveQuery")
 }
@@ -675,42 +1100,60 @@ func init() {
 }
 
 var fileDescriptor_ef7b02fb18d30b6d = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xed, 0xc6, 0x8d, 0xd3, 0x4c, 0xa8, 0x23, 0x16, 0x51, 0x59, 0x15, 0xaa, 0x22, 0x0b, 0xa1,
-	0x08, 0x89, 0x20, 0x19, 0x2e, 0x1c, 0x53, 0x84, 0x04, 0xb7, 0x60, 0xca, 0x15, 0xb4, 0x71, 0x26,
-	0xc8, 0x22, 0xb1, 0xc3, 0xee, 0xa6, 0xaa, 0x6f, 0xdc, 0xf9, 0x08, 0xfe, 0x81, 0xbf, 0xe1, 0xc2,
-	0x4f, 0xf0, 0x03, 0xc8, 0xb3, 0x6b, 0x65, 0x13, 0x1c, 0xa9, 0x87, 0xa8, 0xb7, 0xf8, 0xed, 0x7b,
-	0xeb, 0x99, 0x37, 0x93, 0x67, 0x08, 0x24, 0x6a, 0x99, 0xe1, 0x35, 0x8e, 0x56, 0xb2, 0xd0, 0x05,
-	0x6f, 0xeb, 0x72, 0x85, 0xea, 0xfc, 0xbe, 0x96, 0x22, 0x57, 0x22, 0xd5, 0x59, 0x91, 0x9b, 0x93,
-	0xe8, 0x17, 0x83, 0x7b, 0x89, 0x25, 0x4f, 0x84, 0x14, 0xfc, 0x09, 0x04, 0x33, 0x9c, 0x8b, 0xf5,
-	0x42, 0x8f, 0x67, 0x33, 0x89, 0x4a, 0x85, 0x6c, 0xc0, 0x86, 0xdd, 0x64, 0x07, 0xe5, 0x67, 0xe0,
-	0x2b, 0x2d, 0xf4, 0x5a, 0x85, 0xad, 0x01, 0x1b, 0xb6, 0x13, 0xfb, 0xc4, 0x2f, 0x00, 0x52, 0x89,
-	0x42, 0xe3, 0x55, 0xb6, 0xc4, 0xd0, 0x1b, 0xb0, 0xa1, 0x97, 0x38, 0x08, 0x1f, 0x40, 0x6f, 0x25,
-	0x71, 0x25, 0xa4, 0x21, 0x1c, 0x13, 0xc1, 0x85, 0x2a, 0xc6, 0x0c, 0x17, 0xa2, 0x9c, 0xa0, 0xcc,
-	0x8a, 0x59, 0xd8, 0x36, 0x0c, 0x07, 0x8a, 0x3e, 0xc3, 0x49, 0x5d, 0x33, 0x7f, 0x0c, 0xa7, 0x53,
-	0x91, 0x7e, 0x5d, 0xaf, 0xb6, 0xcb, 0xdd, 0x06, 0xf9, 0x33, 0xe8, 0x48, 0xd4, 0x55, 0x83, 0x61,
-	0x6b, 0xe0, 0x0d, 0x7b, 0xf1, 0x83, 0x11, 0x59, 0x32, 0x72, 0x7b, 0x4f, 0x6a, 0x4e, 0xf4, 0x97,
-	0x41, 0x50, 0x9f, 0x8c, 0xc9, 0x2e, 0x1e, 0x43, 0xc7, 0x16, 0x49, 0x6f, 0xe8, 0xc5, 0x67, 0xf6,
-	0x86, 0x89, 0x41, 0x6b, 0xfa, 0xdb, 0xa3, 0xa4, 0x26, 0x92, 0x06, 0xe5, 0xbc, 0x90, 0x4b, 0x32,
-	0xc9, 0xd1, 0x18, 0x74, 0x4b, 0x63, 0x20, 0xfe, 0x1c, 0x7c, 0x53, 0x3a, 0x79, 0xd7, 0x8b, 0x1f,
-	0x5a, 0xc9, 0x25, 0x81, 0x8e, 0xc2, 0xd2, 0x2a, 0x41, 0x2a, 0xf2, 0x14, 0x17, 0xe4, 0xe5, 0x46,
-	0xf0, 0x9a, 0x40, 0x57, 0x60, 0x68, 0x3c, 0x80, 0x96, 0x2e, 0xc9, 0xd6, 0x76, 0xd2, 0xd2, 0xe5,
-	0x65, 0x07, 0xda, 0xd7, 0x62, 0xb1, 0xc6, 0xe8, 0x3b, 0x83, 0x60, 0xfb, 0x35, 0xb7, 0x74, 0xf7,
-	0xff, 0x9d, 0x69, 0x35, 0xee, 0xcc, 0xce, 0x64, 0xbd, 0xa6, 0xc9, 0xf6, 0x77, 0xfc, 0x3c, 0x6c,
-	0x09, 0xd1, 0x2b, 0xe8, 0x8d, 0x95, 0x42, 0xfd, 0xa1, 0x5c, 0x4e, 0x8b, 0x05, 0xe7, 0x70, 0x8c,
-	0x37, 0x98, 0xda, 0x3b, 0xe9, 0x37, 0x6d, 0x36, 0x9d, 0xda, 0x2b, 0xec, 0x53, 0xf4, 0x83, 0x41,
-	0x7f, 0x67, 0x70, 0x07, 0xf6, 0xe7, 0x29, 0xf8, 0xa2, 0x2a, 0x4e, 0x85, 0x1e, 0x2d, 0x29, 0xb7,
-	0xa3, 0x74, 0x2a, 0x4e, 0x2c, 0x23, 0xfa, 0x04, 0xc1, 0xf6, 0x84, 0x0f, 0x6c, 0xd4, 0x4f, 0x06,
-	0xfd, 0x04, 0xbf, 0xd5, 0xb7, 0xbf, 0xcb, 0xe7, 0xc5, 0x81, 0xbb, 0x7d, 0x04, 0x5d, 0xea, 0xe5,
-	0x4d, 0x35, 0x00, 0x8f, 0x28, 0x1b, 0xa0, 0xda, 0x15, 0xb1, 0x69, 0x9b, 0x76, 0xbb, 0x9b, 0xb8,
-	0x50, 0xf4, 0x9b, 0xc1, 0x69, 0x5d, 0xde, 0xfb, 0x35, 0xca, 0xf2, 0xae, 0xb7, 0xf5, 0x16, 0x59,
-	0x76, 0x01, 0x20, 0x71, 0x29, 0xb2, 0x9c, 0x08, 0x26, 0xca, 0x1c, 0xc4, 0x49, 0x51, 0xdf, 0x4d,
-	0xd1, 0xf8, 0x0f, 0x83, 0x93, 0x3a, 0xc3, 0xf9, 0x4b, 0xe8, 0xd8, 0x3f, 0x05, 0xdf, 0x13, 0x3a,
-	0xe7, 0x7d, 0x8b, 0x7f, 0xcc, 0x55, 0xf6, 0x25, 0xbf, 0xba, 0x89, 0x8e, 0x48, 0x65, 0x33, 0x65,
-	0x4f, 0xec, 0x34, 0xa9, 0x62, 0xf0, 0x4d, 0x04, 0xf0, 0xe6, 0xe0, 0xd9, 0xa3, 0x31, 0xab, 0xc8,
-	0x9b, 0xb3, 0xa7, 0x41, 0x33, 0xf5, 0xe9, 0xf3, 0xf3, 0xe2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x01, 0xad, 0xc9, 0x3d, 0xaa, 0x06, 0x00, 0x00,
+	// 842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0x13, 0x3b,
+	0x14, 0xee, 0x64, 0x32, 0x93, 0xe4, 0xe4, 0x76, 0x72, 0xaf, 0xaf, 0x6e, 0xef, 0xa8, 0xba, 0xaa,
+	0xa2, 0xd1, 0x15, 0x8a, 0x90, 0x5a, 0xa4, 0x14, 0x90, 0x40, 0x95, 0x50, 0x8a, 0x50, 0x61, 0x57,
+	0xa6, 0x45, 0xec, 0x40, 0x6e, 0xe2, 0xb6, 0x23, 0x92, 0x99, 0xe0, 0x71, 0x42, 0xb3, 0x63, 0x0f,
+	0xef, 0xc0, 0x9a, 0x2d, 0x1b, 0x9e, 0x05, 0x9e, 0x81, 0x87, 0x40, 0xe3, 0x9f, 0xc6, 0x76, 0x27,
+	0x34, 0x95, 0xb2, 0x60, 0x17, 0x7f, 0x3e, 0x9f, 0xe7, 0xf8, 0xf3, 0x77, 0xec, 0x13, 0x08, 0x28,
+	0x61, 0x34, 0x21, 0x53, 0xb2, 0x33, 0xa6, 0x19, 0xcb, 0x90, 0xc7, 0x66, 0x63, 0x92, 0x6f, 0xfe,
+	0xc5, 0x28, 0x4e, 0x73, 0xdc, 0x67, 0x49, 0x96, 0x8a, 0x99, 0xe8, 0x8b, 0x03, 0x7f, 0xc4, 0x32,
+	0xf8, 0x10, 0x53, 0x8c, 0x6e, 0x41, 0x30, 0x20, 0xa7, 0x78, 0x32, 0x64, 0xbd, 0xc1, 0x80, 0x92,
+	0x3c, 0x0f, 0x9d, 0xb6, 0xd3, 0x69, 0xc4, 0x16, 0x8a, 0x36, 0xc0, 0xcf, 0x19, 0x66, 0x93, 0x3c,
+	0xac, 0xb4, 0x9d, 0x8e, 0x17, 0xcb, 0x11, 0xda, 0x02, 0xe8, 0x53, 0x82, 0x19, 0x39, 0x4e, 0x46,
+	0x24, 0x74, 0xdb, 0x4e, 0xc7, 0x8d, 0x35, 0x04, 0xb5, 0xa1, 0x39, 0xa6, 0x64, 0x8c, 0xa9, 0x08,
+	0xa8, 0xf2, 0x00, 0x1d, 0x2a, 0x22, 0x06, 0x64, 0x88, 0x67, 0x87, 0x84, 0x26, 0xd9, 0x20, 0xf4,
+	0x44, 0x84, 0x06, 0x45, 0xaf, 0xa1, 0xae, 0x72, 0x46, 0xff, 0xc3, 0xfa, 0x09, 0xee, 0xbf, 0x99,
+	0x8c, 0xcd, 0x74, 0x4d, 0x10, 0x6d, 0x43, 0x8d, 0x12, 0x56, 0x6c, 0x30, 0xac, 0xb4, 0xdd, 0x4e,
+	0xb3, 0xfb, 0xf7, 0x0e, 0x97, 0x64, 0x47, 0xdf, 0x7b, 0xac, 0x62, 0xa2, 0xaf, 0x55, 0x08, 0xd4,
+	0x4c, 0x8f, 0xcb, 0x85, 0xba, 0x50, 0x93, 0x49, 0xf2, 0x2f, 0x34, 0xbb, 0x1b, 0x72, 0x85, 0x43,
+	0x81, 0xaa, 0xf0, 0xa7, 0x6b, 0xb1, 0x0a, 0xe4, 0x1c, 0x42, 0x4f, 0x33, 0x3a, 0x0a, 0x2b, 0x26,
+	0x47, 0xa0, 0x06, 0x47, 0x40, 0xe8, 0x0e, 0xf8, 0x22, 0x75, 0xae, 0x5d, 0xb3, 0xfb, 0x8f, 0xa4,
+	0xec, 0x73, 0x50, 0x63, 0xc8, 0xb0, 0x82, 0xd0, 0xc7, 0x69, 0x9f, 0x0c, 0xc3, 0xaa, 0x41, 0x78,
+	0xcc, 0x41, 0x9d, 0x20, 0xc2, 0xd0, 0x3d, 0xa8, 0x9f, 0x4d, 0x30, 0x1d, 0x24, 0x38, 0x0d, 0x7d,
+	0x4e, 0xf9, 0x57, 0x52, 0x0e, 0x24, 0xac, 0x91, 0x2e, 0x43, 0xd1, 0x3e, 0xb4, 0xd4, 0x6f, 0xb9,
+	0xe5, 0xb0, 0x76, 0x8d, 0x10, 0x36, 0x41, 0x5f, 0xa3, 0x37, 0x1e, 0xd3, 0x6c, 0x4a, 0xc2, 0xba,
+	0xb1, 0x86, 0x44, 0xcb, 0xd6, 0x90, 0x53, 0x46, 0x1e, 0x52, 0xdc, 0xc6, 0x35, 0xe2, 0xda, 0x04,
+	0xf4, 0x08, 0x02, 0x05, 0x09, 0x99, 0x42, 0xf8, 0xb5, 0x76, 0x56, 0x38, 0x0a, 0xa0, 0xc2, 0x66,
+	0xdc, 0x9a, 0x5e, 0x5c, 0x61, 0xb3, 0xfd, 0x1a, 0x78, 0x53, 0x3c, 0x9c, 0x90, 0xe8, 0xbd, 0x03,
+	0x81, 0x79, 0x54, 0x4b, 0x3a, 0xf4, 0x6a, 0xdd, 0x55, 0x4a, 0xeb, 0xce, 0xaa, 0x0e, 0xb7, 0xac,
+	0x3a, 0x5a, 0xd6, 0x51, 0xac, 0x36, 0x85, 0xe8, 0x01, 0x34, 0x7b, 0x79, 0x4e, 0xd8, 0xd1, 0x6c,
+	0x74, 0x92, 0x0d, 0x11, 0x82, 0x2a, 0xb9, 0x20, 0x7d, 0xb9, 0x26, 0xff, 0xcd, 0x6f, 0x07, 0x3e,
+	0x2b, 0x97, 0x90, 0xa3, 0xe8, 0x83, 0x03, 0x2d, 0xeb, 0x7c, 0x56, 0xac, 0xcf, 0x6d, 0xf0, 0x71,
+	0x91, 0x5c, 0x1e, 0xba, 0xbc, 0xd0, 0x91, 0x72, 0xd6, 0x3c, 0xe3, 0x58, 0x46, 0x44, 0xaf, 0x20,
+	0x30, 0x4f, 0x7a, 0xc5, 0x42, 0xed, 0x41, 0x5d, 0x95, 0x14, 0x0a, 0xa1, 0x86, 0x8d, 0x35, 0xd5,
+	0xb0, 0xd0, 0xea, 0x1d, 0x49, 0xce, 0xce, 0x99, 0xba, 0x49, 0xc5, 0x28, 0xfa, 0xec, 0xc0, 0x9f,
+	0x76, 0x45, 0x2e, 0x7d, 0x3d, 0x6f, 0x43, 0x43, 0x59, 0x36, 0x97, 0x57, 0x5e, 0xcb, 0xae, 0xf2,
+	0x79, 0x04, 0xfa, 0x0f, 0x1a, 0xec, 0x9c, 0x92, 0xfc, 0x3c, 0x1b, 0x0a, 0x4f, 0x79, 0xf1, 0x1c,
+	0xb0, 0x3d, 0x57, 0x2d, 0xf5, 0x9c, 0x55, 0xba, 0x2b, 0x96, 0xf2, 0xbb, 0x21, 0x46, 0x3f, 0x9b,
+	0x12, 0x3a, 0x5b, 0xf2, 0x13, 0x8b, 0x5e, 0x2a, 0xeb, 0x25, 0x72, 0x4b, 0x5f, 0x22, 0x2c, 0x76,
+	0xa5, 0xbf, 0x55, 0x1a, 0x54, 0xe8, 0x26, 0x87, 0x34, 0x0f, 0xbd, 0xb6, 0xdb, 0x69, 0xc4, 0x73,
+	0xa0, 0xd8, 0x9c, 0x1c, 0x0c, 0x5e, 0x8a, 0x13, 0xf6, 0x79, 0x06, 0x16, 0x1a, 0x7d, 0x73, 0xa0,
+	0xa9, 0x36, 0x77, 0x44, 0xd8, 0x6f, 0x7a, 0xc8, 0x68, 0x17, 0xea, 0x54, 0x4a, 0x1f, 0x7a, 0x0b,
+	0x1e, 0x0e, 0x31, 0x1d, 0x5f, 0x06, 0x46, 0x9f, 0x1c, 0x68, 0xc5, 0xe4, 0xad, 0xb2, 0xc5, 0xb3,
+	0xf4, 0x34, 0x5b, 0x71, 0xc5, 0x17, 0x67, 0x50, 0xd4, 0xf3, 0x93, 0xe2, 0x12, 0x72, 0x79, 0xc8,
+	0x1c, 0xe0, 0x67, 0x38, 0x2f, 0x7d, 0xbe, 0xad, 0x46, 0xac, 0x43, 0xd1, 0x8f, 0x0a, 0xac, 0xab,
+	0xf4, 0x9e, 0x4f, 0x96, 0xf7, 0xd5, 0xca, 0x6e, 0xec, 0x25, 0x7a, 0xa2, 0x2d, 0x00, 0x4a, 0x46,
+	0x38, 0x49, 0x79, 0x80, 0x68, 0x89, 0x34, 0x44, 0xf3, 0xb8, 0x6f, 0x78, 0xdc, 0x70, 0x48, 0xed,
+	0x66, 0x0e, 0xa9, 0xdb, 0x0e, 0xb9, 0x6a, 0xe7, 0x46, 0x99, 0x9d, 0xcd, 0xa2, 0x00, 0xab, 0x28,
+	0xba, 0x1f, 0xab, 0x85, 0x8d, 0xe4, 0x25, 0x71, 0x17, 0x6a, 0xaa, 0x37, 0x58, 0xd0, 0x46, 0x6c,
+	0xaa, 0x7c, 0x5f, 0xa4, 0x79, 0x72, 0x96, 0x1e, 0x5f, 0x44, 0x6b, 0x9c, 0x25, 0x5f, 0xf2, 0x05,
+	0x8f, 0x7e, 0x19, 0xab, 0x0b, 0xbe, 0x78, 0x99, 0x51, 0x79, 0x4f, 0xb5, 0x80, 0x23, 0x5f, 0xfc,
+	0xf2, 0xd6, 0xa0, 0x8c, 0x73, 0x5f, 0xbb, 0xf5, 0x17, 0x75, 0x56, 0x65, 0xbc, 0x3d, 0x68, 0x1d,
+	0x58, 0xfd, 0xd2, 0x0d, 0x34, 0xd1, 0xd8, 0xaa, 0x53, 0x5a, 0xd0, 0x54, 0x5d, 0xf7, 0xed, 0x9b,
+	0x2b, 0xfb, 0x10, 0x82, 0x03, 0xb3, 0x3f, 0x5a, 0x5a, 0xad, 0x13, 0x9f, 0xff, 0x0f, 0xd9, 0xfd,
+	0x39, 0x00, 0xed, 0xe3, 0x5d, 0x8a, 0xb3, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -729,6 +1172,11 @@ type RetrieveClient interface {
 	Perform(ctx context.Context, in *PerformRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	Backup(ctx context.Context, in *BackupRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	Cancel(ctx context.Context, in *CancelRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	Guardian(ctx context.Context, in *GuardianRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	GuardianPrepare(ctx context.Context, in *PrepareRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	GuardianApprove(ctx context.Context, in *ApproveRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	GuardianPerform(ctx context.Context, in *PerformRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	GuardianCancel(ctx context.Context, in *CancelRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error)
 }
 
 type retrieveClient struct {
@@ -775,12 +1223,62 @@ func (c *retrieveClient) Cancel(ctx context.Context, in *CancelRetrieve, opts ..
 	return out, nil
 }
 
+func (c *retrieveClient) Guardian(ctx context.Context, in *GuardianRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.retrieve/Guardian", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *retrieveClient) GuardianPrepare(ctx context.Context, in *PrepareRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.retrieve/GuardianPrepare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *retrieveClient) GuardianApprove(ctx context.Context, in *ApproveRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.retrieve/GuardianApprove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *retrieveClient) GuardianPerform(ctx context.Context, in *PerformRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.retrieve/GuardianPerform", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *retrieveClient) GuardianCancel(ctx context.Context, in *CancelRetrieve, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.retrieve/GuardianCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RetrieveServer is the server API for Retrieve service.
 type RetrieveServer interface {
 	Prepare(context.Context, *PrepareRetrieve) (*types.UnsignTx, error)
 	Perform(context.Context, *PerformRetrieve) (*types.UnsignTx, error)
 	Backup(context.Context, *BackupRetrieve) (*types.UnsignTx, error)
 	Cancel(context.Context, *CancelRetrieve) (*types.UnsignTx, error)
+	Guardian(context.Context, *GuardianRetrieve) (*types.UnsignTx, error)
+	GuardianPrepare(context.Context, *PrepareRetrieve) (*types.UnsignTx, error)
+	GuardianApprove(context.Context, *ApproveRetrieve) (*types.UnsignTx, error)
+	GuardianPerform(context.Context, *PerformRetrieve) (*types.UnsignTx, error)
+	GuardianCancel(context.Context, *CancelRetrieve) (*types.UnsignTx, error)
 }
 
 // UnimplementedRetrieveServer can be embedded to have forward compatible implementations.
@@ -799,6 +1297,21 @@ func (*UnimplementedRetrieveServer) Backup(ctx context.Context, req *BackupRetri
 func (*UnimplementedRetrieveServer) Cancel(ctx context.Context, req *CancelRetrieve) (*types.UnsignTx, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
+func (*UnimplementedRetrieveServer) Guardian(ctx context.Context, req *GuardianRetrieve) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Guardian not implemented")
+}
+func (*UnimplementedRetrieveServer) GuardianPrepare(ctx context.Context, req *PrepareRetrieve) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianPrepare not implemented")
+}
+func (*UnimplementedRetrieveServer) GuardianApprove(ctx context.Context, req *ApproveRetrieve) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianApprove not implemented")
+}
+func (*UnimplementedRetrieveServer) GuardianPerform(ctx context.Context, req *PerformRetrieve) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianPerform not implemented")
+}
+func (*UnimplementedRetrieveServer) GuardianCancel(ctx context.Context, req *CancelRetrieve) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianCancel not implemented")
+}
 
 func RegisterRetrieveServer(s *grpc.Server, srv RetrieveServer) {
 	s.RegisterService(&_Retrieve_serviceDesc, srv)
@@ -876,6 +1389,96 @@ func _Retrieve_Cancel_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Retrieve_Guardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianRetrieve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetrieveServer).Guardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.retrieve/Guardian",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetrieveServer).Guardian(ctx, req.(*GuardianRetrieve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Retrieve_GuardianPrepare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PrepareRetrieve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetrieveServer).GuardianPrepare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.retrieve/GuardianPrepare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetrieveServer).GuardianPrepare(ctx, req.(*PrepareRetrieve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Retrieve_GuardianApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRetrieve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetrieveServer).GuardianApprove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.retrieve/GuardianApprove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetrieveServer).GuardianApprove(ctx, req.(*ApproveRetrieve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Retrieve_GuardianPerform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PerformRetrieve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetrieveServer).GuardianPerform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.retrieve/GuardianPerform",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetrieveServer).GuardianPerform(ctx, req.(*PerformRetrieve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Retrieve_GuardianCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRetrieve)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RetrieveServer).GuardianCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.retrieve/GuardianCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RetrieveServer).GuardianCancel(ctx, req.(*CancelRetrieve))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Retrieve_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.retrieve",
 	HandlerType: (*RetrieveServer)(nil),
@@ -896,6 +1499,26 @@ var _Retrieve_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Cancel",
 			Handler:    _Retrieve_Cancel_Handler,
 		},
+		{
+			MethodName: "Guardian",
+			Handler:    _Retrieve_Guardian_Handler,
+		},
+		{
+			MethodName: "GuardianPrepare",
+			Handler:    _Retrieve_GuardianPrepare_Handler,
+		},
+		{
+			MethodName: "GuardianApprove",
+			Handler:    _Retrieve_GuardianApprove_Handler,
+		},
+		{
+			MethodName: "GuardianPerform",
+			Handler:    _Retrieve_GuardianPerform_Handler,
+		},
+		{
+			MethodName: "GuardianCancel",
+			Handler:    _Retrieve_GuardianCancel_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "retrieve.proto",
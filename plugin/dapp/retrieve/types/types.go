@@ -22,6 +22,7 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(RetrieveX, "Enable", 0)
 	cfg.RegisterDappFork(RetrieveX, ForkRetriveX, 180000)
 	cfg.RegisterDappFork(RetrieveX, ForkRetriveAssetX, 3150000)
+	cfg.RegisterDappFork(RetrieveX, ForkRetriveGuardianX, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -85,6 +86,16 @@ func (r RetrieveType) ActionName(tx *types.Transaction) string {
 		return "backup"
 	} else if action.Ty == RetrieveActionCancel && action.GetCancel() != nil {
 		return "cancel"
+	} else if action.Ty == RetrieveActionGuardian && action.GetGuardian() != nil {
+		return "guardian"
+	} else if action.Ty == RetrieveActionGuardianPrepare && action.GetGuardianPrepare() != nil {
+		return "guardianPrepare"
+	} else if action.Ty == RetrieveActionGuardianApprove && action.GetGuardianApprove() != nil {
+		return "guardianApprove"
+	} else if action.Ty == RetrieveActionGuardianPerform && action.GetGuardianPerform() != nil {
+		return "guardianPerform"
+	} else if action.Ty == RetrieveActionGuardianCancel && action.GetGuardianCancel() != nil {
+		return "guardianCancel"
 	}
 	return "unknown"
 }
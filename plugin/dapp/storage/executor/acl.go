@@ -0,0 +1,176 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ety "github.com/33cn/plugin/plugin/dapp/storage/types"
+)
+
+/*
+ * ForkStorageACL之后每个存证key记录创建者和授权追加的地址，
+ * 创建者可以授权和撤销其他地址的追加权限，每次写入都记录一个版本
+ * 版本单独保存在ACLVersionKey下，ACL中只记录版本数，避免单个value无限增长
+ */
+
+// 查询时最多返回的最近版本数
+const maxQueryVersions = 100
+
+func getStorageACL(db dbm.KV, key string) (*ety.StorageACL, error) {
+	data, err := db.Get(ACLKey(key))
+	if err != nil {
+		return nil, err
+	}
+	var acl ety.StorageACL
+	err = types.Decode(data, &acl)
+	if err != nil {
+		return nil, err
+	}
+	return &acl, nil
+}
+
+func isWriter(acl *ety.StorageACL, addr string) bool {
+	if acl.Owner == addr {
+		return true
+	}
+	for _, writer := range acl.Writers {
+		if writer == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *StorageAction) saveACL(acl *ety.StorageACL) *types.Receipt {
+	value := types.Encode(acl)
+	s.db.Set(ACLKey(acl.Key), value)
+	kv := []*types.KeyValue{{Key: ACLKey(acl.Key), Value: value}}
+	log := &types.ReceiptLog{Ty: ety.TyStorageACLLog, Log: value}
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: []*types.ReceiptLog{log}}
+}
+
+// writeVersion 分叉后检查写入权限并记录新版本，existed表示key在localdb中已经存在
+func (s *StorageAction) writeVersion(key string, existed bool) (*types.Receipt, error) {
+	cfg := s.api.GetConfig()
+	if !cfg.IsDappFork(s.height, ety.StorageX, ety.ForkStorageACL) {
+		return &types.Receipt{}, nil
+	}
+
+	acl, err := getStorageACL(s.db, key)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	if err == types.ErrNotFound {
+		// 分叉前创建的key没有创建者记录，不允许再追加
+		if existed {
+			return nil, ety.ErrNoPermission
+		}
+		acl = &ety.StorageACL{Key: key, Owner: s.fromaddr}
+	} else if !isWriter(acl, s.fromaddr) {
+		elog.Error("writeVersion", "key", key, "addr", s.fromaddr, "err", ety.ErrNoPermission)
+		return nil, ety.ErrNoPermission
+	}
+
+	acl.VersionCount++
+	version := &ety.StorageVersion{
+		Version:   acl.VersionCount,
+		TxHash:    common.ToHex(s.txhash),
+		Addr:      s.fromaddr,
+		Height:    s.height,
+		BlockTime: s.blocktime,
+	}
+	kv := &types.KeyValue{Key: ACLVersionKey(key, version.Version), Value: types.Encode(version)}
+	s.db.Set(kv.Key, kv.Value)
+	receipt := s.saveACL(acl)
+	receipt.KV = append(receipt.KV, kv)
+	return receipt, nil
+}
+
+func (s *StorageAction) getOwnedACL(key string, writers []string) (*ety.StorageACL, error) {
+	cfg := s.api.GetConfig()
+	if !cfg.IsDappFork(s.height, ety.StorageX, ety.ForkStorageACL) {
+		return nil, types.ErrNotAllow
+	}
+	if key == "" || len(writers) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	for _, writer := range writers {
+		if err := address.CheckAddress(writer); err != nil {
+			return nil, err
+		}
+	}
+	acl, err := getStorageACL(s.db, key)
+	if err == types.ErrNotFound {
+		return nil, ety.ErrKeyNotExist
+	}
+	if err != nil {
+		return nil, err
+	}
+	if acl.Owner != s.fromaddr {
+		elog.Error("getOwnedACL", "key", key, "addr", s.fromaddr, "owner", acl.Owner)
+		return nil, ety.ErrNoPermission
+	}
+	return acl, nil
+}
+
+//GrantWriter 创建者授权其他地址追加内容
+func (s *StorageAction) GrantWriter(payload *ety.StorageGrantWriter) (*types.Receipt, error) {
+	acl, err := s.getOwnedACL(payload.Key, payload.Writers)
+	if err != nil {
+		return nil, err
+	}
+	for _, writer := range payload.Writers {
+		if !isWriter(acl, writer) {
+			acl.Writers = append(acl.Writers, writer)
+		}
+	}
+	return s.saveACL(acl), nil
+}
+
+//RevokeWriter 创建者撤销其他地址的追加权限
+func (s *StorageAction) RevokeWriter(payload *ety.StorageRevokeWriter) (*types.Receipt, error) {
+	acl, err := s.getOwnedACL(payload.Key, payload.Writers)
+	if err != nil {
+		return nil, err
+	}
+	revoked := make(map[string]bool)
+	for _, writer := range payload.Writers {
+		revoked[writer] = true
+	}
+	var writers []string
+	for _, writer := range acl.Writers {
+		if !revoked[writer] {
+			writers = append(writers, writer)
+		}
+	}
+	acl.Writers = writers
+	return s.saveACL(acl), nil
+}
+
+// 查询时附带创建者、授权地址及最近的版本历史，分叉前创建的key没有该记录
+func fillStorageACL(db dbm.KV, storage *ety.Storage, key string) *ety.Storage {
+	acl, err := getStorageACL(db, key)
+	if err != nil {
+		return storage
+	}
+	start := acl.VersionCount - maxQueryVersions + 1
+	if start < 1 {
+		start = 1
+	}
+	for v := start; v <= acl.VersionCount; v++ {
+		data, err := db.Get(ACLVersionKey(key, v))
+		if err != nil {
+			elog.Error("fillStorageACL", "key", key, "version", v, "err", err)
+			break
+		}
+		var version ety.StorageVersion
+		if err = types.Decode(data, &version); err != nil {
+			elog.Error("fillStorageACL", "key", key, "version", v, "err", err)
+			break
+		}
+		acl.Versions = append(acl.Versions, &version)
+	}
+	storage.Acl = acl
+	return storage
+}
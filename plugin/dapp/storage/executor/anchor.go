@@ -0,0 +1,73 @@
+package executor
+
+import (
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ety "github.com/33cn/plugin/plugin/dapp/storage/types"
+)
+
+//AnchorStorage 默克尔根锚定存证
+func (s *StorageAction) AnchorStorage(payload *ety.MerkleAnchorStorage) (*types.Receipt, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	cfg := s.api.GetConfig()
+	if !cfg.IsDappFork(s.height, ety.StorageX, ety.ForkStorageAnchor) {
+		return nil, types.ErrNotAllow
+	}
+	if len(payload.Root) != 32 || payload.LeafCount <= 0 {
+		return nil, ety.ErrAnchorRoot
+	}
+	key := payload.Key
+	if key == "" {
+		key = common.ToHex(s.txhash)
+	}
+	payload.Key = key
+	_, err := QueryStorageFromLocalDB(s.localdb, key)
+	if err != types.ErrNotFound {
+		return nil, ety.ErrKeyExisted
+	}
+	_, err = s.db.Get(AnchorRootKey(payload.Root))
+	if err != types.ErrNotFound {
+		return nil, ety.ErrKeyExisted
+	}
+	payload.Height = s.height
+	payload.BlockTime = s.blocktime
+
+	stg := &ety.Storage{Value: &ety.Storage_AnchorStorage{AnchorStorage: payload}, Ty: ety.TyAnchorStorageAction}
+	log := &types.ReceiptLog{Ty: ety.TyAnchorStorageLog, Log: types.Encode(stg)}
+	logs = append(logs, log)
+	rootKV := &types.KeyValue{Key: AnchorRootKey(payload.Root), Value: []byte(key)}
+	s.db.Set(rootKV.Key, rootKV.Value)
+	kvs = append(kvs, rootKV)
+	receipt, err := s.writeVersion(key, false)
+	if err != nil {
+		return nil, err
+	}
+	kvs = append(kvs, receipt.KV...)
+	logs = append(logs, receipt.Logs...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+//VerifyAnchor 验证文档哈希及证明路径是否与已锚定的默克尔根一致，返回锚定高度和时间
+func VerifyAnchor(localdb dbm.KV, in *ety.QueryAnchorProof) (types.Message, error) {
+	if len(in.Root) != 32 || len(in.DocHash) == 0 {
+		return nil, types.ErrInvalidParam
+	}
+	key, err := localdb.Get(getAnchorRootKey(common.ToHex(in.Root)))
+	if err != nil {
+		return nil, err
+	}
+	storage, err := QueryStorageFromLocalDB(localdb, string(key))
+	if err != nil {
+		return nil, err
+	}
+	anchor := storage.GetAnchorStorage()
+	return &ety.ReplyAnchorProof{
+		Valid:     ety.VerifyAnchorProof(in.Root, in.DocHash, in.Proof),
+		Key:       anchor.Key,
+		LeafCount: anchor.LeafCount,
+		Height:    anchor.Height,
+		BlockTime: anchor.BlockTime,
+	}, nil
+}
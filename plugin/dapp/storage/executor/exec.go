@@ -35,3 +35,18 @@ func (s *storage) Exec_EncryptShareStorage(payload *storagetypes.EncryptShareNot
 	action := newStorageAction(s, tx, index)
 	return action.EncryptShareStorage(payload)
 }
+
+func (s *storage) Exec_GrantWriter(payload *storagetypes.StorageGrantWriter, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newStorageAction(s, tx, index)
+	return action.GrantWriter(payload)
+}
+
+func (s *storage) Exec_RevokeWriter(payload *storagetypes.StorageRevokeWriter, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newStorageAction(s, tx, index)
+	return action.RevokeWriter(payload)
+}
+
+func (s *storage) Exec_AnchorStorage(payload *storagetypes.MerkleAnchorStorage, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newStorageAction(s, tx, index)
+	return action.AnchorStorage(payload)
+}
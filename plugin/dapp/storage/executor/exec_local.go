@@ -1,6 +1,7 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 	ety "github.com/33cn/plugin/plugin/dapp/storage/types"
 )
@@ -115,6 +116,25 @@ func (s *storage) ExecLocal_EncryptShareStorage(payload *ety.EncryptShareNotaryS
 	return s.addAutoRollBack(tx, dbSet.KV), nil
 }
 
+func (s *storage) ExecLocal_AnchorStorage(payload *ety.MerkleAnchorStorage, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	dbSet := &types.LocalDBSet{}
+	if receiptData.Ty == types.ExecOk {
+		for _, log := range receiptData.Logs {
+			switch log.Ty {
+			case ety.TyAnchorStorageLog:
+				storage := &ety.Storage{}
+				if err := types.Decode(log.Log, storage); err != nil {
+					return nil, err
+				}
+				anchor := storage.GetAnchorStorage()
+				dbSet.KV = append(dbSet.KV, &types.KeyValue{Key: getLocalDBKey(anchor.Key), Value: types.Encode(storage)})
+				dbSet.KV = append(dbSet.KV, &types.KeyValue{Key: getAnchorRootKey(common.ToHex(anchor.Root)), Value: []byte(anchor.Key)})
+			}
+		}
+	}
+	return s.addAutoRollBack(tx, dbSet.KV), nil
+}
+
 //设置自动回滚
 func (s *storage) addAutoRollBack(tx *types.Transaction, kv []*types.KeyValue) *types.LocalDBSet {
 
@@ -1,5 +1,11 @@
 package executor
 
+import (
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+)
+
 /*
  * 用户合约存取kv数据时，key值前缀需要满足一定规范
  * 即key = keyPrefix + userKey
@@ -25,3 +31,25 @@ func getLocalDBKey(txHash string) (key []byte) {
 	key = append(key, []byte(txHash)...)
 	return key
 }
+
+// ACLKey 存证key的创建者、授权地址及版本历史
+func ACLKey(key string) []byte {
+	return []byte(KeyPrefixStateDB + "acl-" + key)
+}
+
+// ACLVersionKey 存证key的第version次写入记录，version定长保证不同key之间不会冲突
+func ACLVersionKey(key string, version int32) []byte {
+	return []byte(fmt.Sprintf("%sversion-%s-%010d", KeyPrefixStateDB, key, version))
+}
+
+// AnchorRootKey 已锚定的默克尔根，保证同一个根只能锚定一次
+func AnchorRootKey(root []byte) []byte {
+	return []byte(KeyPrefixStateDB + "anchor-" + common.ToHex(root))
+}
+
+// 默克尔根到存证key的索引
+func getAnchorRootKey(root string) (key []byte) {
+	key = append(key, []byte(KeyPrefixLocalDB+"anchor-")...)
+	key = append(key, []byte(root)...)
+	return key
+}
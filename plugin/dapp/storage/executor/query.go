@@ -14,3 +14,8 @@ func (s *storage) Query_QueryStorage(in *storagetypes.QueryStorage) (types.Messa
 func (s *storage) Query_BatchQueryStorage(in *storagetypes.BatchQueryStorage) (types.Message, error) {
 	return BatchQueryStorage(s.GetStateDB(), s.GetLocalDB(), in)
 }
+
+//根据默克尔证明验证文档哈希是否已锚定
+func (s *storage) Query_VerifyAnchor(in *storagetypes.QueryAnchorProof) (types.Message, error) {
+	return VerifyAnchor(s.GetLocalDB(), in)
+}
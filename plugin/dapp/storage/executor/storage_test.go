@@ -31,6 +31,7 @@ type execEnv struct {
 
 var (
 	PrivKeyA = "0x6da92a632ab7deb67d38c0f6560bcfed28167998f6496db64c258d5e8393a81b" // 1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4
+	PrivKeyB = "0x19c069234f9d3e61135fefbeb7791b149cdf6af536f26bebb310d4cd22c3fee4" // 1JRNjdEqp4LJ5fqycUBm9ayCKSeeskgMKR
 
 	Nodes = [][]byte{
 		[]byte("1KSBd17H7ZK8iT37aJztFB22XGwsPTdwE4"),
@@ -167,6 +168,120 @@ func TestStorage(t *testing.T) {
 	assert.Equal(t, ivs[0], reply.GetEncryptStorage().Nonce)
 }
 
+func TestStorageACL(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageLocalDB, 0)
+	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageACL, 0)
+	InitExecType()
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+	env := &execEnv{10, 1, 1539918074}
+	key := "acl-key"
+
+	tx, err := CreateTx("ContentStorage", &oty.ContentOnlyNotaryStorage{Content: contents[0], Key: key, Op: oty.OpCreate}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//未授权地址不能追加
+	tx, _ = CreateTx("ContentStorage", &oty.ContentOnlyNotaryStorage{Content: contents[1], Key: key, Op: oty.OpAdd}, PrivKeyB, cfg)
+	assert.Equal(t, oty.ErrNoPermission, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//创建者授权后可以追加，被授权地址不能再授权
+	tx, _ = CreateTx("GrantWriter", &oty.StorageGrantWriter{Key: key, Writers: []string{string(Nodes[1])}}, PrivKeyA, cfg)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	tx, _ = CreateTx("ContentStorage", &oty.ContentOnlyNotaryStorage{Content: contents[1], Key: key, Op: oty.OpAdd}, PrivKeyB, cfg)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	tx, _ = CreateTx("GrantWriter", &oty.StorageGrantWriter{Key: key, Writers: []string{string(Nodes[2])}}, PrivKeyB, cfg)
+	assert.Equal(t, oty.ErrNoPermission, Exec_Block(t, stateDB, kvdb, env, tx))
+	tx, _ = CreateTx("GrantWriter", &oty.StorageGrantWriter{Key: "no-such-key", Writers: []string{string(Nodes[2])}}, PrivKeyA, cfg)
+	assert.Equal(t, oty.ErrKeyNotExist, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	//撤销后不能追加
+	tx, _ = CreateTx("RevokeWriter", &oty.StorageRevokeWriter{Key: key, Writers: []string{string(Nodes[1])}}, PrivKeyA, cfg)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	tx, _ = CreateTx("ContentStorage", &oty.ContentOnlyNotaryStorage{Content: contents[2], Key: key, Op: oty.OpAdd}, PrivKeyB, cfg)
+	assert.Equal(t, oty.ErrNoPermission, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	reply, err := QueryStorageByKey(stateDB, kvdb, key, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, append(append(contents[0], []byte(",")...), contents[1]...), reply.GetContentStorage().Content)
+	assert.Equal(t, string(Nodes[0]), reply.Acl.Owner)
+	assert.Equal(t, 0, len(reply.Acl.Writers))
+	assert.Equal(t, 2, len(reply.Acl.Versions))
+	assert.Equal(t, string(Nodes[1]), reply.Acl.Versions[1].Addr)
+	assert.Equal(t, int32(2), reply.Acl.Versions[1].Version)
+	//状态中的ACL只记录版本数，版本单独保存
+	acl, err := getStorageACL(stateDB, key)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), acl.VersionCount)
+	assert.Equal(t, 0, len(acl.Versions))
+
+	//其他类型的存证同样记录创建者
+	tx, _ = CreateTx("HashStorage", &oty.HashOnlyNotaryStorage{Hash: common.Sha256(contents[0])}, PrivKeyB, cfg)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	reply2, err := QueryBatchStorageByKey(stateDB, kvdb, &oty.BatchQueryStorage{TxHashs: []string{key, common.ToHex(tx.Hash())}}, cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[0]), reply2.Storages[0].Acl.Owner)
+	assert.Equal(t, string(Nodes[1]), reply2.Storages[1].Acl.Owner)
+}
+
+func TestStorageAnchor(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageLocalDB, 0)
+	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageACL, 0)
+	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageAnchor, 0)
+	InitExecType()
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 1000)
+	_, _, kvdb := util.CreateTestDB()
+	env := &execEnv{10, 1, 1539918074}
+
+	var docs [][]byte
+	for i := 0; i < 5; i++ {
+		docs = append(docs, common.Sha256([]byte{byte(i)}))
+	}
+	root, proofs := oty.BuildAnchorTree(docs)
+	assert.Equal(t, 5, len(proofs))
+
+	tx, err := CreateTx("AnchorStorage", &oty.MerkleAnchorStorage{Root: root, LeafCount: int64(len(docs)), Value: "batch-1"}, PrivKeyA, cfg)
+	assert.Nil(t, err)
+	assert.Nil(t, Exec_Block(t, stateDB, kvdb, env, tx))
+	//同一个默克尔根不能重复锚定
+	tx, _ = CreateTx("AnchorStorage", &oty.MerkleAnchorStorage{Root: root, LeafCount: int64(len(docs))}, PrivKeyA, cfg)
+	assert.Equal(t, oty.ErrKeyExisted, Exec_Block(t, stateDB, kvdb, env, tx))
+	//根的唯一性由状态数据保证，不依赖本地索引
+	_, _, emptyLocal := util.CreateTestDB()
+	assert.Equal(t, oty.ErrKeyExisted, Exec_Block(t, stateDB, emptyLocal, env, tx))
+	tx, _ = CreateTx("AnchorStorage", &oty.MerkleAnchorStorage{Root: root[:16], LeafCount: 1}, PrivKeyA, cfg)
+	assert.Equal(t, oty.ErrAnchorRoot, Exec_Block(t, stateDB, kvdb, env, tx))
+
+	exec := newStorage()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	for i, doc := range docs {
+		msg, err := exec.Query(oty.FuncNameVerifyAnchor, types.Encode(&oty.QueryAnchorProof{Root: root, DocHash: doc, Proof: proofs[i]}))
+		assert.Nil(t, err)
+		reply := msg.(*oty.ReplyAnchorProof)
+		assert.True(t, reply.Valid)
+		assert.Equal(t, int64(2), reply.Height)
+		assert.Equal(t, int64(30), reply.BlockTime)
+		assert.Equal(t, int64(5), reply.LeafCount)
+	}
+	msg, err := exec.Query(oty.FuncNameVerifyAnchor, types.Encode(&oty.QueryAnchorProof{Root: root, DocHash: docs[0], Proof: proofs[1]}))
+	assert.Nil(t, err)
+	assert.False(t, msg.(*oty.ReplyAnchorProof).Valid)
+	_, err = exec.Query(oty.FuncNameVerifyAnchor, types.Encode(&oty.QueryAnchorProof{Root: common.Sha256(root), DocHash: docs[0]}))
+	assert.Equal(t, types.ErrNotFound, err)
+
+	//单个文档的默克尔树没有证明路径
+	root, proofs = oty.BuildAnchorTree(docs[:1])
+	assert.Equal(t, 0, len(proofs[0]))
+	assert.True(t, oty.VerifyAnchorProof(root, docs[0], proofs[0]))
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName(oty.StorageX, signType))
@@ -236,6 +351,8 @@ func CreateTx(action string, message types.Message, priv string, cfg *types.Chai
 func Exec_Block(t *testing.T, stateDB dbm.DB, kvdb dbm.KVDB, env *execEnv, txs ...*types.Transaction) error {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageLocalDB, 0)
+	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageACL, 0)
+	cfg.RegisterDappFork(oty.StorageX, oty.ForkStorageAnchor, 0)
 	cfg.SetTitleOnlyForTest("chain33")
 	exec := newStorage()
 	e := exec.(*storage)
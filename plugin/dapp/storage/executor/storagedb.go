@@ -51,6 +51,7 @@ func (s *StorageAction) ContentStorage(payload *ety.ContentOnlyNotaryStorage) (*
 		}
 		payload.Key = key
 		storage, err := QueryStorageFromLocalDB(s.localdb, key)
+		existed := err == nil
 		if op == ety.OpCreate {
 			if err != types.ErrNotFound {
 				return nil, ety.ErrKeyExisted
@@ -72,6 +73,12 @@ func (s *StorageAction) ContentStorage(payload *ety.ContentOnlyNotaryStorage) (*
 		stg := &ety.Storage{Value: &ety.Storage_ContentStorage{ContentStorage: payload}, Ty: ety.TyContentStorageAction}
 		log := &types.ReceiptLog{Ty: ety.TyContentStorageLog, Log: types.Encode(stg)}
 		logs = append(logs, log)
+		receipt, err := s.writeVersion(key, existed)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
 	} else {
 		log := &types.ReceiptLog{Ty: ety.TyContentStorageLog}
 		logs = append(logs, log)
@@ -99,6 +106,12 @@ func (s *StorageAction) HashStorage(payload *ety.HashOnlyNotaryStorage) (*types.
 		stg := &ety.Storage{Value: &ety.Storage_HashStorage{HashStorage: payload}, Ty: ety.TyHashStorageAction}
 		log := &types.ReceiptLog{Ty: ety.TyHashStorageLog, Log: types.Encode(stg)}
 		logs = append(logs, log)
+		receipt, err := s.writeVersion(key, false)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
 	} else {
 		log := &types.ReceiptLog{Ty: ety.TyHashStorageLog}
 		logs = append(logs, log)
@@ -127,6 +140,12 @@ func (s *StorageAction) LinkStorage(payload *ety.LinkNotaryStorage) (*types.Rece
 		stg := &ety.Storage{Value: &ety.Storage_LinkStorage{LinkStorage: payload}, Ty: ety.TyLinkStorageAction}
 		log := &types.ReceiptLog{Ty: ety.TyLinkStorageLog, Log: types.Encode(stg)}
 		logs = append(logs, log)
+		receipt, err := s.writeVersion(key, false)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
 	} else {
 		log := &types.ReceiptLog{Ty: ety.TyLinkStorageLog}
 		logs = append(logs, log)
@@ -154,6 +173,12 @@ func (s *StorageAction) EncryptStorage(payload *ety.EncryptNotaryStorage) (*type
 		stg := &ety.Storage{Value: &ety.Storage_EncryptStorage{EncryptStorage: payload}, Ty: ety.TyEncryptStorageAction}
 		log := &types.ReceiptLog{Ty: ety.TyEncryptStorageLog, Log: types.Encode(stg)}
 		logs = append(logs, log)
+		receipt, err := s.writeVersion(key, false)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
 	} else {
 		log := &types.ReceiptLog{Ty: ety.TyEncryptStorageLog}
 		logs = append(logs, log)
@@ -181,6 +206,12 @@ func (s *StorageAction) EncryptShareStorage(payload *ety.EncryptShareNotaryStora
 		stg := &ety.Storage{Value: &ety.Storage_EncryptShareStorage{EncryptShareStorage: payload}, Ty: ety.TyEncryptStorageAction}
 		log := &types.ReceiptLog{Ty: ety.TyEncryptShareStorageLog, Log: types.Encode(stg)}
 		logs = append(logs, log)
+		receipt, err := s.writeVersion(key, false)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, receipt.KV...)
+		logs = append(logs, receipt.Logs...)
 	} else {
 		log := &types.ReceiptLog{Ty: ety.TyEncryptShareStorageLog}
 		logs = append(logs, log)
@@ -217,7 +248,7 @@ func QueryStorage(statedb, localdb dbm.KV, txHash string) (*ety.Storage, error)
 	if err != nil {
 		return QueryStorageByTxHash(statedb, txHash)
 	}
-	return storage, nil
+	return fillStorageACL(statedb, storage, txHash), nil
 }
 
 //BatchQueryStorage ...
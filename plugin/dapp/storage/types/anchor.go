@@ -0,0 +1,87 @@
+package types
+
+import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
+)
+
+/*
+ * 默克尔根锚定的客户端辅助函数
+ * 叶子节点为sha256(0x00||docHash)，中间节点为sha256(0x01||left||right)，
+ * 奇数个节点时最后一个节点直接提升到上一层，不做复制
+ */
+
+const (
+	leafPrefix = byte(0)
+	nodePrefix = byte(1)
+)
+
+func hashLeaf(docHash []byte) []byte {
+	return common.Sha256(append([]byte{leafPrefix}, docHash...))
+}
+
+func hashNode(left, right []byte) []byte {
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, nodePrefix)
+	data = append(data, left...)
+	data = append(data, right...)
+	return common.Sha256(data)
+}
+
+// BuildAnchorTree 根据文档哈希构建默克尔树，返回默克尔根及每个文档对应的证明路径
+func BuildAnchorTree(docHashes [][]byte) ([]byte, [][]*MerkleProofNode) {
+	if len(docHashes) == 0 {
+		return nil, nil
+	}
+	level := make([][]byte, len(docHashes))
+	for i, docHash := range docHashes {
+		level[i] = hashLeaf(docHash)
+	}
+	proofs := make([][]*MerkleProofNode, len(docHashes))
+	// positions[i]为第i个文档在当前层的位置
+	positions := make([]int, len(docHashes))
+	for i := range positions {
+		positions[i] = i
+	}
+
+	for len(level) > 1 {
+		for i, pos := range positions {
+			if pos%2 == 0 && pos+1 < len(level) {
+				proofs[i] = append(proofs[i], &MerkleProofNode{Hash: level[pos+1], Left: false})
+			} else if pos%2 == 1 {
+				proofs[i] = append(proofs[i], &MerkleProofNode{Hash: level[pos-1], Left: true})
+			}
+			positions[i] = pos / 2
+		}
+
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, hashNode(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+	}
+	return level[0], proofs
+}
+
+// ComputeAnchorRoot 根据文档哈希和证明路径计算默克尔根
+func ComputeAnchorRoot(docHash []byte, proof []*MerkleProofNode) []byte {
+	hash := hashLeaf(docHash)
+	for _, node := range proof {
+		if node.Left {
+			hash = hashNode(node.Hash, hash)
+		} else {
+			hash = hashNode(hash, node.Hash)
+		}
+	}
+	return hash
+}
+
+// VerifyAnchorProof 验证文档哈希是否包含在默克尔根中
+func VerifyAnchorProof(root, docHash []byte, proof []*MerkleProofNode) bool {
+	return bytes.Equal(root, ComputeAnchorRoot(docHash, proof))
+}
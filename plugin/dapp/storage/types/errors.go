@@ -4,6 +4,9 @@ import "fmt"
 
 // some errors definition
 var (
-	ErrKeyExisted  = fmt.Errorf("%s", "The key has already existed!")
-	ErrStorageType = fmt.Errorf("%s", "The key has used storage another type!")
+	ErrKeyExisted   = fmt.Errorf("%s", "The key has already existed!")
+	ErrStorageType  = fmt.Errorf("%s", "The key has used storage another type!")
+	ErrKeyNotExist  = fmt.Errorf("%s", "The key does not exist!")
+	ErrNoPermission = fmt.Errorf("%s", "The address has no permission to write the key!")
+	ErrAnchorRoot   = fmt.Errorf("%s", "The merkle root must be 32 bytes and leafCount must be positive!")
 )
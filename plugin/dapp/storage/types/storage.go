@@ -20,15 +20,22 @@ const (
 	TyLinkStorageAction
 	TyEncryptStorageAction
 	TyEncryptShareStorageAction
+	TyGrantWriterAction
+	TyRevokeWriterAction
+	TyAnchorStorageAction
 
 	NameContentStorageAction      = "ContentStorage"
 	NameHashStorageAction         = "HashStorage"
 	NameLinkStorageAction         = "LinkStorage"
 	NameEncryptStorageAction      = "EncryptStorage"
 	NameEncryptShareStorageAction = "EncryptShareStorage"
+	NameGrantWriterAction         = "GrantWriter"
+	NameRevokeWriterAction        = "RevokeWriter"
+	NameAnchorStorageAction       = "AnchorStorage"
 
 	FuncNameQueryStorage      = "QueryStorage"
 	FuncNameBatchQueryStorage = "BatchQueryStorage"
+	FuncNameVerifyAnchor      = "VerifyAnchor"
 )
 
 // log类型id值
@@ -39,6 +46,8 @@ const (
 	TyLinkStorageLog
 	TyEncryptStorageLog
 	TyEncryptShareStorageLog
+	TyStorageACLLog
+	TyAnchorStorageLog
 )
 
 //storage op
@@ -50,6 +59,8 @@ const (
 //fork
 var (
 	ForkStorageLocalDB = "ForkStorageLocalDB"
+	ForkStorageACL     = "ForkStorageACL"
+	ForkStorageAnchor  = "ForkStorageAnchor"
 )
 var (
 	//StorageX 执行器名称定义
@@ -61,6 +72,9 @@ var (
 		NameLinkStorageAction:         TyLinkStorageAction,
 		NameEncryptStorageAction:      TyEncryptStorageAction,
 		NameEncryptShareStorageAction: TyEncryptShareStorageAction,
+		NameGrantWriterAction:         TyGrantWriterAction,
+		NameRevokeWriterAction:        TyRevokeWriterAction,
+		NameAnchorStorageAction:       TyAnchorStorageAction,
 	}
 	//定义log的id和具体log类型及名称，填入具体自定义log类型
 	logMap = map[int64]*types.LogInfo{
@@ -69,6 +83,8 @@ var (
 		TyLinkStorageLog:         {Ty: reflect.TypeOf(Storage{}), Name: "LogLinkStorage"},
 		TyEncryptStorageLog:      {Ty: reflect.TypeOf(Storage{}), Name: "LogEncryptStorage"},
 		TyEncryptShareStorageLog: {Ty: reflect.TypeOf(Storage{}), Name: "LogEncryptShareStorage"},
+		TyStorageACLLog:          {Ty: reflect.TypeOf(StorageACL{}), Name: "LogStorageACL"},
+		TyAnchorStorageLog:       {Ty: reflect.TypeOf(Storage{}), Name: "LogAnchorStorage"},
 	}
 )
 
@@ -84,6 +100,8 @@ func init() {
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(StorageX, "Enable", 0)
 	cfg.RegisterDappFork(StorageX, ForkStorageLocalDB, 0)
+	cfg.RegisterDappFork(StorageX, ForkStorageACL, types.MaxHeight)
+	cfg.RegisterDappFork(StorageX, ForkStorageAnchor, types.MaxHeight)
 }
 
 // InitExecutor defines register executor
@@ -31,8 +31,10 @@ type Storage struct {
 	//	*Storage_LinkStorage
 	//	*Storage_EncryptStorage
 	//	*Storage_EncryptShareStorage
+	//	*Storage_AnchorStorage
 	Value                isStorage_Value `protobuf_oneof:"value"`
 	Ty                   int32           `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
+	Acl                  *StorageACL     `protobuf:"bytes,7,opt,name=acl,proto3" json:"acl,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -87,6 +89,10 @@ type Storage_EncryptShareStorage struct {
 	EncryptShareStorage *EncryptShareNotaryStorage `protobuf:"bytes,5,opt,name=encryptShareStorage,proto3,oneof"`
 }
 
+type Storage_AnchorStorage struct {
+	AnchorStorage *MerkleAnchorStorage `protobuf:"bytes,8,opt,name=anchorStorage,proto3,oneof"`
+}
+
 func (*Storage_ContentStorage) isStorage_Value() {}
 
 func (*Storage_HashStorage) isStorage_Value() {}
@@ -97,6 +103,8 @@ func (*Storage_EncryptStorage) isStorage_Value() {}
 
 func (*Storage_EncryptShareStorage) isStorage_Value() {}
 
+func (*Storage_AnchorStorage) isStorage_Value() {}
+
 func (m *Storage) GetValue() isStorage_Value {
 	if m != nil {
 		return m.Value
@@ -139,6 +147,13 @@ func (m *Storage) GetEncryptShareStorage() *EncryptShareNotaryStorage {
 	return nil
 }
 
+func (m *Storage) GetAnchorStorage() *MerkleAnchorStorage {
+	if x, ok := m.GetValue().(*Storage_AnchorStorage); ok {
+		return x.AnchorStorage
+	}
+	return nil
+}
+
 func (m *Storage) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -146,6 +161,13 @@ func (m *Storage) GetTy() int32 {
 	return 0
 }
 
+func (m *Storage) GetAcl() *StorageACL {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Storage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -154,6 +176,7 @@ func (*Storage) XXX_OneofWrappers() []interface{} {
 		(*Storage_LinkStorage)(nil),
 		(*Storage_EncryptStorage)(nil),
 		(*Storage_EncryptShareStorage)(nil),
+		(*Storage_AnchorStorage)(nil),
 	}
 }
 
@@ -164,6 +187,9 @@ type StorageAction struct {
 	//	*StorageAction_LinkStorage
 	//	*StorageAction_EncryptStorage
 	//	*StorageAction_EncryptShareStorage
+	//	*StorageAction_GrantWriter
+	//	*StorageAction_RevokeWriter
+	//	*StorageAction_AnchorStorage
 	Value                isStorageAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                 `protobuf:"varint,6,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -220,6 +246,18 @@ type StorageAction_EncryptShareStorage struct {
 	EncryptShareStorage *EncryptShareNotaryStorage `protobuf:"bytes,5,opt,name=encryptShareStorage,proto3,oneof"`
 }
 
+type StorageAction_GrantWriter struct {
+	GrantWriter *StorageGrantWriter `protobuf:"bytes,7,opt,name=grantWriter,proto3,oneof"`
+}
+
+type StorageAction_RevokeWriter struct {
+	RevokeWriter *StorageRevokeWriter `protobuf:"bytes,8,opt,name=revokeWriter,proto3,oneof"`
+}
+
+type StorageAction_AnchorStorage struct {
+	AnchorStorage *MerkleAnchorStorage `protobuf:"bytes,9,opt,name=anchorStorage,proto3,oneof"`
+}
+
 func (*StorageAction_ContentStorage) isStorageAction_Value() {}
 
 func (*StorageAction_HashStorage) isStorageAction_Value() {}
@@ -230,6 +268,12 @@ func (*StorageAction_EncryptStorage) isStorageAction_Value() {}
 
 func (*StorageAction_EncryptShareStorage) isStorageAction_Value() {}
 
+func (*StorageAction_GrantWriter) isStorageAction_Value() {}
+
+func (*StorageAction_RevokeWriter) isStorageAction_Value() {}
+
+func (*StorageAction_AnchorStorage) isStorageAction_Value() {}
+
 func (m *StorageAction) GetValue() isStorageAction_Value {
 	if m != nil {
 		return m.Value
@@ -272,6 +316,27 @@ func (m *StorageAction) GetEncryptShareStorage() *EncryptShareNotaryStorage {
 	return nil
 }
 
+func (m *StorageAction) GetGrantWriter() *StorageGrantWriter {
+	if x, ok := m.GetValue().(*StorageAction_GrantWriter); ok {
+		return x.GrantWriter
+	}
+	return nil
+}
+
+func (m *StorageAction) GetRevokeWriter() *StorageRevokeWriter {
+	if x, ok := m.GetValue().(*StorageAction_RevokeWriter); ok {
+		return x.RevokeWriter
+	}
+	return nil
+}
+
+func (m *StorageAction) GetAnchorStorage() *MerkleAnchorStorage {
+	if x, ok := m.GetValue().(*StorageAction_AnchorStorage); ok {
+		return x.AnchorStorage
+	}
+	return nil
+}
+
 func (m *StorageAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -287,6 +352,9 @@ func (*StorageAction) XXX_OneofWrappers() []interface{} {
 		(*StorageAction_LinkStorage)(nil),
 		(*StorageAction_EncryptStorage)(nil),
 		(*StorageAction_EncryptShareStorage)(nil),
+		(*StorageAction_GrantWriter)(nil),
+		(*StorageAction_RevokeWriter)(nil),
+		(*StorageAction_AnchorStorage)(nil),
 	}
 }
 
@@ -639,6 +707,383 @@ func (m *EncryptShareNotaryStorage) GetValue() string {
 	return ""
 }
 
+// 默克尔根锚定存证，批量文档哈希只上链默克尔根，单个文档通过证明路径验证
+type MerkleAnchorStorage struct {
+	//默克尔根，长度固定为32字节
+	Root []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	//叶子数量，即本批次文档数
+	LeafCount int64 `protobuf:"varint,2,opt,name=leafCount,proto3" json:"leafCount,omitempty"`
+	//自定义的主键，可以为空，如果没传，则用txhash为key
+	Key string `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	//字符串值，可以记录批次等元数据
+	Value string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	//锚定高度和时间，执行时填写
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64    `protobuf:"varint,6,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MerkleAnchorStorage) Reset()         { *m = MerkleAnchorStorage{} }
+func (m *MerkleAnchorStorage) String() string { return proto.CompactTextString(m) }
+func (*MerkleAnchorStorage) ProtoMessage()    {}
+func (*MerkleAnchorStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{7}
+}
+
+func (m *MerkleAnchorStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleAnchorStorage.Unmarshal(m, b)
+}
+func (m *MerkleAnchorStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleAnchorStorage.Marshal(b, m, deterministic)
+}
+func (m *MerkleAnchorStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleAnchorStorage.Merge(m, src)
+}
+func (m *MerkleAnchorStorage) XXX_Size() int {
+	return xxx_messageInfo_MerkleAnchorStorage.Size(m)
+}
+func (m *MerkleAnchorStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleAnchorStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleAnchorStorage proto.InternalMessageInfo
+
+func (m *MerkleAnchorStorage) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *MerkleAnchorStorage) GetLeafCount() int64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *MerkleAnchorStorage) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MerkleAnchorStorage) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MerkleAnchorStorage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MerkleAnchorStorage) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+// 默克尔证明路径上的兄弟节点，left表示兄弟节点在左侧
+type MerkleProofNode struct {
+	Hash                 []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Left                 bool     `protobuf:"varint,2,opt,name=left,proto3" json:"left,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MerkleProofNode) Reset()         { *m = MerkleProofNode{} }
+func (m *MerkleProofNode) String() string { return proto.CompactTextString(m) }
+func (*MerkleProofNode) ProtoMessage()    {}
+func (*MerkleProofNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{8}
+}
+
+func (m *MerkleProofNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleProofNode.Unmarshal(m, b)
+}
+func (m *MerkleProofNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleProofNode.Marshal(b, m, deterministic)
+}
+func (m *MerkleProofNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleProofNode.Merge(m, src)
+}
+func (m *MerkleProofNode) XXX_Size() int {
+	return xxx_messageInfo_MerkleProofNode.Size(m)
+}
+func (m *MerkleProofNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleProofNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleProofNode proto.InternalMessageInfo
+
+func (m *MerkleProofNode) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *MerkleProofNode) GetLeft() bool {
+	if m != nil {
+		return m.Left
+	}
+	return false
+}
+
+// 存证key的权限控制及版本历史
+type StorageACL struct {
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	//创建者，只有创建者可以授权和撤销
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	//被授权可以追加内容的地址
+	Writers []string `protobuf:"bytes,3,rep,name=writers,proto3" json:"writers,omitempty"`
+	//最近的写入记录，只在查询时填充
+	Versions []*StorageVersion `protobuf:"bytes,4,rep,name=versions,proto3" json:"versions,omitempty"`
+	//已写入的版本数，每个版本单独保存
+	VersionCount         int32    `protobuf:"varint,5,opt,name=versionCount,proto3" json:"versionCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageACL) Reset()         { *m = StorageACL{} }
+func (m *StorageACL) String() string { return proto.CompactTextString(m) }
+func (*StorageACL) ProtoMessage()    {}
+func (*StorageACL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{9}
+}
+
+func (m *StorageACL) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageACL.Unmarshal(m, b)
+}
+func (m *StorageACL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageACL.Marshal(b, m, deterministic)
+}
+func (m *StorageACL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageACL.Merge(m, src)
+}
+func (m *StorageACL) XXX_Size() int {
+	return xxx_messageInfo_StorageACL.Size(m)
+}
+func (m *StorageACL) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageACL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageACL proto.InternalMessageInfo
+
+func (m *StorageACL) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageACL) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StorageACL) GetWriters() []string {
+	if m != nil {
+		return m.Writers
+	}
+	return nil
+}
+
+func (m *StorageACL) GetVersions() []*StorageVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *StorageACL) GetVersionCount() int32 {
+	if m != nil {
+		return m.VersionCount
+	}
+	return 0
+}
+
+// 存证key的每一次写入记录
+type StorageVersion struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	TxHash               string   `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64    `protobuf:"varint,5,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageVersion) Reset()         { *m = StorageVersion{} }
+func (m *StorageVersion) String() string { return proto.CompactTextString(m) }
+func (*StorageVersion) ProtoMessage()    {}
+func (*StorageVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{10}
+}
+
+func (m *StorageVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageVersion.Unmarshal(m, b)
+}
+func (m *StorageVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageVersion.Marshal(b, m, deterministic)
+}
+func (m *StorageVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageVersion.Merge(m, src)
+}
+func (m *StorageVersion) XXX_Size() int {
+	return xxx_messageInfo_StorageVersion.Size(m)
+}
+func (m *StorageVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageVersion proto.InternalMessageInfo
+
+func (m *StorageVersion) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *StorageVersion) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *StorageVersion) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *StorageVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StorageVersion) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+// 授权地址追加内容
+type StorageGrantWriter struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Writers              []string `protobuf:"bytes,2,rep,name=writers,proto3" json:"writers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageGrantWriter) Reset()         { *m = StorageGrantWriter{} }
+func (m *StorageGrantWriter) String() string { return proto.CompactTextString(m) }
+func (*StorageGrantWriter) ProtoMessage()    {}
+func (*StorageGrantWriter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{11}
+}
+
+func (m *StorageGrantWriter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageGrantWriter.Unmarshal(m, b)
+}
+func (m *StorageGrantWriter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageGrantWriter.Marshal(b, m, deterministic)
+}
+func (m *StorageGrantWriter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageGrantWriter.Merge(m, src)
+}
+func (m *StorageGrantWriter) XXX_Size() int {
+	return xxx_messageInfo_StorageGrantWriter.Size(m)
+}
+func (m *StorageGrantWriter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageGrantWriter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageGrantWriter proto.InternalMessageInfo
+
+func (m *StorageGrantWriter) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageGrantWriter) GetWriters() []string {
+	if m != nil {
+		return m.Writers
+	}
+	return nil
+}
+
+// 撤销地址的追加权限
+type StorageRevokeWriter struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Writers              []string `protobuf:"bytes,2,rep,name=writers,proto3" json:"writers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageRevokeWriter) Reset()         { *m = StorageRevokeWriter{} }
+func (m *StorageRevokeWriter) String() string { return proto.CompactTextString(m) }
+func (*StorageRevokeWriter) ProtoMessage()    {}
+func (*StorageRevokeWriter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{12}
+}
+
+func (m *StorageRevokeWriter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageRevokeWriter.Unmarshal(m, b)
+}
+func (m *StorageRevokeWriter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageRevokeWriter.Marshal(b, m, deterministic)
+}
+func (m *StorageRevokeWriter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageRevokeWriter.Merge(m, src)
+}
+func (m *StorageRevokeWriter) XXX_Size() int {
+	return xxx_messageInfo_StorageRevokeWriter.Size(m)
+}
+func (m *StorageRevokeWriter) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageRevokeWriter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageRevokeWriter proto.InternalMessageInfo
+
+func (m *StorageRevokeWriter) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StorageRevokeWriter) GetWriters() []string {
+	if m != nil {
+		return m.Writers
+	}
+	return nil
+}
+
 //根据txhash去状态数据库中查询存储内容
 type QueryStorage struct {
 	TxHash               string   `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
@@ -651,7 +1096,7 @@ func (m *QueryStorage) Reset()         { *m = QueryStorage{} }
 func (m *QueryStorage) String() string { return proto.CompactTextString(m) }
 func (*QueryStorage) ProtoMessage()    {}
 func (*QueryStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{7}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{13}
 }
 
 func (m *QueryStorage) XXX_Unmarshal(b []byte) error {
@@ -691,7 +1136,7 @@ func (m *BatchQueryStorage) Reset()         { *m = BatchQueryStorage{} }
 func (m *BatchQueryStorage) String() string { return proto.CompactTextString(m) }
 func (*BatchQueryStorage) ProtoMessage()    {}
 func (*BatchQueryStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{8}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{14}
 }
 
 func (m *BatchQueryStorage) XXX_Unmarshal(b []byte) error {
@@ -730,7 +1175,7 @@ func (m *BatchReplyStorage) Reset()         { *m = BatchReplyStorage{} }
 func (m *BatchReplyStorage) String() string { return proto.CompactTextString(m) }
 func (*BatchReplyStorage) ProtoMessage()    {}
 func (*BatchReplyStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{9}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{15}
 }
 
 func (m *BatchReplyStorage) XXX_Unmarshal(b []byte) error {
@@ -758,6 +1203,133 @@ func (m *BatchReplyStorage) GetStorages() []*Storage {
 	return nil
 }
 
+// 根据默克尔根和证明路径验证文档哈希
+type QueryAnchorProof struct {
+	Root                 []byte             `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	DocHash              []byte             `protobuf:"bytes,2,opt,name=docHash,proto3" json:"docHash,omitempty"`
+	Proof                []*MerkleProofNode `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *QueryAnchorProof) Reset()         { *m = QueryAnchorProof{} }
+func (m *QueryAnchorProof) String() string { return proto.CompactTextString(m) }
+func (*QueryAnchorProof) ProtoMessage()    {}
+func (*QueryAnchorProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{16}
+}
+
+func (m *QueryAnchorProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryAnchorProof.Unmarshal(m, b)
+}
+func (m *QueryAnchorProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryAnchorProof.Marshal(b, m, deterministic)
+}
+func (m *QueryAnchorProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAnchorProof.Merge(m, src)
+}
+func (m *QueryAnchorProof) XXX_Size() int {
+	return xxx_messageInfo_QueryAnchorProof.Size(m)
+}
+func (m *QueryAnchorProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAnchorProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAnchorProof proto.InternalMessageInfo
+
+func (m *QueryAnchorProof) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *QueryAnchorProof) GetDocHash() []byte {
+	if m != nil {
+		return m.DocHash
+	}
+	return nil
+}
+
+func (m *QueryAnchorProof) GetProof() []*MerkleProofNode {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type ReplyAnchorProof struct {
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	LeafCount            int64    `protobuf:"varint,3,opt,name=leafCount,proto3" json:"leafCount,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64    `protobuf:"varint,5,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyAnchorProof) Reset()         { *m = ReplyAnchorProof{} }
+func (m *ReplyAnchorProof) String() string { return proto.CompactTextString(m) }
+func (*ReplyAnchorProof) ProtoMessage()    {}
+func (*ReplyAnchorProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{17}
+}
+
+func (m *ReplyAnchorProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyAnchorProof.Unmarshal(m, b)
+}
+func (m *ReplyAnchorProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyAnchorProof.Marshal(b, m, deterministic)
+}
+func (m *ReplyAnchorProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyAnchorProof.Merge(m, src)
+}
+func (m *ReplyAnchorProof) XXX_Size() int {
+	return xxx_messageInfo_ReplyAnchorProof.Size(m)
+}
+func (m *ReplyAnchorProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyAnchorProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyAnchorProof proto.InternalMessageInfo
+
+func (m *ReplyAnchorProof) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ReplyAnchorProof) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReplyAnchorProof) GetLeafCount() int64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *ReplyAnchorProof) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplyAnchorProof) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
 type ReceiptStorage struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -768,7 +1340,7 @@ func (m *ReceiptStorage) Reset()         { *m = ReceiptStorage{} }
 func (m *ReceiptStorage) String() string { return proto.CompactTextString(m) }
 func (*ReceiptStorage) ProtoMessage()    {}
 func (*ReceiptStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d2c4ccf1453ffdb, []int{10}
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{18}
 }
 
 func (m *ReceiptStorage) XXX_Unmarshal(b []byte) error {
@@ -797,9 +1369,17 @@ func init() {
 	proto.RegisterType((*LinkNotaryStorage)(nil), "types.LinkNotaryStorage")
 	proto.RegisterType((*EncryptNotaryStorage)(nil), "types.EncryptNotaryStorage")
 	proto.RegisterType((*EncryptShareNotaryStorage)(nil), "types.EncryptShareNotaryStorage")
+	proto.RegisterType((*MerkleAnchorStorage)(nil), "types.MerkleAnchorStorage")
+	proto.RegisterType((*MerkleProofNode)(nil), "types.MerkleProofNode")
+	proto.RegisterType((*StorageACL)(nil), "types.StorageACL")
+	proto.RegisterType((*StorageVersion)(nil), "types.StorageVersion")
+	proto.RegisterType((*StorageGrantWriter)(nil), "types.StorageGrantWriter")
+	proto.RegisterType((*StorageRevokeWriter)(nil), "types.StorageRevokeWriter")
 	proto.RegisterType((*QueryStorage)(nil), "types.QueryStorage")
 	proto.RegisterType((*BatchQueryStorage)(nil), "types.BatchQueryStorage")
 	proto.RegisterType((*BatchReplyStorage)(nil), "types.BatchReplyStorage")
+	proto.RegisterType((*QueryAnchorProof)(nil), "types.QueryAnchorProof")
+	proto.RegisterType((*ReplyAnchorProof)(nil), "types.ReplyAnchorProof")
 	proto.RegisterType((*ReceiptStorage)(nil), "types.ReceiptStorage")
 }
 
@@ -808,38 +1388,61 @@ func init() {
 }
 
 var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 488 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xc1, 0x8a, 0xd4, 0x40,
-	0x10, 0x35, 0xc9, 0x64, 0x62, 0x2a, 0xb3, 0x61, 0xb7, 0x5d, 0x97, 0x88, 0x82, 0x21, 0x87, 0x65,
-	0x10, 0x9c, 0xc3, 0x78, 0x15, 0xd4, 0x95, 0x85, 0x11, 0x45, 0xb1, 0xd7, 0x1f, 0xc8, 0x86, 0xc6,
-	0x0c, 0x13, 0xba, 0x43, 0xd2, 0x23, 0xe6, 0x17, 0x3c, 0xf9, 0x05, 0xde, 0xfc, 0x4f, 0x49, 0xa7,
-	0xba, 0x37, 0x99, 0x64, 0xc1, 0x83, 0xe0, 0x65, 0x6f, 0x5d, 0x5d, 0xaf, 0xde, 0x7b, 0x55, 0x95,
-	0xd0, 0x70, 0x54, 0x4b, 0x51, 0xa5, 0x5f, 0xd9, 0xaa, 0xac, 0x84, 0x14, 0xc4, 0x95, 0x4d, 0xc9,
-	0xea, 0xe4, 0x87, 0x03, 0xde, 0x55, 0x97, 0x20, 0xef, 0x20, 0xcc, 0x04, 0x97, 0x8c, 0x4b, 0xbc,
-	0x89, 0xac, 0xd8, 0x5a, 0x06, 0xeb, 0xa7, 0x2b, 0x85, 0x5d, 0xbd, 0xed, 0x92, 0x9f, 0x78, 0xd1,
-	0x7c, 0x14, 0x32, 0xad, 0x1a, 0x84, 0x6d, 0xee, 0xd1, 0x83, 0x42, 0xf2, 0x1a, 0x82, 0x3c, 0xad,
-	0x73, 0xcd, 0x63, 0x2b, 0x9e, 0x27, 0xc8, 0xb3, 0x49, 0xeb, 0x7c, 0x8a, 0xa4, 0x5f, 0x42, 0x5e,
-	0x42, 0x50, 0x6c, 0xf9, 0x4e, 0x33, 0x38, 0x8a, 0x21, 0x42, 0x86, 0x0f, 0x5b, 0xbe, 0x1b, 0x55,
-	0xf7, 0xe0, 0xe4, 0x12, 0x42, 0xc6, 0xb3, 0xaa, 0x29, 0x4d, 0x2b, 0x33, 0x45, 0xf0, 0x18, 0x09,
-	0x2e, 0xbb, 0xe4, 0xa8, 0x8d, 0x61, 0x11, 0xf9, 0x02, 0x0f, 0xf4, 0x4d, 0x9e, 0x56, 0x4c, 0x73,
-	0xb9, 0x8a, 0x2b, 0x1e, 0x72, 0x29, 0xc4, 0x21, 0xe1, 0x54, 0x39, 0x09, 0xc1, 0x96, 0x4d, 0x34,
-	0x8f, 0xad, 0xa5, 0x4b, 0x6d, 0xd9, 0x5c, 0x78, 0xe0, 0x7e, 0x4b, 0x8b, 0x3d, 0x4b, 0x7e, 0x3a,
-	0x70, 0x84, 0xa0, 0x37, 0x99, 0xdc, 0x0a, 0x7e, 0xb7, 0x92, 0xff, 0xbd, 0x92, 0x02, 0xa2, 0xdb,
-	0x66, 0x4c, 0x22, 0xf0, 0x70, 0xc6, 0x6a, 0x2b, 0x0b, 0xaa, 0x43, 0x72, 0x0c, 0xce, 0x8e, 0x35,
-	0x6a, 0xc6, 0x3e, 0x6d, 0x8f, 0xad, 0x80, 0x28, 0xd5, 0xc8, 0x5c, 0x6a, 0x8b, 0x92, 0x9c, 0xa2,
-	0x80, 0x1a, 0x82, 0x4f, 0x51, 0xed, 0x0a, 0x1e, 0x4e, 0x6e, 0x82, 0x10, 0x98, 0xb5, 0x9b, 0x40,
-	0x1d, 0x75, 0x9e, 0x10, 0x31, 0xa4, 0x4e, 0x9f, 0x34, 0x83, 0x93, 0xd1, 0x72, 0x5a, 0xc2, 0x76,
-	0x39, 0x9a, 0xb0, 0x3d, 0x1b, 0x11, 0x7b, 0x2c, 0xe2, 0x4c, 0x88, 0x0c, 0x9c, 0xff, 0xb2, 0xe0,
-	0x74, 0x6a, 0x83, 0x24, 0x86, 0x00, 0xa7, 0xb2, 0xb9, 0x69, 0xa0, 0x7f, 0x45, 0xce, 0xcd, 0x87,
-	0x81, 0x93, 0x46, 0x03, 0x07, 0xb7, 0xad, 0x30, 0x17, 0x3c, 0xeb, 0xba, 0x5b, 0xd0, 0x2e, 0xd0,
-	0x06, 0x67, 0x13, 0x06, 0xdd, 0xbe, 0xc1, 0xdf, 0x16, 0x3c, 0xba, 0xf5, 0xb3, 0xf8, 0x87, 0x2e,
-	0xcf, 0x60, 0x5e, 0xee, 0xaf, 0xdf, 0xe3, 0xcc, 0x16, 0x14, 0xa3, 0xbf, 0xf6, 0x79, 0x0e, 0x8b,
-	0xcf, 0x7b, 0x76, 0xe3, 0xec, 0x0c, 0xe6, 0xf2, 0xbb, 0x31, 0xe5, 0x53, 0x8c, 0x92, 0xe7, 0x70,
-	0x72, 0x91, 0xca, 0x2c, 0x1f, 0x80, 0x23, 0xf0, 0xba, 0x74, 0x1d, 0x59, 0xb1, 0xb3, 0xf4, 0xa9,
-	0x0e, 0x93, 0x57, 0x08, 0xa7, 0xac, 0x2c, 0x0c, 0xfc, 0x19, 0xdc, 0xc7, 0x47, 0xa1, 0xc3, 0x07,
-	0xeb, 0x10, 0x7f, 0x20, 0x44, 0x50, 0x93, 0x4f, 0x8e, 0x21, 0xa4, 0x2c, 0x63, 0x5b, 0xf3, 0x27,
-	0xae, 0x7d, 0xf0, 0x30, 0x7b, 0x3d, 0x57, 0x6f, 0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x38, 0xc7, 0xa7, 0x39, 0x64, 0x06, 0x00, 0x00,
+	// 857 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xae, 0xe3, 0xb8, 0x49, 0x8e, 0xd3, 0x90, 0x4e, 0x7f, 0xe4, 0x42, 0x25, 0x22, 0x23, 0x55,
+	0x11, 0x82, 0x4a, 0x84, 0x2b, 0x24, 0x10, 0xfd, 0x51, 0x45, 0x10, 0xa5, 0xc0, 0xb4, 0x82, 0x6b,
+	0xd7, 0x99, 0xd6, 0x56, 0x8c, 0xc7, 0x1a, 0x3b, 0x2d, 0x79, 0x07, 0xc4, 0x23, 0x70, 0xb7, 0x8f,
+	0xb4, 0x4f, 0xb2, 0x2f, 0xb0, 0x9a, 0x99, 0xe3, 0xbf, 0xc4, 0x95, 0xb6, 0xbb, 0xab, 0xbd, 0xda,
+	0xbb, 0x39, 0x33, 0xe7, 0x7c, 0xe7, 0x9b, 0x73, 0xbe, 0x33, 0x36, 0x6c, 0xa5, 0x19, 0x17, 0xde,
+	0x3d, 0x3b, 0x4e, 0x04, 0xcf, 0x38, 0xb1, 0xb2, 0x65, 0xc2, 0x52, 0xf7, 0x95, 0x09, 0x9d, 0x6b,
+	0x7d, 0x40, 0x7e, 0x86, 0x81, 0xcf, 0xe3, 0x8c, 0xc5, 0x19, 0xee, 0x38, 0xc6, 0xc8, 0x18, 0xdb,
+	0x93, 0xcf, 0x8f, 0x95, 0xef, 0xf1, 0xb9, 0x3e, 0xfc, 0x2d, 0x8e, 0x96, 0x57, 0x3c, 0xf3, 0xc4,
+	0x12, 0xdd, 0xa6, 0x1b, 0x74, 0x25, 0x90, 0x9c, 0x80, 0x1d, 0x78, 0x69, 0x90, 0xe3, 0xb4, 0x14,
+	0xce, 0x21, 0xe2, 0x4c, 0xbd, 0x34, 0x68, 0x02, 0xa9, 0x86, 0x90, 0xef, 0xc1, 0x8e, 0xc2, 0x78,
+	0x9e, 0x23, 0x98, 0x0a, 0xc1, 0x41, 0x84, 0xcb, 0x30, 0x9e, 0xaf, 0x45, 0x57, 0xdc, 0xc9, 0x05,
+	0x0c, 0x58, 0xec, 0x8b, 0x65, 0x52, 0x5c, 0xa5, 0xad, 0x00, 0x3e, 0x43, 0x80, 0x0b, 0x7d, 0xb8,
+	0x76, 0x8d, 0x7a, 0x10, 0xb9, 0x81, 0x9d, 0x7c, 0x27, 0xf0, 0x04, 0xcb, 0xb1, 0x2c, 0x85, 0x35,
+	0xaa, 0x63, 0x29, 0x8f, 0x55, 0xc0, 0xa6, 0x70, 0x72, 0x06, 0x5b, 0x5e, 0xec, 0x07, 0x5c, 0xe4,
+	0x78, 0x5d, 0x85, 0xf7, 0x29, 0xe2, 0xfd, 0xca, 0xc4, 0x3c, 0x62, 0xa7, 0x55, 0x8f, 0xe9, 0x06,
+	0xad, 0x87, 0x90, 0x01, 0xb4, 0xb2, 0xa5, 0xb3, 0x39, 0x32, 0xc6, 0x16, 0x6d, 0x65, 0x4b, 0xf2,
+	0x05, 0x98, 0x9e, 0x1f, 0x39, 0x1d, 0x85, 0xb4, 0x8d, 0x48, 0xe8, 0x7c, 0x7a, 0x7e, 0x49, 0xe5,
+	0xe9, 0x59, 0x07, 0xac, 0x07, 0x2f, 0x5a, 0x30, 0xf7, 0x65, 0x1b, 0xb6, 0xf2, 0x43, 0x3f, 0x0b,
+	0x79, 0xfc, 0xb1, 0xf7, 0x1f, 0xa8, 0xf7, 0x3f, 0x80, 0x7d, 0x2f, 0xbc, 0x38, 0xfb, 0x4b, 0x84,
+	0x19, 0x13, 0xd8, 0xaf, 0x83, 0x7a, 0xbf, 0x7e, 0x2a, 0x1d, 0xe4, 0xdd, 0x2a, 0xfe, 0xe4, 0x04,
+	0xfa, 0x82, 0x3d, 0xf0, 0x39, 0xc3, 0xf8, 0xba, 0x72, 0x30, 0x9e, 0x56, 0x3c, 0xa6, 0x1b, 0xb4,
+	0x16, 0xb1, 0x2e, 0xbe, 0xde, 0x3b, 0x8b, 0xaf, 0xd4, 0x55, 0x04, 0xce, 0x53, 0x42, 0x21, 0x0e,
+	0x74, 0x50, 0x28, 0x4a, 0x5a, 0x7d, 0x9a, 0x9b, 0x64, 0x08, 0xe6, 0x9c, 0x2d, 0x95, 0x50, 0x7a,
+	0x54, 0x2e, 0x65, 0x02, 0x9e, 0xa8, 0xbe, 0x5b, 0xb4, 0xc5, 0x13, 0xb2, 0x8b, 0x09, 0x54, 0x27,
+	0x7b, 0x14, 0xb3, 0x5d, 0xc3, 0x5e, 0xa3, 0x9c, 0x08, 0x81, 0xb6, 0x94, 0x13, 0xe6, 0x51, 0xeb,
+	0x86, 0x24, 0x05, 0xa8, 0x59, 0x05, 0xf5, 0x61, 0x7b, 0x4d, 0x61, 0x12, 0x50, 0x2a, 0x2c, 0x07,
+	0x94, 0xeb, 0x22, 0x49, 0x6b, 0x3d, 0x89, 0xd9, 0x90, 0xa4, 0xc6, 0xfc, 0x7f, 0x03, 0x76, 0x9b,
+	0x64, 0x48, 0x46, 0x60, 0x63, 0x55, 0xa6, 0xe5, 0x05, 0xaa, 0x5b, 0xe4, 0xa8, 0x50, 0x37, 0x56,
+	0x1a, 0x09, 0xac, 0xec, 0xca, 0xc4, 0x31, 0x8f, 0x7d, 0x7d, 0xbb, 0x3e, 0xd5, 0x46, 0x4e, 0xb0,
+	0xdd, 0x40, 0xd0, 0xaa, 0x12, 0x7c, 0x61, 0xc0, 0xc1, 0x93, 0xda, 0x7e, 0x8f, 0x2c, 0xf7, 0x61,
+	0x33, 0x59, 0xdc, 0xfe, 0x82, 0x35, 0xeb, 0x53, 0xb4, 0x9e, 0xc3, 0x73, 0xa7, 0x41, 0xb2, 0xb2,
+	0x39, 0x82, 0xf3, 0x5c, 0x69, 0x6a, 0x4d, 0x0e, 0xa1, 0x17, 0x31, 0xef, 0xee, 0x9c, 0x2f, 0x90,
+	0x8e, 0x49, 0xcb, 0x8d, 0x37, 0x6d, 0x9d, 0x64, 0x1c, 0xb0, 0xf0, 0x3e, 0xc8, 0x14, 0x11, 0x93,
+	0xa2, 0x25, 0xd1, 0x6f, 0x23, 0xee, 0xcf, 0x6f, 0xc2, 0xbf, 0x99, 0x1a, 0x0d, 0x93, 0x96, 0x1b,
+	0xee, 0x77, 0xf0, 0x89, 0xa6, 0xf9, 0xbb, 0xe0, 0xfc, 0xee, 0x8a, 0xcf, 0x9a, 0x45, 0x2a, 0x75,
+	0xc6, 0xee, 0x34, 0xbb, 0x2e, 0x55, 0x6b, 0x79, 0x45, 0x28, 0x1f, 0xf2, 0x9c, 0xa7, 0x51, 0xe3,
+	0xc9, 0x1f, 0x63, 0x26, 0x50, 0xdb, 0xda, 0x90, 0xe3, 0xf6, 0xa8, 0x26, 0x3e, 0x75, 0xcc, 0x91,
+	0x39, 0xee, 0xd1, 0xdc, 0x24, 0xdf, 0x40, 0xf7, 0x81, 0x89, 0x34, 0xe4, 0x71, 0xea, 0xb4, 0x47,
+	0xe6, 0xd8, 0x9e, 0xec, 0xd5, 0xdf, 0x8f, 0x3f, 0xf5, 0x29, 0x2d, 0xdc, 0x88, 0x0b, 0x7d, 0x5c,
+	0xeb, 0xea, 0x59, 0x6a, 0x32, 0x6b, 0x7b, 0xee, 0xbf, 0x06, 0x0c, 0xea, 0x00, 0x92, 0x03, 0xba,
+	0x28, 0xbe, 0x16, 0xcd, 0x4d, 0x59, 0xc5, 0xec, 0x9f, 0x69, 0x3e, 0x3e, 0x3d, 0x8a, 0x96, 0x2c,
+	0x80, 0x37, 0x9b, 0x09, 0x6c, 0x83, 0x5a, 0x57, 0x2a, 0xde, 0x7e, 0xba, 0xe2, 0xd6, 0x6a, 0xc5,
+	0x4f, 0x80, 0xac, 0x3f, 0xa7, 0x0d, 0xd5, 0xab, 0xd4, 0xa9, 0x55, 0xab, 0x93, 0x7b, 0x0a, 0x3b,
+	0x0d, 0x0f, 0xea, 0xb3, 0x20, 0x8e, 0xa0, 0xff, 0xc7, 0x82, 0x95, 0x83, 0x53, 0x5e, 0xdb, 0xa8,
+	0x5e, 0xdb, 0xfd, 0x1a, 0xb6, 0xcf, 0xbc, 0xcc, 0x0f, 0x6a, 0xce, 0x0e, 0x74, 0xf4, 0x71, 0xea,
+	0x18, 0x1a, 0x16, 0x4d, 0xf7, 0x47, 0x74, 0xa7, 0x2c, 0x89, 0x0a, 0xf7, 0x2f, 0xa1, 0x8b, 0x7f,
+	0x78, 0xda, 0xdf, 0x9e, 0x0c, 0x56, 0x3e, 0x0b, 0xc5, 0xb9, 0x1b, 0xc3, 0x50, 0xa5, 0xd2, 0x43,
+	0xa3, 0x34, 0xd9, 0x38, 0x32, 0x0e, 0x74, 0x66, 0xdc, 0x9f, 0x96, 0xcf, 0x5c, 0x6e, 0x92, 0xaf,
+	0xc0, 0x4a, 0x64, 0x98, 0x12, 0x97, 0x3d, 0xd9, 0xaf, 0x7d, 0x3e, 0x0a, 0x91, 0x53, 0xed, 0xe4,
+	0xfe, 0x67, 0xc0, 0x50, 0x91, 0xad, 0x26, 0xd4, 0xf3, 0x15, 0xce, 0x54, 0xc6, 0x2e, 0xd5, 0x46,
+	0xc3, 0x3b, 0x5d, 0x9b, 0x5b, 0x73, 0x75, 0x6e, 0xdf, 0x4e, 0x1d, 0x43, 0x18, 0x50, 0xe6, 0xb3,
+	0xb0, 0xf8, 0xdc, 0x4f, 0x7a, 0xd0, 0xc1, 0xf2, 0xdc, 0x6e, 0xaa, 0x3f, 0xe4, 0x6f, 0x5f, 0x0f,
+	0x00, 0xfd, 0xba, 0x1a, 0xb0, 0x32, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
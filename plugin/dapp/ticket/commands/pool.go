@@ -0,0 +1,189 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	ty "github.com/33cn/plugin/plugin/dapp/ticket/types"
+	"github.com/spf13/cobra"
+)
+
+// PoolCmd ticket mining pool
+func PoolCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool",
+		Short: "Ticket mining pool management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		poolCreateCmd(),
+		poolDepositCmd(),
+		poolWithdrawCmd(),
+		poolInfoCmd(),
+		poolMemberCmd(),
+		poolListCmd(),
+	)
+	return cmd
+}
+
+func createPoolTx(cmd *cobra.Command, ta *ty.TicketAction) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+	tx, err := types.CreateFormatTx(cfg, "ticket", types.Encode(ta))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Println(hex.EncodeToString(types.Encode(tx)))
+}
+
+func poolCreateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a mining pool, the creator is the operator",
+		Run:   poolCreate,
+	}
+	cmd.Flags().StringP("miner_addr", "m", "", "miner address of the pool tickets")
+	cmd.MarkFlagRequired("miner_addr")
+	cmd.Flags().Int32P("fee_rate", "f", 0, "operator fee rate of the mining reward, in 1/10000")
+	return cmd
+}
+
+func poolCreate(cmd *cobra.Command, args []string) {
+	minerAddr, _ := cmd.Flags().GetString("miner_addr")
+	feeRate, _ := cmd.Flags().GetInt32("fee_rate")
+	create := &ty.TicketPoolCreate{MinerAddress: minerAddr, FeeRate: feeRate}
+	createPoolTx(cmd, &ty.TicketAction{Ty: ty.TicketActionPoolCreate, Value: &ty.TicketAction_Pcreate{Pcreate: create}})
+}
+
+func poolDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit",
+		Short: "Deposit coins in ticket executor to the pool",
+		Run:   poolDeposit,
+	}
+	cmd.Flags().StringP("pool", "p", "", "pool address")
+	cmd.MarkFlagRequired("pool")
+	cmd.Flags().Float64P("amount", "a", 0, "amount")
+	cmd.MarkFlagRequired("amount")
+	return cmd
+}
+
+func poolDeposit(cmd *cobra.Command, args []string) {
+	pool, _ := cmd.Flags().GetString("pool")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	deposit := &ty.TicketPoolDeposit{Pool: pool, Amount: int64(math.Trunc((amount+0.0000001)*1e4)) * 1e4}
+	createPoolTx(cmd, &ty.TicketAction{Ty: ty.TicketActionPoolDeposit, Value: &ty.TicketAction_Pdeposit{Pdeposit: deposit}})
+}
+
+func poolWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Redeem pool shares, the pending coins can be withdrawn again after the pool tickets closed",
+		Run:   poolWithdraw,
+	}
+	cmd.Flags().StringP("pool", "p", "", "pool address")
+	cmd.MarkFlagRequired("pool")
+	cmd.Flags().Int64P("shares", "s", 0, "shares to redeem, 0 to withdraw the pending coins only")
+	return cmd
+}
+
+func poolWithdraw(cmd *cobra.Command, args []string) {
+	pool, _ := cmd.Flags().GetString("pool")
+	shares, _ := cmd.Flags().GetInt64("shares")
+	withdraw := &ty.TicketPoolWithdraw{Pool: pool, Shares: shares}
+	createPoolTx(cmd, &ty.TicketAction{Ty: ty.TicketActionPoolWithdraw, Value: &ty.TicketAction_Pwithdraw{Pwithdraw: withdraw}})
+}
+
+func poolInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Short: "Get pool info",
+		Run:   poolInfo,
+	}
+	cmd.Flags().StringP("pool", "p", "", "pool address")
+	cmd.MarkFlagRequired("pool")
+	return cmd
+}
+
+func poolInfo(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	pool, _ := cmd.Flags().GetString("pool")
+	var params rpctypes.Query4Jrpc
+	params.Execer = ty.TicketX
+	params.FuncName = "TicketPoolInfo"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: pool})
+	var res ty.TicketPool
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+func poolMemberCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member",
+		Short: "Get member shares and value of pools",
+		Run:   poolMember,
+	}
+	cmd.Flags().StringP("addr", "a", "", "member address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("pool", "p", "", "pool address, all pools of the member if empty")
+	return cmd
+}
+
+func poolMember(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	pool, _ := cmd.Flags().GetString("pool")
+	var params rpctypes.Query4Jrpc
+	params.Execer = ty.TicketX
+	if pool != "" {
+		params.FuncName = "TicketPoolMember"
+		params.Payload = types.MustPBToJSON(&ty.ReqTicketPoolMember{Pool: pool, Addr: addr})
+		var res ty.ReplyTicketPoolMember
+		ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+		ctx.Run()
+		return
+	}
+	params.FuncName = "TicketPoolsOfMember"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: addr})
+	var res ty.ReplyTicketPoolMembers
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+func poolListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get pools created by operator or mined by miner address",
+		Run:   poolList,
+	}
+	cmd.Flags().StringP("addr", "a", "", "operator or miner address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().BoolP("miner", "m", false, "list the pools mined by the address")
+	return cmd
+}
+
+func poolList(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	addr, _ := cmd.Flags().GetString("addr")
+	miner, _ := cmd.Flags().GetBool("miner")
+	var params rpctypes.Query4Jrpc
+	params.Execer = ty.TicketX
+	params.FuncName = "TicketPoolList"
+	if miner {
+		params.FuncName = "TicketPoolsOfMiner"
+	}
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: addr})
+	var res ty.ReplyTicketPools
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
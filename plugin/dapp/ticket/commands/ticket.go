@@ -31,6 +31,7 @@ func TicketCmd() *cobra.Command {
 		CloseTicketCmd(),
 		GetColdAddrByMinerCmd(),
 		listTicketCmd(),
+		PoolCmd(),
 	)
 
 	return cmd
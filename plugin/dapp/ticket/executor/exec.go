@@ -45,3 +45,21 @@ func (t *Ticket) Exec_Miner(payload *ty.TicketMiner, tx *types.Transaction, inde
 	actiondb := NewAction(t, tx)
 	return actiondb.TicketMiner(payload, index)
 }
+
+// Exec_Pcreate exec pool create
+func (t *Ticket) Exec_Pcreate(payload *ty.TicketPoolCreate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewAction(t, tx)
+	return actiondb.TicketPoolCreate(payload)
+}
+
+// Exec_Pdeposit exec pool deposit
+func (t *Ticket) Exec_Pdeposit(payload *ty.TicketPoolDeposit, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewAction(t, tx)
+	return actiondb.TicketPoolDeposit(payload)
+}
+
+// Exec_Pwithdraw exec pool withdraw
+func (t *Ticket) Exec_Pwithdraw(payload *ty.TicketPoolWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	actiondb := NewAction(t, tx)
+	return actiondb.TicketPoolWithdraw(payload)
+}
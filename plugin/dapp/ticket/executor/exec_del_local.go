@@ -29,6 +29,20 @@ func (t *Ticket) execDelLocal(receiptData *types.ReceiptData) (*types.LocalDBSet
 			}
 			kv := t.delTicketBind(&ticketlog)
 			dbSet.KV = append(dbSet.KV, kv...)
+		} else if item.Ty == ty.TyLogTicketPool {
+			var poollog ty.ReceiptTicketPool
+			err := types.Decode(item.Log, &poollog)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			dbSet.KV = append(dbSet.KV, t.delPoolLocal(&poollog)...)
+		} else if item.Ty == ty.TyLogTicketPoolMember {
+			var memberlog ty.ReceiptTicketPoolMember
+			err := types.Decode(item.Log, &memberlog)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			dbSet.KV = append(dbSet.KV, t.delPoolMemberLocal(&memberlog)...)
 		}
 	}
 	return dbSet, nil
@@ -58,3 +72,18 @@ func (t *Ticket) ExecDelLocal_Tclose(payload *ty.TicketClose, tx *types.Transact
 func (t *Ticket) ExecDelLocal_Miner(payload *ty.TicketMiner, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return t.execDelLocal(receiptData)
 }
+
+// ExecDelLocal_Pcreate exec del local pool create
+func (t *Ticket) ExecDelLocal_Pcreate(payload *ty.TicketPoolCreate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocal(receiptData)
+}
+
+// ExecDelLocal_Pdeposit exec del local pool deposit
+func (t *Ticket) ExecDelLocal_Pdeposit(payload *ty.TicketPoolDeposit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocal(receiptData)
+}
+
+// ExecDelLocal_Pwithdraw exec del local pool withdraw
+func (t *Ticket) ExecDelLocal_Pwithdraw(payload *ty.TicketPoolWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocal(receiptData)
+}
@@ -29,6 +29,20 @@ func (t *Ticket) execLocal(receiptData *types.ReceiptData) (*types.LocalDBSet, e
 			}
 			kv := t.saveTicketBind(&ticketlog)
 			dbSet.KV = append(dbSet.KV, kv...)
+		} else if item.Ty == ty.TyLogTicketPool {
+			var poollog ty.ReceiptTicketPool
+			err := types.Decode(item.Log, &poollog)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			dbSet.KV = append(dbSet.KV, t.savePoolLocal(&poollog)...)
+		} else if item.Ty == ty.TyLogTicketPoolMember {
+			var memberlog ty.ReceiptTicketPoolMember
+			err := types.Decode(item.Log, &memberlog)
+			if err != nil {
+				panic(err) //数据错误了，已经被修改了
+			}
+			dbSet.KV = append(dbSet.KV, t.savePoolMemberLocal(&memberlog)...)
 		}
 	}
 	return dbSet, nil
@@ -58,3 +72,18 @@ func (t *Ticket) ExecLocal_Tclose(payload *ty.TicketClose, tx *types.Transaction
 func (t *Ticket) ExecLocal_Miner(payload *ty.TicketMiner, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return t.execLocal(receiptData)
 }
+
+// ExecLocal_Pcreate exec local pool create
+func (t *Ticket) ExecLocal_Pcreate(payload *ty.TicketPoolCreate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocal(receiptData)
+}
+
+// ExecLocal_Pdeposit exec local pool deposit
+func (t *Ticket) ExecLocal_Pdeposit(payload *ty.TicketPoolDeposit, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocal(receiptData)
+}
+
+// ExecLocal_Pwithdraw exec local pool withdraw
+func (t *Ticket) ExecLocal_Pwithdraw(payload *ty.TicketPoolWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocal(receiptData)
+}
@@ -0,0 +1,451 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+/*
+矿池：
+1. 运营者创建矿池，矿池地址由创建交易hash生成，没有私钥，作为矿池ticket的returnAddress
+2. 成员把ticket合约中的币存入矿池获得份额，份额按存入时每份额的权益折算，
+   存入后份额至少持有ticket的提款时间才能赎回，避免只在出块前存入分享收益、出块后马上赎回
+3. 运营者用矿池可用的币购买ticket，挖矿地址为矿池指定的minerAddress，
+   ticket的pubHash由挖矿地址的私钥生成，所以通常由挖矿地址的钱包自动购买
+4. ticket挖矿收益计入矿池权益，所有成员按份额分享，运营费按费率折算成份额分给运营者
+5. 成员赎回份额时按当前权益折算成币，矿池可用余额不足的部分在ticket关闭后再领取，
+   待领取的币不能用于购买ticket，有待领取的成员和运营者都可以关闭到期的矿池ticket
+*/
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	ty "github.com/33cn/plugin/plugin/dapp/ticket/types"
+)
+
+func poolKey(addr string) []byte {
+	return []byte("mavl-ticket-pool-" + addr)
+}
+
+func poolMemberKey(pool, addr string) []byte {
+	return []byte(fmt.Sprintf("mavl-ticket-poolmember-%s-%s", pool, addr))
+}
+
+func calcPoolOperatorKey(operator, pool string) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-pool:%s:%s", operator, pool))
+}
+
+func calcPoolOperatorPrefix(operator string) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-pool:%s:", operator))
+}
+
+func calcPoolMinerKey(miner, pool string) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-poolminer:%s:%s", miner, pool))
+}
+
+func calcPoolMinerPrefix(miner string) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-poolminer:%s:", miner))
+}
+
+func calcPoolMemberKey(addr, pool string) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-poolmember:%s:%s", addr, pool))
+}
+
+func calcPoolMemberPrefix(addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-ticket-poolmember:%s:", addr))
+}
+
+// PoolAddress 由创建交易hash生成矿池地址
+func PoolAddress(txhash []byte) string {
+	return address.ExecAddress("ticket-pool-" + common.ToHex(txhash))
+}
+
+func getPool(db dbm.KV, addr string) (*ty.TicketPool, error) {
+	data, err := db.Get(poolKey(addr))
+	if err == types.ErrNotFound {
+		return nil, ty.ErrTicketPoolNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var pool ty.TicketPool
+	err = types.Decode(data, &pool)
+	if err != nil {
+		return nil, err
+	}
+	return &pool, nil
+}
+
+//成员不存在时返回空的成员
+func getPoolMember(db dbm.KV, pool, addr string) (*ty.TicketPoolMember, error) {
+	data, err := db.Get(poolMemberKey(pool, addr))
+	if err == types.ErrNotFound {
+		return &ty.TicketPoolMember{Pool: pool, Addr: addr}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var member ty.TicketPoolMember
+	err = types.Decode(data, &member)
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
+
+func isPoolMember(member *ty.TicketPoolMember) bool {
+	return member != nil && (member.Shares > 0 || member.Pending > 0)
+}
+
+//amount按当前每份额的权益折算成份额，没有份额时1:1
+func poolShares(pool *ty.TicketPool, amount int64) int64 {
+	if pool.TotalShares == 0 {
+		return amount
+	}
+	v := new(big.Int).Mul(big.NewInt(amount), big.NewInt(pool.TotalShares))
+	return v.Div(v, big.NewInt(pool.TotalValue)).Int64()
+}
+
+//份额按当前权益折算成币
+func poolValue(pool *ty.TicketPool, shares int64) int64 {
+	if pool.TotalShares == 0 {
+		return 0
+	}
+	v := new(big.Int).Mul(big.NewInt(shares), big.NewInt(pool.TotalValue))
+	return v.Div(v, big.NewInt(pool.TotalShares)).Int64()
+}
+
+func mergeReceipt(receipt1, receipt2 *types.Receipt) *types.Receipt {
+	if receipt2 != nil {
+		receipt1.KV = append(receipt1.KV, receipt2.KV...)
+		receipt1.Logs = append(receipt1.Logs, receipt2.Logs...)
+	}
+	return receipt1
+}
+
+func (action *Action) savePool(prev, current *ty.TicketPool) *types.Receipt {
+	kv := &types.KeyValue{Key: poolKey(current.Address), Value: types.Encode(current)}
+	action.db.Set(kv.Key, kv.Value)
+	log := &types.ReceiptLog{
+		Ty:  ty.TyLogTicketPool,
+		Log: types.Encode(&ty.ReceiptTicketPool{Prev: prev, Current: current}),
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}
+}
+
+func (action *Action) savePoolMember(prev, current *ty.TicketPoolMember) *types.Receipt {
+	kv := &types.KeyValue{Key: poolMemberKey(current.Pool, current.Addr), Value: types.Encode(current)}
+	action.db.Set(kv.Key, kv.Value)
+	log := &types.ReceiptLog{
+		Ty:  ty.TyLogTicketPoolMember,
+		Log: types.Encode(&ty.ReceiptTicketPoolMember{Prev: prev, Current: current}),
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}
+}
+
+//fork之后returnAddress是矿池地址时返回矿池，否则返回nil
+func (action *Action) getForkPool(addr string) (*ty.TicketPool, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.TicketX, ty.ForkTicketPool) {
+		return nil, nil
+	}
+	pool, err := getPool(action.db, addr)
+	if err == ty.ErrTicketPoolNotFound {
+		return nil, nil
+	}
+	return pool, err
+}
+
+// TicketPoolCreate 创建矿池
+func (action *Action) TicketPoolCreate(create *ty.TicketPoolCreate) (*types.Receipt, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.TicketX, ty.ForkTicketPool) {
+		return nil, types.ErrNotSupport
+	}
+	if err := address.CheckAddress(create.MinerAddress); err != nil {
+		return nil, err
+	}
+	if create.FeeRate < 0 || create.FeeRate > ty.TicketPoolFeeRateMax {
+		return nil, ty.ErrTicketPoolFeeRate
+	}
+	pool := &ty.TicketPool{
+		Address:      PoolAddress(action.txhash),
+		Operator:     action.fromaddr,
+		MinerAddress: create.MinerAddress,
+		FeeRate:      create.FeeRate,
+		CreateTime:   action.blocktime,
+	}
+	return action.savePool(nil, pool), nil
+}
+
+// TicketPoolDeposit 存入ticket合约中的币，按当前权益获得份额
+func (action *Action) TicketPoolDeposit(deposit *ty.TicketPoolDeposit) (*types.Receipt, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.TicketX, ty.ForkTicketPool) {
+		return nil, types.ErrNotSupport
+	}
+	if deposit.Amount <= 0 {
+		return nil, ty.ErrTicketPoolAmount
+	}
+	pool, err := getPool(action.db, deposit.Pool)
+	if err != nil {
+		return nil, err
+	}
+	member, err := getPoolMember(action.db, pool.Address, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	shares := poolShares(pool, deposit.Amount)
+	if shares <= 0 {
+		return nil, ty.ErrTicketPoolAmount
+	}
+	receipt, err := action.coinsAccount.ExecTransfer(action.fromaddr, pool.Address, action.execaddr, deposit.Amount)
+	if err != nil {
+		tlog.Error("TicketPoolDeposit.ExecTransfer", "addr", action.fromaddr, "pool", pool.Address, "amount", deposit.Amount)
+		return nil, err
+	}
+
+	prevPool := types.Clone(pool).(*ty.TicketPool)
+	prevMember := types.Clone(member).(*ty.TicketPoolMember)
+	pool.TotalShares += shares
+	pool.TotalValue += deposit.Amount
+	member.Shares += shares
+	member.Deposited += deposit.Amount
+	member.UnlockTime = action.blocktime + ty.GetTicketMinerParam(action.api.GetConfig(), action.height).TicketWithdrawTime
+	receipt = mergeReceipt(receipt, action.savePool(prevPool, pool))
+	receipt = mergeReceipt(receipt, action.savePoolMember(prevMember, member))
+	receipt.Ty = types.ExecOk
+	return receipt, nil
+}
+
+// TicketPoolWithdraw 赎回份额并领取，矿池可用余额不足的部分等ticket关闭后再领取
+func (action *Action) TicketPoolWithdraw(withdraw *ty.TicketPoolWithdraw) (*types.Receipt, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, ty.TicketX, ty.ForkTicketPool) {
+		return nil, types.ErrNotSupport
+	}
+	pool, err := getPool(action.db, withdraw.Pool)
+	if err != nil {
+		return nil, err
+	}
+	member, err := getPoolMember(action.db, pool.Address, action.fromaddr)
+	if err != nil {
+		return nil, err
+	}
+	if withdraw.Shares < 0 || withdraw.Shares > member.Shares {
+		return nil, ty.ErrTicketPoolShares
+	}
+	prevPool := types.Clone(pool).(*ty.TicketPool)
+	prevMember := types.Clone(member).(*ty.TicketPoolMember)
+	if withdraw.Shares > 0 {
+		if action.blocktime < member.UnlockTime {
+			tlog.Error("TicketPoolWithdraw", "addr", action.fromaddr, "pool", pool.Address, "unlockTime", member.UnlockTime)
+			return nil, ty.ErrTicketPoolLocked
+		}
+		value := poolValue(pool, withdraw.Shares)
+		if value <= 0 {
+			return nil, ty.ErrTicketPoolShares
+		}
+		pool.TotalShares -= withdraw.Shares
+		pool.TotalValue -= value
+		pool.PendingWithdraw += value
+		member.Shares -= withdraw.Shares
+		member.Pending += value
+	}
+
+	pay := member.Pending
+	acc := action.coinsAccount.LoadExecAccount(pool.Address, action.execaddr)
+	if acc.Balance < pay {
+		pay = acc.Balance
+	}
+	if withdraw.Shares == 0 && pay <= 0 {
+		return nil, ty.ErrTicketPoolBalance
+	}
+	receipt := &types.Receipt{Ty: types.ExecOk}
+	if pay > 0 {
+		r, err := action.coinsAccount.ExecTransfer(pool.Address, action.fromaddr, action.execaddr, pay)
+		if err != nil {
+			tlog.Error("TicketPoolWithdraw.ExecTransfer", "addr", action.fromaddr, "pool", pool.Address, "amount", pay)
+			return nil, err
+		}
+		receipt = mergeReceipt(receipt, r)
+		pool.PendingWithdraw -= pay
+		member.Pending -= pay
+		member.Withdrawn += pay
+	}
+	receipt = mergeReceipt(receipt, action.savePool(prevPool, pool))
+	receipt = mergeReceipt(receipt, action.savePoolMember(prevMember, member))
+	return receipt, nil
+}
+
+//矿池ticket由运营者或者矿池的挖矿地址购买，待领取的币不能用于购买ticket
+func (action *Action) checkPoolOpen(topen *ty.TicketOpen, price int64) (bool, error) {
+	pool, err := action.getForkPool(topen.ReturnAddress)
+	if pool == nil {
+		return false, err
+	}
+	if action.fromaddr != pool.Operator && action.fromaddr != pool.MinerAddress {
+		return false, ty.ErrMinerNotPermit
+	}
+	if topen.MinerAddress != pool.MinerAddress {
+		return false, ty.ErrMinerAddr
+	}
+	if topen.Count > ty.TicketCountOpenOnce {
+		return false, ty.ErrTicketCount
+	}
+	acc := action.coinsAccount.LoadExecAccount(pool.Address, action.execaddr)
+	if acc.Balance-pool.PendingWithdraw < int64(topen.Count)*price {
+		tlog.Error("TicketOpen.pool", "pool", pool.Address, "balance", acc.Balance, "pending", pool.PendingWithdraw, "n", topen.Count)
+		return false, ty.ErrTicketPoolBalance
+	}
+	return true, nil
+}
+
+//运营者和有待领取的成员可以关闭矿池的ticket
+func (action *Action) isPoolCloser(returnAddr string) (bool, error) {
+	pool, err := action.getForkPool(returnAddr)
+	if pool == nil {
+		return false, err
+	}
+	if action.fromaddr == pool.Operator {
+		return true, nil
+	}
+	member, err := getPoolMember(action.db, pool.Address, action.fromaddr)
+	if err != nil {
+		return false, err
+	}
+	return member.Pending > 0, nil
+}
+
+//挖矿收益计入矿池权益，运营费折算成份额给运营者，矿池没有份额时收益都归运营者
+func (action *Action) poolReward(returnAddr string, reward int64) (*types.Receipt, error) {
+	pool, err := action.getForkPool(returnAddr)
+	if pool == nil || reward <= 0 {
+		return nil, err
+	}
+	operator, err := getPoolMember(action.db, pool.Address, pool.Operator)
+	if err != nil {
+		return nil, err
+	}
+	prevPool := types.Clone(pool).(*ty.TicketPool)
+	prevOperator := types.Clone(operator).(*ty.TicketPoolMember)
+
+	fee := reward
+	if pool.TotalShares > 0 {
+		v := new(big.Int).Mul(big.NewInt(reward), big.NewInt(int64(pool.FeeRate)))
+		fee = v.Div(v, big.NewInt(ty.TicketPoolFeeRateMax)).Int64()
+	}
+	pool.TotalValue += reward - fee
+	feeShares := poolShares(pool, fee)
+	pool.TotalValue += fee
+	pool.TotalShares += feeShares
+	pool.TotalReward += reward
+	pool.TotalFee += fee
+	receipt := action.savePool(prevPool, pool)
+	if feeShares > 0 {
+		operator.Shares += feeShares
+		receipt = mergeReceipt(receipt, action.savePoolMember(prevOperator, operator))
+	}
+	return receipt, nil
+}
+
+// PoolInfo 查询矿池
+func PoolInfo(db dbm.KV, req *types.ReqString) (types.Message, error) {
+	return getPool(db, req.Data)
+}
+
+// PoolMember 查询成员的份额和权益
+func PoolMember(db dbm.KV, req *ty.ReqTicketPoolMember) (types.Message, error) {
+	pool, err := getPool(db, req.Pool)
+	if err != nil {
+		return nil, err
+	}
+	member, err := getPoolMember(db, req.Pool, req.Addr)
+	if err != nil {
+		return nil, err
+	}
+	return &ty.ReplyTicketPoolMember{Member: member, Value: poolValue(pool, member.Shares)}, nil
+}
+
+func poolList(db dbm.Lister, db2 dbm.KV, prefix []byte) (*ty.ReplyTicketPools, error) {
+	values, err := db.List(prefix, nil, 0, 0)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &ty.ReplyTicketPools{}
+	for _, value := range values {
+		pool, err := getPool(db2, string(value))
+		if err != nil {
+			return nil, err
+		}
+		reply.Pools = append(reply.Pools, pool)
+	}
+	return reply, nil
+}
+
+// PoolList 查询运营者创建的矿池
+func PoolList(db dbm.Lister, db2 dbm.KV, req *types.ReqString) (types.Message, error) {
+	return poolList(db, db2, calcPoolOperatorPrefix(req.Data))
+}
+
+// PoolsOfMiner 查询由挖矿地址挖矿的矿池
+func PoolsOfMiner(db dbm.Lister, db2 dbm.KV, req *types.ReqString) (types.Message, error) {
+	return poolList(db, db2, calcPoolMinerPrefix(req.Data))
+}
+
+// PoolsOfMember 查询成员参与的矿池
+func PoolsOfMember(db dbm.Lister, db2 dbm.KV, req *types.ReqString) (types.Message, error) {
+	values, err := db.List(calcPoolMemberPrefix(req.Data), nil, 0, 0)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &ty.ReplyTicketPoolMembers{}
+	for _, value := range values {
+		member, err := PoolMember(db2, &ty.ReqTicketPoolMember{Pool: string(value), Addr: req.Data})
+		if err != nil {
+			return nil, err
+		}
+		reply.Members = append(reply.Members, member.(*ty.ReplyTicketPoolMember))
+	}
+	return reply, nil
+}
+
+func (t *Ticket) savePoolLocal(r *ty.ReceiptTicketPool) (kvs []*types.KeyValue) {
+	if r.Prev == nil {
+		pool := r.Current
+		kvs = append(kvs, &types.KeyValue{Key: calcPoolOperatorKey(pool.Operator, pool.Address), Value: []byte(pool.Address)})
+		kvs = append(kvs, &types.KeyValue{Key: calcPoolMinerKey(pool.MinerAddress, pool.Address), Value: []byte(pool.Address)})
+	}
+	return kvs
+}
+
+func (t *Ticket) delPoolLocal(r *ty.ReceiptTicketPool) (kvs []*types.KeyValue) {
+	if r.Prev == nil {
+		pool := r.Current
+		kvs = append(kvs, &types.KeyValue{Key: calcPoolOperatorKey(pool.Operator, pool.Address), Value: nil})
+		kvs = append(kvs, &types.KeyValue{Key: calcPoolMinerKey(pool.MinerAddress, pool.Address), Value: nil})
+	}
+	return kvs
+}
+
+func poolMemberLocal(member *ty.TicketPoolMember, exist bool) *types.KeyValue {
+	kv := &types.KeyValue{Key: calcPoolMemberKey(member.Addr, member.Pool)}
+	if exist {
+		kv.Value = []byte(member.Pool)
+	}
+	return kv
+}
+
+func (t *Ticket) savePoolMemberLocal(r *ty.ReceiptTicketPoolMember) (kvs []*types.KeyValue) {
+	if isPoolMember(r.Prev) != isPoolMember(r.Current) {
+		kvs = append(kvs, poolMemberLocal(r.Current, isPoolMember(r.Current)))
+	}
+	return kvs
+}
+
+func (t *Ticket) delPoolMemberLocal(r *ty.ReceiptTicketPoolMember) (kvs []*types.KeyValue) {
+	if isPoolMember(r.Prev) != isPoolMember(r.Current) {
+		kvs = append(kvs, poolMemberLocal(r.Current, isPoolMember(r.Prev)))
+	}
+	return kvs
+}
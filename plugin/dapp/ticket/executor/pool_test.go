@@ -0,0 +1,326 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor_test
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	pty "github.com/33cn/plugin/plugin/dapp/ticket/types"
+)
+
+type poolEnv struct {
+	t         *testing.T
+	cfg       *types.Chain33Config
+	driver    dapp.Driver
+	acc       *account.DB
+	localdb   db.DB
+	blockTime int64
+	execAddr  string
+}
+
+func newPoolEnv(t *testing.T) *poolEnv {
+	cfg := mock33.GetAPI().GetConfig()
+	_, _, kvdb := util.CreateTestDB()
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	driver, err := dapp.LoadDriver(pty.TicketX, 10000)
+	assert.Nil(t, err)
+	localdb, _ := db.NewGoMemDB("ticketpool", "", 0)
+	env := &poolEnv{t: t, cfg: cfg, driver: driver, localdb: localdb, blockTime: 1539918074, execAddr: address.ExecAddress(pty.TicketX)}
+	driver.SetAPI(api)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(db.NewKVDB(localdb))
+	driver.SetEnv(10000, env.blockTime, 1)
+	env.acc = driver.GetCoinsAccount()
+	return env
+}
+
+func (env *poolEnv) setBalance(addr string, amount int64) {
+	env.acc.SaveExecAccount(env.execAddr, &types.Account{Addr: addr, Balance: amount})
+}
+
+func (env *poolEnv) balance(addr string) *types.Account {
+	return env.acc.LoadExecAccount(addr, env.execAddr)
+}
+
+func (env *poolEnv) sleep(seconds int64) {
+	env.blockTime += seconds
+	env.driver.SetEnv(10000, env.blockTime, 1)
+}
+
+func (env *poolEnv) exec(key string, action *pty.TicketAction, index int) (*types.Receipt, error) {
+	tx, err := types.CreateFormatTx(env.cfg, pty.TicketX, types.Encode(action))
+	assert.Nil(env.t, err)
+	priv, err := FromPrivkey(key)
+	assert.Nil(env.t, err)
+	tx.Sign(types.SECP256K1, priv)
+	receipt, err := env.driver.Exec(tx, index)
+	if err != nil {
+		return nil, err
+	}
+	set, err := env.driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, index)
+	assert.Nil(env.t, err)
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			env.localdb.Delete(kv.Key)
+			continue
+		}
+		env.localdb.Set(kv.Key, kv.Value)
+	}
+	return receipt, nil
+}
+
+func (env *poolEnv) query(funcName string, param types.Message) types.Message {
+	msg, err := env.driver.Query(funcName, types.Encode(param))
+	assert.Nil(env.t, err)
+	return msg
+}
+
+func (env *poolEnv) pool(addr string) *pty.TicketPool {
+	return env.query("TicketPoolInfo", &types.ReqString{Data: addr}).(*pty.TicketPool)
+}
+
+func (env *poolEnv) member(pool, addr string) *pty.ReplyTicketPoolMember {
+	return env.query("TicketPoolMember", &pty.ReqTicketPoolMember{Pool: pool, Addr: addr}).(*pty.ReplyTicketPoolMember)
+}
+
+//矿池地址的币等于成员权益加上待领取的币
+func (env *poolEnv) checkPoolBalance(pool *pty.TicketPool) {
+	acc := env.balance(pool.Address)
+	assert.Equal(env.t, pool.TotalValue+pool.PendingWithdraw, acc.Balance+acc.Frozen)
+}
+
+func poolOpen(pool string, count int32) *pty.TicketAction {
+	topen := &pty.TicketOpen{MinerAddress: string(Nodes[3]), ReturnAddress: pool, Count: count}
+	return &pty.TicketAction{Ty: pty.TicketActionOpen, Value: &pty.TicketAction_Topen{Topen: topen}}
+}
+
+func poolDeposit(pool string, amount int64) *pty.TicketAction {
+	deposit := &pty.TicketPoolDeposit{Pool: pool, Amount: amount}
+	return &pty.TicketAction{Ty: pty.TicketActionPoolDeposit, Value: &pty.TicketAction_Pdeposit{Pdeposit: deposit}}
+}
+
+func poolWithdraw(pool string, shares int64) *pty.TicketAction {
+	withdraw := &pty.TicketPoolWithdraw{Pool: pool, Shares: shares}
+	return &pty.TicketAction{Ty: pty.TicketActionPoolWithdraw, Value: &pty.TicketAction_Pwithdraw{Pwithdraw: withdraw}}
+}
+
+func openedTickets(t *testing.T, receipt *types.Receipt) (ids []string) {
+	for _, log := range receipt.Logs {
+		if log.Ty != pty.TyLogNewTicket {
+			continue
+		}
+		var r pty.ReceiptTicket
+		assert.Nil(t, types.Decode(log.Log, &r))
+		ids = append(ids, r.TicketId)
+	}
+	return ids
+}
+
+func TestTicketPool(t *testing.T) {
+	env := newPoolEnv(t)
+	operator, memberB, memberC, miner := string(Nodes[0]), string(Nodes[1]), string(Nodes[2]), string(Nodes[3])
+	price := pty.GetTicketMinerParam(env.cfg, 10000).TicketPrice
+	env.setBalance(memberB, 2*price)
+	env.setBalance(memberC, price)
+
+	//创建矿池，运营费10%
+	_, err := env.exec(PrivKeyA, &pty.TicketAction{Ty: pty.TicketActionPoolCreate,
+		Value: &pty.TicketAction_Pcreate{Pcreate: &pty.TicketPoolCreate{MinerAddress: miner, FeeRate: 10001}}}, 0)
+	assert.Equal(t, pty.ErrTicketPoolFeeRate, err)
+	create := &pty.TicketAction{Ty: pty.TicketActionPoolCreate,
+		Value: &pty.TicketAction_Pcreate{Pcreate: &pty.TicketPoolCreate{MinerAddress: miner, FeeRate: 1000}}}
+	receipt, err := env.exec(PrivKeyA, create, 0)
+	assert.Nil(t, err)
+	var r pty.ReceiptTicketPool
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &r))
+	pool := r.Current.Address
+	assert.Equal(t, operator, env.pool(pool).Operator)
+	pools := env.query("TicketPoolList", &types.ReqString{Data: operator}).(*pty.ReplyTicketPools)
+	assert.Equal(t, 1, len(pools.Pools))
+	pools = env.query("TicketPoolsOfMiner", &types.ReqString{Data: miner}).(*pty.ReplyTicketPools)
+	assert.Equal(t, 1, len(pools.Pools))
+
+	//成员存入，份额1:1
+	_, err = env.exec(PrivKeyB, poolDeposit(pool, 3*price), 0)
+	assert.Equal(t, types.ErrNoBalance, err)
+	_, err = env.exec(PrivKeyB, poolDeposit(pool, 2*price), 0)
+	assert.Nil(t, err)
+	_, err = env.exec(PrivKeyC, poolDeposit(pool, price), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*price, env.member(pool, memberB).Member.Shares)
+	assert.Equal(t, 3*price, env.pool(pool).TotalValue)
+
+	//只有运营者和挖矿地址可以用矿池的币购买ticket
+	_, err = env.exec(PrivKeyB, poolOpen(pool, 1), 0)
+	assert.Equal(t, pty.ErrMinerNotPermit, err)
+	_, err = env.exec(PrivKeyA, poolOpen(pool, 4), 0)
+	assert.Equal(t, pty.ErrTicketPoolBalance, err)
+	receipt, err = env.exec(PrivKeyA, poolOpen(pool, 3), 0)
+	assert.Nil(t, err)
+	tickets := openedTickets(t, receipt)
+	assert.Equal(t, 3, len(tickets))
+	assert.Equal(t, 3*price, env.balance(pool).Frozen)
+
+	//挖矿收益按份额分配，运营费折算成运营者的份额
+	env.sleep(10)
+	reward := 30 * types.Coin
+	_, err = env.exec(PrivKeyD, &pty.TicketAction{Ty: pty.TicketActionMiner,
+		Value: &pty.TicketAction_Miner{Miner: &pty.TicketMiner{TicketId: tickets[0], Reward: reward}}}, 0)
+	assert.Nil(t, err)
+	info := env.pool(pool)
+	assert.Equal(t, 3*price+reward, info.TotalValue)
+	assert.Equal(t, reward, info.TotalReward)
+	assert.Equal(t, reward/10, info.TotalFee)
+	env.checkPoolBalance(info)
+	assert.Equal(t, 2*price+reward*9/10*2/3, env.member(pool, memberB).Value)
+	assert.InDelta(t, reward/10, env.member(pool, operator).Value, 1)
+
+	//可用余额不足，赎回的币等ticket关闭后领取
+	shares := env.member(pool, memberC).Member.Shares
+	value := env.member(pool, memberC).Value
+	_, err = env.exec(PrivKeyC, poolWithdraw(pool, shares+1), 0)
+	assert.Equal(t, pty.ErrTicketPoolShares, err)
+	_, err = env.exec(PrivKeyC, poolWithdraw(pool, shares), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, value, env.member(pool, memberC).Member.Pending)
+	assert.Equal(t, int64(0), env.balance(memberC).Balance)
+	_, err = env.exec(PrivKeyC, poolWithdraw(pool, 0), 0)
+	assert.Equal(t, pty.ErrTicketPoolBalance, err)
+	env.checkPoolBalance(env.pool(pool))
+
+	//有待领取的成员可以关闭矿池的ticket，其他成员不可以
+	tclose := &pty.TicketAction{Ty: pty.TicketActionClose,
+		Value: &pty.TicketAction_Tclose{Tclose: &pty.TicketClose{TicketId: []string{tickets[1]}}}}
+	_, err = env.exec(PrivKeyB, tclose, 0)
+	assert.Equal(t, types.ErrFromAddr, err)
+	_, err = env.exec(PrivKeyC, tclose, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, price, env.balance(pool).Balance)
+	//待领取的币不能用于购买ticket
+	_, err = env.exec(PrivKeyA, poolOpen(pool, 1), 0)
+	assert.Equal(t, pty.ErrTicketPoolBalance, err)
+	_, err = env.exec(PrivKeyC, poolWithdraw(pool, 0), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, price, env.balance(memberC).Balance)
+	assert.Equal(t, value-price, env.member(pool, memberC).Member.Pending)
+
+	env.sleep(10)
+	_, err = env.exec(PrivKeyD, &pty.TicketAction{Ty: pty.TicketActionClose,
+		Value: &pty.TicketAction_Tclose{Tclose: &pty.TicketClose{TicketId: []string{tickets[0]}}}}, 0)
+	assert.Nil(t, err)
+	_, err = env.exec(PrivKeyC, poolWithdraw(pool, 0), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, value, env.balance(memberC).Balance)
+	info = env.pool(pool)
+	assert.Equal(t, int64(0), info.PendingWithdraw)
+	env.checkPoolBalance(info)
+
+	//成员参与的矿池
+	members := env.query("TicketPoolsOfMember", &types.ReqString{Data: memberC}).(*pty.ReplyTicketPoolMembers)
+	assert.Equal(t, 0, len(members.Members))
+	members = env.query("TicketPoolsOfMember", &types.ReqString{Data: memberB}).(*pty.ReplyTicketPoolMembers)
+	assert.Equal(t, 1, len(members.Members))
+	assert.Equal(t, env.member(pool, memberB).Value, members.Members[0].Value)
+
+	//剩余成员全部赎回后矿池权益清零
+	_, err = env.exec(PrivKeyA, poolWithdraw(pool, env.member(pool, operator).Member.Shares), 0)
+	assert.Nil(t, err)
+	_, err = env.exec(PrivKeyB, poolWithdraw(pool, env.member(pool, memberB).Member.Shares), 0)
+	assert.Nil(t, err)
+	info = env.pool(pool)
+	assert.Equal(t, int64(0), info.TotalShares)
+	assert.Equal(t, int64(0), info.TotalValue)
+	env.checkPoolBalance(info)
+}
+
+func TestTicketPoolNoMember(t *testing.T) {
+	env := newPoolEnv(t)
+	miner := string(Nodes[3])
+	price := pty.GetTicketMinerParam(env.cfg, 10000).TicketPrice
+	env.setBalance(string(Nodes[0]), price)
+
+	create := &pty.TicketAction{Ty: pty.TicketActionPoolCreate,
+		Value: &pty.TicketAction_Pcreate{Pcreate: &pty.TicketPoolCreate{MinerAddress: miner, FeeRate: 500}}}
+	receipt, err := env.exec(PrivKeyA, create, 0)
+	assert.Nil(t, err)
+	var r pty.ReceiptTicketPool
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &r))
+	pool := r.Current.Address
+
+	//成员全部赎回时矿池ticket的收益都归运营者
+	_, err = env.exec(PrivKeyA, poolDeposit(pool, price), 0)
+	assert.Nil(t, err)
+	receipt, err = env.exec(PrivKeyA, poolOpen(pool, 1), 0)
+	assert.Nil(t, err)
+	tickets := openedTickets(t, receipt)
+	env.sleep(10)
+	_, err = env.exec(PrivKeyA, poolWithdraw(pool, price), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), env.pool(pool).TotalShares)
+
+	reward := 30 * types.Coin
+	_, err = env.exec(PrivKeyD, &pty.TicketAction{Ty: pty.TicketActionMiner,
+		Value: &pty.TicketAction_Miner{Miner: &pty.TicketMiner{TicketId: tickets[0], Reward: reward}}}, 0)
+	assert.Nil(t, err)
+	info := env.pool(pool)
+	assert.Equal(t, reward, info.TotalFee)
+	assert.Equal(t, reward, env.member(pool, string(Nodes[0])).Value)
+	env.checkPoolBalance(info)
+}
+
+func TestTicketPoolFreeRider(t *testing.T) {
+	env := newPoolEnv(t)
+	memberB, miner := string(Nodes[1]), string(Nodes[3])
+	param := pty.GetTicketMinerParam(env.cfg, 10000)
+	price := param.TicketPrice
+	env.setBalance(string(Nodes[0]), price)
+	env.setBalance(memberB, price)
+
+	create := &pty.TicketAction{Ty: pty.TicketActionPoolCreate,
+		Value: &pty.TicketAction_Pcreate{Pcreate: &pty.TicketPoolCreate{MinerAddress: miner}}}
+	receipt, err := env.exec(PrivKeyA, create, 0)
+	assert.Nil(t, err)
+	var r pty.ReceiptTicketPool
+	assert.Nil(t, types.Decode(receipt.Logs[0].Log, &r))
+	pool := r.Current.Address
+	_, err = env.exec(PrivKeyA, poolDeposit(pool, price), 0)
+	assert.Nil(t, err)
+	receipt, err = env.exec(PrivKeyA, poolOpen(pool, 1), 0)
+	assert.Nil(t, err)
+	tickets := openedTickets(t, receipt)
+
+	//出块前存入的成员分享了收益，但份额要持有ticket的提款时间才能赎回
+	env.sleep(5)
+	_, err = env.exec(PrivKeyB, poolDeposit(pool, price), 0)
+	assert.Nil(t, err)
+	assert.Equal(t, env.blockTime+param.TicketWithdrawTime, env.member(pool, memberB).Member.UnlockTime)
+	reward := 30 * types.Coin
+	_, err = env.exec(PrivKeyD, &pty.TicketAction{Ty: pty.TicketActionMiner,
+		Value: &pty.TicketAction_Miner{Miner: &pty.TicketMiner{TicketId: tickets[0], Reward: reward}}}, 0)
+	assert.Nil(t, err)
+	shares := env.member(pool, memberB).Member.Shares
+	_, err = env.exec(PrivKeyB, poolWithdraw(pool, shares), 0)
+	assert.Equal(t, pty.ErrTicketPoolLocked, err)
+
+	//到达赎回时间后才能赎回
+	env.sleep(param.TicketWithdrawTime - 1)
+	_, err = env.exec(PrivKeyB, poolWithdraw(pool, shares), 0)
+	assert.Equal(t, pty.ErrTicketPoolLocked, err)
+	env.sleep(1)
+	_, err = env.exec(PrivKeyB, poolWithdraw(pool, shares), 0)
+	assert.Nil(t, err)
+	env.checkPoolBalance(env.pool(pool))
+}
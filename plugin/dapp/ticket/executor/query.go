@@ -49,3 +49,28 @@ func (ticket *Ticket) Query_MinerSourceList(param *types.ReqString) (types.Messa
 func (ticket *Ticket) Query_RandNumHash(param *types.ReqRandHash) (types.Message, error) {
 	return ticket.GetRandNum(param.Hash, param.BlockNum)
 }
+
+// Query_TicketPoolInfo query pool
+func (ticket *Ticket) Query_TicketPoolInfo(param *types.ReqString) (types.Message, error) {
+	return PoolInfo(ticket.GetStateDB(), param)
+}
+
+// Query_TicketPoolMember query pool member shares and value
+func (ticket *Ticket) Query_TicketPoolMember(param *pty.ReqTicketPoolMember) (types.Message, error) {
+	return PoolMember(ticket.GetStateDB(), param)
+}
+
+// Query_TicketPoolList query pools of operator
+func (ticket *Ticket) Query_TicketPoolList(param *types.ReqString) (types.Message, error) {
+	return PoolList(ticket.GetLocalDB(), ticket.GetStateDB(), param)
+}
+
+// Query_TicketPoolsOfMember query pools of member
+func (ticket *Ticket) Query_TicketPoolsOfMember(param *types.ReqString) (types.Message, error) {
+	return PoolsOfMember(ticket.GetLocalDB(), ticket.GetStateDB(), param)
+}
+
+// Query_TicketPoolsOfMiner query pools mined by miner address
+func (ticket *Ticket) Query_TicketPoolsOfMiner(param *types.ReqString) (types.Message, error) {
+	return PoolsOfMiner(ticket.GetLocalDB(), ticket.GetStateDB(), param)
+}
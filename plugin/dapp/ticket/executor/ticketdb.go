@@ -233,8 +233,14 @@ func (action *Action) TicketOpen(topen *ty.TicketOpen) (*types.Receipt, error) {
 	prefix = topen.MinerAddress + ":" + prefix + ":"
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
+	cfg := ty.GetTicketMinerParam(chain33Cfg, action.height)
+	//矿池的ticket由运营者或者矿池的挖矿地址购买
+	isPool, err := action.checkPoolOpen(topen, cfg.TicketPrice)
+	if err != nil {
+		return nil, err
+	}
 	//addr from
-	if action.fromaddr != topen.ReturnAddress {
+	if !isPool && action.fromaddr != topen.ReturnAddress {
 		mineraddr := action.getBind(topen.ReturnAddress)
 		if mineraddr != action.fromaddr {
 			return nil, ty.ErrMinerNotPermit
@@ -244,7 +250,6 @@ func (action *Action) TicketOpen(topen *ty.TicketOpen) (*types.Receipt, error) {
 		}
 	}
 	//action.fromaddr == topen.ReturnAddress or mineraddr == action.fromaddr
-	cfg := ty.GetTicketMinerParam(chain33Cfg, action.height)
 	for i := 0; i < int(topen.Count); i++ {
 		id := prefix + fmt.Sprintf("%010d", i)
 		//add pubHash
@@ -344,6 +349,12 @@ func (action *Action) TicketMiner(miner *ty.TicketMiner, index int) (*types.Rece
 		tlog.Error("TicketMiner.ExecDepositFrozen user", "addr", t.ReturnAddress, "execaddr", action.execaddr)
 		return nil, err
 	}
+	//矿池分配收益
+	receipt3, err := action.poolReward(t.ReturnAddress, ticket.MinerValue)
+	if err != nil {
+		tlog.Error("TicketMiner.poolReward", "addr", t.ReturnAddress, "error", err)
+		return nil, err
+	}
 	//fund
 	var receipt2 *types.Receipt
 	if chain33Cfg.IsFork(action.height, "ForkTicketFundAddrV1") {
@@ -369,6 +380,10 @@ func (action *Action) TicketMiner(miner *ty.TicketMiner, index int) (*types.Rece
 	kv = append(kv, receipt1.KV...)
 	logs = append(logs, receipt2.Logs...)
 	kv = append(kv, receipt2.KV...)
+	if receipt3 != nil {
+		logs = append(logs, receipt3.Logs...)
+		kv = append(kv, receipt3.KV...)
+	}
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
 
@@ -402,7 +417,13 @@ func (action *Action) TicketClose(tclose *ty.TicketClose) (*types.Receipt, error
 		}
 		//check from address
 		if action.fromaddr != ticket.MinerAddress && action.fromaddr != ticket.ReturnAddress {
-			return nil, types.ErrFromAddr
+			isCloser, err := action.isPoolCloser(ticket.ReturnAddress)
+			if err != nil {
+				return nil, err
+			}
+			if !isCloser {
+				return nil, types.ErrFromAddr
+			}
 		}
 		prevstatus := ticket.Status
 		ticket.Status = ty.TicketClosed
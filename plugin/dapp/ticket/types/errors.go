@@ -29,4 +29,16 @@ var (
 	ErrNoVrf = errors.New("ErrNoVrf")
 	// ErrVrfVerify err type
 	ErrVrfVerify = errors.New("ErrVrfVerify")
+	// ErrTicketPoolNotFound err type
+	ErrTicketPoolNotFound = errors.New("ErrTicketPoolNotFound")
+	// ErrTicketPoolFeeRate err type
+	ErrTicketPoolFeeRate = errors.New("ErrTicketPoolFeeRate")
+	// ErrTicketPoolAmount err type
+	ErrTicketPoolAmount = errors.New("ErrTicketPoolAmount")
+	// ErrTicketPoolShares err type
+	ErrTicketPoolShares = errors.New("ErrTicketPoolShares")
+	// ErrTicketPoolBalance err type
+	ErrTicketPoolBalance = errors.New("ErrTicketPoolBalance")
+	// ErrTicketPoolLocked err type
+	ErrTicketPoolLocked = errors.New("ErrTicketPoolLocked")
 )
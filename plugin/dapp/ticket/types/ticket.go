@@ -36,6 +36,10 @@ const (
 	TyLogMinerTicket = 113
 	// TyLogTicketBind bind ticket log type
 	TyLogTicketBind = 114
+	// TyLogTicketPool pool log type
+	TyLogTicketPool = 115
+	// TyLogTicketPoolMember pool member log type
+	TyLogTicketPoolMember = 116
 )
 
 //ticket
@@ -54,8 +58,17 @@ const (
 	TicketActionMiner = 16
 	// TicketActionBind action bind
 	TicketActionBind = 17
+	// TicketActionPoolCreate action pool create
+	TicketActionPoolCreate = 18
+	// TicketActionPoolDeposit action pool deposit
+	TicketActionPoolDeposit = 19
+	// TicketActionPoolWithdraw action pool withdraw
+	TicketActionPoolWithdraw = 20
 )
 
+// TicketPoolFeeRateMax 矿池运营费率上限，万分比
+const TicketPoolFeeRateMax = 10000
+
 // TicketOldParts old tick type
 const TicketOldParts = 3
 
@@ -68,6 +81,9 @@ var ErrOpenTicketPubHash = errors.New("ErrOpenTicketPubHash")
 // TicketX dapp name
 var TicketX = "ticket"
 
+// ForkTicketPool 矿池
+const ForkTicketPool = "ForkTicketPool"
+
 func init() {
 	types.AllowUserExec = append(types.AllowUserExec, []byte(TicketX))
 	types.RegFork(TicketX, InitFork)
@@ -80,6 +96,7 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TicketX, "Enable", 0)
 	cfg.RegisterDappFork(TicketX, "ForkTicketId", 1062000)
 	cfg.RegisterDappFork(TicketX, "ForkTicketVrf", 1770000)
+	cfg.RegisterDappFork(TicketX, ForkTicketPool, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -108,10 +125,12 @@ func (ticket *TicketType) GetPayload() types.Message {
 // GetLogMap get log map
 func (ticket *TicketType) GetLogMap() map[int64]*types.LogInfo {
 	return map[int64]*types.LogInfo{
-		TyLogNewTicket:   {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogNewTicket"},
-		TyLogCloseTicket: {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogCloseTicket"},
-		TyLogMinerTicket: {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogMinerTicket"},
-		TyLogTicketBind:  {Ty: reflect.TypeOf(ReceiptTicketBind{}), Name: "LogTicketBind"},
+		TyLogNewTicket:        {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogNewTicket"},
+		TyLogCloseTicket:      {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogCloseTicket"},
+		TyLogMinerTicket:      {Ty: reflect.TypeOf(ReceiptTicket{}), Name: "LogMinerTicket"},
+		TyLogTicketBind:       {Ty: reflect.TypeOf(ReceiptTicketBind{}), Name: "LogTicketBind"},
+		TyLogTicketPool:       {Ty: reflect.TypeOf(ReceiptTicketPool{}), Name: "LogTicketPool"},
+		TyLogTicketPoolMember: {Ty: reflect.TypeOf(ReceiptTicketPoolMember{}), Name: "LogTicketPoolMember"},
 	}
 }
 
@@ -137,11 +156,14 @@ func (ticket *TicketType) GetName() string {
 // GetTypeMap get type map
 func (ticket *TicketType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Genesis": TicketActionGenesis,
-		"Topen":   TicketActionOpen,
-		"Tbind":   TicketActionBind,
-		"Tclose":  TicketActionClose,
-		"Miner":   TicketActionMiner,
+		"Genesis":   TicketActionGenesis,
+		"Topen":     TicketActionOpen,
+		"Tbind":     TicketActionBind,
+		"Tclose":    TicketActionClose,
+		"Miner":     TicketActionMiner,
+		"Pcreate":   TicketActionPoolCreate,
+		"Pdeposit":  TicketActionPoolDeposit,
+		"Pwithdraw": TicketActionPoolWithdraw,
 	}
 }
 
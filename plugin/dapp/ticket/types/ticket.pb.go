@@ -144,6 +144,9 @@ type TicketAction struct {
 	//	*TicketAction_Genesis
 	//	*TicketAction_Tclose
 	//	*TicketAction_Miner
+	//	*TicketAction_Pcreate
+	//	*TicketAction_Pdeposit
+	//	*TicketAction_Pwithdraw
 	Value                isTicketAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                `protobuf:"varint,10,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -200,6 +203,18 @@ type TicketAction_Miner struct {
 	Miner *TicketMiner `protobuf:"bytes,4,opt,name=miner,proto3,oneof"`
 }
 
+type TicketAction_Pcreate struct {
+	Pcreate *TicketPoolCreate `protobuf:"bytes,6,opt,name=pcreate,proto3,oneof"`
+}
+
+type TicketAction_Pdeposit struct {
+	Pdeposit *TicketPoolDeposit `protobuf:"bytes,7,opt,name=pdeposit,proto3,oneof"`
+}
+
+type TicketAction_Pwithdraw struct {
+	Pwithdraw *TicketPoolWithdraw `protobuf:"bytes,8,opt,name=pwithdraw,proto3,oneof"`
+}
+
 func (*TicketAction_Tbind) isTicketAction_Value() {}
 
 func (*TicketAction_Topen) isTicketAction_Value() {}
@@ -210,6 +225,12 @@ func (*TicketAction_Tclose) isTicketAction_Value() {}
 
 func (*TicketAction_Miner) isTicketAction_Value() {}
 
+func (*TicketAction_Pcreate) isTicketAction_Value() {}
+
+func (*TicketAction_Pdeposit) isTicketAction_Value() {}
+
+func (*TicketAction_Pwithdraw) isTicketAction_Value() {}
+
 func (m *TicketAction) GetValue() isTicketAction_Value {
 	if m != nil {
 		return m.Value
@@ -252,6 +273,27 @@ func (m *TicketAction) GetMiner() *TicketMiner {
 	return nil
 }
 
+func (m *TicketAction) GetPcreate() *TicketPoolCreate {
+	if x, ok := m.GetValue().(*TicketAction_Pcreate); ok {
+		return x.Pcreate
+	}
+	return nil
+}
+
+func (m *TicketAction) GetPdeposit() *TicketPoolDeposit {
+	if x, ok := m.GetValue().(*TicketAction_Pdeposit); ok {
+		return x.Pdeposit
+	}
+	return nil
+}
+
+func (m *TicketAction) GetPwithdraw() *TicketPoolWithdraw {
+	if x, ok := m.GetValue().(*TicketAction_Pwithdraw); ok {
+		return x.Pwithdraw
+	}
+	return nil
+}
+
 func (m *TicketAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -267,6 +309,9 @@ func (*TicketAction) XXX_OneofWrappers() []interface{} {
 		(*TicketAction_Genesis)(nil),
 		(*TicketAction_Tclose)(nil),
 		(*TicketAction_Miner)(nil),
+		(*TicketAction_Pcreate)(nil),
+		(*TicketAction_Pdeposit)(nil),
+		(*TicketAction_Pwithdraw)(nil),
 	}
 }
 
@@ -695,6 +740,628 @@ func (m *TicketClose) GetMinerAddress() string {
 	return ""
 }
 
+// 矿池，成员按份额分享矿池ticket的挖矿收益
+type TicketPool struct {
+	//矿池地址，作为矿池ticket的returnAddress，由创建交易hash生成，没有私钥
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	//运营者，可以用矿池的币购买ticket
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	//矿池ticket的挖矿地址
+	MinerAddress string `protobuf:"bytes,3,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	//运营费率，万分之几，以份额的形式分给运营者
+	FeeRate int32 `protobuf:"varint,4,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	//成员份额总数
+	TotalShares int64 `protobuf:"varint,5,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
+	//份额对应的权益总额，包括冻结在ticket中的本金和收益
+	TotalValue int64 `protobuf:"varint,6,opt,name=totalValue,proto3" json:"totalValue,omitempty"`
+	//已赎回份额但尚未领取的币，不能用于购买ticket
+	PendingWithdraw int64 `protobuf:"varint,7,opt,name=pendingWithdraw,proto3" json:"pendingWithdraw,omitempty"`
+	//累计挖矿收益
+	TotalReward int64 `protobuf:"varint,8,opt,name=totalReward,proto3" json:"totalReward,omitempty"`
+	//累计运营费
+	TotalFee             int64    `protobuf:"varint,9,opt,name=totalFee,proto3" json:"totalFee,omitempty"`
+	CreateTime           int64    `protobuf:"varint,10,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPool) Reset()         { *m = TicketPool{} }
+func (m *TicketPool) String() string { return proto.CompactTextString(m) }
+func (*TicketPool) ProtoMessage()    {}
+func (*TicketPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{9}
+}
+
+func (m *TicketPool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPool.Unmarshal(m, b)
+}
+func (m *TicketPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPool.Marshal(b, m, deterministic)
+}
+func (m *TicketPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPool.Merge(m, src)
+}
+func (m *TicketPool) XXX_Size() int {
+	return xxx_messageInfo_TicketPool.Size(m)
+}
+func (m *TicketPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPool proto.InternalMessageInfo
+
+func (m *TicketPool) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TicketPool) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *TicketPool) GetMinerAddress() string {
+	if m != nil {
+		return m.MinerAddress
+	}
+	return ""
+}
+
+func (m *TicketPool) GetFeeRate() int32 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *TicketPool) GetTotalShares() int64 {
+	if m != nil {
+		return m.TotalShares
+	}
+	return 0
+}
+
+func (m *TicketPool) GetTotalValue() int64 {
+	if m != nil {
+		return m.TotalValue
+	}
+	return 0
+}
+
+func (m *TicketPool) GetPendingWithdraw() int64 {
+	if m != nil {
+		return m.PendingWithdraw
+	}
+	return 0
+}
+
+func (m *TicketPool) GetTotalReward() int64 {
+	if m != nil {
+		return m.TotalReward
+	}
+	return 0
+}
+
+func (m *TicketPool) GetTotalFee() int64 {
+	if m != nil {
+		return m.TotalFee
+	}
+	return 0
+}
+
+func (m *TicketPool) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+type TicketPoolMember struct {
+	Pool   string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Addr   string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Shares int64  `protobuf:"varint,3,opt,name=shares,proto3" json:"shares,omitempty"`
+	//已赎回尚未领取的币
+	Pending int64 `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
+	//累计存入
+	Deposited int64 `protobuf:"varint,5,opt,name=deposited,proto3" json:"deposited,omitempty"`
+	//累计领取
+	Withdrawn int64 `protobuf:"varint,6,opt,name=withdrawn,proto3" json:"withdrawn,omitempty"`
+	//份额可以赎回的时间，每次存入后至少持有ticket的提款时间
+	UnlockTime           int64    `protobuf:"varint,7,opt,name=unlockTime,proto3" json:"unlockTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolMember) Reset()         { *m = TicketPoolMember{} }
+func (m *TicketPoolMember) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolMember) ProtoMessage()    {}
+func (*TicketPoolMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{10}
+}
+
+func (m *TicketPoolMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolMember.Unmarshal(m, b)
+}
+func (m *TicketPoolMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolMember.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolMember.Merge(m, src)
+}
+func (m *TicketPoolMember) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolMember.Size(m)
+}
+func (m *TicketPoolMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolMember proto.InternalMessageInfo
+
+func (m *TicketPoolMember) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *TicketPoolMember) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *TicketPoolMember) GetShares() int64 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+func (m *TicketPoolMember) GetPending() int64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *TicketPoolMember) GetDeposited() int64 {
+	if m != nil {
+		return m.Deposited
+	}
+	return 0
+}
+
+func (m *TicketPoolMember) GetWithdrawn() int64 {
+	if m != nil {
+		return m.Withdrawn
+	}
+	return 0
+}
+
+func (m *TicketPoolMember) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
+type TicketPoolCreate struct {
+	MinerAddress         string   `protobuf:"bytes,1,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	FeeRate              int32    `protobuf:"varint,2,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolCreate) Reset()         { *m = TicketPoolCreate{} }
+func (m *TicketPoolCreate) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolCreate) ProtoMessage()    {}
+func (*TicketPoolCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{11}
+}
+
+func (m *TicketPoolCreate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolCreate.Unmarshal(m, b)
+}
+func (m *TicketPoolCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolCreate.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolCreate.Merge(m, src)
+}
+func (m *TicketPoolCreate) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolCreate.Size(m)
+}
+func (m *TicketPoolCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolCreate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolCreate proto.InternalMessageInfo
+
+func (m *TicketPoolCreate) GetMinerAddress() string {
+	if m != nil {
+		return m.MinerAddress
+	}
+	return ""
+}
+
+func (m *TicketPoolCreate) GetFeeRate() int32 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+// 存入ticket合约中的币
+type TicketPoolDeposit struct {
+	Pool                 string   `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolDeposit) Reset()         { *m = TicketPoolDeposit{} }
+func (m *TicketPoolDeposit) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolDeposit) ProtoMessage()    {}
+func (*TicketPoolDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{12}
+}
+
+func (m *TicketPoolDeposit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolDeposit.Unmarshal(m, b)
+}
+func (m *TicketPoolDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolDeposit.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolDeposit.Merge(m, src)
+}
+func (m *TicketPoolDeposit) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolDeposit.Size(m)
+}
+func (m *TicketPoolDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolDeposit proto.InternalMessageInfo
+
+func (m *TicketPoolDeposit) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *TicketPoolDeposit) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// 赎回份额并领取，矿池可用余额不足的部分在ticket关闭后再次领取，shares为0时只领取
+type TicketPoolWithdraw struct {
+	Pool                 string   `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Shares               int64    `protobuf:"varint,2,opt,name=shares,proto3" json:"shares,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TicketPoolWithdraw) Reset()         { *m = TicketPoolWithdraw{} }
+func (m *TicketPoolWithdraw) String() string { return proto.CompactTextString(m) }
+func (*TicketPoolWithdraw) ProtoMessage()    {}
+func (*TicketPoolWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{13}
+}
+
+func (m *TicketPoolWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TicketPoolWithdraw.Unmarshal(m, b)
+}
+func (m *TicketPoolWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TicketPoolWithdraw.Marshal(b, m, deterministic)
+}
+func (m *TicketPoolWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TicketPoolWithdraw.Merge(m, src)
+}
+func (m *TicketPoolWithdraw) XXX_Size() int {
+	return xxx_messageInfo_TicketPoolWithdraw.Size(m)
+}
+func (m *TicketPoolWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_TicketPoolWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TicketPoolWithdraw proto.InternalMessageInfo
+
+func (m *TicketPoolWithdraw) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *TicketPoolWithdraw) GetShares() int64 {
+	if m != nil {
+		return m.Shares
+	}
+	return 0
+}
+
+type ReceiptTicketPool struct {
+	Prev                 *TicketPool `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TicketPool `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReceiptTicketPool) Reset()         { *m = ReceiptTicketPool{} }
+func (m *ReceiptTicketPool) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTicketPool) ProtoMessage()    {}
+func (*ReceiptTicketPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{14}
+}
+
+func (m *ReceiptTicketPool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTicketPool.Unmarshal(m, b)
+}
+func (m *ReceiptTicketPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTicketPool.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTicketPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTicketPool.Merge(m, src)
+}
+func (m *ReceiptTicketPool) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTicketPool.Size(m)
+}
+func (m *ReceiptTicketPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTicketPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTicketPool proto.InternalMessageInfo
+
+func (m *ReceiptTicketPool) GetPrev() *TicketPool {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTicketPool) GetCurrent() *TicketPool {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptTicketPoolMember struct {
+	Prev                 *TicketPoolMember `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TicketPoolMember `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptTicketPoolMember) Reset()         { *m = ReceiptTicketPoolMember{} }
+func (m *ReceiptTicketPoolMember) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTicketPoolMember) ProtoMessage()    {}
+func (*ReceiptTicketPoolMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{15}
+}
+
+func (m *ReceiptTicketPoolMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTicketPoolMember.Unmarshal(m, b)
+}
+func (m *ReceiptTicketPoolMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTicketPoolMember.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTicketPoolMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTicketPoolMember.Merge(m, src)
+}
+func (m *ReceiptTicketPoolMember) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTicketPoolMember.Size(m)
+}
+func (m *ReceiptTicketPoolMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTicketPoolMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTicketPoolMember proto.InternalMessageInfo
+
+func (m *ReceiptTicketPoolMember) GetPrev() *TicketPoolMember {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTicketPoolMember) GetCurrent() *TicketPoolMember {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReqTicketPoolMember struct {
+	Pool                 string   `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTicketPoolMember) Reset()         { *m = ReqTicketPoolMember{} }
+func (m *ReqTicketPoolMember) String() string { return proto.CompactTextString(m) }
+func (*ReqTicketPoolMember) ProtoMessage()    {}
+func (*ReqTicketPoolMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{16}
+}
+
+func (m *ReqTicketPoolMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTicketPoolMember.Unmarshal(m, b)
+}
+func (m *ReqTicketPoolMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTicketPoolMember.Marshal(b, m, deterministic)
+}
+func (m *ReqTicketPoolMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTicketPoolMember.Merge(m, src)
+}
+func (m *ReqTicketPoolMember) XXX_Size() int {
+	return xxx_messageInfo_ReqTicketPoolMember.Size(m)
+}
+func (m *ReqTicketPoolMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTicketPoolMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTicketPoolMember proto.InternalMessageInfo
+
+func (m *ReqTicketPoolMember) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *ReqTicketPoolMember) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReplyTicketPoolMember struct {
+	Member *TicketPoolMember `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	//份额按当前权益折算的币
+	Value                int64    `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyTicketPoolMember) Reset()         { *m = ReplyTicketPoolMember{} }
+func (m *ReplyTicketPoolMember) String() string { return proto.CompactTextString(m) }
+func (*ReplyTicketPoolMember) ProtoMessage()    {}
+func (*ReplyTicketPoolMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{17}
+}
+
+func (m *ReplyTicketPoolMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTicketPoolMember.Unmarshal(m, b)
+}
+func (m *ReplyTicketPoolMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTicketPoolMember.Marshal(b, m, deterministic)
+}
+func (m *ReplyTicketPoolMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTicketPoolMember.Merge(m, src)
+}
+func (m *ReplyTicketPoolMember) XXX_Size() int {
+	return xxx_messageInfo_ReplyTicketPoolMember.Size(m)
+}
+func (m *ReplyTicketPoolMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTicketPoolMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTicketPoolMember proto.InternalMessageInfo
+
+func (m *ReplyTicketPoolMember) GetMember() *TicketPoolMember {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+func (m *ReplyTicketPoolMember) GetValue() int64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+type ReplyTicketPools struct {
+	Pools                []*TicketPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyTicketPools) Reset()         { *m = ReplyTicketPools{} }
+func (m *ReplyTicketPools) String() string { return proto.CompactTextString(m) }
+func (*ReplyTicketPools) ProtoMessage()    {}
+func (*ReplyTicketPools) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{18}
+}
+
+func (m *ReplyTicketPools) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTicketPools.Unmarshal(m, b)
+}
+func (m *ReplyTicketPools) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTicketPools.Marshal(b, m, deterministic)
+}
+func (m *ReplyTicketPools) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTicketPools.Merge(m, src)
+}
+func (m *ReplyTicketPools) XXX_Size() int {
+	return xxx_messageInfo_ReplyTicketPools.Size(m)
+}
+func (m *ReplyTicketPools) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTicketPools.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTicketPools proto.InternalMessageInfo
+
+func (m *ReplyTicketPools) GetPools() []*TicketPool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type ReplyTicketPoolMembers struct {
+	Members              []*ReplyTicketPoolMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ReplyTicketPoolMembers) Reset()         { *m = ReplyTicketPoolMembers{} }
+func (m *ReplyTicketPoolMembers) String() string { return proto.CompactTextString(m) }
+func (*ReplyTicketPoolMembers) ProtoMessage()    {}
+func (*ReplyTicketPoolMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a6c21780e82d22, []int{19}
+}
+
+func (m *ReplyTicketPoolMembers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTicketPoolMembers.Unmarshal(m, b)
+}
+func (m *ReplyTicketPoolMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTicketPoolMembers.Marshal(b, m, deterministic)
+}
+func (m *ReplyTicketPoolMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTicketPoolMembers.Merge(m, src)
+}
+func (m *ReplyTicketPoolMembers) XXX_Size() int {
+	return xxx_messageInfo_ReplyTicketPoolMembers.Size(m)
+}
+func (m *ReplyTicketPoolMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTicketPoolMembers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTicketPoolMembers proto.InternalMessageInfo
+
+func (m *ReplyTicketPoolMembers) GetMembers() []*ReplyTicketPoolMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
 type TicketList struct {
 	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Status               int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
@@ -707,7 +1374,7 @@ func (m *TicketList) Reset()         { *m = TicketList{} }
 func (m *TicketList) String() string { return proto.CompactTextString(m) }
 func (*TicketList) ProtoMessage()    {}
 func (*TicketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{9}
+	return fileDescriptor_98a6c21780e82d22, []int{20}
 }
 
 func (m *TicketList) XXX_Unmarshal(b []byte) error {
@@ -753,7 +1420,7 @@ func (m *TicketInfos) Reset()         { *m = TicketInfos{} }
 func (m *TicketInfos) String() string { return proto.CompactTextString(m) }
 func (*TicketInfos) ProtoMessage()    {}
 func (*TicketInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{10}
+	return fileDescriptor_98a6c21780e82d22, []int{21}
 }
 
 func (m *TicketInfos) XXX_Unmarshal(b []byte) error {
@@ -792,7 +1459,7 @@ func (m *ReplyTicketList) Reset()         { *m = ReplyTicketList{} }
 func (m *ReplyTicketList) String() string { return proto.CompactTextString(m) }
 func (*ReplyTicketList) ProtoMessage()    {}
 func (*ReplyTicketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{11}
+	return fileDescriptor_98a6c21780e82d22, []int{22}
 }
 
 func (m *ReplyTicketList) XXX_Unmarshal(b []byte) error {
@@ -832,7 +1499,7 @@ func (m *ReplyWalletTickets) Reset()         { *m = ReplyWalletTickets{} }
 func (m *ReplyWalletTickets) String() string { return proto.CompactTextString(m) }
 func (*ReplyWalletTickets) ProtoMessage()    {}
 func (*ReplyWalletTickets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{12}
+	return fileDescriptor_98a6c21780e82d22, []int{23}
 }
 
 func (m *ReplyWalletTickets) XXX_Unmarshal(b []byte) error {
@@ -881,7 +1548,7 @@ func (m *ReceiptTicket) Reset()         { *m = ReceiptTicket{} }
 func (m *ReceiptTicket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTicket) ProtoMessage()    {}
 func (*ReceiptTicket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{13}
+	return fileDescriptor_98a6c21780e82d22, []int{24}
 }
 
 func (m *ReceiptTicket) XXX_Unmarshal(b []byte) error {
@@ -943,7 +1610,7 @@ func (m *ReceiptTicketBind) Reset()         { *m = ReceiptTicketBind{} }
 func (m *ReceiptTicketBind) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTicketBind) ProtoMessage()    {}
 func (*ReceiptTicketBind) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{14}
+	return fileDescriptor_98a6c21780e82d22, []int{25}
 }
 
 func (m *ReceiptTicketBind) XXX_Unmarshal(b []byte) error {
@@ -999,7 +1666,7 @@ func (m *ReqBindMiner) Reset()         { *m = ReqBindMiner{} }
 func (m *ReqBindMiner) String() string { return proto.CompactTextString(m) }
 func (*ReqBindMiner) ProtoMessage()    {}
 func (*ReqBindMiner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{15}
+	return fileDescriptor_98a6c21780e82d22, []int{26}
 }
 
 func (m *ReqBindMiner) XXX_Unmarshal(b []byte) error {
@@ -1059,7 +1726,7 @@ func (m *ReplyBindMiner) Reset()         { *m = ReplyBindMiner{} }
 func (m *ReplyBindMiner) String() string { return proto.CompactTextString(m) }
 func (*ReplyBindMiner) ProtoMessage()    {}
 func (*ReplyBindMiner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a6c21780e82d22, []int{16}
+	return fileDescriptor_98a6c21780e82d22, []int{27}
 }
 
 func (m *ReplyBindMiner) XXX_Unmarshal(b []byte) error {
@@ -1097,6 +1764,17 @@ func init() {
 	proto.RegisterType((*TicketOpen)(nil), "types.TicketOpen")
 	proto.RegisterType((*TicketGenesis)(nil), "types.TicketGenesis")
 	proto.RegisterType((*TicketClose)(nil), "types.TicketClose")
+	proto.RegisterType((*TicketPool)(nil), "types.TicketPool")
+	proto.RegisterType((*TicketPoolMember)(nil), "types.TicketPoolMember")
+	proto.RegisterType((*TicketPoolCreate)(nil), "types.TicketPoolCreate")
+	proto.RegisterType((*TicketPoolDeposit)(nil), "types.TicketPoolDeposit")
+	proto.RegisterType((*TicketPoolWithdraw)(nil), "types.TicketPoolWithdraw")
+	proto.RegisterType((*ReceiptTicketPool)(nil), "types.ReceiptTicketPool")
+	proto.RegisterType((*ReceiptTicketPoolMember)(nil), "types.ReceiptTicketPoolMember")
+	proto.RegisterType((*ReqTicketPoolMember)(nil), "types.ReqTicketPoolMember")
+	proto.RegisterType((*ReplyTicketPoolMember)(nil), "types.ReplyTicketPoolMember")
+	proto.RegisterType((*ReplyTicketPools)(nil), "types.ReplyTicketPools")
+	proto.RegisterType((*ReplyTicketPoolMembers)(nil), "types.ReplyTicketPoolMembers")
 	proto.RegisterType((*TicketList)(nil), "types.TicketList")
 	proto.RegisterType((*TicketInfos)(nil), "types.TicketInfos")
 	proto.RegisterType((*ReplyTicketList)(nil), "types.ReplyTicketList")
@@ -1112,62 +1790,88 @@ func init() {
 }
 
 var fileDescriptor_98a6c21780e82d22 = []byte{
-	// 877 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0xeb, 0x44,
-	0x10, 0x8e, 0xed, 0x3a, 0x49, 0xa7, 0x4e, 0xcb, 0x59, 0x0a, 0xb2, 0x22, 0x74, 0x14, 0xad, 0x10,
-	0x84, 0x1f, 0x15, 0x28, 0x08, 0x01, 0x37, 0xa8, 0xad, 0xc4, 0x49, 0x25, 0xc2, 0x41, 0xdb, 0xa3,
-	0x83, 0xb8, 0x74, 0xed, 0x4d, 0xce, 0xaa, 0xce, 0xda, 0xac, 0x37, 0xe9, 0xc9, 0x0b, 0x20, 0x71,
-	0xc1, 0x3d, 0x4f, 0xc0, 0x1d, 0xcf, 0xc0, 0xab, 0xa1, 0x1d, 0xaf, 0xff, 0x92, 0x5e, 0x54, 0x02,
-	0xee, 0xf2, 0xcd, 0x7c, 0xe3, 0x99, 0xf9, 0x76, 0x66, 0x37, 0x10, 0x68, 0x11, 0xdf, 0x71, 0x7d,
-	0x96, 0xab, 0x4c, 0x67, 0xc4, 0xd7, 0xdb, 0x9c, 0x17, 0xe3, 0x20, 0xce, 0x56, 0xab, 0x4c, 0x96,
-	0x46, 0xfa, 0x87, 0x0b, 0xfd, 0x17, 0xc8, 0x22, 0x63, 0x18, 0x96, 0xfc, 0xeb, 0x24, 0x74, 0x26,
-	0xce, 0xf4, 0x90, 0xd5, 0x98, 0xbc, 0x0d, 0xfd, 0x42, 0x47, 0x7a, 0x5d, 0x84, 0xee, 0xc4, 0x99,
-	0xfa, 0xcc, 0x22, 0xf2, 0x0e, 0x1c, 0x8a, 0xe2, 0x19, 0x97, 0xbc, 0x10, 0x45, 0xe8, 0x4d, 0x9c,
-	0xe9, 0x90, 0x35, 0x06, 0xf2, 0x14, 0x20, 0x56, 0x3c, 0xd2, 0xfc, 0x85, 0x58, 0xf1, 0xf0, 0x60,
-	0xe2, 0x4c, 0x3d, 0xd6, 0xb2, 0x98, 0xe8, 0x95, 0x90, 0x5c, 0xa1, 0xdb, 0x47, 0x77, 0x63, 0x30,
-	0xd1, 0x08, 0x5e, 0x46, 0xe9, 0x9a, 0x87, 0xc3, 0x32, 0xba, 0xb1, 0x10, 0x0a, 0x01, 0xa2, 0x8b,
-	0x24, 0x51, 0xbc, 0x28, 0xc2, 0x3e, 0xd6, 0xdc, 0xb1, 0x91, 0x77, 0x61, 0xa4, 0xb8, 0x5e, 0x2b,
-	0x59, 0x91, 0x06, 0x48, 0xea, 0x1a, 0xc9, 0x29, 0xf8, 0xb9, 0x12, 0x31, 0x0f, 0x0f, 0x31, 0x49,
-	0x09, 0xe8, 0x6f, 0x2e, 0x04, 0xa5, 0x34, 0x17, 0xb1, 0x16, 0x99, 0x24, 0x1f, 0x80, 0xaf, 0x6f,
-	0x85, 0x4c, 0xb0, 0xd4, 0xa3, 0xf3, 0x27, 0x67, 0x28, 0xe8, 0x59, 0xc9, 0xb9, 0x14, 0x32, 0x99,
-	0xf5, 0x58, 0xc9, 0x40, 0x6a, 0x96, 0x73, 0x89, 0x42, 0xee, 0x52, 0x9f, 0xe7, 0x5c, 0x22, 0xd5,
-	0x30, 0xc8, 0xa7, 0x30, 0x58, 0x5a, 0x01, 0x5d, 0x24, 0x9f, 0x76, 0xc8, 0x56, 0xcb, 0x59, 0x8f,
-	0x55, 0x34, 0xf2, 0x31, 0xf4, 0x75, 0x9c, 0x66, 0x05, 0x47, 0xc5, 0x8f, 0xce, 0x49, 0x27, 0xe0,
-	0xca, 0x78, 0x66, 0x3d, 0x66, 0x39, 0xe4, 0x43, 0xf0, 0x51, 0x12, 0xd4, 0x7f, 0x97, 0x3c, 0x37,
-	0x1e, 0x53, 0x0b, 0x52, 0xc8, 0x31, 0xb8, 0x7a, 0x1b, 0x02, 0x1e, 0xb1, 0xab, 0xb7, 0x97, 0x03,
-	0xf0, 0x37, 0x46, 0x6b, 0xfa, 0xb7, 0x03, 0x47, 0xad, 0x08, 0x42, 0xe0, 0xe0, 0x56, 0xe8, 0x02,
-	0xdb, 0x1b, 0x31, 0xfc, 0x6d, 0x66, 0x44, 0xf1, 0xfb, 0x48, 0x25, 0xd8, 0x87, 0xc7, 0x2c, 0xea,
-	0xcc, 0x95, 0xb7, 0x3f, 0x57, 0xab, 0x2c, 0x11, 0x8b, 0x2d, 0x56, 0x17, 0x30, 0x8b, 0x4c, 0x4c,
-	0xae, 0xc4, 0x66, 0x16, 0x15, 0xaf, 0x50, 0xed, 0x80, 0xd5, 0x98, 0x84, 0x30, 0xd8, 0xa8, 0x05,
-	0xba, 0xfa, 0xe8, 0xaa, 0xa0, 0x89, 0xda, 0xa8, 0xc5, 0x8f, 0x2a, 0xcb, 0x16, 0x78, 0xd0, 0x01,
-	0xab, 0x31, 0xcd, 0xe1, 0xb8, 0xd5, 0xc0, 0xf3, 0x34, 0xf9, 0xbf, 0x7b, 0xa0, 0x5f, 0xc3, 0x21,
-	0xe6, 0xfa, 0x2e, 0x8d, 0x96, 0x26, 0xd9, 0x22, 0x8d, 0x96, 0x98, 0xcc, 0x67, 0xf8, 0xdb, 0x34,
-	0xa2, 0x78, 0xc1, 0xd5, 0x86, 0xdb, 0x6c, 0x15, 0xa4, 0x2f, 0x01, 0x9a, 0xa9, 0xda, 0x1b, 0x74,
-	0xe7, 0x31, 0x83, 0xee, 0x3e, 0x30, 0xe8, 0xf4, 0x4f, 0xa7, 0xfa, 0xb0, 0x99, 0xc1, 0x47, 0x7d,
-	0xf8, 0x14, 0xfc, 0x38, 0x5b, 0x4b, 0x6d, 0x17, 0xbf, 0x04, 0xfb, 0xe9, 0xbc, 0x87, 0xf6, 0x6a,
-	0x0c, 0x43, 0x15, 0xc9, 0xe4, 0x86, 0xf3, 0xc4, 0x6e, 0x7f, 0x8d, 0xcd, 0xee, 0xe7, 0xeb, 0x5b,
-	0x73, 0x6c, 0xbc, 0x08, 0xfd, 0x89, 0x37, 0x0d, 0x58, 0x63, 0xa0, 0x19, 0x8c, 0x3a, 0xe3, 0xff,
-	0xdf, 0x69, 0xd0, 0x34, 0xe4, 0xb5, 0x1a, 0xa2, 0xf3, 0x6a, 0xbe, 0x71, 0x7d, 0x76, 0xee, 0x42,
-	0xaf, 0x73, 0xde, 0xbb, 0xa5, 0xb8, 0xfb, 0xa5, 0xd0, 0xaf, 0x2a, 0x9d, 0xbf, 0x17, 0x85, 0x36,
-	0x87, 0x1f, 0x25, 0x89, 0xb2, 0x45, 0xe3, 0xef, 0xd6, 0x8d, 0xea, 0xb5, 0x6f, 0x54, 0xfa, 0x51,
-	0x55, 0xc8, 0xb5, 0x5c, 0x64, 0x78, 0xc1, 0x56, 0x89, 0x0b, 0x5b, 0x49, 0x63, 0xa0, 0xdf, 0xc0,
-	0x09, 0xe3, 0x79, 0xba, 0x6d, 0xe5, 0x7a, 0x1f, 0x06, 0xa5, 0xbf, 0xa4, 0x1f, 0x9d, 0x8f, 0x3a,
-	0x0b, 0xcf, 0x2a, 0x2f, 0xfd, 0x19, 0x08, 0xc6, 0xfe, 0x14, 0xa5, 0x29, 0xd7, 0xa5, 0xb7, 0x78,
-	0x74, 0x78, 0xb5, 0xa1, 0x77, 0x7c, 0x6b, 0x14, 0xf0, 0xaa, 0x0d, 0x35, 0x98, 0xde, 0xc3, 0x88,
-	0xf1, 0x98, 0x8b, 0x5c, 0xff, 0x8b, 0xa7, 0xe5, 0x29, 0x40, 0xae, 0xf8, 0xe6, 0xa6, 0x2d, 0x52,
-	0xcb, 0x52, 0x8b, 0x7a, 0xd0, 0x88, 0x4a, 0x7f, 0x77, 0xe0, 0x49, 0x27, 0x33, 0xee, 0xcf, 0x14,
-	0x4e, 0xb2, 0x34, 0x99, 0xef, 0x8f, 0xcf, 0xae, 0xd9, 0x30, 0x25, 0xbf, 0x9f, 0xef, 0x9f, 0xee,
-	0xae, 0xf9, 0x71, 0x0b, 0x40, 0x7f, 0x75, 0x20, 0x60, 0xfc, 0x17, 0x53, 0x45, 0x79, 0x6f, 0x8e,
-	0x61, 0x68, 0xde, 0x87, 0x8b, 0x66, 0x1a, 0x6a, 0x6c, 0x1a, 0xce, 0x94, 0x58, 0x0a, 0x8c, 0xb6,
-	0x79, 0x5b, 0x16, 0x23, 0x54, 0xb4, 0xaa, 0x27, 0xd7, 0x63, 0x16, 0x99, 0x79, 0x8c, 0x5f, 0xf1,
-	0xf8, 0xee, 0x32, 0x4a, 0x23, 0x19, 0x97, 0xef, 0xec, 0x90, 0x75, 0x6c, 0xf4, 0x3d, 0x38, 0xc6,
-	0xc3, 0x6e, 0x2a, 0x39, 0x05, 0x5f, 0xbf, 0x9e, 0xf1, 0xd7, 0xb6, 0x8c, 0x12, 0x9c, 0xff, 0xe5,
-	0x40, 0xbf, 0x3c, 0x19, 0xf2, 0x2d, 0x9c, 0x5c, 0xe1, 0x53, 0xdd, 0xc4, 0xbc, 0x69, 0x67, 0xa1,
-	0xdd, 0xd2, 0xf8, 0xad, 0xda, 0xd8, 0xfe, 0x3e, 0xed, 0x91, 0x4f, 0xe0, 0xf8, 0x59, 0x35, 0x58,
-	0x57, 0x58, 0xe9, 0xa8, 0x89, 0xff, 0x41, 0xa4, 0xe3, 0xc0, 0xc2, 0x6b, 0xa9, 0xbf, 0xfc, 0x82,
-	0xf6, 0xc8, 0x67, 0x30, 0xba, 0xe1, 0xfa, 0x62, 0xad, 0xb3, 0xb9, 0x90, 0x42, 0x2e, 0xc9, 0x1b,
-	0x96, 0x50, 0x5f, 0xa3, 0x75, 0x08, 0x26, 0xa3, 0xbd, 0xdb, 0x3e, 0xfe, 0x8b, 0xf9, 0xfc, 0x9f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x56, 0xb4, 0x54, 0xea, 0x08, 0x00, 0x00,
+	// 1291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xf7, 0xf9, 0xe2, 0x3f, 0x19, 0xdb, 0x49, 0xba, 0x4d, 0xdb, 0x23, 0xaa, 0xaa, 0x68, 0x05,
+	0xd4, 0x50, 0xd4, 0xd2, 0x14, 0x55, 0x14, 0x84, 0x4a, 0x5a, 0xd4, 0x3a, 0x12, 0xa1, 0xd1, 0xa6,
+	0x6a, 0xc5, 0x0b, 0xd2, 0xe5, 0x6e, 0x9d, 0x9c, 0x72, 0xbe, 0xbd, 0xde, 0xad, 0x9d, 0xfa, 0x0b,
+	0xf0, 0xc6, 0x3b, 0x9f, 0x80, 0x37, 0x1e, 0xf8, 0x04, 0xbc, 0x22, 0x3e, 0x15, 0xda, 0xd9, 0xbd,
+	0xff, 0xae, 0x88, 0x2a, 0x78, 0xf3, 0xcc, 0xfe, 0xe6, 0x66, 0xe6, 0xb7, 0xf3, 0x67, 0x0d, 0x43,
+	0x19, 0x78, 0xe7, 0x5c, 0xde, 0x8d, 0x13, 0x21, 0x05, 0xe9, 0xc8, 0x65, 0xcc, 0xd3, 0x9d, 0xa1,
+	0x27, 0x66, 0x33, 0x11, 0x69, 0x25, 0xfd, 0xb5, 0x0d, 0xdd, 0x97, 0x88, 0x22, 0x3b, 0xd0, 0xd7,
+	0xf8, 0x03, 0xdf, 0xb1, 0x76, 0xad, 0xf1, 0x3a, 0xcb, 0x65, 0x72, 0x1d, 0xba, 0xa9, 0x74, 0xe5,
+	0x3c, 0x75, 0xda, 0xbb, 0xd6, 0xb8, 0xc3, 0x8c, 0x44, 0x6e, 0xc2, 0x7a, 0x90, 0x3e, 0xe7, 0x11,
+	0x4f, 0x83, 0xd4, 0xb1, 0x77, 0xad, 0x71, 0x9f, 0x15, 0x0a, 0x72, 0x0b, 0xc0, 0x4b, 0xb8, 0x2b,
+	0xf9, 0xcb, 0x60, 0xc6, 0x9d, 0xb5, 0x5d, 0x6b, 0x6c, 0xb3, 0x92, 0x46, 0x59, 0xcf, 0x82, 0x88,
+	0x27, 0x78, 0xdc, 0xc1, 0xe3, 0x42, 0xa1, 0xac, 0x51, 0x78, 0xe5, 0x86, 0x73, 0xee, 0xf4, 0xb5,
+	0x75, 0xa1, 0x21, 0x14, 0x86, 0x28, 0xed, 0xfb, 0x7e, 0xc2, 0xd3, 0xd4, 0xe9, 0x62, 0xcc, 0x15,
+	0x1d, 0xf9, 0x10, 0x46, 0x09, 0x97, 0xf3, 0x24, 0xca, 0x40, 0x3d, 0x04, 0x55, 0x95, 0x64, 0x1b,
+	0x3a, 0x71, 0x12, 0x78, 0xdc, 0x59, 0x47, 0x27, 0x5a, 0xa0, 0x7f, 0xd8, 0x30, 0xd4, 0xd4, 0xec,
+	0x7b, 0x32, 0x10, 0x11, 0xf9, 0x04, 0x3a, 0xf2, 0x24, 0x88, 0x7c, 0x0c, 0x75, 0xb0, 0x77, 0xe5,
+	0x2e, 0x12, 0x7a, 0x57, 0x63, 0x9e, 0x04, 0x91, 0x3f, 0x69, 0x31, 0x8d, 0x40, 0xa8, 0x88, 0x79,
+	0xe4, 0x58, 0x2b, 0xa0, 0x2f, 0x62, 0x1e, 0x21, 0x54, 0x21, 0xc8, 0xe7, 0xd0, 0x3b, 0x35, 0x04,
+	0xb6, 0x11, 0xbc, 0x5d, 0x01, 0x1b, 0x2e, 0x27, 0x2d, 0x96, 0xc1, 0xc8, 0x67, 0xd0, 0x95, 0x5e,
+	0x28, 0x52, 0x8e, 0x8c, 0x0f, 0xf6, 0x48, 0xc5, 0xe0, 0xa9, 0x3a, 0x99, 0xb4, 0x98, 0xc1, 0x90,
+	0x4f, 0xa1, 0x83, 0x94, 0x38, 0x6b, 0x2b, 0xc0, 0x87, 0xea, 0x44, 0xc5, 0x82, 0x10, 0xf2, 0x00,
+	0x7a, 0xb1, 0xbe, 0x1f, 0x64, 0x73, 0xb0, 0x77, 0xa3, 0x82, 0x3e, 0x12, 0x22, 0x7c, 0x8a, 0xc7,
+	0x2a, 0x1c, 0x83, 0x24, 0x0f, 0xa1, 0x1f, 0xfb, 0x3c, 0x16, 0x69, 0x20, 0x91, 0xde, 0xc1, 0x9e,
+	0xd3, 0xb0, 0xfa, 0x4e, 0x9f, 0x4f, 0x5a, 0x2c, 0xc7, 0x92, 0x47, 0xb0, 0x1e, 0x5f, 0x04, 0xf2,
+	0xcc, 0x4f, 0xdc, 0x0b, 0xbc, 0xde, 0xc1, 0xde, 0x07, 0x0d, 0xc3, 0xd7, 0x06, 0x30, 0x69, 0xb1,
+	0x02, 0x4d, 0x36, 0xa0, 0x2d, 0x97, 0x0e, 0x60, 0x29, 0xb6, 0xe5, 0xf2, 0x49, 0x0f, 0x3a, 0x0b,
+	0x55, 0x13, 0xf4, 0x4f, 0x0b, 0x06, 0xa5, 0xcc, 0x08, 0x81, 0xb5, 0x93, 0x40, 0xa6, 0x78, 0x0d,
+	0x23, 0x86, 0xbf, 0x55, 0x2d, 0x27, 0xfc, 0xc2, 0x4d, 0x7c, 0xe4, 0xdb, 0x66, 0x46, 0xaa, 0xd4,
+	0xbf, 0xdd, 0xac, 0xff, 0x99, 0xf0, 0x83, 0xe9, 0x12, 0x59, 0x1c, 0x32, 0x23, 0x29, 0x9b, 0x38,
+	0x09, 0x16, 0x13, 0x37, 0x3d, 0xc3, 0xaa, 0x18, 0xb2, 0x5c, 0x26, 0x0e, 0xf4, 0x16, 0xc9, 0x14,
+	0x8f, 0xba, 0x78, 0x94, 0x89, 0xca, 0x6a, 0x91, 0x4c, 0x8f, 0x12, 0x21, 0xa6, 0xc8, 0xd8, 0x90,
+	0xe5, 0x32, 0x8d, 0x61, 0xa3, 0x94, 0xc0, 0x8b, 0xd0, 0xff, 0xbf, 0x73, 0xa0, 0x8f, 0x60, 0x1d,
+	0x7d, 0x3d, 0x0b, 0xdd, 0x53, 0xe5, 0x6c, 0x1a, 0xba, 0xa7, 0xe8, 0xac, 0xc3, 0xf0, 0xb7, 0x4a,
+	0x24, 0xe1, 0x29, 0x4f, 0x16, 0xdc, 0x78, 0xcb, 0x44, 0xfa, 0x0a, 0xa0, 0xa8, 0xfe, 0x46, 0x43,
+	0x5a, 0x97, 0x69, 0xc8, 0xf6, 0x8a, 0x86, 0xa4, 0xbf, 0x59, 0x00, 0x45, 0xaf, 0x5c, 0xea, 0xc3,
+	0xdb, 0xd0, 0xf1, 0xc4, 0x3c, 0x92, 0x66, 0x40, 0x69, 0xa1, 0xe9, 0xce, 0x5e, 0xd5, 0xff, 0x3b,
+	0xd0, 0x4f, 0xdc, 0xc8, 0x3f, 0xe6, 0xdc, 0x37, 0x53, 0x2a, 0x97, 0xd5, 0x8c, 0x8a, 0xe7, 0x27,
+	0xea, 0xda, 0x78, 0xea, 0x74, 0x76, 0xed, 0xf1, 0x90, 0x15, 0x0a, 0x2a, 0x60, 0x54, 0x69, 0xd3,
+	0xff, 0x8e, 0x83, 0x22, 0x21, 0xbb, 0x94, 0x10, 0x3d, 0x84, 0x41, 0xa9, 0xcd, 0x6b, 0x33, 0xdb,
+	0xae, 0xdc, 0x77, 0x3d, 0x94, 0x76, 0x33, 0x14, 0xfa, 0x77, 0x1b, 0xa0, 0x68, 0x36, 0x75, 0xd3,
+	0x6e, 0x25, 0xf0, 0x4c, 0x54, 0x8e, 0x44, 0xcc, 0x13, 0x57, 0x8a, 0xc4, 0x7c, 0x28, 0x97, 0x1b,
+	0x8e, 0xec, 0x15, 0x39, 0x3b, 0xd0, 0x9b, 0x72, 0xce, 0xd4, 0x64, 0x59, 0xc3, 0x7c, 0x32, 0x91,
+	0xec, 0xc2, 0x40, 0x0a, 0xe9, 0x86, 0xc7, 0x67, 0x6e, 0x82, 0x14, 0x2b, 0xfe, 0xcb, 0x2a, 0xb5,
+	0x08, 0x50, 0xd4, 0x8b, 0xa0, 0x8b, 0x80, 0x92, 0x86, 0x8c, 0x61, 0x33, 0xe6, 0x91, 0x1f, 0x44,
+	0xa7, 0xd9, 0xb4, 0xc0, 0xae, 0xb2, 0x59, 0x5d, 0x9d, 0xfb, 0x62, 0xba, 0x77, 0xfa, 0x25, 0x5f,
+	0xac, 0x68, 0x20, 0x25, 0x3e, 0xe3, 0xd9, 0x36, 0xc8, 0xe5, 0xda, 0x3a, 0x83, 0xfa, 0x3a, 0xa3,
+	0x7f, 0x59, 0xb0, 0x55, 0x90, 0x79, 0xc8, 0x67, 0x27, 0x7a, 0x02, 0xc5, 0x42, 0x84, 0x86, 0x4f,
+	0xfc, 0xad, 0x74, 0x8a, 0x57, 0x43, 0x24, 0xfe, 0xc6, 0x0d, 0xab, 0x19, 0xb0, 0x75, 0x47, 0x6b,
+	0x49, 0x11, 0x67, 0xb2, 0x30, 0xa5, 0x99, 0x89, 0xaa, 0x32, 0xcd, 0x28, 0xe5, 0x7e, 0xb6, 0x3d,
+	0x73, 0x85, 0x3a, 0xcd, 0xc6, 0x65, 0x64, 0x38, 0x2b, 0x14, 0x2a, 0x95, 0x79, 0x14, 0x0a, 0xef,
+	0x1c, 0x53, 0xd1, 0x6c, 0x95, 0x34, 0xf4, 0x08, 0xb6, 0xea, 0x23, 0xff, 0x52, 0xa5, 0x5d, 0xba,
+	0xe6, 0x76, 0xe5, 0x9a, 0xe9, 0x63, 0xb8, 0xd2, 0x58, 0x07, 0x2b, 0xc9, 0xb9, 0x0e, 0x5d, 0x77,
+	0x96, 0x77, 0xb2, 0xcd, 0x8c, 0x44, 0xbf, 0x05, 0xd2, 0x5c, 0x0b, 0xef, 0xfa, 0x82, 0xa1, 0xb2,
+	0x5d, 0xa6, 0x92, 0x9e, 0xc2, 0x15, 0xc6, 0x3d, 0x1e, 0xc4, 0xb2, 0x54, 0xf2, 0x1f, 0xc1, 0x5a,
+	0x9c, 0xf0, 0xc5, 0xca, 0x45, 0xad, 0x00, 0x0c, 0x8f, 0xc9, 0x1d, 0xe8, 0x79, 0xf3, 0x24, 0xe1,
+	0x26, 0xac, 0x95, 0xc8, 0x0c, 0x41, 0x97, 0x70, 0xa3, 0xe1, 0xc8, 0x94, 0xc3, 0x9d, 0x8a, 0xbb,
+	0xe6, 0x7a, 0xd5, 0x30, 0xe3, 0xf4, 0x7e, 0xdd, 0xe9, 0x3b, 0xf1, 0xb9, 0xeb, 0x6f, 0xe0, 0x2a,
+	0xe3, 0x6f, 0xde, 0xb7, 0x0a, 0xe9, 0x4f, 0x70, 0x8d, 0xf1, 0x38, 0x5c, 0x36, 0x3e, 0x70, 0x0f,
+	0xba, 0x33, 0xfc, 0xf5, 0x6f, 0x91, 0x1b, 0x18, 0xd9, 0x36, 0x2b, 0xd9, 0xdc, 0x81, 0x16, 0xe8,
+	0xd7, 0xb0, 0x55, 0xfb, 0x7e, 0x4a, 0x6e, 0x43, 0x47, 0xc5, 0x93, 0xe2, 0x00, 0x5b, 0x49, 0xac,
+	0x3e, 0xa7, 0x47, 0x70, 0x7d, 0x65, 0x70, 0x29, 0x79, 0x08, 0x3d, 0xed, 0x36, 0xfb, 0xc8, 0x4d,
+	0xf3, 0x91, 0x95, 0x78, 0x96, 0x81, 0xe9, 0x97, 0xd9, 0xf4, 0xfb, 0x3e, 0x48, 0x65, 0x4e, 0x88,
+	0x55, 0x6b, 0x4b, 0xfd, 0xf0, 0xb5, 0xcb, 0x0f, 0x5f, 0x7a, 0x27, 0x9b, 0xc3, 0x07, 0xd1, 0x54,
+	0xe0, 0x3b, 0x38, 0x9b, 0xbb, 0xa9, 0x19, 0xc4, 0x85, 0x82, 0x7e, 0x05, 0x9b, 0xa5, 0x40, 0xd0,
+	0xd7, 0x6d, 0xe8, 0xe9, 0xf3, 0x2c, 0xe2, 0x51, 0x25, 0x6d, 0x96, 0x9d, 0xd2, 0x1f, 0x81, 0xa0,
+	0xed, 0x6b, 0x37, 0x0c, 0xb9, 0xa9, 0xa7, 0xf4, 0xd2, 0xe6, 0xd9, 0x03, 0xe5, 0x9c, 0x2f, 0x55,
+	0x37, 0xd8, 0xd9, 0x03, 0x45, 0xc9, 0xf4, 0x02, 0x46, 0x95, 0x32, 0x7d, 0xaf, 0x7f, 0x00, 0xb7,
+	0x00, 0x54, 0xad, 0x1e, 0x97, 0x49, 0x2a, 0x69, 0x72, 0x52, 0xd7, 0x4a, 0x55, 0xf6, 0x8b, 0x55,
+	0xeb, 0x44, 0x7c, 0x3e, 0x8c, 0x61, 0x53, 0x84, 0xfe, 0x61, 0x73, 0xc4, 0xd4, 0xd5, 0x0a, 0x19,
+	0xf1, 0x8b, 0xc3, 0xe6, 0x72, 0xab, 0xab, 0x2f, 0xb7, 0xff, 0xe9, 0xcf, 0x16, 0x0c, 0x19, 0x7f,
+	0xa3, 0xa2, 0x40, 0x6b, 0x45, 0x84, 0x7a, 0xc6, 0xef, 0x17, 0xd5, 0x90, 0xcb, 0x2a, 0x61, 0x91,
+	0x04, 0xa7, 0x01, 0x5a, 0x1b, 0xbf, 0x25, 0x4d, 0x69, 0x7e, 0xd9, 0xe5, 0xf9, 0xa5, 0xc6, 0xa7,
+	0x77, 0xc6, 0xbd, 0xf3, 0x27, 0x6e, 0xe8, 0x46, 0x9e, 0x5e, 0x83, 0x7d, 0x56, 0xd1, 0xd1, 0x8f,
+	0x61, 0x03, 0x2f, 0xbb, 0x88, 0x64, 0x1b, 0x3a, 0xf2, 0xed, 0x84, 0xbf, 0x35, 0x61, 0x68, 0x61,
+	0xef, 0x77, 0x0b, 0xba, 0xfa, 0x66, 0xc8, 0x63, 0xd8, 0xd4, 0xf3, 0xb9, 0xb0, 0xb9, 0x9a, 0x17,
+	0x7f, 0x91, 0xd2, 0xce, 0xb5, 0x72, 0x47, 0xe4, 0x6a, 0xda, 0x22, 0xf7, 0x60, 0xe3, 0x79, 0x56,
+	0x58, 0x4f, 0x31, 0xd2, 0x51, 0x61, 0xff, 0x43, 0x10, 0xee, 0x0c, 0x8d, 0x78, 0x10, 0xc9, 0x87,
+	0x5f, 0xd0, 0x16, 0xb9, 0x0f, 0xa3, 0x63, 0x2e, 0xf7, 0xe7, 0x52, 0x1c, 0x06, 0x91, 0x5a, 0x44,
+	0x5b, 0x06, 0x90, 0xbf, 0x22, 0x77, 0x86, 0x65, 0x67, 0xb4, 0x75, 0xd2, 0xc5, 0x3f, 0x9b, 0x0f,
+	0xfe, 0x19, 0x00, 0x32, 0x44, 0xc1, 0x2a, 0x91, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
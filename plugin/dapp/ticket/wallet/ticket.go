@@ -803,6 +803,63 @@ func (policy *ticketPolicy) buyMinerAddrTicket(height int64) ([][]byte, int, err
 	return hashes, count, nil
 }
 
+//矿池的ticket由矿池的挖矿地址购买，赎回待领取的币不能用于购买
+func (policy *ticketPolicy) buyPoolTicketOne(height int64, priv crypto.PrivKey) ([][]byte, int, error) {
+	addr := address.PubKeyToAddress(priv.PubKey().Bytes()).String()
+	api := policy.walletOperate.GetAPI()
+	chain33Cfg := api.GetConfig()
+	if !chain33Cfg.IsDappFork(height, ty.TicketX, ty.ForkTicketPool) {
+		return nil, 0, nil
+	}
+	msg, err := api.Query(ty.TicketX, "TicketPoolsOfMiner", &types.ReqString{Data: addr})
+	if err != nil {
+		return nil, 0, err
+	}
+	cfg := ty.GetTicketMinerParam(chain33Cfg, height)
+	total := 0
+	var hashes [][]byte
+	for _, pool := range msg.(*ty.ReplyTicketPools).Pools {
+		acc, err := policy.getWalletOperate().GetBalance(pool.Address, ty.TicketX)
+		if err != nil {
+			return nil, 0, err
+		}
+		count := (acc.Balance - pool.PendingWithdraw) / cfg.TicketPrice
+		if count > 0 {
+			txhash, err := policy.openticket(addr, pool.Address, priv, int32(count))
+			if err != nil {
+				return nil, 0, err
+			}
+			total += int(count)
+			if txhash != nil {
+				hashes = append(hashes, txhash)
+			}
+		}
+	}
+	return hashes, total, nil
+}
+
+func (policy *ticketPolicy) buyPoolTicket(height int64) ([][]byte, int, error) {
+	privs, err := policy.getWalletOperate().GetAllPrivKeys()
+	if err != nil {
+		bizlog.Error("buyPoolTicket.getAllPrivKeys", "err", err)
+		return nil, 0, err
+	}
+	count := 0
+	var hashes [][]byte
+	for _, priv := range privs {
+		hashlist, n, err := policy.buyPoolTicketOne(height, priv)
+		if err != nil {
+			if err != types.ErrNotFound {
+				bizlog.Error("buyPoolTicketOne", "err", err)
+			}
+			continue
+		}
+		count += n
+		hashes = append(hashes, hashlist...)
+	}
+	return hashes, count, nil
+}
+
 func (policy *ticketPolicy) withdrawFromTicket() (hashes [][]byte, err error) {
 	privs, err := policy.getWalletOperate().GetAllPrivKeys()
 	if err != nil {
@@ -827,6 +884,7 @@ func (policy *ticketPolicy) withdrawFromTicket() (hashes [][]byte, err error) {
 //1. 自动把成熟的ticket关闭
 //2. 查找超过1万余额的账户，自动购买ticket
 //3. 查找mineraddress 和他对应的 账户的余额（不在1中），余额超过1万的自动购买ticket 挖矿
+//4. 查找mineraddress 挖矿的矿池，用矿池可用的余额购买ticket
 //
 //停止挖矿：
 //1. 自动把成熟的ticket关闭
@@ -887,11 +945,16 @@ func (policy *ticketPolicy) autoMining() {
 				if err != nil {
 					bizlog.Error("buyMinerAddrTicket", "err", err)
 				}
+				hashes3, n4, err := policy.buyPoolTicket(lastHeight + 1)
+				if err != nil {
+					bizlog.Error("buyPoolTicket", "err", err)
+				}
 				hashes := append(hashes1, hashes2...)
+				hashes = append(hashes, hashes3...)
 				if len(hashes) > 0 {
 					operater.WaitTxs(hashes)
 				}
-				if n1+n2+n3 > 0 {
+				if n1+n2+n3+n4 > 0 {
 					FlushTicket(policy.getAPI())
 				}
 			} else {
@@ -141,6 +141,38 @@ func TestBuyMinerAddrTicketOne(t *testing.T) {
 
 }
 
+func TestBuyPoolTicketOne(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.GetModuleConfig().Consensus.Name = "ticket"
+
+	pk, err := hex.DecodeString("CC38546E9E659D15E6B4893F0AB32A06D103931A8230B0BDE71459D2B27D6944")
+	assert.Nil(t, err)
+	secp, err := crypto.New(types.GetSignName("", types.SECP256K1))
+	assert.Nil(t, err)
+	priKey, err := secp.PrivKeyFromBytes(pk)
+	assert.Nil(t, err)
+
+	ticket := &ticketPolicy{mtx: &sync.Mutex{}}
+	ticket.cfg = &subConfig{}
+	wallet := new(walletOperateMock)
+	qapi := new(mocks.QueueProtocolAPI)
+	qapi.On("GetConfig", mock.Anything).Return(cfg, nil)
+	wallet.api = qapi
+	ticket.walletOperate = wallet
+
+	//赎回待领取的币不能用于购买ticket
+	pools := &ty.ReplyTicketPools{Pools: []*ty.TicketPool{
+		{Address: "1MCftFynyvG2F4ED5mdHYgziDxx6vDrScs", PendingWithdraw: 25000 * types.Coin},
+		{Address: "1NLHPEcbTWWxxU3dGUZBhayjrCHD3psX7k", PendingWithdraw: 100000 * types.Coin},
+	}}
+	qapi.On("Query", ty.TicketX, "TicketPoolsOfMiner", mock.Anything).Return(pools, nil)
+
+	hashs, n, err := ticket.buyPoolTicketOne(0, priKey)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(sendhash)}, hashs)
+	assert.Equal(t, 7, n)
+}
+
 type walletOperateMock struct {
 	api client.QueueProtocolAPI
 }
@@ -0,0 +1,311 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/33cn/chain33/rpc/jsonclient"
+	rpctypes "github.com/33cn/chain33/rpc/types"
+	"github.com/33cn/chain33/types"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/spf13/cobra"
+)
+
+// NFTCmd nft 命令行
+func NFTCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft",
+		Short: "Non-fungible token management",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		CreateRawNFTMintTxCmd(),
+		CreateRawNFTTransferTxCmd(),
+		CreateRawNFTBurnTxCmd(),
+		CreateRawNFTSendToExecTxCmd(),
+		CreateRawNFTWithdrawTxCmd(),
+		GetNFTOwnerCmd(),
+		GetNFTsOfOwnerCmd(),
+		GetNFTHistoryCmd(),
+	)
+
+	return cmd
+}
+
+func addNFTFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("token_id", "i", "", "token id of the item")
+	cmd.MarkFlagRequired("token_id")
+}
+
+// CreateRawNFTMintTxCmd create raw nft mint transaction
+func CreateRawNFTMintTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint",
+		Short: "Create a mint nft transaction",
+		Run:   nftMint,
+	}
+	addNFTMintFlags(cmd)
+	return cmd
+}
+
+func addNFTMintFlags(cmd *cobra.Command) {
+	addNFTFlags(cmd)
+	cmd.Flags().StringP("to", "t", "", "receiver address, default the token owner")
+	cmd.Flags().StringP("uri", "u", "", "uri of the item metadata")
+	cmd.Flags().StringP("meta_hash", "m", "", "hash of the item metadata")
+}
+
+func nftMint(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+	to, _ := cmd.Flags().GetString("to")
+	uri, _ := cmd.Flags().GetString("uri")
+	metaHash, _ := cmd.Flags().GetString("meta_hash")
+
+	params := &tokenty.NFTMint{
+		Symbol:   symbol,
+		TokenID:  tokenID,
+		To:       to,
+		Uri:      uri,
+		MetaHash: metaHash,
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenNFTMintTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawNFTTransferTxCmd create raw nft transfer transaction
+func CreateRawNFTTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Create a transfer nft transaction",
+		Run:   nftTransfer,
+	}
+	addNFTTransferFlags(cmd)
+	return cmd
+}
+
+func addNFTTransferFlags(cmd *cobra.Command) {
+	addNFTFlags(cmd)
+	cmd.Flags().StringP("to", "t", "", "receiver address")
+	cmd.MarkFlagRequired("to")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+}
+
+func nftTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+	to, _ := cmd.Flags().GetString("to")
+	note, _ := cmd.Flags().GetString("note")
+
+	params := &tokenty.NFTTransfer{
+		Symbol:  symbol,
+		TokenID: tokenID,
+		To:      to,
+		Note:    note,
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenNFTTransferTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawNFTBurnTxCmd create raw nft burn transaction
+func CreateRawNFTBurnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn",
+		Short: "Create a burn nft transaction",
+		Run:   nftBurn,
+	}
+	addNFTFlags(cmd)
+	return cmd
+}
+
+func nftBurn(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+
+	params := &tokenty.NFTBurn{
+		Symbol:  symbol,
+		TokenID: tokenID,
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenNFTBurnTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawNFTSendToExecTxCmd create raw nft send to executor transaction
+func CreateRawNFTSendToExecTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send_exec",
+		Short: "Create a nft send to executor transaction",
+		Run:   nftSendToExec,
+	}
+	addNFTExecFlags(cmd)
+	return cmd
+}
+
+func addNFTExecFlags(cmd *cobra.Command) {
+	addNFTFlags(cmd)
+	cmd.Flags().StringP("exec", "e", "", "executor name")
+	cmd.MarkFlagRequired("exec")
+	cmd.Flags().StringP("note", "n", "", "transaction note info")
+}
+
+func nftSendToExec(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+	exec, _ := cmd.Flags().GetString("exec")
+	note, _ := cmd.Flags().GetString("note")
+
+	params := &tokenty.NFTTransferToExec{
+		Symbol:   symbol,
+		TokenID:  tokenID,
+		ExecName: getRealExecName(paraName, exec),
+		Note:     note,
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenNFTTransferToExecTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawNFTWithdrawTxCmd create raw nft withdraw transaction
+func CreateRawNFTWithdrawTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw",
+		Short: "Create a nft withdraw transaction",
+		Run:   nftWithdraw,
+	}
+	addNFTExecFlags(cmd)
+	return cmd
+}
+
+func nftWithdraw(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+	exec, _ := cmd.Flags().GetString("exec")
+	note, _ := cmd.Flags().GetString("note")
+
+	params := &tokenty.NFTWithdraw{
+		Symbol:   symbol,
+		TokenID:  tokenID,
+		ExecName: getRealExecName(paraName, exec),
+		Note:     note,
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenNFTWithdrawTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func queryNFT(cmd *cobra.Command, funcName string, req types.Message, res types.Message) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = funcName
+	params.Payload = types.MustPBToJSON(req)
+	rpc, err := jsonclient.NewJSONClient(rpcLaddr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	err = rpc.Call("Chain33.Query", params, res)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	data, err := json.MarshalIndent(res, "", "    ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	fmt.Println(string(data))
+}
+
+// GetNFTOwnerCmd get owner of nft
+func GetNFTOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner",
+		Short: "Get nft item and its owner",
+		Run:   getNFTOwner,
+	}
+	addNFTFlags(cmd)
+	return cmd
+}
+
+func getNFTOwner(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+
+	var res tokenty.NFTItem
+	queryNFT(cmd, "GetNFTOwner", &tokenty.ReqNFT{Symbol: symbol, TokenID: tokenID}, &res)
+}
+
+// GetNFTsOfOwnerCmd get nfts of owner
+func GetNFTsOfOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Get nft items of address",
+		Run:   getNFTsOfOwner,
+	}
+	addNFTsOfOwnerFlags(cmd)
+	return cmd
+}
+
+func addNFTsOfOwnerFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("addr", "a", "", "owner address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("symbol", "s", "", "token symbol, default all")
+	cmd.Flags().StringP("primary", "p", "", "start from the primary key(symbol-tokenID)")
+	cmd.Flags().Int32P("count", "c", 10, "count of items")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc  1: asc")
+}
+
+func getNFTsOfOwner(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	req := &tokenty.ReqNFTsOfOwner{
+		Owner:     addr,
+		Symbol:    symbol,
+		Primary:   primary,
+		Count:     count,
+		Direction: direction,
+	}
+	var res tokenty.ReplyNFTs
+	queryNFT(cmd, "GetNFTsOfOwner", req, &res)
+}
+
+// GetNFTHistoryCmd get history of nft
+func GetNFTHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Get history of nft item",
+		Run:   getNFTHistory,
+	}
+	addNFTFlags(cmd)
+	return cmd
+}
+
+func getNFTHistory(cmd *cobra.Command, args []string) {
+	symbol, _ := cmd.Flags().GetString("symbol")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+
+	var res tokenty.ReplyNFTLogs
+	queryNFT(cmd, "GetNFTHistory", &tokenty.ReqNFT{Symbol: symbol, TokenID: tokenID}, &res)
+}
@@ -47,6 +47,7 @@ func TokenCmd() *cobra.Command {
 		GetTokenLogsCmd(),
 		GetTokenCmd(),
 		QueryTxCmd(),
+		NFTCmd(),
 	)
 
 	return cmd
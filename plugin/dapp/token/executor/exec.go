@@ -83,3 +83,28 @@ func (t *token) Exec_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction
 	action := newTokenAction(t, "", tx)
 	return action.burn(payload)
 }
+
+func (t *token) Exec_NFTMint(payload *tokenty.NFTMint, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.nftMint(payload)
+}
+
+func (t *token) Exec_NFTTransfer(payload *tokenty.NFTTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.nftTransfer(payload)
+}
+
+func (t *token) Exec_NFTBurn(payload *tokenty.NFTBurn, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.nftBurn(payload)
+}
+
+func (t *token) Exec_NFTTransferToExec(payload *tokenty.NFTTransferToExec, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.nftTransferToExec(payload)
+}
+
+func (t *token) Exec_NFTWithdraw(payload *tokenty.NFTWithdraw, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.nftWithdraw(payload)
+}
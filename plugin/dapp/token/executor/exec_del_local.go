@@ -187,3 +187,23 @@ func (t *token) ExecDelLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Tra
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecDelLocal_NFTMint(payload *tokenty.NFTMint, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalNFT(tx)
+}
+
+func (t *token) ExecDelLocal_NFTTransfer(payload *tokenty.NFTTransfer, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalNFT(tx)
+}
+
+func (t *token) ExecDelLocal_NFTBurn(payload *tokenty.NFTBurn, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalNFT(tx)
+}
+
+func (t *token) ExecDelLocal_NFTTransferToExec(payload *tokenty.NFTTransferToExec, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalNFT(tx)
+}
+
+func (t *token) ExecDelLocal_NFTWithdraw(payload *tokenty.NFTWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execDelLocalNFT(tx)
+}
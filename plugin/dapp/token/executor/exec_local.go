@@ -281,3 +281,27 @@ func (t *token) ExecLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transa
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecLocal_NFTMint(payload *tokenty.NFTMint, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	to := payload.To
+	if to == "" {
+		to = tx.From()
+	}
+	return t.execLocalNFT(tx, receiptData, index, tokenty.TokenActionNFTMint, payload.Symbol, payload.TokenID, to)
+}
+
+func (t *token) ExecLocal_NFTTransfer(payload *tokenty.NFTTransfer, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalNFT(tx, receiptData, index, tokenty.TokenActionNFTTransfer, payload.Symbol, payload.TokenID, payload.To)
+}
+
+func (t *token) ExecLocal_NFTBurn(payload *tokenty.NFTBurn, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalNFT(tx, receiptData, index, tokenty.TokenActionNFTBurn, payload.Symbol, payload.TokenID, "")
+}
+
+func (t *token) ExecLocal_NFTTransferToExec(payload *tokenty.NFTTransferToExec, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalNFT(tx, receiptData, index, tokenty.TokenActionNFTTransferToExec, payload.Symbol, payload.TokenID, dapp.ExecAddress(payload.ExecName))
+}
+
+func (t *token) ExecLocal_NFTWithdraw(payload *tokenty.NFTWithdraw, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalNFT(tx, receiptData, index, tokenty.TokenActionNFTWithdraw, payload.Symbol, payload.TokenID, tx.From())
+}
@@ -16,6 +16,10 @@ var (
 	tokenPreCreatedSTONew = "mavl-token-create-sto-"
 
 	tokenPreCreatedSTONewLocal = "LODB-token-create-sto-"
+
+	nftItemPrefix     = "mavl-token-nft-"
+	nftExecItemPrefix = "mavl-token-nft-exec-"
+	nftTokenPrefix    = "mavl-token-nfttoken-"
 )
 
 func calcTokenKey(token string) (key []byte) {
@@ -54,3 +58,18 @@ func calcTokenStatusTokenKeyPrefixLocal(status int32, token string) []byte {
 func calcAddrKey(token string, addr string) []byte {
 	return []byte(fmt.Sprintf("LODB-token-%s-Addr:%s", token, addr))
 }
+
+// NFT 物品, key 中的 symbol 只包含大写字母和数字, 不会和托管记录的 exec- 冲突
+func calcNFTKey(symbol, tokenID string) []byte {
+	return []byte(fmt.Sprintf(nftItemPrefix+"%s-%s", symbol, tokenID))
+}
+
+// 按 NFT 规则创建的 token 标记, ForkTokenNFTX 之前带 NFT 位的 token 没有标记, 仍按同质化 token 处理
+func calcNFTTokenKey(symbol string) []byte {
+	return []byte(nftTokenPrefix + symbol)
+}
+
+// NFT 在执行器中的托管记录, 格式和账户的 exec key 一致, 对应的执行器可以修改
+func calcNFTExecKey(execaddr, symbol, tokenID string) []byte {
+	return []byte(fmt.Sprintf(nftExecItemPrefix+"%s:%s-%s", execaddr, symbol, tokenID))
+}
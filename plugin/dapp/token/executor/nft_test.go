@@ -0,0 +1,292 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type nftTestEnv struct {
+	t       *testing.T
+	cfg     *types.Chain33Config
+	api     *apimock.QueueProtocolAPI
+	stateDB dbm.KV
+	ldb     dbm.DB
+	kvdb    dbm.KVDB
+	height  int64
+}
+
+// 本地数据库中 value 为 nil 表示删除
+func (env *nftTestEnv) setLocal(kvs []*types.KeyValue) {
+	for _, kv := range kvs {
+		if kv.Value == nil {
+			env.ldb.Delete(kv.Key)
+			continue
+		}
+		env.kvdb.Set(kv.Key, kv.Value)
+	}
+}
+
+func (env *nftTestEnv) newExec() *token {
+	exec := newToken()
+	exec.SetAPI(env.api)
+	exec.SetStateDB(env.stateDB)
+	exec.SetLocalDB(env.kvdb)
+	exec.SetEnv(env.height, 1539918074+env.height, 1539918074)
+	return exec.(*token)
+}
+
+func (env *nftTestEnv) exec(action string, payload types.Message, privKey string) (*types.Transaction, *types.ReceiptData, error) {
+	tx, err := types.CallCreateTransaction(pty.TokenX, action, payload)
+	assert.Nil(env.t, err)
+	tx, err = signTx(tx, privKey)
+	assert.Nil(env.t, err)
+
+	env.height++
+	exec := env.newExec()
+	receipt, err := exec.Exec(tx, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, kv := range receipt.KV {
+		env.stateDB.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, 1)
+	assert.Nil(env.t, err)
+	env.setLocal(set.KV)
+	return tx, receiptData, nil
+}
+
+func newNFTTestEnv(t *testing.T) *nftTestEnv {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	InitExecType()
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, ldb, kvdb := util.CreateTestDB()
+
+	item := &types.ConfigItem{
+		Key: "mavl-manage-token-blacklist",
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: []string{"bty"}},
+		},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+	item = &types.ConfigItem{
+		Key: "mavl-manage-token-finisher",
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: []string{string(Nodes[0])}},
+		},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+	return &nftTestEnv{t: t, cfg: cfg, api: api, stateDB: stateDB, ldb: ldb, kvdb: kvdb, height: 10}
+}
+
+func TestNFT(t *testing.T) {
+	env := newNFTTestEnv(t)
+
+	// NFT 的 total 必须为 0, 且不能和 mint/burn 同时设置
+	precreate := &pty.TokenPreCreate{
+		Name:         Symbol,
+		Symbol:       Symbol,
+		Introduction: Symbol,
+		Total:        100 * types.TokenPrecision,
+		Owner:        string(Nodes[0]),
+		Category:     pty.CategoryNFT,
+	}
+	_, _, err := env.exec("TokenPreCreate", precreate, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenTotalOverflow, err)
+	precreate.Total = 0
+	precreate.Category = pty.CategoryNFT | pty.CategoryMintBurnSupport
+	_, _, err = env.exec("TokenPreCreate", precreate, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenNFTCategory, err)
+	precreate.Category = pty.CategoryNFT
+	_, _, err = env.exec("TokenPreCreate", precreate, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = env.exec("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+
+	// mint
+	mint := &pty.NFTMint{Symbol: Symbol, TokenID: "1", Uri: "ipfs://1", MetaHash: "0x01"}
+	_, _, err = env.exec("NFTMint", mint, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+	_, _, err = env.exec("NFTMint", mint, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = env.exec("NFTMint", mint, PrivKeyA)
+	assert.Equal(t, pty.ErrNFTExist, err)
+	_, _, err = env.exec("NFTMint", &pty.NFTMint{Symbol: Symbol, TokenID: "2", To: string(Nodes[1])}, PrivKeyA)
+	assert.Nil(t, err)
+
+	exec := env.newExec()
+	info, err := exec.getTokenInfo(Symbol)
+	assert.Nil(t, err)
+	assert.Equal(t, 2*types.TokenPrecision, info.(*pty.LocalToken).Total)
+
+	// transfer
+	transfer := &pty.NFTTransfer{Symbol: Symbol, TokenID: "1", To: string(Nodes[1])}
+	_, _, err = env.exec("NFTTransfer", transfer, PrivKeyB)
+	assert.Equal(t, pty.ErrNFTOwner, err)
+	_, _, err = env.exec("NFTTransfer", transfer, PrivKeyA)
+	assert.Nil(t, err)
+
+	out, err := env.newExec().Query_GetNFTsOfOwner(&pty.ReqNFTsOfOwner{Owner: string(Nodes[1]), Symbol: Symbol, Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(out.(*pty.ReplyNFTs).Items))
+	_, err = env.newExec().Query_GetNFTsOfOwner(&pty.ReqNFTsOfOwner{Owner: string(Nodes[0]), Count: 10})
+	assert.Equal(t, types.ErrNotFound, err)
+
+	// 托管到执行器, 由执行器冻结并转给 C
+	escrowExec := "user.escrow"
+	execAddr := address.ExecAddress(escrowExec)
+	_, _, err = env.exec("NFTTransferToExec", &pty.NFTTransferToExec{Symbol: Symbol, TokenID: "1", ExecName: escrowExec}, PrivKeyB)
+	assert.Nil(t, err)
+	out, err = env.newExec().Query_GetNFTOwner(&pty.ReqNFT{Symbol: Symbol, TokenID: "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[1]), out.(*pty.NFTItem).Owner)
+	assert.Equal(t, execAddr, out.(*pty.NFTItem).ExecAddr)
+
+	acc := NewNFTAccountDB(env.stateDB, Symbol)
+	_, err = acc.ExecActive(string(Nodes[1]), execAddr, "1")
+	assert.Equal(t, pty.ErrNFTNotFrozen, err)
+	_, err = acc.ExecFrozen(string(Nodes[1]), execAddr, "1")
+	assert.Nil(t, err)
+	_, _, err = env.exec("NFTWithdraw", &pty.NFTWithdraw{Symbol: Symbol, TokenID: "1", ExecName: escrowExec}, PrivKeyB)
+	assert.Equal(t, pty.ErrNFTFrozen, err)
+	_, err = acc.ExecTransferFrozen(string(Nodes[1]), string(Nodes[2]), execAddr, "1")
+	assert.Nil(t, err)
+	_, _, err = env.exec("NFTWithdraw", &pty.NFTWithdraw{Symbol: Symbol, TokenID: "1", ExecName: escrowExec}, PrivKeyB)
+	assert.Equal(t, pty.ErrNFTOwner, err)
+	tx, _, err := env.exec("NFTWithdraw", &pty.NFTWithdraw{Symbol: Symbol, TokenID: "1", ExecName: escrowExec}, PrivKeyC)
+	assert.Nil(t, err)
+
+	out, err = env.newExec().Query_GetNFTOwner(&pty.ReqNFT{Symbol: Symbol, TokenID: "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, string(Nodes[2]), out.(*pty.NFTItem).Owner)
+	assert.Equal(t, "", out.(*pty.NFTItem).ExecAddr)
+	out, err = env.newExec().Query_GetNFTsOfOwner(&pty.ReqNFTsOfOwner{Owner: string(Nodes[2]), Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(out.(*pty.ReplyNFTs).Items))
+
+	// 回滚 withdraw 的本地数据
+	exec = env.newExec()
+	set, err := exec.ExecDelLocal(tx, &types.ReceiptData{Ty: types.ExecOk}, 1)
+	assert.Nil(t, err)
+	env.setLocal(set.KV)
+	out, err = env.newExec().Query_GetNFTsOfOwner(&pty.ReqNFTsOfOwner{Owner: string(Nodes[1]), Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(out.(*pty.ReplyNFTs).Items))
+
+	// burn, 销毁后不能再次铸造
+	_, _, err = env.exec("NFTBurn", &pty.NFTBurn{Symbol: Symbol, TokenID: "2"}, PrivKeyB)
+	assert.Nil(t, err)
+	_, err = env.newExec().Query_GetNFTOwner(&pty.ReqNFT{Symbol: Symbol, TokenID: "2"})
+	assert.Equal(t, pty.ErrNFTNotExist, err)
+	_, _, err = env.exec("NFTMint", &pty.NFTMint{Symbol: Symbol, TokenID: "2"}, PrivKeyA)
+	assert.Equal(t, pty.ErrNFTExist, err)
+
+	out, err = env.newExec().Query_GetNFTHistory(&pty.ReqNFT{Symbol: Symbol, TokenID: "1"})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(out.(*pty.ReplyNFTLogs).Logs))
+}
+
+func TestNFTExecHolder(t *testing.T) {
+	env := newNFTTestEnv(t)
+	// 测试中没有注册 trade 执行器
+	types.AllowUserExec = append(types.AllowUserExec, []byte("trade"))
+	precreate := &pty.TokenPreCreate{Name: Symbol, Symbol: Symbol, Introduction: Symbol, Owner: string(Nodes[0]), Category: pty.CategoryNFT}
+	_, _, err := env.exec("TokenPreCreate", precreate, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = env.exec("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = env.exec("NFTMint", &pty.NFTMint{Symbol: Symbol, TokenID: "1", To: string(Nodes[1])}, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = env.exec("NFTTransferToExec", &pty.NFTTransferToExec{Symbol: Symbol, TokenID: "1", ExecName: "trade"}, PrivKeyB)
+	assert.Nil(t, err)
+
+	list := func(owner []byte) []*pty.NFTItem {
+		out, err := env.newExec().Query_GetNFTsOfOwner(&pty.ReqNFTsOfOwner{Owner: string(owner), Count: 10})
+		if err == types.ErrNotFound {
+			return nil
+		}
+		assert.Nil(t, err)
+		return out.(*pty.ReplyNFTs).Items
+	}
+
+	// trade 成交: 冻结后转给 C, 由 trade 的本地数据记录新的持有人
+	execAddr := address.ExecAddress("trade")
+	acc := NewNFTAccountDB(env.stateDB, Symbol)
+	receipt, err := acc.ExecFrozen(string(Nodes[1]), execAddr, "1")
+	assert.Nil(t, err)
+	logs := receipt.Logs
+	receipt, err = acc.ExecTransferFrozen(string(Nodes[1]), string(Nodes[2]), execAddr, "1")
+	assert.Nil(t, err)
+	logs = append(logs, receipt.Logs...)
+	env.height++
+	kvs, err := NFTExecLocal(env.kvdb, "trade", dapp.HeightIndexStr(env.height, 1), logs)
+	assert.Nil(t, err)
+	assert.NotEqual(t, 0, len(kvs))
+	env.setLocal(kvs)
+	assert.Equal(t, 0, len(list(Nodes[1])))
+	items := list(Nodes[2])
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, execAddr, items[0].ExecAddr)
+
+	// C 提取后以 token 的记录为准, 转给 D 后 C 不再持有
+	_, _, err = env.exec("NFTWithdraw", &pty.NFTWithdraw{Symbol: Symbol, TokenID: "1", ExecName: "trade"}, PrivKeyC)
+	assert.Nil(t, err)
+	items = list(Nodes[2])
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, "", items[0].ExecAddr)
+	_, _, err = env.exec("NFTTransfer", &pty.NFTTransfer{Symbol: Symbol, TokenID: "1", To: string(Nodes[3])}, PrivKeyC)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(list(Nodes[2])))
+	assert.Equal(t, 1, len(list(Nodes[3])))
+
+	// 销毁后 trade 中过时的记录不再列出
+	_, _, err = env.exec("NFTBurn", &pty.NFTBurn{Symbol: Symbol, TokenID: "1"}, PrivKeyD)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(list(Nodes[3])))
+	assert.Equal(t, 0, len(list(Nodes[2])))
+}
+
+func TestNFTBeforeFork(t *testing.T) {
+	env := newNFTTestEnv(t)
+	env.cfg.SetTitleOnlyForTest("chain33")
+	env.cfg.SetDappFork(pty.TokenX, pty.ForkTokenNFTX, 100)
+
+	// fork 之前忽略 NFT 位, 按同质化 token 创建
+	precreate := &pty.TokenPreCreate{
+		Name:         Symbol,
+		Symbol:       Symbol,
+		Introduction: Symbol,
+		Total:        100 * types.TokenPrecision,
+		Owner:        string(Nodes[0]),
+		Category:     pty.CategoryNFT,
+	}
+	_, _, err := env.exec("TokenPreCreate", precreate, PrivKeyA)
+	assert.Nil(t, err)
+	_, _, err = env.exec("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(t, err)
+	acc, err := account.NewAccountDB(env.cfg, pty.TokenX, Symbol, env.stateDB)
+	assert.Nil(t, err)
+	assert.Equal(t, 100*types.TokenPrecision, acc.LoadAccount(string(Nodes[0])).Balance)
+
+	// fork 之后也不能作为 NFT 铸造
+	env.height = 100
+	_, _, err = env.exec("NFTMint", &pty.NFTMint{Symbol: Symbol, TokenID: "1"}, PrivKeyA)
+	assert.Equal(t, pty.ErrTokenNotNFT, err)
+}
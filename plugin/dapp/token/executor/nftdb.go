@@ -0,0 +1,400 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+/*
+NFT(非同质化token)
+
+1) token 预创建时 category 带上 CategoryNFT, total 为 0, 由 finish 正常完成创建
+2) token 的 owner 可以铸造 NFT 到指定地址, 每个 tokenID 在 symbol 下唯一, token 的 total 记录现存物品的数量
+3) 物品的所有者可以转账和销毁, 销毁后 tokenID 不能再次铸造
+4) 物品可以托管到其他执行器(如 trade), 物品的 owner 变为执行器地址,
+   执行器中实际的所有者由托管记录保存, 托管记录的冻结/转移由 NFTAccountDB 提供给其他执行器使用
+*/
+
+import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+// 销毁的物品 owner 为空, 保留记录防止 tokenID 被再次铸造
+func loadNFT(db dbm.KV, symbol, tokenID string) (*pty.NFTItem, error) {
+	value, err := db.Get(calcNFTKey(symbol, tokenID))
+	if err != nil {
+		return nil, pty.ErrNFTNotExist
+	}
+	var item pty.NFTItem
+	err = types.Decode(value, &item)
+	if err != nil {
+		tokenlog.Error("loadNFT", "Can't decode nft item", symbol, "tokenID", tokenID)
+		return nil, err
+	}
+	return &item, nil
+}
+
+func getNFT(db dbm.KV, symbol, tokenID string) (*pty.NFTItem, error) {
+	item, err := loadNFT(db, symbol, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Owner == "" {
+		return nil, pty.ErrNFTNotExist
+	}
+	return item, nil
+}
+
+func saveNFT(db dbm.KV, ty int32, prev, current *pty.NFTItem) ([]*types.KeyValue, []*types.ReceiptLog) {
+	key := calcNFTKey(current.Symbol, current.TokenID)
+	value := types.Encode(current)
+	err := db.Set(key, value)
+	if err != nil {
+		panic(err)
+	}
+	kvs := []*types.KeyValue{{Key: key, Value: value}}
+	logs := []*types.ReceiptLog{{Ty: ty, Log: types.Encode(&pty.ReceiptNFT{Prev: prev, Current: current})}}
+	return kvs, logs
+}
+
+func checkNFTParam(symbol, tokenID string) error {
+	if symbol == "" {
+		return types.ErrInvalidParam
+	}
+	if len(tokenID) == 0 || len(tokenID) > pty.NFTTokenIDLenLimit {
+		return pty.ErrNFTTokenIDLen
+	}
+	return nil
+}
+
+// 更新 NFT 类型 token 的 total, 每个现存物品计为 1 个单位(types.TokenPrecision)
+func (t *tokenDB) changeNFTTotal(db dbm.KV, delta int64) []*types.KeyValue {
+	t.token.Total += delta
+	kvs := append(t.getKVSet(calcTokenKey(t.token.Symbol)), t.getKVSet(calcTokenAddrNewKeyS(t.token.Symbol, t.token.Owner))...)
+	for _, kv := range kvs {
+		err := db.Set(kv.Key, kv.Value)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return kvs
+}
+
+func loadNFTTokenDB(db dbm.KV, symbol string) (*tokenDB, error) {
+	tokendb, err := loadTokenDB(db, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Category&pty.CategoryNFT == 0 {
+		return nil, pty.ErrTokenNotNFT
+	}
+	if _, err := db.Get(calcNFTTokenKey(symbol)); err != nil {
+		return nil, pty.ErrTokenNotNFT
+	}
+	return tokendb, nil
+}
+
+func (action *tokenAction) checkNFTFork() error {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenNFTX) {
+		return types.ErrActionNotSupport
+	}
+	return nil
+}
+
+func (action *tokenAction) nftMint(mint *pty.NFTMint) (*types.Receipt, error) {
+	if err := action.checkNFTFork(); err != nil {
+		return nil, err
+	}
+	if mint == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := checkNFTParam(mint.GetSymbol(), mint.GetTokenID()); err != nil {
+		return nil, err
+	}
+	if len(mint.GetUri()) > pty.NFTURILenLimit {
+		return nil, pty.ErrNFTURILen
+	}
+	if len(mint.GetMetaHash()) > pty.NFTMetaHashLenLimit {
+		return nil, pty.ErrNFTMetaHashLen
+	}
+	to := mint.GetTo()
+	if to == "" {
+		to = action.fromaddr
+	}
+	if err := address.CheckAddress(to); err != nil {
+		return nil, err
+	}
+
+	tokendb, err := loadNFTTokenDB(action.db, mint.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Owner != action.fromaddr {
+		tokenlog.Error("nft mint", "symbol", mint.GetSymbol(), "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, types.ErrNotAllow
+	}
+	if _, err = loadNFT(action.db, mint.GetSymbol(), mint.GetTokenID()); err == nil {
+		return nil, pty.ErrNFTExist
+	}
+
+	item := &pty.NFTItem{
+		Symbol:   mint.GetSymbol(),
+		TokenID:  mint.GetTokenID(),
+		Owner:    to,
+		Uri:      mint.GetUri(),
+		MetaHash: mint.GetMetaHash(),
+		Creator:  action.fromaddr,
+		Height:   action.height,
+	}
+	kvs, logs := saveNFT(action.db, pty.TyLogNFTMint, nil, item)
+	kvs = append(kvs, tokendb.changeNFTTotal(action.db, types.TokenPrecision)...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) nftTransfer(transfer *pty.NFTTransfer) (*types.Receipt, error) {
+	if err := action.checkNFTFork(); err != nil {
+		return nil, err
+	}
+	if transfer == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := checkNFTParam(transfer.GetSymbol(), transfer.GetTokenID()); err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(transfer.GetTo()); err != nil {
+		return nil, err
+	}
+	item, err := getNFT(action.db, transfer.GetSymbol(), transfer.GetTokenID())
+	if err != nil {
+		return nil, err
+	}
+	if item.Owner != action.fromaddr {
+		return nil, pty.ErrNFTOwner
+	}
+
+	prev := *item
+	item.Owner = transfer.GetTo()
+	kvs, logs := saveNFT(action.db, pty.TyLogNFTTransfer, &prev, item)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) nftBurn(burn *pty.NFTBurn) (*types.Receipt, error) {
+	if err := action.checkNFTFork(); err != nil {
+		return nil, err
+	}
+	if burn == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := checkNFTParam(burn.GetSymbol(), burn.GetTokenID()); err != nil {
+		return nil, err
+	}
+	item, err := getNFT(action.db, burn.GetSymbol(), burn.GetTokenID())
+	if err != nil {
+		return nil, err
+	}
+	if item.Owner != action.fromaddr {
+		return nil, pty.ErrNFTOwner
+	}
+	tokendb, err := loadNFTTokenDB(action.db, burn.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+
+	prev := *item
+	item.Owner = ""
+	kvs, logs := saveNFT(action.db, pty.TyLogNFTBurn, &prev, item)
+	kvs = append(kvs, tokendb.changeNFTTotal(action.db, -types.TokenPrecision)...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) nftTransferToExec(transfer *pty.NFTTransferToExec) (*types.Receipt, error) {
+	if err := action.checkNFTFork(); err != nil {
+		return nil, err
+	}
+	if transfer == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := checkNFTParam(transfer.GetSymbol(), transfer.GetTokenID()); err != nil {
+		return nil, err
+	}
+	if !types.IsAllowExecName([]byte(transfer.GetExecName()), []byte(transfer.GetExecName())) {
+		return nil, types.ErrExecNameNotAllow
+	}
+	item, err := getNFT(action.db, transfer.GetSymbol(), transfer.GetTokenID())
+	if err != nil {
+		return nil, err
+	}
+	if item.Owner != action.fromaddr {
+		return nil, pty.ErrNFTOwner
+	}
+
+	execaddr := dapp.ExecAddress(transfer.GetExecName())
+	prev := *item
+	item.Owner = execaddr
+	kvs, logs := saveNFT(action.db, pty.TyLogNFTTransfer, &prev, item)
+
+	acc := NewNFTAccountDB(action.db, transfer.GetSymbol())
+	receipt := acc.saveExecItem(nil, &pty.NFTExecItem{
+		Symbol:   transfer.GetSymbol(),
+		TokenID:  transfer.GetTokenID(),
+		Addr:     action.fromaddr,
+		ExecAddr: execaddr,
+	})
+	kvs = append(kvs, receipt.KV...)
+	logs = append(logs, receipt.Logs...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) nftWithdraw(withdraw *pty.NFTWithdraw) (*types.Receipt, error) {
+	if err := action.checkNFTFork(); err != nil {
+		return nil, err
+	}
+	if withdraw == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := checkNFTParam(withdraw.GetSymbol(), withdraw.GetTokenID()); err != nil {
+		return nil, err
+	}
+	item, err := getNFT(action.db, withdraw.GetSymbol(), withdraw.GetTokenID())
+	if err != nil {
+		return nil, err
+	}
+	execaddr := dapp.ExecAddress(withdraw.GetExecName())
+	if item.Owner != execaddr {
+		return nil, pty.ErrNFTOwner
+	}
+	acc := NewNFTAccountDB(action.db, withdraw.GetSymbol())
+	execItem, err := acc.LoadExecItem(execaddr, withdraw.GetTokenID())
+	if err != nil {
+		return nil, err
+	}
+	if execItem.Addr != action.fromaddr {
+		return nil, pty.ErrNFTOwner
+	}
+	if execItem.Frozen {
+		return nil, pty.ErrNFTFrozen
+	}
+
+	prev := *item
+	item.Owner = action.fromaddr
+	kvs, logs := saveNFT(action.db, pty.TyLogNFTTransfer, &prev, item)
+
+	current := *execItem
+	current.Addr = ""
+	receipt := acc.saveExecItem(execItem, &current)
+	kvs = append(kvs, receipt.KV...)
+	logs = append(logs, receipt.Logs...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// NFTAccountDB NFT 在执行器中的托管记录, 和 account.DB 的 exec account 对应,
+// 托管记录的 key 属于执行器的 exec 区域, 所以 trade 等执行器可以直接修改
+type NFTAccountDB struct {
+	db     dbm.KV
+	symbol string
+}
+
+// NewNFTAccountDB new nft account db
+func NewNFTAccountDB(db dbm.KV, symbol string) *NFTAccountDB {
+	return &NFTAccountDB{db: db, symbol: symbol}
+}
+
+// LoadExecItem 获取物品在执行器中的托管记录
+func (acc *NFTAccountDB) LoadExecItem(execaddr, tokenID string) (*pty.NFTExecItem, error) {
+	value, err := acc.db.Get(calcNFTExecKey(execaddr, acc.symbol, tokenID))
+	if err != nil {
+		return nil, pty.ErrNFTNotExist
+	}
+	var item pty.NFTExecItem
+	err = types.Decode(value, &item)
+	if err != nil {
+		return nil, err
+	}
+	if item.Addr == "" {
+		return nil, pty.ErrNFTNotExist
+	}
+	return &item, nil
+}
+
+func (acc *NFTAccountDB) saveExecItem(prev, current *pty.NFTExecItem) *types.Receipt {
+	key := calcNFTExecKey(current.ExecAddr, current.Symbol, current.TokenID)
+	value := types.Encode(current)
+	err := acc.db.Set(key, value)
+	if err != nil {
+		panic(err)
+	}
+	log := &types.ReceiptLog{Ty: pty.TyLogNFTExecItem, Log: types.Encode(&pty.ReceiptNFTExecItem{Prev: prev, Current: current})}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{{Key: key, Value: value}}, Logs: []*types.ReceiptLog{log}}
+}
+
+func (acc *NFTAccountDB) loadOwnedExecItem(addr, execaddr, tokenID string, frozen bool) (*pty.NFTExecItem, error) {
+	item, err := acc.LoadExecItem(execaddr, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if item.Addr != addr {
+		return nil, pty.ErrNFTOwner
+	}
+	if item.Frozen && !frozen {
+		return nil, pty.ErrNFTFrozen
+	}
+	if !item.Frozen && frozen {
+		return nil, pty.ErrNFTNotFrozen
+	}
+	return item, nil
+}
+
+// ExecFrozen 冻结执行器中的物品, 如挂卖单
+func (acc *NFTAccountDB) ExecFrozen(addr, execaddr, tokenID string) (*types.Receipt, error) {
+	item, err := acc.loadOwnedExecItem(addr, execaddr, tokenID, false)
+	if err != nil {
+		return nil, err
+	}
+	current := *item
+	current.Frozen = true
+	return acc.saveExecItem(item, &current), nil
+}
+
+// ExecActive 解冻执行器中的物品, 如撤销卖单
+func (acc *NFTAccountDB) ExecActive(addr, execaddr, tokenID string) (*types.Receipt, error) {
+	item, err := acc.loadOwnedExecItem(addr, execaddr, tokenID, true)
+	if err != nil {
+		return nil, err
+	}
+	current := *item
+	current.Frozen = false
+	return acc.saveExecItem(item, &current), nil
+}
+
+// ExecTransfer 转移执行器中未冻结的物品
+func (acc *NFTAccountDB) ExecTransfer(from, to, execaddr, tokenID string) (*types.Receipt, error) {
+	if err := address.CheckAddress(to); err != nil {
+		return nil, err
+	}
+	item, err := acc.loadOwnedExecItem(from, execaddr, tokenID, false)
+	if err != nil {
+		return nil, err
+	}
+	current := *item
+	current.Addr = to
+	return acc.saveExecItem(item, &current), nil
+}
+
+// ExecTransferFrozen 转移执行器中冻结的物品, 转移后物品处于未冻结状态
+func (acc *NFTAccountDB) ExecTransferFrozen(from, to, execaddr, tokenID string) (*types.Receipt, error) {
+	if err := address.CheckAddress(to); err != nil {
+		return nil, err
+	}
+	item, err := acc.loadOwnedExecItem(from, execaddr, tokenID, true)
+	if err != nil {
+		return nil, err
+	}
+	current := *item
+	current.Addr = to
+	current.Frozen = false
+	return acc.saveExecItem(item, &current), nil
+}
@@ -0,0 +1,431 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 记录 NFT 的所有者和变更历史, 用于按所有者列出物品和查询物品的历史
+// 物品托管到执行器后, 所有者记为托管前的地址. 本地数据只能由交易所属的执行器写入,
+// 所以执行器内部的转移(如 trade 成交)由该执行器记录在自己的 nft 表中, 查询时以最后更新的记录为准
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+var optNFTTable = &table.Option{
+	Prefix:  "LODB-token",
+	Name:    "nft",
+	Primary: "nft",
+	Index: []string{
+		"owner",
+		"owner_symbol",
+	},
+}
+
+var optNFTLogsTable = &table.Option{
+	Prefix:  "LODB-token",
+	Name:    "nftlogs",
+	Primary: "txIndex",
+	Index: []string{
+		"nft",
+	},
+}
+
+// 会在内部转移 NFT 并记录持有人的执行器
+var nftHolderExecs = []string{"trade"}
+
+func nftIndex(symbol, tokenID string) []byte {
+	return []byte(fmt.Sprintf("%s-%s", symbol, tokenID))
+}
+
+// NFTRow row
+type NFTRow struct {
+	*pty.NFTItem
+}
+
+// NewNFTRow create row
+func NewNFTRow() *NFTRow {
+	return &NFTRow{NFTItem: nil}
+}
+
+// CreateRow create row
+func (r *NFTRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.NFTItem{}}
+}
+
+// SetPayload set payload
+func (r *NFTRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.NFTItem); ok {
+		r.NFTItem = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get get index key
+func (r *NFTRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "nft":
+		return nftIndex(r.Symbol, r.TokenID), nil
+	case "owner":
+		return []byte(r.Owner), nil
+	case "owner_symbol":
+		return []byte(fmt.Sprintf("%s:%s", r.Owner, r.Symbol)), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+// NewNFTTable create table
+func NewNFTTable(kvdb dbm.KV) *table.Table {
+	return newNFTTable(kvdb, optNFTTable)
+}
+
+// NewNFTExecTable 执行器记录内部转移后持有人的 nft 表, 和 token 的 nft 表结构相同
+func NewNFTExecTable(kvdb dbm.KV, execName string) *table.Table {
+	opt := *optNFTTable
+	opt.Prefix = "LODB-" + execName
+	return newNFTTable(kvdb, &opt)
+}
+
+func newNFTTable(kvdb dbm.KV, opt *table.Option) *table.Table {
+	rowMeta := NewNFTRow()
+	err := rowMeta.SetPayload(&pty.NFTItem{})
+	if err != nil {
+		panic(err)
+	}
+	t, err := table.NewTable(rowMeta, kvdb, opt)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// NFTLogsRow row
+type NFTLogsRow struct {
+	*pty.LocalNFTLog
+}
+
+// NewNFTLogsRow create row
+func NewNFTLogsRow() *NFTLogsRow {
+	return &NFTLogsRow{LocalNFTLog: nil}
+}
+
+// CreateRow create row
+func (r *NFTLogsRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.LocalNFTLog{}}
+}
+
+// SetPayload set payload
+func (r *NFTLogsRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.LocalNFTLog); ok {
+		r.LocalNFTLog = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get get index key
+func (r *NFTLogsRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "txIndex":
+		return []byte(r.TxIndex), nil
+	case "nft":
+		return nftIndex(r.Symbol, r.TokenID), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+// NewNFTLogsTable create table
+func NewNFTLogsTable(kvdb dbm.KV) *table.Table {
+	rowMeta := NewNFTLogsRow()
+	err := rowMeta.SetPayload(&pty.LocalNFTLog{})
+	if err != nil {
+		panic(err)
+	}
+	t, err := table.NewTable(rowMeta, kvdb, optNFTLogsTable)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// 一笔交易中可能有多条日志修改同一个物品(如托管), 先在 items 中合并再写入表格
+func loadNFTRow(nftTable *table.Table, items map[string]*pty.NFTItem, symbol, tokenID string) (*pty.NFTItem, error) {
+	index := string(nftIndex(symbol, tokenID))
+	if item, ok := items[index]; ok {
+		return item, nil
+	}
+	row, err := nftTable.GetData([]byte(index))
+	if err != nil {
+		return nil, err
+	}
+	item, ok := row.Data.(*pty.NFTItem)
+	if !ok {
+		return nil, types.ErrTypeAsset
+	}
+	items[index] = item
+	return item, nil
+}
+
+func updateNFTRows(nftTable *table.Table, items map[string]*pty.NFTItem) error {
+	for index, item := range items {
+		var err error
+		if item.Owner == "" {
+			err = nftTable.Del([]byte(index))
+		} else {
+			err = nftTable.Replace(item)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NFTMint/NFTBurn 同时更新 token 的总量
+func (t *token) updateNFTTotal(symbol string, delta int64) ([]*types.KeyValue, error) {
+	info, err := t.getTokenInfo(symbol)
+	if err != nil {
+		return nil, err
+	}
+	localToken := info.(*pty.LocalToken)
+	localToken.Total += delta
+	key := calcTokenStatusKeyLocal(symbol, localToken.Owner, pty.TokenStatusCreated)
+	return []*types.KeyValue{{Key: key, Value: types.Encode(localToken)}}, nil
+}
+
+func (t *token) execLocalNFT(tx *types.Transaction, receiptData *types.ReceiptData, index int, actionType int32, symbol, tokenID, to string) (*types.LocalDBSet, error) {
+	if receiptData.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	var set []*types.KeyValue
+	nftTable := NewNFTTable(t.GetLocalDB())
+	items := make(map[string]*pty.NFTItem)
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	for _, log := range receiptData.Logs {
+		switch log.Ty {
+		case pty.TyLogNFTMint, pty.TyLogNFTTransfer, pty.TyLogNFTBurn:
+			var receipt pty.ReceiptNFT
+			if err := types.Decode(log.Log, &receipt); err != nil {
+				return nil, err
+			}
+			current := *receipt.Current
+			items[string(nftIndex(current.Symbol, current.TokenID))] = &current
+			if log.Ty == pty.TyLogNFTMint || log.Ty == pty.TyLogNFTBurn {
+				delta := types.TokenPrecision
+				if log.Ty == pty.TyLogNFTBurn {
+					delta = -delta
+				}
+				kv, err := t.updateNFTTotal(receipt.Current.Symbol, delta)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
+		case pty.TyLogNFTExecItem:
+			var receipt pty.ReceiptNFTExecItem
+			if err := types.Decode(log.Log, &receipt); err != nil {
+				return nil, err
+			}
+			item, err := loadNFTRow(nftTable, items, receipt.Current.Symbol, receipt.Current.TokenID)
+			if err != nil {
+				return nil, err
+			}
+			if receipt.Current.Addr == "" {
+				item.ExecAddr = ""
+			} else {
+				item.Owner = receipt.Current.Addr
+				item.ExecAddr = receipt.Current.ExecAddr
+			}
+		}
+	}
+	for _, item := range items {
+		item.TxIndex = txIndex
+	}
+	if err := updateNFTRows(nftTable, items); err != nil {
+		return nil, err
+	}
+	kv, err := nftTable.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+
+	logsTable := NewNFTLogsTable(t.GetLocalDB())
+	err = logsTable.Add(&pty.LocalNFTLog{
+		Symbol:     symbol,
+		TokenID:    tokenID,
+		TxIndex:    txIndex,
+		ActionType: actionType,
+		From:       tx.From(),
+		To:         to,
+		TxHash:     "0x" + hex.EncodeToString(tx.Hash()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	kv, err = logsTable.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kv...)
+	return &types.LocalDBSet{KV: t.AddRollbackKV(tx, tx.Execer, set)}, nil
+}
+
+func (t *token) execDelLocalNFT(tx *types.Transaction) (*types.LocalDBSet, error) {
+	kvs, err := t.DelRollbackKV(tx, tx.Execer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+// NFTExecLocal 执行器内部转移物品后, 在执行器自己的 nft 表中记录新的持有人, 返回需要写入本地数据的 kv
+func NFTExecLocal(kvdb dbm.KV, execName, txIndex string, logs []*types.ReceiptLog) ([]*types.KeyValue, error) {
+	nftTable := NewNFTTable(kvdb)
+	execTable := NewNFTExecTable(kvdb, execName)
+	for _, log := range logs {
+		if log.Ty != pty.TyLogNFTExecItem {
+			continue
+		}
+		var receipt pty.ReceiptNFTExecItem
+		if err := types.Decode(log.Log, &receipt); err != nil {
+			return nil, err
+		}
+		// 托管、冻结和解冻不改变持有人, 提取由 token 交易记录
+		if receipt.Prev == nil || receipt.Current.Addr == "" || receipt.Prev.Addr == receipt.Current.Addr {
+			continue
+		}
+		row, err := nftTable.GetData(nftIndex(receipt.Current.Symbol, receipt.Current.TokenID))
+		if err != nil {
+			return nil, err
+		}
+		item := *row.Data.(*pty.NFTItem)
+		item.Owner = receipt.Current.Addr
+		item.ExecAddr = receipt.Current.ExecAddr
+		item.TxIndex = txIndex
+		if err := execTable.Replace(&item); err != nil {
+			return nil, err
+		}
+	}
+	return execTable.Save()
+}
+
+// 取 token 和执行器 nft 表中最后更新的记录, token 表中已经删除(销毁)的物品返回 nil
+func latestNFTItem(tables []*table.Table, primary []byte) (*pty.NFTItem, error) {
+	var latest *pty.NFTItem
+	for i, tab := range tables {
+		row, err := tab.GetData(primary)
+		if err == types.ErrNotFound {
+			if i == 0 {
+				return nil, nil
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		item, ok := row.Data.(*pty.NFTItem)
+		if !ok {
+			return nil, types.ErrTypeAsset
+		}
+		if latest == nil || item.TxIndex > latest.TxIndex {
+			latest = item
+		}
+	}
+	return latest, nil
+}
+
+func listNFTs(db dbm.KVDB, req *pty.ReqNFTsOfOwner) (*pty.ReplyNFTs, error) {
+	if req.Owner == "" {
+		return nil, types.ErrInvalidParam
+	}
+	cur := &NFTRow{NFTItem: &pty.NFTItem{Owner: req.Owner, Symbol: req.Symbol}}
+	indexName := "owner"
+	if req.Symbol != "" {
+		indexName = "owner_symbol"
+	}
+	index, err := cur.Get(indexName)
+	if err != nil {
+		return nil, err
+	}
+	var primary []byte
+	if len(req.Primary) > 0 {
+		primary = []byte(req.Primary)
+	}
+	tables := []*table.Table{NewNFTTable(db)}
+	for _, execName := range nftHolderExecs {
+		tables = append(tables, NewNFTExecTable(db, execName))
+	}
+
+	// 各个表中的记录可能已经过时, 按物品取最后更新的记录, 所有者一致时才列出
+	var items []*pty.NFTItem
+	found := make(map[string]bool)
+	for _, tab := range tables {
+		rows, err := tab.GetQuery(db).ListIndex(indexName, index, primary, req.Count, req.Direction)
+		if err == types.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			tokenlog.Error("listNFTs", "owner", req.Owner, "symbol", req.Symbol, "err", err)
+			return nil, err
+		}
+		for _, row := range rows {
+			key := row.Primary
+			if found[string(key)] {
+				continue
+			}
+			item, err := latestNFTItem(tables, key)
+			if err != nil {
+				return nil, err
+			}
+			if item == nil || item.Owner != req.Owner {
+				continue
+			}
+			found[string(key)] = true
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		return nil, types.ErrNotFound
+	}
+	sort.Slice(items, func(i, j int) bool {
+		less := string(nftIndex(items[i].Symbol, items[i].TokenID)) < string(nftIndex(items[j].Symbol, items[j].TokenID))
+		if req.Direction == dbm.ListASC {
+			return less
+		}
+		return !less
+	})
+	if req.Count > 0 && len(items) > int(req.Count) {
+		items = items[:req.Count]
+	}
+	return &pty.ReplyNFTs{Items: items}, nil
+}
+
+func listNFTLogs(db dbm.KVDB, req *pty.ReqNFT) (*pty.ReplyNFTLogs, error) {
+	query := NewNFTLogsTable(db).GetQuery(db)
+	rows, err := query.ListIndex("nft", nftIndex(req.Symbol, req.TokenID), nil, -1, 0)
+	if err != nil {
+		tokenlog.Error("listNFTLogs", "symbol", req.Symbol, "tokenID", req.TokenID, "err", err)
+		return nil, err
+	}
+	var reply pty.ReplyNFTLogs
+	for _, row := range rows {
+		log, ok := row.Data.(*pty.LocalNFTLog)
+		if !ok {
+			return nil, types.ErrTypeAsset
+		}
+		reply.Logs = append(reply.Logs, log)
+	}
+	return &reply, nil
+}
@@ -91,3 +91,36 @@ func (t *token) Query_GetTokenHistory(in *types.ReqString) (types.Message, error
 	}
 	return &replys, nil
 }
+
+// Query_GetNFTOwner 获取 NFT 物品及其所有者, 托管在执行器中时返回执行器中的所有者
+func (t *token) Query_GetNFTOwner(in *tokenty.ReqNFT) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	item, err := getNFT(t.GetStateDB(), in.Symbol, in.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	execItem, err := NewNFTAccountDB(t.GetStateDB(), in.Symbol).LoadExecItem(item.Owner, in.TokenID)
+	if err == nil {
+		item.ExecAddr = execItem.ExecAddr
+		item.Owner = execItem.Addr
+	}
+	return item, nil
+}
+
+// Query_GetNFTsOfOwner 获取地址拥有的 NFT 物品
+func (t *token) Query_GetNFTsOfOwner(in *tokenty.ReqNFTsOfOwner) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return listNFTs(t.GetLocalDB(), in)
+}
+
+// Query_GetNFTHistory 获取 NFT 物品的变更历史
+func (t *token) Query_GetNFTHistory(in *tokenty.ReqNFT) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return listNFTLogs(t.GetLocalDB(), in)
+}
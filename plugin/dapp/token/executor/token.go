@@ -20,6 +20,8 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
+	certexec "github.com/33cn/plugin/plugin/dapp/cert/executor"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 	"github.com/pkg/errors"
 )
@@ -76,9 +78,9 @@ func (t *token) GetDriverName() string {
 	return driverName
 }
 
-// CheckTx ...
+// CheckTx 证书签名的链上按权限策略校验发送者的证书属性
 func (t *token) CheckTx(tx *types.Transaction, index int) error {
-	return nil
+	return certexec.CheckPolicy(t.GetAPI().GetConfig(), t.GetStateDB(), t.GetHeight(), tx)
 }
 
 func (t *token) queryTokenAssetsKey(addr string) (*types.ReplyStrings, error) {
@@ -347,3 +349,11 @@ func (t *token) getTxByToken(req *tokenty.ReqTokenTx) (types.Message, error) {
 func (t *token) CheckReceiptExecOk() bool {
 	return true
 }
+
+// IsFriend 多重签名账户通过MultiSigSubmitTx调用token合约时，允许写入token合约的数据
+func (t *token) IsFriend(myexec, writekey []byte, othertx *types.Transaction) bool {
+	if string(myexec) != t.GetDriverName() {
+		return false
+	}
+	return mty.IsSubmitTxFriend(t.GetDriverName(), writekey, othertx)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
+	ct "github.com/33cn/plugin/plugin/dapp/cert/types"
 	pty "github.com/33cn/plugin/plugin/dapp/token/types"
 	"github.com/stretchr/testify/assert"
 
@@ -18,6 +19,7 @@ import (
 	"strings"
 
 	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -284,6 +286,39 @@ func TestToken(t *testing.T) {
 	t.Log(reply.TokenAssets)
 }
 
+func TestTokenCertPolicy(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(ct.CertX, ct.ForkCertPolicy, 10)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	policy := &ct.CertPolicy{Execer: pty.TokenX, Action: "TokenPreCreate", Ous: []string{"issuer"}}
+	stateDB.Set([]byte("mavl-cert-policy-token-tokenprecreate"), types.Encode(policy))
+
+	exec := newToken()
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+
+	newTx := func(action *pty.TokenAction) *types.Transaction {
+		tx := &types.Transaction{Execer: []byte(pty.TokenX), Payload: types.Encode(action), To: address.ExecAddress(pty.TokenX)}
+		tx, err := signTx(tx, PrivKeyA)
+		assert.Nil(t, err)
+		return tx
+	}
+	p1 := &pty.TokenPreCreate{Name: Symbol, Symbol: Symbol, Total: 1e8, Owner: string(Nodes[0])}
+	createTx := newTx(&pty.TokenAction{Ty: pty.TokenActionPreCreate, Value: &pty.TokenAction_TokenPreCreate{TokenPreCreate: p1}})
+	transfer := &types.AssetsTransfer{Cointoken: Symbol, Amount: 1, To: string(Nodes[1])}
+	transferTx := newTx(&pty.TokenAction{Ty: pty.ActionTransfer, Value: &pty.TokenAction_Transfer{Transfer: transfer}})
+
+	//分叉之前不校验
+	exec.SetEnv(9, 1539918074, 0)
+	assert.Nil(t, exec.CheckTx(createTx, 0))
+	//有策略的action要求证书签名，其他action不受影响
+	exec.SetEnv(10, 1539918074, 0)
+	assert.Equal(t, ct.ErrCertPermission, errors.Cause(exec.CheckTx(createTx, 0)))
+	assert.Nil(t, exec.CheckTx(transferTx, 0))
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName(pty.TokenX, signType))
@@ -15,6 +15,7 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 	pty "github.com/33cn/plugin/plugin/dapp/token/types"
 )
 
@@ -140,7 +141,7 @@ type tokenAction struct {
 
 func newTokenAction(t *token, toaddr string, tx *types.Transaction) *tokenAction {
 	hash := tx.Hash()
-	fromaddr := tx.From()
+	fromaddr := mty.GetTxFrom(tx)
 	return &tokenAction{t.GetCoinsAccount(), t.GetStateDB(), hash, fromaddr, toaddr,
 		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
 }
@@ -157,6 +158,16 @@ func (action *tokenAction) preCreate(token *pty.TokenPreCreate) (*types.Receipt,
 		return nil, pty.ErrTokenIntroLen
 	} else if len(token.GetSymbol()) > pty.TokenSymbolLenLimit {
 		return nil, pty.ErrTokenSymbolLen
+	}
+	// ForkTokenNFTX 之前忽略 NFT 位, 和之前的版本一样按同质化 token 处理
+	if token.Category&pty.CategoryNFT != 0 && cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenNFTX) {
+		if token.Category&pty.CategoryMintBurnSupport != 0 {
+			return nil, pty.ErrTokenNFTCategory
+		}
+		// NFT 的总量由铸造的物品决定
+		if token.GetTotal() != 0 {
+			return nil, pty.ErrTokenTotalOverflow
+		}
 	} else if token.GetTotal() > types.MaxTokenBalance || token.GetTotal() <= 0 {
 		return nil, pty.ErrTokenTotalOverflow
 	}
@@ -281,9 +292,20 @@ func (action *tokenAction) finishCreate(tokenFinish *pty.TokenFinishCreate) (*ty
 		return nil, err
 	}
 	tokenlog.Debug("finishCreate", "token.Owner", token.Owner, "token.GetTotal()", token.GetTotal())
-	receiptForToken, err := tokenAccount.GenesisInit(token.Owner, token.GetTotal())
-	if err != nil {
-		return nil, err
+	receiptForToken := &types.Receipt{}
+	// NFT 没有同质化的额度, 物品由 owner 铸造
+	// fork 之前带 NFT 位预创建的 token 的 total 大于 0, 仍按同质化 token 创建
+	if token.Category&pty.CategoryNFT != 0 && token.GetTotal() == 0 {
+		nftKV := &types.KeyValue{Key: calcNFTTokenKey(token.Symbol), Value: []byte(token.Symbol)}
+		if err = action.db.Set(nftKV.Key, nftKV.Value); err != nil {
+			return nil, err
+		}
+		kv = append(kv, nftKV)
+	} else {
+		receiptForToken, err = tokenAccount.GenesisInit(token.Owner, token.GetTotal())
+		if err != nil {
+			return nil, err
+		}
 	}
 	//更新token的状态为已经创建
 	token.Status = pty.TokenStatusCreated
@@ -10,6 +10,7 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 )
 
@@ -17,7 +18,7 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 	cfg := t.GetAPI().GetConfig()
 	if (action.Ty == tokenty.ActionTransfer) && action.GetTransfer() != nil {
 		transfer := action.GetTransfer()
-		from := tx.From()
+		from := mty.GetTxFrom(tx)
 		//to 是 execs 合约地址
 		if drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) {
 			return accountDB.TransferToExec(from, tx.GetRealToAddr(), transfer.Amount)
@@ -28,7 +29,7 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 		if !cfg.IsFork(t.GetHeight(), "ForkWithdraw") {
 			withdraw.ExecName = ""
 		}
-		from := tx.From()
+		from := mty.GetTxFrom(tx)
 		//to 是 execs 合约地址
 		if drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) || isExecAddrMatch(withdraw.ExecName, tx.GetRealToAddr()) {
 			return accountDB.TransferWithdraw(from, tx.GetRealToAddr(), withdraw.Amount)
@@ -48,7 +49,7 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 			return nil, types.ErrActionNotSupport
 		}
 		transfer := action.GetTransferToExec()
-		from := tx.From()
+		from := mty.GetTxFrom(tx)
 		//to 是 execs 合约地址
 		if !isExecAddrMatch(transfer.ExecName, tx.GetRealToAddr()) {
 			return nil, types.ErrToAddrNotSameToExecAddr
@@ -85,7 +86,7 @@ func (t *token) ExecLocalTransWithdraw(tx *types.Transaction, receipt *types.Rec
 		kv, err = updateAddrReciver(t.GetLocalDB(), transfer.Cointoken, tx.GetRealToAddr(), transfer.Amount, true)
 	} else if action.Ty == tokenty.ActionWithdraw && action.GetWithdraw() != nil {
 		withdraw := action.GetWithdraw()
-		from := tx.From()
+		from := mty.GetTxFrom(tx)
 		kv, err = updateAddrReciver(t.GetLocalDB(), withdraw.Cointoken, from, withdraw.Amount, true)
 	} else if action.Ty == tokenty.ActionGenesis && action.GetGenesis() != nil {
 		gen := action.GetGenesis()
@@ -120,7 +121,7 @@ func (t *token) ExecDelLocalLocalTransWithdraw(tx *types.Transaction, receipt *t
 		kv, err = updateAddrReciver(t.GetLocalDB(), transfer.Cointoken, tx.GetRealToAddr(), transfer.Amount, false)
 	} else if action.Ty == tokenty.ActionWithdraw && action.GetWithdraw() != nil {
 		withdraw := action.GetWithdraw()
-		from := tx.From()
+		from := mty.GetTxFrom(tx)
 		kv, err = updateAddrReciver(t.GetLocalDB(), withdraw.Cointoken, from, withdraw.Amount, false)
 	} else if action.Ty == tokenty.TokenActionTransferToExec && action.GetTransferToExec() != nil {
 		transfer := action.GetTransferToExec()
@@ -149,3 +149,53 @@ func (c *Jrpc) CreateRawTokenBurnTx(param *tokenty.TokenBurn, result *interface{
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawTokenNFTMintTx 创建未签名的铸造 NFT 交易
+func (c *Jrpc) CreateRawTokenNFTMintTx(param *tokenty.NFTMint, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.TokenID == "" {
+		return types.ErrInvalidParam
+	}
+	return c.createRawNFTTx("NFTMint", param, result)
+}
+
+// CreateRawTokenNFTTransferTx 创建未签名的 NFT 转账交易
+func (c *Jrpc) CreateRawTokenNFTTransferTx(param *tokenty.NFTTransfer, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.TokenID == "" || param.To == "" {
+		return types.ErrInvalidParam
+	}
+	return c.createRawNFTTx("NFTTransfer", param, result)
+}
+
+// CreateRawTokenNFTBurnTx 创建未签名的销毁 NFT 交易
+func (c *Jrpc) CreateRawTokenNFTBurnTx(param *tokenty.NFTBurn, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.TokenID == "" {
+		return types.ErrInvalidParam
+	}
+	return c.createRawNFTTx("NFTBurn", param, result)
+}
+
+// CreateRawTokenNFTTransferToExecTx 创建未签名的 NFT 托管到执行器的交易
+func (c *Jrpc) CreateRawTokenNFTTransferToExecTx(param *tokenty.NFTTransferToExec, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.TokenID == "" || param.ExecName == "" {
+		return types.ErrInvalidParam
+	}
+	return c.createRawNFTTx("NFTTransferToExec", param, result)
+}
+
+// CreateRawTokenNFTWithdrawTx 创建未签名的从执行器提取 NFT 的交易
+func (c *Jrpc) CreateRawTokenNFTWithdrawTx(param *tokenty.NFTWithdraw, result *interface{}) error {
+	if param == nil || param.Symbol == "" || param.TokenID == "" || param.ExecName == "" {
+		return types.ErrInvalidParam
+	}
+	return c.createRawNFTTx("NFTWithdraw", param, result)
+}
+
+func (c *Jrpc) createRawNFTTx(action string, param types.Message, result *interface{}) error {
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), action, param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
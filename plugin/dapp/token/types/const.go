@@ -23,6 +23,16 @@ const (
 	TokenActionMint = 12
 	// TokenActionBurn for token burn
 	TokenActionBurn = 13
+	// TokenActionNFTMint for nft mint
+	TokenActionNFTMint = 14
+	// TokenActionNFTTransfer for nft transfer
+	TokenActionNFTTransfer = 15
+	// TokenActionNFTBurn for nft burn
+	TokenActionNFTBurn = 16
+	// TokenActionNFTTransferToExec for nft transfer to exec
+	TokenActionNFTTransferToExec = 17
+	// TokenActionNFTWithdraw for nft withdraw from exec
+	TokenActionNFTWithdraw = 18
 )
 
 // token status
@@ -49,6 +59,8 @@ var (
 	ForkTokenSymbolWithNumberX = "ForkTokenSymbolWithNumber"
 	// ForkTokenCheckX  fork check impl bug
 	ForkTokenCheckX = "ForkTokenCheck"
+	// ForkTokenNFTX fork const, support non-fungible token
+	ForkTokenNFTX = "ForkTokenNFT"
 )
 
 const (
@@ -82,6 +94,14 @@ const (
 	TyLogTokenMint = 323
 	// TyLogTokenBurn log for token burn
 	TyLogTokenBurn = 324
+	// TyLogNFTMint log for nft mint
+	TyLogNFTMint = 325
+	// TyLogNFTTransfer log for nft transfer
+	TyLogNFTTransfer = 326
+	// TyLogNFTBurn log for nft burn
+	TyLogNFTBurn = 327
+	// TyLogNFTExecItem log for nft exec item change
+	TyLogNFTExecItem = 328
 )
 
 const (
@@ -91,9 +111,17 @@ const (
 	TokenSymbolLenLimit = 16
 	// TokenIntroLenLimit token introduction length limit
 	TokenIntroLenLimit = 1024
+	// NFTTokenIDLenLimit nft token id length limit
+	NFTTokenIDLenLimit = 128
+	// NFTURILenLimit nft uri length limit
+	NFTURILenLimit = 1024
+	// NFTMetaHashLenLimit nft meta hash length limit
+	NFTMetaHashLenLimit = 128
 )
 
 const (
 	// CategoryMintBurnSupport support mint & burn
 	CategoryMintBurnSupport = 1 << iota
+	// CategoryNFT non-fungible token, each item is identified by token id
+	CategoryNFT
 )
@@ -35,4 +35,24 @@ var (
 	ErrTokenBlacklist = errors.New("ErrTokenBlacklist")
 	// ErrTokenNotExist error token symbol not exist
 	ErrTokenNotExist = errors.New("ErrTokenSymbolNotExist")
+	// ErrTokenNotNFT error token is not nft category
+	ErrTokenNotNFT = errors.New("ErrTokenNotNFT")
+	// ErrTokenNFTCategory error nft category can not be combined with other category
+	ErrTokenNFTCategory = errors.New("ErrTokenNFTCategory")
+	// ErrNFTTokenIDLen error nft token id length
+	ErrNFTTokenIDLen = errors.New("ErrNFTTokenIDLength")
+	// ErrNFTURILen error nft uri length
+	ErrNFTURILen = errors.New("ErrNFTURILength")
+	// ErrNFTMetaHashLen error nft meta hash length
+	ErrNFTMetaHashLen = errors.New("ErrNFTMetaHashLength")
+	// ErrNFTExist error nft token id exist already
+	ErrNFTExist = errors.New("ErrNFTExistAlready")
+	// ErrNFTNotExist error nft token id not exist
+	ErrNFTNotExist = errors.New("ErrNFTNotExist")
+	// ErrNFTOwner error nft owner not match
+	ErrNFTOwner = errors.New("ErrNFTOwnerNotMatch")
+	// ErrNFTFrozen error nft is frozen in exec
+	ErrNFTFrozen = errors.New("ErrNFTFrozen")
+	// ErrNFTNotFrozen error nft is not frozen in exec
+	ErrNFTNotFrozen = errors.New("ErrNFTNotFrozen")
 )
@@ -38,6 +38,11 @@ type TokenAction struct {
 	//	*TokenAction_TransferToExec
 	//	*TokenAction_TokenMint
 	//	*TokenAction_TokenBurn
+	//	*TokenAction_NFTMint
+	//	*TokenAction_NFTTransfer
+	//	*TokenAction_NFTBurn
+	//	*TokenAction_NFTTransferToExec
+	//	*TokenAction_NFTWithdraw
 	Value                isTokenAction_Value `protobuf_oneof:"value"`
 	Ty                   int32               `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -110,6 +115,26 @@ type TokenAction_TokenBurn struct {
 	TokenBurn *TokenBurn `protobuf:"bytes,10,opt,name=tokenBurn,proto3,oneof"`
 }
 
+type TokenAction_NFTMint struct {
+	NFTMint *NFTMint `protobuf:"bytes,11,opt,name=NFTMint,proto3,oneof"`
+}
+
+type TokenAction_NFTTransfer struct {
+	NFTTransfer *NFTTransfer `protobuf:"bytes,12,opt,name=NFTTransfer,proto3,oneof"`
+}
+
+type TokenAction_NFTBurn struct {
+	NFTBurn *NFTBurn `protobuf:"bytes,13,opt,name=NFTBurn,proto3,oneof"`
+}
+
+type TokenAction_NFTTransferToExec struct {
+	NFTTransferToExec *NFTTransferToExec `protobuf:"bytes,14,opt,name=NFTTransferToExec,proto3,oneof"`
+}
+
+type TokenAction_NFTWithdraw struct {
+	NFTWithdraw *NFTWithdraw `protobuf:"bytes,15,opt,name=NFTWithdraw,proto3,oneof"`
+}
+
 func (*TokenAction_TokenPreCreate) isTokenAction_Value() {}
 
 func (*TokenAction_TokenFinishCreate) isTokenAction_Value() {}
@@ -128,6 +153,16 @@ func (*TokenAction_TokenMint) isTokenAction_Value() {}
 
 func (*TokenAction_TokenBurn) isTokenAction_Value() {}
 
+func (*TokenAction_NFTMint) isTokenAction_Value() {}
+
+func (*TokenAction_NFTTransfer) isTokenAction_Value() {}
+
+func (*TokenAction_NFTBurn) isTokenAction_Value() {}
+
+func (*TokenAction_NFTTransferToExec) isTokenAction_Value() {}
+
+func (*TokenAction_NFTWithdraw) isTokenAction_Value() {}
+
 func (m *TokenAction) GetValue() isTokenAction_Value {
 	if m != nil {
 		return m.Value
@@ -198,6 +233,41 @@ func (m *TokenAction) GetTokenBurn() *TokenBurn {
 	return nil
 }
 
+func (m *TokenAction) GetNFTMint() *NFTMint {
+	if x, ok := m.GetValue().(*TokenAction_NFTMint); ok {
+		return x.NFTMint
+	}
+	return nil
+}
+
+func (m *TokenAction) GetNFTTransfer() *NFTTransfer {
+	if x, ok := m.GetValue().(*TokenAction_NFTTransfer); ok {
+		return x.NFTTransfer
+	}
+	return nil
+}
+
+func (m *TokenAction) GetNFTBurn() *NFTBurn {
+	if x, ok := m.GetValue().(*TokenAction_NFTBurn); ok {
+		return x.NFTBurn
+	}
+	return nil
+}
+
+func (m *TokenAction) GetNFTTransferToExec() *NFTTransferToExec {
+	if x, ok := m.GetValue().(*TokenAction_NFTTransferToExec); ok {
+		return x.NFTTransferToExec
+	}
+	return nil
+}
+
+func (m *TokenAction) GetNFTWithdraw() *NFTWithdraw {
+	if x, ok := m.GetValue().(*TokenAction_NFTWithdraw); ok {
+		return x.NFTWithdraw
+	}
+	return nil
+}
+
 func (m *TokenAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -217,6 +287,11 @@ func (*TokenAction) XXX_OneofWrappers() []interface{} {
 		(*TokenAction_TransferToExec)(nil),
 		(*TokenAction_TokenMint)(nil),
 		(*TokenAction_TokenBurn)(nil),
+		(*TokenAction_NFTMint)(nil),
+		(*TokenAction_NFTTransfer)(nil),
+		(*TokenAction_NFTBurn)(nil),
+		(*TokenAction_NFTTransferToExec)(nil),
+		(*TokenAction_NFTWithdraw)(nil),
 	}
 }
 
@@ -496,6 +571,315 @@ func (m *TokenBurn) GetAmount() int64 {
 	return 0
 }
 
+// 非同质化token, 每个tokenID 对应唯一的一件物品
+type NFTMint struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Uri                  string   `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	MetaHash             string   `protobuf:"bytes,5,opt,name=metaHash,proto3" json:"metaHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NFTMint) Reset()         { *m = NFTMint{} }
+func (m *NFTMint) String() string { return proto.CompactTextString(m) }
+func (*NFTMint) ProtoMessage()    {}
+func (*NFTMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{6}
+}
+
+func (m *NFTMint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NFTMint.Unmarshal(m, b)
+}
+func (m *NFTMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NFTMint.Marshal(b, m, deterministic)
+}
+func (m *NFTMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTMint.Merge(m, src)
+}
+func (m *NFTMint) XXX_Size() int {
+	return xxx_messageInfo_NFTMint.Size(m)
+}
+func (m *NFTMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTMint proto.InternalMessageInfo
+
+func (m *NFTMint) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NFTMint) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NFTMint) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *NFTMint) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *NFTMint) GetMetaHash() string {
+	if m != nil {
+		return m.MetaHash
+	}
+	return ""
+}
+
+type NFTTransfer struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NFTTransfer) Reset()         { *m = NFTTransfer{} }
+func (m *NFTTransfer) String() string { return proto.CompactTextString(m) }
+func (*NFTTransfer) ProtoMessage()    {}
+func (*NFTTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{7}
+}
+
+func (m *NFTTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NFTTransfer.Unmarshal(m, b)
+}
+func (m *NFTTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NFTTransfer.Marshal(b, m, deterministic)
+}
+func (m *NFTTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTTransfer.Merge(m, src)
+}
+func (m *NFTTransfer) XXX_Size() int {
+	return xxx_messageInfo_NFTTransfer.Size(m)
+}
+func (m *NFTTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTTransfer proto.InternalMessageInfo
+
+func (m *NFTTransfer) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NFTTransfer) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NFTTransfer) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *NFTTransfer) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type NFTBurn struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NFTBurn) Reset()         { *m = NFTBurn{} }
+func (m *NFTBurn) String() string { return proto.CompactTextString(m) }
+func (*NFTBurn) ProtoMessage()    {}
+func (*NFTBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{8}
+}
+
+func (m *NFTBurn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NFTBurn.Unmarshal(m, b)
+}
+func (m *NFTBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NFTBurn.Marshal(b, m, deterministic)
+}
+func (m *NFTBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTBurn.Merge(m, src)
+}
+func (m *NFTBurn) XXX_Size() int {
+	return xxx_messageInfo_NFTBurn.Size(m)
+}
+func (m *NFTBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTBurn proto.InternalMessageInfo
+
+func (m *NFTBurn) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NFTBurn) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+// 把NFT托管到其他执行器(如trade), 由执行器记录实际的所有者
+type NFTTransferToExec struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	ExecName             string   `protobuf:"bytes,3,opt,name=execName,proto3" json:"execName,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NFTTransferToExec) Reset()         { *m = NFTTransferToExec{} }
+func (m *NFTTransferToExec) String() string { return proto.CompactTextString(m) }
+func (*NFTTransferToExec) ProtoMessage()    {}
+func (*NFTTransferToExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{9}
+}
+
+func (m *NFTTransferToExec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NFTTransferToExec.Unmarshal(m, b)
+}
+func (m *NFTTransferToExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NFTTransferToExec.Marshal(b, m, deterministic)
+}
+func (m *NFTTransferToExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTTransferToExec.Merge(m, src)
+}
+func (m *NFTTransferToExec) XXX_Size() int {
+	return xxx_messageInfo_NFTTransferToExec.Size(m)
+}
+func (m *NFTTransferToExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTTransferToExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTTransferToExec proto.InternalMessageInfo
+
+func (m *NFTTransferToExec) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NFTTransferToExec) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NFTTransferToExec) GetExecName() string {
+	if m != nil {
+		return m.ExecName
+	}
+	return ""
+}
+
+func (m *NFTTransferToExec) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type NFTWithdraw struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	ExecName             string   `protobuf:"bytes,3,opt,name=execName,proto3" json:"execName,omitempty"`
+	Note                 string   `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NFTWithdraw) Reset()         { *m = NFTWithdraw{} }
+func (m *NFTWithdraw) String() string { return proto.CompactTextString(m) }
+func (*NFTWithdraw) ProtoMessage()    {}
+func (*NFTWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{10}
+}
+
+func (m *NFTWithdraw) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NFTWithdraw.Unmarshal(m, b)
+}
+func (m *NFTWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NFTWithdraw.Marshal(b, m, deterministic)
+}
+func (m *NFTWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTWithdraw.Merge(m, src)
+}
+func (m *NFTWithdraw) XXX_Size() int {
+	return xxx_messageInfo_NFTWithdraw.Size(m)
+}
+func (m *NFTWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTWithdraw.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTWithdraw proto.InternalMessageInfo
+
+func (m *NFTWithdraw) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NFTWithdraw) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NFTWithdraw) GetExecName() string {
+	if m != nil {
+		return m.ExecName
+	}
+	return ""
+}
+
+func (m *NFTWithdraw) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
 // state db
 type Token struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -516,7 +900,7 @@ func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{6}
+	return fileDescriptor_3aff0bcd502840ab, []int{11}
 }
 
 func (m *Token) XXX_Unmarshal(b []byte) error {
@@ -565,39 +949,217 @@ func (m *Token) GetTotal() int64 {
 	return 0
 }
 
-func (m *Token) GetPrice() int64 {
+func (m *Token) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Token) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Token) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Token) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Token) GetCategory() int32 {
+	if m != nil {
+		return m.Category
+	}
+	return 0
+}
+
+// NFT 物品, owner 为执行器地址时表示物品托管在该执行器中
+type NFTItem struct {
+	Symbol   string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID  string `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	Owner    string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Uri      string `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	MetaHash string `protobuf:"bytes,5,opt,name=metaHash,proto3" json:"metaHash,omitempty"`
+	Creator  string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+	Height   int64  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	// 托管在执行器中时, owner 为托管前的所有者, execAddr 为执行器地址, 只在本地数据和查询结果中设置
+	ExecAddr string `protobuf:"bytes,8,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
+	// 本地记录最后一次更新的交易位置, 只在本地数据中设置
+	TxIndex              string   `protobuf:"bytes,9,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NFTItem) Reset()         { *m = NFTItem{} }
+func (m *NFTItem) String() string { return proto.CompactTextString(m) }
+func (*NFTItem) ProtoMessage()    {}
+func (*NFTItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{12}
+}
+
+func (m *NFTItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NFTItem.Unmarshal(m, b)
+}
+func (m *NFTItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NFTItem.Marshal(b, m, deterministic)
+}
+func (m *NFTItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTItem.Merge(m, src)
+}
+func (m *NFTItem) XXX_Size() int {
+	return xxx_messageInfo_NFTItem.Size(m)
+}
+func (m *NFTItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTItem proto.InternalMessageInfo
+
+func (m *NFTItem) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *NFTItem) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *NFTItem) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *NFTItem) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *NFTItem) GetMetaHash() string {
+	if m != nil {
+		return m.MetaHash
+	}
+	return ""
+}
+
+func (m *NFTItem) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *NFTItem) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *NFTItem) GetExecAddr() string {
+	if m != nil {
+		return m.ExecAddr
+	}
+	return ""
+}
+
+func (m *NFTItem) GetTxIndex() string {
+	if m != nil {
+		return m.TxIndex
+	}
+	return ""
+}
+
+// NFT 在执行器中的托管记录
+type NFTExecItem struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	Addr                 string   `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	ExecAddr             string   `protobuf:"bytes,4,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
+	Frozen               bool     `protobuf:"varint,5,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NFTExecItem) Reset()         { *m = NFTExecItem{} }
+func (m *NFTExecItem) String() string { return proto.CompactTextString(m) }
+func (*NFTExecItem) ProtoMessage()    {}
+func (*NFTExecItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{13}
+}
+
+func (m *NFTExecItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NFTExecItem.Unmarshal(m, b)
+}
+func (m *NFTExecItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NFTExecItem.Marshal(b, m, deterministic)
+}
+func (m *NFTExecItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTExecItem.Merge(m, src)
+}
+func (m *NFTExecItem) XXX_Size() int {
+	return xxx_messageInfo_NFTExecItem.Size(m)
+}
+func (m *NFTExecItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTExecItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTExecItem proto.InternalMessageInfo
+
+func (m *NFTExecItem) GetSymbol() string {
 	if m != nil {
-		return m.Price
+		return m.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (m *Token) GetOwner() string {
+func (m *NFTExecItem) GetTokenID() string {
 	if m != nil {
-		return m.Owner
+		return m.TokenID
 	}
 	return ""
 }
 
-func (m *Token) GetCreator() string {
+func (m *NFTExecItem) GetAddr() string {
 	if m != nil {
-		return m.Creator
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *Token) GetStatus() int32 {
+func (m *NFTExecItem) GetExecAddr() string {
 	if m != nil {
-		return m.Status
+		return m.ExecAddr
 	}
-	return 0
+	return ""
 }
 
-func (m *Token) GetCategory() int32 {
+func (m *NFTExecItem) GetFrozen() bool {
 	if m != nil {
-		return m.Category
+		return m.Frozen
 	}
-	return 0
+	return false
 }
 
 // log
@@ -614,7 +1176,7 @@ func (m *ReceiptToken) Reset()         { *m = ReceiptToken{} }
 func (m *ReceiptToken) String() string { return proto.CompactTextString(m) }
 func (*ReceiptToken) ProtoMessage()    {}
 func (*ReceiptToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{7}
+	return fileDescriptor_3aff0bcd502840ab, []int{14}
 }
 
 func (m *ReceiptToken) XXX_Unmarshal(b []byte) error {
@@ -668,7 +1230,7 @@ func (m *ReceiptTokenAmount) Reset()         { *m = ReceiptTokenAmount{} }
 func (m *ReceiptTokenAmount) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTokenAmount) ProtoMessage()    {}
 func (*ReceiptTokenAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{8}
+	return fileDescriptor_3aff0bcd502840ab, []int{15}
 }
 
 func (m *ReceiptTokenAmount) XXX_Unmarshal(b []byte) error {
@@ -703,6 +1265,100 @@ func (m *ReceiptTokenAmount) GetCurrent() *Token {
 	return nil
 }
 
+type ReceiptNFT struct {
+	Prev                 *NFTItem `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *NFTItem `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptNFT) Reset()         { *m = ReceiptNFT{} }
+func (m *ReceiptNFT) String() string { return proto.CompactTextString(m) }
+func (*ReceiptNFT) ProtoMessage()    {}
+func (*ReceiptNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{16}
+}
+
+func (m *ReceiptNFT) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptNFT.Unmarshal(m, b)
+}
+func (m *ReceiptNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptNFT.Marshal(b, m, deterministic)
+}
+func (m *ReceiptNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptNFT.Merge(m, src)
+}
+func (m *ReceiptNFT) XXX_Size() int {
+	return xxx_messageInfo_ReceiptNFT.Size(m)
+}
+func (m *ReceiptNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptNFT proto.InternalMessageInfo
+
+func (m *ReceiptNFT) GetPrev() *NFTItem {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptNFT) GetCurrent() *NFTItem {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptNFTExecItem struct {
+	Prev                 *NFTExecItem `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *NFTExecItem `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReceiptNFTExecItem) Reset()         { *m = ReceiptNFTExecItem{} }
+func (m *ReceiptNFTExecItem) String() string { return proto.CompactTextString(m) }
+func (*ReceiptNFTExecItem) ProtoMessage()    {}
+func (*ReceiptNFTExecItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{17}
+}
+
+func (m *ReceiptNFTExecItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptNFTExecItem.Unmarshal(m, b)
+}
+func (m *ReceiptNFTExecItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptNFTExecItem.Marshal(b, m, deterministic)
+}
+func (m *ReceiptNFTExecItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptNFTExecItem.Merge(m, src)
+}
+func (m *ReceiptNFTExecItem) XXX_Size() int {
+	return xxx_messageInfo_ReceiptNFTExecItem.Size(m)
+}
+func (m *ReceiptNFTExecItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptNFTExecItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptNFTExecItem proto.InternalMessageInfo
+
+func (m *ReceiptNFTExecItem) GetPrev() *NFTExecItem {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptNFTExecItem) GetCurrent() *NFTExecItem {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
 // local
 type LocalToken struct {
 	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -732,7 +1388,7 @@ func (m *LocalToken) Reset()         { *m = LocalToken{} }
 func (m *LocalToken) String() string { return proto.CompactTextString(m) }
 func (*LocalToken) ProtoMessage()    {}
 func (*LocalToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{9}
+	return fileDescriptor_3aff0bcd502840ab, []int{18}
 }
 
 func (m *LocalToken) XXX_Unmarshal(b []byte) error {
@@ -872,6 +1528,93 @@ func (m *LocalToken) GetCategory() int32 {
 	return 0
 }
 
+type LocalNFTLog struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	TxIndex              string   `protobuf:"bytes,3,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	ActionType           int32    `protobuf:"varint,4,opt,name=actionType,proto3" json:"actionType,omitempty"`
+	From                 string   `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	TxHash               string   `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocalNFTLog) Reset()         { *m = LocalNFTLog{} }
+func (m *LocalNFTLog) String() string { return proto.CompactTextString(m) }
+func (*LocalNFTLog) ProtoMessage()    {}
+func (*LocalNFTLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{19}
+}
+
+func (m *LocalNFTLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalNFTLog.Unmarshal(m, b)
+}
+func (m *LocalNFTLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalNFTLog.Marshal(b, m, deterministic)
+}
+func (m *LocalNFTLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalNFTLog.Merge(m, src)
+}
+func (m *LocalNFTLog) XXX_Size() int {
+	return xxx_messageInfo_LocalNFTLog.Size(m)
+}
+func (m *LocalNFTLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalNFTLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalNFTLog proto.InternalMessageInfo
+
+func (m *LocalNFTLog) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *LocalNFTLog) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *LocalNFTLog) GetTxIndex() string {
+	if m != nil {
+		return m.TxIndex
+	}
+	return ""
+}
+
+func (m *LocalNFTLog) GetActionType() int32 {
+	if m != nil {
+		return m.ActionType
+	}
+	return 0
+}
+
+func (m *LocalNFTLog) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *LocalNFTLog) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *LocalNFTLog) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
 type LocalLogs struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	TxIndex              string   `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
@@ -886,7 +1629,7 @@ func (m *LocalLogs) Reset()         { *m = LocalLogs{} }
 func (m *LocalLogs) String() string { return proto.CompactTextString(m) }
 func (*LocalLogs) ProtoMessage()    {}
 func (*LocalLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{10}
+	return fileDescriptor_3aff0bcd502840ab, []int{20}
 }
 
 func (m *LocalLogs) XXX_Unmarshal(b []byte) error {
@@ -950,7 +1693,7 @@ func (m *ReqTokens) Reset()         { *m = ReqTokens{} }
 func (m *ReqTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqTokens) ProtoMessage()    {}
 func (*ReqTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{11}
+	return fileDescriptor_3aff0bcd502840ab, []int{21}
 }
 
 func (m *ReqTokens) XXX_Unmarshal(b []byte) error {
@@ -1010,7 +1753,7 @@ func (m *ReplyTokens) Reset()         { *m = ReplyTokens{} }
 func (m *ReplyTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokens) ProtoMessage()    {}
 func (*ReplyTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{12}
+	return fileDescriptor_3aff0bcd502840ab, []int{22}
 }
 
 func (m *ReplyTokens) XXX_Unmarshal(b []byte) error {
@@ -1050,7 +1793,7 @@ func (m *TokenRecv) Reset()         { *m = TokenRecv{} }
 func (m *TokenRecv) String() string { return proto.CompactTextString(m) }
 func (*TokenRecv) ProtoMessage()    {}
 func (*TokenRecv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{13}
+	return fileDescriptor_3aff0bcd502840ab, []int{23}
 }
 
 func (m *TokenRecv) XXX_Unmarshal(b []byte) error {
@@ -1096,7 +1839,7 @@ func (m *ReplyAddrRecvForTokens) Reset()         { *m = ReplyAddrRecvForTokens{}
 func (m *ReplyAddrRecvForTokens) String() string { return proto.CompactTextString(m) }
 func (*ReplyAddrRecvForTokens) ProtoMessage()    {}
 func (*ReplyAddrRecvForTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{14}
+	return fileDescriptor_3aff0bcd502840ab, []int{24}
 }
 
 func (m *ReplyAddrRecvForTokens) XXX_Unmarshal(b []byte) error {
@@ -1137,7 +1880,7 @@ func (m *ReqTokenBalance) Reset()         { *m = ReqTokenBalance{} }
 func (m *ReqTokenBalance) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBalance) ProtoMessage()    {}
 func (*ReqTokenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{15}
+	return fileDescriptor_3aff0bcd502840ab, []int{25}
 }
 
 func (m *ReqTokenBalance) XXX_Unmarshal(b []byte) error {
@@ -1191,7 +1934,7 @@ func (m *ReqAccountTokenAssets) Reset()         { *m = ReqAccountTokenAssets{} }
 func (m *ReqAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccountTokenAssets) ProtoMessage()    {}
 func (*ReqAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{16}
+	return fileDescriptor_3aff0bcd502840ab, []int{26}
 }
 
 func (m *ReqAccountTokenAssets) XXX_Unmarshal(b []byte) error {
@@ -1238,7 +1981,7 @@ func (m *TokenAsset) Reset()         { *m = TokenAsset{} }
 func (m *TokenAsset) String() string { return proto.CompactTextString(m) }
 func (*TokenAsset) ProtoMessage()    {}
 func (*TokenAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{17}
+	return fileDescriptor_3aff0bcd502840ab, []int{27}
 }
 
 func (m *TokenAsset) XXX_Unmarshal(b []byte) error {
@@ -1284,7 +2027,7 @@ func (m *ReplyAccountTokenAssets) Reset()         { *m = ReplyAccountTokenAssets
 func (m *ReplyAccountTokenAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccountTokenAssets) ProtoMessage()    {}
 func (*ReplyAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{18}
+	return fileDescriptor_3aff0bcd502840ab, []int{28}
 }
 
 func (m *ReplyAccountTokenAssets) XXX_Unmarshal(b []byte) error {
@@ -1328,7 +2071,7 @@ func (m *ReqAddrTokens) Reset()         { *m = ReqAddrTokens{} }
 func (m *ReqAddrTokens) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrTokens) ProtoMessage()    {}
 func (*ReqAddrTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{19}
+	return fileDescriptor_3aff0bcd502840ab, []int{29}
 }
 
 func (m *ReqAddrTokens) XXX_Unmarshal(b []byte) error {
@@ -1409,7 +2152,7 @@ func (m *ReqTokenTx) Reset()         { *m = ReqTokenTx{} }
 func (m *ReqTokenTx) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenTx) ProtoMessage()    {}
 func (*ReqTokenTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{20}
+	return fileDescriptor_3aff0bcd502840ab, []int{30}
 }
 
 func (m *ReqTokenTx) XXX_Unmarshal(b []byte) error {
@@ -1490,7 +2233,7 @@ func (m *ReplyTokenLogs) Reset()         { *m = ReplyTokenLogs{} }
 func (m *ReplyTokenLogs) String() string { return proto.CompactTextString(m) }
 func (*ReplyTokenLogs) ProtoMessage()    {}
 func (*ReplyTokenLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{21}
+	return fileDescriptor_3aff0bcd502840ab, []int{31}
 }
 
 func (m *ReplyTokenLogs) XXX_Unmarshal(b []byte) error {
@@ -1518,6 +2261,202 @@ func (m *ReplyTokenLogs) GetLogs() []*LocalLogs {
 	return nil
 }
 
+type ReqNFT struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,2,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqNFT) Reset()         { *m = ReqNFT{} }
+func (m *ReqNFT) String() string { return proto.CompactTextString(m) }
+func (*ReqNFT) ProtoMessage()    {}
+func (*ReqNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{32}
+}
+
+func (m *ReqNFT) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqNFT.Unmarshal(m, b)
+}
+func (m *ReqNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqNFT.Marshal(b, m, deterministic)
+}
+func (m *ReqNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqNFT.Merge(m, src)
+}
+func (m *ReqNFT) XXX_Size() int {
+	return xxx_messageInfo_ReqNFT.Size(m)
+}
+func (m *ReqNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqNFT proto.InternalMessageInfo
+
+func (m *ReqNFT) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqNFT) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+type ReqNFTsOfOwner struct {
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Primary              string   `protobuf:"bytes,3,opt,name=primary,proto3" json:"primary,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqNFTsOfOwner) Reset()         { *m = ReqNFTsOfOwner{} }
+func (m *ReqNFTsOfOwner) String() string { return proto.CompactTextString(m) }
+func (*ReqNFTsOfOwner) ProtoMessage()    {}
+func (*ReqNFTsOfOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{33}
+}
+
+func (m *ReqNFTsOfOwner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqNFTsOfOwner.Unmarshal(m, b)
+}
+func (m *ReqNFTsOfOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqNFTsOfOwner.Marshal(b, m, deterministic)
+}
+func (m *ReqNFTsOfOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqNFTsOfOwner.Merge(m, src)
+}
+func (m *ReqNFTsOfOwner) XXX_Size() int {
+	return xxx_messageInfo_ReqNFTsOfOwner.Size(m)
+}
+func (m *ReqNFTsOfOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqNFTsOfOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqNFTsOfOwner proto.InternalMessageInfo
+
+func (m *ReqNFTsOfOwner) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReqNFTsOfOwner) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqNFTsOfOwner) GetPrimary() string {
+	if m != nil {
+		return m.Primary
+	}
+	return ""
+}
+
+func (m *ReqNFTsOfOwner) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqNFTsOfOwner) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyNFTs struct {
+	Items                []*NFTItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ReplyNFTs) Reset()         { *m = ReplyNFTs{} }
+func (m *ReplyNFTs) String() string { return proto.CompactTextString(m) }
+func (*ReplyNFTs) ProtoMessage()    {}
+func (*ReplyNFTs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{34}
+}
+
+func (m *ReplyNFTs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyNFTs.Unmarshal(m, b)
+}
+func (m *ReplyNFTs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyNFTs.Marshal(b, m, deterministic)
+}
+func (m *ReplyNFTs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyNFTs.Merge(m, src)
+}
+func (m *ReplyNFTs) XXX_Size() int {
+	return xxx_messageInfo_ReplyNFTs.Size(m)
+}
+func (m *ReplyNFTs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyNFTs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyNFTs proto.InternalMessageInfo
+
+func (m *ReplyNFTs) GetItems() []*NFTItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ReplyNFTLogs struct {
+	Logs                 []*LocalNFTLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReplyNFTLogs) Reset()         { *m = ReplyNFTLogs{} }
+func (m *ReplyNFTLogs) String() string { return proto.CompactTextString(m) }
+func (*ReplyNFTLogs) ProtoMessage()    {}
+func (*ReplyNFTLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{35}
+}
+
+func (m *ReplyNFTLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyNFTLogs.Unmarshal(m, b)
+}
+func (m *ReplyNFTLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyNFTLogs.Marshal(b, m, deterministic)
+}
+func (m *ReplyNFTLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyNFTLogs.Merge(m, src)
+}
+func (m *ReplyNFTLogs) XXX_Size() int {
+	return xxx_messageInfo_ReplyNFTLogs.Size(m)
+}
+func (m *ReplyNFTLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyNFTLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyNFTLogs proto.InternalMessageInfo
+
+func (m *ReplyNFTLogs) GetLogs() []*LocalNFTLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*TokenAction)(nil), "types.TokenAction")
 	proto.RegisterType((*TokenPreCreate)(nil), "types.TokenPreCreate")
@@ -1525,10 +2464,20 @@ func init() {
 	proto.RegisterType((*TokenRevokeCreate)(nil), "types.TokenRevokeCreate")
 	proto.RegisterType((*TokenMint)(nil), "types.TokenMint")
 	proto.RegisterType((*TokenBurn)(nil), "types.TokenBurn")
+	proto.RegisterType((*NFTMint)(nil), "types.NFTMint")
+	proto.RegisterType((*NFTTransfer)(nil), "types.NFTTransfer")
+	proto.RegisterType((*NFTBurn)(nil), "types.NFTBurn")
+	proto.RegisterType((*NFTTransferToExec)(nil), "types.NFTTransferToExec")
+	proto.RegisterType((*NFTWithdraw)(nil), "types.NFTWithdraw")
 	proto.RegisterType((*Token)(nil), "types.Token")
+	proto.RegisterType((*NFTItem)(nil), "types.NFTItem")
+	proto.RegisterType((*NFTExecItem)(nil), "types.NFTExecItem")
 	proto.RegisterType((*ReceiptToken)(nil), "types.ReceiptToken")
 	proto.RegisterType((*ReceiptTokenAmount)(nil), "types.ReceiptTokenAmount")
+	proto.RegisterType((*ReceiptNFT)(nil), "types.ReceiptNFT")
+	proto.RegisterType((*ReceiptNFTExecItem)(nil), "types.ReceiptNFTExecItem")
 	proto.RegisterType((*LocalToken)(nil), "types.LocalToken")
+	proto.RegisterType((*LocalNFTLog)(nil), "types.LocalNFTLog")
 	proto.RegisterType((*LocalLogs)(nil), "types.LocalLogs")
 	proto.RegisterType((*ReqTokens)(nil), "types.ReqTokens")
 	proto.RegisterType((*ReplyTokens)(nil), "types.ReplyTokens")
@@ -1541,6 +2490,10 @@ func init() {
 	proto.RegisterType((*ReqAddrTokens)(nil), "types.ReqAddrTokens")
 	proto.RegisterType((*ReqTokenTx)(nil), "types.ReqTokenTx")
 	proto.RegisterType((*ReplyTokenLogs)(nil), "types.ReplyTokenLogs")
+	proto.RegisterType((*ReqNFT)(nil), "types.ReqNFT")
+	proto.RegisterType((*ReqNFTsOfOwner)(nil), "types.ReqNFTsOfOwner")
+	proto.RegisterType((*ReplyNFTs)(nil), "types.ReplyNFTs")
+	proto.RegisterType((*ReplyNFTLogs)(nil), "types.ReplyNFTLogs")
 }
 
 func init() {
@@ -1548,77 +2501,104 @@ func init() {
 }
 
 var fileDescriptor_3aff0bcd502840ab = []byte{
-	// 1115 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xdd, 0x6a, 0xe3, 0xc6,
-	0x17, 0xb7, 0x2d, 0x7f, 0x44, 0xc7, 0x89, 0x13, 0xcf, 0x7f, 0x37, 0x7f, 0x91, 0x2e, 0x8b, 0x11,
-	0x4b, 0x49, 0xa1, 0x84, 0x90, 0xd0, 0x52, 0x68, 0xa1, 0x38, 0x25, 0xbb, 0xde, 0x76, 0xbb, 0x2d,
-	0x53, 0x43, 0xef, 0x0a, 0x5a, 0xe9, 0xc4, 0x11, 0xb1, 0x25, 0x65, 0x34, 0x76, 0xec, 0xa7, 0xe9,
-	0x7d, 0x2f, 0xfa, 0x08, 0xbd, 0xe8, 0xcb, 0xf4, 0x35, 0xca, 0x9c, 0x19, 0xc9, 0x23, 0x3b, 0x2e,
-	0xe4, 0xae, 0xf4, 0x4e, 0xe7, 0xeb, 0x37, 0x73, 0xce, 0xf9, 0x9d, 0xe3, 0x31, 0x74, 0x65, 0x7a,
-	0x87, 0xc9, 0x59, 0x26, 0x52, 0x99, 0xb2, 0x96, 0x5c, 0x65, 0x98, 0x9f, 0xf4, 0xa5, 0x08, 0x92,
-	0x3c, 0x08, 0x65, 0x9c, 0x1a, 0xcb, 0xc9, 0x41, 0x10, 0x86, 0xe9, 0x3c, 0x91, 0x5a, 0xf4, 0xff,
-	0x6c, 0x42, 0x77, 0xac, 0x02, 0x87, 0xe4, 0xc4, 0xbe, 0x86, 0x1e, 0xe1, 0xfc, 0x28, 0xf0, 0x1b,
-	0x81, 0x81, 0x44, 0xaf, 0x3e, 0xa8, 0x9f, 0x76, 0x2f, 0x9e, 0x9f, 0x11, 0xe2, 0xd9, 0xb8, 0x62,
-	0x1c, 0xd5, 0xf8, 0x86, 0x3b, 0x1b, 0x41, 0x9f, 0x34, 0xaf, 0xe3, 0x24, 0xce, 0x6f, 0x0d, 0x46,
-	0x83, 0x30, 0x3c, 0x1b, 0xc3, 0xb6, 0x8f, 0x6a, 0x7c, 0x3b, 0xa8, 0x44, 0xe2, 0xb8, 0x48, 0xef,
-	0x8a, 0xdb, 0x38, 0xdb, 0x48, 0xb6, 0xbd, 0x44, 0xb2, 0x95, 0xec, 0x12, 0xf6, 0xa8, 0x10, 0x37,
-	0x28, 0xbc, 0x66, 0x25, 0x9d, 0x61, 0x9e, 0xa3, 0xcc, 0xc7, 0xc6, 0x38, 0xaa, 0xf1, 0xd2, 0x51,
-	0x05, 0x3d, 0xc4, 0xf2, 0x36, 0x12, 0xc1, 0x83, 0xd7, 0x7a, 0x24, 0xe8, 0x67, 0x63, 0x54, 0x41,
-	0x85, 0x23, 0x3b, 0x87, 0xce, 0x04, 0x13, 0xcc, 0xe3, 0xdc, 0x6b, 0x53, 0xcc, 0xb3, 0x4a, 0xcc,
-	0x1b, 0x6d, 0x1b, 0xd5, 0x78, 0xe1, 0xc6, 0xae, 0xa1, 0x57, 0x1c, 0x39, 0x4e, 0xaf, 0x97, 0x18,
-	0x7a, 0x7b, 0x14, 0xf8, 0xd1, 0xa3, 0x37, 0xd4, 0x2e, 0x54, 0xf6, 0x8a, 0x86, 0x9d, 0x83, 0x4b,
-	0x79, 0x7f, 0x1f, 0x27, 0xd2, 0x73, 0x09, 0xe1, 0xc8, 0x2e, 0x92, 0xd2, 0x8f, 0x6a, 0x7c, 0xed,
-	0x54, 0x46, 0x5c, 0xcd, 0x45, 0xe2, 0xc1, 0x76, 0x84, 0xd2, 0x97, 0x11, 0x4a, 0x60, 0x3d, 0x68,
-	0x8c, 0x57, 0x5e, 0x67, 0x50, 0x3f, 0x6d, 0xf1, 0xc6, 0x78, 0x75, 0xd5, 0x81, 0xd6, 0x22, 0x98,
-	0xce, 0xd1, 0xff, 0xa3, 0x0e, 0xbd, 0x2a, 0x31, 0x18, 0x83, 0x66, 0x12, 0xcc, 0x34, 0x7b, 0x5c,
-	0x4e, 0xdf, 0xec, 0x18, 0xda, 0xf9, 0x6a, 0xf6, 0x21, 0x9d, 0x12, 0x1f, 0x5c, 0x6e, 0x24, 0xe6,
-	0xc3, 0x7e, 0x9c, 0x48, 0x91, 0x46, 0x73, 0xe2, 0x20, 0xf5, 0xd8, 0xe5, 0x15, 0x1d, 0x7b, 0x06,
-	0x2d, 0x99, 0xca, 0x60, 0x4a, 0xfd, 0x73, 0xb8, 0x16, 0x94, 0x36, 0x13, 0x71, 0x88, 0xd4, 0x20,
-	0x87, 0x6b, 0x41, 0x69, 0xd3, 0x87, 0x04, 0x05, 0xb5, 0xc0, 0xe5, 0x5a, 0x60, 0x27, 0xb0, 0x17,
-	0x06, 0x12, 0x27, 0xa9, 0x28, 0x72, 0x28, 0x65, 0x7f, 0x08, 0xfd, 0x2d, 0x52, 0x5a, 0xd7, 0xad,
-	0x57, 0xae, 0x5b, 0xc2, 0x37, 0x2c, 0xf8, 0x12, 0xa2, 0x42, 0xbc, 0xa7, 0x41, 0x7c, 0x09, 0x6e,
-	0xd9, 0xab, 0x9d, 0xa1, 0xc7, 0xd0, 0x0e, 0x66, 0x6a, 0x80, 0x29, 0xd6, 0xe1, 0x46, 0x2a, 0x83,
-	0xa9, 0x53, 0x4f, 0x0d, 0xfe, 0xab, 0x0e, 0x2d, 0x8a, 0xfe, 0x17, 0xf6, 0xcd, 0x83, 0x4e, 0xa8,
-	0xaa, 0x99, 0x0a, 0x6a, 0x9b, 0xcb, 0x0b, 0x91, 0xee, 0x25, 0x03, 0x39, 0xcf, 0x69, 0x64, 0x5a,
-	0xdc, 0x48, 0x95, 0x4e, 0xbb, 0x1b, 0x9d, 0x1e, 0xc3, 0x3e, 0xc7, 0x10, 0xe3, 0x4c, 0xea, 0x7c,
-	0x9f, 0xd4, 0x21, 0xeb, 0x44, 0xc7, 0x3e, 0xd1, 0xff, 0x05, 0x98, 0x8d, 0x3a, 0xa4, 0xaa, 0xb2,
-	0x01, 0x34, 0x33, 0x81, 0x0b, 0xb3, 0x41, 0xf7, 0x2b, 0x3b, 0x8b, 0x2c, 0xec, 0x63, 0xe8, 0x84,
-	0x73, 0x21, 0xd0, 0x34, 0x64, 0xd3, 0xa9, 0x30, 0xfa, 0xbf, 0x35, 0x01, 0xde, 0xa5, 0x61, 0x30,
-	0xfd, 0xef, 0x34, 0xe9, 0x15, 0x1c, 0x90, 0x0b, 0x46, 0x23, 0x8c, 0x27, 0xb7, 0x7a, 0x69, 0x39,
-	0xbc, 0xaa, 0x64, 0x03, 0xe8, 0x1a, 0xc5, 0x38, 0x9e, 0x21, 0xad, 0x29, 0x87, 0xdb, 0x2a, 0x76,
-	0x0e, 0xff, 0xcb, 0x04, 0x66, 0x41, 0xf9, 0x93, 0xa4, 0xd1, 0xba, 0xe4, 0xf9, 0x98, 0x89, 0x7d,
-	0x0a, 0xfd, 0x8a, 0x9a, 0x90, 0xf7, 0xc9, 0x7f, 0xdb, 0xc0, 0x5e, 0x80, 0x9b, 0x09, 0x0c, 0xe3,
-	0x5c, 0x15, 0xef, 0x80, 0x52, 0x58, 0x2b, 0xd8, 0x19, 0x30, 0x2a, 0x56, 0xb9, 0x9f, 0xe3, 0x19,
-	0xe6, 0x5e, 0x8f, 0xc0, 0x1e, 0xb1, 0xa8, 0xac, 0x05, 0x2d, 0x88, 0x22, 0xeb, 0x43, 0x9d, 0x75,
-	0x45, 0xa9, 0xb2, 0x36, 0x0a, 0xba, 0xdb, 0x91, 0xce, 0xda, 0x52, 0x55, 0x28, 0xde, 0xdf, 0xa0,
-	0xf8, 0x1c, 0x5c, 0xe2, 0xca, 0xbb, 0x74, 0x92, 0xef, 0xe4, 0xb7, 0x07, 0x1d, 0xb9, 0x7c, 0x9b,
-	0x44, 0xb8, 0x34, 0x7c, 0x29, 0x44, 0xf6, 0x12, 0x40, 0x3f, 0x18, 0xc6, 0xab, 0x0c, 0x0d, 0xcf,
-	0x2d, 0x8d, 0x42, 0x94, 0xcb, 0x51, 0x90, 0xdf, 0x12, 0x5b, 0x5c, 0x6e, 0x24, 0xff, 0x01, 0x5c,
-	0x8e, 0xf7, 0x44, 0x50, 0x1a, 0xc1, 0xfb, 0x39, 0x8a, 0xd5, 0x70, 0xaa, 0x0f, 0xde, 0xe3, 0xa5,
-	0x6c, 0x31, 0xa2, 0x51, 0x61, 0x84, 0x02, 0xa6, 0x68, 0xcf, 0x19, 0x38, 0x04, 0xac, 0xb1, 0x5e,
-	0x02, 0xe8, 0x4b, 0xff, 0x90, 0x4c, 0x57, 0x74, 0xe8, 0x1e, 0xb7, 0x34, 0xfe, 0x17, 0xd0, 0xe5,
-	0x98, 0x4d, 0x57, 0xe6, 0xe8, 0x4f, 0x4a, 0x98, 0xfa, 0xc0, 0x39, 0xed, 0x5e, 0xf4, 0xcd, 0x48,
-	0xad, 0xe7, 0xa7, 0x40, 0xf6, 0x3f, 0x33, 0x3b, 0x93, 0x63, 0xb8, 0xd0, 0x43, 0x70, 0x87, 0x89,
-	0x29, 0x94, 0x16, 0xd4, 0xa8, 0x09, 0x0c, 0x17, 0x66, 0x5f, 0xd2, 0xb7, 0xff, 0x2d, 0x1c, 0xd3,
-	0x81, 0xc3, 0x28, 0x12, 0x2a, 0xf4, 0x75, 0x2a, 0xcc, 0xd9, 0xe7, 0x00, 0xb2, 0x00, 0x2c, 0xce,
-	0x3f, 0xaa, 0xbe, 0x55, 0xc2, 0x05, 0xb7, 0x7c, 0xfc, 0x18, 0x0e, 0x8b, 0xaa, 0x5d, 0x05, 0xd3,
-	0x20, 0x09, 0x89, 0x71, 0x41, 0x14, 0x09, 0xcc, 0x73, 0xd4, 0x18, 0x2e, 0x5f, 0x2b, 0x14, 0x37,
-	0x28, 0xfc, 0x27, 0x7b, 0xd8, 0x6d, 0x95, 0xaa, 0x23, 0x2e, 0x31, 0x44, 0x61, 0x66, 0xdd, 0x48,
-	0xfe, 0x5b, 0x78, 0xce, 0xf1, 0x7e, 0xa8, 0x9f, 0x7f, 0x7a, 0x4f, 0xd1, 0xdb, 0x42, 0x71, 0xc1,
-	0xe0, 0x9b, 0xdc, 0x0b, 0xd1, 0x82, 0x6a, 0x54, 0xa0, 0xde, 0x03, 0xac, 0x01, 0x76, 0x72, 0xec,
-	0x14, 0x3a, 0xe6, 0xb1, 0x69, 0xb6, 0x5b, 0xaf, 0x78, 0xd3, 0x68, 0x2d, 0x2f, 0xcc, 0xfe, 0x7b,
-	0xf8, 0xbf, 0xae, 0xe8, 0xf6, 0xe5, 0x2e, 0x4d, 0xbe, 0x5a, 0xdc, 0xe8, 0xe9, 0xda, 0x91, 0xdb,
-	0x5e, 0xfe, 0xaf, 0x75, 0x38, 0x50, 0xb9, 0x46, 0x51, 0xd1, 0x19, 0x06, 0x4d, 0x95, 0x54, 0xb1,
-	0x32, 0xd5, 0xf7, 0x4e, 0x22, 0x96, 0x4c, 0xd0, 0x3c, 0x34, 0x4c, 0x78, 0x01, 0x6e, 0x14, 0x0b,
-	0xd4, 0x5b, 0xb4, 0xa9, 0x17, 0x41, 0xa9, 0x50, 0x31, 0x3a, 0xd3, 0x16, 0x59, 0xb4, 0xa0, 0x2a,
-	0x7b, 0x23, 0xd2, 0xd9, 0x77, 0xb8, 0x32, 0xeb, 0xb2, 0x10, 0xfd, 0xdf, 0xeb, 0x00, 0x45, 0xe3,
-	0xc7, 0xcb, 0x9d, 0x25, 0x64, 0xd0, 0xbc, 0x99, 0x06, 0x13, 0x73, 0x41, 0xfa, 0x5e, 0x1f, 0xe5,
-	0xd8, 0x47, 0xfd, 0xf3, 0xf5, 0x8e, 0xa1, 0x7d, 0xab, 0x17, 0x8e, 0x5e, 0xe6, 0x46, 0x52, 0x58,
-	0x31, 0x2d, 0x81, 0xb6, 0xde, 0xf1, 0x24, 0x94, 0xc5, 0xea, 0xac, 0x8b, 0xe5, 0x7f, 0x0e, 0xbd,
-	0xf5, 0x94, 0xd1, 0x6a, 0x79, 0x05, 0xcd, 0x69, 0x3a, 0xd9, 0xa4, 0x79, 0xb9, 0x7a, 0x38, 0x59,
-	0x2f, 0xae, 0x4d, 0x31, 0xd9, 0x57, 0x70, 0xf8, 0x06, 0x65, 0x85, 0xe9, 0xc7, 0x26, 0x66, 0x63,
-	0x02, 0x4e, 0x0e, 0xab, 0x3c, 0xc9, 0xfd, 0xda, 0x87, 0x36, 0xfd, 0x5d, 0xb9, 0xfc, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xe2, 0xae, 0x58, 0x34, 0xe6, 0x0c, 0x00, 0x00,
+	// 1543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xdd, 0x6e, 0x1c, 0xc5,
+	0x12, 0xf6, 0xec, 0xec, 0xdf, 0xd4, 0xda, 0x6b, 0xbb, 0x4f, 0xe2, 0x33, 0xf2, 0x39, 0x8a, 0xac,
+	0x51, 0x14, 0xf9, 0x1c, 0x45, 0x96, 0x49, 0x44, 0x84, 0x08, 0x12, 0x72, 0x20, 0xce, 0x1a, 0x82,
+	0x83, 0x9a, 0x95, 0x90, 0xb8, 0x40, 0x9a, 0xcc, 0xb6, 0xed, 0x21, 0xbb, 0x33, 0xeb, 0x9e, 0x59,
+	0xdb, 0xcb, 0x0b, 0x70, 0xc3, 0x3b, 0x70, 0xcf, 0x05, 0x77, 0xdc, 0xf2, 0x14, 0xbc, 0x43, 0x5e,
+	0x03, 0x75, 0xf5, 0xcf, 0x74, 0xcf, 0xae, 0x23, 0xd9, 0x42, 0x08, 0x71, 0x37, 0x55, 0x5d, 0x3f,
+	0x5f, 0x55, 0xd7, 0x4f, 0xef, 0x42, 0xaf, 0xcc, 0xdf, 0xb0, 0x6c, 0x6f, 0xca, 0xf3, 0x32, 0x27,
+	0xad, 0x72, 0x3e, 0x65, 0xc5, 0xf6, 0x66, 0xc9, 0xe3, 0xac, 0x88, 0x93, 0x32, 0xcd, 0xd5, 0xc9,
+	0xf6, 0x5a, 0x9c, 0x24, 0xf9, 0x2c, 0x2b, 0x25, 0x19, 0xfd, 0xde, 0x86, 0xde, 0x50, 0x28, 0x1e,
+	0xa0, 0x10, 0xf9, 0x18, 0xfa, 0x68, 0xe7, 0x4b, 0xce, 0x3e, 0xe1, 0x2c, 0x2e, 0x59, 0xe8, 0xed,
+	0x78, 0xbb, 0xbd, 0x47, 0x77, 0xf7, 0xd0, 0xe2, 0xde, 0xd0, 0x39, 0x1c, 0xac, 0xd0, 0x9a, 0x38,
+	0x19, 0xc0, 0x26, 0x72, 0x0e, 0xd3, 0x2c, 0x2d, 0xce, 0x94, 0x8d, 0x06, 0xda, 0x08, 0x6d, 0x1b,
+	0xf6, 0xf9, 0x60, 0x85, 0x2e, 0x2a, 0x19, 0x4b, 0x94, 0x5d, 0xe4, 0x6f, 0x34, 0x1a, 0x7f, 0xd1,
+	0x92, 0x7d, 0x6e, 0x2c, 0xd9, 0x4c, 0xf2, 0x18, 0xba, 0x98, 0x88, 0x13, 0xc6, 0xc3, 0xa6, 0x13,
+	0xce, 0x41, 0x51, 0xb0, 0xb2, 0x18, 0xaa, 0xc3, 0xc1, 0x0a, 0x35, 0x82, 0x42, 0xe9, 0x32, 0x2d,
+	0xcf, 0x46, 0x3c, 0xbe, 0x0c, 0x5b, 0x4b, 0x94, 0xbe, 0x56, 0x87, 0x42, 0x49, 0x0b, 0x92, 0x7d,
+	0xe8, 0x9c, 0xb2, 0x8c, 0x15, 0x69, 0x11, 0xb6, 0x51, 0xe7, 0x8e, 0xa3, 0xf3, 0x42, 0x9e, 0x0d,
+	0x56, 0xa8, 0x16, 0x23, 0xcf, 0xa1, 0xaf, 0x5d, 0x0e, 0xf3, 0xe7, 0x57, 0x2c, 0x09, 0xbb, 0xa8,
+	0xf8, 0x9f, 0xa5, 0x08, 0xa5, 0x08, 0xa6, 0xdd, 0xe1, 0x90, 0x7d, 0x08, 0x30, 0xee, 0x2f, 0xd2,
+	0xac, 0x0c, 0x03, 0xb4, 0xb0, 0x61, 0x27, 0x49, 0xf0, 0x07, 0x2b, 0xb4, 0x12, 0x32, 0x1a, 0xcf,
+	0x66, 0x3c, 0x0b, 0x61, 0x51, 0x43, 0xf0, 0x8d, 0x86, 0x20, 0xc8, 0xff, 0xa1, 0x73, 0x7c, 0x38,
+	0x44, 0x0f, 0x3d, 0x94, 0xef, 0x2b, 0x79, 0xc5, 0x15, 0x61, 0xa9, 0x4f, 0xf2, 0x04, 0x7a, 0xc7,
+	0x87, 0x43, 0x0d, 0x3b, 0x5c, 0x45, 0x79, 0x52, 0xc9, 0x5b, 0x29, 0xb7, 0x05, 0x95, 0x0f, 0xc4,
+	0xb4, 0x56, 0xf7, 0xa1, 0x10, 0x69, 0x01, 0x51, 0x20, 0x96, 0xaa, 0xca, 0x5e, 0xdf, 0x29, 0x90,
+	0x85, 0x73, 0x51, 0x20, 0x0b, 0x4c, 0x85, 0x56, 0xdf, 0x68, 0xb8, 0x5e, 0x47, 0x6b, 0xdd, 0xb5,
+	0x2d, 0x48, 0xfa, 0xd0, 0x18, 0xce, 0xc3, 0xce, 0x8e, 0xb7, 0xdb, 0xa2, 0x8d, 0xe1, 0xfc, 0x59,
+	0x07, 0x5a, 0x17, 0xf1, 0x78, 0xc6, 0xa2, 0xdf, 0x3c, 0xe8, 0xbb, 0xad, 0x42, 0x08, 0x34, 0xb3,
+	0x78, 0x22, 0xfb, 0x29, 0xa0, 0xf8, 0x4d, 0xb6, 0xa0, 0x5d, 0xcc, 0x27, 0xaf, 0xf3, 0x31, 0x76,
+	0x48, 0x40, 0x15, 0x45, 0x22, 0x58, 0x4d, 0xb3, 0x92, 0xe7, 0xa3, 0x19, 0x76, 0x25, 0x56, 0x7d,
+	0x40, 0x1d, 0x1e, 0xb9, 0x03, 0xad, 0x32, 0x2f, 0xe3, 0x31, 0x56, 0xb4, 0x4f, 0x25, 0x21, 0xb8,
+	0x53, 0x9e, 0x26, 0x0c, 0x4b, 0xd6, 0xa7, 0x92, 0x10, 0xdc, 0xfc, 0x32, 0x63, 0x1c, 0x8b, 0x32,
+	0xa0, 0x92, 0x20, 0xdb, 0xd0, 0x4d, 0xe2, 0x92, 0x9d, 0xe6, 0x5c, 0xc7, 0x60, 0xe8, 0xe8, 0x00,
+	0x36, 0x17, 0xda, 0xd4, 0x82, 0xeb, 0x39, 0x70, 0x8d, 0xf9, 0x86, 0x65, 0xde, 0x98, 0x70, 0x5a,
+	0xf1, 0x66, 0x26, 0x9e, 0x42, 0x60, 0xaa, 0xf7, 0x5a, 0xd5, 0x2d, 0x68, 0xc7, 0x13, 0x31, 0xd2,
+	0x50, 0xd7, 0xa7, 0x8a, 0x32, 0xca, 0x58, 0x2b, 0x37, 0x55, 0x9e, 0x9b, 0x5a, 0xbf, 0x56, 0x35,
+	0x84, 0x0e, 0xf6, 0xc6, 0xd1, 0xa7, 0x0a, 0xb4, 0x26, 0x45, 0x59, 0x94, 0xb9, 0xba, 0xb4, 0x46,
+	0x99, 0x93, 0x0d, 0xf0, 0x67, 0x3c, 0xc5, 0x8b, 0x0a, 0xa8, 0xf8, 0x14, 0xa9, 0x9f, 0xb0, 0x32,
+	0x1e, 0xc4, 0xc5, 0x19, 0xde, 0x54, 0x40, 0x0d, 0x1d, 0x25, 0x4e, 0xeb, 0xfc, 0x09, 0xee, 0x45,
+	0xe5, 0xe5, 0x25, 0x53, 0xfe, 0xf1, 0x3b, 0x7a, 0x6a, 0xfa, 0xec, 0xe6, 0x0e, 0xa2, 0xd9, 0x92,
+	0xc6, 0xbb, 0x05, 0xce, 0x6d, 0xe8, 0xb2, 0x2b, 0x96, 0x1c, 0x8b, 0xae, 0x90, 0x68, 0x0d, 0xbd,
+	0x14, 0x73, 0xee, 0x74, 0xe9, 0x5f, 0xe0, 0xf0, 0xad, 0x07, 0x2d, 0x2c, 0xa1, 0xbf, 0x61, 0xf3,
+	0x86, 0xd0, 0x49, 0x44, 0x4b, 0xe5, 0x1c, 0x7b, 0x37, 0xa0, 0x9a, 0x44, 0x5c, 0x65, 0x5c, 0xce,
+	0x0a, 0xdc, 0x24, 0x2d, 0xaa, 0x28, 0xa7, 0xdd, 0x83, 0x5a, 0xbb, 0xbf, 0xf5, 0xb0, 0x1e, 0x8e,
+	0x4a, 0x36, 0xb9, 0x45, 0x5e, 0x0d, 0x42, 0xdf, 0x46, 0x78, 0xa3, 0xaa, 0xb7, 0xe3, 0x69, 0x2f,
+	0xc4, 0x73, 0xc6, 0xd2, 0xd3, 0xb3, 0x12, 0x03, 0xf5, 0xa9, 0xa2, 0xf4, 0x6d, 0x1e, 0x8c, 0x46,
+	0x3c, 0xec, 0x56, 0xb7, 0x29, 0x68, 0xc4, 0x7a, 0x75, 0x94, 0x8d, 0xd8, 0x55, 0x18, 0x28, 0xac,
+	0x92, 0x8c, 0x7e, 0xf0, 0xb0, 0x8a, 0x44, 0xc9, 0xde, 0x32, 0x5a, 0x02, 0xcd, 0x78, 0x34, 0xd2,
+	0xc1, 0xe2, 0xb7, 0x83, 0xa5, 0x59, 0xc3, 0xb2, 0x05, 0xed, 0x13, 0x9e, 0x7f, 0xcf, 0x32, 0x8c,
+	0xb9, 0x4b, 0x15, 0x15, 0x0d, 0x61, 0x95, 0xb2, 0x84, 0xa5, 0xd3, 0x52, 0xd6, 0xd8, 0x8d, 0x46,
+	0xa3, 0x75, 0xcb, 0xbe, 0x7d, 0xcb, 0xd1, 0xb7, 0x40, 0x6c, 0xab, 0x07, 0x38, 0xce, 0xc8, 0x0e,
+	0x34, 0xa7, 0x9c, 0x5d, 0xa8, 0xc7, 0xdc, 0xaa, 0xf3, 0x7c, 0xc2, 0x13, 0xf2, 0x00, 0x3a, 0xc9,
+	0x8c, 0x73, 0xa6, 0x26, 0x61, 0x5d, 0x48, 0x1f, 0x46, 0xdf, 0x00, 0x28, 0xfb, 0xc7, 0x87, 0x43,
+	0x12, 0x39, 0x76, 0xad, 0x5d, 0x2d, 0x72, 0xab, 0x2c, 0xef, 0xd6, 0x2d, 0xd7, 0xc5, 0x8c, 0xed,
+	0xef, 0x0c, 0x76, 0xfb, 0x86, 0x1e, 0x38, 0x3e, 0xac, 0xad, 0xac, 0x25, 0x94, 0x9f, 0x87, 0x75,
+	0x3f, 0xcb, 0x44, 0x8d, 0xaf, 0x9f, 0x9b, 0x00, 0x2f, 0xf3, 0x24, 0x1e, 0xff, 0x73, 0x1a, 0xfc,
+	0x3e, 0xac, 0xa1, 0x08, 0x1b, 0x0d, 0x64, 0xbf, 0x04, 0xe8, 0xc5, 0x65, 0x92, 0x1d, 0xe8, 0x29,
+	0xc6, 0x30, 0x9d, 0x30, 0x7c, 0xf9, 0xf9, 0xd4, 0x66, 0x91, 0x7d, 0xf8, 0xd7, 0x94, 0xb3, 0x69,
+	0x6c, 0x5e, 0xf9, 0xd2, 0x5a, 0x0f, 0x25, 0x97, 0x1d, 0x91, 0x87, 0xb0, 0xe9, 0xb0, 0xd1, 0xf2,
+	0x2a, 0xca, 0x2f, 0x1e, 0x90, 0xff, 0x42, 0x30, 0xe5, 0x2c, 0x49, 0x8b, 0x34, 0x97, 0xaf, 0xbc,
+	0x16, 0xad, 0x18, 0x64, 0x0f, 0x08, 0x26, 0xcb, 0xac, 0x97, 0x74, 0xc2, 0x0a, 0x7c, 0xd6, 0xf9,
+	0x74, 0xc9, 0x89, 0x88, 0x9a, 0xe3, 0x0b, 0x43, 0x47, 0xbd, 0x2e, 0xa3, 0x76, 0x98, 0x22, 0x6a,
+	0xc5, 0x40, 0x6c, 0x1b, 0x32, 0x6a, 0x8b, 0xe5, 0x8c, 0xc7, 0xcd, 0xda, 0x78, 0xfc, 0xd5, 0x83,
+	0x1e, 0x16, 0xcb, 0xf1, 0xe1, 0xf0, 0x65, 0x7e, 0x7a, 0x8b, 0xa1, 0x61, 0x0d, 0x24, 0xdf, 0x19,
+	0x48, 0xe4, 0x1e, 0x80, 0xfc, 0x81, 0x36, 0x9c, 0x4f, 0xe5, 0xfa, 0x69, 0x51, 0x8b, 0x23, 0x2a,
+	0xf3, 0x84, 0xe7, 0x13, 0x35, 0x30, 0xf1, 0x5b, 0x6d, 0xf8, 0xb6, 0xd9, 0xf0, 0x5b, 0xd0, 0x2e,
+	0xaf, 0x70, 0xac, 0xca, 0x52, 0x51, 0x54, 0x34, 0x83, 0x00, 0x61, 0xbf, 0xcc, 0x4f, 0x8b, 0x77,
+	0x82, 0x56, 0xd0, 0x1a, 0xef, 0x82, 0xe6, 0x2f, 0x40, 0xab, 0xdc, 0x36, 0x1d, 0xb7, 0x97, 0x10,
+	0x50, 0x76, 0x8e, 0x8d, 0x85, 0x6b, 0xe7, 0x7c, 0xc6, 0xf8, 0xfc, 0x60, 0x2c, 0x1d, 0x77, 0xa9,
+	0xa1, 0xad, 0x4a, 0x6e, 0x38, 0x95, 0x2c, 0x0c, 0xa3, 0x76, 0xe8, 0xef, 0xf8, 0x68, 0x58, 0xda,
+	0xba, 0x07, 0x20, 0x41, 0xbf, 0xca, 0xc6, 0x73, 0x74, 0xda, 0xa5, 0x16, 0x27, 0xfa, 0x00, 0x7a,
+	0x94, 0x4d, 0xc7, 0x73, 0xe5, 0xfa, 0x7f, 0xc6, 0x8c, 0xb7, 0xe3, 0xef, 0xf6, 0x1e, 0x6d, 0xaa,
+	0x81, 0x50, 0xf5, 0xbd, 0xb6, 0x1c, 0xbd, 0xaf, 0x1e, 0x8b, 0x94, 0x25, 0x17, 0xb2, 0x79, 0xdf,
+	0xb0, 0x4c, 0x25, 0x4a, 0x12, 0xe2, 0x22, 0x38, 0x4b, 0x2e, 0xd4, 0x43, 0x11, 0xbf, 0xa3, 0xcf,
+	0x60, 0x0b, 0x1d, 0x8a, 0x41, 0x2f, 0x54, 0x0f, 0x73, 0xae, 0x7c, 0xef, 0x03, 0x94, 0xda, 0xa0,
+	0xf6, 0xbf, 0xe1, 0xfe, 0x6c, 0x4d, 0x2e, 0xa8, 0x25, 0x13, 0xa5, 0xb0, 0xae, 0xb3, 0xf6, 0x2c,
+	0x1e, 0xc7, 0x59, 0x82, 0x9d, 0x22, 0xd6, 0x0b, 0x2b, 0x0a, 0x26, 0x6d, 0x04, 0xb4, 0x62, 0x88,
+	0x9a, 0x46, 0xf5, 0xaf, 0xec, 0x21, 0x65, 0xb3, 0x44, 0x1e, 0xc5, 0x1a, 0x32, 0x9b, 0x59, 0x51,
+	0xd1, 0x11, 0xdc, 0xa5, 0xec, 0xfc, 0x40, 0xfe, 0x13, 0x20, 0xf7, 0x04, 0xfe, 0xcc, 0x14, 0xb5,
+	0xa0, 0xec, 0xab, 0xd8, 0x35, 0x69, 0x99, 0x6a, 0x38, 0xa6, 0x8e, 0x01, 0x2a, 0x03, 0xd7, 0xd6,
+	0xd8, 0x2e, 0x74, 0xd4, 0xff, 0x0e, 0xb5, 0x1d, 0xa0, 0x30, 0x50, 0x7d, 0x1c, 0x1d, 0xc3, 0xbf,
+	0x65, 0x46, 0x17, 0xc1, 0x3d, 0x56, 0xf1, 0x4a, 0xb2, 0x76, 0xa7, 0x95, 0x20, 0xb5, 0xa5, 0xa2,
+	0x9f, 0x3c, 0x58, 0x13, 0xb1, 0x8e, 0x46, 0xfa, 0x66, 0xf4, 0xfe, 0xf6, 0xac, 0xfd, 0x7d, 0x5d,
+	0x21, 0x9a, 0x4a, 0x90, 0x75, 0x28, 0x09, 0x71, 0x2d, 0xa3, 0x94, 0x33, 0x39, 0xfd, 0x65, 0xc7,
+	0x56, 0x0c, 0xa1, 0x23, 0x23, 0x6d, 0xe1, 0x89, 0x24, 0x44, 0x66, 0x45, 0xeb, 0x7e, 0xce, 0xe6,
+	0xfa, 0x7d, 0xa3, 0xc8, 0xe8, 0x17, 0x0f, 0x40, 0x5f, 0xfc, 0xf0, 0xea, 0xda, 0x14, 0x8a, 0x39,
+	0x30, 0x8e, 0x4f, 0x15, 0x40, 0xfc, 0xae, 0x5c, 0xf9, 0xb6, 0xab, 0x77, 0xc3, 0xab, 0x9e, 0x53,
+	0x2d, 0xe7, 0x39, 0x75, 0x07, 0x5a, 0x29, 0x0e, 0x81, 0x36, 0xb2, 0x25, 0x61, 0x92, 0xd5, 0xa9,
+	0x92, 0x15, 0x3d, 0x81, 0x7e, 0xd5, 0x65, 0x38, 0x5a, 0xee, 0x43, 0x73, 0x9c, 0x9f, 0xd6, 0xcb,
+	0xdc, 0x8c, 0x1e, 0x8a, 0xa7, 0xd1, 0x87, 0xd0, 0xa6, 0xec, 0x5c, 0x3c, 0x1b, 0x6e, 0xfe, 0x93,
+	0xe3, 0x47, 0x0f, 0xfa, 0x52, 0xb9, 0x78, 0x75, 0xf2, 0x0a, 0x17, 0xa4, 0x59, 0x9b, 0x5e, 0xfd,
+	0x5d, 0xb4, 0x6c, 0x69, 0x87, 0xd0, 0x99, 0xf2, 0x74, 0x12, 0xf3, 0xb9, 0x1e, 0xc0, 0x8a, 0xac,
+	0x92, 0xd8, 0xbc, 0x36, 0x89, 0xad, 0x5a, 0x12, 0xa3, 0xf7, 0xc4, 0x84, 0x9b, 0x8e, 0xe7, 0x02,
+	0x0f, 0xb9, 0x0f, 0xad, 0xb4, 0x64, 0x13, 0x1d, 0x7e, 0xfd, 0x79, 0x23, 0x0f, 0xa3, 0x27, 0xb0,
+	0xaa, 0x55, 0x30, 0x67, 0x0f, 0x9c, 0x9c, 0x11, 0x3b, 0x67, 0x52, 0x44, 0x66, 0xed, 0xd1, 0x73,
+	0x55, 0x82, 0xe4, 0x23, 0x58, 0x7f, 0xc1, 0x4a, 0x67, 0x3e, 0x6c, 0x29, 0xad, 0xda, 0xdc, 0xd8,
+	0x5e, 0x77, 0xbb, 0xab, 0x88, 0x56, 0x5e, 0xb7, 0xf1, 0xff, 0xbe, 0xc7, 0x7f, 0x0c, 0x00, 0xe4,
+	0xeb, 0xf9, 0xde, 0x27, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -29,6 +29,7 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TokenX, ForkTokenPriceX, 560000)
 	cfg.RegisterDappFork(TokenX, ForkTokenSymbolWithNumberX, 1298600)
 	cfg.RegisterDappFork(TokenX, ForkTokenCheckX, 1600000)
+	cfg.RegisterDappFork(TokenX, ForkTokenNFTX, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -71,6 +72,11 @@ func (t *TokenType) GetTypeMap() map[string]int32 {
 		"TransferToExec":    TokenActionTransferToExec,
 		"TokenMint":         TokenActionMint,
 		"TokenBurn":         TokenActionBurn,
+		"NFTMint":           TokenActionNFTMint,
+		"NFTTransfer":       TokenActionNFTTransfer,
+		"NFTBurn":           TokenActionNFTBurn,
+		"NFTTransferToExec": TokenActionNFTTransferToExec,
+		"NFTWithdraw":       TokenActionNFTWithdraw,
 	}
 }
 
@@ -91,6 +97,10 @@ func (t *TokenType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogRevokeCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
 		TyLogTokenMint:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
 		TyLogTokenBurn:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogNFTMint:              {Ty: reflect.TypeOf(ReceiptNFT{}), Name: "LogNFTMint"},
+		TyLogNFTTransfer:          {Ty: reflect.TypeOf(ReceiptNFT{}), Name: "LogNFTTransfer"},
+		TyLogNFTBurn:              {Ty: reflect.TypeOf(ReceiptNFT{}), Name: "LogNFTBurn"},
+		TyLogNFTExecItem:          {Ty: reflect.TypeOf(ReceiptNFTExecItem{}), Name: "LogNFTExecItem"},
 	}
 }
 
@@ -43,6 +43,7 @@ func TradeCmd() *cobra.Command {
 		ShowTokenBuyOrdersStatusCmd(),
 
 		ShowOnesOrdersStatusCmd(),
+		ShowTradeMatchesCmd(),
 	)
 
 	return cmd
@@ -437,6 +438,55 @@ func parseTradeOrders(arg interface{}) (interface{}, error) {
 	return result, nil
 }
 
+// ShowTradeMatchesCmd : show matches of order or address
+func ShowTradeMatchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "matches",
+		Short: "Show auto matched fills of an order or an address",
+		Run:   showTradeMatches,
+	}
+	addShowTradeMatchesFlags(cmd)
+	return cmd
+}
+
+func addShowTradeMatchesFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("order", "o", "", "sell id or buy id")
+	cmd.Flags().StringP("address", "a", "", "user address, used when order is not set")
+	cmd.Flags().BoolP("sell", "s", false, "show fills the address sold, default bought")
+	cmd.Flags().Int32P("count", "c", 10, "match count")
+	cmd.Flags().Int32P("direction", "d", 1, "direction must be 0 (previous-page) or 1(next-page)")
+	cmd.Flags().StringP("from", "f", "", "start from key (not required)")
+}
+
+func showTradeMatches(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	orderID, _ := cmd.Flags().GetString("order")
+	addr, _ := cmd.Flags().GetString("address")
+	isSell, _ := cmd.Flags().GetBool("sell")
+	count, _ := cmd.Flags().GetInt32("count")
+	dir, _ := cmd.Flags().GetInt32("direction")
+	from, _ := cmd.Flags().GetString("from")
+	if orderID == "" && addr == "" {
+		fmt.Fprintln(os.Stderr, types.ErrInvalidParam)
+		return
+	}
+	req := &pty.ReqTradeMatches{
+		OrderID:   orderID,
+		Addr:      addr,
+		IsSell:    isSell,
+		FromKey:   from,
+		Count:     count,
+		Direction: dir,
+	}
+	var params rpctypes.Query4Jrpc
+	params.Execer = "trade"
+	params.FuncName = "GetTradeMatches"
+	params.Payload = types.MustPBToJSON(req)
+	var res pty.ReplyTradeMatches
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
 /************* create trade transactions *************/
 
 // CreateRawTradeSellTxCmd : create raw sell token transaction
@@ -468,6 +518,8 @@ func addTokenSellFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
 	cmd.Flags().StringP("price_exec", "", "", "price exec")
 	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+	cmd.Flags().StringP("token_id", "", "", "token id of nft, one item in one boardlot, min and total are ignored")
+	cmd.Flags().BoolP("auto_match", "", false, "match crossing orders at their price, the rest stays on the order book")
 }
 
 func tokenSell(cmd *cobra.Command, args []string) {
@@ -480,6 +532,8 @@ func tokenSell(cmd *cobra.Command, args []string) {
 	priceExec, _ := cmd.Flags().GetString("price_exec")
 	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
 	exec, _ := cmd.Flags().GetString("asset_exec")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+	autoMatch, _ := cmd.Flags().GetBool("auto_match")
 	if exec == "" {
 		exec = "token"
 	}
@@ -497,6 +551,13 @@ func tokenSell(cmd *cobra.Command, args []string) {
 		AssetExec:         exec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		TokenID:           tokenID,
+		AutoMatch:         autoMatch,
+	}
+	if tokenID != "" {
+		params.AmountPerBoardlot = types.TokenPrecision
+		params.MinBoardlot = 1
+		params.TotalBoardlot = 1
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeSellTx", params, nil)
@@ -603,6 +664,8 @@ func addTokenBuyLimitFlags(cmd *cobra.Command) {
 	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
 	cmd.Flags().StringP("price_exec", "", "", "price exec")
 	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+	cmd.Flags().StringP("token_id", "", "", "token id of nft, one item in one boardlot, min and total are ignored")
+	cmd.Flags().BoolP("auto_match", "", false, "match crossing orders at their price, the rest stays on the order book")
 }
 
 func tokenBuyLimit(cmd *cobra.Command, args []string) {
@@ -615,6 +678,8 @@ func tokenBuyLimit(cmd *cobra.Command, args []string) {
 	priceExec, _ := cmd.Flags().GetString("price_exec")
 	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
 	exec, _ := cmd.Flags().GetString("asset_exec")
+	tokenID, _ := cmd.Flags().GetString("token_id")
+	autoMatch, _ := cmd.Flags().GetBool("auto_match")
 	if exec == "" {
 		exec = "token"
 	}
@@ -632,6 +697,13 @@ func tokenBuyLimit(cmd *cobra.Command, args []string) {
 		AssetExec:         exec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		TokenID:           tokenID,
+		AutoMatch:         autoMatch,
+	}
+	if tokenID != "" {
+		params.AmountPerBoardlot = types.TokenPrecision
+		params.MinBoardlot = 1
+		params.TotalBoardlot = 1
 	}
 
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeBuyLimitTx", params, nil)
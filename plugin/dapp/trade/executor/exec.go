@@ -10,31 +10,31 @@ import (
 )
 
 func (t *trade) Exec_SellLimit(sell *pty.TradeForSell, tx *types.Transaction, index int) (*types.Receipt, error) {
-	action := newTradeAction(t, tx)
+	action := newTradeAction(t, tx, index)
 	return action.tradeSell(sell)
 }
 
 func (t *trade) Exec_BuyMarket(buy *pty.TradeForBuy, tx *types.Transaction, index int) (*types.Receipt, error) {
-	action := newTradeAction(t, tx)
+	action := newTradeAction(t, tx, index)
 	return action.tradeBuy(buy)
 }
 
 func (t *trade) Exec_RevokeSell(revoke *pty.TradeForRevokeSell, tx *types.Transaction, index int) (*types.Receipt, error) {
-	action := newTradeAction(t, tx)
+	action := newTradeAction(t, tx, index)
 	return action.tradeRevokeSell(revoke)
 }
 
 func (t *trade) Exec_BuyLimit(buy *pty.TradeForBuyLimit, tx *types.Transaction, index int) (*types.Receipt, error) {
-	action := newTradeAction(t, tx)
+	action := newTradeAction(t, tx, index)
 	return action.tradeBuyLimit(buy)
 }
 
 func (t *trade) Exec_SellMarket(sell *pty.TradeForSellMarket, tx *types.Transaction, index int) (*types.Receipt, error) {
-	action := newTradeAction(t, tx)
+	action := newTradeAction(t, tx, index)
 	return action.tradeSellMarket(sell)
 }
 
 func (t *trade) Exec_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.Transaction, index int) (*types.Receipt, error) {
-	action := newTradeAction(t, tx)
+	action := newTradeAction(t, tx, index)
 	return action.tradeRevokeBuyLimit(revoke)
 }
@@ -11,6 +11,9 @@ import (
 )
 
 func (t *trade) ExecDelLocal_SellLimit(sell *pty.TradeForSell, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if sell.AutoMatch {
+		return t.localDelMatch(tx)
+	}
 	return t.localDelLog(tx, receipt, index, 0)
 }
 
@@ -23,6 +26,9 @@ func (t *trade) ExecDelLocal_RevokeSell(revoke *pty.TradeForRevokeSell, tx *type
 }
 
 func (t *trade) ExecDelLocal_BuyLimit(buy *pty.TradeForBuyLimit, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if buy.AutoMatch {
+		return t.localDelMatch(tx)
+	}
 	return t.localDelLog(tx, receipt, index, 0)
 }
 
@@ -92,6 +98,11 @@ func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, i
 		return nil, err
 	}
 	set.KV = append(set.KV, newKvs...)
+	nftKvs, err := t.localDelNFTHolder(tx, receipt)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, nftKvs...)
 	for _, kv := range set.KV {
 		t.GetLocalDB().Set(kv.Key, kv.Value)
 	}
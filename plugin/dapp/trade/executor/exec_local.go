@@ -11,6 +11,9 @@ import (
 )
 
 func (t *trade) ExecLocal_SellLimit(sell *pty.TradeForSell, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if sell.AutoMatch {
+		return t.localAddMatch(tx, receipt, index)
+	}
 	return t.localAddLog(tx, receipt, index)
 }
 
@@ -23,6 +26,9 @@ func (t *trade) ExecLocal_RevokeSell(revoke *pty.TradeForRevokeSell, tx *types.T
 }
 
 func (t *trade) ExecLocal_BuyLimit(buy *pty.TradeForBuyLimit, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if buy.AutoMatch {
+		return t.localAddMatch(tx, receipt, index)
+	}
 	return t.localAddLog(tx, receipt, index)
 }
 
@@ -94,6 +100,11 @@ func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, i
 	}
 
 	set.KV = append(set.KV, newKvs...)
+	nftKvs, err := t.localAddNFTHolder(tx, receipt, txIndex)
+	if err != nil {
+		return nil, err
+	}
+	set.KV = append(set.KV, nftKvs...)
 	for _, kv := range set.KV {
 		t.GetLocalDB().Set(kv.Key, kv.Value)
 	}
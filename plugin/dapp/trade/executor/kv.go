@@ -4,9 +4,16 @@
 
 package executor
 
+import (
+	"fmt"
+
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
 const (
-	sellIDPrefix = "mavl-trade-sell-"
-	buyIDPrefix  = "mavl-trade-buy-"
+	sellIDPrefix    = "mavl-trade-sell-"
+	buyIDPrefix     = "mavl-trade-buy-"
+	orderBookPrefix = "mavl-trade-book-"
 )
 
 // ids
@@ -18,6 +25,20 @@ func calcTokenBuyID(hash string) string {
 	return buyIDPrefix + hash
 }
 
+// 订单簿按交易对和买卖方向区分
+func calcOrderBookKey(isSell bool, assetExec, assetSymbol, priceExec, priceSymbol string) []byte {
+	side := "buy"
+	if isSell {
+		side = "sell"
+	}
+	return []byte(fmt.Sprintf(orderBookPrefix+"%s-%s.%s-%s.%s", side, assetExec, assetSymbol, priceExec, priceSymbol))
+}
+
+// 订单簿中一个价格档位上的挂单
+func calcOrderBookLevelKey(bookKey []byte, level *pty.OrderBookLevel) []byte {
+	return []byte(fmt.Sprintf("%s-%d-%d", string(bookKey), level.PricePerBoardlot, level.AmountPerBoardlot))
+}
+
 // make a number as token's price whether cheap or dear
 // support 1e8 bty pre token or 1/1e8 bty pre token, [1Coins, 1e16Coins]
 // the number in key is used to sort buy orders and pages
@@ -0,0 +1,219 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 自动撮合订单的本地数据
+// 1. 新订单下单时可能已经部分或全部成交, 直接按状态数据库中的订单生成记录
+// 2. 被吃掉的挂单按成交记录更新
+// 3. 每一笔成交记录在 match 表中, 可以按订单或地址查询
+// 回滚使用框架的 rollback kv
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
+var optMatchTable = &table.Option{
+	Prefix:  "LODB-trade",
+	Name:    "match",
+	Primary: "txIndex",
+	Index: []string{
+		"sellID",
+		"buyID",
+		"seller",
+		"buyer",
+	},
+}
+
+// MatchRow match row
+type MatchRow struct {
+	*pty.LocalTradeMatch
+}
+
+// NewMatchRow create row
+func NewMatchRow() *MatchRow {
+	return &MatchRow{LocalTradeMatch: nil}
+}
+
+// CreateRow create row
+func (r *MatchRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.LocalTradeMatch{}}
+}
+
+// SetPayload set payload
+func (r *MatchRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.LocalTradeMatch); ok {
+		r.LocalTradeMatch = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get get index key
+func (r *MatchRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "txIndex":
+		return []byte(r.TxIndex), nil
+	case "sellID":
+		return []byte(r.SellID), nil
+	case "buyID":
+		return []byte(r.BuyID), nil
+	case "seller":
+		return []byte(r.Seller), nil
+	case "buyer":
+		return []byte(r.Buyer), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+// NewMatchTable create match table
+func NewMatchTable(kvdb dbm.KV) *table.Table {
+	rowMeta := NewMatchRow()
+	err := rowMeta.SetPayload(&pty.LocalTradeMatch{})
+	if err != nil {
+		panic(err)
+	}
+	t, err := table.NewTable(rowMeta, kvdb, optMatchTable)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func (t *trade) localAddMatch(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receipt.Ty != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+	orderTable := NewOrderTableV2(t.GetLocalDB())
+	matchTable := NewMatchTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	seq := 0
+	for _, item := range receipt.Logs {
+		switch item.Ty {
+		case pty.TyLogTradeSellLimit:
+			var receipt pty.ReceiptTradeSellLimit
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			sellOrder := t.getSellOrderFromDb([]byte(receipt.Base.SellID))
+			order := t.genSellLimit(tx, receipt.Base, sellOrder, txIndex)
+			order.IsFinished = order.Status != pty.TradeOrderStatusOnSale
+			if err := orderTable.Add(order); err != nil {
+				return nil, err
+			}
+		case pty.TyLogTradeBuyLimit:
+			var receipt pty.ReceiptTradeBuyLimit
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			buyOrder := t.getBuyOrderFromDb([]byte(receipt.Base.BuyID))
+			order := t.genBuyLimit(tx, receipt.Base, txIndex)
+			order.Status = buyOrder.Status
+			order.IsFinished = order.Status != pty.TradeOrderStatusOnBuy
+			if err := orderTable.Add(order); err != nil {
+				return nil, err
+			}
+		case pty.TyLogTradeMatch:
+			var receipt pty.ReceiptTradeMatch
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			// 被吃掉的挂单在本交易之前已经有本地记录
+			if receipt.IsSellTaker {
+				buyOrder := t.getBuyOrderFromDb([]byte(receipt.BuyID))
+				base := &pty.ReceiptBuyBase{BuyID: receipt.BuyID, BoughtBoardlot: buyOrder.BoughtBoardlot}
+				t.updateBuyLimit(tx, base, buyOrder, txIndex, orderTable)
+			} else {
+				sellOrder := t.getSellOrderFromDb([]byte(receipt.SellID))
+				base := &pty.ReceiptSellBase{SellID: receipt.SellID, SoldBoardlot: sellOrder.SoldBoardlot}
+				t.updateSellLimit(tx, base, sellOrder, txIndex, orderTable)
+			}
+			err := matchTable.Add(&pty.LocalTradeMatch{
+				TxIndex:     fmt.Sprintf("%s.%05d", txIndex, seq),
+				SellID:      receipt.SellID,
+				BuyID:       receipt.BuyID,
+				Seller:      receipt.Seller,
+				Buyer:       receipt.Buyer,
+				AssetExec:   receipt.AssetExec,
+				TokenSymbol: receipt.TokenSymbol,
+				PriceExec:   receipt.PriceExec,
+				PriceSymbol: receipt.PriceSymbol,
+				Amount:      receipt.Amount,
+				Price:       receipt.Price,
+				IsSellTaker: receipt.IsSellTaker,
+				TxHash:      common.ToHex(tx.Hash()),
+				Height:      receipt.Height,
+				BlockTime:   t.GetBlockTime(),
+			})
+			if err != nil {
+				return nil, err
+			}
+			seq++
+		}
+	}
+
+	var kvs []*types.KeyValue
+	for _, tab := range []*table.Table{orderTable, matchTable} {
+		kv, err := tab.Save()
+		if err != nil {
+			tradelog.Error("localAddMatch table.Save failed", "error", err)
+			return nil, err
+		}
+		kvs = append(kvs, kv...)
+	}
+	// 回滚数据要在写入之前读取旧值
+	kvs = t.AddRollbackKV(tx, tx.Execer, kvs)
+	for _, kv := range kvs {
+		t.GetLocalDB().Set(kv.Key, kv.Value)
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+func (t *trade) localDelMatch(tx *types.Transaction) (*types.LocalDBSet, error) {
+	kvs, err := t.DelRollbackKV(tx, tx.Execer)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range kvs {
+		t.GetLocalDB().Set(kv.Key, kv.Value)
+	}
+	return &types.LocalDBSet{KV: kvs}, nil
+}
+
+func listMatches(db dbm.KVDB, req *pty.ReqTradeMatches) ([]*table.Row, error) {
+	var indexName, index string
+	if req.OrderID != "" {
+		if strings.HasPrefix(req.OrderID, sellIDPrefix) {
+			indexName = "sellID"
+		} else if strings.HasPrefix(req.OrderID, buyIDPrefix) {
+			indexName = "buyID"
+		} else {
+			return nil, types.ErrInvalidParam
+		}
+		index = req.OrderID
+	} else if req.Addr != "" {
+		indexName = "buyer"
+		if req.IsSell {
+			indexName = "seller"
+		}
+		index = req.Addr
+	} else {
+		return nil, types.ErrInvalidParam
+	}
+	var primary []byte
+	if len(req.FromKey) > 0 {
+		primary = []byte(req.FromKey)
+	}
+	query := NewMatchTable(db).GetQuery(db)
+	return query.ListIndex(indexName, []byte(index), primary, req.Count, req.Direction)
+}
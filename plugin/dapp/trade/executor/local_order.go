@@ -196,6 +196,7 @@ func (t *trade) genSellLimit(tx *types.Transaction, sell *pty.ReceiptSellBase,
 		IsFinished:        false,
 		PriceExec:         sellorder.PriceExec,
 		PriceSymbol:       sellorder.PriceSymbol,
+		TokenID:           sellorder.TokenID,
 	}
 	return order
 }
@@ -295,6 +296,7 @@ func (t *trade) genSellMarket(tx *types.Transaction, sell *pty.ReceiptSellBase,
 		IsFinished:  true,
 		PriceExec:   sell.PriceExec,
 		PriceSymbol: sell.PriceSymbol,
+		TokenID:     sell.TokenID,
 	}
 	return order
 }
@@ -321,6 +323,7 @@ func (t *trade) genBuyLimit(tx *types.Transaction, buy *pty.ReceiptBuyBase, txIn
 		IsFinished:        false,
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		TokenID:           buy.TokenID,
 	}
 	return order
 }
@@ -395,6 +398,7 @@ func (t *trade) genBuyMarket(tx *types.Transaction, buy *pty.ReceiptBuyBase, txI
 		IsFinished:        true,
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		TokenID:           buy.TokenID,
 	}
 	return order
 }
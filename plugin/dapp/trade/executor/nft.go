@@ -0,0 +1,103 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+/*
+   token 合约的 NFT 也可以在 trade 里面交易, 订单中 tokenID 非空表示交易的是这一个物品
+   一个订单只有一手, 每手一个物品, 物品在 token 合约中的托管记录由 trade 冻结/转移
+*/
+
+import (
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	tokenE "github.com/33cn/plugin/plugin/dapp/token/executor"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	pt "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
+// 订单资产账户, account.DB 和 NFT 的托管记录都实现了这组接口
+type assetAccount interface {
+	ExecFrozen(addr, execaddr string, amount int64) (*types.Receipt, error)
+	ExecActive(addr, execaddr string, amount int64) (*types.Receipt, error)
+	ExecTransfer(from, to, execaddr string, amount int64) (*types.Receipt, error)
+	ExecTransferFrozen(from, to, execaddr string, amount int64) (*types.Receipt, error)
+}
+
+// nft 一手只有一个物品, amount 在下单时已经检查过
+type nftAccount struct {
+	acc     *tokenE.NFTAccountDB
+	tokenID string
+}
+
+func (n *nftAccount) ExecFrozen(addr, execaddr string, amount int64) (*types.Receipt, error) {
+	return n.acc.ExecFrozen(addr, execaddr, n.tokenID)
+}
+
+func (n *nftAccount) ExecActive(addr, execaddr string, amount int64) (*types.Receipt, error) {
+	return n.acc.ExecActive(addr, execaddr, n.tokenID)
+}
+
+func (n *nftAccount) ExecTransfer(from, to, execaddr string, amount int64) (*types.Receipt, error) {
+	return n.acc.ExecTransfer(from, to, execaddr, n.tokenID)
+}
+
+func (n *nftAccount) ExecTransferFrozen(from, to, execaddr string, amount int64) (*types.Receipt, error) {
+	return n.acc.ExecTransferFrozen(from, to, execaddr, n.tokenID)
+}
+
+func createAssetDB(cfg *types.Chain33Config, height int64, db db.KV, exec, symbol, tokenID string) (assetAccount, error) {
+	if tokenID != "" {
+		return &nftAccount{acc: tokenE.NewNFTAccountDB(db, symbol), tokenID: tokenID}, nil
+	}
+	acc, err := createAccountDB(cfg, height, db, exec, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+func checkNFTOrder(cfg *types.Chain33Config, height int64, exec, tokenID string, amountPerBoardlot, minBoardlot, totalBoardlot int64) error {
+	if tokenID == "" {
+		return nil
+	}
+	if !cfg.IsDappFork(height, pt.TradeX, pt.ForkTradeNFTX) {
+		return types.ErrNotSupport
+	}
+	if exec != defaultAssetExec {
+		return types.ErrInvalidParam
+	}
+	if amountPerBoardlot != types.TokenPrecision || minBoardlot != 1 || totalBoardlot != 1 {
+		return pt.ErrTNFTBoardlot
+	}
+	return nil
+}
+
+func hasNFTLog(receipt *types.ReceiptData) bool {
+	for _, item := range receipt.Logs {
+		if item.Ty == tokenty.TyLogNFTExecItem {
+			return true
+		}
+	}
+	return false
+}
+
+// 成交时物品在 trade 内部转移, 由 trade 记录新的持有人, 回滚时按交易记录的 kv 恢复
+func (t *trade) localAddNFTHolder(tx *types.Transaction, receipt *types.ReceiptData, txIndex string) ([]*types.KeyValue, error) {
+	if !hasNFTLog(receipt) {
+		return nil, nil
+	}
+	kvs, err := tokenE.NFTExecLocal(t.GetLocalDB(), pt.TradeX, txIndex, receipt.Logs)
+	if err != nil {
+		return nil, err
+	}
+	return t.AddRollbackKV(tx, tx.Execer, kvs), nil
+}
+
+func (t *trade) localDelNFTHolder(tx *types.Transaction, receipt *types.ReceiptData) ([]*types.KeyValue, error) {
+	if !hasNFTLog(receipt) {
+		return nil, nil
+	}
+	return t.DelRollbackKV(tx, tx.Execer)
+}
@@ -0,0 +1,486 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+/*
+   订单簿和自动撮合
+
+   ForkTradeOrderBook 之后, 挂单(非 NFT, 非众筹)按交易对和买卖方向记录在状态数据库的订单簿中,
+   卖单按单价从低到高, 买单按单价从高到低, 单价相同的按挂单先后排序.
+   订单簿的key下只记录有挂单的价格档位, 每个价格档位的挂单单独保存在档位的key下.
+
+   下单时指定 autoMatch, 新订单会吃掉对手方向上价格交叉的挂单:
+     1. 以挂单的价格成交, 买单吃卖单时多冻结的部分退回买方
+     2. 两边每手数量可以不同, 每次成交的数量是两边每手数量的公倍数, 都按整手结算
+     3. 挂单成交手数不能少于它的起卖/起买手数, 除非刚好吃完
+     4. 不和自己的挂单成交, 一次最多访问 orderBookMatchLimit 个挂单, 跳过的挂单也计数
+   未成交的部分按普通挂单进入订单簿.
+   ForkTradeOrderBook 之前的挂单不在订单簿中, 仍然只能通过指定订单号成交.
+*/
+
+import (
+	"math"
+	"math/big"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+)
+
+const orderBookMatchLimit = 100
+
+func checkAutoMatch(cfg *types.Chain33Config, height int64, autoMatch bool, tokenID string, amountPerBoardlot, pricePerBoardlot int64) error {
+	if !autoMatch {
+		return nil
+	}
+	if !cfg.IsDappFork(height, pty.TradeX, pty.ForkTradeOrderBookX) {
+		return types.ErrNotSupport
+	}
+	if tokenID != "" {
+		return pty.ErrTNFTAutoMatch
+	}
+	if amountPerBoardlot <= 0 || pricePerBoardlot <= 0 {
+		return types.ErrInvalidParam
+	}
+	return nil
+}
+
+// 只有可以正常成交的订单进入订单簿
+func inOrderBook(cfg *types.Chain33Config, height int64, tokenID string, amountPerBoardlot, pricePerBoardlot int64) bool {
+	if !cfg.IsDappFork(height, pty.TradeX, pty.ForkTradeOrderBookX) {
+		return false
+	}
+	return tokenID == "" && amountPerBoardlot > 0 && pricePerBoardlot > 0
+}
+
+func getOrderBookKey(cfg *types.Chain33Config, isSell bool, assetExec, assetSymbol, priceExec, priceSymbol string) []byte {
+	if priceExec == "" {
+		priceExec = defaultPriceExec
+		priceSymbol = cfg.GetCoinSymbol()
+	}
+	return calcOrderBookKey(isSell, assetExec, assetSymbol, priceExec, priceSymbol)
+}
+
+// 价格档位, 单价约分后相同的挂单在同一个档位
+func getOrderBookLevel(pricePerBoardlot, amountPerBoardlot int64) *pty.OrderBookLevel {
+	g := gcd(pricePerBoardlot, amountPerBoardlot)
+	return &pty.OrderBookLevel{PricePerBoardlot: pricePerBoardlot / g, AmountPerBoardlot: amountPerBoardlot / g}
+}
+
+func loadOrderBookValue(db dbm.KV, key []byte, msg types.Message) error {
+	value, err := db.Get(key)
+	if err == types.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return types.Decode(value, msg)
+}
+
+func saveOrderBookValue(db dbm.KV, key []byte, msg types.Message) []*types.KeyValue {
+	value := types.Encode(msg)
+	db.Set(key, value)
+	return []*types.KeyValue{{Key: key, Value: value}}
+}
+
+func loadOrderBookLevels(db dbm.KV, key []byte) (*pty.OrderBookLevels, error) {
+	var levels pty.OrderBookLevels
+	if err := loadOrderBookValue(db, key, &levels); err != nil {
+		return nil, err
+	}
+	return &levels, nil
+}
+
+// 一个价格档位上的挂单
+func loadOrderBook(db dbm.KV, key []byte) (*pty.OrderBook, error) {
+	var book pty.OrderBook
+	if err := loadOrderBookValue(db, key, &book); err != nil {
+		return nil, err
+	}
+	return &book, nil
+}
+
+// 比较每单位资产的价格 price1/amount1 和 price2/amount2
+func cmpUnitPrice(price1, amount1, price2, amount2 int64) int {
+	x := new(big.Int).Mul(big.NewInt(price1), big.NewInt(amount2))
+	y := new(big.Int).Mul(big.NewInt(price2), big.NewInt(amount1))
+	return x.Cmp(y)
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// 一次成交的资产数量, 需要同时是两边每手数量的整数倍
+func calcMatchAmount(remain1, amountPerBoardlot1, remain2, amountPerBoardlot2 int64) int64 {
+	x := amountPerBoardlot1 / gcd(amountPerBoardlot1, amountPerBoardlot2)
+	if x > math.MaxInt64/amountPerBoardlot2 {
+		return 0
+	}
+	unit := x * amountPerBoardlot2
+	amount := remain1 * amountPerBoardlot1
+	if remain2*amountPerBoardlot2 < amount {
+		amount = remain2 * amountPerBoardlot2
+	}
+	return amount / unit * unit
+}
+
+// 按单价插入价格档位, 档位已存在时返回 false
+func insertOrderBookLevel(levels *pty.OrderBookLevels, isSell bool, level *pty.OrderBookLevel) bool {
+	pos := len(levels.Levels)
+	for i, l := range levels.Levels {
+		c := cmpUnitPrice(level.PricePerBoardlot, level.AmountPerBoardlot, l.PricePerBoardlot, l.AmountPerBoardlot)
+		if c == 0 {
+			return false
+		}
+		if isSell && c < 0 || !isSell && c > 0 {
+			pos = i
+			break
+		}
+	}
+	levels.Levels = append(levels.Levels, nil)
+	copy(levels.Levels[pos+1:], levels.Levels[pos:])
+	levels.Levels[pos] = level
+	return true
+}
+
+func removeOrderBookLevel(levels *pty.OrderBookLevels, level *pty.OrderBookLevel) {
+	for i, l := range levels.Levels {
+		if l.PricePerBoardlot == level.PricePerBoardlot && l.AmountPerBoardlot == level.AmountPerBoardlot {
+			levels.Levels = append(levels.Levels[:i], levels.Levels[i+1:]...)
+			return
+		}
+	}
+}
+
+func (action *tradeAction) addOrderBook(isSell bool, key []byte, entry *pty.OrderBookEntry) ([]*types.KeyValue, error) {
+	levels, err := loadOrderBookLevels(action.db, key)
+	if err != nil {
+		return nil, err
+	}
+	level := getOrderBookLevel(entry.PricePerBoardlot, entry.AmountPerBoardlot)
+	levelKey := calcOrderBookLevelKey(key, level)
+	book, err := loadOrderBook(action.db, levelKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var kv []*types.KeyValue
+	if insertOrderBookLevel(levels, isSell, level) {
+		kv = append(kv, saveOrderBookValue(action.db, key, levels)...)
+	}
+	book.Entries = append(book.Entries, entry)
+	kv = append(kv, saveOrderBookValue(action.db, levelKey, book)...)
+	return kv, nil
+}
+
+// 订单成交完或撤销时从订单簿中删除, 不在订单簿中的老订单不需要处理
+func (action *tradeAction) removeOrderBook(key []byte, orderID string, amountPerBoardlot, pricePerBoardlot int64) ([]*types.KeyValue, error) {
+	if amountPerBoardlot <= 0 || pricePerBoardlot <= 0 {
+		return nil, nil
+	}
+	level := getOrderBookLevel(pricePerBoardlot, amountPerBoardlot)
+	levelKey := calcOrderBookLevelKey(key, level)
+	book, err := loadOrderBook(action.db, levelKey)
+	if err != nil {
+		return nil, err
+	}
+	for i, e := range book.Entries {
+		if e.OrderID != orderID {
+			continue
+		}
+		book.Entries = append(book.Entries[:i], book.Entries[i+1:]...)
+		kv := saveOrderBookValue(action.db, levelKey, book)
+		if len(book.Entries) == 0 {
+			levels, err := loadOrderBookLevels(action.db, key)
+			if err != nil {
+				return nil, err
+			}
+			removeOrderBookLevel(levels, level)
+			kv = append(kv, saveOrderBookValue(action.db, key, levels)...)
+		}
+		return kv, nil
+	}
+	return nil, nil
+}
+
+// 按价格优先, 时间优先的顺序访问订单簿上的挂单, 不论是否成交每个挂单都计入 orderBookMatchLimit
+// stop 返回 true 时停止撮合, visit 返回挂单是否还留在订单簿上
+func (action *tradeAction) walkOrderBook(key []byte, stop func(level *pty.OrderBookLevel) bool,
+	visit func(entry *pty.OrderBookEntry) (bool, error)) ([]*types.KeyValue, error) {
+	levels, err := loadOrderBookLevels(action.db, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var kv []*types.KeyValue
+	var remainLevels []*pty.OrderBookLevel
+	visited := 0
+	for i, level := range levels.Levels {
+		if visited >= orderBookMatchLimit || stop(level) {
+			remainLevels = append(remainLevels, levels.Levels[i:]...)
+			break
+		}
+		levelKey := calcOrderBookLevelKey(key, level)
+		book, err := loadOrderBook(action.db, levelKey)
+		if err != nil {
+			return nil, err
+		}
+		var entries []*pty.OrderBookEntry
+		for j, entry := range book.Entries {
+			if visited >= orderBookMatchLimit || stop(level) {
+				entries = append(entries, book.Entries[j:]...)
+				break
+			}
+			visited++
+			keep, err := visit(entry)
+			if err != nil {
+				return nil, err
+			}
+			if keep {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) != len(book.Entries) {
+			kv = append(kv, saveOrderBookValue(action.db, levelKey, &pty.OrderBook{Entries: entries})...)
+		}
+		if len(entries) > 0 {
+			remainLevels = append(remainLevels, level)
+		}
+	}
+	if len(remainLevels) != len(levels.Levels) {
+		kv = append(kv, saveOrderBookValue(action.db, key, &pty.OrderBookLevels{Levels: remainLevels})...)
+	}
+	return kv, nil
+}
+
+func (action *tradeAction) sellBookKey(order *pty.SellOrder) []byte {
+	cfg := action.api.GetConfig()
+	return getOrderBookKey(cfg, true, order.AssetExec, order.TokenSymbol, order.PriceExec, order.PriceSymbol)
+}
+
+func (action *tradeAction) buyBookKey(order *pty.BuyLimitOrder) []byte {
+	cfg := action.api.GetConfig()
+	return getOrderBookKey(cfg, false, order.AssetExec, order.TokenSymbol, order.PriceExec, order.PriceSymbol)
+}
+
+func (action *tradeAction) addSellOrderBook(order *pty.SellOrder) ([]*types.KeyValue, error) {
+	entry := &pty.OrderBookEntry{
+		OrderID:           order.SellID,
+		AmountPerBoardlot: order.AmountPerBoardlot,
+		PricePerBoardlot:  order.PricePerBoardlot,
+		Height:            action.height,
+		Index:             action.index,
+	}
+	return action.addOrderBook(true, action.sellBookKey(order), entry)
+}
+
+func (action *tradeAction) addBuyOrderBook(order *pty.BuyLimitOrder) ([]*types.KeyValue, error) {
+	entry := &pty.OrderBookEntry{
+		OrderID:           order.BuyID,
+		AmountPerBoardlot: order.AmountPerBoardlot,
+		PricePerBoardlot:  order.PricePerBoardlot,
+		Height:            action.height,
+		Index:             action.index,
+	}
+	return action.addOrderBook(false, action.buyBookKey(order), entry)
+}
+
+func (action *tradeAction) getMatchLog(sell *pty.SellOrder, buy *pty.BuyLimitOrder, amount, price int64, isSellTaker bool) *types.ReceiptLog {
+	match := &pty.ReceiptTradeMatch{
+		SellID:      sell.SellID,
+		BuyID:       buy.BuyID,
+		Seller:      sell.Address,
+		Buyer:       buy.Address,
+		AssetExec:   sell.AssetExec,
+		TokenSymbol: sell.TokenSymbol,
+		PriceExec:   sell.PriceExec,
+		PriceSymbol: sell.PriceSymbol,
+		Amount:      amount,
+		Price:       price,
+		IsSellTaker: isSellTaker,
+		TxHash:      action.txhash,
+		Height:      action.height,
+	}
+	return &types.ReceiptLog{Ty: pty.TyLogTradeMatch, Log: types.Encode(match)}
+}
+
+// 新卖单吃掉价格不低于它的买单, 成交后的数量和状态直接更新在 sell 中
+func (action *tradeAction) matchSell(sell *pty.SellOrder) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	key := getOrderBookKey(cfg, false, sell.AssetExec, sell.TokenSymbol, sell.PriceExec, sell.PriceSymbol)
+	accDB, err := createAssetDB(cfg, action.height, action.db, sell.AssetExec, sell.TokenSymbol, sell.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	priceAcc, err := createPriceDB(cfg, action.height, action.db, sell.PriceExec, sell.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	stop := func(level *pty.OrderBookLevel) bool {
+		return sell.SoldBoardlot == sell.TotalBoardlot ||
+			cmpUnitPrice(sell.PricePerBoardlot, sell.AmountPerBoardlot, level.PricePerBoardlot, level.AmountPerBoardlot) > 0
+	}
+	visit := func(entry *pty.OrderBookEntry) (bool, error) {
+		buy, err := getBuyOrderFromID([]byte(entry.OrderID), action.db)
+		if err != nil {
+			return false, err
+		}
+		buyRemain := buy.TotalBoardlot - buy.BoughtBoardlot
+		amount := calcMatchAmount(sell.TotalBoardlot-sell.SoldBoardlot, sell.AmountPerBoardlot, buyRemain, buy.AmountPerBoardlot)
+		buyCnt := amount / buy.AmountPerBoardlot
+		if buy.Address == sell.Address || amount == 0 || buyCnt < buy.MinBoardlot && buyCnt != buyRemain {
+			return true, nil
+		}
+
+		price := buyCnt * buy.PricePerBoardlot
+		receiptAsset, err := accDB.ExecTransferFrozen(sell.Address, buy.Address, action.execaddr, amount)
+		if err != nil {
+			tradelog.Error("matchSell asset", "addrFrom", sell.Address, "addrTo", buy.Address, "amount", amount, "err", err)
+			return false, err
+		}
+		receiptPrice, err := priceAcc.ExecTransferFrozen(buy.Address, sell.Address, action.execaddr, price)
+		if err != nil {
+			tradelog.Error("matchSell price", "addrFrom", buy.Address, "addrTo", sell.Address, "price", price, "err", err)
+			return false, err
+		}
+
+		buy.BoughtBoardlot += buyCnt
+		if buy.BoughtBoardlot == buy.TotalBoardlot {
+			buy.Status = pty.TradeOrderStatusBoughtOut
+		}
+		sell.SoldBoardlot += amount / sell.AmountPerBoardlot
+		if sell.SoldBoardlot == sell.TotalBoardlot {
+			sell.Status = pty.TradeOrderStatusSoldOut
+		}
+
+		logs = append(logs, receiptAsset.Logs...)
+		logs = append(logs, receiptPrice.Logs...)
+		logs = append(logs, action.getMatchLog(sell, buy, amount, price, true))
+		kv = append(kv, receiptAsset.KV...)
+		kv = append(kv, receiptPrice.KV...)
+		kv = append(kv, newBuyDB(*buy).save(action.db)...)
+		return buy.Status != pty.TradeOrderStatusBoughtOut, nil
+	}
+	bookKV, err := action.walkOrderBook(key, stop, visit)
+	if err != nil {
+		return nil, err
+	}
+	kv = append(kv, bookKV...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// 新买单吃掉价格不高于它的卖单, 以卖单价格成交, 多冻结的部分退回
+func (action *tradeAction) matchBuy(buy *pty.BuyLimitOrder) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	key := getOrderBookKey(cfg, true, buy.AssetExec, buy.TokenSymbol, buy.PriceExec, buy.PriceSymbol)
+	accDB, err := createAssetDB(cfg, action.height, action.db, buy.AssetExec, buy.TokenSymbol, buy.TokenID)
+	if err != nil {
+		return nil, err
+	}
+	priceAcc, err := createPriceDB(cfg, action.height, action.db, buy.PriceExec, buy.PriceSymbol)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	stop := func(level *pty.OrderBookLevel) bool {
+		return buy.BoughtBoardlot == buy.TotalBoardlot ||
+			cmpUnitPrice(buy.PricePerBoardlot, buy.AmountPerBoardlot, level.PricePerBoardlot, level.AmountPerBoardlot) < 0
+	}
+	visit := func(entry *pty.OrderBookEntry) (bool, error) {
+		sell, err := getSellOrderFromID([]byte(entry.OrderID), action.db)
+		if err != nil {
+			return false, err
+		}
+		sellRemain := sell.TotalBoardlot - sell.SoldBoardlot
+		amount := calcMatchAmount(buy.TotalBoardlot-buy.BoughtBoardlot, buy.AmountPerBoardlot, sellRemain, sell.AmountPerBoardlot)
+		sellCnt := amount / sell.AmountPerBoardlot
+		if sell.Address == buy.Address || amount == 0 || sellCnt < sell.MinBoardlot && sellCnt != sellRemain {
+			return true, nil
+		}
+
+		buyCnt := amount / buy.AmountPerBoardlot
+		price := sellCnt * sell.PricePerBoardlot
+		receiptPrice, err := priceAcc.ExecTransferFrozen(buy.Address, sell.Address, action.execaddr, price)
+		if err != nil {
+			tradelog.Error("matchBuy price", "addrFrom", buy.Address, "addrTo", sell.Address, "price", price, "err", err)
+			return false, err
+		}
+		logs = append(logs, receiptPrice.Logs...)
+		kv = append(kv, receiptPrice.KV...)
+		if refund := buyCnt*buy.PricePerBoardlot - price; refund > 0 {
+			receiptRefund, err := priceAcc.ExecActive(buy.Address, action.execaddr, refund)
+			if err != nil {
+				tradelog.Error("matchBuy refund", "addr", buy.Address, "refund", refund, "err", err)
+				return false, err
+			}
+			logs = append(logs, receiptRefund.Logs...)
+			kv = append(kv, receiptRefund.KV...)
+		}
+		receiptAsset, err := accDB.ExecTransferFrozen(sell.Address, buy.Address, action.execaddr, amount)
+		if err != nil {
+			tradelog.Error("matchBuy asset", "addrFrom", sell.Address, "addrTo", buy.Address, "amount", amount, "err", err)
+			return false, err
+		}
+
+		sell.SoldBoardlot += sellCnt
+		if sell.SoldBoardlot == sell.TotalBoardlot {
+			sell.Status = pty.TradeOrderStatusSoldOut
+		}
+		buy.BoughtBoardlot += buyCnt
+		if buy.BoughtBoardlot == buy.TotalBoardlot {
+			buy.Status = pty.TradeOrderStatusBoughtOut
+		}
+
+		logs = append(logs, receiptAsset.Logs...)
+		logs = append(logs, action.getMatchLog(sell, buy, amount, price, false))
+		kv = append(kv, receiptAsset.KV...)
+		kv = append(kv, newSellDB(*sell).save(action.db)...)
+		return sell.Status != pty.TradeOrderStatusSoldOut, nil
+	}
+	bookKV, err := action.walkOrderBook(key, stop, visit)
+	if err != nil {
+		return nil, err
+	}
+	kv = append(kv, bookKV...)
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+func (action *tradeAction) removeFinishedSellOrder(order *pty.SellOrder) ([]*types.KeyValue, error) {
+	if order.Status != pty.TradeOrderStatusSoldOut {
+		return nil, nil
+	}
+	return action.removeSellOrderBook(order)
+}
+
+func (action *tradeAction) removeFinishedBuyOrder(order *pty.BuyLimitOrder) ([]*types.KeyValue, error) {
+	if order.Status != pty.TradeOrderStatusBoughtOut {
+		return nil, nil
+	}
+	return action.removeBuyOrderBook(order)
+}
+
+func (action *tradeAction) removeSellOrderBook(order *pty.SellOrder) ([]*types.KeyValue, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, pty.TradeX, pty.ForkTradeOrderBookX) {
+		return nil, nil
+	}
+	return action.removeOrderBook(action.sellBookKey(order), order.SellID, order.AmountPerBoardlot, order.PricePerBoardlot)
+}
+
+func (action *tradeAction) removeBuyOrderBook(order *pty.BuyLimitOrder) ([]*types.KeyValue, error) {
+	if !action.api.GetConfig().IsDappFork(action.height, pty.TradeX, pty.ForkTradeOrderBookX) {
+		return nil, nil
+	}
+	return action.removeOrderBook(action.buyBookKey(order), order.BuyID, order.AmountPerBoardlot, order.PricePerBoardlot)
+}
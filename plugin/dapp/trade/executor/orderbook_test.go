@@ -0,0 +1,267 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/chain33/util"
+	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type bookTestEnv struct {
+	t      *testing.T
+	cfg    *types.Chain33Config
+	api    *apimock.QueueProtocolAPI
+	kvdb   dbm.KVDB
+	height int64
+}
+
+func (env *bookTestEnv) newDriver() *trade {
+	driver := newTrade()
+	driver.SetAPI(env.api)
+	driver.SetEnv(env.height, 1539918074+env.height, 1539918074)
+	driver.SetStateDB(env.kvdb)
+	driver.SetLocalDB(env.kvdb)
+	return driver.(*trade)
+}
+
+func (env *bookTestEnv) exec(tx *types.Transaction, privKey string) (*types.Transaction, *types.Receipt) {
+	tx, err := signTx(tx, privKey)
+	assert.Nil(env.t, err)
+	env.height++
+	driver := env.newDriver()
+	receipt, err := driver.Exec(tx, 1)
+	if !assert.Nil(env.t, err) {
+		env.t.FailNow()
+	}
+	set, err := driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+	assert.Nil(env.t, err)
+	for _, kv := range set.KV {
+		env.kvdb.Set(kv.Key, kv.Value)
+	}
+	return tx, receipt
+}
+
+func (env *bookTestEnv) sell(privKey string, args *orderArgs, autoMatch bool) (*types.Transaction, *types.Receipt) {
+	tx, err := pty.CreateRawTradeSellTx(env.cfg, &pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: args.amount,
+		MinBoardlot:       args.min,
+		PricePerBoardlot:  args.price,
+		TotalBoardlot:     args.total,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		AutoMatch:         autoMatch,
+	})
+	assert.Nil(env.t, err)
+	return env.exec(tx, privKey)
+}
+
+func (env *bookTestEnv) buy(privKey string, args *orderArgs, autoMatch bool) (*types.Transaction, *types.Receipt) {
+	tx, err := pty.CreateRawTradeBuyLimitTx(env.cfg, &pty.TradeBuyLimitTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: args.amount,
+		MinBoardlot:       args.min,
+		PricePerBoardlot:  args.price,
+		TotalBoardlot:     args.total,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+		AutoMatch:         autoMatch,
+	})
+	assert.Nil(env.t, err)
+	return env.exec(tx, privKey)
+}
+
+func (env *bookTestEnv) matches(req *pty.ReqTradeMatches) []*pty.LocalTradeMatch {
+	reply, err := env.newDriver().Query_GetTradeMatches(req)
+	if err != nil {
+		return nil
+	}
+	return reply.(*pty.ReplyTradeMatches).Matches
+}
+
+func orderIDFromReceipt(receipt *types.Receipt) string {
+	for _, log := range receipt.Logs {
+		switch log.Ty {
+		case pty.TyLogTradeSellLimit:
+			var r pty.ReceiptTradeSellLimit
+			types.Decode(log.Log, &r)
+			return r.Base.SellID
+		case pty.TyLogTradeBuyLimit:
+			var r pty.ReceiptTradeBuyLimit
+			types.Decode(log.Log, &r)
+			return r.Base.BuyID
+		}
+	}
+	return ""
+}
+
+// 按撮合顺序列出订单簿上所有价格档位的挂单
+func listOrderBook(t *testing.T, db dbm.KV, key []byte) []*pty.OrderBookEntry {
+	levels, err := loadOrderBookLevels(db, key)
+	assert.Nil(t, err)
+	var entries []*pty.OrderBookEntry
+	for _, level := range levels.Levels {
+		book, err := loadOrderBook(db, calcOrderBookLevelKey(key, level))
+		assert.Nil(t, err)
+		assert.NotEqual(t, 0, len(book.Entries))
+		entries = append(entries, book.Entries...)
+	}
+	return entries
+}
+
+func TestTradeOrderBook(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	_, _, kvdb := util.CreateTestDB()
+	env := &bookTestEnv{t: t, cfg: cfg, api: api, kvdb: kvdb, height: 10}
+
+	execAddr := address.ExecAddress(pty.TradeX)
+	coinsAcc := account.NewCoinsAccount(cfg)
+	coinsAcc.SetDB(kvdb)
+	tokenAcc, _ := account.NewAccountDB(cfg, AssetExecToken, Symbol, kvdb)
+	total := int64(100 * types.Coin)
+	kvdb.Set(calcTokenKey(Symbol), types.Encode(&types.ReqString{Data: Symbol}))
+	for _, node := range Nodes {
+		coinsAcc.SaveExecAccount(execAddr, &types.Account{Addr: string(node), Balance: total})
+		tokenAcc.SaveExecAccount(execAddr, &types.Account{Addr: string(node), Balance: total})
+	}
+
+	// 卖单按单价排序: D 1.5, A 2
+	_, receipt := env.sell(PrivKeyA, &orderArgs{1e8, 2, 2e8, 10}, false)
+	sellA := orderIDFromReceipt(receipt)
+	_, receipt = env.sell(PrivKeyD, &orderArgs{2e8, 1, 3e8, 5}, false)
+	sellD := orderIDFromReceipt(receipt)
+	book := listOrderBook(t, kvdb, getOrderBookKey(cfg, true, AssetExecToken, Symbol, "coins", "bty"))
+	assert.Equal(t, 2, len(book))
+	assert.Equal(t, sellD, book[0].OrderID)
+
+	// B 以单价 2.5 买 15 个, 先吃完 D, 再吃 A 的 5 手, 多冻结的退回
+	buyTx, receipt := env.buy(PrivKeyB, &orderArgs{1e8, 1, 2.5e8, 15}, true)
+	buyB := orderIDFromReceipt(receipt)
+	buyHeight := env.height
+	accB := coinsAcc.LoadExecAccount(string(Nodes[1]), execAddr)
+	assert.Equal(t, total-25e8, accB.Balance)
+	assert.Equal(t, int64(0), accB.Frozen)
+	assert.Equal(t, total+15e8, tokenAcc.LoadExecAccount(string(Nodes[1]), execAddr).Balance)
+	assert.Equal(t, total+10e8, coinsAcc.LoadExecAccount(string(Nodes[0]), execAddr).Balance)
+	assert.Equal(t, int64(5e8), tokenAcc.LoadExecAccount(string(Nodes[0]), execAddr).Frozen)
+	assert.Equal(t, total+15e8, coinsAcc.LoadExecAccount(string(Nodes[3]), execAddr).Balance)
+
+	buyOrder, err := getBuyOrderFromID([]byte(buyB), kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusBoughtOut), buyOrder.Status)
+	book = listOrderBook(t, kvdb, getOrderBookKey(cfg, true, AssetExecToken, Symbol, "coins", "bty"))
+	assert.Equal(t, 1, len(book))
+	assert.Equal(t, sellA, book[0].OrderID)
+	assert.Equal(t, 0, len(listOrderBook(t, kvdb, getOrderBookKey(cfg, false, AssetExecToken, Symbol, "coins", "bty"))))
+
+	fills := env.matches(&pty.ReqTradeMatches{OrderID: buyB, Count: 10, Direction: 1})
+	assert.Equal(t, 2, len(fills))
+	assert.Equal(t, sellD, fills[0].SellID)
+	assert.Equal(t, int64(10e8), fills[0].Amount)
+	assert.Equal(t, int64(15e8), fills[0].Price)
+	assert.Equal(t, int64(5e8), fills[1].Amount)
+	assert.Equal(t, int64(10e8), fills[1].Price)
+	assert.Equal(t, 1, len(env.matches(&pty.ReqTradeMatches{Addr: string(Nodes[0]), IsSell: true, Count: 10})))
+
+	resp, err := env.newDriver().Query_GetOnesOrderWithStatus(&pty.ReqAddrAssets{Addr: string(Nodes[0]), Status: pty.TradeOrderStatusOnSale, Count: 10, Direction: 1})
+	assert.Nil(t, err)
+	orders := resp.(*pty.ReplyTradeOrders).Orders
+	assert.Equal(t, 1, len(orders))
+	assert.Equal(t, int64(5), orders[0].TradedBoardlot)
+	resp, err = env.newDriver().Query_GetOnesOrderWithStatus(&pty.ReqAddrAssets{Addr: string(Nodes[1]), Status: pty.TradeOrderStatusBoughtOut, Count: 10, Direction: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15), resp.(*pty.ReplyTradeOrders).Orders[0].TradedBoardlot)
+
+	// C 的买单挂在订单簿上, D 以单价 1.7 卖出时按 C 的价格 1.8 成交
+	_, receipt = env.buy(PrivKeyC, &orderArgs{1e8, 1, 1.8e8, 4}, false)
+	buyC := orderIDFromReceipt(receipt)
+	_, receipt = env.sell(PrivKeyD, &orderArgs{2e8, 1, 3.4e8, 2}, true)
+	sellD2 := orderIDFromReceipt(receipt)
+	sellOrder, err := getSellOrderFromID([]byte(sellD2), kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(pty.TradeOrderStatusSoldOut), sellOrder.Status)
+	assert.Equal(t, total+15e8+7.2e8, coinsAcc.LoadExecAccount(string(Nodes[3]), execAddr).Balance)
+	fills = env.matches(&pty.ReqTradeMatches{OrderID: buyC, Count: 10, Direction: 1})
+	assert.Equal(t, 1, len(fills))
+	assert.True(t, fills[0].IsSellTaker)
+
+	// 不能和 NFT 订单或众筹一起使用
+	tx, _ := pty.CreateRawTradeSellTx(cfg, &pty.TradeSellTx{TokenSymbol: Symbol, AmountPerBoardlot: 1e8, TotalBoardlot: 1, MinBoardlot: 1,
+		AssetExec: AssetExecToken, TokenID: "1", AutoMatch: true})
+	tx, _ = signTx(tx, PrivKeyA)
+	_, err = env.newDriver().Exec(tx, 1)
+	assert.Equal(t, pty.ErrTNFTAutoMatch, err)
+
+	// 回滚本地数据
+	env.height = buyHeight
+	driver := env.newDriver()
+	_, err = driver.ExecDelLocal(buyTx, &types.ReceiptData{Ty: types.ExecOk}, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(env.matches(&pty.ReqTradeMatches{OrderID: buyB, Count: 10, Direction: 1})))
+}
+
+func TestTradeOrderBookMatchLimit(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	_, _, kvdb := util.CreateTestDB()
+	env := &bookTestEnv{t: t, cfg: cfg, api: api, kvdb: kvdb, height: 10}
+
+	execAddr := address.ExecAddress(pty.TradeX)
+	coinsAcc := account.NewCoinsAccount(cfg)
+	coinsAcc.SetDB(kvdb)
+	tokenAcc, _ := account.NewAccountDB(cfg, AssetExecToken, Symbol, kvdb)
+	total := int64(1000 * types.Coin)
+	kvdb.Set(calcTokenKey(Symbol), types.Encode(&types.ReqString{Data: Symbol}))
+	for _, node := range Nodes {
+		coinsAcc.SaveExecAccount(execAddr, &types.Account{Addr: string(node), Balance: total})
+		tokenAcc.SaveExecAccount(execAddr, &types.Account{Addr: string(node), Balance: total})
+	}
+
+	// 同一单价的卖单在一个档位上, 2e8/1e8 和 4e8/2e8 约分后相同
+	key := getOrderBookKey(cfg, true, AssetExecToken, Symbol, "coins", "bty")
+	for i := 0; i < orderBookMatchLimit; i++ {
+		env.sell(PrivKeyA, &orderArgs{1e8, 1, 2e8, 1}, false)
+	}
+	_, receipt := env.sell(PrivKeyD, &orderArgs{2e8, 1, 4e8, 1}, false)
+	sellD := orderIDFromReceipt(receipt)
+	levels, err := loadOrderBookLevels(kvdb, key)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(levels.Levels))
+	assert.Equal(t, orderBookMatchLimit+1, len(listOrderBook(t, kvdb, key)))
+
+	// A 自己的挂单被跳过但计入访问数, 访问到上限后不再继续撮合
+	_, receipt = env.buy(PrivKeyA, &orderArgs{1e8, 1, 2e8, 2}, true)
+	buyA := orderIDFromReceipt(receipt)
+	buyOrder, err := getBuyOrderFromID([]byte(buyA), kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), buyOrder.BoughtBoardlot)
+	book := listOrderBook(t, kvdb, key)
+	assert.Equal(t, orderBookMatchLimit+1, len(book))
+	assert.Equal(t, sellD, book[orderBookMatchLimit].OrderID)
+
+	// 其他地址可以成交, 成交完的档位从订单簿中删除
+	env.buy(PrivKeyB, &orderArgs{1e8, 1, 2e8, orderBookMatchLimit}, true)
+	book = listOrderBook(t, kvdb, key)
+	assert.Equal(t, 1, len(book))
+	assert.Equal(t, sellD, book[0].OrderID)
+	env.buy(PrivKeyC, &orderArgs{2e8, 1, 4e8, 1}, true)
+	levels, err = loadOrderBookLevels(kvdb, key)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(levels.Levels))
+}
@@ -30,6 +30,24 @@ func (t *trade) Query_GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error)
 	return t.GetOneOrder(req)
 }
 
+// 自动撮合的成交记录, 按订单或地址查询
+func (t *trade) Query_GetTradeMatches(req *pty.ReqTradeMatches) (types.Message, error) {
+	rows, err := listMatches(t.GetLocalDB(), req)
+	if err != nil {
+		tradelog.Error("GetTradeMatches", "err", err)
+		return nil, err
+	}
+	var reply pty.ReplyTradeMatches
+	for _, row := range rows {
+		match, ok := row.Data.(*pty.LocalTradeMatch)
+		if !ok {
+			return nil, types.ErrTypeAsset
+		}
+		reply.Matches = append(reply.Matches, match)
+	}
+	return &reply, nil
+}
+
 // query reply utils
 
 const (
@@ -116,6 +134,7 @@ func fmtReply(cfg *types.Chain33Config, order *pty.LocalOrder) *pty.ReplyTradeOr
 		AssetExec:         order.AssetExec,
 		PriceExec:         priceExec,
 		PriceSymbol:       priceSymbol,
+		TokenID:           order.TokenID,
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/33cn/chain33/common/db/table"
 	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
+	certexec "github.com/33cn/plugin/plugin/dapp/cert/executor"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
 )
 
@@ -64,6 +65,14 @@ func (t *trade) GetDriverName() string {
 	return driverName
 }
 
+// CheckTx 证书签名的链上按权限策略校验发送者的证书属性
+func (t *trade) CheckTx(tx *types.Transaction, index int) error {
+	if err := t.DriverBase.CheckTx(tx, index); err != nil {
+		return err
+	}
+	return certexec.CheckPolicy(t.GetAPI().GetConfig(), t.GetStateDB(), t.GetHeight(), tx)
+}
+
 func (t *trade) getSellOrderFromDb(sellID []byte) *pty.SellOrder {
 	value, err := t.GetStateDB().Get(sellID)
 	if err != nil {
@@ -61,6 +61,7 @@ func (selldb *sellDB) getSellLogs(tradeType int32, txhash string) *types.Receipt
 		AssetExec:         selldb.AssetExec,
 		PriceExec:         selldb.GetPriceExec(),
 		PriceSymbol:       selldb.GetPriceSymbol(),
+		TokenID:           selldb.TokenID,
 	}
 	if pty.TyLogTradeSellLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeSellLimit{Base: base}
@@ -93,6 +94,7 @@ func (selldb *sellDB) getBuyLogs(buyerAddr string, boardlotcnt int64, txhash str
 		AssetExec:         selldb.AssetExec,
 		PriceExec:         selldb.PriceExec,
 		PriceSymbol:       selldb.PriceSymbol,
+		TokenID:           selldb.TokenID,
 	}
 
 	receipt := &pty.ReceiptTradeBuyMarket{Base: base}
@@ -166,6 +168,7 @@ func (buydb *buyDB) getBuyLogs(tradeType int32, txhash string) *types.ReceiptLog
 		AssetExec:         buydb.AssetExec,
 		PriceExec:         buydb.PriceExec,
 		PriceSymbol:       buydb.PriceSymbol,
+		TokenID:           buydb.TokenID,
 	}
 	if pty.TyLogTradeBuyLimit == tradeType {
 		receiptTrade := &pty.ReceiptTradeBuyLimit{Base: base}
@@ -216,6 +219,7 @@ func (buydb *buyDB) getSellLogs(sellerAddr string, sellID string, boardlotCnt in
 		AssetExec:         buydb.AssetExec,
 		PriceExec:         buydb.PriceExec,
 		PriceSymbol:       buydb.PriceSymbol,
+		TokenID:           buydb.TokenID,
 	}
 	receiptSellMarket := &pty.ReceiptSellMarket{Base: base}
 	log.Log = types.Encode(receiptSellMarket)
@@ -231,13 +235,14 @@ type tradeAction struct {
 	height    int64
 	execaddr  string
 	api       client.QueueProtocolAPI
+	index     int64
 }
 
-func newTradeAction(t *trade, tx *types.Transaction) *tradeAction {
+func newTradeAction(t *trade, tx *types.Transaction, index int) *tradeAction {
 	hash := hex.EncodeToString(tx.Hash())
 	fromaddr := tx.From()
 	return &tradeAction{t.GetStateDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetAPI(), int64(index)}
 }
 
 func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, error) {
@@ -255,8 +260,17 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 	if !notSameAsset(cfg, action.height, sell.AssetExec, sell.TokenSymbol, sell.PriceExec, sell.PriceExec) {
 		return nil, pty.ErrAssetAndPriceSame
 	}
+	if err := checkNFTOrder(cfg, action.height, sell.AssetExec, sell.TokenID, sell.AmountPerBoardlot, sell.MinBoardlot, sell.TotalBoardlot); err != nil {
+		return nil, err
+	}
+	if err := checkAutoMatch(cfg, action.height, sell.AutoMatch, sell.TokenID, sell.AmountPerBoardlot, sell.PricePerBoardlot); err != nil {
+		return nil, err
+	}
+	if sell.AutoMatch && sell.Starttime != pty.InvalidStartTime {
+		return nil, types.ErrInvalidParam
+	}
 
-	accDB, err := createAccountDB(cfg, action.height, action.db, sell.AssetExec, sell.TokenSymbol)
+	accDB, err := createAssetDB(cfg, action.height, action.db, sell.AssetExec, sell.TokenSymbol, sell.TokenID)
 	if err != nil {
 		return nil, err
 	}
@@ -287,14 +301,30 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 		AssetExec:         sell.AssetExec,
 		PriceExec:         sell.GetPriceExec(),
 		PriceSymbol:       sell.GetPriceSymbol(),
+		TokenID:           sell.GetTokenID(),
 	}
 
 	tokendb := newSellDB(sellOrder)
-	sellOrderKV := tokendb.save(action.db)
 	logs = append(logs, receipt.Logs...)
-	logs = append(logs, tokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
 	kv = append(kv, receipt.KV...)
+	if sell.AutoMatch {
+		matchReceipt, err := action.matchSell(&tokendb.SellOrder)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, matchReceipt.Logs...)
+		kv = append(kv, matchReceipt.KV...)
+	}
+	sellOrderKV := tokendb.save(action.db)
+	logs = append(logs, tokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
 	kv = append(kv, sellOrderKV...)
+	if tokendb.Status == pty.TradeOrderStatusOnSale && inOrderBook(cfg, action.height, tokendb.TokenID, tokendb.AmountPerBoardlot, tokendb.PricePerBoardlot) {
+		bookKV, err := action.addSellOrderBook(&tokendb.SellOrder)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, bookKV...)
+	}
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
@@ -343,7 +373,7 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		return nil, err
 	}
 	//然后实现购买token的转移,因为这部分token在之前的卖单生成时已经进行冻结
-	accDB, err := createAccountDB(cfg, action.height, action.db, sellOrder.AssetExec, sellOrder.TokenSymbol)
+	accDB, err := createAssetDB(cfg, action.height, action.db, sellOrder.AssetExec, sellOrder.TokenSymbol, sellOrder.TokenID)
 	if err != nil {
 		tradelog.Error("createAccountDB", "addrFrom", action.fromaddr, "height", action.height,
 			"price", sellOrder.AssetExec+"-"+sellOrder.TokenSymbol, "err", err)
@@ -368,6 +398,10 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 	if sellOrder.SoldBoardlot == sellOrder.TotalBoardlot {
 		sellOrder.Status = pty.TradeOrderStatusSoldOut
 	}
+	bookKV, err := action.removeFinishedSellOrder(sellOrder)
+	if err != nil {
+		return nil, err
+	}
 	sellTokendb := newSellDB(*sellOrder)
 	sellOrderKV := sellTokendb.save(action.db)
 
@@ -378,6 +412,7 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 	kv = append(kv, receiptFromAcc.KV...)
 	kv = append(kv, receiptFromExecAcc.KV...)
 	kv = append(kv, sellOrderKV...)
+	kv = append(kv, bookKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
 
@@ -407,7 +442,7 @@ func (action *tradeAction) tradeRevokeSell(revoke *pty.TradeForRevokeSell) (*typ
 		return nil, pty.ErrTSellOrderRevoke
 	}
 	//然后实现购买token的转移,因为这部分token在之前的卖单生成时已经进行冻结
-	accDB, err := createAccountDB(cfg, action.height, action.db, sellOrder.AssetExec, sellOrder.TokenSymbol)
+	accDB, err := createAssetDB(cfg, action.height, action.db, sellOrder.AssetExec, sellOrder.TokenSymbol, sellOrder.TokenID)
 	if err != nil {
 		return nil, err
 	}
@@ -418,6 +453,11 @@ func (action *tradeAction) tradeRevokeSell(revoke *pty.TradeForRevokeSell) (*typ
 		return nil, err
 	}
 
+	bookKV, err := action.removeSellOrderBook(sellOrder)
+	if err != nil {
+		return nil, err
+	}
+
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 	sellOrder.Status = pty.TradeOrderStatusRevoked
@@ -428,6 +468,7 @@ func (action *tradeAction) tradeRevokeSell(revoke *pty.TradeForRevokeSell) (*typ
 	logs = append(logs, tokendb.getSellLogs(pty.TyLogTradeSellRevoke, action.txhash))
 	kv = append(kv, receiptFromExecAcc.KV...)
 	kv = append(kv, sellOrderKV...)
+	kv = append(kv, bookKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
 
@@ -471,6 +512,12 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 	if !notSameAsset(cfg, action.height, buy.AssetExec, buy.TokenSymbol, buy.PriceExec, buy.PriceExec) {
 		return nil, pty.ErrAssetAndPriceSame
 	}
+	if err := checkNFTOrder(cfg, action.height, buy.AssetExec, buy.TokenID, buy.AmountPerBoardlot, buy.MinBoardlot, buy.TotalBoardlot); err != nil {
+		return nil, err
+	}
+	if err := checkAutoMatch(cfg, action.height, buy.AutoMatch, buy.TokenID, buy.AmountPerBoardlot, buy.PricePerBoardlot); err != nil {
+		return nil, err
+	}
 
 	priceAcc, err := createPriceDB(cfg, action.height, action.db, buy.PriceExec, buy.PriceSymbol)
 	if err != nil {
@@ -500,14 +547,30 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 		AssetExec:         buy.AssetExec,
 		PriceExec:         buy.PriceExec,
 		PriceSymbol:       buy.PriceSymbol,
+		TokenID:           buy.TokenID,
 	}
 
 	tokendb := newBuyDB(buyOrder)
-	buyOrderKV := tokendb.save(action.db)
 	logs = append(logs, receipt.Logs...)
-	logs = append(logs, tokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
 	kv = append(kv, receipt.KV...)
+	if buy.AutoMatch {
+		matchReceipt, err := action.matchBuy(&tokendb.BuyLimitOrder)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, matchReceipt.Logs...)
+		kv = append(kv, matchReceipt.KV...)
+	}
+	buyOrderKV := tokendb.save(action.db)
+	logs = append(logs, tokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
 	kv = append(kv, buyOrderKV...)
+	if tokendb.Status == pty.TradeOrderStatusOnBuy && inOrderBook(cfg, action.height, tokendb.TokenID, tokendb.AmountPerBoardlot, tokendb.PricePerBoardlot) {
+		bookKV, err := action.addBuyOrderBook(&tokendb.BuyLimitOrder)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, bookKV...)
+	}
 
 	receipt = &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}
 	return receipt, nil
@@ -540,7 +603,7 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 	}
 
 	// 打token
-	accDB, err := createAccountDB(cfg, action.height, action.db, buyOrder.AssetExec, buyOrder.TokenSymbol)
+	accDB, err := createAssetDB(cfg, action.height, action.db, buyOrder.AssetExec, buyOrder.TokenSymbol, buyOrder.TokenID)
 	if err != nil {
 		tradelog.Error("createAccountDB failed", "err", err, "order", buyOrder)
 		return nil, err
@@ -580,6 +643,10 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 	if buyOrder.BoughtBoardlot == buyOrder.TotalBoardlot {
 		buyOrder.Status = pty.TradeOrderStatusBoughtOut
 	}
+	bookKV, err := action.removeFinishedBuyOrder(buyOrder)
+	if err != nil {
+		return nil, err
+	}
 	buyTokendb := newBuyDB(*buyOrder)
 	sellOrderKV := buyTokendb.save(action.db)
 
@@ -590,6 +657,7 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 	kv = append(kv, receiptFromAcc.KV...)
 	kv = append(kv, receiptFromExecAcc.KV...)
 	kv = append(kv, sellOrderKV...)
+	kv = append(kv, bookKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
 
@@ -630,6 +698,11 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 		return nil, err
 	}
 
+	bookKV, err := action.removeBuyOrderBook(buyOrder)
+	if err != nil {
+		return nil, err
+	}
+
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 	buyOrder.Status = pty.TradeOrderStatusBuyRevoked
@@ -640,5 +713,6 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 	logs = append(logs, tokendb.getBuyLogs(pty.TyLogTradeBuyRevoke, action.txhash))
 	kv = append(kv, receiptFromExecAcc.KV...)
 	kv = append(kv, sellOrderKV...)
+	kv = append(kv, bookKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
@@ -30,6 +30,8 @@ func (jrpc *Jrpc) CreateRawTradeSellTx(in *ptypes.TradeSellTx, result *interface
 		AssetExec:         in.AssetExec,
 		PriceExec:         in.PriceExec,
 		PriceSymbol:       in.PriceSymbol,
+		TokenID:           in.TokenID,
+		AutoMatch:         in.AutoMatch,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeSellTx(context.Background(), param)
@@ -89,6 +91,8 @@ func (jrpc *Jrpc) CreateRawTradeBuyLimitTx(in *ptypes.TradeBuyLimitTx, result *i
 		AssetExec:         in.AssetExec,
 		PriceExec:         in.PriceExec,
 		PriceSymbol:       in.PriceSymbol,
+		TokenID:           in.TokenID,
+		AutoMatch:         in.AutoMatch,
 	}
 
 	reply, err := jrpc.cli.CreateRawTradeBuyLimitTx(context.Background(), param)
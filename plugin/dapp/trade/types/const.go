@@ -23,6 +23,7 @@ const (
 	TyLogTradeSellMarket = 330
 	TyLogTradeBuyLimit   = 331
 	TyLogTradeBuyRevoke  = 332
+	TyLogTradeMatch      = 333
 )
 
 // 0->not start, 1->on sale, 2->sold out, 3->revoke, 4->expired
@@ -94,4 +95,8 @@ const (
 	ForkTradeFixAssetDBX = "ForkTradeFixAssetDB"
 	// ForkTradePriceX all asset can be price
 	ForkTradePriceX = "ForkTradePrice"
+	// ForkTradeNFTX support trade nft of token, one item per order
+	ForkTradeNFTX = "ForkTradeNFT"
+	// ForkTradeOrderBookX orders enter the order book and can be auto matched
+	ForkTradeOrderBookX = "ForkTradeOrderBook"
 )
@@ -39,4 +39,8 @@ var (
 	ErrTCntLessThanMinBoardlot = errors.New("ErrTradeCountLessThanMinBoardlot")
 	// ErrAssetAndPriceSame :
 	ErrAssetAndPriceSame = errors.New("ErrAssetAndPriceSame")
+	// ErrTNFTBoardlot : nft order must be one item in one boardlot
+	ErrTNFTBoardlot = errors.New("ErrTradeNFTBoardlot")
+	// ErrTNFTAutoMatch : nft order not support auto match
+	ErrTNFTAutoMatch = errors.New("ErrTradeNFTAutoMatch")
 )
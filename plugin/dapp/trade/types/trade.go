@@ -33,6 +33,7 @@ var (
 		TyLogTradeSellMarket: {Ty: reflect.TypeOf(ReceiptSellMarket{}), Name: "LogTradeSellMarket"},
 		TyLogTradeBuyLimit:   {Ty: reflect.TypeOf(ReceiptTradeBuyLimit{}), Name: "LogTradeBuyLimit"},
 		TyLogTradeBuyRevoke:  {Ty: reflect.TypeOf(ReceiptTradeBuyRevoke{}), Name: "LogTradeBuyRevoke"},
+		TyLogTradeMatch:      {Ty: reflect.TypeOf(ReceiptTradeMatch{}), Name: "LogTradeMatch"},
 	}
 )
 
@@ -65,6 +66,8 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TradeX, ForkTradeIDX, 1450000)
 	cfg.RegisterDappFork(TradeX, ForkTradeFixAssetDBX, 2500000)
 	cfg.RegisterDappFork(TradeX, ForkTradePriceX, 3150000)
+	cfg.RegisterDappFork(TradeX, ForkTradeNFTX, types.MaxHeight)
+	cfg.RegisterDappFork(TradeX, ForkTradeOrderBookX, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -206,6 +209,8 @@ func CreateRawTradeSellTx(cfg *types.Chain33Config, parm *TradeSellTx) (*types.T
 		AssetExec:         parm.AssetExec,
 		PriceExec:         parm.PriceExec,
 		PriceSymbol:       parm.PriceSymbol,
+		TokenID:           parm.TokenID,
+		AutoMatch:         parm.AutoMatch,
 	}
 	sell := &Trade{
 		Ty:    TradeSellLimit,
@@ -255,6 +260,8 @@ func CreateRawTradeBuyLimitTx(cfg *types.Chain33Config, parm *TradeBuyLimitTx) (
 		AssetExec:         parm.AssetExec,
 		PriceExec:         parm.PriceExec,
 		PriceSymbol:       parm.PriceSymbol,
+		TokenID:           parm.TokenID,
+		AutoMatch:         parm.AutoMatch,
 	}
 	buyLimit := &Trade{
 		Ty:    TradeBuyLimit,
@@ -27,7 +27,6 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // trade 交易部分
-//
 type Trade struct {
 	// Types that are valid to be assigned to Value:
 	//	*Trade_SellLimit
@@ -193,8 +192,12 @@ type TradeForSell struct {
 	// 资产来源
 	AssetExec string `protobuf:"bytes,9,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	// 定价资产
-	PriceExec            string   `protobuf:"bytes,10,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,11,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	PriceExec   string `protobuf:"bytes,10,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,11,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// NFT 的 tokenID, 非空时出售的是这一个物品
+	TokenID string `protobuf:"bytes,12,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	// 自动撮合: 按价格时间优先吃掉价格交叉的买单, 以买单价格成交, 剩余部分挂单
+	AutoMatch            bool     `protobuf:"varint,13,opt,name=autoMatch,proto3" json:"autoMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -302,6 +305,20 @@ func (m *TradeForSell) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *TradeForSell) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *TradeForSell) GetAutoMatch() bool {
+	if m != nil {
+		return m.AutoMatch
+	}
+	return false
+}
+
 // 购买者发起交易用来购买token持有者之前挂单出售的token
 // 其中的hash为token出售者发起出售交易的hash
 type TradeForBuy struct {
@@ -400,8 +417,12 @@ type TradeForBuyLimit struct {
 	TotalBoardlot     int64  `protobuf:"varint,5,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
 	AssetExec         string `protobuf:"bytes,6,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	// 定价资产
-	PriceExec            string   `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
-	PriceSymbol          string   `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	PriceExec   string `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// NFT 的 tokenID, 非空时求购的是这一个物品
+	TokenID string `protobuf:"bytes,9,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	// 自动撮合: 按价格时间优先吃掉价格交叉的卖单, 以卖单价格成交, 剩余部分挂单
+	AutoMatch            bool     `protobuf:"varint,10,opt,name=autoMatch,proto3" json:"autoMatch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -488,6 +509,20 @@ func (m *TradeForBuyLimit) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *TradeForBuyLimit) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+func (m *TradeForBuyLimit) GetAutoMatch() bool {
+	if m != nil {
+		return m.AutoMatch
+	}
+	return false
+}
+
 // 现价卖单
 type TradeForSellMarket struct {
 	BuyID                string   `protobuf:"bytes,1,opt,name=buyID,proto3" json:"buyID,omitempty"`
@@ -598,6 +633,7 @@ type SellOrder struct {
 	AssetExec            string   `protobuf:"bytes,14,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,15,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,16,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,17,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -740,6 +776,13 @@ func (m *SellOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *SellOrder) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
 // 限价买单数据库记录
 type BuyLimitOrder struct {
 	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -755,6 +798,7 @@ type BuyLimitOrder struct {
 	AssetExec            string   `protobuf:"bytes,11,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,12,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,13,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,14,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -876,6 +920,213 @@ func (m *BuyLimitOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *BuyLimitOrder) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+// 订单簿, 同一交易对同一方向的挂单按价格时间优先排序
+type OrderBookEntry struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	AmountPerBoardlot    int64    `protobuf:"varint,2,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	PricePerBoardlot     int64    `protobuf:"varint,3,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderBookEntry) Reset()         { *m = OrderBookEntry{} }
+func (m *OrderBookEntry) String() string { return proto.CompactTextString(m) }
+func (*OrderBookEntry) ProtoMessage()    {}
+func (*OrderBookEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{9}
+}
+
+func (m *OrderBookEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderBookEntry.Unmarshal(m, b)
+}
+func (m *OrderBookEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderBookEntry.Marshal(b, m, deterministic)
+}
+func (m *OrderBookEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookEntry.Merge(m, src)
+}
+func (m *OrderBookEntry) XXX_Size() int {
+	return xxx_messageInfo_OrderBookEntry.Size(m)
+}
+func (m *OrderBookEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookEntry proto.InternalMessageInfo
+
+func (m *OrderBookEntry) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *OrderBookEntry) GetAmountPerBoardlot() int64 {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return 0
+}
+
+func (m *OrderBookEntry) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *OrderBookEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *OrderBookEntry) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// 同一价格档位上的挂单, 按挂单先后排序
+type OrderBook struct {
+	Entries              []*OrderBookEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OrderBook) Reset()         { *m = OrderBook{} }
+func (m *OrderBook) String() string { return proto.CompactTextString(m) }
+func (*OrderBook) ProtoMessage()    {}
+func (*OrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{10}
+}
+
+func (m *OrderBook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderBook.Unmarshal(m, b)
+}
+func (m *OrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderBook.Marshal(b, m, deterministic)
+}
+func (m *OrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBook.Merge(m, src)
+}
+func (m *OrderBook) XXX_Size() int {
+	return xxx_messageInfo_OrderBook.Size(m)
+}
+func (m *OrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBook proto.InternalMessageInfo
+
+func (m *OrderBook) GetEntries() []*OrderBookEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// 价格档位, 单价 pricePerBoardlot/amountPerBoardlot 约分后的值
+type OrderBookLevel struct {
+	PricePerBoardlot     int64    `protobuf:"varint,1,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	AmountPerBoardlot    int64    `protobuf:"varint,2,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderBookLevel) Reset()         { *m = OrderBookLevel{} }
+func (m *OrderBookLevel) String() string { return proto.CompactTextString(m) }
+func (*OrderBookLevel) ProtoMessage()    {}
+func (*OrderBookLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{11}
+}
+
+func (m *OrderBookLevel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderBookLevel.Unmarshal(m, b)
+}
+func (m *OrderBookLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderBookLevel.Marshal(b, m, deterministic)
+}
+func (m *OrderBookLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookLevel.Merge(m, src)
+}
+func (m *OrderBookLevel) XXX_Size() int {
+	return xxx_messageInfo_OrderBookLevel.Size(m)
+}
+func (m *OrderBookLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookLevel proto.InternalMessageInfo
+
+func (m *OrderBookLevel) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *OrderBookLevel) GetAmountPerBoardlot() int64 {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return 0
+}
+
+// 订单簿中有挂单的价格档位, 卖单按单价从低到高, 买单按单价从高到低
+type OrderBookLevels struct {
+	Levels               []*OrderBookLevel `protobuf:"bytes,1,rep,name=levels,proto3" json:"levels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *OrderBookLevels) Reset()         { *m = OrderBookLevels{} }
+func (m *OrderBookLevels) String() string { return proto.CompactTextString(m) }
+func (*OrderBookLevels) ProtoMessage()    {}
+func (*OrderBookLevels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{12}
+}
+
+func (m *OrderBookLevels) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderBookLevels.Unmarshal(m, b)
+}
+func (m *OrderBookLevels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderBookLevels.Marshal(b, m, deterministic)
+}
+func (m *OrderBookLevels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookLevels.Merge(m, src)
+}
+func (m *OrderBookLevels) XXX_Size() int {
+	return xxx_messageInfo_OrderBookLevels.Size(m)
+}
+func (m *OrderBookLevels) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookLevels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookLevels proto.InternalMessageInfo
+
+func (m *OrderBookLevels) GetLevels() []*OrderBookLevel {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
 // 执行器日志部分
 type ReceiptBuyBase struct {
 	TokenSymbol          string   `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -893,6 +1144,7 @@ type ReceiptBuyBase struct {
 	AssetExec            string   `protobuf:"bytes,13,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,14,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,15,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,16,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -902,7 +1154,7 @@ func (m *ReceiptBuyBase) Reset()         { *m = ReceiptBuyBase{} }
 func (m *ReceiptBuyBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptBuyBase) ProtoMessage()    {}
 func (*ReceiptBuyBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{9}
+	return fileDescriptor_ee944bd90e8a0312, []int{13}
 }
 
 func (m *ReceiptBuyBase) XXX_Unmarshal(b []byte) error {
@@ -1028,6 +1280,13 @@ func (m *ReceiptBuyBase) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReceiptBuyBase) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
 type ReceiptSellBase struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
 	Owner       string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -1052,6 +1311,7 @@ type ReceiptSellBase struct {
 	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,19,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1061,7 +1321,7 @@ func (m *ReceiptSellBase) Reset()         { *m = ReceiptSellBase{} }
 func (m *ReceiptSellBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellBase) ProtoMessage()    {}
 func (*ReceiptSellBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{10}
+	return fileDescriptor_ee944bd90e8a0312, []int{14}
 }
 
 func (m *ReceiptSellBase) XXX_Unmarshal(b []byte) error {
@@ -1208,6 +1468,13 @@ func (m *ReceiptSellBase) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReceiptSellBase) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
 type ReceiptTradeBuyMarket struct {
 	Base                 *ReceiptBuyBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -1219,7 +1486,7 @@ func (m *ReceiptTradeBuyMarket) Reset()         { *m = ReceiptTradeBuyMarket{} }
 func (m *ReceiptTradeBuyMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyMarket) ProtoMessage()    {}
 func (*ReceiptTradeBuyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{11}
+	return fileDescriptor_ee944bd90e8a0312, []int{15}
 }
 
 func (m *ReceiptTradeBuyMarket) XXX_Unmarshal(b []byte) error {
@@ -1258,7 +1525,7 @@ func (m *ReceiptTradeBuyLimit) Reset()         { *m = ReceiptTradeBuyLimit{} }
 func (m *ReceiptTradeBuyLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyLimit) ProtoMessage()    {}
 func (*ReceiptTradeBuyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{12}
+	return fileDescriptor_ee944bd90e8a0312, []int{16}
 }
 
 func (m *ReceiptTradeBuyLimit) XXX_Unmarshal(b []byte) error {
@@ -1297,7 +1564,7 @@ func (m *ReceiptTradeBuyRevoke) Reset()         { *m = ReceiptTradeBuyRevoke{} }
 func (m *ReceiptTradeBuyRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyRevoke) ProtoMessage()    {}
 func (*ReceiptTradeBuyRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{13}
+	return fileDescriptor_ee944bd90e8a0312, []int{17}
 }
 
 func (m *ReceiptTradeBuyRevoke) XXX_Unmarshal(b []byte) error {
@@ -1336,7 +1603,7 @@ func (m *ReceiptTradeSellLimit) Reset()         { *m = ReceiptTradeSellLimit{} }
 func (m *ReceiptTradeSellLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellLimit) ProtoMessage()    {}
 func (*ReceiptTradeSellLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{14}
+	return fileDescriptor_ee944bd90e8a0312, []int{18}
 }
 
 func (m *ReceiptTradeSellLimit) XXX_Unmarshal(b []byte) error {
@@ -1375,7 +1642,7 @@ func (m *ReceiptSellMarket) Reset()         { *m = ReceiptSellMarket{} }
 func (m *ReceiptSellMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellMarket) ProtoMessage()    {}
 func (*ReceiptSellMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{15}
+	return fileDescriptor_ee944bd90e8a0312, []int{19}
 }
 
 func (m *ReceiptSellMarket) XXX_Unmarshal(b []byte) error {
@@ -1414,7 +1681,7 @@ func (m *ReceiptTradeSellRevoke) Reset()         { *m = ReceiptTradeSellRevoke{}
 func (m *ReceiptTradeSellRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellRevoke) ProtoMessage()    {}
 func (*ReceiptTradeSellRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{16}
+	return fileDescriptor_ee944bd90e8a0312, []int{20}
 }
 
 func (m *ReceiptTradeSellRevoke) XXX_Unmarshal(b []byte) error {
@@ -1442,6 +1709,142 @@ func (m *ReceiptTradeSellRevoke) GetBase() *ReceiptSellBase {
 	return nil
 }
 
+// 自动撮合的一次成交, amount 为成交的资产数量, price 为成交的总价
+type ReceiptTradeMatch struct {
+	SellID               string   `protobuf:"bytes,1,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	BuyID                string   `protobuf:"bytes,2,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Seller               string   `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer                string   `protobuf:"bytes,4,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	AssetExec            string   `protobuf:"bytes,5,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TokenSymbol          string   `protobuf:"bytes,6,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	PriceExec            string   `protobuf:"bytes,7,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,8,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	Amount               int64    `protobuf:"varint,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                int64    `protobuf:"varint,10,opt,name=price,proto3" json:"price,omitempty"`
+	IsSellTaker          bool     `protobuf:"varint,11,opt,name=isSellTaker,proto3" json:"isSellTaker,omitempty"`
+	TxHash               string   `protobuf:"bytes,12,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,13,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTradeMatch) Reset()         { *m = ReceiptTradeMatch{} }
+func (m *ReceiptTradeMatch) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeMatch) ProtoMessage()    {}
+func (*ReceiptTradeMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{21}
+}
+
+func (m *ReceiptTradeMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeMatch.Unmarshal(m, b)
+}
+func (m *ReceiptTradeMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeMatch.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeMatch.Merge(m, src)
+}
+func (m *ReceiptTradeMatch) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeMatch.Size(m)
+}
+func (m *ReceiptTradeMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeMatch proto.InternalMessageInfo
+
+func (m *ReceiptTradeMatch) GetSellID() string {
+	if m != nil {
+		return m.SellID
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetBuyID() string {
+	if m != nil {
+		return m.BuyID
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetIsSellTaker() bool {
+	if m != nil {
+		return m.IsSellTaker
+	}
+	return false
+}
+
+func (m *ReceiptTradeMatch) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ReqAddrAssets struct {
 	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1459,7 +1862,7 @@ func (m *ReqAddrAssets) Reset()         { *m = ReqAddrAssets{} }
 func (m *ReqAddrAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrAssets) ProtoMessage()    {}
 func (*ReqAddrAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{17}
+	return fileDescriptor_ee944bd90e8a0312, []int{22}
 }
 
 func (m *ReqAddrAssets) XXX_Unmarshal(b []byte) error {
@@ -1523,7 +1926,9 @@ func (m *ReqAddrAssets) GetFromKey() string {
 }
 
 // 获取Token未完成卖单的交易列表
-// 	 fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
+//	fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
 // 传当前页最后一个；当要获得上一页时， 传当前页第一个。 	 count
 // :获取交易列表的个数。 	 direction :查找方式；0，上一页；1，下一页。
 // 越靠后的也单价越贵
@@ -1542,7 +1947,7 @@ func (m *ReqTokenSellOrder) Reset()         { *m = ReqTokenSellOrder{} }
 func (m *ReqTokenSellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenSellOrder) ProtoMessage()    {}
 func (*ReqTokenSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{18}
+	return fileDescriptor_ee944bd90e8a0312, []int{23}
 }
 
 func (m *ReqTokenSellOrder) XXX_Unmarshal(b []byte) error {
@@ -1613,7 +2018,7 @@ func (m *ReqTokenBuyOrder) Reset()         { *m = ReqTokenBuyOrder{} }
 func (m *ReqTokenBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBuyOrder) ProtoMessage()    {}
 func (*ReqTokenBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{19}
+	return fileDescriptor_ee944bd90e8a0312, []int{24}
 }
 
 func (m *ReqTokenBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1693,7 +2098,7 @@ func (m *ReplyBuyOrder) Reset()         { *m = ReplyBuyOrder{} }
 func (m *ReplyBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrder) ProtoMessage()    {}
 func (*ReplyBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{20}
+	return fileDescriptor_ee944bd90e8a0312, []int{25}
 }
 
 func (m *ReplyBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1836,7 +2241,7 @@ func (m *ReplySellOrder) Reset()         { *m = ReplySellOrder{} }
 func (m *ReplySellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrder) ProtoMessage()    {}
 func (*ReplySellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{21}
+	return fileDescriptor_ee944bd90e8a0312, []int{26}
 }
 
 func (m *ReplySellOrder) XXX_Unmarshal(b []byte) error {
@@ -1966,7 +2371,7 @@ func (m *ReplySellOrders) Reset()         { *m = ReplySellOrders{} }
 func (m *ReplySellOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrders) ProtoMessage()    {}
 func (*ReplySellOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{22}
+	return fileDescriptor_ee944bd90e8a0312, []int{27}
 }
 
 func (m *ReplySellOrders) XXX_Unmarshal(b []byte) error {
@@ -2005,7 +2410,7 @@ func (m *ReplyBuyOrders) Reset()         { *m = ReplyBuyOrders{} }
 func (m *ReplyBuyOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrders) ProtoMessage()    {}
 func (*ReplyBuyOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{23}
+	return fileDescriptor_ee944bd90e8a0312, []int{28}
 }
 
 func (m *ReplyBuyOrders) XXX_Unmarshal(b []byte) error {
@@ -2052,6 +2457,7 @@ type ReplyTradeOrder struct {
 	AssetExec            string   `protobuf:"bytes,16,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
 	PriceExec            string   `protobuf:"bytes,17,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,18,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,19,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2061,7 +2467,7 @@ func (m *ReplyTradeOrder) Reset()         { *m = ReplyTradeOrder{} }
 func (m *ReplyTradeOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrder) ProtoMessage()    {}
 func (*ReplyTradeOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{24}
+	return fileDescriptor_ee944bd90e8a0312, []int{29}
 }
 
 func (m *ReplyTradeOrder) XXX_Unmarshal(b []byte) error {
@@ -2208,6 +2614,13 @@ func (m *ReplyTradeOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *ReplyTradeOrder) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
 type ReplyTradeOrders struct {
 	Orders               []*ReplyTradeOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -2219,7 +2632,7 @@ func (m *ReplyTradeOrders) Reset()         { *m = ReplyTradeOrders{} }
 func (m *ReplyTradeOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrders) ProtoMessage()    {}
 func (*ReplyTradeOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{25}
+	return fileDescriptor_ee944bd90e8a0312, []int{30}
 }
 
 func (m *ReplyTradeOrders) XXX_Unmarshal(b []byte) error {
@@ -2259,7 +2672,7 @@ func (m *ReqSellToken) Reset()         { *m = ReqSellToken{} }
 func (m *ReqSellToken) String() string { return proto.CompactTextString(m) }
 func (*ReqSellToken) ProtoMessage()    {}
 func (*ReqSellToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{26}
+	return fileDescriptor_ee944bd90e8a0312, []int{31}
 }
 
 func (m *ReqSellToken) XXX_Unmarshal(b []byte) error {
@@ -2306,7 +2719,7 @@ func (m *ReqRevokeSell) Reset()         { *m = ReqRevokeSell{} }
 func (m *ReqRevokeSell) String() string { return proto.CompactTextString(m) }
 func (*ReqRevokeSell) ProtoMessage()    {}
 func (*ReqRevokeSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{27}
+	return fileDescriptor_ee944bd90e8a0312, []int{32}
 }
 
 func (m *ReqRevokeSell) XXX_Unmarshal(b []byte) error {
@@ -2353,7 +2766,7 @@ func (m *ReqBuyToken) Reset()         { *m = ReqBuyToken{} }
 func (m *ReqBuyToken) String() string { return proto.CompactTextString(m) }
 func (*ReqBuyToken) ProtoMessage()    {}
 func (*ReqBuyToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{28}
+	return fileDescriptor_ee944bd90e8a0312, []int{33}
 }
 
 func (m *ReqBuyToken) XXX_Unmarshal(b []byte) error {
@@ -2409,6 +2822,7 @@ type LocalOrder struct {
 	IsFinished           bool     `protobuf:"varint,18,opt,name=isFinished,proto3" json:"isFinished,omitempty"`
 	PriceExec            string   `protobuf:"bytes,19,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
 	PriceSymbol          string   `protobuf:"bytes,20,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	TokenID              string   `protobuf:"bytes,21,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2418,7 +2832,7 @@ func (m *LocalOrder) Reset()         { *m = LocalOrder{} }
 func (m *LocalOrder) String() string { return proto.CompactTextString(m) }
 func (*LocalOrder) ProtoMessage()    {}
 func (*LocalOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{29}
+	return fileDescriptor_ee944bd90e8a0312, []int{34}
 }
 
 func (m *LocalOrder) XXX_Unmarshal(b []byte) error {
@@ -2579,6 +2993,283 @@ func (m *LocalOrder) GetPriceSymbol() string {
 	return ""
 }
 
+func (m *LocalOrder) GetTokenID() string {
+	if m != nil {
+		return m.TokenID
+	}
+	return ""
+}
+
+type LocalTradeMatch struct {
+	TxIndex              string   `protobuf:"bytes,1,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	SellID               string   `protobuf:"bytes,2,opt,name=sellID,proto3" json:"sellID,omitempty"`
+	BuyID                string   `protobuf:"bytes,3,opt,name=buyID,proto3" json:"buyID,omitempty"`
+	Seller               string   `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer                string   `protobuf:"bytes,5,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	AssetExec            string   `protobuf:"bytes,6,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	TokenSymbol          string   `protobuf:"bytes,7,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	PriceExec            string   `protobuf:"bytes,8,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol          string   `protobuf:"bytes,9,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	Amount               int64    `protobuf:"varint,10,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price                int64    `protobuf:"varint,11,opt,name=price,proto3" json:"price,omitempty"`
+	IsSellTaker          bool     `protobuf:"varint,12,opt,name=isSellTaker,proto3" json:"isSellTaker,omitempty"`
+	TxHash               string   `protobuf:"bytes,13,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64    `protobuf:"varint,14,opt,name=height,proto3" json:"height,omitempty"`
+	BlockTime            int64    `protobuf:"varint,15,opt,name=blockTime,proto3" json:"blockTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocalTradeMatch) Reset()         { *m = LocalTradeMatch{} }
+func (m *LocalTradeMatch) String() string { return proto.CompactTextString(m) }
+func (*LocalTradeMatch) ProtoMessage()    {}
+func (*LocalTradeMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{35}
+}
+
+func (m *LocalTradeMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalTradeMatch.Unmarshal(m, b)
+}
+func (m *LocalTradeMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalTradeMatch.Marshal(b, m, deterministic)
+}
+func (m *LocalTradeMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalTradeMatch.Merge(m, src)
+}
+func (m *LocalTradeMatch) XXX_Size() int {
+	return xxx_messageInfo_LocalTradeMatch.Size(m)
+}
+func (m *LocalTradeMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalTradeMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalTradeMatch proto.InternalMessageInfo
+
+func (m *LocalTradeMatch) GetTxIndex() string {
+	if m != nil {
+		return m.TxIndex
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetSellID() string {
+	if m != nil {
+		return m.SellID
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetBuyID() string {
+	if m != nil {
+		return m.BuyID
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *LocalTradeMatch) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *LocalTradeMatch) GetIsSellTaker() bool {
+	if m != nil {
+		return m.IsSellTaker
+	}
+	return false
+}
+
+func (m *LocalTradeMatch) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *LocalTradeMatch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LocalTradeMatch) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+// 查询成交记录, 指定 orderID 时查询该订单的成交, 否则按地址和买卖方向查询
+type ReqTradeMatches struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	IsSell               bool     `protobuf:"varint,3,opt,name=isSell,proto3" json:"isSell,omitempty"`
+	FromKey              string   `protobuf:"bytes,4,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	Count                int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTradeMatches) Reset()         { *m = ReqTradeMatches{} }
+func (m *ReqTradeMatches) String() string { return proto.CompactTextString(m) }
+func (*ReqTradeMatches) ProtoMessage()    {}
+func (*ReqTradeMatches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{36}
+}
+
+func (m *ReqTradeMatches) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTradeMatches.Unmarshal(m, b)
+}
+func (m *ReqTradeMatches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTradeMatches.Marshal(b, m, deterministic)
+}
+func (m *ReqTradeMatches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTradeMatches.Merge(m, src)
+}
+func (m *ReqTradeMatches) XXX_Size() int {
+	return xxx_messageInfo_ReqTradeMatches.Size(m)
+}
+func (m *ReqTradeMatches) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTradeMatches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTradeMatches proto.InternalMessageInfo
+
+func (m *ReqTradeMatches) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ReqTradeMatches) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqTradeMatches) GetIsSell() bool {
+	if m != nil {
+		return m.IsSell
+	}
+	return false
+}
+
+func (m *ReqTradeMatches) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
+}
+
+func (m *ReqTradeMatches) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqTradeMatches) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type ReplyTradeMatches struct {
+	Matches              []*LocalTradeMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ReplyTradeMatches) Reset()         { *m = ReplyTradeMatches{} }
+func (m *ReplyTradeMatches) String() string { return proto.CompactTextString(m) }
+func (*ReplyTradeMatches) ProtoMessage()    {}
+func (*ReplyTradeMatches) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{37}
+}
+
+func (m *ReplyTradeMatches) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTradeMatches.Unmarshal(m, b)
+}
+func (m *ReplyTradeMatches) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTradeMatches.Marshal(b, m, deterministic)
+}
+func (m *ReplyTradeMatches) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTradeMatches.Merge(m, src)
+}
+func (m *ReplyTradeMatches) XXX_Size() int {
+	return xxx_messageInfo_ReplyTradeMatches.Size(m)
+}
+func (m *ReplyTradeMatches) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTradeMatches.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTradeMatches proto.InternalMessageInfo
+
+func (m *ReplyTradeMatches) GetMatches() []*LocalTradeMatch {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Trade)(nil), "types.Trade")
 	proto.RegisterType((*TradeForSell)(nil), "types.TradeForSell")
@@ -2589,6 +3280,10 @@ func init() {
 	proto.RegisterType((*TradeForRevokeBuy)(nil), "types.TradeForRevokeBuy")
 	proto.RegisterType((*SellOrder)(nil), "types.SellOrder")
 	proto.RegisterType((*BuyLimitOrder)(nil), "types.BuyLimitOrder")
+	proto.RegisterType((*OrderBookEntry)(nil), "types.OrderBookEntry")
+	proto.RegisterType((*OrderBook)(nil), "types.OrderBook")
+	proto.RegisterType((*OrderBookLevel)(nil), "types.OrderBookLevel")
+	proto.RegisterType((*OrderBookLevels)(nil), "types.OrderBookLevels")
 	proto.RegisterType((*ReceiptBuyBase)(nil), "types.ReceiptBuyBase")
 	proto.RegisterType((*ReceiptSellBase)(nil), "types.ReceiptSellBase")
 	proto.RegisterType((*ReceiptTradeBuyMarket)(nil), "types.ReceiptTradeBuyMarket")
@@ -2597,6 +3292,7 @@ func init() {
 	proto.RegisterType((*ReceiptTradeSellLimit)(nil), "types.ReceiptTradeSellLimit")
 	proto.RegisterType((*ReceiptSellMarket)(nil), "types.ReceiptSellMarket")
 	proto.RegisterType((*ReceiptTradeSellRevoke)(nil), "types.ReceiptTradeSellRevoke")
+	proto.RegisterType((*ReceiptTradeMatch)(nil), "types.ReceiptTradeMatch")
 	proto.RegisterType((*ReqAddrAssets)(nil), "types.ReqAddrAssets")
 	proto.RegisterType((*ReqTokenSellOrder)(nil), "types.ReqTokenSellOrder")
 	proto.RegisterType((*ReqTokenBuyOrder)(nil), "types.ReqTokenBuyOrder")
@@ -2610,6 +3306,9 @@ func init() {
 	proto.RegisterType((*ReqRevokeSell)(nil), "types.ReqRevokeSell")
 	proto.RegisterType((*ReqBuyToken)(nil), "types.ReqBuyToken")
 	proto.RegisterType((*LocalOrder)(nil), "types.LocalOrder")
+	proto.RegisterType((*LocalTradeMatch)(nil), "types.LocalTradeMatch")
+	proto.RegisterType((*ReqTradeMatches)(nil), "types.ReqTradeMatches")
+	proto.RegisterType((*ReplyTradeMatches)(nil), "types.ReplyTradeMatches")
 }
 
 func init() {
@@ -2617,93 +3316,116 @@ func init() {
 }
 
 var fileDescriptor_ee944bd90e8a0312 = []byte{
-	// 1371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x8a, 0xdb, 0xc6,
-	0x17, 0x5f, 0x5b, 0x96, 0xd7, 0x3a, 0xfe, 0x58, 0xef, 0xc4, 0xd9, 0xbf, 0xb2, 0xfc, 0x29, 0x46,
-	0x84, 0x36, 0x09, 0x61, 0xa1, 0x09, 0x81, 0x42, 0x4b, 0x4b, 0x9c, 0x34, 0x75, 0xda, 0x84, 0x96,
-	0x59, 0x17, 0x7a, 0x2b, 0x5b, 0x93, 0xac, 0x58, 0x59, 0xf2, 0x4a, 0xa3, 0xc4, 0x7a, 0x83, 0x42,
-	0xdf, 0xa0, 0xbd, 0xe8, 0x03, 0xf4, 0x36, 0x50, 0x42, 0xdf, 0xa7, 0x97, 0xbd, 0xea, 0x03, 0x94,
-	0x19, 0x8d, 0xa5, 0xd1, 0x97, 0x3f, 0x20, 0x85, 0x4d, 0xd2, 0x3b, 0xcf, 0x99, 0x33, 0x67, 0x8e,
-	0xce, 0xef, 0xf7, 0x9b, 0x2f, 0x43, 0x9b, 0xfa, 0xa6, 0x45, 0x4e, 0x16, 0xbe, 0x47, 0x3d, 0xa4,
-	0xd2, 0x68, 0x41, 0x82, 0xe3, 0x43, 0xea, 0x9b, 0x6e, 0x60, 0xce, 0xa8, 0xed, 0xb9, 0x71, 0x8f,
-	0xf1, 0x77, 0x1d, 0xd4, 0x09, 0xf3, 0x44, 0x77, 0x41, 0x0b, 0x88, 0xe3, 0x3c, 0xb1, 0xe7, 0x36,
-	0xd5, 0x6b, 0xc3, 0xda, 0x8d, 0xf6, 0x9d, 0x2b, 0x27, 0x7c, 0xdc, 0x09, 0x77, 0x78, 0xe4, 0xf9,
-	0xa7, 0xc4, 0x71, 0xc6, 0x7b, 0x38, 0xf5, 0x43, 0x77, 0x40, 0x9b, 0x86, 0xd1, 0x53, 0xd3, 0x3f,
-	0x27, 0x54, 0xaf, 0xf3, 0x41, 0x28, 0x37, 0x68, 0x14, 0x46, 0x6c, 0x4c, 0xe2, 0x86, 0x3e, 0x05,
-	0xf0, 0xc9, 0x0b, 0xef, 0x9c, 0xb0, 0x70, 0xba, 0xc2, 0x07, 0x5d, 0xcb, 0x0d, 0xc2, 0x89, 0xc3,
-	0x78, 0x0f, 0x4b, 0xee, 0xe8, 0x1e, 0xb4, 0xa6, 0x61, 0x14, 0x27, 0xa9, 0xf2, 0xa1, 0xff, 0x2b,
-	0xce, 0xc7, 0xbb, 0xc7, 0x7b, 0x38, 0x71, 0x65, 0x73, 0xb2, 0xa4, 0x45, 0xa2, 0xcd, 0xd2, 0x39,
-	0x4f, 0x13, 0x07, 0x36, 0x67, 0xea, 0x8e, 0x3e, 0x01, 0x2d, 0xce, 0x60, 0x14, 0x46, 0xfa, 0x3e,
-	0x1f, 0xab, 0x97, 0xe6, 0x2b, 0x3e, 0x35, 0x71, 0x46, 0x3d, 0xa8, 0xd3, 0x48, 0x6f, 0x0c, 0x6b,
-	0x37, 0x54, 0x5c, 0xa7, 0xd1, 0x68, 0x1f, 0xd4, 0x17, 0xa6, 0x13, 0x12, 0xe3, 0x47, 0x05, 0x3a,
-	0xf2, 0xbc, 0x68, 0x08, 0x6d, 0xea, 0x9d, 0x13, 0xf7, 0x34, 0x9a, 0x4f, 0x3d, 0x87, 0xd7, 0x5f,
-	0xc3, 0xb2, 0x09, 0xdd, 0x86, 0x43, 0x73, 0xee, 0x85, 0x2e, 0xfd, 0x8e, 0xf8, 0x23, 0xcf, 0xf4,
-	0x2d, 0xc7, 0x8b, 0x4b, 0xae, 0xe0, 0x62, 0x07, 0x8b, 0x37, 0xb7, 0xdd, 0xc4, 0x4f, 0xe1, 0x7e,
-	0xb2, 0x09, 0xdd, 0x82, 0xfe, 0xc2, 0xb7, 0x67, 0x44, 0x0e, 0xd7, 0xe0, 0x6e, 0x05, 0x3b, 0xba,
-	0x0e, 0x5d, 0xea, 0x51, 0xd3, 0x49, 0x1c, 0x55, 0xee, 0x98, 0x35, 0xa2, 0xff, 0x83, 0x16, 0x50,
-	0xd3, 0xa7, 0xd4, 0x9e, 0x13, 0x5e, 0x63, 0x05, 0xa7, 0x06, 0x74, 0x0c, 0xad, 0x80, 0x7a, 0x0b,
-	0xde, 0xb9, 0xcf, 0x3b, 0x93, 0x36, 0x1b, 0x39, 0xf3, 0xbd, 0x97, 0xd6, 0xb3, 0xd0, 0xb5, 0xf4,
-	0xd6, 0xb0, 0x76, 0xa3, 0x85, 0x53, 0x03, 0xeb, 0x35, 0x83, 0x80, 0xd0, 0x2f, 0x97, 0x64, 0xa6,
-	0x6b, 0xbc, 0x32, 0xa9, 0x81, 0xf5, 0xf2, 0x7c, 0x79, 0x2f, 0xc4, 0xbd, 0x89, 0x81, 0xd5, 0x81,
-	0x37, 0x44, 0x5d, 0xdb, 0x71, 0x5d, 0x25, 0x93, 0xf1, 0x15, 0xb4, 0x25, 0xea, 0xa0, 0x23, 0x68,
-	0x32, 0xe8, 0x1f, 0x3f, 0x14, 0x18, 0x88, 0x16, 0x0b, 0x34, 0x15, 0x1f, 0xfa, 0xc0, 0x5d, 0x15,
-	0x5e, 0x36, 0x19, 0xb7, 0x01, 0x15, 0xe9, 0x5b, 0x15, 0xcf, 0x78, 0x55, 0x87, 0x7e, 0x9e, 0xb2,
-	0xef, 0x0a, 0x0b, 0x52, 0xb4, 0x9a, 0x6b, 0xd1, 0xda, 0xdf, 0x80, 0x56, 0xab, 0x88, 0xd6, 0x93,
-	0xb4, 0xc8, 0xa9, 0x5e, 0xd1, 0x00, 0xd4, 0x69, 0x18, 0x25, 0x35, 0x8e, 0x1b, 0x5b, 0x40, 0x76,
-	0x13, 0x0e, 0x0b, 0x0a, 0x2e, 0x0f, 0x66, 0xfc, 0xd4, 0x00, 0x8d, 0xcd, 0xf8, 0xad, 0x6f, 0x11,
-	0x7f, 0x0b, 0xa0, 0x74, 0xd8, 0x37, 0x2d, 0xcb, 0x27, 0x41, 0xc0, 0x27, 0xd6, 0xf0, 0xaa, 0x59,
-	0x0e, 0xa1, 0xb2, 0x25, 0x84, 0x8d, 0xed, 0x20, 0x54, 0xb7, 0x85, 0xb0, 0x59, 0x06, 0xa1, 0x01,
-	0x9d, 0xc0, 0x73, 0xac, 0xc4, 0x29, 0x96, 0x6b, 0xc6, 0x96, 0x15, 0x7b, 0x6b, 0x9d, 0xd8, 0xb5,
-	0x75, 0x62, 0x87, 0xbc, 0xd8, 0x53, 0xbd, 0xb4, 0x33, 0xfa, 0x63, 0x76, 0x6a, 0xd2, 0x30, 0xd0,
-	0x3b, 0x7c, 0x39, 0x15, 0x2d, 0x66, 0x3f, 0x23, 0xf6, 0xf3, 0x33, 0xaa, 0x77, 0xf9, 0x3c, 0xa2,
-	0x95, 0xa5, 0x61, 0x6f, 0x2d, 0x0d, 0x0f, 0x36, 0xd0, 0xb0, 0x5f, 0xa4, 0xe1, 0x6b, 0x05, 0xba,
-	0x2b, 0xd5, 0xbe, 0x0f, 0x8c, 0xf8, 0x10, 0x7a, 0x53, 0x2f, 0x7c, 0x7e, 0x46, 0x73, 0x9c, 0xc8,
-	0x59, 0x53, 0xed, 0xb4, 0x64, 0x21, 0xa6, 0xd8, 0x69, 0x15, 0xd8, 0x41, 0x35, 0x76, 0xed, 0xb5,
-	0xd8, 0x75, 0x36, 0x60, 0xd7, 0x2d, 0x62, 0xf7, 0xa7, 0x02, 0x3d, 0x4c, 0x66, 0xc4, 0x5e, 0xd0,
-	0x51, 0x18, 0x8d, 0xcc, 0x80, 0x6c, 0x01, 0xde, 0x00, 0x54, 0xef, 0xa5, 0x4b, 0x7c, 0x01, 0x5d,
-	0xdc, 0xa8, 0x06, 0x4e, 0x7b, 0xb3, 0xc0, 0x69, 0x97, 0x02, 0x38, 0x4d, 0x06, 0x4e, 0x88, 0x14,
-	0xf2, 0x22, 0xa5, 0xcb, 0xb1, 0x19, 0x9c, 0xad, 0xc4, 0x1b, 0xb7, 0x24, 0xa0, 0x3b, 0xd5, 0x40,
-	0x77, 0xd7, 0x02, 0xdd, 0xdb, 0x00, 0xf4, 0x41, 0x11, 0xe8, 0x3f, 0x1a, 0x70, 0x20, 0x80, 0x66,
-	0x2b, 0xf7, 0x3b, 0x8e, 0xf4, 0xe5, 0x5f, 0xb4, 0x53, 0xfe, 0x24, 0x6c, 0xeb, 0xe6, 0xd8, 0x26,
-	0xd8, 0xd3, 0xab, 0x60, 0xcf, 0x41, 0x35, 0x7b, 0xfa, 0x6b, 0xd9, 0x73, 0xb8, 0x81, 0x3d, 0xa8,
-	0xc8, 0x9e, 0x11, 0x5c, 0x15, 0xe4, 0xe1, 0x47, 0x84, 0x51, 0x72, 0x7f, 0xb9, 0x09, 0x8d, 0xa9,
-	0x19, 0x10, 0x71, 0x47, 0xba, 0x2a, 0x6e, 0x02, 0xd9, 0x15, 0x05, 0x73, 0x17, 0xe3, 0x3e, 0x0c,
-	0x72, 0x31, 0xe2, 0x73, 0xde, 0x0e, 0x21, 0x8a, 0x69, 0xc4, 0x27, 0x95, 0x5d, 0x62, 0x3c, 0xc8,
-	0xc6, 0x38, 0x4d, 0xae, 0x6f, 0xb7, 0x32, 0x31, 0x8e, 0xb2, 0x31, 0x56, 0x9a, 0x11, 0x41, 0xbe,
-	0x80, 0x43, 0xa9, 0x43, 0xd4, 0x62, 0x97, 0x00, 0x0f, 0xe1, 0x28, 0x9f, 0x85, 0xf8, 0x94, 0x5d,
-	0xa2, 0xfc, 0x5a, 0x83, 0x2e, 0x26, 0x17, 0xf7, 0x2d, 0xcb, 0xbf, 0xcf, 0xb0, 0x0e, 0x10, 0x82,
-	0x06, 0xdb, 0x48, 0x85, 0x96, 0xf9, 0x6f, 0x89, 0x78, 0xf5, 0xcc, 0x8e, 0x33, 0x00, 0x95, 0x6b,
-	0x5d, 0x57, 0x86, 0x0a, 0x23, 0x1e, 0x6f, 0x30, 0xaa, 0x58, 0xb6, 0x4f, 0xf8, 0xbd, 0x58, 0xdc,
-	0xd6, 0x52, 0x03, 0x1b, 0x33, 0x63, 0x02, 0xe7, 0xfa, 0x54, 0x71, 0xdc, 0x60, 0xbb, 0xf9, 0x33,
-	0xdf, 0x9b, 0x7f, 0x43, 0x22, 0x71, 0xc8, 0x5d, 0x35, 0x8d, 0x5f, 0x6a, 0xac, 0x52, 0x17, 0x13,
-	0xbe, 0xa6, 0xec, 0x76, 0x62, 0x5c, 0x45, 0xac, 0x67, 0x22, 0xa6, 0x19, 0x28, 0x72, 0x06, 0xeb,
-	0xb3, 0x4e, 0x2b, 0xa0, 0xca, 0x15, 0x30, 0x7e, 0xae, 0x41, 0x7f, 0x95, 0xdd, 0x28, 0x8c, 0x2e,
-	0x57, 0x72, 0xbf, 0x2b, 0x0c, 0xdc, 0x85, 0x13, 0xed, 0x90, 0xd9, 0x8e, 0xeb, 0xf5, 0xbb, 0x7f,
-	0xa4, 0x7a, 0x23, 0x3b, 0x73, 0x1f, 0x94, 0x73, 0x12, 0x89, 0xf5, 0x99, 0xfd, 0x5c, 0x7f, 0xa0,
-	0x36, 0x5e, 0xf1, 0x43, 0xd5, 0xc2, 0x89, 0x76, 0x61, 0xfc, 0xdb, 0x0a, 0xdd, 0x36, 0x5b, 0xed,
-	0xdb, 0x01, 0xdb, 0x98, 0x9d, 0x90, 0x64, 0xd4, 0x02, 0x74, 0x2f, 0x7e, 0x2a, 0x8b, 0x5b, 0x7a,
-	0x6d, 0xa8, 0x64, 0x76, 0x17, 0xd9, 0x17, 0x4b, 0x8e, 0xc6, 0x43, 0x81, 0xff, 0x4a, 0xb9, 0x81,
-	0x78, 0x1b, 0xcc, 0xc4, 0x19, 0xc8, 0x71, 0x56, 0x9e, 0x38, 0x75, 0x33, 0x5e, 0x37, 0x44, 0x42,
-	0x7c, 0x8b, 0x78, 0x0f, 0x96, 0x00, 0xfe, 0x4a, 0x9b, 0x67, 0x52, 0xce, 0x7a, 0x99, 0xb8, 0x34,
-	0x75, 0xbc, 0xd9, 0xf9, 0x84, 0x9d, 0x10, 0x7b, 0xf1, 0xf1, 0x31, 0x31, 0xb0, 0x0a, 0xda, 0x41,
-	0x42, 0x0e, 0x7e, 0x56, 0x6b, 0x61, 0xd9, 0xf4, 0xaf, 0x1f, 0xd8, 0xfa, 0x39, 0xea, 0x04, 0xe8,
-	0x04, 0x9a, 0x9e, 0x4c, 0xc0, 0x23, 0x99, 0x80, 0xa9, 0x23, 0x16, 0x5e, 0xc6, 0x53, 0xe8, 0x60,
-	0x72, 0xc1, 0x32, 0xe6, 0x1b, 0x24, 0xfa, 0x08, 0x1a, 0xac, 0x7a, 0x6b, 0xde, 0xc3, 0x31, 0x77,
-	0x28, 0xa7, 0xa0, 0xf1, 0x03, 0x3f, 0xab, 0x48, 0xaf, 0x81, 0x1f, 0x43, 0x33, 0x7e, 0x1d, 0x16,
-	0x11, 0xab, 0xdf, 0xbd, 0xb1, 0x70, 0xac, 0x88, 0xfc, 0x18, 0xda, 0x98, 0x5c, 0x8c, 0xc2, 0x28,
-	0xce, 0xf3, 0x3a, 0x28, 0xd3, 0x30, 0x12, 0x41, 0x4b, 0x5e, 0xe0, 0x31, 0xeb, 0x16, 0x3c, 0x4a,
-	0x43, 0xf1, 0x86, 0xf1, 0x57, 0x03, 0xe0, 0x89, 0x37, 0x33, 0xd3, 0x65, 0x9b, 0x63, 0x92, 0x95,
-	0x9b, 0x64, 0xfa, 0x4f, 0x6e, 0x3b, 0xcb, 0x4d, 0xb9, 0x84, 0x72, 0xd3, 0x61, 0x9f, 0x2e, 0x1f,
-	0xbb, 0x16, 0x59, 0x0a, 0xb1, 0xad, 0x9a, 0xe8, 0x03, 0x00, 0x3b, 0x78, 0x64, 0xbb, 0x76, 0x70,
-	0x46, 0x2c, 0xae, 0xb4, 0x16, 0x96, 0x2c, 0x59, 0xa1, 0x5e, 0xd9, 0x20, 0xd4, 0x41, 0x41, 0xa8,
-	0x77, 0x7e, 0x53, 0x40, 0xe5, 0x25, 0x47, 0x9f, 0xc3, 0xe0, 0x81, 0x4f, 0x4c, 0x4a, 0xb0, 0xf9,
-	0x32, 0xb9, 0x14, 0x4c, 0x96, 0xa8, 0x4c, 0x68, 0xc7, 0x07, 0xc2, 0xf8, 0xbd, 0x1b, 0xd8, 0xcf,
-	0xdd, 0xc9, 0xd2, 0xd8, 0x43, 0x9f, 0xc1, 0x95, 0xec, 0x78, 0x26, 0x88, 0x25, 0x2a, 0x11, 0x40,
-	0xd9, 0xe8, 0x47, 0x70, 0x94, 0x1d, 0x1d, 0xab, 0x6f, 0xb2, 0x44, 0xd5, 0xb2, 0x2c, 0x8f, 0xa3,
-	0x17, 0xb2, 0xe0, 0xf7, 0xab, 0xc9, 0x12, 0x55, 0xfd, 0x3b, 0x55, 0x16, 0xe7, 0x6b, 0x38, 0x2e,
-	0x56, 0x23, 0xbe, 0x68, 0x95, 0xe4, 0x94, 0x76, 0x96, 0xc5, 0x1a, 0xc3, 0xb5, 0xb2, 0x6f, 0x8b,
-	0xeb, 0x53, 0xf9, 0xef, 0x55, 0x49, 0xa4, 0x69, 0x93, 0xff, 0x51, 0x78, 0xf7, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe0, 0xb9, 0xad, 0xb0, 0x51, 0x1c, 0x00, 0x00,
+	// 1744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0xdf, 0xc4, 0x71, 0x12, 0xbf, 0xfc, 0x9f, 0x4d, 0x17, 0x77, 0x85, 0xd0, 0xca, 0xaa, 0x60,
+	0x5b, 0x95, 0x05, 0xb6, 0xaa, 0x84, 0x44, 0x05, 0x34, 0xdd, 0x96, 0x5d, 0xd8, 0x0a, 0x34, 0x1b,
+	0x24, 0xae, 0x4e, 0x32, 0xed, 0x9a, 0xf5, 0xc6, 0xbb, 0xb6, 0xd3, 0xc6, 0x37, 0x8e, 0x7c, 0x06,
+	0x38, 0x70, 0xea, 0x89, 0x1b, 0x42, 0xe2, 0xca, 0x77, 0xa8, 0xf8, 0x16, 0x48, 0x5c, 0xf8, 0x00,
+	0x68, 0xc6, 0x63, 0x7b, 0xc6, 0xb1, 0x9d, 0x8d, 0xa8, 0xc4, 0xb6, 0xe5, 0x96, 0x79, 0xf3, 0xe6,
+	0xf9, 0xf9, 0xfd, 0x7e, 0xef, 0xcd, 0xbc, 0x71, 0xa0, 0xe1, 0xbb, 0xe6, 0x84, 0xec, 0x9c, 0xb9,
+	0x8e, 0xef, 0x20, 0xd5, 0x0f, 0xce, 0x88, 0xb7, 0xd9, 0xf3, 0x5d, 0x73, 0xea, 0x99, 0x63, 0xdf,
+	0x72, 0xa6, 0xe1, 0x8c, 0xf1, 0x77, 0x19, 0xd4, 0x21, 0xd5, 0x44, 0xb7, 0x40, 0xf3, 0x88, 0x6d,
+	0x1f, 0x5a, 0xa7, 0x96, 0xaf, 0x97, 0xb6, 0x4a, 0xdb, 0x8d, 0xdd, 0xf5, 0x1d, 0xb6, 0x6e, 0x87,
+	0x29, 0x3c, 0x70, 0xdc, 0x23, 0x62, 0xdb, 0xfb, 0x6b, 0x38, 0xd1, 0x43, 0xbb, 0xa0, 0x8d, 0x66,
+	0xc1, 0x43, 0xd3, 0x3d, 0x21, 0xbe, 0x5e, 0x66, 0x8b, 0x50, 0x6a, 0xd1, 0x60, 0x16, 0xd0, 0x35,
+	0xb1, 0x1a, 0xfa, 0x08, 0xc0, 0x25, 0x4f, 0x9c, 0x13, 0x42, 0xcd, 0xe9, 0x0a, 0x5b, 0x74, 0x35,
+	0xb5, 0x08, 0xc7, 0x0a, 0xfb, 0x6b, 0x58, 0x50, 0x47, 0xb7, 0xa1, 0x3e, 0x9a, 0x05, 0xa1, 0x93,
+	0x2a, 0x5b, 0xfa, 0xc6, 0xe2, 0xf3, 0xd8, 0xf4, 0xfe, 0x1a, 0x8e, 0x55, 0xe9, 0x33, 0xa9, 0xd3,
+	0xdc, 0xd1, 0x6a, 0xe6, 0x33, 0x8f, 0x62, 0x05, 0xfa, 0xcc, 0x44, 0x1d, 0x7d, 0x08, 0x5a, 0xe8,
+	0xc1, 0x60, 0x16, 0xe8, 0x35, 0xb6, 0x56, 0xcf, 0xf4, 0x97, 0xbf, 0x6a, 0xac, 0x8c, 0xda, 0x50,
+	0xf6, 0x03, 0xbd, 0xb2, 0x55, 0xda, 0x56, 0x71, 0xd9, 0x0f, 0x06, 0x35, 0x50, 0x9f, 0x98, 0xf6,
+	0x8c, 0x18, 0xbf, 0x2b, 0xd0, 0x14, 0x9f, 0x8b, 0xb6, 0xa0, 0xe1, 0x3b, 0x27, 0x64, 0x7a, 0x14,
+	0x9c, 0x8e, 0x1c, 0x9b, 0xc5, 0x5f, 0xc3, 0xa2, 0x08, 0xdd, 0x84, 0x9e, 0x79, 0xea, 0xcc, 0xa6,
+	0xfe, 0x57, 0xc4, 0x1d, 0x38, 0xa6, 0x3b, 0xb1, 0x9d, 0x30, 0xe4, 0x0a, 0x5e, 0x9c, 0xa0, 0xf6,
+	0x4e, 0xad, 0x69, 0xac, 0xa7, 0x30, 0x3d, 0x51, 0x84, 0x6e, 0x40, 0xf7, 0xcc, 0xb5, 0xc6, 0x44,
+	0x34, 0x57, 0x61, 0x6a, 0x0b, 0x72, 0x74, 0x0d, 0x5a, 0xbe, 0xe3, 0x9b, 0x76, 0xac, 0xa8, 0x32,
+	0x45, 0x59, 0x88, 0xde, 0x04, 0xcd, 0xf3, 0x4d, 0xd7, 0xf7, 0xad, 0x53, 0xc2, 0x62, 0xac, 0xe0,
+	0x44, 0x80, 0x36, 0xa1, 0xee, 0xf9, 0xce, 0x19, 0x9b, 0xac, 0xb1, 0xc9, 0x78, 0x4c, 0x57, 0x8e,
+	0x5d, 0xe7, 0xe9, 0xe4, 0xd1, 0x6c, 0x3a, 0xd1, 0xeb, 0x5b, 0xa5, 0xed, 0x3a, 0x4e, 0x04, 0x74,
+	0xd6, 0xf4, 0x3c, 0xe2, 0xdf, 0x9f, 0x93, 0xb1, 0xae, 0xb1, 0xc8, 0x24, 0x02, 0x3a, 0xcb, 0xfc,
+	0x65, 0xb3, 0x10, 0xce, 0xc6, 0x02, 0x1a, 0x07, 0x36, 0xe0, 0x71, 0x6d, 0x84, 0x71, 0x15, 0x44,
+	0x48, 0x87, 0x1a, 0x0b, 0xf3, 0xc1, 0x9e, 0xde, 0x64, 0xb3, 0xd1, 0x90, 0x3d, 0x77, 0xe6, 0x3b,
+	0x0f, 0x4d, 0x7f, 0x7c, 0xac, 0xb7, 0x42, 0xaf, 0x62, 0x81, 0xf1, 0x19, 0x34, 0x04, 0xca, 0xa1,
+	0x0d, 0xa8, 0x52, 0xca, 0x1c, 0xec, 0x71, 0xec, 0xf8, 0x88, 0x3a, 0x30, 0xe2, 0x01, 0xba, 0x37,
+	0x8d, 0x00, 0x13, 0x45, 0xc6, 0x4d, 0x40, 0x8b, 0xb4, 0xcf, 0xb3, 0x67, 0xfc, 0x59, 0x86, 0x6e,
+	0x9a, 0xea, 0xaf, 0x0a, 0x7b, 0x12, 0x94, 0xab, 0x85, 0x28, 0xd7, 0x96, 0xa0, 0x5c, 0x2f, 0x44,
+	0x59, 0x2b, 0x40, 0x19, 0xd2, 0x28, 0x1f, 0x26, 0xe0, 0x24, 0xf5, 0x01, 0xf5, 0x41, 0x1d, 0xcd,
+	0x82, 0x18, 0x9b, 0x70, 0x70, 0x01, 0xa8, 0xaf, 0x43, 0x6f, 0xa1, 0x62, 0x64, 0x1b, 0x33, 0x9e,
+	0x55, 0x40, 0xa3, 0x4f, 0xfc, 0xd2, 0x9d, 0x10, 0xf7, 0x02, 0x00, 0xeb, 0x50, 0x33, 0x27, 0x13,
+	0x97, 0x78, 0x1e, 0x7b, 0xb0, 0x86, 0xa3, 0x61, 0x36, 0xf4, 0xca, 0x05, 0xa1, 0xaf, 0x5c, 0x0c,
+	0x7a, 0xf5, 0xa2, 0xd0, 0x57, 0xb3, 0xa0, 0x37, 0xa0, 0xe9, 0x39, 0xf6, 0x24, 0x56, 0x0a, 0xcb,
+	0x83, 0x24, 0x93, 0x8b, 0x4b, 0xbd, 0xa8, 0xb8, 0x68, 0x45, 0xc5, 0x05, 0xd2, 0xc5, 0x25, 0xc9,
+	0xb3, 0x86, 0x94, 0xb7, 0x54, 0xee, 0x9b, 0xfe, 0xcc, 0x63, 0x55, 0x41, 0xc5, 0x7c, 0x44, 0xe5,
+	0xc7, 0xc4, 0x7a, 0x7c, 0xec, 0xb3, 0x8a, 0xa0, 0x60, 0x3e, 0x92, 0xe9, 0xdb, 0x2e, 0xa4, 0x6f,
+	0x67, 0x09, 0x7d, 0xbb, 0x85, 0xf4, 0xed, 0x49, 0xf4, 0x35, 0x9e, 0x2b, 0xd0, 0x8a, 0xea, 0xc0,
+	0xeb, 0xc0, 0x95, 0xb7, 0xa1, 0x3d, 0x72, 0x66, 0x8f, 0x8f, 0xfd, 0x14, 0x5b, 0x52, 0xd2, 0x24,
+	0xab, 0xea, 0x62, 0x8a, 0x26, 0xa8, 0x6a, 0x39, 0xa8, 0x42, 0x3e, 0xaa, 0x8d, 0x42, 0x54, 0x9b,
+	0x4b, 0x50, 0x6d, 0x15, 0xa2, 0xda, 0x96, 0x51, 0xfd, 0xa5, 0x04, 0x6d, 0x86, 0xe6, 0xc0, 0x71,
+	0x4e, 0xee, 0x4f, 0x7d, 0x37, 0xa0, 0xca, 0x0e, 0x95, 0xc4, 0x85, 0x22, 0x1a, 0xae, 0x58, 0xdb,
+	0xb3, 0x20, 0x51, 0x72, 0x20, 0x49, 0xc2, 0x52, 0x91, 0xc2, 0xd2, 0x07, 0xd5, 0x9a, 0x4e, 0xc8,
+	0x9c, 0x63, 0x19, 0x0e, 0x8c, 0x3b, 0xa0, 0xc5, 0x3e, 0xa3, 0xf7, 0xa0, 0x46, 0xa6, 0xbe, 0x6b,
+	0x11, 0x4f, 0x2f, 0x6d, 0x29, 0xdb, 0x8d, 0xdd, 0x2b, 0xfc, 0xc8, 0x24, 0xbf, 0x16, 0x8e, 0xb4,
+	0x8c, 0x6f, 0x85, 0x37, 0x3e, 0x24, 0x4f, 0x88, 0x9d, 0xe9, 0x69, 0x29, 0xc7, 0xd3, 0x95, 0x62,
+	0x60, 0x7c, 0x0a, 0x1d, 0xf9, 0x59, 0x1e, 0x7a, 0x17, 0xaa, 0x36, 0xfb, 0x95, 0xe7, 0x2e, 0xd3,
+	0xc3, 0x5c, 0xc9, 0xf8, 0xae, 0x02, 0x6d, 0x4c, 0xc6, 0xc4, 0x3a, 0xf3, 0x07, 0xb3, 0x60, 0x60,
+	0x7a, 0xe4, 0x02, 0x79, 0xd7, 0x07, 0xd5, 0x79, 0x3a, 0x25, 0x2e, 0xcf, 0xba, 0x70, 0x90, 0x9f,
+	0x73, 0xda, 0x8b, 0xcd, 0x39, 0xed, 0x52, 0xe4, 0x9c, 0x26, 0xe6, 0x1c, 0xaf, 0xbc, 0x90, 0xae,
+	0xbc, 0xfe, 0x7c, 0xdf, 0xf4, 0x8e, 0xa3, 0x8a, 0x1c, 0x8e, 0x04, 0x32, 0x36, 0xf3, 0x73, 0xb4,
+	0x55, 0x98, 0xa3, 0xed, 0x25, 0x39, 0xda, 0x29, 0xcc, 0xd1, 0xae, 0x9c, 0xa3, 0x7f, 0x54, 0xa0,
+	0xc3, 0x29, 0x40, 0x37, 0xea, 0x57, 0x9c, 0x03, 0x97, 0x7f, 0x8f, 0x4e, 0x98, 0x15, 0xf3, 0xb0,
+	0x95, 0xe2, 0x21, 0xe7, 0x55, 0x3b, 0x87, 0x57, 0x9d, 0x7c, 0x5e, 0x75, 0x0b, 0x79, 0xd5, 0x5b,
+	0xc2, 0x2b, 0x54, 0xc8, 0xab, 0x75, 0x99, 0x57, 0x03, 0xb8, 0xc2, 0x69, 0xc5, 0xce, 0x8a, 0x83,
+	0xb8, 0x71, 0xbe, 0x0e, 0x95, 0x91, 0xe9, 0x11, 0xde, 0x9c, 0x47, 0x05, 0x4a, 0xae, 0x42, 0x98,
+	0xa9, 0x18, 0x77, 0xa1, 0x9f, 0xb2, 0x11, 0x36, 0x0a, 0x2b, 0x98, 0x58, 0x74, 0x23, 0x3c, 0xb2,
+	0xae, 0x62, 0xe3, 0x9e, 0x6c, 0xe3, 0x28, 0xbe, 0x37, 0xb8, 0x21, 0xd9, 0xd8, 0x90, 0x6d, 0x44,
+	0xd9, 0xc4, 0x8d, 0x7c, 0x02, 0x3d, 0x61, 0x82, 0xc7, 0x62, 0x15, 0x03, 0x7b, 0xb0, 0x91, 0xf6,
+	0x82, 0xbf, 0xca, 0x2a, 0x56, 0xfe, 0x2a, 0x43, 0x4f, 0x34, 0xc3, 0xfa, 0x83, 0xdc, 0xb6, 0x2f,
+	0xa6, 0x60, 0x39, 0x5d, 0x0a, 0x89, 0x6d, 0x13, 0x97, 0xe7, 0x36, 0x1f, 0x71, 0x6d, 0xe2, 0xea,
+	0x95, 0x58, 0x9b, 0xb8, 0x32, 0x01, 0xd5, 0x34, 0x01, 0x53, 0xa5, 0xa6, 0xba, 0x58, 0x6a, 0xfe,
+	0x6d, 0xcf, 0xb4, 0x01, 0xd5, 0xb0, 0xf6, 0xf0, 0x74, 0xe5, 0x23, 0xea, 0x2d, 0x53, 0xe3, 0x67,
+	0xa5, 0x70, 0x40, 0xed, 0x59, 0x1e, 0x8d, 0xd9, 0xd0, 0x3c, 0x21, 0x2e, 0xcb, 0xd4, 0x3a, 0x16,
+	0x45, 0x42, 0x02, 0x36, 0x73, 0x12, 0x50, 0x3a, 0x52, 0x1b, 0x3f, 0x95, 0xa0, 0x85, 0xc9, 0xf9,
+	0xdd, 0xc9, 0xc4, 0xbd, 0x4b, 0x5f, 0xdb, 0x43, 0x08, 0x2a, 0xf4, 0xa4, 0xca, 0x63, 0xcd, 0x7e,
+	0x0b, 0x45, 0xa0, 0x2c, 0x1d, 0xe9, 0xfa, 0xa0, 0xb2, 0x60, 0xe8, 0xca, 0x96, 0x42, 0x63, 0xca,
+	0x06, 0x34, 0x26, 0x13, 0xcb, 0x25, 0xec, 0x0a, 0x8c, 0x5f, 0xcc, 0x24, 0x02, 0xba, 0x66, 0xcc,
+	0x5e, 0x58, 0x65, 0x33, 0xe1, 0x80, 0xa6, 0xea, 0x23, 0xd7, 0x39, 0xfd, 0x82, 0x04, 0x3c, 0xca,
+	0xd1, 0xd0, 0xf8, 0xb1, 0x44, 0x39, 0x71, 0x3e, 0x64, 0x41, 0x5f, 0xad, 0x59, 0x8b, 0x2c, 0x96,
+	0x25, 0x8b, 0x89, 0x07, 0x8a, 0xe8, 0x41, 0xb1, 0xd7, 0x49, 0x04, 0x54, 0x31, 0x02, 0xc6, 0x0f,
+	0x25, 0xe8, 0x46, 0xde, 0x0d, 0x66, 0xc1, 0xe5, 0x72, 0xee, 0x37, 0x85, 0x82, 0x7b, 0x66, 0x07,
+	0x2b, 0x78, 0xb6, 0xe2, 0xde, 0xf9, 0xea, 0xf7, 0x2c, 0x2f, 0xe4, 0xfc, 0xd4, 0x05, 0xe5, 0x84,
+	0x04, 0x7c, 0xaf, 0xa4, 0x3f, 0x8b, 0x7b, 0x59, 0xe3, 0x57, 0x85, 0x1e, 0x7d, 0xcf, 0xec, 0x60,
+	0x15, 0xc6, 0xbf, 0xac, 0xd0, 0x5d, 0xe4, 0xd8, 0xf3, 0x72, 0xc0, 0xb6, 0x0f, 0x1d, 0x19, 0x35,
+	0x0f, 0xdd, 0x0e, 0x6f, 0xc5, 0xc3, 0x51, 0xaa, 0xef, 0x91, 0x75, 0xb1, 0xa0, 0x68, 0xec, 0x41,
+	0x5b, 0xca, 0x5c, 0x8f, 0x7f, 0x06, 0x90, 0xec, 0xf4, 0x45, 0x3b, 0x91, 0x26, 0x4e, 0xd4, 0x8c,
+	0xe7, 0x15, 0xee, 0x10, 0xdb, 0x4d, 0x5f, 0x83, 0x12, 0xc0, 0x3e, 0xc8, 0xa4, 0x99, 0x94, 0x92,
+	0x5e, 0x26, 0x2e, 0x8d, 0x6c, 0x67, 0x7c, 0x32, 0xa4, 0xa7, 0xf5, 0x36, 0x53, 0x4e, 0x04, 0xc9,
+	0x5e, 0xcf, 0x60, 0xd3, 0x3b, 0xe2, 0x5e, 0x1f, 0x22, 0xf9, 0xdf, 0x1d, 0x9e, 0xbb, 0x29, 0x52,
+	0x79, 0x68, 0x07, 0xaa, 0x8e, 0x48, 0xcd, 0x0d, 0x91, 0x9a, 0x89, 0x22, 0xe6, 0x5a, 0xc6, 0x43,
+	0x68, 0x62, 0x72, 0xce, 0xce, 0x2d, 0xd4, 0x2a, 0x7a, 0x07, 0x2a, 0x34, 0xae, 0x05, 0x1f, 0xc5,
+	0x30, 0x53, 0xc8, 0x26, 0xa7, 0xf1, 0x0d, 0x3b, 0xc5, 0x08, 0x57, 0xfb, 0x1f, 0x40, 0x35, 0xfc,
+	0x44, 0xa4, 0x97, 0x32, 0x3f, 0x44, 0x25, 0xaa, 0x98, 0x2b, 0xe6, 0x58, 0x3e, 0x80, 0x06, 0x26,
+	0xe7, 0x83, 0x59, 0x10, 0xfa, 0x79, 0x0d, 0x94, 0xd1, 0x2c, 0xd0, 0x4b, 0x79, 0x9f, 0xe1, 0x30,
+	0x9d, 0x4e, 0xce, 0x9a, 0x65, 0xe1, 0xac, 0x69, 0x7c, 0xaf, 0x02, 0x1c, 0x3a, 0x63, 0x33, 0x29,
+	0xe8, 0x0c, 0x2d, 0x39, 0x11, 0x05, 0xd1, 0xff, 0x89, 0xb8, 0x72, 0x22, 0x2a, 0x97, 0x30, 0x11,
+	0x69, 0x22, 0xcd, 0x0f, 0xd8, 0x55, 0x5e, 0x74, 0xaf, 0x1c, 0x0e, 0xd1, 0x5b, 0x00, 0x96, 0xf7,
+	0xc0, 0x9a, 0x5a, 0xde, 0x31, 0x99, 0xb0, 0x1c, 0xac, 0x63, 0x41, 0x22, 0xa7, 0xf0, 0xfa, 0x92,
+	0x14, 0xee, 0x17, 0xa6, 0xf0, 0x15, 0x39, 0x85, 0x9f, 0x29, 0xd0, 0x61, 0x54, 0x14, 0xda, 0x2c,
+	0xc1, 0xcf, 0x92, 0xec, 0x67, 0x12, 0xf9, 0x72, 0x76, 0x03, 0xa6, 0x64, 0x37, 0x60, 0x95, 0xec,
+	0x06, 0x4c, 0xcd, 0x6d, 0xc0, 0xaa, 0x4b, 0x1a, 0xb0, 0xda, 0x92, 0x06, 0xac, 0xbe, 0x24, 0x46,
+	0x5a, 0x51, 0x03, 0x06, 0xd9, 0x0d, 0x58, 0xa3, 0xa0, 0x01, 0x6b, 0x16, 0x35, 0x60, 0xad, 0x9c,
+	0x6d, 0xa1, 0x9d, 0xbe, 0x01, 0x49, 0xb8, 0xd7, 0x49, 0x71, 0xcf, 0x78, 0x56, 0xa2, 0x1b, 0xf8,
+	0x79, 0x82, 0x12, 0xf1, 0x0a, 0x2e, 0xa9, 0xa3, 0xd6, 0xad, 0x2c, 0xb7, 0x6e, 0xa1, 0x7b, 0x0c,
+	0xa4, 0x3a, 0xe6, 0x23, 0xb1, 0x03, 0xa9, 0xe4, 0x74, 0x20, 0x6a, 0x6e, 0x07, 0x52, 0x4d, 0x75,
+	0x20, 0xc6, 0x7d, 0xe8, 0x25, 0x95, 0x3e, 0x72, 0xf4, 0x7d, 0xa8, 0x9d, 0x86, 0x3f, 0x53, 0x9b,
+	0x42, 0x8a, 0x79, 0x38, 0x52, 0xdb, 0xfd, 0x59, 0x01, 0x95, 0xd5, 0x08, 0xf4, 0x31, 0xf4, 0xef,
+	0xb9, 0xc4, 0xf4, 0x09, 0x36, 0x9f, 0xc6, 0x37, 0x0a, 0xc3, 0x39, 0xca, 0xda, 0x19, 0x36, 0x3b,
+	0x5c, 0xf8, 0xf5, 0xd4, 0xb3, 0x1e, 0x4f, 0x87, 0x73, 0x63, 0x0d, 0xdd, 0x81, 0x75, 0x79, 0x3d,
+	0xad, 0xe0, 0x73, 0x94, 0x51, 0xb1, 0xb3, 0x56, 0x3f, 0x80, 0x0d, 0x79, 0x75, 0xb8, 0x5d, 0x0c,
+	0xe7, 0x28, 0x7f, 0x1f, 0xc9, 0xb6, 0xa3, 0x2f, 0x78, 0xc1, 0x2e, 0x67, 0x86, 0x73, 0x94, 0xf7,
+	0x9f, 0x8a, 0x2c, 0x3b, 0x9f, 0xc3, 0xe6, 0x62, 0x34, 0xc2, 0x5b, 0x9a, 0x0c, 0x9f, 0x92, 0xc9,
+	0x2c, 0x5b, 0xfb, 0x70, 0x35, 0xeb, 0xdd, 0xc2, 0xf8, 0xe4, 0xfe, 0xe7, 0x22, 0xc3, 0xd2, 0xa8,
+	0xca, 0xfe, 0xde, 0x72, 0xeb, 0x9f, 0x01, 0x00, 0xc8, 0xf5, 0xf9, 0xcf, 0x07, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
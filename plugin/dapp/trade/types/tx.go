@@ -15,6 +15,8 @@ type TradeSellTx struct {
 	AssetExec         string `json:"assetExec"`
 	PriceExec         string `json:"priceExec"`
 	PriceSymbol       string `json:"priceSymbol"`
+	TokenID           string `json:"tokenID"`
+	AutoMatch         bool   `json:"autoMatch"`
 }
 
 //TradeBuyTx :info for buy order to speficied order
@@ -41,6 +43,8 @@ type TradeBuyLimitTx struct {
 	AssetExec         string `json:"assetExec"`
 	PriceExec         string `json:"priceExec"`
 	PriceSymbol       string `json:"priceSymbol"`
+	TokenID           string `json:"tokenID"`
+	AutoMatch         bool   `json:"autoMatch"`
 }
 
 //TradeSellMarketTx :用于向指定买单出售token的信息
@@ -30,6 +30,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(createCmd())
 	cmd.AddCommand(withdrawCmd())
 	cmd.AddCommand(terminateCmd())
+	cmd.AddCommand(transferCmd())
 	cmd.AddCommand(showCmd())
 	cmd.AddCommand(queryWithdrawCmd())
 	cmd.AddCommand(listUnfreezeCmd())
@@ -44,6 +45,7 @@ func createCmd() *cobra.Command {
 
 	cmd.AddCommand(fixAmountCmd())
 	cmd.AddCommand(leftCmd())
+	cmd.AddCommand(cliffLinearCmd())
 	return cmd
 }
 
@@ -205,6 +207,54 @@ func left(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func cliffLinearCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cliff_linear",
+		Short: "create cliff and linear vesting means unfreeze construct",
+		Run:   cliffLinear,
+	}
+	cmd = createFlag(cmd)
+	cmd.Flags().Int64P("cliff", "c", 0, "cliff period in second from start, nothing is released during cliff")
+	cmd.Flags().Int64P("end_ts", "", 0, "all asset is released at end, UTC timestamp")
+	cmd.MarkFlagRequired("end_ts")
+	return cmd
+}
+
+func cliffLinear(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	create, err := getCreateFlags(cmd)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	cliff, _ := cmd.Flags().GetInt64("cliff")
+	endTs, _ := cmd.Flags().GetInt64("end_ts")
+	if cliff < 0 {
+		fmt.Fprintf(os.Stderr, "cliff must not be negative")
+		return
+	}
+	if create.StartTime > 0 && endTs-create.StartTime < cliff {
+		fmt.Fprintf(os.Stderr, "end_ts must be after the cliff")
+		return
+	}
+
+	create.Means = pty.CliffLinearX
+	create.MeansOpt = &pty.UnfreezeCreate_CliffLinear{CliffLinear: &pty.CliffLinear{Cliff: cliff, EndTime: endTs}}
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_CreateUnfreeze,
+		Payload:    types.MustPBToJSON(create),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func withdrawCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw",
@@ -229,6 +279,20 @@ func terminateCmd() *cobra.Command {
 	return cmd
 }
 
+func transferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "transfer construct to new beneficiary",
+		Run:   transfer,
+	}
+	cmd.Flags().StringP("id", "", "", "unfreeze construct id")
+	cmd.MarkFlagRequired("id")
+	cmd.Flags().StringP("to", "t", "", "address of new beneficiary")
+	cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
 func showCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show",
@@ -287,6 +351,24 @@ func terminate(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+func transfer(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	id, _ := cmd.Flags().GetString("id")
+	to, _ := cmd.Flags().GetString("to")
+
+	params := &rpctypes.CreateTxIn{
+		Execer:     cfg.ExecName(pty.UnfreezeX),
+		ActionName: pty.Action_TransferUnfreeze,
+		Payload:    types.MustPBToJSON(&pty.UnfreezeTransfer{UnfreezeID: id, Beneficiary: to}),
+	}
+
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
 func queryWithdraw(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	paraName, _ := cmd.Flags().GetString("paraName")
@@ -6,6 +6,7 @@ package executor
 
 import (
 	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
@@ -122,6 +123,42 @@ func (u *Unfreeze) Exec_Terminate(payload *pty.UnfreezeTerminate, tx *types.Tran
 	return mergeReceipt(receipt, receipt1)
 }
 
+// Exec_Transfer 执行转让冻结合约，收币人将剩余的解冻权益转给新地址
+func (u *Unfreeze) Exec_Transfer(payload *pty.UnfreezeTransfer, tx *types.Transaction, index int) (*types.Receipt, error) {
+	cfg := u.GetAPI().GetConfig()
+	if !cfg.IsDappFork(u.GetHeight(), pty.UnfreezeX, pty.ForkUnfreezeVestX) {
+		return nil, pty.ErrUnfreezeForkNotActive
+	}
+	if err := address.CheckAddress(payload.Beneficiary); err != nil {
+		return nil, err
+	}
+	unfreeze, err := loadUnfreeze(unfreezeIDFromHex(payload.UnfreezeID), u.GetStateDB())
+	if err != nil {
+		return nil, err
+	}
+	if unfreeze.Beneficiary != tx.From() {
+		uflog.Error("unfreeze transfer no privilege", "beneficiary", unfreeze.Beneficiary, "txFrom", tx.From())
+		return nil, pty.ErrNoPrivilege
+	}
+	if unfreeze.Remaining <= 0 {
+		uflog.Error("unfreeze transfer no asset")
+		return nil, pty.ErrUnfreezeEmptied
+	}
+
+	unfreezeOld := *unfreeze
+	unfreeze.Beneficiary = payload.Beneficiary
+	receiptLog := getUnfreezeLog(&unfreezeOld, unfreeze, pty.TyLogTransferUnfreeze)
+
+	k := []byte(unfreeze.UnfreezeID)
+	v := types.Encode(unfreeze)
+	err = u.GetStateDB().Set(k, v)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{{Key: k, Value: v}},
+		Logs: []*types.ReceiptLog{receiptLog}}, nil
+}
+
 func (u *Unfreeze) newEntity(payload *pty.UnfreezeCreate, tx *types.Transaction) (*pty.Unfreeze, error) {
 	id := unfreezeID(tx.Hash())
 	unfreeze := &pty.Unfreeze{
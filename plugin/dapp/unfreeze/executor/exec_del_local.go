@@ -20,7 +20,7 @@ func (u *Unfreeze) execDelLocal(receiptData *types.ReceiptData, index int) (*typ
 	txIndex := dapp.HeightIndexStr(u.GetHeight(), int64(index))
 	for _, log := range receiptData.Logs {
 		switch log.Ty {
-		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze:
+		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze, uf.TyLogTransferUnfreeze:
 			var receipt uf.ReceiptUnfreeze
 			err := types.Decode(log.Log, &receipt)
 			if err != nil {
@@ -62,3 +62,8 @@ func (u *Unfreeze) ExecDelLocal_Withdraw(payload *uf.UnfreezeWithdraw, tx *types
 func (u *Unfreeze) ExecDelLocal_Terminate(payload *uf.UnfreezeTerminate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return u.execDelLocal(receiptData, index)
 }
+
+// ExecDelLocal_Transfer 本地撤销执行冻结合约的转让
+func (u *Unfreeze) ExecDelLocal_Transfer(payload *uf.UnfreezeTransfer, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return u.execDelLocal(receiptData, index)
+}
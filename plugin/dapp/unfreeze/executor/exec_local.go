@@ -20,7 +20,7 @@ func (u *Unfreeze) execLocal(receiptData *types.ReceiptData, index int) (*types.
 
 	for _, log := range receiptData.Logs {
 		switch log.Ty {
-		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze:
+		case uf.TyLogWithdrawUnfreeze, uf.TyLogTerminateUnfreeze, uf.TyLogTransferUnfreeze:
 			var receipt uf.ReceiptUnfreeze
 			err := types.Decode(log.Log, &receipt)
 			if err != nil {
@@ -72,3 +72,8 @@ func (u *Unfreeze) ExecLocal_Withdraw(payload *uf.UnfreezeWithdraw, tx *types.Tr
 func (u *Unfreeze) ExecLocal_Terminate(payload *uf.UnfreezeTerminate, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return u.execLocal(receiptData, index)
 }
+
+// ExecLocal_Transfer 本地执行转让冻结合约
+func (u *Unfreeze) ExecLocal_Transfer(payload *uf.UnfreezeTransfer, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return u.execLocal(receiptData, index)
+}
@@ -272,6 +272,92 @@ func TestUnfreeze(t *testing.T) {
 	ldb.Close()
 }
 
+func TestUnfreezeCliffLinear(t *testing.T) {
+	chain33TestCfg.RegisterDappFork(pty.UnfreezeX, pty.ForkUnfreezeVestX, 0)
+	total := int64(100000)
+	execAddr := address.ExecAddress(pty.UnfreezeX)
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, ldb, kvdb := util.CreateTestDB()
+	defer ldb.Close()
+
+	acc, _ := account.NewAccountDB(chain33TestCfg, AssetExecPara, Symbol, stateDB)
+	acc.SaveExecAccount(execAddr, &types.Account{Balance: total, Addr: string(Nodes[0])})
+
+	ty := pty.UnfreezeType{}
+	ty.SetConfig(chain33TestCfg)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	exec := newUnfreeze()
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+
+	height := chain33TestCfg.GetDappFork(pty.UnfreezeX, pty.ForkUnfreezeIDX)
+	execTx := func(tx *types.Transaction, privKey string, blockTime int64) error {
+		tx, err := signTx(tx, privKey)
+		assert.Nil(t, err)
+		height++
+		exec.SetEnv(height, blockTime, 1539918074)
+		receipt, err := exec.Exec(tx, 1)
+		if err != nil {
+			return err
+		}
+		for _, kv := range receipt.KV {
+			stateDB.Set(kv.Key, kv.Value)
+		}
+		_, err = exec.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, 1)
+		assert.Nil(t, err)
+		return nil
+	}
+
+	// 创建，开始后100秒内不解冻，1100秒时全部解冻
+	p1 := &pty.UnfreezeCreate{
+		StartTime:   100,
+		AssetExec:   AssetExecPara,
+		AssetSymbol: Symbol,
+		TotalCount:  10000,
+		Beneficiary: string(Nodes[1]),
+		Means:       pty.CliffLinearX,
+		MeansOpt:    &pty.UnfreezeCreate_CliffLinear{CliffLinear: &pty.CliffLinear{Cliff: 100, EndTime: 1100}},
+	}
+	createTx, err := ty.RPC_UnfreezeCreateTx(p1)
+	assert.Nil(t, err)
+	assert.Nil(t, execTx(createTx, PrivKeyA, 50))
+	id := hex.EncodeToString(createTx.Hash())
+
+	unfreeze, err := loadUnfreeze(unfreezeIDFromHex(id), stateDB)
+	assert.Nil(t, err)
+	for _, c := range []struct{ now, available int64 }{{50, 0}, {199, 0}, {200, 1000}, {600, 5000}, {1100, 10000}, {2000, 10000}} {
+		u := *unfreeze
+		available, err := getWithdrawAvailable(chain33TestCfg, &u, c.now)
+		assert.Nil(t, err)
+		assert.Equal(t, c.available, available)
+	}
+
+	withdrawTx, _ := ty.RPC_UnfreezeWithdrawTx(&pty.UnfreezeWithdraw{UnfreezeID: id})
+	assert.Nil(t, execTx(withdrawTx, PrivKeyB, 600))
+	assert.Equal(t, int64(5000), acc.LoadExecAccount(string(Nodes[1]), execAddr).Balance)
+
+	// 只有收币人可以转让
+	transferTx, _ := ty.RPC_UnfreezeTransferTx(&pty.UnfreezeTransfer{UnfreezeID: id, Beneficiary: string(Nodes[2])})
+	assert.Equal(t, pty.ErrNoPrivilege, execTx(transferTx, PrivKeyA, 700))
+	transferTx, _ = ty.RPC_UnfreezeTransferTx(&pty.UnfreezeTransfer{UnfreezeID: id, Beneficiary: string(Nodes[2])})
+	assert.Nil(t, execTx(transferTx, PrivKeyB, 700))
+
+	withdrawTx, _ = ty.RPC_UnfreezeWithdrawTx(&pty.UnfreezeWithdraw{UnfreezeID: id})
+	assert.Equal(t, pty.ErrNoPrivilege, execTx(withdrawTx, PrivKeyB, 1100))
+	withdrawTx, _ = ty.RPC_UnfreezeWithdrawTx(&pty.UnfreezeWithdraw{UnfreezeID: id})
+	assert.Nil(t, execTx(withdrawTx, PrivKeyC, 1100))
+	assert.Equal(t, int64(5000), acc.LoadExecAccount(string(Nodes[2]), execAddr).Balance)
+	assert.Equal(t, int64(0), acc.LoadExecAccount(string(Nodes[0]), execAddr).Frozen)
+
+	reply, err := exec.Query("ListUnfreezeByBeneficiary", types.Encode(&pty.ReqUnfreezes{Beneficiary: string(Nodes[2])}))
+	assert.Nil(t, err)
+	resp := reply.(*pty.ReplyUnfreezes)
+	assert.Equal(t, 1, len(resp.Unfreeze))
+	assert.Equal(t, int64(1100), resp.Unfreeze[0].GetCliffLinear().EndTime)
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName(pty.UnfreezeX, signType))
@@ -5,6 +5,8 @@
 package executor
 
 import (
+	"math/big"
+
 	"github.com/33cn/chain33/types"
 	pty "github.com/33cn/plugin/plugin/dapp/unfreeze/types"
 )
@@ -16,6 +18,12 @@ type Means interface {
 }
 
 func newMeans(cfg *types.Chain33Config, means string, height int64) (Means, error) {
+	if means == pty.CliffLinearX {
+		if !cfg.IsDappFork(height, pty.UnfreezeX, pty.ForkUnfreezeVestX) {
+			return nil, types.ErrNotSupport
+		}
+		return &cliffLinear{}, nil
+	}
 	if cfg.IsDappFork(height, pty.UnfreezeX, "ForkTerminatePart") {
 		if means == "FixAmount" {
 			return &fixAmountV2{}, nil
@@ -158,3 +166,36 @@ func (opt *leftProportionV2) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int6
 	}
 	return int64(frozen), nil
 }
+
+// 悬崖期内全部冻结，悬崖期后按开始时间到结束时间的比例线性解冻
+type cliffLinear struct {
+}
+
+func (opt *cliffLinear) setOpt(unfreeze *pty.Unfreeze, from *pty.UnfreezeCreate) (*pty.Unfreeze, error) {
+	o := from.GetCliffLinear()
+	if o == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if o.Cliff < 0 || o.EndTime <= unfreeze.StartTime || o.EndTime-unfreeze.StartTime < o.Cliff {
+		return nil, types.ErrInvalidParam
+	}
+	unfreeze.MeansOpt = &pty.Unfreeze_CliffLinear{CliffLinear: o}
+	return unfreeze, nil
+}
+
+func (opt *cliffLinear) calcFrozen(unfreeze *pty.Unfreeze, now int64) (int64, error) {
+	means := unfreeze.GetCliffLinear()
+	if means == nil {
+		return 0, types.ErrInvalidParam
+	}
+	if unfreeze.Terminated || now >= means.EndTime {
+		return 0, nil
+	}
+	if now < unfreeze.StartTime+means.Cliff {
+		return unfreeze.TotalCount, nil
+	}
+	// total*(now-start)/(end-start) 可能超出int64
+	unfrozen := new(big.Int).Mul(big.NewInt(unfreeze.TotalCount), big.NewInt(now-unfreeze.StartTime))
+	unfrozen.Div(unfrozen, big.NewInt(means.EndTime-unfreeze.StartTime))
+	return unfreeze.TotalCount - unfrozen.Int64(), nil
+}
@@ -54,7 +54,12 @@ func QueryWithdraw(cfg *types.Chain33Config, stateDB dbm.KV, id string) (types.M
 }
 
 func getWithdrawAvailable(cfg *types.Chain33Config, unfreeze *pty.Unfreeze, calcTime int64) (int64, error) {
-	means, err := newMeans(cfg, unfreeze.Means, 1500000)
+	height := int64(1500000)
+	if unfreeze.Means == pty.CliffLinearX {
+		// CliffLinear 记录只能在 ForkUnfreezeVest 之后创建
+		height = types.MaxHeight
+	}
+	means, err := newMeans(cfg, unfreeze.Means, height)
 	if err != nil {
 		return 0, err
 	}
@@ -146,6 +151,8 @@ func fmtLocalUnfreeze(rows []*table.Row) (*pty.ReplyUnfreezes, error) {
 			v.MeansOpt = &pty.ReplyUnfreeze_FixAmount{FixAmount: r.Unfreeze.GetFixAmount()}
 		} else if v.Means == pty.LeftProportionX {
 			v.MeansOpt = &pty.ReplyUnfreeze_LeftProportion{LeftProportion: r.Unfreeze.GetLeftProportion()}
+		} else if v.Means == pty.CliffLinearX {
+			v.MeansOpt = &pty.ReplyUnfreeze_CliffLinear{CliffLinear: r.Unfreeze.GetCliffLinear()}
 		}
 		results.Unfreeze = append(results.Unfreeze, v)
 	}
@@ -99,3 +99,17 @@ func (c *Jrpc) CreateRawUnfreezeTerminate(param *pty.UnfreezeTerminate, result *
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawUnfreezeTransfer 转让冻结合约
+func (c *Jrpc) CreateRawUnfreezeTransfer(param *pty.UnfreezeTransfer, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(pty.UnfreezeX), "Transfer", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
@@ -9,11 +9,13 @@ const (
 	UnfreezeActionCreate = iota + 1
 	UnfreezeActionWithdraw
 	UnfreezeActionTerminate
+	UnfreezeActionTransfer
 
 	//log for unfreeze
 	TyLogCreateUnfreeze    = 2001 // TODO 修改具体编号
 	TyLogWithdrawUnfreeze  = 2002
 	TyLogTerminateUnfreeze = 2003
+	TyLogTransferUnfreeze  = 2004
 )
 
 const (
@@ -23,6 +25,8 @@ const (
 	Action_WithdrawUnfreeze = "withdrawUnfreeze"
 	// Action_TerminateUnfreeze Action 名字
 	Action_TerminateUnfreeze = "terminateUnfreeze"
+	// Action_TransferUnfreeze Action 名字
+	Action_TransferUnfreeze = "transferUnfreeze"
 )
 
 const (
@@ -41,8 +45,10 @@ var (
 
 	FixAmountX      = "FixAmount"
 	LeftProportionX = "LeftProportion"
-	SupportMeans    = []string{"FixAmount", "LeftProportion"}
+	CliffLinearX    = "CliffLinear"
+	SupportMeans    = []string{"FixAmount", "LeftProportion", "CliffLinear"}
 
 	ForkTerminatePartX = "ForkTerminatePart"
 	ForkUnfreezeIDX    = "ForkUnfreezeIDX"
+	ForkUnfreezeVestX  = "ForkUnfreezeVest"
 )
@@ -17,4 +17,6 @@ var (
 	ErrNoPrivilege = errors.New("ErrNoPrivilege")
 	// ErrTerminated 已经被取消过了
 	ErrTerminated = errors.New("ErrTerminated")
+	// ErrUnfreezeForkNotActive 分叉前不支持
+	ErrUnfreezeForkNotActive = errors.New("ErrUnfreezeForkNotActive")
 )
@@ -19,6 +19,7 @@ type parseUnfreezeCreate struct {
 	Means          string          `protobuf:"bytes,6,opt,name=means,proto3" json:"means,omitempty"`
 	FixAmount      *FixAmount      `json:"fixAmount,omitempty"`
 	LeftProportion *LeftProportion `json:"leftProportion,omitempty"`
+	CliffLinear    *CliffLinear    `json:"cliffLinear,omitempty"`
 }
 
 // UnmarshalJSON 解析UnfreezeCreate
@@ -32,6 +33,8 @@ func (m *UnfreezeCreate) UnmarshalJSON(v []byte) error {
 		m.MeansOpt = &UnfreezeCreate_FixAmount{FixAmount: c.FixAmount}
 	} else if c.Means == LeftProportionX && c.LeftProportion != nil {
 		m.MeansOpt = &UnfreezeCreate_LeftProportion{LeftProportion: c.LeftProportion}
+	} else if c.Means == CliffLinearX && c.CliffLinear != nil {
+		m.MeansOpt = &UnfreezeCreate_CliffLinear{CliffLinear: c.CliffLinear}
 	} else {
 		return types.ErrInvalidParam
 	}
@@ -47,6 +47,7 @@ type Unfreeze struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*Unfreeze_FixAmount
 	//	*Unfreeze_LeftProportion
+	//	*Unfreeze_CliffLinear
 	MeansOpt             isUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated           bool                `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -154,10 +155,16 @@ type Unfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type Unfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,14,opt,name=cliffLinear,proto3,oneof"`
+}
+
 func (*Unfreeze_FixAmount) isUnfreeze_MeansOpt() {}
 
 func (*Unfreeze_LeftProportion) isUnfreeze_MeansOpt() {}
 
+func (*Unfreeze_CliffLinear) isUnfreeze_MeansOpt() {}
+
 func (m *Unfreeze) GetMeansOpt() isUnfreeze_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -179,6 +186,13 @@ func (m *Unfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *Unfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := m.GetMeansOpt().(*Unfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
 func (m *Unfreeze) GetTerminated() bool {
 	if m != nil {
 		return m.Terminated
@@ -191,6 +205,7 @@ func (*Unfreeze) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Unfreeze_FixAmount)(nil),
 		(*Unfreeze_LeftProportion)(nil),
+		(*Unfreeze_CliffLinear)(nil),
 	}
 }
 
@@ -290,12 +305,63 @@ func (m *LeftProportion) GetTenThousandth() int64 {
 	return 0
 }
 
+// 悬崖期内不解冻，之后从开始时间到结束时间按秒线性解冻
+type CliffLinear struct {
+	//悬崖期(秒)，从开始时间算起
+	Cliff int64 `protobuf:"varint,1,opt,name=cliff,proto3" json:"cliff,omitempty"`
+	//全部解冻的时间
+	EndTime              int64    `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CliffLinear) Reset()         { *m = CliffLinear{} }
+func (m *CliffLinear) String() string { return proto.CompactTextString(m) }
+func (*CliffLinear) ProtoMessage()    {}
+func (*CliffLinear) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{3}
+}
+
+func (m *CliffLinear) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CliffLinear.Unmarshal(m, b)
+}
+func (m *CliffLinear) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CliffLinear.Marshal(b, m, deterministic)
+}
+func (m *CliffLinear) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CliffLinear.Merge(m, src)
+}
+func (m *CliffLinear) XXX_Size() int {
+	return xxx_messageInfo_CliffLinear.Size(m)
+}
+func (m *CliffLinear) XXX_DiscardUnknown() {
+	xxx_messageInfo_CliffLinear.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CliffLinear proto.InternalMessageInfo
+
+func (m *CliffLinear) GetCliff() int64 {
+	if m != nil {
+		return m.Cliff
+	}
+	return 0
+}
+
+func (m *CliffLinear) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
 // message for execs.unfreeze
 type UnfreezeAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*UnfreezeAction_Create
 	//	*UnfreezeAction_Withdraw
 	//	*UnfreezeAction_Terminate
+	//	*UnfreezeAction_Transfer
 	Value                isUnfreezeAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -307,7 +373,7 @@ func (m *UnfreezeAction) Reset()         { *m = UnfreezeAction{} }
 func (m *UnfreezeAction) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeAction) ProtoMessage()    {}
 func (*UnfreezeAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{3}
+	return fileDescriptor_6caa0554cb0b9167, []int{4}
 }
 
 func (m *UnfreezeAction) XXX_Unmarshal(b []byte) error {
@@ -344,12 +410,18 @@ type UnfreezeAction_Terminate struct {
 	Terminate *UnfreezeTerminate `protobuf:"bytes,3,opt,name=terminate,proto3,oneof"`
 }
 
+type UnfreezeAction_Transfer struct {
+	Transfer *UnfreezeTransfer `protobuf:"bytes,5,opt,name=transfer,proto3,oneof"`
+}
+
 func (*UnfreezeAction_Create) isUnfreezeAction_Value() {}
 
 func (*UnfreezeAction_Withdraw) isUnfreezeAction_Value() {}
 
 func (*UnfreezeAction_Terminate) isUnfreezeAction_Value() {}
 
+func (*UnfreezeAction_Transfer) isUnfreezeAction_Value() {}
+
 func (m *UnfreezeAction) GetValue() isUnfreezeAction_Value {
 	if m != nil {
 		return m.Value
@@ -378,6 +450,13 @@ func (m *UnfreezeAction) GetTerminate() *UnfreezeTerminate {
 	return nil
 }
 
+func (m *UnfreezeAction) GetTransfer() *UnfreezeTransfer {
+	if x, ok := m.GetValue().(*UnfreezeAction_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
 func (m *UnfreezeAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -391,6 +470,7 @@ func (*UnfreezeAction) XXX_OneofWrappers() []interface{} {
 		(*UnfreezeAction_Create)(nil),
 		(*UnfreezeAction_Withdraw)(nil),
 		(*UnfreezeAction_Terminate)(nil),
+		(*UnfreezeAction_Transfer)(nil),
 	}
 }
 
@@ -405,6 +485,7 @@ type UnfreezeCreate struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*UnfreezeCreate_FixAmount
 	//	*UnfreezeCreate_LeftProportion
+	//	*UnfreezeCreate_CliffLinear
 	MeansOpt             isUnfreezeCreate_MeansOpt `protobuf_oneof:"meansOpt"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
@@ -415,7 +496,7 @@ func (m *UnfreezeCreate) Reset()         { *m = UnfreezeCreate{} }
 func (m *UnfreezeCreate) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeCreate) ProtoMessage()    {}
 func (*UnfreezeCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{4}
+	return fileDescriptor_6caa0554cb0b9167, []int{5}
 }
 
 func (m *UnfreezeCreate) XXX_Unmarshal(b []byte) error {
@@ -490,10 +571,16 @@ type UnfreezeCreate_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,8,opt,name=leftProportion,proto3,oneof"`
 }
 
+type UnfreezeCreate_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,9,opt,name=cliffLinear,proto3,oneof"`
+}
+
 func (*UnfreezeCreate_FixAmount) isUnfreezeCreate_MeansOpt() {}
 
 func (*UnfreezeCreate_LeftProportion) isUnfreezeCreate_MeansOpt() {}
 
+func (*UnfreezeCreate_CliffLinear) isUnfreezeCreate_MeansOpt() {}
+
 func (m *UnfreezeCreate) GetMeansOpt() isUnfreezeCreate_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -515,11 +602,19 @@ func (m *UnfreezeCreate) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *UnfreezeCreate) GetCliffLinear() *CliffLinear {
+	if x, ok := m.GetMeansOpt().(*UnfreezeCreate_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*UnfreezeCreate) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*UnfreezeCreate_FixAmount)(nil),
 		(*UnfreezeCreate_LeftProportion)(nil),
+		(*UnfreezeCreate_CliffLinear)(nil),
 	}
 }
 
@@ -534,7 +629,7 @@ func (m *UnfreezeWithdraw) Reset()         { *m = UnfreezeWithdraw{} }
 func (m *UnfreezeWithdraw) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeWithdraw) ProtoMessage()    {}
 func (*UnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{5}
+	return fileDescriptor_6caa0554cb0b9167, []int{6}
 }
 
 func (m *UnfreezeWithdraw) XXX_Unmarshal(b []byte) error {
@@ -573,7 +668,7 @@ func (m *UnfreezeTerminate) Reset()         { *m = UnfreezeTerminate{} }
 func (m *UnfreezeTerminate) String() string { return proto.CompactTextString(m) }
 func (*UnfreezeTerminate) ProtoMessage()    {}
 func (*UnfreezeTerminate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{6}
+	return fileDescriptor_6caa0554cb0b9167, []int{7}
 }
 
 func (m *UnfreezeTerminate) XXX_Unmarshal(b []byte) error {
@@ -601,6 +696,54 @@ func (m *UnfreezeTerminate) GetUnfreezeID() string {
 	return ""
 }
 
+// 收币人将解冻合约转让给新的地址
+type UnfreezeTransfer struct {
+	UnfreezeID           string   `protobuf:"bytes,1,opt,name=unfreezeID,proto3" json:"unfreezeID,omitempty"`
+	Beneficiary          string   `protobuf:"bytes,2,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnfreezeTransfer) Reset()         { *m = UnfreezeTransfer{} }
+func (m *UnfreezeTransfer) String() string { return proto.CompactTextString(m) }
+func (*UnfreezeTransfer) ProtoMessage()    {}
+func (*UnfreezeTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6caa0554cb0b9167, []int{8}
+}
+
+func (m *UnfreezeTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnfreezeTransfer.Unmarshal(m, b)
+}
+func (m *UnfreezeTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnfreezeTransfer.Marshal(b, m, deterministic)
+}
+func (m *UnfreezeTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnfreezeTransfer.Merge(m, src)
+}
+func (m *UnfreezeTransfer) XXX_Size() int {
+	return xxx_messageInfo_UnfreezeTransfer.Size(m)
+}
+func (m *UnfreezeTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnfreezeTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnfreezeTransfer proto.InternalMessageInfo
+
+func (m *UnfreezeTransfer) GetUnfreezeID() string {
+	if m != nil {
+		return m.UnfreezeID
+	}
+	return ""
+}
+
+func (m *UnfreezeTransfer) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
 // receipt
 type ReceiptUnfreeze struct {
 	Prev                 *Unfreeze `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
@@ -614,7 +757,7 @@ func (m *ReceiptUnfreeze) Reset()         { *m = ReceiptUnfreeze{} }
 func (m *ReceiptUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*ReceiptUnfreeze) ProtoMessage()    {}
 func (*ReceiptUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{7}
+	return fileDescriptor_6caa0554cb0b9167, []int{9}
 }
 
 func (m *ReceiptUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -661,7 +804,7 @@ func (m *LocalUnfreeze) Reset()         { *m = LocalUnfreeze{} }
 func (m *LocalUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*LocalUnfreeze) ProtoMessage()    {}
 func (*LocalUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{8}
+	return fileDescriptor_6caa0554cb0b9167, []int{10}
 }
 
 func (m *LocalUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -709,7 +852,7 @@ func (m *ReplyQueryUnfreezeWithdraw) Reset()         { *m = ReplyQueryUnfreezeWi
 func (m *ReplyQueryUnfreezeWithdraw) String() string { return proto.CompactTextString(m) }
 func (*ReplyQueryUnfreezeWithdraw) ProtoMessage()    {}
 func (*ReplyQueryUnfreezeWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{9}
+	return fileDescriptor_6caa0554cb0b9167, []int{11}
 }
 
 func (m *ReplyQueryUnfreezeWithdraw) XXX_Unmarshal(b []byte) error {
@@ -759,7 +902,7 @@ func (m *ReqUnfreezes) Reset()         { *m = ReqUnfreezes{} }
 func (m *ReqUnfreezes) String() string { return proto.CompactTextString(m) }
 func (*ReqUnfreezes) ProtoMessage()    {}
 func (*ReqUnfreezes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{10}
+	return fileDescriptor_6caa0554cb0b9167, []int{12}
 }
 
 func (m *ReqUnfreezes) XXX_Unmarshal(b []byte) error {
@@ -836,6 +979,7 @@ type ReplyUnfreeze struct {
 	// Types that are valid to be assigned to MeansOpt:
 	//	*ReplyUnfreeze_FixAmount
 	//	*ReplyUnfreeze_LeftProportion
+	//	*ReplyUnfreeze_CliffLinear
 	MeansOpt             isReplyUnfreeze_MeansOpt `protobuf_oneof:"meansOpt"`
 	Terminated           bool                     `protobuf:"varint,12,opt,name=terminated,proto3" json:"terminated,omitempty"`
 	Key                  string                   `protobuf:"bytes,13,opt,name=key,proto3" json:"key,omitempty"`
@@ -848,7 +992,7 @@ func (m *ReplyUnfreeze) Reset()         { *m = ReplyUnfreeze{} }
 func (m *ReplyUnfreeze) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnfreeze) ProtoMessage()    {}
 func (*ReplyUnfreeze) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{11}
+	return fileDescriptor_6caa0554cb0b9167, []int{13}
 }
 
 func (m *ReplyUnfreeze) XXX_Unmarshal(b []byte) error {
@@ -944,10 +1088,16 @@ type ReplyUnfreeze_LeftProportion struct {
 	LeftProportion *LeftProportion `protobuf:"bytes,11,opt,name=leftProportion,proto3,oneof"`
 }
 
+type ReplyUnfreeze_CliffLinear struct {
+	CliffLinear *CliffLinear `protobuf:"bytes,14,opt,name=cliffLinear,proto3,oneof"`
+}
+
 func (*ReplyUnfreeze_FixAmount) isReplyUnfreeze_MeansOpt() {}
 
 func (*ReplyUnfreeze_LeftProportion) isReplyUnfreeze_MeansOpt() {}
 
+func (*ReplyUnfreeze_CliffLinear) isReplyUnfreeze_MeansOpt() {}
+
 func (m *ReplyUnfreeze) GetMeansOpt() isReplyUnfreeze_MeansOpt {
 	if m != nil {
 		return m.MeansOpt
@@ -969,6 +1119,13 @@ func (m *ReplyUnfreeze) GetLeftProportion() *LeftProportion {
 	return nil
 }
 
+func (m *ReplyUnfreeze) GetCliffLinear() *CliffLinear {
+	if x, ok := m.GetMeansOpt().(*ReplyUnfreeze_CliffLinear); ok {
+		return x.CliffLinear
+	}
+	return nil
+}
+
 func (m *ReplyUnfreeze) GetTerminated() bool {
 	if m != nil {
 		return m.Terminated
@@ -988,6 +1145,7 @@ func (*ReplyUnfreeze) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ReplyUnfreeze_FixAmount)(nil),
 		(*ReplyUnfreeze_LeftProportion)(nil),
+		(*ReplyUnfreeze_CliffLinear)(nil),
 	}
 }
 
@@ -1002,7 +1160,7 @@ func (m *ReplyUnfreezes) Reset()         { *m = ReplyUnfreezes{} }
 func (m *ReplyUnfreezes) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnfreezes) ProtoMessage()    {}
 func (*ReplyUnfreezes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6caa0554cb0b9167, []int{12}
+	return fileDescriptor_6caa0554cb0b9167, []int{14}
 }
 
 func (m *ReplyUnfreezes) XXX_Unmarshal(b []byte) error {
@@ -1034,10 +1192,12 @@ func init() {
 	proto.RegisterType((*Unfreeze)(nil), "types.Unfreeze")
 	proto.RegisterType((*FixAmount)(nil), "types.FixAmount")
 	proto.RegisterType((*LeftProportion)(nil), "types.LeftProportion")
+	proto.RegisterType((*CliffLinear)(nil), "types.CliffLinear")
 	proto.RegisterType((*UnfreezeAction)(nil), "types.UnfreezeAction")
 	proto.RegisterType((*UnfreezeCreate)(nil), "types.UnfreezeCreate")
 	proto.RegisterType((*UnfreezeWithdraw)(nil), "types.UnfreezeWithdraw")
 	proto.RegisterType((*UnfreezeTerminate)(nil), "types.UnfreezeTerminate")
+	proto.RegisterType((*UnfreezeTransfer)(nil), "types.UnfreezeTransfer")
 	proto.RegisterType((*ReceiptUnfreeze)(nil), "types.ReceiptUnfreeze")
 	proto.RegisterType((*LocalUnfreeze)(nil), "types.LocalUnfreeze")
 	proto.RegisterType((*ReplyQueryUnfreezeWithdraw)(nil), "types.ReplyQueryUnfreezeWithdraw")
@@ -1051,55 +1211,60 @@ func init() {
 }
 
 var fileDescriptor_6caa0554cb0b9167 = []byte{
-	// 755 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcb, 0x6e, 0x13, 0x4b,
-	0x10, 0xf5, 0x78, 0x3c, 0x7e, 0x94, 0x63, 0xc7, 0xb7, 0x6f, 0xee, 0x65, 0x14, 0x21, 0x64, 0x06,
-	0x16, 0x46, 0x48, 0x01, 0x39, 0x20, 0x21, 0xb1, 0x40, 0x49, 0x78, 0x38, 0x22, 0xe2, 0x31, 0x09,
-	0xb0, 0x6e, 0x8f, 0xcb, 0x49, 0x8b, 0x79, 0xa5, 0xdd, 0x4e, 0x3c, 0x7c, 0x04, 0x5f, 0xc0, 0x9f,
-	0xb0, 0x66, 0xc5, 0x8e, 0x2f, 0x42, 0xd3, 0xf3, 0x1e, 0x27, 0x58, 0x61, 0x87, 0xc4, 0xce, 0x75,
-	0xaa, 0xea, 0x74, 0x75, 0xd5, 0xa9, 0x1e, 0x43, 0x77, 0xee, 0x4e, 0x39, 0xe2, 0x27, 0xdc, 0xf2,
-	0xb9, 0x27, 0x3c, 0xa2, 0x89, 0xc0, 0xc7, 0xd9, 0xe6, 0x9a, 0xe5, 0x39, 0x8e, 0xe7, 0x46, 0xa0,
-	0xf1, 0x55, 0x85, 0xe6, 0xbb, 0x38, 0x8e, 0xdc, 0x00, 0x48, 0x72, 0xf6, 0x9f, 0xea, 0x4a, 0x5f,
-	0x19, 0xb4, 0xcc, 0x1c, 0x42, 0xae, 0x43, 0x6b, 0x26, 0x28, 0x17, 0x47, 0xcc, 0x41, 0xbd, 0xda,
-	0x57, 0x06, 0xaa, 0x99, 0x01, 0xa1, 0x97, 0xce, 0x66, 0x28, 0x9e, 0x2d, 0xd0, 0xd2, 0x55, 0x99,
-	0x9c, 0x01, 0xa4, 0x0f, 0x6d, 0x69, 0x1c, 0x06, 0xce, 0xd8, 0xb3, 0xf5, 0x9a, 0xf4, 0xe7, 0xa1,
-	0xf0, 0x74, 0xe1, 0x09, 0x6a, 0xef, 0x79, 0x73, 0x57, 0xe8, 0x9a, 0xa4, 0xcf, 0x21, 0x21, 0x3f,
-	0x73, 0x99, 0x60, 0x54, 0x78, 0x5c, 0xaf, 0x47, 0xfc, 0x29, 0x10, 0xf2, 0x8f, 0xd1, 0xc5, 0x29,
-	0xb3, 0x18, 0xe5, 0x81, 0xde, 0x88, 0xf8, 0x73, 0x50, 0x98, 0xcf, 0xd1, 0xa1, 0xcc, 0x65, 0xee,
-	0xb1, 0xde, 0x8c, 0xaa, 0x4f, 0x01, 0xb2, 0x01, 0x9a, 0x83, 0xd4, 0x9d, 0xe9, 0x2d, 0x99, 0x19,
-	0x19, 0xe4, 0x3e, 0xb4, 0xa6, 0x6c, 0xb1, 0xe3, 0xc8, 0x92, 0xa0, 0xaf, 0x0c, 0xda, 0xc3, 0xde,
-	0x96, 0xec, 0xe3, 0xd6, 0xf3, 0x04, 0x1f, 0x55, 0xcc, 0x2c, 0x88, 0x3c, 0x81, 0xae, 0x8d, 0x53,
-	0xf1, 0x86, 0x7b, 0xbe, 0xc7, 0x05, 0xf3, 0x5c, 0xbd, 0x2d, 0xd3, 0xfe, 0x8b, 0xd3, 0x0e, 0x0a,
-	0xce, 0x51, 0xc5, 0x2c, 0x85, 0xcb, 0x36, 0x20, 0x77, 0x98, 0x4b, 0x05, 0x4e, 0xf4, 0xb5, 0xbe,
-	0x32, 0x68, 0x9a, 0x39, 0x64, 0x17, 0xa0, 0x29, 0x6b, 0x7b, 0xed, 0x0b, 0xe3, 0x31, 0xb4, 0xd2,
-	0x32, 0xc8, 0xff, 0x50, 0xf7, 0x91, 0x33, 0x6f, 0x22, 0x27, 0xa7, 0x9a, 0xb1, 0x15, 0xe2, 0x34,
-	0xba, 0x40, 0x34, 0xb2, 0xd8, 0x32, 0x5e, 0x41, 0xb7, 0x58, 0xcc, 0xa5, 0x0c, 0xb7, 0xa1, 0x23,
-	0xd0, 0x3d, 0x3a, 0xf1, 0xe6, 0x33, 0xea, 0x4e, 0xc4, 0x49, 0x4c, 0x54, 0x04, 0x8d, 0xef, 0x0a,
-	0x74, 0x13, 0x29, 0xed, 0x58, 0x92, 0xf0, 0x1e, 0xd4, 0x2d, 0x8e, 0x54, 0xa0, 0x24, 0xcc, 0x9a,
-	0x90, 0x84, 0xed, 0x49, 0xe7, 0xa8, 0x62, 0xc6, 0x61, 0xe4, 0x21, 0x34, 0xcf, 0x99, 0x38, 0x99,
-	0x70, 0x7a, 0x2e, 0x0f, 0x69, 0x0f, 0xaf, 0x95, 0x52, 0x3e, 0xc4, 0xee, 0x51, 0xc5, 0x4c, 0x43,
-	0xc9, 0x23, 0x68, 0xa5, 0x1d, 0x92, 0xd2, 0x6b, 0x0f, 0xf5, 0x52, 0xde, 0x51, 0xe2, 0x0f, 0xc7,
-	0x95, 0x06, 0x93, 0x2e, 0x54, 0x45, 0x20, 0xd5, 0xa8, 0x99, 0x55, 0x11, 0xec, 0x36, 0x40, 0x3b,
-	0xa3, 0xf6, 0x1c, 0x8d, 0x6f, 0xd5, 0xec, 0x36, 0x51, 0x99, 0x45, 0xf9, 0x2b, 0xbf, 0x94, 0x7f,
-	0x75, 0x85, 0xfc, 0xd5, 0x55, 0xf2, 0xaf, 0x2d, 0xc9, 0xbf, 0x24, 0x70, 0x6d, 0x59, 0xe0, 0xa9,
-	0x84, 0xeb, 0x97, 0x4a, 0xb8, 0xf1, 0x7b, 0x12, 0x6e, 0x5e, 0x49, 0xc2, 0x05, 0x89, 0x0e, 0xa1,
-	0x57, 0x1e, 0xdd, 0xaa, 0x77, 0xc6, 0xd8, 0x86, 0x7f, 0x96, 0xc6, 0xb6, 0x32, 0x89, 0xc2, 0xba,
-	0x89, 0x16, 0x32, 0x5f, 0xa4, 0xef, 0xd9, 0x2d, 0xa8, 0xf9, 0x1c, 0xcf, 0x62, 0xf1, 0xad, 0x97,
-	0x14, 0x61, 0x4a, 0x27, 0xb9, 0x03, 0x0d, 0x6b, 0xce, 0x39, 0xc6, 0xfb, 0x71, 0x41, 0x5c, 0xe2,
-	0x37, 0xde, 0x43, 0xe7, 0xc0, 0xb3, 0xa8, 0x9d, 0x1e, 0x70, 0x17, 0x9a, 0x49, 0x05, 0x97, 0x1d,
-	0x92, 0x06, 0x10, 0x1d, 0x1a, 0x62, 0xb1, 0xef, 0x4e, 0x70, 0x11, 0xcb, 0x23, 0x31, 0x8d, 0x29,
-	0x6c, 0x9a, 0xe8, 0xdb, 0xc1, 0xdb, 0x39, 0xf2, 0xe0, 0xaa, 0xdd, 0x22, 0x03, 0x58, 0xa7, 0x67,
-	0x94, 0xd9, 0x74, 0x6c, 0xe3, 0x4e, 0x7e, 0xd1, 0xcb, 0xb0, 0xf1, 0x45, 0x81, 0x35, 0x13, 0x4f,
-	0x93, 0x13, 0x66, 0xa1, 0x66, 0x27, 0x8c, 0xa3, 0x5c, 0x56, 0xc9, 0xac, 0x99, 0x19, 0x10, 0xea,
-	0xc9, 0x4a, 0xe9, 0x34, 0x33, 0x32, 0xc2, 0x6b, 0x4c, 0xb9, 0xe7, 0xbc, 0xc4, 0x20, 0x56, 0x71,
-	0x62, 0x16, 0x1f, 0xe8, 0xda, 0x8a, 0x07, 0x7a, 0x59, 0xbf, 0xc6, 0x0f, 0x15, 0x3a, 0xb2, 0x0f,
-	0x7f, 0x3f, 0x48, 0x7f, 0xd0, 0x07, 0x89, 0xf4, 0x40, 0xfd, 0x88, 0x81, 0xde, 0x91, 0x65, 0x86,
-	0x3f, 0x0b, 0xfb, 0xbf, 0x0b, 0xdd, 0xc2, 0x4c, 0xc3, 0x2b, 0xe4, 0x97, 0x46, 0x1d, 0xb4, 0x87,
-	0x1b, 0x71, 0x29, 0x85, 0xc0, 0x6c, 0x73, 0x86, 0x9f, 0x95, 0x2c, 0x85, 0x1c, 0xc0, 0xbf, 0x2f,
-	0x50, 0x2c, 0x6d, 0x49, 0x2f, 0xe5, 0x38, 0x3d, 0x14, 0x9c, 0xb9, 0xc7, 0x9b, 0x37, 0xf3, 0xac,
-	0x17, 0xae, 0x96, 0x51, 0x21, 0x0f, 0xa0, 0x53, 0x70, 0x5d, 0xc0, 0x53, 0x5e, 0x69, 0xa3, 0x32,
-	0xae, 0xcb, 0x3f, 0x4f, 0xdb, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x37, 0x34, 0x48, 0x63,
-	0x09, 0x00, 0x00,
+	// 833 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4b, 0x8f, 0xe3, 0x44,
+	0x10, 0x8e, 0x93, 0x38, 0x8f, 0xf2, 0x24, 0x13, 0x9a, 0x05, 0x5a, 0x23, 0x84, 0x82, 0xe1, 0x10,
+	0x84, 0x34, 0xa0, 0x2c, 0x20, 0x24, 0x84, 0xd0, 0xcc, 0xf0, 0xc8, 0x8a, 0x11, 0x8f, 0xde, 0x00,
+	0xe7, 0x1e, 0xa7, 0xbc, 0xd3, 0xc2, 0x6e, 0x7b, 0xdb, 0x9d, 0xd9, 0x31, 0x47, 0x8e, 0x1c, 0xf8,
+	0x05, 0xfc, 0x4b, 0xce, 0x48, 0xc8, 0xed, 0x47, 0x6c, 0x67, 0x86, 0xf0, 0x38, 0xb2, 0xb7, 0xd4,
+	0x57, 0x55, 0x5f, 0x57, 0x75, 0xd7, 0x57, 0x0e, 0x4c, 0xb7, 0xd2, 0x57, 0x88, 0x3f, 0xe1, 0x69,
+	0xac, 0x22, 0x1d, 0x11, 0x5b, 0xa7, 0x31, 0x26, 0x27, 0x47, 0x5e, 0x14, 0x86, 0x91, 0xcc, 0x41,
+	0xf7, 0xf7, 0x1e, 0x8c, 0xbe, 0x2b, 0xe2, 0xc8, 0x6b, 0x00, 0x65, 0xce, 0xa3, 0x4f, 0xa9, 0x35,
+	0xb7, 0x16, 0x63, 0x56, 0x43, 0xc8, 0xab, 0x30, 0x4e, 0x34, 0x57, 0x7a, 0x2d, 0x42, 0xa4, 0xdd,
+	0xb9, 0xb5, 0xe8, 0xb1, 0x1d, 0x90, 0x79, 0x79, 0x92, 0xa0, 0xfe, 0xec, 0x16, 0x3d, 0xda, 0x33,
+	0xc9, 0x3b, 0x80, 0xcc, 0xc1, 0x31, 0xc6, 0xe3, 0x34, 0xbc, 0x8a, 0x02, 0xda, 0x37, 0xfe, 0x3a,
+	0x94, 0x9d, 0xae, 0x23, 0xcd, 0x83, 0x8b, 0x68, 0x2b, 0x35, 0xb5, 0x0d, 0x7d, 0x0d, 0xc9, 0xf8,
+	0x85, 0x14, 0x5a, 0x70, 0x1d, 0x29, 0x3a, 0xc8, 0xf9, 0x2b, 0x20, 0xe3, 0xbf, 0x42, 0x89, 0xbe,
+	0xf0, 0x04, 0x57, 0x29, 0x1d, 0xe6, 0xfc, 0x35, 0x28, 0xcb, 0x57, 0x18, 0x72, 0x21, 0x85, 0x7c,
+	0x42, 0x47, 0x79, 0xf5, 0x15, 0x40, 0x1e, 0x80, 0x1d, 0x22, 0x97, 0x09, 0x1d, 0x9b, 0xcc, 0xdc,
+	0x20, 0xef, 0xc2, 0xd8, 0x17, 0xb7, 0x67, 0xa1, 0x29, 0x09, 0xe6, 0xd6, 0xc2, 0x59, 0xce, 0x4e,
+	0xcd, 0x3d, 0x9e, 0x7e, 0x5e, 0xe2, 0xab, 0x0e, 0xdb, 0x05, 0x91, 0x4f, 0x60, 0x1a, 0xa0, 0xaf,
+	0xbf, 0x51, 0x51, 0x1c, 0x29, 0x2d, 0x22, 0x49, 0x1d, 0x93, 0xf6, 0x52, 0x91, 0x76, 0xd9, 0x70,
+	0xae, 0x3a, 0xac, 0x15, 0x4e, 0x3e, 0x00, 0xc7, 0x0b, 0x84, 0xef, 0x5f, 0x0a, 0x89, 0x5c, 0xd1,
+	0xa9, 0xc9, 0x26, 0x45, 0xf6, 0xc5, 0xce, 0xb3, 0xea, 0xb0, 0x7a, 0xa0, 0xb9, 0x3e, 0x54, 0xa1,
+	0x90, 0x5c, 0xe3, 0x86, 0x1e, 0xcd, 0xad, 0xc5, 0x88, 0xd5, 0x90, 0x73, 0x80, 0x91, 0xe9, 0xe9,
+	0xeb, 0x58, 0xbb, 0x1f, 0xc1, 0xb8, 0x2a, 0x9f, 0xbc, 0x0c, 0x83, 0x18, 0x95, 0x88, 0x36, 0xe6,
+	0xc5, 0x7b, 0xac, 0xb0, 0x32, 0x9c, 0xe7, 0x8d, 0xe7, 0x4f, 0x5d, 0x58, 0xee, 0x57, 0x30, 0x6d,
+	0x36, 0x71, 0x2f, 0xc3, 0x9b, 0x30, 0xd1, 0x28, 0xd7, 0xd7, 0xd1, 0x36, 0xe1, 0x72, 0xa3, 0xaf,
+	0x0b, 0xa2, 0x26, 0xe8, 0x7e, 0x0c, 0x4e, 0xad, 0xad, 0xec, 0x21, 0x4c, 0x5b, 0x05, 0x57, 0x6e,
+	0x10, 0x0a, 0x43, 0x94, 0x9b, 0xda, 0xe0, 0x95, 0xa6, 0xfb, 0x73, 0x17, 0xa6, 0xe5, 0x04, 0x9f,
+	0x79, 0xa6, 0x9e, 0x77, 0x60, 0xe0, 0x29, 0xe4, 0x1a, 0xa9, 0xd5, 0xb8, 0xfb, 0x32, 0xec, 0xc2,
+	0x38, 0x57, 0x1d, 0x56, 0x84, 0x91, 0xf7, 0x61, 0xf4, 0x4c, 0xe8, 0xeb, 0x8d, 0xe2, 0xcf, 0x0c,
+	0xbd, 0xb3, 0x7c, 0xa5, 0x95, 0xf2, 0x43, 0xe1, 0x5e, 0x75, 0x58, 0x15, 0x4a, 0x3e, 0x84, 0x71,
+	0x75, 0xc1, 0x66, 0xe2, 0x9d, 0x25, 0x6d, 0xe5, 0xad, 0x4b, 0x7f, 0x36, 0x25, 0x55, 0x70, 0x76,
+	0xa0, 0x56, 0x5c, 0x26, 0x3e, 0x2a, 0x6a, 0xdf, 0x79, 0xe0, 0xba, 0x70, 0x67, 0x07, 0x96, 0xa1,
+	0x64, 0x0a, 0x5d, 0x9d, 0x1a, 0xed, 0xd8, 0xac, 0xab, 0xd3, 0xf3, 0x21, 0xd8, 0x37, 0x3c, 0xd8,
+	0xa2, 0xfb, 0x47, 0xed, 0x12, 0xf2, 0xee, 0x9a, 0x62, 0xb5, 0xfe, 0x52, 0xac, 0xdd, 0x03, 0x62,
+	0xed, 0x1d, 0x12, 0x6b, 0x7f, 0x4f, 0xac, 0x2d, 0x39, 0xda, 0xfb, 0x72, 0xac, 0x04, 0x37, 0xb8,
+	0x57, 0x70, 0xc3, 0x7f, 0x27, 0xb8, 0xd1, 0x7f, 0x12, 0xdc, 0xf8, 0x6f, 0x0a, 0xae, 0x21, 0xa8,
+	0x25, 0xcc, 0xda, 0x93, 0x72, 0x68, 0x9b, 0xba, 0x0f, 0xe1, 0x85, 0xbd, 0x29, 0x39, 0x98, 0xb4,
+	0x86, 0x59, 0x7b, 0x42, 0x0e, 0xe5, 0xb4, 0xdf, 0xa2, 0xbb, 0xf7, 0x16, 0x2e, 0x87, 0x63, 0x86,
+	0x1e, 0x8a, 0x58, 0x57, 0xdf, 0x82, 0x37, 0xa0, 0x1f, 0x2b, 0xbc, 0x29, 0x14, 0x74, 0xdc, 0x9a,
+	0x4e, 0x66, 0x9c, 0xe4, 0x2d, 0x18, 0x7a, 0x5b, 0xa5, 0xb0, 0xd8, 0x11, 0x77, 0xc4, 0x95, 0x7e,
+	0xf7, 0x7b, 0x98, 0x5c, 0x46, 0x1e, 0x0f, 0xaa, 0x03, 0xde, 0x86, 0x51, 0x59, 0xe3, 0x7d, 0x87,
+	0x54, 0x01, 0x99, 0xfc, 0xf5, 0xed, 0x23, 0xb9, 0xc1, 0xdb, 0xa2, 0xfc, 0xd2, 0x74, 0x7d, 0x38,
+	0x61, 0x18, 0x07, 0xe9, 0xb7, 0x5b, 0x54, 0xe9, 0x3f, 0x7d, 0x03, 0xb2, 0x80, 0x63, 0x7e, 0xc3,
+	0x45, 0xc0, 0xaf, 0x02, 0x3c, 0xab, 0x2f, 0xbb, 0x36, 0xec, 0xfe, 0x66, 0xc1, 0x11, 0xc3, 0xa7,
+	0xe5, 0x09, 0x49, 0xa6, 0xa0, 0x8d, 0x50, 0x68, 0x36, 0x8e, 0x61, 0xb6, 0xd9, 0x0e, 0x30, 0x5b,
+	0xac, 0xa2, 0xb3, 0x59, 0x6e, 0x64, 0x6d, 0xf8, 0x2a, 0x0a, 0xbf, 0xc4, 0xb4, 0xd0, 0x54, 0x69,
+	0x36, 0x3f, 0x6e, 0xfd, 0x03, 0x1f, 0xb7, 0x7d, 0x35, 0xb9, 0xbf, 0xf4, 0x61, 0x62, 0xee, 0xe1,
+	0xf9, 0xc7, 0xfc, 0x7f, 0xf0, 0x31, 0x27, 0x33, 0xe8, 0xfd, 0x88, 0x29, 0x9d, 0x98, 0xf6, 0xb2,
+	0x9f, 0x8d, 0x6d, 0x74, 0x0e, 0xd3, 0xc6, 0x2c, 0x64, 0xad, 0xd7, 0xc5, 0xd6, 0x5b, 0x38, 0xcb,
+	0x07, 0x45, 0x11, 0x8d, 0xc0, 0x9d, 0xe2, 0x96, 0xbf, 0x5a, 0xbb, 0x14, 0x72, 0x09, 0x2f, 0x7e,
+	0x81, 0x7a, 0x4f, 0x5d, 0xb3, 0x8a, 0xe3, 0xe9, 0x63, 0xad, 0x84, 0x7c, 0x72, 0xf2, 0x7a, 0x9d,
+	0xf5, 0x4e, 0x49, 0xba, 0x1d, 0xf2, 0x1e, 0x4c, 0x1a, 0xae, 0x3b, 0x78, 0xda, 0xab, 0xc0, 0xed,
+	0x5c, 0x0d, 0xcc, 0x1f, 0xd6, 0x87, 0x7f, 0x0e, 0x00, 0x8d, 0xd2, 0xb5, 0xf6, 0xd7, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -17,6 +17,7 @@ import (
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
 	ttypes "github.com/33cn/plugin/plugin/consensus/tendermint/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
 	vt "github.com/33cn/plugin/plugin/dapp/valnode/types"
 	"github.com/spf13/cobra"
 )
@@ -42,6 +43,7 @@ func ValCmd() *cobra.Command {
 		GetNodeInfoCmd(),
 		AddNodeCmd(),
 		CreateCmd(),
+		PopCmd(),
 	)
 	return cmd
 }
@@ -129,6 +131,7 @@ func addNodeFlags(cmd *cobra.Command) {
 	cmd.MarkFlagRequired("pubkey")
 	cmd.Flags().Int64P("power", "w", 0, "voting power")
 	cmd.MarkFlagRequired("power")
+	cmd.Flags().StringP("pop", "o", "", "bls public key's proof of possession, get by valnode pop cmd")
 }
 
 func addNode(cmd *cobra.Command, args []string) {
@@ -136,13 +139,19 @@ func addNode(cmd *cobra.Command, args []string) {
 	cfg := types.GetCliSysParam(title)
 	pubkey, _ := cmd.Flags().GetString("pubkey")
 	power, _ := cmd.Flags().GetInt64("power")
+	pop, _ := cmd.Flags().GetString("pop")
 
 	pubkeybyte, err := hex.DecodeString(pubkey)
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		return
 	}
-	value := &vt.ValNodeAction_Node{Node: &vt.ValNode{PubKey: pubkeybyte, Power: power}}
+	popbyte, err := hex.DecodeString(pop)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	value := &vt.ValNodeAction_Node{Node: &vt.ValNode{PubKey: pubkeybyte, Power: power, Pop: popbyte}}
 	action := &vt.ValNodeAction{Value: value, Ty: vt.ValNodeActionUpdate}
 	tx := &types.Transaction{Payload: types.Encode(action)}
 	tx, err = types.FormatTx(cfg, vt.ValNodeX, tx)
@@ -154,6 +163,36 @@ func addNode(cmd *cobra.Command, args []string) {
 	fmt.Println(hex.EncodeToString(txHex))
 }
 
+//PopCmd generate bls validator's proof of possession
+func PopCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pop",
+		Short: "Generate bls validator public key's proof of possession",
+		Run:   genPop,
+	}
+	cmd.Flags().StringP("file", "f", "priv_validator.json", "bls priv validator file")
+	return cmd
+}
+
+func genPop(cmd *cobra.Command, args []string) {
+	file, _ := cmd.Flags().GetString("file")
+	if _, err := os.Stat(file); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	err := initCryptoImpl(AuthBLS)
+	if err != nil {
+		return
+	}
+	privValidator := ttypes.LoadPrivValidatorFS(file)
+	pop, err := bls.Driver{}.GenPop(privValidator.PrivKey)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	fmt.Printf("pubkey: %X\npop: %X\n", privValidator.GetPubKey().Bytes(), pop.Bytes())
+}
+
 //CreateCmd to create keyfiles
 func CreateCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -9,6 +9,8 @@ import (
 
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
+	auty "github.com/33cn/plugin/plugin/dapp/autonomy/types"
 	pty "github.com/33cn/plugin/plugin/dapp/valnode/types"
 )
 
@@ -16,7 +18,7 @@ const managerKey = "tendermint-manager"
 
 // Exec_Node method
 func (val *ValNode) Exec_Node(node *pty.ValNode, tx *types.Transaction, index int) (*types.Receipt, error) {
-	if !isValidManager(tx.From(), val.GetStateDB()) {
+	if !isValidManager(val.GetAPI().GetConfig(), tx.From(), val.GetStateDB(), val.GetHeight()) {
 		return nil, errors.New("not valid manager")
 	}
 	if len(node.GetPubKey()) == 0 {
@@ -25,6 +27,12 @@ func (val *ValNode) Exec_Node(node *pty.ValNode, tx *types.Transaction, index in
 	if node.GetPower() < 0 {
 		return nil, errors.New("validator power must not be negative")
 	}
+	cfg := val.GetAPI().GetConfig()
+	if cfg.IsDappFork(val.GetHeight(), pty.ValNodeX, pty.ForkBlsPop) {
+		if err := checkBlsPop(node); err != nil {
+			return nil, err
+		}
+	}
 	receipt := &types.Receipt{Ty: types.ExecOk, KV: nil, Logs: nil}
 	return receipt, nil
 }
@@ -35,45 +43,35 @@ func (val *ValNode) Exec_BlockInfo(blockInfo *pty.TendermintBlockInfo, tx *types
 	return receipt, nil
 }
 
-func getConfigKey(key string, db dbm.KV) ([]byte, error) {
-	configKey := types.ConfigKey(key)
-	value, err := db.Get([]byte(configKey))
-	if err != nil {
-		clog.Error("getConfigKey not find", "configKey", configKey, "err", err)
-		return nil, err
+//bls公钥(48字节)的同消息聚合验签需要proof of possession，防止构造的rogue key抵消其他验证者公钥，移除验证者不需要
+//fork时已有的验证者不需要补充交易，携带pop的相同权重交易即为pop登记，规则与tendermint的updateValidators一致
+func checkBlsPop(node *pty.ValNode) error {
+	if len(node.GetPubKey()) != bls.BLSPublicKeyLength || node.GetPower() == 0 {
+		return nil
 	}
-	return value, nil
-}
-
-func getManageKey(key string, db dbm.KV) ([]byte, error) {
-	manageKey := types.ManageKey(key)
-	value, err := db.Get([]byte(manageKey))
-	if err != nil {
-		clog.Info("getManageKey not find", "manageKey", manageKey, "err", err)
-		return getConfigKey(key, db)
+	if len(node.GetPop()) != bls.BLSSignatureLength {
+		return errors.New("bls validator pop is empty or invalid")
 	}
-	return value, nil
-}
-
-func isValidManager(addr string, db dbm.KV) bool {
-	value, err := getManageKey(managerKey, db)
+	drv := bls.Driver{}
+	pub, err := drv.PubKeyFromBytes(node.GetPubKey())
 	if err != nil {
-		clog.Error("isValidManager nil key", "managerKey", managerKey)
-		return false
+		return err
 	}
-	if value == nil {
-		clog.Error("isValidManager nil value")
-		return false
+	pop, err := drv.SignatureFromBytes(node.GetPop())
+	if err != nil {
+		return err
 	}
+	return drv.VerifyPop(pub, pop)
+}
 
-	var item types.ConfigItem
-	err = types.Decode(value, &item)
+func isValidManager(cfg *types.Chain33Config, addr string, db dbm.KV, height int64) bool {
+	// 自治合约提案和manage都可以设置管理员
+	managers, err := auty.GetConfigValues(cfg, db, managerKey, height, true)
 	if err != nil {
-		clog.Error("isValidManager decode fail", "err", err)
+		clog.Error("isValidManager", "managerKey", managerKey, "err", err)
 		return false
 	}
-
-	for _, op := range item.GetArr().Value {
+	for _, op := range managers {
 		if op == addr {
 			return true
 		}
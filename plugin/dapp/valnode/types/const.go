@@ -17,3 +17,6 @@ const (
 const (
 	ActionNodeUpdate = "NodeUpdate"
 )
+
+// ForkBlsPop 新增bls验证者需要公钥的proof of possession
+const ForkBlsPop = "ForkBlsPop"
@@ -8,4 +8,5 @@ package types
 type NodeUpdateTx struct {
 	PubKey string `json:"pubKey"`
 	Power  int64  `json:"power"`
+	Pop    string `json:"pop,omitempty"`
 }
@@ -25,6 +25,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ValNodeX, "Enable", 0)
+	cfg.RegisterDappFork(ValNodeX, ForkBlsPop, types.MaxHeight)
 }
 
 //InitExecutor ...
@@ -99,6 +100,12 @@ func CreateNodeUpdateTx(cfg *types.Chain33Config, parm *NodeUpdateTx) (*types.Tr
 		PubKey: pubkeybyte,
 		Power:  parm.Power,
 	}
+	if len(parm.Pop) > 0 {
+		v.Pop, err = hex.DecodeString(parm.Pop)
+		if err != nil {
+			return nil, err
+		}
+	}
 	update := &ValNodeAction{
 		Ty:    ValNodeActionUpdate,
 		Value: &ValNodeAction_Node{v},
@@ -29,6 +29,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 type ValNode struct {
 	PubKey               []byte   `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 	Power                int64    `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+	Pop                  []byte   `protobuf:"bytes,3,opt,name=pop,proto3" json:"pop,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -73,6 +74,13 @@ func (m *ValNode) GetPower() int64 {
 	return 0
 }
 
+func (m *ValNode) GetPop() []byte {
+	if m != nil {
+		return m.Pop
+	}
+	return nil
+}
+
 type ValNodes struct {
 	Nodes                []*ValNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
@@ -291,28 +299,28 @@ func init() {
 }
 
 var fileDescriptor_38e9a3523ca7e0ea = []byte{
-	// 322 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x6a, 0xf2, 0x40,
-	0x14, 0x4d, 0xcc, 0x97, 0xf8, 0xf5, 0x46, 0x45, 0xa6, 0x45, 0x42, 0x56, 0x61, 0xb0, 0x25, 0x50,
-	0x90, 0xa2, 0x8b, 0x42, 0x77, 0x75, 0x53, 0xa5, 0xd0, 0xc5, 0x28, 0xdd, 0xc7, 0xe4, 0xb6, 0x06,
-	0xe3, 0x4c, 0x4c, 0x46, 0x4b, 0x5e, 0xa1, 0x4f, 0x5d, 0x32, 0x99, 0x46, 0x68, 0xe9, 0xf2, 0xfc,
-	0xe5, 0x9c, 0xdc, 0x81, 0xfe, 0x29, 0xca, 0xb8, 0x48, 0x70, 0x92, 0x17, 0x42, 0x0a, 0x62, 0xcb,
-	0x2a, 0xc7, 0xd2, 0xef, 0xc5, 0x62, 0xbf, 0x17, 0xbc, 0x21, 0xfd, 0xa1, 0x44, 0x9e, 0x60, 0xb1,
-	0x4f, 0xb9, 0x6c, 0x18, 0x7a, 0x0f, 0xdd, 0xd7, 0x28, 0x7b, 0x11, 0x09, 0x92, 0x11, 0x38, 0xf9,
-	0x71, 0xf3, 0x8c, 0x95, 0x67, 0x06, 0x66, 0xd8, 0x63, 0x1a, 0x91, 0x2b, 0xb0, 0x73, 0xf1, 0x81,
-	0x85, 0xd7, 0x09, 0xcc, 0xd0, 0x62, 0x0d, 0xa0, 0x77, 0xf0, 0x5f, 0x07, 0x4b, 0x32, 0x06, 0xbb,
-	0x6e, 0x2e, 0x3d, 0x33, 0xb0, 0x42, 0x77, 0x3a, 0x98, 0xa8, 0xee, 0x89, 0xd6, 0x59, 0x23, 0xd2,
-	0x4f, 0x13, 0xfa, 0x9a, 0x7a, 0x8c, 0x65, 0x2a, 0x38, 0x19, 0xc3, 0xbf, 0x5a, 0x52, 0x7d, 0xbf,
-	0x62, 0x0b, 0x83, 0x29, 0x95, 0x3c, 0xc0, 0xc5, 0x26, 0x13, 0xf1, 0x6e, 0xc9, 0xdf, 0x84, 0xda,
-	0xe0, 0x4e, 0x7d, 0x6d, 0x5d, 0xb7, 0xbf, 0x33, 0xff, 0x76, 0x2c, 0x0c, 0x76, 0xb6, 0x93, 0x01,
-	0x74, 0xd6, 0x95, 0x67, 0x05, 0x66, 0x68, 0xb3, 0xce, 0xba, 0x9a, 0x77, 0xc1, 0x3e, 0x45, 0xd9,
-	0x11, 0xe9, 0x35, 0xb8, 0x0c, 0x0f, 0x75, 0x8f, 0xf2, 0x8d, 0xc0, 0xd9, 0x62, 0xfa, 0xbe, 0x95,
-	0x6a, 0x8b, 0xc5, 0x34, 0xa2, 0x37, 0xd0, 0x63, 0x78, 0x68, 0x3f, 0xfe, 0x97, 0x6f, 0xba, 0x83,
-	0xae, 0x3e, 0x3f, 0xb9, 0x05, 0x67, 0x59, 0xae, 0x2a, 0x1e, 0x93, 0xbe, 0x5e, 0x59, 0x17, 0xa5,
-	0x99, 0x3f, 0xd4, 0x70, 0x59, 0x2e, 0x30, 0xca, 0xe4, 0xb6, 0xa2, 0x06, 0x99, 0x81, 0xfb, 0x84,
-	0xb2, 0x9d, 0xf1, 0x23, 0x71, 0x79, 0xbe, 0x48, 0x9a, 0x44, 0x52, 0x14, 0x2b, 0x94, 0xd4, 0xd8,
-	0x38, 0xea, 0xe9, 0x66, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xce, 0xc8, 0x21, 0x55, 0xf2, 0x01,
-	0x00, 0x00,
+	// 327 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4f, 0xc2, 0x40,
+	0x10, 0x85, 0x5b, 0x6a, 0x8b, 0x4e, 0x81, 0x90, 0xd5, 0x90, 0xa6, 0x27, 0xb2, 0x41, 0x43, 0x62,
+	0x42, 0x0c, 0xdc, 0xbc, 0xc9, 0x45, 0x1a, 0x13, 0x0f, 0x0b, 0xf1, 0x5e, 0xda, 0x51, 0x1a, 0xca,
+	0x6e, 0x69, 0x17, 0xcc, 0xfe, 0x05, 0x7f, 0xb5, 0xe9, 0x76, 0x85, 0x44, 0xe3, 0xad, 0x6f, 0xde,
+	0x9b, 0x99, 0xaf, 0x3b, 0xd0, 0x3d, 0xc6, 0x39, 0x17, 0x29, 0x4e, 0x8a, 0x52, 0x48, 0x41, 0x5c,
+	0xa9, 0x0a, 0xac, 0xc2, 0x4e, 0x22, 0x76, 0x3b, 0xc1, 0x9b, 0x62, 0xd8, 0x97, 0xc8, 0x53, 0x2c,
+	0x77, 0x19, 0x97, 0x4d, 0x85, 0x46, 0xd0, 0x7e, 0x8b, 0xf3, 0x57, 0x91, 0x22, 0x19, 0x80, 0x57,
+	0x1c, 0xd6, 0x2f, 0xa8, 0x02, 0x7b, 0x68, 0x8f, 0x3b, 0xcc, 0x28, 0x72, 0x03, 0x6e, 0x21, 0x3e,
+	0xb1, 0x0c, 0x5a, 0x43, 0x7b, 0xec, 0xb0, 0x46, 0x90, 0x3e, 0x38, 0x85, 0x28, 0x02, 0x47, 0x47,
+	0xeb, 0x4f, 0xfa, 0x00, 0x97, 0x66, 0x54, 0x45, 0x46, 0xe0, 0xd6, 0x2c, 0x55, 0x60, 0x0f, 0x9d,
+	0xb1, 0x3f, 0xed, 0x4d, 0x34, 0xcd, 0xc4, 0xf8, 0xac, 0x31, 0xe9, 0x97, 0x0d, 0x5d, 0x53, 0x7a,
+	0x4a, 0x64, 0x26, 0x38, 0x19, 0xc1, 0x45, 0x6d, 0x69, 0x82, 0x3f, 0x6d, 0x0b, 0x8b, 0x69, 0x97,
+	0x3c, 0xc2, 0xd5, 0x3a, 0x17, 0xc9, 0x36, 0xe2, 0xef, 0x42, 0x53, 0xf9, 0xd3, 0xd0, 0x44, 0x57,
+	0xa7, 0x1f, 0x9c, 0xff, 0x24, 0x16, 0x16, 0x3b, 0xc7, 0x49, 0x0f, 0x5a, 0x2b, 0xa5, 0xb1, 0x5d,
+	0xd6, 0x5a, 0xa9, 0x79, 0x1b, 0xdc, 0x63, 0x9c, 0x1f, 0x90, 0xde, 0x82, 0xcf, 0x70, 0x5f, 0xef,
+	0xd1, 0xb9, 0x01, 0x78, 0x1b, 0xcc, 0x3e, 0x36, 0x52, 0xb3, 0x38, 0xcc, 0x28, 0x7a, 0x07, 0x1d,
+	0x86, 0xfb, 0xd3, 0xf0, 0xff, 0x72, 0xd3, 0x2d, 0xb4, 0xcd, 0x41, 0xc8, 0x3d, 0x78, 0x51, 0xb5,
+	0x54, 0x3c, 0x21, 0x5d, 0x43, 0x59, 0x2f, 0xca, 0xf2, 0xb0, 0x6f, 0x64, 0x54, 0x2d, 0x30, 0xce,
+	0xe5, 0x46, 0x51, 0x8b, 0xcc, 0xc0, 0x7f, 0x46, 0x79, 0xc2, 0xf8, 0xd5, 0x71, 0x7d, 0x7e, 0x91,
+	0x2c, 0x8d, 0xa5, 0x28, 0x97, 0x28, 0xa9, 0xb5, 0xf6, 0xf4, 0x31, 0x67, 0xdf, 0x03, 0x00, 0x09,
+	0x1e, 0x5f, 0x34, 0x04, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.